@@ -0,0 +1,162 @@
+//go:build !slugify_nocjk
+// +build !slugify_nocjk
+
+package slugify
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"golang.org/x/text/language"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
+
+var cjkTests = []struct {
+	in, out string
+}{
+	{"北京 Seoul", "beijing-seoul"},
+	{"ꀀ", "it"},
+	{"日本語の手紙をテスト", "nihongo-no-tegami-wo-tesuto"},
+	{"北京kožuščekł", "beijing-kozuscekl"},
+	{"㐀", "qiu"},
+	{"𠀀𠀁", "he-qi"},
+}
+
+var cjkOptionTests = []struct {
+	opts    []Option
+	in, out string
+}{
+	{[]Option{WithMaxLen(2)}, "日本語の手紙をテスト", "ni"},
+	{[]Option{WithMaxLen(2)}, "北京kožuščekł", "be"},
+	{[]Option{WithCase(CasePreserve)}, "北京kožuščekł", "Beijing-kozuscekl"},
+	{[]Option{WithOverride('北', "north")}, "北京", "north-jing"},
+	{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "Привет 北京", "привет-beijing"},
+	{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "Привет北京", "привет-beijing"},
+	{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "Москва東京", "москва-dong-jing"},
+	{[]Option{WithUnicode(), WithScripts(TransliterateScript, unicode.Han)}, "Привет 北京", "привет-beijing"},
+	{[]Option{WithUnicode(), WithScripts(TransliterateScript, unicode.Han)}, "北京", "beijing"},
+	{[]Option{WithUnicode(), WithScripts(TransliterateScript, unicode.Han)}, "日本語の手紙 ひらがな", "nihongo-no-tegami-ひらがな"},
+	{[]Option{WithUnicode(), WithScripts(TransliterateScript, unicode.Han)}, "食べる", "taberu"},
+}
+
+func TestSlugifyJapanese(t *testing.T) {
+	var tests = []struct {
+		profile *transliterations.Profile
+		in, out string
+	}{
+		{nil, "東京の大学", "tokyo-no-daigaku"},
+		{nil, "大阪の会社員", "osaka-no-kaishain"},
+		{nil, "山の上", "yama-no-ue"},
+		{nil, "食べるのはテスト", "taberu-no-wa-tesuto"},
+		{nil, "本をよむ", "hon-wo-yomu"},
+		{nil, "東京へいく", "tokyo-e-iku"},
+		{nil, "学校でべんきょう", "gakko-de-benkyo"},
+		{nil, "天気がいい", "tenki-ga-ii"},
+		{nil, "金曜日にいく", "kinyobi-ni-iku"},
+		{nil, "北京", "beijing"},
+		{transliterations.Japanese, "東京駅", "tokyo-eki"},
+		{transliterations.Japanese, "人々", "hitobito"},
+		{transliterations.Japanese, "日本\xff", "nihon"},
+		{transliterations.Japanese, "山\xff", "yama"},
+		{&transliterations.Profile{Kanji: transliterations.JapaneseWords, Kana: transliterations.KunreiShiki}, "写真", "syasin"},
+	}
+
+	for _, test := range tests {
+		s := New(WithProfile(test.profile))
+		if out := s.Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+}
+
+func TestSlugifyChinese(t *testing.T) {
+	var tests = []struct{ in, out string }{
+		{"北京大学", "beijing-daxue"},
+		{"重庆", "chongqing"},
+		{"重要的问题", "zhongyao-de-wenti"},
+		{"中华人民共和国", "zhonghua-renmin-gongheguo"},
+		{"臺灣 銀行", "taiwan-yinhang"},
+		{"旅行", "luxing"},
+	}
+
+	for _, test := range tests {
+		if out := Slugify(test.in, 0); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+
+	if out := IDify("北京大学", 0); out != "Beijing-Daxue" {
+		t.Errorf("%q != %q", out, "Beijing-Daxue")
+	}
+}
+
+func TestSlugifyChineseDictionary(t *testing.T) {
+	d, err := transliterations.ParseCEDICT(strings.NewReader("# test\n重慶 重庆 [Chong2 qing4] /Chongqing/\n重 重 [chong2] /again/\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := New(WithProfile(&transliterations.Profile{Hanzi: d}))
+	if out := s.Slugify("重庆 北京"); out != "chongqing-bei-jing" {
+		t.Errorf("%q != %q", out, "chongqing-bei-jing")
+	}
+
+	if _, err := transliterations.ParseCEDICT(strings.NewReader("北京 [Bei3 jing1]")); err == nil {
+		t.Error("a line without both forms of the word was accepted")
+	}
+}
+
+func TestPinyinModes(t *testing.T) {
+	var tests = []struct {
+		mode    transliterations.PinyinMode
+		in, out string
+	}{
+		{transliterations.PinyinPlain, "西安", "Xian"},
+		{transliterations.PinyinApostrophe, "西安", "Xi'an"},
+		{transliterations.PinyinToneMarks, "西安", "Xī'ān"},
+		{transliterations.PinyinToneNumbers, "西安", "Xi1an1"},
+		{transliterations.PinyinToneMarks, "北京大学", "Běijīng Dàxué"},
+		{transliterations.PinyinToneMarks, "欧洲", "Ōuzhōu"},
+		{transliterations.PinyinToneMarks, "旅行", "Lǚxíng"},
+		{transliterations.PinyinToneNumbers, "我们", "Wo3men"},
+	}
+
+	for _, test := range tests {
+		p := &transliterations.Profile{Pinyin: test.mode}
+		if out := SanatizeTextProfile(test.in, p); out != test.out {
+			t.Errorf("%d %q: %q != %q", test.mode, test.in, out, test.out)
+		}
+		if out := New(WithProfile(p)).Slugify(test.in); strings.ContainsAny(out, "'1234") || out != Slugify(test.in, 0) {
+			t.Errorf("%d %q: %q is not the plain slug", test.mode, test.in, out)
+		}
+	}
+}
+
+func TestSlugifyCantonese(t *testing.T) {
+	var tests = []struct {
+		tag     language.Tag
+		in, out string
+	}{
+		{language.MustParse("yue"), "香港", "hoeng-gong"},
+		{language.MustParse("zh-HK"), "銅鑼灣", "tung-lo-waan"},
+		{language.MustParse("zh-Hant-HK"), "九龍", "gau-lung"},
+		{language.MustParse("zh-HK"), "佢哋喺度食飯", "keoi-dei-hai-dou-sik-faan"},
+		{language.Chinese, "香港", "xianggang"},
+		{language.MustParse("zh-TW"), "香港", "xianggang"},
+	}
+
+	for _, test := range tests {
+		if out := SlugifyLanguage(test.in, 0, test.tag); out != test.out {
+			t.Errorf("%s %q: %q != %q", test.tag, test.in, out, test.out)
+		}
+	}
+
+	s := New(WithProfile(&transliterations.Profile{Han: transliterations.Yale}))
+	if out := s.Slugify("香港 將軍澳"); out != "heung-gong-jeung-gwan-ou" {
+		t.Errorf("%q != %q", out, "heung-gong-jeung-gwan-ou")
+	}
+	if out := transliterations.TransliterateHan('粵', transliterations.Jyutping); out != "Jyut " {
+		t.Errorf("%q != %q", out, "Jyut ")
+	}
+}
@@ -4,14 +4,15 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/text/language"
 
 	"github.com/digitalxero/slugify"
+	"github.com/digitalxero/slugify/transliterations"
 )
 
-type slugifierFunc = func(text string, maxLen int) string
-
 var (
 	pkgName    = "slugify"
 	version    = "0.0.1"
@@ -21,10 +22,13 @@ var (
 		Short: "CLI Tool to slugify a string",
 	}
 	lowerOnly = false
-	maxLen         = 0
-	ok = slugify.OK
-	dash = slugify.TO_DASH
-	slugifier slugifierFunc
+	maxLen    = 0
+	ok        = slugify.OK
+	dash      = slugify.TO_DASH
+	separator = "-"
+	lang      = ""
+	cyrillic  = ""
+	slugifier *slugify.Slugifier
 )
 
 func main() {
@@ -56,6 +60,27 @@ func main() {
 		dash,
 		`Convert these to a dash instead of stripping them from the output`)
 
+	cmdRoot.Flags().StringVarP(
+		&separator,
+		"separator",
+		"s",
+		separator,
+		`Put this character between words, leave empty to run the words together`)
+
+	cmdRoot.Flags().StringVarP(
+		&lang,
+		"lang",
+		"",
+		lang,
+		`BCP 47 language tag of the text, e.g. de or da, to transliterate the way that language does`)
+
+	cmdRoot.Flags().StringVarP(
+		&cyrillic,
+		"cyrillic",
+		"",
+		cyrillic,
+		`Romanize Cyrillic with this scheme instead of --lang, one of `+strings.Join(transliterations.CyrillicSchemes(), ", "))
+
 	cmdRoot.Run = run
 	cmdRoot.PersistentPreRun = preReun
 
@@ -70,17 +95,51 @@ func startCLI() (err error) {
 }
 
 func preReun(c *cobra.Command, args []string) {
-	slugifier = slugify.IDify
+	caseMode := slugify.CasePreserve
 	if lowerOnly {
-		slugifier = slugify.Slugify
+		caseMode = slugify.CaseLower
+	}
+	if utf8.RuneCountInString(separator) > 1 {
+		fmt.Fprintf(os.Stderr, "--separator must be a single character, not %q\n", separator)
+		os.Exit(2)
+	}
+	if lang != "" && cyrillic != "" {
+		fmt.Fprintln(os.Stderr, "--lang and --cyrillic cannot be used together")
+		os.Exit(2)
+	}
+	sep := slugify.NoSeparator
+	for _, r := range separator {
+		sep = r
 	}
-	slugify.OK = ok
-	slugify.TO_DASH = dash
+	opts := []slugify.Option{
+		slugify.WithOK(ok),
+		slugify.WithSeparator(sep),
+		slugify.WithToDash(dash),
+		slugify.WithCase(caseMode),
+		slugify.WithMaxLen(maxLen),
+	}
+	if lang != "" {
+		tag, err := language.Parse(lang)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		opts = append(opts, slugify.WithLanguage(tag))
+	}
+	if cyrillic != "" {
+		p, ok := transliterations.CyrillicScheme(cyrillic)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "unknown Cyrillic scheme %q\n", cyrillic)
+			os.Exit(2)
+		}
+		opts = append(opts, slugify.WithProfile(p))
+	}
+	slugifier = slugify.New(opts...)
 }
 
 func run(c *cobra.Command, args []string) {
 	data := strings.Join(args, " ")
-	data = slugifier(data, maxLen)
+	data = slugifier.Slugify(data)
 
 	fmt.Println(data)
-}
\ No newline at end of file
+}
@@ -0,0 +1,41 @@
+package slugify
+
+import (
+	"testing"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
+
+func TestSlugifierEmoji(t *testing.T) {
+	names := New(WithEmoji(transliterations.EmojiNames))
+	codes := New(WithEmoji(transliterations.EmojiRegionCodes))
+	var tests = []struct {
+		s       *Slugifier
+		in, out string
+	}{
+		{New(), "🚀 Launch day", "launch-day"},
+		{names, "🚀 Launch day", "rocket-launch-day"},
+		{names, "🚀Launch🚀🚀", "rocket-launch-rocket-rocket"},
+		{names, "👍🏽 great", "thumbs-up-great"},
+		{names, "👨‍👩‍👧‍👦", "family-man-woman-girl-boy"},
+		{names, "👩🏽‍🚀", "woman-astronaut"},
+		{names, "❤️‍🔥", "heart-on-fire"},
+		{names, "😀‍🚀", "grinning-face-rocket"},
+		{names, "🇩🇪 🇫🇷", "germany-france"},
+		{names, "🏴󠁧󠁢󠁥󠁮󠁧󠁿", "england"},
+		{names, "#️⃣1", "keycap-1"},
+		{names, "© 2024", "c-2024"},
+		{names, "©️", "copyright"},
+		{names, "Жёлтый ☀️", "zhioltyi-sun"},
+		{codes, "🇩🇪 🇫🇷", "de-fr"},
+		{codes, "🏴󠁧󠁢󠁥󠁮󠁧󠁿", "gb-eng"},
+		{codes, "🇽🇽", "xx"},
+		{New(WithEmoji(transliterations.EmojiNames), WithCase(CasePreserve)), "🇩🇪 Trip", "Germany-Trip"},
+	}
+
+	for _, test := range tests {
+		if out := test.s.Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+}
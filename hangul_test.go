@@ -0,0 +1,48 @@
+//go:build !slugify_nohangul
+// +build !slugify_nohangul
+
+package slugify
+
+import (
+	"testing"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
+
+var hangulTests = []struct {
+	in, out string
+}{
+	{"서울 Seoul", "seoul-seoul"},
+	{"ᄀ ㄱ", "g-g"},
+	{"신라", "silla"},
+}
+
+func TestSlugifyHangul(t *testing.T) {
+	var tests = []struct{ in, out string }{
+		{"신라", "silla"},
+		{"독립 기념관", "dongnip-ginyeomgwan"},
+		{"한국어 교실", "hangugeo-gyosil"},
+		{"종로 3가", "jongno-3ga"},
+		{"같이 먹어요", "gachi-meogeoyo"},
+	}
+
+	for _, test := range tests {
+		if out := Slugify(test.in, 0); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+
+	mr := New(WithProfile(&transliterations.Profile{Hangul: transliterations.McCuneReischauer}))
+	for _, test := range []struct{ in, out string }{
+		{"부산 독립", "pusan-tongnip"},
+		{"김치", "kimchi"},
+		{"천안 청주", "chonan-chongju"},
+	} {
+		if out := mr.Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+	if out := transliterations.RomanizeHangul("김치", transliterations.McCuneReischauer); out != "kimch'i" {
+		t.Errorf("%q != %q", out, "kimch'i")
+	}
+}
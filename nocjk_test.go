@@ -0,0 +1,32 @@
+//go:build slugify_nocjk
+// +build slugify_nocjk
+
+package slugify
+
+import "unicode"
+
+var cjkTests = []struct {
+	in, out string
+}{
+	{"北京 Seoul", "seoul"},
+	{"ꀀ", ""},
+	{"日本語の手紙をテスト", "no-wo-tesuto"},
+	{"北京kožuščekł", "kozuscekl"},
+	{"㐀", ""},
+	{"𠀀𠀁", ""},
+}
+
+var cjkOptionTests = []struct {
+	opts    []Option
+	in, out string
+}{
+	{[]Option{WithMaxLen(2)}, "日本語の手紙をテスト", "no"},
+	{[]Option{WithMaxLen(2)}, "北京kožuščekł", "ko"},
+	{[]Option{WithCase(CasePreserve)}, "北京kožuščekł", "kozuscekl"},
+	{[]Option{WithOverride('北', "north")}, "北京", "north"},
+	{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "Привет 北京", "привет"},
+	{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "Привет北京", "привет"},
+	{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "Москва東京", "москва"},
+	{[]Option{WithUnicode(), WithScripts(TransliterateScript, unicode.Han)}, "Привет 北京", "привет"},
+	{[]Option{WithUnicode(), WithScripts(TransliterateScript, unicode.Han)}, "北京", ""},
+}
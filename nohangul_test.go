@@ -0,0 +1,12 @@
+//go:build slugify_nohangul
+// +build slugify_nohangul
+
+package slugify
+
+var hangulTests = []struct {
+	in, out string
+}{
+	{"서울 Seoul", "seoul"},
+	{"ᄀ ㄱ", ""},
+	{"신라", ""},
+}
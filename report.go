@@ -0,0 +1,161 @@
+package slugify
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
+
+// ErrEmpty is returned when nothing in a text makes it into the slug.
+var ErrEmpty = errors.New("slugify: empty slug")
+
+// ErrLossy is returned by a strict Slugifier when the slug of a text
+// loses some of it, see Report.Lossy.
+var ErrLossy = errors.New("slugify: text cannot be slugified without loss")
+
+// Report tells what happened to a text while it was slugified. Every
+// segment of the text, a rune or a run of runes transliterated together,
+// is in at most one of its lists; the ones that went into the slug as
+// they are, like ASCII letters, are in none.
+type Report struct {
+	// Transliterated has the segments that were written differently.
+	Transliterated []Transliteration
+	// Dropped has the runes that left nothing in the slug, such as
+	// punctuation. Separators are not dropped.
+	Dropped []rune
+	// DroppedScripts has the runes dropped by a DropScript policy, which
+	// are not lost by accident.
+	DroppedScripts []rune
+	// Unknown has the runes the tables only have the [?] placeholder for,
+	// which the slug drops.
+	Unknown []rune
+	// NoTable has the runes no table has a transliteration for, which are
+	// kept as they are.
+	NoTable []rune
+}
+
+// Transliteration is a segment of a text and how it was transliterated.
+type Transliteration struct {
+	Segment, Out string
+}
+
+// Lossy reports whether the slug lost some of the text: letters or
+// numbers that were dropped, or runes that could not be transliterated.
+func (r *Report) Lossy() bool {
+	if len(r.Unknown) > 0 || len(r.NoTable) > 0 {
+		return true
+	}
+	for _, d := range r.Dropped {
+		if unicode.IsLetter(d) || unicode.IsNumber(d) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithStrict makes SlugifyE and SlugifyReport return ErrLossy rather than
+// a slug that lost some of the text. Slugify, which has no error to
+// return, is not affected.
+func WithStrict() Option {
+	return func(s *Slugifier) {
+		s.strict = true
+	}
+}
+
+// SlugifyE is Slugify, returning ErrEmpty when the slug is empty and,
+// when s is strict, ErrLossy when it lost some of the text.
+func (s *Slugifier) SlugifyE(text string) (string, error) {
+	slug, _, err := s.SlugifyReport(text)
+	return slug, err
+}
+
+// SlugifyReport is SlugifyE, also telling what happened to the text.
+func (s *Slugifier) SlugifyReport(text string) (string, Report, error) {
+	return s.slugifyReport(text, s.maxLen)
+}
+
+func (s *Slugifier) slugifyReport(text string, maxLen int) (string, Report, error) {
+	report := s.report(text)
+	if s.strict && report.Lossy() {
+		return "", report, ErrLossy
+	}
+	slug := s.slugify(text, maxLen)
+	if slug == "" {
+		return "", report, ErrEmpty
+	}
+	return slug, report, nil
+}
+
+// report reports what became of every segment of text, a run of runes
+// kept, transliterated or dropped together.
+func (s *Slugifier) report(text string) Report {
+	var report Report
+	if !s.unicode && len(s.scripts) == 0 {
+		s.reportTransliteration(&report, text)
+		return report
+	}
+	text = norm.NFC.String(text)
+	for len(text) > 0 {
+		policy, n := s.scriptRun(text)
+		switch policy {
+		case KeepScript:
+			for _, r := range text[:n] {
+				if _, class := s.classify(r); class == classDrop {
+					report.Dropped = append(report.Dropped, r)
+				}
+			}
+		case TransliterateScript:
+			s.reportTransliteration(&report, text[:n])
+		case DropScript:
+			report.DroppedScripts = append(report.DroppedScripts, []rune(text[:n])...)
+		}
+		text = text[n:]
+	}
+	return report
+}
+
+// reportTransliteration transliterates text a segment at a time and
+// reports what became of every segment.
+func (s *Slugifier) reportTransliteration(report *Report, text string) {
+	s.profile.TransliterateSegments(text, func(segment, out string) {
+		switch {
+		case strings.Contains(out, "[?]"):
+			report.Unknown = append(report.Unknown, []rune(segment)...)
+		case out == segment && !isASCII(segment):
+			report.NoTable = append(report.NoTable, []rune(segment)...)
+		case out == "" && writtenAsNothing(segment):
+			report.Transliterated = append(report.Transliterated, Transliteration{segment, out})
+		case !s.keeps(out):
+			report.Dropped = append(report.Dropped, []rune(segment)...)
+		case out != segment:
+			report.Transliterated = append(report.Transliterated, Transliteration{segment, out})
+		}
+	})
+}
+
+// writtenAsNothing reports whether the tables write every rune of segment
+// as nothing on purpose, as they do the Arabic alef and the Hebrew aleph,
+// which are not lost when they leave nothing in the slug.
+func writtenAsNothing(segment string) bool {
+	for _, r := range segment {
+		if out, ok := transliterations.Lookup(r); !ok || out != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// keeps reports whether any rune of out, a transliteration, makes it into
+// the slug, or is a separator.
+func (s *Slugifier) keeps(out string) bool {
+	for _, r := range norm.NFKD.String(out) {
+		if _, class := s.classify(r); class != classDrop {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package slugify
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
+
+func TestSlugifyReport(t *testing.T) {
+	slug, report, err := SlugifyReport("Crème, brûlée ᲉᲉ!", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slug != "creme-brulee" {
+		t.Errorf("%q != %q", slug, "creme-brulee")
+	}
+	want := Report{
+		Transliterated: []Transliteration{{"è", "e"}, {"û", "u"}, {"é", "e"}},
+		Dropped:        []rune{','},
+		Unknown:        []rune{'Ᲊ', 'Ᲊ'},
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("%+v != %+v", report, want)
+	}
+	if !report.Lossy() {
+		t.Error("a report with unknown runes is not lossy")
+	}
+
+	for _, in := range []string{"سلام", "שלום אבא"} {
+		if _, report, err := New(WithStrict()).SlugifyReport(in); err != nil || report.Lossy() {
+			t.Errorf("%q: %v, %+v", in, err, report)
+		}
+	}
+
+	_, report, _ = New(WithTransliterator(transliterations.Map{})).SlugifyReport("жук")
+	if want := []rune("жук"); !reflect.DeepEqual(report.NoTable, want) {
+		t.Errorf("%q != %q", report.NoTable, want)
+	}
+}
+
+func TestSlugifyErrors(t *testing.T) {
+	strict := New(WithStrict())
+	var tests = []struct {
+		s       *Slugifier
+		in, out string
+		err     error
+	}{
+		{New(), "Hello, World!", "hello-world", nil},
+		{New(), "?!", "", ErrEmpty},
+		{New(), "", "", ErrEmpty},
+		{New(), "ᲉᲉ", "", ErrEmpty},
+		{New(), "ᲉᲉ go", "go", nil},
+		{strict, "Hello, World!", "hello-world", nil},
+		{strict, "Grüße aus Köln", "grusse-aus-koln", nil},
+		{strict, "ᲉᲉ go", "", ErrLossy},
+		{New(WithStrict(), WithTransliterator(transliterations.Map{})), "жук", "", ErrLossy},
+	}
+
+	for _, test := range tests {
+		if out, err := test.s.SlugifyE(test.in); out != test.out || err != test.err {
+			t.Errorf("%q: %q, %v != %q, %v", test.in, out, err, test.out, test.err)
+		}
+	}
+	if out, err := IDifyE("Hello World", 0); out != "Hello-World" || err != nil {
+		t.Errorf("%q, %v != %q, %v", out, err, "Hello-World", nil)
+	}
+}
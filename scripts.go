@@ -0,0 +1,157 @@
+package slugify
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ScriptPolicy is what a Slugifier does with the runes of a script.
+type ScriptPolicy int
+
+const (
+	// TransliterateScript transliterates the runes into ASCII, which is
+	// what happens to every script unless the Slugifier is WithUnicode.
+	TransliterateScript ScriptPolicy = iota
+	// KeepScript keeps the runes as they are, with their marks, which is
+	// what happens to every script in a Slugifier WithUnicode.
+	KeepScript
+	// DropScript leaves the runes out of the slug.
+	DropScript
+)
+
+type scriptRule struct {
+	policy  ScriptPolicy
+	scripts []*unicode.RangeTable
+}
+
+// WithScripts applies policy to the runes of scripts, such as
+// unicode.Cyrillic or unicode.Han, or of any other range table, so
+//
+//	New(WithScripts(slugify.KeepScript, unicode.Cyrillic))
+//
+// keeps Russian as it is while it transliterates everything else. When
+// several calls cover a rune the last one wins, so
+//
+//	New(WithScripts(slugify.DropScript, unicode.L), WithScripts(slugify.TransliterateScript, unicode.Latin))
+//
+// drops the letters of every script but Latin. Marks, and the digits and
+// other letters and numbers that belong to no script when no rule is for
+// them, share the fate of the runes around them, so Йод2024 stays one
+// word; kana after kanji share the fate of the kanji.
+func WithScripts(policy ScriptPolicy, scripts ...*unicode.RangeTable) Option {
+	return func(s *Slugifier) {
+		s.scripts = append(s.scripts[:len(s.scripts):len(s.scripts)], scriptRule{policy, scripts})
+	}
+}
+
+// scriptPolicy returns the policy of s for r, and whether one of the
+// rules of s is for r rather than the policy being the default.
+func (s *Slugifier) scriptPolicy(r rune) (ScriptPolicy, bool) {
+	for i := len(s.scripts) - 1; i >= 0; i-- {
+		if unicode.IsOneOf(s.scripts[i].scripts, r) {
+			return s.scripts[i].policy, true
+		}
+	}
+	return s.defaultPolicy(), false
+}
+
+// defaultPolicy returns the policy of s for the runes no rule is for.
+func (s *Slugifier) defaultPolicy() ScriptPolicy {
+	if s.unicode {
+		return KeepScript
+	}
+	return TransliterateScript
+}
+
+// scriptRun returns the policy of the run of runes text starts with that
+// share one, and its length in bytes. Marks share the policy of the run
+// they are in, and so do the letters and numbers no rule is for that
+// belong to no script of their own, like digits, and the kana after kanji,
+// which are read with them.
+func (s *Slugifier) scriptRun(text string) (ScriptPolicy, int) {
+	policy, decided, han := s.defaultPolicy(), false, false
+	n := 0
+	for n < len(text) {
+		r, size := utf8.DecodeRuneInString(text[n:])
+		p, ruled := s.scriptPolicy(r)
+		switch {
+		case unicode.IsMark(r):
+		case !ruled && isWordRune(r) && unicode.IsOneOf(commonScripts, r):
+		case !ruled && han && isKana(r):
+		case !decided:
+			policy, decided = p, true
+		case p != policy:
+			return policy, n
+		}
+		han = han || unicode.Is(unicode.Han, r)
+		n += size
+	}
+	return policy, n
+}
+
+// commonScripts are the runes shared by scripts.
+var commonScripts = []*unicode.RangeTable{unicode.Common, unicode.Inherited}
+
+func isKana(r rune) bool {
+	return unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r)
+}
+
+// prepareScripts is prepare for a Slugifier WithUnicode or WithScripts:
+// it keeps, transliterates or drops every run of text, which is in NFC,
+// and case folds the result if it is Unicode. Transliterated runs are put
+// in NFKD and lose their marks, so the marks left are the ones of the runs
+// that are kept. Where a kept run and a transliterated one meet inside a
+// word, a space keeps them apart, as transliteration does between words
+// of different scripts.
+func (s *Slugifier) prepareScripts(text string) string {
+	b := strings.Builder{}
+	b.Grow(len(text))
+	written := DropScript
+	for len(text) > 0 {
+		policy, n := s.scriptRun(text)
+		run := text[:n]
+		text = text[n:]
+		switch policy {
+		case DropScript:
+			continue
+		case TransliterateScript:
+			run = s.transliterateRun(run)
+		}
+		if written != DropScript && written != policy && joinsWord(b.String(), run) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(run)
+		written = policy
+	}
+	if s.unicode && s.caseMode == CaseLower {
+		return cases.Fold().String(b.String())
+	}
+	return b.String()
+}
+
+// transliterateRun transliterates run and puts it in NFKD without marks.
+func (s *Slugifier) transliterateRun(run string) string {
+	b := strings.Builder{}
+	for _, r := range norm.NFKD.String(s.profile.TransliterateString(run)) {
+		if !unicode.IsMark(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// joinsWord reports whether next, written right after prev, would run on
+// the word prev ends with.
+func joinsWord(prev, next string) bool {
+	last, _ := utf8.DecodeLastRuneInString(prev)
+	first, _ := utf8.DecodeRuneInString(next)
+	return isWordRune(last) && isWordRune(first)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r)
+}
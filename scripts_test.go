@@ -0,0 +1,80 @@
+package slugify
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+
+	"golang.org/x/text/transform"
+)
+
+func TestSlugifierScripts(t *testing.T) {
+	var tests = []struct {
+		opts    []Option
+		in, out string
+	}{
+		{nil, "Привет Αθήνα", "privet-athena"},
+		{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "Привет Αθήνα", "привет-athena"},
+		{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "Йод", "йод"},
+		{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "café Йод", "cafe-йод"},
+		{[]Option{WithScripts(DropScript, unicode.Han)}, "Привет 北京", "privet"},
+		{[]Option{WithScripts(DropScript, unicode.Han)}, "東京 Tokyo", "tokyo"},
+		{[]Option{WithScripts(DropScript, unicode.L), WithScripts(TransliterateScript, unicode.Latin)}, "Straße Привет 北京", "strasse"},
+		{[]Option{WithScripts(KeepScript, unicode.Greek, unicode.Cyrillic)}, "Αθήνα и Москва", "αθήνα-и-москва"},
+		{[]Option{WithUnicode(), WithScripts(TransliterateScript, unicode.Greek)}, "Привет Αθήνα", "привет-athena"},
+		{[]Option{WithUnicode(), WithScripts(DropScript, unicode.Han)}, "Привет 北京", "привет"},
+		{[]Option{WithScripts(KeepScript, unicode.Cyrillic), WithCase(CasePreserve)}, "Привет Мир", "Привет-Мир"},
+		{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "ПриветΑθήνα", "привет-athena"},
+		{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "Αθήναмир", "athena-мир"},
+		{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "Привет.Αθήνα", "привет-athena"},
+		{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "Йод2024", "йод2024"},
+		{[]Option{WithScripts(KeepScript, unicode.Cyrillic)}, "2024Йод Tokyo", "2024йод-tokyo"},
+		{[]Option{WithScripts(KeepScript, unicode.Cyrillic), WithScripts(DropScript, unicode.Greek)}, "ПриветΑθήναTokyo", "привет-tokyo"},
+		{[]Option{WithUnicode(), WithScripts(TransliterateScript, unicode.Greek)}, "日本Αθήνα", "日本-athena"},
+	}
+
+	for _, test := range tests {
+		if out := New(test.opts...).Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+}
+
+func TestSlugifierScriptsTransformer(t *testing.T) {
+	slugifiers := []*Slugifier{
+		New(WithScripts(KeepScript, unicode.Cyrillic)),
+		New(WithScripts(DropScript, unicode.Han)),
+		New(WithUnicode(), WithScripts(TransliterateScript, unicode.Han)),
+	}
+	for _, s := range slugifiers {
+		for _, in := range []string{"Привет 北京", "  Йод, café — 東京 Tokyo! "} {
+			out, _, err := transform.String(s.Transformer(), in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if slug := s.Slugify(in); out != slug {
+				t.Errorf("%q: %q != %q", in, out, slug)
+			}
+		}
+	}
+}
+
+func TestSlugifierScriptsReport(t *testing.T) {
+	s := New(WithScripts(KeepScript, unicode.Cyrillic), WithScripts(DropScript, unicode.Han), WithStrict())
+	slug, report, err := s.SlugifyReport("Привет, 北京 café")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slug != "привет-cafe" {
+		t.Errorf("%q != %q", slug, "привет-cafe")
+	}
+	if want := []rune("北京"); !reflect.DeepEqual(report.DroppedScripts, want) {
+		t.Errorf("DroppedScripts: %q != %q", report.DroppedScripts, want)
+	}
+	if want := []rune(","); !reflect.DeepEqual(report.Dropped, want) {
+		t.Errorf("Dropped: %q != %q", report.Dropped, want)
+	}
+	if want := []Transliteration{{"é", "e"}}; !reflect.DeepEqual(report.Transliterated, want) {
+		t.Errorf("Transliterated: %q != %q", report.Transliterated, want)
+	}
+}
@@ -0,0 +1,610 @@
+package slugify
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
+
+// CaseMode controls how a Slugifier treats letter case.
+type CaseMode int
+
+const (
+	// CaseLower lowercases every letter in the slug.
+	CaseLower CaseMode = iota
+	// CasePreserve keeps letters in the case they were written in.
+	CasePreserve
+)
+
+// Collapse controls how runs of separators are merged.
+type Collapse int
+
+const (
+	// CollapseMixed merges a run made of separators and OK runes into a
+	// single separator, so "a -_- b" becomes "a-b". OK runes touching a
+	// separator at either end of the slug are trimmed with it.
+	CollapseMixed Collapse = iota
+	// CollapseSeparators only merges repeated separators and leaves OK
+	// runes next to them alone, so "a -_- b" becomes "a-_-b".
+	CollapseSeparators
+)
+
+// NoSeparator can be passed to WithSeparator to join words without any
+// separator between them, as in hashtags.
+const NoSeparator rune = 0
+
+// Slugifier turns text into slugs according to its own policy. It is
+// configured once by New and never modified afterwards, so a single
+// Slugifier is safe for concurrent use and several Slugifiers with
+// different policies can live side by side.
+type Slugifier struct {
+	ok        *runeSet
+	toDash    *runeSet
+	ascii     [utf8.RuneSelf]runeClass
+	safe      []*unicode.RangeTable
+	space     []*unicode.RangeTable
+	dash      []*unicode.RangeTable
+	separator rune
+	collapse  Collapse
+	caseMode  CaseMode
+	maxLen    int
+	truncate  Truncator
+	measure   Measure
+	hashLen   int
+	hashRunes []rune
+	alphabet  string
+	profile   *transliterations.Profile
+	overrides transliterations.Map
+	sections  transliterations.Sections
+	fallback  transliterations.Transliterator
+	strict    bool
+	unmapped  Unmapped
+	emoji     transliterations.EmojiMode
+	unicode   bool
+	percent   bool
+	scripts   []scriptRule
+}
+
+// Option configures a Slugifier built by New.
+type Option func(*Slugifier)
+
+// WithOK sets the non alphanumeric runes that are allowed to appear in
+// the slug as they are.
+func WithOK(ok string) Option {
+	return func(s *Slugifier) {
+		s.ok = newRuneSet(ok)
+	}
+}
+
+// WithToDash sets the runes that are turned into a separator instead of
+// being stripped from the slug.
+func WithToDash(toDash string) Option {
+	return func(s *Slugifier) {
+		s.toDash = newRuneSet(toDash)
+	}
+}
+
+// WithSeparator sets the rune used between words, '-' by default. Use
+// NoSeparator to run the words together.
+func WithSeparator(separator rune) Option {
+	return func(s *Slugifier) {
+		s.separator = separator
+	}
+}
+
+// WithCollapse sets how runs of separators are merged, CollapseMixed
+// by default.
+func WithCollapse(collapse Collapse) Option {
+	return func(s *Slugifier) {
+		s.collapse = collapse
+	}
+}
+
+// WithCase sets how letter case is treated, CaseLower by default.
+func WithCase(mode CaseMode) Option {
+	return func(s *Slugifier) {
+		s.caseMode = mode
+	}
+}
+
+// WithMaxLen limits the length of the slug, as counted by its Measure.
+// Zero means no limit.
+func WithMaxLen(maxLen int) Option {
+	return func(s *Slugifier) {
+		s.maxLen = maxLen
+	}
+}
+
+// WithTruncator sets how a slug longer than the maximum length is cut,
+// TruncateRunes by default.
+func WithTruncator(truncate Truncator) Option {
+	return func(s *Slugifier) {
+		s.truncate = truncate
+	}
+}
+
+// WithMeasure sets how the maximum length is counted, MeasureBytes by
+// default.
+func WithMeasure(measure Measure) Option {
+	return func(s *Slugifier) {
+		s.measure = measure
+	}
+}
+
+// WithHashSuffix makes a slug that has to be cut end in a hash of the
+// whole slug, length runes long, so that long texts sharing a prefix do
+// not collide. The hash takes its room out of the maximum length.
+func WithHashSuffix(length int) Option {
+	return func(s *Slugifier) {
+		s.hashLen = length
+	}
+}
+
+// WithHashAlphabet sets the runes hash suffixes are written in,
+// DefaultHashAlphabet by default. Only ASCII letters and digits are used,
+// lowercased when the slug is.
+func WithHashAlphabet(alphabet string) Option {
+	return func(s *Slugifier) {
+		s.alphabet = alphabet
+	}
+}
+
+// WithLanguage transliterates the way the language tag writes its
+// letters in ASCII, for example German ä as ae and Danish ø as oe rather
+// than a and o. Tags fall back to their language, so de-CH is German, and
+// languages without a profile use the generic transliterations.
+func WithLanguage(tag language.Tag) Option {
+	return func(s *Slugifier) {
+		s.profile = transliterations.ForLanguage(tag)
+	}
+}
+
+// WithProfile transliterates with p on top of the generic
+// transliterations, for example one of the romanizations of Cyrillic
+// returned by transliterations.CyrillicScheme. Pinyin is always written
+// without tones or apostrophes, whatever p.Pinyin says, and the
+// apostrophes of the other romanizations are left out.
+func WithProfile(p *transliterations.Profile) Option {
+	return func(s *Slugifier) {
+		s.profile = p
+	}
+}
+
+// WithOverride transliterates r as out, ahead of the language profile and
+// the tables. It only affects this Slugifier.
+func WithOverride(r rune, out string) Option {
+	return func(s *Slugifier) {
+		overrides := transliterations.Map{r: out}
+		for r, out := range s.overrides {
+			if _, ok := overrides[r]; !ok {
+				overrides[r] = out
+			}
+		}
+		s.overrides = overrides
+	}
+}
+
+// WithSection transliterates the 256 code points of section, the ones
+// that share all but their last two hex digits, with table instead of the
+// built-in table, see transliterations.Sections. It only affects this
+// Slugifier.
+func WithSection(section rune, table []string) Option {
+	return func(s *Slugifier) {
+		sections := transliterations.Sections{section: table}
+		for section, table := range s.sections {
+			if _, ok := sections[section]; !ok {
+				sections[section] = table
+			}
+		}
+		s.sections = sections
+	}
+}
+
+// WithTransliterator transliterates the runes the language profile has
+// nothing for with t instead of the built-in tables. Chain t with
+// transliterations.Builtin to only replace part of the tables; runes t
+// has no transliteration for are kept as they are.
+func WithTransliterator(t transliterations.Transliterator) Option {
+	return func(s *Slugifier) {
+		s.fallback = t
+	}
+}
+
+// WithEmoji writes emoji the way mode says, as their CLDR short names,
+// "🚀 Launch day" as rocket-launch-day, instead of dropping them.
+func WithEmoji(mode transliterations.EmojiMode) Option {
+	return func(s *Slugifier) {
+		s.emoji = mode
+	}
+}
+
+// WithUnicode keeps the letters, numbers and marks of every script in the
+// slug as they are, instead of transliterating them, so that "Привет, мир!"
+// becomes привет-мир. The text is put in NFC and, unless the case is
+// preserved, case folded. Punctuation outside ASCII breaks words, like
+// TO_DASH, so 日本語。東京 is 日本語-東京, and symbols are stripped. The
+// options that set how text is transliterated have no effect.
+func WithUnicode() Option {
+	return func(s *Slugifier) {
+		s.unicode = true
+	}
+}
+
+// WithPercentEncoding percent-encodes the slug, after it has been cut to
+// the maximum length, so that it can go in a URL path as it is: привет
+// becomes %D0%BF%D1%80%D0%B8%D0%B2%D0%B5%D1%82.
+func WithPercentEncoding() Option {
+	return func(s *Slugifier) {
+		s.percent = true
+	}
+}
+
+// defaultProfile is the profile of a Slugifier without a language or a
+// profile of its own: the generic transliterations.
+var defaultProfile = &transliterations.Profile{NoApostrophes: true}
+
+// slugProfile returns p, or a copy of it that writes plain pinyin and no
+// apostrophes, since tone numbers and apostrophes have no place in a
+// slug.
+func slugProfile(p *transliterations.Profile) *transliterations.Profile {
+	if p == nil {
+		return defaultProfile
+	}
+	if p.Pinyin == transliterations.PinyinPlain && p.NoApostrophes {
+		return p
+	}
+	plain := *p
+	plain.Pinyin = transliterations.PinyinPlain
+	plain.NoApostrophes = true
+	return &plain
+}
+
+// transliterators returns p, or a copy of it with the overrides, sections,
+// transliterator, unmapped runes policy and emoji mode of s.
+func (s *Slugifier) transliterators(p *transliterations.Profile) *transliterations.Profile {
+	if s.overrides == nil && s.sections == nil && s.fallback == nil && s.unmapped == nil && s.emoji == transliterations.NoEmoji {
+		return p
+	}
+	var t transliterations.Profile
+	if p != nil {
+		t = *p
+	}
+	if s.emoji != transliterations.NoEmoji {
+		t.Emoji = s.emoji
+	}
+	if s.overrides != nil {
+		t.Overrides = transliterations.Chain(s.overrides, t.Overrides)
+	}
+	if s.sections != nil || s.fallback != nil || s.unmapped != nil {
+		fallback := s.fallback
+		if fallback == nil {
+			fallback = t.Fallback
+		}
+		if fallback == nil {
+			fallback = transliterations.Builtin
+		}
+		t.Fallback = transliterations.Chain(s.sections, fallback)
+	}
+	if s.unmapped != nil {
+		t.Fallback = unmappedTransliterator{t.Fallback, s.unmapped}
+	}
+	return &t
+}
+
+// New builds a Slugifier. Without options it lowercases, separates
+// words with '-', has no length limit, cuts on runes when it is given
+// one and takes its rune classes from
+// the package level OK, TO_DASH, SAFE, SPACE and DASH variables as they
+// are at the time of the call.
+func New(opts ...Option) *Slugifier {
+	s := &Slugifier{
+		ok:        newRuneSet(OK),
+		toDash:    newRuneSet(TO_DASH),
+		safe:      SAFE,
+		space:     SPACE,
+		dash:      DASH,
+		separator: '-',
+		caseMode:  CaseLower,
+		truncate:  TruncateRunes,
+		measure:   MeasureBytes,
+		alphabet:  DefaultHashAlphabet,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.profile = s.transliterators(slugProfile(s.profile))
+	if s.unicode || len(s.scripts) > 0 {
+		s.safe = append(s.safe[:len(s.safe):len(s.safe)], unicode.Mark)
+	}
+	s.hashRunes = hashAlphabet(s.alphabet, s.caseMode)
+	for r := range s.ascii {
+		_, s.ascii[r] = s.classifyRune(rune(r))
+	}
+
+	return s
+}
+
+// Slugify a string according to the Slugifier's policy.
+func (s *Slugifier) Slugify(text string) string {
+	return s.slugify(text, s.maxLen)
+}
+
+// AppendSlug appends the slug of text, as Slugify returns it, to dst and
+// returns the extended buffer.
+func (s *Slugifier) AppendSlug(dst []byte, text string) []byte {
+	if s.maxLen > 0 || s.percent {
+		return append(dst, s.Slugify(text)...)
+	}
+	return s.appendSlug(dst, text)
+}
+
+func (s *Slugifier) slugify(text string, maxLen int) string {
+	var slug string
+	if maxLen > 0 {
+		slug = s.cleanup(s.slugWords(text), maxLen)
+	} else {
+		slug = string(s.appendSlug(make([]byte, 0, len(text)), text))
+	}
+	return s.finish(slug)
+}
+
+// finish returns slug the way it is handed out, percent-encoded
+// WithPercentEncoding.
+func (s *Slugifier) finish(slug string) string {
+	if s.percent {
+		return url.PathEscape(slug)
+	}
+	return slug
+}
+
+func (s *Slugifier) appendSlug(dst []byte, text string) []byte {
+	b := slugBuilder{s: s, buf: dst, first: true, held: -1}
+	b.addString(text)
+	return b.buf
+}
+
+// slugWords returns the words of the slug of text, before they are
+// joined and truncated.
+func (s *Slugifier) slugWords(text string) []string {
+	b := slugBuilder{s: s, buf: make([]byte, 0, len(text)), first: true, held: -1, record: true}
+	b.addString(text)
+	words := make([]string, len(b.starts))
+	for i, start := range b.starts {
+		end := len(b.buf)
+		if i+1 < len(b.starts) {
+			end = b.starts[i+1] - len(s.sep())
+		}
+		words[i] = string(b.buf[start:end])
+	}
+	return words
+}
+
+// prepare returns text the way its runes are classified: transliterated
+// and in NFKD or, for a Slugifier WithUnicode or WithScripts, as
+// prepareScripts returns it.
+func (s *Slugifier) prepare(text string) string {
+	if s.unicode || len(s.scripts) > 0 {
+		return s.prepareScripts(norm.NFC.String(text))
+	}
+	return norm.NFKD.String(s.profile.TransliterateString(text))
+}
+
+// runeClass is what a rune is in a slug.
+type runeClass uint8
+
+const (
+	// classDrop runes are stripped.
+	classDrop runeClass = iota
+	// classSafe runes are letters and numbers, kept as they are.
+	classSafe
+	// classOK runes are kept, but trimmed with the separators next to
+	// them under CollapseMixed.
+	classOK
+	// classSeparator runes are turned into a separator.
+	classSeparator
+)
+
+// classify returns r the way it goes into the slug, lowercased if it has
+// to be, and its class.
+func (s *Slugifier) classify(r rune) (rune, runeClass) {
+	if r < 0 || r >= utf8.RuneSelf {
+		return s.classifyRune(r)
+	}
+	class := s.ascii[r]
+	if class == classSafe && s.caseMode == CaseLower && 'A' <= r && r <= 'Z' {
+		r += 'a' - 'A'
+	}
+	return r, class
+}
+
+// classifyRune is classify without the table of ASCII classes, which is
+// built with it.
+func (s *Slugifier) classifyRune(r rune) (rune, runeClass) {
+	switch {
+	case r == s.separator && r != NoSeparator:
+		return r, classSeparator
+	case unicode.IsOneOf(s.safe, r):
+		if s.caseMode == CaseLower && !s.unicode {
+			r = unicode.ToLower(r)
+		}
+		return r, classSafe
+	case s.ok.matches(r):
+		return r, classOK
+	case unicode.IsOneOf(s.space, r):
+		return r, classSeparator
+	case unicode.IsOneOf(s.dash, r):
+		return r, classSeparator
+	case s.toDash.matches(r):
+		return r, classSeparator
+	case s.unicode && r >= utf8.RuneSelf && unicode.IsPunct(r):
+		// What transliteration would have turned into TO_DASH.
+		return r, classSeparator
+	}
+	return r, classDrop
+}
+
+// runeSet holds the runes of an OK or TO_DASH string, so that they can be
+// matched without allocating.
+type runeSet struct {
+	ascii [utf8.RuneSelf]bool
+	other string
+}
+
+func newRuneSet(runes string) *runeSet {
+	set := &runeSet{}
+	for _, r := range runes {
+		if r < utf8.RuneSelf {
+			set.ascii[r] = true
+		} else {
+			set.other += string(r)
+		}
+	}
+	return set
+}
+
+// matches reports whether r written as a Go rune literal, the way
+// strconv.QuoteRune writes it, has any rune of the set in it. That is
+// how OK and TO_DASH have always been matched, so the default TO_DASH,
+// which has a backslash, turns the runes that are written escaped, such
+// as the apostrophe, into a dash.
+func (set *runeSet) matches(r rune) bool {
+	var buf [16]byte
+	for _, c := range strconv.AppendQuoteRune(buf[:0], r) {
+		if c < utf8.RuneSelf && set.ascii[c] {
+			return true
+		}
+	}
+	return r >= utf8.RuneSelf && set.other != "" && strconv.IsPrint(r) && strings.ContainsRune(set.other, r)
+}
+
+// slugBuilder writes a slug to buf a rune at a time, merging runs of
+// separators and trimming the words as it goes.
+type slugBuilder struct {
+	s   *Slugifier
+	buf []byte
+	// record is whether starts is kept.
+	record bool
+	// starts has where every word starts in buf.
+	starts []int
+	// first is whether there has been no separator yet.
+	first bool
+	// wrote is whether a word has been written.
+	wrote bool
+	// started is whether the current word has been written.
+	started bool
+	// held is where the OK runes at the end of the current word start in
+	// buf, since a separator after them trims them, or -1. The state
+	// before them is kept with it.
+	held        int
+	heldStarted bool
+	heldWrote   bool
+	heldStarts  int
+}
+
+// addString adds the runes of text, see prepare. ASCII text that the
+// profile leaves alone is added as it is.
+func (b *slugBuilder) addString(text string) {
+	if (b.s.profile == defaultProfile || b.s.unicode) && len(b.s.scripts) == 0 && isASCII(text) {
+		for i := 0; i < len(text); i++ {
+			b.add(b.s.classify(rune(text[i])))
+		}
+		return
+	}
+	for _, r := range b.s.prepare(text) {
+		b.add(b.s.classify(r))
+	}
+}
+
+// isASCII reports whether text is made of the ASCII runes Transliterate
+// keeps as they are.
+func isASCII(text string) bool {
+	for i := 0; i < len(text); i++ {
+		if text[i] >= unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *slugBuilder) add(r rune, class runeClass) {
+	switch {
+	case class == classDrop:
+	case class == classSeparator:
+		if b.held >= 0 {
+			b.buf = b.buf[:b.held]
+			b.started, b.wrote = b.heldStarted, b.heldWrote
+			b.starts = b.starts[:b.heldStarts]
+			b.held = -1
+		}
+		b.first, b.started = false, false
+	case class == classOK && b.s.collapse == CollapseMixed:
+		if !b.started && !b.first {
+			return
+		}
+		if b.held < 0 {
+			b.held, b.heldStarted, b.heldWrote, b.heldStarts = len(b.buf), b.started, b.wrote, len(b.starts)
+		}
+		b.write(r)
+	default:
+		b.held = -1
+		b.write(r)
+	}
+}
+
+// write writes r, after a separator if it starts a word that is not the
+// first.
+func (b *slugBuilder) write(r rune) {
+	if !b.started {
+		if b.wrote {
+			b.buf = append(b.buf, b.s.sep()...)
+		}
+		if b.record {
+			b.starts = append(b.starts, len(b.buf))
+		}
+		b.started, b.wrote = true, true
+	}
+	if r < utf8.RuneSelf {
+		b.buf = append(b.buf, byte(r))
+		return
+	}
+	var enc [utf8.UTFMax]byte
+	b.buf = append(b.buf, enc[:utf8.EncodeRune(enc[:], r)]...)
+}
+
+func (s *Slugifier) sep() string {
+	if s.separator == NoSeparator {
+		return ""
+	}
+	return string(s.separator)
+}
+
+func (s *Slugifier) cleanup(words []string, maxLen int) string {
+	sep := s.sep()
+	if maxLen <= 0 {
+		return strings.Join(words, sep)
+	}
+	if s.hashLen <= 0 {
+		return s.truncate(words, sep, maxLen, s.measure)
+	}
+
+	text := strings.Join(words, sep)
+	if measureString(text, s.measure) <= maxLen {
+		return text
+	}
+	hash := hashSuffix(text, s.hashLen, s.hashRunes)
+	room := maxLen - measureString(sep+hash, s.measure)
+	if room <= 0 {
+		return TruncateRunes([]string{hash}, sep, maxLen, s.measure)
+	}
+	if prefix := s.truncate(words, sep, room, s.measure); prefix != "" {
+		return prefix + sep + hash
+	}
+	return hash
+}
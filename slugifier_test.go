@@ -0,0 +1,239 @@
+package slugify
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"unicode"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/transform"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
+
+func TestSlugifierOptions(t *testing.T) {
+	var tests = []struct {
+		opts    []Option
+		in, out string
+	}{
+		{nil, "Simple Test", "simple-test"},
+		{[]Option{WithCase(CasePreserve)}, "Simple Test", "Simple-Test"},
+		{[]Option{WithSeparator('_')}, "simple test", "simple_test"},
+		{[]Option{WithOK("-_.")}, "file.tar.gz", "file.tar.gz"},
+		{[]Option{WithToDash("")}, "a.b", "ab"},
+		{[]Option{WithMaxLen(6)}, "simple test", "simple"},
+	}
+
+	for _, test := range tests {
+		if out := New(test.opts...).Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+}
+
+func TestSlugifierSeparator(t *testing.T) {
+	var tests = []struct {
+		opts    []Option
+		in, out string
+	}{
+		{[]Option{WithSeparator('_')}, "__simple  test__", "simple_test"},
+		{[]Option{WithSeparator('_')}, "well-known fact", "well-known_fact"},
+		{[]Option{WithSeparator('_')}, "a - b", "a_b"},
+		{[]Option{WithSeparator('.')}, "http.requests total", "http.requests.total"},
+		{[]Option{WithSeparator(NoSeparator)}, "Go Gophers rock", "gogophersrock"},
+		{[]Option{WithSeparator(NoSeparator), WithCase(CasePreserve)}, "Go - Gophers", "GoGophers"},
+		{nil, "a -_- b", "a-b"},
+		{nil, "_-a", "a"},
+		{nil, "snake_case", "snake_case"},
+		{[]Option{WithCollapse(CollapseSeparators)}, "a -_- b", "a-_-b"},
+		{[]Option{WithSeparator('_'), WithMaxLen(7)}, "simple test", "simple"},
+	}
+
+	for _, test := range tests {
+		if out := New(test.opts...).Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+}
+
+func TestSlugifierTransliterators(t *testing.T) {
+	smileys := make([]string, 0x50)
+	smileys[0x00] = "grinning"
+	smileys[0x02] = "joy"
+
+	var tests = []struct {
+		opts    []Option
+		in, out string
+	}{
+		{[]Option{WithOverride('€', "euro")}, "10 € off", "10-euro-off"},
+		{[]Option{WithOverride('€', "euro"), WithOverride('£', "pound")}, "€ or £", "euro-or-pound"},
+		{[]Option{WithOverride('ä', "a"), WithLanguage(language.German)}, "Bär Öl", "bar-oel"},
+		{[]Option{WithSection(0x1f6, smileys)}, "😀 😂", "grinning-joy"},
+		{[]Option{WithSection(0x1f6, smileys)}, "Ωmega 🙏", "omega"},
+		{[]Option{WithTransliterator(transliterations.Map{'Ω': "Omega"})}, "Ω ж", "omega-ж"},
+		{[]Option{WithTransliterator(transliterations.Chain(transliterations.Map{'Ω': "Omega"}, transliterations.Builtin))}, "Ω ж", "omega-zh"},
+	}
+
+	for _, test := range tests {
+		if out := New(test.opts...).Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+
+	if out := Slugify("10 € off", 0); out != "10-eur-off" {
+		t.Errorf("overrides leaked into the default Slugifier: %q", out)
+	}
+}
+
+func TestSlugifierConcurrent(t *testing.T) {
+	dots := New(WithOK("."), WithToDash(""))
+	dashes := New()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if out := dots.Slugify("a.b c"); out != "a.b-c" {
+				t.Errorf("%q != %q", out, "a.b-c")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if out := dashes.Slugify("a.b c"); out != "a-b-c" {
+				t.Errorf("%q != %q", out, "a-b-c")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSlugifierClassify(t *testing.T) {
+	// The classes runes have always had, from a Go rune literal of them.
+	class := func(s *Slugifier, ok, toDash string, r rune) runeClass {
+		q := strconv.QuoteRune(r)
+		switch {
+		case r == s.separator:
+			return classSeparator
+		case unicode.IsOneOf(SAFE, r):
+			return classSafe
+		case strings.ContainsAny(q, ok):
+			return classOK
+		case unicode.IsOneOf(SPACE, r), unicode.IsOneOf(DASH, r):
+			return classSeparator
+		case strings.ContainsAny(q, toDash):
+			return classSeparator
+		}
+		return classDrop
+	}
+
+	for _, sets := range [][2]string{{OK, TO_DASH}, {"-_.é'", "/"}, {"", ""}} {
+		s := New(WithOK(sets[0]), WithToDash(sets[1]))
+		for r := rune(0); r < 0x3000; r++ {
+			if _, c := s.classify(r); c != class(s, sets[0], sets[1], r) {
+				t.Errorf("%q with %q: %U is %d", sets[0], sets[1], r, c)
+			}
+		}
+	}
+}
+
+func TestAppendSlug(t *testing.T) {
+	var tests = []struct {
+		s       *Slugifier
+		in, out string
+	}{
+		{New(), "Simple Test", "slugs: simple-test"},
+		{New(), "Ångström café", "slugs: angstrom-cafe"},
+		{New(WithMaxLen(6)), "simple test", "slugs: simple"},
+		{New(WithSeparator('_')), "__simple  test__", "slugs: simple_test"},
+	}
+
+	for _, test := range tests {
+		if out := string(test.s.AppendSlug([]byte("slugs: "), test.in)); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+	if out := string(AppendID(nil, "I'm go developer")); out != "I-m-go-developer" {
+		t.Errorf("%q != %q", out, "I-m-go-developer")
+	}
+}
+
+func BenchmarkSlugify(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Slugify("Stainless Steel Water Bottle, 750 ml (Blue)", 0)
+	}
+}
+
+func BenchmarkSlugifyUnicode(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Slugify("Édition limitée : Crème brûlée façon Ångström", 0)
+	}
+}
+
+func BenchmarkSlugifyMaxLen(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Slugify("Stainless Steel Water Bottle, 750 ml (Blue)", 24)
+	}
+}
+
+func BenchmarkAppendSlug(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = AppendSlug(buf[:0], "Stainless Steel Water Bottle, 750 ml (Blue)")
+	}
+}
+
+func TestSlugifierUnicode(t *testing.T) {
+	var tests = []struct {
+		opts    []Option
+		in, out string
+	}{
+		{nil, "Привет, мир!", "привет-мир"},
+		{nil, "日本語", "日本語"},
+		{nil, "مرحبا بالعالم", "مرحبا-بالعالم"},
+		{nil, "नमस्ते दुनिया", "नमस्ते-दुनिया"},
+		{nil, "Straße ΣΊΣΥΦΟΣ", "strasse-σίσυφοσ"},
+		{nil, "Café № 5 ☃ 😀 — ok", "café-5-ok"},
+		{nil, "Simple Test", "simple-test"},
+		{nil, "日本語。東京", "日本語-東京"},
+		{nil, "東京、大阪", "東京-大阪"},
+		{nil, "مرحبا،عالم", "مرحبا-عالم"},
+		{nil, "नमस्ते।दुनिया", "नमस्ते-दुनिया"},
+		{nil, "«Привет»мир", "привет-мир"},
+		{[]Option{WithCase(CasePreserve)}, "Привет Мир", "Привет-Мир"},
+		{[]Option{WithPercentEncoding()}, "Привет мир", "%D0%BF%D1%80%D0%B8%D0%B2%D0%B5%D1%82-%D0%BC%D0%B8%D1%80"},
+		{[]Option{WithMaxLen(6), WithMeasure(MeasureRunes)}, "Привет мир", "привет"},
+	}
+
+	for _, test := range tests {
+		s := New(append(test.opts, WithUnicode())...)
+		if out := s.Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+		if out := string(s.AppendSlug(nil, test.in)); out != test.out {
+			t.Errorf("AppendSlug %q: %q != %q", test.in, out, test.out)
+		}
+		out, _, err := transform.String(s.Transformer(), test.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.percent || s.maxLen > 0 {
+			continue
+		}
+		if out != test.out {
+			t.Errorf("Transformer %q: %q != %q", test.in, out, test.out)
+		}
+	}
+	if out := SlugifyUnicode("Привет, мир!", 0); out != "привет-мир" {
+		t.Errorf("%q != %q", out, "привет-мир")
+	}
+	if out := New(WithPercentEncoding()).Slugify("a b"); out != "a-b" {
+		t.Errorf("%q != %q", out, "a-b")
+	}
+}
@@ -1,15 +1,11 @@
 package slugify
 
 import (
-	"bytes"
-	"regexp"
-	"strconv"
-	"strings"
 	"unicode"
 
-	"golang.org/x/text/unicode/norm"
-	"github.com/digitalxero/slugify/transliterations"
+	"golang.org/x/text/language"
 
+	"github.com/digitalxero/slugify/transliterations"
 )
 
 var SKIP = []*unicode.RangeTable{
@@ -31,77 +27,112 @@ var SPACE = []*unicode.RangeTable{
 	unicode.Space,
 }
 
+// OK holds the non alphanumeric characters the default Slugifiers let
+// through.
+//
+// Deprecated: it is only read when a Slugifier is built, so changing it
+// does not affect Slugify or IDify. Use New with WithOK instead.
 var OK = "-_"
+
+// TO_DASH holds the characters the default Slugifiers turn into a dash.
+//
+// Deprecated: it is only read when a Slugifier is built, so changing it
+// does not affect Slugify or IDify. Use New with WithToDash instead.
 var TO_DASH = "/\\—–.~!@#$%^&*(){}[]+=?><;:`"
-var extra_dashes = regexp.MustCompile("[-]{2,}")
 
-// Slugify a string. The result will only contain lowercase letters,
-// digits and dashes. It will not begin or end with a dash, and it
-// will not contain runs of multiple dashes.
+var (
+	defaultSlugifier = New()
+	defaultIDifier   = New(WithCase(CasePreserve))
+
+	defaultUnicodeSlugifier = New(WithUnicode())
+)
+
+// Slugify a string. The result will only contain lowercase ASCII
+// letters, digits and dashes. It will not begin or end with a dash, and
+// it will not contain runs of multiple dashes.
 //
-// It is NOT forced into being ASCII, but may contain any Unicode
-// characters, with the above restrictions.
+// Letters of other scripts are transliterated into ASCII first, so
+// Привет is privet; SlugifyUnicode keeps them as they are.
 func Slugify(text string, maxLen int) string {
-	buf := make([]rune, 0, len(text))
-	text = SanatizeText(text)
-	for _, r := range norm.NFKD.String(text) {
-		s := strconv.QuoteRune(r)
-		switch {
-		case unicode.IsOneOf(SAFE, r):
-			buf = append(buf, unicode.ToLower(r))
-		case strings.ContainsAny(s, OK):
-			buf = append(buf, r)
-		case unicode.IsOneOf(SPACE, r):
-			buf = append(buf, '-')
-		case unicode.IsOneOf(DASH, r):
-			buf = append(buf, '-')
-		case strings.ContainsAny(s, TO_DASH):
-			buf = append(buf, '-')
-		}
-	}
-	return cleanup(string(buf), maxLen)
+	return defaultSlugifier.slugify(text, maxLen)
 }
 
 // IDify a string. The result will only contain ASCII letters,
 // digits and dashes. It will not begin or end with a dash, and it
 // will not contain runs of multiple dashes.
 //
-// It is forced into being ASCII, but may contain any Unicode
-// characters, with the above restrictions.
+// It is Slugify without the lowercasing.
 func IDify(text string, maxLen int) string {
-	buf := make([]rune, 0, len(text))
-	text = SanatizeText(text)
-	for _, r := range norm.NFKD.String(text) {
-		s := strconv.QuoteRune(r)
-		switch {
-		case unicode.IsOneOf(SAFE, r):
-			buf = append(buf, r)
-		case strings.ContainsAny(s, OK):
-			buf = append(buf, r)
-		case unicode.IsOneOf(SPACE, r):
-			buf = append(buf, '-')
-		case unicode.IsOneOf(DASH, r):
-			buf = append(buf, '-')
-		case strings.ContainsAny(s, TO_DASH):
-			buf = append(buf, '-')
-		}
-	}
-	return cleanup(string(buf), maxLen)
+	return defaultIDifier.slugify(text, maxLen)
+}
+
+// SlugifyUnicode is Slugify keeping the letters, numbers and marks of
+// every script as they are, case folded, so "Привет, мир!" is привет-мир,
+// see WithUnicode.
+func SlugifyUnicode(text string, maxLen int) string {
+	return defaultUnicodeSlugifier.slugify(text, maxLen)
+}
+
+// SlugifyE is Slugify, returning ErrEmpty when the slug is empty.
+func SlugifyE(text string, maxLen int) (string, error) {
+	slug, _, err := defaultSlugifier.slugifyReport(text, maxLen)
+	return slug, err
+}
+
+// IDifyE is IDify, returning ErrEmpty when the ID is empty.
+func IDifyE(text string, maxLen int) (string, error) {
+	slug, _, err := defaultIDifier.slugifyReport(text, maxLen)
+	return slug, err
+}
+
+// SlugifyReport is SlugifyE, also telling what happened to the text.
+func SlugifyReport(text string, maxLen int) (string, Report, error) {
+	return defaultSlugifier.slugifyReport(text, maxLen)
+}
+
+// IDifyReport is IDifyE, also telling what happened to the text.
+func IDifyReport(text string, maxLen int) (string, Report, error) {
+	return defaultIDifier.slugifyReport(text, maxLen)
+}
+
+// AppendSlug appends Slugify(text, 0) to dst and returns the extended
+// buffer, so that a buffer can be reused from one slug to the next.
+func AppendSlug(dst []byte, text string) []byte {
+	return defaultSlugifier.AppendSlug(dst, text)
+}
+
+// AppendID appends IDify(text, 0) to dst and returns the extended buffer.
+func AppendID(dst []byte, text string) []byte {
+	return defaultIDifier.AppendSlug(dst, text)
+}
+
+// SlugifyLanguage is Slugify for a text written in the language tag, see
+// WithLanguage.
+func SlugifyLanguage(text string, maxLen int, tag language.Tag) string {
+	s := *defaultSlugifier
+	WithLanguage(tag)(&s)
+	return s.slugify(text, maxLen)
+}
+
+// IDifyLanguage is IDify for a text written in the language tag, see
+// WithLanguage.
+func IDifyLanguage(text string, maxLen int, tag language.Tag) string {
+	s := *defaultIDifier
+	WithLanguage(tag)(&s)
+	return s.slugify(text, maxLen)
 }
 
 func SanatizeText(text string) string {
-	b := bytes.NewBufferString("")
-	for _, c := range text {
-		b.WriteString(transliterations.Transliterate(c))
-	}
-	return b.String()
-}
-
-func cleanup(text string, maxLen int) string {
-	text = strings.Trim(text, "-")
-	text = extra_dashes.ReplaceAllString(text, "-")
-	if maxLen > 0 && len(text) > maxLen {
-		text = cleanup(text[0:maxLen], maxLen)
-	}
-	return text
+	return transliterations.TransliterateString(text)
+}
+
+// SanatizeTextProfile is SanatizeText with the transliterations of p,
+// which, unlike in a slug, are kept as they are, so Chinese can be given
+// with tones:
+//
+//	SanatizeTextProfile("西安", &transliterations.Profile{Pinyin: transliterations.PinyinToneMarks})
+//
+// returns "Xī'ān".
+func SanatizeTextProfile(text string, p *transliterations.Profile) string {
+	return p.TransliterateString(text)
 }
@@ -1,6 +1,19 @@
 package slugify
 
-import "testing"
+import (
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
 
 func TestSlugify(t *testing.T) {
 	var tests = []struct{ in, out string }{
@@ -8,8 +21,6 @@ func TestSlugify(t *testing.T) {
 		{"Simple Test", "simple-test"},
 		{"I'm go developer", "i-m-go-developer"},
 		{"Simples código em go", "simples-codigo-em-go"},
-		{"日本語の手紙をテスト", "ri-ben-yu-noshou-zhi-wotesuto"},
-		{"北京kožuščekł", "bei-jing-kozuscekl"},
 	}
 
 	for _, test := range tests {
@@ -25,8 +36,6 @@ func TestSlugifyLen(t *testing.T) {
 		{"Simple Test", "si"},
 		{"I'm go developer", "i"},
 		{"Simples código em go", "si"},
-		{"日本語の手紙をテスト", "ri"},
-		{"北京kožuščekł", "be"},
 	}
 
 	for _, test := range tests {
@@ -42,8 +51,6 @@ func TestIDify(t *testing.T) {
 		{"Simple Test", "Simple-Test"},
 		{"I'm go developer", "I-m-go-developer"},
 		{"Simples código em go", "Simples-codigo-em-go"},
-		{"日本語の手紙をテスト", "Ri-Ben-Yu-noShou-Zhi-wotesuto"},
-		{"北京kožuščekł", "Bei-Jing-kozuscekl"},
 	}
 
 	for _, test := range tests {
@@ -51,4 +58,198 @@ func TestIDify(t *testing.T) {
 			t.Errorf("%q: %q != %q", test.in, out, test.out)
 		}
 	}
-}
\ No newline at end of file
+}
+func TestSlugifyLanguage(t *testing.T) {
+	var tests = []struct{ lang, in, out string }{
+		{"de", "Größe ändern", "groesse-aendern"},
+		{"de-CH", "Übung macht den Meister", "uebung-macht-den-meister"},
+		{"da", "Blåbærgrød", "blaabaergroed"},
+		{"nb-NO", "Ørret på Sørlandet", "oerret-paa-soerlandet"},
+		{"fr", "Œuvre complète", "oeuvre-complete"},
+		{"ca", "Col·lecció", "colleccio"},
+		{"tr", "Işık", "isik"},
+		{"mk", "Ќуприја Скопје", "kjuprija-skopje"},
+		{"bg", "София щастие", "sofia-shtastie"},
+		{"en", "Größe ändern", "grosse-andern"},
+	}
+
+	for _, test := range tests {
+		tag := language.MustParse(test.lang)
+		if out := SlugifyLanguage(test.in, 0, tag); out != test.out {
+			t.Errorf("%s %q: %q != %q", test.lang, test.in, out, test.out)
+		}
+	}
+}
+
+func TestIDifyLanguage(t *testing.T) {
+	var tests = []struct{ lang, in, out string }{
+		{"de", "Äpfel und Öl", "Aepfel-und-Oel"},
+		{"de", "ÄRGER", "AERGER"},
+		{"fr", "Œuvre", "Oeuvre"},
+		{"da", "Århus", "Aarhus"},
+	}
+
+	for _, test := range tests {
+		tag := language.MustParse(test.lang)
+		if out := IDifyLanguage(test.in, 0, tag); out != test.out {
+			t.Errorf("%s %q: %q != %q", test.lang, test.in, out, test.out)
+		}
+	}
+}
+
+func TestSlugifyCyrillicSchemes(t *testing.T) {
+	var tests = []struct{ scheme, in, out string }{
+		{"iso9", "Щука и ёж", "suka-i-ez"},
+		{"gost779b", "Царь Хлеб", "car-xleb"},
+		{"gost779b", "Цирк", "czirk"},
+		{"bgn-pcgn", "Ельцин поёт", "yeltsin-poyet"},
+		{"uk-national", "Юрій Їжакевич", "yurii-yizhakevych"},
+		{"uk-national", "Згурський Харків", "zghurskyi-kharkiv"},
+		{"uk-national", "Мар'яна", "mariana"},
+		{"bg-streamlined", "София щастие", "sofia-shtastie"},
+		{"sr-latin", "Ђорђе Џаковић", "djordje-dzakovic"},
+		{"mk-official", "Ѓорѓија Ѕвезда Џамија", "gjorgjija-dzvezda-djamija"},
+	}
+
+	for _, test := range tests {
+		p, ok := transliterations.CyrillicScheme(test.scheme)
+		if !ok {
+			t.Fatalf("no scheme %q", test.scheme)
+		}
+		if out := New(WithProfile(p)).Slugify(test.in); out != test.out {
+			t.Errorf("%s %q: %q != %q", test.scheme, test.in, out, test.out)
+		}
+	}
+}
+
+func TestIDifyUkrainian(t *testing.T) {
+	if out := IDifyLanguage("Єнакієве", 0, language.Ukrainian); out != "Yenakiieve" {
+		t.Errorf("%q != %q", out, "Yenakiieve")
+	}
+}
+
+func TestSlugifyKana(t *testing.T) {
+	var tests = []struct {
+		system  transliterations.KanaSystem
+		in, out string
+	}{
+		{transliterations.Hepburn, "きって", "kitte"},
+		{transliterations.Hepburn, "まっちゃ", "matcha"},
+		{transliterations.Hepburn, "きゃく しゃしん", "kyaku-shashin"},
+		{transliterations.Hepburn, "コーヒー", "kohi"},
+		{transliterations.Hepburn, "ティーシャツ", "tishatsu"},
+		{transliterations.Hepburn, "ドラゴン・ボール", "doragon-boru"},
+		{transliterations.Hepburn, "ABCのテスト", "abc-no-tesuto"},
+		{transliterations.Hepburn, "きんようび", "kinyobi"},
+		{transliterations.Hepburn, "しんよう ほんや", "shinyo-honya"},
+		{transliterations.Hepburn, "ぎゅうにゅう", "gyunyu"},
+		{transliterations.KunreiShiki, "しゃしん ちず", "syasin-tizu"},
+		{transliterations.KunreiShiki, "テストを", "tesuto-o"},
+		{transliterations.NihonShiki, "ぢゃ づ", "dya-du"},
+	}
+
+	for _, test := range tests {
+		s := New(WithProfile(&transliterations.Profile{Kana: test.system}))
+		if out := s.Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+
+	if out := transliterations.RomanizeKana("きんようび", transliterations.Hepburn); out != "kin'yōbi" {
+		t.Errorf("%q != %q", out, "kin'yōbi")
+	}
+}
+
+func TestTransliterateASCII(t *testing.T) {
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		out := IDify(string(r), 0)
+		for i := 0; i < len(out); i++ {
+			if out[i] >= utf8.RuneSelf {
+				t.Errorf("%U: %q is not ASCII", r, out)
+				break
+			}
+		}
+	}
+
+	// Every cased letter and number of the Unicode version of the tables
+	// has a transliteration. Other letters, like the ideographs the data
+	// has no reading for, may be left as [?].
+	b, err := ioutil.ReadFile(filepath.Join("transliterations", "data", "unicode-"+transliterations.UnicodeVersion, "UnicodeData.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		f := strings.Split(line, ";")
+		if len(f) < 3 {
+			continue
+		}
+		switch f[2] {
+		case "Lu", "Ll", "Lt", "Nd", "Nl", "No":
+		default:
+			continue
+		}
+		r, err := strconv.ParseUint(f[0], 16, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out, ok := transliterations.Lookup(rune(r)); !ok {
+			t.Errorf("%U %s: %q", r, f[1], out)
+		}
+	}
+
+	var tests = []struct {
+		in, out string
+	}{
+		{"ꕙ", "va"},
+		{"ⴰⵣⵓⵍ", "azul"},
+		{"𝐀𝐁𝐂", "abc"},
+		{"ԏ", "tje"},
+		{"x² € ₩", "x2-eur-w"},
+		{"Ͽ ӿ ۿ ჿ ỿ", "s-ha-heh-labial-y"},
+		{"🈀 🈁", "hoka-koko"},
+	}
+	for _, test := range tests {
+		if out := Slugify(test.in, 0); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+}
+
+func TestScriptFamilies(t *testing.T) {
+	for _, test := range append(cjkTests, hangulTests...) {
+		if out := Slugify(test.in, 0); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+	for _, test := range cjkOptionTests {
+		if out := New(test.opts...).Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+}
+
+func TestBuildTags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("tests the package for every combination of build tags")
+	}
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("needs the go command to build with other tags")
+	}
+	for _, tags := range []string{"slugify_nocjk", "slugify_nohangul", "slugify_nocjk slugify_nohangul"} {
+		// -short keeps the tests it runs from running this one again.
+		out, err := exec.Command(gobin, "test", "-short", "-tags", tags, ".").CombinedOutput()
+		if err != nil {
+			t.Errorf("-tags %q: %v\n%s", tags, err, out)
+		}
+	}
+}
+
+func BenchmarkTransliterate(b *testing.B) {
+	text := []rune("Ångström Ελληνικά Кириллица 北京 ꕙ 𠀀 𝐀")
+	for i := 0; i < b.N; i++ {
+		for _, r := range text {
+			transliterations.Transliterate(r)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package slugify
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
+
+// Transformer returns a transform.Transformer that slugifies text the way
+// s.Slugify does, a chain of the transliteration, norm.NFKD and the
+// classification of the runes, for use with transform.NewReader and
+// transform.NewWriter on text too large to hold in memory. With WithUnicode
+// or WithScripts the text is prepared a segment at a time instead, see
+// transliterations.SegmentTransformer.
+//
+// Slugs are written as the text comes in, so the maximum length, the
+// truncation, the hash suffix and percent-encoding do not apply, and the
+// transliteration is done a word at a time, see
+// (*transliterations.Profile).Transformer.
+func (s *Slugifier) Transformer() transform.Transformer {
+	if s.unicode || len(s.scripts) > 0 {
+		return transform.Chain(transliterations.SegmentTransformer(s.prepareScripts), newSlugTransformer(s))
+	}
+	return transform.Chain(s.profile.Transformer(), norm.NFKD, newSlugTransformer(s))
+}
+
+// slugTransformer writes slugs with a slugBuilder, keeping back only the
+// runes a separator could still trim.
+type slugTransformer struct {
+	slugBuilder
+}
+
+func newSlugTransformer(s *Slugifier) *slugTransformer {
+	return &slugTransformer{slugBuilder{s: s, first: true, held: -1}}
+}
+
+func (t *slugTransformer) Reset() {
+	t.slugBuilder = slugBuilder{s: t.s, buf: t.buf[:0], first: true, held: -1}
+}
+
+func (t *slugTransformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for {
+		ready := len(t.buf)
+		if t.held >= 0 {
+			ready = t.held
+		}
+		n := copy(dst[nDst:], t.buf[:ready])
+		nDst += n
+		t.buf = t.buf[:copy(t.buf, t.buf[n:])]
+		if t.held >= 0 {
+			t.held -= n
+		}
+		if n < ready {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		if nSrc == len(src) {
+			if atEOF && t.held >= 0 {
+				// The end of the text trims nothing.
+				t.held = -1
+				continue
+			}
+			return nDst, nSrc, nil
+		}
+
+		if !atEOF && !utf8.FullRune(src[nSrc:]) {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		r, size := utf8.DecodeRune(src[nSrc:])
+		nSrc += size
+		t.add(t.s.classify(r))
+	}
+}
@@ -0,0 +1,93 @@
+package slugify
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/transform"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
+
+var transformTests = []string{
+	"Simple Test",
+	"__simple  test__",
+	"a -_- b",
+	"_-a",
+	"Café crème brûlée",
+	"日本語の手紙をテスト",
+	"北京 Seoul 서울",
+	"Жёлтый Ёж — ÄRGER!",
+	"x² € ₩ 🙂",
+	strings.Repeat("long ", 500) + strings.Repeat("a", 3000),
+}
+
+func TestSlugifierTransformer(t *testing.T) {
+	slugifiers := [][]Option{
+		nil,
+		{WithCase(CasePreserve), WithSeparator('_')},
+		{WithSeparator(NoSeparator)},
+		{WithCollapse(CollapseSeparators)},
+		{WithLanguage(language.German)},
+	}
+
+	for _, opts := range slugifiers {
+		s := New(opts...)
+		for _, in := range transformTests {
+			want := s.Slugify(in)
+
+			r := transform.NewReader(iotest.OneByteReader(strings.NewReader(in)), s.Transformer())
+			out, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatalf("%q: %v", in, err)
+			}
+			if string(out) != want {
+				t.Errorf("reader %q: %q != %q", in, out, want)
+			}
+
+			var buf bytes.Buffer
+			w := transform.NewWriter(&buf, s.Transformer())
+			for i := 0; i < len(in); i += 3 {
+				end := i + 3
+				if end > len(in) {
+					end = len(in)
+				}
+				if _, err := w.Write([]byte(in[i:end])); err != nil {
+					t.Fatalf("%q: %v", in, err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("%q: %v", in, err)
+			}
+			if buf.String() != want {
+				t.Errorf("writer %q: %q != %q", in, buf.String(), want)
+			}
+		}
+	}
+}
+
+func TestTransliterationTransformer(t *testing.T) {
+	for _, in := range transformTests {
+		want := SanatizeText(in)
+		out, _, err := transform.String(transliterations.Transformer(), in)
+		if err != nil {
+			t.Fatalf("%q: %v", in, err)
+		}
+		if out != want {
+			t.Errorf("%q: %q != %q", in, out, want)
+		}
+
+		r := transform.NewReader(iotest.OneByteReader(strings.NewReader(in)), transliterations.Transformer())
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("%q: %v", in, err)
+		}
+		if string(b) != want {
+			t.Errorf("reader %q: %q != %q", in, b, want)
+		}
+	}
+}
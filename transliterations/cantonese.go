@@ -0,0 +1,52 @@
+package transliterations
+
+import "strings"
+
+// HanReading selects the language hanzi are read in.
+type HanReading int
+
+const (
+	// Mandarin reads hanzi in Mandarin, written in pinyin.
+	Mandarin HanReading = iota
+	// Jyutping reads hanzi in Cantonese, written in the Jyutping of the
+	// Linguistic Society of Hong Kong: 香港 is hoeng gong.
+	Jyutping
+	// Yale reads hanzi in Cantonese, written in Yale romanization: 香港 is
+	// heung gong.
+	Yale
+)
+
+// TransliterateHan transliterates the hanzi r read as reading, in the
+// style of Transliterate, without tones. Hanzi that have no Cantonese
+// reading are read in Mandarin, and runes that are not hanzi are left to
+// Transliterate.
+func TransliterateHan(r rune, reading HanReading) string {
+	if jyutping, ok := cantoneseReadings[r]; ok && reading != Mandarin {
+		syllable := strings.TrimRight(jyutping, "123456")
+		if reading == Yale {
+			syllable = yale(syllable)
+		}
+		return title(syllable) + " "
+	}
+	if pinyin, ok := hanziReading(r); ok {
+		return title(writePinyin([]string{pinyin}, PinyinPlain)) + " "
+	}
+	return Transliterate(r)
+}
+
+// yale rewrites a toneless Jyutping syllable in Yale. They differ in
+// the initials written j, z and c, which Yale writes y, j and ch, and in
+// the vowels written oe and eo, which Yale writes eu.
+func yale(syllable string) string {
+	switch {
+	case strings.HasPrefix(syllable, "jyu"):
+		syllable = syllable[1:]
+	case strings.HasPrefix(syllable, "j"):
+		syllable = "y" + syllable[1:]
+	case strings.HasPrefix(syllable, "z"):
+		syllable = "j" + syllable[1:]
+	case strings.HasPrefix(syllable, "c"):
+		syllable = "ch" + syllable[1:]
+	}
+	return strings.NewReplacer("oe", "eu", "eo", "eu").Replace(syllable)
+}
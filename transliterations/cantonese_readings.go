@@ -0,0 +1,83 @@
+//go:build !slugify_nocjk
+// +build !slugify_nocjk
+
+package transliterations
+
+// cantoneseReadings holds the Jyutping, with tone numbers, of the hanzi
+// most often seen in Hong Kong, both traditional and simplified, including
+// the characters only written in Cantonese and those of its place names.
+var cantoneseReadings = map[rune]string{
+	'香': "hoeng1", '港': "gong2", '九': "gau2", '龍': "lung4", '龙': "lung4", '灣': "waan1", '湾': "waan1", '仔': "zai2",
+	'中': "zung1", '環': "waan4", '环': "waan4", '銅': "tung4", '铜': "tung4", '鑼': "lo4", '锣': "lo4", '尖': "zim1",
+	'沙': "saa1", '咀': "zeoi2", '旺': "wong6", '角': "gok3", '深': "sam1", '水': "seoi2", '埗': "bou6", '新': "san1",
+	'界': "gaai3", '元': "jyun4", '朗': "long5", '屯': "tyun4", '門': "mun4", '门': "mun4", '大': "daai6", '埔': "bou3",
+	'荃': "cyun4", '葵': "kwai4", '青': "cing1", '衣': "ji1", '將': "zoeng1", '将': "zoeng1", '軍': "gwan1", '军': "gwan1",
+	'澳': "ou3", '西': "sai1", '東': "dung1", '东': "dung1", '南': "naam4", '北': "bak1", '上': "soeng6", '下': "haa6",
+	'山': "saan1", '海': "hoi2", '天': "tin1", '地': "dei6", '人': "jan4", '日': "jat6", '月': "jyut6", '年': "nin4",
+	'時': "si4", '时': "si4", '間': "gaan1", '间': "gaan1", '國': "gwok3", '国': "gwok3", '家': "gaa1", '學': "hok6",
+	'学': "hok6", '校': "haau6", '生': "saang1", '一': "jat1", '二': "ji6", '三': "saam1", '四': "sei3", '五': "ng5",
+	'六': "luk6", '七': "cat1", '八': "baat3", '十': "sap6", '百': "baak3", '千': "cin1", '萬': "maan6", '万': "maan6",
+	'我': "ngo5", '你': "nei5", '他': "taa1", '她': "taa1", '佢': "keoi5", '們': "mun4", '们': "mun4", '哋': "dei6",
+	'的': "dik1", '嘅': "ge3", '係': "hai6", '唔': "m4", '好': "hou2", '是': "si6", '不': "bat1", '有': "jau5",
+	'冇': "mou5", '在': "zoi6", '喺': "hai2", '來': "loi4", '来': "loi4", '去': "heoi3", '食': "sik6", '飯': "faan6",
+	'饭': "faan6", '飲': "jam2", '饮': "jam2", '茶': "caa4", '點': "dim2", '点': "dim2", '心': "sam1", '廣': "gwong2",
+	'广': "gwong2", '州': "zau1", '話': "waa6", '话': "waa6", '粵': "jyut6", '粤': "jyut6", '語': "jyu5", '语': "jyu5",
+	'文': "man4", '字': "zi6", '書': "syu1", '书': "syu1", '電': "din6", '电': "din6", '影': "jing2", '車': "ce1",
+	'车': "ce1", '站': "zaam6", '路': "lou6", '街': "gaai1", '道': "dou6", '區': "keoi1", '区': "keoi1", '市': "si5",
+	'城': "sing4", '場': "coeng4", '场': "coeng4", '公': "gung1", '司': "si1", '園': "jyun4", '园': "jyun4", '會': "wui6",
+	'会': "wui6", '社': "se5", '銀': "ngan4", '银': "ngan4", '行': "hang4", '醫': "ji1", '医': "ji1", '院': "jyun2",
+	'酒': "zau2", '店': "dim3", '樓': "lau4", '楼': "lau4", '餐': "caan1", '廳': "teng1", '厅': "teng1", '美': "mei5",
+	'麗': "lai6", '丽': "lai6", '華': "waa4", '华': "waa4", '民': "man4", '共': "gung6", '和': "wo4", '政': "zing3",
+	'府': "fu2", '特': "dak6", '別': "bit6", '别': "bit6", '主': "zyu2", '義': "ji6", '义': "ji6", '愛': "oi3",
+	'爱': "oi3", '情': "cing4", '歌': "go1", '樂': "lok6", '乐': "lok6", '音': "jam1", '明': "ming4", '星': "sing1",
+	'報': "bou3", '报': "bou3", '聞': "man4", '闻': "man4", '今': "gam1", '朝': "ziu1", '晚': "maan5", '早': "zou2",
+	'夜': "je6", '周': "zau1", '末': "mut6", '春': "ceon1", '夏': "haa6", '秋': "cau1", '冬': "dung1", '風': "fung1",
+	'风': "fung1", '雨': "jyu5", '雪': "syut3", '花': "faa1", '木': "muk6", '火': "fo2", '金': "gam1", '土': "tou2",
+	'石': "sek6", '田': "tin4", '王': "wong4", '李': "lei5", '陳': "can4", '陈': "can4", '張': "zoeng1", '张': "zoeng1",
+	'黃': "wong4", '黄': "wong4", '林': "lam4", '何': "ho4", '吳': "ng4", '吴': "ng4", '劉': "lau4", '刘': "lau4",
+	'梁': "loeng4", '鄭': "zeng6", '郑': "zeng6", '謝': "ze6", '谢': "ze6", '楊': "joeng4", '杨': "joeng4", '馬': "maa5",
+	'马': "maa5", '高': "gou1", '長': "coeng4", '长': "coeng4", '小': "siu2", '少': "siu2", '多': "do1", '老': "lou5",
+	'師': "si1", '师': "si1", '朋': "pang4", '友': "jau5", '女': "neoi5", '男': "naam4", '子': "zi2", '父': "fu6",
+	'母': "mou5", '兄': "hing1", '弟': "dai6", '姐': "ze2", '妹': "mui6", '手': "sau2", '機': "gei1", '机': "gei1",
+	'網': "mong5", '网': "mong5", '頁': "jip6", '页': "jip6", '首': "sau2", '品': "ban2", '價': "gaa3", '价': "gaa3",
+	'錢': "cin2", '钱': "cin2", '買': "maai5", '买': "maai5", '賣': "maai6", '卖': "maai6", '開': "hoi1", '开': "hoi1",
+	'關': "gwaan1", '关': "gwaan1", '入': "jap6", '出': "ceot1", '口': "hau2", '面': "min6", '前': "cin4", '後': "hau6",
+	'后': "hau6", '左': "zo2", '右': "jau6", '內': "noi6", '内': "noi6", '外': "ngoi6", '正': "zing3", '方': "fong1",
+	'法': "faat3", '問': "man6", '问': "man6", '題': "tai4", '题': "tai4", '答': "daap3", '事': "si6", '物': "mat6",
+	'工': "gung1", '作': "zok3", '休': "jau1", '息': "sik1", '旅': "leoi5", '遊': "jau4", '游': "jau4", '運': "wan6",
+	'运': "wan6", '動': "dung6", '动': "dung6", '足': "zuk1", '球': "kau4", '體': "tai2", '体': "tai2", '育': "juk6",
+	'經': "ging1", '经': "ging1", '濟': "zai3", '济': "zai3", '發': "faat3", '发': "faat3", '展': "zin2", '科': "fo1",
+	'技': "gei6", '術': "seot6", '术': "seot6", '自': "zi6", '然': "jin4", '境': "ging2", '保': "bou2", '護': "wu6",
+	'护': "wu6", '安': "on1", '全': "cyun4", '定': "ding6", '知': "zi1", '識': "sik1", '识': "sik1", '見': "gin3",
+	'见': "gin3", '看': "hon3", '聽': "teng1", '听': "teng1", '講': "gong2", '讲': "gong2", '說': "syut3", '说': "syut3",
+	'讀': "duk6", '读': "duk6", '寫': "se2", '写': "se2", '想': "soeng2", '做': "zou6", '用': "jung6", '得': "dak1",
+	'到': "dou3", '過': "gwo3", '过': "gwo3", '了': "liu5", '着': "zoek6", '著': "zoek6", '就': "zau6", '都': "dou1",
+	'也': "jaa5", '還': "waan4", '还': "waan4", '又': "jau6", '很': "han2", '最': "zeoi3", '更': "gang3", '太': "taai3",
+	'真': "zan1", '能': "nang4", '可': "ho2", '以': "ji5", '要': "jiu3", '為': "wai6", '为': "wai6", '因': "jan1",
+	'所': "so2", '如': "jyu4", '果': "gwo2", '但': "daan6", '而': "ji4", '與': "jyu5", '与': "jyu5", '及': "kap6",
+	'或': "waak6", '之': "zi1", '其': "kei4", '這': "ze5", '这': "ze5", '那': "naa5", '哪': "naa5", '邊': "bin1",
+	'边': "bin1", '度': "dou6", '乜': "mat1", '嘢': "je5", '咩': "me1", '樣': "joeng6", '样': "joeng6", '個': "go3",
+	'个': "go3", '啲': "di1", '咗': "zo2", '緊': "gan2", '紧': "gan2", '嚟': "lai4", '睇': "tai2", '俾': "bei2",
+	'畀': "bei2", '同': "tung4", '仲': "zung6", '先': "sin1", '再': "zoi3", '返': "faan1", '企': "kei5", '坐': "co5",
+	'瞓': "fan3", '覺': "gaau3", '觉': "gaau3", '起': "hei2", '身': "san1", '頭': "tau4", '头': "tau4", '髮': "faat3",
+	'眼': "ngaan5", '耳': "ji5", '鼻': "bei6", '牙': "ngaa4", '腳': "goek3", '脚': "goek3", '色': "sik1", '紅': "hung4",
+	'红': "hung4", '白': "baak6", '黑': "hak1", '藍': "laam4", '蓝': "laam4", '綠': "luk6", '绿': "luk6", '光': "gwong1",
+	'雲': "wan4", '云': "wan4", '鳥': "niu5", '鸟': "niu5", '魚': "jyu4", '鱼': "jyu4", '雞': "gai1", '鸡': "gai1",
+	'豬': "zyu1", '猪': "zyu1", '牛': "ngau4", '羊': "joeng4", '狗': "gau2", '貓': "maau1", '猫': "maau1", '肉': "juk6",
+	'菜': "coi3", '米': "mai5", '麵': "min6", '粥': "zuk1", '湯': "tong1", '汤': "tong1", '包': "baau1", '蛋': "daan2",
+	'糖': "tong4", '鹽': "jim4", '盐': "jim4", '奶': "naai5", '咖': "gaa3", '啡': "fe1", '汁': "zap1", '杯': "bui1",
+	'碗': "wun2", '筷': "faai3", '房': "fong4", '屋': "uk1", '窗': "coeng1", '床': "cong4", '椅': "ji2", '枱': "toi2",
+	'桌': "coek3", '燈': "dang1", '灯': "dang1", '錶': "biu1", '表': "biu2", '鐘': "zung1", '钟': "zung1", '分': "fan1",
+	'秒': "miu5", '號': "hou6", '号': "hou6", '期': "kei4", '禮': "lai5", '礼': "lai5", '拜': "baai3", '節': "zit3",
+	'节': "zit3", '誕': "daan3", '诞': "daan3", '聖': "sing3", '圣': "sing3", '快': "faai3", '恭': "gung1", '喜': "hei2",
+	'賀': "ho6", '贺': "ho6", '祝': "zuk1", '福': "fuk1", '壽': "sau6", '寿': "sau6", '財': "coi4", '财': "coi4",
+	'富': "fu3", '貴': "gwai3", '贵': "gwai3", '平': "ping4", '宜': "ji4", '貨': "fo3", '货': "fo3", '幣': "bai6",
+	'币': "bai6", '台': "toi4", '珠': "zyu1", '江': "gong1", '河': "ho4", '湖': "wu4", '島': "dou2", '岛': "dou2",
+	'橋': "kiu4", '桥': "kiu4", '塘': "tong4", '觀': "gun1", '观': "gun1", '荔': "lai6", '枝': "zi1", '柴': "caai4",
+	'筲': "saau1", '箕': "gei1", '鰂': "zak1", '涌': "cung1", '跑': "paau2", '半': "bun3", '薄': "bok6", '扶': "fu4",
+	'赤': "cek3", '柱': "cyu5", '淺': "cin2", '浅': "cin2", '古': "gu2", '鯉': "lei5", '景': "ging2", '利': "lei6",
+	'德': "dak1", '寶': "bou2", '宝': "bou2", '康': "hong1", '健': "gin6", '士': "si6", '打': "daa2", '鼓': "gu2",
+	'硤': "haap6", '尾': "mei5", '磡': "ham3", '油': "jau4", '麻': "maa4", '佐': "zo3", '敦': "deon1", '彩': "coi2",
+	'虹': "hung4", '鑽': "zyun3", '仙': "sin1", '啟': "kai2", '炭': "taan3", '鞍': "on1", '烏': "wu1", '溪': "kai1",
+	'愉': "jyu4", '洲': "zau1", '坪': "ping4", '際': "zai3", '际': "zai3",
+}
@@ -0,0 +1,252 @@
+package transliterations
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ChineseDictionary gives the Mandarin readings of Chinese words. It is
+// used to split a run of hanzi into words, by taking the longest word at
+// every position, and to read characters that have several readings the
+// way the word they are in reads them, so 重庆 is chongqing and not
+// zhongqing. Characters that are in no word are read on their own.
+type ChineseDictionary struct {
+	words    map[string][]string
+	maxRunes int
+	// cedict is CC-CEDICT data the words are parsed from the first time
+	// they are needed, so that a dictionary built into the package costs
+	// nothing until it is used.
+	cedict string
+	once   sync.Once
+}
+
+// NewChineseDictionary returns an empty ChineseDictionary.
+func NewChineseDictionary() *ChineseDictionary {
+	return &ChineseDictionary{words: map[string][]string{}}
+}
+
+// Add adds word with its reading, pinyin syllables with tone numbers
+// separated by spaces, as in "bei3 jing1". ü is written u: or v, and the
+// neutral tone 5 or with no number. Capitalized syllables start a new
+// word, as in CC-CEDICT, so "Bei3 jing1 Da4 xue2" is written beijing
+// daxue.
+func (d *ChineseDictionary) Add(word, pinyin string) {
+	d.load()
+	syllables := strings.Fields(pinyin)
+	for i, s := range syllables {
+		syllables[i] = umlaut.Replace(s)
+	}
+	d.words[word] = syllables
+	if n := utf8.RuneCountInString(word); n > d.maxRunes {
+		d.maxRunes = n
+	}
+}
+
+var umlaut = strings.NewReplacer("u:", "ü", "U:", "Ü", "v", "ü", "V", "Ü")
+
+// ParseCEDICT reads a dictionary in the CC-CEDICT format, one word a line
+// with its traditional and simplified forms and its reading in brackets:
+//
+//	北京大學 北京大学 [Bei3 jing1 Da4 xue2] /Peking University/
+//
+// Both forms of the word are added. Lines starting with # are comments,
+// and the definitions are ignored.
+func ParseCEDICT(r io.Reader) (*ChineseDictionary, error) {
+	d := NewChineseDictionary()
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' {
+			continue
+		}
+		open, end := strings.IndexByte(text, '['), strings.IndexByte(text, ']')
+		if open < 0 || end < open || len(strings.Fields(text[:open])) != 2 {
+			return nil, fmt.Errorf("transliterations: line %d is not a CC-CEDICT entry: %q", line, text)
+		}
+		forms, pinyin := strings.Fields(text[:open]), text[open+1:end]
+		d.Add(forms[0], pinyin)
+		d.Add(forms[1], pinyin)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// load parses the CC-CEDICT data of d, if it has any, the first time it
+// is called.
+func (d *ChineseDictionary) load() {
+	d.once.Do(func() {
+		if d.cedict == "" {
+			return
+		}
+		parsed, err := ParseCEDICT(strings.NewReader(d.cedict))
+		if err != nil {
+			panic(err)
+		}
+		d.words, d.maxRunes = parsed.words, parsed.maxRunes
+	})
+}
+
+// word returns the length in bytes and the syllables of the longest word
+// in d text starts with, or 0 if there is none.
+func (d *ChineseDictionary) word(text string) (n int, syllables []string) {
+	d.load()
+	var ends []int
+	for i, r := range text {
+		if len(ends) == d.maxRunes {
+			break
+		}
+		ends = append(ends, i+utf8.RuneLen(r))
+	}
+	for count := len(ends); count > 0; count-- {
+		if syllables, ok := d.words[text[:ends[count-1]]]; ok {
+			return ends[count-1], syllables
+		}
+	}
+	return 0, nil
+}
+
+// PinyinMode selects how pinyin is written.
+type PinyinMode int
+
+const (
+	// PinyinPlain writes pinyin without tones: xian.
+	PinyinPlain PinyinMode = iota
+	// PinyinApostrophe writes pinyin without tones, with an apostrophe
+	// before the syllables inside a word that start with a, o or e, so
+	// that 西安 is xi'an and not the single syllable xian.
+	PinyinApostrophe
+	// PinyinToneMarks writes the tones as diacritics, with the apostrophes
+	// of PinyinApostrophe: xī'ān.
+	PinyinToneMarks
+	// PinyinToneNumbers writes the tone after every syllable as a number:
+	// xi1an1. The neutral tone has no number.
+	PinyinToneNumbers
+)
+
+// hanziPinyin is hanziReadings split into a reading per character, the
+// first time hanziReading is called.
+var (
+	hanziPinyin     []string
+	hanziPinyinOnce sync.Once
+)
+
+// hanziFirst is the first character hanziReadings has the reading of.
+const hanziFirst = 0x4e00
+
+// hanziReading returns the reading of the hanzi r in pinyin with a tone
+// number.
+func hanziReading(r rune) (string, bool) {
+	hanziPinyinOnce.Do(func() {
+		hanziPinyin = strings.Fields(hanziReadings)
+	})
+	if r < hanziFirst || int(r-hanziFirst) >= len(hanziPinyin) || hanziPinyin[r-hanziFirst] == "-" {
+		return "", false
+	}
+	return hanziPinyin[r-hanziFirst], true
+}
+
+// writeChinese writes the run of hanzi text starts with, which comes
+// after prev, a word at a time, and returns its length in bytes. Words
+// are written in title case, like Transliterate writes hanzi. Hanzi that
+// are in no word of d are written as words of their own.
+func writeChinese(b *strings.Builder, text string, prev rune, d *ChineseDictionary, mode PinyinMode) int {
+	run := hanRun(text)
+	var words []string
+	for i := 0; i < run; {
+		if n, syllables := d.word(text[i:run]); n > 0 {
+			for _, word := range pinyinWords(syllables) {
+				words = append(words, title(writePinyin(word, mode)))
+			}
+			i += n
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if reading, ok := hanziReading(r); ok {
+			words = append(words, title(writePinyin([]string{reading}, mode)))
+		} else {
+			words = append(words, strings.TrimSpace(Transliterate(r)))
+		}
+		i += size
+	}
+
+	if isLetterOrNumber(prev) && !strings.HasSuffix(b.String(), " ") {
+		b.WriteByte(' ')
+	}
+	b.WriteString(strings.Join(words, " "))
+	if next, _ := utf8.DecodeRuneInString(text[run:]); isLetterOrNumber(next) {
+		b.WriteByte(' ')
+	}
+	return run
+}
+
+// pinyinWords splits syllables into words before every capitalized
+// syllable but the first, and lowercases them.
+func pinyinWords(syllables []string) [][]string {
+	var words [][]string
+	for i, s := range syllables {
+		r, _ := utf8.DecodeRuneInString(s)
+		if i == 0 || unicode.IsUpper(r) {
+			words = append(words, nil)
+		}
+		words[len(words)-1] = append(words[len(words)-1], strings.ToLower(s))
+	}
+	return words
+}
+
+// writePinyin writes the syllables of a word, given with tone numbers, in
+// mode.
+func writePinyin(syllables []string, mode PinyinMode) string {
+	b := strings.Builder{}
+	for i, s := range syllables {
+		toneless := strings.TrimRight(s, "012345")
+		tone := s[len(toneless):]
+		if i > 0 && mode != PinyinPlain && mode != PinyinToneNumbers && strings.IndexByte("aoe", toneless[0]) >= 0 {
+			b.WriteByte('\'')
+		}
+		switch mode {
+		case PinyinToneMarks:
+			b.WriteString(markTone(toneless, tone))
+		case PinyinToneNumbers:
+			b.WriteString(toneless)
+			if tone != "5" {
+				b.WriteString(tone)
+			}
+		default:
+			b.WriteString(toneless)
+		}
+	}
+	return b.String()
+}
+
+var toneMarks = map[string]string{"1": "\u0304", "2": "\u0301", "3": "\u030c", "4": "\u0300"}
+
+// markTone puts the diacritic for tone on the vowel of syllable that
+// takes it: a or e if there is one, the o of ou, and the last vowel
+// otherwise.
+func markTone(syllable, tone string) string {
+	mark, ok := toneMarks[tone]
+	if !ok {
+		return syllable
+	}
+	i := strings.IndexAny(syllable, "ae")
+	if i < 0 {
+		i = strings.Index(syllable, "ou")
+	}
+	if i < 0 {
+		i = strings.LastIndexAny(syllable, "iouü")
+	}
+	if i < 0 {
+		return syllable
+	}
+	_, size := utf8.DecodeRuneInString(syllable[i:])
+	return norm.NFC.String(syllable[:i+size] + mark + syllable[i+size:])
+}
@@ -0,0 +1,211 @@
+//go:build !slugify_nocjk
+// +build !slugify_nocjk
+
+package transliterations
+
+// ChineseWords is the dictionary used for Chinese text. It covers common
+// words, place names and the characters whose most frequent reading is
+// not the one Transliterate gives; anything more needs a dictionary of its
+// own, such as CC-CEDICT read with ParseCEDICT.
+var ChineseWords = &ChineseDictionary{cedict: chineseWords}
+
+const chineseWords = `
+# Characters read differently from Transliterate
+了 了 [le5] /
+還 还 [hai2] /
+著 着 [zhe5] /
+都 都 [dou1] /
+們 们 [men5] /
+的 的 [de5] /
+得 得 [de5] /
+
+# Places
+中國 中国 [Zhong1 guo2] /
+北京 北京 [Bei3 jing1] /
+上海 上海 [Shang4 hai3] /
+天津 天津 [Tian1 jin1] /
+重慶 重庆 [Chong2 qing4] /
+廣州 广州 [Guang3 zhou1] /
+深圳 深圳 [Shen1 zhen4] /
+香港 香港 [Xiang1 gang3] /
+澳門 澳门 [Ao4 men2] /
+臺灣 台湾 [Tai2 wan1] /
+台灣 台湾 [Tai2 wan1] /
+臺北 台北 [Tai2 bei3] /
+台北 台北 [Tai2 bei3] /
+西安 西安 [Xi1 an1] /
+南京 南京 [Nan2 jing1] /
+杭州 杭州 [Hang2 zhou1] /
+成都 成都 [Cheng2 du1] /
+武漢 武汉 [Wu3 han4] /
+長沙 长沙 [Chang2 sha1] /
+廈門 厦门 [Xia4 men2] /
+蘇州 苏州 [Su1 zhou1] /
+哈爾濱 哈尔滨 [Ha1 er3 bin1] /
+長江 长江 [Chang2 jiang1] /
+黃河 黄河 [Huang2 he2] /
+長城 长城 [Chang2 cheng2] /
+天安門 天安门 [Tian1 an1 men2] /
+日本 日本 [Ri4 ben3] /
+美國 美国 [Mei3 guo2] /
+英國 英国 [Ying1 guo2] /
+法國 法国 [Fa3 guo2] /
+德國 德国 [De2 guo2] /
+亞洲 亚洲 [Ya4 zhou1] /
+歐洲 欧洲 [Ou1 zhou1] /
+世界 世界 [shi4 jie4] /
+
+# Universities and institutions
+大學 大学 [da4 xue2] /
+北京大學 北京大学 [Bei3 jing1 Da4 xue2] /
+清華大學 清华大学 [Qing1 hua2 Da4 xue2] /
+復旦大學 复旦大学 [Fu4 dan4 Da4 xue2] /
+銀行 银行 [yin2 hang2] /
+醫院 医院 [yi1 yuan4] /
+學校 学校 [xue2 xiao4] /
+政府 政府 [zheng4 fu3] /
+公司 公司 [gong1 si1] /
+人民 人民 [ren2 min2] /
+共和國 共和国 [gong4 he2 guo2] /
+中華 中华 [Zhong1 hua2] /
+中華人民共和國 中华人民共和国 [Zhong1 hua2 Ren2 min2 Gong4 he2 guo2] /
+
+# Language
+中文 中文 [Zhong1 wen2] /
+漢語 汉语 [Han4 yu3] /
+漢字 汉字 [Han4 zi4] /
+普通話 普通话 [pu3 tong1 hua4] /
+英語 英语 [Ying1 yu3] /
+拼音 拼音 [pin1 yin1] /
+語言 语言 [yu3 yan2] /
+文化 文化 [wen2 hua4] /
+歷史 历史 [li4 shi3] /
+
+# Readings that depend on the word
+重要 重要 [zhong4 yao4] /
+重新 重新 [chong2 xin1] /
+重複 重复 [chong2 fu4] /
+體重 体重 [ti3 zhong4] /
+行走 行走 [xing2 zou3] /
+行業 行业 [hang2 ye4] /
+旅行 旅行 [lu:3 xing2] /
+銀行卡 银行卡 [yin2 hang2 ka3] /
+音樂 音乐 [yin1 yue4] /
+快樂 快乐 [kuai4 le4] /
+長大 长大 [zhang3 da4] /
+成長 成长 [cheng2 zhang3] /
+校長 校长 [xiao4 zhang3] /
+長度 长度 [chang2 du4] /
+覺得 觉得 [jue2 de5] /
+睡覺 睡觉 [shui4 jiao4] /
+還是 还是 [hai2 shi4] /
+還有 还有 [hai2 you3] /
+了解 了解 [liao3 jie3] /
+朝陽 朝阳 [Chao2 yang2] /
+朝代 朝代 [chao2 dai4] /
+朝鮮 朝鲜 [Chao2 xian3] /
+東西 东西 [dong1 xi5] /
+地方 地方 [di4 fang5] /
+頭髮 头发 [tou2 fa5] /
+發展 发展 [fa1 zhan3] /
+發現 发现 [fa1 xian4] /
+便宜 便宜 [pian2 yi5] /
+方便 方便 [fang1 bian4] /
+好看 好看 [hao3 kan4] /
+愛好 爱好 [ai4 hao4] /
+爲了 为了 [wei4 le5] /
+為了 为了 [wei4 le5] /
+因爲 因为 [yin1 wei4] /
+因為 因为 [yin1 wei4] /
+作爲 作为 [zuo4 wei2] /
+作為 作为 [zuo4 wei2] /
+認為 认为 [ren4 wei2] /
+會議 会议 [hui4 yi4] /
+會計 会计 [kuai4 ji4] /
+得到 得到 [de2 dao4] /
+應該 应该 [ying1 gai1] /
+數學 数学 [shu4 xue2] /
+教學 教学 [jiao4 xue2] /
+教育 教育 [jiao4 yu4] /
+睡著 睡着 [shui4 zhao2] /
+著名 著名 [zhu4 ming2] /
+差不多 差不多 [cha4 bu5 duo1] /
+參加 参加 [can1 jia1] /
+人參 人参 [ren2 shen1] /
+質量 质量 [zhi4 liang4] /
+數量 数量 [shu4 liang4] /
+處理 处理 [chu3 li3] /
+好處 好处 [hao3 chu5] /
+調查 调查 [diao4 cha2] /
+空調 空调 [kong1 tiao2] /
+一樣 一样 [yi1 yang4] /
+一起 一起 [yi1 qi3] /
+一定 一定 [yi1 ding4] /
+不是 不是 [bu4 shi4] /
+不要 不要 [bu4 yao4] /
+不過 不过 [bu4 guo4] /
+不會 不会 [bu4 hui4] /
+
+# Everyday words
+今天 今天 [jin1 tian1] /
+明天 明天 [ming2 tian1] /
+昨天 昨天 [zuo2 tian1] /
+時間 时间 [shi2 jian1] /
+天氣 天气 [tian1 qi4] /
+新聞 新闻 [xin1 wen2] /
+朋友 朋友 [peng2 you5] /
+老師 老师 [lao3 shi1] /
+學生 学生 [xue2 sheng5] /
+學習 学习 [xue2 xi2] /
+工作 工作 [gong1 zuo4] /
+電腦 电脑 [dian4 nao3] /
+手機 手机 [shou3 ji1] /
+電話 电话 [dian4 hua4] /
+電影 电影 [dian4 ying3] /
+網絡 网络 [wang3 luo4] /
+互聯網 互联网 [hu4 lian2 wang3] /
+網站 网站 [wang3 zhan4] /
+地圖 地图 [di4 tu2] /
+飛機 飞机 [fei1 ji1] /
+火車 火车 [huo3 che1] /
+汽車 汽车 [qi4 che1] /
+經濟 经济 [jing1 ji4] /
+社會 社会 [she4 hui4] /
+科學 科学 [ke1 xue2] /
+技術 技术 [ji4 shu4] /
+問題 问题 [wen4 ti2] /
+市場 市场 [shi4 chang3] /
+生活 生活 [sheng1 huo2] /
+家庭 家庭 [jia1 ting2] /
+孩子 孩子 [hai2 zi5] /
+先生 先生 [xian1 sheng5] /
+女士 女士 [nu:3 shi4] /
+你好 你好 [ni3 hao3] /
+謝謝 谢谢 [xie4 xie5] /
+再見 再见 [zai4 jian4] /
+測試 测试 [ce4 shi4] /
+文章 文章 [wen2 zhang1] /
+故事 故事 [gu4 shi5] /
+歡迎 欢迎 [huan1 ying2] /
+介紹 介绍 [jie4 shao4] /
+產品 产品 [chan3 pin3] /
+價格 价格 [jia4 ge2] /
+服務 服务 [fu2 wu4] /
+首頁 首页 [shou3 ye4] /
+設置 设置 [she4 zhi4] /
+搜索 搜索 [sou1 suo3] /
+登錄 登录 [deng1 lu4] /
+註冊 注册 [zhu4 ce4] /
+下載 下载 [xia4 zai4] /
+關於 关于 [guan1 yu2] /
+我們 我们 [wo3 men5] /
+你們 你们 [ni3 men5] /
+他們 他们 [ta1 men5] /
+什麼 什么 [shen2 me5] /
+怎麼 怎么 [zen3 me5] /
+這個 这个 [zhe4 ge5] /
+那個 那个 [na4 ge5] /
+春節 春节 [Chun1 jie2] /
+中秋節 中秋节 [Zhong1 qiu1 jie2] /
+熊貓 熊猫 [xiong2 mao1] /
+`
@@ -0,0 +1,144 @@
+package transliterations
+
+import "sort"
+
+// ISO9 is the ISO 9:1995 romanization, which gives every Cyrillic letter
+// its own Latin letter, using diacritics. The hard and soft signs, ʺ and
+// ʹ in the standard, are written as " and ' so that they stay ASCII.
+var ISO9 = &Profile{
+	Runes: withUpper(map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'ґ': "g̀", 'д': "d",
+		'ѓ': "ǵ", 'е': "e", 'ё': "ë", 'є': "ê", 'ж': "ž", 'з': "z",
+		'ѕ': "ẑ", 'и': "i", 'і': "ì", 'ї': "ï", 'й': "j", 'ј': "ǰ", 'Ј': "J̌",
+		'к': "k", 'л': "l", 'љ': "l̂", 'м': "m", 'н': "n", 'њ': "n̂",
+		'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'ќ': "ḱ",
+		'ћ': "ć", 'у': "u", 'ў': "ŭ", 'ф': "f", 'х': "h", 'ц': "c",
+		'ч': "č", 'џ': "d̂", 'ш': "š", 'щ': "ŝ", 'ъ': "\"", 'ы': "y",
+		'ь': "'", 'э': "è", 'ю': "û", 'я': "â", 'ѣ': "ě", 'ѫ': "ǎ",
+		'ѳ': "f̀", 'ѵ': "ỳ",
+	}),
+}
+
+// GOST779B is System B of GOST 7.79-2000, which only uses ASCII letters.
+// The backticks and apostrophes the standard adds to some letters are
+// left out, as is usual in URLs, and ц is written cz before the letters
+// written with e, i, y and j.
+var GOST779B = &Profile{
+	Runes: withUpper(map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'ґ': "g", 'д': "d",
+		'ѓ': "g", 'е': "e", 'ё': "yo", 'є': "ye", 'ж': "zh", 'з': "z",
+		'ѕ': "z", 'и': "i", 'і': "i", 'ї': "yi", 'й': "j", 'ј': "j",
+		'к': "k", 'л': "l", 'љ': "l", 'м': "m", 'н': "n", 'њ': "n",
+		'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'ќ': "k",
+		'у': "u", 'ў': "u", 'ф': "f", 'х': "x", 'ц': "c", 'ч': "ch",
+		'џ': "dh", 'ш': "sh", 'щ': "shh", 'ъ': "", 'ы': "y", 'ь': "",
+		'э': "e", 'ю': "yu", 'я': "ya", 'ѣ': "ye", 'ѳ': "fh", 'ѵ': "yh",
+	}),
+	Sequences: withUpperSequences(map[string]string{
+		"це": "cze", "ци": "czi", "ці": "czi", "цы": "czy", "цй": "czj",
+		"цэ": "cze", "цё": "czyo", "цє": "czye", "цї": "czyi", "цю": "czyu",
+		"ця": "czya",
+	}),
+}
+
+// BGNPCGN is the BGN/PCGN 1947 romanization of Russian. е and ё are
+// written ye and yë at the start of a word and after a vowel, й, ъ or ь.
+var BGNPCGN = &Profile{
+	Runes: withUpper(map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e",
+		'ё': "ë", 'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k",
+		'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+		'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+		'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "”", 'ы': "y", 'ь': "’",
+		'э': "e", 'ю': "yu", 'я': "ya",
+	}),
+	WordInitial:  withUpper(map[rune]string{'е': "ye", 'ё': "yë"}),
+	InitialAfter: "аеёиоуыэюяйъьАЕЁИОУЫЭЮЯЙЪЬ",
+}
+
+// UkrainianNational is the official Ukrainian romanization of 2010. є,
+// ї, й, ю and я are written ye, yi, y, yu and ya at the start of a word
+// and ie, i, i, iu and ia elsewhere, зг is written zgh so it is not read
+// as zh, and apostrophes are left out.
+var UkrainianNational = &Profile{
+	Runes: withUpper(map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "h", 'ґ': "g", 'д': "d",
+		'е': "e", 'є': "ie", 'ж': "zh", 'з': "z", 'и': "y", 'і': "i",
+		'ї': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n",
+		'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+		'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+		'ь': "", 'ю': "iu", 'я': "ia", 'ʼ': "", '’': "", '\'': "",
+	}),
+	WordInitial: withUpper(map[rune]string{
+		'є': "ye", 'ї': "yi", 'й': "y", 'ю': "yu", 'я': "ya",
+	}),
+	Sequences: withUpperSequences(map[string]string{"зг": "zgh"}),
+}
+
+// BulgarianStreamlined is the Bulgarian Streamlined System, official
+// since 2009, which writes щ as sht and ъ as a, and ия as ia at the end of
+// a word.
+var BulgarianStreamlined = &Profile{
+	Runes: withUpper(map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e",
+		'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l",
+		'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s",
+		'т': "t", 'у': "u", 'ф': "f", 'х': "h", 'ц': "ts", 'ч': "ch",
+		'ш': "sh", 'щ': "sht", 'ъ': "a", 'ь': "y", 'ю': "yu", 'я': "ya",
+	}),
+	WordFinal: withUpperSequences(map[string]string{"ия": "ia"}),
+}
+
+// SerbianLatin maps Serbian Cyrillic to Gaj's Latin alphabet, letter for
+// letter. đ is written dj, as it has no decomposition to fall back on.
+var SerbianLatin = &Profile{
+	Runes: withUpper(map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'ђ': "dj",
+		'е': "e", 'ж': "ž", 'з': "z", 'и': "i", 'ј': "j", 'к': "k",
+		'л': "l", 'љ': "lj", 'м': "m", 'н': "n", 'њ': "nj", 'о': "o",
+		'п': "p", 'р': "r", 'с': "s", 'т': "t", 'ћ': "ć", 'у': "u",
+		'ф': "f", 'х': "h", 'ц': "c", 'ч': "č", 'џ': "dž", 'ш': "š",
+	}),
+}
+
+// MacedonianOfficial is the official romanization of Macedonian, also
+// used by BGN/PCGN since 2013, which writes ѓ as gj, ќ as kj, ѕ as dz and
+// џ as dj.
+var MacedonianOfficial = &Profile{
+	Runes: withUpper(map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'ѓ': "gj",
+		'е': "e", 'ж': "zh", 'з': "z", 'ѕ': "dz", 'и': "i", 'ј': "j",
+		'к': "k", 'л': "l", 'љ': "lj", 'м': "m", 'н': "n", 'њ': "nj",
+		'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'ќ': "kj",
+		'у': "u", 'ф': "f", 'х': "h", 'ц': "c", 'ч': "ch", 'џ': "dj",
+		'ш': "sh",
+	}),
+}
+
+var cyrillicSchemes = map[string]*Profile{
+	"iso9":           ISO9,
+	"gost779b":       GOST779B,
+	"bgn-pcgn":       BGNPCGN,
+	"uk-national":    UkrainianNational,
+	"bg-streamlined": BulgarianStreamlined,
+	"sr-latin":       SerbianLatin,
+	"mk-official":    MacedonianOfficial,
+}
+
+// CyrillicScheme returns the romanization of Cyrillic called name, one of
+// the names CyrillicSchemes returns.
+func CyrillicScheme(name string) (*Profile, bool) {
+	p, ok := cyrillicSchemes[name]
+	return p, ok
+}
+
+// CyrillicSchemes returns the names of the romanizations of Cyrillic,
+// sorted.
+func CyrillicSchemes() []string {
+	names := make([]string, 0, len(cyrillicSchemes))
+	for name := range cyrillicSchemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,76 @@
+"""Writes the output of the CLDR Han-Latin and Any-Latin transforms.
+
+Usage: python3.13 cldr.py ./transliterate ../unidecode ../cldr-42
+
+The transforms are run with ICU, through the transliterate program built
+from transliterate.cpp, over the code points the Unidecode data has no
+transliteration for: every assigned code point of the sections it does not
+have, and the letters and numbers it only has [?] for or leaves out.
+Han-Latin is run over the hanzi and Any-Latin over the rest, and the code
+points a transform leaves alone are not written.
+"""
+
+import ast
+import glob
+import os
+import subprocess
+import sys
+import unicodedata as ud
+
+
+def category(c):
+    return ud.category(chr(c))
+
+
+def is_han(c):
+    name = ud.name(chr(c), '')
+    return name.startswith('CJK') and 'IDEOGRAPH' in name
+
+
+def load_unidecode(d):
+    base = {}
+    for path in glob.glob(os.path.join(d, 'x*.py')):
+        section = int(os.path.basename(path)[1:-3], 16)
+        with open(path, encoding='utf-8') as f:
+            base[section] = list(ast.literal_eval(f.read().split('=', 1)[1]))
+    return base
+
+
+def transliterate(program, ident, cps):
+    text = ''.join(chr(c) + '\n' for c in cps)
+    out = subprocess.run([program, ident], input=text.encode('utf-8', 'surrogatepass'),
+                         capture_output=True, check=True).stdout.decode()
+    res = out.split('\n')[:len(cps)]
+    assert len(res) == len(cps)
+    return dict(zip(cps, res))
+
+
+def main():
+    program, unidecode, out = sys.argv[1:]
+    base = load_unidecode(unidecode)
+
+    chars = []
+    for section in range(0, 0x324):
+        if section in base or 0xac <= section <= 0xd6:
+            continue
+        cps = range(section << 8, (section << 8) + 256)
+        if any(category(c)[0] in 'LN' for c in cps) or \
+                (section < 0x100 and any(category(c) not in ('Cn', 'Cs', 'Co') for c in cps)):
+            chars.extend(c for c in cps if category(c) != 'Cn')
+    for section, t in base.items():
+        for i in range(256):
+            c = (section << 8) + i
+            if category(c)[0] in 'LN' and (i >= len(t) or t[i].strip() == '[?]'):
+                chars.append(c)
+
+    han = sorted(c for c in chars if is_han(c))
+    other = sorted(c for c in chars if not is_han(c))
+    for ident, cps in (('Han-Latin', han), ('Any-Latin', other)):
+        res = transliterate(program, ident, cps)
+        with open(os.path.join(out, ident + '.txt'), 'w', encoding='utf-8') as f:
+            for c in cps:
+                if res[c] != chr(c):
+                    f.write('%04X\t%s\n' % (c, res[c]))
+
+
+main()
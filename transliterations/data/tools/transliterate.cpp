@@ -0,0 +1,30 @@
+// transliterate runs the ICU transliterator named by its argument, such as
+// Any-Latin, over every line of its input. Build it against ICU with
+//
+//	g++ -o transliterate transliterate.cpp -licui18n -licuuc -licudata
+#include <unicode/translit.h>
+#include <unicode/unistr.h>
+#include <iostream>
+
+using namespace icu;
+
+int main(int argc, char** argv) {
+  if (argc != 2) {
+    std::cerr << "usage: transliterate ID\n";
+    return 2;
+  }
+  UErrorCode status = U_ZERO_ERROR;
+  Transliterator* t = Transliterator::createInstance(argv[1], UTRANS_FORWARD, status);
+  if (U_FAILURE(status)) {
+    std::cerr << u_errorName(status) << "\n";
+    return 1;
+  }
+  std::string line;
+  while (std::getline(std::cin, line)) {
+    UnicodeString s = UnicodeString::fromUTF8(line);
+    t->transliterate(s);
+    std::string out;
+    s.toUTF8String(out);
+    std::cout << out << "\n";
+  }
+}
@@ -0,0 +1,83 @@
+"""Writes UnicodeData.txt from the Unicode database built into Python.
+
+Usage: python3.13 ucd.py > ../unicode-15.1.0/UnicodeData.txt
+
+unicodedata has no Unicode 1.0 names or ISO comments, so fields 10 and 11
+are empty, and the case mappings are the single code point ones of str.
+The ranges are written as First and Last lines like the upstream file.
+"""
+
+import sys
+import unicodedata as ud
+from fractions import Fraction
+
+RANGES = [
+    (0x3400, 0x4DBF, 'CJK Ideograph Extension A'),
+    (0x4E00, 0x9FFF, 'CJK Ideograph'),
+    (0xAC00, 0xD7A3, 'Hangul Syllable'),
+    (0xD800, 0xDB7F, 'Non Private Use High Surrogate'),
+    (0xDB80, 0xDBFF, 'Private Use High Surrogate'),
+    (0xDC00, 0xDFFF, 'Low Surrogate'),
+    (0xE000, 0xF8FF, 'Private Use'),
+    (0x17000, 0x187F7, 'Tangut Ideograph'),
+    (0x18D00, 0x18D08, 'Tangut Ideograph Supplement'),
+    (0x20000, 0x2A6DF, 'CJK Ideograph Extension B'),
+    (0x2A700, 0x2B739, 'CJK Ideograph Extension C'),
+    (0x2B740, 0x2B81D, 'CJK Ideograph Extension D'),
+    (0x2B820, 0x2CEA1, 'CJK Ideograph Extension E'),
+    (0x2CEB0, 0x2EBE0, 'CJK Ideograph Extension F'),
+    (0x2EBF0, 0x2EE5D, 'CJK Ideograph Extension I'),
+    (0x30000, 0x3134A, 'CJK Ideograph Extension G'),
+    (0x31350, 0x323AF, 'CJK Ideograph Extension H'),
+    (0xF0000, 0xFFFFD, 'Plane 15 Private Use'),
+    (0x100000, 0x10FFFD, 'Plane 16 Private Use'),
+]
+
+
+def numeric(v):
+    if v == int(v):
+        return str(int(v))
+    f = Fraction(v).limit_denominator(100000)
+    return '%d/%d' % (f.numerator, f.denominator)
+
+
+def case(c, f):
+    s = f(chr(c))
+    if len(s) == 1 and s != chr(c):
+        return '%04X' % ord(s)
+    return ''
+
+
+def main():
+    if ud.unidata_version != '15.1.0':
+        sys.exit('unicodedata is version %s, not 15.1.0' % ud.unidata_version)
+    bounds = {}
+    inside = set()
+    for first, last, name in RANGES:
+        bounds[first] = '<%s, First>' % name
+        bounds[last] = '<%s, Last>' % name
+        inside.update(range(first + 1, last))
+
+    for c in range(0x110000):
+        ch = chr(c)
+        k = ud.category(ch)
+        if k == 'Cn' or c in inside:
+            continue
+        name = bounds.get(c) or ud.name(ch, '')
+        if k == 'Cc':
+            name = '<control>'
+        d = ud.decimal(ch, None)
+        g = ud.digit(ch, None)
+        n = ud.numeric(ch, None)
+        lower = case(c, str.lower)
+        if c == 0x130:
+            lower = '0069'
+        f = ['%04X' % c, name, k, str(ud.combining(ch)), ud.bidirectional(ch), ud.decomposition(ch),
+             '' if d is None else str(d), '' if g is None else str(g), '' if n is None else numeric(n),
+             'Y' if ud.mirrored(ch) else 'N', '', '', case(c, str.upper), lower, case(c, str.title)]
+        if c in bounds:
+            f[12] = f[13] = f[14] = ''
+        print(';'.join(f))
+
+
+main()
@@ -0,0 +1,162 @@
+package transliterations
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// EmojiMode selects how emoji are transliterated.
+type EmojiMode int
+
+const (
+	// NoEmoji leaves emoji to the tables, which have nothing for most of
+	// them.
+	NoEmoji EmojiMode = iota
+	// EmojiNames writes emoji as their CLDR short name, 🚀 as rocket, and
+	// flags as the name of their country or region, 🇩🇪 as Germany.
+	EmojiNames
+	// EmojiRegionCodes is EmojiNames with flags written as their region
+	// code, 🇩🇪 as DE and the flag of England as GB-ENG.
+	EmojiRegionCodes
+)
+
+const (
+	zwj               = '\u200d'
+	regionalA         = 0x1f1e6
+	regionalZ         = 0x1f1ff
+	blackFlag         = 0x1f3f4
+	tagCancel         = 0xe007f
+	skinToneFirst     = 0x1f3fb
+	skinToneLast      = 0x1f3ff
+	textPresentation  = '\ufe0e'
+	emojiPresentation = '\ufe0f'
+	combiningKeycap   = '\u20e3'
+)
+
+type emojiEnd struct {
+	sequence, name uint32
+}
+
+// mayStartEmoji reports whether r is one of the runes an emoji can start
+// with, so that the others are not looked up.
+func mayStartEmoji(r rune) bool {
+	return r >= 0xa9 || r == '#' || r == '*' || r >= '0' && r <= '9'
+}
+
+// emoji returns the length in bytes of the emoji text starts with and how
+// it is written in mode, or 0 if text does not start with one. An emoji is
+// a grapheme cluster: a pictograph with its variation selector, skin tone
+// or keycap, several of them joined with ZWJ, or a flag. Skin tones do not
+// change the name, and a ZWJ sequence that has no name as a whole is cut
+// after the longest of its starts that has one.
+func emoji(text string, mode EmojiMode) (int, string) {
+	r, size := utf8.DecodeRuneInString(text)
+	if isRegionalIndicator(r) {
+		next, nextSize := utf8.DecodeRuneInString(text[size:])
+		if !isRegionalIndicator(next) {
+			return 0, ""
+		}
+		n := size + nextSize
+		if name, ok := emojiName(text[:n]); ok && mode == EmojiNames {
+			return n, name
+		}
+		return n, string([]rune{r - regionalA + 'A', next - regionalA + 'A'})
+	}
+
+	var seq []rune
+	n, name := 0, ""
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		seq = append(seq, r)
+		i += size
+		for i < len(text) {
+			r, size := utf8.DecodeRuneInString(text[i:])
+			if !isEmojiModifier(r) {
+				break
+			}
+			if r < skinToneFirst || r > skinToneLast {
+				seq = append(seq, r)
+			}
+			i += size
+		}
+		if s, ok := emojiName(string(seq)); ok {
+			n, name = i, s
+			if seq[0] == blackFlag && len(seq) > 1 && mode == EmojiRegionCodes {
+				name = tagRegion(seq[1:])
+			}
+		}
+
+		if r, size := utf8.DecodeRuneInString(text[i:]); r != zwj || i+size == len(text) {
+			break
+		}
+		seq = append(seq, zwj)
+		i += utf8.RuneLen(zwj)
+	}
+	return n, name
+}
+
+// emojiName returns the name of the emoji seq, a sequence without skin
+// tones.
+func emojiName(seq string) (string, bool) {
+	i := sort.Search(len(emojiEnds), func(i int) bool {
+		return emojiSequence(i) >= seq
+	})
+	if i == len(emojiEnds) || emojiSequence(i) != seq {
+		return "", false
+	}
+	start := uint32(0)
+	if i > 0 {
+		start = emojiEnds[i-1].name
+	}
+	return emojiNames[start:emojiEnds[i].name], true
+}
+
+func emojiSequence(i int) string {
+	start := uint32(0)
+	if i > 0 {
+		start = emojiEnds[i-1].sequence
+	}
+	return emojiSequences[start:emojiEnds[i].sequence]
+}
+
+// isEmojiModifier reports whether r is part of the emoji before it: a
+// skin tone, a variation selector, a keycap or a tag.
+func isEmojiModifier(r rune) bool {
+	return r >= skinToneFirst && r <= skinToneLast ||
+		r == emojiPresentation || r == textPresentation || r == combiningKeycap ||
+		r >= 0xe0020 && r <= tagCancel
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= regionalA && r <= regionalZ
+}
+
+// tagRegion returns the region the tags of a flag spell, GB-ENG for
+// gbeng.
+func tagRegion(tags []rune) string {
+	b := strings.Builder{}
+	for i, r := range tags {
+		if r == tagCancel {
+			break
+		}
+		if i == 2 {
+			b.WriteByte('-')
+		}
+		b.WriteRune(unicode.ToUpper(r - 0xe0000))
+	}
+	return b.String()
+}
+
+// writeEmoji writes name, the transliteration of an emoji, to b as a word
+// of its own, before next.
+func writeEmoji(b *strings.Builder, name string, next rune) {
+	if prev, _ := utf8.DecodeLastRuneInString(b.String()); b.Len() > 0 && !unicode.IsSpace(prev) {
+		b.WriteByte(' ')
+	}
+	b.WriteString(name)
+	if next != utf8.RuneError && !unicode.IsSpace(next) {
+		b.WriteByte(' ')
+	}
+}
@@ -0,0 +1,1044 @@
+//go:build ignore
+// +build ignore
+
+// This program generates the transliteration tables in tables*.go from
+// the source data in the data directory. Run it with go generate.
+//
+// The tables start from the Unidecode data in data/unidecode. Sections that
+// data does not cover, and the letters and numbers it only has "[?]" for,
+// are filled in from UnicodeData.txt and from the output of the CLDR
+// Any-Latin and Han-Latin transforms. The code points whose transliteration
+// changed since the last time the tables were generated are written to the
+// report, so an upgrade of the source data can be reviewed.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	dataDir        = flag.String("data", "data", "directory of the source data")
+	unicodeVersion = flag.String("unicode", "", "version of the Unicode data to read from data/unicode-VERSION")
+	cldrVersion    = flag.String("cldr", "", "version of the CLDR transforms to read from data/cldr-VERSION")
+	emojiVersion   = flag.String("emoji", "", "version of the emoji data to read from data/emoji-VERSION")
+	reportFile     = flag.String("report", "", "write the code points that changed to this file instead of stdout")
+)
+
+// lastSection is the last section the tables cover, the end of CJK
+// Unified Ideographs Extension H.
+const lastSection = 0x323
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+	flag.Parse()
+	if *unicodeVersion == "" || *cldrVersion == "" || *emojiVersion == "" {
+		log.Fatal("-unicode, -cldr and -emoji are required")
+	}
+
+	ucd := loadUnicodeData(filepath.Join(*dataDir, "unicode-"+*unicodeVersion, "UnicodeData.txt"))
+	base := loadUnidecode(filepath.Join(*dataDir, "unidecode"))
+	g := &generator{
+		ucd:   ucd,
+		names: map[string]rune{},
+		base:  base,
+		han:   loadTransform(filepath.Join(*dataDir, "cldr-"+*cldrVersion, "Han-Latin.txt")),
+		any:   loadTransform(filepath.Join(*dataDir, "cldr-"+*cldrVersion, "Any-Latin.txt")),
+	}
+	for r, c := range ucd {
+		g.names[c.name] = r
+	}
+
+	tables := map[rune][]string{}
+	for section := rune(0); section <= lastSection; section++ {
+		if t, ok := base[section]; ok {
+			tables[section] = g.fillPlaceholders(section, t)
+		} else if t := g.derive(section); t != nil {
+			tables[section] = t
+		}
+	}
+
+	report(g, readTables("tables.go", "tables_cjk.go", "tables_hangul.go"), tables)
+	writeTables(g, tables)
+	writeNames(g, tables)
+	writeEmoji(tables, filepath.Join(*dataDir, "emoji-"+*emojiVersion, "emoji-test.txt"))
+}
+
+type char struct {
+	name     string
+	category string
+	ccc      int
+	decomp   []rune
+	numeric  string
+}
+
+// loadUnicodeData reads the fields of UnicodeData.txt the tables are
+// derived from. Code points that are not in it are unassigned.
+func loadUnicodeData(path string) map[rune]*char {
+	ucd := map[rune]*char{}
+	var first rune
+	scan(path, func(line string) {
+		f := strings.Split(line, ";")
+		if len(f) != 15 {
+			log.Fatalf("%s: bad line %q", path, line)
+		}
+		r := parseCodePoint(f[0])
+		c := &char{name: f[1], category: f[2], numeric: f[8]}
+		c.ccc, _ = strconv.Atoi(f[3])
+		if d := strings.Fields(f[5]); len(d) > 0 {
+			if strings.HasPrefix(d[0], "<") {
+				d = d[1:]
+			}
+			for _, s := range d {
+				c.decomp = append(c.decomp, parseCodePoint(s))
+			}
+		}
+		switch {
+		case strings.HasSuffix(c.name, ", First>"):
+			first = r
+			return
+		case strings.HasSuffix(c.name, ", Last>"):
+			for cp := first; cp <= r; cp++ {
+				rc := *c
+				rc.name = rangeName(c.name, cp)
+				ucd[cp] = &rc
+			}
+			return
+		}
+		ucd[r] = c
+	})
+	return ucd
+}
+
+// rangeName returns the name of the code point r in the range named name.
+func rangeName(name string, r rune) string {
+	switch {
+	case strings.HasPrefix(name, "<CJK Ideograph"):
+		return fmt.Sprintf("CJK UNIFIED IDEOGRAPH-%04X", r)
+	case strings.HasPrefix(name, "<Tangut Ideograph"):
+		return fmt.Sprintf("TANGUT IDEOGRAPH-%04X", r)
+	}
+	return ""
+}
+
+// loadUnidecode reads the sections in the Unidecode format, Python files
+// with one quoted transliteration a line.
+func loadUnidecode(dir string) map[rune][]string {
+	files, err := filepath.Glob(filepath.Join(dir, "x*.py"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	base := map[rune][]string{}
+	for _, path := range files {
+		section, err := strconv.ParseInt(strings.TrimSuffix(filepath.Base(path)[1:], ".py"), 16, 32)
+		if err != nil {
+			log.Fatalf("%s: %v", path, err)
+		}
+		var t []string
+		scan(path, func(line string) {
+			if line == "data = (" || line == ")" {
+				return
+			}
+			end := strings.LastIndex(line, "# 0x")
+			if end < 0 {
+				log.Fatalf("%s: bad line %q", path, line)
+			}
+			s := strings.TrimSuffix(strings.TrimSpace(line[:end]), ",")
+			t = append(t, unquotePython(path, s))
+		})
+		base[rune(section)] = t
+	}
+	return base
+}
+
+// unquotePython returns the value of the Python string literal s.
+func unquotePython(path, s string) string {
+	if len(s) < 2 || s[0] != s[len(s)-1] || (s[0] != '\'' && s[0] != '"') {
+		log.Fatalf("%s: bad string %s", path, s)
+	}
+	b := strings.Builder{}
+	for i := 1; i < len(s)-1; i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case 'x':
+			n, err := strconv.ParseUint(s[i+1:i+3], 16, 8)
+			if err != nil {
+				log.Fatalf("%s: bad string %s", path, s)
+			}
+			b.WriteByte(byte(n))
+			i += 2
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+// loadTransform reads the output of a CLDR transform, a code point and its
+// transliteration a line. Code points the transform leaves alone are not
+// listed.
+func loadTransform(path string) map[rune]string {
+	t := map[rune]string{}
+	scan(path, func(line string) {
+		f := strings.SplitN(line, "\t", 2)
+		if len(f) != 2 {
+			log.Fatalf("%s: bad line %q", path, line)
+		}
+		t[parseCodePoint(f[0])] = f[1]
+	})
+	return t
+}
+
+func scan(path string, fn func(line string)) {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if line := s.Text(); line != "" && line[0] != '#' {
+			fn(line)
+		}
+	}
+	if err := s.Err(); err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+}
+
+func parseCodePoint(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
+
+type generator struct {
+	ucd      map[rune]*char
+	names    map[string]rune
+	base     map[rune][]string
+	han, any map[rune]string
+}
+
+func (g *generator) category(r rune) string {
+	if c, ok := g.ucd[r]; ok {
+		return c.category
+	}
+	return "Cn"
+}
+
+func (g *generator) isLetterOrNumber(r rune) bool {
+	k := g.category(r)
+	return k[0] == 'L' || k[0] == 'N'
+}
+
+// derive returns the table for a section the Unidecode data does not
+// have, or nil if the section needs none: Hangul syllables are romanized
+// by the code, and sections with nothing but placeholders are left out.
+func (g *generator) derive(section rune) []string {
+	if section >= 0x0ac && section <= 0x0d6 {
+		return nil
+	}
+	needed := false
+	for r := section << 8; r < (section+1)<<8; r++ {
+		k := g.category(r)
+		if g.isLetterOrNumber(r) || section < 0x100 && k != "Cn" && k != "Cs" && k != "Co" {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return nil
+	}
+
+	t := make([]string, 256)
+	placeholders := true
+	for i := range t {
+		t[i] = g.transliterate(section<<8 + rune(i))
+		if strings.TrimSpace(t[i]) != "[?]" {
+			placeholders = false
+		}
+	}
+	if placeholders {
+		return nil
+	}
+	return t
+}
+
+// fillPlaceholders replaces the placeholders in a table of the Unidecode
+// data that stand for a letter or number with their transliteration. The
+// tables of the data end with the last code point they have, often 0xfe,
+// so the code points after it are transliterated like those of the
+// sections it does not have.
+func (g *generator) fillPlaceholders(section rune, base []string) []string {
+	t := append([]string(nil), base...)
+	for len(t) < 256 {
+		t = append(t, g.transliterate(section<<8+rune(len(t))))
+	}
+	for i, s := range t {
+		r := section<<8 + rune(i)
+		if strings.TrimSpace(s) != "[?]" || !g.isLetterOrNumber(r) {
+			continue
+		}
+		if s := g.transliterate(r); strings.TrimSpace(s) != "[?]" {
+			t[i] = s
+		}
+	}
+	return t
+}
+
+// transliterate returns the transliteration of a code point from the
+// Unicode data and the CLDR transforms.
+func (g *generator) transliterate(r rune) string {
+	k := g.category(r)
+	switch {
+	case k == "Cn":
+		return "[?]"
+	case k[0] == 'M' || k == "Cf" || k == "Cc" || k == "Cs" || k == "Co":
+		return ""
+	case k == "Zs":
+		return " "
+	}
+	if g.isHan(r) {
+		// Hanzi are written as words, like the Unidecode tables do.
+		if s := g.fold(g.transform(g.han, r)); isASCII(s) && s != string(r) {
+			return title(s) + " "
+		}
+		return "[?] "
+	}
+	if s, ok := g.decompose(r); ok {
+		return s
+	}
+	if k[0] == 'N' && g.ucd[r].numeric != "" {
+		return g.ucd[r].numeric
+	}
+	if !g.isLetterOrNumber(r) {
+		return ""
+	}
+	if s := g.fold(g.transform(g.any, r)); isASCII(s) && s != string(r) && strings.IndexFunc(s, isASCIILetter) >= 0 {
+		return s
+	}
+	if s, ok := g.letterSymbol(r); ok {
+		return s
+	}
+	return g.fromName(r)
+}
+
+// letterSymbol transliterates a letter named as a symbol form of a letter
+// of its script like the letter: GREEK CAPITAL REVERSED LUNATE SIGMA
+// SYMBOL is written as GREEK CAPITAL LETTER SIGMA is, and GREEK CAPITAL KAI
+// SYMBOL as GREEK KAI SYMBOL in capitals.
+func (g *generator) letterSymbol(r rune) (string, bool) {
+	name := g.ucd[r].name
+	if !strings.HasSuffix(name, " SYMBOL") {
+		return "", false
+	}
+	base := strings.TrimSuffix(name, " SYMBOL")
+	if i := strings.Index(base, " WITH "); i >= 0 {
+		base = base[:i]
+	}
+	words := strings.Fields(base)
+	if len(words) < 2 {
+		return "", false
+	}
+	form := "SMALL"
+	for _, w := range words {
+		if w == "CAPITAL" {
+			form = w
+		}
+	}
+	var s string
+	if letter, ok := g.names[words[0]+" "+form+" LETTER "+words[len(words)-1]]; ok {
+		s = g.entry(letter)
+	} else if letter, ok := g.names[strings.Replace(name, " CAPITAL", "", 1)]; ok && letter != r {
+		s = title(g.entry(letter))
+	} else {
+		return "", false
+	}
+	return s, strings.TrimSpace(s) != "[?]"
+}
+
+// entry returns the transliteration of r in the Unidecode data, or from
+// the Unicode data and the CLDR transforms if the data has none.
+func (g *generator) entry(r rune) string {
+	if t := g.base[r>>8]; int(r&0xff) < len(t) && strings.TrimSpace(t[r&0xff]) != "[?]" {
+		return t[r&0xff]
+	}
+	return g.transliterate(r)
+}
+
+func (g *generator) isHan(r rune) bool {
+	name := g.ucd[r].name
+	return strings.HasPrefix(name, "CJK") && strings.Contains(name, "IDEOGRAPH")
+}
+
+func (g *generator) transform(t map[rune]string, r rune) string {
+	if s, ok := t[r]; ok {
+		return s
+	}
+	return string(r)
+}
+
+// decompose transliterates the compatibility decomposition of r with the
+// Unidecode data, if that is ASCII.
+func (g *generator) decompose(r rune) (string, bool) {
+	d := g.nfkd([]rune{r})
+	if len(d) == 1 && d[0] == r {
+		return "", false
+	}
+	b := strings.Builder{}
+	for _, c := range d {
+		if c < unicode.MaxASCII+1 {
+			b.WriteRune(c)
+			continue
+		}
+		t, ok := g.base[c>>8]
+		if !ok || int(c&0xff) >= len(t) {
+			return "", false
+		}
+		b.WriteString(t[c&0xff])
+	}
+	return b.String(), isASCII(strings.TrimSpace(b.String()))
+}
+
+// nfkd returns the compatibility decomposition of s.
+func (g *generator) nfkd(s []rune) []rune {
+	var d []rune
+	for _, r := range s {
+		d = append(d, g.decomposeRune(r)...)
+	}
+	// Put runs of combining marks in canonical order.
+	for i := 1; i < len(d); i++ {
+		for j := i; j > 0 && g.ccc(d[j]) != 0 && g.ccc(d[j-1]) > g.ccc(d[j]); j-- {
+			d[j], d[j-1] = d[j-1], d[j]
+		}
+	}
+	return d
+}
+
+func (g *generator) decomposeRune(r rune) []rune {
+	const sBase, lBase, vBase, tBase, tCount, nCount, sCount = 0xac00, 0x1100, 0x1161, 0x11a7, 28, 588, 11172
+	if s := r - sBase; s >= 0 && s < sCount {
+		d := []rune{lBase + s/nCount, vBase + s%nCount/tCount}
+		if t := s % tCount; t != 0 {
+			d = append(d, tBase+t)
+		}
+		return d
+	}
+	c, ok := g.ucd[r]
+	if !ok || c.decomp == nil {
+		return []rune{r}
+	}
+	var d []rune
+	for _, dr := range c.decomp {
+		d = append(d, g.decomposeRune(dr)...)
+	}
+	return d
+}
+
+func (g *generator) ccc(r rune) int {
+	if c, ok := g.ucd[r]; ok {
+		return c.ccc
+	}
+	return 0
+}
+
+// fold removes the diacritics from s.
+func (g *generator) fold(s string) string {
+	b := strings.Builder{}
+	for _, r := range g.nfkd([]rune(s)) {
+		if g.ccc(r) == 0 {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+var (
+	nameKeyword = regexp.MustCompile(`\b(LETTER|SYLLABLE|SYLLABICS|LIGATURE|CHARACTER|IDEOGRAPH|LOGOGRAM|SIGN|VOWEL|CONSONANT|HIEROGLYPH|GLYPH|DIGIT|NUMBER|NUMERAL|CHOSEONG|JUNGSEONG|JONGSEONG)\b`)
+	hexName     = regexp.MustCompile(`-[0-9A-F]{4,6}$`)
+	notAlnum    = regexp.MustCompile(`[^A-Z0-9]`)
+)
+
+// nameQualifiers are the words in character names that describe the form
+// of a letter rather than its sound.
+var nameQualifiers = map[string]bool{}
+
+func init() {
+	for _, q := range strings.Fields(`SMALL CAPITAL FINAL MEDIAL INITIAL ISOLATED SUBJOINED
+		MODIFIER LETTER SIGN VOWEL CONSONANT DEAD HALF ARCHAIC OLD SYLLABLE INDEPENDENT
+		DEPENDENT REVERSED TURNED SCRIPT DOUBLE MAHAPRANA ALPAPRANA MURDA SAPA KAPAL TEDUNG
+		SASAK ABOVE BELOW LEFT RIGHT FORM VARIANT ALTERNATE SHORT LONG`) {
+		nameQualifiers[q] = true
+	}
+}
+
+// fromName transliterates a letter no transform knows from its name,
+// which ends in the sound of the letter: VAI SYLLABLE KPEE is kpee.
+func (g *generator) fromName(r rune) string {
+	name := g.ucd[r].name
+	m := nameKeyword.FindStringIndex(name)
+	if name == "" || hexName.MatchString(name) || m == nil {
+		return "[?]"
+	}
+	var words []string
+	for _, w := range strings.Fields(name[m[1]:]) {
+		if w == "WITH" {
+			break
+		}
+		if !nameQualifiers[w] {
+			words = append(words, w)
+		}
+	}
+	if len(words) == 0 {
+		return "[?]"
+	}
+	s := words[len(words)-1]
+	if strings.HasPrefix(name, "TIFINAGH") {
+		// Tifinagh letters are named after their sound with a YA or YE
+		// before it.
+		switch {
+		case s == "YA" || s == "YE" || s == "YI" || s == "YO" || s == "YU":
+			s = s[1:]
+		case strings.HasPrefix(s, "YA") || strings.HasPrefix(s, "YE"):
+			s = s[2:]
+		}
+	}
+	s = strings.ToLower(notAlnum.ReplaceAllString(s, ""))
+	if s == "" {
+		return "[?]"
+	}
+	for _, w := range strings.Fields(name) {
+		if w == "CAPITAL" {
+			return title(s)
+		}
+	}
+	return s
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] > unicode.MaxASCII {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isASCIILetter(r rune) bool {
+	return r < unicode.MaxASCII && unicode.IsLetter(r)
+}
+
+// title upper cases the first letter of every word of the ASCII string s.
+func title(s string) string {
+	b := []byte(s)
+	word := false
+	for i, c := range b {
+		letter := isASCIILetter(rune(c))
+		if letter && !word {
+			b[i] = byte(unicode.ToUpper(rune(c)))
+		} else if letter {
+			b[i] = byte(unicode.ToLower(rune(c)))
+		}
+		word = letter
+	}
+	return string(b)
+}
+
+// report writes the code points whose transliteration in tables is not
+// the one in old, the tables generated last time.
+func report(g *generator, old, tables map[rune][]string) {
+	w := io.Writer(os.Stdout)
+	if *reportFile != "" {
+		f, err := os.Create(*reportFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	var changes []string
+	for r := rune(unicode.MaxASCII); r < (lastSection+1)<<8; r++ {
+		if r >= 0xac00 && r <= 0xd7a3 {
+			continue
+		}
+		if now, next := g.lookup(old, r), g.lookup(tables, r); now != next {
+			changes = append(changes, fmt.Sprintf("%U %q: %q -> %q", r, string(r), now, next))
+		}
+	}
+	fmt.Fprintf(w, "# Unicode %s, CLDR %s: %d code points changed\n", *unicodeVersion, *cldrVersion, len(changes))
+	for _, c := range changes {
+		fmt.Fprintln(w, c)
+	}
+}
+
+// lookup returns the transliteration of r in tables the way Transliterate
+// does, with the Unicode data being generated from.
+func (g *generator) lookup(tables map[rune][]string, r rune) string {
+	if t := tables[r>>8]; int(r&0xff) < len(t) {
+		return t[r&0xff]
+	}
+	if g.isLetterOrNumber(r) {
+		return "[?]"
+	}
+	return string(r)
+}
+
+// readTables reads the tables the generated files at paths declare, so
+// that the report does not depend on the package they are part of
+// building. The tables of the families replace those of the core, as they
+// do in lookup. Files that do not exist yet have no tables.
+func readTables(paths ...string) map[rune][]string {
+	tables := map[rune][]string{}
+	for _, path := range paths {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.CompositeLit); ok {
+				if id, ok := lit.Type.(*ast.Ident); ok && id.Name == "packedTables" {
+					for section, t := range unpack(path, lit) {
+						tables[section] = t
+					}
+					return false
+				}
+			}
+			return true
+		})
+	}
+	return tables
+}
+
+// unpack returns the tables of a packedTables literal, the reverse of
+// writePacked.
+func unpack(path string, lit *ast.CompositeLit) map[rune][]string {
+	fields := map[string]ast.Expr{}
+	for _, e := range lit.Elts {
+		kv := e.(*ast.KeyValueExpr)
+		fields[kv.Key.(*ast.Ident).Name] = kv.Value
+	}
+	ints := func(e ast.Expr) []int {
+		var n []int
+		for _, e := range e.(*ast.CompositeLit).Elts {
+			if pair, ok := e.(*ast.CompositeLit); ok {
+				n = append(n, intValue(path, pair.Elts[0]), intValue(path, pair.Elts[1]))
+			} else {
+				n = append(n, intValue(path, e))
+			}
+		}
+		return n
+	}
+	sections, starts, offsets := ints(fields["sections"]), ints(fields["starts"]), ints(fields["offsets"])
+	data := stringValue(path, fields["data"])
+
+	tables := map[rune][]string{}
+	for section, i := range sections {
+		if i == 0 {
+			continue
+		}
+		// starts holds pairs: where the data of a table starts and where
+		// its offsets do.
+		start := starts[2*(i-1)]
+		o := offsets[starts[2*(i-1)+1]:starts[2*i+1]]
+		t := make([]string, len(o)-1)
+		for j := range t {
+			t[j] = data[start+o[j] : start+o[j+1]]
+		}
+		tables[rune(section)] = t
+	}
+	return tables
+}
+
+func intValue(path string, e ast.Expr) int {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		log.Fatalf("%s: %T is not an integer", path, e)
+	}
+	n, err := strconv.Atoi(lit.Value)
+	if err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+	return n
+}
+
+// stringValue returns the value of a sum of string literals.
+func stringValue(path string, e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		s, err := strconv.Unquote(e.Value)
+		if err != nil {
+			log.Fatalf("%s: %v", path, err)
+		}
+		return s
+	case *ast.BinaryExpr:
+		return stringValue(path, e.X) + stringValue(path, e.Y)
+	}
+	log.Fatalf("%s: %T is not a string", path, e)
+	return ""
+}
+
+const header = "// Code generated by go run gen.go; DO NOT EDIT.\n\n"
+
+// families are the scripts that can be left out of a build with a build
+// tag, with the blocks they are written in.
+var families = []struct {
+	name, tag, doc string
+	blocks         [][2]rune
+}{
+	{"cjk", "slugify_nocjk", "the Han and Yi scripts", [][2]rune{
+		{0x2e80, 0x2fdf},   // CJK Radicals Supplement, Kangxi Radicals
+		{0x3400, 0x4dbf},   // CJK Unified Ideographs Extension A
+		{0x4e00, 0x9fff},   // CJK Unified Ideographs
+		{0xa000, 0xa4cf},   // Yi Syllables, Yi Radicals
+		{0xf900, 0xfaff},   // CJK Compatibility Ideographs
+		{0x20000, 0x323af}, // CJK Unified Ideographs Extension B to H and Supplement
+	}},
+	{"hangul", "slugify_nohangul", "Hangul", [][2]rune{
+		{0x1100, 0x11ff}, // Hangul Jamo
+		{0x3130, 0x318f}, // Hangul Compatibility Jamo
+		{0xa960, 0xa97f}, // Hangul Jamo Extended-A
+		{0xac00, 0xd7ff}, // Hangul Syllables, Hangul Jamo Extended-B
+		{0xffa0, 0xffdc}, // Halfwidth Hangul
+	}},
+}
+
+// family returns the index in families of the family r belongs to, or -1.
+func family(r rune) int {
+	for i, f := range families {
+		for _, b := range f.blocks {
+			if r >= b[0] && r <= b[1] {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// writeTables writes the tables packed into a single string, with the
+// offsets of the transliterations in it, so that they need no
+// initialization. The tables of each family go in a file of their own that
+// its build tag leaves out, and the code points of the family in sections
+// shared with other scripts are "[?]", if they are letters or numbers, or
+// empty in the tables that are always built.
+func writeTables(g *generator, tables map[rune][]string) {
+	core := map[rune][]string{}
+	split := make([]map[rune][]string, len(families))
+	for i := range split {
+		split[i] = map[rune][]string{}
+	}
+	for section, t := range tables {
+		owner := -2
+		for i := range t {
+			r := section<<8 + rune(i)
+			if g.category(r) == "Cn" {
+				continue
+			}
+			if f := family(r); owner == -2 {
+				owner = f
+			} else if f != owner {
+				owner = -1
+			}
+		}
+		if owner >= 0 {
+			split[owner][section] = t
+			continue
+		}
+		c := append([]string(nil), t...)
+		for i := range c {
+			r := section<<8 + rune(i)
+			if f := family(r); f >= 0 {
+				split[f][section] = t
+				c[i] = ""
+				if g.isLetterOrNumber(r) {
+					c[i] = "[?]"
+				}
+			}
+		}
+		core[section] = c
+	}
+
+	b := &bytes.Buffer{}
+	b.WriteString(header)
+	b.WriteString("package transliterations\n\n")
+	fmt.Fprintf(b, "// UnicodeVersion is the version of Unicode the tables were generated from.\nconst UnicodeVersion = %q\n\n", *unicodeVersion)
+	fmt.Fprintf(b, "// CLDRVersion is the version of the CLDR transforms the tables were\n// generated from.\nconst CLDRVersion = %q\n\n", *cldrVersion)
+	b.WriteString("// coreTables has the tables of the scripts that are always built.\n")
+	writePacked(b, "coreTables", core)
+	write("tables.go", b.Bytes())
+
+	for i, f := range families {
+		b := &bytes.Buffer{}
+		fmt.Fprintf(b, "// +build !%s\n\n", f.tag)
+		b.WriteString(header)
+		b.WriteString("package transliterations\n\n")
+		fmt.Fprintf(b, "// %sTables has the tables of %s, which the %s build tag\n// leaves out.\n", f.name, f.doc, f.tag)
+		writePacked(b, f.name+"Tables", split[i])
+		write("tables_"+f.name+".go", b.Bytes())
+	}
+}
+
+// writeNames writes the names, in lowercase, of the characters the tables
+// have no transliteration for, leaving out marks, separators and other
+// characters that slugs drop anyway, and the ones named after their code
+// point.
+func writeNames(g *generator, tables map[rune][]string) {
+	names := map[rune][]string{}
+	for r := rune(unicode.MaxASCII); r <= lastSection<<8|0xff; r++ {
+		c, ok := g.ucd[r]
+		if !ok || c.name == "" || strings.HasPrefix(c.name, "<") || hexName.MatchString(c.name) {
+			continue
+		}
+		switch c.category[0] {
+		case 'C', 'M', 'Z':
+			continue
+		}
+		section, i := r>>8, int(r&0xff)
+		if t := tables[section]; i < len(t) && t[i] != "" && t[i] != "[?]" {
+			continue
+		}
+		if names[section] == nil {
+			names[section] = make([]string, 256)
+		}
+		names[section][i] = strings.ToLower(c.name)
+	}
+	for section, t := range names {
+		for len(t) > 0 && t[len(t)-1] == "" {
+			t = t[:len(t)-1]
+		}
+		names[section] = t
+	}
+
+	b := &bytes.Buffer{}
+	b.WriteString(header)
+	b.WriteString("package transliterations\n\n")
+	b.WriteString("// nameTables has the names of the symbols the tables have no\n// transliteration for.\n")
+	writePacked(b, "nameTables", names)
+	write("tables_names.go", b.Bytes())
+}
+
+// writeEmoji writes the CLDR short names of the emoji in emoji-test.txt,
+// sorted by their sequence of code points. Skin tones are left out of both,
+// so that an emoji has the same name whatever its skin tone, and so are the
+// emoji written without a variation selector that the tables already have
+// a transliteration for, like the copyright sign.
+func writeEmoji(tables map[rune][]string, path string) {
+	names := map[string]string{}
+	scan(path, func(line string) {
+		f := strings.SplitN(line, ";", 2)
+		if len(f) != 2 {
+			log.Fatalf("%s: bad line %q", path, line)
+		}
+		var seq []rune
+		for _, s := range strings.Fields(f[0]) {
+			if r := parseCodePoint(s); r < 0x1f3fb || r > 0x1f3ff {
+				seq = append(seq, r)
+			}
+		}
+		comment := strings.SplitN(f[1], "#", 2)
+		desc := strings.Fields(comment[1])
+		if len(seq) == 0 || len(desc) < 3 || names[string(seq)] != "" {
+			return
+		}
+		if status := strings.TrimSpace(comment[0]); status == "unqualified" && len(seq) == 1 {
+			if s := lookupTable(tables, seq[0]); s != "" && s != "[?]" {
+				return
+			}
+		}
+		names[string(seq)] = emojiName(tables, strings.Join(desc[2:], " "))
+	})
+
+	var seqs []string
+	for seq := range names {
+		seqs = append(seqs, seq)
+	}
+	sort.Strings(seqs)
+
+	b := &bytes.Buffer{}
+	b.WriteString(header)
+	b.WriteString("package transliterations\n\n")
+	fmt.Fprintf(b, "// EmojiVersion is the version of the emoji data the emoji names were\n// generated from.\nconst EmojiVersion = %q\n\n", *emojiVersion)
+	b.WriteString("// emojiEnds has for every emoji where its sequence ends in\n// emojiSequences and where its name ends in emojiNames.\n")
+	b.WriteString("var emojiEnds = []emojiEnd{")
+	seqEnd, nameEnd := 0, 0
+	for i, seq := range seqs {
+		if i%8 == 0 {
+			b.WriteString("\n")
+		}
+		seqEnd += len(seq)
+		nameEnd += len(names[seq])
+		fmt.Fprintf(b, "{%d, %d},", seqEnd, nameEnd)
+	}
+	b.WriteString("\n}\n\n")
+	b.WriteString("// emojiSequences has the sequences of the emoji, without skin tones, in\n// order.\nconst emojiSequences = \"\" +\n")
+	for i := 0; i < len(seqs); i += 16 {
+		end := i + 16
+		if end > len(seqs) {
+			end = len(seqs)
+		}
+		fmt.Fprintf(b, "%+q +\n", strings.Join(seqs[i:end], ""))
+	}
+	b.WriteString("\"\"\n\n")
+	b.WriteString("// emojiNames has the names of the emoji, in the order of their\n// sequences.\nconst emojiNames = \"\" +\n")
+	for i := 0; i < len(seqs); i += 16 {
+		end := i + 16
+		if end > len(seqs) {
+			end = len(seqs)
+		}
+		var n []string
+		for _, seq := range seqs[i:end] {
+			n = append(n, names[seq])
+		}
+		fmt.Fprintf(b, "%q +\n", strings.Join(n, ""))
+	}
+	b.WriteString("\"\"\n")
+	write("tables_emoji.go", b.Bytes())
+}
+
+// emojiName returns name, the CLDR short name of an emoji, without skin
+// tones and the flag: prefix of flags, in ASCII.
+func emojiName(tables map[rune][]string, name string) string {
+	name = strings.TrimPrefix(name, "flag: ")
+	if i := strings.Index(name, ": "); i >= 0 {
+		var keep []string
+		for _, part := range strings.Split(name[i+2:], ", ") {
+			if !strings.HasSuffix(part, "skin tone") {
+				keep = append(keep, part)
+			}
+		}
+		name = name[:i]
+		if len(keep) > 0 {
+			name += ": " + strings.Join(keep, ", ")
+		}
+	}
+	b := strings.Builder{}
+	for _, r := range name {
+		if r < unicode.MaxASCII {
+			b.WriteRune(r)
+		} else if s := lookupTable(tables, r); s != "[?]" {
+			b.WriteString(s)
+		}
+	}
+	return b.String()
+}
+
+// lookupTable returns the transliteration of r in tables.
+func lookupTable(tables map[rune][]string, r rune) string {
+	if t := tables[r>>8]; int(r&0xff) < len(t) {
+		return t[r&0xff]
+	}
+	return ""
+}
+
+// writePacked writes the declaration of a packedTables variable called
+// name holding tables.
+func writePacked(b *bytes.Buffer, name string, tables map[rune][]string) {
+	var sections []rune
+	for section := range tables {
+		sections = append(sections, section)
+	}
+	sort.Slice(sections, func(i, j int) bool { return sections[i] < sections[j] })
+
+	fmt.Fprintf(b, "var %s = &packedTables{\n", name)
+	index := make([]int, sections[len(sections)-1]+1)
+	for i, section := range sections {
+		index[section] = i + 1
+	}
+	b.WriteString("sections: []uint16{")
+	for section, i := range index {
+		if section%16 == 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(b, "%d,", i)
+	}
+	b.WriteString("\n},\n")
+
+	var offsets []int
+	size, count := 0, 0
+	b.WriteString("starts: []tableStart{\n")
+	for _, section := range sections {
+		fmt.Fprintf(b, "{%d, %d}, // 0x%03x\n", size, count, section)
+		start := size
+		for _, s := range tables[section] {
+			offsets = append(offsets, size-start)
+			size += len(s)
+		}
+		offsets = append(offsets, size-start)
+		if size-start > 0xffff {
+			log.Fatalf("section 0x%03x is too large", section)
+		}
+		count += len(tables[section]) + 1
+	}
+	fmt.Fprintf(b, "{%d, %d},\n},\n", size, count)
+
+	b.WriteString("offsets: []uint16{")
+	for i, o := range offsets {
+		if i%16 == 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(b, "%d,", o)
+	}
+	b.WriteString("\n},\n")
+
+	var chunks []string
+	for _, section := range sections {
+		t := tables[section]
+		for i := 0; i < len(t); i += 16 {
+			end := i + 16
+			if end > len(t) {
+				end = len(t)
+			}
+			if s := strings.Join(t[i:end], ""); s != "" {
+				chunks = append(chunks, fmt.Sprintf("%q + // U+%04X\n", s, section<<8+rune(i)))
+			}
+		}
+	}
+	chunks[len(chunks)-1] = strings.Replace(chunks[len(chunks)-1], " + //", ", //", 1)
+	b.WriteString("data: ")
+	for _, c := range chunks {
+		b.WriteString(c)
+	}
+	b.WriteString("}\n")
+}
+
+func write(path string, src []byte) {
+	src, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+	if err := ioutil.WriteFile(path, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,296 @@
+package transliterations
+
+import "strings"
+
+// HangulSystem selects how Hangul is romanized.
+type HangulSystem int
+
+const (
+	// RevisedRomanization is the official romanization of South Korea.
+	RevisedRomanization HangulSystem = iota
+	// McCuneReischauer is the older system that marks aspiration with an
+	// apostrophe, writes ㅓ and ㅡ with a breve and voices ㄱ, ㄷ, ㅂ and ㅈ
+	// between voiced sounds.
+	McCuneReischauer
+)
+
+const (
+	hangulFirst = 0xac00
+	hangulLast  = 0xd7a3
+	vowelCount  = 21
+	finalCount  = 28
+)
+
+// Initial consonants, in the order they are encoded in a syllable.
+const (
+	iG = iota // ㄱ
+	iKK
+	iN
+	iD
+	iTT
+	iR
+	iM
+	iB
+	iPP
+	iS
+	iSS
+	iNone // ㅇ
+	iJ
+	iJJ
+	iCH
+	iK
+	iT
+	iP
+	iH
+)
+
+// Final consonants, in the order they are encoded in a syllable.
+const (
+	fNone = iota
+	fG    // ㄱ
+	fKK
+	fGS
+	fN
+	fNJ
+	fNH
+	fD
+	fL
+	fLG
+	fLM
+	fLB
+	fLS
+	fLT
+	fLP
+	fLH
+	fM
+	fB
+	fBS
+	fS
+	fSS
+	fNG
+	fJ
+	fCH
+	fK
+	fT
+	fP
+	fH
+)
+
+const (
+	vowelWI = 16 // ㅟ
+	vowelI  = 20 // ㅣ
+)
+
+// Codas, the sounds a final consonant is pronounced as before another
+// consonant or at the end of a word.
+const (
+	codaNone = iota
+	codaK
+	codaN
+	codaT
+	codaL
+	codaM
+	codaP
+	codaNG
+)
+
+// finalParts gives, for every final consonant, the initial consonants
+// it is made of, the second one being -1 for a single consonant.
+var finalParts = [finalCount][2]int{
+	{-1, -1}, {iG, -1}, {iKK, -1}, {iG, iS}, {iN, -1}, {iN, iJ}, {iN, iH},
+	{iD, -1}, {iR, -1}, {iR, iG}, {iR, iM}, {iR, iB}, {iR, iS}, {iR, iT},
+	{iR, iP}, {iR, iH}, {iM, -1}, {iB, -1}, {iB, iS}, {iS, -1}, {iSS, -1},
+	{iNone, -1}, {iJ, -1}, {iCH, -1}, {iK, -1}, {iT, -1}, {iP, -1}, {iH, -1},
+}
+
+var finalCodas = [finalCount]int{
+	codaNone, codaK, codaK, codaK, codaN, codaN, codaN,
+	codaT, codaL, codaK, codaM, codaL, codaL, codaL,
+	codaP, codaL, codaM, codaP, codaP, codaT, codaT,
+	codaNG, codaT, codaT, codaK, codaT, codaP, codaT,
+}
+
+// initialCodas is the coda an initial consonant is pronounced as when it
+// is all that is left of a final.
+var initialCodas = [19]int{
+	codaK, codaK, codaN, codaT, codaT, codaL, codaM, codaP, codaP, codaT,
+	codaT, codaNG, codaT, codaT, codaT, codaK, codaT, codaP, codaT,
+}
+
+var rrInitials = [19]string{
+	"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s",
+	"ss", "", "j", "jj", "ch", "k", "t", "p", "h",
+}
+
+var rrVowels = [vowelCount]string{
+	"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae",
+	"oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i",
+}
+
+var mrInitials = [19]string{
+	"k", "kk", "n", "t", "tt", "r", "m", "p", "pp", "s",
+	"ss", "", "ch", "tch", "ch'", "k'", "t'", "p'", "h",
+}
+
+var mrVoiced = map[int]string{iG: "g", iD: "d", iB: "b", iJ: "j"}
+
+var mrVowels = [vowelCount]string{
+	"a", "ae", "ya", "yae", "ŏ", "e", "yŏ", "ye", "o", "wa", "wae",
+	"oe", "yo", "u", "wŏ", "we", "wi", "yu", "ŭ", "ŭi", "i",
+}
+
+var codaLetters = [...]string{"", "k", "n", "t", "l", "m", "p", "ng"}
+
+// syllable is a Hangul syllable as it is pronounced in context: the
+// initial it is read with, its vowel and the coda it ends with.
+type syllable struct {
+	initial, vowel, coda int
+}
+
+func isHangul(r rune) bool {
+	return r >= hangulFirst && r <= hangulLast
+}
+
+// romanizesHangul reports whether r is a Hangul syllable that
+// Transliterate and profiles romanize, which they do unless the build
+// leaves Hangul out.
+func romanizesHangul(r rune) bool {
+	return hangulTables != nil && isHangul(r)
+}
+
+// RomanizeHangul romanizes a run of Hangul syllables with system,
+// applying the sound changes across syllable boundaries, so 신라 becomes
+// silla and 독립 dongnip. Runes that are not Hangul syllables end a word.
+func RomanizeHangul(text string, system HangulSystem) string {
+	b := strings.Builder{}
+	var word []rune
+	for _, r := range text {
+		if isHangul(r) {
+			word = append(word, r)
+			continue
+		}
+		b.WriteString(romanizeHangulWord(word, system))
+		word = word[:0]
+		b.WriteString(Transliterate(r))
+	}
+	b.WriteString(romanizeHangulWord(word, system))
+	return b.String()
+}
+
+func romanizeHangulWord(word []rune, system HangulSystem) string {
+	syllables := make([]syllable, len(word))
+	finals := make([]int, len(word))
+	for i, r := range word {
+		s := int(r - hangulFirst)
+		syllables[i] = syllable{
+			initial: s / (vowelCount * finalCount),
+			vowel:   s % (vowelCount * finalCount) / finalCount,
+			coda:    finalCodas[s%finalCount],
+		}
+		finals[i] = s % finalCount
+	}
+	for i := 0; i+1 < len(syllables); i++ {
+		assimilate(&syllables[i], &syllables[i+1], finals[i])
+	}
+
+	b := strings.Builder{}
+	for i, s := range syllables {
+		prev := codaNone
+		voiced := false
+		if i > 0 {
+			prev = syllables[i-1].coda
+			voiced = prev == codaNone || prev == codaN || prev == codaL || prev == codaM || prev == codaNG
+		}
+		b.WriteString(initialLetters(s, prev, voiced, system))
+		if system == McCuneReischauer {
+			b.WriteString(mrVowels[s.vowel])
+		} else {
+			b.WriteString(rrVowels[s.vowel])
+		}
+		b.WriteString(codaLetters[s.coda])
+	}
+	return b.String()
+}
+
+// assimilate applies the sound changes between the final consonant of a
+// and the initial of b.
+func assimilate(a, b *syllable, final int) {
+	if final == fNone {
+		return
+	}
+
+	if b.initial == iNone && final != fNG {
+		// Liaison: the final, or the second half of a double final,
+		// moves over to the next syllable. ㅎ is silent, letting the
+		// consonant before it move instead.
+		first, second := finalParts[final][0], finalParts[final][1]
+		moved := first
+		a.coda = codaNone
+		if second >= 0 && second != iH {
+			moved = second
+			a.coda = initialCodas[first]
+		}
+		switch {
+		case moved == iH:
+			return
+		case moved == iD && b.vowel == vowelI:
+			moved = iJ
+		case moved == iT && b.vowel == vowelI:
+			moved = iCH
+		}
+		b.initial = moved
+		return
+	}
+
+	switch {
+	case (final == fH || final == fNH || final == fLH) && (b.initial == iG || b.initial == iD || b.initial == iJ):
+		// ㅎ aspirates the consonant after it.
+		b.initial = map[int]int{iG: iK, iD: iT, iJ: iCH}[b.initial]
+		a.coda = map[int]int{fH: codaNone, fNH: codaN, fLH: codaL}[final]
+	case final == fH && b.initial == iS:
+		a.coda, b.initial = codaNone, iSS
+	case final == fD && b.initial == iH && b.vowel == vowelI:
+		a.coda, b.initial = codaNone, iCH
+	case (a.coda == codaK || a.coda == codaT || a.coda == codaP) && (b.initial == iN || b.initial == iM):
+		a.coda = nasal(a.coda)
+	case (a.coda == codaK || a.coda == codaT || a.coda == codaP) && b.initial == iR:
+		a.coda, b.initial = nasal(a.coda), iN
+	case (a.coda == codaM || a.coda == codaNG) && b.initial == iR:
+		b.initial = iN
+	case a.coda == codaN && b.initial == iR:
+		a.coda = codaL
+	case a.coda == codaL && b.initial == iN:
+		b.initial = iR
+	}
+}
+
+func nasal(coda int) int {
+	switch coda {
+	case codaK:
+		return codaNG
+	case codaT:
+		return codaN
+	case codaP:
+		return codaM
+	}
+	return coda
+}
+
+// initialLetters romanizes the initial of s, which comes after a syllable
+// ending in prev. ㄹ is written l after an l, and McCune–Reischauer
+// voices plain stops between voiced sounds and writes ㅅ before i as sh.
+func initialLetters(s syllable, prev int, voiced bool, system HangulSystem) string {
+	if s.initial == iR && prev == codaL {
+		return "l"
+	}
+	if system != McCuneReischauer {
+		return rrInitials[s.initial]
+	}
+	if v, ok := mrVoiced[s.initial]; ok && voiced {
+		return v
+	}
+	if s.initial == iS && (s.vowel == vowelI || s.vowel == vowelWI) {
+		return "sh"
+	}
+	return mrInitials[s.initial]
+}
@@ -0,0 +1,146 @@
+package transliterations
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// JapaneseDictionary gives kanji their Japanese readings, so that they
+// are not romanized as Mandarin.
+//
+// A run of kanji is read by taking the longest word in Words at every
+// position. Kanji that start no word are read with Kanji, and a run of
+// them is read as one word, the way compounds are. Kanji that are in
+// neither are left to Transliterate.
+type JapaneseDictionary struct {
+	// Words maps words to their reading in hiragana. A word may end in
+	// kana, as 食べる does. Words of a single kanji are only used when the
+	// kanji stands alone, as in 山の上, since in compounds kanji are read
+	// differently.
+	Words map[string]string
+	// Kanji maps single kanji to the reading they usually take in
+	// compounds, in hiragana.
+	Kanji map[rune]string
+}
+
+// maxWordRunes bounds the length of the words looked up in Words.
+const maxWordRunes = 8
+
+// isHan reports whether r is a kanji or the 々 iteration mark.
+func isHan(r rune) bool {
+	return unicode.Is(unicode.Han, r)
+}
+
+// hanRun returns the length in bytes of the run of kanji text starts
+// with.
+func hanRun(text string) int {
+	for i, r := range text {
+		if !isHan(r) {
+			return i
+		}
+	}
+	return len(text)
+}
+
+// word returns the length in bytes and the reading of the longest word in
+// d.Words text starts with, or 0 if there is none. Words are made of
+// kanji and kana, so the lookup stops at the first rune that is neither.
+// Single kanji are only looked up when alone is true.
+func (d *JapaneseDictionary) word(text string, alone bool) (n int, reading string) {
+	var ends [maxWordRunes]int
+	count := 0
+	for i := 0; i < len(text) && count < maxWordRunes; {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if !isHan(r) && !isKana(r) {
+			break
+		}
+		i += size
+		ends[count] = i
+		count++
+	}
+	for ; count > 0; count-- {
+		if count == 1 && !alone {
+			break
+		}
+		if reading, ok := d.Words[text[:ends[count-1]]]; ok {
+			return ends[count-1], reading
+		}
+	}
+	return 0, ""
+}
+
+// writeJapanese writes the reading of the kanji text starts with, which
+// come after prev, and returns the length in bytes it read. That may go
+// past the kanji when a word ends in kana.
+func writeJapanese(b *strings.Builder, text string, prev rune, d *JapaneseDictionary, system KanaSystem, apostrophe bool) int {
+	var words []string
+	compound := ""
+	flush := func() {
+		if compound != "" {
+			words = append(words, romanizeReading(compound, system, apostrophe))
+			compound = ""
+		}
+	}
+
+	run := hanRun(text)
+	alone := !isHan(prev) && utf8.RuneCountInString(text[:run]) == 1
+	i, last := 0, ""
+	for i < run {
+		if n, reading := d.word(text[i:], alone); n > 0 {
+			flush()
+			words = append(words, romanizeReading(reading, system, apostrophe))
+			i += n
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch reading, ok := d.Kanji[r]; {
+		case ok:
+			compound += reading
+			last = reading
+		case r == '々' && last != "":
+			compound += last
+		default:
+			flush()
+			words = append(words, strings.TrimSpace(Transliterate(r)))
+			last = ""
+		}
+		i += size
+	}
+	flush()
+
+	if isLetterOrNumber(prev) && !strings.HasSuffix(b.String(), " ") {
+		b.WriteByte(' ')
+	}
+	b.WriteString(strings.Join(words, " "))
+	if next, _ := utf8.DecodeRuneInString(text[i:]); isLetterOrNumber(next) {
+		b.WriteByte(' ')
+	}
+	return i
+}
+
+// romanizeReading romanizes a reading in hiragana, marking the long
+// vowels written with う and お as if they had been written with ー, so
+// とうきょう becomes tōkyō.
+func romanizeReading(reading string, system KanaSystem, apostrophe bool) string {
+	runes := []rune(reading)
+	for i := 1; i < len(runes); i++ {
+		before := kanaRomaji[runes[i-1]]
+		if runes[i] == 'う' && (strings.HasSuffix(before, "o") || strings.HasSuffix(before, "u")) ||
+			runes[i] == 'お' && strings.HasSuffix(before, "o") {
+			runes[i] = 'ー'
+		}
+	}
+	return romanizeKanaWord(string(runes), system, apostrophe)
+}
+
+// containsKana reports whether text has any hiragana or katakana, which
+// only Japanese is written with.
+func containsKana(text string) bool {
+	for _, r := range text {
+		if isKana(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+//go:build !slugify_nocjk
+// +build !slugify_nocjk
+
+package transliterations
+
+// JapaneseWords is the dictionary used for Japanese text. It covers common
+// words and place names, and the compound readings of the most frequent
+// kanji; anything more needs a dictionary of its own.
+var JapaneseWords = &JapaneseDictionary{
+	Words: map[string]string{
+		// Places
+		"日本": "にほん", "東京": "とうきょう", "東京都": "とうきょうと",
+		"京都": "きょうと", "大阪": "おおさか", "北海道": "ほっかいどう",
+		"沖縄": "おきなわ", "横浜": "よこはま", "名古屋": "なごや",
+		"福岡": "ふくおか", "神戸": "こうべ", "札幌": "さっぽろ",
+		"広島": "ひろしま", "奈良": "なら", "仙台": "せんだい",
+		"富士山": "ふじさん", "中国": "ちゅうごく", "韓国": "かんこく",
+		"外国": "がいこく", "世界": "せかい",
+
+		// Languages and people
+		"日本語": "にほんご", "日本人": "にほんじん", "英語": "えいご",
+		"中国語": "ちゅうごくご", "外国人": "がいこくじん",
+		"漢字": "かんじ", "平仮名": "ひらがな", "片仮名": "かたかな",
+		"言葉": "ことば", "名前": "なまえ", "私": "わたし", "僕": "ぼく",
+		"彼": "かれ", "彼女": "かのじょ", "人": "ひと", "人々": "ひとびと",
+		"子供": "こども", "友達": "ともだち", "家族": "かぞく",
+		"男": "おとこ", "女": "おんな", "男の子": "おとこのこ",
+		"女の子": "おんなのこ", "先生": "せんせい", "学生": "がくせい",
+		"医者": "いしゃ", "会社員": "かいしゃいん", "初心者": "しょしんしゃ",
+
+		// Time
+		"今日": "きょう", "明日": "あした", "昨日": "きのう",
+		"今年": "ことし", "去年": "きょねん", "来年": "らいねん",
+		"毎日": "まいにち", "時間": "じかん", "時々": "ときどき",
+		"日曜日": "にちようび", "月曜日": "げつようび", "火曜日": "かようび",
+		"水曜日": "すいようび", "木曜日": "もくようび", "金曜日": "きんようび",
+		"土曜日": "どようび", "週末": "しゅうまつ", "誕生日": "たんじょうび",
+		"春": "はる", "夏": "なつ", "秋": "あき", "冬": "ふゆ",
+		"朝": "あさ", "昼": "ひる", "夜": "よる", "今": "いま",
+		"季節": "きせつ", "時代": "じだい",
+
+		// Places in a town
+		"学校": "がっこう", "大学": "だいがく", "会社": "かいしゃ",
+		"駅": "えき", "病院": "びょういん", "銀行": "ぎんこう",
+		"図書館": "としょかん", "公園": "こうえん", "神社": "じんじゃ",
+		"寺": "てら", "家": "いえ", "店": "みせ", "空港": "くうこう",
+		"場所": "ばしょ",
+
+		// Getting around
+		"電話": "でんわ", "電車": "でんしゃ", "自転車": "じてんしゃ",
+		"自動車": "じどうしゃ", "車": "くるま", "新幹線": "しんかんせん",
+		"飛行機": "ひこうき", "地下鉄": "ちかてつ", "旅行": "りょこう",
+
+		// Nature
+		"山": "やま", "川": "かわ", "海": "うみ", "空": "そら",
+		"花": "はな", "雨": "あめ", "雪": "ゆき", "水": "みず",
+		"火": "ひ", "木": "き", "月": "つき", "星": "ほし",
+		"風": "かぜ", "光": "ひかり", "桜": "さくら", "猫": "ねこ",
+		"犬": "いぬ", "自然": "しぜん", "天気": "てんき",
+		"太陽": "たいよう", "地球": "ちきゅう", "宇宙": "うちゅう",
+		"環境": "かんきょう",
+
+		// Everyday words
+		"手紙": "てがみ", "本": "ほん", "本当": "ほんとう",
+		"新聞": "しんぶん", "写真": "しゃしん", "映画": "えいが",
+		"音楽": "おんがく", "料理": "りょうり", "食事": "しょくじ",
+		"寿司": "すし", "お茶": "おちゃ", "日本酒": "にほんしゅ",
+		"仕事": "しごと", "勉強": "べんきょう", "練習": "れんしゅう",
+		"元気": "げんき", "心": "こころ", "愛": "あい", "夢": "ゆめ",
+		"日記": "にっき", "時計": "とけい", "休み": "やすみ",
+		"結婚": "けっこん", "生活": "せいかつ", "人生": "じんせい",
+		"東": "ひがし", "西": "にし", "南": "みなみ", "北": "きた",
+		"上": "うえ", "下": "した", "中": "なか", "外": "そと",
+		"一": "いち", "二": "に", "三": "さん", "四": "よん",
+		"五": "ご", "六": "ろく", "七": "なな", "八": "はち",
+		"九": "きゅう", "十": "じゅう", "百": "ひゃく", "千": "せん",
+		"万": "まん", "円": "えん",
+
+		// Verbs and adjectives with their okurigana
+		"食べる": "たべる", "見る": "みる", "来る": "くる",
+		"行く": "いく", "書く": "かく", "読む": "よむ",
+		"話す": "はなす", "聞く": "きく", "作る": "つくる",
+		"新しい": "あたらしい", "古い": "ふるい", "大きい": "おおきい",
+		"小さい": "ちいさい", "高い": "たかい", "安い": "やすい",
+		"美しい": "うつくしい", "楽しい": "たのしい", "好き": "すき",
+
+		// Society and work
+		"経済": "けいざい", "政治": "せいじ", "社会": "しゃかい",
+		"文化": "ぶんか", "歴史": "れきし", "技術": "ぎじゅつ",
+		"情報": "じょうほう", "開発": "かいはつ", "研究": "けんきゅう",
+		"問題": "もんだい", "質問": "しつもん", "説明": "せつめい",
+		"方法": "ほうほう", "会議": "かいぎ", "試験": "しけん",
+		"準備": "じゅんび", "予約": "よやく", "案内": "あんない",
+		"紹介": "しょうかい", "入門": "にゅうもん", "基本": "きほん",
+
+		// Words found on web sites
+		"記事": "きじ", "最新": "さいしん", "更新": "こうしん",
+		"発表": "はっぴょう", "公式": "こうしき", "一覧": "いちらん",
+		"検索": "けんさく", "設定": "せってい", "登録": "とうろく",
+		"無料": "むりょう", "商品": "しょうひん", "価格": "かかく",
+		"注文": "ちゅうもん", "配送": "はいそう", "会員": "かいいん",
+		"お知らせ": "おしらせ", "新着": "しんちゃく",
+	},
+	Kanji: map[rune]string{
+		'一': "いち", '二': "に", '三': "さん", '四': "し", '五': "ご",
+		'六': "ろく", '七': "しち", '八': "はち", '九': "く", '十': "じゅう",
+		'百': "ひゃく", '千': "せん", '万': "まん", '円': "えん", '年': "ねん",
+		'月': "げつ", '日': "にち", '火': "か", '水': "すい", '木': "もく",
+		'金': "きん", '土': "ど", '曜': "よう", '時': "じ", '分': "ぶん",
+		'半': "はん", '週': "しゅう", '毎': "まい", '今': "こん", '先': "せん",
+		'後': "ご", '前': "ぜん", '午': "ご", '上': "じょう", '下': "か",
+		'中': "ちゅう", '外': "がい", '内': "ない", '左': "さ", '右': "う",
+		'東': "とう", '西': "せい", '南': "なん", '北': "ほく", '大': "だい",
+		'小': "しょう", '高': "こう", '安': "あん", '新': "しん", '古': "こ",
+		'長': "ちょう", '多': "た", '少': "しょう", '白': "はく", '黒': "こく",
+		'赤': "せき", '青': "せい", '人': "じん", '男': "だん", '女': "じょ",
+		'子': "し", '父': "ふ", '母': "ぼ", '友': "ゆう", '名': "めい",
+		'生': "せい", '学': "がく", '校': "こう", '会': "かい", '社': "しゃ",
+		'員': "いん", '店': "てん", '駅': "えき", '道': "どう", '車': "しゃ",
+		'電': "でん", '気': "き", '話': "わ", '語': "ご", '文': "ぶん",
+		'字': "じ", '本': "ほん", '書': "しょ", '読': "どく", '聞': "ぶん",
+		'見': "けん", '言': "げん", '行': "こう", '来': "らい", '出': "しゅつ",
+		'入': "にゅう", '帰': "き", '休': "きゅう", '食': "しょく", '飲': "いん",
+		'買': "ばい", '売': "ばい", '作': "さく", '使': "し", '住': "じゅう",
+		'知': "ち", '思': "し", '考': "こう", '持': "じ", '待': "たい",
+		'開': "かい", '閉': "へい", '始': "し", '終': "しゅう", '発': "はつ",
+		'着': "ちゃく", '近': "きん", '遠': "えん", '国': "こく", '都': "と",
+		'市': "し", '町': "ちょう", '村': "そん", '県': "けん", '区': "く",
+		'地': "ち", '図': "ず", '場': "じょう", '所': "しょ", '家': "か",
+		'室': "しつ", '屋': "おく", '門': "もん", '館': "かん", '院': "いん",
+		'病': "びょう", '医': "い", '薬': "やく", '体': "たい", '手': "しゅ",
+		'足': "そく", '目': "もく", '耳': "じ", '口': "こう", '心': "しん",
+		'頭': "とう", '顔': "がん", '声': "せい", '色': "しょく", '形': "けい",
+		'物': "ぶつ", '事': "じ", '品': "ひん", '者': "しゃ", '方': "ほう",
+		'法': "ほう", '問': "もん", '題': "だい", '答': "とう", '試': "し",
+		'験': "けん", '勉': "べん", '強': "きょう", '教': "きょう", '習': "しゅう",
+		'研': "けん", '究': "きゅう", '意': "い", '味': "み", '音': "おん",
+		'楽': "がく", '歌': "か", '画': "が", '写': "しゃ", '真': "しん",
+		'映': "えい", '旅': "りょ", '世': "せ", '界': "かい", '代': "だい",
+		'自': "じ", '然': "ぜん", '天': "てん", '空': "くう", '海': "かい",
+		'山': "さん", '川': "せん", '花': "か", '雨': "う", '雪': "せつ",
+		'風': "ふう", '春': "しゅん", '夏': "か", '秋': "しゅう", '冬': "とう",
+		'朝': "ちょう", '昼': "ちゅう", '夜': "や", '明': "めい", '暗': "あん",
+		'光': "こう", '星': "せい", '政': "せい", '治': "じ", '経': "けい",
+		'済': "ざい", '業': "ぎょう", '工': "こう", '産': "さん", '商': "しょう",
+		'農': "のう", '技': "ぎ", '術': "じゅつ", '情': "じょう", '報': "ほう",
+		'通': "つう", '信': "しん", '送': "そう", '運': "うん", '動': "どう",
+		'転': "てん", '働': "どう", '仕': "し", '務': "む", '部': "ぶ",
+		'課': "か", '議': "ぎ", '選': "せん", '挙': "きょ", '民': "みん",
+		'主': "しゅ", '公': "こう", '共': "きょう", '私': "し", '利': "り",
+		'用': "よう", '便': "べん", '性': "せい", '的': "てき", '化': "か",
+		'全': "ぜん", '合': "ごう", '同': "どう", '記': "き", '録': "ろく",
+		'最': "さい", '重': "じゅう", '要': "よう", '必': "ひつ", '特': "とく",
+		'別': "べつ", '関': "かん", '係': "けい", '感': "かん", '想': "そう",
+		'愛': "あい", '好': "こう", '悪': "あく", '美': "び", '元': "げん",
+		'和': "わ", '漢': "かん", '英': "えい", '京': "きょう", '阪': "はん",
+		'神': "しん", '島': "とう", '州': "しゅう", '港': "こう", '料': "りょう",
+		'理': "り", '茶': "ちゃ", '酒': "しゅ", '肉': "にく", '魚': "ぎょ",
+		'米': "べい", '飯': "はん", '野': "や", '菜': "さい", '果': "か",
+		'鉄': "てつ", '線': "せん", '号': "ごう", '番': "ばん", '数': "すう",
+		'計': "けい", '算': "さん", '価': "か", '格': "かく", '値': "ち",
+		'無': "む", '有': "ゆう", '不': "ふ", '非': "ひ", '未': "み",
+		'再': "さい", '初': "しょ", '第': "だい", '次': "じ", '回': "かい",
+		'度': "ど", '台': "だい", '個': "こ", '点': "てん", '面': "めん",
+		'表': "ひょう", '示': "じ", '検': "けん", '索': "さく", '設': "せつ",
+		'定': "てい", '登': "とう", '案': "あん", '紹': "しょう", '介': "かい",
+		'紙': "し", '説': "せつ", '期': "き", '間': "かん",
+	},
+}
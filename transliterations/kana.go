@@ -0,0 +1,380 @@
+package transliterations
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// KanaSystem selects how Japanese kana are romanized.
+type KanaSystem int
+
+const (
+	// Hepburn writes kana the way they sound to an English speaker: shi,
+	// chi, tsu, fu, ja. Long vowels marked with ー get a macron, and the
+	// particle を is written wo, as in traditional Hepburn, so that it
+	// stays apart from お.
+	Hepburn KanaSystem = iota
+	// KunreiShiki is the system taught in Japanese schools: si, ti, tu,
+	// hu, zya. Long vowels get a circumflex.
+	KunreiShiki
+	// NihonShiki follows the kana table strictly, also keeping ぢ and づ
+	// apart from じ and ず as di and du.
+	NihonShiki
+)
+
+// kanaRomaji holds the Hepburn romanization of the hiragana, katakana
+// being looked up by their hiragana counterpart.
+var kanaRomaji = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "i", 'ゑ': "e", 'を': "wo", 'ん': "n",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ゔ': "vu", 'ゕ': "ka", 'ゖ': "ke",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+	'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo", 'ゎ': "wa", 'っ': "",
+	'ヷ': "va", 'ヸ': "vi", 'ヹ': "ve", 'ヺ': "vo",
+}
+
+// kunreiRomaji and nihonRomaji hold where Kunrei-shiki and Nihon-shiki
+// differ from Hepburn.
+var (
+	kunreiRomaji = map[rune]string{
+		'し': "si", 'ち': "ti", 'つ': "tu", 'ふ': "hu", 'じ': "zi",
+		'ぢ': "zi", 'を': "o",
+	}
+	nihonRomaji = map[rune]string{
+		'し': "si", 'ち': "ti", 'つ': "tu", 'ふ': "hu", 'じ': "zi",
+		'ぢ': "di", 'づ': "du", 'ゐ': "wi", 'ゑ': "we",
+	}
+)
+
+// particles are the hiragana written after a word that are read as words
+// of their own, with the reading they take as a particle.
+var particles = []struct {
+	kana   string
+	romaji [3]string
+}{
+	{"から", [3]string{"kara", "kara", "kara"}},
+	{"まで", [3]string{"made", "made", "made"}},
+	{"より", [3]string{"yori", "yori", "yori"}},
+	{"は", [3]string{"wa", "wa", "wa"}},
+	{"へ", [3]string{"e", "e", "e"}},
+	{"を", [3]string{"wo", "o", "wo"}},
+	{"が", [3]string{"ga", "ga", "ga"}},
+	{"の", [3]string{"no", "no", "no"}},
+	{"に", [3]string{"ni", "ni", "ni"}},
+	{"で", [3]string{"de", "de", "de"}},
+	{"と", [3]string{"to", "to", "to"}},
+	{"も", [3]string{"mo", "mo", "mo"}},
+	{"や", [3]string{"ya", "ya", "ya"}},
+}
+
+// leadingParticles are the particles split off the start of a run of
+// hiragana after kanji, as in 本をよむ, which words rarely start with.
+const leadingParticles = "をへがではに"
+
+// kanaScript tells hiragana, 1, from katakana, 2. The ー and iteration
+// marks, 0, go with either.
+func kanaScript(r rune) int {
+	switch {
+	case r == 'ー' || r == 'ゝ' || r == 'ゞ' || r == 'ヽ' || r == 'ヾ':
+		return 0
+	case isHiragana(r):
+		return 1
+	}
+	return 2
+}
+
+func isKana(r rune) bool {
+	return isHiragana(r) || isKatakana(r)
+}
+
+func isHiragana(r rune) bool {
+	return r >= 0x3041 && r <= 0x3096 || r == 'ゝ' || r == 'ゞ'
+}
+
+func isKatakana(r rune) bool {
+	return r >= 0x30a1 && r <= 0x30fa || r >= 0x30fc && r <= 0x30fe
+}
+
+// hiragana returns the hiragana for the katakana r, or r itself.
+func hiragana(r rune) rune {
+	if r >= 0x30a1 && r <= 0x30f6 || r == 'ヽ' || r == 'ヾ' {
+		return r - 0x60
+	}
+	return r
+}
+
+// RomanizeKana romanizes the hiragana and katakana in text with system,
+// writing runes that are not kana with Transliterate. Hiragana and
+// katakana are written as separate words, and a run of hiragana after
+// another word that is made of particles, as in 日本語の or テストを, is
+// read as those particles. The vowels of hiragana written long with う and
+// お are marked long, as in kanji readings, so ぎゅうにゅう is gyūnyū.
+func RomanizeKana(text string, system KanaSystem) string {
+	b := strings.Builder{}
+	var prev rune
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if !isKana(r) {
+			b.WriteString(Transliterate(r))
+			prev = r
+			i += size
+			continue
+		}
+		n := kanaRun(text[i:])
+		next, _ := utf8.DecodeRuneInString(text[i+n:])
+		writeKana(&b, text[i:i+n], prev, next, system, true)
+		prev, _ = utf8.DecodeLastRuneInString(text[:i+n])
+		i += n
+	}
+	return b.String()
+}
+
+// kanaRun returns the length in bytes of the run of kana text starts
+// with, including the ・ between katakana words.
+func kanaRun(text string) int {
+	for i, r := range text {
+		if !isKana(r) && r != '・' {
+			return i
+		}
+	}
+	return len(text)
+}
+
+// writeKana writes the romanization of a run of kana that comes after
+// prev and before next, keeping it apart from them when they are letters.
+// The apostrophe between n and a vowel or y is left out unless apostrophe
+// is true.
+func writeKana(b *strings.Builder, run string, prev, next rune, system KanaSystem, apostrophe bool) {
+	if isLetterOrNumber(prev) && !strings.HasSuffix(b.String(), " ") {
+		b.WriteByte(' ')
+	}
+
+	start, script := 0, 0
+	for i, r := range run {
+		if r == '・' {
+			writeKanaWord(b, run[start:i], prev, system, apostrophe)
+			b.WriteByte(' ')
+			prev, start, script = r, i+len("・"), 0
+			continue
+		}
+		s := kanaScript(r)
+		if s != 0 && script != 0 && s != script {
+			writeKanaWord(b, run[start:i], prev, system, apostrophe)
+			b.WriteByte(' ')
+			prev, start = lastRune(run[:i]), i
+		}
+		if s != 0 {
+			script = s
+		}
+	}
+	writeKanaWord(b, run[start:], prev, system, apostrophe)
+
+	if isLetterOrNumber(next) {
+		b.WriteByte(' ')
+	}
+}
+
+// writeKanaWord writes a run of only hiragana or only katakana, reading it
+// as particles when it is hiragana after another word, and splitting a
+// particle off its start when it comes after kanji.
+func writeKanaWord(b *strings.Builder, word string, prev rune, system KanaSystem, apostrophe bool) {
+	if word == "" {
+		return
+	}
+	if !isHiragana(lastRune(word)) {
+		b.WriteString(romanizeKanaWord(word, system, apostrophe))
+		return
+	}
+	if isLetterOrNumber(prev) && !isHiragana(prev) {
+		if romaji, ok := readParticles(word, system); ok {
+			b.WriteString(strings.Join(romaji, " "))
+			return
+		}
+	}
+	if first, size := utf8.DecodeRuneInString(word); isHan(prev) && strings.ContainsRune(leadingParticles, first) {
+		romaji, _ := readParticles(word[:size], system)
+		b.WriteString(romaji[0])
+		b.WriteByte(' ')
+		word = word[size:]
+	}
+	b.WriteString(romanizeReading(word, system, apostrophe))
+}
+
+// readParticles reads word as a sequence of particles, if it is one.
+func readParticles(word string, system KanaSystem) ([]string, bool) {
+	var romaji []string
+	for word != "" {
+		found := false
+		for _, p := range particles {
+			if strings.HasPrefix(word, p.kana) {
+				romaji = append(romaji, p.romaji[system])
+				word = word[len(p.kana):]
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return romaji, true
+}
+
+// romanizeKanaWord romanizes a word of kana, combining yōon and the small
+// vowels of katakana loan words with the kana before them, doubling the
+// consonant after っ and lengthening the vowel before ー. An n before a
+// vowel or y is followed by an apostrophe if apostrophe is true.
+func romanizeKanaWord(word string, system KanaSystem, apostrophe bool) string {
+	var syllables []string
+	geminate := false
+	for _, r := range word {
+		h := hiragana(r)
+		switch {
+		case h == 'っ':
+			geminate = true
+			continue
+		case h == 'ー':
+			if n := len(syllables); n > 0 {
+				syllables[n-1] = lengthen(syllables[n-1], system)
+			}
+			continue
+		case h == 'ゝ' || h == 'ゞ':
+			if n := len(syllables); n > 0 {
+				syllables = append(syllables, syllables[n-1])
+			}
+			continue
+		case isSmallKana(h) && len(syllables) > 0:
+			n := len(syllables)
+			syllables[n-1] = combine(syllables[n-1], kanaRomaji[h], h)
+			continue
+		}
+
+		romaji, ok := romajiFor(h, system)
+		if !ok {
+			romaji = Transliterate(r)
+		}
+		if geminate {
+			romaji = double(romaji, system)
+			geminate = false
+		}
+		syllables = append(syllables, romaji)
+	}
+
+	for i := 0; apostrophe && i+1 < len(syllables); i++ {
+		if syllables[i] == "n" && startsWithVowelOrY(syllables[i+1]) {
+			syllables[i] = "n'"
+		}
+	}
+	return strings.Join(syllables, "")
+}
+
+func romajiFor(h rune, system KanaSystem) (string, bool) {
+	switch system {
+	case KunreiShiki:
+		if romaji, ok := kunreiRomaji[h]; ok {
+			return romaji, true
+		}
+	case NihonShiki:
+		if romaji, ok := nihonRomaji[h]; ok {
+			return romaji, true
+		}
+	}
+	romaji, ok := kanaRomaji[h]
+	return romaji, ok
+}
+
+func isSmallKana(h rune) bool {
+	switch h {
+	case 'ぁ', 'ぃ', 'ぅ', 'ぇ', 'ぉ', 'ゃ', 'ゅ', 'ょ', 'ゎ':
+		return true
+	}
+	return false
+}
+
+// combine joins the syllable before a small kana with it: き and ゃ make
+// kya, し and ゃ sha, テ and ィ ti, ウ and ィ wi, フ and ァ fa.
+func combine(syllable, small string, h rune) string {
+	switch h {
+	case 'ゃ', 'ゅ', 'ょ':
+		if !strings.HasSuffix(syllable, "i") {
+			return syllable + small
+		}
+		stem := syllable[:len(syllable)-1]
+		if strings.HasSuffix(stem, "sh") || strings.HasSuffix(stem, "ch") || strings.HasSuffix(stem, "j") {
+			return stem + small[1:]
+		}
+		return stem + small
+	}
+	stem := strings.TrimRight(syllable, "aiueo")
+	switch {
+	case syllable == "u":
+		stem = "w"
+	case syllable == "i":
+		stem = "y"
+	case syllable == "fu" || syllable == "hu":
+		stem = "f"
+	case h == 'ぇ' && strings.HasSuffix(syllable, "i") && !strings.HasSuffix(stem, "sh") && !strings.HasSuffix(stem, "ch") && !strings.HasSuffix(stem, "j"):
+		stem += "y"
+	}
+	return stem + strings.TrimLeft(small, "w")
+}
+
+// double doubles the first consonant of romaji for a preceding っ, which
+// Hepburn writes as t before ch.
+func double(romaji string, system KanaSystem) string {
+	if romaji == "" || strings.IndexByte("aiueo", romaji[0]) >= 0 {
+		return romaji
+	}
+	if system == Hepburn && strings.HasPrefix(romaji, "ch") {
+		return "t" + romaji
+	}
+	return romaji[:1] + romaji
+}
+
+var (
+	macrons     = map[byte]string{'a': "ā", 'i': "ī", 'u': "ū", 'e': "ē", 'o': "ō"}
+	circumflexs = map[byte]string{'a': "â", 'i': "î", 'u': "û", 'e': "ê", 'o': "ô"}
+)
+
+// lengthen marks the vowel at the end of syllable as long.
+func lengthen(syllable string, system KanaSystem) string {
+	if syllable == "" {
+		return syllable
+	}
+	marks := macrons
+	if system != Hepburn {
+		marks = circumflexs
+	}
+	last := syllable[len(syllable)-1]
+	if long, ok := marks[last]; ok {
+		return syllable[:len(syllable)-1] + long
+	}
+	return syllable
+}
+
+func startsWithVowelOrY(romaji string) bool {
+	return romaji != "" && strings.IndexByte("aiueoy", romaji[0]) >= 0
+}
+
+func isLetterOrNumber(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+func lastRune(s string) rune {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return r
+}
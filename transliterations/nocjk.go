@@ -0,0 +1,19 @@
+//go:build slugify_nocjk
+// +build slugify_nocjk
+
+package transliterations
+
+// The slugify_nocjk build tag leaves out the tables of the Han and Yi
+// scripts along with the readings and dictionaries of hanzi and kanji, so
+// those characters are transliterated as "[?]".
+var cjkTables *packedTables
+
+const hanziReadings = ""
+
+var cantoneseReadings = map[rune]string{}
+
+// ChineseWords is empty in builds that leave out Han.
+var ChineseWords = NewChineseDictionary()
+
+// JapaneseWords is empty in builds that leave out Han.
+var JapaneseWords = &JapaneseDictionary{}
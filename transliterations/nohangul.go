@@ -0,0 +1,9 @@
+//go:build slugify_nohangul
+// +build slugify_nohangul
+
+package transliterations
+
+// The slugify_nohangul build tag leaves out the tables of Hangul, and
+// Transliterate and profiles no longer romanize Hangul syllables, so Korean
+// is transliterated as "[?]". RomanizeHangul still romanizes it.
+var hangulTables *packedTables
@@ -0,0 +1,518 @@
+package transliterations
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Profile holds transliterations that take precedence over Tables, such
+// as the ones a language or a romanization standard writes differently
+// from the generic romanization.
+//
+// When an uppercase rune is transliterated to several letters by a
+// Profile they are written in title case, "Ä" as "Ae", unless the rune is
+// next to another uppercase letter, in which case they are all uppercase,
+// so "ÄRGER" becomes "AERGER".
+type Profile struct {
+	// Runes maps single runes to their transliteration.
+	Runes map[rune]string
+	// Sequences maps runs of several runes to their transliteration. The
+	// longest sequence matching at a position wins.
+	Sequences map[string]string
+	// WordInitial maps runes to the transliteration they take at the start
+	// of a word, where it differs from Runes.
+	WordInitial map[rune]string
+	// InitialAfter lists runes after which WordInitial is used even though
+	// they are part of the word, such as vowels in BGN/PCGN.
+	InitialAfter string
+	// WordFinal maps runs of runes to the transliteration they take at the
+	// end of a word. They take precedence over Sequences.
+	WordFinal map[string]string
+	// Hangul is the romanization used for Korean.
+	Hangul HangulSystem
+	// Kana is the romanization used for Japanese kana.
+	Kana KanaSystem
+	// Kanji is the dictionary kanji are read with as Japanese. When it is
+	// nil, JapaneseWords is used for text that has kana in it, and kanji
+	// are otherwise read as Mandarin.
+	Kanji *JapaneseDictionary
+	// Hanzi is the dictionary Chinese is read with, ChineseWords when it
+	// is nil.
+	Hanzi *ChineseDictionary
+	// Pinyin is how Chinese is written.
+	Pinyin PinyinMode
+	// NoApostrophes leaves out the apostrophes Hepburn writes after an n
+	// before a vowel or y, kin'yōbi, and McCune–Reischauer after aspirated
+	// consonants, ch'ŏnan. The apostrophes of pinyin are set by Pinyin.
+	NoApostrophes bool
+	// Han is the language hanzi are read in. Hanzi read in Cantonese are
+	// written a syllable at a time, without Hanzi or Pinyin.
+	Han HanReading
+	// Emoji is how emoji are written.
+	Emoji EmojiMode
+	// Overrides transliterates runes ahead of everything else in the
+	// profile.
+	Overrides Transliterator
+	// Fallback transliterates the runes the profile has nothing for,
+	// instead of the built-in tables. Runes it has no transliteration for
+	// are kept as they are.
+	Fallback Transliterator
+}
+
+// TransliterateString transliterates a whole text without any language
+// profile.
+func TransliterateString(text string) string {
+	var p *Profile
+	return p.TransliterateString(text)
+}
+
+// TransliterateString transliterates text to NFC and then through the
+// profile, falling back to Transliterate, or the profile's Fallback, for
+// anything the profile does not cover. A nil Profile just uses
+// Transliterate.
+func (p *Profile) TransliterateString(text string) string {
+	text = norm.NFC.String(text)
+	return p.transliterate(text, p.kanji(text), nil)
+}
+
+// TransliterateSegments is TransliterateString, calling f with every
+// segment of text, in NFC, and its transliteration in turn. Runs that are
+// read together, such as a word of Hangul or of hanzi, are one segment,
+// and every other rune is a segment of its own. The transliterations of
+// the segments make up the transliteration of text.
+func (p *Profile) TransliterateSegments(text string, f func(segment, out string)) {
+	text = norm.NFC.String(text)
+	p.transliterate(text, p.kanji(text), f)
+}
+
+// transliterate transliterates text, which is in NFC, reading kanji with
+// the kanji dictionary, or as Mandarin if it is nil. It calls f, unless it
+// is nil, with every segment and its transliteration.
+func (p *Profile) transliterate(text string, kanji *JapaneseDictionary, f func(segment, out string)) string {
+	b := strings.Builder{}
+	b.Grow(len(text))
+	var prev rune
+	start, mark := 0, 0
+	for i := 0; i < len(text); {
+		if f != nil && i > start {
+			f(text[start:i], b.String()[mark:])
+			start, mark = i, b.Len()
+		}
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if out, ok := p.override(r); ok {
+			b.WriteString(out)
+			prev = r
+			i += size
+			continue
+		}
+
+		if mayStartEmoji(r) && p.emoji() != NoEmoji {
+			if n, name := emoji(text[i:], p.emoji()); n > 0 {
+				next, _ := utf8.DecodeRuneInString(text[i+n:])
+				writeEmoji(&b, name, next)
+				prev, _ = utf8.DecodeLastRuneInString(text[:i+n])
+				i += n
+				continue
+			}
+		}
+
+		if n, out := p.sequence(text[i:]); n > 0 {
+			next, _ := utf8.DecodeRuneInString(text[i+n:])
+			b.WriteString(matchCase(text[i:i+n], out, prev, next))
+			prev, _ = utf8.DecodeLastRuneInString(text[:i+n])
+			i += n
+			continue
+		}
+
+		if romanizesHangul(r) {
+			n := hangulRun(text[i:])
+			out := romanizeHangulWord([]rune(text[i:i+n]), p.hangul())
+			if !p.apostrophes() {
+				out = strings.Replace(out, "'", "", -1)
+			}
+			b.WriteString(out)
+			prev, _ = utf8.DecodeLastRuneInString(text[:i+n])
+			i += n
+			continue
+		}
+
+		if isKana(r) {
+			n := kanaRun(text[i:])
+			next, _ := utf8.DecodeRuneInString(text[i+n:])
+			writeKana(&b, text[i:i+n], prev, next, p.kana(), p.apostrophes())
+			prev, _ = utf8.DecodeLastRuneInString(text[:i+n])
+			i += n
+			continue
+		}
+
+		han := isHan(r) && !p.unreadable(r)
+		if han && p.han() != Mandarin {
+			b.WriteString(TransliterateHan(r, p.han()))
+			prev = r
+			i += size
+			continue
+		}
+
+		if han && kanji != nil {
+			// The kanji stands for the word, which may end in okurigana,
+			// so that the kana after it are read as following a word.
+			i += writeJapanese(&b, text[i:], prev, kanji, p.kana(), p.apostrophes())
+			prev = r
+			continue
+		}
+
+		if han {
+			n := writeChinese(&b, text[i:], prev, p.hanzi(), p.pinyin())
+			prev, _ = utf8.DecodeLastRuneInString(text[:i+n])
+			i += n
+			continue
+		}
+
+		next, _ := utf8.DecodeRuneInString(text[i+size:])
+		if out, ok := p.rune(r, prev); ok {
+			b.WriteString(matchCase(string(r), out, prev, next))
+		} else {
+			b.WriteString(p.fallback(r))
+		}
+		prev = r
+		i += size
+	}
+	if f != nil && len(text) > start {
+		f(text[start:], b.String()[mark:])
+	}
+	return b.String()
+}
+
+func (p *Profile) override(r rune) (string, bool) {
+	if p == nil || p.Overrides == nil {
+		return "", false
+	}
+	return p.Overrides.Transliterate(r)
+}
+
+// unreadable reports whether r is a hanzi the tables have no reading for,
+// which is left to the profile's Fallback if it has one.
+func (p *Profile) unreadable(r rune) bool {
+	if p == nil || p.Fallback == nil {
+		return false
+	}
+	_, ok := Lookup(r)
+	return !ok
+}
+
+func (p *Profile) fallback(r rune) string {
+	if p == nil || p.Fallback == nil {
+		return Transliterate(r)
+	}
+	if out, ok := p.Fallback.Transliterate(r); ok {
+		return out
+	}
+	return string(r)
+}
+
+func (p *Profile) hangul() HangulSystem {
+	if p == nil {
+		return RevisedRomanization
+	}
+	return p.Hangul
+}
+
+func (p *Profile) emoji() EmojiMode {
+	if p == nil {
+		return NoEmoji
+	}
+	return p.Emoji
+}
+
+func (p *Profile) kana() KanaSystem {
+	if p == nil {
+		return Hepburn
+	}
+	return p.Kana
+}
+
+func (p *Profile) apostrophes() bool {
+	return p == nil || !p.NoApostrophes
+}
+
+func (p *Profile) hanzi() *ChineseDictionary {
+	if p == nil || p.Hanzi == nil {
+		return ChineseWords
+	}
+	return p.Hanzi
+}
+
+func (p *Profile) han() HanReading {
+	if p == nil {
+		return Mandarin
+	}
+	return p.Han
+}
+
+func (p *Profile) pinyin() PinyinMode {
+	if p == nil {
+		return PinyinPlain
+	}
+	return p.Pinyin
+}
+
+// kanji returns the dictionary kanji in text are read with, or nil if
+// they are not Japanese.
+func (p *Profile) kanji(text string) *JapaneseDictionary {
+	if p != nil && p.Kanji != nil {
+		return p.Kanji
+	}
+	if containsKana(text) {
+		return JapaneseWords
+	}
+	return nil
+}
+
+// hangulRun returns the length in bytes of the run of Hangul syllables
+// text starts with.
+func hangulRun(text string) int {
+	for i, r := range text {
+		if !isHangul(r) {
+			return i
+		}
+	}
+	return len(text)
+}
+
+func (p *Profile) rune(r, prev rune) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+	if out, ok := p.WordInitial[r]; ok {
+		if !isWordRune(prev) || strings.ContainsRune(p.InitialAfter, prev) {
+			return out, true
+		}
+	}
+	out, ok := p.Runes[r]
+	return out, ok
+}
+
+// sequence returns the length in bytes and the transliteration of the
+// longest of p's WordFinal or Sequences text starts with, or 0 if there
+// is none.
+func (p *Profile) sequence(text string) (n int, out string) {
+	if p == nil {
+		return 0, ""
+	}
+	for seq, tr := range p.WordFinal {
+		if len(seq) > n && strings.HasPrefix(text, seq) {
+			if next, _ := utf8.DecodeRuneInString(text[len(seq):]); !isWordRune(next) {
+				n, out = len(seq), tr
+			}
+		}
+	}
+	if n > 0 {
+		return n, out
+	}
+	for seq, tr := range p.Sequences {
+		if len(seq) > n && strings.HasPrefix(text, seq) {
+			n, out = len(seq), tr
+		}
+	}
+	return n, out
+}
+
+// isWordRune reports whether r is part of a word. Apostrophes are, as
+// they are written inside words in Ukrainian, English and French.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || r == '\'' || r == '’' || r == 'ʼ'
+}
+
+// matchCase writes out, the transliteration of src, in the case of src:
+// all uppercase when src is uppercase and is next to another uppercase
+// letter, and as given otherwise.
+func matchCase(src, out string, prev, next rune) string {
+	first, _ := utf8.DecodeRuneInString(src)
+	last, _ := utf8.DecodeLastRuneInString(src)
+	if !unicode.IsUpper(first) {
+		return out
+	}
+	if len(src) > len(string(first)) && unicode.IsUpper(last) || unicode.IsUpper(prev) || unicode.IsUpper(next) {
+		return strings.ToUpper(out)
+	}
+	return out
+}
+
+// withUpper adds the uppercase forms of the lowercase runes in m, with
+// their transliteration in title case, and returns m.
+func withUpper(m map[rune]string) map[rune]string {
+	for r, out := range m {
+		if upper := unicode.ToUpper(r); upper != r {
+			if _, ok := m[upper]; !ok {
+				m[upper] = title(out)
+			}
+		}
+	}
+	return m
+}
+
+// withUpperSequences is withUpper for sequences of runes, adding both the
+// title case and the all uppercase forms.
+func withUpperSequences(m map[string]string) map[string]string {
+	for seq, out := range m {
+		if t := title(seq); t != seq {
+			m[t] = title(out)
+			m[strings.ToUpper(seq)] = strings.ToUpper(out)
+		}
+	}
+	return m
+}
+
+func title(s string) string {
+	if s == "" {
+		return s
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// German writes umlauts as a trailing e.
+var German = &Profile{
+	Runes: map[rune]string{
+		'Ä': "Ae", 'ä': "ae",
+		'Ö': "Oe", 'ö': "oe",
+		'Ü': "Ue", 'ü': "ue",
+		'ẞ': "SS", 'ß': "ss",
+	},
+}
+
+// Nordic is used for Danish and Norwegian, which write ø as oe and å as
+// aa.
+var Nordic = &Profile{
+	Runes: map[rune]string{
+		'Æ': "Ae", 'æ': "ae",
+		'Ø': "Oe", 'ø': "oe",
+		'Å': "Aa", 'å': "aa",
+	},
+}
+
+// French writes the œ and æ ligatures as two letters.
+var French = &Profile{
+	Runes: map[rune]string{
+		'Œ': "Oe", 'œ': "oe",
+		'Æ': "Ae", 'æ': "ae",
+	},
+}
+
+// Catalan turns the ela geminada, l·l, into ll rather than splitting it.
+var Catalan = &Profile{
+	Runes: map[rune]string{
+		'Ŀ': "L", 'ŀ': "l",
+	},
+	Sequences: map[string]string{
+		"l·l": "ll", "L·L": "LL", "L·l": "Ll",
+		"l.l": "ll", "L.L": "LL", "L.l": "Ll",
+	},
+}
+
+// Turkish maps the dotted and dotless i to their ASCII look-alikes.
+var Turkish = &Profile{
+	Runes: map[rune]string{
+		'İ': "I", 'ı': "i",
+		'Ğ': "G", 'ğ': "g",
+		'Ş': "S", 'ş': "s",
+	},
+}
+
+// Esperanto uses the h-system for its circumflexed letters.
+var Esperanto = &Profile{
+	Runes: map[rune]string{
+		'Ĉ': "Ch", 'ĉ': "ch",
+		'Ĝ': "Gh", 'ĝ': "gh",
+		'Ĥ': "Hh", 'ĥ': "hh",
+		'Ĵ': "Jh", 'ĵ': "jh",
+		'Ŝ': "Sh", 'ŝ': "sh",
+		'Ŭ': "U", 'ŭ': "u",
+	},
+}
+
+// Japanese reads kanji with JapaneseWords even when there is no kana
+// around them to tell they are Japanese.
+var Japanese = &Profile{
+	Kanji: JapaneseWords,
+}
+
+// Cantonese reads hanzi in Cantonese, written in Jyutping.
+var Cantonese = &Profile{
+	Han: Jyutping,
+}
+
+// Dutch keeps the ij digraph together, capitalized as IJ.
+var Dutch = &Profile{
+	Runes: map[rune]string{
+		'Ĳ': "IJ", 'ĳ': "ij",
+	},
+}
+
+var (
+	languageTags = []language.Tag{
+		language.Und,
+		language.German,
+		language.Danish,
+		language.Norwegian,
+		language.MustParse("nb"),
+		language.MustParse("nn"),
+		language.French,
+		language.Catalan,
+		language.Turkish,
+		language.MustParse("eo"),
+		language.Dutch,
+		language.Ukrainian,
+		language.Bulgarian,
+		language.Serbian,
+		language.Macedonian,
+		language.Japanese,
+		language.Chinese,
+		language.MustParse("zh-TW"),
+		language.MustParse("yue"),
+		language.MustParse("zh-HK"),
+		language.MustParse("zh-MO"),
+	}
+	languageProfiles = []*Profile{
+		nil,
+		German,
+		Nordic,
+		Nordic,
+		Nordic,
+		Nordic,
+		French,
+		Catalan,
+		Turkish,
+		Esperanto,
+		Dutch,
+		UkrainianNational,
+		BulgarianStreamlined,
+		SerbianLatin,
+		MacedonianOfficial,
+		Japanese,
+		nil,
+		nil,
+		Cantonese,
+		Cantonese,
+		Cantonese,
+	}
+	languageMatcher = language.NewMatcher(languageTags)
+)
+
+// ForLanguage returns the Profile for the language tag is in, or nil if
+// the generic transliterations are right for it. Ukrainian, Bulgarian,
+// Serbian and Macedonian get their national romanizations of Cyrillic,
+// and Japanese has its kanji read as Japanese. Cantonese, and the Chinese
+// of Hong Kong and Macau, have their hanzi read in Cantonese. Regional
+// and script variants fall back to their language, so de-CH gets German.
+func ForLanguage(tag language.Tag) *Profile {
+	_, i, confidence := languageMatcher.Match(tag)
+	if confidence == language.No {
+		return nil
+	}
+	return languageProfiles[i]
+}
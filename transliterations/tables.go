@@ -0,0 +1,4450 @@
+// Code generated by go run gen.go; DO NOT EDIT.
+
+package transliterations
+
+// UnicodeVersion is the version of Unicode the tables were generated from.
+const UnicodeVersion = "15.1.0"
+
+// CLDRVersion is the version of the CLDR transforms the tables were
+// generated from.
+const CLDRVersion = "42"
+
+// coreTables has the tables of the scripts that are always built.
+var coreTables = &packedTables{
+	sections: []uint16{
+		1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16,
+		17, 0, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+		32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47,
+		48, 49, 50, 51, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 52, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 53, 54, 55, 56, 57, 58, 59, 60, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 61, 62, 63, 64, 65,
+		66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+		82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 0, 93, 94, 95, 96,
+		97, 98, 99, 100, 101, 102, 0, 0, 0, 0, 0, 0, 0, 0, 0, 103,
+		104, 105, 106, 107, 108, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 109, 110, 111, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 112, 113, 114, 115, 0, 0, 116, 117,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 118,
+		119, 120, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 121, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 122, 123, 124, 125, 126, 127, 0, 0, 0, 0, 0, 0, 0, 128,
+		129, 130, 131, 0, 132, 0, 0, 133, 134, 135, 0, 0, 136, 137, 138, 0,
+		0, 139, 0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	},
+	starts: []tableStart{
+		{0, 0},         // 0x000
+		{120, 257},     // 0x001
+		{415, 514},     // 0x002
+		{718, 771},     // 0x003
+		{1003, 1028},   // 0x004
+		{1441, 1285},   // 0x005
+		{1859, 1542},   // 0x006
+		{2232, 1799},   // 0x007
+		{2768, 2056},   // 0x008
+		{3265, 2313},   // 0x009
+		{3757, 2570},   // 0x00a
+		{4290, 2827},   // 0x00b
+		{4842, 3084},   // 0x00c
+		{5392, 3341},   // 0x00d
+		{5923, 3598},   // 0x00e
+		{6437, 3855},   // 0x00f
+		{6916, 4112},   // 0x010
+		{7411, 4369},   // 0x012
+		{8125, 4626},   // 0x013
+		{8794, 4883},   // 0x014
+		{9502, 5140},   // 0x015
+		{10236, 5397},  // 0x016
+		{10799, 5654},  // 0x017
+		{11311, 5911},  // 0x018
+		{11832, 6168},  // 0x019
+		{12294, 6425},  // 0x01a
+		{12707, 6682},  // 0x01b
+		{13105, 6939},  // 0x01c
+		{13693, 7196},  // 0x01d
+		{13720, 7453},  // 0x01e
+		{13983, 7710},  // 0x01f
+		{14294, 7967},  // 0x020
+		{14730, 8224},  // 0x021
+		{15107, 8481},  // 0x022
+		{15854, 8738},  // 0x023
+		{16614, 8995},  // 0x024
+		{17068, 9252},  // 0x025
+		{17357, 9509},  // 0x026
+		{17796, 9766},  // 0x027
+		{17998, 10023}, // 0x028
+		{19470, 10280}, // 0x029
+		{19473, 10537}, // 0x02a
+		{19481, 10794}, // 0x02b
+		{19490, 11051}, // 0x02c
+		{19505, 11308}, // 0x02d
+		{20120, 11565}, // 0x02e
+		{20504, 11822}, // 0x02f
+		{20609, 12079}, // 0x030
+		{21074, 12336}, // 0x031
+		{21682, 12593}, // 0x032
+		{22588, 12850}, // 0x033
+		{23566, 13107}, // 0x04d
+		{24331, 13364}, // 0x0a4
+		{24859, 13621}, // 0x0a5
+		{25578, 13878}, // 0x0a6
+		{26209, 14135}, // 0x0a7
+		{26734, 14392}, // 0x0a8
+		{27217, 14649}, // 0x0a9
+		{27680, 14906}, // 0x0aa
+		{28202, 15163}, // 0x0ab
+		{28867, 15420}, // 0x0fb
+		{29110, 15677}, // 0x0fc
+		{29110, 15934}, // 0x0fd
+		{29293, 16191}, // 0x0fe
+		{29522, 16448}, // 0x0ff
+		{29951, 16705}, // 0x100
+		{30651, 16962}, // 0x101
+		{31110, 17219}, // 0x102
+		{31747, 17476}, // 0x103
+		{32540, 17733}, // 0x104
+		{33205, 17990}, // 0x105
+		{33903, 18247}, // 0x106
+		{35084, 18504}, // 0x107
+		{35807, 18761}, // 0x108
+		{36574, 19018}, // 0x109
+		{37270, 19275}, // 0x10a
+		{38002, 19532}, // 0x10b
+		{38765, 19789}, // 0x10c
+		{39414, 20046}, // 0x10d
+		{40117, 20303}, // 0x10e
+		{40832, 20560}, // 0x10f
+		{41645, 20817}, // 0x110
+		{42140, 21074}, // 0x111
+		{42644, 21331}, // 0x112
+		{43215, 21588}, // 0x113
+		{43868, 21845}, // 0x114
+		{44420, 22102}, // 0x115
+		{45023, 22359}, // 0x116
+		{45547, 22616}, // 0x117
+		{46219, 22873}, // 0x118
+		{46855, 23130}, // 0x119
+		{47445, 23387}, // 0x11a
+		{47958, 23644}, // 0x11c
+		{48453, 23901}, // 0x11d
+		{49040, 24158}, // 0x11e
+		{49773, 24415}, // 0x11f
+		{50334, 24672}, // 0x120
+		{51159, 24929}, // 0x121
+		{51946, 25186}, // 0x122
+		{52792, 25443}, // 0x123
+		{53580, 25700}, // 0x124
+		{54275, 25957}, // 0x125
+		{55077, 26214}, // 0x12f
+		{56035, 26471}, // 0x130
+		{57107, 26728}, // 0x131
+		{58181, 26985}, // 0x132
+		{59305, 27242}, // 0x133
+		{60404, 27499}, // 0x134
+		{61181, 27756}, // 0x144
+		{62239, 28013}, // 0x145
+		{63283, 28270}, // 0x146
+		{64123, 28527}, // 0x168
+		{65488, 28784}, // 0x169
+		{66572, 29041}, // 0x16a
+		{67213, 29298}, // 0x16b
+		{67997, 29555}, // 0x16e
+		{68607, 29812}, // 0x16f
+		{69218, 30069}, // 0x1af
+		{70012, 30326}, // 0x1b0
+		{70769, 30583}, // 0x1b1
+		{71529, 30840}, // 0x1bc
+		{72096, 31097}, // 0x1d2
+		{72594, 31354}, // 0x1d3
+		{73060, 31611}, // 0x1d4
+		{73304, 31868}, // 0x1d5
+		{73548, 32125}, // 0x1d6
+		{73927, 32382}, // 0x1d7
+		{73977, 32639}, // 0x1df
+		{74721, 32896}, // 0x1e0
+		{75273, 33153}, // 0x1e1
+		{75996, 33410}, // 0x1e2
+		{76660, 33667}, // 0x1e4
+		{77371, 33924}, // 0x1e7
+		{78160, 34181}, // 0x1e8
+		{78848, 34438}, // 0x1e9
+		{79563, 34695}, // 0x1ec
+		{80327, 34952}, // 0x1ed
+		{81078, 35209}, // 0x1ee
+		{81586, 35466}, // 0x1f1
+		{81913, 35723}, // 0x1fb
+		{82055, 35980},
+	},
+	offsets: []uint16{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 2, 4, 6, 8, 10, 11, 13, 14, 17, 18, 20, 21, 21, 24,
+		25, 28, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 41, 44, 47, 50,
+		51, 52, 53, 54, 55, 56, 57, 59, 60, 61, 62, 63, 64, 65, 66, 67,
+		68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 84,
+		86, 87, 88, 89, 90, 91, 92, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+		103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 119,
+		120, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14,
+		15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
+		31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46,
+		47, 48, 49, 50, 52, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64,
+		65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 77, 79, 81, 82, 83,
+		84, 85, 86, 87, 89, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+		102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+		118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
+		134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149,
+		150, 151, 152, 153, 154, 155, 156, 158, 159, 160, 161, 162, 163, 164, 165, 166,
+		167, 168, 169, 170, 172, 174, 175, 176, 178, 179, 180, 182, 184, 185, 186, 187,
+		188, 189, 190, 191, 192, 193, 194, 195, 196, 198, 200, 202, 204, 205, 206, 207,
+		209, 210, 211, 213, 215, 216, 218, 220, 222, 224, 226, 228, 230, 232, 234, 235,
+		236, 237, 238, 239, 240, 241, 242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
+		252, 253, 254, 255, 257, 259, 260, 261, 262, 263, 264, 265, 266, 267, 268, 269,
+		271, 273, 274, 276, 278, 280, 281, 282, 284, 285, 286, 287, 288, 289, 291, 293,
+		294, 295, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
+		14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29,
+		30, 31, 32, 33, 34, 36, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47,
+		48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 60, 62, 63, 64, 65,
+		66, 67, 68, 69, 73, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87,
+		88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+		104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+		120, 122, 123, 124, 125, 126, 127, 128, 129, 131, 132, 133, 134, 135, 136, 137,
+		138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151, 152, 153,
+		154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
+		170, 171, 172, 173, 174, 175, 177, 179, 181, 183, 185, 187, 189, 191, 193, 195,
+		197, 198, 199, 200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
+		213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+		229, 230, 231, 232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244,
+		245, 246, 247, 248, 249, 250, 251, 252, 252, 252, 252, 252, 252, 252, 252, 253,
+		254, 255, 258, 261, 264, 267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 297,
+		300, 303, 303, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39,
+		42, 45, 48, 51, 51, 51, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60,
+		61, 62, 63, 64, 68, 72, 77, 82, 83, 84, 91, 98, 101, 104, 104, 105,
+		106, 107, 108, 111, 114, 117, 120, 123, 123, 123, 124, 125, 126, 127, 128, 131,
+		132, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 147, 148, 149, 150,
+		151, 152, 154, 155, 156, 157, 160, 161, 162, 163, 165, 167, 169, 170, 171, 172,
+		173, 174, 175, 176, 177, 178, 179, 180, 181, 182, 183, 184, 186, 187, 188, 189,
+		190, 191, 192, 193, 194, 195, 196, 197, 198, 199, 201, 203, 205, 206, 207, 208,
+		209, 210, 211, 212, 213, 215, 216, 217, 218, 220, 221, 222, 227, 232, 234, 236,
+		237, 238, 239, 240, 242, 244, 246, 248, 249, 250, 252, 254, 255, 256, 257, 258,
+		260, 262, 264, 266, 267, 268, 269, 270, 272, 273, 276, 277, 278, 279, 280, 281,
+		282, 283, 284, 285, 0, 2, 4, 6, 8, 10, 12, 13, 15, 16, 18, 20,
+		23, 25, 26, 27, 30, 31, 32, 33, 34, 35, 36, 38, 39, 40, 41, 42,
+		43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 54, 56, 58, 60, 64, 65,
+		66, 67, 68, 70, 72, 73, 74, 75, 76, 77, 78, 80, 81, 82, 83, 84,
+		85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 96, 98, 100, 102, 106, 107,
+		108, 109, 110, 112, 114, 116, 118, 120, 122, 124, 126, 127, 129, 130, 132, 134,
+		137, 139, 140, 141, 144, 145, 146, 147, 148, 150, 152, 153, 154, 156, 158, 159,
+		160, 162, 164, 166, 168, 170, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+		182, 183, 184, 186, 188, 189, 190, 196, 196, 196, 196, 196, 199, 208, 219, 220,
+		221, 222, 223, 225, 227, 229, 231, 233, 235, 237, 239, 242, 245, 247, 249, 251,
+		253, 255, 257, 259, 261, 263, 265, 267, 269, 271, 273, 275, 277, 279, 281, 283,
+		285, 287, 289, 290, 291, 293, 295, 298, 301, 304, 307, 310, 313, 316, 319, 320,
+		321, 323, 325, 328, 331, 332, 334, 336, 338, 340, 342, 344, 346, 348, 350, 352,
+		354, 356, 358, 360, 368, 369, 370, 371, 372, 374, 376, 378, 380, 381, 382, 383,
+		384, 386, 388, 389, 390, 392, 394, 395, 396, 397, 398, 399, 400, 401, 402, 403,
+		404, 405, 406, 407, 408, 409, 410, 411, 412, 414, 416, 419, 422, 423, 424, 427,
+		430, 432, 434, 436, 438, 0, 2, 4, 7, 10, 13, 16, 20, 24, 27, 30,
+		33, 36, 39, 42, 45, 48, 50, 52, 54, 56, 59, 62, 65, 68, 71, 74,
+		76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 102, 106, 108, 110,
+		115, 120, 124, 128, 130, 132, 135, 136, 137, 138, 139, 140, 141, 142, 143, 145,
+		147, 148, 149, 151, 153, 154, 155, 157, 159, 161, 162, 163, 164, 166, 167, 170,
+		171, 172, 174, 175, 176, 177, 178, 181, 182, 184, 186, 187, 188, 191, 194, 195,
+		196, 197, 198, 199, 200, 201, 204, 205, 206, 207, 208, 209, 210, 211, 212, 214,
+		216, 217, 218, 220, 222, 223, 224, 226, 228, 230, 231, 232, 233, 235, 236, 239,
+		240, 241, 243, 244, 245, 246, 247, 250, 251, 253, 255, 256, 257, 259, 261, 262,
+		263, 266, 269, 272, 275, 278, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
+		281, 281, 281, 281, 281, 281, 281, 281, 284, 284, 284, 284, 284, 284, 284, 284,
+		284, 284, 284, 284, 284, 284, 285, 286, 287, 288, 289, 290, 291, 292, 293, 294,
+		297, 298, 299, 299, 299, 299, 300, 300, 300, 301, 301, 304, 307, 310, 313, 316,
+		319, 322, 325, 328, 331, 334, 334, 335, 336, 337, 338, 339, 340, 342, 343, 344,
+		345, 346, 347, 348, 349, 350, 351, 352, 353, 354, 355, 357, 359, 360, 361, 363,
+		364, 367, 370, 373, 376, 379, 380, 382, 383, 384, 385, 388, 391, 394, 397, 400,
+		403, 406, 409, 412, 415, 418, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27,
+		30, 33, 36, 37, 40, 43, 46, 49, 52, 55, 58, 61, 64, 67, 70, 73,
+		76, 79, 80, 83, 86, 89, 90, 93, 93, 94, 95, 97, 97, 99, 99, 100,
+		101, 102, 104, 105, 106, 108, 109, 111, 112, 113, 114, 116, 117, 118, 119, 120,
+		121, 122, 127, 132, 135, 138, 141, 141, 142, 143, 144, 145, 146, 147, 148, 149,
+		150, 151, 153, 155, 157, 158, 159, 160, 161, 161, 161, 162, 163, 166, 169, 172,
+		175, 178, 181, 184, 187, 190, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+		203, 204, 205, 206, 207, 210, 213, 213, 214, 215, 216, 216, 217, 219, 221, 223,
+		225, 228, 229, 230, 231, 232, 234, 236, 238, 239, 241, 243, 244, 246, 249, 251,
+		252, 253, 255, 257, 260, 261, 262, 263, 265, 266, 267, 268, 269, 270, 271, 272,
+		273, 274, 275, 276, 277, 278, 279, 281, 282, 283, 284, 285, 286, 288, 289, 290,
+		292, 293, 294, 295, 297, 298, 299, 300, 301, 302, 303, 304, 305, 306, 307, 308,
+		309, 310, 311, 312, 313, 314, 316, 318, 319, 320, 321, 322, 324, 326, 327, 329,
+		331, 332, 333, 334, 335, 336, 337, 337, 337, 338, 340, 341, 343, 343, 343, 343,
+		343, 343, 343, 343, 344, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345,
+		346, 346, 346, 346, 346, 349, 352, 353, 354, 355, 356, 357, 358, 359, 360, 361,
+		362, 364, 365, 367, 368, 370, 373, 0, 2, 3, 4, 5, 6, 7, 8, 9,
+		10, 11, 12, 13, 14, 15, 18, 18, 19, 19, 20, 21, 22, 23, 24, 25,
+		26, 27, 28, 29, 30, 31, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42,
+		43, 44, 45, 47, 48, 53, 59, 66, 67, 68, 69, 70, 71, 72, 73, 74,
+		75, 76, 77, 78, 79, 80, 81, 82, 82, 83, 84, 84, 84, 85, 86, 87,
+		88, 89, 90, 93, 96, 101, 106, 108, 111, 114, 117, 120, 123, 126, 129, 132,
+		135, 138, 141, 144, 148, 151, 154, 157, 160, 163, 168, 173, 178, 182, 186, 190,
+		194, 198, 201, 204, 207, 211, 214, 217, 221, 224, 227, 231, 235, 238, 241, 244,
+		247, 250, 256, 262, 265, 269, 273, 276, 277, 279, 280, 281, 282, 283, 284, 285,
+		286, 287, 288, 290, 292, 293, 294, 296, 297, 298, 299, 300, 301, 302, 303, 305,
+		307, 309, 311, 313, 314, 316, 317, 318, 319, 320, 321, 323, 324, 325, 326, 328,
+		329, 331, 332, 334, 335, 337, 338, 340, 340, 343, 346, 349, 352, 355, 358, 361,
+		364, 367, 370, 373, 376, 379, 382, 385, 386, 387, 388, 389, 390, 391, 392, 393,
+		394, 395, 396, 398, 399, 400, 401, 403, 404, 414, 415, 417, 419, 421, 423, 426,
+		428, 430, 433, 435, 438, 440, 442, 444, 450, 452, 455, 457, 459, 461, 463, 469,
+		471, 474, 476, 479, 482, 485, 488, 491, 494, 497, 500, 503, 506, 509, 512, 515,
+		518, 521, 524, 527, 530, 533, 536, 536, 0, 4, 7, 12, 17, 19, 22, 25,
+		27, 30, 33, 37, 42, 45, 48, 55, 57, 59, 66, 69, 73, 77, 81, 81,
+		81, 81, 81, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 85, 85, 85,
+		85, 86, 86, 86, 86, 86, 86, 89, 92, 92, 92, 92, 92, 92, 92, 92,
+		92, 92, 92, 92, 92, 92, 92, 92, 95, 100, 102, 104, 106, 108, 115, 117,
+		119, 122, 126, 128, 130, 132, 134, 136, 138, 140, 143, 145, 147, 150, 152, 160,
+		163, 166, 166, 166, 166, 169, 172, 172, 175, 178, 180, 183, 186, 189, 193, 196,
+		198, 201, 205, 208, 211, 214, 217, 220, 223, 227, 231, 235, 239, 243, 247, 251,
+		255, 259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 299, 302, 305, 308, 311,
+		314, 314, 318, 321, 324, 327, 332, 335, 338, 338, 338, 341, 344, 347, 350, 353,
+		356, 356, 356, 356, 356, 356, 356, 356, 356, 359, 362, 366, 369, 372, 375, 378,
+		382, 385, 388, 391, 394, 397, 401, 404, 407, 410, 413, 417, 420, 423, 426, 429,
+		432, 435, 438, 441, 444, 447, 451, 454, 457, 461, 466, 471, 476, 479, 483, 487,
+		490, 494, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497,
+		497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497,
+		497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497, 497,
+		497, 497, 497, 497, 497, 497, 497, 497, 497, 0, 3, 4, 5, 6, 7, 8,
+		10, 11, 13, 14, 16, 17, 18, 20, 21, 22, 24, 26, 27, 28, 30, 31,
+		33, 34, 36, 38, 39, 41, 42, 44, 46, 48, 51, 53, 56, 58, 59, 61,
+		62, 64, 65, 68, 69, 71, 72, 74, 75, 76, 77, 79, 80, 81, 84, 85,
+		87, 89, 90, 91, 94, 97, 98, 99, 101, 102, 104, 105, 107, 108, 110, 112,
+		113, 114, 116, 118, 119, 120, 122, 122, 125, 128, 131, 132, 133, 134, 135, 138,
+		141, 144, 145, 148, 151, 152, 156, 158, 159, 161, 163, 165, 166, 168, 171, 175,
+		176, 177, 178, 179, 180, 181, 182, 183, 184, 185, 186, 189, 190, 192, 195, 197,
+		199, 202, 205, 208, 210, 213, 216, 220, 224, 227, 230, 231, 232, 233, 236, 237,
+		239, 240, 242, 243, 245, 246, 248, 251, 254, 255, 257, 260, 263, 264, 266, 267,
+		269, 270, 272, 274, 275, 277, 278, 280, 282, 284, 287, 289, 292, 294, 295, 297,
+		298, 300, 301, 304, 305, 307, 308, 310, 311, 312, 313, 316, 317, 320, 323, 326,
+		328, 330, 331, 332, 335, 338, 339, 347, 349, 350, 352, 353, 355, 356, 358, 361,
+		364, 365, 367, 370, 373, 374, 376, 376, 377, 380, 383, 386, 389, 392, 395, 398,
+		401, 402, 405, 408, 411, 414, 416, 418, 421, 423, 425, 427, 428, 430, 433, 436,
+		437, 438, 439, 440, 441, 442, 443, 444, 445, 446, 448, 450, 452, 454, 456, 458,
+		460, 462, 469, 472, 472, 475, 483, 486, 489, 492, 0, 3, 6, 7, 10, 13,
+		14, 16, 17, 19, 20, 22, 25, 28, 31, 34, 36, 38, 41, 44, 46, 48,
+		49, 51, 52, 54, 56, 57, 59, 60, 62, 64, 66, 69, 71, 74, 76, 77,
+		79, 80, 82, 83, 86, 87, 89, 90, 92, 93, 94, 95, 98, 99, 101, 104,
+		105, 107, 110, 111, 112, 115, 118, 119, 122, 124, 125, 127, 128, 130, 133, 136,
+		139, 142, 144, 146, 149, 152, 154, 156, 156, 159, 162, 165, 168, 171, 174, 177,
+		180, 183, 186, 189, 192, 195, 196, 198, 201, 202, 205, 208, 211, 214, 217, 220,
+		223, 224, 225, 226, 227, 228, 229, 230, 231, 232, 233, 234, 235, 235, 235, 241,
+		244, 247, 250, 253, 256, 259, 262, 265, 268, 271, 274, 277, 278, 279, 280, 283,
+		284, 286, 287, 289, 290, 292, 293, 294, 296, 299, 300, 302, 304, 307, 308, 310,
+		311, 313, 314, 316, 318, 319, 321, 322, 324, 326, 328, 331, 333, 336, 338, 339,
+		341, 342, 344, 345, 348, 349, 351, 352, 354, 355, 357, 358, 361, 362, 364, 367,
+		368, 370, 372, 373, 374, 377, 380, 381, 382, 384, 385, 387, 388, 390, 391, 393,
+		395, 398, 399, 401, 403, 406, 407, 409, 409, 412, 415, 418, 421, 424, 427, 430,
+		433, 436, 439, 442, 445, 448, 451, 454, 457, 460, 463, 465, 466, 469, 472, 475,
+		478, 479, 480, 481, 482, 483, 484, 485, 486, 487, 488, 491, 494, 497, 500, 503,
+		506, 509, 512, 515, 518, 521, 524, 527, 530, 533, 533, 0, 3, 4, 5, 6,
+		9, 10, 12, 13, 15, 16, 18, 19, 20, 23, 26, 27, 29, 32, 35, 36,
+		38, 39, 41, 42, 44, 46, 47, 49, 50, 52, 54, 56, 59, 61, 64, 66,
+		67, 69, 70, 72, 73, 76, 77, 79, 80, 82, 83, 84, 85, 88, 89, 91,
+		94, 94, 96, 98, 99, 100, 103, 106, 107, 108, 110, 111, 113, 114, 116, 117,
+		120, 123, 126, 127, 129, 132, 135, 136, 138, 138, 141, 144, 147, 150, 153, 156,
+		159, 162, 163, 164, 167, 170, 173, 176, 178, 180, 183, 185, 187, 189, 192, 195,
+		198, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 211, 213, 216, 219,
+		222, 226, 229, 233, 236, 239, 242, 245, 248, 251, 254, 257, 260, 263, 264, 265,
+		268, 269, 271, 272, 274, 275, 277, 280, 283, 286, 287, 289, 291, 294, 295, 297,
+		299, 300, 303, 306, 309, 311, 312, 315, 316, 319, 321, 323, 326, 329, 332, 334,
+		335, 338, 341, 344, 345, 348, 349, 352, 355, 358, 359, 360, 361, 363, 364, 366,
+		369, 370, 372, 374, 375, 376, 379, 382, 385, 388, 390, 391, 393, 394, 396, 399,
+		402, 405, 406, 408, 410, 413, 414, 416, 418, 418, 421, 424, 427, 430, 433, 436,
+		439, 442, 445, 446, 449, 452, 455, 458, 461, 464, 467, 470, 473, 476, 479, 482,
+		485, 488, 489, 490, 491, 492, 493, 494, 495, 496, 497, 498, 502, 507, 513, 516,
+		519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 552, 0, 3, 4, 5,
+		6, 9, 10, 12, 13, 15, 16, 18, 19, 20, 23, 24, 26, 28, 31, 32,
+		34, 36, 37, 39, 40, 42, 44, 45, 47, 48, 50, 52, 54, 57, 59, 62,
+		64, 65, 67, 68, 70, 71, 74, 75, 77, 78, 80, 81, 82, 83, 85, 86,
+		88, 92, 93, 95, 97, 98, 99, 102, 105, 108, 116, 118, 119, 121, 122, 124,
+		125, 127, 130, 131, 133, 135, 138, 139, 141, 143, 143, 146, 149, 152, 155, 158,
+		161, 164, 165, 166, 169, 172, 175, 179, 182, 185, 190, 193, 196, 198, 200, 203,
+		206, 209, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 225, 228, 231,
+		234, 237, 240, 243, 246, 247, 248, 249, 250, 251, 252, 253, 256, 267, 270, 271,
+		272, 275, 276, 278, 279, 281, 282, 284, 285, 286, 289, 290, 292, 294, 297, 298,
+		300, 302, 303, 305, 306, 308, 310, 311, 313, 314, 316, 318, 320, 323, 325, 328,
+		330, 331, 333, 334, 336, 337, 340, 341, 343, 344, 346, 347, 348, 349, 351, 352,
+		354, 357, 358, 360, 362, 363, 364, 367, 370, 373, 381, 383, 384, 386, 387, 389,
+		390, 392, 395, 396, 398, 400, 403, 404, 406, 408, 408, 411, 414, 417, 420, 423,
+		426, 429, 430, 431, 434, 437, 440, 443, 446, 449, 454, 457, 460, 462, 464, 467,
+		470, 473, 476, 477, 478, 479, 480, 481, 482, 483, 484, 485, 486, 489, 500, 511,
+		514, 517, 520, 523, 526, 529, 532, 535, 538, 541, 544, 547, 550, 0, 3, 6,
+		7, 8, 16, 17, 19, 20, 22, 23, 25, 26, 27, 30, 31, 33, 35, 38,
+		39, 41, 43, 44, 46, 47, 49, 51, 52, 54, 55, 57, 59, 61, 64, 66,
+		69, 71, 72, 74, 75, 77, 78, 82, 83, 85, 86, 88, 89, 90, 91, 93,
+		94, 96, 99, 100, 102, 104, 105, 106, 110, 113, 116, 124, 126, 127, 129, 130,
+		132, 133, 136, 139, 140, 142, 144, 144, 145, 147, 149, 149, 153, 156, 159, 162,
+		165, 168, 169, 170, 173, 174, 179, 183, 187, 191, 195, 199, 202, 204, 206, 208,
+		211, 214, 217, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229, 230, 232, 235,
+		239, 242, 245, 248, 252, 255, 259, 262, 264, 265, 267, 268, 270, 271, 274, 277,
+		278, 279, 282, 283, 285, 287, 290, 291, 293, 294, 296, 297, 299, 300, 302, 303,
+		305, 307, 308, 310, 312, 315, 318, 321, 322, 324, 325, 327, 329, 332, 333, 335,
+		336, 338, 340, 343, 346, 348, 351, 353, 356, 358, 362, 363, 365, 366, 368, 369,
+		372, 374, 375, 377, 378, 380, 381, 383, 384, 385, 388, 389, 392, 395, 396, 398,
+		400, 401, 402, 404, 405, 408, 411, 414, 414, 417, 420, 423, 426, 428, 430, 433,
+		434, 436, 437, 440, 442, 445, 446, 447, 449, 451, 452, 454, 456, 457, 460, 463,
+		466, 469, 472, 475, 476, 477, 478, 479, 480, 481, 482, 483, 484, 485, 488, 491,
+		493, 495, 498, 501, 504, 507, 510, 513, 516, 519, 522, 525, 528, 531, 0, 3,
+		4, 6, 8, 10, 12, 14, 16, 19, 21, 23, 25, 27, 28, 29, 30, 32,
+		34, 36, 37, 38, 39, 41, 43, 45, 46, 47, 48, 50, 51, 53, 54, 56,
+		57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72,
+		73, 75, 77, 78, 80, 82, 85, 86, 88, 89, 92, 95, 98, 101, 104, 105,
+		107, 108, 110, 112, 114, 115, 115, 115, 115, 115, 115, 115, 116, 116, 119, 120,
+		121, 122, 123, 124, 125, 126, 127, 128, 129, 133, 138, 141, 144, 147, 150, 153,
+		156, 159, 162, 165, 168, 171, 174, 177, 180, 183, 186, 189, 192, 195, 198, 201,
+		204, 207, 210, 213, 216, 219, 222, 225, 228, 231, 234, 237, 240, 243, 246, 249,
+		250, 252, 255, 257, 260, 263, 265, 267, 270, 271, 274, 277, 279, 282, 285, 289,
+		292, 296, 299, 300, 301, 303, 305, 308, 309, 310, 311, 313, 314, 316, 317, 320,
+		321, 322, 323, 326, 327, 330, 331, 334, 337, 338, 339, 342, 343, 343, 344, 345,
+		345, 347, 349, 350, 352, 353, 355, 356, 358, 361, 362, 363, 365, 368, 371, 372,
+		374, 375, 377, 379, 382, 383, 386, 386, 386, 386, 386, 386, 387, 390, 393, 394,
+		395, 396, 397, 398, 399, 400, 401, 402, 403, 406, 409, 411, 413, 415, 418, 421,
+		424, 427, 430, 433, 436, 439, 442, 445, 448, 451, 454, 457, 460, 463, 466, 469,
+		472, 475, 478, 481, 484, 487, 490, 493, 496, 499, 502, 505, 508, 511, 514, 0,
+		3, 3, 3, 3, 3, 3, 3, 3, 7, 10, 10, 11, 14, 17, 21, 25,
+		29, 33, 39, 44, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+		47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 58, 61, 64, 67, 70, 73,
+		76, 79, 82, 85, 86, 87, 88, 89, 89, 90, 93, 94, 96, 98, 98, 98,
+		99, 101, 102, 104, 106, 107, 109, 110, 113, 115, 117, 120, 122, 125, 127, 128,
+		130, 131, 133, 134, 135, 137, 138, 140, 141, 143, 146, 148, 151, 152, 154, 155,
+		156, 157, 158, 159, 161, 164, 165, 166, 167, 170, 171, 174, 177, 180, 183, 186,
+		189, 191, 192, 194, 195, 197, 198, 200, 201, 203, 204, 206, 207, 209, 210, 211,
+		212, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 217, 220, 223, 226,
+		227, 229, 230, 232, 234, 235, 237, 238, 241, 243, 245, 248, 250, 253, 255, 256,
+		258, 259, 261, 262, 263, 265, 266, 268, 269, 271, 274, 276, 279, 280, 282, 283,
+		284, 285, 286, 287, 289, 291, 292, 293, 294, 297, 298, 299, 300, 303, 304, 309,
+		314, 319, 324, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 332, 335, 335,
+		338, 341, 344, 347, 350, 353, 356, 359, 362, 365, 368, 371, 374, 377, 380, 383,
+		386, 389, 392, 395, 398, 401, 404, 407, 410, 413, 416, 419, 422, 425, 428, 431,
+		434, 437, 440, 443, 446, 449, 452, 455, 458, 461, 464, 467, 470, 473, 476, 479,
+		0, 1, 3, 4, 6, 8, 9, 11, 12, 14, 16, 19, 21, 24, 26, 29,
+		31, 33, 35, 36, 38, 39, 40, 42, 43, 45, 46, 47, 48, 49, 50, 51,
+		52, 54, 55, 56, 57, 59, 60, 62, 63, 64, 65, 67, 70, 72, 73, 75,
+		76, 78, 79, 81, 84, 87, 90, 91, 92, 93, 93, 96, 99, 102, 105, 108,
+		110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 123, 127, 129, 131, 133,
+		135, 137, 139, 140, 142, 143, 145, 146, 148, 149, 151, 154, 157, 160, 163, 166,
+		169, 172, 175, 178, 181, 184, 187, 190, 193, 196, 199, 202, 205, 208, 211, 214,
+		217, 221, 224, 227, 230, 233, 235, 238, 240, 242, 244, 247, 249, 251, 254, 256,
+		258, 261, 263, 266, 269, 272, 275, 278, 281, 284, 287, 290, 293, 296, 299, 301,
+		304, 305, 306, 307, 308, 309, 310, 311, 312, 313, 314, 317, 320, 323, 326, 329,
+		332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 343, 344, 345, 346, 347, 348,
+		350, 351, 352, 353, 354, 356, 358, 360, 361, 363, 366, 368, 370, 371, 373, 374,
+		375, 376, 377, 378, 379, 381, 383, 386, 388, 391, 394, 397, 400, 403, 406, 409,
+		412, 413, 414, 415, 416, 417, 418, 419, 421, 422, 423, 424, 425, 426, 427, 428,
+		430, 431, 432, 433, 434, 436, 438, 440, 441, 443, 446, 448, 450, 451, 453, 454,
+		455, 456, 457, 458, 459, 461, 463, 464, 466, 471, 474, 477, 481, 482, 485, 489,
+		495, 0, 2, 4, 6, 9, 12, 14, 16, 19, 21, 23, 25, 28, 31, 33,
+		35, 38, 41, 44, 47, 51, 55, 58, 61, 65, 67, 69, 71, 74, 77, 79,
+		81, 84, 87, 90, 93, 97, 101, 104, 107, 111, 113, 115, 117, 120, 123, 125,
+		127, 130, 132, 134, 136, 139, 142, 144, 146, 149, 152, 155, 158, 162, 166, 169,
+		172, 176, 178, 180, 182, 185, 188, 190, 192, 195, 198, 201, 204, 208, 212, 215,
+		218, 221, 224, 227, 230, 234, 238, 241, 244, 247, 251, 254, 258, 263, 268, 272,
+		275, 278, 280, 282, 284, 287, 290, 292, 294, 297, 299, 301, 303, 306, 309, 311,
+		313, 316, 318, 320, 322, 325, 328, 330, 332, 335, 337, 339, 341, 344, 347, 349,
+		351, 354, 356, 358, 360, 363, 366, 368, 370, 373, 376, 379, 382, 386, 390, 393,
+		396, 399, 401, 403, 405, 408, 411, 413, 415, 418, 421, 424, 427, 431, 435, 438,
+		441, 445, 447, 449, 450, 453, 456, 458, 460, 463, 465, 467, 469, 472, 475, 477,
+		479, 482, 485, 488, 491, 495, 499, 502, 505, 508, 511, 514, 517, 521, 525, 528,
+		531, 534, 538, 541, 545, 550, 555, 559, 562, 565, 567, 569, 571, 574, 577, 579,
+		581, 584, 586, 588, 590, 593, 596, 598, 600, 603, 605, 607, 609, 612, 615, 617,
+		619, 622, 625, 628, 631, 635, 639, 642, 645, 649, 651, 653, 655, 658, 661, 663,
+		665, 668, 670, 672, 674, 677, 680, 682, 684, 687, 690, 693, 696, 700, 704, 707,
+		710, 714, 0, 2, 4, 6, 9, 12, 14, 16, 19, 21, 23, 25, 28, 31,
+		33, 35, 38, 41, 44, 47, 51, 55, 58, 61, 64, 67, 70, 73, 77, 81,
+		84, 87, 92, 95, 98, 101, 105, 109, 112, 115, 119, 122, 125, 128, 132, 136,
+		139, 142, 146, 149, 152, 155, 159, 163, 166, 169, 173, 176, 179, 182, 186, 190,
+		193, 196, 200, 203, 206, 209, 213, 217, 220, 223, 227, 229, 231, 233, 236, 239,
+		241, 243, 246, 248, 250, 252, 255, 258, 260, 262, 265, 268, 271, 274, 277, 280,
+		283, 286, 289, 292, 293, 294, 295, 296, 297, 300, 301, 303, 304, 305, 306, 307,
+		308, 309, 310, 311, 312, 315, 318, 321, 324, 327, 330, 333, 336, 339, 343, 350,
+		353, 356, 359, 362, 365, 369, 372, 375, 378, 382, 385, 388, 391, 395, 398, 401,
+		404, 408, 411, 414, 417, 420, 423, 426, 429, 432, 435, 438, 441, 444, 447, 450,
+		453, 456, 459, 460, 461, 462, 463, 464, 465, 467, 469, 471, 473, 475, 477, 479,
+		481, 483, 485, 487, 489, 491, 493, 495, 497, 499, 501, 503, 505, 507, 509, 511,
+		513, 515, 518, 521, 523, 525, 527, 529, 531, 534, 537, 540, 543, 546, 549, 551,
+		552, 554, 556, 558, 560, 562, 564, 566, 568, 570, 572, 574, 576, 578, 580, 583,
+		586, 589, 592, 595, 598, 601, 604, 607, 610, 613, 616, 619, 621, 623, 625, 627,
+		629, 631, 633, 635, 637, 639, 641, 643, 645, 648, 651, 653, 655, 657, 659, 661,
+		663, 666, 669, 0, 3, 4, 7, 8, 10, 11, 13, 15, 17, 18, 19, 21,
+		23, 25, 27, 29, 32, 35, 37, 39, 42, 45, 48, 50, 52, 55, 58, 61,
+		63, 64, 65, 66, 67, 69, 70, 71, 72, 73, 80, 81, 82, 83, 84, 86,
+		88, 90, 92, 94, 98, 100, 103, 105, 108, 111, 114, 116, 118, 121, 124, 127,
+		130, 133, 137, 141, 144, 147, 151, 155, 158, 161, 165, 169, 173, 174, 175, 176,
+		178, 182, 184, 187, 189, 192, 195, 198, 200, 202, 205, 208, 211, 214, 217, 221,
+		225, 228, 231, 235, 239, 242, 245, 249, 253, 257, 258, 261, 264, 267, 270, 272,
+		276, 278, 281, 283, 286, 289, 291, 294, 297, 300, 303, 306, 310, 314, 317, 320,
+		324, 328, 331, 334, 338, 342, 346, 347, 349, 352, 355, 358, 361, 363, 367, 369,
+		372, 374, 377, 380, 382, 385, 388, 391, 394, 397, 401, 405, 408, 411, 415, 419,
+		422, 425, 429, 433, 437, 438, 440, 442, 446, 448, 451, 453, 456, 459, 461, 464,
+		467, 470, 473, 476, 480, 484, 487, 490, 494, 498, 501, 504, 508, 512, 516, 517,
+		518, 520, 521, 522, 524, 528, 530, 533, 535, 538, 541, 543, 546, 549, 552, 555,
+		558, 562, 566, 570, 571, 573, 575, 577, 581, 583, 586, 588, 591, 594, 596, 599,
+		602, 605, 608, 611, 615, 619, 622, 625, 629, 633, 636, 639, 643, 647, 648, 649,
+		650, 652, 656, 658, 661, 663, 666, 669, 671, 674, 677, 680, 683, 686, 690, 694,
+		697, 700, 704, 708, 0, 3, 6, 10, 14, 18, 19, 20, 22, 23, 25, 28,
+		30, 34, 38, 42, 46, 49, 52, 56, 59, 63, 66, 70, 74, 78, 82, 86,
+		91, 96, 100, 104, 109, 114, 118, 122, 127, 132, 134, 136, 140, 142, 145, 147,
+		150, 153, 155, 158, 161, 164, 167, 170, 174, 178, 181, 184, 188, 192, 195, 198,
+		202, 206, 210, 211, 212, 213, 215, 217, 219, 221, 225, 227, 230, 232, 235, 237,
+		239, 242, 244, 248, 252, 253, 254, 255, 257, 261, 263, 266, 268, 271, 273, 276,
+		280, 284, 285, 288, 291, 294, 297, 301, 305, 308, 312, 315, 319, 324, 329, 331,
+		335, 339, 343, 347, 350, 353, 356, 359, 362, 364, 366, 369, 371, 374, 376, 379,
+		380, 381, 383, 387, 389, 392, 394, 397, 399, 402, 403, 407, 411, 415, 419, 421,
+		423, 425, 427, 432, 435, 439, 442, 446, 449, 453, 455, 458, 461, 464, 467, 470,
+		473, 476, 479, 482, 484, 487, 491, 494, 498, 501, 505, 507, 510, 513, 517, 520,
+		524, 527, 531, 533, 534, 535, 536, 537, 538, 540, 542, 544, 546, 548, 550, 552,
+		554, 556, 558, 560, 562, 564, 566, 568, 570, 573, 576, 579, 583, 586, 589, 591,
+		593, 595, 598, 600, 602, 604, 606, 608, 611, 613, 615, 618, 621, 624, 628, 631,
+		634, 637, 640, 643, 647, 650, 653, 656, 659, 662, 666, 669, 672, 674, 676, 678,
+		681, 683, 685, 686, 688, 690, 692, 695, 697, 699, 702, 705, 708, 712, 715, 718,
+		721, 724, 727, 731, 734, 0, 3, 5, 7, 9, 11, 14, 16, 18, 20, 22,
+		24, 27, 29, 31, 33, 35, 37, 40, 42, 44, 46, 48, 50, 52, 55, 57,
+		59, 61, 64, 67, 70, 74, 77, 80, 82, 84, 86, 89, 91, 93, 96, 99,
+		102, 106, 109, 112, 115, 118, 121, 125, 128, 131, 135, 139, 143, 148, 152, 156,
+		159, 162, 165, 169, 172, 175, 177, 179, 181, 184, 186, 188, 189, 190, 193, 196,
+		199, 203, 206, 209, 211, 213, 215, 218, 220, 222, 225, 228, 231, 235, 238, 241,
+		243, 246, 249, 252, 256, 259, 262, 265, 268, 271, 275, 278, 281, 285, 289, 293,
+		298, 302, 306, 307, 308, 311, 315, 319, 324, 328, 333, 337, 342, 347, 351, 356,
+		360, 365, 369, 374, 376, 377, 378, 379, 380, 381, 382, 383, 384, 385, 386, 387,
+		388, 389, 390, 392, 393, 394, 395, 396, 397, 398, 399, 401, 403, 405, 406, 407,
+		408, 409, 410, 413, 416, 419, 420, 421, 422, 424, 425, 426, 428, 430, 431, 432,
+		434, 436, 437, 438, 439, 441, 443, 444, 445, 446, 447, 448, 450, 451, 452, 453,
+		454, 455, 456, 457, 458, 459, 460, 461, 462, 463, 464, 466, 467, 469, 470, 471,
+		472, 473, 474, 475, 476, 477, 478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+		488, 489, 491, 493, 494, 495, 498, 501, 503, 505, 507, 508, 510, 512, 514, 515,
+		516, 517, 518, 519, 521, 523, 525, 526, 528, 530, 532, 534, 536, 538, 542, 545,
+		548, 551, 554, 557, 560, 563, 0, 1, 2, 3, 5, 7, 10, 12, 14, 16,
+		18, 20, 22, 24, 26, 28, 30, 32, 34, 37, 40, 43, 46, 49, 52, 55,
+		58, 61, 64, 67, 70, 73, 75, 76, 77, 78, 80, 82, 85, 87, 89, 91,
+		93, 95, 97, 99, 101, 103, 105, 107, 109, 112, 115, 118, 121, 124, 127, 130,
+		133, 136, 139, 142, 145, 148, 151, 152, 153, 154, 156, 158, 161, 163, 165, 167,
+		169, 171, 173, 175, 177, 179, 181, 183, 185, 188, 191, 194, 197, 200, 203, 206,
+		209, 212, 215, 218, 221, 224, 227, 228, 229, 230, 232, 234, 237, 239, 241, 243,
+		245, 247, 249, 251, 254, 256, 258, 260, 263, 266, 269, 272, 275, 278, 281, 284,
+		287, 290, 293, 296, 299, 302, 305, 306, 308, 309, 311, 313, 314, 316, 317, 319,
+		321, 322, 325, 326, 329, 331, 332, 334, 335, 337, 338, 339, 341, 342, 344, 345,
+		346, 347, 348, 349, 351, 353, 354, 355, 356, 357, 358, 360, 361, 363, 364, 366,
+		368, 371, 373, 376, 378, 381, 382, 384, 386, 388, 390, 391, 393, 395, 396, 398,
+		399, 401, 402, 404, 406, 408, 410, 412, 413, 415, 417, 419, 421, 422, 423, 425,
+		425, 425, 425, 426, 426, 427, 427, 427, 427, 427, 427, 428, 432, 433, 434, 436,
+		439, 444, 446, 447, 450, 453, 456, 457, 458, 459, 460, 461, 462, 463, 464, 465,
+		466, 469, 472, 475, 478, 481, 484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+		494, 497, 500, 503, 506, 509, 512, 0, 3, 8, 10, 12, 14, 18, 18, 19,
+		21, 23, 23, 23, 23, 23, 23, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+		35, 36, 39, 42, 45, 48, 51, 54, 55, 56, 57, 58, 59, 60, 61, 63,
+		64, 66, 67, 68, 69, 70, 71, 72, 73, 75, 76, 77, 79, 80, 81, 82,
+		83, 84, 85, 88, 90, 91, 92, 94, 96, 98, 100, 101, 102, 103, 104, 105,
+		106, 107, 109, 110, 111, 112, 113, 114, 115, 116, 118, 119, 121, 122, 123, 124,
+		125, 126, 128, 130, 132, 133, 134, 136, 137, 138, 140, 141, 142, 143, 144, 146,
+		147, 148, 149, 150, 151, 152, 154, 155, 156, 158, 160, 161, 162, 163, 164, 166,
+		169, 172, 175, 178, 181, 184, 187, 190, 193, 194, 195, 196, 197, 200, 205, 206,
+		207, 208, 210, 211, 213, 216, 218, 220, 221, 222, 223, 225, 227, 229, 230, 231,
+		232, 234, 236, 238, 239, 241, 244, 247, 248, 250, 252, 254, 256, 257, 258, 259,
+		261, 262, 265, 268, 271, 274, 277, 280, 282, 284, 287, 290, 293, 296, 300, 303,
+		306, 310, 313, 316, 319, 322, 325, 328, 332, 336, 341, 344, 347, 350, 353, 356,
+		360, 364, 367, 370, 374, 378, 382, 385, 389, 392, 396, 399, 400, 401, 402, 403,
+		404, 405, 406, 408, 409, 410, 414, 417, 420, 423, 426, 430, 434, 439, 444, 449,
+		454, 459, 463, 467, 470, 473, 476, 479, 482, 485, 488, 489, 490, 491, 494, 497,
+		500, 503, 506, 509, 512, 515, 518, 521, 0, 7, 9, 12, 14, 17, 20, 22,
+		25, 27, 30, 33, 35, 38, 40, 43, 45, 47, 50, 52, 55, 57, 59, 61,
+		63, 65, 68, 71, 73, 75, 79, 82, 85, 85, 85, 85, 85, 85, 85, 85,
+		85, 85, 85, 85, 85, 88, 91, 94, 97, 97, 97, 97, 97, 97, 97, 97,
+		97, 97, 97, 97, 97, 100, 103, 106, 109, 109, 112, 115, 118, 118, 118, 119,
+		120, 121, 122, 123, 124, 125, 126, 127, 128, 130, 132, 135, 138, 140, 142, 144,
+		147, 149, 151, 154, 156, 158, 160, 162, 164, 167, 171, 173, 174, 175, 177, 179,
+		180, 182, 183, 185, 186, 189, 191, 194, 197, 202, 207, 212, 217, 222, 225, 228,
+		231, 234, 237, 240, 243, 246, 249, 252, 255, 257, 259, 261, 263, 266, 268, 270,
+		273, 276, 278, 280, 283, 285, 287, 289, 292, 294, 296, 299, 301, 303, 306, 308,
+		310, 313, 315, 317, 319, 321, 323, 325, 327, 329, 331, 333, 335, 337, 339, 342,
+		345, 348, 351, 354, 357, 360, 363, 366, 369, 378, 380, 382, 383, 385, 386, 388,
+		389, 391, 393, 395, 398, 400, 402, 405, 408, 410, 411, 413, 414, 415, 416, 417,
+		418, 421, 424, 427, 430, 433, 436, 439, 442, 443, 444, 445, 446, 447, 448, 449,
+		450, 451, 452, 453, 456, 459, 462, 462, 462, 462, 462, 462, 462, 462, 462, 462,
+		462, 462, 462, 462, 462, 462, 462, 462, 462, 462, 462, 462, 462, 462, 462, 462,
+		462, 462, 462, 462, 462, 462, 462, 462, 462, 0, 2, 4, 7, 11, 13, 15,
+		17, 20, 22, 24, 26, 29, 31, 33, 36, 40, 42, 44, 46, 48, 50, 51,
+		53, 53, 53, 53, 53, 53, 56, 59, 59, 59, 61, 64, 67, 69, 72, 75,
+		78, 80, 83, 85, 87, 90, 93, 97, 102, 104, 109, 113, 115, 118, 120, 123,
+		125, 127, 129, 132, 134, 136, 138, 141, 143, 145, 147, 149, 152, 154, 157, 159,
+		162, 165, 167, 169, 172, 173, 175, 176, 178, 179, 181, 183, 185, 188, 190, 190,
+		190, 190, 190, 190, 190, 190, 190, 190, 190, 193, 193, 193, 193, 193, 193, 193,
+		193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193,
+		193, 193, 193, 193, 193, 193, 193, 196, 199, 199, 200, 201, 202, 203, 204, 205,
+		206, 207, 208, 209, 212, 215, 218, 221, 224, 227, 228, 229, 230, 231, 232, 233,
+		234, 235, 236, 237, 240, 243, 246, 249, 252, 255, 255, 255, 255, 255, 255, 255,
+		255, 260, 260, 260, 260, 260, 260, 260, 263, 266, 266, 266, 266, 266, 266, 266,
+		266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266,
+		266, 266, 266, 266, 266, 266, 266, 266, 266, 269, 272, 275, 278, 281, 284, 287,
+		290, 293, 296, 299, 302, 305, 308, 311, 314, 317, 320, 323, 326, 329, 332, 335,
+		338, 341, 344, 347, 350, 353, 356, 359, 362, 365, 368, 371, 374, 377, 380, 383,
+		386, 389, 392, 395, 398, 401, 404, 407, 410, 413, 0, 0, 0, 0, 0, 0,
+		5, 10, 15, 20, 25, 30, 34, 38, 43, 48, 53, 59, 64, 69, 71, 73,
+		75, 79, 82, 84, 88, 90, 94, 97, 102, 104, 106, 108, 114, 116, 120, 122,
+		126, 128, 130, 132, 134, 141, 143, 145, 147, 149, 151, 155, 157, 159, 161, 161,
+		161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+		164, 168, 172, 174, 176, 179, 185, 189, 192, 195, 198, 199, 200, 201, 202, 203,
+		204, 205, 206, 207, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
+		208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
+		208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 211, 211, 211, 211, 212, 213,
+		214, 216, 217, 218, 220, 222, 224, 226, 229, 231, 233, 235, 238, 240, 242, 244,
+		246, 248, 250, 252, 254, 256, 258, 260, 262, 264, 266, 268, 268, 268, 268, 268,
+		268, 268, 268, 268, 268, 268, 268, 268, 268, 271, 274, 275, 276, 277, 278, 279,
+		280, 281, 282, 283, 284, 287, 290, 293, 296, 297, 298, 299, 300, 302, 304, 306,
+		308, 310, 312, 314, 316, 318, 320, 322, 324, 326, 328, 330, 332, 334, 336, 338,
+		340, 342, 344, 346, 348, 350, 352, 354, 357, 359, 361, 364, 366, 369, 372, 373,
+		374, 374, 374, 374, 374, 374, 374, 374, 374, 374, 374, 374, 374, 374, 374, 377,
+		380, 383, 386, 389, 392, 395, 398, 398, 398, 398, 398, 0, 2, 5, 8, 10,
+		13, 16, 18, 21, 23, 26, 28, 31, 33, 35, 37, 40, 43, 45, 48, 50,
+		53, 55, 58, 61, 65, 68, 70, 72, 74, 76, 79, 81, 83, 86, 88, 89,
+		89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89,
+		89, 89, 89, 89, 92, 95, 98, 98, 98, 98, 98, 98, 99, 100, 101, 102,
+		103, 104, 105, 106, 107, 108, 111, 114, 117, 120, 124, 127, 128, 129, 130, 131,
+		132, 133, 134, 135, 136, 137, 139, 141, 143, 146, 148, 151, 154, 157, 160, 163,
+		165, 167, 169, 172, 174, 176, 178, 180, 183, 185, 187, 189, 192, 194, 197, 199,
+		202, 204, 206, 208, 211, 214, 217, 220, 223, 226, 226, 226, 228, 230, 231, 233,
+		235, 237, 241, 244, 246, 249, 252, 255, 258, 261, 264, 267, 269, 272, 275, 278,
+		280, 283, 286, 289, 291, 294, 297, 300, 303, 305, 308, 312, 315, 318, 321, 323,
+		327, 331, 335, 338, 342, 346, 349, 352, 355, 359, 362, 366, 369, 371, 374, 376,
+		379, 382, 384, 386, 391, 394, 397, 400, 403, 406, 410, 416, 416, 416, 416, 416,
+		416, 416, 416, 416, 419, 422, 425, 428, 431, 434, 437, 440, 440, 440, 440, 440,
+		440, 440, 440, 440, 440, 440, 440, 440, 440, 440, 440, 440, 440, 440, 440, 440,
+		440, 440, 440, 440, 440, 452, 464, 475, 486, 486, 494, 502, 510, 515, 527, 539,
+		539, 550, 561, 561, 561, 561, 573, 576, 579, 582, 585, 588, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7,
+		8, 9, 10, 11, 12, 12, 12, 12, 12, 12, 13, 13, 13, 14, 15, 16,
+		17, 18, 19, 20, 21, 22, 23, 24, 24, 25, 26, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 0, 1, 2,
+		3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
+		19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+		35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50,
+		51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
+		67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
+		83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+		99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+		115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+		131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+		147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 160, 165, 166, 167,
+		168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+		184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+		200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+		216, 217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
+		232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244, 245, 246, 247,
+		248, 249, 250, 251, 252, 253, 254, 255, 257, 259, 260, 261, 262, 263, 0, 1,
+		2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17,
+		18, 19, 20, 21, 22, 25, 28, 29, 30, 31, 32, 33, 34, 37, 40, 41,
+		42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57,
+		58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73,
+		74, 75, 76, 77, 78, 81, 84, 85, 86, 87, 88, 89, 90, 93, 96, 97,
+		98, 99, 100, 101, 102, 103, 104, 107, 108, 111, 112, 115, 116, 119, 120, 121,
+		122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
+		138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 153, 156, 157,
+		158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173,
+		174, 175, 176, 177, 178, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
+		190, 191, 192, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
+		206, 207, 208, 209, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+		225, 226, 227, 228, 231, 232, 233, 234, 235, 236, 237, 238, 240, 242, 244, 245,
+		246, 247, 248, 251, 254, 255, 256, 257, 258, 259, 260, 263, 265, 267, 269, 270,
+		271, 272, 273, 274, 275, 276, 277, 278, 279, 280, 281, 282, 284, 286, 287, 290,
+		293, 294, 295, 296, 299, 300, 301, 302, 303, 304, 305, 306, 307, 308, 311, 0,
+		1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 12, 12, 12, 12,
+		13, 14, 15, 16, 18, 20, 22, 23, 24, 25, 26, 27, 28, 29, 31, 32,
+		33, 35, 36, 38, 39, 41, 44, 45, 46, 48, 48, 48, 48, 48, 48, 49,
+		51, 54, 55, 57, 60, 61, 63, 66, 67, 68, 69, 70, 72, 74, 75, 76,
+		77, 78, 81, 83, 84, 86, 88, 90, 92, 94, 95, 97, 99, 101, 102, 105,
+		108, 111, 112, 113, 116, 119, 122, 125, 128, 131, 134, 137, 140, 143, 146, 149,
+		149, 152, 155, 158, 161, 164, 167, 170, 173, 176, 176, 176, 176, 176, 176, 176,
+		177, 177, 177, 177, 178, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
+		190, 191, 192, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 207,
+		208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218, 219, 220, 223, 226, 229,
+		232, 234, 236, 238, 239, 242, 243, 246, 248, 249, 251, 252, 255, 256, 257, 259,
+		262, 265, 268, 271, 274, 277, 280, 283, 286, 289, 292, 295, 298, 301, 304, 307,
+		310, 313, 316, 319, 322, 325, 328, 331, 334, 337, 340, 343, 346, 349, 352, 355,
+		355, 355, 355, 355, 355, 355, 355, 355, 355, 355, 355, 355, 355, 355, 355, 355,
+		355, 355, 355, 355, 358, 358, 361, 364, 367, 370, 373, 376, 379, 382, 385, 388,
+		391, 394, 397, 400, 403, 406, 409, 412, 415, 418, 421, 424, 427, 430, 433, 436,
+		0, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
+		2, 2, 2, 2, 2, 2, 3, 3, 3, 3, 4, 5, 5, 5, 6, 6,
+		6, 10, 13, 17, 17, 18, 18, 18, 18, 18, 18, 19, 20, 20, 20, 21,
+		22, 23, 24, 25, 26, 26, 26, 26, 26, 26, 26, 26, 29, 29, 29, 29,
+		29, 32, 35, 38, 41, 44, 45, 46, 47, 48, 49, 52, 55, 58, 61, 62,
+		65, 68, 71, 75, 80, 85, 90, 95, 100, 105, 110, 115, 120, 125, 130, 135,
+		138, 139, 141, 144, 146, 147, 149, 152, 156, 158, 159, 161, 164, 165, 166, 167,
+		168, 169, 171, 174, 176, 177, 179, 182, 186, 188, 189, 191, 194, 195, 196, 197,
+		198, 200, 202, 207, 208, 209, 210, 212, 217, 223, 226, 229, 232, 235, 238, 241,
+		244, 245, 246, 247, 248, 249, 250, 251, 252, 253, 254, 255, 256, 257, 258, 259,
+		260, 261, 262, 263, 264, 265, 266, 267, 268, 269, 270, 271, 272, 273, 274, 275,
+		276, 277, 278, 279, 280, 281, 282, 283, 284, 285, 286, 287, 288, 289, 290, 291,
+		292, 293, 294, 295, 296, 297, 298, 299, 300, 301, 302, 303, 304, 305, 306, 307,
+		308, 309, 310, 311, 312, 313, 314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+		324, 325, 326, 327, 328, 329, 330, 331, 332, 333, 334, 335, 336, 337, 338, 339,
+		340, 341, 342, 343, 344, 347, 350, 353, 356, 359, 362, 365, 368, 371, 374, 377,
+		377, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39, 42,
+		45, 48, 51, 54, 55, 58, 61, 62, 63, 64, 67, 70, 73, 76, 79, 82,
+		85, 88, 91, 94, 97, 98, 101, 104, 107, 110, 113, 116, 119, 122, 125, 128,
+		131, 134, 137, 140, 143, 146, 149, 152, 153, 156, 159, 162, 165, 168, 169, 172,
+		175, 178, 181, 184, 187, 190, 193, 196, 199, 202, 205, 208, 211, 214, 217, 220,
+		223, 226, 229, 232, 235, 238, 241, 244, 247, 250, 253, 256, 259, 262, 265, 268,
+		271, 274, 277, 280, 283, 286, 288, 290, 292, 294, 297, 300, 303, 306, 309, 312,
+		315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360,
+		363, 366, 369, 372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408,
+		411, 414, 417, 420, 423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453, 456,
+		459, 462, 465, 468, 471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 501, 504,
+		507, 510, 513, 516, 519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 552,
+		555, 558, 561, 564, 567, 570, 573, 576, 579, 582, 585, 588, 591, 594, 597, 600,
+		603, 606, 609, 612, 615, 618, 621, 624, 627, 630, 633, 636, 639, 642, 645, 648,
+		651, 654, 657, 660, 663, 666, 669, 672, 675, 678, 681, 684, 687, 690, 693, 696,
+		699, 702, 705, 708, 711, 714, 717, 720, 723, 726, 729, 732, 735, 738, 741, 744,
+		747, 747, 0, 3, 6, 9, 10, 13, 16, 19, 22, 25, 28, 31, 34, 37,
+		40, 43, 46, 49, 52, 55, 58, 61, 64, 67, 70, 73, 76, 79, 82, 85,
+		88, 91, 94, 97, 100, 103, 106, 109, 112, 115, 118, 121, 122, 124, 127, 130,
+		133, 136, 139, 142, 145, 148, 151, 154, 157, 160, 163, 166, 169, 172, 175, 178,
+		181, 184, 187, 190, 193, 196, 199, 202, 205, 208, 211, 214, 217, 220, 223, 226,
+		229, 232, 235, 238, 241, 244, 247, 250, 253, 256, 259, 262, 265, 268, 271, 274,
+		277, 280, 283, 286, 289, 292, 295, 298, 301, 304, 307, 310, 313, 316, 319, 322,
+		325, 328, 331, 334, 337, 340, 343, 346, 349, 352, 355, 358, 361, 364, 367, 370,
+		373, 376, 379, 382, 385, 388, 391, 394, 397, 400, 403, 406, 409, 412, 415, 418,
+		421, 424, 427, 430, 433, 436, 439, 442, 445, 448, 451, 454, 457, 460, 463, 466,
+		469, 472, 475, 478, 481, 484, 487, 490, 493, 496, 499, 502, 505, 508, 511, 514,
+		517, 520, 523, 526, 529, 532, 535, 538, 541, 544, 547, 550, 553, 556, 559, 562,
+		565, 568, 571, 574, 577, 580, 583, 586, 589, 592, 595, 598, 601, 604, 607, 610,
+		613, 616, 619, 622, 625, 628, 631, 634, 637, 640, 643, 646, 649, 652, 655, 658,
+		661, 664, 667, 670, 673, 676, 679, 682, 685, 688, 691, 694, 697, 700, 703, 706,
+		709, 712, 715, 718, 721, 724, 727, 730, 733, 736, 739, 742, 745, 748, 751, 754,
+		757, 760, 760, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 6, 9, 12, 15,
+		18, 21, 24, 27, 30, 33, 36, 39, 42, 45, 48, 51, 54, 57, 60, 63,
+		66, 69, 72, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 78,
+		81, 84, 87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126,
+		129, 132, 135, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 149, 151, 153,
+		155, 157, 159, 161, 163, 165, 167, 169, 172, 175, 178, 181, 184, 187, 190, 193,
+		196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 242, 244, 246, 248,
+		250, 252, 254, 256, 258, 261, 264, 267, 270, 273, 276, 279, 282, 285, 288, 291,
+		294, 297, 300, 303, 306, 309, 312, 315, 318, 321, 324, 327, 330, 333, 336, 339,
+		342, 345, 348, 351, 354, 357, 360, 363, 366, 369, 370, 371, 372, 373, 374, 375,
+		376, 377, 378, 379, 380, 381, 382, 383, 384, 385, 386, 387, 388, 389, 390, 391,
+		392, 393, 394, 395, 396, 397, 398, 399, 400, 401, 402, 403, 404, 405, 406, 407,
+		408, 409, 410, 411, 412, 413, 414, 415, 416, 417, 418, 419, 420, 421, 422, 424,
+		426, 428, 430, 432, 434, 436, 438, 440, 442, 443, 444, 445, 446, 447, 448, 449,
+		450, 451, 453, 454, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+		12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27,
+		28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43,
+		44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59,
+		60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75,
+		76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91,
+		92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+		108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+		124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136, 137, 138, 139,
+		140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 153, 156, 159, 162, 165,
+		168, 171, 174, 177, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191,
+		192, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+		208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+		224, 225, 226, 227, 228, 229, 230, 231, 232, 233, 234, 235, 236, 237, 238, 239,
+		240, 241, 242, 243, 244, 245, 246, 247, 248, 249, 250, 251, 252, 253, 254, 255,
+		256, 257, 258, 259, 260, 261, 262, 263, 264, 265, 266, 267, 268, 271, 274, 277,
+		280, 283, 286, 289, 289, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 6, 9, 12, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 16, 16, 16, 19, 22, 25, 28, 31, 34, 37, 40,
+		43, 46, 49, 52, 55, 58, 61, 64, 67, 70, 73, 76, 79, 82, 85, 88,
+		91, 94, 97, 100, 103, 106, 109, 112, 115, 118, 121, 124, 127, 130, 133, 136,
+		139, 142, 145, 148, 151, 154, 157, 160, 163, 166, 169, 172, 175, 178, 181, 184,
+		187, 190, 193, 196, 199, 202, 205, 208, 211, 214, 217, 220, 223, 226, 229, 232,
+		235, 238, 241, 244, 247, 250, 253, 256, 259, 262, 265, 268, 271, 274, 277, 280,
+		283, 286, 289, 292, 295, 298, 301, 304, 307, 310, 313, 316, 319, 322, 325, 328,
+		331, 334, 337, 340, 343, 346, 349, 352, 355, 358, 361, 364, 367, 370, 373, 376,
+		379, 382, 385, 388, 391, 394, 397, 400, 403, 406, 409, 412, 415, 418, 421, 424,
+		427, 430, 433, 436, 439, 439, 0, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 5,
+		5, 5, 5, 5, 5, 5, 8, 11, 11, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 18, 21, 24, 27, 30, 33, 36, 39, 42, 45,
+		48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84, 87, 90, 93,
+		96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129, 132, 133, 136, 137,
+		139, 142, 145, 148, 151, 154, 157, 160, 163, 166, 169, 172, 175, 178, 181, 184,
+		187, 190, 193, 196, 199, 202, 202, 0, 1, 2, 3, 4, 5, 6, 7, 8,
+		9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
+		25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
+		41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56,
+		57, 58, 59, 60, 61, 62, 63, 64, 68, 73, 78, 84, 89, 95, 101, 108,
+		113, 119, 125, 132, 138, 145, 152, 160, 165, 171, 177, 184, 190, 197, 204, 212,
+		218, 225, 232, 240, 247, 255, 263, 272, 277, 283, 289, 296, 302, 309, 316, 324,
+		330, 337, 344, 352, 359, 367, 375, 384, 390, 397, 404, 412, 419, 427, 435, 444,
+		451, 459, 467, 476, 484, 493, 502, 512, 516, 521, 526, 532, 537, 543, 549, 556,
+		561, 567, 573, 580, 586, 593, 600, 608, 613, 619, 625, 632, 638, 645, 652, 660,
+		666, 673, 680, 688, 695, 703, 711, 720, 725, 731, 737, 744, 750, 757, 764, 772,
+		778, 785, 792, 800, 807, 815, 823, 832, 838, 845, 852, 860, 867, 875, 883, 892,
+		899, 907, 915, 924, 932, 941, 950, 960, 965, 971, 977, 984, 990, 997, 1004, 1012,
+		1018, 1025, 1032, 1040, 1047, 1055, 1063, 1072, 1078, 1085, 1092, 1100, 1107, 1115, 1123, 1132,
+		1139, 1147, 1155, 1164, 1172, 1181, 1190, 1200, 1206, 1213, 1220, 1228, 1235, 1243, 1251, 1260,
+		1267, 1275, 1283, 1292, 1300, 1309, 1318, 1328, 1335, 1343, 1351, 1360, 1368, 1377, 1386, 1396,
+		1404, 1413, 1422, 1432, 1441, 1451, 1461, 1472, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 5,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3,
+		6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
+		6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
+		6, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4,
+		5, 6, 7, 8, 9, 10, 11, 12, 13, 13, 14, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 0, 2, 5, 8,
+		11, 13, 16, 19, 22, 24, 27, 30, 33, 36, 38, 41, 45, 48, 51, 54,
+		56, 60, 64, 68, 71, 75, 79, 82, 85, 88, 92, 95, 99, 102, 104, 107,
+		109, 112, 115, 118, 120, 123, 126, 129, 132, 135, 138, 141, 144, 145, 146, 148,
+		149, 152, 153, 154, 155, 157, 159, 162, 163, 164, 165, 166, 169, 170, 171, 172,
+		174, 175, 177, 179, 180, 181, 182, 184, 186, 188, 189, 190, 191, 193, 195, 196,
+		197, 198, 200, 202, 204, 206, 207, 209, 211, 212, 214, 216, 218, 219, 220, 221,
+		222, 223, 225, 226, 227, 230, 233, 236, 239, 242, 245, 248, 252, 252, 255, 258,
+		261, 264, 267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 294, 297, 300, 303,
+		306, 310, 313, 316, 319, 322, 326, 328, 331, 334, 338, 341, 345, 349, 353, 356,
+		360, 364, 369, 373, 376, 379, 382, 385, 388, 391, 394, 397, 400, 403, 406, 409,
+		413, 417, 420, 423, 426, 429, 432, 435, 439, 443, 446, 449, 452, 455, 458, 461,
+		465, 469, 472, 475, 478, 482, 486, 490, 495, 500, 504, 508, 511, 514, 517, 520,
+		524, 528, 531, 534, 537, 540, 543, 546, 550, 554, 557, 560, 563, 566, 569, 572,
+		576, 580, 583, 586, 589, 592, 595, 598, 602, 606, 609, 612, 615, 615, 615, 615,
+		615, 615, 615, 615, 615, 615, 615, 615, 615, 615, 615, 615, 615, 615, 615, 615,
+		615, 615, 615, 615, 615, 615, 615, 615, 615, 615, 615, 615, 615, 0, 3, 6,
+		9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39, 42, 45, 48, 51, 54,
+		57, 60, 63, 66, 69, 72, 75, 78, 81, 84, 87, 90, 93, 96, 99, 102,
+		105, 108, 111, 114, 117, 120, 123, 126, 129, 132, 135, 138, 141, 144, 147, 150,
+		153, 156, 159, 162, 165, 168, 171, 174, 177, 180, 183, 186, 189, 192, 195, 198,
+		201, 204, 207, 210, 213, 216, 219, 222, 225, 228, 231, 234, 237, 240, 243, 246,
+		249, 252, 255, 258, 261, 264, 267, 270, 273, 276, 279, 282, 285, 288, 291, 294,
+		297, 300, 303, 306, 309, 312, 315, 318, 321, 324, 327, 330, 333, 336, 339, 342,
+		345, 348, 351, 354, 357, 360, 363, 366, 369, 372, 375, 378, 381, 384, 384, 384,
+		384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384,
+		384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384,
+		384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384,
+		384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384,
+		384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384,
+		384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384,
+		384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384,
+		384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3,
+		6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39, 42, 45, 48, 52,
+		56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 99, 102, 105, 105, 0,
+		1, 3, 5, 6, 11, 12, 13, 14, 15, 17, 19, 22, 23, 25, 26, 28,
+		30, 33, 34, 36, 37, 39, 41, 44, 46, 49, 51, 54, 56, 58, 60, 62,
+		63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 72, 72, 72, 72, 72, 72,
+		73, 74, 75, 76, 77, 77, 78, 82, 86, 90, 94, 97, 100, 103, 103, 103,
+		106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 118, 120, 122, 124, 126,
+		128, 130, 132, 134, 136, 138, 140, 143, 145, 147, 149, 151, 153, 155, 157, 159,
+		161, 164, 166, 169, 172, 174, 176, 178, 180, 182, 184, 186, 188, 190, 192, 194,
+		196, 198, 200, 202, 204, 206, 208, 210, 212, 214, 216, 218, 220, 222, 224, 226,
+		228, 230, 232, 234, 236, 238, 240, 242, 244, 246, 248, 250, 252, 254, 256, 258,
+		260, 262, 264, 265, 267, 269, 271, 274, 277, 277, 277, 277, 277, 278, 279, 283,
+		286, 287, 288, 289, 290, 291, 292, 293, 294, 295, 296, 298, 300, 302, 304, 306,
+		308, 310, 312, 314, 316, 318, 320, 323, 325, 327, 329, 331, 333, 335, 337, 339,
+		341, 344, 346, 349, 352, 354, 356, 358, 360, 362, 364, 366, 368, 370, 372, 374,
+		376, 378, 380, 382, 384, 386, 388, 390, 392, 394, 396, 398, 400, 402, 404, 406,
+		408, 410, 412, 414, 416, 418, 420, 422, 424, 426, 428, 430, 432, 434, 436, 438,
+		440, 442, 444, 445, 447, 449, 451, 453, 455, 457, 459, 459, 459, 460, 461, 465,
+		0, 3, 6, 9, 12, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25,
+		26, 27, 28, 29, 31, 33, 35, 36, 37, 38, 39, 40, 41, 42, 44, 46,
+		48, 50, 52, 54, 56, 59, 62, 64, 65, 66, 68, 69, 71, 73, 75, 76,
+		78, 78, 81, 84, 87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120,
+		123, 126, 129, 132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168,
+		171, 174, 177, 180, 183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216,
+		219, 222, 225, 228, 231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264,
+		267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312,
+		315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360,
+		360, 360, 360, 360, 360, 360, 360, 360, 360, 360, 360, 360, 360, 360, 360, 360,
+		360, 362, 364, 366, 368, 370, 373, 375, 378, 380, 383, 386, 389, 391, 394, 398,
+		402, 404, 406, 409, 413, 414, 415, 416, 417, 419, 421, 423, 424, 426, 428, 430,
+		432, 435, 438, 441, 444, 447, 450, 453, 456, 459, 462, 465, 468, 471, 474, 477,
+		480, 483, 486, 489, 492, 495, 498, 501, 504, 507, 510, 513, 516, 519, 522, 525,
+		528, 531, 534, 537, 540, 543, 546, 549, 552, 555, 558, 561, 564, 567, 570, 573,
+		576, 578, 580, 582, 584, 586, 588, 590, 592, 594, 596, 598, 600, 602, 604, 606,
+		608, 0, 3, 6, 9, 12, 15, 18, 21, 23, 26, 29, 32, 35, 38, 41,
+		45, 49, 53, 57, 61, 65, 69, 72, 76, 80, 84, 88, 92, 96, 100, 103,
+		106, 109, 113, 117, 121, 125, 129, 133, 137, 141, 145, 150, 156, 162, 169, 174,
+		180, 185, 190, 196, 202, 208, 215, 220, 226, 232, 238, 244, 249, 255, 262, 267,
+		272, 278, 283, 289, 291, 293, 296, 299, 302, 305, 307, 309, 311, 313, 315, 317,
+		319, 321, 324, 326, 328, 330, 332, 334, 336, 338, 340, 342, 344, 346, 348, 350,
+		352, 354, 357, 360, 363, 366, 369, 372, 375, 377, 380, 383, 386, 389, 392, 395,
+		399, 403, 407, 411, 415, 419, 423, 426, 430, 434, 438, 442, 446, 450, 453, 456,
+		459, 463, 467, 471, 475, 479, 483, 487, 491, 495, 499, 504, 510, 516, 523, 528,
+		534, 539, 544, 550, 556, 562, 569, 574, 580, 586, 592, 597, 603, 608, 614, 620,
+		626, 632, 640, 646, 652, 660, 668, 676, 682, 688, 694, 699, 706, 712, 719, 724,
+		729, 735, 740, 742, 744, 746, 748, 750, 752, 754, 756, 758, 760, 762, 764, 766,
+		768, 770, 772, 774, 776, 778, 780, 782, 784, 786, 788, 791, 794, 797, 800, 803,
+		806, 809, 810, 811, 812, 813, 814, 816, 818, 820, 822, 824, 826, 828, 830, 832,
+		834, 836, 838, 840, 842, 844, 846, 848, 850, 852, 854, 856, 858, 860, 862, 864,
+		866, 868, 870, 872, 874, 876, 878, 880, 882, 884, 886, 888, 890, 892, 894, 896,
+		898, 906, 0, 9, 14, 20, 23, 29, 33, 36, 42, 46, 51, 54, 60, 65,
+		72, 78, 83, 87, 93, 98, 105, 109, 117, 126, 134, 138, 146, 154, 159, 163,
+		169, 174, 179, 186, 194, 199, 203, 208, 212, 218, 221, 225, 229, 236, 243, 248,
+		254, 261, 266, 270, 278, 283, 287, 293, 298, 305, 309, 316, 321, 326, 330, 334,
+		339, 343, 346, 351, 355, 359, 364, 368, 372, 376, 383, 389, 394, 402, 406, 413,
+		418, 422, 426, 430, 435, 439, 444, 449, 452, 460, 464, 466, 468, 470, 472, 474,
+		476, 478, 480, 482, 484, 487, 490, 493, 496, 499, 502, 505, 508, 511, 514, 517,
+		520, 523, 526, 529, 532, 534, 536, 539, 541, 543, 546, 549, 552, 555, 561, 567,
+		574, 579, 583, 585, 587, 595, 597, 599, 601, 603, 605, 608, 612, 614, 616, 626,
+		635, 637, 639, 641, 644, 647, 650, 653, 663, 665, 667, 669, 671, 673, 683, 685,
+		687, 689, 693, 697, 700, 704, 708, 712, 715, 719, 722, 727, 729, 732, 735, 738,
+		741, 746, 753, 755, 757, 768, 770, 772, 774, 783, 785, 787, 789, 791, 793, 802,
+		804, 806, 808, 812, 816, 820, 822, 824, 826, 830, 833, 835, 837, 839, 841, 843,
+		847, 849, 851, 853, 855, 858, 860, 862, 865, 868, 870, 874, 877, 879, 881, 883,
+		885, 888, 891, 893, 895, 897, 899, 901, 903, 905, 907, 909, 912, 915, 918, 921,
+		924, 927, 930, 933, 936, 939, 942, 945, 948, 951, 954, 957, 960, 963, 966, 969,
+		972, 975, 978, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36,
+		39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84,
+		87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129, 132,
+		135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 177, 180,
+		183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228,
+		231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273, 276,
+		279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 315, 318, 321, 324,
+		327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360, 363, 366, 369, 372,
+		375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408, 411, 414, 417, 420,
+		423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453, 456, 459, 462, 465, 468,
+		471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 501, 504, 507, 510, 513, 516,
+		519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 552, 555, 558, 561, 564,
+		567, 570, 573, 576, 579, 582, 585, 588, 591, 594, 597, 600, 603, 606, 609, 612,
+		615, 618, 621, 624, 627, 630, 633, 636, 639, 642, 645, 648, 651, 654, 657, 660,
+		663, 666, 669, 672, 675, 678, 681, 684, 687, 690, 693, 696, 699, 702, 705, 708,
+		711, 714, 717, 720, 723, 726, 729, 732, 735, 738, 741, 744, 747, 750, 753, 756,
+		759, 762, 765, 765, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33,
+		36, 39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81,
+		84, 87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129,
+		132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 177,
+		180, 183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225,
+		228, 231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273,
+		276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 315, 318, 321,
+		324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360, 363, 366, 369,
+		372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408, 411, 414, 417,
+		420, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423,
+		423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423,
+		423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423,
+		423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423, 423,
+		423, 423, 423, 423, 423, 425, 427, 430, 432, 434, 437, 439, 441, 444, 446, 448,
+		451, 454, 457, 461, 463, 465, 467, 469, 472, 474, 477, 479, 481, 484, 486, 488,
+		491, 493, 496, 497, 499, 500, 502, 503, 504, 505, 507, 509, 511, 513, 515, 518,
+		520, 522, 525, 528, 528, 0, 2, 5, 8, 11, 15, 18, 22, 25, 29, 33,
+		38, 42, 45, 48, 51, 55, 59, 64, 67, 70, 73, 77, 80, 84, 87, 91,
+		94, 97, 101, 104, 107, 112, 115, 118, 121, 125, 126, 128, 130, 133, 135, 138,
+		140, 143, 145, 148, 151, 155, 158, 160, 162, 164, 167, 170, 174, 176, 178, 180,
+		183, 185, 188, 190, 193, 195, 197, 200, 202, 204, 208, 210, 212, 214, 217, 218,
+		220, 224, 226, 229, 231, 234, 236, 239, 241, 244, 247, 251, 255, 258, 260, 262,
+		264, 267, 270, 274, 276, 278, 280, 283, 285, 288, 290, 293, 295, 297, 300, 302,
+		304, 307, 311, 313, 315, 317, 320, 322, 325, 328, 331, 335, 338, 342, 345, 349,
+		353, 358, 362, 365, 368, 371, 375, 379, 384, 387, 390, 393, 397, 400, 404, 407,
+		411, 414, 417, 421, 424, 427, 432, 435, 438, 441, 445, 446, 448, 450, 453, 455,
+		458, 460, 463, 465, 468, 471, 475, 478, 480, 482, 484, 487, 490, 494, 496, 498,
+		500, 503, 505, 508, 510, 513, 515, 517, 520, 522, 524, 528, 530, 532, 534, 537,
+		538, 540, 544, 546, 549, 551, 554, 556, 559, 561, 564, 567, 571, 574, 578, 580,
+		582, 584, 587, 590, 594, 596, 598, 600, 603, 605, 608, 610, 613, 615, 617, 620,
+		622, 624, 628, 630, 632, 634, 637, 638, 640, 644, 646, 649, 651, 654, 656, 659,
+		661, 664, 667, 671, 675, 678, 682, 684, 686, 688, 691, 694, 698, 700, 702, 704,
+		707, 709, 712, 714, 717, 719, 0, 2, 5, 7, 9, 13, 18, 20, 23, 25,
+		27, 30, 32, 42, 42, 42, 42, 44, 46, 49, 52, 55, 58, 61, 64, 67,
+		70, 73, 76, 79, 82, 85, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+		98, 100, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129, 132, 135, 138, 141,
+		144, 147, 150, 153, 156, 159, 162, 168, 174, 179, 184, 187, 190, 194, 198, 203,
+		208, 210, 212, 217, 222, 225, 228, 232, 236, 239, 242, 244, 246, 247, 248, 251,
+		254, 257, 260, 263, 266, 268, 270, 273, 276, 278, 280, 282, 284, 286, 288, 289,
+		290, 291, 292, 293, 294, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295,
+		295, 295, 295, 295, 295, 295, 298, 301, 304, 308, 312, 316, 320, 324, 328, 332,
+		336, 338, 340, 343, 346, 350, 354, 358, 362, 366, 370, 373, 376, 380, 384, 385,
+		386, 387, 388, 389, 390, 390, 390, 391, 393, 394, 396, 398, 401, 404, 405, 408,
+		409, 411, 413, 416, 420, 425, 430, 433, 437, 442, 443, 446, 448, 452, 454, 459,
+		463, 467, 470, 474, 476, 481, 484, 487, 490, 492, 496, 500, 504, 508, 512, 515,
+		518, 521, 524, 526, 528, 530, 532, 536, 539, 542, 549, 553, 556, 559, 563, 565,
+		568, 570, 572, 574, 576, 579, 581, 585, 588, 591, 593, 595, 597, 598, 599, 600,
+		601, 602, 603, 604, 605, 606, 607, 607, 607, 607, 607, 607, 607, 607, 607, 610,
+		613, 616, 619, 622, 625, 628, 631, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3,
+		8, 11, 16, 21, 26, 30, 34, 38, 38, 38, 42, 46, 49, 52, 56, 60,
+		62, 64, 72, 80, 89, 98, 107, 116, 117, 118, 120, 122, 124, 126, 128, 130,
+		132, 134, 136, 138, 140, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151, 152,
+		153, 154, 155, 156, 157, 158, 160, 162, 163, 164, 165, 166, 167, 168, 169, 170,
+		171, 172, 179, 186, 193, 200, 201, 202, 204, 206, 207, 208, 213, 218, 223, 228,
+		232, 236, 238, 240, 242, 244, 247, 250, 252, 255, 258, 261, 264, 267, 270, 273,
+		275, 276, 277, 278, 279, 280, 281, 282, 283, 284, 285, 286, 287, 288, 289, 290,
+		296, 296, 296, 304, 312, 313, 314, 317, 318, 319, 320, 321, 322, 323, 324, 325,
+		326, 327, 329, 331, 333, 335, 337, 339, 340, 341, 342, 343, 344, 345, 346, 347,
+		348, 349, 350, 351, 352, 353, 354, 355, 356, 357, 358, 361, 365, 369, 374, 379,
+		380, 381, 382, 383, 384, 385, 386, 387, 388, 389, 390, 391, 392, 393, 394, 395,
+		396, 397, 398, 401, 404, 407, 410, 413, 414, 415, 418, 423, 426, 430, 431, 432,
+		433, 434, 437, 440, 443, 446, 449, 452, 455, 458, 461, 464, 467, 470, 473, 476,
+		479, 482, 485, 488, 491, 494, 497, 500, 503, 506, 507, 508, 509, 510, 511, 512,
+		513, 515, 516, 517, 518, 519, 524, 525, 0, 1, 2, 2, 3, 4, 5, 5,
+		7, 10, 12, 15, 15, 17, 20, 22, 25, 28, 32, 35, 39, 41, 44, 46,
+		49, 51, 53, 56, 58, 61, 63, 65, 67, 70, 72, 74, 74, 74, 74, 74,
+		74, 74, 74, 74, 74, 74, 77, 80, 83, 86, 89, 92, 96, 99, 103, 103,
+		103, 103, 103, 106, 109, 112, 115, 118, 121, 123, 126, 128, 131, 133, 136, 138,
+		141, 143, 146, 148, 150, 152, 155, 157, 159, 162, 166, 169, 171, 174, 176, 177,
+		179, 181, 183, 186, 188, 190, 191, 192, 193, 194, 195, 197, 199, 201, 204, 206,
+		208, 210, 213, 217, 220, 223, 225, 228, 230, 232, 234, 236, 247, 247, 247, 247,
+		247, 250, 253, 256, 259, 262, 265, 268, 271, 271, 271, 272, 274, 275, 277, 278,
+		280, 281, 283, 284, 286, 287, 289, 291, 292, 294, 296, 298, 301, 303, 306, 309,
+		311, 314, 316, 319, 322, 325, 329, 332, 336, 339, 341, 344, 346, 349, 351, 353,
+		356, 358, 361, 363, 365, 367, 369, 371, 374, 377, 379, 381, 384, 384, 384, 384,
+		384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 384, 387,
+		390, 393, 396, 399, 402, 405, 408, 408, 408, 409, 410, 411, 412, 413, 414, 415,
+		416, 417, 418, 421, 424, 427, 430, 433, 436, 436, 436, 436, 436, 436, 436, 436,
+		436, 436, 436, 436, 436, 436, 436, 436, 436, 436, 436, 447, 453, 459, 462, 467,
+		475, 475, 475, 475, 478, 478, 481, 483, 483, 0, 1, 2, 3, 4, 5, 6,
+		7, 8, 9, 10, 12, 15, 17, 20, 22, 25, 27, 30, 32, 35, 37, 39,
+		42, 44, 46, 48, 50, 52, 54, 56, 59, 61, 63, 65, 66, 68, 69, 71,
+		71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 73, 75, 78, 80, 82, 84,
+		86, 88, 90, 92, 94, 97, 99, 101, 103, 105, 107, 109, 112, 116, 119, 123,
+		124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 127, 130,
+		133, 136, 139, 142, 145, 148, 151, 154, 157, 157, 160, 163, 166, 169, 172, 175,
+		178, 181, 184, 187, 190, 193, 196, 199, 202, 205, 208, 211, 214, 217, 220, 223,
+		226, 229, 232, 235, 238, 241, 244, 244, 244, 244, 244, 244, 244, 244, 245, 249,
+		250, 252, 253, 258, 263, 270, 271, 273, 274, 276, 278, 280, 282, 284, 287, 289,
+		291, 293, 296, 298, 301, 304, 307, 310, 313, 315, 317, 319, 321, 323, 325, 327,
+		329, 331, 333, 335, 337, 339, 344, 346, 348, 350, 352, 354, 356, 356, 356, 356,
+		356, 356, 356, 356, 356, 356, 356, 356, 356, 356, 356, 356, 356, 356, 356, 356,
+		356, 356, 356, 356, 356, 356, 356, 356, 359, 362, 363, 364, 365, 366, 367, 368,
+		369, 370, 371, 372, 375, 378, 381, 384, 384, 384, 387, 390, 393, 396, 399, 399,
+		412, 415, 417, 419, 422, 424, 427, 430, 434, 437, 438, 439, 440, 441, 442, 443,
+		444, 445, 446, 447, 450, 452, 455, 457, 460, 463, 0, 1, 2, 3, 4, 6,
+		7, 9, 12, 14, 17, 21, 24, 27, 31, 33, 36, 40, 43, 47, 49, 52,
+		54, 57, 60, 62, 65, 67, 70, 73, 75, 78, 81, 83, 86, 88, 90, 92,
+		94, 97, 99, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+		101, 101, 104, 107, 110, 113, 116, 119, 122, 125, 128, 129, 130, 132, 132, 134,
+		135, 136, 137, 138, 139, 140, 142, 142, 142, 145, 148, 149, 150, 151, 152, 153,
+		154, 155, 156, 157, 158, 161, 164, 164, 164, 164, 164, 166, 168, 171, 173, 176,
+		179, 182, 186, 189, 193, 196, 198, 200, 202, 205, 207, 220, 222, 224, 226, 229,
+		231, 233, 233, 233, 233, 235, 235, 235, 235, 238, 241, 243, 245, 248, 251, 255,
+		259, 261, 263, 266, 269, 271, 273, 276, 279, 281, 283, 286, 289, 291, 293, 295,
+		297, 300, 303, 305, 307, 309, 311, 313, 315, 318, 321, 323, 325, 327, 329, 331,
+		333, 335, 337, 339, 341, 343, 345, 347, 349, 350, 351, 351, 353, 353, 353, 353,
+		354, 355, 355, 355, 358, 360, 363, 365, 367, 367, 367, 370, 370, 373, 376, 379,
+		382, 385, 388, 391, 394, 397, 400, 403, 406, 409, 412, 415, 418, 421, 424, 427,
+		430, 433, 436, 439, 442, 445, 448, 451, 454, 454, 454, 455, 456, 459, 462, 465,
+		469, 472, 476, 479, 482, 485, 485, 485, 485, 485, 485, 485, 485, 488, 492, 495,
+		495, 495, 498, 501, 504, 507, 510, 513, 516, 519, 522, 0, 3, 7, 11, 16,
+		21, 25, 29, 32, 35, 39, 43, 48, 53, 57, 61, 64, 67, 70, 73, 77,
+		81, 84, 87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 119, 124, 129, 135,
+		141, 146, 151, 154, 157, 160, 163, 167, 171, 174, 177, 180, 185, 198, 199, 200,
+		201, 202, 203, 204, 205, 206, 207, 208, 211, 212, 213, 214, 216, 218, 220, 225,
+		230, 231, 232, 238, 239, 240, 241, 242, 243, 246, 247, 250, 252, 254, 255, 258,
+		261, 264, 265, 266, 267, 268, 269, 269, 273, 274, 275, 276, 279, 280, 282, 284,
+		289, 294, 301, 308, 309, 310, 310, 310, 313, 316, 319, 322, 323, 324, 325, 326,
+		327, 328, 330, 332, 334, 336, 338, 340, 342, 344, 346, 348, 350, 352, 354, 356,
+		358, 360, 362, 364, 366, 368, 370, 372, 374, 376, 378, 381, 384, 386, 388, 390,
+		392, 394, 397, 400, 403, 406, 409, 412, 414, 415, 417, 419, 421, 423, 425, 427,
+		429, 431, 433, 435, 437, 439, 441, 443, 446, 449, 452, 455, 458, 461, 464, 467,
+		470, 473, 476, 479, 482, 484, 486, 488, 490, 492, 494, 496, 499, 502, 505, 508,
+		510, 512, 516, 519, 523, 527, 531, 534, 538, 541, 543, 544, 548, 553, 556, 560,
+		563, 565, 568, 571, 575, 579, 583, 589, 595, 601, 607, 613, 619, 625, 631, 631,
+		631, 631, 631, 631, 631, 631, 631, 631, 631, 631, 634, 637, 638, 639, 640, 641,
+		642, 643, 644, 645, 646, 647, 650, 653, 656, 659, 662, 665, 0, 2, 4, 6,
+		9, 12, 14, 16, 19, 22, 25, 28, 31, 34, 37, 40, 43, 46, 49, 52,
+		54, 56, 58, 60, 63, 66, 69, 72, 75, 78, 80, 80, 82, 83, 83, 84,
+		85, 86, 87, 88, 89, 90, 91, 93, 94, 96, 97, 98, 99, 99, 100, 101,
+		102, 103, 104, 105, 108, 109, 110, 111, 112, 113, 116, 117, 120, 121, 122, 125,
+		126, 127, 130, 132, 134, 135, 137, 138, 140, 141, 142, 143, 144, 144, 144, 144,
+		144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+		144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+		144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+		144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+		144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+		144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 147,
+		150, 153, 156, 159, 162, 165, 168, 171, 174, 177, 180, 183, 186, 189, 192, 195,
+		198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228, 231, 234, 237, 240, 243,
+		243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+		243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+		243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3,
+		6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39, 42, 45, 48, 48,
+		48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48,
+		48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48,
+		48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48,
+		48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 51,
+		54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+		54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+		54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+		54, 54, 54, 54, 54, 54, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81,
+		84, 87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129,
+		132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 174,
+		174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 177, 180, 183, 183, 0,
+		3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39, 42, 45, 48,
+		51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84, 87, 90, 93, 96,
+		96, 96, 96, 97, 100, 103, 106, 109, 112, 115, 118, 121, 124, 127, 130, 133,
+		135, 137, 138, 139, 140, 141, 143, 144, 146, 147, 149, 151, 154, 156, 159, 160,
+		162, 163, 165, 166, 167, 170, 173, 176, 179, 179, 179, 179, 179, 179, 179, 179,
+		180, 181, 182, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
+		195, 196, 197, 198, 199, 200, 201, 201, 202, 203, 204, 205, 208, 211, 214, 217,
+		217, 217, 217, 220, 220, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+		223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+		223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+		223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+		223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+		223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+		223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+		223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+		223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 226, 229, 229,
+		0, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17,
+		18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33,
+		34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49,
+		50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65,
+		66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+		82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+		100, 103, 104, 105, 106, 107, 108, 110, 111, 112, 113, 114, 115, 117, 119, 121,
+		123, 124, 125, 126, 127, 128, 129, 131, 133, 135, 137, 139, 141, 143, 145, 147,
+		149, 151, 153, 155, 157, 159, 161, 163, 165, 167, 169, 171, 173, 175, 177, 179,
+		181, 183, 185, 187, 189, 191, 193, 195, 197, 199, 201, 203, 205, 207, 208, 209,
+		210, 213, 216, 219, 222, 225, 228, 231, 234, 237, 240, 243, 246, 249, 252, 255,
+		258, 261, 264, 267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303,
+		303, 303, 303, 306, 309, 312, 315, 318, 321, 321, 321, 324, 327, 330, 333, 336,
+		339, 339, 339, 342, 345, 348, 351, 354, 357, 357, 357, 360, 363, 366, 369, 372,
+		375, 377, 379, 380, 381, 382, 384, 386, 389, 390, 391, 392, 393, 394, 395, 396,
+		399, 402, 405, 408, 411, 414, 417, 420, 423, 426, 427, 428, 429, 429, 429, 429,
+		429, 0, 1, 2, 3, 4, 5, 7, 9, 11, 13, 15, 17, 19, 22, 24,
+		26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56,
+		58, 60, 62, 64, 66, 68, 70, 72, 74, 77, 79, 81, 83, 85, 87, 89,
+		91, 93, 95, 97, 99, 101, 103, 105, 107, 109, 111, 113, 115, 118, 120, 122,
+		125, 127, 129, 131, 133, 136, 139, 142, 145, 148, 151, 154, 157, 160, 163, 166,
+		169, 172, 175, 178, 181, 184, 187, 190, 193, 196, 199, 202, 205, 208, 211, 214,
+		217, 220, 223, 226, 229, 232, 235, 238, 241, 244, 247, 250, 253, 256, 259, 262,
+		265, 268, 271, 274, 277, 280, 283, 286, 289, 292, 295, 298, 301, 304, 307, 310,
+		313, 316, 319, 322, 325, 328, 331, 334, 337, 340, 343, 346, 349, 352, 355, 358,
+		361, 364, 367, 370, 373, 376, 379, 382, 385, 388, 391, 394, 397, 400, 403, 406,
+		409, 412, 415, 418, 421, 424, 427, 430, 433, 436, 439, 442, 445, 448, 451, 454,
+		457, 460, 463, 466, 469, 472, 475, 478, 481, 484, 487, 490, 493, 496, 499, 502,
+		505, 508, 511, 514, 517, 520, 523, 526, 529, 532, 535, 538, 541, 544, 547, 550,
+		553, 556, 559, 562, 565, 568, 571, 574, 577, 580, 583, 586, 589, 592, 595, 598,
+		601, 604, 607, 610, 613, 616, 619, 622, 625, 628, 631, 634, 637, 640, 643, 646,
+		649, 652, 655, 658, 661, 664, 667, 670, 673, 676, 679, 682, 685, 688, 691, 694,
+		697, 700, 0, 0, 0, 0, 3, 6, 9, 12, 13, 14, 15, 16, 17, 18,
+		19, 20, 21, 23, 25, 27, 29, 31, 33, 35, 37, 39, 42, 45, 48, 51,
+		54, 57, 60, 63, 66, 70, 74, 78, 82, 86, 90, 94, 98, 102, 107, 112,
+		117, 122, 127, 132, 137, 142, 147, 150, 153, 156, 156, 156, 156, 156, 156, 156,
+		156, 156, 156, 159, 162, 163, 164, 166, 169, 173, 178, 179, 181, 183, 186, 189,
+		193, 197, 198, 200, 202, 205, 208, 212, 217, 222, 224, 225, 226, 227, 228, 229,
+		230, 231, 232, 234, 236, 238, 240, 242, 244, 246, 248, 250, 252, 255, 258, 261,
+		264, 267, 270, 273, 277, 281, 282, 284, 287, 290, 293, 296, 296, 296, 296, 296,
+		296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 297, 300, 300,
+		300, 300, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303,
+		306, 309, 312, 312, 315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348,
+		351, 354, 357, 360, 363, 366, 369, 372, 375, 378, 381, 384, 387, 390, 393, 396,
+		399, 402, 405, 408, 411, 414, 417, 420, 423, 426, 429, 432, 435, 438, 441, 444,
+		447, 450, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453,
+		453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453,
+		453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453, 453,
+		453, 456, 459, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36,
+		39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84,
+		87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129, 132,
+		135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 177, 180,
+		183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228,
+		231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273, 276,
+		279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 315, 318, 321, 324,
+		327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360, 363, 366, 369, 372,
+		375, 378, 381, 384, 385, 386, 387, 389, 390, 391, 392, 393, 394, 396, 397, 398,
+		399, 400, 401, 402, 404, 406, 407, 408, 410, 411, 412, 413, 415, 417, 419, 420,
+		421, 424, 427, 430, 431, 433, 434, 435, 438, 439, 441, 443, 444, 445, 446, 447,
+		449, 450, 452, 455, 456, 459, 460, 462, 463, 464, 467, 468, 470, 471, 472, 476,
+		477, 479, 481, 483, 484, 486, 488, 491, 493, 495, 498, 500, 504, 506, 508, 511,
+		514, 517, 520, 522, 526, 529, 532, 535, 538, 541, 544, 547, 550, 553, 556, 559,
+		562, 565, 568, 571, 571, 572, 573, 574, 575, 576, 577, 578, 579, 580, 582, 584,
+		586, 588, 590, 592, 594, 596, 598, 601, 604, 607, 610, 613, 616, 619, 622, 625,
+		628, 631, 634, 637, 0, 1, 3, 5, 7, 8, 10, 12, 14, 17, 18, 20,
+		22, 24, 26, 29, 30, 32, 35, 37, 39, 41, 43, 44, 47, 50, 53, 55,
+		58, 61, 63, 65, 68, 69, 70, 72, 74, 77, 80, 83, 86, 89, 92, 95,
+		98, 101, 103, 106, 109, 113, 120, 124, 128, 134, 142, 146, 150, 156, 159, 164,
+		169, 174, 180, 183, 187, 195, 197, 202, 207, 212, 217, 222, 227, 232, 237, 240,
+		243, 246, 249, 252, 255, 257, 260, 263, 266, 267, 271, 276, 280, 285, 286, 290,
+		293, 298, 303, 307, 311, 314, 317, 320, 321, 326, 331, 338, 341, 345, 346, 348,
+		350, 352, 356, 359, 362, 366, 369, 371, 373, 375, 377, 377, 377, 377, 377, 377,
+		380, 383, 386, 389, 392, 396, 400, 405, 408, 413, 415, 417, 421, 425, 428, 431,
+		434, 438, 443, 446, 450, 453, 455, 460, 463, 465, 469, 473, 478, 484, 489, 491,
+		492, 493, 496, 499, 499, 500, 501, 502, 504, 506, 508, 510, 512, 514, 516, 518,
+		520, 522, 524, 526, 528, 531, 533, 535, 537, 539, 541, 543, 545, 547, 549, 551,
+		553, 555, 557, 559, 561, 563, 566, 569, 571, 574, 577, 580, 583, 593, 604, 616,
+		628, 637, 647, 651, 658, 658, 659, 660, 662, 664, 667, 670, 673, 676, 679, 682,
+		685, 688, 691, 694, 697, 700, 703, 706, 709, 712, 715, 718, 721, 724, 727, 730,
+		733, 736, 739, 742, 745, 748, 751, 754, 757, 760, 763, 766, 769, 772, 775, 778,
+		781, 784, 787, 790, 793, 0, 1, 2, 3, 5, 6, 8, 9, 10, 11, 13,
+		14, 16, 18, 20, 22, 25, 26, 29, 32, 35, 38, 42, 45, 48, 51, 53,
+		56, 59, 63, 65, 68, 71, 75, 77, 79, 81, 83, 86, 88, 90, 91, 92,
+		93, 95, 96, 98, 99, 100, 101, 103, 104, 106, 108, 110, 112, 115, 116, 119,
+		122, 125, 128, 132, 135, 138, 141, 143, 146, 149, 153, 155, 158, 161, 165, 167,
+		169, 171, 173, 176, 178, 180, 184, 187, 191, 194, 199, 201, 205, 211, 214, 218,
+		221, 224, 227, 230, 234, 237, 244, 249, 252, 256, 260, 264, 266, 269, 272, 275,
+		277, 281, 284, 286, 290, 293, 296, 299, 302, 304, 307, 311, 314, 317, 320, 322,
+		325, 328, 333, 336, 339, 342, 346, 348, 350, 352, 354, 357, 361, 363, 365, 370,
+		373, 377, 379, 381, 385, 389, 393, 397, 401, 404, 406, 408, 409, 410, 411, 412,
+		413, 415, 417, 419, 422, 425, 426, 427, 428, 429, 430, 431, 432, 433, 434, 435,
+		438, 441, 444, 447, 450, 453, 454, 456, 459, 461, 464, 467, 472, 473, 476, 478,
+		481, 482, 484, 488, 491, 493, 495, 497, 498, 501, 503, 507, 509, 512, 514, 518,
+		521, 526, 530, 533, 534, 536, 539, 542, 544, 547, 550, 553, 556, 559, 560, 562,
+		565, 567, 570, 573, 578, 579, 582, 584, 587, 588, 590, 594, 597, 599, 601, 603,
+		604, 607, 609, 613, 615, 618, 620, 624, 627, 632, 636, 639, 640, 642, 645, 648,
+		650, 653, 656, 659, 662, 665, 0, 1, 3, 5, 8, 10, 13, 16, 18, 19,
+		21, 23, 26, 28, 29, 31, 33, 35, 38, 40, 42, 44, 47, 48, 50, 52,
+		54, 57, 59, 62, 64, 67, 68, 70, 72, 73, 75, 78, 81, 87, 90, 93,
+		96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 128, 132, 135, 139, 142,
+		145, 149, 152, 155, 158, 161, 165, 169, 173, 176, 180, 183, 187, 191, 194, 197,
+		201, 204, 208, 211, 214, 218, 223, 227, 231, 233, 237, 240, 244, 248, 251, 256,
+		259, 263, 267, 271, 275, 278, 282, 286, 290, 294, 297, 300, 303, 306, 309, 312,
+		315, 318, 321, 324, 327, 330, 330, 331, 334, 336, 338, 341, 343, 346, 348, 349,
+		351, 353, 356, 358, 361, 362, 365, 367, 369, 371, 374, 376, 378, 381, 382, 384,
+		386, 388, 391, 393, 396, 398, 401, 402, 404, 406, 409, 410, 412, 415, 416, 419,
+		421, 423, 426, 428, 431, 433, 434, 436, 438, 441, 443, 446, 447, 450, 452, 454,
+		456, 459, 461, 463, 466, 467, 469, 471, 473, 476, 478, 481, 483, 486, 487, 489,
+		491, 494, 495, 497, 500, 503, 506, 509, 512, 515, 518, 521, 524, 527, 530, 533,
+		536, 539, 542, 545, 548, 551, 554, 557, 560, 563, 566, 569, 572, 575, 578, 581,
+		584, 587, 590, 593, 596, 599, 602, 605, 608, 611, 614, 617, 620, 623, 626, 629,
+		632, 635, 638, 641, 644, 647, 650, 653, 656, 659, 662, 665, 668, 671, 674, 677,
+		680, 683, 686, 689, 692, 695, 698, 0, 5, 10, 15, 20, 25, 30, 35, 40,
+		45, 50, 55, 60, 65, 70, 75, 80, 86, 92, 97, 103, 109, 114, 120, 125,
+		130, 135, 140, 145, 150, 155, 160, 165, 170, 175, 180, 185, 190, 195, 200, 205,
+		210, 215, 220, 225, 230, 235, 240, 245, 250, 255, 260, 265, 270, 275, 280, 285,
+		290, 295, 300, 305, 310, 315, 320, 325, 330, 335, 340, 345, 350, 355, 360, 367,
+		372, 377, 382, 387, 392, 398, 404, 409, 414, 419, 424, 429, 434, 438, 442, 446,
+		450, 454, 458, 462, 466, 471, 476, 481, 485, 489, 493, 498, 503, 508, 512, 516,
+		520, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560, 564, 568, 572, 576, 580,
+		584, 588, 592, 596, 600, 604, 608, 612, 616, 620, 624, 628, 632, 636, 640, 644,
+		648, 652, 656, 660, 664, 668, 672, 676, 680, 684, 688, 692, 696, 700, 704, 708,
+		712, 716, 720, 724, 728, 732, 736, 740, 747, 754, 761, 768, 775, 782, 789, 796,
+		803, 810, 817, 824, 831, 838, 845, 852, 859, 866, 873, 877, 881, 885, 889, 893,
+		897, 901, 905, 909, 913, 917, 921, 925, 929, 933, 937, 941, 945, 949, 953, 957,
+		961, 965, 969, 973, 977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013, 1017, 1021,
+		1025, 1029, 1033, 1037, 1041, 1045, 1049, 1053, 1057, 1061, 1065, 1069, 1073, 1077, 1081, 1085,
+		1089, 1093, 1097, 1101, 1105, 1109, 1113, 1117, 1121, 1125, 1129, 1133, 1137, 1141, 1145, 1149,
+		1153, 1157, 1161, 1165, 1169, 1173, 1177, 1181, 0, 4, 8, 12, 16, 20, 24, 28,
+		32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92,
+		96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156,
+		160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220,
+		223, 226, 229, 232, 235, 238, 241, 244, 247, 248, 249, 250, 251, 252, 253, 254,
+		255, 256, 258, 260, 262, 264, 265, 266, 267, 272, 275, 277, 279, 283, 285, 288,
+		291, 294, 297, 300, 303, 306, 309, 312, 315, 319, 323, 327, 331, 335, 339, 343,
+		347, 350, 353, 356, 359, 362, 365, 368, 371, 374, 377, 380, 383, 386, 389, 392,
+		395, 398, 401, 404, 407, 410, 413, 416, 419, 421, 422, 423, 425, 426, 427, 430,
+		432, 439, 441, 443, 444, 445, 446, 447, 448, 450, 451, 452, 453, 454, 455, 456,
+		457, 458, 460, 462, 463, 464, 465, 467, 471, 472, 473, 474, 476, 477, 478, 479,
+		480, 481, 482, 483, 485, 487, 494, 496, 497, 498, 501, 502, 503, 504, 505, 506,
+		508, 510, 515, 516, 519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 552,
+		555, 558, 561, 564, 567, 570, 573, 576, 579, 582, 585, 588, 591, 594, 597, 600,
+		603, 606, 609, 612, 615, 618, 621, 624, 627, 630, 633, 636, 639, 642, 645, 648,
+		651, 654, 657, 660, 663, 666, 669, 672, 675, 678, 681, 684, 687, 690, 693, 696,
+		699, 702, 705, 708, 711, 714, 717, 720, 723, 0, 1, 2, 3, 4, 5, 7,
+		10, 13, 15, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42,
+		44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74,
+		76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106,
+		109, 111, 113, 116, 119, 122, 124, 127, 130, 132, 137, 141, 146, 152, 154, 157,
+		162, 166, 170, 174, 178, 184, 187, 190, 196, 200, 202, 207, 211, 215, 219, 222,
+		225, 225, 226, 227, 228, 230, 232, 235, 239, 244, 249, 253, 258, 264, 266, 269,
+		274, 278, 282, 286, 290, 296, 299, 302, 305, 311, 315, 317, 322, 326, 330, 334,
+		337, 337, 337, 338, 339, 340, 341, 342, 344, 346, 351, 356, 360, 364, 369, 375,
+		377, 379, 382, 387, 391, 395, 399, 403, 407, 411, 417, 423, 426, 429, 432, 435,
+		441, 445, 447, 452, 456, 460, 464, 468, 471, 474, 477, 480, 483, 486, 489, 492,
+		495, 496, 497, 498, 499, 500, 501, 503, 505, 508, 511, 514, 517, 520, 523, 526,
+		529, 532, 535, 538, 541, 544, 547, 550, 553, 556, 559, 562, 565, 568, 571, 574,
+		577, 580, 583, 586, 589, 592, 595, 598, 601, 604, 607, 610, 613, 616, 619, 622,
+		625, 628, 631, 634, 637, 640, 643, 646, 649, 652, 657, 661, 666, 676, 678, 681,
+		685, 689, 693, 697, 701, 707, 710, 713, 719, 722, 724, 729, 733, 736, 740, 743,
+		746, 749, 752, 755, 758, 759, 760, 762, 764, 767, 0, 3, 6, 10, 14, 16,
+		19, 22, 25, 28, 31, 34, 38, 41, 44, 48, 51, 53, 57, 60, 64, 68,
+		71, 72, 74, 76, 79, 80, 81, 84, 87, 90, 90, 91, 92, 93, 94, 95,
+		96, 97, 98, 99, 100, 101, 102, 103, 104, 106, 107, 108, 109, 110, 111, 113,
+		115, 117, 119, 121, 122, 125, 128, 131, 134, 137, 137, 140, 143, 146, 149, 152,
+		155, 158, 161, 164, 167, 170, 173, 176, 179, 182, 185, 188, 191, 194, 197, 200,
+		203, 206, 209, 212, 215, 218, 221, 224, 227, 230, 233, 236, 239, 242, 245, 248,
+		251, 254, 257, 260, 263, 266, 269, 272, 275, 278, 281, 284, 287, 290, 293, 296,
+		299, 302, 305, 308, 311, 314, 317, 320, 323, 326, 329, 330, 331, 332, 333, 335,
+		337, 339, 342, 344, 346, 348, 351, 353, 356, 358, 361, 363, 366, 369, 371, 374,
+		376, 378, 380, 382, 385, 387, 390, 392, 394, 397, 400, 401, 402, 403, 404, 406,
+		408, 410, 412, 414, 416, 418, 420, 422, 425, 428, 430, 432, 434, 436, 438, 440,
+		442, 444, 446, 449, 452, 455, 458, 463, 466, 469, 472, 473, 474, 475, 476, 477,
+		478, 479, 480, 481, 483, 485, 487, 489, 491, 493, 495, 498, 501, 504, 507, 510,
+		513, 516, 519, 522, 525, 528, 532, 536, 540, 544, 548, 552, 556, 560, 564, 569,
+		574, 579, 584, 589, 594, 599, 604, 609, 615, 621, 627, 633, 639, 645, 651, 657,
+		663, 667, 670, 673, 676, 680, 683, 687, 690, 693, 696, 0, 1, 1, 1, 1,
+		4, 4, 4, 7, 10, 13, 16, 19, 19, 19, 19, 19, 21, 24, 26, 29,
+		32, 34, 37, 39, 42, 45, 48, 52, 55, 59, 62, 64, 67, 69, 72, 74,
+		76, 79, 81, 84, 86, 88, 90, 92, 94, 97, 100, 102, 104, 106, 109, 114,
+		118, 121, 124, 127, 127, 127, 127, 130, 133, 136, 139, 139, 140, 141, 142, 143,
+		145, 147, 150, 154, 157, 160, 163, 166, 169, 172, 175, 178, 178, 178, 178, 178,
+		178, 178, 178, 178, 178, 181, 184, 187, 190, 193, 196, 199, 201, 207, 211, 214,
+		218, 221, 225, 229, 233, 236, 239, 243, 246, 251, 256, 262, 264, 268, 271, 277,
+		282, 288, 293, 297, 301, 308, 312, 316, 321, 322, 324, 324, 327, 330, 333, 337,
+		340, 343, 346, 349, 352, 355, 358, 361, 365, 369, 372, 375, 378, 382, 385, 388,
+		392, 395, 400, 403, 407, 411, 414, 418, 421, 422, 424, 426, 429, 432, 435, 438,
+		441, 444, 447, 450, 453, 456, 459, 462, 465, 468, 471, 474, 477, 480, 483, 486,
+		489, 492, 495, 498, 501, 504, 507, 510, 513, 516, 519, 522, 527, 531, 536, 541,
+		547, 553, 555, 558, 558, 563, 569, 574, 580, 584, 588, 592, 596, 600, 605, 611,
+		618, 625, 628, 631, 637, 641, 646, 648, 650, 655, 659, 663, 668, 672, 676, 681,
+		684, 684, 684, 687, 690, 693, 696, 697, 698, 700, 702, 705, 705, 705, 705, 705,
+		705, 705, 705, 708, 711, 714, 717, 720, 723, 726, 729, 732, 0, 1, 3, 5,
+		8, 10, 13, 15, 18, 19, 21, 22, 24, 25, 27, 28, 30, 32, 34, 37,
+		40, 42, 45, 48, 50, 52, 54, 57, 59, 62, 65, 67, 69, 71, 74, 77,
+		81, 85, 87, 90, 93, 95, 98, 101, 103, 105, 107, 109, 111, 113, 116, 119,
+		123, 127, 129, 132, 135, 138, 138, 138, 138, 138, 138, 138, 138, 143, 147, 152,
+		158, 160, 163, 168, 172, 176, 180, 184, 190, 193, 196, 202, 206, 208, 213, 217,
+		221, 225, 228, 231, 234, 235, 236, 237, 238, 240, 242, 245, 249, 254, 258, 263,
+		269, 271, 282, 287, 291, 295, 299, 303, 309, 316, 319, 325, 327, 332, 336, 339,
+		342, 345, 348, 351, 354, 355, 356, 357, 358, 360, 362, 365, 369, 374, 378, 383,
+		389, 391, 402, 407, 411, 415, 419, 425, 432, 435, 441, 443, 448, 452, 455, 458,
+		461, 464, 467, 470, 473, 476, 476, 476, 476, 476, 479, 482, 485, 488, 491, 494,
+		497, 500, 503, 506, 509, 512, 513, 514, 515, 516, 518, 520, 523, 526, 529, 532,
+		535, 538, 541, 544, 547, 550, 553, 556, 559, 562, 565, 568, 571, 574, 577, 580,
+		583, 586, 589, 592, 595, 598, 601, 604, 607, 610, 613, 616, 619, 622, 625, 628,
+		631, 634, 637, 640, 643, 646, 649, 652, 655, 658, 661, 664, 667, 670, 673, 676,
+		679, 682, 685, 688, 691, 694, 697, 700, 703, 706, 709, 712, 715, 718, 721, 724,
+		727, 730, 733, 736, 739, 742, 745, 748, 751, 754, 757, 760, 763, 0, 1, 2,
+		4, 5, 6, 7, 8, 10, 12, 14, 16, 19, 22, 24, 26, 29, 32, 34,
+		36, 39, 41, 43, 45, 47, 50, 53, 56, 59, 62, 65, 67, 69, 72, 75,
+		77, 79, 82, 85, 88, 91, 94, 97, 100, 103, 106, 109, 113, 115, 117, 119,
+		121, 123, 125, 127, 129, 131, 133, 135, 137, 139, 142, 144, 147, 150, 153, 156,
+		159, 161, 163, 166, 169, 171, 175, 178, 181, 184, 187, 190, 193, 196, 199, 202,
+		205, 208, 211, 214, 217, 220, 223, 226, 229, 232, 235, 238, 241, 244, 247, 250,
+		253, 256, 259, 262, 265, 268, 271, 274, 277, 280, 283, 286, 289, 292, 295, 298,
+		301, 304, 307, 310, 313, 316, 319, 322, 325, 328, 331, 334, 337, 340, 341, 343,
+		345, 348, 350, 353, 356, 358, 361, 362, 363, 365, 367, 369, 372, 374, 375, 377,
+		379, 381, 383, 386, 388, 391, 393, 395, 398, 399, 401, 403, 405, 408, 410, 413,
+		415, 417, 419, 422, 424, 427, 430, 433, 434, 436, 438, 440, 442, 444, 447, 456,
+		458, 461, 464, 467, 470, 473, 476, 479, 482, 485, 488, 491, 494, 497, 498, 500,
+		502, 505, 507, 510, 513, 515, 518, 519, 520, 522, 524, 526, 529, 531, 532, 534,
+		536, 538, 540, 543, 545, 548, 550, 552, 555, 556, 558, 560, 562, 565, 567, 570,
+		572, 574, 576, 579, 581, 584, 587, 590, 591, 593, 595, 597, 599, 601, 604, 613,
+		615, 618, 621, 624, 627, 630, 633, 636, 637, 638, 640, 642, 645, 649, 0, 1,
+		3, 5, 7, 10, 12, 14, 16, 19, 21, 23, 26, 28, 31, 33, 35, 38,
+		40, 42, 44, 46, 48, 50, 52, 54, 56, 59, 62, 64, 65, 66, 67, 68,
+		69, 72, 75, 75, 75, 75, 75, 78, 81, 84, 87, 90, 93, 96, 99, 100,
+		101, 102, 103, 104, 105, 106, 107, 108, 109, 112, 115, 118, 121, 124, 127, 130,
+		133, 136, 139, 142, 145, 148, 151, 154, 157, 160, 163, 166, 169, 172, 175, 178,
+		181, 184, 187, 190, 193, 196, 199, 202, 205, 208, 211, 214, 217, 220, 223, 226,
+		229, 232, 235, 238, 241, 244, 247, 250, 253, 256, 259, 262, 265, 268, 271, 274,
+		277, 280, 283, 286, 289, 292, 295, 298, 301, 304, 307, 310, 313, 316, 319, 322,
+		325, 328, 331, 334, 337, 340, 343, 346, 349, 352, 355, 358, 361, 364, 367, 370,
+		373, 376, 379, 382, 385, 388, 391, 394, 397, 400, 403, 406, 409, 412, 415, 418,
+		421, 424, 427, 430, 433, 436, 439, 442, 445, 448, 451, 454, 457, 460, 463, 466,
+		469, 472, 475, 478, 481, 484, 487, 490, 493, 496, 499, 502, 505, 508, 511, 514,
+		517, 520, 523, 526, 529, 532, 535, 538, 541, 544, 547, 550, 553, 556, 559, 562,
+		565, 568, 571, 574, 577, 580, 583, 586, 589, 592, 595, 598, 601, 604, 607, 610,
+		613, 616, 619, 622, 625, 628, 631, 634, 637, 640, 643, 646, 649, 652, 655, 658,
+		661, 664, 667, 670, 673, 676, 679, 682, 685, 688, 691, 694, 697, 700, 703, 0,
+		3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39, 42, 45, 48,
+		51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84, 87, 90, 93, 96,
+		99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129, 132, 135, 138, 141, 144,
+		147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 177, 180, 183, 186, 189, 192,
+		195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228, 231, 234, 237, 240,
+		243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273, 276, 279, 282, 285, 288,
+		289, 290, 291, 292, 293, 294, 295, 296, 297, 299, 301, 303, 305, 307, 309, 311,
+		313, 315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357,
+		361, 363, 365, 368, 371, 373, 376, 380, 385, 388, 390, 393, 396, 398, 401, 403,
+		405, 408, 412, 415, 418, 420, 422, 425, 430, 432, 434, 436, 439, 442, 446, 449,
+		452, 455, 458, 460, 463, 465, 467, 470, 473, 475, 478, 478, 478, 478, 481, 484,
+		487, 490, 493, 496, 499, 502, 505, 508, 511, 514, 517, 520, 523, 526, 529, 532,
+		535, 538, 541, 544, 547, 550, 553, 556, 559, 562, 565, 568, 571, 574, 577, 580,
+		583, 586, 589, 592, 595, 598, 601, 604, 607, 610, 613, 616, 619, 622, 625, 628,
+		631, 634, 637, 640, 643, 646, 649, 652, 655, 658, 661, 664, 667, 670, 673, 676,
+		679, 682, 685, 688, 691, 694, 697, 700, 703, 706, 709, 712, 715, 715, 715, 715,
+		0, 5, 10, 14, 18, 23, 25, 27, 30, 35, 39, 43, 47, 53, 56, 59,
+		62, 65, 71, 75, 79, 81, 86, 91, 96, 110, 114, 117, 120, 123, 124, 125,
+		126, 127, 128, 130, 132, 134, 137, 140, 150, 153, 156, 159, 162, 165, 168, 171,
+		174, 179, 183, 188, 190, 193, 198, 202, 206, 210, 216, 219, 222, 228, 232, 234,
+		239, 247, 251, 254, 258, 262, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265,
+		265, 265, 266, 268, 270, 273, 273, 273, 273, 273, 273, 276, 279, 282, 285, 288,
+		291, 294, 297, 300, 303, 306, 309, 312, 315, 318, 321, 324, 327, 330, 333, 336,
+		339, 344, 348, 357, 360, 365, 369, 373, 377, 383, 386, 389, 395, 397, 402, 406,
+		410, 413, 417, 417, 417, 417, 417, 417, 417, 417, 417, 420, 423, 426, 429, 432,
+		435, 438, 441, 444, 447, 450, 453, 456, 459, 462, 465, 468, 471, 474, 477, 480,
+		483, 486, 489, 492, 495, 498, 501, 504, 507, 510, 513, 516, 519, 522, 525, 528,
+		531, 536, 541, 545, 550, 556, 558, 561, 564, 569, 573, 577, 581, 587, 590, 593,
+		599, 603, 605, 609, 613, 616, 617, 618, 619, 620, 622, 624, 627, 630, 633, 636,
+		639, 642, 645, 648, 651, 654, 657, 660, 663, 666, 669, 672, 675, 678, 681, 684,
+		687, 692, 696, 701, 707, 709, 712, 717, 721, 725, 729, 733, 739, 742, 745, 751,
+		755, 757, 762, 766, 770, 774, 777, 786, 789, 792, 795, 798, 801, 804, 807, 810,
+		813, 0, 0, 0, 0, 11, 22, 23, 25, 26, 28, 29, 31, 32, 34, 35,
+		37, 38, 40, 41, 43, 45, 48, 50, 53, 56, 58, 61, 63, 66, 69, 72,
+		76, 79, 83, 86, 88, 91, 93, 96, 98, 100, 103, 105, 108, 110, 112, 114,
+		116, 118, 121, 124, 126, 128, 131, 135, 138, 142, 142, 142, 142, 142, 142, 142,
+		142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+		145, 148, 151, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 165, 167, 169,
+		171, 173, 175, 177, 179, 181, 184, 188, 189, 190, 191, 192, 193, 194, 195, 196,
+		197, 198, 198, 199, 200, 200, 200, 203, 206, 209, 212, 215, 218, 221, 224, 227,
+		230, 230, 230, 230, 230, 231, 233, 234, 236, 237, 239, 240, 242, 243, 245, 247,
+		250, 252, 255, 258, 260, 263, 265, 268, 271, 274, 278, 281, 286, 290, 293, 296,
+		298, 301, 303, 306, 308, 310, 313, 315, 318, 320, 322, 324, 326, 328, 331, 334,
+		336, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338,
+		338, 338, 338, 338, 338, 341, 344, 347, 350, 353, 356, 359, 362, 365, 368, 368,
+		371, 374, 377, 380, 383, 386, 389, 392, 395, 399, 402, 405, 408, 411, 414, 417,
+		420, 423, 426, 430, 432, 435, 437, 439, 441, 443, 446, 449, 452, 455, 458, 461,
+		464, 467, 468, 469, 470, 471, 472, 473, 474, 475, 476, 477, 480, 483, 486, 489,
+		492, 495, 0, 0, 0, 0, 2, 3, 4, 5, 8, 12, 15, 19, 23, 26,
+		30, 33, 37, 41, 45, 50, 54, 59, 63, 66, 70, 73, 77, 80, 83, 87,
+		90, 94, 97, 101, 104, 107, 110, 113, 116, 119, 119, 119, 119, 119, 119, 119,
+		119, 119, 119, 119, 119, 119, 119, 119, 122, 123, 124, 125, 126, 127, 128, 129,
+		130, 131, 132, 132, 132, 132, 132, 136, 136, 136, 139, 142, 145, 148, 151, 154,
+		157, 160, 163, 164, 165, 166, 167, 168, 170, 173, 175, 178, 180, 183, 185, 188,
+		191, 194, 198, 201, 205, 208, 210, 213, 215, 218, 220, 222, 225, 227, 230, 232,
+		234, 236, 238, 240, 242, 245, 245, 245, 245, 249, 252, 255, 258, 261, 264, 267,
+		270, 273, 276, 276, 276, 276, 277, 279, 280, 282, 283, 285, 286, 288, 289, 291,
+		292, 294, 295, 297, 299, 302, 304, 307, 310, 312, 315, 317, 320, 323, 326, 330,
+		333, 337, 340, 342, 345, 347, 350, 352, 354, 357, 359, 362, 364, 366, 368, 370,
+		373, 375, 378, 381, 383, 385, 385, 385, 385, 385, 385, 385, 385, 385, 385, 385,
+		385, 385, 385, 385, 393, 404, 415, 418, 418, 418, 418, 418, 418, 418, 418, 418,
+		418, 418, 418, 419, 420, 421, 422, 423, 424, 425, 426, 427, 428, 431, 431, 434,
+		434, 434, 434, 437, 438, 439, 440, 441, 442, 443, 444, 445, 446, 448, 450, 452,
+		454, 456, 458, 460, 462, 464, 467, 471, 474, 477, 480, 483, 486, 489, 492, 495,
+		498, 501, 504, 0, 1, 3, 4, 5, 6, 8, 9, 11, 13, 16, 18, 21,
+		24, 27, 29, 32, 34, 37, 40, 43, 46, 50, 53, 57, 60, 62, 65, 67,
+		71, 74, 76, 78, 81, 83, 86, 89, 91, 93, 95, 97, 99, 101, 103, 106,
+		106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+		106, 106, 106, 108, 109, 109, 112, 115, 118, 121, 124, 127, 130, 133, 136, 139,
+		142, 145, 148, 151, 154, 157, 160, 163, 166, 169, 172, 175, 178, 181, 184, 187,
+		190, 193, 196, 199, 202, 205, 208, 211, 214, 217, 220, 223, 226, 229, 232, 235,
+		238, 241, 244, 247, 250, 253, 256, 259, 262, 265, 268, 271, 274, 277, 280, 283,
+		286, 289, 292, 295, 296, 297, 298, 299, 301, 304, 306, 309, 312, 315, 317, 320,
+		322, 325, 328, 331, 334, 338, 341, 345, 349, 352, 354, 357, 359, 362, 364, 366,
+		369, 371, 374, 377, 379, 381, 383, 385, 387, 389, 391, 394, 397, 397, 400, 403,
+		406, 409, 412, 415, 416, 418, 419, 421, 422, 424, 425, 427, 428, 430, 432, 435,
+		437, 440, 443, 446, 448, 451, 453, 456, 459, 462, 465, 469, 472, 476, 479, 483,
+		486, 488, 491, 493, 496, 498, 500, 503, 505, 508, 511, 513, 515, 517, 519, 521,
+		524, 526, 528, 528, 528, 528, 528, 528, 528, 528, 528, 528, 528, 528, 528, 531,
+		534, 537, 540, 543, 544, 545, 546, 547, 548, 549, 550, 551, 552, 553, 556, 559,
+		562, 565, 568, 571, 0, 0, 0, 0, 0, 3, 4, 6, 7, 9, 10, 12,
+		13, 14, 17, 20, 22, 24, 27, 30, 32, 34, 36, 39, 41, 44, 47, 49,
+		52, 54, 57, 60, 63, 67, 70, 74, 77, 79, 82, 84, 87, 89, 92, 94,
+		97, 99, 102, 104, 106, 108, 111, 113, 116, 119, 121, 124, 127, 129, 131, 134,
+		134, 134, 142, 142, 142, 142, 142, 142, 142, 142, 145, 148, 148, 148, 151, 154,
+		154, 154, 154, 157, 160, 163, 166, 169, 172, 175, 178, 181, 181, 184, 187, 190,
+		193, 196, 201, 209, 217, 219, 221, 221, 221, 224, 227, 227, 227, 227, 227, 227,
+		227, 227, 230, 233, 236, 236, 236, 236, 236, 236, 239, 242, 245, 248, 251, 254,
+		257, 260, 263, 266, 269, 272, 275, 278, 281, 284, 287, 290, 293, 296, 299, 302,
+		305, 308, 311, 314, 317, 320, 323, 326, 329, 332, 335, 338, 341, 344, 347, 350,
+		353, 356, 359, 362, 365, 368, 371, 374, 377, 380, 383, 386, 389, 392, 395, 398,
+		401, 404, 407, 410, 413, 416, 419, 422, 425, 428, 431, 434, 437, 440, 443, 446,
+		449, 452, 455, 458, 461, 464, 467, 470, 473, 476, 479, 482, 485, 488, 491, 494,
+		497, 500, 503, 506, 509, 512, 515, 518, 521, 524, 527, 530, 533, 536, 539, 542,
+		545, 548, 551, 554, 557, 560, 563, 566, 569, 572, 575, 578, 581, 584, 587, 590,
+		593, 596, 599, 602, 605, 608, 611, 614, 617, 620, 623, 626, 629, 632, 635, 638,
+		641, 644, 647, 650, 653, 0, 1, 3, 4, 6, 7, 9, 10, 12, 13, 15,
+		16, 18, 19, 21, 23, 26, 28, 31, 34, 38, 40, 43, 45, 48, 51, 55,
+		58, 62, 65, 69, 72, 74, 77, 79, 82, 84, 87, 89, 92, 94, 97, 99,
+		102, 104, 106, 109, 111, 114, 116, 119, 122, 124, 126, 126, 126, 126, 126, 126,
+		126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 134, 142, 145,
+		148, 148, 148, 148, 148, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
+		158, 158, 161, 161, 161, 169, 180, 191, 194, 197, 200, 203, 206, 209, 212, 215,
+		218, 221, 224, 227, 230, 233, 236, 239, 242, 245, 248, 251, 254, 257, 260, 263,
+		266, 269, 272, 275, 278, 281, 284, 285, 287, 288, 290, 291, 293, 294, 296, 297,
+		299, 300, 302, 303, 305, 307, 310, 312, 315, 318, 320, 323, 325, 328, 331, 334,
+		338, 341, 345, 348, 350, 353, 355, 358, 360, 362, 365, 367, 370, 372, 374, 376,
+		378, 380, 383, 386, 388, 390, 390, 390, 390, 390, 390, 390, 390, 390, 390, 390,
+		390, 390, 390, 390, 390, 390, 390, 390, 390, 390, 398, 401, 401, 404, 407, 410,
+		413, 416, 419, 422, 425, 428, 429, 430, 431, 432, 433, 434, 435, 436, 437, 438,
+		441, 444, 447, 450, 453, 456, 459, 462, 465, 468, 471, 474, 477, 480, 483, 486,
+		489, 492, 495, 498, 501, 504, 507, 510, 513, 516, 519, 522, 525, 528, 531, 534,
+		537, 540, 543, 546, 549, 552, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27,
+		30, 33, 36, 39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75,
+		78, 81, 84, 87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123,
+		126, 129, 132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171,
+		174, 177, 180, 183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216, 219,
+		222, 225, 228, 231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264, 267,
+		270, 273, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 315,
+		318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360, 363,
+		366, 369, 372, 375, 378, 381, 384, 385, 387, 388, 390, 391, 393, 394, 396, 397,
+		399, 400, 402, 403, 405, 407, 410, 412, 415, 418, 420, 423, 425, 428, 431, 434,
+		438, 441, 445, 448, 450, 453, 455, 458, 460, 462, 465, 467, 470, 472, 474, 476,
+		478, 480, 483, 486, 488, 490, 490, 490, 490, 490, 490, 490, 490, 493, 496, 496,
+		496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 496,
+		496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 496, 497,
+		498, 500, 501, 501, 501, 504, 507, 510, 513, 516, 519, 522, 525, 528, 531, 534,
+		537, 540, 543, 546, 549, 552, 555, 558, 561, 564, 567, 570, 573, 576, 579, 582,
+		585, 588, 591, 594, 597, 600, 603, 0, 1, 3, 4, 6, 7, 9, 10, 12,
+		13, 15, 16, 18, 19, 21, 23, 26, 28, 31, 34, 36, 39, 41, 44, 47,
+		50, 54, 57, 61, 64, 66, 69, 71, 74, 76, 78, 81, 83, 86, 88, 90,
+		92, 94, 96, 99, 102, 104, 106, 109, 109, 109, 109, 109, 109, 109, 109, 109,
+		109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 113, 116, 119, 122,
+		125, 128, 131, 134, 137, 140, 143, 146, 147, 148, 149, 150, 151, 152, 153, 154,
+		155, 156, 159, 162, 165, 168, 171, 174, 174, 174, 174, 174, 174, 174, 174, 174,
+		174, 174, 174, 174, 174, 177, 180, 183, 186, 189, 192, 195, 198, 201, 204, 207,
+		210, 213, 216, 219, 222, 225, 228, 231, 232, 234, 235, 237, 238, 240, 241, 243,
+		244, 246, 248, 251, 253, 256, 259, 261, 264, 266, 269, 272, 275, 279, 282, 286,
+		289, 291, 294, 296, 299, 301, 303, 306, 308, 311, 313, 315, 317, 319, 321, 324,
+		326, 328, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331,
+		334, 334, 337, 340, 343, 346, 349, 352, 353, 354, 355, 356, 357, 358, 359, 360,
+		361, 362, 365, 368, 371, 374, 377, 380, 383, 386, 389, 392, 395, 398, 401, 404,
+		407, 410, 413, 416, 419, 422, 425, 428, 431, 434, 437, 440, 443, 446, 449, 452,
+		455, 458, 461, 464, 467, 470, 473, 476, 479, 482, 485, 488, 491, 494, 497, 500,
+		503, 506, 509, 512, 515, 518, 521, 524, 0, 2, 5, 8, 10, 12, 14, 16,
+		19, 21, 23, 25, 28, 31, 33, 35, 37, 40, 42, 43, 45, 48, 50, 52,
+		55, 58, 61, 63, 66, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+		69, 69, 69, 69, 69, 72, 75, 78, 81, 82, 83, 84, 85, 86, 87, 88,
+		89, 90, 91, 93, 95, 95, 95, 95, 95, 97, 100, 104, 107, 111, 114, 117,
+		120, 123, 126, 129, 132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165,
+		168, 171, 174, 177, 180, 183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213,
+		216, 219, 222, 225, 228, 231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261,
+		264, 267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309,
+		312, 315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357,
+		360, 363, 366, 369, 372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405,
+		408, 411, 414, 417, 420, 423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453,
+		456, 459, 462, 465, 468, 471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 501,
+		504, 507, 510, 513, 516, 519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549,
+		552, 555, 558, 561, 564, 567, 570, 573, 576, 579, 582, 585, 588, 591, 594, 597,
+		600, 603, 606, 609, 612, 615, 618, 621, 624, 627, 630, 633, 636, 639, 642, 645,
+		648, 651, 654, 657, 660, 663, 666, 669, 672, 0, 1, 3, 4, 6, 7, 9,
+		10, 12, 13, 15, 17, 20, 22, 25, 28, 30, 33, 35, 38, 41, 44, 48,
+		51, 55, 58, 60, 63, 65, 68, 70, 72, 75, 77, 80, 82, 84, 86, 88,
+		90, 93, 96, 98, 100, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
+		103, 103, 103, 103, 103, 103, 106, 109, 112, 115, 118, 121, 124, 127, 130, 133,
+		136, 139, 142, 145, 148, 151, 154, 157, 160, 163, 166, 169, 172, 175, 178, 181,
+		184, 187, 190, 193, 196, 199, 202, 205, 208, 211, 214, 217, 220, 223, 226, 229,
+		232, 235, 238, 241, 244, 247, 250, 253, 256, 259, 262, 265, 268, 271, 274, 277,
+		280, 283, 286, 289, 292, 295, 298, 301, 304, 307, 310, 313, 316, 319, 322, 325,
+		328, 331, 334, 337, 340, 343, 346, 349, 352, 355, 358, 361, 364, 367, 370, 373,
+		376, 379, 382, 385, 388, 391, 394, 397, 400, 403, 407, 408, 410, 412, 414, 416,
+		418, 420, 421, 422, 425, 427, 429, 432, 435, 437, 440, 443, 446, 450, 452, 454,
+		456, 458, 460, 464, 468, 472, 475, 479, 482, 486, 490, 491, 493, 495, 497, 499,
+		501, 503, 504, 505, 508, 510, 512, 515, 518, 520, 523, 526, 529, 533, 535, 537,
+		539, 541, 543, 547, 551, 555, 558, 562, 565, 569, 570, 571, 572, 573, 574, 575,
+		576, 577, 578, 579, 581, 583, 585, 587, 589, 591, 593, 595, 597, 600, 603, 606,
+		609, 612, 615, 618, 621, 624, 627, 630, 633, 636, 0, 1, 3, 4, 6, 7,
+		9, 10, 13, 16, 17, 20, 23, 25, 28, 30, 33, 36, 38, 41, 43, 46,
+		49, 52, 55, 58, 62, 65, 67, 70, 72, 75, 77, 79, 82, 84, 87, 89,
+		91, 94, 96, 98, 100, 103, 106, 108, 110, 113, 115, 115, 115, 115, 115, 115,
+		115, 118, 118, 118, 121, 124, 124, 124, 124, 124, 127, 127, 130, 130, 130, 130,
+		130, 130, 133, 136, 139, 142, 145, 148, 151, 154, 157, 158, 159, 160, 161, 162,
+		163, 164, 165, 166, 167, 170, 173, 176, 179, 182, 185, 188, 191, 194, 197, 200,
+		203, 206, 209, 212, 215, 218, 221, 224, 227, 230, 233, 236, 239, 242, 245, 248,
+		251, 254, 257, 260, 263, 266, 269, 272, 275, 278, 281, 284, 287, 290, 293, 296,
+		299, 302, 305, 308, 311, 314, 317, 320, 323, 326, 329, 332, 335, 338, 341, 344,
+		347, 350, 353, 356, 359, 362, 365, 368, 371, 374, 377, 378, 380, 381, 383, 384,
+		386, 387, 389, 392, 395, 396, 398, 399, 401, 403, 406, 408, 411, 414, 416, 419,
+		421, 424, 427, 430, 434, 437, 441, 444, 446, 449, 451, 454, 456, 458, 461, 463,
+		466, 468, 470, 472, 474, 476, 479, 482, 484, 486, 489, 492, 492, 492, 492, 492,
+		492, 492, 492, 495, 498, 498, 498, 498, 498, 498, 498, 498, 506, 506, 509, 509,
+		512, 515, 518, 521, 524, 527, 530, 533, 536, 539, 542, 545, 548, 551, 554, 557,
+		560, 563, 566, 569, 572, 575, 578, 581, 584, 587, 590, 0, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 3, 6, 8, 11, 14, 16, 19, 21, 24,
+		27, 31, 34, 38, 41, 43, 46, 48, 51, 53, 55, 58, 60, 63, 65, 68,
+		72, 75, 79, 82, 84, 85, 87, 89, 91, 93, 96, 99, 101, 103, 107, 107,
+		107, 107, 107, 107, 107, 107, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
+		109, 109, 109, 109, 112, 115, 118, 121, 124, 127, 130, 133, 134, 134, 134, 134,
+		134, 134, 134, 134, 134, 134, 134, 134, 136, 139, 141, 144, 147, 149, 152, 154,
+		157, 160, 163, 167, 170, 174, 177, 179, 182, 184, 187, 189, 191, 194, 196, 199,
+		201, 204, 208, 211, 214, 216, 217, 219, 221, 223, 225, 228, 231, 233, 235, 239,
+		250, 261, 263, 265, 268, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270,
+		270, 270, 270, 270, 270, 270, 270, 270, 270, 273, 273, 273, 273, 273, 273, 276,
+		279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 314, 317, 319, 322,
+		324, 327, 331, 336, 340, 345, 349, 354, 357, 360, 363, 366, 368, 370, 372, 374,
+		376, 378, 380, 383, 385, 387, 390, 392, 394, 396, 398, 401, 403, 406, 408, 410,
+		413, 414, 415, 416, 417, 418, 420, 422, 423, 424, 425, 426, 427, 428, 429, 431,
+		432, 435, 438, 441, 444, 447, 450, 453, 456, 459, 462, 465, 468, 471, 474, 477,
+		480, 483, 486, 489, 492, 495, 498, 501, 504, 507, 510, 513, 0, 1, 3, 4,
+		6, 7, 9, 10, 12, 13, 16, 17, 19, 20, 22, 24, 27, 29, 32, 35,
+		37, 40, 42, 45, 48, 51, 55, 58, 62, 65, 67, 70, 72, 75, 77, 79,
+		82, 84, 87, 89, 91, 93, 95, 97, 100, 103, 105, 107, 107, 107, 107, 107,
+		107, 107, 107, 107, 110, 110, 110, 110, 110, 110, 110, 110, 110, 118, 118, 118,
+		118, 118, 118, 121, 124, 127, 130, 133, 136, 139, 142, 145, 148, 149, 150, 151,
+		152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
+		169, 171, 173, 175, 177, 179, 181, 183, 185, 188, 191, 194, 197, 197, 197, 199,
+		202, 204, 207, 209, 212, 214, 217, 219, 222, 224, 226, 228, 231, 233, 235, 238,
+		242, 245, 247, 250, 252, 253, 255, 257, 259, 262, 264, 266, 267, 270, 273, 273,
+		273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+		273, 273, 273, 273, 273, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+		276, 276, 276, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312,
+		315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360,
+		363, 366, 369, 372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408,
+		411, 414, 417, 420, 423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453, 456,
+		459, 462, 465, 468, 471, 474, 477, 480, 483, 486, 489, 492, 495, 0, 1, 3,
+		4, 6, 7, 9, 10, 13, 15, 16, 19, 21, 23, 26, 28, 31, 34, 36,
+		39, 41, 44, 47, 50, 54, 57, 61, 64, 66, 69, 71, 74, 76, 78, 81,
+		83, 86, 88, 90, 92, 94, 96, 99, 102, 104, 106, 109, 113, 117, 120, 120,
+		120, 120, 120, 120, 120, 123, 126, 129, 129, 132, 132, 132, 135, 135, 135, 135,
+		135, 135, 135, 135, 138, 138, 141, 144, 147, 150, 153, 156, 159, 162, 163, 164,
+		165, 166, 167, 168, 169, 170, 171, 172, 175, 178, 181, 184, 187, 190, 191, 193,
+		194, 196, 197, 199, 202, 204, 206, 209, 211, 213, 215, 217, 219, 222, 224, 226,
+		229, 231, 234, 236, 238, 241, 243, 246, 248, 250, 253, 256, 260, 263, 265, 268,
+		271, 275, 278, 280, 283, 285, 287, 289, 289, 289, 289, 289, 289, 292, 292, 292,
+		295, 295, 295, 295, 295, 295, 298, 301, 304, 307, 310, 313, 316, 319, 320, 321,
+		322, 323, 324, 325, 326, 327, 328, 329, 332, 335, 338, 341, 344, 347, 350, 353,
+		356, 359, 362, 365, 368, 371, 374, 377, 380, 383, 386, 389, 392, 395, 398, 401,
+		404, 407, 410, 413, 416, 419, 422, 425, 428, 431, 434, 437, 440, 443, 446, 449,
+		452, 455, 458, 461, 464, 467, 470, 473, 476, 479, 482, 485, 488, 491, 494, 497,
+		500, 503, 506, 509, 512, 515, 518, 521, 524, 527, 530, 533, 536, 539, 542, 545,
+		548, 551, 554, 557, 560, 563, 566, 569, 572, 575, 578, 581, 584, 587, 0, 3,
+		6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39, 42, 45, 48, 51,
+		54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84, 87, 90, 93, 96, 99,
+		102, 105, 108, 111, 114, 117, 120, 123, 126, 129, 132, 135, 138, 141, 144, 147,
+		150, 153, 156, 159, 162, 165, 168, 171, 174, 177, 180, 183, 186, 189, 192, 195,
+		198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228, 231, 234, 237, 240, 243,
+		246, 249, 252, 255, 258, 261, 264, 267, 270, 273, 276, 279, 282, 285, 288, 291,
+		294, 297, 300, 303, 306, 309, 312, 315, 318, 321, 324, 327, 330, 333, 336, 339,
+		342, 345, 348, 351, 354, 357, 360, 363, 366, 369, 372, 375, 378, 381, 384, 387,
+		390, 393, 396, 399, 402, 405, 408, 411, 414, 417, 420, 423, 426, 429, 432, 435,
+		438, 441, 444, 447, 450, 453, 456, 459, 462, 465, 468, 471, 474, 477, 480, 483,
+		486, 489, 492, 495, 498, 501, 504, 507, 510, 513, 516, 519, 522, 525, 528, 531,
+		534, 537, 540, 543, 546, 549, 552, 555, 558, 561, 564, 567, 570, 573, 576, 579,
+		582, 585, 588, 591, 594, 597, 600, 603, 606, 609, 612, 615, 618, 621, 624, 627,
+		630, 633, 636, 639, 642, 645, 648, 651, 654, 657, 660, 663, 666, 669, 672, 674,
+		676, 679, 681, 683, 685, 687, 689, 691, 693, 695, 698, 700, 702, 704, 706, 708,
+		709, 712, 712, 712, 712, 712, 712, 712, 715, 718, 721, 724, 727, 730, 733, 0,
+		0, 0, 5, 5, 6, 8, 9, 11, 12, 14, 15, 17, 18, 20, 21, 23,
+		24, 27, 29, 32, 34, 37, 40, 42, 45, 47, 50, 53, 56, 60, 63, 67,
+		70, 72, 75, 77, 80, 82, 84, 87, 89, 92, 94, 96, 98, 100, 102, 105,
+		108, 110, 112, 116, 116, 116, 116, 116, 116, 116, 116, 119, 122, 125, 125, 125,
+		125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+		126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 138, 141, 144, 147, 150, 153,
+		156, 159, 162, 165, 168, 171, 174, 177, 180, 183, 186, 189, 192, 195, 198, 201,
+		204, 207, 210, 213, 216, 219, 222, 225, 228, 231, 234, 237, 240, 243, 246, 249,
+		252, 255, 258, 261, 264, 267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 297,
+		300, 303, 306, 309, 312, 315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345,
+		348, 351, 354, 357, 360, 363, 366, 369, 372, 375, 378, 381, 384, 387, 390, 393,
+		396, 399, 402, 405, 408, 411, 414, 417, 420, 423, 426, 429, 432, 435, 438, 441,
+		446, 451, 455, 459, 463, 467, 471, 475, 479, 483, 487, 491, 494, 498, 502, 505,
+		508, 511, 514, 517, 522, 522, 522, 522, 522, 522, 522, 522, 522, 522, 522, 522,
+		522, 522, 522, 522, 522, 522, 522, 522, 522, 522, 522, 522, 522, 522, 522, 522,
+		522, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 552, 555, 558, 561, 561,
+		0, 1, 2, 5, 9, 11, 14, 18, 22, 25, 27, 29, 33, 37, 40, 44,
+		46, 50, 54, 59, 64, 65, 69, 72, 77, 81, 83, 87, 91, 93, 95, 100,
+		104, 106, 108, 112, 116, 118, 122, 124, 127, 130, 134, 139, 143, 146, 148, 150,
+		155, 159, 166, 171, 175, 179, 182, 187, 192, 195, 199, 203, 206, 209, 212, 216,
+		222, 224, 227, 231, 237, 240, 243, 248, 251, 256, 258, 259, 262, 266, 268, 270,
+		272, 274, 279, 284, 287, 291, 293, 296, 300, 304, 309, 311, 313, 317, 319, 323,
+		326, 328, 330, 332, 335, 337, 339, 344, 347, 349, 352, 354, 358, 362, 365, 368,
+		371, 376, 381, 383, 386, 389, 392, 396, 399, 403, 407, 409, 411, 415, 422, 425,
+		429, 433, 436, 441, 444, 447, 451, 455, 459, 463, 467, 468, 471, 474, 476, 478,
+		481, 483, 486, 489, 490, 494, 498, 500, 502, 506, 510, 512, 514, 516, 523, 527,
+		532, 536, 540, 541, 544, 547, 549, 552, 556, 560, 562, 566, 570, 576, 583, 586,
+		588, 591, 593, 595, 597, 599, 601, 605, 608, 610, 612, 615, 618, 620, 623, 626,
+		629, 631, 634, 636, 638, 640, 643, 646, 648, 650, 652, 656, 660, 663, 665, 668,
+		669, 671, 675, 678, 680, 682, 686, 690, 693, 696, 702, 705, 708, 710, 712, 714,
+		716, 719, 722, 724, 727, 730, 733, 736, 740, 743, 747, 748, 750, 752, 755, 759,
+		763, 766, 769, 772, 775, 780, 783, 786, 790, 794, 798, 802, 805, 809, 815, 822,
+		825, 0, 2, 3, 4, 6, 9, 12, 15, 20, 24, 28, 32, 35, 39, 42,
+		45, 47, 52, 56, 60, 63, 67, 71, 73, 75, 78, 81, 85, 88, 92, 96,
+		99, 102, 105, 110, 113, 116, 119, 122, 126, 131, 137, 139, 143, 147, 150, 152,
+		155, 159, 162, 166, 169, 172, 175, 178, 179, 181, 185, 187, 190, 196, 200, 202,
+		206, 207, 208, 210, 214, 217, 224, 226, 229, 232, 234, 236, 240, 242, 246, 249,
+		251, 255, 257, 259, 266, 270, 272, 274, 277, 279, 280, 282, 285, 289, 292, 297,
+		300, 302, 307, 311, 313, 316, 320, 323, 324, 326, 330, 333, 337, 339, 343, 346,
+		348, 351, 353, 356, 358, 360, 362, 364, 366, 368, 370, 375, 377, 380, 382, 384,
+		386, 389, 392, 395, 399, 402, 405, 410, 413, 414, 416, 418, 420, 423, 425, 428,
+		431, 434, 438, 442, 446, 450, 454, 457, 461, 464, 467, 470, 474, 478, 484, 485,
+		486, 491, 493, 496, 497, 499, 502, 505, 510, 514, 520, 526, 528, 532, 535, 538,
+		541, 544, 547, 551, 554, 557, 560, 566, 572, 574, 579, 580, 582, 585, 588, 590,
+		592, 596, 599, 601, 604, 606, 608, 611, 614, 617, 619, 622, 625, 629, 631, 633,
+		635, 637, 640, 643, 646, 647, 652, 656, 658, 661, 663, 665, 669, 671, 674, 679,
+		683, 687, 691, 694, 697, 701, 703, 704, 705, 706, 709, 711, 714, 721, 726, 729,
+		732, 736, 739, 745, 748, 751, 754, 757, 759, 761, 764, 770, 774, 776, 779, 782,
+		784, 787, 0, 4, 8, 12, 15, 17, 21, 25, 28, 30, 33, 38, 40, 42,
+		44, 47, 49, 53, 57, 60, 63, 70, 77, 80, 85, 90, 95, 102, 105, 108,
+		111, 114, 117, 119, 123, 127, 130, 133, 136, 140, 145, 147, 150, 152, 155, 157,
+		159, 162, 166, 172, 178, 182, 183, 186, 188, 192, 194, 198, 203, 204, 206, 208,
+		212, 214, 217, 221, 229, 233, 237, 242, 249, 252, 256, 258, 259, 261, 268, 270,
+		271, 274, 277, 281, 285, 291, 293, 296, 299, 302, 306, 308, 311, 314, 316, 319,
+		322, 325, 328, 333, 335, 339, 342, 345, 349, 352, 355, 358, 362, 365, 368, 373,
+		378, 384, 386, 389, 392, 396, 400, 402, 404, 409, 411, 413, 414, 416, 419, 422,
+		425, 430, 432, 433, 435, 437, 439, 440, 441, 443, 444, 446, 448, 453, 456, 458,
+		460, 465, 467, 470, 472, 474, 476, 483, 486, 487, 489, 492, 494, 497, 500, 503,
+		505, 508, 511, 515, 518, 520, 522, 524, 526, 529, 532, 536, 539, 543, 549, 552,
+		555, 559, 560, 563, 567, 569, 573, 576, 577, 578, 582, 587, 592, 595, 597, 600,
+		603, 608, 612, 617, 623, 631, 635, 636, 638, 642, 643, 646, 651, 654, 657, 660,
+		664, 670, 673, 676, 679, 685, 689, 693, 696, 701, 704, 707, 711, 717, 719, 723,
+		726, 730, 734, 738, 743, 745, 747, 750, 754, 759, 762, 768, 771, 775, 777, 785,
+		787, 789, 793, 796, 800, 807, 810, 812, 815, 818, 821, 825, 827, 831, 834, 836,
+		840, 842, 846, 0, 3, 6, 10, 13, 16, 18, 22, 25, 28, 31, 33, 34,
+		37, 38, 41, 44, 45, 47, 49, 51, 57, 60, 62, 63, 67, 71, 78, 81,
+		85, 87, 92, 94, 98, 99, 104, 108, 114, 116, 118, 122, 124, 126, 133, 136,
+		138, 140, 142, 144, 147, 149, 152, 154, 157, 160, 164, 167, 168, 174, 177, 180,
+		182, 185, 189, 192, 194, 196, 199, 201, 204, 206, 209, 212, 214, 217, 221, 223,
+		226, 228, 233, 238, 239, 242, 243, 245, 248, 252, 256, 260, 266, 271, 274, 280,
+		283, 285, 289, 292, 295, 299, 302, 304, 306, 309, 312, 316, 319, 323, 325, 328,
+		329, 334, 337, 344, 347, 348, 351, 355, 359, 362, 365, 368, 371, 372, 376, 380,
+		383, 387, 392, 396, 400, 403, 407, 410, 412, 416, 418, 421, 423, 426, 429, 432,
+		436, 438, 441, 444, 448, 451, 453, 460, 468, 472, 476, 479, 481, 482, 485, 488,
+		491, 494, 497, 500, 503, 506, 509, 512, 515, 518, 521, 524, 527, 530, 533, 536,
+		539, 542, 545, 548, 551, 554, 557, 560, 563, 566, 569, 572, 575, 578, 581, 584,
+		587, 590, 593, 596, 599, 602, 605, 608, 611, 614, 617, 620, 623, 626, 629, 632,
+		635, 638, 641, 644, 647, 650, 653, 656, 659, 662, 665, 668, 671, 674, 677, 680,
+		683, 686, 689, 692, 695, 698, 701, 704, 707, 710, 713, 716, 719, 722, 725, 728,
+		731, 734, 737, 740, 743, 746, 749, 752, 755, 758, 761, 764, 767, 770, 773, 776,
+		779, 782, 785, 788, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+		12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27,
+		28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43,
+		44, 45, 46, 47, 48, 49, 50, 56, 62, 63, 64, 65, 66, 67, 68, 69,
+		70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85,
+		86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 103,
+		106, 109, 112, 115, 118, 121, 124, 127, 130, 133, 136, 139, 141, 143, 144, 145,
+		146, 147, 148, 149, 152, 152, 152, 152, 152, 152, 155, 158, 161, 164, 167, 170,
+		173, 176, 179, 182, 185, 189, 193, 197, 201, 204, 206, 208, 211, 215, 218, 221,
+		222, 224, 229, 232, 234, 237, 240, 245, 247, 249, 253, 257, 259, 261, 265, 271,
+		274, 280, 286, 292, 295, 298, 302, 306, 309, 311, 313, 315, 318, 322, 325, 328,
+		329, 333, 336, 338, 342, 344, 347, 349, 352, 354, 356, 362, 366, 370, 376, 380,
+		382, 384, 386, 387, 393, 396, 399, 402, 406, 408, 410, 412, 416, 420, 427, 428,
+		432, 436, 440, 444, 447, 449, 451, 452, 454, 456, 462, 468, 474, 480, 486, 492,
+		498, 502, 508, 514, 520, 526, 532, 538, 544, 550, 556, 562, 568, 574, 580, 586,
+		592, 598, 604, 610, 616, 622, 628, 630, 633, 636, 639, 641, 647, 653, 659, 665,
+		671, 677, 683, 689, 695, 0, 6, 12, 15, 18, 22, 25, 27, 30, 32, 34,
+		36, 41, 47, 53, 56, 58, 61, 65, 67, 70, 72, 74, 79, 85, 91, 94,
+		98, 101, 104, 107, 111, 114, 116, 119, 123, 127, 130, 132, 137, 143, 147, 150,
+		151, 152, 157, 159, 161, 163, 167, 171, 174, 178, 182, 185, 187, 189, 193, 196,
+		200, 204, 212, 214, 219, 225, 228, 231, 234, 238, 241, 244, 247, 250, 253, 256,
+		259, 262, 265, 268, 271, 274, 277, 280, 283, 286, 289, 292, 295, 298, 301, 304,
+		307, 310, 313, 316, 319, 322, 325, 328, 331, 334, 337, 340, 343, 346, 349, 352,
+		355, 358, 361, 364, 367, 370, 373, 376, 379, 382, 385, 388, 391, 394, 397, 400,
+		403, 406, 409, 412, 415, 418, 421, 424, 427, 430, 433, 436, 439, 442, 445, 448,
+		451, 454, 457, 460, 463, 466, 469, 472, 475, 478, 481, 484, 487, 490, 493, 496,
+		499, 502, 505, 508, 511, 514, 517, 520, 523, 526, 529, 532, 535, 538, 541, 544,
+		547, 550, 553, 556, 559, 562, 565, 568, 571, 574, 577, 580, 583, 586, 589, 592,
+		595, 598, 601, 604, 607, 610, 613, 616, 619, 622, 625, 628, 631, 634, 637, 640,
+		643, 646, 649, 652, 655, 658, 661, 664, 667, 670, 673, 676, 679, 682, 685, 688,
+		691, 694, 697, 700, 703, 706, 709, 712, 715, 718, 721, 724, 727, 730, 733, 736,
+		739, 742, 745, 748, 751, 754, 757, 760, 763, 766, 769, 772, 775, 778, 781, 784,
+		787, 790, 793, 796, 799, 802, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27,
+		30, 33, 36, 39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75,
+		78, 81, 84, 87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123,
+		126, 129, 132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171,
+		174, 177, 180, 183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216, 219,
+		222, 225, 228, 231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264, 267,
+		270, 273, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 315,
+		318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360, 363,
+		366, 369, 372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408, 411,
+		414, 417, 420, 423, 426, 429, 432, 437, 442, 447, 452, 457, 462, 467, 472, 477,
+		482, 487, 493, 498, 503, 508, 513, 518, 523, 528, 533, 538, 543, 548, 553, 558,
+		563, 568, 573, 578, 583, 588, 593, 598, 603, 608, 613, 618, 623, 628, 633, 638,
+		643, 648, 653, 658, 663, 668, 673, 678, 683, 688, 693, 698, 703, 708, 713, 718,
+		723, 728, 733, 738, 743, 749, 754, 759, 764, 769, 774, 779, 784, 789, 794, 799,
+		804, 809, 814, 819, 824, 829, 834, 839, 844, 849, 854, 859, 864, 869, 874, 879,
+		884, 889, 894, 899, 904, 909, 914, 919, 919, 919, 922, 925, 928, 931, 934, 937,
+		940, 943, 946, 949, 952, 955, 958, 0, 4, 8, 12, 16, 20, 25, 29, 34,
+		39, 43, 47, 51, 55, 59, 63, 67, 71, 76, 80, 84, 88, 93, 97, 101,
+		105, 109, 113, 117, 121, 125, 129, 133, 137, 141, 145, 149, 153, 158, 162, 166,
+		170, 174, 178, 182, 186, 190, 195, 199, 203, 208, 212, 217, 221, 225, 230, 234,
+		238, 242, 246, 250, 254, 258, 262, 266, 270, 274, 278, 282, 286, 290, 294, 298,
+		302, 306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 346, 350, 355, 359, 363,
+		367, 371, 375, 379, 384, 389, 394, 398, 402, 406, 410, 414, 418, 422, 426, 431,
+		435, 439, 443, 447, 451, 455, 459, 463, 467, 471, 475, 479, 483, 487, 491, 495,
+		499, 503, 507, 511, 515, 519, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560,
+		564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 605, 609, 613, 617, 621, 626,
+		630, 634, 638, 643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 687, 691,
+		696, 700, 704, 709, 713, 717, 722, 727, 732, 737, 742, 747, 752, 757, 762, 766,
+		770, 775, 779, 783, 788, 792, 796, 800, 804, 808, 812, 816, 820, 824, 828, 832,
+		836, 840, 845, 850, 855, 860, 865, 870, 875, 880, 884, 888, 892, 896, 900, 904,
+		908, 912, 917, 921, 926, 930, 934, 938, 942, 946, 950, 954, 959, 963, 968, 972,
+		976, 980, 985, 989, 993, 997, 1001, 1005, 1009, 1013, 1017, 1022, 1026, 1030, 1034, 1038,
+		1042, 1046, 1051, 1055, 1059, 1063, 1067, 1072, 0, 4, 8, 12, 16, 20, 24, 28,
+		32, 36, 40, 44, 48, 53, 57, 61, 65, 69, 73, 77, 81, 85, 90, 94,
+		98, 102, 106, 110, 114, 118, 122, 126, 130, 135, 139, 143, 147, 151, 155, 159,
+		164, 168, 173, 177, 181, 185, 189, 193, 197, 201, 206, 210, 215, 219, 224, 228,
+		232, 236, 240, 245, 250, 255, 259, 263, 267, 271, 275, 279, 283, 287, 292, 296,
+		301, 306, 310, 314, 318, 322, 327, 331, 335, 339, 343, 347, 351, 355, 359, 363,
+		368, 372, 376, 380, 384, 388, 392, 397, 401, 405, 409, 413, 417, 421, 425, 429,
+		433, 437, 442, 446, 451, 455, 459, 463, 467, 471, 475, 480, 484, 488, 493, 497,
+		501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549, 553, 558, 562,
+		566, 570, 574, 578, 582, 586, 591, 595, 599, 603, 607, 612, 616, 621, 625, 630,
+		634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682, 686, 691, 695,
+		699, 703, 707, 712, 716, 720, 724, 729, 734, 738, 742, 747, 751, 755, 759, 763,
+		767, 771, 775, 780, 784, 788, 793, 798, 803, 808, 813, 818, 823, 828, 832, 836,
+		840, 845, 849, 854, 858, 863, 867, 871, 875, 879, 883, 888, 892, 896, 901, 905,
+		909, 913, 917, 922, 926, 930, 934, 939, 943, 947, 952, 957, 961, 965, 969, 973,
+		977, 981, 985, 990, 994, 998, 1002, 1006, 1010, 1014, 1018, 1022, 1026, 1030, 1034, 1038,
+		1042, 1046, 1050, 1054, 1058, 1062, 1066, 1070, 1074, 0, 4, 9, 14, 18, 22, 26,
+		30, 34, 38, 42, 47, 51, 55, 59, 63, 67, 71, 75, 79, 84, 88, 93,
+		97, 102, 106, 110, 115, 119, 123, 127, 131, 135, 140, 145, 150, 155, 160, 166,
+		171, 176, 181, 186, 191, 196, 201, 206, 211, 216, 221, 226, 232, 237, 242, 247,
+		252, 257, 262, 267, 272, 277, 282, 287, 292, 297, 303, 308, 314, 319, 324, 329,
+		334, 339, 344, 349, 355, 360, 365, 370, 375, 381, 385, 390, 394, 398, 402, 406,
+		411, 415, 420, 425, 430, 435, 440, 445, 449, 453, 457, 461, 466, 471, 476, 480,
+		484, 488, 492, 496, 500, 504, 508, 512, 517, 521, 526, 530, 534, 538, 542, 547,
+		551, 556, 560, 564, 568, 572, 577, 581, 586, 590, 594, 598, 603, 607, 611, 615,
+		620, 625, 630, 635, 639, 643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683,
+		687, 691, 696, 701, 705, 709, 714, 718, 722, 727, 731, 735, 739, 743, 747, 751,
+		755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795, 800, 804, 809, 814, 818,
+		822, 826, 830, 834, 838, 842, 847, 851, 855, 859, 863, 867, 871, 876, 880, 884,
+		888, 892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 932, 936, 941, 945, 949,
+		953, 957, 962, 966, 970, 974, 978, 982, 986, 990, 994, 999, 1004, 1008, 1012, 1016,
+		1021, 1025, 1029, 1033, 1037, 1041, 1045, 1049, 1053, 1057, 1062, 1067, 1071, 1075, 1079, 1083,
+		1087, 1091, 1095, 1099, 1103, 1108, 1112, 1116, 1120, 1124, 0, 4, 8, 12, 16, 20,
+		24, 28, 32, 36, 40, 45, 49, 53, 57, 61, 66, 70, 75, 79, 84, 88,
+		92, 97, 101, 105, 109, 113, 117, 122, 126, 130, 134, 138, 142, 146, 150, 154,
+		158, 162, 166, 170, 174, 178, 182, 186, 191, 195, 200, 204, 208, 212, 216, 220,
+		224, 228, 232, 236, 241, 246, 250, 254, 258, 262, 266, 270, 274, 278, 282, 286,
+		290, 294, 298, 302, 306, 310, 314, 319, 323, 327, 331, 335, 339, 343, 348, 352,
+		356, 360, 365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 414, 419,
+		424, 429, 434, 439, 444, 449, 454, 458, 463, 467, 471, 475, 479, 483, 488, 493,
+		497, 501, 505, 509, 514, 519, 524, 528, 533, 538, 542, 546, 550, 554, 558, 562,
+		566, 570, 575, 580, 585, 590, 595, 600, 605, 610, 615, 620, 625, 630, 634, 638,
+		642, 647, 651, 655, 659, 663, 667, 672, 676, 681, 685, 690, 694, 699, 703, 707,
+		712, 716, 720, 724, 728, 733, 737, 741, 745, 750, 754, 758, 762, 767, 771, 775,
+		779, 783, 787, 791, 796, 800, 805, 809, 813, 817, 821, 826, 830, 834, 838, 843,
+		847, 852, 856, 860, 864, 868, 872, 876, 881, 885, 889, 893, 897, 901, 906, 911,
+		915, 919, 924, 928, 932, 937, 941, 946, 950, 954, 958, 962, 966, 970, 974, 978,
+		982, 987, 992, 997, 1002, 1006, 1011, 1016, 1020, 1025, 1029, 1034, 1038, 1042, 1046, 1050,
+		1054, 1058, 1062, 1066, 1070, 1074, 1079, 1084, 1089, 1094, 1099, 0, 5, 10, 15, 20,
+		24, 29, 34, 39, 44, 49, 54, 59, 64, 69, 74, 79, 84, 89, 94, 99,
+		105, 111, 116, 121, 126, 131, 136, 141, 146, 151, 156, 161, 166, 171, 176, 181,
+		186, 191, 196, 201, 206, 211, 216, 221, 226, 231, 236, 241, 241, 241, 241, 241,
+		241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 246, 251, 255,
+		259, 263, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267,
+		267, 267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309,
+		312, 315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357,
+		360, 363, 366, 369, 372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405,
+		408, 411, 414, 417, 420, 423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453,
+		456, 459, 462, 465, 468, 471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 501,
+		504, 507, 510, 513, 516, 519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549,
+		552, 555, 558, 561, 564, 567, 570, 573, 576, 579, 582, 585, 588, 591, 594, 597,
+		600, 603, 606, 609, 612, 615, 618, 621, 624, 627, 630, 633, 636, 639, 642, 645,
+		648, 651, 654, 657, 660, 663, 666, 669, 672, 675, 678, 681, 684, 687, 690, 693,
+		696, 699, 702, 705, 708, 711, 714, 717, 720, 723, 726, 729, 732, 735, 738, 741,
+		744, 747, 750, 753, 756, 759, 762, 765, 768, 771, 774, 777, 0, 4, 8, 12,
+		16, 20, 24, 28, 32, 36, 40, 45, 49, 53, 57, 61, 65, 69, 73, 77,
+		81, 85, 89, 93, 97, 101, 105, 109, 114, 118, 122, 126, 130, 134, 138, 142,
+		146, 150, 154, 158, 162, 166, 171, 175, 179, 184, 188, 192, 196, 200, 205, 209,
+		214, 219, 223, 227, 231, 235, 239, 243, 247, 251, 255, 259, 263, 267, 271, 275,
+		279, 283, 287, 291, 295, 299, 304, 309, 314, 318, 322, 326, 330, 334, 338, 342,
+		346, 350, 354, 358, 362, 366, 370, 374, 378, 382, 386, 390, 394, 398, 402, 406,
+		410, 414, 418, 422, 426, 430, 434, 438, 443, 447, 452, 456, 460, 465, 469, 474,
+		478, 483, 487, 491, 496, 501, 506, 510, 515, 520, 524, 528, 533, 538, 543, 547,
+		551, 555, 560, 565, 569, 573, 577, 581, 585, 590, 594, 598, 602, 606, 610, 614,
+		618, 622, 626, 630, 635, 639, 643, 647, 651, 655, 659, 663, 667, 671, 675, 680,
+		684, 688, 692, 696, 700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744,
+		748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808,
+		812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 868, 872,
+		876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 932, 936,
+		940, 944, 948, 953, 958, 962, 966, 970, 974, 978, 983, 987, 991, 996, 1000, 1004,
+		1008, 1012, 1016, 1020, 1025, 1029, 1034, 1038, 1042, 1046, 1050, 1054, 1058, 0, 4, 8,
+		12, 16, 20, 25, 29, 33, 37, 41, 45, 49, 53, 57, 61, 65, 69, 73,
+		77, 81, 85, 89, 93, 97, 101, 105, 109, 113, 117, 121, 125, 129, 133, 137,
+		141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 185, 190, 194, 198, 202,
+		206, 210, 214, 218, 222, 226, 230, 234, 238, 242, 246, 250, 254, 258, 262, 266,
+		270, 274, 278, 283, 287, 291, 295, 299, 303, 308, 312, 316, 320, 324, 328, 333,
+		337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 378, 382, 386, 390, 394, 398,
+		402, 406, 410, 414, 418, 422, 426, 430, 434, 438, 442, 446, 450, 454, 458, 463,
+		467, 471, 476, 481, 486, 490, 494, 498, 502, 507, 512, 517, 521, 525, 529, 533,
+		537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 597,
+		601, 605, 609, 614, 618, 622, 626, 630, 634, 639, 643, 647, 651, 655, 660, 664,
+		668, 672, 677, 681, 685, 689, 693, 697, 701, 705, 709, 713, 717, 722, 726, 728,
+		733, 737, 741, 745, 750, 754, 758, 762, 766, 770, 774, 779, 783, 787, 791, 795,
+		799, 803, 807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855, 859,
+		863, 867, 871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923,
+		927, 931, 935, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987,
+		991, 995, 999, 1003, 1007, 1011, 1016, 1020, 1024, 1028, 1032, 1036, 1040, 1044, 0, 5,
+		9, 13, 17, 21, 25, 29, 33, 37, 41, 45, 49, 53, 57, 61, 65, 69,
+		73, 77, 81, 85, 89, 93, 97, 101, 105, 109, 113, 117, 121, 125, 129, 133,
+		137, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 185, 189, 193, 197,
+		201, 205, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261,
+		265, 269, 273, 277, 281, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 315,
+		318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360, 363,
+		366, 369, 372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408, 411,
+		414, 417, 420, 423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453, 456, 459,
+		462, 465, 468, 471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 501, 504, 507,
+		510, 513, 516, 519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 552, 555,
+		558, 561, 564, 567, 570, 573, 576, 579, 582, 585, 588, 591, 594, 597, 600, 603,
+		606, 609, 612, 615, 618, 621, 624, 627, 630, 633, 636, 639, 642, 645, 648, 651,
+		654, 657, 660, 663, 666, 669, 672, 675, 678, 681, 684, 687, 690, 693, 696, 699,
+		702, 705, 708, 711, 714, 717, 720, 723, 726, 729, 732, 735, 738, 741, 744, 747,
+		750, 753, 756, 759, 762, 765, 768, 771, 774, 777, 780, 783, 786, 789, 792, 795,
+		798, 801, 804, 807, 810, 813, 816, 819, 822, 825, 828, 831, 834, 837, 840, 0,
+		4, 7, 18, 26, 30, 40, 44, 48, 51, 62, 70, 80, 86, 92, 100, 107,
+		117, 124, 131, 141, 147, 150, 160, 165, 168, 172, 179, 185, 194, 201, 209, 213,
+		216, 228, 232, 236, 242, 254, 261, 265, 273, 281, 284, 292, 296, 299, 304, 309,
+		315, 324, 330, 333, 345, 349, 356, 360, 366, 370, 373, 377, 380, 385, 391, 395,
+		398, 408, 414, 421, 428, 433, 438, 442, 448, 452, 458, 461, 465, 471, 475, 478,
+		481, 485, 488, 490, 493, 496, 500, 506, 515, 519, 521, 526, 532, 537, 543, 550,
+		553, 559, 567, 571, 577, 581, 592, 595, 600, 604, 613, 619, 626, 631, 637, 645,
+		652, 658, 663, 667, 671, 674, 679, 686, 690, 694, 700, 705, 711, 714, 720, 728,
+		735, 742, 748, 752, 757, 760, 766, 774, 779, 782, 788, 792, 794, 797, 801, 807,
+		810, 813, 821, 826, 835, 840, 846, 854, 859, 863, 867, 872, 879, 885, 896, 906,
+		910, 916, 922, 927, 931, 938, 942, 946, 952, 955, 959, 964, 971, 976, 979, 984,
+		988, 992, 1000, 1007, 1011, 1017, 1020, 1028, 1035, 1043, 1050, 1055, 1058, 1063, 1066, 1071,
+		1075, 1083, 1089, 1091, 1096, 1099, 1106, 1110, 1116, 1119, 1123, 1128, 1134, 1136, 1139, 1143,
+		1147, 1150, 1157, 1161, 1165, 1172, 1176, 1180, 1185, 1188, 1192, 1197, 1203, 1206, 1212, 1218,
+		1225, 1230, 1234, 1240, 1245, 1250, 1254, 1258, 1262, 1265, 1272, 1276, 1279, 1282, 1285, 1288,
+		1291, 1295, 1298, 1301, 1305, 1310, 1314, 1319, 1324, 1332, 1336, 1341, 1346, 1350, 1355, 1365,
+		0, 4, 10, 14, 17, 20, 27, 31, 34, 39, 44, 51, 55, 61, 64, 71,
+		76, 83, 85, 89, 95, 104, 108, 115, 121, 125, 129, 135, 139, 145, 148, 151,
+		157, 161, 166, 170, 176, 179, 184, 188, 192, 197, 205, 211, 217, 221, 224, 230,
+		234, 237, 243, 248, 255, 259, 262, 266, 270, 275, 279, 282, 288, 294, 298, 302,
+		305, 309, 313, 320, 325, 329, 335, 340, 345, 351, 353, 356, 359, 362, 365, 368,
+		373, 378, 379, 382, 384, 388, 393, 397, 399, 402, 406, 409, 411, 415, 418, 422,
+		425, 429, 431, 435, 440, 443, 446, 450, 454, 459, 462, 465, 469, 472, 475, 478,
+		484, 488, 492, 495, 499, 504, 507, 510, 518, 522, 525, 529, 532, 538, 542, 546,
+		552, 556, 561, 567, 572, 576, 579, 586, 590, 595, 603, 610, 613, 617, 624, 628,
+		633, 636, 639, 647, 651, 655, 660, 664, 669, 671, 676, 681, 686, 692, 697, 701,
+		705, 707, 711, 718, 726, 733, 738, 742, 746, 750, 754, 757, 761, 764, 771, 774,
+		779, 784, 789, 793, 799, 802, 807, 812, 815, 818, 825, 829, 831, 835, 839, 843,
+		850, 857, 861, 865, 869, 873, 876, 880, 886, 887, 890, 894, 899, 902, 906, 910,
+		916, 921, 927, 931, 934, 938, 939, 942, 943, 944, 947, 953, 956, 959, 964, 968,
+		972, 977, 982, 990, 995, 998, 1001, 1005, 1009, 1014, 1017, 1020, 1023, 1026, 1028, 1030,
+		1034, 1037, 1043, 1046, 1049, 1053, 1059, 1061, 1063, 1066, 1068, 1071, 1073, 1076, 1079, 1081,
+		1084, 0, 3, 6, 10, 12, 13, 15, 17, 20, 23, 26, 28, 31, 35, 40,
+		43, 47, 52, 53, 56, 60, 62, 66, 70, 73, 77, 79, 84, 87, 90, 92,
+		96, 100, 104, 107, 110, 112, 114, 118, 121, 128, 132, 135, 139, 142, 144, 146,
+		149, 151, 155, 158, 160, 162, 166, 169, 172, 177, 181, 184, 187, 190, 193, 196,
+		199, 202, 204, 207, 209, 212, 214, 216, 217, 220, 224, 227, 230, 233, 238, 240,
+		244, 247, 249, 251, 252, 254, 257, 261, 263, 265, 266, 268, 271, 273, 276, 278,
+		281, 284, 285, 286, 287, 288, 289, 290, 291, 292, 293, 294, 297, 300, 303, 306,
+		306, 306, 308, 310, 312, 314, 316, 318, 320, 322, 324, 326, 328, 330, 332, 334,
+		336, 338, 340, 342, 344, 346, 348, 350, 352, 354, 357, 360, 363, 366, 369, 372,
+		375, 378, 380, 383, 386, 389, 392, 395, 398, 401, 405, 409, 413, 417, 419, 421,
+		423, 425, 427, 430, 432, 435, 437, 439, 441, 443, 446, 449, 451, 453, 455, 457,
+		459, 462, 464, 466, 468, 471, 474, 476, 479, 482, 486, 489, 491, 493, 496, 499,
+		501, 504, 505, 506, 507, 508, 509, 510, 511, 512, 513, 514, 517, 520, 523, 526,
+		529, 532, 536, 538, 540, 542, 545, 548, 551, 555, 559, 561, 565, 567, 569, 572,
+		574, 576, 579, 581, 583, 585, 589, 591, 596, 597, 598, 600, 601, 603, 604, 605,
+		608, 611, 611, 611, 611, 611, 611, 611, 614, 617, 620, 623, 626, 629, 632, 635,
+		638, 641, 0, 4, 8, 11, 14, 18, 22, 25, 28, 31, 34, 38, 42, 46,
+		50, 54, 58, 62, 66, 69, 72, 76, 80, 83, 86, 89, 92, 96, 100, 103,
+		108, 111, 114, 118, 121, 125, 129, 132, 136, 139, 143, 148, 152, 157, 162, 165,
+		167, 170, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
+		173, 173, 173, 177, 181, 185, 188, 188, 188, 191, 194, 197, 200, 203, 206, 209,
+		212, 215, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 231, 233, 236,
+		241, 248, 257, 268, 281, 284, 287, 291, 294, 297, 301, 305, 309, 313, 317, 319,
+		323, 328, 331, 334, 337, 341, 347, 351, 355, 359, 362, 365, 368, 371, 374, 377,
+		383, 387, 390, 394, 399, 402, 406, 410, 414, 418, 422, 426, 429, 432, 435, 438,
+		442, 445, 448, 451, 454, 457, 460, 463, 466, 469, 472, 475, 478, 481, 484, 487,
+		490, 493, 496, 499, 502, 505, 508, 511, 514, 517, 520, 523, 526, 529, 532, 535,
+		538, 541, 544, 547, 550, 553, 556, 559, 562, 565, 568, 571, 574, 577, 580, 583,
+		586, 589, 592, 595, 598, 601, 604, 607, 610, 613, 616, 619, 622, 625, 628, 631,
+		634, 637, 640, 643, 646, 649, 652, 655, 658, 661, 664, 667, 670, 673, 676, 679,
+		682, 685, 688, 691, 694, 697, 700, 703, 706, 709, 712, 715, 718, 721, 724, 727,
+		730, 733, 736, 739, 742, 745, 748, 751, 754, 757, 760, 763, 766, 769, 772, 775,
+		778, 781, 784, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36,
+		39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84,
+		87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129, 132,
+		135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 177, 180,
+		183, 186, 189, 192, 193, 194, 195, 196, 200, 201, 203, 204, 205, 206, 207, 208,
+		209, 210, 211, 212, 213, 214, 215, 217, 218, 219, 221, 223, 224, 225, 227, 228,
+		229, 230, 232, 233, 234, 235, 236, 237, 241, 242, 244, 245, 246, 247, 248, 249,
+		250, 251, 252, 253, 254, 255, 256, 258, 259, 260, 262, 264, 265, 266, 268, 269,
+		270, 271, 273, 274, 275, 276, 277, 278, 279, 280, 281, 282, 283, 284, 286, 288,
+		290, 292, 294, 296, 298, 300, 302, 304, 305, 306, 307, 307, 307, 307, 307, 310,
+		313, 316, 319, 322, 325, 328, 331, 334, 337, 340, 343, 346, 349, 352, 355, 358,
+		361, 364, 367, 370, 373, 376, 379, 382, 385, 388, 391, 394, 397, 400, 403, 406,
+		409, 412, 415, 418, 421, 424, 427, 430, 433, 436, 439, 442, 445, 448, 451, 454,
+		457, 460, 463, 466, 469, 472, 475, 478, 481, 484, 487, 490, 493, 496, 499, 502,
+		505, 508, 511, 514, 517, 520, 523, 526, 529, 532, 535, 538, 541, 544, 547, 550,
+		553, 556, 559, 562, 565, 568, 571, 574, 577, 580, 583, 586, 589, 592, 595, 598,
+		601, 604, 607, 610, 0, 2, 4, 6, 9, 11, 14, 16, 18, 20, 23, 25,
+		27, 30, 32, 35, 38, 40, 43, 46, 48, 51, 55, 57, 60, 63, 67, 71,
+		75, 80, 85, 87, 89, 91, 93, 96, 99, 103, 106, 108, 110, 113, 117, 121,
+		125, 128, 132, 136, 140, 144, 148, 152, 155, 158, 161, 165, 168, 171, 174, 176,
+		178, 181, 184, 188, 191, 195, 200, 202, 204, 207, 210, 213, 217, 219, 222, 225,
+		228, 231, 234, 237, 237, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249,
+		249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249,
+		249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249,
+		249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 252, 255, 258,
+		261, 264, 267, 270, 270, 270, 270, 270, 275, 280, 285, 290, 295, 300, 305, 310,
+		315, 320, 325, 330, 335, 338, 341, 344, 347, 350, 353, 356, 359, 362, 365, 368,
+		371, 374, 377, 380, 383, 386, 389, 392, 395, 398, 401, 404, 407, 410, 413, 416,
+		419, 422, 425, 428, 431, 434, 437, 440, 443, 446, 449, 452, 455, 458, 461, 464,
+		467, 470, 473, 476, 479, 482, 485, 488, 491, 494, 497, 500, 503, 506, 509, 512,
+		515, 518, 521, 524, 527, 530, 533, 533, 536, 536, 539, 542, 545, 548, 551, 554,
+		557, 560, 563, 566, 569, 569, 569, 572, 575, 578, 581, 584, 587, 590, 593, 596,
+		599, 602, 605, 608, 611, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30,
+		33, 36, 39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78,
+		81, 84, 87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126,
+		129, 132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171, 174,
+		177, 180, 183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216, 219, 222,
+		225, 228, 231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264, 267, 270,
+		273, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 315, 318,
+		321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360, 363, 366,
+		369, 372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408, 411, 414,
+		417, 420, 423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453, 456, 459, 462,
+		465, 468, 471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 501, 504, 507, 510,
+		513, 516, 519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 552, 555, 558,
+		561, 564, 567, 570, 573, 576, 579, 582, 585, 588, 591, 594, 597, 600, 603, 606,
+		609, 612, 615, 618, 621, 624, 627, 630, 633, 636, 639, 642, 645, 648, 651, 654,
+		657, 660, 663, 666, 669, 672, 675, 678, 681, 684, 687, 690, 693, 696, 699, 702,
+		705, 708, 711, 714, 717, 720, 725, 730, 735, 740, 743, 748, 753, 758, 763, 768,
+		773, 778, 781, 786, 791, 794, 0, 1, 3, 5, 7, 9, 12, 14, 16, 18,
+		20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 49, 52,
+		55, 58, 61, 64, 67, 70, 73, 77, 81, 85, 88, 91, 94, 97, 100, 103,
+		106, 109, 112, 115, 118, 121, 124, 127, 130, 133, 136, 139, 142, 145, 148, 151,
+		154, 157, 161, 164, 167, 170, 173, 176, 179, 182, 185, 188, 191, 194, 197, 200,
+		203, 206, 209, 212, 215, 218, 221, 224, 227, 230, 233, 236, 239, 242, 245, 248,
+		251, 254, 257, 260, 263, 266, 269, 272, 275, 278, 281, 284, 287, 290, 293, 296,
+		299, 302, 305, 308, 312, 315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345,
+		348, 351, 354, 357, 361, 364, 367, 370, 373, 376, 379, 382, 385, 388, 391, 394,
+		397, 400, 403, 406, 409, 413, 416, 419, 422, 425, 428, 431, 434, 437, 440, 444,
+		447, 450, 453, 456, 459, 462, 465, 468, 471, 474, 477, 480, 483, 486, 490, 494,
+		497, 500, 503, 506, 509, 512, 515, 518, 521, 524, 527, 530, 533, 536, 539, 542,
+		545, 548, 551, 554, 557, 560, 563, 566, 569, 572, 575, 578, 581, 584, 587, 590,
+		593, 596, 599, 602, 605, 608, 611, 614, 617, 620, 623, 626, 629, 633, 636, 639,
+		642, 645, 648, 651, 654, 657, 660, 663, 666, 670, 673, 676, 679, 682, 685, 688,
+		691, 694, 697, 700, 703, 706, 709, 712, 715, 718, 721, 724, 727, 730, 733, 736,
+		739, 742, 745, 748, 751, 754, 757, 0, 3, 6, 9, 12, 15, 18, 21, 24,
+		27, 30, 33, 36, 39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72,
+		75, 78, 81, 84, 87, 93, 99, 101, 103, 105, 107, 110, 113, 116, 119, 122,
+		125, 128, 131, 134, 137, 140, 143, 146, 149, 152, 154, 157, 160, 163, 166, 169,
+		172, 175, 178, 181, 184, 187, 190, 193, 196, 199, 202, 205, 208, 211, 214, 217,
+		220, 223, 226, 229, 232, 235, 238, 241, 243, 245, 247, 250, 253, 255, 258, 261,
+		264, 267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 297, 299, 301, 303, 304,
+		307, 310, 313, 316, 319, 322, 325, 328, 331, 334, 337, 340, 343, 346, 349, 352,
+		355, 358, 361, 364, 367, 370, 373, 376, 379, 382, 385, 388, 391, 394, 397, 400,
+		403, 406, 409, 412, 415, 418, 421, 424, 427, 430, 433, 436, 439, 442, 445, 448,
+		451, 454, 457, 460, 463, 466, 469, 472, 475, 478, 481, 484, 487, 490, 493, 496,
+		499, 502, 505, 508, 511, 514, 517, 520, 523, 526, 529, 532, 535, 538, 541, 544,
+		547, 550, 553, 556, 559, 562, 565, 568, 571, 574, 577, 580, 583, 586, 589, 592,
+		595, 598, 601, 604, 607, 610, 613, 616, 619, 622, 625, 628, 631, 634, 637, 640,
+		643, 646, 649, 652, 655, 658, 661, 664, 667, 670, 673, 676, 679, 682, 685, 688,
+		691, 694, 697, 700, 703, 706, 709, 712, 715, 718, 721, 724, 727, 730, 733, 736,
+		739, 742, 745, 748, 751, 754, 757, 760, 0, 1, 2, 3, 4, 5, 6, 7,
+		8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 19, 21, 23, 25, 26, 28,
+		30, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46,
+		47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62,
+		63, 64, 66, 67, 68, 69, 70, 71, 72, 73, 74, 76, 78, 79, 82, 83,
+		84, 86, 87, 89, 91, 93, 94, 96, 97, 99, 100, 102, 104, 105, 106, 107,
+		109, 110, 113, 115, 117, 119, 121, 123, 126, 129, 130, 131, 132, 133, 135, 137,
+		139, 141, 143, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 177, 180,
+		183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228,
+		231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273, 276,
+		279, 282, 285, 288, 291, 291, 291, 291, 291, 291, 291, 291, 291, 294, 297, 300,
+		303, 306, 309, 312, 315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348,
+		351, 354, 357, 360, 363, 366, 369, 372, 375, 378, 381, 384, 387, 390, 393, 396,
+		399, 402, 405, 408, 411, 414, 417, 420, 423, 426, 429, 432, 435, 438, 441, 444,
+		447, 450, 453, 456, 459, 462, 465, 468, 471, 474, 477, 480, 483, 486, 489, 492,
+		495, 498, 501, 504, 507, 510, 513, 516, 519, 522, 525, 528, 531, 534, 537, 540,
+		543, 546, 549, 552, 555, 558, 561, 564, 567, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39, 42, 45, 48,
+		51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84, 87, 90, 93, 96,
+		99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129, 132, 135, 138, 141, 144,
+		147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 177, 180, 183, 186, 189, 192,
+		195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228, 231, 234, 237, 240,
+		243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273, 276, 279, 282, 285, 288,
+		291, 294, 297, 300, 303, 306, 309, 312, 315, 318, 321, 324, 327, 330, 333, 336,
+		339, 342, 345, 348, 351, 354, 357, 360, 363, 366, 367, 368, 369, 370, 371, 372,
+		373, 374, 375, 376, 378, 380, 382, 384, 386, 388, 390, 392, 394, 396, 399, 402,
+		405, 408, 411, 414, 417, 420, 423, 426, 429, 432, 433, 434, 435, 436, 437, 438,
+		439, 440, 441, 442, 444, 446, 448, 450, 452, 454, 456, 458, 460, 462, 465, 468,
+		471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 28, 29, 30, 31, 32,
+		33, 34, 35, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 55, 56, 57,
+		58, 59, 60, 61, 64, 67, 70, 73, 76, 79, 82, 85, 88, 91, 94, 97,
+		100, 103, 106, 109, 112, 115, 118, 121, 124, 127, 130, 133, 136, 139, 142, 145,
+		148, 151, 154, 157, 160, 163, 166, 169, 172, 175, 178, 181, 184, 187, 190, 193,
+		196, 199, 202, 205, 208, 211, 214, 217, 220, 223, 226, 229, 232, 235, 238, 241,
+		244, 247, 250, 253, 256, 259, 262, 265, 268, 271, 274, 277, 280, 283, 286, 289,
+		292, 295, 298, 301, 304, 307, 310, 313, 316, 319, 322, 325, 328, 331, 334, 337,
+		340, 343, 346, 349, 352, 355, 358, 361, 364, 367, 370, 373, 376, 379, 382, 385,
+		388, 391, 394, 397, 400, 403, 406, 409, 412, 415, 418, 421, 424, 427, 430, 433,
+		436, 439, 442, 445, 448, 451, 454, 457, 460, 463, 466, 0, 1, 2, 3, 4,
+		5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+		21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36,
+		37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52,
+		53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68,
+		69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+		85, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+		100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+		116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+		132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147,
+		148, 149, 150, 151, 152, 153, 154, 155, 156, 156, 157, 158, 158, 158, 159, 159,
+		159, 160, 161, 161, 161, 162, 163, 164, 165, 165, 166, 167, 168, 169, 170, 171,
+		172, 173, 174, 175, 176, 177, 177, 178, 178, 179, 180, 181, 182, 183, 184, 185,
+		185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+		201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
+		217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
+		233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244, 0, 1, 2, 3,
+		4, 5, 6, 6, 7, 8, 9, 10, 10, 10, 11, 12, 13, 14, 15, 16,
+		17, 18, 18, 19, 20, 21, 22, 23, 24, 25, 25, 26, 27, 28, 29, 30,
+		31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46,
+		47, 48, 49, 50, 51, 52, 53, 53, 54, 55, 56, 57, 57, 58, 59, 60,
+		61, 62, 62, 63, 63, 63, 63, 64, 65, 66, 67, 68, 69, 70, 70, 71,
+		72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87,
+		88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+		104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+		120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
+		136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+		152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
+		168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+		184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+		200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+		216, 217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
+		232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244, 0, 1, 2,
+		3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
+		19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+		35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50,
+		51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
+		67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
+		83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+		99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+		115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+		131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+		147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162,
+		163, 164, 165, 166, 166, 166, 171, 175, 180, 185, 192, 196, 199, 204, 208, 213,
+		218, 220, 222, 224, 231, 233, 236, 241, 246, 249, 256, 259, 262, 265, 270, 275,
+		280, 284, 289, 294, 301, 305, 308, 313, 317, 322, 327, 329, 331, 333, 340, 342,
+		345, 350, 355, 358, 365, 368, 371, 374, 379, 379, 379, 379, 379, 379, 379, 379,
+		379, 379, 379, 379, 379, 379, 379, 379, 379, 379, 379, 379, 379, 379, 379, 379,
+		379, 379, 379, 379, 379, 379, 379, 379, 379, 379, 379, 379, 379, 379, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3,
+		4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+		20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35,
+		36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 0,
+		7, 8, 9, 10, 11, 15, 16, 19, 20, 21, 26, 29, 32, 33, 37, 38,
+		39, 40, 47, 48, 51, 52, 53, 60, 63, 70, 71, 72, 79, 80, 81, 84,
+		87, 90, 93, 96, 99, 100, 101, 102, 103, 104, 105, 108, 111, 114, 117, 120,
+		123, 126, 129, 132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168,
+		171, 174, 177, 180, 183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216,
+		219, 222, 225, 228, 231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264,
+		267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312,
+		315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360,
+		363, 366, 369, 372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408,
+		411, 414, 417, 420, 423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453, 456,
+		459, 462, 465, 468, 471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 501, 504,
+		507, 510, 513, 516, 519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 552,
+		555, 558, 561, 564, 567, 570, 573, 576, 579, 582, 585, 588, 591, 594, 597, 600,
+		603, 606, 609, 612, 615, 618, 621, 624, 627, 630, 633, 636, 639, 642, 645, 648,
+		651, 654, 657, 660, 663, 666, 669, 672, 675, 678, 681, 684, 687, 690, 693, 696,
+		699, 702, 705, 708, 711, 714, 717, 720, 723, 726, 729, 732, 735, 738, 741, 744,
+		0, 0, 0, 0, 0, 0, 0, 0, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 6, 9, 9, 9, 9, 9,
+		9, 9, 9, 12, 12, 12, 15, 15, 15, 15, 15, 15, 18, 21, 24, 27,
+		30, 31, 32, 33, 34, 35, 36, 38, 39, 40, 41, 42, 43, 44, 45, 46,
+		47, 48, 49, 50, 52, 54, 56, 58, 59, 60, 62, 66, 67, 68, 69, 70,
+		71, 74, 75, 76, 77, 78, 79, 80, 82, 83, 84, 85, 86, 87, 88, 89,
+		90, 91, 93, 95, 97, 99, 100, 101, 103, 104, 106, 109, 111, 115, 117, 120,
+		123, 126, 129, 132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168,
+		171, 174, 177, 180, 183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216,
+		216, 219, 222, 225, 228, 231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261,
+		264, 267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309,
+		312, 315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357,
+		360, 363, 366, 369, 372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405,
+		408, 411, 414, 417, 420, 423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453,
+		456, 459, 462, 465, 468, 471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 501,
+		504, 507, 510, 513, 516, 519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549,
+		552, 0, 2, 5, 8, 10, 12, 14, 16, 19, 21, 23, 25, 27, 30, 34,
+		36, 38, 41, 44, 46, 50, 53, 55, 57, 59, 61, 64, 66, 69, 72, 75,
+		79, 82, 85, 88, 90, 93, 94, 96, 97, 98, 99, 101, 102, 104, 105, 108,
+		111, 114, 114, 114, 114, 114, 114, 114, 114, 120, 125, 133, 139, 151, 153, 163,
+		166, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 182, 185, 188, 191,
+		195, 195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228, 231, 234, 237,
+		240, 243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273, 276, 279, 282, 285,
+		288, 291, 294, 297, 300, 303, 306, 309, 312, 315, 318, 321, 324, 327, 330, 333,
+		336, 339, 342, 345, 348, 351, 354, 357, 360, 363, 366, 369, 372, 375, 378, 381,
+		384, 387, 390, 393, 396, 399, 402, 405, 408, 411, 414, 417, 420, 423, 426, 429,
+		432, 435, 438, 441, 444, 447, 450, 453, 456, 459, 462, 465, 468, 471, 474, 477,
+		480, 483, 486, 489, 492, 495, 498, 501, 504, 507, 510, 513, 516, 519, 522, 525,
+		528, 531, 534, 537, 540, 543, 546, 549, 552, 555, 558, 561, 564, 567, 570, 573,
+		576, 579, 582, 585, 588, 591, 594, 597, 600, 603, 606, 609, 612, 615, 618, 621,
+		624, 627, 630, 633, 636, 639, 642, 645, 648, 651, 654, 657, 660, 663, 666, 669,
+		672, 675, 678, 681, 684, 687, 690, 693, 696, 699, 702, 705, 708, 711, 714, 717,
+		720, 723, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39,
+		42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84, 87,
+		90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129, 132, 135,
+		138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 177, 180, 183,
+		186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228, 231,
+		234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273, 276, 279,
+		282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 315, 318, 321, 324, 327,
+		330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360, 363, 366, 369, 372, 375,
+		378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408, 411, 414, 417, 420, 423,
+		426, 429, 432, 434, 436, 438, 440, 442, 444, 446, 448, 451, 453, 456, 458, 460,
+		462, 464, 466, 468, 469, 470, 472, 474, 475, 476, 477, 479, 481, 482, 484, 486,
+		487, 487, 490, 493, 496, 499, 502, 505, 508, 511, 514, 517, 520, 523, 526, 529,
+		532, 535, 538, 540, 541, 543, 545, 547, 549, 551, 554, 556, 558, 560, 562, 565,
+		567, 569, 572, 574, 576, 578, 580, 582, 583, 585, 587, 589, 592, 594, 595, 596,
+		599, 600, 604, 607, 610, 613, 617, 620, 623, 625, 627, 630, 633, 636, 639, 639,
+		639, 639, 639, 640, 641, 642, 643, 644, 645, 646, 647, 648, 649, 652, 655, 658,
+		661, 664, 664, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36,
+		39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84,
+		87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129, 132,
+		135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 177, 180,
+		183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228,
+		231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273, 276,
+		279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 315, 318, 321, 324,
+		327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360, 363, 366, 369, 372,
+		375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408, 411, 414, 417, 420,
+		423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453, 456, 459, 462, 465, 468,
+		471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 501, 504, 507, 510, 513, 516,
+		519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 552, 555, 558, 561, 564,
+		567, 570, 573, 576, 579, 582, 585, 588, 591, 594, 597, 600, 603, 606, 609, 612,
+		615, 618, 621, 624, 625, 627, 629, 631, 634, 635, 637, 639, 642, 644, 645, 647,
+		650, 652, 654, 655, 657, 659, 661, 663, 664, 667, 669, 671, 673, 676, 679, 683,
+		683, 683, 683, 683, 684, 685, 686, 687, 688, 689, 690, 691, 692, 693, 696, 699,
+		702, 705, 708, 711, 0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33,
+		36, 39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81,
+		84, 87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129,
+		132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 177,
+		180, 183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225,
+		228, 231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273,
+		276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 315, 318, 321,
+		324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360, 363, 366, 369,
+		372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408, 411, 414, 417,
+		420, 423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453, 456, 459, 462, 465,
+		468, 471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 501, 504, 507, 510, 513,
+		516, 519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 552, 555, 558, 561,
+		564, 567, 570, 573, 576, 579, 582, 585, 588, 591, 594, 597, 600, 603, 606, 609,
+		612, 615, 618, 621, 624, 627, 630, 633, 636, 639, 642, 645, 648, 651, 654, 657,
+		660, 663, 666, 669, 672, 676, 680, 684, 689, 694, 698, 702, 705, 709, 713, 718,
+		722, 725, 728, 732, 735, 738, 742, 745, 748, 752, 755, 759, 762, 765, 769, 772,
+		775, 779, 782, 786, 789, 0, 2, 4, 6, 9, 11, 14, 16, 19, 21, 23,
+		25, 28, 30, 33, 35, 38, 41, 44, 47, 50, 53, 56, 59, 62, 65, 69,
+		73, 75, 77, 79, 82, 84, 87, 89, 90, 91, 92, 94, 95, 97, 98, 100,
+		102, 104, 106, 108, 110, 112, 114, 117, 119, 122, 124, 127, 129, 131, 133, 136,
+		138, 141, 143, 145, 147, 149, 151, 154, 157, 159, 161, 163, 165, 168, 170, 173,
+		175, 177, 179, 181, 184, 186, 189, 191, 193, 195, 197, 199, 202, 204, 207, 209,
+		211, 213, 215, 218, 220, 223, 225, 228, 231, 234, 237, 240, 243, 246, 248, 250,
+		252, 255, 257, 260, 262, 266, 270, 273, 276, 279, 282, 285, 289, 293, 297, 301,
+		306, 310, 315, 319, 324, 329, 333, 338, 342, 344, 346, 348, 351, 355, 359, 363,
+		367, 372, 374, 376, 378, 381, 383, 386, 388, 391, 394, 397, 401, 405, 408, 412,
+		415, 419, 422, 426, 429, 432, 435, 438, 442, 445, 449, 452, 455, 458, 461, 465,
+		468, 472, 475, 477, 480, 483, 486, 490, 493, 497, 500, 503, 506, 510, 514, 516,
+		518, 520, 523, 525, 528, 530, 534, 538, 542, 546, 550, 553, 556, 557, 558, 559,
+		560, 561, 562, 563, 564, 565, 565, 565, 565, 565, 565, 565, 565, 568, 571, 574,
+		577, 580, 583, 586, 589, 592, 595, 598, 601, 604, 607, 610, 613, 616, 619, 622,
+		625, 628, 631, 634, 637, 640, 643, 646, 649, 652, 655, 658, 661, 664, 667, 670,
+		673, 676, 679, 682, 685, 688, 0, 4, 9, 13, 17, 19, 29, 31, 34, 36,
+		37, 39, 40, 41, 44, 47, 50, 53, 56, 58, 59, 63, 66, 68, 72, 74,
+		77, 79, 82, 84, 87, 90, 93, 96, 99, 103, 108, 112, 116, 118, 128, 130,
+		133, 135, 136, 138, 139, 140, 143, 146, 149, 152, 155, 157, 158, 162, 165, 167,
+		171, 173, 176, 178, 181, 183, 186, 189, 192, 195, 198, 198, 198, 198, 198, 198,
+		198, 198, 201, 204, 207, 210, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+		223, 226, 229, 232, 235, 235, 235, 238, 241, 244, 247, 250, 253, 256, 259, 262,
+		265, 268, 271, 274, 277, 280, 283, 286, 289, 292, 295, 298, 301, 304, 307, 310,
+		313, 316, 319, 322, 325, 328, 331, 334, 337, 340, 343, 346, 349, 352, 355, 358,
+		361, 364, 367, 370, 373, 376, 379, 382, 385, 388, 391, 394, 397, 400, 403, 406,
+		409, 412, 415, 418, 421, 424, 427, 430, 433, 436, 439, 442, 445, 448, 451, 454,
+		457, 460, 463, 466, 469, 472, 475, 478, 481, 484, 487, 490, 493, 496, 499, 502,
+		505, 508, 511, 514, 517, 520, 523, 526, 529, 532, 535, 538, 541, 544, 547, 550,
+		553, 556, 559, 562, 565, 568, 571, 574, 577, 580, 583, 586, 589, 592, 595, 598,
+		601, 604, 607, 610, 613, 616, 619, 622, 625, 628, 631, 634, 637, 640, 643, 646,
+		649, 652, 655, 658, 661, 664, 667, 670, 673, 676, 679, 682, 685, 688, 691, 694,
+		697, 700, 703, 706, 709, 712, 715, 0, 3, 6, 9, 12, 15, 18, 21, 24,
+		27, 30, 33, 36, 39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72,
+		75, 78, 81, 84, 87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 117, 120,
+		123, 126, 129, 132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168,
+		171, 174, 177, 180, 183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216,
+		219, 222, 225, 228, 231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264,
+		267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312,
+		315, 318, 321, 324, 327, 330, 333, 336, 339, 340, 341, 342, 343, 344, 345, 346,
+		347, 348, 350, 352, 354, 356, 358, 360, 362, 364, 366, 369, 372, 375, 378, 381,
+		384, 387, 390, 393, 397, 401, 405, 409, 413, 417, 421, 425, 429, 434, 439, 444,
+		449, 454, 459, 464, 469, 474, 480, 486, 492, 500, 508, 509, 510, 511, 512, 513,
+		514, 515, 516, 517, 517, 520, 523, 526, 526, 527, 528, 533, 539, 542, 545, 548,
+		551, 554, 557, 560, 563, 566, 569, 572, 575, 578, 581, 584, 587, 590, 593, 596,
+		599, 602, 605, 608, 611, 614, 617, 620, 623, 626, 629, 632, 635, 638, 641, 644,
+		647, 650, 653, 656, 659, 662, 665, 668, 671, 674, 677, 680, 683, 686, 689, 692,
+		695, 698, 701, 704, 707, 710, 713, 716, 719, 722, 725, 728, 731, 734, 737, 740,
+		743, 746, 749, 752, 755, 758, 761, 764, 0, 3, 4, 5, 6, 7, 8, 9,
+		10, 11, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 33, 36, 39, 42,
+		45, 48, 51, 54, 57, 61, 65, 69, 73, 77, 81, 85, 89, 93, 98, 103,
+		108, 113, 118, 123, 128, 133, 138, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+		148, 151, 154, 158, 163, 166, 169, 172, 175, 178, 181, 184, 187, 190, 193, 196,
+		199, 202, 205, 208, 211, 214, 217, 220, 223, 226, 229, 232, 235, 238, 241, 244,
+		247, 250, 253, 256, 259, 262, 265, 268, 271, 274, 277, 280, 283, 286, 289, 292,
+		295, 298, 301, 304, 307, 310, 313, 316, 319, 322, 325, 328, 331, 334, 337, 340,
+		343, 346, 349, 352, 355, 358, 361, 364, 367, 370, 373, 376, 379, 382, 385, 388,
+		391, 394, 397, 400, 403, 406, 409, 412, 415, 418, 421, 424, 427, 430, 433, 436,
+		439, 442, 445, 448, 451, 454, 457, 460, 463, 466, 469, 472, 475, 478, 481, 484,
+		487, 490, 493, 496, 499, 502, 505, 508, 511, 514, 517, 520, 523, 526, 529, 532,
+		535, 538, 541, 544, 547, 550, 553, 556, 559, 562, 565, 568, 571, 574, 577, 580,
+		583, 586, 589, 592, 595, 598, 601, 604, 607, 610, 613, 616, 619, 622, 625, 628,
+		631, 634, 637, 640, 643, 646, 649, 652, 655, 658, 661, 664, 667, 670, 673, 676,
+		679, 682, 685, 688, 691, 694, 697, 700, 703, 706, 709, 712, 715, 718, 721, 724,
+		727, 730, 733, 736, 739, 742, 745, 748, 751, 0, 1, 2, 3, 4, 7, 8,
+		9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 24, 25,
+		27, 29, 31, 32, 33, 34, 37, 38, 39, 42, 45, 46, 47, 50, 51, 54,
+		57, 58, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 74, 76, 77,
+		79, 81, 84, 85, 88, 89, 92, 95, 98, 101, 104, 107, 108, 111, 114, 117,
+		120, 121, 124, 125, 128, 129, 132, 133, 134, 135, 138, 139, 140, 143, 145, 148,
+		151, 153, 156, 157, 160, 161, 164, 165, 168, 171, 174, 175, 176, 179, 180, 183,
+		186, 187, 188, 189, 190, 193, 194, 195, 196, 197, 198, 199, 200, 203, 205, 206,
+		208, 210, 213, 214, 215, 216, 219, 222, 223, 226, 227, 228, 229, 230, 231, 232,
+		233, 234, 235, 236, 239, 240, 241, 242, 243, 244, 245, 246, 247, 248, 250, 251,
+		253, 255, 257, 258, 259, 260, 263, 266, 269, 272, 275, 276, 277, 278, 281, 282,
+		283, 284, 285, 286, 289, 290, 291, 292, 293, 294, 295, 296, 297, 298, 300, 301,
+		303, 305, 307, 308, 309, 310, 313, 316, 319, 322, 325, 328, 331, 334, 337, 340,
+		343, 346, 349, 352, 355, 358, 361, 364, 367, 370, 373, 376, 379, 382, 385, 388,
+		391, 394, 397, 400, 403, 406, 409, 412, 415, 418, 421, 424, 427, 430, 433, 436,
+		439, 442, 445, 448, 451, 454, 457, 460, 463, 466, 466, 466, 469, 472, 475, 478,
+		481, 484, 487, 490, 493, 496, 499, 502, 505, 508, 0, 2, 4, 6, 8, 10,
+		12, 14, 16, 18, 20, 22, 23, 24, 24, 24, 24, 27, 30, 33, 36, 39,
+		42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84, 87,
+		90, 93, 96, 99, 102, 106, 107, 108, 110, 112, 112, 113, 114, 115, 116, 117,
+		118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
+		134, 135, 136, 137, 138, 140, 142, 144, 146, 149, 151, 151, 151, 151, 151, 151,
+		151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+		151, 151, 151, 151, 151, 153, 155, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+		157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+		157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 159, 159, 159, 159, 159,
+		159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+		159, 159, 159, 159, 159, 159, 159, 159, 159, 162, 165, 168, 171, 174, 177, 180,
+		183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228,
+		231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273, 276,
+		279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312, 315, 318, 321, 324,
+		327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327,
+		327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30,
+		33, 36, 39, 42, 45, 48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78,
+		81, 84, 87, 90, 93, 96, 99, 102, 105, 108, 111, 114, 115, 116, 117, 118,
+		119, 120, 121, 122, 123, 124, 127, 130, 133, 136, 139, 142,
+	},
+	data: " !C/PS$?Y=|SS\"(c)a<<!(r)-" + // U+00A0
+		"deg+-23'uP*,1o>>1/41/23/4?" + // U+00B0
+		"AAAAAAAECEEEEIIII" + // U+00C0
+		"DNOOOOOxOUUUUYThss" + // U+00D0
+		"aaaaaaaeceeeeiiii" + // U+00E0
+		"dnooooo/ouuuuythy" + // U+00F0
+		"AaAaAaCcCcCcCcDd" + // U+0100
+		"DdEeEeEeEeEeGgGg" + // U+0110
+		"GgGgHhHhIiIiIiIi" + // U+0120
+		"IiIJijJjKkkLlLlLlL" + // U+0130
+		"lLlNnNnNn'nngNGOoOo" + // U+0140
+		"OoOEoeRrRrRrSsSsSs" + // U+0150
+		"SsTtTtTtUuUuUuUu" + // U+0160
+		"UuUuWwYyYZzZzZzs" + // U+0170
+		"bBBb66OCcDDDdd3@" + // U+0180
+		"EFfGGhvIIKkllWNnO" + // U+0190
+		"OoOIoiPpYR22SHshtTtTU" + // U+01A0
+		"uYVYyZzZHZHzhzh255tsw" + // U+01B0
+		"||||=!DZDzdzLJLjljNJNjnjAaI" + // U+01C0
+		"iOoUuUuUuUuUu@Aa" + // U+01D0
+		"AaAEaeGgGgKkOoOoZHzh" + // U+01E0
+		"jDZDzdzGgHVWNnAaAEaeOo" + // U+01F0
+		"AaAaEeEeIiIiOoOo" + // U+0200
+		"RrRrUuUuSsTtYyHh" + // U+0210
+		"NdOUouZzAaEeOoOoOo" + // U+0220
+		"OoYylntjdbqpACcLTs" + // U+0230
+		"zStopstopBU^EeJjqqRrYy" + // U+0240
+		"aaabocdde@@eeeej" + // U+0250
+		"gggguYhhiiIllllZW" + // U+0260
+		"WmnnnoOEOFrrrrrrr" + // U+0270
+		"RRsSjSSttuUv^wyY" + // U+0280
+		"zzZZ???C@BEGHjkL" + // U+0290
+		"q??dzdZdztstStCfNlslzWW]]hh" + // U+02A0
+		"khjrrrrwy'\"`'``'" + // U+02B0
+		"??<>^V^V'-/\\,_\\/" + // U+02C0
+		":.`'^V+-V.@,~\"RX" + // U+02D0
+		"Glsx?V=\"[?]" + // U+02E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+02F0
+		"[?]" + // U+0340
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+0350
+		"aeioucdhmrtvx" + // U+0360
+		"HetahetaSampisampi',Digammadigamma[?][?]sss?Yot" + // U+0370
+		"[?][?][?][?]A;EEI[?]O[?]UO" + // U+0380
+		"IABGDEZEThIKLMNKsO" + // U+0390
+		"PR[?]STUPhKhPsOIUaeei" + // U+03A0
+		"uabgdezethiklmnxo" + // U+03B0
+		"prsstuphkhpsoiuouo&" + // U+03C0
+		"bthUUUphp&koppakoppaStstWwQq" + // U+03D0
+		"SpspShshFfKhkhHhGgCHchTiti" + // U+03E0
+		"krcjThe[?]SsSSsrSSS" + // U+03F0
+		"IeIoDjGjIeDzIYiJLjNjTshKjIUDzh" + // U+0400
+		"ABVGDEZhZIIKLMNOP" + // U+0410
+		"RSTUFKhTsChShShch'Y'EIuIa" + // U+0420
+		"abvgdezhziiklmnop" + // U+0430
+		"rstufkhtschshshch'y'eiuia" + // U+0440
+		"ieiodjgjiedziyijljnjtshkjiudzh" + // U+0450
+		"OoEeIeieEeIeieOoIoioKsks" + // U+0460
+		"PspsFfYyYyuuOoOoOtot" + // U+0470
+		"Qq*1000*[?]*100.000**1.000.000*Ii\"\"R'r'" + // U+0480
+		"G'g'G'g'G'g'Zh'zh'Z'z'K'k'K'k'K'k'" + // U+0490
+		"K'k'N'n'NgngP'p'KhkhS's'T't'Uu" + // U+04A0
+		"U'u'Kh'kh'TtsttsCh'ch'Ch'ch'HhChchCh'ch'" + // U+04B0
+		"`ZhzhK'k'ElelN'n'EnenChchEmempalochka" + // U+04C0
+		"aaAaAeaeIeie@@@@ZhzhZz" + // U+04D0
+		"DzdzIiIiOoOoOoEeUu" + // U+04E0
+		"UuUuChchGhegheYyGhegheHahaHaha" + // U+04F0
+		"DedeDjedjeZjezjeDzjedzjeLjeljeNjenjeSjesjeTjetje" + // U+0500
+		"ZezeElelLhalhaRharhaYaeyaeQaqaWeweKaka" + // U+0510
+		"ElelEnenPepeShhashhaEnenDzzhedzzheDchedcheElel" + // U+0520
+		"[?]ABGDEZEET`ZhILKhTsK" + // U+0530
+		"HDzGhChMYNShOCh`PJRhSVT" + // U+0540
+		"RTs`WP`K`OF[?][?]<'/!,?." + // U+0550
+		"aybabgdezeet`zhilkhtsk" + // U+0560
+		"hdzghchmynshoch`pjrhsvt" + // U+0570
+		"rts`wp`k`ofewyi:-[?][?][?][?][?]" + // U+0580
+		"[?]" + // U+0590
+		"[?]" + // U+05A0
+		"@eaoieeaao[?]u'" + // U+05B0
+		"|:[?][?][?][?][?][?][?][?][?][?][?]" + // U+05C0
+		"bgdhvzkhtykklmmn" + // U+05D0
+		"ns`pptstsqrsht[?][?][?][?][?]" + // U+05E0
+		"Voyi'\"[?][?][?][?][?][?][?][?][?][?][?]" + // U+05F0
+		"[?][?][?][?][?][?][?][?][?][?][?][?],[?][?][?]" + // U+0600
+		"[?][?][?][?][?][?][?][?][?][?][?];[?][?][?]?" + // U+0610
+		"yeha'w'y'b@tthjHkhd" + // U+0620
+		"dhrzsshSDTZ`Gkehehkehehyehyehyeh" + // U+0630
+		"fqklmnhw~yanuninau" + // U+0640
+		"iW''[?][?][?][?][?][?][?][?][?][?]" + // U+0650
+		"0123456789%.,*behqaf" + // U+0660
+		"'''''w'u'ytttthbtTpth" + // U+0670
+		"bh'hHnydyHchcchddDDDtdhddhdD" + // U+0680
+		"DrrRRRRRRjRSSSSST" + // U+0690
+		"GHFFFvfphQQkhkKKngKg" + // U+06A0
+		"GNGGGLLLLNNNNNhCh" + // U+06B0
+		"hyhH@WoeoeuyuyuWvyYYW" + // U+06C0
+		"yy'.ae@#" + // U+06D0
+		"^dalreh" + // U+06E0
+		"0123456789ShDGh&+mheh" + // U+06F0
+		"///,!!-,,;?~{}*[?]" + // U+0700
+		"'bggddhwzHttyyhk" + // U+0710
+		"lmnss`ppSqrshtbhethghamaldhalath" + // U+0720
+		"aaaAAAeeeEiiuuuo" + // U+0730
+		"`'XQ@@|+[?][?]zhainkhaphfe" + // U+0740
+		"behbehbehbehbehbehbehhahhahdaldalrehseenainainain" + // U+0750
+		"fehfehkehehkehehkehehmeemmeemnoonnoonnoonlamrehrehseenhahhah" + // U+0760
+		"seenrehhahalefalefyehyehyehwawwawbarreebarreehahseenseenkaf" + // U+0770
+		"hshnrbLk'vmfdhthlgny" + // U+0780
+		"sdztypjchtthhkhthzshsd" + // U+0790
+		"tz`ghqwaaaieeuooeeyooa" + // U+07A0
+		"naa[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+07B0
+		"0123456789aeeieuoo" + // U+07C0
+		"odagbasinnanbapatajachadararrasagbafakala" + // U+07D0
+		"wolosomanyanahawayawolosojachara[?][?][?][?][?]" + // U+07E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+07F0
+		"alafbitgamandalatiybaazenittityutkaaflabatmimnunsingaatin" + // U+0800
+		"fitsaadiyqufrishshantaafyut" + // U+0810
+		"ai[?][?]" + // U+0820
+		"[?]" + // U+0830
+		"halqaabagadahushennaazitattaksaakalamanasin" + // U+0840
+		"apaszaqarashatdushennakadain[?][?][?]" + // U+0850
+		"ngajanyattannannnabharallalllassa[?][?][?][?][?]" + // U+0860
+		"alefalefalefalefalefalefalefalefalefalefalefalefalefalefalefalef" + // U+0870
+		"alefalefalef[?][?][?]yeh[?]noonhahtahtahkeheh[?][?]" + // U+0880
+		"[?][?][?][?][?][?]" + // U+0890
+		"behbehjeemtahfehqaflammeemyehyehrehwawyehalefdalsad" + // U+08A0
+		"gafwawzainainkafqafbehpehtehrehyehfehqafnoonpehteh" + // U+08B0
+		"ttehtchehkehehghainqafjeemjeemlamgraf[?]" + // U+08C0
+		"[?]NNHaaaaiiiuuuRLeNee" + // U+0900
+		"aioNooaukkhgghngcchjjhnytt" + // U+0910
+		"tthddddhnntthddhnnnnpphbbhmy" + // U+0920
+		"rrrlllllvshsssh[?][?]''aai" + // U+0930
+		"iiuuuRRReNeeaioNooau[?][?]" + // U+0940
+		"AUM''`'[?][?][?]qkhhghhzdddhrhfyy" + // U+0950
+		"RRLLLLL /  // 0123456789" + // U+0960
+		".dotaoeooeawueuueddazhayaggajjastopdddabba" + // U+0970
+		"[?]NNH[?]aaaiiiuuuRRR[?][?]e" + // U+0980
+		"ai[?][?]oaukkhgghngcchjjhnytt" + // U+0990
+		"tthddddhnntthddhn[?]pphbbhmy" + // U+09A0
+		"r[?]l[?][?][?]shsssh[?][?]'avagrahaaai" + // U+09B0
+		"iiuuuRRR[?][?]eai[?][?]oaut[?]" + // U+09C0
+		"[?][?][?][?][?][?][?]+[?][?][?][?]rrrh[?]yy" + // U+09D0
+		"RRLLLLL[?][?]0123456789" + // U+09E0
+		"r'r`RsRs1/2/3/4/ 1 - 1//16[?]anusvara[?][?][?]" + // U+09F0
+		"[?][?]N[?][?]aaaiiiuuu[?][?][?][?]ee" + // U+0A00
+		"ai[?][?]ooaukkhgghngcchjjhnytt" + // U+0A10
+		"tthddddhnntthddhn[?]pphbbbmy" + // U+0A20
+		"r[?]lll[?]vsh[?]sh[?][?]'[?]aai" + // U+0A30
+		"iiuuu[?][?][?][?]eeai[?][?]ooau[?][?]" + // U+0A40
+		"[?][?][?][?][?][?][?][?][?]khhghhzrr[?]f[?]" + // U+0A50
+		"[?][?][?][?][?][?]0123456789" + // U+0A60
+		"NHG.E.O.[?][?][?][?][?][?][?][?][?][?][?]" + // U+0A70
+		"[?]NNH[?]aaaiiiuuuRleN[?]e" + // U+0A80
+		"aioN[?]oaukkhgghngcchjjhnytt" + // U+0A90
+		"tthddddhnntthddhn[?]pphbbhmya" + // U+0AA0
+		"r[?]lll[?]vshsssh[?][?]''aai" + // U+0AB0
+		"iiuuuRRReN[?]eaioN[?]oau[?][?]" + // U+0AC0
+		"AUM[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+0AD0
+		"RRl[?][?][?][?]0123456789" + // U+0AE0
+		"[?][?][?][?][?][?][?][?][?]zha[?][?][?][?][?]" + // U+0AF0
+		"[?]NNH[?]aaaiiiuuuRL[?][?]e" + // U+0B00
+		"ai[?][?]oaukkhgghngcchjjhnytt" + // U+0B10
+		"tthddddhnntthddhn[?]pphbbhmy" + // U+0B20
+		"r[?]lll[?]shsssh[?][?]''aai" + // U+0B30
+		"iiuuuR[?][?][?]eai[?][?]oau[?][?]" + // U+0B40
+		"[?][?][?][?][?][?]++[?][?][?][?]rrrh[?]yy" + // U+0B50
+		"RRLL[?][?][?][?]0123456789" + // U+0B60
+		"wa1/41/23/41/161/83/16[?][?][?][?][?][?][?][?]" + // U+0B70
+		"[?][?]NH[?]aaaiiiuuu[?][?][?]eee" + // U+0B80
+		"ai[?]oooauk[?][?][?]ngc[?]j[?]nytt" + // U+0B90
+		"[?][?][?]nnt[?][?][?]nnnnp[?][?][?]my" + // U+0BA0
+		"rrrllllllvsasssh[?][?][?][?]aai" + // U+0BB0
+		"iiuuu[?][?][?]eeeai[?]oooau[?][?]" + // U+0BC0
+		"[?][?][?][?][?][?][?]+[?][?][?][?][?][?][?][?]" + // U+0BD0
+		"[?][?][?][?][?][?]0123456789" + // U+0BE0
+		"+10++100++1000+[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+0BF0
+		"[?]NNH[?]aaaiiiuuuRL[?]eee" + // U+0C00
+		"ai[?]oooaukkhgghngcchjjhnytt" + // U+0C10
+		"tthddddhnntthddhn[?]pphbbhmy" + // U+0C20
+		"rrrllllllavshsssh[?][?][?]avagrahaaai" + // U+0C30
+		"iiuuuRRR[?]eeeai[?]oooau[?][?]" + // U+0C40
+		"[?][?][?][?][?]++[?]tsadzarrra[?][?]pollu[?][?]" + // U+0C50
+		"RRLL[?][?][?][?]0123456789" + // U+0C60
+		"[?][?][?][?][?][?][?][?]0123123[?]" + // U+0C70
+		"candrabindu[?]NH[?]aaaiiiuuuRL[?]eee" + // U+0C80
+		"ai[?]oooaukkhgghngcchjjhnytt" + // U+0C90
+		"tthddddhnntthddhn[?]pphbbhmy" + // U+0CA0
+		"rrrlll[?]vshsssh[?][?][?]avagrahaaai" + // U+0CB0
+		"iiuuuRRR[?]eeeai[?]oooau[?][?]" + // U+0CC0
+		"[?][?][?][?][?]++[?][?][?][?][?][?]pollulll[?]" + // U+0CD0
+		"RRLL[?][?][?][?]0123456789" + // U+0CE0
+		"[?]jihvamuliyaupadhmaniya[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+0CF0
+		"[?][?]NHanusvaraaaaiiiuuuRL[?]eee" + // U+0D00
+		"ai[?]oooaukkhgghngcchjjhnytt" + // U+0D10
+		"tthddddhnntthddhnnnnapphbbhmy" + // U+0D20
+		"rrrllllllvshssshttta[?][?]avagrahaaai" + // U+0D30
+		"iiuuuR[?][?]eeeaioooaureph[?]" + // U+0D40
+		"[?][?][?][?]mylll+1/1601/403/801/201/103/201/5ii" + // U+0D50
+		"RRLL[?][?][?][?]0123456789" + // U+0D60
+		"1010010001/41/23/41/161/83/16[?]nnnrrlllk" + // U+0D70
+		"[?][?]NH[?]aaaaeaaeiiiuuuRRRL" + // U+0D80
+		"LLeeeaioooau[?][?][?]kkhgghngnng" + // U+0D90
+		"cchjjhnyjnynyjtttthddddhnnnnddtthd" + // U+0DA0
+		"dhn[?]ndpphbbhmmbyr[?]l[?][?]" + // U+0DB0
+		"vshssshllf[?][?][?][?][?][?][?]aa" + // U+0DC0
+		"aeaaeiiiu[?]uu[?]ReeeaioooauL" + // U+0DD0
+		"[?][?][?][?][?][?]0123456789" + // U+0DE0
+		"[?][?]RRLL . [?][?][?][?][?][?][?][?][?][?][?]" + // U+0DF0
+		"[?]kkhkhkhkhkhngcchchchchchydt" + // U+0E00
+		"thththndtthththnbpphfphf" + // U+0E10
+		"phmyrRlLwssshl`h~" + // U+0E20
+		"aaaaamiiiueuueuuu'[?][?][?][?]Bh." + // U+0E30
+		"eaeoaiaiao+M * " + // U+0E40
+		"0123456789 //  /// [?][?][?][?]" + // U+0E50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+0E60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+0E70
+		"[?]kkh[?]kh[?]ghangchchas[?]jhanynyatta" + // U+0E80
+		"tthaddaddhannadhththdhanbpphfphf" + // U+0E90
+		"bhamyr[?]l[?]wshassashlla`~" + // U+0EA0
+		"aaaamiiiyyyuuu[?]olny[?][?]" + // U+0EB0
+		"eeioayai[?]+[?]M[?][?]" + // U+0EC0
+		"0123456789[?][?]hnhmgonyo" + // U+0ED0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+0EE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+0EF0
+		"AUM //  * - /  /  //  -/ " + // U+0F00
+		" +/  X/  /XX/  /X/ , " + // U+0F10
+		"0123456789.51.52.53.54.55.5" + // U+0F20
+		"6.57.58.5-.5+*^_~[?]][[]]" + // U+0F30
+		"kkhgghngcchj[?]nytttthddddhnnt" + // U+0F40
+		"thddhnpphbbhmtstshdzdzhwzhz" + // U+0F50
+		"'yrlshsshshakssrkkarra[?][?][?]" + // U+0F60
+		"[?]aaiiiuuuRRRLLLeeeoooMH" + // U+0F70
+		"iiican[?][?][?]" + // U+0F80
+		"kkhgghngcchj[?]nytttthddddhnnt" + // U+0F90
+		"thddhnpphbbhmtstshdzdzhwzhz" + // U+0FA0
+		"'yrlshssshaksswyr[?]X :X: " + // U+0FB0
+		" /O/  /o/  \\o\\  (O) [?][?]" + // U+0FC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+0FD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+0FE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+0FF0
+		"kkhgghngcchjjhnynnytttthddddhnn" + // U+1000
+		"ttthddhnpphbbhmyrlwsh" + // U+1010
+		"llaaiiiuuueeoau[?]aaiiiu" + // U+1020
+		"uueai[?][?][?]N':[?][?][?][?][?]sa" + // U+1030
+		"0123456789 /  // n*r*l*e*" + // U+1040
+		"shssRRRLLLRRRLLLngajhabbabbe[?][?]" + // U+1050
+		"[?]sha[?][?][?]thapwa[?][?][?][?][?][?][?]nnaywa" + // U+1060
+		"ghwa[?][?][?][?]kakhagacazanyadanaphafaba" + // U+1070
+		"thaha[?][?][?][?][?][?][?][?][?][?][?][?]fa[?]" + // U+1080
+		"0123456789[?][?][?][?][?][?]" + // U+1090
+		"ABGDEVZT`IKLMNOPZh" + // U+10A0
+		"RSTUP`K`G'QShCh`C`Z'CChXJ" + // U+10B0
+		"HEYWXhOE[?]Yn[?][?][?][?][?]Aen[?][?]" + // U+10C0
+		"abgdevzt`iklmnopzh" + // U+10D0
+		"rstup`k`g'qshch`c`z'cchxj" + // U+10E0
+		"heywxhoefynelifiganain // naenhardlabial" + // U+10F0
+		"hahuhihaaheehehohoalalulilaaleelelolwa" + // U+1200
+		"hhahhuhhihhaahheehhehhohhwamamumimaameememomwa" + // U+1210
+		"szaszusziszaaszeeszeszoszwararuriraareererorwa" + // U+1220
+		"sasusisaaseesesoswashashushishaasheesheshoshwa" + // U+1230
+		"qaquqiqaaqeeqeqoqoaqwa[?]qwiqwaaqweeqwe[?][?]" + // U+1240
+		"qhaqhuqhiqhaaqheeqheqho[?]qhwa[?]qhwiqhwaaqhweeqhwe[?][?]" + // U+1250
+		"babubibaabeebebobwavavuvivaaveevevovwa" + // U+1260
+		"tatutitaateetetotwacacucicaaceececocwa" + // U+1270
+		"xaxuxixaaxeexexoxoaxwa[?]xwixwaaxweexwe[?][?]" + // U+1280
+		"nanuninaaneenenonwanyanyunyinyaanyeenyenyonywa" + // U+1290
+		"'a'ui'aa'ee'e'o'wakakukikaakeekekokoa" + // U+12A0
+		"kwa[?]kwikwaakweekwe[?][?]kxakxukxikxaakxeekxekxo[?]" + // U+12B0
+		"kxwa[?]kxwikxwaakxweekxwe[?][?]wawuwiwaaweewewowoa" + // U+12C0
+		"`a`u`i`aa`ee`e`o[?]zazuzizaazeezezozwa" + // U+12D0
+		"zhazhuzhizhaazheezhezhozhwayayuyiyaayeeyeyoyoa" + // U+12E0
+		"dadudidaadeededodwaddadduddiddaaddeeddeddoddwa" + // U+12F0
+		"jajujijaajeejejojwagagugigaageegegogoa" + // U+1300
+		"gwa[?]gwigwaagweegwe[?][?]ggagguggiggaaggeeggeggoggwaa" + // U+1310
+		"thathuthithaatheethethothwachachuchichaacheechechochwa" + // U+1320
+		"phaphuphiphaapheephephophwatsatsutsitsaatseetsetsotswa" + // U+1330
+		"tzatzutzitzaatzeetzetzotzoafafufifaafeefefofwa" + // U+1340
+		"papupipaapeepepopwaryamyafya[?][?][?][?][?]" + // U+1350
+		"[?] .,;::: ?//1234567" + // U+1360
+		"8910+20+30+40+50+60+70+80+90+100+10,000+[?][?][?]" + // U+1370
+		"mwamwimweemwebwabwibweebwefwafwifweefwepwapwipweepwe" + // U+1380
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1390
+		"aeiouvgakagegigogugvhahehi" + // U+13A0
+		"hohuhvlalelilolulvmamemimomunahna" + // U+13B0
+		"nahneninonunvquaquequiquoquuquvsassesi" + // U+13C0
+		"sosusvdatadeteditidodudvdlatlatletli" + // U+13D0
+		"tlotlutlvtsatsetsitsotsutsvwawewiwowuwvya" + // U+13E0
+		"yeyiyoyuyvmv[?][?]yeyiyoyuyvmv[?][?]" + // U+13F0
+		"[?]eaaiiiioooooeeiaaawewewiwi" + // U+1400
+		"wiiwiiwowowoowoowoowawawaawaawaaaiw't" + // U+1410
+		"kshsnwnstrokeswc?leninonanpe" + // U+1420
+		"paaipipiipopoopooheehipapaapwepwepwipwipwiipwii" + // U+1430
+		"pwopwopwoopwoopwapwapwaapwaapwaapphtetaaititii" + // U+1440
+		"totootoodeeditataatwetwetwitwitwiitwiitwotwotwoo" + // U+1450
+		"twootwatwatwaatwaatwaatttettittottakekaaikikiiko" + // U+1460
+		"kookookakaakwekwekwikwikwiikwiikwokwokwookwookwakwa" + // U+1470
+		"kwaakwaakwaakkwkehkihkohkahcecaaiciciicocoocoo" + // U+1480
+		"cacaacwecwecwicwicwiicwiicwocwocwoocwoocwacwacwaacwaa" + // U+1490
+		"cwaacthmemaaimimiimomoomoomamaamwemwemwimwi" + // U+14A0
+		"mwiimwiimwomwomwoomwoomwamwamwaamwaamwaammmhmm" + // U+14B0
+		"nenaaininiinonoonoonanaanwenwenwanwanwaanwaanwaa" + // U+14C0
+		"nngnhlelaaililiilolooloolalaalwelwelwilwi" + // U+14D0
+		"lwiilwiilwolwolwoolwoolwalwalwaalwaalllsesaaisi" + // U+14E0
+		"siisosoosoosasaaswesweswiswiswiiswiiswoswoswooswoo" + // U+14F0
+		"swaswaswaaswaaswaassswsskskwsWspwastwaskwascwa" + // U+1500
+		"sheshishiishoshooshashaashweshweshwishwishwiishwiishwoshwoshwoo" + // U+1510
+		"shwooshwashwashwaashwaashyeyaaiyiyiiyoyooyooyayaaywe" + // U+1520
+		"yweywiywiywiiywiiywoywoywooywooywaywaywaaywaaywaayy" + // U+1530
+		"yyirereleraaiririirorooloraraalarwaarwaa" + // U+1540
+		"rrrfefaaififiifofoofafaafwaafwaafthethe" + // U+1550
+		"thithithiithiithothoothathaathwaathwaathtthetthitthotthatth" + // U+1560
+		"tyetyityotyahehihiihohoohahaahhhkqaaiqi" + // U+1570
+		"qiiqoqooqaqaaqtlhetlhitlhotlharerirorangaaingi" + // U+1580
+		"ngiingongoongangaangnngsheshishoshathethithothath" + // U+1590
+		"lhilhiilholhoolhalhaalhthethithiithothoothathaathb" + // U+15A0
+		"eioawewiwowaneninonakekikoka" + // U+15B0
+		"hehihohaghughoghegheeghigharurorereerira" + // U+15C0
+		"wuwoweweewiwahwuhwohwehweehwihwathuthothethee" + // U+15D0
+		"thithattuttottetteettittapupopepeepipapgu" + // U+15E0
+		"gogegeegigakhukhokhekheekhikhakkukkokkekkeekki" + // U+15F0
+		"kkakknunoneneeninamumomemeemimayuyo" + // U+1600
+		"yeyeeyiyajujujojejeejijijajjujjojjejjee" + // U+1610
+		"jjijjaluloleleeliladludlodledleedlidlalhulho" + // U+1620
+		"lhelheelhilhatlhutlhotlhetlheetlhitlhatlutlotletleetlitla" + // U+1630
+		"zuzozezeezizazzdzudzodzedzeedzidzasuso" + // U+1640
+		"seseesisashushoshesheeshishashtsutsotsetseetsi" + // U+1650
+		"tsachuchochecheechichattsuttsottsettseettsittsaX.qai" + // U+1660
+		"ngainnginngiinngonngoonnganngaathweethwithwiithwothwoothwathwaathw" + // U+1670
+		" blfsnhdtcqmgngzr" + // U+1680
+		"aoueichthphpxp<>[?][?][?]" + // U+1690
+		"fvuyrywththaoacaeooooe" + // U+16A0
+		"onrkckgngggwhhhhnn" + // U+16B0
+		"niejgaeaeopzsssczt" + // U+16C0
+		"tdbbppemmmllngngdo" + // U+16D0
+		"eariorquququsyryryrqx.:+1718" + // U+16E0
+		"19kshooosisehacaesc[?][?][?][?][?][?][?]" + // U+16F0
+		"aiukagangatadanapabamayaralawa" + // U+1700
+		"saha[?][?][?][?][?][?][?][?][?][?][?][?][?]ra" + // U+1710
+		"aiukagangatadanapabamayaralawa" + // U+1720
+		"saha[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1730
+		"aiukagangatadanapabamayaralawa" + // U+1740
+		"saha[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1750
+		"aiukagangatadanapabamaya[?]lawa" + // U+1760
+		"sa[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1770
+		"kkhgghngcchjjhnyttthdddhnnt" + // U+1780
+		"thddhnpphbbhmyrlvshsss" + // U+1790
+		"hlqaaaiiiuukuuuuvryryylylyye" + // U+17A0
+		"aiooooauaaaaaiiiyyyuuuuaoeya" + // U+17B0
+		"ieeaeaiooauMHa`r!" + // U+17C0
+		". // :+++ *  /// KR'[?][?][?]" + // U+17D0
+		"0123456789[?][?][?][?][?][?]" + // U+17E0
+		"0123456789[?][?][?][?][?][?]" + // U+17F0
+		" @  ... , . :  // -, . [?]" + // U+1800
+		"0123456789[?][?][?][?][?][?]" + // U+1810
+		"aeiouOUeenngbpqgml" + // U+1820
+		"sshtdchjyrwfkkhatszhzr" + // U+1830
+		"lhzhch-eiouOUngbpqgm" + // U+1840
+		"tdchjtsywkghjynydzeiiy" + // U+1850
+		"Uungkghpshtdjfghtsz" + // U+1860
+		"rchzhikrfzhcha[?][?][?][?][?][?][?]" + // U+1870
+		"oneHXWM 3  333 aikngctttthddnn" + // U+1880
+		"tdpphsszhzatzhghngcjhttaddh" + // U+1890
+		"tdhsscyzhzuybh'lha[?][?][?][?][?]" + // U+18A0
+		"oyayaaywaypoypaypwoytaykaykwaymaynoynaylaysoysay" + // U+18B0
+		"shoyshayshwoyyoyyayraynwinwinwiinwiinwonwonwoonwoorweerwi" + // U+18C0
+		"rwiirworwoorwaptkcmnsshwwringdot" + // U+18D0
+		"rweloolaathwethwatthwetthootthaatlhwetlhooshweshoohoogwugeegaa" + // U+18E0
+		"gwajuujwalrs[?][?][?][?][?][?][?][?][?][?]" + // U+18F0
+		"carrierkakhagaghangacachajajhayantathadadhana" + // U+1900
+		"paphababhamayaralawashassasahagyantra[?]" + // U+1910
+		"[?][?][?][?]" + // U+1920
+		"[?][?][?][?]" + // U+1930
+		"[?][?][?]0123456789" + // U+1940
+		"kaxangatsasayatathalapaphamafavahaqa" + // U+1950
+		"khatshanaaieeehuoooueeaueai[?][?]" + // U+1960
+		"tone2tone3tone4tone5tone6[?][?][?][?][?][?][?][?][?][?][?]" + // U+1970
+		"qaqakaxangakaxangatsasayatsasayatatha" + // U+1980
+		"natathanapaphamapaphamafavalafavala" + // U+1990
+		"hadabahadabakvaxvakvaxvasuasua[?][?][?][?]" + // U+19A0
+		"shorteneraaiiuuueaeooaueayaayuyoyoayuey" + // U+19B0
+		"iyvngnmkdb[?][?][?][?][?][?][?][?]" + // U+19C0
+		"01234567891[?][?][?]" + // U+19D0
+		"kagangangkapabamampatadananracajanyanyca" + // U+1A00
+		"yaralavasaaha[?][?]" + // U+1A10
+		"kakhakxakakxakhangacachacasachanyaratarathada" + // U+1A20
+		"ratharanatathatathanabapaphafapafaphamaya" + // U+1A30
+		"yararuelaluewashassasahallaahaiiiu" + // U+1A40
+		"uueeoolaesa[?]" + // U+1A50
+		"[?][?]" + // U+1A70
+		"0123456789[?][?][?][?][?][?]" + // U+1A80
+		"0123456789[?][?][?][?][?][?]" + // U+1A90
+		"yamok[?][?]" + // U+1AA0
+		"[?]" + // U+1AC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AF0
+		"akaraakaraikaraikaraukaraukarareparepalengalengaekara" + // U+1B00
+		"aikaraokaraokarakakagagorangacalacajajeranyalatiktada" + // U+1B10
+		"darambattatawadamadunapapabakembangmayaralawa" + // U+1B20
+		"sagasasaha" + // U+1B30
+		"kafkhottzirefvezalasyurajnya[?][?][?]" + // U+1B40
+		"0123456789" + // U+1B50
+		"[?]" + // U+1B70
+		"aiuaeoeeukaqagangacaja" + // U+1B80
+		"zanyatadanapafavabamayaralawasaxa" + // U+1B90
+		"hakhasya" + // U+1BA0
+		"0123456789[?]reuleubhakm" + // U+1BB0
+		"aahahahababapapananawawawagaga" + // U+1BC0
+		"jadararamamatatasasasayayangalala" + // U+1BD0
+		"nyacandambaiu" + // U+1BE0
+		"[?][?][?][?][?][?][?][?]" + // U+1BF0
+		"kaklakhagaglangacachajanyatathadanapapla" + // U+1C00
+		"phafaflabablamamlatsatshadzayaralahahlava" + // U+1C10
+		"sashawaa" + // U+1C20
+		"[?][?][?]" + // U+1C30
+		"0123456789[?][?][?]ttatthadda" + // U+1C40
+		"0123456789laatagangallaa" + // U+1C50
+		"aakaajaamaawliisihinyirluucudunnuyleep" + // U+1C60
+		"eddenerrloottobovoh[?][?][?][?][?][?]" + // U+1C70
+		"vedeoestetehardyatuk[?][?][?][?][?][?][?]" + // U+1C80
+		"AnBanGanDonEnVinZenTanInKanLasManNarOnParZhar" + // U+1C90
+		"RaeSanTarUnPharKharGhanQarShinChinCanJilCilCharXanJhan" + // U+1CA0
+		"HaeHeHieWeHarHoeFiYnElifiGanAin[?][?]AenHardLabial" + // U+1CB0
+		"[?][?][?][?][?][?][?][?]" + // U+1CC0
+		"antargomukhabahirgomukhavamagomukhavamagomukhaanusvaraanusvara" + // U+1CE0
+		"anusvaramukhaardhavisargaardhavisargajihvamuliyaupadhmaniyaantargomukha[?][?][?][?][?]" + // U+1CF0
+		"bdfm" + // U+1D60
+		"nprrstzgp" + // U+1D70
+		"bdfgklmnprsvxz" + // U+1D80
+		"AaBbBbBbCcDdDdDd" + // U+1E00
+		"DdDdEeEeEeEeEeFf" + // U+1E10
+		"GgHhHhHhHhHhIiIi" + // U+1E20
+		"KkKkKkLlLlLlLlMm" + // U+1E30
+		"MmMmNnNnNnNnOoOo" + // U+1E40
+		"OoOoPpPpRrRrRrRr" + // U+1E50
+		"SsSsSsSsSsTtTtTt" + // U+1E60
+		"TtUuUuUuUuUuVvVv" + // U+1E70
+		"WwWwWwWwWwXxXxYy" + // U+1E80
+		"ZzZzZzhtwyaSssSsdelta" + // U+1E90
+		"AaAaAaAaAaAaAaAa" + // U+1EA0
+		"AaAaAaAaEeEeEeEe" + // U+1EB0
+		"EeEeEeEeIiIiOoOo" + // U+1EC0
+		"OoOoOoOoOoOoOoOo" + // U+1ED0
+		"OoOoUuUuUuUuUuUu" + // U+1EE0
+		"UuYyYyYyYyLlllVvYy" + // U+1EF0
+		"aaaaaaaaAAAAAAAA" + // U+1F00
+		"eeeeee[?][?]EEEEEE[?][?]" + // U+1F10
+		"eeeeeeeeEEEEEEEE" + // U+1F20
+		"iiiiiiiiIIIIIIII" + // U+1F30
+		"oooooo[?][?]OOOOOO[?][?]" + // U+1F40
+		"uuuuuuuu[?]U[?]U[?]U[?]U" + // U+1F50
+		"ooooooooOOOOOOOO" + // U+1F60
+		"aaeeeeiioouuoo[?][?]" + // U+1F70
+		"aaaaaaaaAAAAAAAA" + // U+1F80
+		"eeeeeeeeEEEEEEEE" + // U+1F90
+		"ooooooooOOOOOOOO" + // U+1FA0
+		"aaaaa[?]aaAAAAA'i'" + // U+1FB0
+		"~\"~eee[?]eeEEEEE'`'''~" + // U+1FC0
+		"iiii[?][?]iiIIII[?]`'`'`~" + // U+1FD0
+		"uuuuRRuuUUUUR\"`\"'`" + // U+1FE0
+		"[?][?]ooo[?]ooOOOOO'`[?]" + // U+1FF0
+		"            " + // U+2000
+		"--------||_'','\"\",,\"" + // U+2010
+		"+++**>.......\n\n\n " + // U+2020
+		"%0%00''''''``````^<>*!!!?-_" + // U+2030
+		"-^***--/-[]-???!!?7PP(][)*[?]" + // U+2040
+		"[?][?]%~[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2050
+		"[?][?][?][?][?][?][?][?][?]" + // U+2060
+		"0456789+-=()n" + // U+2070
+		"0123456789+-=()[?]" + // U+2080
+		"aeox@hklmnpst[?][?][?]" + // U+2090
+		"ECUCLCrFFLmilNPtsRsWNSDEURKTDr" + // U+20A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+20B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+20C0
+		"[?][?][?][?][?][?][?][?][?][?][?]" + // U+20E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+20F0
+		"CH" + // U+2100
+		"NPQR" + // U+2110
+		"(sm)TEL(tm)ZKAee" + // U+2120
+		"EFFMFAX" + // U+2130
+		"[?][?][?][?][?]Ddeij[?][?][?][?]F[?]" + // U+2140
+		"1/71/91/10 1/3  2/3  1/5  2/5  3/5  4/5  1/6  5/6  1/8  3/8  5/8  7/8  1/" + // U+2150
+		"IIIIIIIVVVIVIIVIIIIXXXIXIILCDM" + // U+2160
+		"iiiiiiivvviviiviiiixxxixiilcdm" + // U+2170
+		"(DD)((|)))c650500001000000/3[?][?][?][?][?][?]" + // U+2180
+		"-|-|-|\\/\\/--~~-|" + // U+2190
+		"-|---|-||------|" + // U+21A0
+		"||||||^V\\=V^--||" + // U+21B0
+		"--||=|==|=|=====" + // U+21C0
+		"=|=|=|\\/\\/==~~||" + // U+21D0
+		"-|-|---|-|||||||" + // U+21E0
+		"-\\\\|[?][?][?][?][?][?][?][?][?][?][?]" + // U+21F0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2200
+		"[?][?]-[?][?]/\\*[?][?][?][?][?][?][?][?]" + // U+2210
+		"[?][?][?]|[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2220
+		"[?][?][?][?][?][?]:[?][?][?][?][?]~[?][?][?]" + // U+2230
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2240
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2250
+		"[?][?][?][?]<=>=<=>=[?][?][?][?][?][?][?][?]" + // U+2260
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2270
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2280
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2290
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+22A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+22B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+22C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+22D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+22E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+22F0
+		"[?][?][?]^[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2300
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2310
+		"[?][?][?][?][?][?][?][?][?]<> [?][?][?][?][?]" + // U+2320
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2330
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2340
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2350
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2360
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2370
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2380
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2390
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+23A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+23B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+23C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+23D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+23E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+23F0
+		"[?][?][?][?][?][?][?][?][?]" + // U+2420
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2430
+		"[?][?][?][?][?]" + // U+2440
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2450
+		"12345678910111213141516" + // U+2460
+		"17181920(1)(2)(3)(4)(5)(6)(7)(8)(9)(10)(11)(12)" + // U+2470
+		"(13)(14)(15)(16)(17)(18)(19)(20)1.2.3.4.5.6.7.8." + // U+2480
+		"9.10.11.12.13.14.15.16.17.18.19.20.(a)(b)(c)(d)" + // U+2490
+		"(e)(f)(g)(h)(i)(j)(k)(l)(m)(n)(o)(p)(q)(r)(s)(t)" + // U+24A0
+		"(u)(v)(w)(x)(y)(z)ABCDEFGHIJ" + // U+24B0
+		"KLMNOPQRSTUVWXYZ" + // U+24C0
+		"abcdefghijklmnop" + // U+24D0
+		"qrstuvwxyz01112131415" + // U+24E0
+		"1617181920123456789100" + // U+24F0
+		"--||--||--||++++" + // U+2500
+		"++++++++++++++++" + // U+2510
+		"++++++++++++++++" + // U+2520
+		"++++++++++++++++" + // U+2530
+		"++++++++++++--||" + // U+2540
+		"-|++++++++++++++" + // U+2550
+		"++++++++++++++++" + // U+2560
+		"+/\\X-|-|-|-|-|-|" + // U+2570
+		"################" + // U+2580
+		"####-|[?][?][?][?][?][?][?][?][?][?]" + // U+2590
+		"################" + // U+25A0
+		"##^^^^>>>>>>VVVV" + // U+25B0
+		"<<<<<<**********" + // U+25C0
+		"****************" + // U+25D0
+		"*******#####^^^O" + // U+25E0
+		"########[?][?][?][?][?][?][?]" + // U+25F0
+		"[?][?][?][?][?]" + // U+2610
+		"#" + // U+2660
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2670
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2680
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2690
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+26A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+26B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+26C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+26D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+26E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+26F0
+		"[?]" + // U+2700
+		"*" + // U+2730
+		"|[?]" + // U+2750
+		"[?]!" + // U+2760
+		"[?][?]" + // U+27B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+27C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+27D0
+		"[?][?][?][?][?][?][[?]<> [?][?][?][?][?][?]" + // U+27E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+27F0
+		" a1b'k2l@cif/msp" + // U+2800
+		"\"e3h9o6r^djg>ntq" + // U+2810
+		",*5<-u8v.%[$+x!&" + // U+2820
+		";:4\\0z7(_?w]#y)=" + // U+2830
+		"[d7][d17][d27][d127][d37][d137][d237][d1237][d47][d147][d247][d1247][d347][d1347][d2347][d12347]" + // U+2840
+		"[d57][d157][d257][d1257][d357][d1357][d2357][d12357][d457][d1457][d2457][d12457][d3457][d13457][d23457][d123457]" + // U+2850
+		"[d67][d167][d267][d1267][d367][d1367][d2367][d12367][d467][d1467][d2467][d12467][d3467][d13467][d23467][d123467]" + // U+2860
+		"[d567][d1567][d2567][d12567][d3567][d13567][d23567][d123567][d4567][d14567][d24567][d124567][d34567][d134567][d234567][d1234567]" + // U+2870
+		"[d8][d18][d28][d128][d38][d138][d238][d1238][d48][d148][d248][d1248][d348][d1348][d2348][d12348]" + // U+2880
+		"[d58][d158][d258][d1258][d358][d1358][d2358][d12358][d458][d1458][d2458][d12458][d3458][d13458][d23458][d123458]" + // U+2890
+		"[d68][d168][d268][d1268][d368][d1368][d2368][d12368][d468][d1468][d2468][d12468][d3468][d13468][d23468][d123468]" + // U+28A0
+		"[d568][d1568][d2568][d12568][d3568][d13568][d23568][d123568][d4568][d14568][d24568][d124568][d34568][d134568][d234568][d1234568]" + // U+28B0
+		"[d78][d178][d278][d1278][d378][d1378][d2378][d12378][d478][d1478][d2478][d12478][d3478][d13478][d23478][d123478]" + // U+28C0
+		"[d578][d1578][d2578][d12578][d3578][d13578][d23578][d123578][d4578][d14578][d24578][d124578][d34578][d134578][d234578][d1234578]" + // U+28D0
+		"[d678][d1678][d2678][d12678][d3678][d13678][d23678][d123678][d4678][d14678][d24678][d124678][d34678][d134678][d234678][d1234678]" + // U+28E0
+		"[d5678][d15678][d25678][d125678][d35678][d135678][d235678][d1235678][d45678][d145678][d245678][d1245678][d345678][d1345678][d2345678][d12345678]" + // U+28F0
+		"{} " + // U+2980
+		"::======" + // U+2A70
+		"[?][?]" + // U+2B70
+		"[?]" + // U+2B90
+		"LlLPRatHhKkZzMA" + // U+2C60
+		"anbangandonenvinzentaninkanlasmannaronparzhar" + // U+2D00
+		"raesantarunpharkharghanqarshinchincanjilcilcharxanjhan" + // U+2D10
+		"haehehieweharhoe[?]yn[?][?][?][?][?]aen[?][?]" + // U+2D20
+		"abbhgghhjjddhddddhyfkkkhh" + // U+2D30
+		"hhhhhakhkhqqizhzhzhlmn" + // U+2D40
+		"gnngpurrrghghghsssshtthchtt" + // U+2D50
+		"vwyzzzzeo[?][?][?][?][?][?][?]mark" + // U+2D60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2D70
+		"loamoaroasoashoaboatoacoanoanyoaoazoadoaddoajoathoa" + // U+2D80
+		"choaphoapoaggwaggwiggweeggwe[?][?][?][?][?][?][?][?][?]" + // U+2D90
+		"ssassussissaasseessesso[?]ccaccucciccaacceeccecco[?]" + // U+2DA0
+		"zzazzuzzizzaazzeezzezzo[?]cchacchucchicchaaccheeccheccho[?]" + // U+2DB0
+		"qyaqyuqyiqyaaqyeeqyeqyo[?]kyakyukyikyaakyeekyekyo[?]" + // U+2DC0
+		"xyaxyuxyixyaaxyeexyexyo[?]gyagyugyigyaagyeegyegyo[?]" + // U+2DD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E00
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E10
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E20
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E70
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?][?][?]" + // U+2FF0
+		" , . \"[JIS]\"/0<> <<>> [] {} " + // U+3000
+		"[()] @X [] [[]] (()) [[]] ~ ``'',," + // U+3010
+		"@123456789" + // U+3020
+		"~++++@ // +10++20++30+[?][?][?]" + // U+3030
+		"[?]aaiiuueeookagakigiku" + // U+3040
+		"gukegekogosazashizisuzusezesozota" + // U+3050
+		"dachiditsutsudutedetodonaninunenoha" + // U+3060
+		"bapahibipihubupuhebepehobopomami" + // U+3070
+		"mumemoyayayuyuyoyorarirurerowawa" + // U+3080
+		"wiwewonvukake[?][?]\"\"yori" + // U+3090
+		"[?]aaiiuueeookagakigiku" + // U+30A0
+		"gukegekogosazashizisuzusezesozota" + // U+30B0
+		"dachiditsutsudutedetodonaninunenoha" + // U+30C0
+		"bapahibipihubupuhebepehobopomami" + // U+30D0
+		"mumemoyayayuyuyoyorarirurerowawa" + // U+30E0
+		"wiwewonvukakevavivevo\"\"koto" + // U+30F0
+		"[?][?][?][?][?]BPMFDTNLGKH" + // U+3100
+		"JQXZHCHSHRZCSAOEEHAIEI" + // U+3110
+		"AUOUANENANGENGERIUIUVNGGNihonn" + // U+3120
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+3130
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+3140
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+3150
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+3160
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+3170
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+3180
+		"BUZIJIGUEEENNOOONNIRANNINNUNNIMNGGAINNAUNN" + // U+31A0
+		"AMOMONGINNNPTKHghlhzyggwkwoeah" + // U+31B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+31C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+31D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+31E0
+		"kusisutonuhahihuhehomurarirurero" + // U+31F0
+		"(g)(n)(d)(r)(m)(b)(s)()(j)(c)(k)(t)(p)(h)(ga)(na)" + // U+3200
+		"(da)(ra)(ma)(ba)(sa)(a)(ja)(ca)(ka)(ta)(pa)(ha)(ju)[?][?][?]" + // U+3210
+		"(1) (2) (3) (4) (5) (6) (7) (8) (9) (10) (Yue) (Huo) (Shui) (Mu) (Jin) (Tu) " + // U+3220
+		"(Ri) (Zhu) (You) (She) (Ming) (Te) (Cai) (Zhu) (Lao) (Dai) (Hu) (Xue) (Jian) (Qi) (Zi) (Xie) " + // U+3230
+		"(Ji) (Xiu) <<>>[?][?][?][?]1020304050607080" + // U+3240
+		"[?]212223242526272829303132333435" + // U+3250
+		"(g)(n)(d)(r)(m)(b)(s)()(j)(c)(k)(t)(p)(h)(ga)(na)" + // U+3260
+		"(da)(ra)(ma)(ba)(sa)(a)(ja)(ca)(ka)(ta)(pa)(ha)[?][?][?]KIS " + // U+3270
+		"(1) (2) (3) (4) (5) (6) (7) (8) (9) (10) (Yue) (Huo) (Shui) (Mu) (Jin) (Tu) " + // U+3280
+		"(Ri) (Zhu) (You) (She) (Ming) (Te) (Cai) (Zhu) (Lao) (Mi) (Nan) (Nu) (Shi) (You) (Yin) (Zhu) " + // U+3290
+		"(Xiang) (Xiu) (Xie) (Zheng) (Shang) (Zhong) (Xia) (Zuo) (You) (Yi) (Zong) (Xue) (Jian) (Qi) (Zi) (Xie) " + // U+32A0
+		"(Ye) 363738394041424344454647484950" + // U+32B0
+		"1M2M3M4M5M6M7M8M9M10M11M12M[?][?][?][?]" + // U+32C0
+		"aiuuokakikukekosasisusesota" + // U+32D0
+		"titutetonaninunenohahihuhehomami" + // U+32E0
+		"mumemoyayuyorarirurerowawiwewoLing He " + // U+32F0
+		"apartmentalphaampereareinninginchwonescudoacreounceohmkai-ricaratcaloriegallongamma" + // U+3300
+		"gigaguineacurieguilderkilokilogramkilometerkilowattgramgram toncruzeirokronecasekorunaco-opcycle" + // U+3310
+		"centimeshillingcenticentdozendesidollartonnanoknotheightspercentpartsbarrelpiasterpicul" + // U+3320
+		"picobuildingfaradfeetbushelfranchectarepesopfennighertzpencepagebetapointvolthon" + // U+3330
+		"poundhallhornmicromilemachmarkmansionmicronmillimillibarmegamegatonmeteryardyard" + // U+3340
+		"yuanliterlirarupeerubleremroentgenwatt0h1h2h3h4h5h6h7h" + // U+3350
+		"8h9h10h11h12h13h14h15h16h17h18h19h20h21h22h23h" + // U+3360
+		"24hHPAdaAUbaroVpc[?][?][?][?]HeiseiSyouwaTaisyouMeijiInc." + // U+3370
+		"pAnAmicroampmAkAkBMBGBcalkcalpFnFmicroFaradmicrogrammgkg" + // U+3380
+		"HzkHzMHzGHzTHzmicrolitermldlklfmnmmicrometermmcmkmmm^2" + // U+3390
+		"cm^2m^2km^2mm^4cm^3m^3km^3m/sm/s^2PakPaMPaGParadrad/srad/s^2" + // U+33A0
+		"psnsmicrosecondmspVnVmicrovoltmVkVMVpWnWmicrowattmWkWMW" + // U+33B0
+		"kOhmMOhma.m.BqcccdC/kgCo.dBGyhaHPinK.K.KMkt" + // U+33C0
+		"lmlnloglxmbmilmolpHp.m.PPMPRsrSvWb[?][?]" + // U+33D0
+		"1d2d3d4d5d6d7d8d9d10d11d12d13d14d15d16d" + // U+33E0
+		"17d18d19d20d21d22d23d24d25d26d27d28d29d30d31dgal" + // U+33F0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4D00
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4D10
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4D20
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4D30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4D40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4D50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4D60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4D70
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4D80
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4D90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4DA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4DB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4DC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4DD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4DE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4DF0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A400
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A410
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A420
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A430
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A440
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A450
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A460
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A470
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A480
+		"bapaphadatathagakakhajacachadzatsatshama" + // U+A4D0
+		"nalasazhazangahaxahhafawashayaghaaae" + // U+A4E0
+		"eeuiouueuhoetipocyabonajeu[?]" + // U+A4F0
+		"eeeenheeweeweenpeebheebeembeekpeemgbeegbeefeeveeteethee" + // U+A500
+		"dheedhheeleereedeendeeseesheezeezheeceejeenjeeyeekeenggee" + // U+A510
+		"geemeeneenyeeiinhihinwiwinpibhibimbikpimgbi" + // U+A520
+		"gbifivitithidhidhhiliridindisishizizhici" + // U+A530
+		"jinjiyikinggigimininyiaannganhahanwawan" + // U+A540
+		"pabhabambakpakpanmgbagbafavatathadhadhhalara" + // U+A550
+		"dandasashazazhacajanjayakakannggagamana" + // U+A560
+		"nyaoooonhoowoowoonpoobhooboombookpoomgboogboofoovootoo" + // U+A570
+		"thoodhoodhhoolooroodoondoosooshoozoozhoocoojoonjooyookoo" + // U+A580
+		"nggoogoomoonoonyoouunhuhunwuwunpubhubumbukpu" + // U+A590
+		"mgbugbufuvututhudhudhhulurudundusushuzuzhu" + // U+A5A0
+		"cujunjuyukunggugumununyuoonngonhohonwo" + // U+A5B0
+		"wonpobhobombokpomgbogbogbonfovotothodhodhholo" + // U+A5C0
+		"rodondososhozozhocojonjoyokonggogomono" + // U+A5D0
+		"nyoeenngenhehenwewenpebhebembekpekpenmgbegbe" + // U+A5E0
+		"gbenfevetethedhedhheleredendeseshezezhece" + // U+A5F0
+		"jenjeyekenggenggengegenmenenyenglengthener" + // U+A600
+		"fakasoo[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A610
+		"0123456789mado[?][?][?][?]" + // U+A620
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A630
+		"ZemlyazemlyaDzelodzeloDzedzeIotaiotaDjervdjervUkukOmegaomegaYeryer" + // U+A640
+		"YeruyeruYatyatYuyuAaYusyusYusyusYusyusYnyn" + // U+A650
+		"TsetseDedeElelEmemOoOoOoo" + // U+A660
+		"[?]" + // U+A670
+		"DwedweDzwedzweZhwezhweCcheccheDzzedzzeTeteTwetweTswetswe" + // U+A680
+		"TssetsseTchetcheHwehweShweshweOoOo''" + // U+A690
+		"akaukueereetaeonyiilapariirieeleeeemeeee" + // U+A6A0
+		"taandaanjaemmsuumushiisisheuxseuxkyeeketnuaenunjuaeyoq" + // U+A6B0
+		"shuyuqyanshakeuxpeuxnjeenteepuewuepeefeerulumini" + // U+A6C0
+		"reuxraekenngkwaennggangashopuaefufomwanalipiloqko" + // U+A6D0
+		"mbenrenmenmatiki1234567890" + // U+A6E0
+		"[?][?][?][?][?][?][?][?]" + // U+A6F0
+		"barslashbaranglearrowarrowmarkmarkmark" + // U+A710
+		"AlefalefAinainHenghengTztzTresillotresilloCuatrillocuatrilloCuatrillocuatrillo" + // U+A720
+		"FSAaaaAoaoAuauAvavAvavAyayCc" + // U+A730
+		"KkKkKkLlLlOoOoOooo" + // U+A740
+		"PpPpPpQqQqRotundarotundaRotundarotundaVv" + // U+A750
+		"VyvyZzThornthornThornthornVendvendEtetIsisConcon" + // U+A760
+		"usdumlummumnumrumRumtumumDdFfGGg" + // U+A770
+		"LlRrSsTtaccentSaltillosaltilloHldot" + // U+A780
+		"NnCcchBbFfAeaeOeoeUeue" + // U+A790
+		"GgKkNnRrSsHEGLIQ" + // U+A7A0
+		"KTJChiBetabetaOmegaomegaUuAaIiUu" + // U+A7B0
+		"OoWwCSZDdSs[?][?][?][?][?]" + // U+A7C0
+		"Gg[?]thorn[?]wynnSsSs[?][?][?][?][?][?]" + // U+A7D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A7E0
+		"[?][?]CFQHhiHoeMfpmlongam" + // U+A7F0
+		"aiueokokhogoghocochojojho" + // U+A800
+		"ttotthoddoddhotothododhonopophobobhomorolo" + // U+A810
+		"rrosoho[?][?][?]" + // U+A820
+		"1/41/23/41/161/83/16[?][?][?][?][?][?]" + // U+A830
+		"kakhagangacachajanyatathadanapaphabama" + // U+A840
+		"tsatshadzawazhazaayaralashasahaaiu" + // U+A850
+		"eoqaxafaggaeewayattatthaddannayashaha" + // U+A860
+		"fararacandrabindu[?][?][?][?][?][?][?][?]" + // U+A870
+		"aaaiiiuuurrrllleeeaio" + // U+A880
+		"ooaukakhagaghangacachajajhanyattatthaddaddha" + // U+A890
+		"nnatathadadhanapaphababhamayaralavasha" + // U+A8A0
+		"ssasahalla" + // U+A8B0
+		"[?][?][?][?][?][?][?][?]" + // U+A8C0
+		"0123456789[?][?][?][?][?][?]" + // U+A8D0
+		"candrabinduviramaviramatwothreeavagraha[?][?]ay" + // U+A8F0
+		"0123456789kakhagangasasha" + // U+A900
+		"zanyatahtanapaphamadabarayalawathaha" + // U+A910
+		"vacaaoeioo" + // U+A920
+		"kagangatadanapabamacajanyasaralaya" + // U+A930
+		"wahambanggandanyjaa" + // U+A940
+		"[?][?][?][?][?][?][?][?][?][?][?]" + // U+A950
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A960
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+A970
+		"akawiiiiucerekleletraswadieaioka" + // U+A980
+		"kakagagangacacajanyajanyattattaddaddana" + // U+A990
+		"tatadadanapapababamayaraagunglawasa" + // U+A9A0
+		"sasaha" + // U+A9B0
+		"[?][?]" + // U+A9C0
+		"0123456789[?][?][?][?]" + // U+A9D0
+		"ghachajhannabhareduplicationnyafagaghajajhaddaddhanna" + // U+A9E0
+		"0123456789lladadhababha[?]" + // U+A9F0
+		"aiueaiokakhagaghanguengachachhajajha" + // U+AA00
+		"nhuenhanhjatathadadhanuenaddapappaphababhamue" + // U+AA10
+		"mabbayaralavassasaha" + // U+AA20
+		"[?][?][?][?][?][?][?][?][?]" + // U+AA30
+		"kgngchtnpyrlss[?][?]" + // U+AA40
+		"0123456789[?][?]" + // U+AA50
+		"gacachajajhanyattatthaddaddhadhanasahahhafa" + // U+AA60
+		"reduplicationxazaraoayqnhmrachasha" + // U+AA70
+		"kokokhokhokhhokhhogogongongococochochososo" + // U+AA80
+		"nyonyododototothothononobobopopophopho" + // U+AA90
+		"fofomomoyoyororololovovohohooo" + // U+AAA0
+		"aaeoueauaaueayan" + // U+AAB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+AAC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+AAD0
+		"eochanyattatthaddaddhannashassa" + // U+AAE0
+		"[?]mark[?][?][?][?][?][?][?][?][?][?]" + // U+AAF0
+		"[?]tthutthitthaattheetthettho[?][?]ddhuddhiddhaaddheeddheddho[?]" + // U+AB00
+		"[?]dzudzidzaadzeedzedzo[?][?][?][?][?][?][?][?][?]" + // U+AB10
+		"cchhacchhucchhicchhaacchheecchhecchho[?]bbabbubbibbaabbeebbebbo[?]" + // U+AB20
+		"alphareversedschwaeeefglllmnengooo" + // U+AB30
+		"oeoeoeopenoopenorRhandlerrrrreshubar" + // U+AB40
+		"uiuiuchichichixxxxyhengllu" + // U+AB50
+		"yateoeuoalphaOmegadigraphdigraphrw[?][?][?][?]" + // U+AB60
+		"aeiouvgakagegigogugvhahehi" + // U+AB70
+		"hohuhvlalelilolulvmamemimomunahna" + // U+AB80
+		"nahneninonunvquaquequiquoquuquvsassesi" + // U+AB90
+		"sosusvdatadeteditidodudvdlatlatletli" + // U+ABA0
+		"tlotlutlvtsatsetsitsotsutsvwawewiwowuwvya" + // U+ABB0
+		"koksamlaimitpanachiltilkhoungouthouwaiyanghukuni" + // U+ABC0
+		"phamatiyagokjhamraibajildilghoudhoubhamlonsumlonsumlonsumlonsumlonsum" + // U+ABD0
+		"lonsumlonsumlonsum[?][?]" + // U+ABE0
+		"0123456789[?][?][?][?][?][?]" + // U+ABF0
+		"fffiflffifflstst[?][?][?][?][?][?][?][?][?]" + // U+FB00
+		"[?][?][?]mnmemivnmkh[?][?][?][?][?]yiay" + // U+FB10
+		"`dhklmmt+shsshsaa" + // U+FB20
+		"bgdhvz[?]tykkl[?]l[?]" + // U+FB30
+		"nn[?]pp[?]tstsrshtvobkpl" + // U+FB40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FBB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FBC0
+		"[?][?][?]" + // U+FBD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FD40
+		"[?][?]" + // U+FD90
+		"[?][?][?][?][?][?][?][?]" + // U+FDC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FDD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FDE0
+		"[?][?][?]" + // U+FDF0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FE00
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FE10
+		"~[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FE20
+		"..---__() {} [] [()] <<>> <" + // U+FE30
+		"> [] {}[?][?][?][?]" + // U+FE40
+		",,.;:?!-(){}{}#" + // U+FE50
+		"&*+-<>=\\$%@[?][?][?][?]" + // U+FE60
+		"[?][?]" + // U+FE70
+		"[?][?]" + // U+FEF0
+		"[?]!\"#$%&'()*+,-./" + // U+FF00
+		"0123456789:;<=>?" + // U+FF10
+		"@ABCDEFGHIJKLMNO" + // U+FF20
+		"PQRSTUVWXYZ[\\]^_" + // U+FF30
+		"`abcdefghijklmno" + // U+FF40
+		"pqrstuvwxyz{|}~[?]" + // U+FF50
+		"[?].[],*woaiueoyayuyotu" + // U+FF60
+		"+aiueokakikukekosasisuseso" + // U+FF70
+		"tatitutetonaninunenohahihuhehoma" + // U+FF80
+		"mimumemoyayuyorarirurerowan:;" + // U+FF90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FFA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FFB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FFC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FFD0
+		"/CPS!-|Y=W=[?]|-|-|#O[?]" + // U+FFE0
+		"[?][?][?][?][?][?][?][?][?]{|}" + // U+FFF0
+		"aeioudadedidodujaje[?]jojuka" + // U+10000
+		"kekikokumamemimomunaneninonupape" + // U+10010
+		"pipopuqaqeqiqo[?]rarerirorusasesi" + // U+10020
+		"sosutatetitotuwawewiwo[?]zaze[?]zo" + // U+10030
+		"a2a3audwedwonwapu2ptera2ra3ro2ta2twetwo[?][?]" + // U+10040
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10050
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10060
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10070
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10080
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10090
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+100A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+100B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+100C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+100D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+100E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+100F0
+		"[?][?][?][?]123456789" + // U+10100
+		"102030405060708090100200300400500600700" + // U+10110
+		"8009001000200030004000500060007000800090001000020000300004000050000" + // U+10120
+		"60000700008000090000[?][?][?]" + // U+10130
+		"1/41/2155050050005000051050100500100050005" + // U+10140
+		"1050100500100010000500001011122225" + // U+10150
+		"10101010103050505050100300500500500500" + // U+10160
+		"500100050005501/21/22/33/4" + // U+10170
+		"01/4[?]" + // U+10180
+		"[?][?][?]" + // U+10190
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+101A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+101B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+101C0
+		"[?][?]" + // U+101F0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10200
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10210
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10220
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10230
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10240
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10250
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10260
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10270
+		"aebbhgdiwzthjkqlmn" + // U+10280
+		"mmnnupkkrstttanenhx[?][?][?]" + // U+10290
+		"ap2dluuurlda2qbmod2tshsh2" + // U+102A0
+		"sc18unnxntt2pssieuuuukk2nduu" + // U+102B0
+		"gg2stst2ngiic39ttuuu2rrmbmb2mb3mb4ld2e2" + // U+102C0
+		"uuu3[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+102D0
+		"123456789102030405060" + // U+102E0
+		"708090100200300400500600700800900[?][?][?][?]" + // U+102F0
+		"abekedeevezehetheikaelemenesho" + // U+10300
+		"peshekueresteueksphekheeferscheiiuuess" + // U+10310
+		"151050[?][?][?][?][?][?][?][?][?]yetsetse" + // U+10320
+		"ahsabairkangibadagsaihvusqairthraiujahaglthiutheiskusmalagusmannanauthsjerurus" + // U+10330
+		"pairthra90raidasauilteiwswinjafaihuiggwshwairothal900[?][?][?][?][?]" + // U+10340
+		"anburgaidoiezhoidzhoizatadzitaikokeleimenoenenoevooipeei" + // U+10350
+		"reisiitaiucheryshooishchooiyryyeruoooefhatsiuveryer" + // U+10360
+		"yeriyatieyuyaia[?][?][?][?][?]" + // U+10370
+		"alpabetagamlakhadeltahowozetahotatetyodkafshinlamdamemdhal" + // U+10380
+		"nunzusamkaainpusadeqoparashathannaghaintoiussu[?]" + // U+10390
+		"aiukakugaguxacajajitatudadidu" + // U+103A0
+		"thapabafananumamimuyavavirarulasa" + // U+103B0
+		"zashassaha[?][?][?][?]auramazdaaauramazdaa2auramazdaahaxshaayathiyadahyaaushdahyaaush2bagabuumish" + // U+103C0
+		"121020100[?][?][?][?][?][?][?][?][?][?]" + // U+103D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+103E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+103F0
+		"IEAAhOOoIEAAhOOoAyOwWuYee" + // U+10400
+		"HPeeBeeTeeDeeCheeJeeKayGayEfVeeEthTheeEsZeeEsh" + // U+10410
+		"ZheeErElEmEnEngOiEwieaahoooie" + // U+10420
+		"aahoooayowwuyeehpeebeeteedeecheejeekay" + // U+10430
+		"gayefveeeththeeeszeeeshzheeerelemenengoiew" + // U+10440
+		"peeptotkickfeethighsosurechurchyeahungbib[?]gagvowtheyzoo" + // U+10450
+		"measurejudgewoehahalollmimeifeggashadoonwooloutahroarnun" + // U+10460
+		"eatageiceupoakoozeoilaweareorairerrarrayearianyew" + // U+10470
+		"alefbatajaxakhadeelrasashiindhacayngafaqaafkaaf" + // U+10480
+		"laanmiinnuunwawhayaaeiouaaeeoo[?][?]" + // U+10490
+		"0123456789[?][?][?][?][?][?]" + // U+104A0
+		"AAiAinAhBraChaEhchaEEinHaHyaIKaEhkaKyaLa" + // U+104B0
+		"MaNaOOinPaEhpaSaShaTaEhtaTsaEhtsaTshaDhaUWa" + // U+104C0
+		"KhaGhaZaZha[?][?][?][?]aaiainahbrachaehchae" + // U+104D0
+		"einhahyaikaehkakyalamanaooinpaehpasasha" + // U+104E0
+		"taehtatsaehtsatshadhauwakhaghazazha[?][?][?][?]" + // U+104F0
+		"abecechedendedheeiefegegjeheijeke" + // U+10500
+		"lellemenenanjeopeqererreseshetetheu" + // U+10510
+		"vexeyzezhegheghammakhe[?][?][?][?][?][?][?][?]" + // U+10520
+		"altbetgimdatebzarleynzhiltaschayowdzhairbshalaninya" + // U+10530
+		"xeyndyancarjhoxkarlyitheytqayaorchoychicyaymaqqarnowcdzyay" + // U+10540
+		"shakjaynontyayfamdzaychatpengheysratseykveyztiwrshoyiwncyaw" + // U+10550
+		"caynyaydpiwrkiw[?][?][?][?][?][?][?][?][?][?][?]" + // U+10560
+		"ABbeBeCeCheDeDheEiEFeGa[?]HaHhaIIje" + // U+10570
+		"JeKaLaLlaMeNeNjeOPeQaRe[?]SeSheTeThe" + // U+10580
+		"UVeXe[?]YZe[?]abbebecechededheeie" + // U+10590
+		"fega[?]hahhaiijejekalallamenenjeope" + // U+105A0
+		"qare[?]seshetetheuvexe[?]yze[?][?][?]" + // U+105B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+105C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+105D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+105E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+105F0
+		"ab001ab002ab003ab004ab005ab006ab007ab008ab009ab010ab011ab013ab016ab017ab020ab021" + // U+10600
+		"ab021fab021mab022ab022fab022mab023ab023mab024ab026ab027ab028a028bab029ab030ab031ab034" + // U+10610
+		"ab037ab038ab039ab040ab041ab044ab045ab046ab047ab048ab049ab050ab051ab053ab054ab055" + // U+10620
+		"ab056ab057ab058ab059ab060ab061ab065ab066ab067ab069ab070ab073ab074ab076ab077ab078" + // U+10630
+		"ab079ab080ab081ab082ab085ab086ab087a100102ab118ab120a120bab122ab123ab131aab131ba131c" + // U+10640
+		"ab164ab171ab180ab188ab191a301a302a303a304a305a306a307a308a309aa309ba309c" + // U+10650
+		"a310a311a312a313aa313ba313ca314a315a316a317a318a319a320a321a322a323" + // U+10660
+		"a324a325a326a327a328a329a330a331a332a333a334a335a336a337a338a339" + // U+10670
+		"a340a341a342a343a344a345a346a347a348a349a350a351a352a353a354a355" + // U+10680
+		"a356a357a358a359a360a361a362a363a364a365a366a367a368a369a370a371" + // U+10690
+		"a400vasa401vasa402vasa403vasa404vasa405vasa406vasa407vasa408vasa409vasa410vasa411vasa412vasa413vasa414vasa415vas" + // U+106A0
+		"a416vasa417vasa418vasa501a502a503a504a505a506a508a509a510a511a512a513a515" + // U+106B0
+		"a516a520a521a523a524a525a526a527a528a529a530a531a532a534a535a536" + // U+106C0
+		"a537a538a539a540a541a542a545a547a548a549a550a551a552a553a554a555" + // U+106D0
+		"a556a557a559a563a564a565a566a568a569a570a571a572a573a574a575a576" + // U+106E0
+		"a577a578a579a580a581a582a583a584a585a586a587a588a589a591a592a594" + // U+106F0
+		"a595a596a598a600a601a602a603a604a606a608a609a610a611a612a613a614" + // U+10700
+		"a615a616a617a618a619a620a621a622a623a624a626a627a628a629a634a637" + // U+10710
+		"a638a640a642a643a644a645a646a648a649a651a652a653a654a655a656a657" + // U+10720
+		"a658a659a660a661a662a663a664[?][?][?][?][?][?][?][?][?]" + // U+10730
+		"abdefhjkll2l3l4l6wxy" + // U+10740
+		"omegaabbbbddeyyyje[?][?][?][?][?][?][?][?][?][?]" + // U+10750
+		"a800a801a802a803a804a805a806a807[?][?][?][?][?][?][?][?]" + // U+10760
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10770
+		"Aa:.aeBb[?]dzdigraphdzdZdddee" + // U+10780
+		"fNuggGhHhjlslzlLllZlezh" + // U+10790
+		"yyoOEOqrrrrRtCtsdigraphtSt" + // U+107A0
+		"v[?]Y??@||||=clicks[?][?][?][?][?]" + // U+107B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+107C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+107D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+107E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+107F0
+		"aeiouja[?][?]jo[?]kakekikokula" + // U+10800
+		"lelilolumamemimomunaneninonupape" + // U+10810
+		"pipopurarerirorusasesisosutateti" + // U+10820
+		"totuwawewiwo[?]xaxe[?][?][?]za[?][?]zo" + // U+10830
+		"alephbethgimeldalethhewawzayinhethtethyodhkaphlamedhmemnunsamekhayin" + // U+10840
+		"pesadheqophreshshintaw[?]1231020100100010000" + // U+10850
+		"alephbethgimeldalethhewawzayinhethtethyodhkaphlamedhmemnunnunsamekh" + // U+10860
+		"ayinpesadheqophreshshintaw123451020" + // U+10870
+		"alephalephbethbethgimeldalethhehewawzayinhethtethyodhyodhkaphkaph" + // U+10880
+		"lamedhlamedhmemmemnunnunsamekhayinpesadheqophreshshinshintaw[?]" + // U+10890
+		"[?][?][?][?][?][?][?]1234451020100" + // U+108A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+108B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+108C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+108D0
+		"alephbethgimeldalethreshhewawzaynhethtethyodhkaphlamedhmemnunsamekhayn" + // U+108E0
+		"pesadheqoph[?]shintaw[?][?][?][?][?]151020100" + // U+108F0
+		"alfbetgamldelthewauzaihettetyodkaflamdmemnunsemkain" + // U+10900
+		"pesadeqofroshshintau1102010023[?][?][?]" + // U+10910
+		"abgdeviyklmnorsst" + // U+10920
+		"ufqsttanenlynnc[?][?][?][?][?]" + // U+10930
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10940
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10950
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10960
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10970
+		"aeioyawababa2pamanana2nene2rara2" + // U+10980
+		"lakhahhasasa2sekaqatata2tete2toda[?][?]" + // U+10990
+		"aeioyawabapamananeralakhahhasa" + // U+109A0
+		"sasekaqatatetoda[?][?][?][?]11/121/2rmtimn" + // U+109B0
+		"12345678910203040506070" + // U+109C0
+		"[?][?]10020030040050060070080090010002000300040005000" + // U+109D0
+		"6000700080009000100002000030000400005000060000700008000090000100000200000300000" + // U+109E0
+		"4000005000006000007000008000009000001/121/61/41/35/121/27/122/33/45/6" + // U+109F0
+		"a[?][?][?][?][?][?]" + // U+10A00
+		"kakhagagha[?]cachaja[?]nyattatthaddaddhannata" + // U+10A10
+		"thadadhanapaphababhamayaralavashassasa" + // U+10A20
+		"zahakkattthatttavha[?][?][?][?][?][?]" + // U+10A30
+		"1234102010010001/2[?][?][?][?][?][?][?]" + // U+10A40
+		"[?][?][?][?][?][?][?]" + // U+10A50
+		"helamedhhethmemqophwawshinreshbethtawsatkaphnunkhethsadhesamekh" + // U+10A60
+		"fealefayndhadhegimeldalethghayntethzayndhalethyodhthawtheth150" + // U+10A70
+		"hehlamhahmeemqafwawes2rehbehtehes1kafnoonkhahsades3" + // U+10A80
+		"fehalefaindadgeemdalghaintahzainthalyehthehzah11020" + // U+10A90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10AA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10AB0
+		"alephbethbhethgimelghimeldalethhewawzayinzhayinjayinjhayinhethtethyodh" + // U+10AC0
+		"kaphxaphkhaphlamedhdhamedhthamedhmemnunsamekhayinaayinpefesadheqophxoph" + // U+10AD0
+		"qhophreshshinsshintaw[?][?][?][?]151020100" + // U+10AE0
+		"[?][?][?][?][?][?][?][?][?]" + // U+10AF0
+		"aaaaoaaoanaanaeaeeeeeoooiiiuuu" + // U+10B00
+		"kexexyexvegeggeghecejetethededhettepefe" + // U+10B10
+		"bebhengengyengvenenyennemehmeyyeyeverelese" + // U+10B20
+		"zeshezheshyesshehe[?][?][?]" + // U+10B30
+		"alephbethgimeldalethhewawzayinhethtethyodhkaphlamedhmemnunsamekhayin" + // U+10B40
+		"pesadheqophreshshintaw[?][?]123410201001000" + // U+10B50
+		"alephbethgimeldalethhewawayinreshzayinhethtethyodhkaphlamedhmemqophnunsamekhpe" + // U+10B60
+		"sadheshintaw[?][?][?][?][?]123410201001000" + // U+10B70
+		"alephbethgimeldalethhewawayinreshzayinhethyodhkaphlamedhmemqophnunsamekhpesadhe" + // U+10B80
+		"shintaw[?][?][?][?][?][?][?][?][?][?]" + // U+10B90
+		"[?][?][?][?][?][?][?][?][?]12341020100" + // U+10BA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10BB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10BC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10BD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10BE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10BF0
+		"aaaeiieooeoeababaebaebagagaeg" + // U+10C00
+		"aegadadaedezezayayaeyaeyaekaekoekoekalal" + // U+10C10
+		"aeleltemanaenaenententencencenyenyangengaengep" + // U+10C20
+		"opicececaqaqiqiqoqoqararaerasaesash" + // U+10C30
+		"ashesheshatataetaetotbash[?][?][?][?][?][?][?]" + // U+10C40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10C50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10C60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10C70
+		"AAaEbAmbEcEncEcsEdAndEEEeEfEgEgyEh" + // U+10C80
+		"IIiEjEkAkUnkElElyEmEnEnyOOoOeOeOee" + // U+10C90
+		"EpEmpErErEsEszEtEntEtyEchUUuUeUeEvEz" + // U+10CA0
+		"EzsEntshapedUs[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10CB0
+		"aaaebambecencecsedandeeeeefegegyeh" + // U+10CC0
+		"iiiejekakunkelelyemenenyooooeoeoee" + // U+10CD0
+		"epemperereseszetentetyechuuuueueevez" + // U+10CE0
+		"ezsentshapedus[?][?][?][?][?][?][?]1510501001000" + // U+10CF0
+		"abapatattajacahakhafadaddararrazasa" + // U+10D00
+		"shakagalamanawawayayanganyavaaiu" + // U+10D10
+		"eo[?][?][?][?][?][?][?][?][?][?]" + // U+10D20
+		"0123456789[?][?][?][?][?][?]" + // U+10D30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10D40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10D50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10D60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10D70
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10D80
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10D90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10DA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10DB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10DC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10DD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10DE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10DF0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10E00
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10E10
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10E20
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10E30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10E40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10E50
+		"12345678910203040506070" + // U+10E60
+		"80901002003004005006007008009001/21/41/32/3[?]" + // U+10E70
+		"elifbepephethesecimchimchhimhhaxadalzalrarhaza" + // U+10E80
+		"jasinshinsaddadtazeeynxheynfavavaqafkafkhafgaf" + // U+10E90
+		"lammimnunumwawowewhayyotet[?][?][?]" + // U+10EA0
+		"lamyot[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10EB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10EC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10ED0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10EE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10EF0
+		"alephalephbethbethgimelhehewawzayinhethyodhkaphlamedhmemnunnun" + // U+10F00
+		"nunsamekhayinayinpesadhesadhesadhereshayindalethshintawtawtaw123" + // U+10F10
+		"451020301001/2ayindaleth[?][?][?][?][?][?][?][?]" + // U+10F20
+		"alephbethgimelhewawzayinhethyodhkaphlamedhmemnunsamekhayinpesadhe" + // U+10F30
+		"reshayinshintawfethlesh[?]" + // U+10F40
+		"11020100[?][?][?][?][?][?]" + // U+10F50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10F60
+		"alephbethgimelhethwawzayinhethyodhkaphlamedhmemnunsamekhpesadhereshshin" + // U+10F70
+		"tawlesh[?][?][?][?][?][?]" + // U+10F80
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10F90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10FA0
+		"alephalephbethgimeldalethhewawwawzayinhethyodhkaphlamedhmemnunsamekh" + // U+10FB0
+		"ayinpereshshintaw12341020100[?][?][?][?]" + // U+10FC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+10FD0
+		"alephbethgimeldalethhewawzayinhethtethyodhkaphlamedhmemnunsamekhayin" + // U+10FE0
+		"pesadheqophreshshintawzayinyodh[?][?][?][?][?][?][?][?][?]" + // U+10FF0
+		"jihvamuliyaupadhmaniyaaaaiiiuuurrrllle" + // U+11000
+		"aioaukakhagaghangacachajajhanyattatthadda" + // U+11010
+		"ddhannatathadadhanapaphababhamayaralava" + // U+11020
+		"shassasahallalllarrannna" + // U+11030
+		"[?][?]" + // U+11040
+		"[?][?]1234567891020304050" + // U+11050
+		"6070809010010000123456789" + // U+11060
+		"eolla[?][?][?][?][?][?][?][?][?]" + // U+11070
+		"aaaiiiuuueaioaukakhaga" + // U+11080
+		"ghangacachajajhanyattatthaddadddhaddharhannatatha" + // U+11090
+		"dadhanapaphababhamayaralavashassasaha" + // U+110A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+110C0
+		"sahtahbahcahdahgahmahngahlahnahvahpahyahrahhahkah" + // U+110D0
+		"jahnyahaheehihuhohehmae[?][?][?][?][?][?][?]" + // U+110E0
+		"0123456789[?][?][?][?][?][?]" + // U+110F0
+		"aaiuekaakhaagaaghaangaacaachaajaajhaa" + // U+11100
+		"nyaattaatthaaddaaddhaannaataathaadaadhaanaapaaphaabaabhaamaa" + // U+11110
+		"yyaayaaraalaawaasaahaa" + // U+11120
+		"[?]0123456789" + // U+11130
+		"lhaavaa[?][?][?][?][?][?][?][?]" + // U+11140
+		"aiueokakhagaghacachajajhanyattattha" + // U+11150
+		"ddaddhannatathadadhanapaphababhamaralava" + // U+11160
+		"saharrashri[?][?][?][?][?][?][?][?][?]" + // U+11170
+		"aaaiiiuuurrrllleaio" + // U+11180
+		"aukakhagaghangacachajajhanyattatthaddaddhanna" + // U+11190
+		"tathadadhanapaphababhamayaralallavasha" + // U+111A0
+		"ssasaha" + // U+111B0
+		"avagrahajihvamuliyaupadhmaniya[?]" + // U+111C0
+		"0123456789[?][?]" + // U+111D0
+		"[?]123456789102030405060" + // U+111E0
+		"7080901001000[?][?][?][?][?][?][?][?][?][?][?]" + // U+111F0
+		"aaaiueaioaukakhagaggaghangacacha" + // U+11200
+		"jajja[?]nyattatthaddaddhannatathadadddadhanapa" + // U+11210
+		"phababbabhamayaralavasahalla" + // U+11220
+		"qa" + // U+11230
+		"i[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11240
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11250
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11260
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11270
+		"aiuekakhaga[?]gha[?]cachajajja[?]nya" + // U+11280
+		"ttatthaddadddaddhannatathadadhanapaphaba[?]bha" + // U+11290
+		"mayaralavasaharrarha[?][?][?][?][?][?]" + // U+112A0
+		"aaaiiiuuueaioaukakhagaggaghanga" + // U+112B0
+		"cachajajjajhanyattatthaddadddarraddhannatathada" + // U+112C0
+		"dhanapaphababbabhamayaralavashasaha" + // U+112D0
+		"[?][?][?][?][?]" + // U+112E0
+		"0123456789[?][?][?][?][?][?]" + // U+112F0
+		"[?]aaaiiiuuurl[?][?]ee" + // U+11300
+		"ai[?][?]ooaukakhagaghangacachajajhanyatta" + // U+11310
+		"tthaddaddhannatathadadhana[?]paphababhamaya" + // U+11320
+		"ra[?]lalla[?]vashassasaha[?]avagraha" + // U+11330
+		"[?][?][?][?][?][?]" + // U+11340
+		"[?][?][?][?][?][?][?][?][?][?][?][?]plutaanusvaraanusvara" + // U+11350
+		"rrll[?][?][?][?][?]" + // U+11360
+		"[?][?][?][?][?][?][?][?][?][?][?]" + // U+11370
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11380
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11390
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+113A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+113B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+113C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+113D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+113E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+113F0
+		"aaaiiiuuurrrllleaioaukakha" + // U+11400
+		"gaghanganghacachajajhanyanyhattatthaddaddhannata" + // U+11410
+		"thadadhananhapaphababhamamhayararhalalha" + // U+11420
+		"washassasaha" + // U+11430
+		"avagrahaanusvara[?][?]" + // U+11440
+		"0123456789[?]anusvara" + // U+11450
+		"jihvamuliyaupadhmaniya[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11460
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11470
+		"[?]aaaiiiuuurrrllleaioauka" + // U+11480
+		"khagaghangacachajajhanyattatthaddaddhannatatha" + // U+11490
+		"dadhanapaphababhamayaralavashassasaha" + // U+114A0
+		"avagraha[?][?][?][?][?][?][?][?][?][?]" + // U+114C0
+		"0123456789[?][?][?][?][?][?]" + // U+114D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+114E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+114F0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11500
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11510
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11520
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11530
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11540
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11550
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11560
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11570
+		"aaaiiiuuurrrllleaioaukakha" + // U+11580
+		"gaghangacachajajhanyattatthaddaddhannatathada" + // U+11590
+		"dhanapaphababhamayaralavashassasaha" + // U+115A0
+		"[?][?]" + // U+115B0
+		"iiiiu[?][?]" + // U+115D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+115E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+115F0
+		"aaaiiiuuurrrllleaioaukakha" + // U+11600
+		"gaghangacachajajhanyattatthaddaddhannatathada" + // U+11610
+		"dhanapaphababhamayaralavashassasahalla" + // U+11620
+		"huva[?][?][?][?][?][?][?][?][?][?][?]" + // U+11640
+		"0123456789[?][?][?][?][?][?]" + // U+11650
+		"[?][?][?]" + // U+11660
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11670
+		"aaaiiiuuueaioaukakhagaghangaca" + // U+11680
+		"chajajhanyattatthaddaddhannatathadadhanapapha" + // U+11690
+		"babhamayaralavashasaharra" + // U+116A0
+		"kha[?][?][?][?][?][?]" + // U+116B0
+		"0123456789[?][?][?][?][?][?]" + // U+116C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+116D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+116E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+116F0
+		"kakhanganatatapaphabamajachatharalasa" + // U+11700
+		"nyahaadadhagagaghabhajhaba[?][?]" + // U+11710
+		"[?][?][?][?]" + // U+11720
+		"01234567891020" + // U+11730
+		"cattatthaddaddhannalla[?][?][?][?][?][?][?][?][?]" + // U+11740
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11750
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11760
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11770
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11780
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11790
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+117A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+117B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+117C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+117D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+117E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+117F0
+		"aaaiiiuuueaioaukakhagaghangaca" + // U+11800
+		"chajajhanyattatthaddaddhannatathadadhanapapha" + // U+11810
+		"babhamayaralavashassasaharra" + // U+11820
+		"[?][?][?][?]" + // U+11830
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11840
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11850
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11860
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11870
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11880
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11890
+		"NgaaAWiYuYaYoIiUuEOAngGaKoEnyYujUc" + // U+118A0
+		"EnnOddTteNungDaAtAmBuPuHiyoHoloHorrHarSsuuSiiViyo" + // U+118B0
+		"ngaaawiyuyayoiiuueoanggakoenyyujuc" + // U+118C0
+		"ennoddttenungdaatambupuhiyoholohorrharssuusiiviyo" + // U+118D0
+		"0123456789102030405060" + // U+118E0
+		"708090[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+118F0
+		"aaaiiiuuue[?][?]o[?][?]kakhagagha" + // U+11900
+		"ngacachaja[?]nyatta[?]ddaddhannatathadadhana" + // U+11910
+		"paphababhamayayyaralavashassasahallaza" + // U+11920
+		"[?][?][?][?]" + // U+11930
+		"[?][?][?][?][?][?][?][?][?][?]" + // U+11940
+		"0123456789[?][?][?][?][?][?]" + // U+11950
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11960
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11970
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11980
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11990
+		"aaaiiiuuurrr[?][?]eaioaukakha" + // U+119A0
+		"gaghangacachajajhanyattatthaddaddhannatathada" + // U+119B0
+		"dhanapaphababhamayaralavashassasahalla" + // U+119C0
+		"rra[?][?]" + // U+119D0
+		"avagraha[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+119E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+119F0
+		"akakhagaghanga" + // U+11A00
+		"cachajanyattatthaddaddhannatathadadhanapapha" + // U+11A10
+		"babhamatsatshadzadzhazhazaayaralavashassa" + // U+11A20
+		"sahakssara" + // U+11A30
+		"[?][?][?][?][?][?][?][?]" + // U+11A40
+		"akakhagagha" + // U+11A50
+		"ngacachajajhanyattatthaddaddhannatathadadhana" + // U+11A60
+		"paphababhamatsatshadzazhazaayaralavasha" + // U+11A70
+		"ssasahakssajihvamuliyaupadhmaniyaralashasa" + // U+11A80
+		"[?]" + // U+11A90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11AA0
+		"hihiihohoohahaashrishriishroshrooshrashraaspespispospa" + // U+11AB0
+		"pakalamadazavangahagakhasabacatatha" + // U+11AC0
+		"napharafachaaeiouuaiapktm" + // U+11AD0
+		"nlwngy[?][?][?][?][?][?][?][?][?][?][?]" + // U+11AE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11AF0
+		"aaaiiiuuurrrl[?]eaioaukakha" + // U+11C00
+		"gaghangacachajajhanyattatthaddaddhannatathada" + // U+11C10
+		"dhanapaphababhamayaralavashassasaha" + // U+11C20
+		"[?]" + // U+11C30
+		"avagraha[?][?][?][?][?][?][?][?][?][?]" + // U+11C40
+		"0123456789123456" + // U+11C50
+		"789102030405060708090100[?][?][?]" + // U+11C60
+		"kakhagangacachajanyatathadanapapha" + // U+11C70
+		"bamatsatshadzawazhazaayaralashasahaa" + // U+11C80
+		"[?][?]" + // U+11C90
+		"[?]" + // U+11CA0
+		"[?][?][?][?][?][?][?][?][?]" + // U+11CB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11CC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11CD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11CE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11CF0
+		"aaaiiiuuue[?]aio[?]aukakhagagha" + // U+11D00
+		"ngacachajajhanyattatthaddaddhannatathadadhana" + // U+11D10
+		"paphababhamayaralavashassasahallakssajnya" + // U+11D20
+		"tra[?][?][?][?][?]" + // U+11D30
+		"[?][?][?][?][?][?][?][?][?]" + // U+11D40
+		"0123456789[?][?][?][?][?][?]" + // U+11D50
+		"aaaiiiuuu[?]eeai[?]ooauyavababha" + // U+11D60
+		"makakhatathalagaghadadhanacachattatthalla" + // U+11D70
+		"jajhaddaddhangapaphaharasa[?]" + // U+11D80
+		"[?][?][?][?][?][?][?][?][?]" + // U+11D90
+		"0123456789[?][?][?][?][?][?]" + // U+11DA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11DB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11DC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11DD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11DE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11DF0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11E00
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11E10
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11E20
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11E30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11E40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11E50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11E60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11E70
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11E80
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11E90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11EA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11EB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11EC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11ED0
+		"kagangapabamatadanacajanyayaralava" + // U+11EE0
+		"saa[?][?][?][?][?][?][?][?]" + // U+11EF0
+		"rephaaaaiiiuuurrrllleai" + // U+11F00
+		"o[?]kakhagaghangacachajajhanyattatthaddaddha" + // U+11F10
+		"nnatathadadhanapaphababhamayaralawasha" + // U+11F20
+		"ssasahajnya[?][?][?]" + // U+11F30
+		"0123456789[?][?][?][?][?][?]" + // U+11F50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11F60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11F70
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11F80
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11F90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11FA0
+		"yha[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11FB0
+		"1/3201/1601/801/641/401/323/803/641/201/161/161/101/83/203/161/5" + // U+11FC0
+		"1/41/21/23/41/320" + // U+11FD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+11FF0
+		"aabadtenuhaigigunumushsaga2abash2gunugaltenuha" + // U+12000
+		"gunuiminlagabsheshugunuab2balagtenuensha3tak4adakerin2gish" + // U+12010
+		"alaldim2gishhakad3kisheushalanalephamarsheanantimes" + // U+12020
+		"nagasquaredansheapinaradkurarkabasal2ashtenutenupapashashash2ashgab" + // U+12030
+		"babadbag3bahar2balbalbalagbarbara2biagargunubuabun" + // U+12040
+		"bubulugbulugburbur2dadagmashamarbalagbigamashgigir2gud" + // U+12050
+		"hairlukaklalumash2lumnepapsitak4gir2ushdamdar" + // U+12060
+		"dara3dara4didibdimshedim2dindishdishdudugunusheshigdubesh2" + // U+12070
+		"dub2dugdugudduhdundun3gunugunudun4dur2epapnune2dagar" + // U+12080
+		"misalsheuedinegirelengan2tenumeenensquarederenerin2" + // U+12090
+		"esh2ezenalallalanbadgunugunuhagunugunukaskalsquaredku3la" + // U+120A0
+		"lalliluu2udgagunuga2hahaigitabanashgalbad" + // U+120B0
+		"raburradadishedubellaentenutenugargigi4a" + // U+120C0
+		"suesh2hallalihub2gunuashkakkaskalkidlalanlaenmi" + // U+120D0
+		"nunnunpasalsarsheturshidsumtak4uudduga2gabagaba" + // U+120E0
+		"gadgargalgargalamgamgangan2tenugan2gan2gargar3gashangeshtinkur" + // U+120F0
+		"gieugigi4gi4gi4gidimgir2gunugir3igitenuigiigipa" + // U+12100
+		"gisalgishgishbadtak4tenugugugu2kakgunununtug2gunugudkur" + // U+12110
+		"kurlugalgulgumshegurgur7gurungurushhatenugunuhalhiashash2" + // U+12120
+		"baddishgadkinnunsheuhuhub2anhalkaskallishudhul2i" + // U+12130
+		"aibidimbursquaredigigidibriudgunuiltenuil2imtak4" + // U+12140
+		"imimsquaredimininirishkaaadku3ash2badbalagbarbi" + // U+12150
+		"erin2esh2gagaltenugaragigir2sargishgugur7igiimkak" + // U+12160
+		"kikidlilumedugiteminunuznenunpirusasar" + // U+12170
+		"shasheshidshusigsuhurtaruu2udpaushzika2ka2kab" + // U+12180
+		"kad2kad3kad4kad5kad5kakgunukalbadkalkam2kam4kaskaluukesh2" + // U+12190
+		"kibaduudkidkinkisalkishkisim5kisim5kuash2ku3ku4ku4ku7" + // U+121A0
+		"kulgunukunkurkurkushu2kwu318lalagabahagarlalalantenu" + // U+121B0
+		"badbidarengagargudgudhahalnungunuimhaluki" + // U+121C0
+		"kinku3kulalagablishlululmeenmushnesumerin2tenushu2" + // U+121D0
+		"shu2sumtagtak4nauauashudushsquaredlagarshesumgunu" + // U+121E0
+		"shelahshulallallamkurrulilillimmu2lishlubadlu2albad" + // U+121F0
+		"esh2tenutenubadimkad2kad3ashkiashlagabennenuashbu" + // U+12200
+		"tug2tenulu2lu2squaredsheshiglu3lugallugallugalsheshigluhlullumlumgar" + // U+12210
+		"matak4gunuma2mahmarmashmash2memesmiminmumumuggunu" + // U+12220
+		"munsubmurgu2mushakurzamushnamushmush3adidigununana2" + // U+12230
+		"nagainvertedtenunaganagarnutillunamnam2neaudsheshignieni2nim" + // U+12240
+		"tenutenuninda2anashashgudtenunenunsheanashashashush" + // U+12250
+		"nisagnunu11nungarmashsalsalushtenununnunlagarnunuzashgabbi" + // U+12260
+		"duggudgunukad3lanesila3u2biupapadpanpappesh2pi" + // U+12270
+		"aabbibueiibuu2pipirigkaludzapirigra" + // U+12280
+		"rabrirusanutillusagadudubhakakkurlumminunsal" + // U+12290
+		"shidtabu2ubumurushsaggunusalash2sanga2sarshasha3a" + // U+122A0
+		"badgishneshu2turuasha6shab6shar2shehugargarsheg9shen" + // U+122B0
+		"sheshshesh2sheshlamshidaimshimabalbulugdingarigigunukushu2lul" + // U+122C0
+		"mugsalshinigshirtenuburshitashushushu2shubursigunusigsig4shu2" + // U+122D0
+		"sik2sila3sususudsud2suhursumsumashsursur9taasteriskhimigunu" + // U+122E0
+		"tabdishsquaredtagbigudsheshutug2udtak4tartegunutitenu" + // U+122F0
+		"tiltirtak4tirgartutug2tuktumturzaugudugarsur" + // U+12300
+		"uu2ubudkushu2badmiugunugunusheshigbadudugumlagabda" + // U+12310
+		"sha3uumbinumumkaskalpaungunuurursheshigur2hanaalha" + // U+12320
+		"nunu2ashbiur4uriuri3uruaashgabbardungagaltenugar" + // U+12330
+		"guhaigiimishkilumminpashesig4tugududurudauruda" + // U+12340
+		"uushakukurtak4ushxush2ushumxutukiuz3kaskaluzuzatenukur" + // U+12350
+		"zagzamxze2zizizi3zibtenuzigziz2zuzu5azuburzumelamite" + // U+12360
+		"nunakugmashdag3shushegudsheaash2ge22gighushansheash3" + // U+12370
+		"gishgudash2lumpashultuur2gibadlalshumeshzana4nin" + // U+12380
+		"nin9balgidegreesasteriskpir2gunuti2meu[?][?][?][?][?][?]" + // U+12390
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+123A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+123B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+123C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+123D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+123E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+123F0
+		"2345678934567894" + // U+12400
+		"5678912345678912" + // U+12410
+		"3452334567891233" + // U+12420
+		"45216000432000123345334444" + // U+12430
+		"6777889999234561" + // U+12440
+		"23445523121/32/35/61/32/31/8" + // U+12450
+		"1/41/61/41/41/21/32/34050456789[?]" + // U+12460
+		"[?][?][?][?][?][?][?][?][?][?][?]" + // U+12470
+		"tenushu2esh2tenuab2nizana2tak4kurigiuudansheashat" + // U+12480
+		"dindunerin2gagigunugishhahigunukaskalkurkushu2kaskallak020lam" + // U+12490
+		"kurgishmashmesminipishegunukurpapxesh2ashhigunu" + // U+124A0
+		"lalalmemessusubahar2gunugunukaskalerengadineakaskal" + // U+124B0
+		"lamkurluhmushnee2gishimziz2degreesugishgunutak4gunuash" + // U+124C0
+		"bukauurzulak003lak021lak025lak030lak050lak051lak062gunulak080lak081lak092" + // U+124D0
+		"lak130lak142lak210lak219lak220lak225lak228lak238lak265lak266lak343lak347lak348lak383lak384lak390" + // U+124E0
+		"lak441lak449guigilu3lu3balak450lak457lak470lak483lak490lak492lak493lak495lak550" + // U+124F0
+		"lak608lak617ashbadgunuku3latarteu2udurudalak636lak648dubga" + // U+12500
+		"igigununilu3kiudurudalak724lak749ashdishhalpaplu3tak4za7" + // U+12510
+		"gakakgunugishgulhikesh2lak050mashpapuuurudahaenat" + // U+12520
+		"tak4tenushehub2nam2sarniantak4sagtenudishinverteduddara3lak668" + // U+12530
+		"lu3za7sartenu[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12540
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12550
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12560
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12570
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12580
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12590
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+125A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+125B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+125C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+125D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+125E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+125F0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12F00
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12F10
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12F20
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12F30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12F40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12F50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12F60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12F70
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12F80
+		"cm001cm002cm004cm005cm006cm007cm008cm009cm010cm011cm012cm012bcm013cm015cm017cm019" + // U+12F90
+		"cm021cm023cm024cm025cm026cm027cm028cm029cm030cm033cm034cm035cm036cm037cm038cm039" + // U+12FA0
+		"cm040cm041cm044cm046cm047cm049cm050cm051cm052cm053cm054cm055cm056cm058cm059cm060" + // U+12FB0
+		"cm061cm062cm063cm064cm066cm067cm068cm069cm070cm071cm072cm073cm074cm075cm075bcm076" + // U+12FC0
+		"cm078cm079cm080cm081cm082cm083cm084cm085cm086cm087cm088cm089cm090cm091cm092cm094" + // U+12FD0
+		"cm095cm096cm097cm098cm099cm100cm101cm102cm103cm104cm105cm107cm108cm109cm110cm112" + // U+12FE0
+		"cm114[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+12FF0
+		"a001a002a003a004a005a005aa006a006aa006ba007a008a009a010a011a012a013" + // U+13000
+		"a014a014aa015a016a017a017aa018a019a020a021a022a023a024a025a026a027" + // U+13010
+		"a028a029a030a031a032a032aa033a034a035a036a037a038a039a040a040aa041" + // U+13020
+		"a042a042aa043a043aa044a045a045aa046a047a048a049a050a051a052a053a054" + // U+13030
+		"a055a056a057a058a059a060a061a062a063a064a065a066a067a068a069a070" + // U+13040
+		"b001b002b003b004b005b005ab006b007b008b009c001c002c002ac002bc002cc003" + // U+13050
+		"c004c005c006c007c008c009c010c010ac011c012c013c014c015c016c017c018" + // U+13060
+		"c019c020c021c022c023c024d001d002d003d004d005d006d007d008d008ad009" + // U+13070
+		"d010d011d012d013d014d015d016d017d018d019d020d021d022d023d024d025" + // U+13080
+		"d026d027d027ad028d029d030d031d031ad032d033d034d034ad035d036d037d038" + // U+13090
+		"d039d040d041d042d043d044d045d046d046ad047d048d048ad049d050d050ad050b" + // U+130A0
+		"d050cd050dd050ed050fd050gd050hd050id051d052d052ad053d054d054ad055d056d057" + // U+130B0
+		"d058d059d060d061d062d063d064d065d066d067d067ad067bd067cd067dd067ed067f" + // U+130C0
+		"d067gd067he001e002e003e004e005e006e007e008e008ae009e009ae010e011e012" + // U+130D0
+		"e013e014e015e016e016ae017e017ae018e019e020e020ae021e022e023e024e025" + // U+130E0
+		"e026e027e028e028ae029e030e031e032e033e034e034ae036e037e038f001f001a" + // U+130F0
+		"f002f003f004f005f006f007f008f009f010f011f012f013f013af014f015f016" + // U+13100
+		"f017f018f019f020f021f021af022f023f024f025f026f027f028f029f030f031" + // U+13110
+		"f031af032f033f034f035f036f037f037af038f038af039f040f041f042f043f044" + // U+13120
+		"f045f045af046f046af047f047af048f049f050f051f051af051bf051cf052f053g001" + // U+13130
+		"g002g003g004g005g006g006ag007g007ag007bg008g009g010g011g011ag012g013" + // U+13140
+		"g014g015g016g017g018g019g020g020ag021g022g023g024g025g026g026ag027" + // U+13150
+		"g028g029g030g031g032g033g034g035g036g036ag037g037ag038g039g040g041" + // U+13160
+		"g042g043g043ag044g045g045ag046g047g048g049g050g051g052g053g054h001" + // U+13170
+		"h002h003h004h005h006h006ah007h008i001i002i003i004i005i005ai006i007" + // U+13180
+		"i008i009i009ai010i010ai011i011ai012i013i014i015k001k002k003k004k005" + // U+13190
+		"k006k007k008l001l002l002al003l004l005l006l006al007l008m001m001am001b" + // U+131A0
+		"m002m003m003am004m005m006m007m008m009m010m010am011m012m012am012bm012c" + // U+131B0
+		"m012dm012em012fm012gm012hm013m014m015m015am016m016am017m017am018m019m020" + // U+131C0
+		"m021m022m022am023m024m024am025m026m027m028m028am029m030m031m031am032" + // U+131D0
+		"m033m033am033bm034m035m036m037m038m039m040m040am041m042m043m044n001" + // U+131E0
+		"n002n003n004n005n006n007n008n009n010n011n012n013n014n015n016n017" + // U+131F0
+		"n018n018an018bn019n020n021n022n023n024n025n025an026n027n028n029n030" + // U+13200
+		"n031n032n033n033an034n034an035n035an036n037n037an038n039n040n041n042" + // U+13210
+		"nl001nl002nl003nl004nl005nl005anl006nl007nl008nl009nl010nl011nl012nl013nl014nl015" + // U+13220
+		"nl016nl017nl017anl018nl019nl020nu001nu002nu003nu004nu005nu006nu007nu008nu009nu010" + // U+13230
+		"nu010anu011nu011anu012nu013nu014nu015nu016nu017nu018nu018anu019nu020nu021nu022nu022a" + // U+13240
+		"o001o001ao002o003o004o005o005ao006o006ao006bo006co006do006eo006fo007o008" + // U+13250
+		"o009o010o010ao010bo010co011o012o013o014o015o016o017o018o019o019ao020" + // U+13260
+		"o020ao021o022o023o024o024ao025o025ao026o027o028o029o029ao030o030ao031" + // U+13270
+		"o032o033o033ao034o035o036o036ao036bo036co036do037o038o039o040o041o042" + // U+13280
+		"o043o044o045o046o047o048o049o050o050ao050bo051p001p001ap002p003p003a" + // U+13290
+		"p004p005p006p007p008p009p010p011q001q002q003q004q005q006q007r001" + // U+132A0
+		"r002r002ar003r003ar003br004r005r006r007r008r009r010r010ar011r012r013" + // U+132B0
+		"r014r015r016r016ar017r018r019r020r021r022r023r024r025r026r027r028" + // U+132C0
+		"r029s001s002s002as003s004s005s006s006as007s008s009s010s011s012s013" + // U+132D0
+		"s014s014as014bs015s016s017s017as018s019s020s021s022s023s024s025s026" + // U+132E0
+		"s026as026bs027s028s029s030s031s032s033s034s035s035as036s037s038s039" + // U+132F0
+		"s040s041s042s043s044s045s046t001t002t003t003at004t005t006t007t007a" + // U+13300
+		"t008t008at009t009at010t011t011at012t013t014t015t016t016at017t018t019" + // U+13310
+		"t020t021t022t023t024t025t026t027t028t029t030t031t032t032at033t033a" + // U+13320
+		"t034t035t036u001u002u003u004u005u006u006au006bu007u008u009u010u011" + // U+13330
+		"u012u013u014u015u016u017u018u019u020u021u022u023u023au024u025u026" + // U+13340
+		"u027u028u029u029au030u031u032u032au033u034u035u036u037u038u039u040" + // U+13350
+		"u041u042v001v001av001bv001cv001dv001ev001fv001gv001hv001iv002v002av003v004" + // U+13360
+		"v005v006v007v007av007bv008v009v010v011v011av011bv011cv012v012av012bv013" + // U+13370
+		"v014v015v016v017v018v019v020v020av020bv020cv020dv020ev020fv020gv020hv020i" + // U+13380
+		"v020jv020kv020lv021v022v023v023av024v025v026v027v028v028av029v029av030" + // U+13390
+		"v030av031v031av032v033v033av034v035v036v037v037av038v039v040v040aw001" + // U+133A0
+		"w002w003w003aw004w005w006w007w008w009w009aw010w010aw011w012w013w014" + // U+133B0
+		"w014aw015w016w017w017aw018w018aw019w020w021w022w023w024w024aw025x001" + // U+133C0
+		"x002x003x004x004ax004bx005x006x006ax007x008x008ay001y001ay002y003y004" + // U+133D0
+		"y005y006y007y008z001z002z002az002bz002cz002dz003z003az003bz004z004az005" + // U+133E0
+		"z005az006z007z008z009z010z011z012z013z014z015z015az015bz015cz015dz015e" + // U+133F0
+		"z015fz015gz015hz015iz016z016az016bz016cz016dz016ez016fz016gz016haa001aa002aa003" + // U+13400
+		"aa004aa005aa006aa007aa007aaa007baa008aa009aa010aa011aa012aa013aa014aa015aa016aa017" + // U+13410
+		"aa018aa019aa020aa021aa022aa023aa024aa025aa026aa027aa028aa029aa030aa031aa032v011d" + // U+13420
+		"blankblanklostlostlostlost" + // U+13440
+		"[?][?][?][?][?][?][?][?][?][?]" + // U+13450
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+13460
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+13470
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+13480
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+13490
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+134A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+134B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+134C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+134D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+134E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+134F0
+		"a001a002a003a004a005a006a007a008a009a010a010aa011a012a013a014a015" + // U+14400
+		"a016a017a018a019a020a021a022a023a024a025a026a026aa027a028a029a030" + // U+14410
+		"a031a032a033a034a035a036a037a038a039a039aa040a041a041aa042a043a044" + // U+14420
+		"a045a045aa046a046aa046ba047a048a049a050a051a052a053a054a055a056a057" + // U+14430
+		"a058a059a060a061a062a063a064a065a066a066aa066ba066ca067a068a069a070" + // U+14440
+		"a071a072a073a074a075a076a077a078a079a080a081a082a083a084a085a086" + // U+14450
+		"a087a088a089a090a091a092a093a094a095a096a097a097aa098a098aa099a100" + // U+14460
+		"a100aa101a101aa102a102aa103a104a104aa104ba104ca105a105aa105ba106a107a107a" + // U+14470
+		"a107ba107ca108a109a110a110aa110ba111a112a113a114a115a115aa116a117a118" + // U+14480
+		"a119a120a121a122a123a124a125a125aa126a127a128a129a130a131a132a133" + // U+14490
+		"a134a135a135aa136a137a138a139a140a141a142a143a144a145a146a147a148" + // U+144A0
+		"a149a150a151a152a153a154a155a156a157a158a159a160a161a162a163a164" + // U+144B0
+		"a165a166a167a168a169a170a171a172a173a174a175a176a177a178a179a180" + // U+144C0
+		"a181a182a183a184a185a186a187a188a189a190a191a192a193a194a195a196" + // U+144D0
+		"a197a198a199a200a201a202a202aa202ba203a204a205a206a207a207aa208a209" + // U+144E0
+		"a209aa210a211a212a213a214a215a215aa216a216aa217a218a219a220a221a222" + // U+144F0
+		"a223a224a225a226a227a227aa228a229a230a231a232a233a234a235a236a237" + // U+14500
+		"a238a239a240a241a242a243a244a245a246a247a248a249a250a251a252a253" + // U+14510
+		"a254a255a256a257a258a259a260a261a262a263a264a265a266a267a267aa268" + // U+14520
+		"a269a270a271a272a273a274a275a276a277a278a279a280a281a282a283a284" + // U+14530
+		"a285a286a287a288a289a289aa290a291a292a293a294a294aa295a296a297a298" + // U+14540
+		"a299a299aa300a301a302a303a304a305a306a307a308a309a309aa310a311a312" + // U+14550
+		"a313a314a315a316a317a318a319a320a321a322a323a324a325a326a327a328" + // U+14560
+		"a329a329aa330a331a332aa332ba332ca333a334a335a336a336aa336ba336ca337a338" + // U+14570
+		"a339a340a341a342a343a344a345a346a347a348a349a350a351a352a353a354" + // U+14580
+		"a355a356a357a358a359a359aa360a361a362a363a364a364aa365a366a367a368" + // U+14590
+		"a368aa369a370a371a371aa372a373a374a375a376a377a378a379a380a381a381a" + // U+145A0
+		"a382ria383aa384a385a386a386aa387a388a389a390a391a392eighta394a395" + // U+145B0
+		"a396a397a398a399a400a401a402a403a404a405a406a407a408a409markmark" + // U+145C0
+		"a411a412a413a414a415a416a417a418a419a420a421a422a423a424a425a426" + // U+145D0
+		"a427a428a429a430a431a432a433a434a435a436a437a438a439a440a441a442" + // U+145E0
+		"a443a444a445a446a447a448a449a450a450aa451a452a453a454a455a456a457" + // U+145F0
+		"a457aa458a459a460a461a462a463a464a465a466a467a468a469a470a471a472" + // U+14600
+		"a473a474a475a476a477a478a479a480a481a482a483a484a485a486a487a488" + // U+14610
+		"a489a490a491a492a493a494a495a496a497a501a502a503a504a505a506a507" + // U+14620
+		"a508a509a510a511a512a513a514a515a516a517a518a519a520a521a522a523" + // U+14630
+		"a524a525a526a527a528a529a530[?][?][?][?][?][?][?][?][?]" + // U+14640
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+14650
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+14660
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+14670
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+14680
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+14690
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+146A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+146B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+146C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+146D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+146E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+146F0
+		"mfonfonpipaemgbieepipaembamfonshuenshuetmfonmfonnjipipaemgbieepipaembamaembgbieemaembangangumaemveuxmansuae" + // U+16800
+		"mveuaengamseunyamntoqpenkeukeutndankindisuungkuenzeumlapaqkutmfaamaekeuppashaegheuaeraepamshaenggeuaetmeut" + // U+16810
+		"naegheuaegheuaentaasisamgbasameunjomndeuqmoompuqkafaleeraewaleeraewapetmaemkpennikapuptuaepluaep" + // U+16820
+		"sonjamteuteuwenmaenyiketndaanggeuaetkuoqmoomeutshumlommaefiriromkpoqsoqpieetshiraentap" + // U+16830
+		"yummongkeuaeqpaaraenkaaraeunknownnggenmaesinjammbanyinyetteuaensotpaamnshieemaemnyi" + // U+16840
+		"kaqnshaveelunennaqmbaqnshuetmaemgbieesieetunteummaeleekieemyeuraembaarae" + // U+16850
+		"kampeeshileeraewanyamsheuoqnjaagheugheuaempitnsieenjaqsheuaeqtuteuaeqmbaaketnteumkeupuqgheughen" + // U+16860
+		"keuyeuxlaanaeparumveummvopmbuwuaetsakeuaetaammeuqngguoqlargemfiyaqsuembeurimontieen" + // U+16870
+		"nyaemaepungaamnggeetfeuxmbuoqfeekeuaemnjeuaenanjuqaletnggaamnsenmakiqngommaemba" + // U+16880
+		"nzayumwangkuoqnggenndeuaereengkaqgharaembeekeetgbayimeunmbitmbeumpirieenndombucabbagetreekeusheuaep" + // U+16890
+		"ghapkeukaqmuomaenzeummbuenseuaenmbityeuqkparaqkaaseuxndidataashaenjueqyuesuaet" + // U+168A0
+		"nyamveuxnansanaqkeuaerintaangguonlapmbirieenmgbasaqnteungbateuteuxnggumfuendeutnsanshaq" + // U+168B0
+		"bungveuaepenmberaerunjaemlamtituaepngomnjeeeeketnggumaesimbuaemlukutnjam" + // U+168C0
+		"ngomwupnggueetnsomntennkaaraensunndamnsieeyaandapshueqsetfonmbimaembambanyi" + // U+168D0
+		"keuseuxmbeuxkeumpicketyuwoqnjeuxmieemuaeshiqlawfatiguengaqnaqliqpinpen" + // U+168E0
+		"tetmbuowapnjimfonnjieelieenjeutnsheenggaamaenyamwuaenngkunsheengkapkeuaetmeun" + // U+168F0
+		"teutsheuaenjapsueketyaemmaekuomsapmfeutndeuxmaleerimeutseuaeqyennjeuaemmbuae" + // U+16900
+		"ngkeuritughaangkyeefeufeuaetndeemgbofumleuaepndonmonimgbeunpuutmgbieemfolumnsieep" + // U+16910
+		"mbaakwaetnyetteuaensotyuwoqkeumraemteeeengkeuaeqmfeuaensieetkeuppippeutaenyue" + // U+16920
+		"letnggaammfieenggwaenyuompapyuopndamnteumsuaekunnggeuxngkieetuotmeunkuq" + // U+16930
+		"nsumteunmaenjetnggapleumngguomnshutnjueqgheuaekurentaetoqnyiriileeee" + // U+16940
+		"meeeemsuumushiisheuxkyeenushunteepeenishoqpuqmvoploq" + // U+16950
+		"muchtintuusevensaqfaandaptoonmbeumlapvomloonpaasomraqnshuop" + // U+16960
+		"ndunpuaetamngkakpeuxwuoseenggeuaetpaamtookuoplomnshieengopmaemngkeux" + // U+16970
+		"ngoqnshuerimgbanjeuxpeemsaangguraemgbagheuxngkeuaemnjaemlimaplootnggeeeendiqnteum" + // U+16980
+		"setpumsoftnessnyamyieegheuntuaeyeuaepotumaekeuaesuaenteuaeqveuaeweuxlaam" + // U+16990
+		"putaaqghaamaengeureutsheuaeqmgbenmbeenzaqnkomgbettumkuetyapcleaveryitmfeuq" + // U+169A0
+		"ndiaqpieeqyueqleuaemfuegbeuxngkupketmaengkaamighetfantumpeutyeumnggeuae" + // U+169B0
+		"betweennzuqpoonmieefuetnaemuaegheuaeimvipuaqngkumkutpietntapyeuaet" + // U+169C0
+		"ngguppeoplecallfomnjeeatoqoilaqpluraltaataqhouseshiqyeux" + // U+169D0
+		"nguaeyuaenswimmingcoveryuqyunkeuxpeuxepochpuewuefeeveelumireux" + // U+169E0
+		"raenguaetngashoshoqremedynapiloqkomenmamaqteukimon" + // U+169F0
+		"tenfaqghomkaukueereetaenyilariirieemeeeetaandaa" + // U+16A00
+		"njaemmsuushiisiseuxkyeeketnuaenunjuaeyoqshuyanshapeux" + // U+16A10
+		"nteewuepeerunireuxkenngkwaennggashopuaefomwaliloqko" + // U+16A20
+		"mbenrenmamombaatetkpasambavueq[?][?][?][?][?][?][?]" + // U+16A30
+		"tangiyomimbadaaphikhaihaodaichukeaaeolmaemnin" + // U+16A40
+		"paoooroshitheaeawaekolanlahairitek[?]" + // U+16A50
+		"0123456789[?][?][?][?]" + // U+16A60
+		"ozocoqoxazacaqaxvzvcvqvxezeceqex" + // U+16A70
+		"iziciqixuzucuquxawzawcawqawxuizuicuiquix" + // U+16A80
+		"nguexuezawxuecuezuequexuiuzuiucuiuquiuxmzmcmqmx" + // U+16A90
+		"kakhagangasayawapanyaphabamanahalahta" + // U+16AA0
+		"tadaranhashacatsaghahttathaxafadhachaza[?]" + // U+16AB0
+		"0123456789[?][?][?][?][?][?]" + // U+16AC0
+		"ennikasefambeyiegahdhiikpahjohwahwazogbudoce" + // U+16AD0
+		"uwutobavuyeinpawaddaaoooueeei[?][?]" + // U+16AE0
+		"[?][?][?][?][?][?][?][?][?][?]" + // U+16AF0
+		"keebkeevkibkivkaubkauvkubkuvkebkevkaibkaivkoobkoovkawbkawv" + // U+16B00
+		"kuabkuavkobkovkiabkiavkabkavkwbkwvkaabkaavvauntsaulauhau" + // U+16B10
+		"nlauraunkauqhauyauhlaumauchaunchauhnauplhaunthaunauauxaucau" + // U+16B20
+		"seevsuabnruayam[?][?][?][?][?][?][?][?][?][?]" + // U+16B40
+		"0123456789[?]10100100001000000100000000" + // U+16B50
+		"100000000001000000000000[?]lubxyoohlihlithajhnubnqigxiabntujavceevtseebtau" + // U+16B60
+		"losmusntogtshoojtxwvchwvdawbtos[?][?][?][?][?]tsheejyeeglis" + // U+16B70
+		"laujxyoojkoohawjmuasthojtsabphabkhabhamvajfajyajtswbkwmvwj" + // U+16B80
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16B90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16BA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16BB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16BC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16BD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16BE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16BF0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16E00
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16E10
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16E20
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16E30
+		"MSVWAtiuZKpPTGFIKAJE" + // U+16E40
+		"BCUYuLQHpNyXDOeNROAiY" + // U+16E50
+		"msvwatiuzkpptgfikaje" + // U+16E60
+		"bcuyulqhpnyxdoenroaiy" + // U+16E70
+		"0123456789101112131415" + // U+16E80
+		"16171819123[?][?][?][?][?]" + // U+16E90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16EA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16EB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16EC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16ED0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16EE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16EF0
+		"pabapaplamamhamafavavfatadattatattadda" + // U+16F00
+		"nanhannanannannhalalyalhalhyatlhadlhatlhyadlhyakaga" + // U+16F10
+		"kaqaqganganghangahaxaghaghhatssadzzanyanyhatshadzha" + // U+16F20
+		"tshadzhatshashassazhazshatsadzatsasazazsazzazzsazza" + // U+16F30
+		"zzyazzsyawaahhhabrisyidzyitetserte[?][?][?][?]" + // U+16F40
+		"nasalization" + // U+16F50
+		"[?][?][?][?][?][?][?]" + // U+16F80
+		"tone2tone3tone4tone5tone6tone7tone8tone1tone2tone4tone5tone6tone8" + // U+16F90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16FA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16FB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16FC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16FD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16FE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+16FF0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AF00
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AF10
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AF20
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AF30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AF40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AF50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AF60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AF70
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AF80
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AF90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AFA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AFB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AFC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AFD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1AFE0
+		"tone2tone3tone4tone5[?]tone7tone8tone1tone2tone3tone4tone5[?]tone7tone8[?]" + // U+1AFF0
+		"eyea1a2a3awoi1i2i3i4u1u2u3u4u5e2" + // U+1B000
+		"e3e4e5e6o1o2o3ka1ka2ka3ka4ka5ka6ka7ka8ka9" + // U+1B010
+		"ka10ka11kakeki1ki2ki3ki4ki5ki6ki7ki8ku1ku2ku3ku4ku5" + // U+1B020
+		"ku6ku7ke1ke2ke3ke4ke5ke6ko1ko2ko3kokisa1sa2sa3sa4" + // U+1B030
+		"sa5sa6sa7sa8si1si2si3si4si5si6su1su2su3su4su5su6" + // U+1B040
+		"su7su8se1se2se3se4se5so1so2so3so4so5so6so7ta1ta2" + // U+1B050
+		"ta3ta4ti1ti2ti3ti4ti5ti6ti7tu1tu2tu3tu4tutote1te2" + // U+1B060
+		"te3te4te5te6te7te8te9to1to2to3to4to5to6torana1na2" + // U+1B070
+		"na3na4na5na6na7na8na9ni1ni2ni3ni4ni5ni6ni7nitenu1" + // U+1B080
+		"nu2nu3ne1ne2ne3ne4ne5ne6nekono1no2no3no4no5ha1ha2" + // U+1B090
+		"ha3ha4ha5ha6ha7ha8ha9ha10ha11hi1hi2hi3hi4hi5hi6hi7" + // U+1B0A0
+		"hu1hu2hu3he1he2he3he4he5he6he7ho1ho2ho3ho4ho5ho6" + // U+1B0B0
+		"ho7ho8ma1ma2ma3ma4ma5ma6ma7mi1mi2mi3mi4mi5mi6mi7" + // U+1B0C0
+		"mu1mu2mu3mu4me1me2memamo1mo2mo3mo4mo5mo6ya1ya2ya3" + // U+1B0D0
+		"ya4ya5yayoyu1yu2yu3yu4yo1yo2yo3yo4yo5yo6ra1ra2ra3" + // U+1B0E0
+		"ra4ri1ri2ri3ri4ri5ri6ri7ru1ru2ru3ru4ru5ru6re1re2" + // U+1B0F0
+		"re3re4ro1ro2ro3ro4ro5ro6wa1wa2wa3wa4wa5wi1wi2wi3" + // U+1B100
+		"wi4wi5we1we2we3we4wo1wo2wo3wo4wo5wo6wo7nmumo1nmumo2wu" + // U+1B110
+		"yiyewu[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B120
+		"[?][?]ko[?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B130
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B140
+		"wiwewo[?][?]ko[?][?][?][?][?][?][?][?][?][?]" + // U+1B150
+		"[?][?][?][?]wiwewon[?][?][?][?][?][?][?][?]" + // U+1B160
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B170
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B180
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B190
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B1A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B1B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B1C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B1D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B1E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1B1F0
+		"hxptfklbdvgrnsnm" + // U+1BC00
+		"sthdhdhkkjhllhrhmnjsnmm" + // U+1BC10
+		"jmnjjsssssssssss" + // U+1BC20
+		"nstrprsswwhrnmssk" + // U+1BC30
+		"raowoaoaouieieiuieeehieei" + // U+1BC40
+		"yeueuxwnuuuhuoohowouwawowiwei" + // U+1BC50
+		"wowuoiaanamenanonm[?][?][?][?][?]" + // U+1BC60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1BC70
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1BC80
+		"[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1BC90
+		"[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1BCA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1BCB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1BCC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1BCD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1BCE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1BCF0
+		"[?][?][?][?][?][?][?][?][?][?]" + // U+1D240
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D250
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D260
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D270
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D280
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D290
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D2A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D2B0
+		"0123456789101112131415" + // U+1D2C0
+		"16171819[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D2D0
+		"0123456789101112131415" + // U+1D2E0
+		"16171819[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D2F0
+		"[?][?][?][?][?][?][?][?][?]" + // U+1D350
+		"12345678910203040506070" + // U+1D360
+		"80901234515[?][?][?][?][?][?][?]" + // U+1D370
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D380
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D390
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D3A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D3B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D3C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D3D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D3E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1D3F0
+		"ABCDEFGHIJKLMNOP" + // U+1D400
+		"QRSTUVWXYZabcdef" + // U+1D410
+		"ghijklmnopqrstuv" + // U+1D420
+		"wxyzABCDEFGHIJKL" + // U+1D430
+		"MNOPQRSTUVWXYZab" + // U+1D440
+		"cdefgijklmnopqr" + // U+1D450
+		"stuvwxyzABCDEFGH" + // U+1D460
+		"IJKLMNOPQRSTUVWX" + // U+1D470
+		"YZabcdefghijklmn" + // U+1D480
+		"opqrstuvwxyzACD" + // U+1D490
+		"GJKNOPQST" + // U+1D4A0
+		"UVWXYZabcdfhij" + // U+1D4B0
+		"klmnpqrstuvwxyz" + // U+1D4C0
+		"ABCDEFGHIJKLMNOP" + // U+1D4D0
+		"QRSTUVWXYZabcdef" + // U+1D4E0
+		"ghijklmnopqrstuv" + // U+1D4F0
+		"wxyzABDEFGJKL" + // U+1D500
+		"MNOPQSTUVWXYab" + // U+1D510
+		"cdefghijklmnopqr" + // U+1D520
+		"stuvwxyzABDEFG" + // U+1D530
+		"IJKLMOSTUVWX" + // U+1D540
+		"Yabcdefghijklmn" + // U+1D550
+		"opqrstuvwxyzABCD" + // U+1D560
+		"EFGHIJKLMNOPQRST" + // U+1D570
+		"UVWXYZabcdefghij" + // U+1D580
+		"klmnopqrstuvwxyz" + // U+1D590
+		"ABCDEFGHIJKLMNOP" + // U+1D5A0
+		"QRSTUVWXYZabcdef" + // U+1D5B0
+		"ghijklmnopqrstuv" + // U+1D5C0
+		"wxyzABCDEFGHIJKL" + // U+1D5D0
+		"MNOPQRSTUVWXYZab" + // U+1D5E0
+		"cdefghijklmnopqr" + // U+1D5F0
+		"stuvwxyzABCDEFGH" + // U+1D600
+		"IJKLMNOPQRSTUVWX" + // U+1D610
+		"YZabcdefghijklmn" + // U+1D620
+		"opqrstuvwxyzABCD" + // U+1D630
+		"EFGHIJKLMNOPQRST" + // U+1D640
+		"UVWXYZabcdefghij" + // U+1D650
+		"klmnopqrstuvwxyz" + // U+1D660
+		"ABCDEFGHIJKLMNOP" + // U+1D670
+		"QRSTUVWXYZabcdef" + // U+1D680
+		"ghijklmnopqrstuv" + // U+1D690
+		"wxyzijAlphaBetaGammaDeltaEpsilonZetaEtaTheta" + // U+1D6A0
+		"IotaKappaLamdaMuNuXiOmicronPiRhoThetaSigmaTauUpsilonPhiChiPsi" + // U+1D6B0
+		"Omeganablaalphabetagammadeltaepsilonzetaetathetaiotakappalamdamunuxi" + // U+1D6C0
+		"omicronpirhosigmasigmataiupsilonphichipsiomega" + // U+1D6D0
+		"01" + // U+1D7C0
+		"2345678901234567" + // U+1D7D0
+		"8901234567890123" + // U+1D7E0
+		"4567890123456789" + // U+1D7F0
+		"digraphgGkLlezhyengrtclickesheshtstopc" + // U+1DF00
+		"Kldigraphlengrrdigraphezhdigraphiodigraphcs[?]" + // U+1DF10
+		"[?][?][?][?][?]dlnrst[?][?][?][?][?]" + // U+1DF20
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DF30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DF40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DF50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DF60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DF70
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DF80
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DF90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DFA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DFB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DFC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DFD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DFE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1DFF0
+		"[?]" + // U+1E000
+		"[?][?]" + // U+1E010
+		"[?][?][?][?][?][?][?]" + // U+1E020
+		"abvgdezhziklmoprs" + // U+1E030
+		"tufkhtschshyeiudzze@ijou" + // U+1E040
+		"[?]abvgdezhziklopsu" + // U+1E050
+		"fkhtschsh'yg'idzdzhs'yeruu'[?][?]" + // U+1E060
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E070
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E080
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E090
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E0A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E0B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E0C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E0D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E0E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E0F0
+		"matsantatahanaxankacalasazancantsakada" + // U+1E100
+		"nyanravantxatxafaraqayanqapaxyanpadlanplahah" + // U+1E110
+		"mlaplagarraaaaiuoooeeew[?][?][?]" + // U+1E120
+		"personthinglocationanimalinvertebratexwlengthener[?][?]" + // U+1E130
+		"0123456789[?][?][?][?]nyaj" + // U+1E140
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E150
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E160
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E170
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E180
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E190
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E1A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E1B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E1C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E1D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E1E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E1F0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E200
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E210
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E220
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E230
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E240
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E250
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E260
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E270
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E280
+		"pabatadakagamanangasachayawajahara" + // U+1E290
+		"laiiiuiuueeeoeooaeaea[?]" + // U+1E2A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E2B0
+		"aaabacadagayaphalanapatathafasasha" + // U+1E2C0
+		"jazawavakaoauramakhahaeingaullha" + // U+1E2D0
+		"tsatraongaangangingonenaannyauenyih" + // U+1E2E0
+		"0123456789[?][?][?][?][?]" + // U+1E2F0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E400
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E410
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E420
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E430
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E440
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E450
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E460
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E470
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E480
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E490
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E4A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E4B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E4C0
+		"oopoloyongaajabanyahiisidditihu" + // U+1E4D0
+		"ucudukureennegemenettellojod" + // U+1E4E0
+		"0123456789[?][?][?][?][?][?]" + // U+1E4F0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E700
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E710
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E720
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E730
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E740
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E750
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E760
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E770
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E780
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E790
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E7A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E7B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E7C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E7D0
+		"hhyahhyuhhyihhyaahhyeehhyehhyo[?]hhwahhwihhweehhwe[?]mwimwee[?]" + // U+1E7E0
+		"qwiqweeqwebwibweekwikweekwegwigweegwefwifweepwipwee[?]" + // U+1E7F0
+		"kikakukeekekookokuawiwawuweewewoowowui" + // U+1E800
+		"weiwviwvawveminmanmunmenmonmuanmuenbibabubeebe" + // U+1E810
+		"booboiaueeeoooeiininanensisa" + // U+1E820
+		"suseesesoososialilaluleeleloololedida" + // U+1E830
+		"dudeedoodotitatuteetetootojijajujeeje" + // U+1E840
+		"joojojoyiyayuyeeyeyooyofifafufeefefoo" + // U+1E850
+		"fofuafanninnannunnennonhihahuheehehoohoheei" + // U+1E860
+		"hoouhinhanhunhenhonhuannggingganggunggeenggenggoonggonggaanggua" + // U+1E870
+		"nggenggoonggogigagugeegueiguanngenngonnguanpipapupee" + // U+1E880
+		"pepoopombimbambumbeembeembemboombombuumbemboombokpi" + // U+1E890
+		"kpakpukpeekpekpookpogbigbagbugbeegbegboogborandinda" + // U+1E8A0
+		"ndundeendendoondonjanjunjeenjoovivavuveevevoovo" + // U+1E8B0
+		"nyinnyannyunnyennyon[?][?]123456789" + // U+1E8C0
+		"[?][?][?][?][?][?][?][?][?]" + // U+1E8D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E8E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E8F0
+		"AlifDaaliLaamMiimBaSinnyiiyhePeBheRaEFaIODhaYheWaw" + // U+1E900
+		"NunKafYaUJiimChiHaQaafGaNyaTuNhaVaKhaGbeZal" + // U+1E910
+		"KpoShaalifdaalilaammiimbasinnyiiyhepebheraefaiodha" + // U+1E920
+		"yhewawnunkafyaujiimchihaqaafganyatunhavakha" + // U+1E930
+		"gbezalkposha[?][?][?][?][?]" + // U+1E940
+		"0123456789[?][?][?][?]" + // U+1E950
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E960
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E970
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E980
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E990
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E9A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E9B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E9C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E9D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E9E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1E9F0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EC00
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EC10
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EC20
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EC30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EC40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EC50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EC60
+		"[?]123456789102030405060" + // U+1EC70
+		"7080901002003004005006007008009001000200030004000" + // U+1EC80
+		"50006000700080009000100002000030000400005000060000700008000090000100000200000" + // U+1EC90
+		"10000010000000200000001234567891/41/23/4" + // U+1ECA0
+		"1210000100000[?][?][?][?][?][?][?][?][?][?][?]" + // U+1ECB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1ECC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1ECD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1ECE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1ECF0
+		"[?]123456789102030405060" + // U+1ED00
+		"7080901002003004005006007008009001000200030004000" + // U+1ED10
+		"500060007000800090001000020000300004000050000600007000080000900002" + // U+1ED20
+		"3456789104006002000100001/21/6[?][?]" + // U+1ED30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1ED40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1ED50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1ED60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1ED70
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1ED80
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1ED90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EDA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EDB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EDC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EDD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EDE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EDF0
+		"abjd[?]wzHTyklmns`" + // U+1EE00
+		"fSqrshtthkhdhDZG[?]NF[?]" + // U+1EE10
+		"[?]bj[?]h[?][?]H[?]yklmns`" + // U+1EE20
+		"fSq[?]shtthkh[?]D[?]G[?][?][?][?]" + // U+1EE30
+		"[?][?]j[?][?][?][?]H[?]y[?]l[?]ns`" + // U+1EE40
+		"[?]Sq[?]sh[?][?]kh[?]D[?]G[?]N[?][?]" + // U+1EE50
+		"[?]bj[?]h[?][?]HTyk[?]mns`" + // U+1EE60
+		"fSq[?]shtthkh[?]DZG[?][?]F[?]" + // U+1EE70
+		"abjdhwzHTy[?]lmns`" + // U+1EE80
+		"fSqrshtthkhdhDZG[?][?][?][?]" + // U+1EE90
+		"[?]bjd[?]wzHTy[?]lmns`" + // U+1EEA0
+		"fSqrshtthkhdhDZG[?][?][?][?]" + // U+1EEB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EEC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EED0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EEE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1EEF0
+		"0.0,1,2,3,4,5,6,7,8,9,00" + // U+1F100
+		"(A)(B)(C)(D)(E)(F)(G)(H)(I)(J)(K)(L)(M)(N)(O)(P)" + // U+1F110
+		"(Q)(R)(S)(T)(U)(V)(W)(X)(Y)(Z)[S] CRCDWZ" + // U+1F120
+		"ABCDEFGHIJKLMNOP" + // U+1F130
+		"QRSTUVWXYZHVMVSDSSPPVWC" + // U+1F140
+		"MCMDMR" + // U+1F160
+		"DJ" + // U+1F190
+		"[?][?]" + // U+1F1A0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1F1B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1F1C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1F1D0
+		"[?][?][?][?][?][?]" + // U+1F1E0
+		"[?]" + // U+1FB90
+		"[?][?][?][?][?]" + // U+1FBC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1FBD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+1FBE0
+		"0123456789[?][?][?][?][?][?]", // U+1FBF0
+}
@@ -0,0 +1,12401 @@
+//go:build !slugify_nocjk
+// +build !slugify_nocjk
+
+// Code generated by go run gen.go; DO NOT EDIT.
+
+package transliterations
+
+// cjkTables has the tables of the Han and Yi scripts, which the slugify_nocjk build tag
+// leaves out.
+var cjkTables = &packedTables{
+	sections: []uint16{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2,
+		0, 0, 0, 0, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14,
+		15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
+		31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46,
+		47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62,
+		63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78,
+		79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
+		95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+		111, 112, 113, 114, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 116, 117, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
+		134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149,
+		150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165,
+		166, 167, 168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+		182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
+		198, 199, 200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+		214, 215, 216, 217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
+		230, 231, 232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244, 245,
+		246, 247, 248, 249, 250, 251, 252, 253, 254, 255, 256, 257, 258, 259, 260, 261,
+		262, 263, 264, 265, 266, 267, 268, 269, 270, 271, 272, 273, 274, 275, 276, 277,
+		278, 279, 280, 281, 282, 283, 284, 285, 286, 287, 288, 289, 290, 291, 292, 293,
+		294, 295, 296, 297, 298, 299, 300, 301, 302, 303, 304, 305, 306, 307, 308, 309,
+		310, 311, 312, 313, 314, 315, 316, 317, 318, 319, 320, 321, 322, 323, 324, 0,
+		325, 326, 327, 328, 0, 329, 330, 331, 332, 333, 334, 335, 336, 337, 338, 0,
+		339, 340, 341, 342, 343, 344, 345, 346, 347, 348, 349, 350, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 351, 352, 353, 0, 0, 0, 0, 0,
+		354, 355, 356, 357, 358, 359, 360, 361, 362, 363, 364, 365, 366, 367, 368, 369,
+		370, 371, 372, 373,
+	},
+	starts: []tableStart{
+		{0, 0},          // 0x02e
+		{883, 257},      // 0x02f
+		{1874, 514},     // 0x034
+		{2917, 771},     // 0x035
+		{3919, 1028},    // 0x036
+		{4937, 1285},    // 0x037
+		{5965, 1542},    // 0x038
+		{7000, 1799},    // 0x039
+		{8026, 2056},    // 0x03a
+		{9053, 2313},    // 0x03b
+		{10101, 2570},   // 0x03c
+		{11130, 2827},   // 0x03d
+		{12174, 3084},   // 0x03e
+		{13198, 3341},   // 0x03f
+		{14239, 3598},   // 0x040
+		{15298, 3855},   // 0x041
+		{16352, 4112},   // 0x042
+		{17394, 4369},   // 0x043
+		{18409, 4626},   // 0x044
+		{19441, 4883},   // 0x045
+		{20461, 5140},   // 0x046
+		{21484, 5397},   // 0x047
+		{22522, 5654},   // 0x048
+		{23565, 5911},   // 0x049
+		{24620, 6168},   // 0x04a
+		{25642, 6425},   // 0x04b
+		{26653, 6682},   // 0x04c
+		{27682, 6939},   // 0x04d
+		{28633, 7196},   // 0x04e
+		{29682, 7453},   // 0x04f
+		{30692, 7710},   // 0x050
+		{31780, 7967},   // 0x051
+		{32870, 8224},   // 0x052
+		{33929, 8481},   // 0x053
+		{34949, 8738},   // 0x054
+		{35938, 8995},   // 0x055
+		{36943, 9252},   // 0x056
+		{37961, 9509},   // 0x057
+		{38996, 9766},   // 0x058
+		{40081, 10023},  // 0x059
+		{41131, 10280},  // 0x05a
+		{42171, 10537},  // 0x05b
+		{43244, 10794},  // 0x05c
+		{44260, 11051},  // 0x05d
+		{45333, 11308},  // 0x05e
+		{46412, 11565},  // 0x05f
+		{47478, 11822},  // 0x060
+		{48523, 12079},  // 0x061
+		{49592, 12336},  // 0x062
+		{50617, 12593},  // 0x063
+		{51679, 12850},  // 0x064
+		{52737, 13107},  // 0x065
+		{53782, 13364},  // 0x066
+		{54865, 13621},  // 0x067
+		{55902, 13878},  // 0x068
+		{56981, 14135},  // 0x069
+		{58060, 14392},  // 0x06a
+		{59140, 14649},  // 0x06b
+		{60153, 14906},  // 0x06c
+		{61201, 15163},  // 0x06d
+		{62258, 15420},  // 0x06e
+		{63303, 15677},  // 0x06f
+		{64359, 15934},  // 0x070
+		{65460, 16191},  // 0x071
+		{66543, 16448},  // 0x072
+		{67608, 16705},  // 0x073
+		{68661, 16962},  // 0x074
+		{69762, 17219},  // 0x075
+		{70842, 17476},  // 0x076
+		{71884, 17733},  // 0x077
+		{72964, 17990},  // 0x078
+		{74020, 18247},  // 0x079
+		{75025, 18504},  // 0x07a
+		{76101, 18761},  // 0x07b
+		{77174, 19018},  // 0x07c
+		{78241, 19275},  // 0x07d
+		{79324, 19532},  // 0x07e
+		{80412, 19789},  // 0x07f
+		{81481, 20046},  // 0x080
+		{82535, 20303},  // 0x081
+		{83613, 20560},  // 0x082
+		{84666, 20817},  // 0x083
+		{85716, 21074},  // 0x084
+		{86766, 21331},  // 0x085
+		{87808, 21588},  // 0x086
+		{88829, 21845},  // 0x087
+		{89885, 22102},  // 0x088
+		{90933, 22359},  // 0x089
+		{91965, 22616},  // 0x08a
+		{93017, 22873},  // 0x08b
+		{94065, 23130},  // 0x08c
+		{95100, 23387},  // 0x08d
+		{96141, 23644},  // 0x08e
+		{97205, 23901},  // 0x08f
+		{98261, 24158},  // 0x090
+		{99295, 24415},  // 0x091
+		{100354, 24672}, // 0x092
+		{101408, 24929}, // 0x093
+		{102486, 25186}, // 0x094
+		{103556, 25443}, // 0x095
+		{104632, 25700}, // 0x096
+		{105646, 25957}, // 0x097
+		{106695, 26214}, // 0x098
+		{107746, 26471}, // 0x099
+		{108780, 26728}, // 0x09a
+		{109808, 26985}, // 0x09b
+		{110868, 27242}, // 0x09c
+		{111932, 27499}, // 0x09d
+		{112970, 27756}, // 0x09e
+		{113990, 28013}, // 0x09f
+		{114936, 28270}, // 0x0a0
+		{115769, 28527}, // 0x0a1
+		{116643, 28784}, // 0x0a2
+		{117551, 29041}, // 0x0a3
+		{118477, 29298}, // 0x0a4
+		{119297, 29555}, // 0x0f9
+		{120269, 29812}, // 0x0fa
+		{121294, 30069}, // 0x200
+		{122316, 30326}, // 0x201
+		{123339, 30583}, // 0x202
+		{124361, 30840}, // 0x203
+		{125399, 31097}, // 0x204
+		{126443, 31354}, // 0x205
+		{127497, 31611}, // 0x206
+		{128525, 31868}, // 0x207
+		{129547, 32125}, // 0x208
+		{130574, 32382}, // 0x209
+		{131588, 32639}, // 0x20a
+		{132597, 32896}, // 0x20b
+		{133618, 33153}, // 0x20c
+		{134633, 33410}, // 0x20d
+		{135642, 33667}, // 0x20e
+		{136668, 33924}, // 0x20f
+		{137686, 34181}, // 0x210
+		{138701, 34438}, // 0x211
+		{139733, 34695}, // 0x212
+		{140751, 34952}, // 0x213
+		{141776, 35209}, // 0x214
+		{142806, 35466}, // 0x215
+		{143844, 35723}, // 0x216
+		{144867, 35980}, // 0x217
+		{145893, 36237}, // 0x218
+		{146931, 36494}, // 0x219
+		{147959, 36751}, // 0x21a
+		{148980, 37008}, // 0x21b
+		{150023, 37265}, // 0x21c
+		{151042, 37522}, // 0x21d
+		{152060, 37779}, // 0x21e
+		{153077, 38036}, // 0x21f
+		{154108, 38293}, // 0x220
+		{155143, 38550}, // 0x221
+		{156176, 38807}, // 0x222
+		{157198, 39064}, // 0x223
+		{158228, 39321}, // 0x224
+		{159266, 39578}, // 0x225
+		{160303, 39835}, // 0x226
+		{161329, 40092}, // 0x227
+		{162352, 40349}, // 0x228
+		{163370, 40606}, // 0x229
+		{164408, 40863}, // 0x22a
+		{165417, 41120}, // 0x22b
+		{166447, 41377}, // 0x22c
+		{167466, 41634}, // 0x22d
+		{168506, 41891}, // 0x22e
+		{169540, 42148}, // 0x22f
+		{170583, 42405}, // 0x230
+		{171623, 42662}, // 0x231
+		{172649, 42919}, // 0x232
+		{173679, 43176}, // 0x233
+		{174710, 43433}, // 0x234
+		{175743, 43690}, // 0x235
+		{176772, 43947}, // 0x236
+		{177788, 44204}, // 0x237
+		{178828, 44461}, // 0x238
+		{179840, 44718}, // 0x239
+		{180847, 44975}, // 0x23a
+		{181878, 45232}, // 0x23b
+		{182891, 45489}, // 0x23c
+		{183909, 45746}, // 0x23d
+		{184939, 46003}, // 0x23e
+		{185970, 46260}, // 0x23f
+		{186996, 46517}, // 0x240
+		{188023, 46774}, // 0x241
+		{189041, 47031}, // 0x242
+		{190065, 47288}, // 0x243
+		{191097, 47545}, // 0x244
+		{192121, 47802}, // 0x245
+		{193159, 48059}, // 0x246
+		{194190, 48316}, // 0x247
+		{195196, 48573}, // 0x248
+		{196213, 48830}, // 0x249
+		{197236, 49087}, // 0x24a
+		{198265, 49344}, // 0x24b
+		{199293, 49601}, // 0x24c
+		{200330, 49858}, // 0x24d
+		{201354, 50115}, // 0x24e
+		{202368, 50372}, // 0x24f
+		{203400, 50629}, // 0x250
+		{204427, 50886}, // 0x251
+		{205454, 51143}, // 0x252
+		{206477, 51400}, // 0x253
+		{207518, 51657}, // 0x254
+		{208539, 51914}, // 0x255
+		{209570, 52171}, // 0x256
+		{210589, 52428}, // 0x257
+		{211602, 52685}, // 0x258
+		{212635, 52942}, // 0x259
+		{213680, 53199}, // 0x25a
+		{214712, 53456}, // 0x25b
+		{215740, 53713}, // 0x25c
+		{216780, 53970}, // 0x25d
+		{217803, 54227}, // 0x25e
+		{218821, 54484}, // 0x25f
+		{219842, 54741}, // 0x260
+		{220873, 54998}, // 0x261
+		{221899, 55255}, // 0x262
+		{222922, 55512}, // 0x263
+		{223948, 55769}, // 0x264
+		{224970, 56026}, // 0x265
+		{226007, 56283}, // 0x266
+		{227024, 56540}, // 0x267
+		{228055, 56797}, // 0x268
+		{229093, 57054}, // 0x269
+		{230113, 57311}, // 0x26a
+		{231139, 57568}, // 0x26b
+		{232165, 57825}, // 0x26c
+		{233183, 58082}, // 0x26d
+		{234217, 58339}, // 0x26e
+		{235244, 58596}, // 0x26f
+		{236270, 58853}, // 0x270
+		{237298, 59110}, // 0x271
+		{238315, 59367}, // 0x272
+		{239317, 59624}, // 0x273
+		{240342, 59881}, // 0x274
+		{241355, 60138}, // 0x275
+		{242392, 60395}, // 0x276
+		{243420, 60652}, // 0x277
+		{244448, 60909}, // 0x278
+		{245473, 61166}, // 0x279
+		{246476, 61423}, // 0x27a
+		{247486, 61680}, // 0x27b
+		{248520, 61937}, // 0x27c
+		{249552, 62194}, // 0x27d
+		{250582, 62451}, // 0x27e
+		{251604, 62708}, // 0x27f
+		{252618, 62965}, // 0x280
+		{253645, 63222}, // 0x281
+		{254691, 63479}, // 0x282
+		{255733, 63736}, // 0x283
+		{256790, 63993}, // 0x284
+		{257818, 64250}, // 0x285
+		{258855, 64507}, // 0x286
+		{259887, 64764}, // 0x287
+		{260934, 65021}, // 0x288
+		{261970, 65278}, // 0x289
+		{263006, 65535}, // 0x28a
+		{264031, 65792}, // 0x28b
+		{265058, 66049}, // 0x28c
+		{266106, 66306}, // 0x28d
+		{267147, 66563}, // 0x28e
+		{268181, 66820}, // 0x28f
+		{269210, 67077}, // 0x290
+		{270240, 67334}, // 0x291
+		{271289, 67591}, // 0x292
+		{272312, 67848}, // 0x293
+		{273337, 68105}, // 0x294
+		{274355, 68362}, // 0x295
+		{275384, 68619}, // 0x296
+		{276422, 68876}, // 0x297
+		{277473, 69133}, // 0x298
+		{278487, 69390}, // 0x299
+		{279516, 69647}, // 0x29a
+		{280537, 69904}, // 0x29b
+		{281571, 70161}, // 0x29c
+		{282592, 70418}, // 0x29d
+		{283611, 70675}, // 0x29e
+		{284620, 70932}, // 0x29f
+		{285635, 71189}, // 0x2a0
+		{286660, 71446}, // 0x2a1
+		{287688, 71703}, // 0x2a2
+		{288713, 71960}, // 0x2a3
+		{289745, 72217}, // 0x2a4
+		{290761, 72474}, // 0x2a5
+		{291787, 72731}, // 0x2a6
+		{292778, 72988}, // 0x2a7
+		{293803, 73245}, // 0x2a8
+		{294830, 73502}, // 0x2a9
+		{295859, 73759}, // 0x2aa
+		{296887, 74016}, // 0x2ab
+		{297918, 74273}, // 0x2ac
+		{298939, 74530}, // 0x2ad
+		{299962, 74787}, // 0x2ae
+		{300985, 75044}, // 0x2af
+		{302007, 75301}, // 0x2b0
+		{303032, 75558}, // 0x2b1
+		{304056, 75815}, // 0x2b2
+		{305084, 76072}, // 0x2b3
+		{306124, 76329}, // 0x2b4
+		{307151, 76586}, // 0x2b5
+		{308190, 76843}, // 0x2b6
+		{309215, 77100}, // 0x2b7
+		{310244, 77357}, // 0x2b8
+		{311267, 77614}, // 0x2b9
+		{312289, 77871}, // 0x2ba
+		{313310, 78128}, // 0x2bb
+		{314339, 78385}, // 0x2bc
+		{315365, 78642}, // 0x2bd
+		{316393, 78899}, // 0x2be
+		{317419, 79156}, // 0x2bf
+		{318449, 79413}, // 0x2c0
+		{319477, 79670}, // 0x2c1
+		{320502, 79927}, // 0x2c2
+		{321531, 80184}, // 0x2c3
+		{322555, 80441}, // 0x2c4
+		{323588, 80698}, // 0x2c5
+		{324615, 80955}, // 0x2c6
+		{325650, 81212}, // 0x2c7
+		{326675, 81469}, // 0x2c8
+		{327711, 81726}, // 0x2c9
+		{328755, 81983}, // 0x2ca
+		{329787, 82240}, // 0x2cb
+		{330819, 82497}, // 0x2cc
+		{331852, 82754}, // 0x2cd
+		{332882, 83011}, // 0x2ce
+		{333898, 83268}, // 0x2d0
+		{334921, 83525}, // 0x2d1
+		{335945, 83782}, // 0x2d2
+		{336969, 84039}, // 0x2d3
+		{337993, 84296}, // 0x2d5
+		{339016, 84553}, // 0x2d6
+		{340039, 84810}, // 0x2d7
+		{341063, 85067}, // 0x2d8
+		{342087, 85324}, // 0x2d9
+		{343110, 85581}, // 0x2da
+		{344132, 85838}, // 0x2db
+		{345155, 86095}, // 0x2dc
+		{346181, 86352}, // 0x2dd
+		{347204, 86609}, // 0x2de
+		{348228, 86866}, // 0x2e0
+		{349253, 87123}, // 0x2e1
+		{350278, 87380}, // 0x2e2
+		{351303, 87637}, // 0x2e3
+		{352326, 87894}, // 0x2e4
+		{353351, 88151}, // 0x2e5
+		{354378, 88408}, // 0x2e6
+		{355402, 88665}, // 0x2e7
+		{356425, 88922}, // 0x2e8
+		{357454, 89179}, // 0x2e9
+		{358477, 89436}, // 0x2ea
+		{359502, 89693}, // 0x2eb
+		{360516, 89950}, // 0x2f8
+		{361580, 90207}, // 0x2f9
+		{362640, 90464}, // 0x2fa
+		{363435, 90721}, // 0x300
+		{364460, 90978}, // 0x301
+		{365484, 91235}, // 0x302
+		{366516, 91492}, // 0x303
+		{367543, 91749}, // 0x304
+		{368576, 92006}, // 0x305
+		{369605, 92263}, // 0x306
+		{370633, 92520}, // 0x307
+		{371664, 92777}, // 0x308
+		{372690, 93034}, // 0x309
+		{373719, 93291}, // 0x30a
+		{374756, 93548}, // 0x30b
+		{375793, 93805}, // 0x30c
+		{376823, 94062}, // 0x30d
+		{377848, 94319}, // 0x30e
+		{378893, 94576}, // 0x30f
+		{379928, 94833}, // 0x310
+		{380942, 95090}, // 0x311
+		{381968, 95347}, // 0x312
+		{382976, 95604}, // 0x313
+		{383993, 95861},
+	},
+	offsets: []uint16{
+		0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39, 42, 45,
+		48, 51, 54, 57, 60, 63, 66, 69, 72, 75, 78, 81, 84, 87, 90, 93,
+		96, 99, 102, 105, 108, 111, 114, 117, 120, 123, 126, 129, 132, 135, 138, 141,
+		144, 147, 150, 153, 156, 159, 162, 165, 168, 171, 174, 177, 180, 183, 186, 189,
+		192, 195, 198, 201, 204, 207, 210, 213, 216, 219, 222, 225, 228, 231, 234, 237,
+		240, 243, 246, 249, 252, 255, 258, 261, 264, 267, 270, 273, 276, 279, 282, 285,
+		288, 291, 294, 297, 300, 303, 306, 309, 312, 315, 318, 321, 324, 327, 330, 333,
+		336, 339, 342, 345, 348, 351, 354, 357, 360, 363, 366, 369, 372, 375, 378, 381,
+		384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444,
+		448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 488, 491, 495, 499, 503, 507,
+		511, 515, 519, 523, 527, 531, 535, 539, 543, 547, 551, 555, 559, 563, 567, 571,
+		575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 619, 623, 627, 631, 635,
+		639, 643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 687, 691, 695, 699,
+		703, 707, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751, 755, 759, 763,
+		767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 807, 811, 815, 819, 823, 827,
+		831, 835, 839, 843, 847, 850, 853, 856, 859, 862, 865, 868, 871, 874, 877, 880,
+		883, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56,
+		60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120,
+		124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184,
+		188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248,
+		252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312,
+		316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376,
+		380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440,
+		444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 488, 492, 496, 500, 504,
+		508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560, 564, 568,
+		572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616, 620, 624, 628, 632,
+		636, 640, 644, 648, 652, 656, 660, 664, 668, 672, 676, 680, 684, 688, 692, 696,
+		700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744, 748, 752, 756, 760,
+		764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816, 820, 824,
+		828, 832, 836, 840, 844, 848, 852, 856, 859, 862, 865, 868, 871, 874, 877, 880,
+		883, 886, 889, 892, 895, 898, 901, 904, 907, 910, 913, 916, 919, 922, 925, 928,
+		931, 934, 938, 942, 946, 950, 954, 958, 962, 966, 970, 974, 978, 982, 985, 988,
+		991, 991, 0, 4, 9, 13, 17, 21, 24, 28, 32, 36, 40, 44, 48, 51,
+		55, 59, 63, 67, 71, 75, 79, 83, 87, 91, 95, 99, 103, 107, 111, 116,
+		120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 163, 168, 172, 178, 182,
+		186, 192, 197, 201, 206, 210, 213, 217, 220, 224, 227, 232, 235, 239, 243, 249,
+		254, 258, 262, 266, 271, 276, 279, 282, 286, 290, 295, 300, 303, 306, 312, 318,
+		322, 326, 330, 334, 338, 342, 346, 349, 353, 357, 362, 366, 370, 374, 377, 380,
+		385, 390, 395, 399, 403, 407, 411, 416, 419, 422, 425, 428, 432, 437, 441, 445,
+		449, 453, 456, 460, 464, 468, 473, 477, 481, 484, 488, 492, 496, 500, 503, 507,
+		512, 516, 521, 526, 529, 534, 537, 541, 544, 548, 551, 555, 560, 563, 566, 572,
+		576, 579, 583, 587, 591, 594, 597, 601, 606, 609, 613, 617, 622, 626, 631, 634,
+		638, 642, 647, 651, 655, 659, 663, 667, 672, 676, 679, 683, 687, 691, 694, 698,
+		702, 706, 710, 715, 719, 723, 729, 732, 736, 740, 744, 748, 752, 756, 760, 764,
+		769, 773, 776, 780, 785, 789, 792, 797, 802, 806, 810, 815, 819, 824, 829, 835,
+		839, 843, 849, 852, 857, 861, 865, 869, 873, 876, 880, 883, 887, 892, 896, 900,
+		905, 909, 912, 917, 920, 924, 928, 932, 937, 941, 945, 948, 953, 958, 962, 966,
+		970, 975, 978, 982, 986, 991, 994, 999, 1001, 1005, 1008, 1013, 1017, 1022, 1026, 1030,
+		1036, 1039, 1043, 0, 4, 9, 13, 17, 20, 25, 29, 33, 37, 41, 46, 51,
+		55, 60, 64, 67, 71, 75, 78, 83, 87, 90, 94, 98, 102, 107, 111, 115,
+		118, 124, 129, 133, 137, 141, 145, 149, 153, 157, 162, 165, 169, 171, 174, 178,
+		182, 186, 190, 195, 200, 203, 207, 211, 214, 219, 224, 228, 231, 234, 238, 242,
+		246, 250, 255, 259, 263, 267, 271, 274, 278, 282, 285, 288, 291, 294, 297, 301,
+		304, 308, 311, 315, 319, 323, 327, 331, 335, 340, 343, 347, 351, 355, 360, 363,
+		367, 371, 376, 380, 387, 390, 394, 398, 402, 407, 411, 414, 418, 423, 427, 432,
+		437, 440, 443, 446, 450, 454, 458, 462, 467, 471, 473, 477, 480, 483, 488, 492,
+		496, 499, 503, 507, 510, 514, 517, 521, 525, 528, 531, 534, 538, 542, 546, 550,
+		554, 558, 562, 566, 570, 574, 577, 582, 586, 590, 594, 598, 603, 607, 611, 615,
+		619, 623, 627, 632, 636, 639, 642, 646, 650, 654, 659, 663, 666, 669, 673, 678,
+		682, 687, 691, 695, 699, 703, 707, 711, 715, 718, 722, 726, 730, 734, 739, 743,
+		747, 750, 752, 756, 760, 762, 768, 772, 775, 778, 781, 785, 789, 793, 796, 800,
+		804, 808, 812, 816, 820, 824, 827, 832, 837, 841, 845, 849, 852, 856, 859, 864,
+		868, 872, 876, 880, 884, 888, 893, 896, 899, 903, 907, 911, 915, 918, 922, 926,
+		930, 933, 937, 941, 946, 949, 953, 956, 960, 963, 966, 970, 974, 978, 982, 986,
+		989, 995, 999, 1002, 0, 4, 7, 10, 14, 19, 24, 28, 33, 36, 40, 43,
+		48, 51, 54, 58, 62, 66, 69, 73, 77, 81, 85, 89, 92, 96, 100, 104,
+		108, 112, 116, 120, 124, 127, 131, 136, 141, 145, 149, 153, 156, 160, 163, 167,
+		172, 175, 178, 182, 186, 191, 195, 198, 202, 206, 209, 213, 216, 221, 224, 227,
+		231, 235, 239, 243, 247, 251, 255, 259, 264, 267, 271, 275, 279, 283, 288, 294,
+		298, 302, 306, 310, 313, 317, 320, 323, 327, 331, 336, 340, 344, 348, 353, 358,
+		361, 365, 369, 373, 378, 381, 386, 390, 396, 400, 404, 408, 412, 415, 420, 423,
+		426, 430, 434, 437, 440, 444, 448, 453, 457, 462, 466, 471, 475, 479, 484, 488,
+		493, 497, 501, 504, 508, 511, 516, 521, 525, 529, 534, 537, 542, 546, 549, 553,
+		558, 562, 566, 570, 574, 578, 582, 587, 591, 595, 598, 602, 606, 610, 614, 619,
+		622, 625, 630, 635, 640, 645, 648, 651, 656, 659, 662, 665, 669, 674, 678, 682,
+		685, 689, 693, 697, 701, 705, 709, 714, 718, 721, 726, 729, 732, 736, 740, 744,
+		747, 751, 756, 762, 766, 770, 775, 779, 783, 786, 789, 793, 797, 801, 805, 809,
+		813, 817, 821, 824, 828, 832, 836, 840, 843, 848, 851, 855, 859, 862, 866, 871,
+		875, 879, 883, 888, 892, 896, 900, 904, 908, 911, 914, 918, 922, 928, 933, 937,
+		940, 943, 946, 950, 954, 958, 962, 965, 968, 972, 978, 982, 985, 989, 994, 997,
+		1001, 1005, 1009, 1014, 1018, 0, 4, 8, 13, 18, 22, 25, 28, 32, 35, 40,
+		45, 48, 52, 57, 60, 64, 68, 71, 74, 77, 81, 86, 90, 94, 97, 100,
+		103, 107, 110, 113, 118, 122, 126, 131, 135, 139, 144, 148, 152, 156, 160, 163,
+		168, 171, 175, 179, 183, 186, 190, 194, 199, 204, 209, 214, 218, 221, 225, 228,
+		232, 237, 241, 244, 248, 251, 255, 260, 264, 269, 274, 278, 282, 287, 292, 296,
+		301, 306, 310, 314, 319, 323, 328, 333, 337, 340, 344, 349, 352, 357, 362, 366,
+		370, 373, 377, 381, 384, 388, 393, 397, 400, 403, 407, 410, 414, 418, 422, 427,
+		432, 437, 441, 446, 450, 455, 460, 465, 469, 473, 477, 480, 483, 487, 492, 496,
+		501, 505, 508, 513, 517, 520, 524, 528, 532, 535, 538, 542, 545, 549, 552, 555,
+		558, 563, 567, 571, 574, 578, 581, 585, 589, 592, 595, 599, 602, 606, 609, 614,
+		617, 620, 624, 628, 633, 638, 642, 647, 651, 655, 659, 663, 666, 670, 673, 677,
+		681, 684, 688, 692, 696, 700, 703, 708, 713, 717, 721, 725, 729, 735, 739, 742,
+		745, 749, 755, 759, 762, 766, 771, 774, 777, 780, 786, 791, 795, 799, 803, 807,
+		810, 814, 819, 824, 828, 832, 837, 840, 844, 847, 851, 855, 860, 864, 869, 872,
+		875, 879, 882, 886, 891, 897, 902, 906, 909, 913, 916, 920, 924, 926, 931, 935,
+		939, 944, 948, 952, 955, 959, 964, 968, 972, 975, 980, 985, 990, 993, 997, 1001,
+		1005, 1010, 1013, 1018, 1023, 1028, 0, 4, 8, 11, 15, 18, 21, 25, 29, 33,
+		38, 41, 43, 46, 49, 52, 56, 61, 65, 70, 75, 79, 83, 86, 89, 92,
+		96, 100, 103, 106, 111, 115, 118, 121, 126, 131, 134, 138, 141, 146, 149, 152,
+		156, 159, 163, 167, 172, 177, 180, 184, 187, 190, 194, 198, 204, 208, 211, 214,
+		218, 222, 226, 229, 234, 239, 242, 245, 249, 252, 258, 262, 266, 270, 274, 278,
+		283, 287, 290, 294, 298, 303, 307, 311, 316, 321, 325, 328, 333, 340, 343, 348,
+		351, 355, 360, 364, 369, 373, 377, 383, 387, 391, 396, 400, 404, 408, 414, 419,
+		424, 428, 431, 435, 438, 441, 446, 449, 453, 458, 462, 465, 470, 474, 478, 482,
+		485, 489, 494, 497, 502, 507, 511, 514, 518, 522, 527, 531, 536, 541, 545, 549,
+		553, 556, 560, 564, 569, 573, 577, 581, 585, 589, 593, 598, 602, 606, 611, 616,
+		620, 623, 627, 632, 635, 638, 643, 649, 655, 659, 664, 668, 673, 677, 682, 686,
+		689, 693, 697, 702, 706, 710, 715, 718, 722, 726, 729, 733, 737, 740, 744, 747,
+		751, 756, 760, 764, 767, 772, 776, 781, 785, 787, 790, 793, 797, 800, 803, 806,
+		810, 814, 818, 823, 827, 830, 835, 840, 845, 850, 854, 859, 863, 867, 871, 875,
+		878, 883, 886, 891, 896, 900, 903, 907, 911, 914, 919, 924, 928, 932, 936, 940,
+		944, 949, 955, 958, 963, 967, 971, 975, 978, 982, 986, 990, 993, 998, 1003, 1007,
+		1011, 1015, 1018, 1022, 1026, 1030, 1035, 0, 4, 9, 12, 17, 21, 24, 28, 31,
+		35, 39, 44, 48, 52, 57, 60, 65, 70, 74, 78, 82, 85, 89, 93, 97,
+		102, 106, 111, 115, 119, 125, 129, 134, 138, 141, 144, 148, 151, 155, 158, 162,
+		167, 170, 175, 178, 182, 187, 193, 199, 203, 206, 210, 214, 218, 221, 226, 230,
+		233, 237, 243, 247, 251, 256, 260, 264, 268, 271, 274, 278, 283, 288, 292, 296,
+		300, 304, 309, 312, 315, 318, 322, 327, 332, 336, 340, 343, 346, 351, 356, 360,
+		364, 368, 371, 374, 378, 381, 385, 389, 392, 396, 400, 404, 408, 411, 415, 420,
+		425, 429, 433, 437, 442, 446, 451, 455, 459, 463, 468, 473, 476, 480, 483, 486,
+		490, 494, 497, 501, 505, 508, 511, 514, 519, 524, 530, 535, 538, 542, 545, 548,
+		551, 554, 558, 562, 565, 567, 571, 575, 578, 583, 586, 591, 595, 600, 604, 608,
+		611, 615, 620, 623, 627, 632, 637, 641, 645, 648, 652, 656, 659, 664, 667, 671,
+		675, 679, 683, 686, 690, 695, 700, 704, 709, 712, 716, 720, 723, 727, 731, 736,
+		739, 744, 747, 750, 757, 760, 763, 768, 771, 774, 779, 784, 789, 792, 796, 800,
+		804, 808, 811, 816, 820, 825, 828, 833, 838, 842, 845, 849, 854, 858, 860, 863,
+		866, 869, 872, 876, 880, 884, 888, 893, 897, 901, 905, 909, 915, 920, 925, 929,
+		933, 937, 941, 944, 949, 955, 958, 962, 965, 970, 974, 979, 982, 986, 991, 995,
+		1000, 1003, 1006, 1011, 1016, 1019, 1022, 1026, 0, 5, 9, 14, 18, 23, 27, 31,
+		34, 37, 41, 45, 49, 53, 58, 61, 65, 68, 73, 77, 81, 85, 90, 94,
+		98, 103, 107, 111, 116, 121, 125, 128, 132, 135, 139, 142, 146, 150, 153, 158,
+		162, 167, 171, 177, 181, 185, 188, 192, 196, 200, 204, 207, 211, 216, 221, 225,
+		229, 232, 236, 241, 245, 249, 252, 257, 260, 263, 267, 271, 276, 281, 285, 289,
+		293, 297, 300, 305, 310, 314, 317, 320, 324, 328, 332, 336, 340, 344, 348, 354,
+		357, 360, 364, 369, 374, 378, 382, 385, 390, 393, 397, 401, 405, 408, 412, 416,
+		419, 423, 428, 432, 436, 440, 443, 448, 451, 456, 460, 464, 469, 472, 474, 478,
+		483, 487, 491, 495, 498, 502, 505, 510, 513, 516, 520, 523, 526, 529, 534, 537,
+		542, 546, 549, 553, 557, 562, 565, 570, 573, 576, 579, 582, 588, 592, 597, 600,
+		604, 609, 612, 616, 621, 627, 632, 636, 640, 645, 649, 654, 659, 662, 666, 670,
+		673, 678, 681, 684, 688, 693, 696, 701, 705, 709, 714, 718, 722, 726, 730, 733,
+		737, 741, 745, 748, 753, 756, 760, 764, 768, 773, 778, 782, 786, 791, 795, 799,
+		803, 807, 811, 814, 818, 822, 826, 830, 834, 838, 842, 847, 850, 854, 858, 862,
+		866, 869, 872, 875, 881, 885, 888, 892, 896, 900, 904, 907, 911, 917, 922, 926,
+		930, 933, 937, 941, 946, 950, 954, 958, 962, 967, 971, 975, 980, 984, 988, 993,
+		996, 1000, 1004, 1008, 1012, 1016, 1019, 1023, 1027, 0, 4, 8, 11, 15, 20, 24,
+		28, 33, 37, 42, 47, 51, 56, 59, 64, 67, 71, 75, 80, 85, 89, 94,
+		98, 103, 106, 110, 114, 117, 121, 126, 129, 133, 138, 143, 147, 151, 154, 157,
+		161, 164, 168, 172, 176, 180, 183, 188, 192, 196, 199, 202, 207, 210, 215, 220,
+		224, 228, 233, 239, 243, 249, 252, 256, 260, 264, 268, 273, 278, 282, 286, 290,
+		294, 299, 303, 307, 311, 316, 320, 324, 329, 333, 337, 341, 344, 349, 352, 355,
+		359, 363, 366, 370, 373, 377, 382, 386, 390, 395, 400, 403, 407, 411, 416, 420,
+		424, 428, 432, 436, 439, 443, 448, 451, 455, 459, 463, 466, 469, 473, 478, 482,
+		486, 490, 493, 498, 502, 507, 510, 513, 517, 521, 525, 530, 533, 537, 542, 547,
+		551, 556, 560, 564, 568, 572, 577, 581, 585, 589, 595, 599, 603, 607, 613, 618,
+		622, 626, 630, 632, 635, 639, 644, 647, 651, 656, 660, 665, 669, 673, 677, 681,
+		685, 688, 691, 694, 700, 703, 708, 713, 717, 721, 724, 729, 734, 739, 743, 748,
+		753, 756, 761, 765, 769, 773, 777, 781, 786, 789, 794, 798, 802, 805, 809, 812,
+		817, 820, 823, 827, 831, 837, 843, 849, 853, 856, 861, 865, 869, 872, 876, 879,
+		882, 887, 892, 896, 899, 902, 906, 909, 914, 917, 922, 926, 931, 935, 938, 942,
+		945, 949, 953, 956, 961, 964, 969, 972, 976, 981, 985, 989, 993, 998, 1002, 1006,
+		1011, 1014, 1018, 1023, 1028, 1032, 1036, 1040, 1044, 1048, 0, 3, 7, 12, 17, 22,
+		25, 29, 33, 38, 42, 47, 51, 55, 60, 64, 68, 72, 77, 81, 85, 89,
+		93, 97, 100, 103, 107, 110, 114, 118, 121, 125, 128, 133, 137, 142, 145, 148,
+		151, 154, 158, 162, 166, 170, 175, 179, 182, 187, 191, 195, 199, 202, 205, 209,
+		212, 216, 219, 224, 229, 233, 236, 240, 244, 249, 252, 256, 260, 265, 269, 273,
+		276, 279, 282, 286, 289, 293, 297, 300, 305, 309, 315, 319, 323, 327, 331, 335,
+		339, 343, 347, 350, 354, 358, 364, 368, 372, 375, 378, 381, 387, 392, 396, 399,
+		404, 407, 410, 415, 418, 422, 426, 430, 435, 440, 443, 447, 451, 454, 457, 460,
+		464, 469, 473, 476, 479, 482, 486, 490, 495, 499, 503, 508, 513, 519, 523, 527,
+		530, 535, 539, 544, 548, 552, 557, 562, 566, 570, 574, 578, 582, 586, 591, 596,
+		600, 605, 608, 611, 615, 619, 623, 627, 632, 635, 640, 644, 648, 653, 657, 663,
+		668, 672, 676, 681, 685, 690, 693, 698, 702, 706, 710, 715, 719, 723, 727, 733,
+		737, 740, 744, 747, 751, 754, 757, 760, 765, 768, 773, 777, 780, 784, 788, 793,
+		798, 802, 805, 809, 813, 817, 821, 824, 827, 832, 836, 840, 843, 848, 852, 856,
+		860, 863, 868, 874, 879, 882, 885, 889, 893, 897, 901, 904, 908, 912, 916, 920,
+		925, 929, 933, 938, 942, 945, 949, 954, 959, 964, 968, 972, 976, 980, 984, 987,
+		990, 994, 997, 1001, 1006, 1010, 1013, 1016, 1019, 1025, 1029, 0, 4, 7, 11, 15,
+		20, 24, 28, 32, 36, 39, 43, 46, 50, 54, 58, 63, 68, 71, 74, 79,
+		82, 85, 89, 93, 97, 101, 105, 109, 114, 117, 121, 125, 129, 133, 137, 141,
+		145, 150, 155, 158, 163, 168, 172, 176, 180, 184, 188, 192, 197, 201, 204, 209,
+		214, 217, 221, 225, 230, 234, 238, 242, 245, 249, 252, 257, 260, 264, 268, 272,
+		276, 280, 284, 288, 292, 296, 299, 302, 306, 310, 315, 319, 323, 326, 330, 334,
+		338, 343, 346, 351, 355, 359, 363, 367, 371, 374, 379, 383, 386, 390, 394, 397,
+		401, 404, 408, 411, 414, 417, 422, 425, 430, 434, 439, 444, 447, 452, 455, 460,
+		465, 469, 473, 478, 482, 487, 491, 495, 499, 503, 508, 513, 518, 522, 526, 530,
+		534, 538, 542, 547, 552, 555, 559, 563, 568, 573, 578, 582, 586, 591, 596, 601,
+		605, 610, 614, 618, 623, 627, 630, 633, 637, 641, 646, 650, 653, 657, 661, 666,
+		671, 675, 679, 683, 687, 691, 695, 700, 703, 707, 711, 715, 719, 723, 728, 732,
+		736, 740, 745, 749, 753, 758, 763, 767, 770, 774, 779, 782, 788, 792, 796, 801,
+		805, 810, 813, 817, 819, 823, 827, 831, 835, 839, 842, 847, 851, 855, 858, 863,
+		867, 871, 876, 882, 886, 890, 894, 898, 902, 906, 910, 914, 918, 924, 928, 933,
+		936, 940, 943, 947, 951, 955, 959, 964, 967, 971, 975, 980, 984, 988, 992, 996,
+		999, 1002, 1006, 1011, 1015, 1020, 1024, 1027, 1031, 1035, 1039, 1044, 0, 4, 8, 12,
+		17, 20, 24, 28, 33, 37, 41, 45, 49, 53, 56, 60, 63, 67, 72, 76,
+		79, 83, 87, 90, 95, 99, 103, 106, 111, 116, 121, 125, 129, 134, 138, 142,
+		145, 150, 155, 158, 162, 166, 169, 174, 179, 183, 186, 190, 193, 197, 201, 205,
+		210, 214, 218, 222, 226, 230, 234, 238, 241, 245, 249, 253, 256, 259, 265, 270,
+		274, 277, 282, 286, 289, 292, 297, 301, 305, 310, 313, 317, 321, 327, 330, 333,
+		337, 343, 348, 353, 357, 360, 365, 370, 374, 378, 382, 386, 390, 394, 399, 403,
+		409, 413, 417, 422, 426, 430, 434, 438, 441, 445, 448, 451, 455, 459, 463, 468,
+		471, 476, 481, 486, 490, 493, 498, 501, 504, 507, 512, 517, 522, 525, 529, 533,
+		537, 540, 544, 548, 551, 555, 558, 563, 568, 573, 577, 582, 586, 590, 595, 600,
+		603, 607, 611, 615, 620, 625, 629, 632, 636, 641, 645, 648, 653, 657, 660, 664,
+		667, 672, 677, 682, 686, 689, 693, 696, 700, 705, 708, 711, 715, 719, 724, 728,
+		732, 735, 739, 743, 746, 751, 756, 760, 764, 768, 772, 776, 779, 782, 786, 791,
+		794, 798, 802, 806, 810, 814, 817, 822, 825, 828, 831, 834, 839, 843, 846, 850,
+		854, 858, 862, 866, 870, 874, 878, 882, 886, 890, 893, 897, 901, 904, 908, 912,
+		916, 920, 924, 928, 932, 936, 940, 944, 947, 950, 953, 957, 960, 965, 969, 972,
+		976, 980, 985, 988, 991, 995, 1000, 1003, 1007, 1011, 1015, 1020, 1024, 0, 4, 8,
+		10, 14, 18, 22, 26, 32, 36, 41, 45, 48, 51, 55, 58, 62, 67, 72,
+		77, 82, 86, 91, 94, 99, 102, 107, 112, 117, 121, 125, 130, 133, 137, 141,
+		144, 147, 151, 155, 160, 165, 169, 175, 179, 184, 189, 194, 199, 204, 207, 212,
+		216, 222, 225, 228, 231, 237, 242, 247, 252, 257, 262, 269, 272, 277, 283, 286,
+		289, 293, 296, 300, 305, 308, 311, 316, 320, 324, 328, 332, 336, 340, 344, 348,
+		352, 356, 360, 364, 368, 372, 377, 381, 385, 389, 393, 396, 400, 404, 408, 412,
+		415, 418, 421, 425, 429, 432, 436, 440, 445, 450, 453, 458, 462, 465, 469, 474,
+		479, 482, 488, 492, 496, 501, 506, 510, 513, 517, 521, 524, 527, 531, 535, 539,
+		543, 547, 550, 553, 557, 560, 563, 568, 571, 575, 580, 584, 587, 591, 594, 597,
+		600, 605, 608, 613, 616, 622, 627, 631, 635, 639, 643, 647, 651, 654, 660, 664,
+		667, 671, 677, 681, 685, 689, 692, 697, 703, 708, 712, 717, 723, 727, 730, 734,
+		738, 742, 746, 749, 752, 755, 759, 764, 767, 771, 775, 780, 785, 790, 794, 798,
+		802, 806, 809, 814, 818, 821, 825, 829, 833, 837, 842, 846, 850, 854, 858, 862,
+		866, 870, 874, 878, 881, 885, 890, 893, 897, 900, 904, 908, 912, 916, 922, 926,
+		930, 933, 938, 941, 946, 951, 954, 959, 964, 967, 970, 974, 979, 982, 985, 989,
+		993, 996, 999, 1003, 1008, 1012, 1016, 1019, 1023, 1027, 1031, 1034, 1038, 1041, 0, 4,
+		8, 11, 15, 20, 24, 28, 31, 34, 39, 44, 48, 51, 55, 60, 65, 70,
+		73, 78, 83, 87, 92, 97, 101, 105, 108, 112, 116, 119, 123, 127, 131, 134,
+		139, 144, 147, 151, 156, 159, 164, 168, 172, 177, 181, 186, 190, 196, 199, 202,
+		207, 210, 213, 218, 223, 229, 233, 237, 241, 246, 251, 256, 260, 263, 266, 270,
+		276, 281, 286, 291, 296, 299, 302, 305, 308, 311, 315, 318, 322, 328, 332, 337,
+		342, 345, 350, 355, 359, 363, 367, 371, 375, 379, 383, 389, 394, 400, 404, 409,
+		413, 418, 424, 428, 431, 434, 439, 443, 446, 451, 454, 459, 464, 469, 472, 478,
+		482, 486, 491, 496, 501, 504, 508, 513, 516, 520, 525, 528, 532, 536, 539, 542,
+		547, 550, 555, 559, 564, 567, 571, 576, 580, 583, 587, 593, 596, 601, 606, 611,
+		614, 618, 622, 627, 631, 635, 638, 642, 646, 650, 653, 658, 662, 666, 670, 674,
+		677, 682, 685, 688, 692, 697, 702, 706, 710, 715, 720, 725, 730, 734, 739, 742,
+		746, 749, 753, 757, 762, 766, 771, 775, 779, 782, 788, 792, 797, 801, 804, 808,
+		811, 815, 819, 823, 826, 830, 835, 839, 843, 846, 849, 854, 859, 863, 868, 872,
+		876, 879, 883, 888, 892, 895, 898, 903, 906, 909, 914, 917, 922, 926, 930, 935,
+		939, 943, 947, 950, 957, 961, 966, 970, 974, 978, 982, 986, 990, 993, 996, 999,
+		1004, 1008, 1011, 1015, 1020, 1025, 1030, 1035, 1039, 1042, 1045, 1049, 1053, 1056, 1059, 0,
+		4, 7, 11, 16, 20, 25, 29, 35, 39, 41, 45, 49, 54, 58, 61, 64,
+		69, 73, 77, 83, 86, 90, 94, 98, 103, 108, 112, 116, 119, 126, 130, 134,
+		139, 143, 146, 150, 154, 159, 164, 169, 172, 175, 180, 183, 186, 191, 194, 199,
+		202, 207, 211, 216, 220, 224, 227, 231, 234, 239, 243, 247, 252, 257, 260, 263,
+		267, 270, 273, 279, 282, 286, 289, 292, 297, 300, 304, 308, 313, 318, 322, 326,
+		331, 335, 340, 343, 346, 349, 352, 356, 360, 364, 369, 375, 379, 384, 387, 391,
+		394, 397, 401, 407, 410, 414, 418, 422, 426, 430, 434, 438, 441, 446, 451, 456,
+		459, 463, 466, 470, 474, 479, 483, 486, 491, 495, 500, 503, 507, 511, 515, 521,
+		525, 529, 534, 538, 543, 546, 550, 554, 558, 561, 565, 569, 573, 578, 583, 587,
+		591, 597, 601, 603, 609, 613, 618, 622, 625, 629, 634, 638, 642, 646, 650, 653,
+		658, 663, 667, 672, 676, 680, 685, 689, 694, 699, 704, 711, 715, 720, 724, 729,
+		732, 736, 741, 747, 751, 757, 761, 765, 771, 777, 781, 786, 790, 793, 797, 800,
+		805, 808, 813, 816, 820, 823, 827, 832, 836, 840, 844, 848, 853, 857, 861, 865,
+		868, 871, 875, 878, 882, 886, 890, 896, 899, 903, 906, 910, 915, 919, 923, 928,
+		932, 936, 939, 943, 947, 950, 954, 958, 963, 966, 970, 973, 977, 981, 985, 990,
+		996, 999, 1002, 1006, 1010, 1013, 1017, 1021, 1027, 1031, 1035, 1039, 1042, 1046, 1050, 1054,
+		0, 4, 8, 13, 18, 22, 25, 29, 34, 38, 42, 47, 50, 53, 58, 61,
+		65, 69, 73, 77, 80, 84, 88, 91, 95, 98, 103, 107, 112, 116, 119, 122,
+		125, 130, 135, 139, 145, 150, 154, 159, 163, 167, 171, 174, 177, 180, 184, 187,
+		192, 197, 201, 205, 208, 213, 218, 223, 227, 230, 234, 240, 243, 247, 251, 256,
+		259, 263, 268, 272, 278, 283, 287, 292, 296, 300, 303, 307, 311, 315, 320, 325,
+		329, 332, 335, 339, 343, 347, 352, 357, 360, 365, 369, 374, 377, 381, 384, 388,
+		392, 396, 400, 403, 407, 411, 416, 421, 425, 428, 432, 436, 439, 443, 447, 451,
+		456, 461, 464, 467, 472, 477, 483, 490, 494, 499, 504, 509, 512, 517, 521, 524,
+		527, 530, 534, 537, 541, 547, 552, 556, 559, 563, 567, 571, 575, 579, 582, 587,
+		591, 595, 601, 605, 609, 614, 618, 622, 628, 632, 636, 640, 643, 648, 652, 656,
+		660, 663, 667, 671, 677, 681, 686, 689, 692, 696, 700, 703, 707, 712, 716, 719,
+		725, 729, 734, 739, 742, 747, 751, 756, 760, 764, 768, 771, 775, 779, 784, 787,
+		790, 794, 799, 803, 807, 812, 816, 820, 824, 827, 831, 837, 841, 847, 851, 856,
+		860, 864, 868, 872, 877, 880, 884, 887, 891, 896, 899, 902, 905, 910, 914, 919,
+		922, 925, 929, 933, 937, 941, 944, 948, 951, 954, 957, 959, 965, 970, 973, 977,
+		980, 983, 987, 991, 995, 999, 1002, 1005, 1009, 1013, 1016, 1020, 1024, 1029, 1034, 1038,
+		1042, 0, 3, 7, 10, 15, 20, 25, 29, 33, 36, 40, 44, 47, 50, 54,
+		58, 61, 64, 69, 72, 76, 79, 82, 86, 89, 93, 99, 102, 106, 110, 114,
+		119, 122, 127, 130, 135, 140, 144, 148, 152, 157, 160, 164, 168, 172, 178, 183,
+		190, 195, 199, 203, 207, 211, 215, 219, 223, 228, 233, 239, 242, 245, 249, 253,
+		257, 260, 264, 268, 271, 275, 280, 285, 289, 292, 297, 302, 307, 312, 315, 319,
+		323, 327, 331, 335, 339, 342, 347, 350, 353, 358, 361, 365, 370, 373, 378, 381,
+		384, 389, 392, 395, 398, 402, 405, 408, 411, 415, 418, 423, 426, 430, 435, 439,
+		444, 448, 452, 455, 459, 463, 467, 473, 478, 482, 485, 489, 494, 499, 503, 506,
+		510, 516, 520, 523, 527, 531, 536, 539, 544, 549, 552, 555, 559, 563, 567, 571,
+		574, 578, 583, 586, 591, 594, 597, 602, 607, 612, 615, 619, 624, 628, 632, 636,
+		640, 643, 646, 651, 654, 657, 660, 664, 668, 673, 676, 679, 682, 689, 692, 696,
+		699, 703, 707, 710, 714, 717, 722, 725, 728, 732, 735, 739, 743, 748, 751, 755,
+		759, 763, 766, 770, 774, 779, 782, 785, 789, 794, 799, 803, 808, 811, 814, 819,
+		824, 827, 831, 834, 839, 844, 848, 852, 857, 861, 864, 868, 873, 878, 881, 887,
+		890, 894, 897, 901, 904, 908, 911, 915, 919, 922, 926, 929, 932, 936, 940, 944,
+		947, 951, 955, 958, 962, 966, 971, 976, 979, 983, 986, 991, 996, 1001, 1005, 1008,
+		1012, 1015, 0, 3, 8, 11, 15, 20, 23, 27, 31, 35, 38, 42, 47, 52,
+		56, 59, 64, 68, 72, 76, 80, 84, 87, 90, 94, 98, 101, 104, 107, 112,
+		116, 120, 126, 132, 135, 139, 143, 148, 153, 156, 160, 163, 167, 171, 176, 180,
+		184, 189, 193, 197, 202, 206, 209, 213, 218, 222, 226, 230, 234, 238, 241, 244,
+		248, 252, 255, 258, 261, 264, 269, 272, 277, 282, 286, 289, 294, 298, 303, 307,
+		311, 316, 321, 326, 329, 333, 337, 341, 345, 349, 354, 358, 362, 367, 371, 374,
+		378, 383, 389, 394, 398, 402, 407, 411, 413, 417, 420, 424, 428, 430, 435, 439,
+		442, 446, 449, 452, 456, 461, 465, 468, 471, 476, 480, 485, 489, 493, 497, 502,
+		505, 509, 512, 515, 518, 524, 528, 531, 536, 540, 543, 547, 550, 553, 558, 563,
+		568, 573, 578, 583, 587, 592, 596, 600, 605, 609, 612, 616, 620, 625, 630, 634,
+		639, 644, 648, 653, 657, 661, 665, 668, 672, 676, 679, 683, 686, 691, 695, 698,
+		703, 707, 711, 717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 760, 763,
+		768, 771, 776, 780, 784, 788, 791, 795, 798, 802, 805, 808, 811, 814, 818, 822,
+		826, 831, 834, 839, 844, 849, 854, 858, 862, 868, 873, 877, 881, 884, 887, 891,
+		896, 899, 904, 907, 911, 914, 920, 923, 926, 931, 934, 939, 943, 947, 950, 955,
+		959, 963, 966, 970, 975, 979, 983, 988, 991, 995, 1000, 1005, 1008, 1012, 1016, 1021,
+		1024, 1028, 1032, 0, 4, 7, 10, 13, 17, 20, 23, 26, 31, 34, 39, 43,
+		47, 51, 54, 59, 64, 68, 72, 75, 80, 83, 88, 94, 98, 103, 108, 113,
+		117, 122, 125, 128, 133, 136, 140, 143, 146, 150, 154, 157, 161, 165, 172, 176,
+		179, 184, 187, 190, 193, 196, 201, 205, 208, 212, 215, 219, 224, 228, 232, 236,
+		239, 242, 245, 249, 254, 258, 262, 266, 270, 274, 278, 282, 286, 289, 293, 298,
+		302, 305, 308, 310, 314, 318, 322, 326, 331, 335, 339, 342, 347, 351, 355, 359,
+		363, 369, 374, 379, 383, 386, 390, 393, 396, 399, 404, 409, 414, 418, 421, 425,
+		429, 434, 437, 442, 446, 450, 454, 457, 462, 466, 469, 472, 476, 479, 484, 487,
+		491, 495, 499, 503, 507, 510, 515, 519, 523, 528, 534, 537, 541, 545, 548, 552,
+		555, 560, 564, 568, 572, 575, 578, 581, 585, 588, 592, 596, 599, 602, 605, 609,
+		613, 619, 624, 629, 634, 637, 642, 646, 650, 653, 656, 659, 663, 666, 669, 674,
+		678, 683, 689, 693, 697, 703, 707, 711, 715, 719, 723, 726, 728, 733, 738, 742,
+		746, 749, 753, 756, 762, 765, 768, 773, 776, 782, 787, 790, 795, 801, 805, 809,
+		814, 817, 821, 825, 829, 832, 837, 842, 846, 850, 853, 856, 859, 863, 866, 870,
+		874, 878, 882, 885, 890, 894, 898, 902, 907, 912, 916, 921, 924, 927, 931, 934,
+		938, 942, 949, 953, 958, 961, 965, 969, 974, 980, 983, 986, 991, 994, 998, 1002,
+		1006, 1010, 1015, 1020, 0, 3, 6, 10, 13, 17, 21, 26, 30, 33, 37, 41,
+		46, 49, 54, 58, 61, 64, 68, 72, 76, 79, 84, 88, 92, 95, 99, 103,
+		107, 111, 114, 118, 123, 126, 129, 133, 136, 140, 144, 147, 151, 156, 160, 166,
+		170, 175, 179, 184, 188, 193, 196, 200, 204, 208, 211, 215, 219, 222, 226, 231,
+		234, 238, 241, 246, 250, 255, 259, 263, 267, 270, 274, 278, 283, 287, 291, 295,
+		299, 303, 307, 311, 314, 317, 321, 324, 328, 331, 336, 340, 343, 347, 351, 356,
+		361, 366, 370, 375, 379, 383, 387, 392, 395, 399, 404, 409, 413, 417, 422, 427,
+		431, 436, 440, 445, 448, 451, 454, 458, 463, 468, 471, 475, 478, 481, 486, 491,
+		495, 500, 504, 508, 512, 516, 520, 523, 527, 530, 534, 539, 545, 550, 553, 558,
+		562, 566, 569, 573, 577, 580, 584, 591, 596, 600, 603, 608, 613, 619, 624, 629,
+		632, 635, 638, 642, 646, 650, 654, 658, 661, 665, 669, 673, 677, 681, 686, 691,
+		694, 698, 703, 708, 712, 715, 719, 724, 728, 731, 735, 740, 743, 747, 750, 754,
+		758, 761, 765, 769, 773, 777, 781, 785, 789, 794, 798, 803, 808, 812, 817, 820,
+		823, 827, 831, 835, 838, 841, 844, 849, 854, 857, 860, 862, 866, 870, 873, 877,
+		881, 885, 889, 893, 897, 901, 905, 908, 913, 916, 919, 924, 927, 931, 934, 940,
+		946, 950, 955, 959, 962, 966, 969, 973, 977, 980, 985, 989, 993, 997, 1001, 1005,
+		1009, 1013, 1017, 1020, 1023, 0, 4, 7, 11, 15, 19, 23, 27, 31, 36, 40,
+		44, 48, 53, 58, 62, 66, 70, 73, 76, 80, 84, 88, 92, 96, 101, 104,
+		107, 112, 116, 121, 124, 129, 133, 136, 141, 145, 149, 153, 157, 161, 165, 169,
+		173, 179, 184, 188, 193, 196, 200, 204, 208, 212, 216, 220, 226, 230, 234, 238,
+		243, 247, 250, 253, 257, 261, 265, 269, 273, 277, 284, 288, 292, 296, 298, 302,
+		306, 311, 315, 319, 324, 328, 332, 338, 342, 346, 350, 354, 359, 363, 366, 370,
+		373, 376, 381, 384, 388, 391, 396, 400, 404, 407, 411, 415, 421, 425, 430, 435,
+		439, 443, 447, 451, 456, 459, 463, 467, 471, 475, 479, 482, 488, 492, 495, 499,
+		504, 507, 512, 515, 518, 522, 526, 530, 535, 539, 544, 547, 551, 555, 559, 565,
+		569, 574, 578, 583, 587, 591, 596, 601, 606, 612, 616, 619, 623, 627, 630, 633,
+		636, 640, 643, 646, 649, 652, 656, 660, 664, 668, 671, 675, 678, 682, 686, 688,
+		694, 698, 702, 706, 709, 712, 716, 719, 723, 726, 731, 735, 740, 744, 747, 753,
+		758, 763, 768, 772, 776, 781, 785, 790, 794, 798, 801, 806, 809, 812, 816, 820,
+		823, 828, 831, 836, 840, 843, 846, 850, 855, 861, 864, 868, 873, 877, 882, 886,
+		889, 892, 895, 899, 903, 906, 910, 914, 919, 923, 926, 931, 934, 939, 943, 947,
+		952, 958, 962, 967, 970, 974, 979, 983, 987, 991, 996, 1000, 1004, 1008, 1012, 1016,
+		1020, 1023, 1026, 1030, 1035, 1038, 0, 5, 9, 13, 19, 24, 28, 34, 38, 41,
+		46, 50, 53, 57, 61, 64, 67, 70, 74, 77, 81, 85, 89, 93, 97, 100,
+		105, 108, 112, 117, 121, 124, 128, 132, 136, 141, 146, 150, 154, 158, 162, 167,
+		171, 176, 180, 185, 189, 194, 198, 203, 207, 212, 217, 222, 225, 229, 234, 238,
+		241, 245, 251, 255, 259, 264, 268, 273, 278, 282, 285, 289, 294, 299, 303, 307,
+		312, 316, 319, 324, 327, 330, 335, 339, 343, 348, 353, 356, 362, 367, 372, 377,
+		382, 386, 390, 393, 398, 401, 404, 408, 412, 417, 421, 425, 430, 435, 438, 442,
+		447, 452, 457, 461, 465, 469, 474, 479, 485, 490, 493, 499, 503, 507, 511, 515,
+		519, 523, 527, 532, 537, 541, 546, 549, 553, 557, 560, 563, 568, 572, 577, 582,
+		587, 591, 594, 597, 602, 606, 610, 613, 616, 621, 624, 627, 630, 633, 636, 640,
+		644, 649, 655, 659, 662, 666, 670, 675, 678, 683, 687, 691, 695, 701, 706, 711,
+		714, 717, 721, 725, 729, 733, 737, 740, 743, 747, 750, 754, 758, 762, 766, 769,
+		772, 776, 781, 786, 792, 797, 800, 804, 807, 811, 815, 818, 823, 828, 832, 837,
+		841, 846, 850, 853, 857, 860, 864, 869, 874, 878, 881, 885, 888, 891, 895, 900,
+		904, 908, 911, 915, 918, 920, 924, 928, 931, 934, 939, 943, 946, 950, 953, 958,
+		963, 966, 969, 973, 977, 980, 983, 986, 989, 993, 997, 1000, 1003, 1007, 1011, 1015,
+		1018, 1023, 1027, 1033, 1037, 1040, 1043, 0, 4, 8, 12, 16, 21, 24, 28, 32,
+		36, 39, 44, 47, 53, 56, 59, 62, 66, 71, 74, 79, 84, 88, 92, 96,
+		101, 104, 107, 111, 115, 120, 124, 129, 133, 137, 141, 145, 149, 153, 157, 161,
+		164, 168, 172, 177, 181, 185, 189, 194, 199, 203, 207, 210, 214, 218, 221, 225,
+		230, 234, 238, 242, 247, 252, 256, 260, 265, 269, 274, 278, 281, 286, 290, 295,
+		298, 303, 307, 312, 318, 322, 327, 330, 334, 338, 344, 347, 350, 354, 359, 364,
+		367, 371, 375, 379, 384, 387, 392, 395, 399, 402, 407, 411, 415, 420, 424, 429,
+		432, 436, 441, 445, 448, 454, 458, 462, 467, 471, 474, 478, 482, 487, 490, 494,
+		497, 501, 504, 508, 512, 515, 519, 523, 527, 531, 536, 541, 545, 550, 554, 557,
+		561, 565, 570, 573, 577, 580, 585, 589, 594, 598, 603, 608, 612, 617, 621, 624,
+		629, 632, 636, 642, 645, 649, 653, 657, 660, 665, 669, 672, 678, 683, 687, 691,
+		695, 699, 702, 707, 711, 716, 721, 725, 729, 733, 737, 743, 746, 749, 755, 761,
+		765, 769, 774, 777, 781, 784, 789, 793, 797, 800, 804, 809, 812, 815, 820, 823,
+		827, 830, 833, 838, 842, 845, 850, 854, 858, 861, 865, 870, 875, 881, 886, 889,
+		895, 900, 904, 909, 914, 918, 921, 926, 932, 937, 940, 944, 947, 951, 955, 958,
+		961, 965, 969, 973, 977, 981, 986, 991, 995, 999, 1003, 1006, 1010, 1013, 1017, 1021,
+		1025, 1030, 1036, 1040, 1043, 1047, 1051, 1055, 0, 3, 6, 10, 14, 18, 23, 26,
+		30, 34, 38, 43, 47, 51, 56, 61, 64, 68, 71, 74, 78, 82, 86, 89,
+		92, 97, 101, 104, 107, 112, 117, 120, 123, 126, 130, 134, 137, 140, 144, 149,
+		153, 158, 163, 167, 171, 176, 181, 185, 190, 193, 196, 200, 203, 207, 211, 215,
+		220, 224, 228, 232, 237, 240, 244, 248, 251, 255, 259, 264, 268, 273, 278, 283,
+		288, 292, 295, 299, 302, 307, 312, 316, 320, 323, 328, 331, 334, 338, 342, 346,
+		349, 352, 357, 360, 363, 368, 372, 376, 379, 383, 387, 391, 394, 398, 402, 407,
+		411, 417, 422, 427, 433, 438, 442, 445, 448, 453, 457, 460, 464, 467, 471, 476,
+		481, 486, 488, 493, 496, 501, 504, 508, 512, 517, 520, 523, 527, 533, 536, 539,
+		542, 547, 551, 556, 560, 565, 569, 573, 576, 579, 583, 587, 591, 594, 597, 600,
+		604, 608, 611, 614, 618, 623, 626, 629, 633, 637, 641, 645, 649, 653, 657, 662,
+		666, 670, 674, 678, 683, 686, 691, 696, 701, 704, 708, 712, 716, 721, 724, 727,
+		731, 735, 739, 742, 747, 752, 756, 761, 767, 771, 774, 778, 783, 788, 792, 798,
+		802, 807, 811, 815, 819, 822, 825, 830, 833, 837, 840, 844, 847, 850, 854, 859,
+		863, 866, 871, 875, 879, 882, 885, 890, 894, 897, 901, 904, 907, 912, 916, 919,
+		923, 926, 930, 937, 941, 946, 950, 955, 959, 963, 966, 970, 973, 977, 982, 986,
+		988, 993, 998, 1003, 1006, 1010, 1014, 1017, 1022, 0, 4, 7, 11, 14, 17, 20,
+		23, 28, 32, 36, 40, 45, 49, 52, 56, 59, 63, 67, 71, 74, 77, 83,
+		88, 93, 98, 102, 106, 109, 113, 118, 122, 126, 130, 134, 138, 141, 145, 148,
+		152, 157, 161, 165, 168, 171, 176, 180, 183, 188, 193, 197, 201, 205, 208, 212,
+		215, 219, 223, 227, 232, 237, 242, 247, 250, 254, 258, 262, 265, 269, 273, 276,
+		279, 282, 287, 291, 296, 300, 303, 306, 309, 313, 318, 323, 326, 329, 333, 337,
+		341, 346, 351, 355, 362, 366, 370, 375, 378, 381, 386, 389, 394, 397, 402, 406,
+		409, 412, 417, 420, 426, 429, 432, 437, 440, 443, 448, 452, 455, 459, 464, 468,
+		471, 475, 478, 482, 486, 489, 492, 496, 500, 503, 506, 511, 514, 518, 523, 527,
+		531, 534, 538, 541, 544, 547, 551, 556, 559, 562, 566, 570, 573, 578, 582, 587,
+		593, 596, 599, 602, 607, 610, 614, 618, 623, 627, 630, 633, 637, 641, 644, 647,
+		651, 655, 659, 662, 668, 672, 675, 678, 682, 685, 689, 693, 699, 703, 708, 713,
+		717, 720, 723, 727, 730, 734, 738, 743, 748, 753, 757, 761, 766, 770, 774, 778,
+		781, 784, 788, 791, 795, 798, 802, 807, 811, 815, 819, 825, 830, 834, 837, 842,
+		847, 850, 855, 858, 862, 866, 869, 873, 877, 881, 884, 889, 892, 896, 901, 904,
+		907, 912, 917, 921, 925, 929, 933, 938, 942, 946, 950, 953, 956, 961, 967, 971,
+		975, 980, 983, 988, 992, 996, 999, 1003, 1008, 1011, 0, 3, 7, 11, 16, 20,
+		24, 28, 31, 35, 39, 42, 46, 51, 54, 58, 61, 65, 69, 74, 79, 83,
+		86, 91, 95, 99, 102, 106, 109, 112, 117, 121, 125, 130, 134, 139, 144, 149,
+		153, 156, 159, 163, 167, 171, 176, 179, 183, 187, 190, 193, 198, 201, 205, 208,
+		211, 215, 220, 225, 228, 232, 235, 239, 243, 246, 250, 255, 260, 264, 268, 272,
+		275, 281, 286, 289, 294, 297, 302, 305, 310, 315, 319, 322, 326, 329, 334, 339,
+		343, 347, 351, 354, 358, 361, 365, 369, 373, 377, 380, 385, 388, 394, 397, 402,
+		406, 410, 414, 417, 421, 425, 428, 431, 436, 438, 441, 447, 450, 455, 460, 465,
+		470, 475, 478, 482, 486, 490, 494, 499, 505, 509, 514, 518, 522, 527, 531, 535,
+		539, 542, 546, 550, 553, 557, 561, 566, 571, 575, 579, 582, 585, 589, 593, 598,
+		601, 606, 611, 616, 620, 624, 629, 632, 638, 641, 645, 650, 654, 659, 662, 666,
+		670, 674, 677, 682, 686, 689, 693, 696, 700, 705, 708, 712, 717, 722, 727, 732,
+		736, 740, 744, 748, 751, 755, 760, 766, 770, 775, 778, 782, 785, 790, 794, 797,
+		801, 805, 808, 812, 816, 819, 824, 828, 833, 837, 841, 844, 848, 853, 857, 860,
+		863, 867, 869, 871, 876, 880, 884, 887, 891, 896, 901, 906, 910, 913, 918, 922,
+		926, 931, 935, 941, 945, 950, 954, 956, 959, 962, 968, 972, 975, 980, 983, 986,
+		990, 993, 998, 1001, 1005, 1009, 1013, 1017, 1020, 1025, 1029, 0, 5, 8, 14, 18,
+		23, 27, 30, 35, 38, 43, 46, 51, 56, 61, 65, 69, 73, 77, 82, 86,
+		91, 95, 100, 103, 106, 109, 114, 119, 123, 129, 132, 137, 141, 144, 147, 150,
+		156, 160, 164, 168, 172, 177, 180, 185, 188, 192, 197, 200, 204, 208, 211, 215,
+		219, 224, 229, 233, 236, 240, 243, 246, 251, 254, 258, 263, 267, 273, 277, 283,
+		286, 289, 294, 299, 302, 306, 311, 315, 320, 324, 329, 333, 338, 341, 344, 347,
+		352, 355, 360, 363, 368, 372, 376, 381, 386, 389, 394, 398, 401, 406, 410, 413,
+		418, 421, 425, 429, 434, 437, 441, 445, 448, 452, 457, 462, 468, 471, 476, 480,
+		485, 490, 493, 496, 500, 504, 509, 512, 518, 521, 524, 528, 531, 534, 539, 543,
+		548, 551, 556, 561, 564, 568, 571, 574, 578, 581, 584, 587, 591, 594, 597, 600,
+		604, 607, 611, 614, 617, 620, 623, 626, 629, 633, 636, 641, 646, 650, 655, 658,
+		662, 666, 669, 673, 678, 681, 685, 689, 694, 698, 702, 706, 711, 716, 720, 724,
+		728, 732, 735, 738, 741, 744, 747, 750, 753, 756, 759, 762, 765, 768, 771, 774,
+		777, 780, 783, 786, 789, 792, 795, 798, 801, 804, 807, 810, 813, 816, 819, 822,
+		825, 828, 831, 834, 837, 840, 843, 846, 849, 852, 855, 858, 861, 864, 867, 870,
+		873, 876, 879, 882, 885, 888, 891, 894, 897, 900, 903, 906, 909, 912, 915, 918,
+		921, 924, 927, 930, 933, 936, 939, 942, 945, 948, 951, 951, 0, 3, 8, 12,
+		15, 21, 25, 29, 32, 38, 42, 48, 52, 55, 58, 61, 66, 70, 75, 80,
+		86, 90, 93, 97, 101, 105, 110, 113, 118, 123, 126, 132, 136, 140, 146, 150,
+		154, 160, 164, 169, 174, 178, 182, 185, 188, 194, 200, 203, 207, 212, 217, 223,
+		228, 232, 237, 241, 244, 248, 252, 256, 260, 265, 268, 271, 275, 278, 281, 284,
+		288, 294, 298, 302, 306, 310, 313, 316, 320, 323, 327, 330, 333, 336, 342, 347,
+		352, 357, 360, 365, 371, 377, 380, 384, 387, 391, 395, 398, 401, 404, 410, 414,
+		418, 422, 425, 429, 434, 438, 441, 446, 450, 454, 458, 462, 465, 469, 474, 478,
+		481, 485, 489, 492, 496, 499, 503, 507, 510, 515, 519, 523, 527, 531, 535, 540,
+		544, 547, 551, 556, 559, 562, 568, 572, 576, 579, 583, 586, 589, 592, 596, 599,
+		602, 605, 610, 613, 617, 621, 625, 628, 632, 635, 638, 641, 644, 648, 653, 658,
+		661, 666, 670, 673, 678, 683, 686, 689, 695, 700, 705, 711, 717, 722, 725, 729,
+		732, 736, 740, 744, 749, 753, 757, 761, 765, 768, 771, 776, 779, 783, 787, 790,
+		795, 798, 802, 805, 810, 813, 819, 823, 827, 832, 837, 842, 845, 849, 853, 857,
+		862, 865, 869, 872, 878, 881, 886, 891, 895, 900, 905, 909, 914, 918, 921, 924,
+		928, 933, 936, 941, 947, 950, 956, 959, 962, 966, 970, 974, 979, 984, 989, 995,
+		998, 1002, 1005, 1010, 1014, 1018, 1023, 1028, 1032, 1037, 1041, 1044, 1049, 0, 6, 9,
+		13, 16, 21, 25, 29, 32, 36, 41, 44, 47, 50, 53, 56, 59, 62, 66,
+		70, 74, 78, 81, 86, 92, 96, 100, 104, 107, 111, 117, 121, 125, 131, 135,
+		138, 143, 149, 155, 159, 164, 168, 172, 176, 180, 183, 188, 191, 194, 197, 200,
+		203, 207, 211, 214, 219, 224, 229, 232, 235, 240, 243, 247, 250, 253, 256, 259,
+		265, 270, 274, 278, 282, 286, 289, 292, 295, 300, 303, 307, 310, 314, 318, 322,
+		327, 330, 335, 338, 341, 345, 349, 352, 355, 358, 362, 366, 371, 376, 379, 384,
+		387, 392, 395, 400, 404, 407, 411, 415, 420, 423, 427, 433, 436, 441, 445, 448,
+		453, 457, 460, 465, 468, 472, 476, 480, 485, 490, 495, 498, 501, 505, 510, 515,
+		519, 523, 527, 531, 535, 538, 542, 546, 552, 555, 559, 563, 566, 570, 573, 577,
+		580, 583, 587, 590, 594, 599, 603, 607, 611, 616, 621, 624, 627, 632, 636, 640,
+		644, 647, 650, 655, 660, 663, 668, 673, 678, 683, 688, 692, 695, 699, 705, 711,
+		716, 720, 725, 729, 732, 735, 739, 744, 749, 753, 756, 760, 764, 769, 773, 776,
+		779, 782, 784, 788, 791, 797, 800, 803, 807, 810, 813, 818, 821, 826, 829, 834,
+		838, 843, 848, 852, 856, 859, 862, 865, 868, 871, 876, 880, 883, 886, 890, 894,
+		898, 901, 904, 908, 913, 917, 921, 925, 928, 932, 935, 940, 944, 947, 950, 953,
+		958, 962, 967, 972, 976, 980, 985, 988, 991, 995, 998, 1002, 1005, 1010, 0, 6,
+		10, 15, 19, 23, 27, 31, 35, 39, 44, 49, 52, 57, 61, 66, 70, 74,
+		78, 82, 86, 90, 95, 100, 105, 110, 114, 117, 120, 123, 127, 132, 136, 140,
+		146, 150, 155, 159, 164, 169, 174, 177, 182, 185, 189, 194, 198, 202, 207, 212,
+		216, 221, 224, 228, 231, 234, 238, 242, 247, 252, 255, 259, 263, 268, 273, 278,
+		284, 289, 293, 298, 304, 309, 313, 317, 321, 324, 329, 333, 336, 340, 345, 349,
+		354, 359, 362, 364, 368, 372, 378, 382, 385, 389, 393, 398, 402, 405, 411, 415,
+		420, 425, 429, 433, 438, 441, 445, 448, 451, 454, 458, 463, 468, 471, 474, 478,
+		482, 485, 490, 493, 498, 501, 505, 509, 513, 516, 519, 523, 527, 531, 537, 541,
+		545, 549, 552, 556, 559, 564, 569, 572, 575, 579, 583, 586, 591, 596, 601, 605,
+		609, 612, 617, 622, 626, 631, 635, 639, 643, 648, 653, 657, 661, 665, 670, 673,
+		678, 682, 686, 690, 695, 698, 702, 706, 710, 714, 718, 722, 727, 731, 736, 741,
+		746, 749, 755, 758, 763, 767, 773, 779, 784, 788, 792, 796, 802, 807, 811, 814,
+		817, 820, 824, 829, 833, 838, 841, 845, 850, 855, 859, 864, 869, 875, 881, 886,
+		891, 897, 901, 905, 908, 911, 915, 921, 927, 932, 935, 940, 945, 949, 952, 956,
+		960, 966, 971, 975, 980, 984, 989, 993, 998, 1002, 1004, 1009, 1014, 1019, 1023, 1026,
+		1029, 1034, 1037, 1042, 1048, 1052, 1055, 1059, 1063, 1068, 1071, 1076, 1081, 1084, 1088, 0,
+		3, 7, 12, 17, 20, 25, 30, 35, 40, 45, 49, 53, 58, 62, 66, 70,
+		74, 77, 80, 84, 89, 94, 98, 101, 105, 110, 115, 118, 123, 129, 132, 138,
+		142, 146, 149, 155, 159, 162, 167, 171, 175, 178, 182, 186, 191, 196, 199, 204,
+		208, 213, 217, 222, 227, 231, 235, 238, 242, 246, 250, 255, 259, 263, 268, 271,
+		274, 278, 282, 287, 293, 299, 304, 310, 315, 321, 325, 328, 332, 337, 340, 346,
+		349, 353, 356, 360, 363, 366, 370, 374, 378, 382, 387, 392, 396, 400, 404, 409,
+		413, 417, 422, 425, 431, 434, 439, 443, 448, 454, 457, 460, 465, 469, 472, 476,
+		480, 485, 490, 495, 500, 505, 508, 511, 516, 519, 525, 530, 535, 540, 543, 546,
+		549, 554, 560, 564, 568, 572, 577, 581, 586, 590, 593, 599, 602, 606, 610, 616,
+		620, 625, 629, 633, 636, 641, 644, 649, 653, 657, 661, 665, 670, 673, 676, 680,
+		685, 690, 696, 699, 704, 709, 713, 717, 720, 724, 727, 732, 737, 741, 746, 751,
+		756, 759, 765, 769, 772, 778, 781, 786, 790, 793, 797, 802, 807, 811, 815, 820,
+		825, 829, 833, 836, 839, 842, 847, 852, 857, 863, 868, 873, 878, 883, 887, 892,
+		896, 900, 903, 906, 911, 916, 922, 926, 929, 933, 937, 941, 945, 950, 953, 956,
+		959, 963, 967, 971, 976, 979, 983, 988, 993, 997, 1000, 1003, 1008, 1013, 1018, 1022,
+		1028, 1032, 1036, 1041, 1046, 1049, 1055, 1060, 1063, 1066, 1070, 1073, 1078, 1082, 1086, 1090,
+		0, 4, 9, 13, 17, 21, 28, 32, 36, 39, 42, 46, 51, 55, 59, 63,
+		66, 70, 75, 79, 83, 87, 90, 94, 98, 102, 105, 110, 117, 120, 124, 127,
+		130, 135, 139, 144, 150, 154, 158, 162, 166, 170, 173, 178, 182, 187, 192, 196,
+		200, 204, 211, 215, 218, 222, 225, 229, 234, 239, 243, 246, 249, 253, 257, 260,
+		264, 268, 272, 275, 278, 283, 287, 291, 294, 299, 303, 307, 310, 313, 318, 322,
+		329, 333, 338, 342, 345, 348, 352, 356, 361, 364, 371, 374, 379, 383, 386, 389,
+		393, 398, 402, 407, 412, 415, 418, 422, 425, 429, 435, 440, 444, 449, 452, 456,
+		459, 465, 470, 473, 477, 481, 488, 493, 498, 503, 506, 509, 513, 518, 523, 527,
+		532, 536, 541, 545, 549, 553, 558, 563, 566, 569, 573, 577, 582, 586, 591, 596,
+		601, 605, 608, 613, 616, 621, 625, 630, 635, 638, 641, 645, 648, 651, 656, 660,
+		665, 669, 672, 676, 680, 684, 689, 693, 697, 702, 706, 709, 713, 716, 721, 724,
+		728, 733, 736, 741, 745, 749, 754, 758, 763, 766, 771, 775, 781, 785, 789, 792,
+		796, 799, 804, 808, 811, 815, 819, 824, 829, 834, 839, 842, 846, 851, 856, 859,
+		863, 868, 872, 875, 879, 884, 889, 892, 895, 899, 902, 905, 909, 914, 920, 924,
+		927, 930, 935, 939, 942, 946, 952, 957, 962, 967, 970, 974, 978, 983, 988, 991,
+		995, 999, 1003, 1007, 1011, 1014, 1017, 1021, 1026, 1031, 1035, 1040, 1044, 1048, 1052, 1056,
+		1059, 0, 4, 9, 13, 17, 21, 25, 30, 33, 39, 44, 47, 51, 54, 57,
+		60, 64, 67, 72, 75, 79, 85, 88, 92, 96, 100, 104, 109, 113, 116, 119,
+		125, 130, 136, 142, 145, 149, 152, 157, 161, 165, 170, 176, 180, 183, 187, 191,
+		195, 199, 203, 207, 212, 217, 222, 225, 229, 232, 235, 238, 241, 244, 248, 252,
+		257, 260, 263, 267, 271, 276, 281, 284, 287, 293, 296, 300, 304, 308, 311, 315,
+		319, 323, 327, 331, 334, 339, 343, 347, 351, 355, 359, 362, 365, 371, 374, 380,
+		385, 388, 393, 397, 400, 404, 407, 410, 414, 417, 421, 425, 429, 433, 437, 443,
+		447, 451, 455, 459, 464, 467, 471, 476, 480, 485, 489, 492, 496, 500, 503, 506,
+		508, 513, 516, 520, 524, 529, 531, 536, 539, 543, 547, 550, 553, 556, 560, 564,
+		568, 572, 577, 580, 583, 586, 590, 593, 598, 601, 604, 608, 612, 617, 621, 625,
+		629, 634, 637, 642, 648, 652, 655, 659, 663, 668, 672, 676, 680, 683, 687, 691,
+		694, 697, 703, 707, 710, 714, 718, 723, 726, 731, 735, 739, 742, 745, 748, 752,
+		755, 760, 766, 770, 774, 778, 782, 786, 789, 793, 797, 801, 804, 808, 815, 819,
+		824, 829, 832, 835, 839, 843, 847, 852, 855, 860, 865, 868, 872, 876, 880, 884,
+		888, 892, 896, 900, 905, 909, 912, 915, 920, 924, 928, 932, 936, 941, 946, 949,
+		954, 957, 961, 965, 969, 973, 977, 980, 984, 988, 991, 995, 999, 1002, 1007, 1010,
+		1015, 1020, 0, 4, 7, 12, 16, 19, 24, 28, 31, 34, 37, 42, 46, 51,
+		56, 60, 63, 66, 72, 76, 80, 83, 86, 88, 91, 94, 98, 101, 105, 110,
+		114, 118, 122, 126, 129, 133, 137, 141, 144, 148, 151, 155, 159, 161, 165, 170,
+		175, 180, 183, 188, 192, 197, 200, 203, 208, 211, 215, 218, 223, 227, 231, 235,
+		238, 241, 245, 248, 252, 255, 257, 260, 264, 267, 270, 276, 279, 283, 286, 291,
+		296, 300, 306, 309, 313, 316, 319, 323, 326, 329, 333, 338, 343, 347, 353, 356,
+		358, 362, 367, 371, 375, 378, 380, 385, 389, 393, 396, 401, 405, 410, 414, 417,
+		420, 423, 428, 431, 434, 437, 441, 444, 447, 451, 455, 459, 462, 467, 472, 475,
+		480, 483, 486, 489, 493, 496, 500, 504, 508, 512, 515, 518, 523, 526, 530, 533,
+		537, 541, 546, 549, 553, 558, 561, 564, 567, 570, 574, 577, 582, 587, 592, 596,
+		599, 604, 608, 611, 614, 616, 622, 626, 629, 632, 636, 639, 643, 646, 650, 654,
+		657, 662, 665, 671, 675, 680, 683, 687, 691, 696, 700, 705, 707, 712, 716, 720,
+		724, 728, 731, 734, 738, 743, 748, 753, 759, 763, 766, 769, 773, 776, 779, 783,
+		789, 792, 796, 802, 805, 808, 813, 816, 820, 825, 829, 835, 840, 844, 848, 851,
+		856, 860, 863, 867, 872, 877, 881, 886, 889, 891, 895, 900, 903, 906, 909, 912,
+		915, 920, 925, 929, 932, 936, 940, 946, 949, 953, 956, 960, 964, 967, 971, 976,
+		981, 986, 989, 0, 4, 8, 11, 14, 18, 22, 26, 31, 34, 37, 41, 44,
+		49, 54, 57, 60, 65, 69, 73, 77, 80, 84, 87, 91, 94, 97, 101, 104,
+		108, 113, 117, 121, 125, 131, 135, 139, 144, 149, 153, 156, 160, 163, 168, 172,
+		175, 178, 183, 187, 192, 198, 201, 204, 210, 213, 217, 220, 225, 228, 233, 237,
+		241, 244, 248, 251, 254, 259, 263, 267, 272, 277, 283, 286, 291, 295, 297, 302,
+		308, 312, 315, 319, 323, 327, 330, 333, 336, 340, 344, 348, 351, 354, 357, 361,
+		366, 369, 372, 375, 379, 383, 389, 394, 397, 401, 404, 407, 412, 416, 419, 423,
+		426, 432, 436, 440, 444, 448, 451, 456, 460, 463, 468, 473, 478, 481, 486, 490,
+		493, 496, 500, 504, 507, 512, 516, 520, 525, 528, 532, 535, 539, 543, 547, 551,
+		556, 561, 565, 568, 571, 575, 579, 583, 586, 591, 594, 598, 604, 608, 613, 618,
+		621, 624, 627, 631, 637, 641, 645, 648, 654, 657, 661, 666, 672, 675, 680, 684,
+		689, 693, 697, 701, 705, 708, 711, 715, 719, 724, 729, 733, 738, 742, 745, 748,
+		752, 756, 759, 763, 767, 770, 774, 777, 779, 783, 789, 792, 797, 800, 805, 809,
+		812, 816, 819, 823, 827, 830, 833, 838, 843, 846, 850, 853, 856, 861, 864, 869,
+		873, 876, 879, 883, 887, 892, 895, 898, 902, 906, 910, 914, 918, 922, 926, 930,
+		933, 937, 941, 944, 947, 950, 954, 957, 963, 968, 971, 974, 977, 982, 986, 990,
+		993, 997, 1001, 1005, 0, 3, 6, 11, 17, 22, 26, 30, 34, 38, 42, 45,
+		50, 55, 59, 62, 65, 70, 73, 77, 81, 84, 89, 92, 98, 101, 104, 107,
+		110, 113, 116, 120, 123, 126, 131, 134, 139, 144, 149, 154, 157, 162, 166, 170,
+		174, 178, 183, 187, 192, 195, 199, 204, 208, 212, 217, 220, 224, 227, 232, 237,
+		240, 244, 248, 252, 255, 259, 261, 265, 269, 273, 277, 281, 288, 291, 295, 298,
+		302, 308, 313, 316, 319, 322, 326, 331, 334, 339, 343, 347, 350, 354, 358, 362,
+		366, 369, 372, 376, 381, 384, 388, 391, 396, 400, 405, 409, 413, 416, 418, 422,
+		425, 429, 434, 439, 442, 447, 451, 456, 459, 463, 467, 470, 474, 478, 483, 487,
+		491, 494, 497, 501, 505, 510, 513, 517, 520, 524, 527, 531, 535, 538, 542, 545,
+		550, 553, 557, 561, 564, 570, 574, 577, 580, 583, 586, 590, 593, 597, 601, 605,
+		608, 611, 617, 621, 624, 628, 633, 638, 643, 646, 650, 653, 656, 661, 664, 668,
+		673, 677, 680, 686, 690, 693, 696, 700, 703, 707, 712, 717, 722, 727, 730, 733,
+		738, 743, 748, 753, 757, 763, 767, 772, 775, 778, 783, 788, 791, 794, 798, 803,
+		807, 810, 813, 816, 821, 824, 828, 832, 836, 841, 845, 848, 852, 856, 860, 864,
+		867, 871, 876, 880, 884, 888, 892, 897, 902, 906, 911, 916, 922, 927, 931, 936,
+		939, 943, 948, 952, 956, 960, 965, 969, 972, 976, 980, 984, 988, 991, 996, 999,
+		1003, 1007, 1011, 1014, 1018, 0, 4, 8, 12, 15, 18, 22, 27, 31, 36, 39,
+		44, 48, 54, 58, 63, 68, 71, 74, 79, 84, 86, 89, 92, 95, 100, 104,
+		108, 111, 116, 121, 126, 129, 132, 135, 140, 146, 149, 152, 156, 159, 163, 167,
+		170, 173, 176, 180, 183, 186, 189, 194, 199, 204, 209, 214, 218, 225, 230, 236,
+		242, 245, 249, 252, 255, 259, 263, 267, 271, 275, 279, 283, 287, 291, 296, 300,
+		305, 309, 313, 317, 321, 324, 328, 333, 336, 341, 344, 349, 352, 357, 360, 365,
+		370, 374, 377, 380, 383, 387, 392, 395, 399, 404, 408, 411, 415, 419, 423, 427,
+		432, 437, 441, 444, 448, 451, 457, 462, 465, 468, 472, 476, 479, 482, 486, 490,
+		493, 497, 501, 506, 510, 513, 516, 520, 525, 528, 533, 538, 541, 544, 548, 552,
+		556, 561, 566, 569, 572, 575, 578, 582, 586, 590, 594, 598, 602, 607, 610, 616,
+		620, 624, 628, 632, 636, 641, 645, 648, 652, 657, 661, 665, 669, 675, 680, 682,
+		685, 690, 695, 698, 702, 705, 709, 714, 718, 723, 727, 730, 735, 740, 745, 750,
+		754, 757, 760, 764, 768, 771, 776, 780, 785, 788, 793, 798, 802, 807, 811, 815,
+		818, 822, 827, 832, 838, 842, 846, 850, 854, 858, 861, 867, 872, 875, 879, 883,
+		887, 893, 896, 901, 904, 907, 910, 913, 918, 922, 925, 931, 935, 938, 942, 948,
+		953, 959, 964, 968, 972, 975, 979, 983, 988, 992, 996, 1000, 1003, 1007, 1010, 1014,
+		1017, 1022, 1025, 1029, 1032, 1035, 0, 3, 6, 11, 15, 18, 23, 27, 31, 36,
+		39, 41, 46, 49, 52, 57, 62, 65, 70, 74, 77, 82, 86, 90, 93, 99,
+		103, 107, 110, 115, 119, 123, 129, 133, 137, 141, 144, 147, 151, 155, 160, 162,
+		167, 171, 176, 179, 185, 187, 191, 195, 199, 202, 206, 212, 215, 219, 223, 228,
+		234, 238, 243, 248, 253, 259, 264, 270, 277, 283, 289, 294, 299, 303, 308, 311,
+		314, 319, 324, 327, 333, 338, 342, 345, 348, 352, 355, 358, 363, 369, 372, 377,
+		381, 387, 390, 395, 400, 404, 409, 413, 418, 421, 427, 431, 434, 439, 442, 447,
+		451, 455, 460, 465, 469, 473, 477, 481, 486, 492, 497, 502, 507, 510, 513, 516,
+		521, 525, 528, 534, 541, 545, 549, 553, 557, 562, 567, 570, 574, 577, 583, 587,
+		592, 597, 602, 606, 609, 613, 619, 623, 626, 632, 635, 639, 643, 646, 651, 654,
+		660, 666, 670, 675, 680, 685, 688, 693, 698, 701, 704, 709, 714, 718, 722, 725,
+		729, 733, 737, 740, 746, 750, 754, 758, 763, 766, 770, 776, 780, 783, 786, 790,
+		795, 798, 804, 807, 812, 816, 819, 822, 825, 830, 836, 839, 844, 847, 851, 855,
+		858, 863, 868, 873, 878, 882, 886, 889, 892, 895, 898, 902, 906, 911, 916, 920,
+		926, 929, 933, 937, 941, 946, 951, 956, 960, 963, 967, 972, 976, 980, 984, 988,
+		991, 995, 999, 1003, 1007, 1014, 1021, 1027, 1030, 1034, 1037, 1041, 1048, 1051, 1054, 1058,
+		1061, 1064, 1067, 1071, 1076, 1081, 1085, 0, 5, 8, 12, 15, 19, 25, 30, 34,
+		38, 43, 47, 51, 56, 59, 63, 67, 73, 77, 81, 85, 89, 92, 96, 101,
+		105, 108, 112, 116, 119, 124, 128, 132, 136, 139, 144, 149, 153, 157, 162, 165,
+		168, 173, 177, 180, 185, 189, 194, 199, 203, 207, 211, 215, 219, 223, 226, 229,
+		233, 237, 241, 245, 251, 255, 259, 262, 265, 270, 275, 278, 282, 286, 291, 294,
+		298, 303, 307, 311, 316, 321, 325, 329, 334, 337, 341, 345, 349, 352, 358, 362,
+		367, 371, 374, 380, 384, 389, 393, 398, 403, 406, 410, 415, 419, 422, 425, 428,
+		434, 439, 443, 447, 453, 457, 461, 465, 468, 473, 477, 480, 483, 488, 492, 497,
+		502, 505, 509, 513, 517, 521, 526, 530, 533, 538, 541, 545, 550, 555, 562, 565,
+		568, 572, 576, 579, 584, 588, 592, 596, 602, 605, 608, 611, 616, 622, 626, 630,
+		634, 639, 643, 647, 651, 658, 662, 666, 669, 673, 677, 680, 683, 687, 692, 697,
+		702, 705, 708, 712, 715, 718, 721, 726, 731, 736, 739, 743, 748, 752, 757, 761,
+		763, 767, 770, 773, 776, 781, 785, 787, 790, 793, 796, 802, 806, 810, 813, 816,
+		821, 824, 827, 831, 835, 840, 845, 849, 853, 856, 859, 864, 868, 871, 874, 879,
+		883, 887, 891, 896, 902, 906, 909, 913, 919, 923, 928, 933, 937, 940, 945, 950,
+		953, 958, 962, 965, 968, 973, 978, 984, 988, 992, 996, 1001, 1005, 1009, 1013, 1017,
+		1021, 1025, 1030, 1034, 1038, 1042, 1047, 1050, 0, 5, 9, 14, 17, 21, 24, 28,
+		33, 38, 43, 48, 53, 56, 62, 67, 72, 75, 79, 82, 86, 89, 93, 98,
+		103, 109, 114, 118, 121, 125, 129, 133, 138, 143, 147, 151, 154, 161, 163, 167,
+		171, 175, 180, 183, 187, 190, 193, 197, 200, 203, 206, 209, 212, 217, 220, 223,
+		228, 231, 236, 241, 246, 252, 255, 258, 260, 262, 266, 271, 276, 280, 283, 286,
+		290, 295, 299, 304, 309, 313, 316, 320, 323, 325, 328, 332, 336, 340, 344, 349,
+		352, 357, 362, 366, 371, 376, 381, 386, 389, 392, 396, 399, 403, 408, 413, 416,
+		421, 425, 429, 433, 437, 441, 444, 447, 450, 455, 460, 464, 468, 473, 478, 483,
+		488, 493, 497, 500, 504, 508, 512, 516, 519, 522, 526, 529, 533, 537, 541, 546,
+		550, 554, 557, 562, 567, 571, 574, 578, 583, 586, 592, 596, 602, 607, 610, 615,
+		620, 624, 628, 632, 637, 642, 646, 649, 653, 657, 662, 666, 670, 673, 678, 682,
+		685, 689, 692, 695, 699, 703, 706, 710, 714, 718, 722, 725, 728, 733, 738, 743,
+		746, 750, 754, 758, 762, 765, 768, 772, 776, 780, 784, 788, 793, 798, 802, 807,
+		812, 817, 820, 823, 828, 833, 837, 842, 847, 851, 855, 858, 861, 865, 868, 873,
+		876, 880, 885, 889, 892, 898, 903, 908, 911, 914, 917, 921, 925, 929, 935, 941,
+		944, 948, 952, 956, 959, 963, 966, 969, 972, 976, 982, 985, 989, 992, 995, 1000,
+		1004, 1009, 1013, 1018, 1023, 1026, 1031, 1036, 1040, 0, 4, 9, 13, 16, 19, 23,
+		26, 30, 34, 37, 41, 46, 51, 55, 59, 63, 68, 71, 75, 80, 83, 87,
+		90, 95, 99, 105, 110, 115, 119, 124, 129, 132, 136, 139, 145, 150, 153, 158,
+		163, 167, 170, 173, 177, 182, 185, 189, 193, 197, 202, 206, 211, 214, 219, 224,
+		229, 232, 237, 242, 245, 248, 252, 257, 261, 265, 272, 277, 282, 288, 292, 297,
+		302, 309, 315, 320, 323, 326, 331, 335, 339, 343, 346, 350, 354, 358, 363, 367,
+		370, 373, 377, 381, 384, 388, 391, 396, 400, 405, 408, 412, 416, 419, 422, 425,
+		429, 433, 439, 443, 448, 452, 457, 461, 466, 471, 475, 480, 483, 486, 489, 492,
+		495, 498, 502, 505, 508, 512, 516, 520, 525, 530, 535, 539, 544, 547, 551, 556,
+		562, 565, 570, 573, 576, 581, 585, 589, 593, 598, 601, 606, 611, 614, 618, 623,
+		628, 633, 638, 643, 648, 652, 655, 659, 663, 667, 673, 678, 681, 686, 690, 694,
+		699, 702, 707, 711, 716, 721, 727, 731, 736, 741, 745, 749, 753, 757, 761, 766,
+		770, 775, 780, 785, 791, 794, 798, 803, 807, 810, 814, 818, 821, 826, 829, 833,
+		836, 840, 845, 849, 854, 859, 862, 867, 872, 877, 881, 885, 889, 892, 896, 901,
+		905, 910, 914, 917, 921, 926, 931, 935, 938, 942, 945, 949, 953, 956, 959, 964,
+		968, 972, 977, 982, 986, 990, 995, 999, 1004, 1008, 1013, 1016, 1019, 1023, 1027, 1033,
+		1037, 1042, 1046, 1050, 1053, 1057, 1061, 1064, 1068, 1073, 0, 3, 8, 14, 17, 21,
+		24, 30, 36, 42, 46, 50, 54, 58, 62, 66, 71, 74, 79, 82, 85, 88,
+		91, 96, 100, 105, 111, 117, 120, 123, 129, 134, 139, 144, 148, 153, 158, 162,
+		167, 172, 176, 181, 186, 191, 196, 199, 203, 207, 211, 217, 221, 225, 228, 232,
+		236, 240, 244, 248, 252, 256, 260, 263, 267, 271, 276, 279, 282, 287, 290, 293,
+		296, 300, 305, 308, 311, 315, 318, 323, 327, 331, 336, 339, 343, 348, 351, 354,
+		359, 362, 366, 370, 372, 376, 381, 384, 388, 392, 396, 399, 402, 405, 408, 413,
+		416, 419, 423, 426, 430, 435, 439, 443, 446, 450, 454, 457, 462, 465, 470, 473,
+		477, 482, 486, 491, 494, 497, 501, 504, 508, 513, 516, 520, 524, 527, 531, 535,
+		539, 543, 547, 550, 553, 557, 559, 562, 567, 571, 576, 579, 583, 588, 591, 595,
+		599, 602, 607, 612, 615, 619, 623, 626, 630, 634, 639, 642, 647, 650, 654, 658,
+		662, 665, 670, 673, 677, 680, 684, 688, 693, 697, 701, 705, 709, 715, 719, 722,
+		725, 728, 732, 735, 740, 745, 749, 754, 759, 763, 767, 771, 775, 780, 784, 787,
+		792, 795, 798, 802, 804, 807, 811, 815, 818, 821, 824, 828, 833, 838, 841, 844,
+		848, 851, 855, 858, 862, 865, 868, 871, 876, 880, 884, 889, 893, 896, 900, 905,
+		911, 916, 921, 923, 925, 928, 931, 934, 939, 943, 948, 953, 957, 960, 964, 969,
+		974, 978, 983, 989, 992, 997, 1001, 1005, 1009, 1013, 1016, 0, 5, 9, 13, 17,
+		22, 26, 31, 37, 43, 46, 50, 54, 57, 61, 64, 68, 72, 76, 79, 82,
+		86, 89, 92, 97, 101, 105, 110, 114, 118, 124, 128, 132, 137, 141, 147, 151,
+		155, 158, 162, 167, 171, 176, 179, 183, 188, 193, 196, 200, 203, 206, 212, 215,
+		219, 224, 229, 233, 237, 240, 243, 247, 251, 255, 259, 261, 265, 269, 272, 276,
+		280, 284, 287, 290, 295, 300, 306, 310, 315, 318, 321, 326, 330, 334, 338, 342,
+		346, 351, 355, 359, 364, 374, 378, 381, 390, 394, 397, 401, 404, 409, 414, 419,
+		424, 428, 431, 435, 438, 443, 448, 451, 455, 460, 465, 469, 474, 479, 483, 487,
+		490, 494, 498, 503, 508, 514, 519, 524, 529, 533, 537, 542, 545, 549, 555, 560,
+		565, 568, 572, 575, 581, 585, 589, 593, 597, 600, 603, 606, 611, 617, 622, 625,
+		629, 634, 638, 642, 647, 651, 656, 661, 665, 670, 674, 678, 683, 687, 691, 696,
+		700, 704, 709, 712, 716, 720, 723, 726, 729, 731, 736, 739, 743, 746, 749, 752,
+		755, 759, 763, 766, 771, 775, 780, 783, 786, 790, 794, 797, 801, 804, 808, 813,
+		818, 823, 828, 831, 834, 839, 844, 848, 852, 856, 860, 865, 870, 875, 880, 885,
+		890, 894, 898, 902, 906, 910, 914, 920, 924, 930, 935, 941, 946, 950, 955, 960,
+		964, 969, 973, 978, 981, 986, 990, 993, 997, 1001, 1006, 1010, 1014, 1017, 1020, 1023,
+		1026, 1030, 1035, 1041, 1044, 1048, 1052, 1057, 1062, 1066, 1070, 1073, 0, 3, 6, 10,
+		13, 18, 24, 28, 32, 36, 40, 43, 47, 50, 53, 57, 61, 67, 72, 76,
+		79, 83, 86, 90, 93, 98, 102, 107, 110, 114, 117, 120, 123, 126, 131, 135,
+		140, 143, 149, 153, 159, 164, 169, 174, 178, 182, 185, 190, 194, 198, 203, 208,
+		214, 218, 222, 226, 230, 236, 240, 243, 246, 250, 254, 257, 261, 267, 270, 273,
+		277, 280, 283, 286, 291, 296, 303, 308, 312, 318, 322, 325, 329, 334, 338, 344,
+		349, 353, 356, 361, 365, 368, 371, 376, 382, 388, 393, 396, 400, 403, 407, 414,
+		417, 421, 427, 430, 435, 440, 444, 449, 454, 459, 463, 467, 471, 476, 480, 484,
+		489, 494, 499, 504, 509, 514, 518, 522, 527, 531, 535, 538, 544, 547, 552, 555,
+		561, 568, 571, 576, 579, 583, 587, 594, 598, 601, 605, 609, 612, 615, 618, 625,
+		628, 633, 636, 640, 645, 648, 653, 658, 661, 664, 669, 674, 678, 684, 687, 691,
+		696, 700, 704, 707, 711, 716, 719, 723, 726, 731, 736, 740, 743, 748, 754, 758,
+		762, 765, 768, 772, 777, 782, 786, 791, 795, 800, 803, 806, 811, 815, 818, 824,
+		828, 832, 835, 839, 843, 847, 852, 857, 861, 866, 870, 875, 879, 883, 887, 890,
+		894, 898, 902, 907, 911, 914, 917, 921, 926, 929, 932, 936, 941, 947, 950, 954,
+		960, 964, 969, 972, 978, 982, 986, 990, 995, 998, 1001, 1006, 1011, 1016, 1021, 1024,
+		1029, 1033, 1037, 1041, 1046, 1049, 1052, 1057, 1061, 1065, 1069, 1074, 1079, 0, 4, 9,
+		12, 15, 20, 24, 27, 31, 34, 39, 42, 45, 48, 51, 55, 59, 62, 66,
+		70, 75, 80, 84, 87, 90, 95, 98, 102, 106, 112, 115, 120, 123, 129, 133,
+		137, 140, 143, 146, 151, 154, 159, 162, 167, 172, 175, 178, 183, 187, 192, 196,
+		201, 206, 210, 216, 222, 228, 233, 237, 243, 246, 249, 253, 257, 262, 266, 270,
+		273, 276, 280, 285, 289, 294, 298, 302, 308, 313, 316, 320, 324, 328, 331, 334,
+		338, 343, 346, 349, 354, 358, 362, 366, 370, 373, 376, 379, 382, 386, 390, 395,
+		400, 404, 409, 413, 417, 420, 424, 428, 433, 438, 442, 447, 452, 457, 463, 468,
+		472, 476, 481, 485, 488, 493, 499, 502, 507, 511, 514, 518, 523, 526, 531, 537,
+		540, 545, 550, 554, 557, 561, 565, 570, 575, 578, 582, 585, 591, 595, 598, 603,
+		606, 611, 615, 619, 624, 627, 631, 634, 639, 642, 648, 652, 657, 662, 666, 669,
+		673, 677, 682, 686, 690, 695, 701, 704, 708, 712, 717, 721, 725, 728, 734, 739,
+		743, 746, 752, 758, 762, 765, 771, 775, 780, 784, 789, 793, 797, 802, 808, 812,
+		815, 819, 823, 826, 829, 832, 836, 840, 845, 849, 852, 856, 860, 865, 869, 872,
+		875, 879, 882, 886, 890, 894, 899, 904, 907, 911, 916, 921, 925, 929, 935, 941,
+		944, 947, 950, 953, 956, 960, 964, 969, 975, 980, 983, 988, 992, 995, 999, 1004,
+		1008, 1012, 1017, 1022, 1026, 1032, 1036, 1041, 1046, 1050, 1055, 1058, 1062, 1066, 0, 5,
+		9, 14, 17, 20, 26, 33, 36, 39, 43, 48, 52, 56, 60, 64, 69, 73,
+		77, 80, 84, 90, 93, 96, 100, 103, 106, 109, 112, 117, 120, 125, 128, 132,
+		135, 138, 142, 145, 148, 153, 158, 163, 166, 171, 174, 177, 180, 184, 188, 193,
+		198, 203, 209, 212, 216, 219, 222, 225, 229, 237, 242, 246, 249, 252, 255, 259,
+		263, 267, 271, 274, 278, 283, 289, 292, 296, 300, 305, 310, 316, 320, 324, 329,
+		333, 338, 341, 346, 350, 352, 356, 360, 365, 369, 373, 377, 381, 384, 390, 395,
+		399, 403, 406, 409, 413, 419, 422, 426, 429, 432, 437, 442, 447, 452, 455, 459,
+		463, 468, 472, 475, 479, 481, 486, 491, 495, 499, 502, 506, 510, 515, 520, 525,
+		530, 533, 537, 542, 546, 549, 552, 556, 559, 563, 567, 570, 574, 578, 582, 585,
+		588, 591, 596, 600, 603, 607, 611, 614, 619, 624, 629, 635, 639, 642, 645, 649,
+		652, 658, 663, 668, 671, 675, 678, 682, 686, 688, 692, 697, 702, 705, 709, 714,
+		718, 722, 726, 730, 736, 740, 743, 746, 751, 756, 761, 765, 768, 773, 778, 782,
+		785, 789, 793, 796, 801, 806, 810, 814, 819, 824, 828, 833, 837, 840, 844, 847,
+		851, 854, 859, 863, 866, 869, 873, 878, 883, 886, 890, 893, 898, 902, 906, 910,
+		912, 916, 921, 926, 931, 936, 939, 943, 949, 952, 956, 960, 964, 968, 973, 977,
+		981, 985, 991, 996, 1000, 1006, 1011, 1017, 1020, 1025, 1028, 1033, 1037, 1042, 1045, 0,
+		5, 10, 14, 19, 23, 26, 31, 35, 38, 41, 44, 49, 54, 58, 61, 64,
+		69, 74, 78, 83, 87, 89, 94, 98, 101, 104, 107, 110, 114, 118, 122, 126,
+		130, 135, 139, 144, 148, 153, 157, 161, 165, 170, 174, 177, 180, 183, 187, 192,
+		198, 201, 204, 207, 214, 217, 221, 225, 231, 236, 240, 244, 249, 252, 256, 261,
+		265, 269, 274, 279, 282, 286, 290, 294, 297, 300, 305, 309, 315, 319, 324, 329,
+		334, 338, 343, 348, 351, 354, 358, 362, 366, 370, 374, 378, 382, 385, 391, 396,
+		399, 406, 410, 415, 419, 423, 427, 431, 435, 440, 443, 448, 452, 456, 459, 465,
+		469, 474, 478, 483, 487, 492, 497, 502, 505, 509, 513, 518, 521, 524, 527, 532,
+		537, 542, 546, 552, 556, 561, 567, 570, 573, 578, 582, 586, 591, 596, 601, 605,
+		610, 615, 619, 623, 628, 634, 638, 642, 645, 648, 652, 656, 660, 664, 668, 671,
+		675, 678, 683, 687, 691, 695, 699, 705, 709, 712, 717, 721, 726, 731, 734, 738,
+		742, 745, 750, 754, 760, 763, 766, 770, 775, 779, 783, 787, 792, 797, 801, 806,
+		810, 815, 820, 824, 828, 831, 835, 839, 843, 848, 851, 855, 858, 862, 865, 869,
+		874, 878, 882, 885, 889, 893, 897, 901, 905, 908, 912, 916, 921, 924, 929, 933,
+		936, 939, 943, 947, 952, 956, 960, 964, 968, 973, 976, 980, 986, 992, 996, 999,
+		1003, 1007, 1013, 1017, 1022, 1027, 1031, 1036, 1041, 1046, 1051, 1054, 1057, 1062, 1066, 1069,
+		0, 5, 9, 12, 17, 21, 26, 31, 36, 39, 43, 46, 51, 54, 58, 63,
+		66, 72, 75, 79, 82, 87, 93, 97, 103, 108, 113, 116, 120, 124, 128, 132,
+		135, 139, 143, 146, 150, 154, 159, 164, 171, 174, 179, 183, 186, 191, 195, 198,
+		201, 206, 209, 212, 217, 221, 224, 227, 230, 233, 235, 239, 242, 246, 249, 252,
+		257, 261, 266, 271, 277, 283, 286, 289, 294, 297, 301, 305, 310, 312, 316, 320,
+		324, 327, 330, 333, 336, 341, 344, 351, 355, 359, 365, 370, 375, 378, 381, 385,
+		390, 394, 397, 400, 404, 407, 411, 416, 420, 424, 428, 432, 436, 441, 445, 449,
+		453, 457, 460, 465, 469, 473, 476, 479, 482, 485, 488, 492, 495, 497, 501, 506,
+		512, 515, 519, 525, 530, 535, 538, 542, 545, 549, 553, 556, 560, 565, 571, 575,
+		579, 582, 585, 589, 594, 598, 602, 606, 611, 615, 619, 622, 626, 629, 632, 635,
+		640, 644, 648, 654, 658, 661, 665, 670, 674, 679, 683, 686, 689, 693, 697, 702,
+		706, 711, 715, 718, 721, 724, 727, 731, 734, 737, 740, 743, 748, 751, 756, 759,
+		763, 767, 771, 774, 779, 783, 787, 792, 795, 800, 803, 806, 810, 814, 818, 823,
+		826, 831, 836, 839, 843, 846, 850, 854, 857, 860, 865, 869, 874, 878, 882, 885,
+		888, 891, 895, 900, 905, 909, 914, 918, 923, 926, 929, 934, 938, 942, 946, 950,
+		956, 960, 965, 970, 975, 981, 985, 989, 993, 997, 1001, 1004, 1008, 1012, 1018, 1022,
+		1025, 0, 3, 7, 11, 15, 19, 23, 27, 31, 35, 38, 43, 48, 51, 56,
+		59, 64, 67, 72, 76, 80, 83, 87, 90, 94, 102, 105, 109, 114, 117, 122,
+		125, 129, 133, 138, 143, 149, 152, 156, 160, 163, 166, 170, 174, 178, 181, 186,
+		189, 194, 200, 204, 208, 213, 217, 222, 225, 230, 235, 238, 243, 248, 252, 256,
+		260, 264, 269, 274, 277, 281, 285, 290, 294, 298, 301, 306, 310, 313, 316, 320,
+		325, 329, 334, 337, 342, 345, 349, 352, 357, 360, 364, 367, 372, 375, 379, 383,
+		387, 391, 396, 401, 406, 410, 414, 418, 422, 427, 431, 435, 439, 443, 446, 450,
+		453, 457, 460, 463, 468, 472, 477, 482, 487, 491, 494, 498, 501, 506, 510, 513,
+		516, 519, 524, 530, 535, 539, 543, 548, 553, 557, 562, 567, 571, 574, 580, 584,
+		587, 591, 595, 598, 602, 606, 611, 616, 619, 622, 626, 632, 638, 642, 645, 649,
+		654, 660, 664, 668, 672, 676, 681, 685, 688, 693, 697, 701, 705, 709, 712, 717,
+		722, 726, 730, 735, 739, 742, 746, 751, 755, 759, 763, 768, 773, 777, 782, 787,
+		792, 796, 801, 806, 810, 815, 818, 822, 826, 830, 835, 839, 842, 846, 850, 854,
+		859, 864, 867, 871, 875, 879, 884, 889, 892, 897, 902, 907, 912, 917, 921, 926,
+		929, 932, 935, 938, 941, 947, 950, 953, 956, 959, 965, 969, 973, 977, 980, 984,
+		988, 993, 999, 1004, 1008, 1012, 1017, 1022, 1025, 1029, 1033, 1037, 1041, 1045, 1050, 1054,
+		1058, 1062, 0, 5, 8, 12, 17, 22, 27, 31, 35, 40, 44, 49, 53, 58,
+		62, 66, 69, 73, 78, 83, 87, 91, 94, 98, 102, 106, 109, 113, 118, 122,
+		126, 130, 135, 140, 145, 149, 153, 155, 160, 164, 169, 172, 176, 181, 185, 189,
+		192, 195, 199, 202, 206, 209, 213, 218, 222, 228, 233, 238, 240, 243, 247, 252,
+		256, 260, 263, 266, 269, 273, 278, 282, 286, 290, 294, 298, 302, 306, 309, 314,
+		318, 323, 329, 336, 340, 345, 350, 356, 359, 362, 366, 371, 376, 381, 385, 390,
+		393, 397, 401, 406, 410, 413, 417, 424, 429, 433, 437, 441, 444, 450, 454, 459,
+		463, 466, 470, 474, 478, 483, 487, 491, 495, 500, 505, 508, 511, 515, 520, 525,
+		530, 536, 540, 544, 549, 553, 556, 561, 565, 569, 573, 577, 581, 586, 591, 595,
+		599, 602, 606, 612, 618, 621, 625, 630, 633, 638, 644, 647, 651, 656, 660, 666,
+		670, 677, 682, 685, 689, 693, 697, 701, 704, 708, 712, 717, 722, 726, 729, 734,
+		737, 741, 746, 752, 756, 759, 763, 767, 772, 776, 780, 783, 788, 793, 796, 800,
+		805, 810, 815, 819, 824, 828, 832, 835, 840, 845, 848, 851, 856, 859, 864, 868,
+		872, 877, 882, 887, 891, 895, 900, 904, 907, 910, 913, 916, 919, 922, 925, 927,
+		932, 936, 939, 942, 946, 951, 955, 960, 964, 967, 970, 973, 976, 979, 982, 985,
+		988, 991, 995, 1000, 1003, 1007, 1011, 1015, 1018, 1023, 1027, 1031, 1035, 1039, 1043, 1046,
+		1050, 1054, 1058, 0, 4, 9, 13, 16, 20, 24, 29, 34, 38, 42, 45, 48,
+		52, 57, 60, 65, 70, 75, 79, 84, 88, 92, 97, 101, 106, 111, 116, 121,
+		124, 128, 132, 136, 139, 142, 146, 151, 155, 160, 163, 168, 171, 176, 181, 185,
+		189, 192, 197, 201, 205, 209, 212, 216, 219, 223, 228, 232, 236, 240, 243, 248,
+		252, 256, 261, 267, 270, 275, 279, 283, 286, 289, 292, 295, 300, 303, 307, 310,
+		313, 316, 321, 325, 330, 334, 339, 343, 346, 350, 353, 357, 360, 365, 369, 374,
+		378, 381, 387, 392, 396, 399, 403, 407, 410, 414, 418, 421, 425, 430, 434, 439,
+		444, 449, 453, 457, 461, 464, 469, 472, 478, 481, 486, 489, 493, 498, 501, 507,
+		510, 515, 519, 524, 529, 532, 537, 540, 543, 548, 553, 557, 561, 564, 567, 572,
+		576, 580, 585, 590, 594, 598, 602, 606, 609, 613, 617, 621, 627, 632, 636, 639,
+		643, 647, 650, 655, 660, 663, 667, 671, 675, 679, 683, 686, 692, 697, 700, 705,
+		710, 715, 720, 723, 727, 732, 737, 741, 745, 750, 754, 759, 763, 768, 772, 777,
+		780, 784, 788, 792, 796, 801, 804, 809, 813, 816, 820, 823, 827, 830, 835, 840,
+		845, 850, 853, 856, 861, 864, 868, 873, 878, 881, 884, 887, 891, 895, 900, 904,
+		909, 914, 918, 921, 924, 927, 930, 933, 937, 940, 944, 948, 952, 956, 960, 965,
+		969, 972, 975, 978, 982, 986, 990, 993, 996, 1001, 1005, 1011, 1016, 1020, 1025, 1029,
+		1033, 1037, 1042, 1045, 0, 4, 8, 12, 15, 19, 23, 27, 33, 36, 41, 45,
+		49, 55, 60, 65, 69, 73, 77, 80, 83, 86, 90, 94, 97, 102, 106, 111,
+		114, 119, 123, 128, 133, 138, 143, 147, 152, 157, 162, 166, 170, 174, 177, 182,
+		185, 189, 194, 199, 203, 207, 210, 214, 218, 222, 225, 231, 235, 240, 243, 248,
+		252, 257, 262, 267, 273, 278, 283, 287, 293, 299, 304, 308, 311, 316, 320, 324,
+		328, 334, 339, 344, 348, 352, 358, 363, 368, 371, 375, 379, 383, 387, 391, 395,
+		400, 404, 409, 412, 418, 424, 427, 431, 435, 438, 442, 446, 450, 455, 459, 464,
+		469, 473, 478, 481, 486, 489, 494, 497, 500, 505, 508, 513, 517, 522, 525, 529,
+		532, 536, 540, 546, 552, 557, 562, 566, 569, 574, 579, 582, 586, 590, 594, 597,
+		601, 605, 608, 613, 616, 620, 624, 629, 633, 637, 642, 647, 650, 655, 660, 664,
+		668, 672, 677, 682, 686, 690, 695, 701, 704, 708, 712, 715, 718, 721, 725, 729,
+		733, 737, 741, 744, 747, 752, 755, 761, 765, 769, 773, 778, 784, 789, 794, 798,
+		803, 807, 811, 815, 818, 821, 824, 830, 834, 837, 840, 843, 847, 852, 857, 861,
+		865, 870, 874, 877, 883, 888, 892, 897, 901, 906, 911, 914, 917, 920, 924, 929,
+		933, 937, 940, 943, 948, 954, 958, 963, 967, 971, 974, 977, 982, 987, 992, 996,
+		1001, 1006, 1011, 1015, 1019, 1023, 1027, 1030, 1033, 1038, 1041, 1044, 1047, 1051, 1055, 1059,
+		1066, 1070, 1075, 1080, 1083, 0, 4, 8, 11, 15, 19, 23, 27, 30, 34, 38,
+		43, 48, 52, 55, 60, 64, 67, 70, 73, 78, 83, 88, 93, 98, 103, 108,
+		114, 119, 123, 128, 131, 134, 139, 144, 149, 154, 159, 163, 168, 173, 176, 181,
+		185, 188, 192, 196, 200, 204, 207, 211, 215, 218, 221, 225, 229, 233, 236, 240,
+		243, 247, 250, 254, 259, 262, 266, 272, 275, 280, 285, 288, 292, 295, 299, 304,
+		308, 312, 315, 318, 321, 326, 330, 334, 338, 343, 347, 350, 356, 361, 365, 368,
+		371, 376, 379, 382, 385, 389, 394, 399, 404, 409, 415, 419, 424, 429, 434, 437,
+		442, 445, 450, 455, 459, 463, 467, 472, 476, 480, 485, 489, 493, 496, 500, 504,
+		508, 513, 517, 522, 527, 531, 536, 539, 543, 547, 551, 554, 558, 561, 564, 569,
+		573, 578, 582, 585, 588, 592, 595, 598, 601, 605, 609, 614, 618, 622, 626, 628,
+		632, 637, 641, 645, 650, 654, 659, 663, 667, 671, 674, 677, 682, 687, 693, 699,
+		705, 710, 714, 719, 724, 727, 732, 735, 738, 742, 747, 752, 756, 760, 764, 767,
+		770, 773, 776, 779, 783, 787, 790, 794, 797, 802, 807, 810, 813, 816, 820, 824,
+		830, 834, 837, 842, 845, 848, 852, 856, 859, 863, 867, 871, 876, 881, 885, 889,
+		893, 898, 901, 905, 909, 913, 918, 923, 926, 930, 934, 938, 942, 945, 948, 952,
+		955, 958, 963, 966, 970, 973, 977, 981, 984, 988, 993, 997, 1000, 1004, 1008, 1012,
+		1017, 1021, 1025, 1029, 1033, 1037, 0, 4, 8, 12, 16, 21, 25, 29, 34, 39,
+		43, 48, 53, 56, 62, 65, 69, 74, 78, 82, 88, 91, 96, 99, 102, 105,
+		111, 116, 119, 122, 126, 130, 135, 139, 144, 147, 151, 156, 159, 164, 167, 171,
+		174, 178, 183, 187, 190, 193, 197, 200, 205, 209, 213, 218, 221, 225, 230, 233,
+		237, 241, 245, 248, 252, 257, 260, 264, 269, 273, 277, 283, 287, 293, 296, 299,
+		302, 307, 310, 315, 318, 322, 328, 333, 338, 343, 348, 352, 356, 360, 364, 369,
+		372, 376, 382, 387, 392, 396, 401, 404, 408, 413, 418, 421, 426, 430, 435, 441,
+		448, 452, 456, 460, 465, 469, 474, 478, 483, 486, 490, 493, 497, 502, 506, 509,
+		514, 518, 523, 526, 530, 534, 538, 541, 547, 551, 556, 560, 564, 569, 574, 579,
+		586, 589, 594, 597, 601, 604, 607, 610, 613, 617, 620, 624, 628, 632, 637, 642,
+		647, 651, 655, 659, 664, 667, 672, 675, 680, 685, 689, 693, 698, 703, 706, 709,
+		712, 716, 720, 725, 729, 732, 735, 739, 743, 748, 752, 757, 761, 765, 770, 773,
+		778, 785, 793, 797, 801, 805, 810, 815, 820, 825, 828, 831, 836, 841, 846, 851,
+		856, 859, 862, 866, 870, 875, 878, 882, 886, 891, 895, 899, 904, 910, 914, 917,
+		920, 925, 928, 931, 934, 938, 943, 948, 953, 958, 961, 964, 968, 974, 979, 982,
+		987, 991, 994, 999, 1002, 1006, 1010, 1015, 1020, 1023, 1028, 1031, 1034, 1038, 1042, 1046,
+		1051, 1056, 1060, 1064, 1069, 1075, 1079, 0, 4, 8, 11, 15, 19, 22, 27, 30,
+		33, 39, 43, 49, 55, 59, 64, 67, 70, 74, 79, 84, 87, 91, 96, 101,
+		105, 111, 118, 125, 130, 134, 137, 140, 145, 152, 157, 163, 167, 171, 175, 181,
+		186, 191, 196, 201, 204, 208, 212, 216, 219, 223, 227, 231, 236, 240, 245, 250,
+		253, 258, 261, 265, 269, 275, 280, 285, 288, 291, 295, 298, 303, 306, 310, 314,
+		317, 321, 326, 329, 333, 337, 341, 345, 349, 353, 356, 361, 365, 369, 373, 378,
+		381, 385, 389, 392, 395, 400, 405, 410, 414, 417, 421, 425, 430, 435, 440, 445,
+		450, 455, 458, 461, 465, 468, 472, 477, 480, 484, 488, 492, 495, 498, 502, 506,
+		510, 515, 519, 524, 528, 531, 537, 545, 549, 554, 558, 562, 566, 570, 573, 578,
+		581, 584, 591, 595, 598, 602, 606, 609, 614, 617, 621, 624, 629, 634, 637, 642,
+		645, 648, 652, 657, 662, 666, 671, 674, 679, 682, 686, 690, 694, 700, 704, 708,
+		712, 717, 720, 724, 729, 733, 738, 742, 746, 750, 754, 759, 763, 768, 773, 777,
+		782, 785, 791, 794, 797, 800, 803, 806, 810, 814, 818, 822, 826, 829, 833, 838,
+		842, 845, 850, 854, 859, 865, 869, 874, 879, 883, 886, 891, 895, 900, 904, 910,
+		915, 920, 924, 929, 933, 939, 943, 947, 951, 956, 959, 962, 967, 971, 976, 981,
+		985, 989, 993, 998, 1002, 1005, 1009, 1013, 1018, 1022, 1025, 1031, 1034, 1039, 1043, 1046,
+		1050, 1054, 1059, 1063, 1067, 1071, 1075, 1079, 0, 3, 10, 13, 18, 23, 28, 31,
+		35, 40, 47, 51, 56, 61, 64, 68, 72, 75, 81, 84, 88, 93, 96, 99,
+		103, 108, 113, 116, 120, 124, 128, 132, 138, 142, 145, 150, 155, 160, 165, 169,
+		173, 176, 181, 186, 191, 196, 199, 206, 212, 217, 222, 225, 229, 233, 238, 242,
+		247, 250, 254, 258, 262, 267, 271, 275, 280, 283, 287, 292, 295, 299, 302, 305,
+		310, 314, 318, 322, 327, 332, 336, 340, 345, 349, 353, 357, 362, 366, 372, 377,
+		382, 385, 391, 394, 398, 402, 406, 410, 413, 417, 423, 427, 432, 436, 440, 447,
+		452, 456, 462, 466, 471, 475, 478, 482, 486, 490, 494, 499, 504, 507, 511, 514,
+		518, 523, 526, 531, 535, 540, 543, 547, 553, 557, 561, 566, 570, 573, 577, 582,
+		586, 591, 597, 601, 604, 608, 611, 615, 618, 622, 626, 631, 635, 640, 643, 647,
+		650, 653, 658, 662, 667, 672, 675, 679, 683, 688, 692, 697, 703, 707, 710, 715,
+		720, 724, 728, 735, 739, 744, 748, 752, 756, 761, 764, 769, 773, 777, 780, 785,
+		788, 793, 796, 800, 805, 809, 812, 817, 821, 824, 827, 832, 836, 841, 844, 848,
+		851, 856, 860, 863, 868, 872, 876, 882, 887, 891, 895, 898, 901, 904, 909, 912,
+		916, 920, 923, 926, 930, 934, 937, 942, 945, 949, 954, 958, 963, 968, 972, 977,
+		981, 984, 988, 991, 995, 1000, 1004, 1006, 1009, 1014, 1018, 1021, 1026, 1030, 1035, 1040,
+		1044, 1047, 1052, 1057, 1062, 1067, 1071, 1075, 1080, 0, 6, 11, 14, 19, 23, 26,
+		33, 37, 41, 46, 51, 54, 59, 63, 66, 70, 73, 77, 82, 87, 91, 94,
+		98, 102, 106, 110, 113, 116, 121, 124, 129, 135, 140, 143, 148, 152, 155, 158,
+		163, 166, 169, 174, 178, 182, 186, 189, 193, 196, 199, 203, 206, 211, 216, 221,
+		226, 229, 232, 235, 238, 241, 245, 249, 254, 258, 263, 267, 273, 277, 281, 285,
+		289, 293, 296, 301, 306, 309, 312, 315, 319, 323, 326, 329, 332, 337, 340, 344,
+		347, 352, 355, 358, 362, 367, 371, 374, 378, 381, 386, 391, 395, 401, 404, 407,
+		410, 413, 416, 419, 423, 426, 431, 435, 438, 442, 445, 451, 455, 459, 462, 466,
+		469, 472, 476, 480, 484, 487, 492, 496, 499, 502, 506, 509, 512, 517, 522, 528,
+		532, 538, 541, 545, 549, 553, 557, 562, 566, 570, 573, 578, 582, 587, 590, 594,
+		598, 601, 605, 609, 613, 616, 619, 624, 628, 632, 637, 641, 644, 648, 654, 658,
+		663, 666, 670, 675, 678, 682, 685, 691, 696, 700, 703, 711, 716, 720, 723, 728,
+		732, 736, 741, 744, 748, 752, 755, 759, 763, 768, 772, 776, 779, 783, 786, 789,
+		792, 796, 801, 804, 809, 812, 817, 820, 824, 828, 831, 835, 838, 841, 844, 847,
+		850, 853, 856, 859, 864, 868, 872, 876, 879, 887, 891, 896, 900, 903, 907, 911,
+		914, 919, 924, 927, 930, 934, 938, 942, 950, 954, 958, 961, 965, 969, 972, 976,
+		980, 984, 988, 991, 994, 998, 1001, 1006, 1009, 1013, 0, 3, 6, 9, 14, 19,
+		25, 28, 32, 37, 41, 46, 51, 55, 58, 62, 66, 69, 73, 77, 82, 85,
+		89, 93, 96, 100, 105, 111, 115, 118, 122, 126, 129, 134, 139, 144, 147, 151,
+		154, 158, 163, 166, 169, 172, 177, 180, 185, 189, 192, 197, 202, 206, 210, 215,
+		219, 225, 230, 235, 240, 250, 253, 256, 260, 264, 268, 273, 277, 281, 285, 288,
+		293, 298, 302, 307, 310, 314, 319, 325, 329, 333, 337, 340, 344, 349, 353, 356,
+		361, 365, 369, 374, 377, 380, 384, 387, 390, 395, 401, 405, 408, 415, 419, 424,
+		428, 432, 437, 440, 443, 448, 453, 458, 462, 466, 471, 475, 480, 483, 488, 493,
+		497, 501, 507, 512, 518, 522, 527, 532, 535, 539, 542, 545, 549, 552, 555, 559,
+		564, 569, 573, 578, 583, 587, 591, 595, 598, 602, 606, 609, 613, 617, 620, 625,
+		628, 634, 639, 642, 646, 650, 654, 658, 663, 666, 670, 674, 678, 681, 686, 689,
+		692, 696, 701, 706, 710, 713, 716, 720, 724, 727, 731, 735, 739, 743, 746, 749,
+		752, 755, 758, 762, 766, 771, 775, 780, 783, 788, 792, 795, 801, 807, 810, 814,
+		818, 821, 825, 831, 836, 839, 843, 846, 850, 855, 859, 862, 866, 869, 874, 878,
+		881, 885, 888, 891, 896, 899, 903, 907, 910, 915, 921, 926, 930, 933, 936, 939,
+		942, 945, 949, 953, 959, 963, 968, 972, 975, 979, 983, 987, 992, 995, 1000, 1005,
+		1010, 1014, 1019, 1022, 1026, 1029, 1033, 1036, 1039, 1044, 1048, 0, 5, 8, 11, 15,
+		19, 23, 29, 33, 37, 41, 46, 51, 55, 58, 61, 64, 69, 72, 75, 79,
+		83, 87, 90, 93, 97, 101, 107, 111, 115, 118, 123, 126, 130, 134, 137, 140,
+		145, 149, 152, 156, 161, 165, 170, 173, 178, 184, 188, 192, 195, 198, 203, 206,
+		211, 215, 221, 225, 231, 236, 241, 245, 248, 252, 256, 259, 262, 266, 269, 273,
+		278, 283, 289, 294, 300, 304, 309, 312, 316, 321, 324, 328, 333, 337, 340, 345,
+		349, 353, 357, 361, 365, 369, 373, 377, 382, 387, 392, 396, 399, 402, 406, 410,
+		415, 418, 421, 426, 430, 434, 439, 443, 446, 451, 454, 457, 462, 467, 472, 475,
+		478, 483, 487, 491, 495, 499, 505, 510, 514, 518, 524, 528, 533, 538, 541, 545,
+		549, 553, 557, 562, 567, 571, 576, 579, 584, 589, 594, 599, 601, 604, 608, 613,
+		617, 620, 623, 628, 631, 635, 640, 644, 647, 651, 655, 660, 664, 667, 671, 676,
+		679, 684, 688, 693, 699, 702, 705, 710, 715, 720, 726, 729, 734, 740, 743, 748,
+		751, 755, 759, 764, 767, 770, 773, 777, 783, 787, 790, 795, 800, 804, 807, 812,
+		815, 818, 822, 825, 828, 832, 836, 840, 845, 850, 855, 859, 865, 869, 872, 877,
+		881, 884, 888, 891, 895, 900, 904, 908, 913, 917, 922, 927, 930, 934, 937, 940,
+		943, 946, 950, 953, 958, 963, 967, 971, 975, 978, 983, 986, 991, 996, 1001, 1006,
+		1009, 1014, 1018, 1022, 1027, 1031, 1036, 1041, 1046, 1050, 1054, 1057, 0, 4, 9, 13,
+		16, 20, 25, 30, 33, 36, 40, 45, 52, 55, 58, 61, 64, 69, 73, 76,
+		81, 84, 89, 94, 99, 103, 108, 112, 117, 122, 125, 129, 134, 137, 140, 145,
+		149, 152, 155, 158, 161, 165, 169, 172, 176, 179, 183, 186, 191, 195, 200, 205,
+		208, 211, 215, 220, 224, 228, 233, 238, 243, 247, 251, 255, 259, 263, 267, 269,
+		273, 277, 282, 285, 288, 292, 297, 301, 305, 309, 314, 319, 323, 326, 329, 332,
+		336, 341, 346, 349, 354, 360, 363, 367, 372, 376, 380, 386, 392, 396, 399, 402,
+		406, 410, 413, 419, 424, 427, 433, 438, 442, 447, 452, 456, 461, 466, 470, 475,
+		479, 483, 487, 492, 497, 503, 508, 512, 517, 521, 524, 528, 532, 536, 541, 547,
+		551, 556, 561, 564, 567, 571, 575, 578, 583, 586, 590, 594, 599, 604, 608, 613,
+		618, 622, 627, 632, 638, 641, 645, 648, 651, 655, 659, 663, 668, 672, 676, 679,
+		683, 686, 689, 693, 696, 700, 703, 706, 710, 716, 719, 723, 726, 729, 734, 738,
+		742, 746, 750, 755, 759, 762, 765, 768, 771, 775, 778, 782, 786, 790, 794, 799,
+		804, 809, 813, 816, 821, 825, 831, 834, 837, 840, 844, 849, 852, 856, 860, 863,
+		866, 870, 875, 879, 882, 887, 891, 895, 899, 903, 908, 912, 916, 920, 924, 929,
+		934, 937, 941, 946, 950, 954, 958, 961, 965, 968, 971, 976, 980, 986, 990, 995,
+		1001, 1004, 1007, 1010, 1013, 1016, 1019, 1023, 1028, 1032, 1037, 1041, 1045, 0, 5, 8,
+		13, 16, 19, 24, 27, 30, 33, 36, 39, 44, 48, 52, 57, 61, 65, 69,
+		75, 78, 82, 86, 91, 96, 100, 105, 108, 113, 116, 119, 122, 127, 130, 136,
+		140, 145, 149, 152, 155, 160, 165, 170, 173, 177, 180, 185, 190, 194, 199, 203,
+		209, 215, 221, 224, 229, 233, 238, 242, 249, 254, 258, 261, 266, 272, 277, 282,
+		287, 291, 295, 300, 305, 310, 315, 319, 322, 327, 331, 335, 338, 342, 347, 350,
+		355, 359, 363, 366, 369, 372, 376, 380, 385, 390, 395, 398, 401, 404, 409, 413,
+		419, 423, 427, 430, 435, 440, 446, 449, 452, 456, 460, 464, 469, 473, 477, 481,
+		486, 489, 493, 497, 501, 504, 509, 514, 519, 523, 528, 531, 535, 539, 542, 545,
+		551, 557, 563, 567, 572, 576, 580, 584, 588, 593, 596, 600, 605, 609, 613, 617,
+		622, 625, 629, 632, 637, 642, 647, 650, 654, 657, 661, 666, 669, 673, 677, 681,
+		686, 690, 693, 697, 700, 703, 707, 711, 716, 720, 723, 728, 733, 737, 742, 747,
+		750, 753, 758, 763, 768, 772, 776, 780, 783, 787, 790, 793, 796, 800, 803, 808,
+		813, 818, 822, 826, 830, 833, 836, 840, 844, 848, 851, 856, 861, 864, 868, 872,
+		876, 881, 884, 888, 892, 895, 900, 905, 910, 915, 919, 922, 925, 928, 932, 935,
+		939, 942, 946, 950, 954, 958, 961, 965, 970, 974, 978, 981, 984, 989, 993, 997,
+		1000, 1005, 1010, 1015, 1019, 1023, 1028, 1033, 1038, 1042, 1046, 1050, 1053, 1056, 0, 4,
+		9, 12, 15, 19, 24, 27, 32, 36, 40, 44, 49, 54, 59, 62, 66, 71,
+		74, 77, 83, 86, 90, 94, 99, 102, 106, 110, 115, 120, 123, 128, 133, 138,
+		142, 146, 150, 155, 159, 163, 168, 172, 176, 180, 183, 187, 191, 196, 201, 204,
+		207, 212, 217, 222, 226, 230, 233, 238, 242, 247, 251, 256, 261, 265, 269, 276,
+		281, 286, 291, 295, 299, 303, 308, 311, 316, 321, 324, 329, 333, 337, 341, 347,
+		350, 354, 359, 363, 366, 369, 374, 378, 383, 388, 393, 398, 402, 405, 409, 413,
+		417, 422, 426, 431, 435, 440, 444, 448, 452, 455, 459, 462, 466, 472, 477, 481,
+		486, 491, 494, 499, 504, 508, 514, 518, 522, 526, 530, 535, 539, 543, 547, 552,
+		555, 561, 565, 569, 575, 579, 582, 585, 588, 593, 596, 600, 604, 608, 612, 617,
+		620, 625, 630, 634, 639, 643, 649, 653, 657, 663, 669, 673, 679, 684, 687, 691,
+		697, 701, 704, 709, 712, 715, 719, 723, 728, 733, 738, 741, 745, 749, 755, 759,
+		763, 767, 772, 777, 782, 786, 790, 794, 799, 803, 807, 812, 816, 822, 827, 830,
+		835, 839, 844, 849, 852, 857, 862, 866, 871, 876, 881, 885, 890, 894, 897, 902,
+		906, 910, 914, 919, 923, 927, 931, 936, 940, 944, 948, 953, 959, 962, 966, 970,
+		976, 980, 984, 988, 991, 995, 1000, 1003, 1007, 1011, 1016, 1020, 1023, 1027, 1030, 1033,
+		1039, 1043, 1046, 1051, 1056, 1061, 1065, 1069, 1074, 1079, 1084, 1087, 1092, 1096, 1101, 0,
+		3, 6, 10, 13, 17, 20, 24, 29, 32, 36, 40, 47, 51, 54, 58, 61,
+		64, 68, 71, 75, 79, 84, 88, 91, 96, 100, 104, 108, 112, 116, 120, 123,
+		127, 130, 135, 138, 141, 144, 149, 154, 159, 164, 170, 174, 177, 183, 187, 192,
+		196, 200, 203, 207, 210, 215, 219, 222, 225, 230, 236, 242, 247, 253, 258, 263,
+		267, 272, 275, 279, 285, 290, 294, 298, 303, 308, 313, 318, 324, 329, 334, 337,
+		342, 346, 350, 355, 360, 363, 368, 373, 378, 382, 388, 394, 397, 401, 405, 409,
+		412, 416, 422, 426, 430, 435, 438, 443, 447, 451, 455, 459, 464, 468, 472, 476,
+		480, 484, 488, 491, 495, 499, 503, 509, 514, 518, 522, 527, 532, 537, 539, 542,
+		548, 552, 555, 558, 561, 565, 568, 571, 574, 578, 584, 588, 593, 599, 603, 607,
+		610, 615, 620, 624, 629, 637, 641, 647, 651, 654, 657, 662, 667, 670, 675, 680,
+		683, 687, 692, 696, 699, 704, 708, 713, 717, 720, 723, 727, 730, 734, 739, 743,
+		746, 749, 755, 759, 763, 769, 774, 779, 784, 787, 790, 793, 796, 801, 805, 811,
+		816, 819, 824, 828, 832, 837, 842, 846, 851, 855, 860, 865, 869, 872, 877, 880,
+		884, 889, 894, 898, 902, 906, 910, 914, 918, 923, 926, 931, 935, 940, 945, 950,
+		953, 956, 960, 964, 968, 972, 976, 980, 983, 987, 992, 997, 1001, 1005, 1009, 1014,
+		1018, 1021, 1025, 1030, 1034, 1040, 1045, 1049, 1052, 1057, 1061, 1065, 1069, 1074, 1079, 1083,
+		0, 3, 7, 12, 17, 20, 23, 27, 31, 34, 37, 40, 44, 50, 55, 60,
+		63, 66, 70, 75, 79, 82, 86, 91, 94, 98, 103, 107, 111, 116, 120, 125,
+		130, 133, 137, 140, 145, 149, 153, 157, 162, 167, 170, 175, 188, 191, 197, 201,
+		207, 212, 215, 219, 223, 227, 231, 234, 237, 240, 244, 247, 251, 254, 261, 264,
+		270, 277, 280, 285, 289, 295, 300, 306, 311, 315, 319, 324, 329, 333, 336, 343,
+		347, 351, 356, 360, 365, 368, 375, 379, 383, 386, 389, 395, 399, 407, 411, 415,
+		419, 423, 426, 430, 434, 439, 444, 448, 451, 456, 459, 463, 466, 470, 473, 478,
+		481, 485, 488, 494, 497, 500, 505, 510, 515, 518, 521, 524, 529, 534, 539, 542,
+		545, 548, 551, 554, 558, 561, 566, 570, 574, 579, 583, 586, 589, 593, 598, 603,
+		608, 611, 614, 618, 622, 625, 629, 633, 637, 640, 644, 648, 651, 655, 660, 665,
+		671, 678, 681, 684, 688, 691, 696, 700, 703, 708, 712, 716, 721, 726, 730, 733,
+		737, 741, 744, 748, 753, 756, 760, 767, 773, 776, 780, 785, 788, 792, 795, 799,
+		804, 811, 815, 821, 825, 828, 833, 839, 842, 846, 849, 852, 855, 861, 865, 869,
+		873, 876, 881, 885, 888, 891, 894, 898, 902, 906, 909, 913, 916, 919, 924, 929,
+		934, 938, 943, 946, 951, 954, 958, 963, 966, 971, 976, 981, 985, 988, 992, 996,
+		1000, 1006, 1009, 1013, 1016, 1019, 1024, 1027, 1032, 1035, 1039, 1043, 1048, 1053, 1057, 1061,
+		1065, 0, 4, 7, 11, 16, 21, 25, 29, 33, 36, 39, 42, 47, 51, 55,
+		58, 63, 69, 73, 77, 81, 86, 89, 95, 98, 102, 108, 111, 116, 120, 123,
+		127, 132, 136, 140, 143, 148, 151, 155, 160, 163, 168, 173, 177, 181, 185, 190,
+		195, 200, 203, 207, 211, 215, 219, 224, 228, 232, 236, 240, 244, 248, 251, 256,
+		260, 265, 269, 272, 277, 281, 284, 288, 292, 298, 301, 306, 313, 317, 321, 326,
+		332, 335, 341, 346, 349, 352, 356, 360, 364, 368, 371, 374, 380, 383, 387, 390,
+		395, 399, 404, 409, 414, 418, 422, 425, 428, 433, 436, 440, 445, 450, 454, 457,
+		462, 466, 471, 475, 479, 483, 488, 492, 496, 502, 507, 510, 513, 518, 521, 526,
+		531, 535, 539, 544, 547, 551, 556, 561, 564, 567, 570, 573, 576, 581, 585, 588,
+		593, 596, 599, 602, 607, 610, 615, 619, 623, 626, 629, 632, 637, 640, 645, 648,
+		651, 655, 659, 662, 666, 671, 676, 680, 684, 688, 692, 696, 701, 705, 709, 712,
+		716, 721, 726, 732, 737, 741, 744, 747, 752, 757, 760, 765, 768, 771, 774, 778,
+		782, 787, 790, 793, 796, 800, 806, 811, 814, 817, 821, 825, 830, 834, 837, 842,
+		847, 851, 854, 859, 863, 868, 873, 876, 882, 887, 892, 897, 901, 905, 910, 913,
+		917, 920, 924, 928, 932, 936, 940, 943, 949, 953, 957, 962, 966, 972, 975, 979,
+		983, 987, 992, 995, 999, 1003, 1005, 1011, 1014, 1017, 1020, 1026, 1030, 1034, 1038, 1043,
+		1048, 1053, 0, 4, 9, 13, 17, 22, 27, 30, 34, 37, 41, 44, 47, 52,
+		55, 59, 64, 68, 76, 81, 85, 90, 94, 99, 103, 107, 110, 113, 118, 122,
+		126, 132, 136, 141, 145, 150, 154, 160, 163, 166, 168, 172, 178, 181, 186, 190,
+		193, 198, 203, 207, 212, 216, 220, 224, 227, 230, 234, 239, 243, 246, 250, 256,
+		259, 263, 267, 270, 274, 278, 283, 288, 291, 296, 300, 304, 308, 313, 317, 322,
+		327, 331, 337, 342, 348, 353, 358, 363, 367, 372, 377, 380, 384, 387, 392, 395,
+		401, 405, 408, 412, 416, 421, 425, 429, 433, 436, 440, 444, 449, 452, 456, 460,
+		465, 468, 473, 477, 482, 488, 492, 496, 501, 505, 508, 512, 515, 521, 525, 531,
+		536, 540, 543, 547, 552, 555, 558, 563, 567, 571, 576, 579, 584, 588, 594, 598,
+		601, 606, 610, 613, 616, 621, 626, 631, 635, 638, 643, 647, 652, 658, 661, 667,
+		671, 674, 679, 683, 687, 691, 694, 698, 703, 706, 709, 713, 716, 720, 725, 730,
+		734, 738, 742, 747, 750, 754, 759, 763, 766, 770, 773, 777, 782, 786, 790, 794,
+		797, 800, 805, 810, 814, 818, 822, 825, 828, 832, 836, 839, 843, 849, 853, 857,
+		861, 864, 869, 872, 875, 879, 883, 888, 891, 897, 900, 905, 909, 913, 918, 922,
+		925, 929, 933, 936, 940, 945, 949, 954, 957, 960, 971, 977, 982, 986, 990, 995,
+		999, 1004, 1007, 1019, 1030, 1035, 1038, 1043, 1046, 1051, 1054, 1065, 1070, 1076, 1080, 1087,
+		1092, 1097, 1101, 0, 5, 10, 15, 20, 25, 36, 39, 44, 47, 52, 56, 59,
+		62, 67, 73, 78, 82, 87, 90, 93, 97, 102, 107, 111, 115, 119, 124, 129,
+		134, 138, 144, 150, 155, 161, 166, 171, 175, 181, 184, 188, 193, 199, 202, 205,
+		210, 215, 220, 225, 230, 234, 238, 243, 247, 252, 255, 259, 264, 269, 274, 278,
+		283, 288, 291, 296, 299, 302, 306, 310, 314, 320, 323, 327, 331, 334, 339, 344,
+		348, 352, 357, 361, 364, 369, 372, 376, 380, 386, 389, 392, 396, 400, 404, 409,
+		413, 416, 419, 422, 426, 430, 433, 436, 440, 444, 447, 451, 455, 460, 464, 468,
+		471, 474, 477, 481, 484, 488, 491, 495, 500, 504, 509, 514, 517, 521, 527, 533,
+		539, 544, 548, 551, 555, 559, 566, 571, 575, 581, 587, 592, 595, 599, 603, 606,
+		610, 614, 618, 622, 626, 629, 636, 640, 645, 648, 652, 657, 662, 665, 669, 674,
+		678, 683, 686, 690, 693, 698, 703, 707, 710, 714, 718, 722, 726, 730, 733, 736,
+		739, 744, 751, 756, 760, 764, 767, 771, 774, 777, 781, 784, 788, 793, 796, 800,
+		805, 808, 811, 815, 818, 823, 827, 832, 836, 841, 844, 850, 855, 860, 865, 871,
+		876, 879, 883, 887, 891, 895, 900, 904, 908, 912, 915, 919, 923, 928, 933, 938,
+		941, 946, 949, 954, 959, 962, 965, 969, 973, 977, 980, 984, 988, 993, 998, 1003,
+		1006, 1011, 1017, 1022, 1026, 1030, 1033, 1037, 1041, 1044, 1049, 1052, 1056, 1059, 1063, 1067,
+		1070, 1074, 1076, 1080, 0, 3, 7, 10, 14, 17, 21, 26, 32, 35, 38, 42,
+		47, 50, 55, 60, 63, 66, 70, 74, 79, 82, 86, 90, 93, 96, 100, 104,
+		108, 111, 116, 119, 123, 126, 133, 137, 141, 145, 150, 155, 159, 164, 167, 171,
+		175, 181, 186, 191, 194, 198, 201, 206, 211, 217, 222, 226, 230, 234, 239, 243,
+		246, 249, 253, 257, 262, 268, 271, 276, 281, 286, 290, 294, 299, 303, 307, 311,
+		314, 318, 322, 327, 332, 337, 342, 345, 349, 352, 357, 360, 364, 367, 371, 375,
+		379, 384, 388, 392, 396, 399, 403, 408, 413, 417, 423, 426, 429, 433, 437, 443,
+		448, 453, 458, 462, 465, 470, 474, 479, 483, 488, 493, 496, 499, 503, 506, 509,
+		514, 517, 521, 525, 529, 532, 536, 540, 544, 547, 550, 554, 560, 564, 567, 572,
+		576, 579, 582, 587, 592, 596, 599, 601, 605, 609, 612, 616, 621, 626, 629, 632,
+		638, 642, 648, 652, 655, 659, 663, 668, 671, 674, 678, 683, 686, 691, 697, 700,
+		705, 708, 713, 716, 720, 724, 729, 733, 737, 741, 745, 749, 752, 756, 760, 765,
+		769, 772, 777, 780, 784, 787, 792, 795, 799, 804, 810, 814, 817, 822, 825, 828,
+		831, 835, 838, 842, 847, 851, 856, 859, 862, 866, 870, 874, 878, 882, 885, 889,
+		895, 899, 902, 907, 912, 915, 919, 922, 927, 931, 936, 939, 942, 946, 951, 956,
+		959, 962, 965, 968, 974, 978, 981, 986, 991, 995, 998, 1003, 1008, 1014, 1018, 1022,
+		1025, 1029, 1034, 1038, 1042, 0, 5, 11, 15, 19, 24, 28, 33, 38, 42, 46,
+		50, 54, 59, 62, 66, 71, 77, 81, 86, 90, 93, 98, 104, 107, 112, 116,
+		122, 126, 129, 133, 138, 143, 148, 151, 156, 160, 163, 166, 169, 174, 178, 183,
+		188, 191, 196, 200, 205, 208, 212, 215, 218, 223, 228, 232, 238, 243, 247, 252,
+		257, 262, 266, 269, 275, 279, 284, 290, 294, 298, 303, 307, 312, 315, 321, 325,
+		330, 332, 336, 341, 344, 348, 352, 357, 362, 367, 371, 375, 380, 384, 390, 394,
+		398, 403, 409, 412, 416, 420, 425, 430, 434, 437, 440, 443, 446, 450, 453, 456,
+		459, 463, 467, 472, 475, 479, 482, 486, 491, 494, 498, 502, 507, 512, 516, 519,
+		523, 528, 531, 535, 539, 543, 547, 550, 553, 557, 562, 567, 571, 574, 579, 583,
+		588, 593, 596, 600, 604, 610, 614, 619, 623, 628, 631, 637, 640, 645, 648, 651,
+		656, 661, 665, 669, 673, 678, 684, 687, 692, 696, 700, 704, 707, 712, 715, 719,
+		724, 729, 734, 739, 743, 748, 752, 755, 758, 763, 767, 771, 775, 780, 783, 786,
+		790, 795, 800, 803, 808, 811, 815, 820, 824, 829, 833, 837, 841, 846, 851, 855,
+		860, 863, 869, 873, 878, 883, 887, 890, 895, 900, 905, 909, 912, 916, 921, 925,
+		929, 933, 937, 941, 944, 949, 952, 956, 960, 963, 968, 972, 976, 981, 986, 989,
+		994, 999, 1003, 1008, 1011, 1016, 1021, 1025, 1029, 1033, 1038, 1041, 1044, 1047, 1051, 1054,
+		1058, 1061, 1067, 1070, 1074, 1080, 0, 5, 8, 12, 16, 20, 23, 26, 30, 34,
+		38, 43, 47, 50, 54, 58, 62, 64, 67, 70, 74, 78, 82, 88, 92, 96,
+		100, 104, 108, 113, 116, 119, 123, 126, 129, 133, 137, 141, 144, 149, 154, 157,
+		161, 164, 168, 171, 176, 179, 184, 189, 194, 198, 201, 204, 207, 210, 215, 218,
+		222, 225, 230, 235, 240, 243, 251, 255, 260, 265, 269, 275, 279, 281, 285, 289,
+		292, 296, 299, 303, 307, 312, 316, 321, 326, 329, 333, 338, 343, 347, 352, 357,
+		361, 366, 369, 374, 379, 383, 388, 393, 398, 401, 406, 410, 415, 420, 425, 429,
+		433, 435, 439, 444, 449, 453, 457, 461, 465, 468, 472, 477, 480, 485, 490, 494,
+		499, 504, 509, 514, 518, 523, 528, 533, 536, 541, 546, 550, 554, 557, 562, 566,
+		571, 576, 579, 582, 585, 589, 593, 598, 602, 606, 610, 613, 616, 620, 624, 629,
+		634, 638, 641, 646, 651, 655, 659, 664, 667, 671, 675, 680, 685, 688, 691, 695,
+		700, 705, 710, 714, 719, 722, 726, 731, 736, 739, 743, 747, 752, 755, 759, 764,
+		769, 773, 777, 780, 785, 790, 793, 795, 798, 802, 805, 810, 815, 819, 822, 826,
+		831, 835, 839, 844, 848, 851, 856, 860, 864, 868, 872, 876, 879, 882, 888, 893,
+		897, 903, 908, 911, 914, 919, 922, 925, 929, 935, 940, 944, 948, 953, 956, 959,
+		962, 966, 971, 976, 981, 985, 988, 993, 997, 1000, 1005, 1010, 1015, 1018, 1022, 1027,
+		1032, 1038, 1042, 1045, 1050, 1053, 1056, 0, 5, 10, 13, 19, 24, 28, 33, 36,
+		41, 44, 48, 53, 57, 60, 64, 67, 71, 76, 79, 85, 88, 91, 94, 98,
+		101, 104, 108, 113, 116, 121, 126, 130, 133, 136, 141, 145, 148, 153, 159, 163,
+		167, 171, 174, 177, 181, 185, 189, 194, 197, 202, 207, 210, 213, 220, 225, 229,
+		233, 237, 241, 245, 248, 253, 257, 261, 264, 267, 270, 273, 277, 282, 287, 291,
+		294, 298, 303, 307, 313, 317, 320, 324, 328, 332, 337, 340, 343, 346, 349, 353,
+		358, 362, 366, 369, 372, 376, 381, 385, 388, 393, 396, 399, 402, 408, 411, 416,
+		421, 425, 429, 433, 437, 440, 444, 449, 453, 458, 462, 467, 471, 475, 478, 482,
+		486, 490, 493, 496, 501, 505, 510, 513, 518, 522, 526, 530, 533, 538, 542, 546,
+		550, 554, 557, 561, 564, 568, 573, 576, 581, 584, 589, 594, 597, 600, 604, 607,
+		610, 614, 619, 624, 629, 632, 636, 641, 644, 647, 650, 653, 658, 661, 664, 667,
+		670, 673, 678, 682, 687, 691, 694, 699, 702, 706, 709, 714, 718, 721, 725, 729,
+		733, 736, 739, 743, 747, 751, 754, 757, 761, 764, 768, 771, 774, 778, 782, 785,
+		790, 795, 800, 804, 808, 814, 818, 822, 826, 829, 834, 838, 843, 846, 850, 853,
+		856, 859, 863, 866, 869, 872, 876, 879, 882, 885, 890, 893, 899, 904, 908, 913,
+		917, 921, 925, 929, 932, 935, 939, 942, 948, 953, 957, 961, 964, 968, 971, 975,
+		979, 983, 986, 989, 993, 997, 1002, 1005, 0, 3, 7, 12, 15, 18, 23, 26,
+		30, 34, 39, 42, 48, 51, 56, 61, 64, 68, 71, 74, 78, 82, 87, 92,
+		96, 99, 103, 107, 111, 116, 121, 124, 129, 134, 137, 140, 143, 147, 153, 156,
+		159, 164, 167, 170, 173, 177, 181, 187, 192, 195, 201, 205, 209, 214, 217, 220,
+		223, 226, 231, 235, 239, 243, 246, 250, 254, 257, 262, 266, 270, 273, 278, 281,
+		286, 290, 294, 297, 300, 303, 306, 311, 315, 319, 322, 325, 328, 334, 338, 341,
+		345, 350, 353, 358, 363, 368, 372, 375, 379, 384, 387, 391, 396, 400, 403, 407,
+		410, 414, 418, 424, 428, 433, 436, 441, 444, 449, 454, 457, 461, 465, 468, 472,
+		478, 481, 487, 492, 495, 499, 504, 508, 514, 519, 522, 526, 530, 535, 539, 544,
+		548, 552, 557, 560, 564, 569, 574, 579, 582, 586, 590, 594, 601, 605, 610, 615,
+		622, 628, 633, 639, 643, 648, 651, 655, 658, 661, 666, 669, 672, 677, 681, 685,
+		690, 694, 697, 700, 703, 706, 709, 715, 719, 723, 728, 733, 736, 741, 746, 749,
+		754, 757, 760, 764, 771, 776, 781, 786, 791, 797, 801, 806, 810, 815, 820, 826,
+		830, 834, 839, 843, 846, 850, 854, 858, 863, 867, 872, 875, 880, 885, 889, 893,
+		898, 901, 906, 910, 915, 920, 925, 930, 935, 941, 944, 947, 951, 956, 961, 966,
+		971, 976, 979, 983, 988, 991, 995, 1000, 1005, 1010, 1016, 1021, 1026, 1030, 1035, 1040,
+		1044, 1049, 1053, 1057, 1060, 1065, 1068, 1072, 1076, 0, 5, 9, 16, 19, 22, 27,
+		30, 35, 38, 43, 48, 52, 55, 60, 65, 68, 73, 78, 82, 85, 88, 93,
+		96, 101, 106, 112, 116, 119, 123, 126, 130, 133, 136, 140, 144, 148, 153, 156,
+		159, 162, 166, 170, 173, 176, 179, 184, 187, 190, 193, 197, 201, 205, 208, 212,
+		216, 220, 223, 227, 232, 238, 243, 249, 254, 258, 262, 265, 269, 273, 277, 282,
+		285, 291, 295, 300, 305, 309, 314, 318, 321, 324, 330, 334, 339, 342, 345, 350,
+		353, 359, 363, 367, 370, 375, 380, 386, 389, 392, 396, 399, 402, 405, 410, 413,
+		418, 423, 427, 432, 436, 440, 446, 451, 455, 459, 463, 468, 473, 478, 481, 485,
+		489, 494, 498, 503, 508, 513, 517, 519, 524, 529, 534, 537, 541, 545, 548, 551,
+		554, 557, 561, 566, 569, 574, 579, 582, 586, 592, 597, 601, 606, 609, 612, 615,
+		619, 624, 628, 631, 635, 638, 643, 648, 653, 657, 662, 667, 672, 675, 678, 681,
+		684, 688, 692, 696, 700, 705, 709, 714, 719, 724, 728, 734, 739, 744, 749, 755,
+		758, 762, 766, 770, 775, 778, 781, 786, 791, 794, 798, 804, 809, 813, 817, 822,
+		828, 833, 838, 842, 847, 851, 855, 859, 864, 869, 872, 876, 880, 884, 888, 891,
+		896, 900, 905, 909, 913, 917, 923, 927, 931, 936, 941, 946, 951, 956, 959, 962,
+		965, 970, 974, 979, 983, 987, 993, 998, 1002, 1007, 1010, 1013, 1017, 1020, 1023, 1028,
+		1032, 1037, 1041, 1047, 1051, 1056, 1060, 1063, 1067, 1073, 0, 3, 6, 10, 13, 16,
+		21, 25, 28, 33, 38, 41, 45, 48, 52, 56, 59, 64, 68, 73, 80, 84,
+		87, 92, 97, 102, 105, 108, 111, 116, 121, 125, 130, 133, 138, 142, 146, 150,
+		155, 160, 166, 170, 174, 178, 183, 186, 190, 194, 197, 201, 206, 210, 214, 217,
+		220, 223, 227, 230, 235, 239, 242, 247, 252, 257, 260, 265, 269, 273, 277, 281,
+		284, 288, 292, 297, 301, 304, 307, 312, 315, 318, 323, 328, 334, 339, 343, 347,
+		352, 357, 362, 367, 370, 373, 378, 382, 387, 390, 394, 399, 407, 412, 416, 421,
+		425, 431, 434, 439, 444, 449, 454, 457, 460, 464, 469, 473, 478, 481, 484, 487,
+		491, 496, 500, 505, 508, 512, 516, 521, 524, 527, 531, 537, 542, 547, 550, 554,
+		557, 561, 566, 570, 574, 577, 581, 585, 588, 592, 601, 604, 607, 610, 614, 617,
+		620, 623, 626, 631, 636, 639, 642, 647, 650, 653, 656, 661, 666, 669, 672, 676,
+		681, 685, 692, 696, 700, 704, 707, 710, 717, 723, 728, 731, 737, 741, 746, 749,
+		753, 756, 759, 762, 766, 770, 776, 780, 785, 790, 794, 800, 804, 808, 813, 818,
+		822, 827, 831, 834, 839, 842, 847, 851, 854, 857, 861, 864, 867, 871, 874, 878,
+		882, 887, 891, 898, 902, 909, 914, 917, 921, 925, 929, 934, 940, 943, 947, 951,
+		955, 958, 964, 970, 975, 978, 983, 987, 992, 997, 1001, 1006, 1010, 1013, 1017, 1020,
+		1024, 1029, 1033, 1036, 1041, 1045, 1048, 1053, 1059, 1063, 1067, 0, 3, 7, 12, 16,
+		20, 25, 28, 31, 35, 39, 43, 47, 51, 54, 57, 61, 65, 69, 73, 77,
+		82, 85, 89, 93, 98, 102, 105, 109, 113, 117, 121, 125, 128, 133, 137, 141,
+		145, 149, 154, 158, 161, 165, 168, 171, 176, 181, 185, 189, 192, 195, 199, 204,
+		208, 212, 215, 220, 224, 229, 233, 238, 241, 245, 250, 254, 259, 263, 269, 274,
+		277, 283, 287, 290, 293, 297, 301, 306, 311, 315, 320, 324, 328, 332, 337, 341,
+		345, 349, 355, 358, 361, 366, 371, 375, 379, 382, 387, 392, 396, 400, 405, 410,
+		415, 418, 422, 427, 432, 437, 441, 445, 449, 454, 457, 461, 465, 470, 473, 479,
+		485, 489, 493, 497, 502, 507, 511, 516, 521, 524, 530, 534, 538, 543, 548, 553,
+		557, 561, 566, 570, 573, 577, 581, 586, 589, 592, 597, 601, 605, 609, 612, 617,
+		620, 624, 628, 634, 639, 642, 646, 651, 656, 660, 664, 667, 670, 676, 681, 686,
+		691, 694, 697, 702, 705, 709, 714, 719, 724, 728, 731, 735, 739, 744, 749, 754,
+		759, 763, 767, 771, 775, 779, 782, 787, 791, 796, 801, 806, 809, 813, 817, 822,
+		826, 830, 833, 836, 841, 847, 851, 855, 859, 863, 866, 871, 875, 878, 881, 887,
+		893, 900, 903, 909, 914, 917, 922, 927, 932, 935, 940, 946, 949, 953, 958, 963,
+		967, 971, 974, 978, 983, 988, 993, 998, 1003, 1007, 1010, 1014, 1017, 1021, 1026, 1030,
+		1035, 1040, 1045, 1049, 1053, 1056, 1060, 1064, 1068, 1074, 1079, 1083, 0, 4, 9, 14,
+		17, 22, 29, 34, 38, 43, 47, 50, 55, 58, 63, 66, 70, 75, 80, 84,
+		89, 96, 100, 104, 110, 113, 118, 122, 125, 129, 134, 138, 141, 144, 148, 153,
+		158, 161, 166, 171, 175, 180, 184, 187, 192, 195, 198, 202, 206, 209, 214, 218,
+		224, 229, 233, 237, 240, 243, 248, 253, 256, 261, 266, 269, 274, 278, 282, 288,
+		293, 296, 300, 304, 309, 315, 319, 323, 326, 331, 335, 339, 344, 348, 353, 358,
+		362, 366, 371, 375, 379, 382, 386, 391, 396, 400, 405, 409, 414, 418, 422, 426,
+		430, 435, 438, 444, 447, 450, 456, 460, 463, 466, 471, 477, 482, 486, 491, 495,
+		500, 503, 508, 511, 515, 519, 522, 527, 530, 533, 537, 542, 546, 549, 553, 558,
+		561, 566, 569, 572, 576, 580, 585, 591, 595, 598, 602, 607, 612, 616, 619, 624,
+		629, 633, 639, 644, 648, 653, 657, 660, 664, 668, 672, 677, 680, 684, 687, 692,
+		697, 702, 705, 709, 712, 716, 722, 725, 729, 733, 737, 742, 747, 750, 754, 759,
+		762, 766, 771, 775, 779, 783, 787, 792, 796, 800, 804, 808, 813, 817, 821, 824,
+		829, 832, 837, 840, 844, 850, 855, 859, 862, 867, 872, 875, 880, 884, 889, 894,
+		898, 901, 905, 909, 914, 918, 922, 927, 933, 937, 941, 946, 951, 956, 961, 966,
+		970, 973, 977, 981, 984, 987, 990, 993, 998, 1003, 1006, 1009, 1013, 1018, 1024, 1028,
+		1034, 1038, 1043, 1048, 1053, 1058, 1062, 1066, 1071, 1076, 1081, 1085, 1088, 0, 5, 8,
+		11, 17, 22, 27, 31, 34, 39, 42, 46, 50, 53, 57, 62, 67, 72, 76,
+		81, 86, 89, 92, 97, 101, 106, 110, 113, 116, 121, 126, 132, 136, 141, 144,
+		147, 152, 156, 161, 165, 169, 174, 178, 182, 186, 190, 195, 200, 205, 211, 216,
+		220, 225, 230, 235, 239, 243, 248, 252, 256, 260, 268, 271, 276, 280, 285, 290,
+		295, 300, 305, 309, 314, 318, 322, 328, 331, 336, 341, 345, 349, 352, 357, 362,
+		367, 372, 377, 381, 385, 389, 392, 395, 398, 401, 405, 408, 412, 415, 419, 424,
+		427, 431, 434, 439, 442, 446, 450, 455, 459, 463, 468, 471, 475, 478, 481, 485,
+		489, 492, 495, 498, 502, 505, 508, 511, 516, 520, 523, 526, 531, 536, 540, 543,
+		548, 551, 556, 560, 563, 566, 569, 574, 579, 583, 589, 592, 596, 601, 605, 609,
+		613, 616, 620, 625, 628, 634, 639, 643, 648, 651, 655, 658, 662, 667, 670, 675,
+		680, 684, 688, 693, 698, 702, 707, 710, 719, 725, 730, 733, 738, 742, 747, 752,
+		755, 759, 764, 768, 773, 778, 782, 787, 791, 797, 802, 805, 810, 813, 819, 824,
+		828, 833, 837, 841, 845, 848, 852, 856, 859, 862, 865, 868, 873, 877, 881, 884,
+		887, 891, 897, 900, 903, 906, 911, 915, 919, 924, 927, 932, 938, 941, 945, 949,
+		953, 957, 961, 965, 970, 975, 979, 984, 989, 993, 997, 1001, 1004, 1007, 1011, 1014,
+		1019, 1022, 1027, 1030, 1033, 1037, 1041, 1046, 1049, 1053, 1056, 1060, 1065, 1069, 0, 4,
+		8, 12, 16, 20, 24, 27, 31, 35, 39, 43, 47, 50, 55, 60, 63, 67,
+		73, 77, 82, 85, 90, 95, 99, 103, 106, 109, 113, 116, 120, 124, 127, 130,
+		134, 138, 142, 145, 150, 153, 157, 161, 165, 170, 173, 177, 180, 184, 188, 192,
+		195, 200, 203, 207, 212, 215, 218, 223, 227, 231, 235, 239, 243, 248, 253, 257,
+		261, 265, 269, 274, 278, 283, 289, 293, 296, 301, 306, 310, 315, 320, 323, 326,
+		330, 334, 337, 342, 346, 352, 356, 360, 365, 368, 371, 374, 378, 382, 385, 390,
+		395, 403, 406, 411, 414, 419, 423, 433, 437, 442, 447, 452, 456, 461, 466, 471,
+		474, 480, 485, 490, 494, 498, 502, 506, 511, 515, 518, 523, 528, 533, 536, 539,
+		544, 547, 550, 553, 556, 559, 564, 569, 573, 576, 579, 582, 586, 590, 594, 597,
+		600, 605, 611, 614, 618, 623, 626, 631, 636, 639, 644, 649, 653, 657, 660, 666,
+		669, 673, 677, 680, 684, 688, 692, 697, 702, 707, 712, 716, 719, 724, 728, 732,
+		737, 740, 744, 748, 752, 755, 760, 763, 766, 770, 774, 779, 783, 788, 794, 800,
+		804, 809, 813, 818, 822, 826, 830, 833, 836, 839, 844, 848, 852, 856, 859, 862,
+		866, 869, 874, 877, 880, 885, 890, 895, 899, 903, 907, 913, 917, 921, 924, 927,
+		930, 933, 936, 940, 943, 948, 953, 958, 961, 964, 969, 972, 976, 981, 985, 988,
+		994, 998, 1001, 1006, 1010, 1015, 1021, 1027, 1030, 1032, 1037, 1042, 1047, 1050, 1054, 0,
+		6, 11, 15, 19, 23, 27, 31, 35, 38, 42, 45, 56, 60, 65, 68, 73,
+		76, 80, 83, 88, 93, 97, 100, 104, 109, 113, 118, 123, 127, 132, 136, 140,
+		145, 150, 154, 159, 164, 168, 171, 175, 178, 182, 186, 190, 194, 200, 204, 207,
+		211, 215, 220, 224, 227, 230, 234, 237, 242, 248, 252, 256, 262, 267, 270, 275,
+		279, 284, 288, 292, 297, 301, 306, 310, 315, 319, 322, 325, 328, 332, 337, 342,
+		345, 348, 351, 355, 361, 365, 370, 373, 377, 382, 387, 390, 394, 399, 405, 409,
+		413, 417, 420, 423, 426, 431, 435, 438, 444, 448, 452, 458, 462, 464, 468, 471,
+		475, 480, 484, 489, 492, 496, 501, 504, 510, 513, 518, 521, 526, 529, 534, 538,
+		543, 548, 551, 554, 558, 563, 566, 571, 574, 577, 580, 585, 588, 591, 595, 599,
+		602, 606, 609, 615, 618, 622, 627, 633, 638, 644, 647, 652, 655, 658, 664, 667,
+		672, 677, 680, 684, 689, 694, 699, 704, 709, 712, 716, 721, 725, 729, 734, 739,
+		743, 747, 752, 757, 760, 764, 768, 774, 778, 782, 787, 791, 796, 800, 805, 810,
+		814, 819, 822, 827, 831, 835, 838, 843, 846, 851, 855, 859, 862, 865, 869, 873,
+		877, 880, 884, 889, 894, 899, 904, 909, 912, 916, 919, 923, 928, 932, 935, 940,
+		945, 948, 953, 958, 963, 966, 972, 977, 981, 987, 990, 995, 999, 1004, 1007, 1011,
+		1016, 1021, 1025, 1029, 1033, 1036, 1041, 1045, 1049, 1053, 1057, 1062, 1066, 1071, 1074, 1078,
+		0, 4, 7, 13, 16, 19, 23, 26, 29, 34, 37, 41, 45, 49, 53, 60,
+		64, 68, 72, 76, 80, 85, 89, 92, 95, 100, 104, 108, 114, 119, 123, 126,
+		131, 135, 140, 145, 148, 152, 155, 159, 163, 167, 173, 178, 183, 187, 191, 198,
+		204, 209, 214, 219, 223, 226, 230, 233, 238, 241, 247, 251, 254, 259, 264, 267,
+		271, 274, 278, 283, 286, 291, 294, 299, 304, 307, 311, 314, 319, 324, 327, 333,
+		338, 343, 348, 352, 356, 360, 365, 369, 372, 376, 381, 385, 389, 393, 398, 402,
+		407, 414, 419, 423, 426, 429, 433, 438, 442, 447, 450, 453, 456, 461, 468, 472,
+		478, 483, 488, 491, 495, 498, 503, 507, 511, 515, 519, 522, 525, 530, 534, 537,
+		541, 546, 551, 555, 560, 564, 567, 572, 577, 581, 585, 590, 593, 596, 601, 607,
+		610, 614, 617, 622, 625, 629, 633, 637, 643, 646, 649, 653, 657, 660, 664, 667,
+		672, 676, 681, 685, 689, 693, 697, 700, 703, 706, 710, 713, 718, 722, 725, 729,
+		733, 736, 740, 744, 749, 752, 756, 760, 764, 768, 772, 775, 779, 783, 786, 790,
+		795, 800, 805, 809, 813, 818, 821, 826, 830, 833, 836, 838, 843, 849, 854, 859,
+		862, 865, 870, 874, 879, 883, 888, 891, 896, 902, 905, 910, 913, 916, 920, 924,
+		928, 932, 935, 938, 941, 944, 948, 951, 955, 958, 961, 966, 971, 975, 979, 984,
+		988, 993, 998, 1002, 1007, 1010, 1014, 1018, 1022, 1025, 1030, 1034, 1037, 1043, 1046, 1049,
+		1053, 0, 3, 8, 12, 16, 20, 24, 28, 31, 34, 37, 40, 43, 47, 50,
+		55, 60, 65, 70, 75, 79, 84, 90, 93, 98, 101, 106, 110, 114, 119, 124,
+		129, 132, 136, 139, 143, 146, 149, 153, 156, 162, 165, 169, 175, 180, 184, 189,
+		194, 197, 200, 204, 207, 213, 217, 221, 225, 228, 233, 236, 242, 247, 252, 258,
+		263, 267, 271, 276, 280, 285, 289, 292, 297, 302, 307, 311, 316, 319, 322, 327,
+		331, 335, 340, 343, 349, 354, 357, 361, 365, 369, 376, 379, 383, 387, 390, 393,
+		398, 402, 405, 410, 413, 418, 422, 427, 431, 436, 440, 444, 448, 452, 456, 461,
+		466, 470, 473, 477, 481, 485, 488, 492, 496, 499, 502, 505, 509, 512, 515, 519,
+		523, 529, 534, 537, 541, 544, 549, 552, 555, 559, 562, 565, 572, 575, 579, 583,
+		587, 591, 596, 601, 604, 608, 613, 618, 623, 627, 631, 635, 639, 644, 649, 653,
+		657, 661, 665, 669, 673, 678, 682, 686, 691, 696, 701, 704, 706, 709, 713, 717,
+		721, 724, 728, 732, 737, 741, 744, 750, 755, 759, 763, 768, 773, 777, 782, 787,
+		792, 795, 799, 804, 807, 810, 815, 820, 824, 827, 830, 833, 836, 840, 844, 848,
+		852, 855, 858, 861, 865, 869, 872, 876, 882, 887, 892, 897, 902, 906, 910, 913,
+		916, 919, 922, 926, 930, 934, 939, 942, 946, 949, 953, 956, 961, 965, 970, 974,
+		979, 983, 986, 991, 995, 999, 1002, 1007, 1012, 1017, 1021, 1024, 1029, 1033, 1037, 1041,
+		1046, 1050, 0, 3, 6, 9, 13, 17, 22, 26, 32, 37, 41, 45, 48, 53,
+		58, 62, 66, 70, 75, 79, 82, 87, 90, 94, 97, 101, 112, 116, 120, 124,
+		128, 131, 134, 139, 142, 147, 152, 157, 162, 167, 172, 175, 179, 182, 188, 192,
+		195, 198, 201, 206, 211, 216, 220, 223, 226, 231, 236, 239, 244, 248, 251, 253,
+		257, 262, 266, 270, 276, 281, 285, 289, 292, 296, 300, 303, 307, 310, 313, 318,
+		321, 324, 329, 333, 338, 343, 348, 352, 356, 359, 363, 366, 372, 377, 380, 384,
+		389, 392, 398, 403, 406, 410, 415, 420, 425, 429, 432, 436, 439, 442, 445, 450,
+		454, 459, 463, 467, 472, 477, 481, 486, 490, 495, 499, 502, 506, 509, 513, 518,
+		522, 527, 531, 535, 539, 542, 548, 553, 560, 564, 569, 573, 577, 581, 587, 591,
+		595, 599, 603, 607, 611, 615, 620, 624, 628, 633, 638, 641, 646, 649, 653, 658,
+		663, 666, 669, 672, 677, 681, 684, 687, 691, 694, 699, 704, 707, 710, 714, 719,
+		723, 726, 731, 735, 738, 741, 744, 749, 752, 756, 760, 766, 771, 774, 778, 783,
+		786, 789, 793, 797, 802, 807, 811, 814, 818, 821, 824, 829, 834, 839, 843, 847,
+		851, 856, 860, 863, 867, 872, 876, 880, 884, 887, 892, 896, 899, 903, 906, 910,
+		914, 918, 921, 924, 928, 933, 936, 941, 946, 949, 954, 959, 963, 968, 972, 977,
+		980, 985, 990, 993, 998, 1002, 1006, 1011, 1015, 1019, 1023, 1027, 1030, 1033, 1036, 1041,
+		1044, 1047, 1050, 0, 3, 9, 13, 19, 23, 27, 32, 35, 40, 44, 48, 51,
+		54, 57, 61, 67, 70, 74, 78, 82, 85, 88, 92, 96, 100, 105, 109, 112,
+		115, 118, 122, 125, 131, 135, 138, 144, 147, 152, 157, 161, 165, 169, 174, 178,
+		182, 186, 190, 195, 198, 202, 206, 211, 214, 219, 226, 232, 236, 241, 245, 249,
+		252, 255, 258, 262, 265, 269, 275, 279, 284, 287, 292, 297, 301, 306, 310, 314,
+		318, 321, 326, 330, 334, 339, 343, 347, 351, 355, 358, 361, 365, 369, 371, 376,
+		380, 384, 388, 391, 394, 398, 402, 407, 411, 414, 417, 420, 424, 429, 432, 437,
+		440, 445, 448, 453, 457, 462, 465, 470, 474, 478, 483, 486, 490, 495, 498, 503,
+		506, 511, 515, 520, 524, 527, 530, 534, 537, 541, 544, 548, 552, 556, 559, 562,
+		568, 573, 577, 580, 585, 591, 595, 600, 606, 611, 614, 619, 622, 625, 630, 634,
+		637, 642, 646, 651, 655, 660, 664, 667, 671, 675, 680, 684, 689, 692, 696, 700,
+		704, 708, 712, 716, 720, 724, 728, 732, 737, 742, 746, 749, 754, 758, 761, 765,
+		770, 775, 778, 781, 786, 790, 795, 800, 803, 806, 811, 814, 818, 822, 827, 832,
+		835, 839, 843, 848, 853, 859, 863, 868, 873, 876, 881, 884, 887, 890, 893, 897,
+		900, 903, 906, 910, 915, 918, 922, 926, 931, 935, 938, 943, 948, 952, 956, 960,
+		964, 970, 975, 979, 983, 987, 990, 994, 998, 1002, 1005, 1009, 1013, 1016, 1020, 1024,
+		1029, 1034, 1038, 1042, 0, 4, 7, 11, 15, 18, 23, 26, 29, 33, 38, 42,
+		46, 49, 53, 56, 62, 67, 70, 75, 78, 84, 89, 93, 96, 101, 104, 109,
+		112, 115, 120, 125, 129, 135, 140, 145, 150, 154, 158, 163, 166, 170, 174, 177,
+		181, 185, 189, 192, 197, 205, 209, 213, 217, 222, 226, 230, 234, 239, 244, 247,
+		250, 253, 257, 262, 266, 269, 273, 276, 281, 285, 290, 294, 299, 304, 307, 312,
+		316, 320, 323, 326, 329, 333, 336, 339, 344, 349, 353, 356, 359, 363, 366, 369,
+		372, 375, 378, 381, 385, 390, 393, 397, 401, 405, 410, 415, 419, 423, 426, 430,
+		436, 440, 445, 448, 452, 458, 462, 466, 469, 473, 477, 480, 484, 487, 492, 496,
+		501, 504, 508, 512, 517, 521, 524, 527, 533, 538, 540, 543, 547, 552, 556, 560,
+		564, 569, 572, 576, 580, 584, 587, 591, 595, 598, 603, 608, 612, 616, 620, 623,
+		629, 632, 635, 638, 643, 647, 651, 656, 661, 665, 668, 672, 676, 679, 683, 687,
+		692, 697, 700, 703, 707, 711, 715, 720, 724, 728, 731, 735, 738, 741, 744, 748,
+		752, 756, 759, 762, 767, 771, 776, 780, 785, 788, 792, 795, 799, 803, 808, 811,
+		815, 818, 823, 826, 832, 835, 839, 842, 845, 849, 853, 858, 861, 866, 869, 873,
+		877, 880, 885, 889, 894, 897, 900, 905, 908, 911, 915, 918, 923, 926, 932, 936,
+		940, 945, 949, 953, 957, 961, 965, 969, 972, 975, 980, 984, 988, 993, 998, 1002,
+		1007, 1011, 1015, 1017, 1021, 0, 4, 9, 14, 19, 24, 27, 32, 36, 39, 42,
+		45, 50, 53, 57, 62, 66, 70, 74, 78, 83, 88, 93, 97, 101, 105, 110,
+		114, 117, 120, 123, 126, 129, 133, 137, 142, 148, 151, 154, 158, 161, 165, 170,
+		174, 178, 182, 186, 189, 194, 198, 201, 205, 209, 212, 217, 220, 225, 230, 234,
+		237, 242, 246, 252, 256, 260, 265, 267, 271, 274, 279, 283, 288, 293, 297, 302,
+		307, 310, 313, 316, 319, 324, 328, 331, 336, 339, 343, 347, 352, 358, 362, 367,
+		371, 375, 378, 383, 387, 391, 394, 399, 403, 407, 411, 415, 419, 424, 428, 434,
+		439, 443, 448, 451, 454, 459, 463, 468, 471, 474, 477, 481, 485, 488, 492, 496,
+		499, 503, 507, 511, 516, 521, 527, 531, 536, 541, 544, 547, 550, 553, 558, 563,
+		568, 572, 576, 581, 586, 589, 592, 596, 600, 604, 607, 610, 614, 619, 622, 625,
+		629, 632, 635, 639, 642, 647, 650, 654, 659, 664, 667, 672, 675, 679, 683, 688,
+		694, 698, 702, 706, 709, 712, 715, 719, 723, 728, 733, 738, 741, 744, 747, 752,
+		756, 760, 764, 770, 774, 780, 786, 790, 795, 799, 803, 807, 810, 813, 817, 821,
+		825, 828, 833, 836, 840, 845, 854, 860, 865, 871, 874, 878, 881, 885, 888, 892,
+		897, 902, 907, 910, 914, 919, 923, 927, 930, 933, 939, 945, 949, 953, 957, 961,
+		965, 969, 974, 979, 984, 988, 993, 997, 1003, 1007, 1010, 1014, 1020, 1025, 1028, 1032,
+		1037, 1040, 1045, 1048, 1053, 1056, 0, 3, 9, 13, 17, 21, 26, 31, 34, 37,
+		42, 47, 51, 54, 58, 63, 67, 70, 75, 80, 85, 89, 94, 98, 103, 107,
+		111, 114, 118, 122, 126, 130, 133, 136, 139, 144, 147, 151, 155, 158, 161, 166,
+		170, 175, 178, 183, 188, 191, 196, 202, 205, 210, 215, 219, 222, 226, 229, 234,
+		237, 241, 245, 249, 253, 257, 261, 265, 271, 275, 279, 282, 286, 292, 295, 298,
+		301, 305, 308, 313, 317, 321, 326, 329, 334, 339, 343, 348, 353, 358, 362, 367,
+		370, 373, 377, 381, 387, 391, 395, 400, 405, 408, 411, 414, 417, 421, 424, 429,
+		433, 436, 441, 446, 449, 453, 457, 463, 467, 470, 476, 480, 483, 489, 495, 499,
+		502, 506, 510, 514, 518, 522, 526, 530, 535, 539, 544, 547, 552, 556, 560, 564,
+		568, 573, 577, 581, 585, 589, 593, 596, 601, 605, 608, 611, 615, 619, 624, 627,
+		630, 633, 636, 639, 642, 646, 650, 654, 658, 662, 665, 669, 673, 676, 680, 685,
+		690, 693, 697, 701, 704, 707, 710, 715, 718, 721, 725, 728, 732, 738, 742, 746,
+		749, 753, 756, 760, 763, 767, 771, 775, 779, 783, 793, 798, 805, 810, 813, 817,
+		821, 826, 830, 834, 838, 844, 847, 852, 857, 861, 864, 867, 870, 875, 879, 883,
+		887, 890, 893, 896, 903, 908, 912, 916, 919, 924, 929, 932, 937, 941, 946, 949,
+		953, 957, 962, 967, 971, 977, 982, 986, 991, 997, 1003, 1007, 1011, 1015, 1020, 1024,
+		1028, 1032, 1035, 1038, 1042, 1045, 1048, 0, 3, 7, 11, 15, 19, 22, 25, 28,
+		34, 38, 43, 47, 51, 56, 60, 64, 67, 72, 76, 80, 83, 86, 91, 95,
+		99, 103, 107, 110, 114, 120, 124, 127, 131, 134, 139, 144, 149, 152, 156, 162,
+		166, 170, 174, 178, 183, 188, 193, 197, 202, 207, 210, 215, 219, 222, 226, 230,
+		233, 236, 240, 244, 249, 253, 258, 262, 265, 271, 275, 279, 285, 293, 296, 301,
+		307, 312, 316, 319, 323, 326, 330, 333, 339, 343, 347, 351, 355, 359, 362, 366,
+		370, 374, 378, 383, 388, 393, 396, 400, 405, 409, 413, 416, 420, 423, 426, 430,
+		434, 438, 441, 445, 449, 453, 456, 461, 465, 470, 473, 478, 482, 486, 490, 497,
+		501, 505, 510, 514, 517, 521, 524, 527, 530, 534, 539, 543, 546, 551, 554, 557,
+		560, 563, 566, 571, 576, 581, 585, 589, 593, 598, 602, 605, 608, 612, 616, 619,
+		624, 628, 632, 635, 640, 645, 649, 653, 657, 660, 665, 669, 672, 677, 680, 684,
+		686, 689, 693, 698, 701, 706, 711, 715, 718, 723, 727, 732, 737, 742, 746, 749,
+		754, 758, 762, 765, 769, 773, 778, 781, 786, 791, 796, 800, 804, 807, 811, 816,
+		820, 824, 827, 830, 833, 838, 841, 845, 849, 852, 857, 861, 865, 868, 872, 876,
+		881, 884, 887, 891, 895, 898, 904, 908, 913, 916, 920, 924, 928, 933, 938, 942,
+		946, 950, 955, 958, 961, 964, 967, 971, 975, 978, 983, 986, 992, 997, 1001, 1005,
+		1009, 1012, 1015, 1018, 1022, 1025, 1029, 1032, 0, 4, 8, 13, 16, 20, 24, 29,
+		34, 37, 41, 45, 50, 55, 59, 63, 66, 70, 73, 77, 81, 85, 90, 93,
+		97, 100, 104, 108, 110, 114, 117, 121, 126, 131, 135, 139, 143, 148, 151, 156,
+		160, 164, 170, 175, 179, 184, 188, 193, 197, 201, 204, 207, 210, 213, 217, 220,
+		225, 228, 231, 236, 240, 246, 250, 253, 256, 261, 264, 267, 272, 276, 281, 284,
+		289, 292, 296, 300, 305, 309, 312, 315, 318, 322, 326, 329, 332, 337, 342, 345,
+		351, 354, 357, 360, 363, 367, 371, 374, 378, 383, 386, 390, 393, 399, 402, 406,
+		410, 415, 419, 423, 427, 431, 435, 440, 444, 448, 452, 456, 462, 466, 471, 476,
+		481, 484, 489, 494, 496, 500, 504, 510, 514, 517, 522, 527, 531, 535, 539, 545,
+		549, 552, 555, 560, 563, 567, 571, 574, 579, 581, 587, 589, 593, 598, 602, 605,
+		610, 614, 619, 624, 628, 633, 636, 639, 644, 650, 654, 659, 662, 665, 669, 674,
+		677, 681, 686, 691, 696, 701, 704, 712, 718, 723, 727, 730, 733, 738, 743, 747,
+		752, 757, 761, 765, 768, 771, 774, 778, 783, 786, 791, 796, 801, 806, 809, 812,
+		816, 821, 825, 828, 833, 838, 844, 847, 851, 856, 861, 867, 872, 876, 880, 884,
+		889, 894, 899, 904, 907, 911, 914, 919, 923, 928, 932, 936, 940, 942, 948, 951,
+		955, 958, 961, 966, 971, 974, 977, 980, 986, 989, 993, 997, 1000, 1005, 1009, 1014,
+		1019, 1023, 1028, 1032, 1037, 1042, 1045, 1049, 1052, 0, 4, 7, 11, 16, 21, 25,
+		30, 35, 38, 41, 47, 51, 54, 59, 62, 67, 70, 73, 79, 84, 88, 91,
+		94, 99, 103, 108, 112, 118, 123, 127, 131, 135, 139, 143, 146, 149, 154, 159,
+		164, 167, 170, 173, 179, 183, 187, 192, 195, 199, 204, 208, 212, 215, 220, 223,
+		228, 231, 234, 238, 242, 245, 248, 254, 258, 263, 267, 271, 276, 279, 284, 287,
+		290, 294, 298, 304, 308, 312, 314, 319, 323, 326, 330, 335, 338, 342, 348, 351,
+		355, 359, 363, 368, 372, 376, 379, 385, 390, 394, 398, 403, 407, 412, 415, 420,
+		425, 430, 434, 437, 440, 445, 448, 452, 456, 459, 462, 467, 472, 476, 481, 486,
+		489, 492, 497, 501, 504, 509, 514, 517, 522, 526, 529, 533, 538, 542, 546, 551,
+		555, 560, 563, 566, 571, 575, 579, 581, 586, 590, 595, 599, 604, 609, 613, 617,
+		622, 626, 630, 635, 639, 642, 647, 652, 656, 659, 663, 666, 671, 674, 678, 681,
+		685, 690, 694, 699, 704, 707, 711, 715, 718, 722, 725, 729, 735, 739, 742, 745,
+		748, 751, 754, 756, 760, 766, 771, 776, 780, 785, 789, 795, 798, 801, 806, 809,
+		813, 819, 823, 826, 831, 834, 838, 841, 844, 849, 852, 855, 858, 864, 868, 872,
+		876, 880, 884, 888, 894, 898, 903, 907, 911, 915, 920, 924, 928, 931, 937, 941,
+		947, 951, 955, 958, 963, 967, 970, 973, 978, 981, 985, 989, 993, 999, 1004, 1009,
+		1012, 1017, 1021, 1025, 1029, 1032, 1037, 1041, 1044, 1048, 0, 3, 8, 13, 18, 23,
+		29, 34, 38, 42, 47, 50, 54, 59, 63, 69, 74, 78, 82, 85, 89, 91,
+		94, 99, 104, 107, 110, 114, 117, 120, 125, 128, 131, 136, 139, 143, 147, 152,
+		156, 161, 164, 168, 172, 176, 181, 185, 189, 193, 198, 202, 205, 209, 213, 218,
+		223, 228, 231, 236, 241, 245, 249, 254, 258, 263, 266, 269, 273, 278, 282, 285,
+		290, 294, 300, 303, 307, 310, 315, 319, 322, 326, 331, 336, 340, 344, 348, 352,
+		356, 360, 364, 368, 371, 375, 378, 383, 386, 390, 392, 395, 401, 406, 411, 415,
+		419, 422, 425, 428, 432, 436, 439, 443, 447, 451, 454, 457, 461, 466, 470, 473,
+		478, 482, 485, 489, 493, 498, 502, 505, 508, 511, 515, 518, 522, 527, 530, 533,
+		537, 542, 546, 549, 552, 555, 559, 563, 566, 569, 572, 575, 579, 584, 588, 591,
+		594, 596, 599, 602, 606, 610, 615, 619, 623, 628, 633, 636, 640, 645, 648, 651,
+		656, 660, 664, 668, 672, 676, 681, 684, 688, 691, 695, 699, 702, 705, 708, 712,
+		717, 722, 726, 730, 736, 742, 746, 750, 753, 757, 761, 764, 767, 770, 774, 778,
+		782, 787, 790, 794, 797, 801, 806, 810, 815, 820, 825, 829, 834, 838, 842, 846,
+		850, 854, 860, 865, 870, 874, 878, 881, 886, 892, 897, 901, 906, 911, 915, 920,
+		924, 927, 931, 936, 941, 945, 948, 954, 957, 961, 967, 972, 976, 980, 984, 988,
+		993, 998, 1001, 1007, 1010, 1016, 1019, 1023, 1027, 1030, 1035, 0, 4, 8, 13, 19,
+		23, 28, 33, 37, 42, 46, 50, 54, 57, 62, 66, 71, 75, 79, 84, 89,
+		92, 95, 99, 103, 109, 114, 119, 123, 128, 132, 137, 140, 145, 150, 154, 157,
+		162, 166, 172, 176, 180, 184, 188, 192, 197, 201, 205, 210, 213, 218, 221, 225,
+		229, 233, 239, 243, 247, 251, 254, 257, 261, 265, 269, 273, 276, 280, 283, 288,
+		291, 295, 299, 303, 308, 312, 316, 319, 322, 325, 329, 335, 338, 341, 346, 351,
+		355, 359, 363, 368, 373, 376, 382, 386, 389, 393, 397, 401, 406, 411, 416, 420,
+		424, 427, 431, 435, 441, 444, 450, 453, 459, 463, 467, 472, 476, 480, 483, 487,
+		490, 495, 499, 502, 507, 513, 517, 522, 525, 529, 533, 537, 540, 545, 550, 554,
+		557, 562, 565, 568, 573, 577, 581, 584, 588, 592, 596, 600, 604, 607, 612, 616,
+		620, 624, 628, 631, 634, 639, 642, 646, 649, 654, 658, 663, 668, 672, 675, 678,
+		683, 687, 690, 693, 696, 700, 706, 711, 716, 720, 724, 727, 730, 734, 738, 741,
+		744, 748, 751, 754, 758, 762, 765, 770, 775, 780, 784, 787, 791, 794, 797, 801,
+		807, 811, 815, 818, 823, 828, 832, 835, 839, 842, 846, 850, 853, 857, 861, 865,
+		868, 872, 876, 879, 883, 886, 891, 894, 897, 900, 903, 907, 910, 913, 917, 922,
+		927, 931, 935, 941, 945, 951, 955, 961, 965, 971, 975, 978, 983, 987, 991, 996,
+		1000, 1005, 1008, 1013, 1016, 1021, 1025, 1028, 1031, 1034, 1038, 1041, 0, 4, 9, 13,
+		18, 21, 25, 29, 33, 37, 42, 47, 52, 57, 62, 66, 69, 74, 77, 80,
+		84, 89, 93, 96, 100, 103, 106, 110, 113, 118, 123, 126, 130, 134, 139, 142,
+		145, 148, 152, 155, 158, 163, 167, 172, 177, 181, 187, 192, 196, 199, 203, 207,
+		212, 217, 223, 226, 230, 235, 241, 246, 250, 255, 258, 261, 264, 268, 273, 277,
+		281, 284, 288, 292, 297, 301, 305, 308, 311, 317, 322, 327, 330, 333, 337, 341,
+		345, 350, 353, 359, 362, 367, 370, 375, 379, 383, 386, 390, 393, 397, 403, 406,
+		410, 415, 420, 425, 429, 434, 438, 442, 445, 450, 455, 460, 464, 468, 474, 478,
+		481, 484, 487, 491, 495, 499, 502, 507, 512, 515, 520, 524, 529, 535, 539, 542,
+		545, 548, 552, 555, 559, 564, 568, 573, 576, 579, 583, 588, 592, 596, 600, 603,
+		607, 612, 616, 621, 625, 630, 634, 639, 643, 648, 652, 656, 661, 665, 669, 672,
+		675, 679, 684, 689, 692, 696, 700, 704, 709, 714, 718, 725, 728, 731, 735, 739,
+		744, 749, 755, 759, 762, 765, 768, 773, 780, 784, 788, 797, 802, 805, 809, 813,
+		817, 821, 828, 831, 835, 842, 845, 849, 852, 856, 860, 864, 868, 872, 875, 880,
+		884, 888, 893, 899, 903, 907, 912, 916, 918, 921, 924, 928, 933, 937, 941, 947,
+		952, 955, 958, 964, 967, 972, 976, 979, 984, 988, 994, 998, 1003, 1007, 1010, 1013,
+		1017, 1021, 1026, 1028, 1031, 1036, 1040, 1044, 1047, 1050, 1055, 1059, 1064, 0, 3, 9,
+		12, 17, 23, 26, 30, 35, 40, 44, 48, 52, 58, 61, 66, 70, 75, 79,
+		83, 87, 90, 95, 100, 103, 108, 112, 117, 123, 126, 130, 135, 140, 144, 148,
+		151, 156, 161, 165, 170, 175, 180, 184, 188, 192, 198, 203, 207, 210, 215, 220,
+		226, 230, 234, 237, 241, 246, 250, 253, 256, 259, 263, 267, 272, 275, 279, 283,
+		286, 291, 295, 300, 303, 307, 312, 318, 322, 326, 331, 335, 340, 345, 348, 352,
+		356, 360, 364, 367, 370, 374, 379, 382, 385, 389, 392, 395, 400, 405, 409, 413,
+		416, 419, 422, 426, 430, 433, 437, 442, 445, 449, 455, 457, 461, 466, 471, 474,
+		477, 480, 485, 489, 492, 495, 500, 503, 507, 512, 516, 520, 524, 529, 534, 539,
+		542, 547, 551, 554, 560, 565, 569, 573, 577, 582, 588, 593, 596, 600, 603, 606,
+		610, 614, 618, 623, 627, 632, 635, 639, 643, 647, 650, 653, 656, 659, 663, 668,
+		671, 674, 677, 681, 685, 690, 695, 700, 704, 709, 713, 716, 721, 726, 731, 734,
+		739, 744, 749, 754, 759, 766, 771, 776, 781, 785, 788, 793, 796, 801, 805, 810,
+		813, 816, 819, 823, 826, 830, 834, 837, 840, 845, 849, 854, 859, 862, 866, 870,
+		875, 878, 882, 885, 888, 890, 895, 899, 906, 910, 915, 919, 924, 928, 932, 935,
+		940, 944, 947, 953, 957, 962, 966, 969, 972, 975, 980, 984, 987, 991, 995, 999,
+		1003, 1008, 1012, 1017, 1021, 1024, 1029, 1032, 1036, 1039, 1043, 1048, 1052, 1056, 0, 4,
+		9, 13, 17, 22, 26, 29, 33, 39, 44, 48, 51, 55, 60, 64, 68, 72,
+		76, 79, 82, 85, 90, 93, 97, 100, 104, 109, 115, 118, 122, 128, 131, 135,
+		139, 144, 149, 153, 157, 160, 165, 169, 173, 177, 181, 186, 191, 195, 198, 202,
+		207, 211, 214, 219, 223, 227, 230, 233, 236, 241, 245, 248, 252, 255, 260, 264,
+		268, 272, 276, 282, 286, 289, 292, 296, 301, 305, 309, 311, 316, 320, 322, 326,
+		332, 336, 340, 343, 347, 354, 357, 361, 365, 369, 373, 377, 380, 383, 387, 392,
+		395, 398, 403, 406, 410, 415, 421, 424, 428, 431, 435, 438, 442, 446, 450, 453,
+		457, 461, 465, 469, 473, 477, 482, 487, 490, 493, 496, 501, 504, 508, 511, 515,
+		519, 523, 527, 532, 537, 542, 545, 550, 555, 560, 563, 566, 571, 575, 579, 582,
+		585, 590, 595, 598, 603, 608, 612, 615, 620, 623, 629, 632, 638, 641, 646, 650,
+		655, 660, 663, 667, 672, 677, 682, 686, 690, 694, 699, 702, 705, 709, 713, 717,
+		721, 724, 728, 733, 738, 742, 745, 748, 752, 755, 759, 765, 769, 773, 777, 780,
+		783, 787, 790, 793, 797, 800, 804, 808, 813, 818, 821, 825, 829, 834, 838, 843,
+		849, 855, 859, 863, 869, 873, 877, 880, 883, 886, 889, 893, 897, 902, 906, 911,
+		915, 920, 923, 926, 930, 933, 937, 940, 945, 948, 951, 956, 960, 965, 969, 973,
+		978, 982, 985, 990, 994, 997, 1003, 1007, 1010, 1015, 1020, 1023, 1026, 1030, 1034, 0,
+		4, 8, 10, 13, 18, 21, 25, 29, 33, 39, 45, 49, 54, 59, 62, 65,
+		69, 72, 76, 79, 82, 88, 92, 96, 101, 104, 107, 112, 115, 120, 124, 130,
+		133, 138, 142, 148, 152, 157, 160, 165, 168, 172, 175, 180, 184, 190, 194, 199,
+		203, 206, 210, 215, 218, 222, 227, 232, 237, 241, 247, 252, 256, 261, 265, 268,
+		272, 277, 281, 286, 291, 294, 299, 303, 306, 310, 315, 319, 324, 328, 333, 336,
+		341, 344, 348, 352, 356, 360, 364, 367, 371, 376, 380, 391, 394, 398, 402, 407,
+		411, 415, 419, 423, 426, 429, 432, 437, 441, 446, 450, 455, 460, 464, 469, 473,
+		478, 484, 490, 494, 497, 502, 506, 509, 514, 518, 521, 525, 529, 533, 536, 542,
+		546, 549, 553, 557, 561, 565, 570, 575, 579, 583, 588, 591, 595, 598, 603, 606,
+		609, 612, 617, 621, 625, 630, 634, 637, 640, 644, 647, 652, 657, 661, 665, 670,
+		674, 678, 682, 687, 693, 698, 703, 706, 709, 713, 717, 720, 726, 729, 734, 737,
+		741, 744, 749, 752, 755, 758, 763, 766, 772, 775, 779, 784, 788, 793, 796, 799,
+		805, 809, 814, 817, 820, 824, 829, 833, 837, 841, 845, 849, 852, 858, 861, 867,
+		870, 874, 878, 882, 885, 890, 894, 899, 904, 907, 911, 914, 917, 922, 926, 929,
+		934, 937, 941, 946, 951, 956, 960, 966, 969, 973, 976, 980, 984, 989, 992, 997,
+		1000, 1003, 1006, 1009, 1012, 1017, 1021, 1024, 1027, 1030, 1035, 1044, 1049, 1052, 1055, 1059,
+		0, 3, 8, 13, 18, 22, 26, 30, 33, 36, 39, 43, 45, 49, 53, 57,
+		61, 66, 70, 73, 77, 82, 86, 90, 94, 97, 101, 104, 108, 113, 117, 121,
+		125, 128, 134, 138, 142, 145, 149, 153, 158, 165, 171, 177, 181, 185, 188, 191,
+		194, 198, 202, 205, 207, 212, 217, 220, 223, 226, 229, 232, 235, 239, 242, 246,
+		251, 255, 260, 264, 268, 272, 275, 280, 284, 288, 293, 298, 302, 305, 308, 314,
+		318, 322, 325, 329, 333, 336, 339, 344, 349, 352, 357, 361, 366, 369, 372, 376,
+		379, 384, 388, 391, 395, 399, 403, 409, 412, 415, 419, 422, 426, 429, 433, 438,
+		441, 444, 450, 453, 457, 462, 467, 472, 477, 482, 486, 489, 492, 497, 501, 505,
+		509, 513, 517, 522, 528, 534, 539, 542, 546, 549, 552, 555, 559, 563, 567, 573,
+		578, 582, 587, 592, 597, 600, 604, 608, 612, 617, 621, 625, 630, 635, 639, 643,
+		647, 651, 654, 657, 660, 663, 666, 670, 676, 679, 684, 688, 691, 695, 700, 705,
+		713, 716, 721, 725, 729, 733, 738, 742, 747, 751, 755, 760, 763, 767, 771, 776,
+		782, 786, 789, 793, 798, 802, 806, 810, 814, 817, 820, 823, 828, 833, 836, 840,
+		844, 849, 854, 859, 864, 868, 872, 875, 880, 883, 886, 891, 896, 901, 905, 910,
+		915, 920, 924, 928, 932, 936, 942, 945, 950, 954, 959, 962, 965, 969, 973, 979,
+		983, 986, 989, 995, 999, 1004, 1007, 1011, 1015, 1018, 1024, 1029, 1034, 1039, 1044, 1048,
+		1054, 0, 4, 8, 13, 17, 20, 23, 29, 33, 38, 42, 46, 51, 54, 57,
+		62, 65, 70, 74, 76, 81, 84, 88, 91, 95, 100, 103, 109, 113, 117, 122,
+		126, 130, 135, 138, 143, 148, 151, 154, 158, 163, 167, 173, 178, 181, 186, 190,
+		193, 197, 201, 206, 209, 214, 217, 221, 226, 229, 233, 237, 244, 247, 251, 255,
+		259, 262, 265, 270, 275, 279, 285, 288, 292, 296, 301, 304, 309, 313, 318, 321,
+		324, 328, 333, 336, 340, 343, 345, 349, 354, 357, 361, 366, 371, 376, 380, 383,
+		388, 393, 399, 403, 406, 411, 415, 419, 423, 428, 432, 437, 441, 446, 451, 455,
+		459, 464, 469, 472, 476, 481, 486, 491, 496, 500, 503, 508, 512, 516, 521, 526,
+		532, 535, 539, 542, 546, 550, 553, 557, 562, 566, 571, 574, 579, 583, 588, 592,
+		595, 599, 602, 605, 609, 614, 619, 624, 628, 634, 637, 642, 647, 650, 654, 657,
+		660, 665, 670, 673, 676, 681, 687, 692, 696, 700, 704, 708, 712, 716, 720, 725,
+		730, 734, 737, 742, 747, 751, 754, 758, 761, 764, 769, 777, 784, 788, 792, 797,
+		801, 804, 807, 811, 814, 817, 822, 826, 831, 836, 841, 846, 849, 853, 856, 860,
+		863, 867, 871, 874, 879, 884, 887, 890, 893, 898, 904, 908, 911, 917, 922, 926,
+		931, 936, 941, 946, 951, 955, 959, 963, 968, 973, 977, 982, 986, 989, 993, 996,
+		1001, 1008, 1013, 1019, 1024, 1030, 1033, 1037, 1040, 1043, 1047, 1053, 1056, 1060, 1064, 1068,
+		1072, 1078, 0, 4, 7, 11, 15, 20, 24, 28, 32, 37, 42, 47, 52, 58,
+		62, 67, 71, 76, 80, 84, 88, 92, 96, 99, 104, 110, 115, 118, 123, 127,
+		131, 135, 138, 141, 145, 149, 155, 160, 165, 169, 174, 178, 182, 185, 190, 194,
+		197, 202, 207, 212, 217, 222, 226, 229, 233, 238, 241, 245, 249, 254, 257, 261,
+		264, 268, 271, 274, 279, 283, 288, 292, 297, 302, 305, 309, 312, 316, 321, 325,
+		330, 334, 339, 342, 347, 352, 357, 361, 365, 369, 372, 376, 379, 384, 390, 396,
+		401, 404, 407, 412, 417, 420, 425, 429, 432, 437, 442, 447, 449, 452, 456, 461,
+		465, 468, 473, 477, 482, 488, 493, 496, 501, 506, 510, 514, 519, 522, 526, 530,
+		535, 540, 544, 548, 552, 557, 561, 565, 569, 573, 576, 581, 586, 591, 594, 599,
+		602, 607, 613, 618, 621, 625, 630, 634, 637, 640, 645, 650, 654, 657, 661, 664,
+		668, 673, 679, 682, 686, 691, 695, 700, 704, 708, 712, 715, 719, 724, 729, 733,
+		737, 741, 744, 747, 752, 758, 763, 766, 769, 771, 774, 777, 780, 784, 789, 792,
+		796, 800, 805, 809, 813, 816, 821, 826, 831, 835, 839, 843, 848, 852, 855, 858,
+		861, 865, 870, 874, 878, 881, 886, 889, 893, 899, 903, 906, 910, 914, 919, 924,
+		927, 932, 936, 940, 944, 948, 953, 958, 962, 967, 971, 976, 980, 984, 988, 991,
+		996, 1002, 1005, 1010, 1013, 1018, 1024, 1029, 1032, 1036, 1039, 1043, 1047, 1050, 1053, 1057,
+		1060, 1065, 1070, 0, 5, 9, 12, 18, 22, 26, 30, 34, 38, 42, 46, 51,
+		55, 59, 63, 68, 72, 75, 80, 85, 88, 90, 96, 100, 105, 109, 113, 117,
+		120, 123, 127, 131, 137, 140, 143, 147, 152, 157, 161, 165, 170, 175, 179, 183,
+		187, 192, 197, 200, 205, 208, 212, 217, 221, 227, 232, 234, 238, 243, 249, 254,
+		258, 264, 269, 272, 275, 279, 283, 286, 290, 294, 297, 302, 305, 308, 312, 317,
+		322, 326, 329, 333, 337, 342, 345, 349, 353, 358, 363, 368, 372, 376, 381, 386,
+		391, 394, 397, 402, 406, 410, 414, 419, 422, 425, 429, 433, 436, 441, 447, 452,
+		456, 460, 465, 470, 475, 478, 482, 487, 490, 495, 501, 507, 513, 517, 520, 524,
+		527, 532, 535, 541, 545, 548, 554, 559, 563, 567, 571, 574, 578, 581, 585, 589,
+		594, 599, 604, 608, 612, 617, 622, 627, 631, 635, 640, 644, 648, 652, 657, 662,
+		666, 671, 676, 679, 683, 686, 691, 694, 697, 700, 704, 709, 713, 717, 720, 724,
+		729, 732, 737, 741, 744, 748, 752, 757, 760, 764, 770, 775, 779, 783, 787, 791,
+		793, 797, 800, 804, 810, 814, 820, 823, 827, 831, 835, 838, 842, 846, 850, 854,
+		858, 861, 866, 873, 878, 882, 885, 889, 892, 896, 903, 908, 912, 915, 919, 924,
+		929, 934, 938, 941, 945, 950, 953, 958, 963, 966, 970, 973, 977, 983, 988, 992,
+		996, 999, 1003, 1010, 1014, 1018, 1023, 1028, 1033, 1037, 1042, 1046, 1050, 1054, 1058, 1062,
+		1065, 1069, 1072, 1076, 0, 3, 6, 9, 13, 17, 21, 26, 29, 32, 36, 42,
+		45, 49, 53, 57, 59, 64, 68, 71, 75, 79, 83, 86, 91, 94, 98, 102,
+		107, 110, 113, 116, 120, 124, 129, 132, 135, 139, 143, 148, 152, 154, 160, 164,
+		168, 173, 177, 182, 186, 189, 194, 199, 204, 208, 213, 217, 223, 225, 228, 231,
+		234, 238, 243, 248, 250, 254, 258, 261, 266, 269, 272, 275, 280, 285, 290, 294,
+		298, 301, 307, 311, 315, 320, 323, 327, 330, 334, 339, 343, 348, 353, 358, 361,
+		364, 368, 373, 379, 383, 386, 390, 395, 400, 404, 409, 413, 417, 421, 426, 430,
+		434, 438, 441, 445, 449, 453, 456, 461, 466, 469, 474, 478, 483, 486, 492, 497,
+		501, 505, 510, 515, 520, 526, 530, 534, 537, 540, 543, 548, 552, 556, 560, 564,
+		568, 571, 577, 581, 585, 589, 593, 597, 601, 604, 608, 611, 615, 618, 621, 626,
+		629, 635, 639, 642, 645, 649, 652, 656, 660, 664, 669, 672, 676, 680, 683, 688,
+		692, 695, 698, 701, 705, 708, 712, 714, 718, 723, 726, 729, 732, 735, 740, 743,
+		747, 752, 756, 760, 763, 767, 771, 776, 779, 785, 788, 791, 794, 799, 803, 807,
+		811, 814, 819, 822, 826, 829, 832, 836, 839, 844, 849, 853, 857, 861, 868, 873,
+		877, 880, 885, 888, 891, 896, 900, 903, 907, 911, 914, 917, 920, 923, 926, 930,
+		933, 937, 940, 943, 947, 951, 956, 960, 963, 966, 970, 975, 979, 982, 986, 991,
+		996, 1001, 1006, 1009, 1014, 0, 3, 6, 9, 14, 19, 23, 28, 33, 37, 41,
+		46, 49, 54, 58, 62, 66, 71, 76, 80, 83, 88, 92, 96, 101, 106, 111,
+		114, 119, 126, 131, 135, 140, 144, 147, 151, 155, 159, 164, 168, 171, 175, 179,
+		183, 186, 189, 192, 196, 201, 206, 209, 212, 217, 221, 224, 229, 234, 237, 240,
+		244, 249, 252, 255, 259, 264, 269, 273, 276, 280, 283, 287, 291, 296, 301, 304,
+		309, 312, 316, 319, 322, 325, 330, 335, 340, 345, 350, 355, 360, 366, 371, 376,
+		381, 386, 391, 396, 400, 404, 408, 411, 416, 421, 425, 428, 433, 437, 440, 443,
+		448, 452, 457, 461, 464, 467, 470, 474, 478, 482, 486, 490, 493, 497, 500, 503,
+		506, 509, 512, 516, 519, 523, 527, 531, 535, 540, 544, 549, 554, 558, 562, 566,
+		569, 573, 576, 579, 583, 588, 595, 598, 603, 608, 612, 617, 621, 626, 631, 636,
+		641, 646, 649, 655, 660, 664, 667, 670, 674, 678, 683, 686, 690, 694, 697, 702,
+		705, 708, 713, 718, 721, 726, 729, 733, 737, 740, 744, 748, 752, 755, 758, 762,
+		767, 771, 775, 780, 784, 787, 792, 798, 803, 806, 810, 815, 820, 823, 826, 831,
+		835, 839, 843, 846, 850, 855, 858, 862, 867, 871, 877, 881, 885, 889, 893, 897,
+		900, 904, 908, 912, 916, 920, 923, 927, 931, 934, 937, 940, 944, 948, 951, 955,
+		959, 963, 967, 971, 975, 980, 984, 989, 992, 996, 999, 1003, 1008, 1011, 1016, 1021,
+		1026, 1030, 1035, 1039, 1043, 1049, 0, 3, 6, 11, 16, 20, 26, 31, 35, 38,
+		41, 44, 47, 52, 56, 59, 64, 67, 71, 75, 79, 82, 86, 90, 93, 98,
+		101, 106, 110, 113, 118, 120, 122, 126, 130, 134, 139, 142, 147, 151, 155, 160,
+		165, 169, 172, 176, 180, 184, 188, 192, 195, 200, 206, 211, 215, 218, 222, 227,
+		231, 235, 239, 243, 247, 250, 253, 258, 263, 267, 271, 275, 280, 283, 287, 293,
+		297, 300, 304, 307, 309, 311, 315, 319, 323, 328, 334, 338, 343, 347, 350, 355,
+		360, 365, 370, 376, 379, 383, 388, 393, 396, 400, 403, 407, 411, 416, 419, 423,
+		428, 432, 437, 440, 445, 449, 454, 458, 461, 465, 469, 474, 477, 482, 487, 491,
+		497, 502, 505, 508, 512, 515, 519, 522, 526, 531, 536, 539, 542, 547, 550, 555,
+		559, 563, 568, 572, 577, 583, 587, 590, 594, 598, 602, 606, 611, 616, 619, 622,
+		627, 629, 635, 639, 641, 645, 649, 654, 659, 663, 667, 672, 675, 679, 684, 689,
+		694, 701, 704, 708, 713, 718, 721, 724, 728, 732, 736, 741, 746, 749, 752, 755,
+		759, 764, 768, 772, 776, 780, 784, 788, 791, 796, 801, 806, 810, 815, 820, 825,
+		830, 835, 838, 843, 848, 853, 858, 863, 868, 871, 874, 877, 881, 885, 889, 894,
+		899, 904, 908, 912, 916, 920, 924, 928, 932, 935, 940, 943, 947, 952, 956, 962,
+		966, 970, 973, 978, 982, 986, 990, 994, 998, 1002, 1006, 1009, 1014, 1017, 1021, 1025,
+		1029, 1032, 1036, 1039, 1043, 1047, 1051, 0, 4, 8, 13, 18, 22, 27, 31, 36,
+		39, 45, 50, 55, 58, 62, 65, 68, 72, 75, 79, 81, 84, 88, 92, 95,
+		98, 102, 106, 110, 114, 118, 123, 128, 133, 138, 141, 144, 148, 152, 158, 162,
+		167, 169, 174, 178, 181, 187, 191, 195, 200, 204, 207, 212, 216, 220, 223, 227,
+		232, 237, 240, 244, 247, 251, 255, 259, 263, 266, 270, 273, 278, 282, 285, 288,
+		292, 296, 300, 304, 310, 315, 319, 323, 326, 329, 333, 339, 344, 348, 352, 358,
+		363, 367, 371, 376, 380, 383, 388, 394, 398, 403, 408, 412, 417, 420, 424, 429,
+		433, 436, 440, 443, 447, 451, 455, 460, 464, 468, 471, 475, 478, 481, 485, 491,
+		495, 498, 503, 506, 511, 514, 518, 520, 523, 527, 531, 535, 539, 544, 549, 553,
+		557, 560, 564, 569, 572, 575, 578, 582, 586, 590, 594, 598, 604, 609, 614, 618,
+		622, 628, 632, 635, 638, 641, 644, 647, 651, 655, 660, 663, 666, 669, 674, 678,
+		682, 686, 690, 695, 698, 701, 706, 710, 713, 717, 721, 725, 729, 733, 737, 741,
+		745, 748, 751, 755, 759, 763, 767, 770, 774, 777, 781, 786, 790, 793, 796, 800,
+		803, 809, 812, 817, 822, 827, 832, 835, 839, 842, 845, 848, 853, 857, 862, 867,
+		871, 874, 879, 883, 886, 890, 894, 897, 901, 904, 909, 913, 917, 922, 927, 932,
+		936, 941, 946, 952, 955, 959, 962, 966, 970, 974, 979, 983, 987, 991, 994, 999,
+		1003, 1008, 1014, 1018, 1021, 1026, 1030, 1034, 0, 2, 8, 12, 15, 18, 23, 28,
+		32, 37, 41, 45, 49, 54, 57, 60, 63, 67, 71, 75, 79, 83, 87, 90,
+		95, 100, 105, 110, 114, 120, 125, 129, 132, 135, 140, 144, 149, 153, 157, 160,
+		164, 168, 172, 176, 181, 187, 191, 195, 200, 205, 208, 212, 215, 219, 224, 228,
+		232, 237, 240, 244, 251, 254, 257, 261, 267, 270, 273, 277, 282, 287, 290, 293,
+		297, 300, 303, 307, 310, 313, 318, 322, 327, 331, 335, 339, 344, 348, 353, 357,
+		361, 365, 370, 375, 378, 381, 385, 389, 393, 397, 402, 405, 410, 416, 419, 426,
+		429, 432, 437, 440, 445, 448, 451, 455, 459, 463, 466, 469, 472, 475, 480, 484,
+		487, 490, 493, 497, 501, 505, 508, 512, 515, 519, 524, 527, 531, 536, 540, 544,
+		548, 553, 558, 561, 567, 571, 575, 579, 583, 586, 589, 592, 597, 602, 605, 609,
+		614, 618, 622, 626, 629, 632, 636, 641, 646, 651, 655, 660, 665, 669, 672, 679,
+		685, 688, 692, 696, 700, 703, 707, 710, 714, 718, 722, 726, 729, 733, 736, 739,
+		742, 746, 751, 755, 759, 762, 766, 771, 776, 779, 782, 785, 788, 792, 796, 799,
+		804, 809, 812, 815, 820, 824, 827, 832, 836, 840, 843, 848, 852, 855, 859, 864,
+		867, 871, 875, 880, 884, 889, 893, 897, 902, 906, 910, 913, 918, 922, 926, 930,
+		934, 938, 942, 945, 950, 955, 958, 961, 965, 968, 972, 975, 979, 982, 985, 990,
+		993, 998, 1002, 1006, 1009, 1014, 1019, 1024, 1028, 0, 3, 6, 10, 15, 18, 23,
+		28, 34, 39, 44, 49, 54, 58, 61, 64, 68, 71, 76, 81, 85, 90, 93,
+		97, 101, 105, 110, 113, 117, 122, 127, 132, 137, 142, 147, 151, 155, 160, 164,
+		168, 172, 177, 180, 184, 188, 192, 196, 200, 206, 209, 212, 215, 220, 223, 228,
+		232, 237, 241, 245, 251, 254, 258, 262, 265, 268, 272, 276, 280, 283, 286, 290,
+		293, 297, 302, 308, 311, 315, 318, 323, 329, 333, 338, 342, 347, 350, 353, 356,
+		359, 364, 368, 373, 376, 380, 384, 387, 391, 396, 400, 405, 408, 411, 413, 415,
+		419, 424, 428, 431, 435, 440, 444, 449, 452, 455, 459, 462, 465, 468, 473, 477,
+		480, 484, 491, 496, 499, 503, 506, 510, 513, 518, 522, 525, 529, 534, 537, 540,
+		543, 547, 550, 555, 558, 562, 566, 569, 574, 579, 583, 587, 590, 594, 597, 600,
+		607, 612, 616, 619, 623, 627, 631, 637, 640, 643, 647, 652, 656, 660, 664, 668,
+		673, 676, 679, 684, 688, 693, 696, 700, 705, 708, 712, 717, 722, 726, 731, 735,
+		739, 742, 746, 751, 754, 758, 762, 765, 769, 773, 777, 782, 785, 788, 792, 797,
+		800, 804, 808, 811, 815, 819, 823, 828, 833, 839, 849, 858, 863, 866, 869, 872,
+		877, 883, 887, 892, 895, 900, 904, 907, 912, 917, 921, 925, 928, 931, 935, 938,
+		942, 948, 953, 957, 962, 966, 969, 974, 979, 983, 988, 995, 1001, 1009, 1013, 1018,
+		1022, 1026, 1030, 1035, 1039, 1042, 1047, 1050, 1055, 1060, 0, 5, 10, 13, 17, 21,
+		24, 28, 32, 36, 42, 47, 51, 55, 59, 64, 67, 69, 74, 77, 81, 86,
+		90, 94, 97, 105, 109, 116, 120, 125, 129, 132, 137, 141, 145, 148, 152, 156,
+		160, 162, 167, 170, 174, 177, 182, 187, 190, 194, 198, 207, 212, 215, 218, 222,
+		226, 229, 234, 237, 242, 246, 250, 253, 257, 262, 265, 268, 272, 278, 282, 288,
+		293, 299, 304, 308, 312, 316, 319, 325, 328, 333, 337, 340, 346, 350, 355, 360,
+		364, 368, 372, 376, 381, 384, 388, 393, 397, 402, 406, 411, 415, 419, 424, 428,
+		432, 438, 441, 447, 456, 463, 469, 472, 475, 478, 482, 489, 492, 496, 499, 503,
+		508, 514, 516, 519, 524, 527, 532, 535, 539, 542, 546, 550, 553, 558, 561, 564,
+		567, 572, 577, 580, 584, 588, 591, 594, 598, 602, 605, 609, 613, 617, 621, 625,
+		628, 633, 636, 640, 645, 648, 653, 658, 661, 667, 671, 676, 679, 684, 689, 692,
+		696, 701, 705, 709, 713, 717, 720, 725, 730, 735, 738, 742, 746, 750, 756, 759,
+		762, 767, 772, 777, 782, 786, 789, 793, 797, 800, 806, 810, 814, 818, 822, 824,
+		828, 831, 837, 842, 848, 853, 857, 860, 863, 866, 870, 874, 879, 884, 887, 892,
+		896, 900, 904, 908, 913, 917, 920, 924, 929, 933, 937, 941, 945, 948, 953, 958,
+		963, 966, 969, 972, 976, 979, 983, 986, 991, 994, 999, 1003, 1007, 1011, 1016, 1021,
+		1025, 1030, 1034, 1037, 1041, 1044, 1048, 1052, 1057, 1061, 1064, 0, 4, 9, 13, 17,
+		20, 25, 30, 34, 38, 41, 47, 52, 57, 61, 64, 67, 71, 74, 79, 83,
+		86, 90, 94, 97, 102, 106, 109, 114, 117, 120, 125, 129, 133, 136, 140, 143,
+		149, 152, 157, 162, 165, 169, 172, 178, 183, 188, 191, 194, 198, 201, 205, 209,
+		214, 219, 222, 226, 230, 234, 237, 242, 246, 249, 253, 256, 260, 265, 269, 274,
+		278, 282, 290, 295, 299, 304, 308, 311, 314, 319, 323, 326, 329, 334, 337, 340,
+		344, 348, 351, 354, 358, 361, 364, 369, 372, 374, 376, 382, 385, 388, 393, 397,
+		405, 412, 415, 420, 424, 427, 430, 435, 440, 444, 449, 453, 458, 461, 465, 470,
+		473, 476, 479, 484, 487, 490, 493, 498, 503, 508, 512, 518, 521, 526, 529, 532,
+		537, 540, 544, 549, 552, 557, 562, 565, 568, 574, 586, 589, 593, 598, 602, 607,
+		611, 614, 618, 621, 624, 627, 629, 633, 637, 640, 645, 648, 652, 655, 660, 665,
+		669, 673, 676, 681, 685, 688, 691, 699, 704, 709, 712, 717, 722, 727, 732, 736,
+		739, 743, 748, 752, 756, 760, 763, 766, 771, 776, 780, 783, 786, 789, 793, 797,
+		800, 803, 808, 812, 815, 818, 821, 824, 827, 832, 836, 841, 846, 849, 855, 859,
+		862, 866, 869, 872, 876, 880, 885, 890, 894, 897, 904, 909, 912, 915, 919, 923,
+		928, 931, 936, 939, 943, 946, 951, 954, 960, 964, 969, 974, 978, 982, 986, 991,
+		996, 999, 1003, 1007, 1010, 1015, 1018, 1023, 1028, 1032, 1035, 1038, 0, 4, 8, 11,
+		14, 17, 21, 24, 29, 32, 37, 40, 45, 49, 52, 57, 62, 65, 69, 72,
+		76, 80, 83, 86, 91, 98, 102, 107, 112, 115, 118, 123, 128, 132, 135, 140,
+		145, 149, 152, 155, 160, 164, 169, 172, 177, 180, 183, 188, 193, 198, 202, 205,
+		210, 214, 218, 221, 225, 228, 232, 236, 241, 246, 249, 254, 259, 262, 265, 268,
+		273, 276, 278, 281, 286, 289, 292, 296, 301, 304, 308, 312, 317, 322, 327, 332,
+		337, 340, 343, 346, 348, 351, 355, 358, 362, 365, 368, 372, 377, 381, 384, 387,
+		392, 395, 398, 403, 407, 412, 417, 422, 426, 429, 432, 437, 442, 447, 451, 456,
+		461, 468, 471, 475, 480, 485, 490, 494, 499, 504, 508, 512, 515, 519, 522, 525,
+		530, 533, 538, 542, 546, 550, 555, 558, 561, 564, 568, 573, 577, 581, 584, 587,
+		590, 594, 598, 603, 606, 612, 616, 620, 623, 626, 630, 636, 640, 645, 648, 653,
+		657, 660, 664, 668, 671, 676, 679, 684, 689, 692, 696, 699, 702, 706, 709, 714,
+		718, 721, 726, 730, 735, 738, 741, 746, 749, 752, 755, 759, 762, 766, 770, 774,
+		780, 786, 790, 796, 801, 805, 810, 813, 819, 824, 828, 831, 836, 840, 844, 848,
+		851, 856, 860, 863, 868, 871, 874, 879, 883, 887, 891, 896, 899, 903, 907, 910,
+		914, 918, 923, 926, 929, 934, 937, 941, 945, 948, 952, 956, 959, 964, 968, 972,
+		975, 979, 983, 987, 990, 993, 997, 1001, 1004, 1007, 1011, 1015, 1020, 0, 3, 6,
+		11, 14, 18, 22, 27, 30, 34, 38, 41, 46, 51, 55, 60, 63, 67, 72,
+		75, 78, 81, 86, 90, 94, 99, 102, 108, 112, 115, 120, 125, 130, 134, 138,
+		142, 146, 150, 153, 158, 162, 167, 170, 176, 180, 184, 188, 193, 196, 201, 206,
+		210, 213, 217, 220, 223, 226, 231, 235, 239, 242, 246, 250, 254, 257, 260, 264,
+		267, 270, 274, 278, 283, 287, 292, 297, 300, 305, 308, 311, 314, 317, 320, 323,
+		327, 332, 337, 340, 343, 347, 351, 355, 359, 363, 366, 370, 375, 378, 383, 388,
+		393, 397, 402, 406, 410, 414, 418, 422, 427, 431, 434, 438, 441, 444, 448, 452,
+		455, 459, 464, 467, 469, 472, 475, 480, 484, 489, 493, 497, 500, 504, 509, 512,
+		516, 519, 524, 528, 533, 536, 540, 543, 548, 551, 554, 559, 564, 569, 574, 578,
+		583, 588, 593, 597, 600, 605, 608, 613, 618, 622, 626, 630, 634, 638, 642, 647,
+		650, 654, 658, 661, 664, 667, 670, 673, 676, 679, 682, 685, 688, 691, 694, 697,
+		700, 703, 706, 709, 712, 715, 718, 721, 724, 727, 730, 733, 736, 739, 742, 745,
+		748, 753, 756, 759, 762, 765, 768, 771, 774, 777, 780, 785, 788, 792, 795, 798,
+		801, 804, 807, 811, 814, 817, 820, 823, 826, 829, 832, 835, 838, 841, 844, 847,
+		850, 853, 856, 859, 862, 865, 868, 871, 874, 877, 882, 885, 888, 891, 894, 897,
+		900, 903, 908, 912, 917, 920, 923, 926, 930, 933, 936, 939, 942, 946, 0, 2,
+		4, 5, 7, 10, 13, 15, 18, 20, 22, 23, 25, 28, 30, 33, 35, 37,
+		38, 40, 42, 43, 45, 48, 51, 53, 56, 60, 64, 67, 71, 74, 77, 79,
+		82, 86, 89, 93, 96, 99, 101, 104, 107, 109, 112, 115, 118, 120, 123, 127,
+		130, 133, 136, 138, 141, 145, 148, 151, 154, 156, 159, 163, 166, 170, 173, 176,
+		178, 181, 185, 188, 192, 195, 198, 200, 203, 206, 209, 211, 214, 218, 221, 224,
+		227, 229, 232, 236, 239, 243, 247, 250, 254, 259, 264, 268, 273, 277, 281, 284,
+		288, 293, 297, 302, 306, 310, 313, 317, 321, 324, 328, 332, 336, 339, 343, 348,
+		352, 356, 360, 363, 367, 371, 375, 378, 382, 387, 391, 396, 400, 404, 407, 411,
+		415, 419, 422, 426, 430, 434, 437, 441, 446, 450, 454, 458, 461, 465, 470, 474,
+		478, 482, 485, 489, 494, 498, 503, 507, 511, 514, 518, 523, 527, 532, 536, 540,
+		543, 547, 551, 555, 558, 562, 567, 571, 575, 578, 582, 587, 591, 594, 597, 599,
+		602, 606, 609, 613, 616, 619, 621, 624, 628, 632, 635, 639, 642, 645, 647, 650,
+		653, 655, 658, 661, 663, 666, 670, 673, 676, 679, 681, 684, 687, 690, 692, 695,
+		698, 701, 703, 706, 709, 711, 714, 717, 720, 722, 725, 729, 732, 735, 738, 740,
+		743, 746, 749, 751, 754, 758, 762, 765, 769, 772, 775, 777, 780, 783, 786, 788,
+		791, 794, 797, 800, 803, 805, 808, 812, 815, 818, 821, 823, 826, 830, 833, 0,
+		3, 6, 8, 11, 15, 18, 22, 25, 28, 30, 33, 37, 40, 43, 46, 48,
+		51, 54, 56, 59, 62, 65, 67, 70, 74, 77, 80, 83, 85, 88, 92, 95,
+		99, 102, 105, 107, 110, 114, 118, 121, 125, 128, 131, 133, 136, 139, 141, 144,
+		147, 150, 152, 155, 159, 162, 166, 170, 173, 177, 182, 186, 191, 195, 199, 202,
+		206, 211, 215, 220, 224, 228, 231, 235, 239, 242, 246, 250, 254, 257, 261, 266,
+		270, 274, 278, 281, 285, 290, 294, 298, 302, 305, 309, 313, 317, 320, 324, 328,
+		331, 335, 339, 343, 346, 350, 355, 359, 363, 367, 370, 374, 379, 384, 388, 393,
+		397, 401, 404, 408, 413, 417, 421, 425, 429, 433, 436, 440, 444, 447, 450, 452,
+		455, 459, 462, 466, 469, 471, 474, 478, 481, 485, 488, 491, 493, 496, 499, 501,
+		504, 507, 510, 512, 515, 519, 522, 526, 530, 533, 537, 542, 546, 551, 555, 559,
+		562, 566, 571, 575, 580, 584, 587, 591, 595, 598, 602, 606, 610, 613, 617, 622,
+		626, 630, 634, 637, 641, 646, 650, 653, 656, 658, 661, 665, 669, 672, 676, 679,
+		682, 684, 687, 691, 695, 698, 702, 705, 708, 710, 713, 716, 718, 721, 724, 727,
+		729, 732, 736, 739, 742, 745, 747, 750, 754, 757, 760, 763, 765, 768, 772, 776,
+		779, 783, 786, 789, 791, 794, 798, 802, 805, 809, 812, 815, 817, 820, 823, 826,
+		828, 831, 834, 837, 839, 842, 846, 849, 852, 855, 857, 860, 864, 867, 871, 874,
+		0, 3, 5, 8, 12, 15, 19, 22, 25, 27, 30, 33, 36, 38, 41, 44,
+		47, 49, 52, 56, 59, 63, 67, 70, 75, 79, 84, 88, 92, 95, 99, 104,
+		109, 113, 118, 122, 126, 129, 133, 137, 141, 144, 148, 152, 156, 159, 163, 168,
+		172, 177, 181, 185, 189, 192, 196, 201, 205, 210, 214, 218, 221, 225, 229, 232,
+		236, 240, 244, 247, 251, 256, 260, 264, 268, 271, 275, 280, 285, 289, 294, 298,
+		302, 305, 309, 314, 319, 323, 328, 332, 336, 339, 343, 347, 350, 354, 359, 363,
+		368, 372, 376, 379, 383, 388, 393, 397, 401, 405, 408, 412, 416, 419, 423, 426,
+		430, 433, 436, 439, 441, 444, 448, 452, 455, 459, 462, 465, 467, 470, 473, 475,
+		478, 481, 484, 486, 489, 493, 496, 500, 503, 505, 508, 511, 513, 516, 519, 522,
+		524, 527, 531, 534, 538, 541, 544, 546, 549, 553, 556, 560, 563, 566, 568, 571,
+		574, 576, 579, 582, 585, 587, 590, 594, 597, 600, 603, 605, 608, 612, 615, 618,
+		621, 623, 626, 630, 634, 637, 641, 644, 647, 649, 652, 656, 659, 663, 666, 669,
+		671, 674, 677, 679, 682, 685, 688, 690, 693, 697, 700, 703, 706, 708, 711, 715,
+		718, 722, 726, 729, 733, 738, 743, 747, 752, 756, 760, 763, 767, 771, 774, 778,
+		782, 785, 789, 793, 796, 800, 805, 809, 813, 817, 820, 824, 829, 833, 837, 841,
+		844, 848, 853, 857, 862, 866, 870, 873, 877, 882, 886, 890, 894, 898, 901, 905,
+		908, 0, 4, 9, 13, 17, 21, 24, 28, 33, 37, 40, 43, 45, 48, 52,
+		55, 59, 62, 65, 67, 70, 74, 77, 81, 84, 87, 89, 92, 95, 97, 100,
+		103, 106, 108, 111, 115, 118, 121, 124, 126, 129, 133, 136, 140, 144, 147, 151,
+		156, 160, 165, 169, 173, 176, 180, 184, 188, 191, 195, 199, 202, 206, 210, 214,
+		217, 221, 225, 229, 232, 236, 241, 245, 249, 253, 256, 260, 265, 269, 274, 278,
+		282, 285, 289, 293, 297, 300, 304, 308, 312, 315, 319, 324, 328, 332, 336, 339,
+		343, 348, 352, 356, 360, 363, 367, 372, 377, 381, 386, 390, 394, 397, 401, 405,
+		409, 412, 416, 420, 423, 427, 432, 436, 440, 444, 447, 451, 456, 460, 464, 467,
+		472, 476, 480, 484, 487, 491, 495, 499, 502, 506, 510, 514, 517, 521, 526, 530,
+		534, 538, 541, 545, 550, 554, 558, 562, 565, 569, 573, 576, 580, 584, 588, 591,
+		595, 599, 603, 606, 610, 615, 619, 623, 627, 630, 634, 639, 643, 647, 651, 654,
+		658, 663, 667, 672, 676, 680, 683, 687, 691, 695, 698, 702, 706, 710, 713, 717,
+		722, 726, 730, 734, 737, 741, 746, 750, 753, 756, 758, 761, 765, 768, 772, 775,
+		778, 780, 783, 786, 788, 791, 794, 797, 799, 802, 806, 809, 812, 815, 817, 820,
+		824, 827, 830, 833, 835, 838, 842, 846, 849, 853, 857, 861, 864, 868, 871, 874,
+		876, 879, 882, 885, 887, 890, 894, 897, 900, 903, 905, 908, 912, 915, 918, 921,
+		923, 926, 0, 4, 8, 11, 15, 19, 23, 26, 30, 33, 36, 38, 41, 44,
+		47, 49, 52, 56, 59, 62, 65, 67, 70, 74, 77, 81, 85, 88, 92, 97,
+		102, 106, 111, 116, 120, 125, 129, 133, 136, 140, 144, 148, 151, 155, 160, 164,
+		168, 172, 175, 179, 183, 187, 190, 194, 199, 204, 208, 213, 218, 222, 226, 230,
+		233, 237, 241, 244, 248, 253, 257, 261, 265, 268, 272, 277, 281, 285, 289, 292,
+		296, 301, 306, 310, 315, 320, 324, 329, 333, 337, 340, 344, 348, 352, 355, 359,
+		362, 365, 367, 370, 374, 378, 381, 385, 389, 392, 395, 398, 400, 403, 406, 409,
+		411, 414, 418, 421, 424, 427, 429, 432, 436, 440, 443, 447, 451, 455, 458, 462,
+		465, 468, 470, 473, 476, 479, 481, 484, 488, 491, 494, 497, 499, 502, 506, 509,
+		512, 515, 518, 521, 523, 526, 530, 533, 536, 540, 543, 545, 549, 552, 556, 559,
+		561, 564, 568, 571, 573, 576, 579, 583, 586, 590, 594, 596, 598, 602, 605, 608,
+		611, 614, 617, 620, 623, 625, 627, 630, 633, 636, 640, 642, 644, 648, 651, 653,
+		657, 660, 664, 668, 671, 675, 678, 683, 686, 688, 691, 694, 697, 700, 703, 706,
+		709, 712, 715, 717, 719, 722, 724, 726, 729, 731, 733, 736, 738, 740, 743, 746,
+		749, 753, 755, 757, 759, 761, 764, 766, 769, 771, 773, 776, 778, 780, 783, 785,
+		788, 789, 791, 792, 794, 795, 796, 797, 799, 801, 803, 805, 807, 810, 812, 814,
+		817, 820, 820, 0, 4, 10, 13, 16, 20, 24, 28, 32, 37, 41, 45, 48,
+		51, 54, 57, 60, 63, 66, 69, 72, 76, 80, 84, 88, 92, 96, 100, 104,
+		108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 157, 162, 167, 172,
+		177, 181, 187, 190, 193, 196, 199, 202, 205, 208, 211, 214, 217, 220, 223, 227,
+		231, 235, 239, 243, 247, 251, 256, 261, 266, 271, 275, 279, 283, 287, 291, 295,
+		299, 303, 307, 311, 315, 319, 323, 327, 331, 336, 341, 346, 351, 356, 360, 363,
+		367, 371, 375, 381, 384, 389, 392, 396, 400, 405, 409, 414, 417, 421, 425, 429,
+		434, 440, 444, 448, 453, 457, 461, 465, 468, 472, 476, 481, 486, 491, 496, 501,
+		506, 511, 516, 519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 553, 557,
+		561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613, 617, 621,
+		625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 674, 679, 684, 689,
+		694, 699, 704, 709, 714, 719, 724, 729, 734, 738, 742, 746, 750, 752, 755, 758,
+		761, 764, 767, 770, 773, 776, 779, 782, 787, 791, 795, 798, 801, 804, 807, 810,
+		813, 816, 819, 822, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865, 869,
+		874, 876, 878, 880, 882, 884, 886, 888, 890, 892, 894, 896, 898, 900, 902, 905,
+		908, 911, 914, 917, 920, 923, 926, 929, 932, 935, 938, 941, 944, 947, 952, 956,
+		959, 963, 967, 972, 0, 5, 10, 15, 21, 27, 33, 37, 42, 47, 52, 57,
+		62, 65, 69, 73, 77, 83, 87, 92, 96, 100, 103, 107, 110, 113, 118, 124,
+		127, 132, 137, 140, 144, 149, 153, 157, 161, 165, 168, 171, 175, 179, 183, 187,
+		190, 195, 198, 203, 206, 209, 214, 219, 224, 228, 232, 235, 239, 242, 247, 250,
+		255, 259, 263, 267, 272, 278, 282, 285, 289, 293, 297, 301, 305, 309, 314, 318,
+		322, 326, 330, 333, 337, 340, 344, 348, 353, 356, 359, 363, 368, 372, 376, 380,
+		384, 389, 393, 397, 401, 404, 408, 411, 415, 419, 424, 429, 432, 436, 442, 446,
+		450, 453, 458, 461, 464, 469, 475, 480, 485, 491, 494, 499, 504, 507, 511, 515,
+		518, 524, 528, 532, 536, 539, 542, 545, 548, 552, 556, 561, 566, 569, 574, 577,
+		583, 587, 590, 594, 599, 602, 607, 612, 617, 623, 627, 631, 635, 639, 642, 646,
+		651, 655, 660, 664, 668, 672, 677, 680, 684, 689, 693, 696, 702, 706, 711, 715,
+		720, 725, 729, 733, 737, 742, 747, 751, 757, 761, 765, 771, 774, 778, 783, 787,
+		792, 795, 799, 802, 806, 811, 816, 820, 824, 828, 833, 837, 841, 846, 850, 856,
+		858, 862, 867, 871, 874, 877, 880, 883, 887, 891, 894, 899, 904, 906, 911, 914,
+		917, 920, 923, 926, 929, 932, 935, 938, 941, 944, 947, 950, 953, 956, 959, 962,
+		965, 968, 971, 974, 977, 980, 983, 986, 989, 992, 995, 998, 1001, 1004, 1007, 1010,
+		1013, 1016, 1019, 1022, 1025, 0, 3, 6, 10, 14, 18, 22, 26, 30, 34, 38,
+		42, 46, 50, 54, 58, 62, 66, 70, 74, 77, 81, 85, 89, 93, 97, 101,
+		105, 108, 112, 117, 121, 125, 129, 133, 136, 140, 144, 148, 153, 157, 161, 165,
+		169, 173, 177, 181, 185, 189, 193, 197, 201, 205, 209, 213, 217, 220, 223, 227,
+		231, 235, 240, 243, 247, 251, 255, 259, 263, 267, 271, 275, 279, 283, 287, 291,
+		295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351, 355,
+		359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 399, 402, 406, 410, 414, 418,
+		422, 426, 430, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 474, 478, 482,
+		486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 527, 531, 534, 538, 542, 546,
+		550, 554, 558, 562, 566, 570, 574, 578, 582, 586, 589, 593, 597, 601, 605, 609,
+		613, 617, 621, 624, 628, 632, 636, 640, 644, 648, 652, 657, 661, 666, 670, 674,
+		678, 682, 686, 690, 694, 698, 702, 706, 710, 714, 718, 722, 726, 730, 736, 740,
+		744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804,
+		808, 812, 816, 820, 824, 828, 832, 836, 840, 845, 849, 853, 857, 861, 865, 869,
+		873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 925, 929, 933,
+		937, 942, 946, 950, 954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998,
+		1002, 1006, 1010, 1014, 1018, 1022, 0, 4, 8, 12, 16, 20, 23, 27, 31, 35,
+		40, 44, 48, 52, 56, 60, 65, 69, 73, 78, 82, 86, 90, 95, 99, 103,
+		107, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168,
+		172, 176, 180, 184, 188, 191, 195, 199, 202, 206, 210, 214, 218, 222, 226, 230,
+		234, 238, 242, 246, 250, 254, 258, 262, 266, 270, 274, 278, 282, 286, 290, 294,
+		298, 302, 306, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357,
+		361, 364, 368, 372, 376, 380, 384, 388, 392, 396, 400, 403, 407, 411, 415, 419,
+		423, 427, 431, 435, 439, 443, 447, 451, 455, 459, 463, 467, 471, 475, 479, 483,
+		489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549,
+		553, 557, 561, 566, 570, 574, 578, 581, 585, 589, 593, 597, 601, 605, 609, 613,
+		617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 677,
+		681, 685, 689, 693, 697, 700, 704, 708, 711, 715, 719, 723, 726, 730, 734, 738,
+		742, 746, 750, 754, 758, 762, 766, 770, 774, 778, 782, 786, 790, 794, 798, 802,
+		806, 810, 814, 818, 822, 826, 830, 833, 837, 842, 846, 850, 854, 858, 862, 866,
+		870, 874, 878, 882, 886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930,
+		934, 938, 942, 946, 950, 954, 957, 961, 964, 968, 972, 976, 980, 984, 990, 994,
+		999, 1003, 1007, 1011, 1015, 1019, 1023, 0, 4, 8, 12, 16, 20, 25, 29, 33,
+		37, 41, 45, 49, 53, 57, 61, 65, 69, 73, 77, 81, 85, 89, 93, 97,
+		101, 105, 109, 113, 117, 121, 125, 129, 133, 137, 141, 145, 149, 153, 157, 161,
+		165, 169, 173, 177, 182, 186, 190, 194, 198, 202, 206, 210, 214, 219, 222, 226,
+		229, 233, 236, 240, 244, 248, 252, 256, 260, 264, 267, 271, 275, 279, 283, 287,
+		291, 295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351,
+		355, 359, 363, 367, 371, 375, 379, 383, 387, 391, 394, 398, 401, 405, 409, 412,
+		416, 419, 423, 427, 430, 434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474,
+		478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 526, 530, 534, 538,
+		542, 546, 550, 554, 558, 562, 565, 568, 572, 576, 581, 585, 588, 592, 596, 600,
+		604, 608, 612, 616, 620, 624, 628, 632, 636, 640, 644, 649, 653, 659, 663, 668,
+		672, 678, 682, 686, 690, 695, 699, 703, 707, 711, 714, 718, 722, 726, 731, 735,
+		739, 743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 796, 800,
+		804, 808, 812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 861, 864,
+		868, 872, 876, 880, 884, 889, 893, 896, 900, 903, 907, 911, 915, 919, 923, 927,
+		931, 934, 938, 942, 945, 949, 953, 956, 960, 964, 968, 972, 976, 981, 986, 991,
+		995, 999, 1003, 1006, 1010, 1014, 1018, 1022, 0, 4, 8, 12, 16, 20, 24, 28,
+		32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 89, 95,
+		99, 103, 107, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160,
+		164, 168, 172, 177, 181, 185, 191, 195, 198, 202, 206, 211, 215, 222, 226, 230,
+		234, 238, 242, 246, 250, 254, 257, 261, 265, 269, 273, 277, 280, 284, 288, 292,
+		296, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356,
+		360, 364, 368, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421,
+		425, 429, 433, 436, 439, 443, 447, 451, 455, 459, 463, 466, 469, 473, 478, 482,
+		486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 526, 530, 534, 538, 542, 546,
+		550, 554, 558, 562, 566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606, 610,
+		614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674,
+		678, 682, 686, 690, 694, 698, 702, 706, 710, 713, 717, 721, 724, 728, 732, 736,
+		739, 742, 746, 750, 754, 758, 762, 766, 770, 773, 777, 781, 785, 789, 793, 797,
+		802, 806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 851, 855, 860, 865,
+		869, 873, 877, 880, 883, 887, 891, 895, 899, 903, 908, 912, 916, 922, 926, 930,
+		936, 943, 946, 950, 956, 960, 965, 969, 973, 978, 982, 986, 990, 994, 998, 1002,
+		1006, 1010, 1014, 1018, 1022, 1026, 1030, 1034, 1038, 0, 3, 8, 12, 16, 20, 24,
+		28, 32, 37, 41, 45, 50, 53, 56, 60, 64, 68, 71, 75, 79, 83, 87,
+		91, 95, 99, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151,
+		155, 160, 164, 169, 173, 177, 181, 185, 189, 193, 197, 201, 205, 209, 213, 217,
+		221, 225, 229, 233, 237, 242, 246, 250, 253, 258, 262, 266, 270, 273, 277, 283,
+		288, 292, 296, 300, 304, 308, 312, 316, 320, 324, 328, 333, 337, 341, 345, 349,
+		353, 357, 362, 368, 372, 376, 380, 384, 389, 394, 398, 403, 407, 411, 415, 419,
+		423, 427, 431, 435, 440, 444, 448, 452, 456, 459, 463, 468, 473, 477, 482, 486,
+		490, 494, 498, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 544, 548,
+		552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612,
+		616, 620, 624, 628, 632, 636, 641, 646, 650, 654, 658, 662, 666, 670, 674, 678,
+		682, 686, 690, 694, 698, 702, 706, 710, 714, 718, 723, 727, 731, 735, 739, 744,
+		748, 752, 756, 760, 764, 768, 772, 776, 780, 783, 787, 791, 795, 799, 803, 807,
+		811, 815, 819, 823, 827, 830, 835, 839, 843, 847, 851, 855, 859, 863, 867, 871,
+		875, 878, 882, 888, 892, 896, 902, 906, 909, 913, 917, 921, 925, 929, 933, 937,
+		941, 945, 949, 953, 956, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997, 1001,
+		1005, 1008, 1014, 1018, 1022, 1026, 1030, 1036, 1040, 1044, 0, 4, 8, 13, 16, 20,
+		24, 28, 32, 36, 41, 45, 48, 52, 55, 59, 63, 67, 71, 75, 79, 83,
+		87, 91, 95, 99, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 143, 147,
+		151, 155, 159, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 205, 209, 213,
+		217, 220, 224, 228, 232, 237, 243, 247, 251, 255, 259, 263, 268, 272, 276, 279,
+		283, 287, 291, 295, 299, 304, 308, 313, 317, 321, 325, 329, 333, 337, 341, 345,
+		351, 356, 360, 364, 368, 372, 376, 380, 384, 388, 393, 399, 403, 407, 411, 415,
+		419, 422, 427, 431, 435, 439, 443, 447, 452, 456, 460, 464, 468, 472, 475, 479,
+		483, 487, 491, 495, 499, 503, 507, 511, 515, 519, 524, 528, 532, 537, 541, 546,
+		550, 555, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 606, 610,
+		614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674,
+		680, 684, 688, 692, 696, 700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740,
+		744, 748, 754, 758, 762, 766, 770, 774, 778, 782, 786, 790, 794, 797, 801, 805,
+		809, 813, 817, 821, 825, 830, 835, 840, 844, 848, 851, 855, 859, 863, 867, 871,
+		875, 879, 883, 888, 892, 896, 900, 904, 909, 913, 917, 921, 925, 929, 933, 937,
+		942, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 1000, 1004,
+		1009, 1014, 1018, 1022, 1026, 1029, 1034, 1038, 1042, 1049, 1054, 0, 4, 7, 11, 15,
+		19, 23, 28, 32, 36, 40, 45, 50, 56, 60, 64, 68, 72, 76, 80, 84,
+		88, 91, 95, 99, 103, 107, 111, 115, 119, 123, 126, 129, 133, 137, 140, 145,
+		149, 153, 157, 161, 165, 169, 173, 177, 181, 185, 189, 193, 197, 202, 206, 210,
+		214, 218, 222, 226, 230, 234, 238, 241, 245, 249, 253, 257, 261, 267, 272, 276,
+		280, 284, 287, 291, 295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339,
+		343, 347, 351, 355, 359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 399, 403,
+		407, 411, 415, 419, 423, 427, 431, 435, 439, 443, 447, 451, 455, 459, 463, 467,
+		471, 475, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 526, 530,
+		533, 537, 542, 546, 550, 553, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595,
+		598, 602, 606, 610, 614, 618, 622, 626, 630, 634, 638, 642, 647, 651, 655, 659,
+		663, 668, 672, 675, 679, 683, 687, 691, 695, 698, 701, 705, 709, 713, 717, 721,
+		726, 729, 733, 737, 741, 746, 750, 755, 759, 762, 766, 770, 776, 780, 784, 787,
+		791, 795, 798, 802, 806, 810, 813, 816, 820, 824, 828, 832, 836, 841, 845, 849,
+		853, 858, 864, 868, 871, 874, 879, 883, 887, 891, 895, 899, 903, 906, 910, 914,
+		918, 923, 926, 930, 934, 938, 943, 947, 951, 955, 960, 964, 968, 972, 976, 979,
+		983, 987, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 1028, 0, 4, 7, 11,
+		15, 18, 23, 27, 31, 34, 38, 42, 47, 51, 55, 60, 64, 69, 73, 77,
+		81, 85, 89, 93, 96, 100, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139,
+		143, 147, 151, 155, 159, 163, 167, 171, 175, 179, 183, 187, 191, 195, 198, 201,
+		206, 210, 214, 218, 222, 226, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265,
+		269, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 320, 325, 329,
+		333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 377, 382, 386, 390, 394,
+		398, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460,
+		465, 469, 473, 477, 481, 485, 489, 493, 497, 501, 506, 510, 514, 517, 521, 525,
+		529, 532, 536, 539, 543, 546, 549, 553, 557, 561, 565, 569, 573, 577, 581, 585,
+		589, 593, 598, 603, 607, 611, 614, 618, 622, 626, 630, 634, 638, 642, 646, 650,
+		654, 657, 661, 665, 668, 672, 676, 680, 684, 688, 692, 699, 703, 708, 712, 716,
+		720, 724, 727, 732, 736, 740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 781,
+		785, 789, 794, 798, 802, 806, 811, 814, 818, 821, 827, 831, 835, 839, 843, 847,
+		851, 855, 859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 911,
+		914, 918, 922, 925, 930, 935, 938, 941, 945, 949, 953, 957, 961, 964, 968, 972,
+		976, 980, 984, 987, 991, 995, 999, 1002, 1005, 1010, 1014, 1018, 1022, 0, 4, 9,
+		13, 18, 23, 27, 31, 35, 39, 43, 47, 51, 55, 59, 63, 66, 70, 74,
+		78, 82, 85, 89, 93, 97, 101, 105, 109, 113, 118, 121, 125, 129, 132, 136,
+		141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 185, 189, 193, 197, 201,
+		205, 209, 213, 216, 219, 224, 228, 233, 237, 241, 245, 249, 253, 257, 260, 264,
+		267, 271, 274, 278, 282, 286, 290, 294, 299, 303, 307, 311, 315, 319, 323, 328,
+		332, 336, 339, 343, 347, 351, 355, 359, 363, 367, 370, 373, 376, 380, 384, 388,
+		392, 397, 400, 404, 408, 412, 416, 420, 424, 428, 432, 437, 442, 446, 450, 455,
+		459, 463, 467, 471, 476, 480, 483, 487, 491, 496, 500, 504, 508, 512, 516, 520,
+		524, 529, 533, 537, 541, 545, 549, 553, 557, 561, 565, 570, 574, 578, 582, 586,
+		590, 593, 596, 600, 604, 608, 612, 616, 621, 626, 630, 634, 638, 642, 645, 649,
+		653, 658, 662, 666, 670, 674, 678, 682, 686, 690, 694, 698, 702, 706, 710, 714,
+		718, 721, 726, 730, 734, 738, 742, 745, 749, 753, 757, 761, 765, 769, 773, 777,
+		781, 785, 790, 794, 798, 803, 807, 811, 814, 818, 822, 826, 830, 834, 838, 842,
+		846, 850, 854, 858, 863, 867, 872, 876, 880, 884, 888, 892, 896, 900, 904, 908,
+		912, 916, 920, 924, 928, 932, 936, 940, 943, 948, 952, 956, 960, 964, 967, 971,
+		976, 980, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1023, 1027, 0, 4,
+		8, 12, 16, 19, 23, 27, 31, 35, 39, 45, 49, 53, 58, 62, 66, 70,
+		74, 78, 82, 86, 89, 93, 96, 99, 103, 107, 111, 115, 119, 123, 127, 131,
+		135, 139, 143, 147, 151, 156, 160, 164, 168, 172, 175, 179, 183, 187, 191, 196,
+		200, 204, 208, 211, 215, 219, 223, 227, 232, 237, 241, 246, 250, 254, 258, 262,
+		266, 270, 274, 278, 282, 286, 289, 293, 297, 301, 305, 309, 311, 315, 319, 324,
+		329, 333, 337, 341, 343, 346, 350, 355, 360, 364, 368, 370, 373, 378, 382, 386,
+		390, 393, 397, 401, 405, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 447,
+		451, 455, 459, 463, 467, 471, 475, 479, 483, 487, 491, 495, 499, 503, 507, 511,
+		515, 519, 522, 526, 530, 534, 538, 541, 545, 549, 554, 557, 561, 565, 569, 572,
+		576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616, 619, 623, 627, 631, 635,
+		639, 643, 647, 651, 655, 659, 663, 668, 672, 675, 678, 683, 687, 691, 695, 699,
+		703, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744, 748, 752, 756, 760, 764,
+		768, 772, 776, 780, 783, 787, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826,
+		830, 833, 837, 841, 844, 848, 852, 856, 861, 865, 868, 872, 876, 880, 885, 889,
+		893, 897, 901, 905, 909, 913, 917, 921, 925, 930, 934, 938, 942, 946, 950, 953,
+		957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1014, 0,
+		4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 57, 63, 67,
+		71, 75, 79, 83, 87, 90, 94, 98, 101, 105, 108, 112, 116, 120, 124, 128,
+		131, 135, 139, 143, 147, 152, 156, 160, 164, 168, 172, 176, 180, 183, 187, 191,
+		195, 199, 203, 207, 211, 216, 220, 224, 227, 231, 235, 238, 242, 246, 249, 252,
+		256, 260, 264, 268, 272, 276, 279, 283, 287, 291, 295, 299, 303, 307, 311, 316,
+		320, 324, 328, 332, 336, 340, 344, 347, 351, 355, 359, 363, 367, 371, 375, 379,
+		384, 388, 392, 396, 400, 404, 408, 411, 415, 419, 423, 426, 430, 434, 438, 442,
+		446, 450, 454, 458, 462, 465, 469, 474, 478, 482, 485, 489, 493, 497, 501, 504,
+		508, 512, 517, 521, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560, 564, 568,
+		572, 576, 579, 583, 587, 591, 595, 598, 602, 605, 609, 613, 617, 621, 625, 628,
+		632, 636, 640, 644, 648, 652, 656, 659, 663, 667, 671, 675, 679, 683, 686, 690,
+		694, 698, 702, 706, 710, 714, 718, 723, 727, 731, 734, 737, 741, 745, 749, 753,
+		757, 761, 765, 769, 774, 778, 782, 786, 790, 795, 799, 803, 806, 810, 814, 818,
+		822, 826, 830, 833, 837, 841, 845, 849, 854, 858, 862, 866, 869, 875, 879, 883,
+		887, 891, 895, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 941, 945,
+		949, 953, 957, 961, 965, 969, 973, 976, 979, 984, 989, 993, 997, 1001, 1005, 1009,
+		0, 4, 8, 12, 16, 20, 24, 29, 33, 36, 40, 44, 49, 53, 57, 61,
+		65, 69, 73, 77, 82, 86, 90, 94, 98, 102, 105, 109, 112, 116, 119, 123,
+		127, 131, 135, 139, 144, 147, 151, 155, 159, 163, 167, 172, 176, 179, 183, 187,
+		191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231, 235, 239, 243, 247, 252,
+		256, 260, 264, 268, 272, 276, 280, 284, 288, 291, 296, 300, 304, 308, 312, 316,
+		320, 324, 328, 332, 336, 340, 344, 348, 353, 357, 361, 365, 369, 373, 377, 381,
+		385, 389, 393, 397, 401, 405, 409, 413, 417, 421, 425, 429, 433, 437, 441, 445,
+		449, 452, 456, 460, 464, 469, 473, 477, 481, 485, 490, 494, 498, 502, 506, 510,
+		514, 518, 522, 526, 529, 533, 537, 541, 545, 549, 553, 556, 560, 564, 568, 572,
+		576, 580, 583, 587, 591, 595, 599, 603, 607, 611, 614, 618, 622, 625, 629, 633,
+		637, 641, 645, 649, 653, 657, 661, 665, 669, 674, 678, 682, 686, 690, 693, 697,
+		700, 704, 708, 712, 717, 722, 727, 731, 735, 739, 743, 747, 751, 755, 760, 762,
+		765, 771, 775, 778, 781, 785, 789, 793, 797, 801, 804, 808, 811, 815, 819, 823,
+		827, 831, 835, 839, 843, 846, 850, 854, 857, 861, 865, 869, 873, 877, 881, 885,
+		890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 937, 941, 944, 948,
+		953, 957, 963, 967, 970, 975, 979, 983, 988, 992, 997, 1001, 1005, 1009, 1013, 1017,
+		1021, 0, 4, 8, 12, 16, 19, 23, 27, 31, 36, 41, 45, 49, 53, 57,
+		61, 65, 68, 71, 75, 78, 82, 86, 90, 94, 98, 102, 106, 109, 111, 115,
+		119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 163, 167, 171, 175, 179,
+		183, 187, 191, 195, 199, 203, 207, 211, 215, 218, 222, 226, 230, 234, 237, 242,
+		245, 249, 252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304,
+		308, 312, 315, 319, 323, 327, 332, 336, 340, 344, 347, 352, 357, 362, 366, 369,
+		373, 377, 382, 385, 389, 393, 397, 399, 403, 409, 412, 416, 420, 422, 426, 430,
+		434, 438, 442, 446, 449, 453, 457, 461, 465, 469, 473, 477, 481, 485, 489, 493,
+		497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549, 553, 557,
+		560, 564, 568, 573, 577, 582, 585, 589, 592, 596, 600, 603, 606, 610, 616, 620,
+		624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668, 672, 676, 680, 684,
+		689, 693, 697, 700, 704, 708, 712, 716, 720, 724, 728, 731, 734, 737, 741, 744,
+		749, 754, 759, 763, 767, 772, 776, 780, 784, 787, 791, 795, 798, 803, 807, 811,
+		814, 818, 823, 827, 831, 835, 839, 843, 847, 851, 855, 859, 863, 867, 871, 875,
+		879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931, 936, 939,
+		943, 947, 951, 955, 959, 962, 966, 970, 973, 977, 981, 988, 992, 996, 1001, 1007,
+		1011, 1015, 0, 4, 8, 11, 15, 19, 23, 27, 31, 35, 39, 43, 47, 51,
+		55, 59, 63, 67, 71, 75, 79, 83, 87, 91, 95, 99, 103, 107, 111, 115,
+		119, 123, 127, 131, 136, 142, 146, 150, 154, 158, 162, 167, 170, 174, 177, 181,
+		184, 188, 192, 195, 198, 201, 206, 210, 214, 218, 222, 225, 228, 231, 235, 239,
+		243, 246, 250, 254, 258, 262, 266, 270, 274, 278, 282, 286, 290, 294, 298, 302,
+		306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 346, 350, 354, 358, 362, 366,
+		370, 374, 378, 383, 387, 391, 394, 398, 402, 405, 410, 413, 416, 420, 424, 427,
+		430, 434, 438, 442, 446, 450, 453, 457, 461, 465, 470, 474, 479, 482, 487, 492,
+		496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556,
+		560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616, 620,
+		624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 665, 668, 672, 676, 679, 684,
+		688, 692, 696, 701, 705, 708, 712, 716, 720, 724, 727, 731, 734, 738, 741, 745,
+		749, 752, 756, 760, 765, 769, 774, 777, 781, 785, 789, 792, 796, 801, 805, 808,
+		811, 816, 819, 824, 827, 832, 836, 840, 844, 849, 853, 857, 860, 864, 868, 872,
+		876, 879, 883, 887, 891, 896, 900, 904, 908, 912, 916, 920, 924, 928, 932, 936,
+		940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 982, 986, 989, 994, 998,
+		1002, 1006, 1009, 0, 4, 8, 11, 15, 20, 24, 28, 32, 36, 40, 44, 48,
+		52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112,
+		116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176,
+		180, 184, 190, 194, 198, 202, 205, 208, 212, 216, 220, 224, 229, 232, 235, 239,
+		243, 247, 251, 255, 259, 262, 266, 270, 274, 279, 283, 287, 291, 295, 299, 302,
+		307, 312, 317, 321, 326, 330, 334, 337, 342, 346, 351, 355, 358, 362, 366, 370,
+		374, 379, 383, 388, 392, 396, 400, 404, 408, 411, 414, 418, 422, 426, 430, 434,
+		438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 478, 482, 486, 490, 494, 498,
+		502, 506, 510, 514, 518, 522, 526, 530, 534, 538, 542, 546, 550, 554, 558, 562,
+		566, 570, 574, 578, 583, 587, 590, 594, 598, 602, 605, 609, 614, 618, 622, 626,
+		630, 633, 637, 640, 644, 648, 652, 655, 659, 663, 667, 671, 675, 679, 683, 687,
+		691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751,
+		755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 807, 811, 815,
+		819, 824, 828, 831, 835, 839, 843, 847, 851, 855, 859, 862, 866, 869, 872, 876,
+		881, 886, 891, 895, 900, 904, 908, 912, 916, 921, 926, 929, 933, 937, 941, 945,
+		948, 952, 956, 960, 964, 969, 973, 977, 982, 986, 990, 994, 998, 1002, 1006, 1010,
+		1014, 1018, 1022, 1026, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44,
+		48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 99, 103, 107,
+		111, 115, 118, 120, 124, 128, 133, 139, 143, 148, 152, 156, 160, 164, 168, 171,
+		175, 181, 185, 189, 193, 197, 201, 205, 209, 213, 217, 221, 225, 229, 233, 237,
+		240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300,
+		304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364,
+		368, 371, 375, 379, 383, 387, 391, 395, 399, 403, 407, 411, 415, 419, 422, 425,
+		429, 432, 436, 440, 444, 447, 452, 456, 460, 464, 467, 473, 476, 480, 484, 488,
+		492, 495, 499, 503, 506, 510, 514, 518, 522, 526, 530, 533, 537, 541, 546, 550,
+		554, 557, 561, 565, 569, 574, 578, 582, 586, 590, 594, 598, 602, 606, 610, 614,
+		618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 659, 663, 667, 672, 675, 679,
+		682, 686, 690, 696, 700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744,
+		748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 803, 807,
+		811, 815, 819, 823, 828, 832, 836, 840, 843, 847, 852, 856, 859, 863, 867, 871,
+		874, 878, 882, 887, 890, 893, 898, 903, 906, 910, 914, 918, 922, 926, 930, 934,
+		938, 942, 946, 950, 954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998,
+		1002, 1006, 1010, 1014, 1018, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40,
+		44, 48, 52, 56, 60, 64, 68, 73, 77, 81, 84, 88, 91, 95, 100, 103,
+		107, 111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 163, 167,
+		171, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231,
+		235, 239, 243, 246, 248, 251, 255, 258, 262, 266, 270, 274, 278, 283, 287, 291,
+		296, 300, 303, 306, 309, 313, 317, 321, 325, 329, 334, 337, 341, 345, 349, 353,
+		357, 361, 365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 410, 414, 418,
+		421, 425, 429, 433, 436, 440, 443, 446, 450, 455, 460, 464, 468, 472, 476, 480,
+		484, 488, 492, 496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544,
+		547, 551, 555, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 607,
+		612, 616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668, 672,
+		676, 681, 685, 689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737,
+		741, 745, 749, 753, 757, 760, 764, 768, 772, 775, 779, 784, 789, 793, 797, 801,
+		805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 852, 856, 860, 864,
+		868, 872, 876, 880, 884, 888, 892, 897, 900, 904, 908, 912, 916, 920, 924, 928,
+		932, 936, 940, 944, 948, 952, 956, 960, 964, 969, 973, 977, 981, 984, 987, 991,
+		995, 999, 1003, 1007, 1011, 1015, 0, 4, 8, 12, 16, 20, 24, 28, 33, 37,
+		41, 45, 49, 53, 57, 61, 65, 69, 74, 78, 83, 88, 92, 97, 101, 105,
+		109, 113, 117, 121, 125, 129, 132, 136, 140, 144, 147, 152, 156, 160, 164, 168,
+		172, 176, 180, 184, 188, 192, 196, 200, 203, 206, 209, 213, 216, 220, 223, 227,
+		232, 236, 239, 243, 247, 251, 255, 259, 263, 266, 271, 275, 277, 282, 287, 291,
+		295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 336, 340, 343, 348, 352, 355,
+		359, 363, 367, 371, 375, 379, 383, 387, 389, 393, 397, 401, 405, 409, 413, 417,
+		421, 425, 429, 433, 438, 442, 446, 450, 455, 459, 463, 467, 471, 476, 480, 484,
+		489, 492, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549,
+		553, 557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 602, 606, 610, 614,
+		618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674, 678,
+		682, 689, 693, 697, 701, 705, 708, 712, 716, 720, 724, 728, 732, 736, 740, 743,
+		747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 808,
+		812, 816, 820, 824, 828, 832, 836, 840, 845, 848, 852, 856, 860, 865, 869, 873,
+		877, 881, 885, 889, 894, 897, 900, 904, 908, 912, 916, 921, 925, 929, 933, 937,
+		940, 944, 948, 953, 957, 962, 966, 972, 976, 980, 985, 989, 994, 997, 1001, 1006,
+		1010, 1013, 1017, 1020, 1024, 1028, 1032, 0, 4, 8, 12, 16, 20, 24, 27, 31,
+		35, 40, 44, 48, 52, 57, 60, 64, 67, 71, 76, 81, 85, 89, 93, 96,
+		100, 104, 108, 112, 116, 120, 123, 127, 133, 137, 141, 146, 150, 154, 158, 162,
+		165, 169, 173, 177, 181, 185, 189, 194, 198, 202, 206, 210, 215, 219, 223, 227,
+		231, 235, 239, 243, 248, 252, 256, 261, 265, 269, 273, 277, 281, 284, 287, 291,
+		295, 299, 303, 307, 311, 315, 318, 322, 327, 330, 334, 337, 341, 345, 349, 352,
+		356, 360, 366, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417,
+		421, 425, 429, 433, 437, 440, 444, 448, 452, 456, 460, 464, 467, 471, 475, 479,
+		483, 487, 492, 496, 500, 504, 508, 512, 516, 519, 523, 527, 531, 535, 539, 543,
+		547, 550, 554, 558, 562, 566, 570, 574, 578, 581, 585, 589, 593, 597, 601, 605,
+		609, 613, 617, 620, 624, 627, 632, 635, 639, 644, 648, 652, 656, 660, 664, 668,
+		672, 676, 680, 685, 689, 692, 696, 700, 704, 708, 712, 716, 720, 724, 727, 731,
+		736, 740, 744, 748, 754, 758, 762, 766, 770, 774, 778, 782, 786, 790, 794, 798,
+		802, 806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850, 854, 858, 862,
+		866, 870, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 925,
+		929, 932, 936, 940, 943, 947, 951, 954, 958, 962, 966, 970, 974, 978, 982, 986,
+		990, 994, 998, 1002, 1006, 1010, 1014, 1018, 0, 4, 8, 12, 16, 20, 24, 28,
+		32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 85, 89, 93,
+		97, 101, 105, 109, 113, 117, 121, 125, 129, 133, 137, 141, 145, 149, 153, 157,
+		161, 165, 168, 173, 177, 181, 185, 189, 193, 197, 201, 205, 209, 213, 217, 221,
+		225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 277, 281, 285,
+		289, 293, 297, 301, 305, 310, 314, 318, 322, 325, 329, 333, 337, 341, 345, 349,
+		353, 357, 361, 365, 369, 373, 377, 381, 385, 389, 392, 396, 400, 404, 409, 414,
+		418, 421, 425, 428, 431, 435, 439, 444, 448, 452, 456, 459, 463, 467, 471, 475,
+		480, 484, 488, 492, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541,
+		545, 549, 552, 556, 560, 564, 568, 572, 576, 580, 583, 587, 591, 594, 598, 602,
+		606, 611, 615, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668,
+		672, 676, 680, 684, 688, 693, 697, 700, 704, 708, 712, 716, 719, 723, 727, 731,
+		735, 739, 743, 747, 752, 756, 760, 765, 768, 772, 776, 780, 784, 788, 792, 796,
+		800, 804, 808, 812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860,
+		864, 868, 872, 876, 879, 883, 887, 893, 897, 901, 905, 909, 913, 917, 921, 925,
+		929, 933, 937, 940, 944, 948, 953, 958, 962, 966, 970, 974, 978, 982, 986, 990,
+		994, 998, 1002, 1006, 1010, 1013, 1017, 1021, 1025, 0, 4, 8, 12, 16, 20, 24,
+		28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 81, 85, 89,
+		94, 98, 102, 106, 110, 114, 118, 121, 125, 129, 133, 137, 141, 145, 149, 152,
+		156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216,
+		220, 224, 228, 232, 236, 240, 244, 248, 252, 255, 258, 262, 266, 270, 276, 280,
+		286, 290, 294, 298, 302, 306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 346,
+		350, 354, 358, 362, 365, 369, 373, 377, 381, 384, 388, 392, 396, 399, 403, 407,
+		411, 415, 419, 423, 427, 431, 435, 439, 443, 448, 452, 456, 460, 464, 468, 472,
+		478, 482, 486, 490, 494, 499, 503, 510, 513, 517, 521, 524, 528, 531, 535, 539,
+		543, 547, 551, 555, 558, 562, 567, 570, 574, 578, 582, 586, 590, 595, 599, 603,
+		607, 611, 615, 619, 623, 627, 631, 635, 639, 643, 647, 651, 655, 659, 663, 667,
+		671, 676, 680, 684, 688, 692, 696, 700, 704, 709, 713, 716, 720, 725, 729, 733,
+		737, 741, 745, 749, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797,
+		801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861,
+		866, 870, 874, 878, 882, 886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 926,
+		931, 935, 939, 943, 947, 951, 955, 958, 962, 966, 970, 974, 978, 982, 986, 990,
+		994, 998, 1002, 1006, 1010, 1014, 1018, 1022, 1026, 1030, 0, 4, 8, 12, 16, 20,
+		24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84,
+		89, 93, 97, 101, 105, 109, 114, 118, 122, 127, 131, 135, 139, 143, 147, 151,
+		155, 159, 163, 167, 171, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 214,
+		218, 222, 226, 230, 234, 238, 242, 246, 250, 254, 258, 262, 267, 271, 275, 279,
+		283, 287, 291, 295, 299, 303, 307, 311, 315, 319, 324, 329, 333, 337, 341, 345,
+		349, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388, 392, 396, 401, 405, 409,
+		413, 417, 422, 426, 430, 435, 439, 443, 447, 452, 456, 461, 465, 469, 473, 477,
+		481, 485, 489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541,
+		545, 549, 553, 557, 561, 565, 569, 575, 579, 584, 588, 592, 596, 600, 604, 608,
+		612, 616, 620, 624, 628, 632, 637, 641, 645, 650, 654, 658, 662, 667, 671, 676,
+		680, 684, 688, 692, 696, 701, 705, 710, 714, 718, 721, 725, 729, 732, 736, 740,
+		744, 748, 752, 756, 760, 764, 768, 772, 776, 779, 783, 787, 791, 795, 799, 803,
+		807, 811, 815, 818, 822, 826, 830, 834, 839, 843, 848, 852, 856, 860, 864, 868,
+		872, 876, 881, 885, 889, 893, 897, 901, 905, 908, 912, 916, 920, 924, 928, 932,
+		937, 941, 945, 949, 953, 957, 961, 965, 969, 973, 977, 981, 985, 989, 992, 996,
+		999, 1003, 1006, 1010, 1014, 1018, 1022, 1026, 1030, 1034, 1038, 0, 4, 8, 12, 16,
+		20, 24, 28, 32, 36, 40, 44, 48, 52, 57, 61, 66, 69, 73, 77, 81,
+		85, 89, 93, 97, 101, 105, 109, 112, 116, 120, 124, 128, 132, 135, 139, 143,
+		147, 151, 155, 159, 163, 167, 172, 176, 180, 184, 188, 192, 197, 201, 205, 209,
+		213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273,
+		277, 282, 286, 290, 294, 298, 302, 305, 309, 313, 318, 322, 327, 331, 336, 340,
+		344, 348, 352, 356, 360, 364, 368, 371, 375, 379, 383, 387, 391, 395, 399, 403,
+		407, 411, 415, 419, 423, 427, 431, 435, 439, 443, 447, 451, 455, 459, 463, 467,
+		471, 475, 479, 483, 487, 491, 495, 499, 503, 507, 511, 515, 519, 522, 526, 530,
+		534, 539, 543, 547, 551, 555, 560, 564, 568, 572, 576, 580, 584, 589, 593, 597,
+		602, 606, 610, 614, 618, 621, 625, 630, 635, 639, 643, 646, 650, 654, 658, 662,
+		666, 670, 674, 678, 682, 686, 690, 694, 698, 701, 705, 709, 713, 717, 721, 725,
+		729, 733, 737, 740, 744, 750, 754, 757, 761, 765, 769, 773, 777, 781, 785, 789,
+		793, 797, 801, 805, 809, 813, 817, 821, 824, 828, 832, 836, 840, 844, 848, 852,
+		856, 860, 864, 867, 870, 874, 878, 882, 885, 889, 893, 897, 901, 904, 908, 912,
+		916, 920, 924, 928, 932, 936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976,
+		980, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1015, 1019, 1023, 0, 4, 8, 11,
+		14, 18, 22, 26, 30, 34, 38, 42, 48, 52, 56, 60, 64, 68, 72, 76,
+		80, 84, 88, 92, 96, 100, 104, 107, 111, 115, 119, 123, 127, 131, 135, 139,
+		143, 147, 151, 155, 158, 162, 166, 170, 174, 177, 181, 185, 189, 193, 196, 200,
+		204, 208, 211, 215, 219, 223, 227, 231, 235, 239, 243, 247, 251, 255, 259, 263,
+		267, 271, 275, 279, 283, 287, 290, 294, 299, 303, 307, 311, 315, 319, 323, 328,
+		332, 336, 340, 344, 348, 352, 356, 362, 366, 369, 374, 378, 383, 386, 390, 394,
+		398, 402, 406, 410, 413, 417, 421, 425, 430, 434, 438, 442, 446, 450, 454, 458,
+		462, 466, 470, 474, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522,
+		526, 530, 534, 538, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578, 582, 586,
+		590, 594, 598, 602, 606, 610, 614, 619, 623, 626, 630, 636, 640, 643, 647, 650,
+		655, 659, 663, 669, 674, 678, 682, 685, 689, 693, 696, 700, 703, 707, 711, 715,
+		719, 723, 727, 731, 735, 739, 743, 747, 751, 755, 759, 763, 767, 771, 775, 779,
+		783, 787, 791, 795, 799, 803, 807, 811, 815, 819, 822, 826, 830, 834, 838, 842,
+		845, 849, 853, 857, 861, 865, 869, 873, 877, 881, 885, 889, 894, 899, 903, 907,
+		912, 917, 920, 924, 928, 933, 937, 941, 946, 950, 955, 959, 962, 966, 970, 974,
+		978, 982, 986, 990, 994, 998, 1002, 1006, 1010, 1014, 1018, 1022, 1026, 0, 4, 8,
+		12, 16, 20, 24, 28, 32, 36, 40, 45, 49, 53, 57, 61, 66, 70, 74,
+		79, 83, 87, 91, 95, 99, 104, 109, 112, 116, 119, 123, 126, 130, 134, 138,
+		142, 146, 150, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204,
+		208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 261, 266, 270,
+		275, 279, 285, 289, 293, 297, 302, 305, 310, 314, 318, 322, 325, 330, 333, 338,
+		341, 345, 349, 353, 357, 362, 366, 370, 374, 378, 382, 386, 390, 394, 398, 402,
+		406, 410, 414, 417, 421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465,
+		469, 473, 477, 481, 485, 489, 493, 497, 502, 506, 510, 514, 518, 522, 528, 532,
+		536, 540, 544, 548, 552, 556, 561, 565, 570, 574, 578, 582, 586, 590, 594, 598,
+		601, 605, 609, 613, 616, 620, 624, 628, 632, 636, 639, 643, 647, 651, 655, 659,
+		663, 667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 724,
+		728, 732, 736, 740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 787,
+		791, 795, 799, 803, 807, 811, 815, 819, 823, 828, 832, 836, 840, 844, 848, 852,
+		856, 860, 864, 868, 872, 876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916,
+		920, 924, 928, 932, 936, 940, 944, 948, 952, 956, 961, 965, 969, 973, 977, 981,
+		985, 989, 993, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025, 1029, 1034, 1038, 0, 4,
+		8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 51, 55, 59, 63, 67,
+		71, 75, 79, 83, 87, 91, 95, 99, 102, 106, 112, 116, 120, 124, 128, 132,
+		136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196,
+		200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 258, 262,
+		265, 269, 273, 277, 281, 285, 289, 294, 298, 302, 306, 310, 315, 318, 322, 326,
+		330, 334, 338, 342, 346, 350, 354, 358, 362, 366, 370, 374, 378, 381, 385, 389,
+		393, 397, 401, 405, 409, 412, 416, 419, 422, 426, 430, 434, 438, 442, 446, 450,
+		454, 458, 462, 466, 470, 473, 477, 482, 486, 490, 494, 498, 501, 505, 511, 515,
+		518, 522, 528, 531, 535, 539, 543, 547, 551, 554, 558, 562, 565, 568, 572, 576,
+		580, 584, 588, 593, 596, 600, 604, 608, 612, 616, 620, 625, 630, 634, 637, 641,
+		645, 649, 653, 657, 661, 665, 669, 673, 677, 680, 684, 688, 692, 696, 700, 704,
+		708, 712, 717, 721, 725, 729, 734, 738, 742, 746, 750, 755, 759, 763, 767, 771,
+		775, 779, 783, 787, 791, 795, 799, 803, 807, 811, 815, 819, 824, 828, 832, 836,
+		840, 845, 849, 853, 858, 862, 865, 869, 873, 877, 881, 885, 889, 893, 897, 901,
+		905, 909, 913, 917, 920, 925, 929, 933, 938, 942, 946, 950, 954, 958, 962, 966,
+		970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006, 1010, 1014, 1020, 1024, 1028, 0,
+		4, 8, 12, 16, 20, 23, 27, 31, 35, 39, 43, 47, 51, 55, 59, 63,
+		67, 71, 75, 79, 83, 87, 91, 95, 99, 103, 107, 112, 116, 120, 124, 128,
+		132, 136, 139, 142, 146, 150, 154, 158, 162, 166, 170, 174, 178, 182, 186, 190,
+		194, 198, 202, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 250, 254, 257,
+		261, 265, 270, 275, 279, 283, 287, 292, 296, 300, 304, 308, 312, 316, 320, 324,
+		328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388,
+		392, 396, 400, 404, 407, 412, 416, 420, 424, 429, 433, 437, 441, 445, 449, 453,
+		457, 461, 465, 469, 473, 477, 482, 486, 490, 494, 498, 502, 506, 511, 514, 518,
+		522, 525, 529, 533, 537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577, 581,
+		585, 587, 590, 594, 598, 602, 606, 608, 612, 615, 619, 623, 627, 631, 635, 639,
+		644, 648, 652, 656, 660, 664, 668, 672, 676, 680, 684, 688, 692, 696, 700, 704,
+		710, 713, 717, 721, 725, 728, 732, 736, 740, 744, 750, 754, 758, 762, 766, 770,
+		773, 776, 781, 786, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826, 830, 834,
+		838, 842, 846, 850, 854, 858, 862, 866, 870, 874, 878, 882, 886, 890, 894, 897,
+		901, 905, 909, 913, 917, 920, 925, 929, 933, 937, 941, 945, 948, 952, 956, 959,
+		963, 967, 971, 975, 979, 983, 987, 991, 994, 999, 1002, 1006, 1010, 1013, 1017, 1021,
+		0, 4, 10, 14, 18, 21, 25, 30, 33, 37, 41, 44, 49, 54, 59, 65,
+		69, 72, 76, 80, 83, 87, 92, 96, 99, 103, 108, 112, 116, 120, 124, 127,
+		131, 135, 139, 143, 147, 151, 155, 159, 163, 167, 171, 175, 178, 182, 186, 190,
+		195, 199, 203, 208, 213, 217, 222, 226, 230, 235, 239, 243, 247, 251, 255, 259,
+		263, 267, 271, 274, 278, 282, 286, 290, 296, 300, 304, 308, 313, 317, 321, 325,
+		329, 335, 339, 343, 347, 351, 355, 359, 363, 367, 371, 375, 379, 383, 387, 392,
+		396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 453, 458,
+		462, 466, 470, 474, 478, 482, 486, 490, 495, 500, 504, 508, 512, 516, 520, 524,
+		530, 534, 538, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578, 582, 586, 592,
+		596, 600, 604, 608, 612, 616, 620, 624, 628, 632, 636, 639, 643, 647, 651, 655,
+		659, 663, 667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719,
+		723, 726, 730, 734, 738, 742, 746, 750, 754, 758, 762, 766, 770, 774, 778, 782,
+		787, 791, 796, 800, 804, 807, 811, 815, 818, 822, 826, 830, 834, 838, 842, 846,
+		849, 853, 858, 862, 866, 870, 874, 878, 882, 886, 890, 894, 898, 902, 906, 910,
+		914, 918, 922, 926, 930, 934, 938, 942, 946, 950, 955, 959, 964, 968, 972, 976,
+		980, 983, 988, 992, 995, 1000, 1004, 1008, 1011, 1015, 1019, 1023, 1027, 1031, 1034, 1038,
+		1043, 0, 3, 7, 11, 15, 19, 22, 26, 30, 34, 38, 42, 46, 50, 54,
+		57, 61, 66, 71, 77, 81, 85, 89, 92, 96, 100, 104, 108, 112, 116, 121,
+		127, 131, 135, 139, 143, 147, 151, 155, 159, 163, 168, 172, 175, 180, 184, 188,
+		192, 196, 200, 204, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 252,
+		255, 258, 262, 266, 270, 274, 278, 281, 285, 290, 294, 299, 304, 308, 313, 317,
+		321, 325, 328, 333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 378, 382,
+		386, 390, 394, 398, 402, 405, 409, 413, 417, 421, 425, 429, 433, 437, 441, 445,
+		449, 454, 458, 462, 466, 470, 474, 478, 482, 486, 490, 494, 497, 501, 504, 507,
+		510, 514, 519, 523, 527, 531, 535, 539, 543, 547, 551, 555, 559, 563, 567, 572,
+		576, 580, 584, 588, 592, 596, 600, 603, 607, 611, 615, 619, 623, 627, 631, 635,
+		639, 643, 647, 651, 655, 661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 701,
+		704, 708, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751, 755, 758, 762,
+		766, 769, 773, 777, 781, 785, 788, 792, 795, 799, 803, 807, 811, 815, 819, 823,
+		827, 832, 836, 840, 844, 848, 852, 856, 860, 864, 867, 871, 874, 878, 882, 886,
+		890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946, 950,
+		953, 957, 961, 965, 969, 973, 976, 980, 984, 988, 991, 995, 999, 1003, 1007, 1011,
+		1015, 1019, 0, 4, 8, 12, 16, 20, 24, 27, 31, 35, 39, 43, 47, 51,
+		55, 59, 63, 67, 71, 75, 79, 84, 88, 92, 96, 100, 104, 108, 112, 116,
+		120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180,
+		183, 187, 189, 193, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240,
+		244, 248, 252, 257, 261, 265, 269, 273, 277, 281, 285, 289, 292, 296, 300, 304,
+		308, 312, 316, 320, 324, 328, 333, 337, 341, 344, 347, 350, 354, 358, 361, 366,
+		371, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 431,
+		435, 439, 443, 447, 451, 455, 459, 463, 467, 470, 475, 479, 483, 487, 492, 496,
+		500, 504, 508, 512, 516, 520, 523, 527, 531, 534, 538, 542, 546, 550, 553, 556,
+		560, 564, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 619,
+		623, 627, 631, 635, 639, 645, 649, 654, 658, 661, 665, 669, 673, 677, 681, 685,
+		690, 694, 697, 701, 705, 709, 713, 718, 722, 726, 730, 734, 738, 742, 746, 750,
+		754, 758, 762, 766, 770, 774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814,
+		820, 823, 826, 829, 833, 837, 840, 844, 848, 852, 857, 861, 865, 869, 873, 877,
+		880, 883, 887, 891, 896, 901, 905, 908, 912, 916, 920, 925, 929, 932, 936, 940,
+		944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 989, 993, 997, 1000, 1004,
+		1009, 1013, 1018, 0, 4, 8, 11, 16, 20, 24, 28, 32, 35, 38, 42, 46,
+		50, 54, 58, 62, 66, 69, 73, 77, 81, 87, 91, 94, 97, 101, 105, 109,
+		113, 117, 121, 124, 128, 131, 135, 139, 145, 149, 152, 156, 159, 163, 166, 170,
+		174, 178, 182, 186, 190, 194, 198, 202, 206, 210, 214, 218, 222, 226, 230, 234,
+		238, 242, 246, 250, 254, 258, 262, 266, 269, 273, 277, 281, 285, 288, 292, 296,
+		300, 304, 308, 312, 316, 320, 324, 327, 331, 336, 340, 344, 347, 351, 355, 359,
+		363, 367, 371, 375, 379, 383, 387, 389, 393, 397, 401, 405, 408, 411, 414, 418,
+		422, 426, 430, 434, 438, 442, 445, 449, 453, 457, 461, 467, 472, 475, 479, 483,
+		486, 490, 495, 499, 503, 507, 511, 517, 521, 525, 529, 533, 537, 541, 545, 549,
+		553, 557, 561, 565, 569, 573, 577, 580, 584, 588, 592, 596, 601, 605, 609, 615,
+		619, 623, 627, 632, 636, 641, 645, 649, 653, 657, 661, 665, 669, 673, 677, 681,
+		685, 690, 693, 697, 701, 705, 709, 713, 716, 721, 725, 729, 732, 736, 742, 746,
+		750, 754, 758, 762, 766, 770, 774, 778, 782, 786, 790, 794, 799, 803, 807, 811,
+		815, 819, 822, 826, 831, 837, 841, 845, 849, 853, 857, 861, 864, 868, 873, 877,
+		881, 886, 889, 892, 896, 900, 903, 906, 909, 913, 917, 920, 924, 928, 933, 937,
+		940, 944, 947, 954, 957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997, 1001,
+		1005, 1009, 1013, 1017, 0, 4, 8, 12, 15, 19, 23, 27, 31, 35, 39, 43,
+		47, 51, 55, 58, 62, 66, 70, 74, 79, 83, 87, 91, 95, 98, 102, 107,
+		110, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 163, 167, 171,
+		175, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236,
+		240, 245, 249, 254, 257, 261, 265, 268, 272, 276, 280, 284, 288, 292, 295, 301,
+		305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 354, 358, 362, 366,
+		370, 373, 377, 381, 385, 388, 392, 395, 398, 402, 406, 410, 414, 418, 422, 426,
+		430, 434, 438, 442, 446, 450, 454, 459, 463, 468, 472, 475, 479, 483, 487, 491,
+		496, 499, 503, 507, 511, 515, 519, 523, 527, 531, 534, 538, 544, 548, 551, 555,
+		559, 563, 567, 571, 575, 579, 583, 586, 591, 595, 600, 604, 608, 612, 614, 618,
+		623, 627, 631, 635, 639, 643, 647, 651, 655, 659, 664, 668, 672, 676, 680, 684,
+		688, 692, 696, 701, 705, 710, 713, 717, 721, 725, 729, 733, 737, 741, 745, 749,
+		754, 759, 764, 767, 771, 775, 779, 785, 789, 794, 798, 802, 806, 810, 814, 818,
+		821, 825, 829, 832, 836, 840, 844, 848, 853, 857, 861, 865, 869, 873, 876, 880,
+		884, 888, 893, 897, 901, 905, 910, 914, 918, 923, 926, 930, 934, 939, 943, 947,
+		951, 955, 959, 963, 966, 972, 976, 980, 984, 988, 992, 996, 1000, 1004, 1008, 1012,
+		1016, 1020, 1024, 1027, 1031, 0, 4, 7, 11, 15, 19, 23, 27, 31, 35, 39,
+		42, 45, 50, 55, 59, 63, 67, 71, 75, 79, 83, 87, 92, 96, 99, 103,
+		107, 111, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168,
+		171, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231,
+		235, 239, 242, 246, 250, 254, 257, 262, 265, 269, 273, 277, 281, 285, 289, 293,
+		297, 301, 305, 309, 313, 318, 322, 326, 329, 333, 337, 342, 346, 351, 355, 359,
+		363, 367, 371, 377, 381, 385, 390, 394, 397, 401, 405, 409, 413, 418, 422, 426,
+		430, 434, 439, 443, 448, 452, 456, 459, 463, 467, 471, 475, 480, 486, 490, 496,
+		500, 503, 507, 510, 515, 521, 524, 528, 532, 536, 541, 545, 548, 552, 556, 560,
+		564, 568, 572, 575, 579, 583, 587, 591, 594, 598, 603, 607, 611, 614, 620, 624,
+		628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 667, 671, 675, 679, 683, 687,
+		691, 695, 699, 703, 707, 711, 715, 720, 724, 728, 732, 736, 742, 746, 750, 754,
+		759, 763, 766, 770, 774, 778, 781, 785, 789, 793, 797, 801, 805, 809, 813, 817,
+		821, 825, 829, 833, 837, 842, 847, 851, 855, 859, 863, 866, 870, 874, 879, 883,
+		887, 891, 895, 899, 903, 907, 911, 915, 918, 922, 926, 931, 935, 939, 943, 947,
+		951, 955, 960, 966, 971, 975, 979, 983, 985, 988, 991, 995, 999, 1003, 1008, 1012,
+		1015, 1019, 1023, 1027, 1031, 1035, 0, 4, 8, 12, 16, 20, 23, 27, 31, 35,
+		39, 43, 48, 53, 56, 61, 64, 69, 73, 77, 80, 84, 88, 92, 98, 102,
+		106, 110, 114, 118, 122, 126, 131, 135, 138, 142, 146, 150, 154, 159, 162, 166,
+		170, 174, 178, 182, 186, 190, 194, 198, 202, 206, 210, 214, 220, 224, 229, 233,
+		238, 243, 247, 250, 253, 257, 261, 265, 269, 273, 277, 281, 285, 289, 293, 297,
+		301, 304, 309, 313, 317, 322, 327, 331, 335, 339, 343, 347, 351, 356, 360, 364,
+		368, 373, 377, 380, 385, 388, 391, 395, 399, 403, 407, 411, 415, 419, 423, 427,
+		430, 433, 437, 441, 445, 450, 454, 457, 461, 465, 469, 473, 477, 481, 485, 489,
+		493, 498, 502, 506, 510, 514, 518, 523, 527, 532, 536, 540, 544, 548, 552, 556,
+		560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616, 620,
+		624, 628, 632, 635, 639, 644, 649, 653, 657, 661, 665, 669, 673, 678, 682, 686,
+		691, 695, 699, 703, 707, 711, 714, 717, 721, 725, 729, 734, 738, 742, 746, 751,
+		755, 760, 763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 807, 812, 816,
+		820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 868, 872, 876, 880,
+		883, 886, 890, 894, 898, 902, 908, 912, 916, 920, 924, 929, 933, 937, 941, 945,
+		949, 953, 958, 962, 966, 970, 974, 978, 982, 985, 988, 991, 994, 999, 1002, 1005,
+		1009, 1013, 1017, 1021, 1025, 1029, 1033, 0, 4, 8, 12, 16, 21, 25, 29, 34,
+		37, 42, 46, 51, 55, 59, 63, 67, 71, 75, 79, 83, 87, 91, 95, 99,
+		103, 107, 110, 114, 119, 123, 127, 131, 134, 138, 142, 146, 150, 154, 158, 162,
+		166, 170, 174, 178, 182, 186, 190, 195, 199, 203, 206, 210, 215, 221, 224, 228,
+		232, 236, 240, 244, 248, 253, 257, 261, 265, 270, 275, 279, 283, 287, 290, 294,
+		298, 302, 306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 346, 350, 354, 357,
+		361, 365, 369, 373, 376, 380, 385, 389, 393, 397, 400, 404, 408, 411, 415, 419,
+		422, 426, 430, 434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 478, 482,
+		486, 490, 493, 497, 501, 505, 509, 512, 515, 519, 523, 527, 531, 536, 540, 544,
+		548, 552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 601, 606, 609,
+		612, 616, 619, 623, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668, 671,
+		675, 678, 682, 686, 690, 694, 698, 702, 706, 710, 715, 719, 723, 727, 731, 735,
+		739, 743, 747, 752, 756, 760, 764, 768, 772, 776, 780, 784, 789, 792, 796, 800,
+		803, 807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 847, 852, 856, 860, 864,
+		868, 872, 875, 879, 883, 887, 891, 895, 899, 902, 906, 910, 914, 918, 922, 926,
+		930, 934, 938, 942, 946, 950, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991,
+		995, 998, 1002, 1006, 1009, 1013, 1017, 1022, 0, 4, 8, 12, 16, 19, 23, 27,
+		31, 34, 38, 42, 46, 50, 55, 59, 63, 67, 71, 75, 79, 84, 89, 93,
+		97, 101, 105, 110, 114, 118, 122, 126, 130, 134, 138, 142, 146, 150, 154, 160,
+		164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224,
+		227, 231, 234, 238, 241, 245, 249, 253, 257, 261, 264, 268, 272, 276, 280, 283,
+		286, 291, 295, 299, 303, 307, 311, 315, 320, 324, 327, 331, 335, 339, 344, 348,
+		352, 356, 360, 364, 368, 372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412,
+		416, 420, 424, 428, 433, 437, 440, 444, 448, 451, 456, 460, 464, 468, 472, 476,
+		480, 484, 488, 492, 496, 499, 503, 507, 512, 515, 519, 523, 526, 529, 533, 537,
+		541, 545, 548, 552, 556, 560, 564, 567, 571, 575, 579, 583, 587, 591, 596, 599,
+		603, 607, 612, 616, 620, 624, 628, 632, 636, 641, 645, 649, 653, 657, 662, 666,
+		670, 674, 678, 682, 686, 690, 694, 698, 702, 706, 710, 714, 718, 723, 726, 730,
+		734, 738, 742, 746, 749, 753, 757, 761, 765, 769, 773, 777, 780, 786, 791, 794,
+		798, 803, 807, 811, 815, 819, 822, 827, 831, 835, 839, 844, 848, 852, 857, 861,
+		864, 868, 873, 877, 881, 885, 890, 893, 897, 901, 905, 909, 913, 917, 921, 926,
+		931, 935, 939, 943, 947, 951, 955, 960, 963, 969, 973, 977, 982, 986, 990, 994,
+		998, 1002, 1006, 1010, 1014, 1018, 1022, 1026, 1030, 0, 4, 8, 12, 17, 22, 26,
+		31, 35, 39, 43, 48, 52, 56, 60, 66, 70, 75, 79, 84, 88, 93, 97,
+		100, 104, 108, 112, 116, 120, 124, 128, 134, 139, 143, 147, 151, 155, 159, 163,
+		166, 170, 174, 180, 184, 189, 193, 197, 201, 205, 209, 213, 217, 221, 225, 229,
+		233, 237, 241, 245, 249, 253, 257, 261, 264, 269, 273, 277, 281, 285, 289, 294,
+		299, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351, 355, 359,
+		363, 367, 371, 375, 379, 383, 387, 391, 395, 398, 402, 406, 409, 413, 417, 421,
+		425, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484,
+		488, 492, 496, 499, 503, 507, 511, 515, 519, 523, 527, 531, 535, 539, 543, 547,
+		552, 556, 560, 565, 569, 573, 577, 581, 585, 589, 593, 597, 600, 604, 608, 612,
+		616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668, 672, 676,
+		680, 684, 688, 691, 695, 699, 703, 707, 711, 716, 719, 723, 726, 730, 734, 738,
+		742, 746, 750, 755, 759, 763, 767, 771, 776, 780, 783, 787, 791, 795, 798, 802,
+		806, 810, 814, 818, 822, 826, 830, 835, 839, 843, 847, 851, 855, 859, 862, 866,
+		869, 872, 877, 881, 885, 889, 892, 897, 903, 908, 912, 915, 919, 924, 928, 932,
+		936, 939, 943, 947, 951, 955, 959, 963, 968, 974, 978, 983, 986, 990, 994, 998,
+		1002, 1006, 1010, 1014, 1018, 1022, 1026, 1030, 1034, 1038, 0, 4, 9, 13, 17, 21,
+		26, 29, 33, 37, 41, 45, 50, 54, 58, 62, 66, 70, 75, 79, 83, 87,
+		91, 95, 99, 103, 107, 111, 115, 119, 123, 127, 131, 134, 138, 141, 145, 151,
+		154, 158, 163, 167, 172, 176, 180, 183, 187, 191, 195, 199, 203, 207, 211, 214,
+		218, 222, 226, 230, 234, 238, 242, 246, 250, 254, 258, 262, 266, 270, 274, 278,
+		284, 288, 292, 296, 300, 304, 309, 313, 317, 323, 327, 331, 337, 341, 345, 351,
+		355, 360, 364, 368, 373, 376, 380, 384, 388, 392, 395, 399, 403, 407, 411, 415,
+		419, 423, 427, 432, 436, 441, 446, 449, 454, 458, 463, 467, 471, 475, 479, 483,
+		487, 491, 494, 498, 502, 506, 511, 515, 519, 523, 527, 531, 535, 539, 543, 549,
+		552, 556, 560, 565, 569, 574, 579, 583, 587, 592, 595, 599, 604, 608, 612, 616,
+		620, 624, 629, 633, 637, 641, 645, 649, 654, 658, 662, 666, 669, 673, 677, 681,
+		685, 689, 694, 698, 703, 707, 710, 713, 717, 721, 725, 729, 733, 737, 740, 743,
+		746, 750, 755, 759, 763, 766, 770, 774, 778, 782, 786, 790, 794, 797, 801, 805,
+		809, 813, 817, 822, 825, 829, 833, 837, 841, 844, 848, 852, 856, 860, 863, 867,
+		871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931,
+		935, 939, 943, 947, 951, 955, 958, 962, 966, 970, 975, 979, 983, 986, 989, 992,
+		997, 1001, 1005, 1009, 1012, 1018, 1022, 1026, 1030, 1034, 1037, 0, 4, 8, 12, 15,
+		19, 23, 27, 30, 34, 38, 43, 46, 51, 54, 58, 62, 66, 70, 74, 78,
+		82, 86, 90, 94, 98, 103, 107, 111, 115, 118, 122, 127, 131, 135, 139, 143,
+		147, 151, 155, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 208,
+		212, 216, 220, 224, 230, 234, 237, 241, 245, 248, 252, 256, 260, 263, 268, 272,
+		276, 279, 283, 286, 290, 294, 298, 302, 306, 310, 314, 318, 322, 326, 331, 335,
+		339, 343, 347, 351, 355, 359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 399,
+		403, 407, 411, 415, 419, 423, 427, 431, 435, 439, 443, 447, 451, 456, 459, 466,
+		470, 474, 478, 482, 486, 490, 494, 498, 503, 507, 512, 516, 521, 524, 528, 532,
+		536, 540, 543, 547, 551, 555, 559, 564, 568, 572, 576, 580, 584, 587, 591, 595,
+		599, 603, 607, 611, 615, 619, 623, 627, 631, 635, 639, 643, 647, 651, 655, 659,
+		663, 667, 671, 675, 679, 683, 686, 690, 694, 698, 701, 705, 709, 713, 717, 721,
+		725, 729, 733, 737, 741, 745, 750, 754, 758, 762, 766, 770, 774, 777, 781, 785,
+		788, 792, 797, 801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 847, 851,
+		856, 860, 866, 870, 875, 879, 882, 887, 890, 894, 898, 901, 905, 909, 913, 917,
+		921, 924, 928, 932, 935, 939, 943, 947, 951, 955, 958, 962, 966, 970, 974, 978,
+		982, 986, 990, 994, 998, 1002, 1006, 1010, 1014, 1018, 1022, 1026, 0, 4, 8, 12,
+		16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76,
+		80, 84, 88, 92, 97, 101, 105, 109, 113, 117, 121, 125, 129, 133, 137, 141,
+		144, 148, 152, 156, 160, 164, 169, 173, 178, 182, 185, 188, 191, 195, 198, 201,
+		205, 209, 213, 217, 221, 225, 229, 233, 239, 245, 249, 253, 257, 260, 265, 268,
+		272, 276, 280, 286, 290, 296, 299, 303, 307, 311, 315, 319, 323, 327, 331, 335,
+		340, 344, 348, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388, 392, 396, 400,
+		404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464,
+		467, 470, 474, 478, 482, 486, 489, 493, 498, 502, 506, 510, 514, 518, 522, 526,
+		530, 534, 538, 542, 545, 549, 552, 556, 560, 564, 568, 572, 578, 582, 586, 589,
+		593, 597, 601, 604, 607, 611, 615, 620, 624, 628, 632, 635, 639, 642, 648, 652,
+		656, 660, 664, 667, 671, 675, 679, 683, 687, 693, 697, 701, 705, 709, 713, 717,
+		721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 761, 765, 769, 773, 777, 781,
+		785, 789, 793, 797, 801, 805, 809, 815, 819, 823, 827, 831, 835, 839, 843, 847,
+		851, 855, 859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 910,
+		915, 918, 922, 926, 929, 932, 936, 939, 943, 947, 951, 955, 959, 962, 967, 971,
+		974, 978, 981, 985, 989, 993, 997, 1001, 1005, 1010, 1014, 1018, 1023, 0, 4, 8,
+		12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72,
+		76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136,
+		140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 183, 187, 191, 195, 199,
+		202, 206, 210, 215, 219, 225, 229, 232, 235, 239, 243, 248, 252, 256, 260, 263,
+		268, 271, 275, 278, 282, 285, 289, 292, 296, 300, 304, 307, 310, 313, 317, 320,
+		324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376, 380, 384,
+		388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 445, 449,
+		453, 457, 460, 465, 469, 473, 477, 483, 487, 491, 495, 499, 503, 507, 511, 515,
+		519, 523, 527, 531, 535, 539, 543, 547, 551, 555, 561, 565, 569, 573, 577, 581,
+		584, 589, 593, 597, 600, 605, 609, 613, 617, 621, 624, 628, 631, 635, 639, 643,
+		647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707,
+		711, 715, 719, 723, 727, 731, 735, 741, 745, 749, 753, 757, 761, 765, 769, 773,
+		777, 781, 785, 789, 793, 797, 801, 804, 809, 813, 817, 821, 826, 829, 833, 837,
+		841, 845, 850, 853, 857, 860, 864, 869, 872, 876, 880, 884, 887, 891, 895, 899,
+		903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963,
+		967, 971, 975, 979, 983, 987, 991, 995, 999, 1002, 1006, 1010, 1014, 1018, 0, 4,
+		8, 11, 15, 20, 24, 27, 31, 35, 39, 43, 46, 50, 54, 58, 62, 66,
+		70, 74, 78, 82, 86, 90, 94, 99, 103, 108, 112, 116, 120, 124, 128, 132,
+		136, 140, 144, 151, 155, 159, 164, 168, 171, 175, 178, 182, 186, 190, 194, 198,
+		203, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264,
+		268, 273, 277, 281, 285, 289, 293, 297, 301, 305, 310, 315, 319, 323, 328, 332,
+		336, 340, 344, 348, 352, 356, 360, 364, 368, 373, 377, 381, 385, 391, 395, 399,
+		403, 407, 411, 415, 419, 423, 427, 431, 435, 439, 443, 447, 451, 455, 459, 463,
+		467, 471, 474, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 526,
+		530, 534, 538, 542, 547, 551, 555, 559, 563, 567, 571, 574, 578, 582, 586, 590,
+		596, 600, 604, 608, 611, 615, 619, 623, 627, 631, 635, 639, 643, 647, 651, 655,
+		659, 663, 667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719,
+		722, 725, 729, 732, 736, 740, 744, 748, 752, 757, 761, 765, 770, 774, 779, 783,
+		787, 791, 795, 799, 805, 809, 813, 818, 822, 826, 830, 834, 838, 842, 846, 851,
+		854, 858, 862, 866, 870, 874, 879, 883, 887, 891, 895, 899, 903, 907, 911, 914,
+		918, 922, 926, 930, 935, 939, 942, 946, 950, 954, 958, 962, 966, 970, 974, 978,
+		983, 987, 992, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 1027, 1030, 1034, 1038, 0,
+		4, 7, 10, 14, 18, 22, 26, 30, 34, 38, 42, 46, 50, 54, 58, 62,
+		65, 69, 73, 77, 81, 85, 89, 93, 97, 101, 105, 109, 113, 117, 121, 124,
+		128, 130, 134, 138, 142, 145, 148, 152, 155, 159, 163, 167, 171, 175, 178, 182,
+		185, 191, 195, 198, 202, 206, 210, 214, 218, 222, 227, 231, 235, 239, 243, 248,
+		253, 256, 260, 264, 268, 272, 276, 280, 283, 287, 291, 295, 299, 303, 307, 310,
+		313, 316, 319, 322, 326, 329, 333, 338, 341, 345, 349, 353, 357, 361, 364, 369,
+		375, 378, 383, 387, 391, 395, 398, 402, 407, 411, 415, 419, 423, 427, 430, 434,
+		440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 487, 491, 495, 499,
+		503, 507, 511, 514, 518, 522, 526, 532, 536, 540, 544, 549, 553, 557, 561, 564,
+		568, 572, 576, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 619, 623, 627,
+		631, 635, 639, 643, 647, 651, 654, 657, 661, 665, 669, 673, 678, 682, 686, 690,
+		694, 698, 702, 706, 710, 714, 719, 725, 729, 733, 737, 741, 745, 749, 755, 759,
+		763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 807, 811, 815, 819, 823,
+		827, 831, 835, 838, 843, 847, 851, 855, 858, 862, 866, 870, 874, 878, 882, 886,
+		890, 894, 898, 902, 906, 910, 914, 919, 924, 928, 932, 935, 938, 941, 945, 948,
+		952, 956, 960, 964, 967, 970, 974, 977, 980, 984, 988, 992, 996, 1000, 1004, 1009,
+		0, 5, 9, 13, 17, 21, 25, 29, 33, 37, 41, 45, 49, 53, 56, 60,
+		64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124,
+		128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 177, 181, 185, 189,
+		193, 197, 201, 204, 207, 211, 215, 219, 223, 227, 231, 235, 239, 243, 247, 250,
+		254, 258, 261, 265, 270, 273, 277, 282, 286, 291, 295, 299, 303, 307, 311, 317,
+		321, 325, 329, 333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 377, 381,
+		385, 389, 393, 397, 401, 405, 410, 415, 419, 423, 427, 431, 435, 439, 443, 447,
+		451, 455, 459, 463, 467, 471, 475, 479, 483, 487, 491, 495, 499, 503, 507, 511,
+		515, 519, 522, 527, 530, 535, 539, 543, 547, 551, 556, 559, 563, 567, 573, 577,
+		582, 588, 592, 596, 600, 604, 608, 612, 616, 620, 624, 627, 633, 637, 641, 645,
+		649, 653, 657, 661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 701, 705, 709,
+		713, 717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 761, 765, 769, 773,
+		777, 781, 785, 789, 793, 797, 800, 804, 808, 812, 816, 820, 823, 828, 832, 836,
+		840, 844, 848, 852, 856, 860, 864, 868, 872, 876, 880, 884, 888, 892, 896, 900,
+		904, 908, 912, 916, 920, 924, 928, 932, 936, 940, 943, 947, 952, 956, 961, 964,
+		968, 971, 975, 979, 983, 988, 992, 996, 1000, 1004, 1007, 1010, 1014, 1018, 1022, 1026,
+		1030, 0, 4, 8, 12, 16, 20, 24, 27, 30, 34, 38, 43, 47, 52, 55,
+		59, 63, 67, 71, 74, 78, 82, 86, 90, 94, 97, 101, 105, 109, 113, 117,
+		121, 125, 129, 133, 137, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181,
+		185, 189, 193, 197, 201, 205, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245,
+		249, 253, 257, 260, 264, 268, 272, 276, 279, 283, 287, 291, 295, 299, 303, 307,
+		311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351, 355, 359, 363, 367, 371,
+		375, 380, 384, 388, 392, 395, 400, 404, 407, 410, 413, 417, 421, 425, 429, 433,
+		436, 440, 444, 448, 452, 456, 459, 463, 467, 471, 475, 479, 483, 487, 491, 496,
+		501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549, 553, 557, 561,
+		565, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613, 617, 621, 625,
+		629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 677, 681, 685, 689,
+		693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 732, 736, 740, 744, 748, 754,
+		757, 761, 764, 768, 772, 776, 781, 785, 788, 793, 796, 800, 804, 807, 811, 815,
+		819, 823, 827, 832, 836, 839, 843, 847, 851, 855, 859, 864, 869, 873, 877, 881,
+		885, 890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946,
+		950, 954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006, 1010,
+		1015, 1019, 0, 4, 8, 13, 17, 21, 25, 29, 33, 37, 41, 45, 49, 53,
+		57, 61, 65, 69, 73, 77, 81, 85, 89, 93, 97, 101, 105, 109, 113, 117,
+		121, 125, 129, 133, 137, 141, 145, 149, 153, 158, 162, 167, 170, 175, 179, 183,
+		187, 190, 194, 197, 201, 205, 210, 214, 218, 222, 226, 230, 234, 238, 242, 246,
+		250, 254, 258, 262, 266, 270, 274, 278, 282, 286, 291, 296, 300, 304, 308, 312,
+		316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376,
+		380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440,
+		444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 488, 492, 496, 500, 504,
+		508, 512, 516, 522, 526, 530, 534, 538, 542, 546, 552, 556, 559, 563, 567, 571,
+		574, 578, 582, 586, 591, 596, 600, 604, 608, 612, 616, 620, 624, 628, 632, 636,
+		640, 644, 648, 652, 657, 661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 701,
+		705, 709, 713, 717, 721, 725, 729, 732, 736, 741, 745, 749, 753, 757, 761, 765,
+		769, 773, 777, 781, 785, 789, 793, 797, 801, 805, 809, 813, 817, 821, 825, 829,
+		833, 837, 841, 845, 850, 854, 858, 861, 866, 871, 876, 880, 885, 889, 893, 897,
+		901, 905, 909, 913, 917, 923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963,
+		967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1016, 1019, 1024, 1028,
+		1032, 1036, 1040, 0, 3, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48,
+		53, 57, 61, 65, 69, 73, 77, 81, 85, 89, 93, 97, 102, 107, 111, 115,
+		119, 123, 127, 131, 135, 139, 143, 148, 152, 157, 161, 165, 169, 173, 177, 180,
+		184, 189, 193, 197, 201, 205, 209, 214, 218, 222, 226, 230, 234, 238, 242, 246,
+		250, 254, 258, 262, 266, 270, 274, 279, 283, 287, 291, 295, 299, 303, 307, 311,
+		315, 318, 322, 325, 329, 335, 339, 344, 348, 352, 356, 360, 365, 369, 374, 378,
+		382, 387, 391, 395, 399, 403, 407, 411, 415, 419, 423, 427, 431, 435, 439, 443,
+		447, 451, 455, 459, 463, 467, 471, 476, 480, 484, 488, 492, 496, 500, 504, 508,
+		511, 515, 519, 523, 527, 531, 535, 539, 544, 548, 552, 556, 561, 564, 568, 572,
+		576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616, 620, 624, 628, 632, 636,
+		640, 644, 647, 652, 656, 661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 702,
+		706, 710, 714, 718, 723, 727, 731, 735, 738, 741, 745, 748, 752, 756, 760, 764,
+		767, 770, 774, 778, 781, 785, 789, 793, 797, 802, 806, 810, 814, 818, 822, 825,
+		829, 833, 837, 841, 845, 849, 853, 859, 863, 867, 871, 875, 879, 883, 886, 890,
+		894, 898, 902, 907, 910, 914, 919, 923, 928, 932, 936, 940, 943, 947, 950, 954,
+		958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 999, 1002, 1006, 1009, 1013, 1017,
+		1021, 1025, 1029, 1034, 0, 4, 7, 11, 15, 19, 23, 27, 31, 35, 39, 43,
+		46, 50, 54, 58, 62, 65, 71, 75, 79, 83, 87, 91, 95, 99, 104, 106,
+		109, 113, 117, 121, 126, 130, 134, 138, 142, 146, 150, 155, 159, 163, 168, 172,
+		177, 181, 185, 189, 193, 196, 199, 204, 210, 214, 218, 222, 225, 229, 232, 236,
+		240, 245, 249, 253, 257, 261, 265, 270, 274, 279, 283, 287, 290, 294, 298, 302,
+		306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 347, 351, 355, 359, 363, 368,
+		372, 375, 380, 384, 388, 392, 396, 400, 404, 408, 412, 417, 422, 425, 430, 434,
+		438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 478, 482, 486, 491, 495, 499,
+		503, 507, 511, 516, 520, 523, 527, 531, 535, 538, 541, 545, 548, 552, 556, 562,
+		566, 570, 574, 579, 583, 588, 592, 597, 601, 605, 609, 613, 617, 621, 625, 629,
+		633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 676, 682, 686, 689, 692,
+		696, 699, 703, 707, 711, 715, 718, 722, 726, 730, 734, 738, 742, 746, 750, 754,
+		758, 762, 766, 770, 774, 778, 782, 787, 791, 795, 799, 803, 807, 812, 816, 820,
+		824, 830, 834, 838, 843, 847, 851, 855, 859, 863, 867, 871, 875, 879, 883, 887,
+		891, 895, 899, 904, 908, 912, 916, 921, 926, 931, 935, 941, 947, 951, 955, 959,
+		963, 967, 972, 976, 980, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1015, 1019, 1023,
+		1027, 1031, 1035, 1039, 1043, 0, 4, 9, 12, 17, 21, 25, 29, 33, 37, 41,
+		45, 49, 53, 58, 62, 68, 71, 75, 80, 85, 91, 95, 99, 103, 107, 111,
+		115, 119, 123, 128, 131, 135, 139, 143, 147, 150, 154, 157, 161, 165, 169, 173,
+		177, 181, 185, 189, 193, 196, 200, 204, 208, 212, 216, 220, 225, 228, 232, 236,
+		240, 244, 248, 252, 255, 259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 298,
+		302, 306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 346, 350, 354, 358, 362,
+		366, 370, 374, 378, 382, 385, 389, 393, 396, 400, 404, 408, 412, 416, 420, 424,
+		428, 432, 436, 440, 444, 448, 453, 457, 461, 464, 468, 472, 476, 480, 484, 489,
+		493, 497, 501, 505, 509, 513, 517, 522, 526, 530, 534, 538, 543, 548, 552, 555,
+		559, 563, 567, 571, 575, 579, 583, 587, 590, 594, 598, 602, 605, 609, 613, 617,
+		621, 625, 629, 635, 639, 643, 647, 651, 655, 659, 664, 669, 673, 677, 681, 685,
+		689, 693, 698, 702, 706, 710, 714, 718, 722, 727, 732, 738, 742, 746, 750, 754,
+		758, 761, 765, 768, 772, 776, 780, 784, 788, 792, 796, 801, 805, 809, 814, 819,
+		823, 827, 831, 835, 839, 843, 847, 852, 856, 860, 864, 868, 872, 877, 882, 886,
+		890, 894, 898, 903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947, 951,
+		955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 1000, 1004, 1008, 1012, 1016,
+		1020, 1024, 1028, 1032, 1036, 1040, 0, 4, 8, 12, 16, 20, 25, 29, 33, 37,
+		41, 44, 48, 52, 55, 58, 62, 66, 70, 74, 78, 82, 86, 90, 94, 98,
+		104, 108, 112, 116, 120, 124, 127, 132, 136, 140, 144, 148, 152, 156, 160, 164,
+		168, 172, 176, 180, 184, 187, 190, 192, 195, 199, 203, 209, 213, 217, 221, 225,
+		229, 233, 238, 242, 246, 250, 255, 259, 263, 267, 271, 275, 279, 283, 287, 291,
+		295, 299, 303, 307, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356,
+		360, 363, 367, 371, 374, 378, 382, 386, 390, 394, 398, 401, 404, 408, 412, 416,
+		420, 424, 428, 432, 436, 440, 444, 450, 454, 458, 462, 466, 470, 474, 480, 484,
+		488, 492, 496, 500, 504, 507, 511, 515, 519, 523, 527, 531, 535, 539, 543, 546,
+		550, 554, 558, 562, 567, 571, 575, 578, 582, 585, 589, 594, 599, 605, 609, 613,
+		617, 621, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668, 672, 676,
+		680, 684, 688, 692, 696, 700, 704, 708, 712, 716, 721, 726, 729, 733, 737, 741,
+		745, 749, 753, 757, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804,
+		808, 812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 861, 866, 870,
+		873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 926, 930, 934,
+		938, 942, 945, 951, 955, 959, 963, 967, 971, 975, 978, 982, 986, 990, 994, 998,
+		1002, 1006, 1010, 1014, 1018, 1022, 1026, 0, 4, 8, 12, 16, 20, 24, 28, 32,
+		36, 40, 43, 47, 51, 55, 59, 63, 67, 71, 75, 79, 83, 87, 91, 95,
+		99, 103, 107, 111, 115, 119, 123, 127, 132, 135, 139, 144, 148, 151, 155, 159,
+		163, 167, 171, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 220, 224,
+		228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 283, 287,
+		291, 295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 334, 338, 343, 348, 352,
+		356, 360, 365, 369, 373, 377, 381, 385, 389, 394, 398, 402, 406, 410, 414, 418,
+		422, 425, 431, 435, 439, 443, 447, 451, 455, 459, 463, 467, 471, 475, 479, 483,
+		487, 491, 495, 499, 502, 506, 509, 513, 517, 520, 524, 528, 532, 536, 540, 544,
+		548, 552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 597, 601, 606, 610,
+		614, 618, 622, 626, 630, 635, 638, 642, 646, 650, 654, 658, 662, 666, 670, 675,
+		679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 740,
+		744, 748, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801, 805,
+		809, 812, 816, 821, 825, 830, 834, 837, 841, 845, 849, 853, 857, 861, 865, 869,
+		873, 877, 881, 885, 889, 893, 896, 899, 903, 907, 912, 916, 920, 924, 928, 932,
+		936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 981, 985, 988, 994, 998,
+		1002, 1005, 1009, 1013, 1017, 1021, 1025, 1030, 0, 4, 8, 12, 16, 20, 23, 27,
+		31, 35, 39, 43, 47, 51, 55, 59, 62, 66, 70, 74, 79, 83, 87, 91,
+		95, 99, 103, 108, 112, 115, 119, 125, 130, 135, 139, 143, 147, 151, 155, 159,
+		162, 166, 170, 174, 178, 181, 185, 189, 193, 197, 201, 205, 209, 213, 217, 221,
+		225, 229, 233, 237, 241, 245, 249, 254, 258, 262, 266, 270, 274, 278, 282, 287,
+		290, 294, 298, 302, 306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 346, 350,
+		354, 358, 362, 366, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 415,
+		419, 423, 427, 431, 435, 439, 443, 447, 451, 456, 460, 464, 468, 473, 477, 481,
+		485, 488, 492, 496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 537, 540, 544,
+		548, 552, 556, 560, 564, 568, 572, 576, 580, 584, 589, 593, 598, 603, 607, 611,
+		615, 619, 623, 626, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673,
+		677, 681, 684, 688, 692, 696, 700, 703, 707, 711, 715, 720, 724, 728, 732, 736,
+		740, 745, 749, 753, 757, 761, 765, 769, 773, 778, 782, 786, 790, 794, 798, 802,
+		806, 810, 814, 818, 822, 826, 830, 833, 837, 841, 846, 850, 854, 858, 862, 867,
+		871, 875, 879, 883, 887, 891, 895, 899, 903, 908, 911, 916, 920, 924, 928, 932,
+		936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988, 992, 995,
+		998, 1002, 1006, 1011, 1015, 1019, 1023, 1027, 1031, 0, 4, 8, 11, 15, 19, 23,
+		27, 31, 35, 39, 43, 47, 51, 55, 58, 63, 67, 70, 74, 77, 81, 86,
+		90, 94, 98, 102, 106, 110, 114, 118, 122, 126, 130, 134, 138, 142, 146, 150,
+		154, 158, 162, 166, 170, 175, 178, 182, 186, 192, 196, 200, 204, 208, 212, 215,
+		219, 223, 227, 231, 235, 239, 243, 247, 251, 254, 258, 264, 267, 272, 276, 280,
+		284, 288, 292, 296, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344,
+		348, 352, 356, 360, 364, 368, 372, 377, 381, 385, 389, 393, 397, 401, 405, 409,
+		415, 419, 423, 427, 431, 435, 439, 443, 447, 451, 455, 459, 463, 467, 471, 475,
+		479, 483, 488, 493, 497, 501, 505, 509, 513, 516, 520, 524, 529, 532, 536, 541,
+		546, 549, 553, 557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605,
+		609, 612, 616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668,
+		672, 676, 680, 684, 688, 692, 696, 700, 704, 708, 712, 716, 720, 724, 728, 732,
+		736, 740, 746, 750, 754, 759, 764, 768, 772, 776, 781, 785, 789, 793, 797, 802,
+		806, 810, 816, 820, 823, 826, 830, 834, 838, 842, 846, 850, 855, 859, 862, 865,
+		869, 872, 876, 880, 884, 888, 892, 897, 901, 905, 909, 913, 917, 921, 925, 929,
+		933, 937, 941, 945, 949, 953, 957, 961, 965, 969, 973, 977, 981, 985, 989, 993,
+		997, 1001, 1005, 1009, 1013, 1017, 1021, 1025, 1029, 1033, 0, 4, 8, 12, 16, 20,
+		24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 79, 83,
+		87, 91, 96, 99, 104, 108, 113, 117, 121, 125, 129, 133, 137, 141, 145, 149,
+		153, 157, 161, 165, 169, 173, 179, 182, 186, 190, 194, 198, 202, 208, 212, 216,
+		221, 225, 230, 234, 238, 242, 245, 249, 252, 256, 260, 264, 267, 271, 274, 280,
+		284, 288, 293, 297, 301, 305, 309, 313, 317, 322, 328, 332, 336, 340, 344, 348,
+		352, 356, 360, 364, 368, 372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412,
+		416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476,
+		480, 484, 488, 492, 496, 500, 504, 508, 512, 517, 521, 525, 529, 533, 537, 542,
+		545, 549, 553, 557, 562, 566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606,
+		610, 614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 665, 669,
+		673, 677, 681, 684, 688, 691, 694, 698, 702, 706, 710, 713, 717, 721, 726, 730,
+		734, 738, 741, 744, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791,
+		795, 799, 803, 807, 811, 815, 820, 824, 828, 832, 836, 841, 845, 849, 853, 857,
+		861, 865, 869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921,
+		925, 929, 933, 937, 941, 945, 949, 953, 957, 961, 965, 969, 973, 977, 981, 985,
+		990, 993, 997, 1001, 1006, 1009, 1013, 1017, 1021, 1025, 1029, 0, 4, 8, 12, 16,
+		20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 67, 71, 75, 79,
+		83, 87, 92, 95, 100, 105, 111, 115, 119, 124, 128, 132, 136, 140, 144, 149,
+		153, 156, 159, 162, 164, 168, 172, 176, 179, 183, 187, 191, 195, 198, 203, 207,
+		211, 215, 219, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272,
+		276, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336,
+		340, 344, 348, 352, 356, 360, 364, 367, 371, 375, 379, 384, 388, 392, 397, 401,
+		405, 409, 413, 417, 421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465,
+		469, 473, 476, 481, 485, 489, 493, 497, 500, 504, 508, 512, 515, 518, 522, 526,
+		529, 533, 538, 542, 546, 550, 554, 557, 560, 564, 568, 571, 574, 578, 581, 586,
+		589, 592, 595, 599, 602, 607, 611, 615, 620, 623, 627, 630, 634, 637, 643, 647,
+		651, 655, 661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 701, 705, 709, 713,
+		717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 761, 765, 769, 773, 777,
+		781, 785, 789, 793, 797, 801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 840,
+		843, 848, 852, 856, 860, 864, 868, 872, 876, 880, 884, 888, 892, 896, 900, 904,
+		908, 912, 916, 920, 924, 928, 932, 935, 939, 943, 947, 951, 955, 959, 963, 967,
+		971, 975, 979, 984, 987, 993, 996, 1000, 1004, 1008, 1012, 1016, 0, 4, 7, 10,
+		14, 18, 22, 26, 29, 33, 37, 41, 45, 49, 53, 57, 61, 65, 69, 73,
+		77, 81, 85, 89, 93, 97, 101, 105, 109, 113, 117, 121, 125, 129, 133, 137,
+		141, 145, 149, 153, 159, 164, 168, 172, 176, 179, 184, 188, 192, 196, 200, 204,
+		208, 212, 216, 220, 224, 228, 231, 235, 239, 243, 248, 252, 256, 260, 264, 268,
+		272, 276, 279, 283, 288, 293, 297, 301, 304, 308, 311, 315, 319, 324, 327, 331,
+		335, 339, 344, 348, 351, 355, 359, 363, 367, 372, 375, 380, 384, 388, 392, 396,
+		400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460,
+		464, 468, 472, 476, 480, 485, 489, 493, 497, 501, 505, 509, 513, 517, 521, 525,
+		529, 533, 537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577, 581, 586, 589,
+		592, 596, 600, 604, 608, 613, 618, 622, 626, 630, 637, 641, 646, 650, 654, 658,
+		662, 666, 670, 674, 678, 682, 686, 690, 694, 698, 702, 706, 710, 715, 719, 723,
+		727, 730, 734, 738, 742, 746, 750, 754, 758, 762, 766, 770, 774, 779, 783, 787,
+		791, 795, 799, 804, 809, 814, 819, 824, 829, 832, 836, 840, 844, 849, 853, 856,
+		860, 864, 868, 872, 876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916, 920,
+		924, 929, 933, 937, 941, 945, 949, 953, 957, 961, 965, 969, 973, 976, 980, 985,
+		990, 996, 999, 1003, 1007, 1011, 1015, 1020, 1024, 1028, 1032, 1036, 1040, 0, 4, 8,
+		12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 61, 65, 69, 73,
+		77, 81, 85, 89, 93, 97, 101, 105, 109, 113, 118, 122, 126, 130, 134, 138,
+		142, 146, 150, 154, 158, 162, 166, 170, 174, 178, 182, 187, 191, 195, 199, 203,
+		207, 211, 215, 219, 223, 228, 234, 238, 242, 246, 250, 254, 258, 262, 266, 270,
+		274, 278, 282, 286, 290, 294, 298, 301, 304, 307, 311, 315, 319, 323, 327, 331,
+		335, 339, 343, 347, 351, 356, 360, 364, 368, 372, 376, 380, 384, 388, 393, 397,
+		401, 405, 409, 413, 417, 421, 425, 429, 433, 437, 441, 445, 449, 453, 456, 460,
+		464, 468, 472, 476, 480, 484, 488, 492, 496, 500, 504, 508, 511, 515, 519, 522,
+		525, 529, 533, 537, 541, 545, 549, 552, 555, 558, 562, 565, 568, 572, 576, 579,
+		582, 585, 589, 592, 597, 601, 605, 609, 612, 614, 618, 623, 626, 630, 633, 639,
+		643, 647, 652, 657, 660, 664, 667, 671, 675, 680, 685, 688, 692, 696, 699, 703,
+		708, 713, 718, 722, 726, 729, 733, 737, 741, 746, 750, 754, 758, 762, 766, 770,
+		774, 778, 782, 786, 790, 794, 799, 802, 805, 810, 813, 817, 820, 824, 828, 831,
+		835, 839, 843, 847, 851, 855, 859, 863, 866, 870, 874, 877, 881, 885, 889, 893,
+		897, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946, 950, 954, 958,
+		962, 966, 969, 973, 977, 981, 985, 988, 992, 996, 1000, 1004, 1008, 1012, 0, 4,
+		8, 12, 15, 19, 23, 27, 31, 34, 38, 41, 45, 51, 55, 59, 63, 67,
+		71, 75, 79, 83, 87, 90, 94, 98, 102, 106, 110, 114, 118, 122, 126, 130,
+		135, 138, 142, 146, 150, 154, 158, 162, 166, 169, 173, 177, 181, 185, 189, 193,
+		198, 200, 203, 206, 211, 214, 217, 221, 225, 228, 232, 236, 240, 244, 248, 252,
+		256, 259, 263, 267, 271, 275, 279, 283, 287, 292, 295, 299, 303, 307, 311, 314,
+		318, 322, 326, 330, 334, 338, 342, 346, 350, 354, 358, 362, 366, 371, 375, 379,
+		383, 387, 390, 394, 399, 403, 407, 410, 414, 418, 422, 426, 430, 434, 439, 443,
+		447, 451, 454, 458, 462, 466, 469, 473, 477, 483, 489, 493, 497, 500, 504, 508,
+		512, 516, 520, 524, 528, 532, 535, 539, 543, 547, 551, 554, 558, 562, 566, 571,
+		575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 620, 624, 628, 632, 636,
+		640, 643, 647, 651, 655, 659, 663, 667, 670, 675, 679, 684, 687, 691, 694, 698,
+		702, 706, 710, 714, 716, 720, 724, 728, 732, 736, 740, 744, 748, 752, 756, 760,
+		764, 767, 770, 773, 777, 781, 785, 789, 793, 797, 801, 805, 808, 812, 816, 820,
+		824, 830, 834, 838, 842, 846, 850, 854, 858, 862, 867, 870, 874, 878, 882, 886,
+		890, 894, 897, 900, 904, 909, 912, 916, 920, 924, 928, 932, 936, 940, 944, 948,
+		952, 955, 959, 963, 967, 971, 975, 979, 982, 987, 991, 995, 999, 1004, 1007, 0,
+		5, 9, 13, 17, 21, 25, 29, 33, 37, 42, 46, 50, 54, 57, 61, 65,
+		69, 73, 77, 80, 84, 88, 92, 95, 99, 103, 107, 110, 113, 116, 121, 124,
+		128, 132, 136, 142, 145, 150, 154, 158, 162, 166, 170, 175, 179, 183, 187, 191,
+		195, 199, 202, 208, 212, 216, 220, 224, 228, 232, 235, 239, 242, 246, 251, 255,
+		260, 264, 268, 272, 275, 279, 283, 287, 291, 295, 299, 303, 307, 311, 315, 318,
+		322, 326, 330, 335, 340, 344, 348, 352, 356, 360, 363, 367, 372, 376, 380, 385,
+		388, 392, 397, 401, 405, 409, 413, 418, 422, 425, 429, 432, 436, 441, 445, 448,
+		453, 456, 460, 464, 470, 474, 478, 481, 485, 490, 493, 497, 501, 506, 510, 514,
+		519, 524, 528, 532, 536, 540, 544, 548, 552, 555, 559, 563, 567, 571, 575, 579,
+		584, 588, 592, 596, 600, 604, 608, 612, 616, 620, 624, 628, 632, 636, 640, 644,
+		647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 688, 692, 696, 701, 705, 710,
+		714, 718, 722, 726, 730, 734, 739, 743, 746, 750, 754, 758, 762, 766, 770, 774,
+		777, 781, 785, 789, 793, 797, 801, 805, 808, 813, 817, 821, 825, 829, 832, 836,
+		841, 845, 850, 854, 857, 861, 865, 869, 873, 876, 880, 884, 888, 894, 898, 902,
+		906, 910, 914, 919, 923, 926, 930, 933, 938, 942, 946, 950, 954, 958, 962, 966,
+		970, 973, 977, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 1027, 1031,
+		0, 4, 8, 13, 17, 21, 25, 29, 33, 37, 40, 44, 49, 53, 57, 61,
+		66, 70, 74, 78, 82, 86, 90, 94, 98, 102, 107, 110, 114, 118, 122, 126,
+		130, 133, 137, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 180, 184, 187,
+		191, 195, 199, 203, 207, 210, 214, 217, 220, 225, 230, 236, 241, 244, 247, 251,
+		254, 258, 262, 266, 270, 274, 278, 282, 285, 289, 293, 297, 301, 305, 309, 313,
+		317, 321, 325, 329, 333, 337, 341, 345, 349, 352, 355, 359, 363, 367, 370, 374,
+		378, 381, 386, 390, 394, 397, 401, 405, 409, 413, 417, 421, 425, 429, 433, 437,
+		441, 445, 449, 454, 458, 462, 466, 470, 473, 477, 481, 485, 489, 493, 497, 501,
+		505, 509, 513, 517, 521, 525, 529, 533, 537, 540, 543, 546, 550, 554, 558, 562,
+		567, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606, 610, 614, 618, 622, 626,
+		630, 634, 639, 644, 648, 652, 656, 660, 665, 669, 673, 678, 682, 686, 690, 694,
+		698, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 758,
+		763, 767, 771, 775, 778, 782, 785, 788, 792, 796, 800, 804, 807, 811, 816, 819,
+		824, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865, 868, 872, 876, 880, 884,
+		888, 892, 896, 900, 904, 907, 911, 915, 919, 924, 928, 931, 935, 940, 944, 948,
+		952, 956, 960, 964, 968, 971, 974, 978, 982, 985, 990, 994, 998, 1001, 1005, 1009,
+		1013, 0, 4, 8, 12, 15, 19, 23, 27, 32, 35, 39, 43, 46, 50, 54,
+		58, 62, 66, 70, 74, 78, 82, 86, 90, 94, 98, 102, 106, 109, 114, 118,
+		122, 126, 129, 132, 136, 140, 144, 149, 153, 157, 161, 166, 170, 174, 177, 181,
+		185, 189, 193, 197, 201, 205, 209, 213, 218, 223, 227, 231, 235, 238, 242, 246,
+		250, 254, 257, 261, 265, 269, 273, 277, 281, 285, 289, 293, 297, 301, 305, 309,
+		313, 317, 321, 325, 329, 334, 338, 342, 346, 349, 353, 357, 361, 365, 369, 374,
+		378, 382, 386, 390, 394, 398, 402, 406, 410, 416, 420, 424, 428, 431, 435, 439,
+		443, 447, 451, 455, 459, 463, 467, 471, 474, 478, 482, 486, 489, 492, 496, 501,
+		505, 509, 514, 518, 524, 528, 532, 536, 540, 544, 548, 552, 555, 559, 563, 567,
+		571, 575, 579, 582, 585, 589, 593, 597, 601, 605, 608, 612, 616, 620, 625, 629,
+		633, 637, 641, 645, 648, 652, 656, 660, 664, 668, 672, 676, 680, 684, 688, 692,
+		696, 700, 704, 707, 710, 713, 717, 721, 725, 728, 732, 736, 740, 744, 748, 753,
+		758, 762, 766, 770, 774, 777, 781, 785, 790, 794, 798, 802, 806, 810, 814, 818,
+		822, 826, 830, 834, 838, 842, 846, 850, 854, 858, 862, 866, 870, 874, 878, 882,
+		886, 890, 894, 898, 902, 906, 911, 915, 918, 922, 926, 929, 933, 937, 940, 945,
+		949, 953, 957, 961, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006, 1010,
+		1014, 1018, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52,
+		56, 60, 64, 68, 72, 76, 81, 85, 89, 92, 96, 100, 104, 108, 112, 116,
+		120, 124, 128, 132, 136, 140, 147, 151, 157, 161, 165, 168, 172, 176, 180, 184,
+		188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248,
+		252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312,
+		316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376,
+		380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440,
+		444, 448, 452, 456, 459, 463, 467, 471, 475, 479, 483, 487, 491, 495, 499, 503,
+		507, 511, 515, 519, 523, 527, 531, 535, 539, 545, 549, 554, 558, 561, 565, 569,
+		576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616, 619, 623, 627, 631, 635,
+		638, 642, 646, 649, 653, 657, 662, 666, 670, 674, 678, 682, 686, 690, 693, 697,
+		701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 761,
+		765, 769, 773, 777, 781, 785, 789, 793, 797, 801, 805, 809, 813, 817, 821, 825,
+		829, 833, 837, 841, 845, 849, 853, 857, 861, 865, 869, 873, 877, 881, 885, 889,
+		893, 897, 901, 905, 911, 915, 919, 923, 928, 932, 936, 940, 943, 948, 951, 955,
+		959, 963, 967, 971, 975, 979, 983, 987, 991, 994, 998, 1002, 1006, 1010, 1014, 1018,
+		1022, 1026, 1030, 0, 4, 8, 12, 15, 19, 23, 26, 31, 35, 38, 42, 47,
+		51, 55, 60, 63, 66, 70, 73, 77, 81, 85, 89, 93, 96, 100, 104, 109,
+		113, 118, 122, 126, 130, 134, 138, 142, 146, 150, 154, 158, 162, 166, 170, 174,
+		178, 182, 186, 190, 194, 198, 202, 206, 210, 214, 218, 222, 226, 230, 234, 238,
+		242, 246, 250, 254, 258, 262, 266, 270, 274, 278, 282, 285, 289, 293, 297, 300,
+		304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 359, 363,
+		366, 371, 377, 381, 386, 390, 394, 398, 402, 407, 411, 415, 419, 423, 427, 431,
+		434, 438, 441, 445, 449, 453, 456, 460, 464, 467, 472, 476, 480, 484, 488, 492,
+		496, 500, 504, 508, 511, 515, 519, 523, 527, 531, 535, 539, 543, 547, 551, 555,
+		559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 619,
+		623, 627, 631, 635, 639, 643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683,
+		689, 693, 697, 701, 706, 710, 714, 719, 723, 727, 731, 735, 739, 743, 747, 751,
+		756, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816,
+		820, 825, 829, 833, 836, 840, 845, 849, 853, 856, 860, 864, 869, 873, 877, 884,
+		887, 891, 895, 900, 904, 909, 914, 918, 922, 926, 930, 934, 938, 942, 946, 950,
+		954, 958, 962, 966, 970, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015,
+		1019, 1023, 1027, 1031, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44,
+		48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108,
+		112, 116, 120, 124, 127, 131, 135, 139, 143, 147, 151, 154, 160, 164, 168, 172,
+		176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236,
+		240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 291, 294, 298,
+		302, 306, 309, 313, 317, 321, 325, 329, 333, 337, 340, 345, 349, 353, 357, 361,
+		365, 369, 374, 378, 383, 387, 390, 394, 398, 401, 404, 409, 413, 418, 422, 426,
+		431, 435, 440, 444, 448, 452, 456, 460, 465, 469, 473, 477, 480, 484, 488, 492,
+		496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556,
+		560, 564, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613, 617, 621,
+		625, 629, 633, 637, 641, 645, 649, 653, 657, 660, 664, 670, 674, 678, 682, 686,
+		690, 694, 698, 702, 706, 710, 714, 718, 722, 726, 730, 734, 738, 742, 746, 750,
+		754, 758, 762, 766, 770, 774, 778, 782, 786, 790, 795, 798, 803, 806, 809, 814,
+		818, 821, 825, 830, 834, 837, 841, 845, 849, 853, 857, 861, 865, 869, 873, 877,
+		881, 885, 890, 893, 897, 901, 905, 909, 914, 918, 922, 926, 930, 934, 938, 942,
+		946, 950, 954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006,
+		1010, 1014, 1018, 1022, 1026, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40,
+		44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104,
+		108, 112, 116, 119, 123, 127, 131, 135, 139, 144, 148, 152, 155, 159, 163, 168,
+		171, 176, 180, 184, 188, 192, 196, 200, 205, 209, 213, 217, 221, 225, 229, 233,
+		237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 277, 281, 285, 289, 293, 297,
+		301, 305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357, 361,
+		365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 410, 415, 418, 422, 426,
+		430, 435, 439, 443, 446, 450, 453, 457, 461, 465, 469, 472, 476, 480, 484, 488,
+		492, 496, 499, 504, 508, 512, 515, 519, 523, 526, 530, 534, 539, 543, 547, 551,
+		555, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615,
+		619, 623, 627, 631, 635, 639, 643, 647, 651, 655, 661, 665, 669, 673, 677, 681,
+		685, 689, 693, 697, 701, 705, 709, 713, 718, 722, 726, 730, 734, 736, 740, 744,
+		748, 752, 756, 760, 764, 769, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808,
+		812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 868, 872,
+		876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 932, 937,
+		940, 945, 949, 954, 959, 963, 967, 971, 975, 979, 983, 986, 991, 994, 998, 1002,
+		1006, 1010, 1015, 1019, 1023, 1027, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36,
+		40, 44, 48, 52, 56, 59, 64, 68, 72, 77, 82, 85, 89, 92, 96, 100,
+		104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 151, 155, 159, 163,
+		167, 171, 175, 178, 182, 186, 190, 194, 198, 202, 206, 210, 214, 218, 222, 226,
+		230, 235, 239, 243, 246, 250, 254, 258, 262, 266, 270, 274, 278, 282, 286, 290,
+		294, 298, 302, 306, 310, 314, 318, 323, 328, 332, 336, 340, 344, 348, 352, 356,
+		360, 364, 368, 372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420,
+		424, 428, 432, 436, 440, 444, 448, 452, 455, 461, 465, 469, 473, 477, 481, 485,
+		488, 492, 496, 500, 504, 508, 512, 517, 523, 527, 531, 535, 539, 543, 547, 551,
+		555, 559, 563, 567, 571, 575, 578, 582, 586, 590, 594, 598, 602, 606, 610, 614,
+		619, 623, 627, 631, 634, 637, 640, 644, 647, 651, 655, 659, 663, 667, 671, 675,
+		679, 683, 687, 691, 695, 698, 702, 706, 710, 714, 718, 724, 727, 731, 735, 739,
+		743, 747, 751, 755, 759, 762, 766, 770, 774, 778, 781, 786, 790, 794, 798, 802,
+		806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850, 854, 858, 862, 866,
+		870, 874, 878, 882, 886, 889, 893, 896, 900, 904, 908, 912, 916, 920, 924, 928,
+		932, 936, 940, 944, 949, 953, 956, 959, 963, 967, 970, 973, 977, 981, 985, 989,
+		993, 997, 1001, 1005, 1009, 1014, 1018, 0, 4, 8, 12, 16, 20, 24, 28, 32,
+		36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96,
+		100, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 150, 154, 157,
+		161, 165, 169, 173, 177, 181, 185, 189, 193, 197, 201, 205, 209, 213, 217, 222,
+		226, 230, 234, 238, 242, 246, 250, 254, 258, 262, 266, 270, 274, 278, 282, 286,
+		290, 294, 298, 302, 307, 311, 316, 320, 325, 329, 333, 337, 341, 345, 349, 353,
+		357, 361, 365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 412, 417,
+		420, 424, 430, 434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 478, 482,
+		486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 526, 530, 534, 538, 542, 546,
+		550, 554, 558, 562, 566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606, 610,
+		614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674,
+		678, 682, 686, 690, 694, 698, 702, 706, 710, 714, 718, 722, 726, 729, 732, 736,
+		740, 744, 748, 752, 757, 762, 766, 770, 774, 778, 781, 785, 789, 793, 797, 801,
+		805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865,
+		869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 925, 929,
+		933, 937, 941, 945, 949, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988, 992,
+		996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 0, 4, 8, 11, 15, 19, 23, 27,
+		33, 37, 41, 45, 49, 54, 57, 61, 66, 70, 74, 78, 82, 86, 90, 94,
+		98, 102, 106, 110, 114, 118, 122, 126, 130, 134, 138, 142, 146, 150, 154, 158,
+		162, 166, 170, 174, 178, 182, 186, 190, 194, 198, 202, 206, 210, 214, 218, 222,
+		225, 229, 233, 237, 241, 245, 249, 252, 255, 261, 265, 269, 273, 277, 281, 285,
+		289, 293, 297, 301, 305, 309, 313, 317, 321, 325, 329, 334, 338, 342, 346, 351,
+		355, 359, 363, 367, 371, 375, 379, 383, 387, 390, 394, 398, 402, 408, 412, 416,
+		420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480,
+		484, 488, 492, 496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544,
+		548, 552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608,
+		611, 617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 659, 663, 667, 671,
+		675, 679, 683, 687, 691, 695, 699, 703, 708, 712, 717, 720, 724, 728, 732, 736,
+		740, 744, 748, 752, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801,
+		805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865,
+		870, 874, 878, 882, 886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930,
+		934, 938, 942, 946, 950, 954, 958, 962, 966, 970, 974, 979, 983, 987, 991, 994,
+		998, 1003, 1007, 1011, 1015, 1019, 1024, 1028, 1032, 0, 3, 7, 11, 15, 19, 23,
+		27, 31, 35, 39, 43, 47, 51, 55, 59, 63, 67, 71, 75, 79, 83, 87,
+		91, 95, 99, 102, 106, 110, 114, 118, 122, 126, 130, 134, 138, 143, 147, 151,
+		155, 159, 163, 167, 171, 176, 180, 184, 188, 192, 196, 200, 205, 209, 213, 217,
+		221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 274, 277, 281,
+		285, 289, 293, 297, 301, 305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345,
+		349, 354, 358, 362, 366, 370, 374, 378, 382, 386, 390, 394, 398, 402, 406, 410,
+		414, 418, 422, 426, 430, 434, 438, 442, 446, 450, 454, 458, 462, 467, 471, 475,
+		479, 482, 486, 489, 493, 497, 501, 505, 509, 514, 518, 522, 526, 530, 534, 538,
+		542, 546, 550, 554, 558, 562, 566, 570, 574, 578, 582, 586, 590, 594, 597, 601,
+		605, 609, 613, 617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665,
+		670, 674, 678, 682, 686, 690, 694, 698, 702, 706, 710, 714, 718, 722, 726, 730,
+		734, 738, 742, 746, 750, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793,
+		797, 801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 840, 844, 848, 851, 855,
+		859, 863, 867, 871, 874, 878, 882, 886, 890, 894, 898, 902, 906, 910, 915, 919,
+		924, 928, 932, 936, 940, 944, 948, 952, 956, 962, 966, 970, 974, 978, 982, 985,
+		989, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 0, 3, 7, 11, 15, 19,
+		23, 27, 31, 35, 39, 43, 46, 50, 54, 58, 62, 66, 70, 74, 78, 83,
+		87, 91, 95, 99, 104, 110, 114, 118, 122, 126, 130, 134, 138, 142, 146, 150,
+		154, 158, 162, 165, 169, 173, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215,
+		219, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 266, 270, 274, 278, 284,
+		288, 292, 296, 300, 304, 309, 313, 317, 321, 325, 329, 333, 337, 340, 344, 348,
+		352, 356, 360, 363, 367, 371, 375, 379, 384, 388, 392, 396, 400, 405, 409, 413,
+		417, 422, 426, 430, 434, 438, 442, 446, 452, 456, 462, 466, 470, 474, 478, 482,
+		486, 490, 495, 499, 503, 507, 511, 515, 521, 525, 529, 534, 538, 542, 546, 550,
+		554, 559, 562, 566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606, 609, 613,
+		617, 623, 626, 630, 634, 638, 644, 648, 652, 656, 660, 664, 668, 671, 675, 679,
+		683, 688, 692, 696, 700, 703, 707, 710, 714, 718, 722, 726, 729, 733, 738, 742,
+		745, 749, 753, 757, 761, 765, 769, 772, 778, 782, 785, 789, 792, 796, 799, 803,
+		807, 811, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860, 866, 870,
+		874, 878, 882, 885, 889, 892, 897, 901, 905, 910, 914, 918, 922, 925, 929, 933,
+		937, 941, 945, 949, 953, 957, 960, 963, 967, 971, 975, 979, 983, 987, 990, 994,
+		997, 1001, 1005, 1009, 1013, 1016, 1021, 1025, 1029, 1034, 1038, 0, 4, 8, 12, 15,
+		19, 22, 25, 30, 34, 38, 41, 45, 48, 52, 56, 60, 64, 68, 72, 76,
+		79, 83, 88, 92, 96, 100, 104, 109, 113, 117, 121, 125, 130, 134, 137, 142,
+		145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 185, 189, 193, 197, 204, 208,
+		212, 215, 220, 224, 228, 231, 236, 241, 245, 249, 254, 258, 262, 266, 270, 274,
+		278, 282, 286, 290, 294, 298, 302, 306, 309, 313, 317, 320, 324, 328, 332, 336,
+		341, 345, 349, 353, 358, 362, 366, 369, 373, 379, 382, 386, 390, 395, 399, 403,
+		406, 410, 414, 418, 422, 426, 430, 434, 438, 441, 445, 449, 452, 456, 460, 464,
+		469, 474, 478, 482, 486, 490, 494, 498, 502, 506, 511, 515, 519, 523, 527, 531,
+		535, 538, 542, 546, 550, 553, 557, 561, 565, 570, 573, 579, 583, 587, 591, 594,
+		599, 603, 607, 611, 615, 619, 622, 626, 630, 636, 640, 644, 648, 651, 654, 660,
+		664, 668, 672, 676, 681, 685, 688, 693, 698, 702, 706, 710, 715, 718, 722, 726,
+		730, 733, 737, 741, 745, 749, 753, 757, 761, 764, 768, 772, 776, 780, 784, 788,
+		791, 795, 799, 803, 807, 811, 814, 818, 822, 826, 830, 833, 836, 841, 845, 849,
+		853, 857, 861, 864, 869, 874, 879, 883, 889, 892, 896, 900, 904, 908, 913, 916,
+		920, 926, 930, 934, 938, 942, 948, 952, 955, 959, 963, 967, 971, 975, 979, 983,
+		987, 991, 995, 998, 1002, 1006, 1010, 1015, 1019, 1023, 1027, 1031, 0, 4, 7, 11,
+		15, 20, 24, 28, 33, 37, 42, 45, 49, 53, 58, 62, 66, 70, 74, 78,
+		82, 85, 88, 92, 96, 100, 105, 109, 113, 116, 119, 123, 127, 131, 135, 139,
+		143, 147, 151, 155, 158, 162, 166, 170, 174, 178, 182, 186, 190, 195, 198, 202,
+		206, 210, 214, 218, 221, 225, 228, 232, 235, 241, 245, 248, 252, 256, 260, 263,
+		267, 271, 277, 281, 284, 288, 291, 295, 299, 303, 306, 310, 314, 318, 322, 325,
+		329, 332, 335, 339, 343, 346, 350, 355, 359, 363, 367, 371, 374, 378, 382, 385,
+		389, 393, 397, 401, 405, 409, 413, 417, 421, 424, 428, 431, 434, 437, 440, 444,
+		447, 451, 455, 459, 465, 468, 472, 476, 479, 483, 486, 490, 496, 500, 504, 508,
+		512, 516, 520, 524, 527, 530, 534, 538, 542, 546, 550, 554, 558, 562, 566, 570,
+		574, 578, 582, 586, 591, 595, 599, 604, 609, 613, 617, 620, 624, 628, 632, 637,
+		641, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682, 687, 691, 695, 699, 702,
+		706, 710, 714, 718, 722, 726, 730, 734, 738, 742, 746, 750, 753, 757, 761, 765,
+		769, 773, 778, 782, 786, 790, 793, 797, 801, 805, 809, 813, 817, 821, 824, 828,
+		831, 835, 839, 843, 847, 850, 854, 858, 862, 866, 870, 875, 879, 882, 886, 890,
+		895, 899, 902, 906, 909, 913, 917, 920, 924, 928, 932, 936, 940, 944, 948, 952,
+		956, 960, 964, 968, 972, 977, 981, 985, 989, 994, 998, 1001, 1006, 0, 3, 7,
+		11, 15, 19, 23, 27, 30, 34, 38, 42, 46, 51, 55, 58, 62, 65, 69,
+		73, 76, 80, 84, 89, 93, 97, 101, 104, 110, 114, 118, 122, 125, 131, 135,
+		139, 143, 147, 151, 155, 159, 163, 167, 170, 173, 177, 181, 185, 190, 194, 198,
+		202, 206, 210, 214, 219, 223, 227, 231, 234, 238, 242, 245, 249, 254, 258, 262,
+		266, 269, 273, 277, 282, 286, 290, 294, 298, 302, 306, 310, 314, 318, 322, 326,
+		330, 333, 338, 342, 346, 350, 354, 358, 362, 366, 370, 373, 377, 382, 386, 389,
+		393, 396, 400, 404, 408, 412, 416, 420, 424, 428, 431, 436, 440, 445, 449, 453,
+		457, 462, 466, 470, 473, 477, 481, 485, 489, 493, 497, 501, 503, 506, 509, 514,
+		517, 521, 527, 531, 535, 539, 543, 547, 550, 554, 558, 562, 567, 571, 576, 580,
+		585, 588, 592, 596, 600, 604, 607, 611, 615, 619, 623, 627, 631, 634, 638, 642,
+		646, 649, 653, 657, 661, 665, 669, 673, 677, 681, 685, 689, 694, 698, 702, 706,
+		710, 714, 719, 722, 728, 732, 736, 740, 744, 748, 752, 756, 760, 764, 768, 772,
+		776, 779, 783, 787, 792, 796, 800, 804, 808, 812, 817, 821, 826, 830, 834, 838,
+		842, 846, 850, 854, 858, 862, 867, 871, 875, 879, 883, 887, 892, 896, 901, 905,
+		909, 913, 917, 921, 925, 929, 932, 936, 940, 944, 947, 951, 954, 958, 961, 965,
+		969, 973, 977, 980, 984, 988, 991, 994, 998, 1001, 1005, 1009, 1013, 1017, 0, 4,
+		8, 12, 16, 20, 24, 28, 32, 36, 40, 43, 46, 50, 54, 58, 62, 65,
+		71, 74, 78, 82, 86, 90, 94, 98, 102, 106, 110, 114, 118, 122, 126, 130,
+		134, 138, 142, 146, 150, 155, 159, 163, 167, 171, 175, 179, 183, 188, 192, 196,
+		199, 204, 208, 212, 216, 222, 226, 230, 234, 237, 241, 245, 249, 253, 257, 261,
+		265, 269, 273, 277, 281, 285, 289, 293, 297, 301, 305, 309, 313, 317, 321, 325,
+		329, 332, 336, 339, 343, 347, 351, 355, 359, 363, 367, 371, 375, 379, 383, 387,
+		391, 395, 399, 403, 407, 411, 415, 419, 423, 427, 431, 435, 439, 443, 447, 451,
+		455, 459, 463, 467, 471, 475, 480, 484, 488, 492, 496, 500, 503, 507, 510, 513,
+		517, 521, 525, 529, 533, 537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577,
+		581, 585, 589, 593, 597, 601, 605, 609, 613, 617, 621, 625, 629, 633, 637, 641,
+		645, 649, 653, 656, 660, 664, 668, 672, 676, 681, 685, 689, 693, 697, 701, 705,
+		709, 713, 717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 761, 765, 769,
+		773, 777, 781, 785, 789, 793, 797, 801, 805, 809, 813, 817, 821, 825, 829, 833,
+		837, 841, 845, 849, 852, 856, 860, 865, 868, 873, 876, 880, 884, 888, 892, 897,
+		901, 905, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946, 951, 955, 959, 963,
+		967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 0,
+		4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 55, 58, 62,
+		65, 68, 72, 76, 80, 84, 87, 91, 95, 98, 102, 106, 110, 114, 118, 122,
+		126, 130, 134, 138, 142, 146, 150, 154, 158, 162, 166, 170, 174, 178, 182, 186,
+		190, 194, 198, 202, 206, 210, 215, 219, 223, 227, 231, 236, 240, 244, 248, 252,
+		256, 260, 264, 268, 274, 278, 282, 286, 290, 294, 298, 302, 306, 310, 314, 318,
+		322, 326, 330, 334, 338, 342, 346, 350, 354, 358, 362, 366, 370, 374, 378, 382,
+		386, 390, 394, 398, 402, 406, 410, 414, 418, 422, 428, 432, 436, 440, 443, 447,
+		451, 456, 459, 463, 467, 471, 475, 479, 483, 487, 491, 495, 499, 504, 508, 512,
+		516, 520, 524, 528, 530, 534, 538, 542, 546, 550, 554, 559, 562, 566, 570, 574,
+		578, 582, 586, 590, 594, 598, 602, 606, 610, 614, 618, 622, 626, 630, 634, 638,
+		642, 648, 652, 656, 660, 664, 668, 672, 676, 680, 686, 690, 694, 698, 703, 707,
+		711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751, 755, 759, 763, 767, 771,
+		775, 779, 783, 787, 791, 795, 799, 803, 807, 811, 815, 819, 823, 827, 831, 835,
+		839, 843, 847, 851, 855, 859, 863, 867, 871, 875, 879, 883, 887, 890, 895, 899,
+		903, 907, 911, 915, 919, 923, 927, 931, 935, 940, 944, 948, 953, 957, 961, 965,
+		969, 973, 978, 982, 986, 990, 994, 999, 1003, 1006, 1010, 1013, 1017, 1021, 1025, 1029,
+		0, 4, 8, 11, 15, 19, 23, 27, 31, 35, 39, 43, 47, 52, 56, 60,
+		64, 69, 73, 77, 82, 86, 89, 93, 97, 100, 104, 108, 111, 115, 119, 123,
+		127, 132, 136, 140, 144, 148, 153, 156, 159, 163, 166, 169, 173, 177, 180, 184,
+		188, 192, 195, 199, 204, 208, 211, 215, 218, 222, 226, 230, 234, 238, 242, 246,
+		249, 253, 257, 261, 265, 269, 273, 277, 281, 286, 292, 296, 300, 303, 307, 311,
+		315, 319, 322, 326, 330, 335, 339, 343, 347, 351, 355, 359, 364, 367, 371, 375,
+		378, 381, 385, 389, 393, 397, 401, 405, 409, 413, 418, 422, 427, 433, 437, 441,
+		445, 449, 452, 456, 460, 463, 467, 471, 475, 479, 483, 487, 490, 492, 495, 499,
+		503, 507, 511, 515, 519, 523, 527, 530, 534, 538, 542, 547, 551, 555, 561, 565,
+		570, 574, 578, 582, 586, 590, 594, 598, 602, 607, 610, 614, 618, 623, 627, 631,
+		635, 639, 643, 647, 651, 655, 659, 663, 666, 670, 674, 678, 682, 686, 691, 695,
+		699, 702, 706, 710, 716, 720, 724, 728, 732, 736, 740, 744, 748, 752, 756, 760,
+		764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816, 820, 824,
+		828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 868, 874, 878, 882, 886, 890,
+		894, 898, 904, 908, 912, 916, 921, 925, 929, 933, 937, 941, 945, 949, 953, 957,
+		961, 965, 969, 973, 977, 981, 985, 989, 995, 999, 1003, 1007, 1012, 1016, 1020, 1024,
+		1028, 0, 4, 8, 13, 17, 21, 27, 31, 35, 39, 43, 47, 51, 57, 61,
+		65, 69, 73, 76, 80, 84, 88, 92, 96, 100, 104, 107, 110, 114, 118, 123,
+		127, 132, 136, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 186, 190,
+		194, 198, 202, 206, 210, 215, 219, 223, 227, 231, 235, 239, 243, 247, 251, 255,
+		259, 263, 267, 271, 275, 279, 283, 286, 289, 294, 298, 302, 306, 310, 313, 316,
+		320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 377, 382,
+		386, 390, 395, 399, 403, 407, 411, 415, 419, 423, 428, 433, 437, 441, 445, 449,
+		453, 457, 461, 465, 469, 473, 478, 483, 487, 491, 494, 499, 503, 507, 511, 514,
+		518, 522, 526, 530, 534, 538, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578,
+		582, 586, 590, 594, 598, 602, 606, 610, 615, 619, 623, 627, 632, 636, 640, 644,
+		648, 652, 656, 660, 664, 668, 672, 676, 680, 684, 689, 693, 697, 701, 706, 710,
+		714, 718, 722, 726, 730, 734, 738, 742, 747, 751, 754, 758, 761, 765, 769, 773,
+		777, 781, 785, 789, 792, 795, 799, 803, 807, 811, 817, 821, 826, 830, 834, 838,
+		841, 845, 849, 853, 857, 860, 864, 867, 871, 875, 880, 884, 888, 892, 896, 901,
+		905, 909, 914, 918, 922, 926, 929, 932, 936, 940, 944, 948, 951, 955, 959, 965,
+		969, 973, 977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025, 1029,
+		1033, 1037, 0, 6, 10, 14, 18, 22, 26, 30, 34, 38, 42, 46, 50, 54,
+		58, 62, 66, 70, 74, 78, 81, 85, 89, 93, 97, 101, 105, 109, 113, 117,
+		121, 125, 129, 133, 137, 141, 144, 148, 152, 156, 159, 163, 167, 171, 175, 179,
+		183, 187, 192, 196, 199, 203, 207, 211, 215, 219, 223, 227, 231, 235, 239, 243,
+		247, 251, 256, 259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 298, 302, 306,
+		310, 313, 317, 320, 324, 328, 332, 336, 340, 344, 348, 351, 355, 359, 363, 367,
+		370, 374, 378, 383, 387, 391, 395, 399, 403, 407, 411, 415, 419, 423, 427, 431,
+		434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 479, 483, 487, 492, 498,
+		502, 506, 510, 515, 519, 523, 527, 531, 535, 539, 543, 546, 550, 554, 557, 561,
+		565, 569, 573, 577, 581, 585, 589, 593, 597, 600, 604, 607, 611, 615, 618, 625,
+		629, 633, 637, 641, 645, 649, 653, 658, 662, 666, 670, 674, 678, 682, 686, 690,
+		694, 697, 701, 704, 710, 715, 720, 725, 729, 733, 737, 741, 745, 749, 753, 757,
+		761, 765, 769, 774, 778, 782, 787, 792, 795, 800, 803, 806, 809, 813, 817, 822,
+		825, 829, 833, 837, 841, 844, 848, 852, 856, 861, 865, 869, 872, 876, 880, 884,
+		888, 892, 895, 899, 902, 905, 909, 914, 918, 923, 927, 931, 935, 939, 943, 947,
+		951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1013,
+		1017, 1021, 1024, 0, 3, 7, 11, 15, 19, 23, 27, 31, 35, 39, 43, 47,
+		51, 55, 58, 61, 65, 68, 71, 75, 79, 83, 86, 89, 93, 97, 101, 105,
+		109, 113, 117, 121, 125, 130, 134, 138, 142, 146, 151, 155, 159, 163, 167, 171,
+		177, 181, 184, 189, 193, 195, 201, 204, 208, 211, 215, 219, 222, 226, 230, 233,
+		236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 279, 282, 286, 289, 293,
+		297, 301, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 357,
+		362, 365, 369, 373, 377, 381, 385, 388, 393, 398, 401, 406, 411, 415, 418, 422,
+		426, 430, 434, 438, 442, 446, 450, 454, 457, 461, 465, 469, 472, 476, 480, 484,
+		488, 492, 496, 500, 506, 510, 514, 519, 525, 530, 534, 539, 543, 547, 550, 554,
+		559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 602, 606, 610, 614, 618,
+		622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674, 679, 683,
+		687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 730, 734, 738, 742, 747,
+		750, 754, 757, 761, 765, 769, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808,
+		811, 815, 819, 823, 827, 831, 835, 839, 843, 847, 850, 854, 858, 862, 866, 870,
+		874, 879, 883, 887, 891, 895, 898, 902, 905, 909, 914, 918, 922, 926, 930, 934,
+		938, 942, 946, 950, 954, 957, 961, 965, 969, 973, 978, 982, 986, 990, 994, 998,
+		1002, 1006, 1010, 1014, 0, 3, 8, 13, 18, 22, 25, 29, 33, 37, 41, 45,
+		50, 53, 58, 62, 67, 72, 76, 80, 84, 88, 92, 96, 100, 104, 109, 112,
+		116, 119, 123, 127, 131, 135, 139, 144, 148, 152, 156, 160, 164, 168, 172, 176,
+		180, 184, 188, 192, 196, 200, 204, 208, 211, 215, 219, 224, 228, 232, 236, 240,
+		244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 285, 289, 293, 297, 300, 303,
+		307, 310, 314, 318, 322, 326, 330, 334, 337, 341, 346, 350, 354, 358, 362, 366,
+		370, 374, 378, 382, 386, 390, 394, 398, 404, 408, 412, 416, 420, 424, 428, 432,
+		436, 440, 444, 448, 453, 457, 460, 464, 469, 473, 477, 481, 485, 489, 493, 496,
+		500, 504, 508, 512, 517, 520, 524, 528, 532, 536, 539, 543, 547, 551, 556, 561,
+		565, 569, 573, 577, 581, 585, 591, 595, 599, 603, 607, 611, 615, 619, 623, 627,
+		632, 636, 639, 643, 647, 650, 654, 659, 662, 666, 671, 676, 680, 684, 688, 692,
+		696, 701, 706, 710, 714, 718, 722, 727, 731, 735, 739, 743, 747, 751, 755, 758,
+		762, 766, 770, 774, 777, 781, 785, 789, 793, 797, 802, 808, 811, 815, 819, 823,
+		827, 831, 835, 838, 842, 845, 848, 852, 855, 859, 863, 866, 869, 874, 878, 881,
+		885, 889, 893, 897, 901, 904, 908, 912, 918, 922, 926, 930, 934, 939, 943, 947,
+		952, 956, 959, 963, 967, 971, 975, 978, 982, 985, 989, 994, 999, 1003, 1007, 1011,
+		1015, 1021, 1024, 1028, 1032, 0, 4, 7, 12, 16, 20, 24, 28, 32, 36, 40,
+		44, 48, 52, 56, 60, 63, 67, 71, 75, 79, 82, 86, 89, 93, 96, 101,
+		105, 109, 113, 117, 121, 125, 129, 133, 137, 141, 145, 148, 152, 156, 160, 164,
+		168, 172, 176, 180, 184, 188, 191, 197, 200, 204, 208, 211, 215, 219, 223, 228,
+		232, 235, 239, 243, 249, 253, 257, 261, 265, 270, 274, 278, 282, 286, 290, 294,
+		298, 302, 306, 310, 315, 319, 323, 326, 330, 334, 338, 342, 346, 350, 354, 358,
+		362, 366, 370, 374, 378, 381, 386, 389, 394, 398, 402, 406, 410, 414, 418, 422,
+		426, 430, 434, 438, 443, 447, 451, 455, 459, 463, 467, 473, 477, 481, 486, 490,
+		494, 498, 502, 506, 510, 514, 518, 522, 527, 530, 534, 538, 542, 546, 550, 554,
+		558, 561, 565, 569, 573, 577, 581, 585, 590, 593, 597, 601, 605, 609, 613, 617,
+		621, 625, 629, 633, 637, 641, 645, 649, 652, 656, 659, 663, 667, 671, 676, 680,
+		684, 688, 692, 696, 700, 704, 707, 711, 715, 719, 723, 727, 731, 735, 740, 744,
+		748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808,
+		812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 851, 854, 858, 862, 866, 870,
+		874, 878, 882, 886, 890, 895, 898, 902, 906, 910, 915, 919, 923, 928, 931, 935,
+		940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 982, 986, 990, 994, 997, 1003,
+		1007, 1011, 1015, 1020, 1023, 1027, 0, 4, 9, 13, 17, 21, 25, 29, 33, 36,
+		41, 45, 48, 52, 56, 60, 64, 68, 71, 75, 78, 82, 85, 88, 91, 96,
+		100, 104, 107, 110, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 160,
+		164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 211, 214, 217, 221,
+		225, 229, 232, 236, 239, 243, 246, 250, 254, 258, 262, 265, 269, 273, 277, 281,
+		285, 289, 293, 297, 301, 305, 309, 313, 318, 322, 325, 329, 333, 337, 340, 345,
+		349, 353, 357, 361, 365, 369, 374, 378, 381, 385, 389, 393, 397, 401, 405, 410,
+		414, 419, 423, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468, 473, 478,
+		482, 485, 491, 495, 499, 503, 507, 511, 515, 519, 523, 527, 533, 537, 541, 545,
+		548, 552, 556, 560, 564, 568, 572, 576, 581, 585, 589, 594, 598, 602, 606, 610,
+		615, 620, 623, 628, 632, 637, 641, 645, 649, 653, 658, 662, 666, 670, 674, 678,
+		682, 686, 690, 694, 698, 702, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741,
+		745, 749, 753, 757, 761, 765, 769, 773, 777, 781, 785, 788, 792, 795, 799, 803,
+		809, 813, 817, 821, 826, 829, 832, 836, 841, 845, 850, 856, 860, 864, 868, 872,
+		876, 879, 882, 887, 891, 896, 901, 904, 908, 913, 917, 921, 925, 929, 933, 937,
+		941, 945, 949, 953, 957, 961, 965, 968, 972, 976, 980, 984, 988, 992, 996, 1000,
+		1004, 1008, 1012, 1016, 1020, 1024, 1027, 0, 4, 8, 11, 15, 22, 26, 30, 35,
+		38, 43, 47, 50, 54, 58, 62, 68, 72, 76, 81, 85, 89, 92, 96, 99,
+		105, 108, 113, 116, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 163,
+		167, 171, 175, 179, 182, 186, 191, 195, 199, 203, 207, 211, 215, 219, 223, 226,
+		230, 234, 238, 241, 245, 248, 252, 257, 262, 265, 269, 273, 277, 280, 283, 287,
+		290, 294, 297, 302, 305, 309, 313, 317, 320, 323, 328, 332, 337, 342, 346, 350,
+		353, 356, 360, 364, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413,
+		417, 421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 464, 467, 470, 473,
+		478, 482, 486, 489, 494, 499, 504, 507, 512, 516, 520, 524, 528, 532, 536, 541,
+		544, 547, 550, 554, 558, 562, 566, 570, 574, 578, 583, 588, 592, 596, 600, 604,
+		608, 612, 616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668,
+		672, 676, 680, 685, 688, 692, 696, 700, 704, 708, 712, 716, 720, 724, 729, 733,
+		736, 740, 744, 748, 754, 758, 764, 769, 773, 776, 780, 784, 788, 792, 796, 800,
+		804, 808, 812, 816, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855, 859, 863,
+		867, 869, 873, 877, 881, 886, 890, 893, 897, 902, 906, 910, 916, 919, 923, 927,
+		931, 935, 939, 943, 947, 951, 956, 960, 964, 968, 972, 976, 980, 984, 988, 992,
+		996, 1000, 1004, 1008, 1012, 1016, 1020, 1023, 0, 4, 7, 12, 17, 20, 23, 28,
+		32, 37, 41, 45, 49, 53, 57, 61, 66, 70, 74, 78, 82, 86, 90, 94,
+		98, 103, 107, 111, 114, 118, 122, 127, 130, 134, 138, 142, 146, 149, 153, 157,
+		161, 165, 168, 172, 176, 180, 185, 188, 193, 197, 202, 206, 210, 214, 218, 222,
+		226, 230, 234, 238, 242, 247, 252, 257, 260, 265, 269, 273, 277, 281, 286, 290,
+		294, 298, 302, 306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 346, 350, 354,
+		358, 362, 366, 371, 375, 379, 383, 386, 389, 393, 397, 401, 405, 409, 413, 417,
+		421, 425, 429, 434, 439, 444, 448, 453, 457, 461, 464, 467, 471, 474, 478, 482,
+		487, 491, 496, 501, 505, 510, 514, 518, 522, 527, 531, 536, 539, 543, 548, 552,
+		556, 559, 563, 567, 572, 576, 579, 583, 587, 590, 594, 601, 604, 607, 610, 613,
+		616, 621, 625, 629, 633, 638, 643, 647, 651, 655, 659, 664, 669, 674, 678, 682,
+		686, 690, 694, 698, 702, 706, 710, 713, 717, 720, 723, 727, 731, 735, 739, 743,
+		747, 751, 755, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808,
+		813, 818, 822, 826, 830, 834, 838, 843, 847, 851, 855, 858, 862, 866, 871, 876,
+		880, 884, 888, 892, 896, 899, 902, 906, 910, 913, 917, 921, 925, 929, 934, 938,
+		942, 947, 951, 955, 961, 965, 969, 972, 976, 980, 984, 988, 992, 996, 1000, 1004,
+		1008, 1012, 1017, 1021, 1025, 1029, 1033, 1037, 1041, 0, 3, 6, 9, 13, 17, 21,
+		25, 29, 33, 37, 42, 46, 50, 54, 58, 62, 66, 71, 75, 80, 84, 87,
+		91, 95, 99, 102, 105, 109, 113, 117, 122, 127, 131, 135, 139, 143, 147, 151,
+		155, 159, 163, 167, 171, 175, 178, 182, 186, 190, 194, 198, 202, 206, 210, 214,
+		218, 222, 226, 230, 234, 238, 242, 246, 250, 253, 257, 261, 265, 269, 273, 278,
+		282, 286, 291, 295, 299, 304, 308, 312, 318, 322, 326, 329, 332, 336, 340, 344,
+		348, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388, 392, 395, 398, 402, 406,
+		410, 414, 418, 422, 426, 430, 434, 438, 442, 445, 450, 456, 460, 465, 468, 472,
+		476, 480, 485, 489, 491, 496, 501, 505, 507, 512, 515, 520, 524, 528, 532, 536,
+		540, 544, 548, 552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600,
+		604, 608, 612, 616, 620, 626, 630, 634, 639, 643, 647, 650, 654, 658, 662, 666,
+		670, 673, 676, 680, 684, 687, 691, 695, 699, 703, 706, 711, 714, 719, 723, 726,
+		730, 734, 738, 742, 746, 750, 754, 758, 761, 765, 769, 773, 777, 780, 784, 788,
+		792, 795, 797, 801, 805, 809, 815, 818, 822, 826, 830, 835, 840, 844, 848, 852,
+		856, 860, 864, 868, 872, 876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916,
+		920, 924, 928, 932, 936, 940, 944, 948, 952, 956, 961, 965, 969, 973, 977, 981,
+		985, 989, 993, 997, 1001, 1006, 1010, 1014, 1017, 1021, 0, 5, 8, 13, 17, 21,
+		25, 29, 34, 38, 42, 46, 50, 54, 58, 62, 66, 70, 75, 79, 83, 87,
+		91, 95, 99, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 142, 148, 152,
+		156, 160, 164, 168, 171, 176, 181, 185, 189, 194, 198, 202, 207, 209, 213, 217,
+		221, 225, 229, 233, 237, 241, 245, 249, 252, 256, 260, 265, 268, 271, 275, 279,
+		283, 287, 290, 294, 298, 302, 306, 310, 314, 319, 323, 326, 330, 334, 337, 341,
+		345, 349, 354, 359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 399, 403, 406,
+		410, 414, 418, 422, 426, 430, 434, 438, 442, 446, 450, 453, 458, 462, 466, 470,
+		474, 477, 481, 485, 489, 493, 496, 500, 505, 510, 514, 518, 522, 526, 530, 534,
+		538, 542, 546, 550, 554, 558, 562, 566, 569, 573, 577, 581, 585, 589, 593, 597,
+		601, 605, 610, 615, 618, 622, 626, 630, 635, 639, 643, 648, 652, 656, 660, 664,
+		668, 672, 676, 680, 684, 687, 690, 695, 699, 703, 707, 711, 715, 719, 723, 727,
+		731, 735, 739, 743, 747, 751, 755, 758, 762, 765, 768, 773, 778, 782, 786, 790,
+		794, 798, 802, 806, 810, 816, 820, 824, 828, 832, 836, 840, 844, 849, 852, 856,
+		860, 864, 868, 872, 876, 880, 884, 888, 893, 897, 900, 904, 908, 912, 916, 920,
+		924, 930, 934, 938, 942, 948, 951, 955, 958, 962, 966, 970, 974, 978, 982, 986,
+		992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 1028, 1031, 0, 4, 7, 11, 15,
+		18, 22, 26, 30, 34, 38, 43, 47, 50, 54, 58, 62, 66, 70, 73, 77,
+		81, 85, 89, 93, 97, 101, 105, 109, 113, 117, 121, 125, 128, 132, 136, 140,
+		145, 151, 155, 159, 163, 167, 171, 174, 177, 181, 184, 188, 192, 196, 200, 204,
+		208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 259, 263, 267,
+		271, 275, 280, 283, 287, 290, 294, 299, 303, 307, 310, 314, 318, 322, 326, 330,
+		334, 338, 342, 346, 350, 354, 358, 362, 366, 370, 374, 378, 382, 387, 391, 395,
+		399, 403, 406, 410, 414, 418, 422, 426, 431, 435, 439, 443, 448, 452, 456, 460,
+		464, 468, 472, 476, 480, 484, 488, 492, 496, 500, 504, 507, 511, 515, 519, 523,
+		527, 531, 535, 539, 543, 546, 549, 553, 557, 561, 565, 569, 573, 577, 581, 585,
+		589, 593, 597, 601, 605, 609, 613, 617, 621, 625, 629, 633, 638, 642, 646, 650,
+		654, 658, 662, 666, 670, 673, 677, 681, 685, 689, 693, 697, 701, 705, 709, 713,
+		717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 758, 763, 767, 771, 775, 779,
+		783, 787, 791, 795, 799, 803, 807, 811, 815, 819, 823, 827, 831, 834, 838, 842,
+		846, 850, 854, 858, 861, 865, 869, 873, 876, 881, 884, 887, 891, 895, 899, 903,
+		907, 913, 917, 921, 925, 928, 931, 937, 941, 945, 949, 953, 958, 963, 967, 971,
+		975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 0, 4, 8, 12,
+		15, 20, 24, 28, 32, 36, 40, 44, 48, 51, 55, 59, 63, 67, 71, 75,
+		79, 83, 87, 90, 94, 98, 102, 106, 110, 114, 118, 122, 126, 130, 134, 138,
+		142, 146, 149, 152, 156, 160, 164, 168, 172, 175, 179, 183, 187, 191, 195, 200,
+		204, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265,
+		269, 273, 277, 281, 285, 289, 293, 297, 301, 304, 307, 311, 315, 319, 323, 327,
+		331, 335, 340, 343, 347, 351, 355, 359, 363, 367, 371, 375, 378, 381, 385, 388,
+		392, 396, 400, 404, 407, 410, 414, 418, 422, 426, 430, 434, 438, 442, 446, 450,
+		454, 458, 462, 466, 470, 473, 476, 480, 484, 488, 492, 495, 499, 503, 508, 512,
+		517, 520, 525, 529, 533, 537, 541, 545, 549, 553, 556, 559, 563, 567, 571, 575,
+		579, 583, 587, 591, 595, 599, 603, 606, 610, 616, 620, 624, 628, 632, 636, 640,
+		644, 648, 652, 656, 661, 665, 670, 673, 677, 681, 685, 689, 692, 696, 700, 704,
+		708, 711, 715, 719, 723, 727, 731, 736, 740, 744, 748, 752, 756, 760, 764, 768,
+		771, 775, 780, 784, 788, 792, 796, 800, 804, 808, 812, 818, 822, 826, 830, 835,
+		840, 843, 848, 852, 855, 858, 862, 868, 872, 876, 880, 884, 888, 892, 896, 900,
+		904, 908, 912, 916, 920, 924, 928, 932, 936, 940, 944, 948, 952, 956, 960, 964,
+		968, 972, 976, 980, 984, 988, 992, 996, 1000, 1004, 1007, 1010, 1013, 0, 5, 8,
+		12, 16, 21, 26, 30, 34, 38, 41, 45, 48, 52, 55, 59, 63, 67, 71,
+		75, 79, 83, 87, 91, 95, 99, 103, 107, 112, 117, 121, 125, 129, 133, 138,
+		142, 146, 150, 154, 158, 162, 166, 170, 174, 178, 182, 186, 190, 194, 198, 201,
+		204, 207, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 262, 266,
+		270, 274, 278, 282, 286, 290, 294, 298, 302, 306, 310, 314, 318, 322, 326, 330,
+		333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 378, 382, 389, 396, 400,
+		403, 407, 412, 416, 419, 423, 427, 431, 434, 438, 443, 447, 451, 455, 459, 463,
+		467, 471, 474, 478, 482, 486, 490, 494, 498, 502, 506, 509, 513, 517, 521, 525,
+		529, 533, 537, 541, 545, 550, 554, 558, 562, 566, 570, 574, 578, 583, 587, 591,
+		595, 599, 604, 608, 612, 616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656,
+		660, 664, 668, 672, 675, 678, 682, 686, 690, 694, 698, 702, 706, 710, 714, 718,
+		724, 728, 732, 736, 741, 746, 749, 753, 757, 761, 765, 769, 773, 777, 781, 785,
+		789, 793, 797, 801, 804, 808, 811, 815, 819, 823, 826, 830, 834, 838, 842, 846,
+		850, 854, 858, 862, 866, 871, 875, 880, 884, 889, 893, 898, 902, 908, 912, 916,
+		920, 924, 927, 930, 934, 938, 942, 945, 949, 953, 957, 961, 965, 969, 974, 978,
+		982, 986, 990, 994, 998, 1002, 1005, 1009, 1013, 1017, 1021, 1025, 1029, 1033, 0, 4,
+		8, 13, 17, 21, 25, 29, 33, 37, 41, 46, 50, 54, 58, 62, 66, 70,
+		74, 78, 82, 86, 90, 94, 99, 103, 107, 111, 115, 120, 124, 129, 133, 137,
+		141, 145, 149, 154, 157, 160, 164, 167, 172, 176, 180, 184, 188, 192, 196, 200,
+		204, 208, 212, 216, 221, 225, 229, 233, 237, 240, 243, 247, 251, 255, 259, 263,
+		267, 271, 275, 279, 284, 288, 292, 297, 301, 306, 311, 314, 319, 323, 327, 331,
+		335, 339, 343, 347, 351, 355, 359, 363, 367, 371, 374, 378, 383, 387, 390, 394,
+		399, 403, 407, 411, 415, 419, 425, 429, 431, 435, 439, 443, 447, 451, 455, 459,
+		465, 469, 474, 477, 482, 487, 491, 495, 499, 503, 507, 511, 515, 520, 525, 530,
+		535, 539, 544, 548, 553, 557, 561, 565, 569, 573, 577, 582, 585, 589, 593, 597,
+		601, 605, 609, 613, 617, 621, 625, 628, 632, 636, 640, 645, 649, 653, 658, 662,
+		666, 671, 675, 679, 685, 689, 693, 696, 700, 704, 708, 712, 716, 720, 724, 728,
+		732, 736, 740, 744, 748, 753, 758, 762, 766, 770, 774, 778, 782, 786, 790, 794,
+		798, 803, 807, 811, 815, 818, 821, 825, 829, 833, 836, 840, 844, 848, 852, 856,
+		861, 865, 868, 872, 876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916, 920,
+		925, 929, 932, 937, 941, 945, 948, 952, 956, 960, 965, 970, 974, 978, 982, 986,
+		991, 995, 999, 1003, 1007, 1011, 1014, 1018, 1022, 1026, 1029, 1033, 1037, 1041, 1045, 0,
+		4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 55, 59, 63,
+		68, 72, 76, 80, 84, 89, 93, 97, 101, 105, 109, 113, 116, 121, 125, 129,
+		133, 137, 141, 145, 149, 153, 157, 161, 165, 169, 175, 179, 183, 187, 191, 195,
+		199, 203, 207, 212, 216, 220, 224, 228, 232, 236, 240, 243, 247, 251, 255, 258,
+		262, 266, 270, 274, 278, 282, 286, 290, 294, 298, 302, 306, 311, 315, 319, 323,
+		327, 331, 335, 339, 344, 348, 351, 356, 360, 363, 367, 371, 375, 378, 382, 386,
+		390, 394, 398, 402, 406, 410, 414, 418, 422, 426, 430, 434, 438, 442, 446, 450,
+		454, 457, 461, 465, 469, 473, 477, 481, 485, 489, 493, 497, 501, 505, 509, 513,
+		516, 521, 525, 529, 533, 537, 541, 545, 549, 553, 557, 560, 565, 568, 572, 575,
+		579, 583, 587, 591, 595, 599, 603, 607, 612, 616, 619, 623, 627, 631, 635, 639,
+		643, 647, 652, 656, 660, 664, 667, 672, 676, 680, 684, 687, 691, 695, 699, 703,
+		707, 710, 714, 718, 723, 727, 731, 735, 739, 743, 747, 752, 755, 759, 763, 767,
+		771, 775, 780, 783, 787, 793, 797, 801, 805, 809, 813, 818, 822, 826, 831, 835,
+		839, 843, 847, 851, 855, 859, 865, 869, 873, 878, 882, 888, 891, 894, 897, 900,
+		904, 908, 912, 916, 920, 924, 928, 932, 936, 940, 944, 948, 952, 956, 960, 966,
+		969, 973, 977, 982, 986, 989, 992, 996, 1001, 1006, 1010, 1014, 1020, 1024, 1028, 1032,
+		0, 4, 8, 12, 16, 20, 24, 29, 33, 37, 41, 45, 49, 53, 58, 61,
+		65, 70, 74, 77, 81, 84, 88, 92, 96, 100, 103, 107, 111, 115, 119, 123,
+		127, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 173, 179, 183, 186, 192,
+		196, 199, 205, 210, 213, 218, 222, 226, 230, 234, 238, 242, 246, 250, 254, 258,
+		262, 266, 270, 274, 278, 282, 286, 290, 294, 298, 302, 306, 309, 312, 316, 320,
+		324, 327, 331, 335, 339, 344, 348, 351, 355, 359, 363, 368, 372, 375, 380, 385,
+		389, 392, 396, 401, 405, 409, 415, 419, 423, 427, 431, 435, 438, 442, 446, 451,
+		455, 459, 463, 467, 471, 475, 479, 483, 487, 491, 495, 499, 503, 507, 511, 515,
+		519, 523, 527, 531, 535, 539, 543, 547, 551, 555, 559, 563, 567, 571, 575, 580,
+		585, 590, 594, 599, 603, 607, 612, 616, 619, 624, 628, 632, 636, 639, 642, 646,
+		650, 654, 658, 662, 667, 671, 676, 680, 683, 688, 692, 695, 699, 702, 706, 710,
+		713, 717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 761, 765, 769, 773,
+		777, 781, 785, 789, 793, 797, 801, 805, 809, 813, 817, 821, 825, 829, 833, 837,
+		841, 845, 849, 853, 857, 860, 865, 868, 872, 875, 880, 883, 887, 891, 894, 898,
+		902, 906, 909, 914, 918, 922, 926, 931, 935, 938, 943, 947, 951, 956, 960, 963,
+		967, 971, 975, 979, 981, 985, 989, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024,
+		1028, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56,
+		60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 111, 115, 118,
+		123, 128, 131, 134, 139, 143, 147, 150, 153, 156, 160, 164, 167, 171, 175, 179,
+		182, 186, 190, 194, 199, 204, 208, 212, 216, 220, 224, 228, 232, 235, 239, 243,
+		248, 252, 255, 258, 261, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304,
+		308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368,
+		372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 413, 417, 421, 425, 429, 433,
+		437, 441, 445, 449, 453, 457, 461, 465, 472, 476, 479, 482, 487, 491, 495, 500,
+		504, 508, 513, 517, 522, 526, 530, 535, 540, 544, 548, 551, 556, 561, 565, 569,
+		572, 578, 581, 585, 589, 593, 597, 600, 604, 607, 611, 615, 622, 625, 628, 633,
+		637, 642, 647, 654, 659, 663, 667, 672, 677, 680, 684, 688, 691, 695, 699, 703,
+		707, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751, 755, 759, 763, 767,
+		771, 775, 779, 783, 787, 791, 795, 799, 802, 805, 809, 812, 816, 820, 825, 829,
+		835, 838, 843, 847, 852, 857, 861, 865, 868, 871, 876, 880, 884, 888, 893, 897,
+		901, 906, 909, 913, 918, 922, 926, 931, 935, 939, 943, 947, 952, 957, 960, 964,
+		968, 971, 976, 981, 984, 987, 992, 996, 1002, 1007, 1011, 1015, 1020, 1024, 1028, 1032,
+		1036, 1040, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52,
+		56, 60, 64, 69, 74, 78, 82, 86, 90, 94, 98, 102, 106, 110, 114, 118,
+		122, 126, 130, 134, 137, 141, 145, 150, 154, 158, 161, 164, 167, 170, 174, 177,
+		181, 184, 188, 192, 197, 201, 205, 209, 214, 218, 222, 226, 230, 233, 239, 243,
+		247, 251, 255, 259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 299, 303, 307,
+		311, 315, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 371,
+		376, 380, 384, 387, 391, 395, 400, 405, 409, 414, 418, 421, 425, 430, 435, 440,
+		444, 448, 451, 455, 459, 463, 467, 471, 475, 480, 484, 488, 492, 496, 500, 504,
+		508, 512, 516, 520, 524, 528, 532, 536, 540, 543, 546, 550, 554, 558, 562, 565,
+		570, 574, 579, 583, 589, 593, 597, 601, 606, 610, 613, 617, 621, 625, 628, 631,
+		635, 639, 643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 687, 691, 695,
+		699, 703, 707, 711, 715, 719, 723, 727, 731, 734, 739, 743, 748, 752, 756, 760,
+		764, 768, 772, 776, 780, 784, 790, 793, 796, 799, 802, 808, 812, 816, 820, 824,
+		828, 832, 836, 840, 843, 847, 851, 854, 858, 862, 865, 870, 873, 876, 880, 885,
+		889, 892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 932, 935, 940, 944, 949,
+		953, 958, 962, 966, 969, 973, 977, 981, 985, 989, 993, 997, 1000, 1004, 1008, 1011,
+		1015, 1019, 1023, 0, 4, 8, 12, 16, 20, 24, 28, 32, 37, 42, 45, 49,
+		53, 57, 61, 65, 69, 73, 77, 81, 85, 89, 93, 96, 100, 104, 107, 111,
+		115, 119, 123, 127, 131, 135, 139, 144, 147, 150, 154, 159, 163, 167, 170, 174,
+		179, 183, 187, 191, 195, 199, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240,
+		244, 248, 252, 256, 260, 264, 268, 272, 275, 279, 284, 288, 292, 295, 298, 301,
+		305, 309, 313, 317, 321, 325, 329, 333, 336, 340, 343, 347, 351, 355, 360, 364,
+		369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421, 425, 428,
+		432, 436, 440, 444, 448, 452, 456, 461, 464, 468, 473, 477, 480, 484, 489, 493,
+		497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549, 552, 556,
+		560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 613, 616, 620,
+		623, 628, 633, 637, 641, 645, 649, 654, 658, 662, 666, 670, 674, 678, 682, 686,
+		690, 694, 698, 702, 706, 710, 714, 718, 723, 726, 730, 733, 737, 741, 745, 749,
+		753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 798, 802, 805, 809, 813,
+		817, 820, 824, 828, 832, 835, 838, 842, 846, 850, 854, 858, 862, 866, 870, 874,
+		878, 884, 887, 891, 895, 899, 903, 907, 912, 915, 918, 923, 926, 931, 935, 939,
+		943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 999, 1003,
+		1007, 1011, 1015, 1018, 0, 3, 7, 11, 14, 18, 21, 25, 29, 33, 37, 41,
+		45, 49, 53, 57, 61, 65, 69, 73, 77, 81, 85, 89, 93, 96, 100, 105,
+		109, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 163, 166,
+		171, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232,
+		235, 238, 242, 246, 250, 254, 259, 263, 267, 271, 275, 279, 283, 288, 292, 296,
+		300, 304, 308, 312, 317, 322, 326, 330, 334, 338, 342, 346, 349, 352, 356, 360,
+		364, 368, 372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 411, 415, 419, 423,
+		427, 433, 437, 441, 445, 448, 452, 456, 459, 463, 467, 470, 474, 479, 483, 487,
+		491, 495, 499, 503, 507, 511, 515, 520, 524, 528, 532, 536, 541, 545, 549, 552,
+		557, 561, 565, 569, 573, 576, 580, 584, 589, 593, 596, 600, 604, 608, 612, 617,
+		620, 624, 628, 632, 636, 640, 644, 648, 652, 657, 661, 665, 668, 671, 675, 679,
+		683, 686, 690, 694, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 740, 744,
+		748, 753, 757, 761, 765, 769, 773, 777, 780, 783, 787, 790, 794, 798, 801, 805,
+		809, 813, 816, 821, 825, 829, 834, 838, 842, 846, 850, 854, 858, 862, 866, 870,
+		874, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 920, 926, 931, 934, 940,
+		943, 947, 950, 954, 958, 962, 966, 970, 974, 977, 981, 985, 990, 994, 997, 1001,
+		1005, 1009, 1013, 1017, 1021, 0, 4, 8, 12, 16, 20, 24, 28, 33, 37, 41,
+		45, 49, 53, 57, 61, 65, 69, 73, 77, 82, 87, 90, 94, 98, 101, 105,
+		109, 113, 120, 126, 130, 134, 138, 141, 145, 148, 152, 156, 160, 164, 168, 172,
+		176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236,
+		240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 281, 285, 291, 296, 301, 305,
+		309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 352, 357, 361, 365, 369,
+		373, 377, 381, 385, 389, 394, 398, 402, 406, 410, 414, 418, 422, 426, 430, 434,
+		438, 442, 446, 450, 454, 458, 462, 466, 471, 474, 478, 482, 487, 492, 496, 500,
+		504, 508, 512, 516, 520, 524, 527, 531, 535, 539, 543, 548, 552, 557, 562, 566,
+		569, 573, 578, 582, 586, 590, 594, 597, 602, 606, 610, 613, 616, 621, 625, 629,
+		633, 637, 641, 645, 649, 653, 658, 661, 665, 669, 673, 677, 681, 685, 689, 693,
+		697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 757,
+		761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801, 805, 809, 812, 816, 820,
+		823, 826, 830, 834, 838, 842, 846, 850, 854, 858, 861, 865, 869, 874, 878, 881,
+		885, 889, 893, 896, 900, 904, 908, 911, 915, 919, 922, 926, 930, 934, 938, 942,
+		946, 950, 954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006,
+		1010, 1014, 1018, 1023, 1027, 1031, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36,
+		40, 43, 48, 52, 59, 63, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104,
+		108, 112, 116, 121, 125, 129, 133, 137, 141, 145, 149, 153, 157, 161, 165, 169,
+		173, 177, 181, 185, 189, 193, 199, 203, 207, 211, 215, 219, 223, 227, 231, 235,
+		239, 243, 247, 250, 253, 257, 261, 265, 269, 273, 278, 282, 286, 291, 296, 301,
+		305, 309, 314, 318, 322, 326, 329, 333, 337, 341, 345, 348, 352, 356, 360, 364,
+		368, 372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428,
+		432, 436, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 483, 487, 492,
+		496, 499, 503, 507, 510, 514, 518, 523, 527, 531, 535, 538, 542, 547, 551, 556,
+		560, 564, 568, 575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 619, 623,
+		627, 631, 635, 639, 643, 646, 651, 655, 658, 662, 666, 670, 674, 677, 681, 685,
+		689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741, 745, 749,
+		753, 757, 760, 764, 768, 771, 775, 779, 782, 786, 790, 794, 798, 802, 806, 810,
+		814, 818, 822, 826, 830, 835, 839, 843, 847, 851, 855, 858, 862, 866, 870, 874,
+		877, 881, 884, 888, 892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 931, 935,
+		939, 943, 947, 951, 956, 960, 964, 968, 973, 976, 980, 984, 987, 991, 995, 998,
+		1002, 1006, 1010, 1014, 1018, 1022, 1026, 0, 4, 8, 12, 17, 21, 25, 29, 33,
+		37, 41, 45, 49, 53, 57, 62, 66, 71, 75, 80, 84, 87, 91, 94, 98,
+		101, 105, 108, 112, 116, 121, 124, 128, 132, 135, 139, 142, 148, 152, 155, 159,
+		164, 169, 173, 177, 181, 185, 189, 193, 197, 201, 205, 209, 213, 218, 222, 226,
+		229, 233, 238, 243, 247, 251, 255, 259, 263, 267, 273, 277, 281, 285, 289, 293,
+		298, 302, 306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 346, 350, 354, 358,
+		362, 367, 371, 375, 379, 383, 387, 390, 394, 398, 403, 407, 411, 415, 419, 422,
+		426, 430, 434, 438, 443, 447, 451, 455, 459, 463, 467, 471, 475, 479, 483, 487,
+		491, 494, 498, 502, 506, 510, 514, 518, 522, 525, 528, 532, 536, 540, 544, 548,
+		552, 556, 561, 565, 570, 574, 578, 582, 585, 589, 593, 598, 602, 606, 610, 614,
+		617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 672, 675,
+		679, 683, 685, 690, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737,
+		741, 745, 749, 753, 756, 761, 764, 768, 772, 776, 780, 784, 788, 792, 795, 799,
+		804, 807, 811, 814, 818, 822, 826, 830, 834, 838, 842, 847, 850, 854, 858, 862,
+		866, 870, 874, 878, 882, 886, 891, 895, 899, 903, 906, 910, 914, 918, 922, 926,
+		930, 934, 938, 942, 946, 950, 954, 957, 962, 966, 970, 975, 979, 983, 987, 991,
+		995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 0, 5, 9, 13, 17, 21, 25, 30,
+		34, 38, 41, 44, 50, 54, 58, 62, 66, 70, 74, 79, 83, 88, 91, 95,
+		98, 102, 106, 110, 114, 118, 122, 126, 129, 133, 137, 140, 144, 148, 152, 157,
+		161, 165, 168, 172, 176, 179, 183, 187, 191, 194, 198, 202, 206, 210, 214, 218,
+		221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 260, 264, 268, 272, 277, 281,
+		285, 289, 293, 297, 301, 305, 309, 313, 317, 321, 325, 328, 332, 336, 341, 345,
+		349, 353, 357, 361, 365, 369, 373, 377, 381, 384, 388, 392, 396, 400, 404, 408,
+		411, 415, 418, 422, 426, 430, 434, 438, 442, 446, 450, 456, 460, 466, 470, 474,
+		478, 483, 488, 491, 495, 500, 504, 508, 512, 516, 520, 524, 528, 531, 535, 539,
+		545, 551, 555, 559, 563, 567, 571, 574, 577, 580, 584, 588, 592, 596, 600, 604,
+		608, 611, 615, 619, 623, 629, 632, 637, 641, 645, 649, 652, 656, 660, 664, 667,
+		672, 677, 681, 685, 689, 693, 696, 700, 704, 707, 711, 715, 719, 723, 728, 732,
+		736, 739, 743, 747, 751, 755, 759, 763, 767, 771, 775, 780, 784, 788, 792, 797,
+		801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 854, 857, 861,
+		864, 868, 872, 876, 880, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923,
+		927, 932, 936, 940, 944, 948, 952, 957, 961, 965, 970, 974, 978, 982, 986, 990,
+		995, 998, 1002, 1007, 1010, 1014, 1018, 1022, 1026, 0, 4, 8, 12, 16, 21, 24,
+		27, 30, 34, 38, 42, 46, 51, 55, 59, 63, 67, 71, 75, 79, 83, 87,
+		91, 95, 99, 103, 107, 110, 114, 118, 122, 126, 129, 132, 136, 140, 144, 148,
+		151, 155, 158, 162, 166, 170, 174, 178, 182, 186, 191, 195, 199, 204, 208, 212,
+		216, 220, 224, 229, 234, 238, 242, 247, 250, 255, 258, 262, 266, 270, 274, 278,
+		282, 285, 289, 293, 297, 300, 304, 307, 311, 316, 320, 324, 328, 332, 336, 340,
+		344, 348, 353, 358, 362, 367, 370, 376, 379, 384, 389, 393, 397, 401, 405, 409,
+		413, 417, 421, 425, 429, 433, 437, 441, 445, 449, 453, 458, 461, 465, 469, 472,
+		475, 479, 483, 486, 489, 492, 495, 499, 503, 507, 511, 514, 518, 523, 527, 531,
+		534, 538, 542, 546, 550, 554, 558, 563, 567, 570, 574, 577, 581, 585, 589, 593,
+		597, 601, 605, 609, 613, 617, 622, 626, 630, 633, 637, 641, 645, 649, 653, 657,
+		660, 664, 668, 672, 676, 680, 684, 688, 692, 696, 700, 704, 708, 712, 716, 720,
+		724, 728, 732, 736, 740, 745, 749, 753, 757, 761, 765, 769, 773, 777, 781, 785,
+		789, 793, 799, 803, 807, 812, 816, 820, 824, 828, 832, 836, 840, 843, 846, 849,
+		852, 856, 860, 864, 868, 872, 876, 882, 886, 890, 894, 898, 902, 906, 910, 914,
+		918, 921, 925, 929, 933, 937, 943, 947, 951, 955, 960, 963, 967, 972, 977, 981,
+		985, 989, 993, 997, 1003, 1006, 1010, 1014, 1018, 1022, 0, 4, 8, 12, 17, 23,
+		26, 29, 33, 36, 42, 46, 51, 54, 57, 60, 64, 68, 72, 76, 79, 83,
+		87, 92, 97, 101, 105, 109, 116, 119, 123, 127, 131, 135, 139, 143, 147, 151,
+		154, 159, 163, 167, 172, 176, 180, 183, 186, 190, 194, 198, 202, 206, 210, 214,
+		218, 222, 226, 231, 235, 239, 243, 247, 251, 255, 260, 264, 268, 272, 276, 280,
+		284, 287, 291, 295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 336, 339, 343,
+		347, 351, 355, 359, 362, 366, 370, 374, 378, 382, 386, 391, 397, 402, 406, 410,
+		414, 418, 422, 426, 430, 434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474,
+		479, 483, 488, 491, 495, 501, 505, 509, 514, 518, 522, 525, 529, 533, 537, 541,
+		545, 549, 553, 557, 562, 565, 569, 573, 577, 582, 586, 590, 594, 598, 602, 606,
+		610, 614, 618, 622, 626, 630, 634, 638, 641, 647, 651, 655, 659, 662, 666, 671,
+		675, 679, 683, 687, 691, 695, 699, 702, 706, 710, 714, 718, 722, 726, 730, 734,
+		738, 742, 746, 751, 755, 758, 762, 765, 770, 775, 778, 783, 788, 792, 796, 800,
+		804, 808, 812, 816, 820, 824, 829, 833, 836, 840, 844, 847, 851, 855, 858, 863,
+		866, 871, 875, 879, 883, 887, 890, 895, 899, 903, 908, 912, 916, 921, 924, 928,
+		932, 936, 939, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 989, 994,
+		999, 1003, 1007, 1011, 1015, 1019, 1022, 1025, 1029, 1033, 1037, 0, 4, 8, 12, 16,
+		20, 24, 28, 32, 36, 40, 43, 47, 51, 55, 59, 63, 67, 71, 75, 79,
+		83, 87, 91, 95, 99, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 143,
+		147, 151, 155, 159, 163, 166, 170, 174, 178, 182, 186, 190, 194, 198, 201, 204,
+		207, 210, 214, 218, 221, 226, 230, 234, 237, 241, 245, 248, 252, 256, 260, 264,
+		270, 274, 278, 282, 286, 291, 294, 297, 301, 305, 309, 313, 317, 321, 325, 329,
+		333, 337, 341, 345, 349, 354, 358, 362, 365, 368, 372, 376, 380, 384, 388, 392,
+		396, 400, 404, 409, 412, 416, 420, 426, 430, 434, 437, 441, 445, 449, 453, 457,
+		461, 465, 468, 472, 476, 480, 484, 488, 492, 496, 500, 504, 508, 512, 516, 520,
+		524, 528, 532, 536, 540, 544, 547, 551, 555, 559, 563, 570, 574, 578, 582, 587,
+		591, 595, 599, 603, 607, 611, 615, 619, 623, 628, 634, 637, 641, 645, 649, 653,
+		657, 661, 667, 672, 676, 679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719,
+		723, 727, 731, 735, 740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 784,
+		788, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826, 829, 832, 836, 840, 843,
+		848, 851, 855, 858, 861, 867, 871, 875, 878, 882, 886, 891, 895, 899, 903, 907,
+		912, 916, 920, 924, 928, 932, 936, 940, 944, 948, 952, 955, 958, 961, 965, 969,
+		973, 977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013, 1017, 0, 4, 9, 13,
+		16, 20, 24, 28, 31, 35, 39, 44, 48, 52, 57, 63, 66, 70, 74, 78,
+		82, 86, 90, 93, 97, 101, 105, 109, 112, 117, 121, 125, 129, 133, 137, 141,
+		145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 186, 190, 194, 199, 202, 206,
+		211, 215, 219, 223, 228, 231, 235, 239, 243, 247, 251, 255, 259, 263, 267, 271,
+		275, 279, 283, 287, 291, 295, 299, 303, 307, 311, 315, 319, 325, 329, 333, 337,
+		341, 345, 349, 352, 356, 360, 364, 369, 373, 377, 382, 387, 392, 396, 400, 404,
+		408, 412, 416, 420, 424, 428, 432, 436, 440, 443, 447, 451, 455, 460, 464, 468,
+		471, 475, 479, 483, 488, 492, 496, 500, 504, 507, 511, 515, 519, 523, 527, 530,
+		534, 538, 542, 546, 549, 553, 557, 561, 565, 570, 574, 578, 582, 586, 590, 594,
+		598, 602, 606, 610, 615, 619, 623, 627, 631, 635, 638, 642, 645, 649, 654, 658,
+		662, 666, 670, 674, 678, 683, 687, 691, 695, 699, 702, 706, 710, 714, 718, 722,
+		726, 730, 734, 738, 742, 746, 750, 754, 758, 762, 765, 769, 773, 777, 781, 784,
+		789, 794, 798, 802, 806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850,
+		854, 858, 862, 866, 870, 874, 878, 882, 888, 891, 895, 900, 903, 906, 910, 914,
+		919, 923, 927, 931, 934, 938, 942, 946, 950, 954, 958, 964, 968, 972, 976, 980,
+		983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 1027, 1031, 0, 4, 7,
+		11, 15, 18, 22, 26, 31, 35, 38, 42, 46, 50, 54, 59, 63, 67, 71,
+		75, 78, 82, 86, 90, 94, 98, 102, 106, 109, 112, 116, 119, 123, 127, 131,
+		135, 139, 143, 147, 151, 155, 159, 163, 166, 170, 174, 178, 182, 186, 190, 194,
+		198, 202, 206, 210, 214, 217, 221, 226, 230, 234, 238, 242, 245, 248, 252, 256,
+		261, 265, 268, 274, 277, 281, 285, 290, 294, 298, 302, 306, 310, 314, 318, 322,
+		326, 330, 334, 338, 342, 346, 350, 355, 359, 363, 367, 371, 375, 379, 383, 387,
+		391, 395, 399, 403, 407, 411, 415, 419, 424, 427, 431, 434, 438, 442, 446, 450,
+		455, 459, 464, 468, 472, 476, 480, 483, 488, 493, 498, 504, 508, 512, 516, 520,
+		524, 528, 532, 536, 541, 545, 549, 553, 557, 561, 565, 569, 575, 579, 583, 587,
+		591, 595, 599, 603, 607, 611, 615, 620, 624, 628, 632, 636, 640, 644, 648, 652,
+		656, 661, 665, 669, 673, 678, 682, 687, 691, 695, 699, 703, 707, 711, 715, 718,
+		722, 726, 730, 734, 738, 742, 746, 750, 755, 759, 763, 767, 771, 775, 778, 782,
+		787, 791, 795, 799, 803, 807, 811, 815, 819, 824, 828, 833, 837, 842, 846, 850,
+		853, 857, 861, 865, 869, 873, 878, 882, 886, 890, 894, 898, 902, 906, 910, 914,
+		919, 923, 927, 930, 935, 938, 943, 948, 952, 956, 960, 964, 968, 972, 976, 980,
+		984, 988, 993, 997, 1001, 1005, 1010, 1013, 1017, 1022, 1026, 1030, 1034, 1038, 0, 4,
+		8, 12, 16, 20, 24, 28, 32, 35, 39, 42, 46, 50, 54, 58, 62, 66,
+		70, 74, 78, 82, 86, 90, 94, 99, 102, 105, 109, 113, 117, 121, 125, 129,
+		133, 136, 139, 143, 147, 151, 156, 159, 163, 166, 169, 173, 177, 181, 185, 189,
+		193, 197, 201, 205, 209, 213, 217, 221, 225, 229, 233, 237, 240, 244, 248, 252,
+		255, 258, 262, 266, 270, 274, 278, 281, 284, 288, 292, 296, 301, 305, 309, 313,
+		316, 320, 324, 328, 332, 336, 340, 344, 348, 353, 357, 361, 365, 369, 373, 377,
+		381, 385, 389, 394, 398, 401, 405, 409, 413, 417, 421, 425, 428, 432, 436, 442,
+		445, 448, 452, 456, 460, 464, 468, 472, 476, 480, 485, 489, 493, 497, 501, 505,
+		509, 513, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560, 564, 568,
+		572, 576, 580, 584, 588, 592, 598, 602, 606, 610, 615, 619, 623, 627, 631, 635,
+		639, 645, 649, 653, 657, 661, 667, 671, 674, 678, 681, 685, 689, 693, 697, 701,
+		705, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744, 748, 753, 757, 762, 766,
+		771, 775, 779, 783, 788, 792, 796, 800, 803, 807, 811, 815, 819, 823, 827, 832,
+		836, 841, 845, 849, 853, 858, 862, 865, 869, 874, 877, 881, 886, 889, 892, 896,
+		900, 904, 908, 912, 915, 919, 923, 927, 931, 935, 939, 944, 948, 952, 955, 959,
+		962, 966, 970, 974, 978, 982, 986, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 0,
+		4, 8, 12, 16, 20, 24, 28, 31, 34, 37, 41, 44, 48, 53, 56, 60,
+		64, 68, 72, 76, 80, 84, 89, 93, 97, 100, 105, 109, 112, 116, 120, 124,
+		128, 131, 134, 139, 144, 148, 152, 156, 160, 165, 169, 173, 178, 181, 185, 189,
+		195, 199, 203, 206, 211, 216, 220, 224, 228, 232, 236, 240, 244, 248, 253, 257,
+		261, 265, 269, 273, 278, 282, 286, 290, 295, 299, 303, 307, 311, 315, 319, 323,
+		327, 331, 335, 339, 343, 346, 350, 354, 359, 363, 367, 371, 375, 379, 382, 386,
+		391, 394, 399, 402, 405, 409, 413, 416, 420, 425, 429, 434, 438, 441, 445, 449,
+		453, 459, 463, 467, 470, 475, 479, 483, 487, 491, 495, 499, 504, 508, 512, 516,
+		521, 525, 529, 533, 537, 541, 545, 548, 551, 555, 558, 562, 566, 570, 574, 578,
+		582, 585, 589, 593, 597, 602, 606, 612, 616, 619, 623, 627, 631, 636, 640, 644,
+		648, 651, 655, 660, 664, 668, 672, 676, 680, 684, 689, 693, 697, 700, 704, 708,
+		712, 715, 719, 723, 727, 731, 734, 738, 742, 746, 750, 754, 758, 763, 766, 769,
+		774, 778, 782, 787, 791, 795, 799, 803, 806, 810, 815, 819, 823, 827, 831, 835,
+		839, 843, 847, 851, 854, 857, 861, 865, 869, 872, 876, 880, 884, 888, 892, 896,
+		900, 903, 907, 911, 915, 919, 923, 927, 931, 935, 938, 942, 946, 951, 955, 959,
+		964, 968, 972, 977, 981, 985, 990, 994, 998, 1002, 1006, 1010, 1014, 1018, 1022, 1026,
+		0, 4, 8, 11, 15, 19, 23, 27, 31, 35, 39, 43, 47, 51, 55, 59,
+		63, 67, 71, 75, 79, 84, 90, 94, 98, 103, 106, 109, 114, 118, 122, 127,
+		131, 135, 139, 143, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192,
+		196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 235, 241, 244, 248, 252, 257,
+		261, 265, 270, 274, 278, 282, 286, 290, 294, 297, 301, 305, 309, 313, 317, 321,
+		325, 329, 333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 377, 381, 385,
+		388, 392, 395, 399, 403, 407, 410, 414, 418, 422, 426, 430, 434, 438, 444, 448,
+		451, 454, 458, 461, 464, 469, 474, 479, 483, 488, 492, 496, 500, 505, 509, 513,
+		517, 521, 526, 530, 535, 539, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578,
+		582, 585, 588, 592, 596, 600, 604, 608, 612, 616, 620, 624, 628, 632, 636, 640,
+		644, 648, 652, 656, 660, 664, 668, 672, 676, 680, 684, 688, 692, 696, 700, 704,
+		707, 711, 715, 719, 723, 727, 731, 735, 739, 742, 745, 748, 753, 756, 760, 763,
+		767, 771, 775, 779, 783, 787, 790, 794, 798, 802, 805, 809, 813, 818, 822, 826,
+		829, 834, 838, 843, 847, 851, 856, 860, 864, 868, 872, 876, 879, 883, 887, 891,
+		895, 899, 903, 907, 910, 916, 920, 924, 928, 932, 936, 941, 945, 949, 953, 957,
+		961, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006, 1010, 1014, 1018, 1022,
+		1026, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56,
+		60, 65, 69, 73, 77, 81, 85, 89, 92, 96, 100, 103, 107, 111, 115, 119,
+		123, 127, 131, 134, 138, 142, 146, 151, 155, 159, 163, 167, 171, 174, 177, 181,
+		185, 189, 192, 198, 201, 204, 207, 211, 215, 219, 223, 228, 231, 235, 239, 242,
+		246, 250, 253, 258, 261, 264, 268, 271, 274, 279, 283, 287, 293, 298, 303, 307,
+		311, 315, 319, 323, 326, 329, 332, 336, 339, 343, 347, 351, 355, 359, 364, 368,
+		372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432,
+		436, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 488, 492, 496,
+		500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560,
+		564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616, 620, 624,
+		628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668, 672, 676, 679, 683, 687,
+		691, 695, 698, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741, 745, 749,
+		753, 757, 761, 765, 769, 773, 778, 781, 785, 790, 793, 797, 801, 806, 809, 813,
+		817, 821, 825, 829, 833, 836, 839, 843, 847, 853, 857, 861, 865, 869, 873, 878,
+		882, 888, 892, 897, 901, 905, 909, 914, 918, 922, 926, 930, 934, 939, 942, 945,
+		949, 953, 957, 961, 966, 971, 975, 979, 984, 988, 992, 995, 998, 1002, 1006, 1011,
+		1014, 1018, 0, 4, 8, 12, 16, 20, 24, 28, 34, 38, 41, 46, 50, 54,
+		58, 62, 66, 70, 75, 79, 83, 88, 92, 96, 100, 104, 108, 113, 117, 121,
+		125, 129, 133, 137, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 185,
+		189, 193, 197, 201, 205, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249,
+		253, 257, 261, 265, 269, 273, 277, 281, 285, 289, 293, 297, 301, 305, 309, 313,
+		317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 377,
+		381, 385, 389, 393, 399, 403, 407, 411, 415, 418, 423, 427, 431, 434, 438, 441,
+		445, 449, 453, 457, 461, 465, 469, 473, 477, 481, 485, 490, 494, 498, 502, 506,
+		512, 515, 519, 522, 526, 529, 533, 537, 541, 545, 550, 554, 558, 563, 568, 573,
+		576, 580, 584, 589, 592, 596, 601, 605, 609, 613, 617, 621, 626, 630, 634, 638,
+		642, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682, 686, 690, 694, 698, 702,
+		706, 710, 714, 718, 722, 726, 730, 734, 738, 742, 746, 750, 754, 758, 762, 766,
+		770, 774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826, 830,
+		834, 838, 842, 846, 850, 854, 858, 862, 866, 870, 874, 878, 883, 887, 891, 895,
+		899, 903, 907, 911, 915, 919, 923, 927, 931, 937, 941, 945, 949, 953, 957, 961,
+		965, 969, 973, 977, 981, 984, 987, 992, 995, 999, 1003, 1006, 1011, 1015, 1019, 1023,
+		1026, 1030, 1034, 0, 4, 8, 13, 17, 21, 25, 28, 32, 36, 40, 45, 49,
+		53, 57, 61, 65, 68, 72, 76, 79, 84, 88, 92, 95, 99, 103, 106, 111,
+		115, 119, 123, 127, 131, 134, 138, 141, 145, 149, 153, 157, 161, 165, 169, 173,
+		177, 181, 186, 191, 196, 199, 203, 207, 211, 215, 218, 221, 225, 229, 233, 237,
+		241, 245, 249, 253, 257, 261, 265, 269, 273, 277, 281, 285, 289, 293, 297, 301,
+		305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357, 361, 365,
+		369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421, 425, 430,
+		434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 478, 482, 486, 490, 494,
+		498, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 540, 544, 547, 552, 556,
+		560, 565, 569, 573, 577, 582, 586, 591, 596, 600, 603, 607, 612, 615, 619, 625,
+		629, 633, 636, 639, 642, 648, 652, 658, 662, 665, 669, 673, 679, 682, 686, 691,
+		694, 699, 703, 707, 711, 714, 720, 724, 727, 731, 734, 739, 743, 748, 752, 756,
+		760, 763, 768, 772, 775, 779, 783, 788, 792, 795, 799, 803, 807, 811, 815, 819,
+		823, 827, 831, 835, 839, 843, 847, 851, 855, 859, 863, 867, 871, 875, 879, 883,
+		887, 891, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947,
+		951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011,
+		1015, 1019, 1023, 1027, 0, 4, 8, 12, 16, 20, 24, 28, 31, 34, 39, 44,
+		47, 51, 55, 59, 64, 68, 72, 76, 81, 85, 89, 93, 96, 100, 104, 108,
+		112, 116, 120, 124, 128, 132, 135, 139, 143, 147, 151, 155, 158, 162, 166, 170,
+		173, 177, 180, 184, 188, 192, 197, 202, 208, 213, 216, 220, 223, 227, 232, 236,
+		240, 244, 248, 252, 256, 259, 264, 267, 272, 277, 280, 284, 289, 293, 297, 301,
+		305, 309, 313, 316, 319, 323, 327, 331, 335, 339, 342, 346, 350, 354, 358, 362,
+		366, 370, 374, 378, 383, 387, 391, 395, 399, 403, 407, 411, 415, 419, 423, 427,
+		431, 435, 440, 443, 447, 451, 455, 459, 463, 467, 471, 475, 479, 483, 487, 491,
+		495, 499, 503, 507, 511, 515, 519, 523, 527, 531, 535, 539, 543, 547, 551, 555,
+		559, 563, 567, 571, 575, 579, 584, 588, 592, 596, 599, 603, 607, 611, 615, 619,
+		623, 627, 632, 635, 639, 643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683,
+		686, 689, 694, 698, 702, 706, 711, 714, 718, 722, 728, 733, 736, 741, 746, 750,
+		754, 758, 761, 765, 770, 774, 777, 782, 787, 791, 796, 801, 805, 809, 813, 816,
+		820, 824, 827, 831, 836, 840, 844, 848, 851, 855, 859, 862, 866, 870, 874, 878,
+		882, 886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942,
+		946, 950, 954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006,
+		1010, 1014, 1018, 1022, 1026, 0, 4, 8, 12, 16, 22, 26, 31, 36, 40, 44,
+		48, 52, 55, 59, 63, 67, 71, 75, 79, 83, 86, 89, 93, 96, 101, 106,
+		109, 113, 117, 121, 125, 129, 132, 137, 141, 146, 150, 154, 157, 162, 166, 169,
+		173, 177, 180, 184, 187, 192, 195, 200, 204, 208, 211, 216, 219, 223, 227, 231,
+		235, 239, 243, 247, 251, 255, 259, 263, 267, 270, 274, 278, 282, 286, 290, 294,
+		299, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351, 355, 359,
+		363, 367, 371, 375, 379, 383, 387, 391, 395, 399, 403, 407, 411, 415, 419, 423,
+		427, 431, 435, 439, 443, 447, 451, 455, 459, 463, 467, 471, 475, 479, 483, 487,
+		491, 495, 499, 503, 507, 510, 514, 519, 524, 528, 532, 536, 541, 545, 550, 553,
+		557, 562, 566, 572, 576, 581, 584, 588, 593, 597, 600, 604, 608, 612, 616, 619,
+		623, 628, 631, 635, 640, 644, 648, 653, 658, 662, 666, 670, 674, 678, 681, 685,
+		689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741, 745, 749,
+		753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801, 805, 809, 813,
+		817, 821, 825, 829, 833, 837, 841, 846, 849, 853, 858, 862, 866, 870, 874, 878,
+		882, 886, 890, 895, 898, 901, 904, 908, 912, 916, 920, 924, 928, 932, 936, 939,
+		943, 947, 951, 955, 959, 963, 967, 972, 976, 980, 984, 988, 992, 996, 1000, 1004,
+		1008, 1012, 1016, 1020, 1024, 1028, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36,
+		40, 44, 48, 52, 57, 61, 64, 71, 75, 78, 83, 87, 91, 95, 99, 103,
+		107, 110, 113, 117, 121, 125, 129, 133, 137, 141, 145, 150, 154, 158, 162, 166,
+		170, 174, 178, 182, 186, 190, 194, 198, 202, 206, 210, 214, 218, 222, 226, 230,
+		234, 239, 242, 247, 251, 255, 259, 263, 267, 271, 274, 278, 282, 287, 291, 296,
+		300, 304, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 352, 357, 361,
+		365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421, 425,
+		429, 433, 437, 441, 445, 448, 451, 454, 458, 462, 466, 470, 474, 478, 482, 486,
+		490, 495, 499, 503, 507, 511, 515, 519, 523, 528, 532, 536, 540, 544, 548, 552,
+		557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 596, 600, 604, 608, 612, 616,
+		620, 624, 628, 631, 635, 639, 643, 647, 650, 653, 656, 660, 664, 667, 671, 675,
+		679, 683, 687, 691, 695, 698, 701, 704, 708, 712, 716, 720, 724, 728, 733, 737,
+		740, 745, 748, 754, 758, 762, 766, 770, 774, 778, 782, 785, 789, 793, 797, 800,
+		804, 808, 812, 817, 821, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860, 864,
+		868, 871, 874, 877, 881, 887, 892, 896, 899, 903, 907, 911, 915, 919, 923, 927,
+		931, 935, 939, 943, 947, 950, 953, 956, 960, 964, 968, 972, 976, 981, 986, 990,
+		994, 998, 1002, 1006, 1010, 1014, 1017, 0, 4, 9, 13, 17, 21, 24, 28, 32,
+		35, 39, 43, 47, 50, 53, 57, 61, 65, 68, 72, 76, 79, 83, 86, 89,
+		93, 98, 102, 106, 111, 114, 117, 121, 125, 129, 133, 137, 141, 145, 149, 153,
+		157, 161, 166, 170, 174, 177, 181, 184, 188, 192, 196, 200, 204, 208, 212, 216,
+		220, 224, 228, 231, 234, 239, 244, 249, 253, 256, 261, 265, 269, 272, 275, 279,
+		283, 287, 290, 294, 298, 301, 306, 310, 317, 321, 325, 328, 332, 336, 340, 345,
+		349, 353, 357, 360, 364, 368, 372, 376, 380, 385, 389, 392, 396, 400, 404, 407,
+		411, 414, 418, 422, 426, 429, 433, 437, 441, 445, 448, 452, 456, 460, 464, 468,
+		472, 476, 480, 484, 488, 492, 496, 500, 504, 508, 512, 516, 520, 525, 529, 533,
+		537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 576, 580, 584, 589, 593, 597,
+		600, 604, 609, 612, 614, 618, 623, 626, 629, 633, 637, 640, 643, 646, 650, 653,
+		657, 661, 665, 669, 672, 676, 680, 685, 689, 693, 697, 701, 705, 709, 713, 717,
+		721, 725, 729, 733, 737, 742, 746, 750, 754, 758, 762, 766, 770, 774, 778, 782,
+		786, 789, 793, 797, 800, 803, 806, 809, 812, 817, 822, 826, 831, 835, 839, 843,
+		846, 850, 855, 859, 863, 867, 871, 875, 878, 881, 885, 889, 893, 897, 901, 905,
+		909, 913, 917, 921, 925, 929, 933, 937, 941, 946, 950, 954, 958, 962, 966, 970,
+		974, 978, 982, 986, 990, 994, 998, 1002, 0, 4, 9, 13, 16, 18, 23, 28,
+		33, 36, 39, 43, 47, 51, 54, 59, 62, 65, 68, 72, 75, 79, 83, 87,
+		91, 95, 99, 104, 108, 112, 117, 120, 124, 129, 133, 137, 140, 144, 148, 152,
+		155, 159, 163, 167, 171, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215,
+		219, 223, 227, 231, 236, 240, 244, 249, 253, 257, 261, 266, 270, 274, 278, 282,
+		286, 290, 294, 298, 302, 306, 310, 314, 318, 322, 326, 332, 338, 342, 346, 352,
+		356, 360, 364, 368, 372, 376, 379, 383, 387, 391, 395, 399, 403, 407, 411, 415,
+		419, 424, 428, 431, 435, 437, 442, 448, 453, 458, 462, 467, 471, 476, 479, 482,
+		486, 490, 494, 498, 502, 506, 510, 514, 519, 523, 527, 531, 535, 539, 543, 547,
+		551, 555, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 608, 613,
+		616, 620, 625, 629, 633, 637, 641, 645, 649, 653, 658, 662, 667, 671, 676, 680,
+		684, 688, 692, 695, 699, 703, 706, 710, 714, 719, 722, 725, 729, 733, 736, 740,
+		744, 749, 753, 756, 759, 763, 767, 771, 774, 778, 782, 786, 790, 794, 798, 802,
+		806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 847, 851, 855, 859, 863, 867,
+		870, 874, 878, 882, 886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930,
+		934, 938, 942, 946, 950, 954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994,
+		998, 1002, 1005, 1008, 1011, 1015, 1018, 1021, 1025, 0, 4, 7, 11, 15, 18, 21,
+		25, 29, 32, 36, 42, 46, 50, 56, 59, 63, 66, 70, 73, 76, 79, 84,
+		89, 94, 98, 101, 105, 109, 113, 117, 121, 125, 129, 133, 137, 141, 145, 149,
+		153, 157, 161, 165, 169, 173, 178, 182, 186, 190, 193, 198, 203, 206, 209, 213,
+		217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 277,
+		283, 286, 290, 294, 299, 303, 306, 310, 314, 318, 321, 326, 330, 334, 338, 341,
+		344, 349, 354, 357, 361, 365, 368, 374, 378, 382, 385, 388, 392, 396, 400, 404,
+		408, 412, 416, 421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469,
+		473, 477, 481, 485, 489, 493, 497, 501, 505, 509, 512, 516, 519, 523, 527, 531,
+		535, 539, 542, 546, 550, 554, 558, 562, 564, 568, 571, 575, 579, 582, 586, 589,
+		593, 596, 600, 603, 607, 611, 615, 620, 624, 628, 632, 636, 640, 644, 648, 652,
+		656, 660, 665, 669, 673, 677, 681, 685, 689, 693, 697, 701, 705, 709, 713, 717,
+		721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 762, 765, 769, 773, 776, 780,
+		784, 788, 792, 796, 800, 805, 809, 813, 816, 821, 825, 828, 832, 835, 840, 845,
+		849, 852, 857, 861, 865, 869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909,
+		913, 917, 922, 926, 929, 933, 937, 941, 945, 949, 953, 957, 962, 966, 971, 975,
+		979, 983, 987, 991, 995, 998, 1002, 1005, 1009, 1013, 0, 4, 8, 12, 16, 20,
+		24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84,
+		88, 91, 95, 99, 103, 107, 111, 115, 118, 123, 127, 131, 136, 140, 143, 148,
+		152, 155, 160, 164, 167, 171, 175, 178, 182, 185, 189, 193, 197, 201, 205, 210,
+		214, 218, 222, 226, 230, 234, 238, 240, 244, 248, 252, 256, 260, 264, 269, 274,
+		279, 283, 288, 292, 295, 299, 303, 307, 312, 315, 319, 323, 327, 331, 334, 338,
+		342, 346, 350, 354, 359, 363, 367, 371, 376, 380, 387, 391, 394, 398, 402, 405,
+		408, 412, 417, 422, 426, 430, 434, 438, 442, 445, 448, 452, 456, 461, 465, 469,
+		472, 476, 480, 486, 490, 497, 501, 504, 508, 512, 516, 520, 524, 528, 532, 536,
+		540, 543, 548, 552, 557, 561, 565, 569, 573, 577, 581, 585, 591, 596, 600, 604,
+		608, 612, 616, 621, 626, 629, 633, 638, 642, 646, 650, 654, 658, 662, 666, 670,
+		674, 678, 682, 687, 691, 696, 700, 706, 710, 713, 717, 721, 725, 729, 733, 737,
+		741, 745, 748, 752, 756, 760, 764, 767, 771, 775, 781, 785, 789, 793, 797, 801,
+		805, 811, 815, 817, 821, 825, 829, 832, 836, 839, 843, 847, 851, 854, 858, 862,
+		866, 869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 925,
+		929, 934, 938, 942, 945, 948, 952, 956, 960, 964, 968, 972, 976, 982, 986, 991,
+		995, 998, 1002, 1007, 1011, 1015, 1019, 1023, 1027, 1031, 1037, 0, 4, 8, 14, 19,
+		23, 27, 31, 35, 40, 44, 48, 52, 56, 62, 66, 71, 75, 80, 84, 88,
+		92, 96, 100, 103, 107, 111, 115, 119, 124, 128, 132, 135, 139, 143, 146, 150,
+		154, 158, 162, 165, 170, 175, 179, 182, 186, 190, 196, 200, 204, 208, 212, 216,
+		220, 224, 228, 232, 236, 240, 244, 248, 252, 255, 259, 265, 268, 272, 276, 278,
+		281, 284, 287, 292, 295, 298, 302, 306, 310, 314, 318, 322, 326, 330, 334, 338,
+		342, 346, 350, 354, 358, 362, 366, 369, 373, 377, 380, 384, 388, 391, 395, 398,
+		402, 407, 411, 416, 420, 424, 428, 432, 436, 441, 446, 450, 454, 458, 462, 466,
+		470, 474, 477, 481, 486, 490, 494, 498, 502, 506, 509, 513, 516, 521, 526, 529,
+		531, 536, 540, 544, 548, 552, 556, 561, 565, 569, 573, 577, 582, 586, 590, 594,
+		598, 602, 606, 610, 614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658,
+		662, 666, 670, 674, 677, 680, 684, 689, 694, 699, 703, 708, 712, 716, 720, 724,
+		728, 732, 736, 740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788,
+		793, 797, 801, 805, 809, 813, 817, 821, 825, 829, 833, 836, 840, 844, 847, 852,
+		855, 859, 863, 866, 870, 874, 878, 882, 886, 890, 893, 898, 902, 907, 911, 915,
+		919, 923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979,
+		983, 987, 991, 995, 1000, 1005, 1009, 1013, 1017, 1021, 1024, 1028, 0, 4, 9, 13,
+		18, 22, 26, 30, 34, 38, 42, 45, 49, 53, 57, 61, 65, 69, 73, 77,
+		81, 85, 89, 93, 97, 101, 105, 109, 113, 117, 121, 125, 129, 135, 138, 142,
+		147, 150, 154, 157, 164, 168, 172, 176, 180, 184, 187, 191, 195, 199, 203, 207,
+		211, 215, 219, 223, 227, 231, 235, 239, 243, 247, 252, 256, 260, 264, 268, 271,
+		275, 279, 283, 286, 290, 294, 298, 302, 306, 310, 314, 320, 324, 327, 332, 337,
+		341, 344, 348, 352, 356, 360, 364, 368, 372, 377, 381, 385, 389, 393, 397, 401,
+		405, 408, 412, 416, 420, 424, 428, 433, 437, 441, 445, 450, 454, 458, 462, 465,
+		468, 472, 476, 480, 484, 489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529,
+		533, 537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577, 580, 585, 590, 593,
+		597, 600, 604, 608, 612, 616, 620, 624, 628, 632, 636, 642, 646, 650, 654, 658,
+		662, 666, 670, 674, 678, 682, 686, 689, 693, 697, 701, 705, 709, 712, 716, 720,
+		724, 728, 732, 736, 740, 744, 747, 750, 754, 758, 762, 766, 770, 774, 778, 782,
+		786, 791, 795, 799, 803, 807, 811, 815, 819, 823, 827, 831, 835, 839, 844, 848,
+		852, 856, 860, 864, 868, 873, 878, 882, 886, 889, 893, 897, 901, 906, 910, 914,
+		917, 921, 925, 929, 933, 938, 942, 946, 950, 953, 957, 961, 965, 969, 972, 976,
+		980, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 1028, 0, 4, 8,
+		11, 15, 19, 23, 27, 31, 35, 39, 43, 46, 50, 54, 57, 61, 65, 69,
+		74, 77, 81, 85, 89, 93, 97, 101, 105, 110, 114, 119, 121, 124, 128, 132,
+		135, 139, 143, 146, 150, 154, 158, 161, 164, 168, 174, 178, 182, 186, 190, 194,
+		198, 202, 206, 210, 214, 218, 222, 226, 230, 234, 238, 242, 246, 250, 254, 258,
+		262, 266, 270, 274, 278, 281, 287, 290, 294, 297, 301, 306, 310, 314, 318, 322,
+		326, 330, 334, 338, 342, 346, 349, 354, 359, 363, 368, 372, 376, 380, 384, 388,
+		393, 397, 402, 406, 410, 414, 417, 422, 426, 430, 435, 439, 445, 448, 452, 456,
+		460, 464, 469, 473, 479, 483, 487, 491, 494, 498, 503, 507, 511, 515, 519, 523,
+		526, 530, 534, 538, 545, 549, 553, 557, 561, 565, 569, 573, 577, 581, 586, 590,
+		594, 598, 602, 606, 611, 615, 619, 623, 627, 632, 636, 639, 644, 648, 652, 656,
+		660, 664, 668, 672, 676, 680, 684, 688, 692, 696, 701, 705, 709, 713, 716, 720,
+		724, 728, 732, 736, 740, 744, 748, 753, 757, 761, 766, 768, 771, 775, 779, 783,
+		787, 790, 794, 798, 802, 806, 810, 814, 818, 822, 827, 831, 835, 839, 843, 848,
+		851, 855, 859, 863, 867, 871, 875, 879, 883, 886, 890, 894, 898, 901, 905, 908,
+		912, 915, 918, 922, 927, 931, 935, 938, 943, 947, 950, 954, 958, 962, 966, 970,
+		974, 978, 982, 986, 990, 995, 999, 1003, 1007, 1010, 1013, 1017, 1021, 1025, 0, 4,
+		8, 12, 15, 19, 23, 27, 31, 35, 39, 43, 47, 51, 55, 60, 63, 67,
+		70, 73, 77, 81, 85, 89, 94, 98, 103, 107, 112, 115, 119, 123, 126, 130,
+		134, 138, 141, 144, 148, 152, 156, 161, 165, 169, 173, 177, 181, 185, 189, 193,
+		197, 200, 204, 208, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257,
+		261, 265, 269, 272, 275, 278, 282, 287, 291, 295, 298, 304, 309, 313, 317, 321,
+		324, 328, 333, 337, 340, 343, 347, 351, 355, 358, 363, 367, 371, 375, 378, 382,
+		386, 390, 394, 397, 401, 405, 409, 413, 417, 421, 425, 429, 433, 436, 439, 443,
+		446, 450, 454, 459, 463, 467, 471, 475, 480, 484, 488, 492, 496, 500, 504, 508,
+		511, 515, 519, 523, 527, 531, 535, 539, 543, 547, 551, 555, 559, 563, 567, 571,
+		576, 580, 584, 588, 592, 596, 600, 604, 608, 611, 615, 619, 622, 625, 629, 633,
+		637, 641, 645, 649, 653, 657, 660, 664, 668, 672, 676, 680, 684, 687, 691, 695,
+		699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751, 755, 759,
+		763, 767, 770, 775, 779, 783, 787, 791, 795, 798, 801, 805, 809, 813, 816, 820,
+		824, 827, 830, 835, 838, 842, 846, 850, 854, 858, 862, 867, 870, 874, 877, 882,
+		886, 890, 894, 898, 902, 906, 910, 914, 917, 921, 925, 929, 933, 937, 941, 945,
+		949, 953, 957, 961, 966, 969, 973, 977, 980, 983, 987, 991, 995, 999, 1003, 0,
+		4, 8, 11, 15, 19, 23, 26, 30, 35, 39, 43, 47, 51, 55, 59, 63,
+		67, 71, 75, 79, 83, 87, 91, 96, 100, 104, 108, 112, 116, 120, 124, 126,
+		130, 135, 139, 143, 147, 151, 155, 159, 163, 168, 172, 176, 180, 184, 188, 192,
+		196, 199, 202, 207, 210, 213, 217, 220, 225, 228, 232, 236, 240, 244, 249, 253,
+		257, 261, 265, 271, 275, 279, 283, 287, 291, 295, 299, 303, 307, 311, 315, 319,
+		323, 327, 330, 333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 372, 376, 380,
+		384, 388, 392, 396, 400, 404, 407, 411, 415, 419, 424, 428, 432, 436, 441, 445,
+		450, 455, 458, 462, 466, 470, 474, 478, 481, 485, 489, 493, 497, 501, 505, 509,
+		513, 517, 521, 525, 529, 532, 536, 539, 543, 547, 551, 555, 559, 563, 567, 571,
+		575, 579, 583, 586, 590, 594, 598, 602, 607, 611, 614, 618, 622, 628, 631, 635,
+		638, 642, 645, 651, 655, 659, 662, 666, 669, 673, 677, 680, 684, 688, 691, 696,
+		700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744, 748, 752, 756, 760,
+		764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 803, 807, 811, 815, 819, 823,
+		827, 831, 835, 838, 842, 846, 850, 854, 858, 862, 866, 871, 874, 878, 882, 886,
+		889, 893, 898, 902, 905, 908, 911, 914, 918, 922, 926, 930, 933, 937, 941, 945,
+		949, 953, 957, 961, 965, 969, 973, 977, 981, 986, 990, 994, 998, 1002, 1006, 1010,
+		0, 4, 8, 11, 15, 19, 23, 28, 31, 34, 38, 41, 44, 48, 52, 56,
+		59, 63, 67, 71, 75, 79, 82, 86, 90, 94, 98, 102, 106, 110, 114, 118,
+		122, 126, 130, 134, 138, 144, 148, 153, 160, 164, 167, 171, 176, 179, 183, 187,
+		191, 197, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252,
+		256, 260, 264, 268, 272, 276, 280, 284, 289, 294, 298, 303, 307, 311, 316, 319,
+		323, 327, 331, 335, 340, 345, 349, 353, 357, 361, 365, 371, 375, 378, 381, 385,
+		389, 393, 397, 401, 405, 409, 413, 418, 422, 426, 430, 432, 436, 440, 444, 448,
+		452, 456, 460, 464, 467, 471, 475, 479, 483, 487, 491, 495, 499, 504, 509, 513,
+		517, 521, 525, 529, 533, 537, 541, 546, 550, 555, 559, 563, 567, 571, 574, 578,
+		582, 586, 589, 592, 596, 600, 604, 608, 612, 616, 620, 624, 627, 631, 635, 639,
+		643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 688, 692, 697, 700, 705,
+		709, 713, 717, 721, 725, 730, 733, 737, 741, 745, 749, 754, 759, 763, 768, 772,
+		776, 781, 785, 789, 793, 797, 801, 805, 809, 812, 815, 818, 822, 826, 830, 834,
+		837, 841, 845, 851, 855, 859, 863, 867, 870, 874, 878, 882, 886, 890, 894, 898,
+		902, 906, 910, 914, 918, 922, 926, 931, 935, 939, 943, 947, 953, 957, 961, 965,
+		968, 972, 976, 980, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1016, 1021, 1025, 1030,
+		1034, 0, 4, 8, 12, 16, 21, 25, 29, 32, 35, 39, 44, 48, 52, 56,
+		60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 103, 107, 111, 115, 119,
+		123, 127, 131, 134, 138, 142, 146, 150, 154, 158, 163, 168, 172, 176, 180, 184,
+		188, 194, 198, 201, 205, 209, 212, 215, 219, 222, 226, 229, 233, 238, 242, 246,
+		250, 253, 258, 262, 266, 270, 274, 277, 281, 285, 289, 293, 296, 300, 304, 308,
+		312, 315, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372,
+		376, 380, 384, 390, 395, 399, 403, 407, 411, 415, 419, 424, 428, 432, 435, 439,
+		443, 447, 452, 456, 459, 464, 469, 474, 478, 482, 486, 489, 493, 496, 500, 504,
+		509, 513, 518, 522, 526, 530, 534, 539, 543, 546, 550, 554, 558, 561, 565, 570,
+		574, 578, 582, 586, 590, 594, 598, 602, 606, 610, 613, 618, 622, 626, 629, 633,
+		637, 641, 645, 648, 652, 656, 661, 664, 668, 671, 676, 680, 684, 688, 692, 696,
+		701, 704, 708, 713, 717, 721, 726, 729, 733, 737, 741, 745, 748, 753, 757, 761,
+		766, 770, 775, 778, 781, 785, 789, 793, 798, 802, 806, 811, 815, 819, 822, 827,
+		830, 834, 838, 842, 846, 850, 854, 858, 862, 866, 870, 873, 877, 881, 886, 891,
+		895, 899, 902, 906, 910, 915, 919, 923, 927, 931, 935, 940, 944, 948, 952, 957,
+		963, 967, 971, 975, 979, 983, 987, 991, 995, 999, 1002, 1007, 1011, 1016, 1019, 1023,
+		1027, 1032, 0, 4, 8, 13, 18, 23, 30, 34, 37, 41, 44, 49, 53, 56,
+		60, 65, 69, 73, 77, 81, 85, 89, 94, 98, 103, 107, 111, 116, 120, 124,
+		128, 132, 136, 139, 143, 147, 151, 154, 158, 162, 166, 170, 174, 178, 182, 186,
+		191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231, 235, 239, 243, 247, 251,
+		255, 259, 263, 267, 271, 275, 280, 284, 288, 292, 296, 300, 304, 308, 312, 317,
+		321, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 375, 379,
+		383, 386, 390, 394, 397, 401, 407, 411, 415, 419, 423, 426, 430, 434, 438, 441,
+		445, 449, 453, 457, 462, 466, 470, 474, 478, 482, 486, 490, 494, 498, 502, 505,
+		509, 513, 517, 521, 525, 529, 533, 539, 543, 547, 551, 555, 559, 563, 567, 571,
+		574, 578, 582, 586, 590, 594, 598, 604, 607, 610, 614, 618, 622, 626, 630, 636,
+		640, 644, 648, 651, 654, 658, 662, 666, 670, 674, 678, 682, 686, 690, 694, 698,
+		702, 706, 710, 714, 718, 723, 727, 731, 735, 739, 742, 748, 752, 757, 761, 765,
+		769, 773, 777, 781, 785, 789, 793, 797, 800, 804, 808, 812, 816, 820, 824, 828,
+		832, 835, 839, 843, 847, 853, 857, 861, 865, 869, 873, 877, 881, 885, 889, 893,
+		897, 901, 905, 909, 913, 917, 921, 925, 928, 931, 935, 939, 943, 947, 951, 955,
+		959, 963, 967, 971, 975, 979, 983, 987, 991, 996, 1000, 1004, 1008, 1012, 1016, 1020,
+		1024, 1027, 1030, 0, 4, 8, 13, 17, 21, 25, 29, 33, 37, 41, 45, 49,
+		53, 57, 61, 65, 69, 73, 77, 81, 85, 89, 95, 99, 104, 108, 112, 116,
+		120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 163, 168, 172, 177, 181,
+		185, 189, 193, 197, 201, 205, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245,
+		249, 253, 258, 263, 267, 271, 275, 279, 283, 287, 291, 295, 300, 304, 308, 312,
+		315, 319, 323, 327, 331, 335, 339, 345, 351, 354, 359, 363, 367, 371, 375, 379,
+		383, 387, 391, 395, 399, 403, 407, 411, 415, 419, 423, 427, 430, 434, 438, 442,
+		446, 449, 453, 457, 461, 465, 468, 472, 475, 479, 482, 486, 490, 494, 498, 502,
+		506, 509, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560, 564,
+		568, 572, 575, 579, 583, 587, 590, 594, 598, 602, 606, 610, 614, 618, 622, 626,
+		631, 634, 638, 642, 646, 649, 653, 658, 662, 666, 670, 674, 677, 681, 685, 689,
+		693, 697, 701, 705, 708, 712, 715, 719, 722, 726, 730, 733, 738, 741, 744, 748,
+		751, 754, 758, 762, 766, 770, 774, 778, 782, 786, 790, 794, 798, 802, 806, 810,
+		814, 819, 823, 827, 831, 835, 840, 845, 851, 854, 859, 862, 866, 869, 874, 878,
+		882, 886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942,
+		946, 950, 954, 958, 961, 965, 969, 972, 977, 981, 984, 987, 991, 996, 1002, 1006,
+		1010, 1014, 1018, 1022, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 43,
+		47, 51, 55, 57, 62, 66, 69, 73, 78, 83, 86, 90, 93, 96, 102, 106,
+		110, 113, 117, 121, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 166, 170,
+		173, 177, 180, 183, 187, 191, 194, 198, 202, 205, 209, 213, 217, 221, 225, 229,
+		233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 278, 282, 286, 290, 294,
+		297, 301, 306, 310, 314, 319, 322, 328, 331, 335, 339, 343, 347, 351, 355, 359,
+		363, 367, 371, 375, 379, 383, 387, 392, 398, 403, 406, 410, 414, 417, 423, 427,
+		432, 436, 440, 444, 448, 452, 456, 459, 463, 467, 472, 474, 477, 481, 485, 489,
+		492, 496, 501, 505, 510, 514, 517, 521, 525, 529, 533, 538, 543, 547, 551, 556,
+		560, 564, 569, 574, 578, 583, 586, 590, 594, 598, 602, 606, 610, 614, 618, 621,
+		625, 629, 633, 637, 641, 645, 650, 654, 657, 661, 664, 668, 673, 678, 682, 686,
+		690, 694, 698, 703, 707, 710, 713, 717, 722, 726, 730, 733, 737, 741, 745, 749,
+		753, 757, 760, 763, 767, 771, 775, 779, 783, 787, 790, 795, 799, 805, 808, 812,
+		815, 819, 823, 827, 830, 834, 838, 843, 846, 849, 851, 857, 861, 867, 871, 875,
+		880, 884, 887, 890, 894, 897, 901, 905, 909, 913, 917, 921, 925, 929, 932, 936,
+		939, 943, 947, 951, 955, 959, 963, 968, 971, 974, 978, 982, 985, 989, 992, 996,
+		999, 1003, 1006, 1010, 1014, 0, 3, 8, 12, 16, 19, 23, 27, 31, 35, 39,
+		43, 47, 51, 55, 59, 63, 67, 71, 75, 79, 84, 90, 94, 98, 104, 107,
+		111, 115, 120, 124, 128, 132, 136, 140, 144, 148, 152, 155, 159, 165, 169, 173,
+		177, 182, 186, 190, 195, 201, 205, 209, 213, 216, 220, 224, 227, 231, 235, 239,
+		243, 247, 251, 255, 259, 263, 267, 272, 276, 279, 283, 288, 293, 297, 302, 306,
+		310, 314, 318, 322, 328, 331, 334, 338, 341, 345, 349, 353, 357, 362, 366, 370,
+		374, 378, 382, 386, 390, 393, 399, 403, 407, 411, 415, 419, 423, 427, 431, 435,
+		439, 443, 447, 451, 455, 459, 463, 467, 471, 475, 479, 483, 486, 490, 493, 497,
+		500, 504, 508, 511, 515, 519, 522, 524, 528, 533, 537, 541, 545, 548, 552, 556,
+		560, 564, 568, 572, 576, 580, 584, 588, 591, 595, 599, 603, 607, 611, 615, 619,
+		623, 627, 631, 635, 639, 644, 647, 651, 654, 657, 661, 665, 671, 675, 679, 682,
+		686, 690, 694, 697, 701, 706, 710, 714, 719, 723, 728, 732, 736, 740, 744, 748,
+		752, 756, 760, 764, 768, 772, 776, 780, 784, 786, 790, 794, 798, 804, 808, 812,
+		816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 869, 873, 876,
+		879, 882, 886, 890, 894, 898, 902, 906, 910, 914, 919, 923, 927, 931, 935, 939,
+		943, 947, 951, 954, 958, 962, 966, 970, 974, 979, 983, 987, 991, 995, 999, 1003,
+		1007, 1011, 1015, 1019, 1023, 1027, 0, 4, 8, 12, 16, 21, 24, 28, 33, 39,
+		44, 48, 52, 56, 60, 64, 68, 71, 75, 79, 84, 90, 93, 97, 102, 106,
+		110, 116, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 163, 167, 171,
+		175, 179, 183, 187, 191, 197, 200, 206, 210, 214, 218, 222, 226, 230, 234, 238,
+		242, 246, 250, 254, 258, 262, 266, 270, 274, 278, 282, 286, 289, 293, 297, 301,
+		305, 309, 314, 318, 322, 326, 330, 334, 337, 341, 345, 349, 353, 357, 360, 365,
+		369, 373, 377, 381, 387, 391, 395, 399, 403, 407, 412, 415, 419, 423, 427, 431,
+		435, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 488, 492, 496,
+		500, 504, 508, 512, 516, 520, 524, 528, 533, 536, 540, 544, 548, 552, 556, 561,
+		566, 571, 575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 619, 623, 627,
+		630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682, 686, 690,
+		694, 699, 703, 708, 712, 716, 720, 723, 728, 732, 736, 740, 743, 747, 751, 755,
+		759, 763, 767, 771, 774, 777, 781, 785, 791, 795, 799, 803, 807, 811, 815, 819,
+		823, 827, 831, 835, 840, 843, 847, 851, 855, 859, 863, 867, 872, 875, 878, 883,
+		887, 891, 895, 899, 904, 908, 912, 916, 920, 924, 928, 933, 937, 942, 946, 950,
+		955, 959, 963, 967, 971, 975, 982, 986, 990, 994, 998, 1002, 1006, 1010, 1014, 1018,
+		1022, 1026, 1029, 1034, 1038, 1042, 1046, 0, 5, 9, 13, 17, 21, 25, 29, 33,
+		37, 41, 45, 49, 54, 58, 63, 67, 71, 75, 79, 84, 88, 92, 96, 100,
+		105, 109, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164,
+		168, 171, 175, 179, 183, 187, 193, 197, 201, 205, 209, 213, 217, 221, 224, 228,
+		232, 237, 240, 244, 248, 252, 256, 260, 264, 270, 274, 278, 282, 285, 289, 293,
+		297, 301, 305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 354, 357,
+		361, 365, 367, 371, 376, 380, 384, 388, 392, 396, 402, 405, 411, 416, 420, 424,
+		428, 433, 437, 442, 447, 451, 455, 459, 463, 468, 472, 476, 481, 485, 489, 494,
+		497, 501, 505, 509, 513, 519, 524, 528, 532, 536, 540, 544, 548, 553, 557, 561,
+		566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606, 610, 614, 618, 622, 626,
+		631, 635, 639, 642, 646, 651, 655, 660, 664, 669, 673, 677, 681, 685, 689, 694,
+		698, 702, 706, 710, 714, 718, 722, 726, 729, 732, 736, 740, 744, 748, 752, 756,
+		760, 763, 767, 770, 775, 779, 783, 787, 791, 795, 799, 803, 807, 811, 815, 819,
+		822, 825, 829, 833, 837, 841, 845, 849, 852, 857, 861, 865, 870, 875, 879, 882,
+		886, 890, 895, 899, 903, 908, 911, 914, 919, 923, 927, 931, 935, 938, 941, 944,
+		948, 951, 955, 960, 966, 970, 975, 978, 981, 985, 990, 994, 998, 1002, 1006, 1011,
+		1015, 1019, 1022, 1026, 1030, 1035, 1038, 1042, 0, 4, 9, 13, 17, 22, 27, 32,
+		36, 41, 45, 49, 53, 57, 62, 65, 69, 73, 78, 82, 86, 90, 94, 98,
+		102, 106, 110, 114, 118, 122, 125, 129, 133, 136, 140, 146, 151, 157, 160, 164,
+		166, 170, 175, 179, 184, 189, 192, 195, 199, 204, 208, 212, 217, 221, 225, 230,
+		234, 238, 242, 246, 250, 254, 258, 262, 266, 270, 274, 278, 282, 286, 291, 295,
+		298, 303, 308, 312, 316, 320, 324, 327, 331, 334, 339, 344, 348, 352, 356, 360,
+		364, 368, 372, 376, 379, 383, 387, 392, 396, 400, 404, 407, 411, 415, 419, 423,
+		429, 433, 438, 442, 445, 449, 452, 455, 458, 461, 465, 468, 472, 477, 481, 487,
+		492, 496, 500, 503, 507, 511, 515, 519, 523, 527, 531, 535, 539, 543, 547, 551,
+		555, 559, 563, 567, 572, 576, 581, 585, 589, 593, 597, 601, 605, 610, 614, 619,
+		624, 628, 632, 636, 642, 646, 650, 654, 658, 662, 666, 671, 675, 680, 684, 689,
+		694, 698, 704, 707, 712, 717, 721, 725, 729, 733, 737, 741, 746, 751, 755, 758,
+		763, 766, 770, 774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814, 818, 824,
+		828, 832, 838, 843, 847, 851, 855, 859, 863, 867, 871, 875, 879, 882, 886, 890,
+		894, 898, 902, 905, 910, 914, 918, 922, 926, 931, 935, 939, 943, 948, 953, 957,
+		961, 965, 970, 975, 978, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1012, 1016, 1021,
+		1025, 1029, 1033, 1038, 1042, 1046, 1050, 1054, 1057, 0, 4, 8, 12, 16, 20, 24,
+		27, 32, 38, 42, 47, 52, 56, 61, 65, 69, 73, 77, 81, 85, 90, 95,
+		98, 102, 106, 110, 114, 117, 121, 125, 129, 133, 137, 140, 144, 147, 151, 154,
+		158, 162, 165, 170, 174, 178, 182, 186, 190, 194, 198, 202, 206, 210, 213, 217,
+		221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 277, 281,
+		285, 289, 293, 297, 302, 306, 311, 315, 320, 324, 328, 331, 334, 338, 342, 346,
+		350, 354, 358, 362, 366, 370, 373, 376, 380, 384, 388, 392, 396, 400, 403, 406,
+		410, 414, 418, 421, 426, 430, 434, 438, 442, 446, 450, 454, 458, 462, 466, 470,
+		474, 478, 482, 487, 491, 495, 498, 503, 507, 511, 516, 520, 524, 528, 532, 535,
+		540, 544, 548, 552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600,
+		604, 608, 612, 616, 620, 624, 628, 632, 636, 640, 644, 648, 653, 657, 660, 664,
+		668, 672, 676, 681, 685, 690, 694, 698, 701, 705, 709, 714, 717, 721, 724, 728,
+		732, 736, 740, 744, 748, 752, 756, 760, 764, 768, 772, 777, 781, 785, 789, 793,
+		797, 801, 805, 809, 813, 817, 821, 826, 830, 834, 838, 842, 846, 850, 854, 858,
+		862, 866, 870, 874, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923,
+		926, 930, 935, 940, 944, 948, 951, 954, 958, 963, 967, 971, 976, 980, 983, 987,
+		991, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 1028, 0, 4, 8, 12, 16, 20,
+		24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84,
+		88, 92, 96, 100, 104, 108, 111, 115, 119, 123, 127, 131, 135, 140, 144, 148,
+		153, 157, 161, 165, 169, 173, 177, 181, 185, 189, 192, 196, 200, 204, 208, 212,
+		216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276,
+		280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340,
+		344, 348, 353, 357, 361, 364, 368, 372, 376, 380, 384, 387, 391, 395, 399, 403,
+		407, 411, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468,
+		472, 476, 480, 484, 489, 493, 499, 503, 507, 511, 516, 520, 524, 528, 531, 535,
+		539, 543, 547, 551, 555, 558, 562, 567, 571, 575, 579, 583, 587, 591, 595, 599,
+		603, 607, 611, 615, 619, 624, 627, 631, 635, 639, 643, 648, 652, 658, 662, 666,
+		670, 674, 678, 682, 686, 690, 694, 699, 702, 707, 711, 715, 720, 724, 729, 732,
+		737, 743, 747, 751, 755, 759, 763, 769, 773, 778, 782, 786, 790, 794, 798, 802,
+		806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850, 854, 858, 862, 866,
+		870, 874, 878, 882, 886, 890, 894, 898, 901, 905, 909, 913, 918, 922, 926, 930,
+		934, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995,
+		999, 1003, 1007, 1011, 1015, 1019, 1023, 1026, 1030, 1033, 1037, 0, 4, 8, 12, 16,
+		20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80,
+		84, 88, 92, 96, 100, 105, 109, 113, 117, 121, 126, 130, 134, 138, 142, 146,
+		150, 154, 158, 162, 166, 170, 174, 178, 181, 185, 189, 193, 197, 201, 205, 209,
+		213, 217, 221, 225, 228, 232, 236, 240, 246, 250, 254, 258, 262, 266, 270, 274,
+		278, 282, 286, 290, 294, 298, 302, 306, 310, 314, 317, 321, 325, 329, 333, 337,
+		341, 345, 349, 353, 357, 361, 365, 369, 373, 377, 381, 385, 389, 394, 398, 402,
+		406, 410, 414, 418, 422, 426, 430, 434, 437, 440, 444, 448, 452, 456, 460, 464,
+		468, 474, 478, 483, 486, 491, 495, 498, 502, 506, 510, 514, 518, 522, 526, 530,
+		534, 538, 542, 546, 550, 554, 558, 562, 566, 569, 573, 577, 581, 585, 589, 592,
+		596, 601, 604, 607, 611, 615, 619, 623, 627, 631, 635, 639, 643, 647, 651, 657,
+		661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 701, 706, 710, 716, 720, 726,
+		729, 733, 738, 742, 746, 750, 754, 758, 762, 766, 771, 775, 779, 783, 787, 791,
+		795, 799, 803, 807, 812, 815, 819, 822, 827, 832, 836, 840, 844, 847, 850, 855,
+		859, 863, 868, 872, 876, 880, 884, 888, 894, 898, 902, 906, 910, 914, 918, 922,
+		926, 929, 933, 937, 941, 945, 949, 952, 956, 960, 963, 966, 972, 978, 982, 985,
+		989, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 1028, 1032, 0, 4, 8, 12,
+		16, 20, 24, 28, 32, 36, 40, 44, 48, 53, 58, 62, 67, 70, 75, 78,
+		84, 90, 94, 97, 101, 105, 109, 113, 117, 123, 127, 131, 135, 139, 143, 147,
+		151, 155, 159, 163, 167, 171, 176, 180, 184, 188, 192, 196, 200, 206, 209, 213,
+		218, 221, 225, 228, 233, 237, 241, 246, 251, 254, 257, 264, 267, 271, 275, 279,
+		283, 287, 291, 294, 298, 301, 305, 309, 314, 318, 323, 328, 331, 336, 341, 345,
+		350, 354, 358, 361, 365, 368, 372, 376, 379, 384, 388, 391, 394, 398, 402, 406,
+		410, 414, 418, 423, 427, 431, 436, 440, 444, 448, 453, 456, 461, 466, 471, 475,
+		478, 484, 489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541,
+		545, 549, 553, 557, 561, 565, 569, 573, 577, 581, 585, 590, 594, 600, 605, 609,
+		612, 616, 620, 624, 628, 631, 634, 638, 641, 645, 649, 653, 657, 661, 665, 668,
+		671, 675, 679, 683, 687, 691, 695, 700, 705, 709, 713, 717, 721, 725, 729, 734,
+		738, 742, 746, 751, 755, 759, 763, 766, 770, 774, 778, 783, 788, 792, 796, 800,
+		806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850, 854, 858, 861, 865,
+		869, 873, 877, 880, 884, 888, 893, 898, 902, 906, 910, 914, 918, 923, 927, 931,
+		935, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995,
+		999, 1003, 1006, 1009, 1013, 1017, 1021, 1026, 1030, 1035, 1039, 1043, 1047, 0, 4, 8,
+		12, 16, 20, 24, 28, 32, 36, 40, 44, 47, 52, 56, 61, 64, 67, 70,
+		74, 77, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 125, 129, 134,
+		138, 142, 147, 151, 156, 160, 163, 167, 171, 175, 179, 183, 187, 191, 195, 199,
+		203, 207, 211, 215, 218, 222, 227, 231, 235, 239, 243, 247, 251, 254, 258, 263,
+		266, 271, 275, 279, 283, 287, 291, 295, 299, 303, 307, 311, 315, 320, 324, 329,
+		333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 377, 382, 386, 390, 393,
+		397, 401, 405, 409, 413, 417, 421, 424, 428, 432, 436, 439, 444, 448, 452, 455,
+		460, 464, 468, 472, 476, 480, 485, 489, 493, 497, 501, 505, 509, 513, 517, 521,
+		525, 529, 533, 537, 542, 546, 550, 554, 559, 562, 565, 569, 572, 576, 581, 586,
+		590, 594, 598, 602, 606, 610, 614, 618, 622, 626, 630, 634, 638, 642, 647, 651,
+		654, 659, 662, 665, 670, 674, 678, 684, 689, 693, 698, 702, 706, 711, 715, 719,
+		723, 727, 731, 734, 738, 742, 745, 751, 755, 759, 763, 767, 771, 775, 780, 785,
+		790, 794, 798, 804, 808, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853,
+		857, 861, 866, 870, 874, 877, 882, 886, 891, 897, 901, 905, 909, 913, 916, 920,
+		924, 928, 932, 936, 939, 942, 946, 950, 954, 958, 961, 965, 969, 972, 976, 980,
+		984, 988, 992, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025, 1029, 1032, 1036, 0, 4,
+		8, 13, 18, 23, 27, 31, 35, 39, 43, 47, 51, 55, 59, 63, 67, 71,
+		76, 80, 84, 88, 90, 94, 98, 102, 106, 110, 114, 118, 122, 126, 130, 134,
+		140, 144, 148, 152, 156, 160, 164, 168, 174, 178, 181, 185, 189, 193, 197, 201,
+		205, 209, 213, 217, 221, 225, 230, 234, 237, 241, 245, 249, 252, 256, 260, 264,
+		268, 272, 276, 280, 284, 288, 293, 297, 301, 305, 309, 313, 319, 323, 327, 331,
+		337, 341, 345, 349, 353, 357, 361, 365, 370, 374, 377, 381, 385, 390, 394, 398,
+		402, 406, 410, 414, 418, 421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461,
+		465, 469, 473, 477, 481, 485, 489, 493, 497, 502, 506, 510, 514, 518, 522, 526,
+		530, 534, 538, 543, 547, 551, 555, 559, 563, 567, 571, 575, 579, 583, 587, 591,
+		595, 599, 603, 607, 611, 615, 619, 623, 627, 631, 634, 638, 642, 647, 651, 655,
+		660, 664, 668, 672, 676, 680, 684, 688, 692, 696, 700, 704, 708, 711, 714, 718,
+		723, 727, 731, 735, 739, 743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 782,
+		786, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846,
+		852, 856, 860, 864, 869, 876, 879, 883, 886, 890, 894, 898, 902, 906, 910, 914,
+		918, 922, 926, 930, 934, 937, 942, 946, 950, 954, 957, 961, 967, 970, 974, 977,
+		980, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 1028, 1032, 1036, 0,
+		4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 63,
+		67, 71, 75, 79, 83, 87, 91, 96, 101, 104, 109, 112, 115, 119, 123, 127,
+		130, 133, 137, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 183, 187, 191,
+		196, 200, 204, 207, 212, 216, 219, 222, 227, 230, 235, 239, 243, 247, 251, 255,
+		259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 299, 303, 307, 311, 315, 319,
+		323, 327, 331, 335, 339, 343, 347, 351, 355, 359, 363, 367, 371, 375, 379, 383,
+		387, 391, 395, 399, 403, 408, 412, 417, 421, 425, 430, 435, 438, 442, 446, 450,
+		453, 457, 460, 464, 470, 474, 477, 481, 486, 490, 494, 498, 502, 506, 510, 514,
+		518, 522, 526, 530, 535, 540, 544, 548, 552, 555, 559, 563, 566, 570, 573, 576,
+		580, 585, 590, 594, 598, 602, 606, 611, 615, 619, 623, 627, 631, 635, 639, 643,
+		647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707,
+		711, 715, 719, 724, 728, 732, 736, 739, 743, 746, 750, 754, 758, 762, 765, 769,
+		774, 777, 781, 785, 789, 793, 797, 801, 805, 809, 813, 817, 821, 825, 829, 833,
+		837, 841, 845, 849, 853, 857, 861, 865, 869, 873, 877, 881, 885, 889, 893, 897,
+		901, 905, 909, 913, 917, 921, 925, 929, 933, 937, 941, 945, 949, 953, 957, 961,
+		965, 969, 973, 977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025,
+		0, 4, 9, 13, 17, 21, 25, 29, 33, 37, 41, 45, 49, 53, 57, 61,
+		65, 69, 72, 76, 80, 85, 88, 93, 96, 100, 104, 108, 112, 116, 120, 124,
+		128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188,
+		192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252,
+		256, 260, 264, 267, 273, 277, 281, 285, 289, 293, 297, 301, 305, 309, 313, 316,
+		320, 324, 328, 332, 336, 340, 344, 349, 353, 357, 361, 366, 369, 373, 377, 381,
+		385, 389, 393, 397, 401, 405, 409, 413, 417, 421, 425, 429, 433, 437, 441, 445,
+		449, 453, 457, 461, 465, 469, 473, 477, 481, 485, 489, 493, 497, 501, 505, 509,
+		513, 517, 521, 525, 529, 533, 537, 541, 545, 549, 553, 557, 561, 565, 569, 573,
+		577, 581, 585, 590, 594, 597, 601, 605, 609, 613, 617, 621, 625, 629, 633, 637,
+		641, 645, 649, 653, 657, 661, 665, 669, 673, 677, 681, 684, 688, 693, 696, 700,
+		704, 708, 712, 716, 720, 725, 729, 733, 737, 742, 745, 748, 753, 757, 761, 765,
+		770, 774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826, 830,
+		834, 838, 842, 846, 850, 854, 858, 862, 866, 870, 874, 878, 882, 886, 890, 896,
+		900, 904, 908, 912, 916, 920, 924, 928, 932, 936, 940, 944, 948, 952, 956, 960,
+		964, 968, 972, 976, 980, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1016, 1019, 1023,
+		1027, 0, 4, 8, 13, 17, 21, 25, 30, 34, 38, 42, 46, 50, 54, 58,
+		62, 65, 69, 74, 78, 83, 87, 91, 95, 99, 103, 107, 111, 115, 119, 123,
+		126, 130, 134, 138, 142, 146, 150, 154, 158, 162, 166, 170, 174, 178, 182, 187,
+		191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 230, 234, 238, 242, 246, 250,
+		253, 256, 259, 262, 264, 267, 270, 275, 279, 283, 286, 292, 295, 300, 305, 310,
+		314, 317, 321, 327, 332, 337, 341, 345, 349, 353, 357, 361, 366, 371, 374, 379,
+		383, 387, 391, 395, 399, 403, 407, 411, 415, 419, 423, 427, 431, 436, 440, 445,
+		451, 455, 459, 462, 466, 470, 474, 478, 482, 486, 491, 495, 499, 504, 508, 512,
+		516, 521, 525, 528, 532, 536, 540, 544, 549, 553, 557, 561, 564, 567, 571, 575,
+		578, 582, 585, 589, 593, 599, 603, 607, 611, 616, 620, 624, 628, 632, 637, 641,
+		644, 649, 653, 657, 661, 665, 669, 673, 677, 682, 686, 690, 693, 698, 701, 706,
+		710, 715, 720, 724, 728, 733, 737, 742, 746, 750, 754, 758, 762, 766, 770, 774,
+		778, 782, 787, 793, 798, 802, 806, 810, 815, 819, 823, 827, 831, 834, 839, 843,
+		847, 851, 855, 860, 864, 868, 872, 876, 880, 886, 890, 894, 898, 901, 905, 910,
+		914, 918, 923, 928, 932, 936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976,
+		980, 984, 988, 992, 997, 1001, 1005, 1009, 1013, 1016, 1020, 1024, 1028, 1031, 1035, 1039,
+		1043, 1048, 0, 6, 10, 14, 18, 22, 26, 30, 34, 38, 42, 46, 50, 54,
+		58, 61, 66, 71, 77, 81, 85, 89, 93, 97, 101, 105, 109, 113, 118, 122,
+		126, 131, 135, 139, 144, 147, 151, 155, 159, 163, 167, 171, 174, 177, 181, 184,
+		189, 193, 197, 201, 205, 209, 213, 217, 221, 225, 229, 233, 238, 242, 245, 248,
+		252, 257, 261, 265, 269, 273, 277, 282, 286, 289, 293, 297, 300, 304, 308, 310,
+		315, 318, 322, 325, 329, 333, 337, 341, 345, 349, 353, 357, 361, 365, 370, 374,
+		377, 381, 384, 388, 391, 395, 399, 403, 409, 412, 416, 420, 423, 427, 431, 436,
+		441, 444, 448, 451, 455, 459, 463, 467, 471, 477, 481, 486, 490, 494, 498, 503,
+		507, 511, 515, 518, 522, 525, 529, 533, 538, 544, 547, 552, 556, 560, 564, 568,
+		572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 613, 617, 622, 626, 630, 634,
+		638, 642, 647, 651, 655, 658, 662, 666, 670, 674, 678, 681, 685, 690, 694, 698,
+		702, 705, 707, 713, 717, 722, 726, 730, 734, 738, 742, 746, 750, 754, 758, 764,
+		769, 773, 778, 783, 787, 791, 795, 801, 805, 808, 814, 818, 822, 826, 830, 834,
+		838, 842, 846, 850, 854, 858, 863, 866, 870, 874, 878, 881, 885, 889, 893, 897,
+		901, 905, 909, 913, 917, 921, 925, 929, 933, 936, 940, 944, 948, 952, 956, 959,
+		964, 968, 972, 976, 982, 986, 990, 994, 998, 1002, 1006, 1010, 1014, 1018, 1022, 1026,
+		1030, 1035, 1041, 0, 4, 9, 14, 18, 23, 27, 30, 35, 39, 42, 46, 51,
+		54, 58, 62, 66, 71, 76, 79, 84, 87, 91, 95, 99, 104, 108, 111, 115,
+		119, 123, 127, 130, 134, 138, 142, 146, 150, 154, 158, 162, 166, 170, 174, 178,
+		183, 186, 190, 193, 196, 201, 205, 209, 213, 217, 221, 223, 227, 231, 234, 238,
+		241, 245, 249, 253, 256, 261, 265, 269, 273, 277, 282, 286, 291, 295, 300, 304,
+		308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 353, 356, 360, 366, 370,
+		374, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421, 425, 429, 433,
+		437, 441, 445, 449, 453, 457, 461, 465, 469, 474, 477, 481, 486, 491, 495, 500,
+		504, 508, 512, 516, 521, 525, 529, 533, 537, 540, 544, 548, 552, 556, 560, 564,
+		568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 617, 620, 625, 629,
+		633, 638, 642, 648, 653, 658, 663, 668, 671, 675, 679, 683, 685, 690, 694, 699,
+		703, 706, 710, 714, 718, 722, 726, 730, 734, 739, 743, 747, 751, 756, 760, 764,
+		768, 772, 776, 781, 784, 787, 791, 795, 799, 803, 807, 811, 815, 819, 822, 826,
+		830, 833, 837, 840, 844, 848, 852, 856, 860, 863, 868, 872, 876, 880, 884, 888,
+		892, 896, 900, 904, 908, 912, 916, 921, 925, 928, 932, 936, 940, 944, 948, 952,
+		956, 960, 964, 968, 972, 977, 981, 985, 989, 993, 997, 1002, 1006, 1010, 1015, 1019,
+		1022, 1026, 1030, 1034, 0, 4, 8, 12, 16, 20, 24, 28, 32, 37, 42, 45,
+		48, 53, 57, 61, 65, 70, 74, 79, 83, 87, 91, 95, 99, 103, 107, 111,
+		115, 119, 123, 127, 131, 135, 139, 143, 146, 151, 154, 158, 162, 166, 170, 174,
+		177, 181, 185, 190, 194, 198, 202, 206, 210, 214, 218, 222, 226, 230, 234, 238,
+		242, 246, 249, 253, 258, 261, 265, 268, 272, 276, 280, 284, 288, 292, 296, 301,
+		305, 309, 312, 316, 320, 323, 327, 331, 335, 339, 343, 348, 352, 356, 360, 364,
+		368, 372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 411, 416, 421, 425, 429,
+		433, 437, 441, 445, 449, 453, 457, 462, 466, 470, 474, 478, 483, 487, 490, 494,
+		497, 500, 504, 508, 512, 516, 520, 524, 528, 532, 535, 539, 543, 547, 551, 556,
+		560, 563, 567, 571, 575, 579, 583, 588, 591, 596, 600, 604, 608, 612, 616, 620,
+		624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668, 672, 676, 680, 684,
+		688, 692, 696, 700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744, 748,
+		753, 757, 761, 766, 770, 774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814,
+		818, 822, 826, 830, 833, 837, 841, 845, 849, 853, 857, 861, 865, 869, 873, 877,
+		881, 885, 889, 893, 897, 902, 906, 911, 915, 919, 923, 927, 931, 935, 939, 943,
+		947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007,
+		1011, 1015, 1019, 1023, 1029, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40,
+		44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 91, 95, 99, 103,
+		107, 111, 116, 120, 125, 129, 133, 137, 141, 145, 149, 153, 157, 161, 165, 169,
+		172, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231,
+		235, 239, 243, 247, 251, 255, 259, 263, 267, 271, 275, 279, 283, 287, 291, 295,
+		299, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351, 355, 359,
+		363, 367, 371, 375, 379, 382, 386, 390, 394, 398, 402, 406, 410, 414, 418, 422,
+		426, 430, 434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 480, 484, 488,
+		493, 497, 501, 505, 509, 513, 517, 521, 524, 529, 533, 537, 541, 545, 549, 552,
+		556, 560, 564, 570, 573, 577, 581, 585, 588, 593, 596, 599, 602, 605, 609, 614,
+		618, 622, 626, 631, 635, 639, 643, 647, 651, 655, 659, 663, 668, 671, 675, 679,
+		683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 728, 732, 736, 740, 743,
+		747, 751, 756, 761, 766, 770, 774, 777, 781, 785, 789, 793, 797, 801, 805, 809,
+		813, 818, 822, 827, 832, 836, 839, 842, 846, 850, 854, 861, 865, 869, 874, 879,
+		884, 888, 891, 895, 900, 904, 908, 912, 916, 920, 924, 928, 932, 936, 940, 944,
+		948, 952, 956, 961, 965, 968, 972, 976, 980, 984, 987, 991, 994, 998, 1002, 1006,
+		1010, 1014, 1018, 1022, 1026, 1030, 0, 4, 8, 12, 16, 20, 23, 27, 31, 35,
+		39, 44, 47, 51, 55, 59, 63, 67, 71, 75, 79, 83, 87, 92, 96, 101,
+		105, 108, 113, 116, 120, 123, 127, 132, 137, 141, 145, 149, 153, 158, 162, 166,
+		170, 175, 179, 183, 187, 191, 194, 198, 202, 206, 210, 214, 218, 222, 227, 230,
+		234, 239, 242, 246, 249, 252, 255, 260, 265, 269, 276, 279, 284, 288, 291, 295,
+		299, 303, 307, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 351, 355, 359,
+		363, 367, 371, 375, 378, 384, 388, 392, 396, 399, 403, 407, 411, 415, 421, 425,
+		428, 435, 439, 443, 447, 451, 455, 458, 462, 466, 470, 474, 478, 482, 486, 490,
+		494, 498, 502, 507, 512, 516, 519, 523, 527, 530, 534, 539, 542, 547, 551, 555,
+		559, 563, 567, 571, 575, 579, 583, 587, 592, 597, 601, 606, 610, 614, 618, 622,
+		626, 631, 635, 639, 642, 646, 650, 654, 658, 662, 666, 671, 675, 679, 683, 687,
+		691, 695, 700, 704, 708, 713, 718, 722, 726, 729, 733, 737, 740, 745, 750, 755,
+		759, 763, 768, 773, 778, 782, 789, 793, 797, 801, 805, 810, 814, 818, 822, 826,
+		830, 834, 838, 842, 846, 851, 856, 859, 863, 867, 871, 876, 881, 886, 890, 894,
+		898, 902, 906, 911, 916, 920, 925, 927, 931, 935, 938, 942, 946, 950, 954, 958,
+		962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1007, 1010, 1014, 1018, 1022,
+		1026, 1029, 1033, 1037, 1041, 1045, 1049, 0, 4, 8, 12, 16, 20, 24, 27, 31,
+		36, 40, 44, 47, 51, 56, 61, 64, 68, 72, 76, 80, 83, 86, 91, 95,
+		99, 103, 108, 112, 116, 120, 124, 128, 132, 137, 140, 144, 148, 153, 157, 161,
+		165, 169, 173, 177, 181, 184, 189, 193, 197, 200, 205, 209, 212, 216, 220, 224,
+		228, 233, 237, 241, 245, 249, 253, 257, 261, 266, 269, 273, 278, 282, 287, 291,
+		295, 299, 303, 306, 310, 315, 319, 323, 327, 331, 335, 339, 344, 348, 352, 357,
+		361, 365, 369, 373, 376, 380, 384, 388, 392, 396, 399, 403, 407, 412, 416, 421,
+		425, 429, 433, 436, 439, 443, 447, 452, 456, 459, 463, 467, 469, 473, 477, 481,
+		484, 487, 491, 495, 500, 503, 507, 510, 513, 517, 522, 525, 530, 534, 538, 541,
+		545, 549, 553, 557, 562, 566, 570, 574, 578, 583, 587, 591, 596, 600, 605, 609,
+		613, 617, 621, 625, 629, 633, 637, 641, 645, 650, 652, 656, 659, 663, 667, 671,
+		676, 681, 685, 690, 693, 697, 701, 704, 707, 711, 715, 719, 723, 727, 730, 734,
+		738, 742, 746, 750, 754, 758, 762, 765, 769, 774, 778, 782, 787, 790, 795, 799,
+		803, 806, 808, 813, 816, 821, 825, 829, 833, 837, 842, 846, 850, 854, 858, 862,
+		866, 871, 875, 879, 882, 886, 890, 893, 898, 902, 907, 910, 913, 917, 921, 925,
+		928, 932, 935, 940, 945, 949, 954, 959, 964, 969, 973, 977, 981, 985, 989, 992,
+		996, 1001, 1005, 1009, 1013, 1015, 1019, 1023, 0, 4, 8, 12, 17, 22, 26, 30,
+		33, 37, 40, 44, 48, 53, 57, 61, 64, 67, 72, 76, 80, 84, 88, 92,
+		96, 99, 103, 107, 111, 115, 120, 124, 128, 134, 137, 141, 145, 149, 153, 157,
+		160, 166, 170, 173, 176, 182, 186, 189, 194, 199, 203, 206, 210, 214, 218, 222,
+		226, 230, 234, 238, 242, 246, 250, 256, 260, 264, 268, 272, 276, 280, 285, 288,
+		292, 296, 301, 305, 311, 316, 320, 325, 328, 333, 337, 341, 346, 349, 353, 356,
+		361, 365, 368, 372, 376, 380, 384, 388, 392, 396, 401, 405, 409, 413, 416, 419,
+		424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468, 471, 475, 479, 483,
+		487, 490, 494, 498, 503, 506, 510, 514, 517, 521, 525, 530, 534, 538, 542, 546,
+		550, 554, 557, 561, 565, 569, 573, 576, 580, 584, 589, 593, 597, 602, 605, 609,
+		613, 617, 621, 625, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 667, 671,
+		675, 679, 683, 687, 691, 695, 698, 702, 706, 710, 714, 718, 723, 727, 731, 735,
+		738, 742, 745, 749, 753, 757, 760, 764, 768, 772, 776, 781, 785, 789, 793, 797,
+		802, 807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 846, 850, 854, 858, 862,
+		867, 871, 875, 879, 883, 887, 891, 895, 898, 904, 908, 912, 916, 920, 924, 928,
+		932, 936, 941, 946, 949, 953, 956, 959, 962, 966, 970, 974, 978, 981, 984, 988,
+		992, 996, 1001, 1005, 1009, 1012, 1017, 1021, 1025, 0, 4, 8, 12, 16, 20, 23,
+		26, 30, 34, 38, 42, 46, 50, 53, 57, 61, 65, 69, 73, 77, 81, 85,
+		89, 93, 98, 102, 105, 110, 114, 118, 122, 126, 131, 134, 138, 143, 148, 151,
+		154, 158, 163, 167, 171, 174, 178, 182, 186, 191, 193, 196, 200, 204, 209, 214,
+		218, 223, 227, 231, 235, 238, 241, 245, 248, 252, 255, 257, 262, 265, 269, 273,
+		277, 283, 289, 293, 298, 302, 306, 309, 313, 317, 322, 326, 330, 336, 339, 342,
+		347, 351, 356, 361, 366, 370, 374, 378, 382, 386, 390, 393, 397, 401, 404, 408,
+		411, 415, 419, 423, 427, 431, 434, 439, 442, 446, 450, 454, 458, 463, 467, 471,
+		474, 477, 480, 485, 489, 492, 495, 499, 502, 506, 511, 515, 519, 523, 526, 530,
+		534, 537, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578, 582, 587, 591, 595,
+		599, 603, 609, 612, 618, 621, 625, 629, 634, 638, 642, 646, 650, 655, 659, 663,
+		667, 670, 673, 677, 682, 686, 690, 694, 698, 702, 704, 708, 712, 716, 721, 726,
+		730, 734, 738, 742, 745, 749, 754, 757, 760, 765, 769, 773, 776, 780, 784, 789,
+		793, 797, 801, 805, 809, 813, 817, 821, 825, 829, 832, 836, 840, 844, 848, 852,
+		855, 859, 863, 867, 871, 875, 879, 883, 888, 892, 896, 900, 904, 907, 910, 914,
+		917, 920, 925, 929, 932, 937, 941, 945, 948, 952, 956, 960, 964, 968, 972, 976,
+		980, 984, 988, 992, 998, 1002, 1006, 1010, 1014, 1018, 0, 4, 8, 12, 17, 21,
+		25, 29, 33, 36, 40, 46, 51, 55, 59, 63, 67, 71, 75, 79, 83, 88,
+		92, 96, 100, 104, 107, 112, 116, 120, 124, 128, 132, 136, 140, 143, 148, 152,
+		157, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 205, 209, 212, 215,
+		219, 222, 225, 228, 232, 236, 240, 243, 247, 251, 255, 258, 262, 266, 270, 274,
+		278, 283, 287, 291, 296, 301, 305, 309, 313, 317, 320, 325, 329, 333, 337, 341,
+		345, 350, 354, 358, 362, 366, 370, 374, 378, 382, 384, 388, 391, 395, 399, 403,
+		407, 411, 415, 419, 423, 428, 432, 434, 438, 442, 446, 450, 455, 458, 463, 467,
+		471, 475, 479, 483, 488, 492, 496, 500, 504, 509, 513, 515, 519, 523, 527, 531,
+		535, 539, 544, 548, 553, 558, 562, 567, 572, 576, 580, 584, 588, 593, 597, 602,
+		605, 609, 613, 617, 621, 625, 630, 634, 639, 643, 647, 651, 655, 659, 663, 667,
+		672, 676, 680, 683, 688, 692, 696, 699, 703, 707, 711, 715, 719, 723, 727, 731,
+		736, 740, 743, 747, 750, 754, 758, 761, 764, 767, 771, 774, 778, 782, 786, 791,
+		794, 798, 802, 806, 809, 812, 816, 820, 824, 828, 833, 837, 840, 843, 846, 850,
+		854, 858, 862, 866, 870, 874, 878, 882, 886, 889, 893, 897, 901, 906, 910, 914,
+		918, 922, 927, 931, 935, 940, 943, 947, 950, 954, 958, 963, 966, 972, 976, 981,
+		987, 991, 995, 999, 1003, 1007, 1011, 1016, 1019, 1025, 1029, 0, 5, 11, 15, 19,
+		23, 27, 31, 36, 40, 44, 47, 51, 55, 60, 65, 68, 72, 77, 82, 86,
+		90, 94, 98, 102, 107, 111, 115, 119, 124, 128, 132, 135, 139, 142, 146, 150,
+		153, 157, 161, 164, 168, 172, 176, 180, 184, 188, 191, 196, 199, 205, 209, 212,
+		216, 221, 225, 229, 233, 236, 240, 243, 249, 255, 259, 263, 267, 271, 275, 279,
+		283, 287, 293, 297, 301, 305, 309, 313, 317, 321, 325, 328, 333, 337, 342, 346,
+		350, 354, 357, 361, 366, 370, 375, 380, 384, 388, 392, 396, 401, 405, 410, 415,
+		419, 422, 425, 429, 432, 437, 442, 446, 450, 454, 459, 464, 468, 472, 476, 480,
+		484, 488, 492, 496, 501, 505, 509, 513, 517, 522, 526, 530, 534, 538, 541, 545,
+		548, 552, 556, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 607,
+		611, 615, 619, 624, 628, 632, 636, 639, 643, 647, 651, 655, 659, 663, 667, 672,
+		676, 679, 683, 687, 691, 695, 698, 704, 706, 710, 713, 716, 720, 726, 730, 734,
+		738, 742, 746, 750, 754, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795, 799,
+		803, 807, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850, 853, 857, 861,
+		865, 869, 873, 877, 882, 886, 890, 894, 898, 902, 906, 911, 915, 920, 924, 928,
+		932, 936, 942, 947, 951, 955, 958, 962, 966, 969, 972, 976, 980, 984, 989, 993,
+		997, 1002, 1005, 1009, 1013, 1016, 1019, 1023, 1026, 1030, 1034, 1038, 0, 5, 10, 14,
+		17, 21, 25, 28, 33, 37, 41, 44, 48, 52, 57, 61, 65, 69, 73, 78,
+		82, 86, 90, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144,
+		149, 153, 156, 161, 165, 169, 173, 177, 181, 186, 189, 193, 197, 200, 204, 207,
+		212, 216, 221, 225, 229, 233, 237, 240, 244, 248, 252, 256, 260, 264, 268, 272,
+		276, 280, 284, 288, 292, 297, 301, 304, 308, 312, 316, 320, 324, 327, 331, 335,
+		339, 342, 345, 349, 352, 356, 360, 365, 369, 373, 377, 382, 386, 390, 394, 398,
+		401, 405, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460,
+		464, 468, 472, 476, 479, 484, 488, 492, 496, 500, 506, 512, 517, 521, 524, 528,
+		534, 538, 542, 549, 552, 556, 562, 565, 571, 575, 579, 583, 587, 591, 595, 599,
+		603, 607, 611, 615, 619, 623, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664,
+		668, 672, 678, 684, 690, 693, 699, 703, 707, 712, 716, 720, 726, 730, 734, 738,
+		742, 746, 750, 753, 757, 761, 765, 769, 773, 777, 781, 784, 788, 793, 798, 802,
+		807, 811, 816, 820, 823, 828, 831, 836, 841, 845, 849, 853, 857, 860, 863, 867,
+		870, 874, 877, 881, 886, 890, 894, 898, 902, 906, 910, 915, 919, 922, 926, 930,
+		934, 938, 943, 947, 952, 956, 960, 964, 969, 973, 978, 983, 987, 991, 995, 999,
+		1002, 1006, 1010, 1015, 1020, 1024, 1028, 1032, 1036, 1040, 1044, 1048, 1051, 0, 3, 7,
+		10, 14, 18, 21, 26, 30, 34, 39, 44, 47, 52, 56, 60, 66, 70, 75,
+		79, 83, 87, 91, 95, 99, 103, 107, 111, 115, 119, 123, 127, 130, 134, 138,
+		142, 146, 150, 154, 157, 161, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200,
+		204, 208, 212, 216, 221, 224, 228, 233, 237, 241, 245, 249, 254, 258, 262, 266,
+		270, 273, 277, 281, 285, 289, 293, 297, 301, 305, 311, 315, 319, 323, 327, 331,
+		335, 340, 344, 349, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388, 394, 398,
+		402, 405, 409, 413, 418, 423, 426, 429, 433, 437, 441, 445, 449, 454, 458, 462,
+		466, 470, 474, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 526,
+		530, 534, 538, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577, 581, 585, 588,
+		592, 596, 600, 603, 606, 610, 613, 617, 621, 624, 628, 632, 636, 640, 644, 648,
+		652, 656, 660, 664, 668, 672, 676, 680, 683, 687, 691, 695, 699, 705, 708, 712,
+		716, 719, 723, 727, 730, 733, 738, 742, 746, 750, 756, 762, 766, 769, 773, 777,
+		781, 785, 789, 793, 797, 801, 805, 809, 812, 816, 820, 824, 828, 832, 836, 840,
+		844, 848, 852, 856, 860, 864, 868, 872, 875, 879, 882, 885, 888, 892, 896, 899,
+		903, 905, 909, 913, 917, 921, 925, 929, 933, 936, 940, 944, 947, 950, 954, 957,
+		961, 966, 970, 974, 979, 983, 986, 989, 993, 996, 1002, 1006, 1010, 1014, 0, 4,
+		8, 12, 16, 20, 24, 28, 32, 36, 40, 45, 49, 53, 57, 61, 65, 69,
+		73, 76, 80, 84, 88, 92, 97, 101, 107, 112, 117, 121, 125, 129, 134, 138,
+		143, 147, 152, 157, 162, 167, 171, 175, 177, 181, 185, 189, 193, 197, 201, 205,
+		209, 212, 216, 220, 224, 228, 232, 236, 240, 243, 247, 251, 254, 258, 262, 266,
+		271, 274, 278, 283, 287, 290, 295, 299, 302, 305, 308, 312, 316, 320, 324, 328,
+		331, 335, 339, 342, 346, 350, 354, 358, 362, 366, 370, 374, 378, 382, 386, 390,
+		394, 398, 402, 406, 410, 414, 418, 422, 426, 430, 436, 439, 442, 447, 450, 454,
+		458, 462, 466, 469, 473, 477, 481, 485, 489, 493, 497, 501, 504, 508, 511, 515,
+		519, 524, 528, 532, 536, 540, 543, 547, 551, 555, 558, 563, 567, 571, 575, 580,
+		584, 588, 592, 596, 600, 604, 608, 613, 617, 621, 625, 629, 633, 637, 641, 646,
+		649, 652, 656, 659, 663, 667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707,
+		712, 717, 721, 725, 729, 733, 737, 741, 746, 751, 755, 759, 763, 767, 771, 775,
+		779, 783, 786, 790, 794, 798, 802, 805, 811, 817, 820, 823, 827, 831, 835, 839,
+		843, 846, 851, 855, 859, 863, 867, 870, 874, 878, 882, 888, 892, 896, 900, 904,
+		908, 912, 916, 920, 924, 928, 932, 937, 942, 947, 952, 956, 959, 963, 966, 970,
+		973, 978, 983, 988, 992, 996, 1000, 1003, 1007, 1010, 1014, 1018, 1022, 1026, 1029, 0,
+		3, 8, 12, 17, 21, 25, 31, 35, 38, 41, 46, 51, 54, 59, 63, 67,
+		71, 76, 79, 84, 87, 91, 95, 98, 101, 104, 107, 111, 114, 117, 120, 125,
+		128, 133, 137, 141, 145, 149, 153, 157, 160, 164, 168, 172, 176, 180, 182, 188,
+		192, 196, 199, 202, 206, 210, 214, 218, 222, 226, 230, 234, 238, 242, 245, 249,
+		253, 257, 261, 265, 269, 274, 278, 282, 287, 291, 295, 300, 304, 309, 313, 317,
+		321, 324, 327, 331, 336, 340, 344, 348, 351, 355, 359, 362, 366, 372, 377, 382,
+		385, 390, 393, 397, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 439, 444,
+		448, 452, 455, 461, 465, 469, 473, 477, 481, 485, 490, 495, 499, 503, 507, 511,
+		516, 520, 523, 527, 531, 535, 539, 543, 547, 550, 555, 559, 564, 567, 572, 576,
+		580, 584, 588, 592, 596, 600, 603, 607, 614, 618, 622, 625, 629, 633, 637, 640,
+		644, 648, 653, 656, 658, 663, 667, 670, 674, 678, 682, 686, 690, 694, 697, 701,
+		705, 709, 713, 717, 721, 725, 729, 733, 736, 740, 744, 748, 752, 756, 761, 765,
+		769, 773, 778, 782, 786, 790, 794, 797, 801, 805, 808, 812, 816, 820, 824, 828,
+		832, 836, 840, 844, 848, 852, 856, 860, 864, 868, 872, 876, 880, 884, 888, 892,
+		896, 900, 904, 908, 912, 916, 920, 924, 928, 932, 936, 939, 943, 947, 951, 955,
+		959, 963, 967, 972, 975, 979, 983, 987, 991, 997, 1001, 1005, 1009, 1013, 1017, 1021,
+		0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 55, 59,
+		64, 68, 73, 77, 81, 86, 90, 95, 99, 103, 106, 111, 117, 120, 125, 129,
+		133, 137, 141, 145, 149, 155, 159, 163, 167, 171, 175, 179, 184, 188, 192, 197,
+		201, 207, 211, 214, 219, 223, 227, 231, 235, 238, 244, 248, 252, 257, 261, 264,
+		268, 272, 276, 280, 284, 288, 292, 296, 299, 303, 307, 310, 314, 318, 322, 326,
+		329, 333, 338, 343, 347, 351, 355, 359, 363, 367, 371, 375, 379, 383, 386, 390,
+		394, 398, 401, 404, 407, 412, 416, 421, 424, 428, 433, 437, 443, 447, 451, 455,
+		458, 462, 466, 469, 473, 477, 481, 485, 489, 493, 497, 500, 504, 508, 511, 515,
+		518, 523, 527, 531, 535, 539, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578,
+		583, 587, 590, 594, 598, 602, 606, 609, 614, 618, 622, 626, 630, 633, 636, 640,
+		645, 650, 654, 658, 662, 666, 670, 674, 678, 682, 686, 690, 694, 699, 703, 707,
+		710, 714, 721, 725, 730, 734, 737, 741, 745, 749, 753, 757, 761, 765, 769, 773,
+		777, 781, 785, 789, 793, 797, 801, 805, 810, 814, 818, 823, 826, 829, 834, 840,
+		843, 847, 851, 855, 859, 863, 867, 871, 875, 880, 884, 888, 892, 895, 899, 903,
+		907, 911, 915, 919, 923, 927, 931, 935, 939, 942, 946, 950, 954, 958, 962, 966,
+		970, 974, 977, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1015, 1019, 1023, 1026, 1030,
+		1034, 0, 6, 10, 14, 19, 23, 27, 31, 35, 39, 43, 47, 51, 55, 60,
+		65, 69, 73, 77, 81, 86, 90, 94, 98, 102, 106, 109, 113, 117, 121, 125,
+		128, 132, 135, 139, 143, 147, 150, 154, 158, 162, 166, 170, 173, 177, 181, 185,
+		189, 192, 197, 201, 204, 208, 211, 216, 220, 224, 228, 233, 237, 240, 244, 249,
+		253, 257, 262, 267, 271, 276, 279, 283, 288, 291, 296, 300, 303, 308, 311, 314,
+		318, 321, 325, 329, 333, 336, 340, 344, 348, 352, 355, 358, 361, 365, 369, 372,
+		377, 381, 385, 389, 393, 397, 401, 405, 410, 415, 418, 422, 426, 430, 434, 438,
+		442, 446, 450, 453, 457, 462, 467, 471, 475, 479, 483, 487, 491, 495, 499, 503,
+		507, 511, 515, 518, 522, 527, 531, 535, 539, 543, 547, 551, 555, 560, 565, 569,
+		573, 577, 581, 585, 590, 594, 598, 602, 606, 610, 614, 618, 622, 626, 630, 634,
+		638, 642, 646, 650, 653, 657, 662, 666, 669, 674, 678, 683, 686, 690, 694, 698,
+		702, 706, 710, 713, 716, 720, 725, 728, 732, 736, 740, 746, 750, 754, 758, 762,
+		765, 769, 773, 777, 781, 785, 789, 793, 797, 800, 804, 808, 812, 816, 819, 824,
+		827, 833, 836, 840, 843, 846, 850, 854, 858, 862, 866, 870, 874, 878, 882, 886,
+		890, 894, 898, 901, 905, 908, 913, 917, 921, 925, 928, 932, 936, 940, 944, 948,
+		952, 957, 961, 965, 969, 973, 977, 980, 984, 988, 993, 997, 1001, 1005, 1009, 1013,
+		1017, 1021, 0, 4, 8, 14, 17, 21, 25, 29, 34, 38, 42, 46, 50, 54,
+		59, 63, 67, 70, 74, 80, 84, 88, 92, 96, 100, 103, 107, 111, 115, 118,
+		123, 127, 131, 135, 138, 142, 145, 150, 154, 158, 163, 168, 173, 176, 180, 184,
+		188, 191, 195, 199, 203, 208, 212, 216, 220, 224, 228, 232, 235, 238, 242, 246,
+		250, 254, 258, 263, 267, 271, 275, 280, 283, 287, 291, 295, 299, 303, 307, 311,
+		315, 318, 322, 326, 330, 334, 338, 342, 346, 351, 354, 358, 362, 365, 369, 373,
+		377, 381, 385, 389, 393, 396, 400, 404, 408, 411, 415, 419, 422, 426, 430, 433,
+		438, 442, 446, 451, 454, 458, 462, 466, 470, 474, 478, 482, 485, 489, 493, 497,
+		501, 505, 509, 513, 518, 522, 526, 530, 534, 538, 543, 546, 549, 553, 557, 561,
+		565, 569, 573, 577, 581, 585, 589, 593, 597, 600, 604, 607, 611, 615, 619, 623,
+		627, 630, 634, 638, 643, 647, 652, 657, 661, 666, 670, 674, 677, 681, 684, 688,
+		691, 695, 698, 702, 706, 710, 714, 718, 722, 726, 730, 734, 738, 742, 746, 750,
+		754, 758, 762, 766, 770, 774, 778, 784, 788, 792, 796, 800, 804, 808, 811, 815,
+		818, 821, 826, 829, 833, 837, 841, 845, 850, 853, 859, 862, 866, 869, 873, 877,
+		881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 925, 929, 933, 937, 941,
+		947, 951, 954, 958, 962, 966, 970, 974, 978, 983, 987, 992, 997, 1001, 1005, 1009,
+		1012, 1015, 1019, 0, 5, 9, 13, 16, 20, 24, 28, 30, 34, 38, 42, 44,
+		48, 52, 57, 61, 64, 68, 72, 76, 80, 84, 86, 90, 94, 98, 102, 106,
+		110, 114, 118, 121, 125, 129, 133, 137, 141, 146, 149, 152, 155, 159, 163, 167,
+		171, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219, 222, 226, 230,
+		234, 238, 241, 244, 248, 252, 256, 260, 264, 269, 273, 278, 282, 286, 290, 294,
+		298, 302, 305, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356,
+		360, 364, 368, 372, 376, 380, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421,
+		425, 429, 433, 437, 441, 445, 449, 453, 457, 460, 465, 469, 473, 477, 480, 484,
+		488, 492, 495, 498, 502, 506, 510, 514, 520, 524, 528, 532, 536, 540, 544, 548,
+		552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 595, 599, 603, 607, 610,
+		614, 617, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674,
+		678, 682, 686, 689, 692, 695, 698, 702, 706, 711, 715, 719, 723, 727, 731, 735,
+		738, 742, 746, 750, 754, 758, 762, 766, 770, 774, 778, 782, 786, 790, 794, 798,
+		801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 844, 849, 853, 856, 860,
+		864, 870, 874, 878, 882, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923, 927,
+		931, 935, 939, 943, 947, 952, 956, 960, 964, 968, 972, 977, 981, 985, 989, 993,
+		997, 1001, 1005, 1009, 0, 4, 8, 12, 16, 19, 24, 28, 32, 35, 40, 44,
+		47, 51, 55, 59, 63, 67, 71, 77, 81, 85, 89, 93, 97, 101, 104, 107,
+		111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 162, 166, 170,
+		174, 178, 182, 186, 190, 194, 198, 202, 206, 209, 213, 217, 221, 225, 229, 233,
+		237, 242, 246, 250, 254, 259, 264, 268, 272, 276, 280, 283, 287, 291, 295, 299,
+		303, 307, 311, 315, 318, 322, 326, 330, 334, 338, 342, 346, 350, 354, 358, 362,
+		365, 370, 375, 379, 383, 387, 392, 396, 400, 404, 408, 412, 419, 422, 425, 429,
+		433, 437, 441, 445, 449, 453, 457, 461, 466, 471, 474, 478, 481, 485, 487, 490,
+		493, 497, 501, 504, 508, 512, 516, 521, 526, 530, 533, 536, 539, 543, 547, 550,
+		556, 561, 565, 569, 573, 577, 581, 586, 591, 594, 598, 602, 607, 610, 614, 619,
+		623, 627, 631, 635, 639, 642, 646, 649, 653, 657, 661, 665, 669, 673, 677, 681,
+		685, 691, 695, 699, 703, 707, 711, 715, 718, 722, 726, 730, 733, 736, 740, 745,
+		748, 751, 755, 760, 763, 769, 773, 777, 781, 787, 791, 795, 799, 803, 807, 812,
+		816, 820, 824, 828, 832, 836, 841, 845, 849, 852, 856, 860, 864, 868, 872, 876,
+		879, 883, 886, 890, 893, 897, 901, 905, 908, 912, 915, 919, 922, 926, 929, 933,
+		937, 941, 945, 949, 953, 957, 961, 965, 969, 973, 977, 981, 984, 988, 992, 995,
+		999, 1003, 1007, 1011, 1015, 0, 4, 7, 11, 15, 19, 23, 27, 31, 35, 38,
+		41, 45, 49, 53, 57, 60, 64, 68, 72, 75, 79, 82, 86, 90, 96, 100,
+		105, 111, 115, 117, 123, 127, 131, 135, 139, 142, 146, 151, 155, 159, 163, 166,
+		170, 174, 178, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231,
+		234, 238, 242, 246, 250, 253, 256, 260, 264, 268, 272, 276, 280, 284, 291, 296,
+		300, 306, 310, 314, 319, 324, 327, 331, 335, 339, 343, 346, 349, 353, 358, 362,
+		365, 368, 373, 377, 381, 384, 388, 392, 396, 400, 404, 407, 411, 416, 420, 424,
+		429, 433, 437, 441, 445, 449, 453, 460, 464, 468, 472, 476, 480, 484, 490, 494,
+		500, 504, 509, 512, 515, 519, 522, 526, 530, 534, 538, 542, 546, 552, 556, 559,
+		563, 566, 569, 572, 576, 580, 584, 588, 591, 594, 598, 601, 605, 609, 613, 617,
+		621, 624, 628, 632, 636, 640, 644, 648, 652, 656, 661, 665, 669, 673, 677, 681,
+		685, 689, 693, 697, 701, 705, 709, 713, 717, 721, 726, 729, 734, 738, 742, 747,
+		750, 754, 760, 764, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 807, 811,
+		815, 820, 824, 827, 830, 834, 839, 843, 847, 850, 854, 858, 862, 866, 870, 874,
+		878, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923, 928, 932, 935, 939,
+		943, 947, 951, 954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002,
+		1006, 1010, 1013, 1017, 1022, 1025, 0, 4, 9, 13, 17, 21, 25, 28, 32, 37,
+		42, 45, 49, 54, 58, 61, 66, 70, 73, 77, 81, 85, 89, 92, 96, 100,
+		104, 108, 111, 115, 118, 122, 126, 130, 134, 138, 142, 146, 150, 154, 158, 162,
+		166, 170, 174, 178, 181, 184, 188, 192, 195, 201, 205, 209, 212, 215, 220, 223,
+		229, 233, 239, 243, 247, 251, 255, 259, 263, 269, 274, 277, 281, 284, 289, 295,
+		300, 304, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 355, 359, 363,
+		367, 371, 375, 379, 384, 388, 393, 397, 401, 405, 409, 413, 417, 421, 425, 429,
+		433, 437, 441, 445, 449, 452, 456, 460, 464, 467, 471, 473, 476, 480, 484, 488,
+		491, 495, 501, 504, 508, 511, 515, 520, 523, 526, 530, 534, 538, 542, 546, 550,
+		554, 558, 562, 566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606, 609, 613,
+		617, 621, 624, 628, 632, 636, 640, 643, 647, 651, 655, 659, 663, 667, 671, 675,
+		679, 683, 687, 691, 695, 699, 704, 707, 711, 715, 719, 723, 727, 731, 735, 739,
+		743, 747, 751, 755, 759, 763, 768, 772, 777, 781, 785, 789, 793, 797, 802, 805,
+		809, 812, 816, 820, 824, 828, 832, 836, 840, 845, 850, 854, 858, 862, 865, 870,
+		874, 878, 883, 887, 890, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931, 935,
+		939, 943, 947, 951, 955, 959, 963, 966, 970, 974, 979, 983, 989, 993, 997, 1001,
+		1004, 1008, 1012, 1016, 1020, 1024, 1028, 0, 5, 9, 13, 17, 21, 25, 30, 35,
+		39, 43, 46, 51, 55, 59, 63, 67, 71, 75, 79, 83, 87, 91, 95, 99,
+		104, 108, 112, 116, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 163,
+		166, 171, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219, 223, 227,
+		232, 236, 240, 245, 250, 254, 258, 262, 266, 270, 274, 278, 282, 286, 290, 294,
+		297, 304, 308, 312, 315, 318, 322, 326, 329, 332, 336, 340, 343, 348, 351, 355,
+		359, 363, 367, 371, 376, 380, 384, 388, 392, 396, 400, 403, 407, 411, 416, 420,
+		426, 430, 433, 437, 441, 445, 449, 453, 457, 462, 466, 470, 474, 478, 482, 486,
+		490, 494, 498, 503, 507, 511, 515, 519, 523, 527, 531, 535, 539, 543, 546, 550,
+		554, 559, 563, 567, 571, 574, 578, 582, 585, 589, 593, 597, 601, 604, 608, 611,
+		615, 619, 623, 627, 631, 635, 639, 643, 647, 651, 655, 659, 663, 668, 672, 676,
+		680, 684, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 732, 737, 742,
+		746, 750, 754, 757, 761, 765, 769, 773, 777, 782, 786, 790, 793, 797, 801, 806,
+		810, 813, 817, 822, 826, 829, 833, 837, 842, 846, 850, 854, 858, 862, 867, 870,
+		874, 878, 882, 886, 889, 892, 896, 900, 904, 907, 910, 914, 918, 922, 926, 930,
+		934, 938, 942, 945, 948, 952, 955, 959, 963, 967, 971, 976, 980, 984, 988, 992,
+		996, 1000, 1004, 1008, 1012, 1016, 1021, 1025, 0, 5, 9, 13, 17, 20, 23, 26,
+		30, 33, 37, 41, 45, 49, 52, 56, 61, 65, 69, 73, 76, 79, 83, 87,
+		91, 95, 99, 103, 107, 111, 115, 119, 122, 126, 130, 135, 138, 142, 146, 150,
+		154, 158, 163, 168, 172, 175, 179, 183, 187, 190, 193, 197, 201, 205, 210, 214,
+		219, 223, 227, 231, 235, 239, 243, 247, 251, 255, 259, 262, 266, 269, 272, 276,
+		279, 284, 288, 292, 296, 300, 304, 307, 310, 314, 319, 323, 327, 331, 335, 339,
+		343, 347, 351, 355, 358, 362, 366, 370, 374, 377, 380, 384, 388, 391, 395, 400,
+		405, 409, 413, 416, 420, 424, 428, 432, 436, 440, 444, 447, 451, 456, 460, 465,
+		469, 472, 475, 480, 484, 488, 492, 496, 502, 506, 510, 514, 518, 522, 526, 530,
+		534, 537, 542, 547, 551, 555, 559, 563, 567, 571, 575, 580, 584, 587, 591, 595,
+		598, 602, 606, 610, 614, 618, 622, 628, 632, 635, 639, 643, 647, 650, 654, 658,
+		662, 666, 670, 674, 677, 681, 685, 689, 693, 697, 701, 705, 709, 713, 718, 722,
+		726, 730, 734, 738, 744, 748, 754, 759, 763, 767, 772, 777, 781, 785, 790, 794,
+		798, 802, 807, 812, 817, 821, 827, 831, 835, 839, 843, 849, 854, 858, 862, 867,
+		872, 876, 882, 886, 890, 894, 898, 902, 905, 910, 914, 919, 923, 927, 931, 936,
+		940, 944, 948, 954, 959, 963, 967, 970, 974, 978, 982, 986, 989, 993, 996, 1000,
+		1004, 1007, 1011, 1014, 1017, 1020, 1024, 1028, 1032, 0, 3, 7, 12, 17, 20, 23,
+		27, 32, 37, 41, 45, 50, 53, 58, 61, 65, 69, 73, 77, 81, 84, 88,
+		93, 97, 100, 104, 108, 112, 116, 120, 123, 127, 131, 135, 139, 142, 146, 150,
+		155, 159, 163, 167, 171, 175, 179, 183, 186, 190, 193, 197, 202, 206, 210, 214,
+		218, 222, 226, 230, 234, 239, 243, 247, 251, 256, 260, 264, 268, 273, 276, 280,
+		283, 287, 291, 295, 300, 304, 307, 311, 315, 318, 322, 326, 330, 334, 338, 342,
+		346, 350, 354, 358, 362, 366, 370, 373, 377, 380, 384, 388, 392, 396, 400, 404,
+		407, 411, 415, 420, 424, 428, 433, 437, 442, 446, 451, 454, 458, 463, 467, 471,
+		475, 479, 482, 486, 490, 494, 498, 503, 506, 509, 513, 517, 520, 524, 527, 531,
+		534, 537, 541, 545, 549, 553, 557, 561, 565, 568, 572, 575, 581, 585, 588, 591,
+		595, 599, 604, 608, 612, 616, 619, 623, 627, 632, 636, 640, 643, 647, 651, 655,
+		659, 663, 667, 671, 675, 680, 685, 689, 693, 697, 701, 705, 708, 712, 716, 720,
+		724, 728, 732, 737, 741, 745, 749, 753, 757, 762, 765, 769, 773, 779, 784, 789,
+		793, 797, 801, 805, 808, 812, 816, 821, 825, 829, 833, 837, 841, 845, 849, 853,
+		857, 861, 865, 869, 873, 878, 881, 885, 889, 893, 897, 900, 904, 908, 912, 916,
+		920, 924, 928, 932, 936, 940, 943, 947, 950, 954, 957, 961, 965, 969, 973, 977,
+		981, 984, 988, 991, 995, 999, 1004, 1008, 1012, 1016, 0, 4, 8, 13, 19, 23,
+		28, 32, 36, 40, 44, 48, 51, 55, 60, 64, 69, 73, 78, 82, 86, 91,
+		94, 98, 103, 107, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 153, 157,
+		161, 166, 170, 174, 179, 182, 186, 191, 195, 199, 203, 207, 211, 215, 220, 224,
+		228, 233, 238, 242, 246, 250, 254, 258, 262, 267, 270, 275, 280, 284, 289, 293,
+		297, 301, 306, 309, 312, 315, 319, 324, 330, 335, 339, 343, 347, 352, 358, 361,
+		365, 369, 373, 377, 381, 385, 389, 393, 396, 400, 404, 408, 412, 416, 420, 424,
+		428, 432, 436, 439, 442, 446, 451, 455, 458, 461, 464, 468, 471, 475, 478, 481,
+		485, 488, 491, 495, 500, 504, 508, 512, 516, 520, 524, 528, 533, 536, 540, 545,
+		549, 553, 557, 560, 563, 567, 571, 574, 577, 582, 586, 590, 594, 598, 602, 606,
+		610, 614, 618, 622, 626, 631, 635, 639, 643, 646, 650, 654, 658, 662, 666, 670,
+		673, 676, 680, 684, 687, 691, 695, 699, 703, 707, 712, 716, 720, 723, 728, 732,
+		736, 739, 744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 783, 788, 792, 796,
+		799, 802, 806, 810, 813, 817, 820, 824, 827, 831, 834, 837, 841, 846, 851, 854,
+		858, 861, 865, 869, 873, 877, 881, 886, 889, 893, 897, 901, 905, 909, 913, 917,
+		921, 926, 930, 934, 938, 942, 946, 950, 954, 958, 962, 966, 970, 974, 978, 983,
+		987, 991, 994, 999, 1002, 1006, 1010, 1014, 1018, 1022, 1026, 0, 5, 8, 12, 15,
+		19, 23, 27, 32, 36, 40, 42, 46, 50, 54, 58, 62, 64, 69, 72, 76,
+		80, 84, 88, 92, 96, 100, 104, 109, 113, 118, 122, 126, 130, 134, 138, 142,
+		146, 149, 154, 157, 162, 166, 171, 175, 178, 182, 186, 190, 194, 198, 201, 205,
+		209, 213, 217, 221, 225, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 267,
+		271, 275, 279, 283, 286, 290, 294, 298, 302, 305, 311, 315, 319, 323, 327, 331,
+		335, 339, 343, 347, 351, 355, 359, 362, 366, 370, 375, 379, 383, 387, 391, 395,
+		399, 403, 407, 410, 415, 419, 423, 426, 430, 435, 439, 441, 445, 449, 453, 456,
+		459, 463, 467, 471, 475, 479, 482, 486, 490, 493, 497, 501, 505, 510, 514, 519,
+		523, 527, 531, 534, 538, 542, 546, 550, 554, 558, 563, 568, 572, 577, 581, 586,
+		590, 594, 598, 602, 607, 611, 615, 619, 623, 628, 632, 636, 641, 645, 650, 654,
+		658, 662, 666, 670, 674, 678, 682, 686, 690, 694, 698, 701, 706, 710, 714, 718,
+		722, 726, 730, 733, 737, 741, 745, 750, 754, 758, 762, 766, 771, 775, 779, 782,
+		786, 790, 794, 798, 803, 807, 811, 815, 819, 823, 826, 830, 834, 838, 843, 847,
+		851, 855, 859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 898, 901, 904, 907,
+		910, 913, 916, 919, 922, 925, 928, 931, 934, 937, 940, 943, 946, 949, 952, 955,
+		958, 961, 964, 967, 970, 973, 976, 979, 982, 985, 988, 991, 0, 4, 8, 12,
+		16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 61, 65, 69, 73, 77,
+		81, 85, 89, 93, 97, 101, 105, 109, 113, 117, 121, 125, 129, 133, 137, 141,
+		145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 185, 189, 193, 197, 201, 205,
+		209, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269,
+		273, 277, 281, 285, 289, 293, 297, 301, 305, 309, 313, 317, 321, 325, 329, 333,
+		337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 377, 381, 385, 389, 393, 397,
+		401, 405, 409, 413, 417, 421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461,
+		465, 469, 473, 477, 481, 485, 489, 493, 497, 501, 505, 509, 513, 517, 521, 525,
+		529, 533, 537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577, 581, 585, 589,
+		593, 597, 601, 605, 609, 613, 617, 621, 625, 629, 633, 637, 641, 645, 649, 653,
+		657, 661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 701, 705, 709, 713, 717,
+		721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 761, 765, 769, 773, 777, 781,
+		785, 789, 793, 797, 801, 805, 809, 813, 817, 821, 825, 830, 834, 838, 842, 846,
+		850, 854, 858, 862, 866, 870, 874, 878, 882, 886, 889, 893, 897, 901, 905, 909,
+		913, 917, 921, 925, 929, 933, 937, 941, 945, 949, 953, 957, 961, 965, 969, 973,
+		977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025, 0, 3, 7,
+		11, 15, 19, 23, 27, 31, 35, 39, 43, 47, 51, 55, 59, 63, 67, 71,
+		75, 79, 83, 87, 91, 95, 99, 103, 107, 111, 115, 119, 123, 128, 132, 135,
+		139, 143, 147, 151, 155, 159, 163, 167, 171, 175, 179, 183, 187, 191, 195, 199,
+		203, 207, 211, 215, 219, 223, 226, 230, 234, 238, 242, 247, 251, 255, 260, 264,
+		268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 321, 325, 329,
+		333, 337, 341, 345, 349, 353, 357, 361, 365, 368, 372, 376, 380, 384, 388, 392,
+		396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456,
+		460, 464, 468, 472, 476, 480, 484, 488, 492, 496, 500, 504, 508, 512, 516, 520,
+		524, 528, 532, 536, 540, 544, 548, 552, 556, 560, 564, 568, 572, 576, 580, 584,
+		588, 592, 596, 601, 605, 609, 613, 617, 621, 625, 629, 633, 637, 641, 645, 649,
+		653, 657, 661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 702, 706, 710, 714,
+		718, 722, 726, 730, 734, 738, 742, 746, 750, 754, 758, 762, 766, 770, 774, 778,
+		782, 786, 790, 794, 799, 803, 807, 811, 815, 819, 823, 827, 831, 835, 839, 843,
+		847, 851, 855, 859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899, 903, 907,
+		911, 915, 919, 923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963, 967, 971,
+		975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 1027, 0, 4,
+		8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68,
+		72, 76, 80, 84, 88, 92, 97, 101, 105, 109, 113, 117, 121, 125, 129, 133,
+		137, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 185, 189, 193, 197,
+		201, 205, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261,
+		265, 269, 273, 277, 281, 285, 289, 293, 297, 301, 305, 309, 313, 317, 321, 325,
+		329, 333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 377, 381, 385, 390,
+		394, 398, 402, 406, 410, 414, 418, 422, 426, 430, 437, 441, 445, 449, 453, 458,
+		462, 466, 470, 474, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 517, 521,
+		525, 529, 533, 537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577, 581, 585,
+		589, 593, 597, 601, 605, 609, 613, 617, 621, 625, 629, 633, 637, 641, 645, 649,
+		653, 657, 661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 701, 705, 709, 713,
+		717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 761, 765, 769, 773, 778,
+		782, 786, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826, 830, 834, 838, 842,
+		846, 850, 854, 858, 862, 866, 870, 873, 877, 881, 885, 889, 893, 897, 901, 905,
+		909, 913, 917, 921, 925, 929, 933, 937, 941, 945, 949, 953, 957, 961, 965, 969,
+		973, 977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025, 1029, 0,
+		4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 45, 49, 53, 57, 61, 65,
+		69, 73, 77, 81, 85, 89, 93, 97, 101, 105, 109, 113, 117, 121, 125, 129,
+		133, 137, 141, 145, 149, 153, 157, 161, 165, 168, 172, 176, 180, 184, 188, 192,
+		195, 199, 203, 207, 211, 215, 219, 223, 227, 231, 235, 239, 243, 247, 251, 255,
+		259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 299, 303, 307, 311, 316, 320,
+		324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376, 380, 384,
+		388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448,
+		452, 456, 460, 464, 468, 472, 476, 480, 484, 488, 492, 496, 500, 504, 508, 512,
+		516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560, 564, 568, 572, 576,
+		580, 585, 589, 593, 597, 601, 605, 609, 613, 617, 621, 625, 629, 634, 640, 644,
+		648, 652, 656, 660, 664, 668, 672, 676, 680, 684, 688, 692, 696, 700, 704, 708,
+		712, 716, 720, 724, 727, 731, 735, 739, 743, 747, 751, 755, 759, 763, 767, 771,
+		775, 779, 783, 787, 791, 795, 799, 803, 807, 811, 815, 819, 824, 828, 832, 836,
+		840, 844, 848, 852, 856, 860, 864, 868, 872, 876, 880, 884, 888, 892, 896, 900,
+		904, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963,
+		967, 971, 975, 979, 983, 987, 991, 996, 999, 1003, 1008, 1012, 1016, 1020, 1024, 1028,
+		0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60,
+		64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124,
+		128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188,
+		192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252,
+		256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316,
+		320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376, 380,
+		384, 388, 392, 399, 403, 407, 411, 415, 418, 422, 426, 430, 434, 438, 442, 446,
+		451, 455, 459, 463, 467, 471, 477, 481, 485, 489, 493, 497, 501, 505, 509, 514,
+		518, 522, 526, 530, 534, 538, 542, 546, 550, 554, 558, 562, 567, 571, 575, 579,
+		583, 587, 591, 595, 599, 603, 607, 611, 615, 619, 623, 627, 631, 635, 639, 643,
+		647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707,
+		711, 715, 719, 723, 727, 731, 735, 738, 742, 746, 750, 754, 758, 762, 766, 770,
+		774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814, 818, 823, 827, 831, 835,
+		839, 843, 847, 851, 855, 859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899,
+		903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963,
+		967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 1027,
+		1031, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56,
+		60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120,
+		124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184,
+		188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248,
+		252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312,
+		316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376,
+		380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440,
+		444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 488, 492, 496, 500, 504,
+		508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560, 564, 568,
+		571, 575, 579, 583, 587, 591, 594, 598, 602, 606, 610, 614, 618, 621, 625, 629,
+		633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 677, 681, 685, 689, 693,
+		697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 757,
+		761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801, 805, 809, 813, 817, 821,
+		825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865, 869, 873, 877, 881, 885,
+		889, 893, 897, 901, 905, 909, 913, 917, 921, 925, 929, 933, 937, 941, 945, 949,
+		953, 957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013,
+		1017, 1021, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52,
+		56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 105, 109, 113, 117,
+		121, 125, 129, 133, 137, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181,
+		185, 189, 191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231, 235, 239, 243,
+		247, 251, 255, 259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 299, 303, 307,
+		311, 315, 319, 323, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372,
+		376, 380, 384, 388, 392, 396, 399, 403, 407, 411, 415, 419, 423, 427, 431, 435,
+		439, 443, 447, 451, 454, 458, 462, 466, 470, 474, 478, 482, 486, 490, 494, 498,
+		502, 506, 510, 514, 518, 522, 526, 530, 534, 538, 542, 546, 550, 554, 558, 562,
+		566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606, 610, 614, 618, 622, 626,
+		630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682, 686, 690,
+		694, 698, 702, 706, 710, 714, 718, 722, 726, 730, 734, 738, 742, 746, 750, 754,
+		758, 762, 766, 770, 774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814, 818,
+		823, 827, 831, 835, 839, 843, 847, 851, 855, 859, 863, 867, 871, 875, 879, 883,
+		887, 891, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947,
+		951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011,
+		1015, 1019, 1023, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48,
+		52, 56, 60, 64, 68, 72, 76, 80, 84, 87, 91, 95, 99, 103, 107, 111,
+		115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 163, 167, 171, 175,
+		179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231, 235, 239,
+		243, 247, 251, 255, 258, 262, 266, 270, 274, 278, 282, 286, 290, 294, 298, 302,
+		306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 346, 350, 354, 358, 362, 366,
+		370, 374, 378, 382, 386, 390, 394, 398, 402, 406, 410, 414, 418, 422, 426, 430,
+		434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 478, 482, 485, 489, 493,
+		497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549, 553, 557,
+		561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613, 617, 621,
+		625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 677, 681, 685,
+		689, 694, 698, 702, 706, 710, 714, 718, 722, 726, 730, 735, 739, 742, 746, 751,
+		755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 807, 811, 815,
+		819, 823, 827, 831, 836, 840, 844, 848, 852, 856, 860, 864, 868, 872, 876, 880,
+		884, 888, 892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 931, 935, 939, 943,
+		947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007,
+		1011, 1015, 1019, 1023, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44,
+		47, 51, 55, 59, 63, 67, 71, 75, 79, 83, 87, 91, 95, 99, 103, 107,
+		111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 163, 167, 171,
+		175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231, 235,
+		239, 243, 247, 251, 255, 259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 299,
+		303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351, 355, 359, 363,
+		367, 371, 375, 379, 383, 387, 391, 395, 399, 403, 407, 411, 415, 419, 423, 429,
+		433, 437, 442, 445, 449, 453, 457, 461, 465, 469, 473, 477, 480, 484, 488, 492,
+		496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556,
+		560, 564, 568, 572, 576, 580, 584, 588, 592, 595, 599, 603, 607, 611, 615, 619,
+		623, 627, 631, 635, 639, 643, 647, 652, 656, 660, 664, 667, 671, 675, 679, 683,
+		687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747,
+		751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 807, 810,
+		814, 818, 822, 826, 830, 834, 838, 842, 846, 850, 854, 858, 862, 866, 870, 874,
+		878, 882, 886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938,
+		942, 946, 950, 954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002,
+		1006, 1010, 1014, 1018, 1022, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40,
+		44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104,
+		108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 165, 169,
+		173, 177, 181, 185, 190, 194, 198, 202, 206, 210, 214, 218, 222, 226, 230, 234,
+		238, 242, 246, 250, 254, 258, 262, 266, 269, 273, 277, 281, 285, 289, 293, 297,
+		301, 305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357, 361,
+		365, 369, 373, 377, 381, 387, 391, 394, 398, 402, 406, 410, 414, 418, 422, 426,
+		430, 434, 438, 442, 446, 450, 454, 458, 461, 465, 469, 473, 477, 484, 488, 492,
+		496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556,
+		560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 607, 611, 615, 618,
+		622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682,
+		686, 690, 694, 698, 702, 706, 710, 714, 718, 722, 726, 730, 734, 738, 742, 746,
+		750, 754, 758, 762, 766, 769, 773, 777, 781, 785, 789, 793, 797, 801, 805, 809,
+		813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865, 869, 873,
+		877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 925, 929, 933, 937,
+		941, 945, 949, 953, 957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997, 1001,
+		1005, 1009, 1013, 1017, 1021, 1025, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36,
+		40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100,
+		104, 107, 111, 115, 120, 123, 127, 132, 137, 140, 145, 148, 151, 155, 159, 163,
+		167, 172, 175, 179, 182, 187, 191, 196, 199, 202, 205, 208, 213, 219, 222, 226,
+		230, 234, 238, 242, 246, 250, 254, 258, 262, 266, 270, 274, 277, 281, 285, 289,
+		293, 297, 301, 305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353,
+		357, 361, 365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417,
+		421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 473, 477, 481,
+		485, 489, 493, 498, 502, 506, 510, 514, 518, 522, 526, 530, 534, 538, 542, 546,
+		550, 554, 558, 562, 566, 570, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609,
+		613, 617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673,
+		677, 681, 685, 689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737,
+		741, 745, 749, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801,
+		805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865,
+		869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 922, 926, 930,
+		934, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995,
+		999, 1003, 1007, 1011, 1016, 1020, 1024, 0, 4, 8, 12, 16, 20, 24, 28, 32,
+		36, 41, 45, 49, 53, 57, 62, 66, 70, 74, 78, 82, 86, 90, 94, 98,
+		102, 106, 110, 114, 118, 122, 126, 131, 135, 139, 143, 147, 151, 155, 159, 163,
+		167, 171, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219, 223, 227,
+		231, 235, 239, 243, 247, 251, 255, 259, 263, 266, 270, 274, 278, 282, 286, 290,
+		294, 298, 302, 306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 346, 350, 354,
+		358, 362, 366, 370, 374, 378, 382, 386, 390, 394, 398, 402, 406, 410, 414, 418,
+		422, 426, 430, 434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 478, 482,
+		486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 526, 530, 534, 538, 542, 546,
+		550, 554, 558, 562, 566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606, 610,
+		614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674,
+		678, 682, 688, 692, 696, 700, 703, 707, 711, 716, 720, 724, 728, 732, 736, 740,
+		745, 749, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801, 806,
+		810, 814, 818, 822, 825, 829, 833, 837, 842, 846, 850, 854, 858, 862, 866, 870,
+		874, 878, 882, 886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934,
+		938, 942, 946, 950, 954, 958, 962, 966, 970, 974, 977, 981, 985, 989, 993, 996,
+		1000, 1004, 1008, 1012, 1016, 1020, 1024, 1028, 0, 3, 7, 11, 15, 19, 23, 27,
+		31, 35, 39, 43, 47, 51, 55, 59, 63, 67, 71, 75, 79, 83, 87, 91,
+		95, 99, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155,
+		159, 163, 167, 170, 175, 179, 183, 187, 192, 196, 200, 204, 208, 212, 216, 220,
+		224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284,
+		288, 292, 296, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348,
+		352, 356, 359, 363, 367, 370, 374, 377, 380, 385, 388, 392, 397, 402, 407, 412,
+		416, 421, 425, 428, 432, 437, 441, 444, 449, 452, 458, 463, 469, 473, 476, 480,
+		484, 489, 493, 497, 500, 506, 511, 516, 521, 525, 529, 533, 537, 541, 545, 550,
+		554, 558, 562, 566, 570, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613,
+		617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 678,
+		682, 687, 692, 697, 701, 705, 711, 715, 719, 723, 726, 730, 733, 737, 741, 745,
+		749, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 799, 803, 807, 810,
+		814, 818, 822, 826, 830, 834, 838, 842, 846, 850, 854, 858, 862, 866, 871, 875,
+		879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931, 935, 939,
+		943, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988, 992, 996, 1000, 1004,
+		1008, 1012, 1016, 1020, 1024, 1028, 1032, 1036, 1040, 0, 4, 8, 12, 16, 20, 25,
+		30, 33, 36, 41, 45, 49, 54, 59, 62, 67, 70, 75, 80, 84, 87, 91,
+		96, 99, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155,
+		159, 163, 167, 171, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219,
+		223, 227, 231, 235, 239, 243, 247, 251, 255, 259, 263, 267, 271, 275, 279, 283,
+		287, 291, 295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347,
+		351, 355, 361, 365, 369, 373, 377, 381, 385, 389, 393, 398, 402, 406, 410, 414,
+		418, 422, 426, 430, 434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 478,
+		482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 526, 530, 534, 538, 542,
+		546, 550, 554, 558, 562, 566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606,
+		610, 614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670,
+		674, 678, 682, 686, 690, 694, 698, 702, 706, 710, 714, 718, 722, 726, 730, 734,
+		738, 742, 746, 750, 754, 758, 762, 766, 770, 774, 778, 782, 786, 790, 794, 798,
+		802, 806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850, 854, 858, 862,
+		866, 870, 874, 878, 882, 886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 925,
+		928, 931, 935, 940, 945, 949, 952, 957, 961, 964, 967, 972, 976, 980, 984, 989,
+		994, 998, 1002, 1005, 1008, 1012, 1015, 1020, 1023, 1027, 0, 5, 9, 12, 16, 20,
+		24, 28, 31, 36, 40, 44, 49, 53, 57, 61, 66, 70, 75, 79, 83, 86,
+		90, 95, 99, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151,
+		155, 159, 163, 167, 171, 175, 179, 183, 187, 191, 195, 200, 204, 209, 213, 217,
+		221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 277, 281,
+		285, 289, 293, 297, 301, 305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345,
+		349, 353, 357, 361, 365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409,
+		413, 417, 421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 473,
+		477, 481, 485, 489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537,
+		542, 546, 550, 554, 558, 562, 566, 570, 574, 578, 582, 586, 590, 593, 598, 602,
+		606, 612, 616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668,
+		672, 676, 680, 684, 688, 691, 696, 701, 705, 708, 711, 715, 719, 723, 727, 730,
+		734, 739, 743, 748, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795,
+		799, 803, 806, 809, 814, 817, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856,
+		860, 864, 868, 872, 876, 881, 885, 889, 893, 898, 903, 909, 913, 917, 920, 924,
+		928, 931, 934, 939, 943, 947, 950, 955, 959, 965, 969, 973, 978, 982, 988, 993,
+		999, 1003, 1007, 1011, 1015, 1019, 1023, 1027, 1031, 1035, 1039, 0, 4, 8, 12, 16,
+		20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80,
+		84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 127, 131, 137, 141, 145,
+		148, 152, 156, 159, 162, 168, 173, 177, 180, 187, 191, 196, 201, 204, 208, 212,
+		216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276,
+		280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340,
+		344, 348, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388, 392, 396, 400, 404,
+		408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468,
+		472, 476, 480, 484, 488, 492, 496, 500, 504, 508, 512, 516, 520, 524, 528, 532,
+		536, 540, 544, 548, 551, 556, 561, 567, 571, 575, 580, 585, 590, 593, 596, 600,
+		604, 608, 611, 614, 618, 621, 625, 629, 633, 637, 640, 644, 648, 653, 657, 662,
+		665, 669, 674, 678, 681, 685, 688, 692, 696, 700, 704, 708, 712, 716, 720, 724,
+		728, 732, 736, 740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788,
+		792, 796, 800, 804, 808, 812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852,
+		856, 860, 864, 868, 872, 876, 881, 885, 889, 894, 898, 903, 907, 910, 915, 918,
+		921, 925, 928, 932, 935, 939, 942, 947, 950, 956, 960, 965, 968, 973, 978, 981,
+		984, 988, 992, 995, 998, 1002, 1006, 1010, 1014, 1017, 1021, 1025, 0, 5, 8, 14,
+		18, 22, 27, 31, 35, 39, 43, 47, 51, 55, 59, 63, 67, 71, 76, 80,
+		84, 87, 90, 94, 98, 102, 107, 111, 115, 119, 123, 127, 130, 134, 138, 142,
+		146, 150, 154, 158, 162, 165, 169, 173, 177, 180, 184, 188, 192, 195, 199, 204,
+		208, 212, 216, 220, 225, 229, 233, 236, 239, 242, 245, 248, 251, 255, 259, 263,
+		267, 271, 275, 279, 283, 287, 291, 295, 299, 303, 307, 311, 315, 319, 323, 327,
+		331, 335, 339, 343, 347, 351, 355, 359, 363, 367, 371, 375, 379, 383, 387, 391,
+		395, 399, 403, 407, 411, 415, 420, 425, 429, 433, 438, 442, 446, 450, 454, 458,
+		462, 466, 470, 474, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522,
+		526, 530, 534, 538, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578, 582, 586,
+		590, 594, 598, 602, 606, 610, 614, 617, 621, 625, 629, 633, 637, 642, 647, 651,
+		656, 660, 664, 667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707, 711, 715,
+		719, 723, 727, 731, 736, 740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 780,
+		784, 789, 795, 801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 846, 850,
+		854, 858, 861, 865, 869, 873, 877, 881, 885, 889, 893, 897, 901, 906, 911, 915,
+		919, 923, 928, 932, 936, 940, 944, 948, 951, 955, 959, 963, 967, 971, 975, 979,
+		982, 986, 990, 995, 998, 1002, 1007, 1011, 1016, 1019, 1022, 1025, 1029, 0, 3, 6,
+		11, 15, 19, 22, 26, 30, 33, 37, 42, 45, 50, 54, 58, 61, 65, 69,
+		75, 79, 83, 87, 91, 95, 99, 103, 107, 111, 114, 118, 121, 124, 128, 132,
+		136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196,
+		200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260,
+		264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 320, 324,
+		328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388,
+		392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 431, 435, 439, 443, 447, 451,
+		455, 459, 463, 467, 471, 475, 479, 483, 487, 491, 495, 499, 503, 507, 511, 515,
+		519, 523, 527, 531, 535, 539, 543, 547, 551, 555, 559, 563, 567, 571, 575, 579,
+		584, 588, 592, 596, 600, 604, 608, 613, 617, 621, 625, 629, 633, 637, 641, 645,
+		649, 653, 657, 661, 665, 669, 673, 677, 682, 686, 690, 694, 698, 702, 706, 710,
+		714, 718, 722, 726, 730, 734, 738, 744, 748, 752, 756, 760, 764, 768, 772, 776,
+		780, 784, 788, 792, 796, 800, 804, 808, 811, 815, 819, 823, 827, 831, 835, 839,
+		843, 847, 851, 855, 859, 863, 867, 871, 875, 880, 884, 888, 892, 896, 900, 904,
+		908, 912, 916, 920, 924, 928, 932, 936, 940, 943, 947, 951, 955, 959, 963, 967,
+		971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 0, 4,
+		8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68,
+		72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132,
+		136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196,
+		200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260,
+		264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 320, 324,
+		328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388,
+		392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452,
+		456, 460, 463, 467, 470, 474, 478, 482, 486, 489, 493, 496, 500, 504, 508, 512,
+		516, 520, 524, 528, 533, 537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577,
+		581, 585, 589, 593, 597, 601, 605, 609, 613, 617, 621, 625, 629, 633, 637, 641,
+		645, 649, 653, 657, 661, 665, 669, 673, 679, 683, 687, 691, 695, 699, 703, 707,
+		711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751, 755, 759, 763, 767, 771,
+		775, 779, 783, 787, 791, 795, 799, 803, 807, 811, 815, 819, 823, 827, 831, 835,
+		839, 843, 847, 851, 855, 859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899,
+		903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963,
+		967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1022, 0,
+		4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64,
+		68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128,
+		132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192,
+		196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256,
+		260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 320,
+		324, 328, 332, 336, 340, 345, 349, 353, 357, 361, 366, 371, 375, 379, 383, 387,
+		391, 395, 399, 403, 406, 410, 414, 418, 422, 426, 430, 433, 437, 441, 445, 449,
+		453, 457, 461, 465, 469, 473, 477, 481, 485, 489, 493, 497, 501, 505, 509, 513,
+		517, 521, 525, 528, 533, 537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577,
+		581, 585, 589, 593, 597, 601, 605, 609, 612, 616, 620, 624, 628, 632, 636, 640,
+		644, 648, 652, 656, 660, 664, 668, 673, 677, 681, 685, 689, 693, 697, 701, 705,
+		709, 713, 717, 721, 725, 729, 733, 737, 741, 745, 748, 752, 756, 760, 764, 768,
+		772, 776, 780, 784, 788, 792, 796, 798, 802, 806, 810, 814, 818, 822, 826, 830,
+		834, 838, 842, 846, 850, 854, 858, 862, 866, 870, 874, 878, 882, 886, 890, 894,
+		898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946, 950, 954, 958,
+		962, 966, 970, 974, 978, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013, 1017, 1021,
+		0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60,
+		64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124,
+		128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188,
+		192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252,
+		256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316,
+		320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376, 382,
+		385, 389, 393, 397, 401, 405, 409, 413, 417, 420, 424, 429, 433, 437, 441, 444,
+		448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 488, 492, 496, 500, 504, 508,
+		512, 516, 520, 524, 529, 533, 538, 542, 546, 550, 554, 558, 562, 566, 570, 574,
+		578, 582, 586, 590, 594, 598, 602, 606, 610, 614, 618, 622, 626, 630, 634, 638,
+		642, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682, 686, 690, 696, 700, 704,
+		708, 712, 716, 720, 724, 728, 732, 736, 740, 744, 748, 752, 756, 760, 764, 768,
+		772, 776, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816, 820, 824, 828, 832,
+		836, 840, 844, 849, 853, 857, 861, 865, 869, 873, 877, 881, 885, 889, 893, 897,
+		901, 905, 909, 913, 917, 921, 925, 929, 933, 937, 941, 945, 949, 953, 957, 961,
+		965, 969, 973, 977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025,
+		1029, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56,
+		60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 113, 117, 121,
+		125, 129, 133, 137, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 185,
+		189, 193, 198, 202, 206, 210, 214, 218, 222, 226, 230, 234, 238, 242, 246, 250,
+		254, 258, 262, 266, 270, 274, 278, 282, 286, 290, 294, 298, 302, 306, 310, 314,
+		318, 322, 326, 330, 334, 338, 342, 346, 350, 354, 358, 362, 366, 370, 374, 378,
+		382, 386, 390, 394, 398, 402, 406, 410, 414, 418, 422, 426, 430, 434, 438, 442,
+		446, 450, 454, 458, 462, 466, 470, 474, 478, 482, 486, 490, 494, 498, 502, 506,
+		510, 514, 518, 522, 526, 530, 534, 538, 542, 546, 550, 554, 558, 562, 566, 570,
+		574, 578, 582, 586, 590, 594, 598, 602, 606, 610, 614, 618, 622, 626, 630, 634,
+		638, 642, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682, 686, 690, 694, 698,
+		702, 706, 710, 714, 718, 722, 726, 730, 734, 738, 743, 747, 751, 755, 759, 763,
+		767, 771, 775, 779, 783, 786, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826,
+		830, 834, 838, 842, 846, 850, 854, 858, 862, 866, 870, 874, 878, 882, 886, 890,
+		894, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946, 950, 954,
+		958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006, 1010, 1014, 1018,
+		1022, 1026, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52,
+		56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116,
+		120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180,
+		184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244,
+		248, 252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308,
+		312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372,
+		376, 380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436,
+		440, 444, 448, 452, 456, 460, 464, 468, 473, 477, 480, 485, 489, 493, 497, 501,
+		505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549, 553, 557, 561, 565,
+		569, 573, 577, 581, 585, 589, 593, 597, 602, 606, 610, 614, 618, 622, 626, 630,
+		634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682, 686, 690, 694,
+		698, 702, 706, 710, 714, 719, 723, 727, 731, 735, 739, 743, 747, 751, 755, 759,
+		763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 806, 811, 815, 819, 823,
+		827, 831, 835, 839, 843, 847, 851, 855, 859, 863, 867, 871, 875, 879, 883, 887,
+		891, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947, 951,
+		955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015,
+		1019, 1024, 1028, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48,
+		52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112,
+		116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176,
+		180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240,
+		244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304,
+		308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368,
+		372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432,
+		436, 440, 443, 447, 451, 455, 459, 463, 467, 471, 475, 479, 483, 487, 491, 495,
+		500, 506, 510, 514, 518, 522, 526, 530, 534, 538, 542, 546, 550, 554, 557, 561,
+		566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606, 610, 614, 618, 622, 626,
+		630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682, 687, 691,
+		695, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751, 755,
+		759, 763, 767, 771, 775, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814, 818,
+		822, 826, 830, 834, 838, 842, 846, 850, 854, 858, 862, 866, 870, 874, 878, 882,
+		886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946,
+		950, 954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006, 1010,
+		1014, 1018, 1022, 1026, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44,
+		48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108,
+		113, 117, 122, 126, 130, 134, 138, 142, 146, 149, 153, 157, 161, 165, 169, 173,
+		176, 180, 184, 189, 193, 197, 202, 206, 210, 214, 217, 221, 225, 229, 233, 237,
+		241, 245, 249, 253, 257, 260, 265, 269, 273, 277, 281, 285, 289, 293, 297, 300,
+		304, 308, 312, 316, 320, 325, 329, 333, 337, 341, 345, 349, 353, 357, 362, 366,
+		370, 374, 378, 382, 386, 390, 394, 398, 404, 408, 413, 417, 421, 425, 429, 433,
+		437, 441, 445, 450, 454, 458, 462, 467, 470, 474, 478, 482, 486, 490, 494, 498,
+		502, 506, 510, 514, 518, 522, 526, 530, 535, 539, 543, 547, 551, 555, 559, 563,
+		567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 619, 623, 627,
+		631, 635, 639, 643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 687, 691,
+		695, 699, 703, 707, 711, 715, 719, 722, 726, 730, 734, 738, 742, 746, 750, 754,
+		758, 762, 766, 770, 774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814, 818,
+		822, 826, 830, 834, 838, 842, 846, 850, 854, 858, 862, 866, 870, 874, 878, 882,
+		886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946,
+		950, 954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006, 1010,
+		1014, 1018, 1022, 1026, 1030, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40,
+		44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104,
+		108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168,
+		173, 177, 181, 185, 189, 193, 197, 201, 205, 209, 213, 217, 221, 227, 231, 235,
+		239, 243, 247, 251, 255, 259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 299,
+		303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351, 355, 359, 363,
+		367, 371, 375, 379, 383, 387, 391, 395, 399, 403, 407, 411, 415, 419, 423, 427,
+		431, 435, 439, 443, 447, 451, 455, 459, 463, 467, 471, 474, 478, 482, 486, 490,
+		493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549, 555,
+		559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 619,
+		623, 627, 631, 635, 639, 643, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682,
+		686, 690, 694, 698, 702, 706, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747,
+		751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 807, 811,
+		815, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855, 859, 863, 867, 870, 874,
+		878, 882, 886, 890, 894, 898, 902, 906, 910, 914, 918, 922, 927, 931, 935, 939,
+		943, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988, 992, 996, 1000, 1004,
+		1008, 1012, 1016, 1020, 1024, 1028, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36,
+		40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100,
+		104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164,
+		168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228,
+		232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292,
+		296, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356,
+		360, 364, 368, 372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420,
+		424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484,
+		488, 492, 496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548,
+		552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612,
+		616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 667, 671, 675,
+		679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 739,
+		743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 784, 788, 792, 796, 800, 804,
+		808, 812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 869,
+		873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 925, 929, 933,
+		937, 941, 945, 949, 953, 957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997,
+		1001, 1005, 1009, 1013, 1017, 1021, 1025, 0, 4, 9, 13, 17, 21, 25, 29, 33,
+		37, 41, 45, 49, 53, 57, 61, 65, 69, 73, 77, 81, 85, 89, 93, 97,
+		101, 105, 109, 113, 117, 121, 125, 129, 133, 137, 141, 145, 149, 153, 157, 160,
+		164, 168, 172, 176, 180, 184, 188, 192, 196, 199, 203, 207, 211, 215, 219, 223,
+		227, 231, 235, 239, 243, 247, 251, 255, 259, 263, 267, 271, 275, 279, 283, 287,
+		291, 295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351,
+		355, 359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 399, 403, 407, 411, 415,
+		419, 423, 427, 431, 435, 439, 443, 447, 451, 455, 459, 463, 467, 471, 475, 479,
+		483, 487, 491, 495, 498, 502, 506, 510, 514, 518, 523, 527, 531, 535, 539, 543,
+		547, 550, 554, 558, 562, 567, 572, 576, 580, 584, 588, 592, 596, 600, 604, 609,
+		613, 617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 662, 666, 671, 675,
+		679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 732, 736, 740,
+		744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 789, 793, 797, 801, 805,
+		809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865, 869,
+		873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 925, 929, 933,
+		937, 941, 945, 949, 953, 957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997,
+		1001, 1005, 1009, 1013, 1017, 1021, 1025, 1029, 0, 4, 8, 12, 16, 20, 24, 28,
+		32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92,
+		95, 99, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155,
+		159, 163, 167, 171, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219,
+		224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284,
+		288, 292, 296, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348,
+		352, 356, 361, 365, 368, 372, 376, 380, 384, 388, 393, 397, 401, 405, 409, 413,
+		417, 421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 473, 477,
+		481, 485, 489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541,
+		545, 549, 553, 557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605,
+		609, 613, 617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669,
+		674, 678, 682, 686, 690, 694, 698, 702, 706, 710, 714, 718, 722, 726, 730, 734,
+		738, 742, 746, 750, 754, 758, 762, 766, 770, 774, 778, 782, 786, 790, 794, 798,
+		802, 806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850, 854, 858, 862,
+		866, 870, 874, 878, 882, 885, 889, 893, 896, 900, 904, 908, 912, 916, 920, 924,
+		928, 932, 936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988,
+		992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 0, 4, 8, 12, 16, 20, 24,
+		28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88,
+		92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 133, 137, 141, 145, 149, 153,
+		157, 161, 165, 169, 173, 177, 181, 185, 189, 193, 197, 201, 205, 209, 213, 217,
+		221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 277, 281,
+		285, 291, 295, 299, 303, 307, 311, 316, 320, 325, 329, 333, 337, 340, 346, 350,
+		354, 359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 400, 404, 408, 412, 416,
+		420, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 473, 477, 481,
+		485, 489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545,
+		548, 552, 556, 560, 564, 568, 572, 576, 581, 585, 589, 593, 597, 601, 606, 609,
+		613, 617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673,
+		677, 681, 685, 689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737,
+		741, 745, 749, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801,
+		805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865,
+		869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 908, 912, 916, 920, 924, 928,
+		932, 936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988, 992,
+		996, 1000, 1005, 1009, 1013, 1017, 1021, 1025, 1029, 1033, 0, 4, 8, 12, 16, 20,
+		24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84,
+		88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148,
+		152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 191, 195, 199, 203, 207, 211,
+		215, 219, 223, 227, 231, 235, 239, 243, 247, 251, 255, 259, 263, 268, 272, 275,
+		279, 283, 287, 291, 295, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340,
+		344, 348, 352, 356, 360, 364, 367, 371, 375, 379, 383, 387, 391, 395, 399, 403,
+		407, 410, 414, 418, 422, 426, 430, 435, 439, 443, 447, 451, 455, 459, 463, 467,
+		471, 475, 479, 483, 487, 491, 495, 499, 503, 507, 511, 515, 519, 523, 528, 532,
+		536, 540, 544, 548, 552, 556, 560, 564, 568, 572, 576, 580, 585, 589, 593, 597,
+		601, 606, 610, 614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662,
+		666, 670, 674, 678, 682, 686, 690, 694, 698, 703, 707, 711, 715, 719, 723, 727,
+		731, 735, 739, 743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791,
+		795, 799, 803, 807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855,
+		859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919,
+		923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983,
+		987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 1027, 0, 4, 8, 12, 16,
+		20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80,
+		84, 88, 93, 97, 101, 105, 108, 111, 116, 121, 126, 131, 135, 139, 145, 149,
+		152, 157, 161, 164, 168, 173, 178, 183, 188, 193, 196, 201, 206, 210, 214, 217,
+		221, 226, 230, 233, 237, 241, 245, 249, 253, 257, 264, 268, 272, 276, 280, 285,
+		289, 293, 297, 301, 305, 309, 312, 318, 322, 326, 329, 333, 337, 341, 345, 349,
+		353, 357, 361, 365, 369, 373, 377, 381, 385, 388, 392, 396, 400, 404, 408, 412,
+		416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476,
+		480, 484, 488, 492, 496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540,
+		543, 547, 551, 555, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603,
+		607, 611, 615, 619, 623, 627, 631, 635, 639, 643, 647, 651, 655, 659, 663, 667,
+		671, 675, 679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731,
+		735, 739, 743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795,
+		799, 803, 807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855, 859,
+		863, 867, 871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923,
+		927, 931, 935, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987,
+		991, 995, 999, 1003, 1007, 1012, 1016, 1020, 1023, 1027, 1031, 1035, 0, 4, 8, 12,
+		16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76,
+		80, 83, 87, 91, 95, 99, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139,
+		143, 147, 151, 155, 158, 162, 166, 170, 174, 178, 182, 186, 192, 196, 200, 204,
+		208, 212, 216, 220, 224, 229, 233, 237, 241, 245, 249, 254, 258, 262, 265, 269,
+		273, 277, 281, 285, 289, 293, 297, 300, 304, 308, 312, 316, 320, 324, 328, 332,
+		336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388, 392, 396,
+		400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460,
+		464, 468, 472, 476, 480, 484, 488, 492, 496, 500, 504, 508, 512, 516, 520, 524,
+		528, 532, 536, 540, 544, 548, 552, 556, 561, 565, 569, 573, 577, 581, 585, 589,
+		593, 597, 601, 605, 609, 613, 617, 621, 625, 629, 633, 637, 641, 645, 649, 653,
+		657, 661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 701, 705, 709, 713, 717,
+		721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 761, 765, 769, 773, 776, 780,
+		784, 788, 792, 796, 800, 804, 808, 812, 816, 820, 824, 828, 832, 836, 840, 844,
+		848, 852, 856, 860, 864, 868, 872, 876, 880, 884, 888, 892, 896, 900, 904, 908,
+		912, 916, 920, 924, 928, 932, 936, 939, 943, 947, 951, 955, 959, 963, 967, 971,
+		975, 979, 983, 987, 991, 995, 999, 1004, 1008, 1012, 1017, 1021, 1025, 0, 4, 8,
+		12, 15, 19, 24, 28, 32, 37, 41, 45, 49, 53, 57, 61, 65, 70, 74,
+		78, 82, 86, 90, 94, 98, 102, 106, 110, 114, 118, 122, 126, 130, 134, 138,
+		142, 146, 150, 154, 158, 162, 166, 170, 174, 178, 182, 186, 190, 194, 198, 202,
+		206, 210, 214, 218, 222, 226, 230, 234, 238, 242, 246, 250, 254, 258, 262, 266,
+		270, 274, 278, 282, 286, 291, 295, 299, 303, 307, 311, 316, 321, 325, 329, 333,
+		337, 341, 345, 350, 354, 358, 362, 366, 370, 374, 378, 382, 386, 390, 394, 398,
+		402, 406, 410, 414, 418, 422, 426, 430, 434, 438, 442, 446, 450, 454, 458, 462,
+		466, 470, 474, 478, 482, 486, 490, 494, 498, 503, 507, 511, 515, 519, 523, 527,
+		531, 535, 539, 543, 547, 551, 556, 559, 562, 567, 571, 575, 578, 582, 585, 590,
+		595, 599, 604, 608, 612, 616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656,
+		660, 664, 668, 672, 676, 680, 684, 688, 693, 697, 701, 705, 709, 714, 718, 722,
+		726, 730, 734, 738, 742, 746, 750, 754, 758, 762, 766, 770, 774, 778, 782, 786,
+		790, 794, 798, 802, 806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850,
+		854, 858, 862, 866, 870, 874, 878, 881, 885, 889, 893, 897, 901, 905, 909, 914,
+		918, 922, 927, 931, 935, 939, 942, 946, 950, 954, 958, 963, 968, 972, 976, 981,
+		984, 988, 992, 996, 1000, 1003, 1007, 1010, 1014, 1019, 1024, 1029, 1032, 1036, 0, 4,
+		8, 13, 17, 21, 24, 28, 32, 36, 40, 44, 49, 53, 59, 64, 68, 71,
+		74, 77, 81, 85, 88, 92, 96, 100, 103, 108, 113, 117, 121, 124, 129, 133,
+		136, 140, 144, 149, 155, 158, 162, 166, 169, 172, 176, 181, 185, 189, 194, 199,
+		203, 207, 211, 215, 219, 223, 227, 231, 235, 239, 243, 247, 251, 255, 259, 263,
+		267, 271, 275, 279, 283, 287, 291, 295, 299, 303, 307, 311, 315, 319, 323, 327,
+		331, 335, 339, 343, 347, 351, 355, 359, 363, 367, 371, 375, 379, 383, 387, 391,
+		395, 399, 403, 407, 411, 415, 419, 423, 427, 431, 435, 439, 443, 447, 451, 455,
+		459, 463, 468, 472, 475, 478, 484, 490, 494, 498, 503, 508, 512, 516, 521, 525,
+		529, 533, 537, 541, 546, 551, 555, 559, 563, 567, 571, 575, 579, 583, 587, 591,
+		595, 599, 603, 607, 611, 615, 619, 623, 627, 631, 635, 639, 643, 647, 651, 655,
+		659, 663, 666, 670, 674, 678, 682, 686, 690, 694, 698, 702, 706, 710, 714, 718,
+		722, 726, 730, 735, 741, 745, 749, 753, 757, 761, 766, 770, 774, 778, 782, 788,
+		792, 796, 801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853,
+		858, 862, 866, 870, 874, 878, 882, 886, 890, 895, 899, 903, 907, 911, 915, 919,
+		923, 928, 932, 936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984,
+		988, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 1028, 1032, 1036, 1040, 1044, 0,
+		4, 8, 11, 15, 20, 25, 30, 34, 38, 43, 47, 52, 55, 58, 62, 65,
+		69, 74, 80, 85, 89, 93, 97, 101, 105, 109, 113, 117, 121, 125, 129, 133,
+		137, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 185, 189, 193, 197,
+		201, 205, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261,
+		265, 269, 273, 277, 281, 285, 289, 293, 297, 301, 305, 309, 313, 317, 321, 325,
+		329, 333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 377, 381, 385, 389,
+		393, 397, 401, 405, 409, 413, 417, 421, 425, 429, 433, 437, 441, 445, 449, 453,
+		457, 461, 465, 469, 473, 477, 481, 485, 489, 493, 497, 501, 505, 509, 513, 517,
+		521, 525, 529, 533, 537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577, 581,
+		585, 589, 593, 597, 601, 605, 609, 613, 617, 621, 625, 629, 633, 637, 641, 645,
+		649, 653, 657, 661, 665, 669, 673, 676, 681, 686, 690, 694, 698, 702, 706, 710,
+		714, 718, 722, 726, 730, 734, 738, 742, 746, 750, 756, 760, 764, 768, 772, 776,
+		780, 784, 788, 792, 796, 800, 804, 808, 812, 816, 820, 824, 828, 832, 836, 840,
+		844, 848, 852, 856, 860, 864, 868, 872, 876, 880, 884, 888, 892, 896, 900, 904,
+		908, 912, 916, 920, 924, 928, 932, 936, 940, 944, 948, 952, 956, 960, 964, 968,
+		972, 976, 980, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 1028, 1032,
+		0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60,
+		64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124,
+		128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 167, 171, 174, 178, 182, 188,
+		192, 198, 202, 205, 208, 212, 217, 221, 225, 229, 234, 238, 241, 245, 249, 254,
+		259, 262, 265, 270, 273, 277, 283, 287, 291, 295, 298, 301, 305, 309, 313, 318,
+		323, 327, 330, 334, 339, 344, 348, 353, 357, 361, 365, 370, 373, 377, 381, 384,
+		388, 392, 397, 401, 406, 410, 415, 419, 423, 428, 433, 437, 442, 446, 449, 453,
+		457, 460, 464, 468, 471, 475, 479, 483, 489, 493, 497, 502, 507, 511, 514, 518,
+		521, 525, 528, 532, 537, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578, 582,
+		586, 590, 594, 598, 602, 606, 610, 614, 618, 622, 626, 630, 634, 638, 642, 646,
+		650, 654, 659, 664, 670, 673, 677, 681, 685, 689, 692, 696, 700, 704, 708, 712,
+		716, 718, 722, 726, 730, 733, 738, 742, 746, 750, 755, 758, 761, 765, 769, 773,
+		777, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816, 820, 824, 828, 832, 836,
+		840, 844, 848, 852, 856, 860, 864, 868, 872, 876, 880, 884, 888, 892, 896, 900,
+		904, 908, 912, 916, 920, 924, 928, 932, 936, 940, 944, 948, 952, 956, 960, 964,
+		968, 972, 976, 980, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 1028,
+		1032, 0, 4, 8, 12, 15, 19, 23, 27, 31, 35, 39, 43, 47, 51, 55,
+		59, 63, 67, 71, 75, 79, 83, 87, 91, 95, 99, 103, 107, 111, 115, 119,
+		123, 127, 131, 135, 139, 144, 149, 155, 159, 163, 167, 171, 175, 179, 183, 187,
+		191, 195, 199, 203, 207, 211, 215, 219, 223, 228, 231, 235, 238, 242, 246, 250,
+		254, 258, 262, 266, 270, 274, 278, 282, 286, 290, 294, 298, 302, 306, 310, 314,
+		318, 322, 326, 330, 334, 338, 342, 345, 348, 352, 356, 360, 364, 370, 374, 378,
+		382, 386, 390, 394, 398, 403, 407, 412, 415, 419, 423, 427, 430, 435, 439, 442,
+		445, 450, 453, 458, 462, 465, 469, 473, 477, 481, 485, 489, 493, 497, 501, 506,
+		510, 514, 517, 521, 525, 529, 533, 538, 541, 545, 549, 553, 557, 561, 565, 569,
+		573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613, 617, 621, 625, 631, 635,
+		639, 643, 647, 651, 655, 659, 663, 667, 670, 674, 678, 682, 689, 694, 698, 702,
+		705, 709, 715, 719, 724, 727, 731, 735, 738, 741, 745, 750, 754, 757, 760, 764,
+		767, 771, 774, 778, 783, 786, 790, 794, 799, 803, 807, 810, 814, 817, 821, 826,
+		830, 834, 840, 845, 850, 855, 858, 862, 866, 870, 874, 878, 882, 886, 890, 894,
+		898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946, 950, 954, 958,
+		962, 966, 970, 974, 979, 983, 987, 990, 996, 1000, 1003, 1007, 1011, 1017, 1021, 1026,
+		1030, 1033, 0, 5, 8, 12, 16, 20, 25, 28, 32, 36, 40, 44, 48, 53,
+		58, 62, 67, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120,
+		124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 173, 177, 181, 185,
+		189, 193, 197, 201, 205, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249,
+		253, 257, 261, 265, 269, 273, 277, 281, 285, 289, 293, 297, 301, 305, 309, 313,
+		317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 377,
+		381, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421, 425, 429, 433, 437, 441,
+		445, 449, 453, 457, 461, 465, 469, 473, 477, 481, 485, 489, 493, 497, 501, 505,
+		509, 513, 517, 521, 525, 528, 532, 537, 541, 546, 550, 554, 557, 562, 565, 569,
+		573, 577, 581, 586, 590, 594, 599, 603, 606, 610, 614, 618, 622, 626, 631, 635,
+		639, 642, 645, 650, 654, 659, 663, 666, 670, 674, 678, 681, 685, 688, 694, 697,
+		700, 703, 707, 711, 716, 722, 726, 729, 734, 738, 742, 746, 750, 755, 758, 762,
+		766, 770, 774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826,
+		830, 834, 838, 842, 846, 850, 854, 858, 861, 865, 869, 873, 877, 881, 885, 889,
+		893, 897, 901, 905, 909, 913, 917, 921, 925, 929, 933, 937, 941, 945, 949, 953,
+		957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013, 1016,
+		1021, 1026, 1030, 0, 4, 7, 10, 14, 18, 22, 28, 32, 36, 42, 46, 50,
+		54, 59, 62, 67, 74, 78, 81, 85, 89, 94, 98, 102, 106, 111, 115, 121,
+		126, 129, 132, 136, 139, 143, 147, 152, 157, 163, 168, 172, 175, 178, 182, 186,
+		190, 193, 197, 200, 203, 206, 210, 214, 218, 223, 229, 233, 238, 242, 246, 250,
+		254, 258, 261, 265, 269, 273, 277, 281, 285, 289, 293, 296, 300, 304, 308, 312,
+		316, 319, 324, 328, 332, 336, 340, 344, 348, 353, 358, 362, 367, 371, 375, 379,
+		383, 387, 391, 395, 399, 403, 407, 411, 415, 419, 423, 427, 431, 435, 439, 443,
+		447, 451, 455, 459, 463, 467, 471, 475, 479, 483, 487, 491, 495, 499, 502, 505,
+		509, 514, 518, 522, 526, 530, 534, 537, 541, 546, 550, 554, 557, 560, 564, 567,
+		570, 575, 578, 581, 585, 589, 593, 597, 601, 605, 609, 613, 617, 621, 625, 629,
+		634, 638, 642, 646, 650, 654, 657, 660, 663, 666, 669, 672, 675, 678, 681, 684,
+		687, 690, 693, 696, 700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744,
+		748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808,
+		812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 868, 872,
+		876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 932, 936,
+		940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988, 992, 996, 1000,
+		1004, 1008, 1012, 1016, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44,
+		48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 91, 95, 99, 103, 107,
+		111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 163, 167, 171,
+		175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231, 235,
+		239, 243, 247, 251, 255, 259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 299,
+		303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351, 355, 359, 363,
+		367, 371, 375, 379, 383, 387, 391, 395, 399, 403, 407, 411, 415, 419, 423, 427,
+		431, 435, 439, 443, 447, 451, 455, 459, 463, 467, 471, 475, 479, 483, 487, 491,
+		495, 499, 503, 507, 511, 515, 519, 523, 527, 531, 535, 539, 543, 547, 551, 555,
+		559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 619,
+		623, 627, 631, 635, 639, 643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683,
+		687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747,
+		751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 807, 811,
+		815, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855, 859, 863, 867, 871, 875,
+		879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931, 935, 939,
+		943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 999, 1003,
+		1007, 1011, 1015, 1019, 1023, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40,
+		44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104,
+		108, 113, 117, 121, 125, 129, 133, 137, 141, 145, 149, 153, 157, 161, 165, 169,
+		173, 177, 181, 185, 189, 193, 197, 201, 205, 209, 213, 217, 221, 225, 229, 233,
+		237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 277, 281, 285, 289, 293, 297,
+		301, 305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357, 361,
+		365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421, 425,
+		429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 473, 477, 481, 485, 489,
+		493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549, 553,
+		557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613, 617,
+		621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 677, 681,
+		685, 689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741, 745,
+		749, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801, 805, 809,
+		813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865, 869, 873,
+		877, 881, 884, 888, 892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 932, 936,
+		940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988, 992, 996, 1000,
+		1004, 1008, 1012, 1016, 1020, 1024, 0, 4, 8, 12, 16, 20, 24, 28, 32, 35,
+		40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100,
+		104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164,
+		168, 172, 176, 180, 184, 189, 193, 197, 201, 205, 209, 213, 217, 221, 225, 229,
+		233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 277, 281, 285, 289, 293,
+		297, 301, 305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357,
+		361, 365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421,
+		425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 473, 477, 481, 485,
+		489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549,
+		553, 557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613,
+		617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 677,
+		681, 685, 689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 740,
+		744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804,
+		808, 812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 868,
+		872, 876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 932,
+		936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988, 992, 996,
+		1000, 1004, 1008, 1012, 1016, 1020, 1024, 0, 4, 8, 12, 16, 20, 24, 28, 32,
+		36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96,
+		100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160,
+		164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224,
+		228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284, 288,
+		292, 296, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352,
+		356, 360, 364, 368, 372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412, 416,
+		420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480,
+		484, 488, 492, 496, 500, 504, 508, 512, 516, 520, 525, 529, 533, 537, 541, 545,
+		549, 553, 557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609,
+		613, 617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673,
+		677, 681, 685, 689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737,
+		741, 745, 749, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801,
+		805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865,
+		869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 924, 928,
+		932, 936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988, 992,
+		996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 0, 4, 8, 12, 16, 20, 24, 28,
+		32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92,
+		96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156,
+		160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220,
+		224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284,
+		288, 292, 296, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348,
+		352, 356, 360, 364, 368, 372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412,
+		416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476,
+		480, 484, 488, 492, 496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540,
+		544, 548, 552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604,
+		608, 612, 616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668,
+		672, 676, 680, 684, 688, 692, 696, 700, 704, 708, 712, 716, 720, 724, 728, 732,
+		736, 740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796,
+		800, 804, 808, 812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860,
+		864, 868, 872, 876, 880, 884, 888, 892, 896, 900, 903, 907, 911, 915, 919, 923,
+		927, 931, 935, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987,
+		991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 0, 4, 8, 12, 16, 20, 24,
+		28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 79, 83, 87,
+		91, 95, 99, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151,
+		155, 159, 163, 167, 171, 175, 179, 183, 187, 191, 195, 199, 203, 207, 211, 215,
+		219, 223, 227, 231, 235, 239, 243, 247, 251, 255, 259, 263, 267, 271, 275, 279,
+		283, 287, 291, 295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343,
+		347, 351, 355, 359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 399, 403, 407,
+		411, 415, 419, 423, 427, 431, 435, 439, 443, 447, 451, 455, 459, 463, 467, 471,
+		475, 479, 483, 487, 491, 495, 499, 503, 507, 511, 515, 519, 523, 527, 531, 535,
+		539, 543, 547, 551, 555, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599,
+		603, 607, 611, 615, 619, 623, 627, 631, 635, 639, 643, 647, 651, 655, 659, 663,
+		667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727,
+		731, 735, 739, 743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791,
+		795, 799, 803, 807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855,
+		859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919,
+		923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983,
+		987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 0, 4, 8, 12, 16, 20,
+		24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84,
+		88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148,
+		152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212,
+		216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276,
+		280, 284, 288, 292, 296, 300, 305, 309, 313, 317, 321, 325, 329, 333, 337, 341,
+		345, 349, 353, 357, 361, 365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405,
+		409, 413, 417, 421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469,
+		473, 477, 481, 485, 489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 532,
+		536, 540, 544, 548, 552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 596,
+		600, 604, 608, 612, 616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660,
+		664, 668, 672, 676, 680, 684, 688, 692, 696, 700, 704, 708, 712, 716, 720, 724,
+		728, 732, 736, 740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788,
+		792, 796, 800, 804, 808, 812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852,
+		856, 860, 864, 868, 872, 876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916,
+		920, 924, 928, 932, 936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980,
+		984, 988, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 0, 4, 8, 12, 16,
+		20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80,
+		84, 88, 92, 96, 100, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 143,
+		147, 151, 155, 159, 163, 167, 171, 175, 179, 183, 187, 191, 195, 199, 203, 207,
+		211, 215, 219, 223, 227, 231, 235, 239, 243, 247, 251, 255, 259, 263, 267, 271,
+		275, 279, 283, 287, 291, 295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 335,
+		339, 343, 347, 351, 355, 359, 363, 367, 372, 376, 380, 384, 388, 392, 396, 400,
+		404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464,
+		468, 472, 476, 480, 484, 488, 492, 496, 500, 504, 508, 512, 516, 520, 524, 528,
+		532, 536, 540, 544, 548, 552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592,
+		596, 600, 604, 608, 612, 616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656,
+		660, 664, 668, 672, 676, 680, 684, 688, 692, 696, 700, 704, 708, 712, 716, 720,
+		724, 728, 732, 736, 740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 784,
+		788, 792, 796, 801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849,
+		853, 857, 861, 865, 869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913,
+		917, 921, 925, 928, 932, 936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976,
+		980, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 0, 4, 8, 12,
+		16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 59, 63, 67, 71, 75,
+		79, 83, 87, 91, 95, 99, 103, 107, 111, 115, 119, 123, 127, 131, 135, 139,
+		143, 147, 151, 155, 159, 163, 167, 171, 175, 179, 183, 187, 191, 194, 198, 202,
+		206, 210, 214, 218, 222, 226, 230, 234, 238, 242, 246, 250, 254, 258, 262, 266,
+		270, 274, 278, 282, 286, 290, 294, 298, 302, 306, 310, 314, 318, 322, 326, 330,
+		334, 338, 342, 346, 350, 354, 358, 362, 366, 370, 374, 378, 382, 386, 390, 394,
+		398, 402, 406, 410, 414, 418, 422, 426, 430, 434, 438, 442, 446, 450, 454, 458,
+		462, 466, 470, 474, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522,
+		526, 530, 534, 538, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578, 582, 586,
+		590, 594, 598, 602, 606, 610, 614, 618, 622, 626, 630, 634, 638, 642, 646, 650,
+		654, 658, 662, 666, 670, 674, 678, 682, 686, 690, 694, 698, 702, 706, 710, 714,
+		718, 722, 726, 730, 734, 738, 742, 746, 750, 754, 758, 762, 766, 770, 774, 778,
+		782, 786, 790, 794, 798, 802, 806, 810, 815, 819, 823, 827, 831, 835, 839, 843,
+		847, 851, 855, 859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899, 903, 907,
+		911, 915, 919, 923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963, 967, 971,
+		975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 0, 4, 8,
+		12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72,
+		76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136,
+		140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200,
+		204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264,
+		268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 320, 324, 328,
+		332, 336, 340, 344, 348, 352, 356, 360, 363, 367, 371, 375, 379, 383, 387, 391,
+		395, 399, 403, 407, 411, 415, 419, 423, 427, 431, 435, 439, 443, 447, 450, 454,
+		458, 462, 466, 470, 474, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518,
+		522, 526, 530, 534, 539, 543, 547, 551, 555, 559, 563, 567, 571, 575, 579, 583,
+		587, 591, 595, 599, 603, 607, 611, 615, 619, 623, 627, 631, 635, 639, 643, 647,
+		651, 655, 659, 663, 667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707, 711,
+		715, 719, 723, 727, 731, 735, 739, 743, 747, 751, 755, 759, 763, 767, 771, 775,
+		779, 783, 787, 791, 795, 799, 803, 807, 811, 815, 819, 823, 827, 831, 835, 839,
+		843, 847, 851, 855, 859, 863, 867, 870, 874, 878, 882, 886, 890, 894, 898, 902,
+		906, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946, 950, 954, 958, 962, 966,
+		970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006, 1010, 1014, 1018, 1022, 0, 4,
+		8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68,
+		72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132,
+		136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196,
+		200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260,
+		264, 268, 272, 276, 280, 284, 288, 291, 295, 299, 303, 307, 311, 315, 319, 323,
+		327, 331, 335, 339, 343, 347, 351, 355, 359, 363, 367, 371, 375, 379, 383, 387,
+		391, 395, 399, 403, 407, 411, 415, 419, 423, 427, 431, 435, 439, 443, 447, 451,
+		455, 459, 463, 467, 471, 475, 479, 483, 487, 491, 495, 499, 503, 507, 511, 515,
+		519, 523, 527, 531, 535, 539, 543, 547, 551, 555, 559, 563, 567, 571, 575, 579,
+		583, 587, 591, 595, 599, 603, 607, 611, 615, 619, 623, 627, 631, 635, 639, 643,
+		647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707,
+		711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751, 755, 759, 763, 767, 771,
+		775, 779, 783, 787, 791, 795, 799, 803, 807, 811, 815, 819, 823, 827, 831, 835,
+		839, 843, 847, 851, 855, 859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899,
+		903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963,
+		967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 0,
+		4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 61, 65,
+		69, 73, 77, 81, 85, 89, 93, 97, 101, 105, 109, 113, 117, 121, 125, 129,
+		133, 137, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 185, 189, 193,
+		197, 201, 205, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257,
+		261, 265, 269, 273, 277, 281, 285, 289, 293, 297, 302, 306, 310, 314, 318, 322,
+		326, 330, 334, 338, 342, 346, 350, 354, 358, 362, 366, 370, 374, 378, 382, 386,
+		390, 394, 398, 402, 406, 410, 414, 418, 422, 426, 430, 434, 438, 442, 446, 450,
+		454, 458, 462, 466, 470, 474, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514,
+		518, 522, 526, 530, 534, 538, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578,
+		582, 586, 590, 594, 598, 602, 606, 610, 614, 618, 622, 626, 630, 634, 638, 642,
+		646, 650, 654, 658, 662, 666, 670, 674, 678, 682, 686, 690, 694, 698, 702, 706,
+		710, 714, 718, 722, 726, 730, 734, 738, 742, 746, 750, 754, 758, 762, 766, 770,
+		774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826, 830, 834,
+		838, 842, 846, 850, 854, 858, 862, 866, 870, 874, 878, 882, 886, 890, 894, 898,
+		902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946, 950, 954, 958, 962,
+		966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006, 1010, 1014, 1018, 1022, 1026,
+		0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 43, 47, 51, 55, 59,
+		63, 67, 71, 75, 79, 83, 87, 91, 95, 99, 103, 107, 111, 115, 119, 123,
+		127, 131, 135, 139, 143, 147, 151, 155, 159, 163, 167, 171, 175, 179, 183, 187,
+		191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231, 235, 239, 243, 247, 251,
+		255, 259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 299, 303, 307, 311, 315,
+		319, 323, 327, 331, 335, 339, 343, 347, 351, 355, 359, 363, 367, 371, 375, 379,
+		383, 387, 391, 395, 399, 403, 407, 411, 415, 419, 423, 427, 431, 435, 439, 443,
+		447, 451, 455, 459, 463, 467, 471, 475, 479, 483, 487, 491, 495, 499, 503, 507,
+		511, 515, 519, 523, 527, 531, 535, 539, 543, 547, 551, 555, 559, 563, 567, 571,
+		575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 619, 623, 627, 631, 635,
+		639, 643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 687, 691, 695, 699,
+		703, 707, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751, 755, 759, 763,
+		767, 771, 775, 779, 783, 787, 791, 795, 799, 803, 807, 811, 815, 819, 823, 827,
+		831, 835, 839, 843, 847, 851, 855, 859, 863, 867, 871, 875, 879, 883, 887, 891,
+		895, 899, 903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947, 951, 955,
+		959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019,
+		1023, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56,
+		60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120,
+		124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184,
+		188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248,
+		252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312,
+		316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376,
+		380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440,
+		444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 488, 492, 496, 500, 504,
+		508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560, 564, 568,
+		572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616, 620, 624, 628, 632,
+		636, 640, 644, 648, 652, 656, 660, 664, 668, 672, 676, 680, 684, 688, 692, 696,
+		700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744, 748, 752, 756, 760,
+		764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816, 820, 824,
+		828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 868, 872, 876, 880, 884, 888,
+		892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 932, 936, 940, 944, 948, 952,
+		956, 960, 964, 968, 972, 976, 980, 984, 988, 992, 996, 1000, 1004, 1008, 1012, 1016,
+		1020, 1024, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52,
+		56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116,
+		120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180,
+		184, 188, 192, 196, 200, 205, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245,
+		249, 253, 257, 261, 265, 269, 273, 277, 281, 285, 289, 293, 297, 301, 305, 309,
+		313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373,
+		377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421, 425, 429, 433, 437,
+		441, 445, 449, 453, 457, 461, 465, 469, 473, 477, 481, 485, 489, 493, 497, 501,
+		505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549, 553, 557, 561, 565,
+		569, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613, 617, 621, 625, 629,
+		633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 677, 681, 685, 689, 693,
+		697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741, 745, 749, 753, 757,
+		761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801, 805, 809, 813, 817, 821,
+		825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865, 869, 873, 877, 881, 885,
+		889, 893, 897, 901, 905, 909, 913, 917, 921, 925, 929, 933, 937, 941, 945, 949,
+		953, 957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013,
+		1017, 1021, 1025, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48,
+		52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112,
+		116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176,
+		180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240,
+		244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304,
+		308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368,
+		372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432,
+		436, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 488, 492, 496,
+		500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560,
+		564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616, 620, 624,
+		628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668, 672, 676, 680, 684, 688,
+		692, 696, 700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744, 748, 752,
+		756, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816,
+		820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 868, 872, 876, 880,
+		884, 888, 892, 896, 900, 904, 908, 912, 917, 921, 925, 929, 933, 937, 941, 945,
+		949, 953, 957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997, 1001, 1005, 1009,
+		1013, 1017, 1021, 1025, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44,
+		48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108,
+		112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172,
+		176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236,
+		240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300,
+		304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364,
+		368, 372, 376, 380, 384, 388, 391, 395, 399, 403, 407, 411, 417, 421, 425, 429,
+		433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 473, 477, 481, 485, 489, 493,
+		497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549, 553, 557,
+		561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613, 617, 621,
+		625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 677, 681, 685,
+		689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741, 745, 749,
+		753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801, 805, 809, 813,
+		817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865, 869, 873, 877,
+		881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 925, 929, 933, 937, 941,
+		945, 949, 953, 957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997, 1001, 1005,
+		1009, 1013, 1017, 1021, 1025, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40,
+		44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104,
+		108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168,
+		172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232,
+		236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296,
+		300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360,
+		364, 368, 372, 376, 380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424,
+		428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 488,
+		492, 496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552,
+		556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616,
+		620, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668, 672, 676, 680,
+		684, 688, 692, 696, 700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744,
+		748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808,
+		812, 816, 820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 868, 872,
+		876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 932, 936,
+		940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988, 992, 996, 1000,
+		1003, 1007, 1011, 1015, 1019, 1023, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36,
+		40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100,
+		104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 165,
+		169, 173, 177, 181, 185, 189, 193, 197, 201, 205, 209, 213, 217, 221, 225, 229,
+		233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 277, 281, 285, 289, 293,
+		297, 301, 305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357,
+		361, 365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421,
+		425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 473, 477, 481, 485,
+		489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549,
+		553, 557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613,
+		617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 677,
+		681, 685, 689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741,
+		745, 749, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801, 805,
+		809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865, 869,
+		873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 925, 929, 933,
+		937, 941, 945, 949, 953, 957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997,
+		1001, 1005, 1009, 1013, 1017, 1021, 1025, 0, 4, 8, 12, 16, 20, 23, 27, 31,
+		35, 39, 45, 49, 53, 57, 61, 65, 69, 73, 77, 81, 85, 89, 93, 97,
+		101, 105, 109, 113, 117, 121, 125, 129, 133, 137, 141, 145, 149, 153, 157, 161,
+		165, 169, 173, 177, 181, 185, 189, 193, 197, 201, 205, 209, 213, 217, 221, 225,
+		229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 277, 281, 285, 289,
+		293, 297, 301, 305, 309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353,
+		357, 361, 365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417,
+		421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 473, 477, 481,
+		485, 489, 493, 497, 501, 505, 509, 513, 517, 523, 527, 531, 535, 539, 543, 547,
+		551, 555, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 607, 611,
+		615, 619, 623, 627, 631, 635, 639, 643, 647, 651, 655, 659, 663, 667, 671, 675,
+		679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 739,
+		743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803,
+		807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855, 859, 863, 867,
+		871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931,
+		935, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995,
+		999, 1003, 1007, 1011, 1015, 1019, 1023, 1027, 0, 4, 8, 12, 16, 20, 24, 28,
+		32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92,
+		96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156,
+		160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220,
+		224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280, 284,
+		288, 292, 296, 300, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347,
+		351, 355, 359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 399, 403, 407, 411,
+		415, 419, 423, 427, 431, 435, 439, 443, 447, 451, 455, 459, 463, 467, 471, 475,
+		479, 483, 487, 491, 495, 499, 503, 507, 511, 515, 519, 523, 527, 531, 535, 539,
+		543, 547, 551, 555, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599, 603,
+		607, 611, 615, 619, 623, 627, 631, 635, 639, 643, 647, 651, 655, 659, 663, 667,
+		671, 675, 679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731,
+		735, 739, 743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795,
+		799, 803, 807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855, 859,
+		864, 868, 872, 876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916, 920, 924,
+		928, 932, 936, 940, 944, 948, 952, 956, 960, 964, 968, 972, 976, 980, 984, 988,
+		992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1024, 0, 4, 8, 12, 16, 20, 24,
+		28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88,
+		92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152,
+		156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216,
+		220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264, 268, 272, 276, 280,
+		284, 288, 292, 296, 300, 304, 308, 312, 316, 320, 324, 328, 332, 336, 340, 344,
+		348, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388, 392, 396, 400, 404, 408,
+		412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452, 456, 460, 464, 467, 470,
+		474, 478, 483, 488, 491, 495, 499, 503, 507, 511, 515, 519, 523, 527, 531, 535,
+		539, 543, 547, 551, 555, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599,
+		603, 607, 611, 615, 619, 623, 627, 631, 635, 639, 643, 647, 651, 655, 659, 663,
+		667, 671, 675, 679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727,
+		731, 735, 739, 743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791,
+		795, 799, 803, 807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855,
+		859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919,
+		923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983,
+		987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 0, 4, 8, 12, 16, 20,
+		24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84,
+		88, 92, 96, 100, 104, 108, 112, 116, 120, 126, 130, 134, 138, 142, 146, 150,
+		154, 158, 162, 166, 170, 174, 178, 182, 186, 190, 194, 198, 202, 206, 211, 215,
+		219, 223, 227, 231, 235, 239, 243, 247, 251, 255, 259, 263, 267, 271, 275, 279,
+		283, 287, 291, 294, 298, 302, 306, 310, 314, 318, 322, 326, 330, 334, 338, 342,
+		346, 350, 354, 358, 362, 366, 370, 374, 378, 382, 386, 390, 394, 398, 402, 406,
+		410, 414, 418, 422, 426, 430, 434, 438, 442, 446, 450, 454, 458, 462, 466, 470,
+		474, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 526, 530, 534,
+		538, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578, 582, 586, 590, 594, 598,
+		602, 606, 610, 614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662,
+		666, 670, 674, 678, 682, 686, 690, 694, 698, 702, 706, 710, 714, 718, 722, 726,
+		730, 734, 738, 742, 746, 750, 754, 758, 762, 766, 770, 774, 778, 782, 786, 790,
+		794, 798, 802, 806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850, 854,
+		858, 862, 866, 870, 874, 878, 882, 886, 890, 894, 898, 902, 906, 910, 914, 918,
+		922, 926, 930, 934, 938, 942, 946, 950, 954, 958, 962, 966, 970, 975, 980, 985,
+		990, 993, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025, 1029, 0, 4, 8, 12, 16,
+		20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80,
+		84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144,
+		148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 203, 207,
+		211, 215, 219, 223, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269,
+		273, 277, 281, 285, 289, 293, 297, 301, 305, 309, 313, 317, 321, 325, 329, 333,
+		337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 377, 381, 385, 389, 393, 397,
+		401, 405, 409, 413, 417, 421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461,
+		465, 469, 473, 477, 481, 485, 489, 493, 497, 501, 505, 509, 513, 517, 521, 525,
+		529, 533, 537, 541, 545, 549, 553, 557, 561, 565, 569, 573, 577, 581, 585, 589,
+		593, 597, 601, 605, 609, 613, 617, 621, 625, 629, 633, 637, 641, 645, 649, 653,
+		657, 661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 701, 705, 709, 713, 717,
+		721, 725, 729, 733, 737, 741, 745, 749, 753, 757, 761, 765, 769, 773, 777, 781,
+		785, 789, 793, 797, 801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845,
+		849, 853, 857, 861, 865, 869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909,
+		913, 917, 921, 925, 929, 933, 937, 941, 945, 949, 953, 957, 961, 965, 969, 973,
+		979, 983, 987, 991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1023, 0, 4, 8, 12,
+		16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76,
+		80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140,
+		144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204,
+		208, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269,
+		273, 277, 281, 285, 289, 293, 297, 301, 305, 309, 313, 317, 321, 325, 329, 333,
+		337, 341, 345, 349, 353, 357, 361, 365, 368, 372, 375, 378, 382, 386, 390, 394,
+		398, 402, 406, 410, 414, 418, 422, 426, 430, 434, 438, 442, 446, 450, 454, 458,
+		462, 466, 470, 474, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522,
+		526, 530, 534, 538, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578, 582, 586,
+		590, 594, 598, 602, 606, 610, 614, 618, 622, 626, 630, 634, 638, 642, 647, 651,
+		656, 660, 664, 668, 672, 676, 680, 684, 688, 692, 696, 700, 704, 708, 712, 716,
+		720, 724, 728, 732, 736, 740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 781,
+		785, 789, 793, 797, 801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845,
+		849, 853, 857, 861, 865, 869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909,
+		913, 917, 921, 925, 929, 933, 937, 941, 945, 949, 953, 957, 961, 965, 969, 973,
+		977, 981, 985, 989, 993, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025, 0, 4, 8,
+		12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72,
+		76, 80, 84, 88, 92, 96, 100, 104, 108, 111, 114, 118, 122, 126, 129, 133,
+		138, 143, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200,
+		204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260, 264,
+		268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 320, 324, 328,
+		332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388, 392,
+		396, 400, 404, 409, 414, 418, 422, 426, 433, 437, 441, 445, 449, 453, 458, 462,
+		466, 470, 474, 478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 526,
+		530, 534, 538, 542, 546, 550, 554, 558, 562, 566, 570, 574, 578, 582, 586, 590,
+		594, 598, 602, 606, 610, 614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654,
+		658, 662, 666, 670, 674, 678, 682, 686, 690, 694, 698, 702, 706, 710, 714, 718,
+		722, 726, 730, 734, 738, 742, 746, 750, 754, 758, 762, 766, 770, 774, 778, 782,
+		786, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846,
+		850, 854, 858, 862, 866, 870, 874, 877, 881, 885, 889, 893, 897, 901, 905, 908,
+		911, 914, 917, 920, 923, 926, 929, 932, 935, 938, 941, 944, 947, 950, 954, 958,
+		962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1002, 1006, 1010, 1014, 0, 3,
+		7, 10, 14, 17, 20, 24, 29, 32, 36, 41, 47, 51, 55, 60, 63, 69,
+		72, 76, 80, 84, 88, 92, 97, 102, 107, 112, 118, 123, 128, 132, 135, 138,
+		142, 145, 150, 153, 158, 163, 167, 172, 176, 181, 185, 189, 193, 196, 199, 202,
+		207, 212, 217, 221, 225, 228, 232, 236, 241, 245, 249, 254, 257, 263, 268, 270,
+		274, 279, 282, 287, 292, 297, 301, 305, 309, 313, 316, 320, 323, 327, 331, 335,
+		342, 348, 352, 355, 360, 363, 367, 370, 374, 378, 381, 386, 390, 395, 399, 403,
+		407, 410, 413, 417, 421, 424, 428, 432, 436, 440, 444, 448, 453, 457, 462, 466,
+		470, 475, 481, 486, 490, 494, 497, 501, 505, 510, 513, 517, 521, 526, 529, 534,
+		538, 543, 546, 550, 555, 559, 563, 567, 571, 574, 579, 582, 586, 591, 596, 601,
+		605, 609, 612, 616, 620, 623, 627, 631, 636, 641, 646, 651, 655, 659, 665, 670,
+		676, 680, 684, 689, 693, 696, 702, 707, 713, 717, 722, 727, 731, 735, 740, 746,
+		750, 756, 760, 763, 767, 770, 775, 779, 783, 787, 791, 795, 798, 802, 806, 810,
+		814, 818, 821, 827, 831, 836, 840, 844, 849, 853, 856, 860, 864, 868, 872, 876,
+		882, 886, 891, 895, 898, 901, 905, 910, 915, 920, 923, 928, 932, 936, 941, 944,
+		949, 953, 957, 960, 964, 967, 970, 974, 978, 982, 986, 990, 994, 997, 1000, 1004,
+		1007, 1011, 1015, 1019, 1023, 1028, 1032, 1036, 1040, 1044, 1048, 1052, 1056, 1061, 1064, 0,
+		4, 8, 12, 16, 20, 24, 28, 33, 38, 42, 47, 50, 55, 59, 63, 68,
+		72, 76, 80, 84, 89, 94, 98, 103, 107, 113, 117, 121, 126, 130, 135, 139,
+		144, 148, 152, 156, 159, 163, 167, 171, 174, 179, 184, 188, 193, 198, 202, 205,
+		210, 214, 220, 225, 229, 233, 237, 241, 244, 249, 252, 256, 260, 264, 268, 271,
+		275, 279, 283, 287, 291, 296, 301, 306, 311, 315, 320, 323, 327, 331, 336, 339,
+		344, 348, 352, 355, 359, 363, 366, 370, 375, 378, 381, 385, 389, 394, 399, 404,
+		409, 413, 419, 423, 427, 432, 436, 439, 445, 449, 452, 456, 461, 465, 468, 473,
+		477, 481, 486, 490, 494, 498, 503, 507, 512, 515, 519, 523, 527, 532, 536, 541,
+		545, 549, 552, 556, 561, 564, 569, 573, 577, 582, 586, 589, 592, 595, 600, 603,
+		606, 610, 614, 618, 623, 626, 629, 633, 637, 642, 647, 651, 654, 659, 662, 666,
+		671, 674, 678, 682, 686, 690, 694, 698, 703, 707, 711, 715, 719, 723, 729, 732,
+		737, 740, 744, 748, 751, 755, 758, 762, 767, 772, 777, 781, 785, 789, 792, 795,
+		799, 805, 808, 813, 816, 821, 825, 830, 833, 837, 841, 845, 849, 853, 858, 864,
+		867, 872, 876, 881, 886, 889, 893, 896, 900, 905, 908, 913, 918, 922, 926, 930,
+		934, 938, 941, 946, 949, 953, 958, 961, 966, 970, 975, 981, 985, 989, 993, 998,
+		1003, 1007, 1011, 1016, 1019, 1023, 1028, 1033, 1036, 1041, 1044, 1048, 1052, 1056, 1058, 1060,
+		0, 5, 9, 12, 16, 18, 22, 26, 30, 34, 40, 45, 48, 53, 57, 60,
+		63, 66, 69, 73, 77, 81, 84, 89, 93, 98, 102, 105, 109, 112, 117, 120,
+		123, 126, 129, 132, 135, 138, 141, 144, 147, 150, 153, 156, 159, 162, 165, 168,
+		171, 174, 177, 180, 183, 186, 189, 192, 195, 198, 201, 204, 207, 210, 213, 216,
+		219, 222, 225, 228, 231, 234, 237, 240, 243, 246, 249, 252, 255, 258, 261, 264,
+		267, 270, 273, 276, 279, 282, 285, 288, 291, 294, 297, 300, 303, 306, 309, 312,
+		315, 318, 321, 324, 327, 330, 333, 336, 339, 342, 345, 348, 351, 354, 357, 360,
+		363, 366, 369, 372, 375, 378, 381, 384, 387, 390, 393, 396, 399, 402, 405, 408,
+		411, 414, 417, 420, 423, 426, 429, 432, 435, 438, 441, 444, 447, 450, 453, 456,
+		459, 462, 465, 468, 471, 474, 477, 480, 483, 486, 489, 492, 495, 498, 501, 504,
+		507, 510, 513, 516, 519, 522, 525, 528, 531, 534, 537, 540, 543, 546, 549, 552,
+		555, 558, 561, 564, 567, 570, 573, 576, 579, 582, 585, 588, 591, 594, 597, 600,
+		603, 606, 609, 612, 615, 618, 621, 624, 627, 630, 633, 636, 639, 642, 645, 648,
+		651, 654, 657, 660, 663, 666, 669, 672, 675, 678, 681, 684, 687, 690, 693, 696,
+		699, 702, 705, 708, 711, 714, 717, 720, 723, 726, 729, 732, 735, 738, 741, 744,
+		747, 750, 753, 756, 759, 762, 765, 768, 771, 774, 777, 780, 783, 786, 789, 792,
+		795, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56,
+		60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120,
+		124, 128, 132, 137, 141, 145, 149, 153, 157, 161, 165, 169, 173, 177, 181, 185,
+		189, 193, 197, 201, 205, 209, 213, 217, 221, 225, 229, 233, 237, 241, 245, 249,
+		253, 257, 261, 265, 269, 273, 277, 281, 285, 289, 293, 297, 301, 305, 309, 313,
+		317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357, 361, 365, 369, 373, 377,
+		381, 385, 389, 393, 397, 401, 405, 409, 413, 418, 422, 426, 430, 434, 438, 442,
+		446, 450, 454, 458, 462, 466, 470, 474, 478, 482, 487, 491, 495, 499, 503, 507,
+		510, 514, 518, 522, 526, 530, 534, 538, 542, 546, 550, 554, 558, 563, 567, 571,
+		576, 579, 583, 587, 591, 595, 599, 603, 607, 610, 614, 618, 622, 626, 630, 634,
+		638, 642, 646, 650, 654, 658, 662, 666, 669, 673, 677, 681, 685, 689, 693, 696,
+		700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744, 748, 752, 756, 760,
+		764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816, 820, 824,
+		828, 832, 836, 840, 844, 848, 852, 856, 860, 864, 868, 872, 876, 880, 884, 888,
+		892, 896, 900, 904, 908, 912, 916, 920, 924, 928, 932, 936, 940, 944, 948, 952,
+		956, 960, 964, 968, 972, 976, 980, 984, 988, 995, 999, 1003, 1007, 1010, 1014, 1018,
+		1022, 1025, 0, 4, 9, 13, 17, 21, 25, 29, 33, 37, 41, 45, 49, 53,
+		57, 61, 65, 69, 73, 77, 81, 85, 89, 93, 97, 101, 105, 109, 113, 117,
+		121, 126, 130, 134, 138, 142, 146, 150, 154, 158, 162, 166, 170, 174, 178, 182,
+		186, 190, 194, 198, 202, 206, 210, 214, 218, 222, 226, 230, 234, 238, 242, 246,
+		250, 254, 258, 262, 266, 270, 274, 278, 282, 286, 290, 294, 298, 302, 306, 310,
+		314, 318, 322, 326, 330, 334, 338, 341, 345, 349, 353, 357, 361, 365, 369, 373,
+		377, 381, 385, 389, 393, 397, 401, 405, 408, 412, 416, 420, 424, 428, 432, 436,
+		440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484, 488, 492, 496, 500,
+		504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548, 552, 556, 560, 564,
+		568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616, 620, 624, 628,
+		632, 636, 640, 644, 648, 652, 656, 660, 664, 668, 672, 676, 680, 684, 688, 692,
+		696, 700, 704, 708, 712, 716, 720, 724, 728, 732, 736, 740, 744, 748, 752, 756,
+		760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816, 820,
+		824, 829, 833, 837, 841, 845, 849, 853, 857, 862, 866, 869, 873, 877, 881, 885,
+		889, 893, 897, 901, 904, 908, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947,
+		951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995, 999, 1003, 1007, 1012,
+		1016, 1020, 1024, 0, 4, 8, 12, 16, 20, 24, 28, 34, 38, 42, 47, 51,
+		55, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116,
+		120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180,
+		184, 188, 192, 195, 199, 203, 207, 211, 215, 219, 222, 226, 230, 234, 238, 242,
+		246, 250, 254, 258, 262, 266, 270, 274, 277, 281, 285, 289, 293, 297, 301, 305,
+		309, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357, 362, 367, 371,
+		375, 379, 383, 387, 391, 395, 399, 402, 406, 410, 414, 418, 422, 426, 429, 433,
+		437, 441, 445, 449, 453, 458, 462, 466, 470, 474, 478, 482, 486, 490, 494, 498,
+		502, 505, 509, 513, 517, 521, 525, 529, 533, 538, 542, 546, 552, 556, 560, 564,
+		568, 572, 576, 580, 584, 589, 593, 597, 601, 605, 609, 613, 617, 621, 625, 629,
+		633, 637, 641, 645, 649, 653, 658, 662, 666, 670, 674, 678, 682, 686, 690, 694,
+		698, 702, 706, 710, 714, 718, 722, 726, 730, 734, 738, 742, 746, 750, 754, 758,
+		762, 766, 770, 774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814, 818, 822,
+		826, 830, 834, 838, 842, 846, 850, 854, 858, 862, 866, 870, 874, 878, 882, 886,
+		890, 894, 898, 902, 906, 910, 914, 918, 922, 926, 930, 934, 938, 942, 946, 950,
+		954, 958, 962, 966, 970, 974, 978, 982, 986, 990, 994, 998, 1003, 1009, 1013, 1017,
+		1021, 1025, 1028, 1032, 0, 5, 9, 15, 19, 23, 27, 32, 35, 39, 43, 47,
+		51, 55, 59, 63, 67, 71, 75, 79, 83, 87, 91, 95, 99, 103, 107, 111,
+		115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 158, 162, 166, 170, 174,
+		178, 182, 186, 190, 194, 198, 202, 206, 210, 214, 218, 222, 226, 230, 234, 238,
+		242, 246, 250, 254, 258, 262, 266, 270, 274, 278, 282, 286, 290, 294, 298, 302,
+		306, 310, 314, 318, 322, 326, 330, 334, 338, 342, 346, 350, 354, 358, 362, 366,
+		370, 374, 378, 382, 386, 390, 394, 398, 402, 406, 410, 414, 418, 422, 426, 430,
+		434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 478, 482, 486, 490, 494,
+		498, 502, 506, 510, 514, 518, 522, 526, 530, 534, 538, 542, 546, 550, 554, 558,
+		562, 567, 571, 576, 581, 584, 587, 591, 595, 599, 603, 606, 610, 614, 618, 622,
+		627, 631, 635, 639, 643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 687,
+		691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751,
+		755, 759, 763, 767, 771, 775, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816,
+		820, 824, 828, 832, 836, 840, 844, 848, 851, 855, 859, 863, 867, 871, 875, 879,
+		883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943,
+		947, 951, 955, 959, 963, 967, 971, 974, 978, 982, 986, 991, 995, 999, 1003, 1007,
+		1011, 1015, 1019, 1023, 1027, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40,
+		44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104,
+		108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168,
+		172, 176, 180, 184, 188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232,
+		236, 240, 244, 248, 254, 258, 262, 267, 271, 275, 279, 283, 287, 291, 295, 299,
+		303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351, 355, 359, 363,
+		367, 371, 375, 379, 383, 388, 392, 396, 400, 404, 408, 413, 417, 421, 425, 429,
+		435, 439, 443, 447, 451, 455, 459, 463, 467, 471, 475, 478, 482, 486, 489, 493,
+		497, 501, 505, 509, 513, 518, 522, 526, 530, 534, 538, 542, 546, 550, 554, 558,
+		562, 566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606, 611, 615, 619, 623,
+		627, 631, 635, 639, 643, 647, 651, 655, 659, 663, 667, 671, 675, 679, 683, 687,
+		691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 739, 743, 747, 751,
+		755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 797, 801, 804, 808, 812, 816,
+		820, 824, 828, 832, 836, 840, 844, 848, 852, 856, 861, 865, 869, 873, 877, 880,
+		884, 888, 892, 896, 900, 903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943,
+		947, 951, 954, 958, 962, 966, 970, 975, 979, 983, 987, 991, 995, 1000, 1004, 1008,
+		1012, 1016, 1021, 1025, 1029, 1033, 0, 4, 8, 12, 16, 20, 24, 28, 31, 35,
+		39, 43, 47, 51, 55, 59, 63, 67, 71, 75, 79, 83, 87, 91, 95, 99,
+		103, 107, 111, 115, 119, 123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 163,
+		167, 171, 175, 179, 183, 187, 191, 195, 199, 204, 208, 212, 216, 219, 223, 227,
+		231, 235, 239, 243, 247, 251, 255, 259, 263, 267, 271, 275, 278, 282, 286, 291,
+		295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351, 355,
+		359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 399, 403, 407, 411, 415, 419,
+		423, 427, 431, 435, 440, 444, 448, 452, 456, 460, 464, 468, 472, 476, 480, 484,
+		488, 492, 496, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536, 540, 544, 548,
+		552, 556, 560, 564, 568, 572, 576, 580, 584, 588, 592, 596, 600, 604, 608, 612,
+		616, 620, 624, 628, 632, 636, 640, 644, 648, 652, 656, 660, 664, 668, 672, 676,
+		679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 739,
+		743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803,
+		807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 849, 853, 857, 861, 865, 869,
+		874, 878, 883, 887, 891, 895, 899, 903, 908, 912, 916, 920, 924, 928, 932, 936,
+		940, 944, 948, 953, 957, 961, 965, 969, 973, 977, 981, 985, 990, 994, 998, 1002,
+		1005, 1009, 1013, 1017, 1021, 1025, 1029, 0, 4, 8, 12, 16, 20, 24, 28, 32,
+		35, 39, 43, 47, 51, 55, 59, 63, 67, 71, 75, 80, 84, 88, 92, 96,
+		100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 145, 149, 153, 157, 161,
+		165, 170, 174, 178, 182, 186, 190, 194, 198, 202, 206, 210, 214, 218, 221, 225,
+		229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273, 277, 281, 285, 289,
+		293, 297, 301, 304, 308, 312, 316, 320, 324, 329, 333, 337, 341, 345, 349, 353,
+		357, 361, 365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417,
+		421, 425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 473, 477, 481,
+		485, 489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545,
+		549, 553, 557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 598, 602, 606, 610,
+		614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 666, 669, 673,
+		677, 681, 685, 689, 693, 697, 701, 705, 709, 712, 716, 720, 724, 728, 732, 736,
+		740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800,
+		804, 808, 811, 815, 819, 823, 827, 832, 836, 840, 845, 849, 853, 857, 861, 865,
+		869, 873, 877, 882, 886, 890, 894, 898, 902, 905, 909, 913, 917, 920, 924, 928,
+		932, 937, 942, 946, 950, 954, 957, 961, 965, 969, 974, 978, 982, 987, 991, 995,
+		999, 1003, 1007, 1011, 1015, 1020, 1024, 1028, 0, 4, 8, 12, 16, 20, 24, 28,
+		32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88, 92,
+		96, 100, 104, 108, 112, 116, 121, 125, 129, 133, 137, 140, 144, 148, 152, 156,
+		160, 165, 169, 173, 177, 181, 185, 189, 193, 197, 201, 205, 210, 214, 218, 222,
+		226, 230, 234, 238, 242, 246, 250, 254, 258, 262, 266, 270, 274, 278, 283, 287,
+		291, 295, 299, 303, 307, 311, 315, 319, 323, 327, 331, 335, 339, 343, 347, 351,
+		356, 360, 364, 368, 372, 376, 380, 384, 389, 393, 397, 401, 405, 410, 414, 418,
+		422, 426, 430, 434, 438, 442, 446, 450, 454, 458, 462, 466, 470, 474, 478, 482,
+		486, 490, 494, 498, 502, 506, 510, 515, 519, 523, 527, 531, 535, 539, 543, 547,
+		550, 554, 558, 562, 566, 570, 574, 578, 582, 586, 590, 594, 598, 602, 606, 610,
+		614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 662, 667, 671, 675,
+		679, 683, 687, 691, 695, 699, 703, 707, 711, 715, 719, 723, 727, 731, 735, 739,
+		743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795, 799, 803,
+		807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855, 859, 863, 867,
+		871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931,
+		935, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987, 991, 995,
+		999, 1003, 1007, 1011, 1015, 1019, 1023, 1027, 1031, 0, 4, 8, 12, 16, 20, 24,
+		28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80, 84, 88,
+		92, 96, 100, 104, 108, 113, 117, 121, 125, 129, 133, 137, 141, 145, 149, 153,
+		157, 161, 165, 169, 173, 178, 182, 186, 190, 194, 198, 202, 207, 211, 215, 219,
+		223, 227, 231, 235, 239, 243, 247, 251, 255, 259, 263, 267, 271, 275, 278, 282,
+		286, 290, 294, 298, 303, 307, 311, 315, 319, 322, 326, 330, 334, 338, 343, 347,
+		351, 355, 359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 400, 404, 408, 412,
+		416, 420, 424, 427, 431, 435, 439, 443, 447, 451, 455, 459, 463, 467, 471, 474,
+		478, 482, 486, 490, 494, 498, 502, 506, 510, 514, 518, 522, 526, 530, 533, 537,
+		541, 545, 549, 553, 557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601,
+		605, 609, 613, 617, 621, 625, 629, 633, 637, 641, 645, 649, 652, 656, 661, 665,
+		669, 673, 677, 681, 685, 689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729,
+		733, 737, 741, 745, 749, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793,
+		797, 801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857,
+		861, 865, 869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921,
+		925, 929, 933, 938, 942, 946, 949, 953, 957, 962, 966, 970, 974, 978, 982, 986,
+		990, 994, 998, 1002, 1006, 1010, 1013, 1018, 1022, 1026, 0, 4, 8, 12, 16, 20,
+		24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 81, 85,
+		88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 148,
+		152, 156, 160, 163, 167, 171, 174, 177, 181, 185, 189, 193, 197, 201, 205, 209,
+		213, 217, 221, 225, 229, 233, 237, 241, 245, 249, 253, 257, 261, 265, 269, 273,
+		277, 281, 285, 289, 293, 297, 301, 305, 309, 313, 317, 321, 325, 330, 334, 338,
+		342, 346, 350, 354, 358, 362, 367, 371, 375, 380, 384, 388, 392, 395, 400, 404,
+		408, 412, 416, 421, 425, 429, 433, 437, 442, 446, 450, 454, 458, 462, 466, 470,
+		474, 478, 482, 486, 491, 495, 499, 503, 507, 511, 515, 519, 523, 527, 531, 535,
+		539, 543, 547, 551, 555, 559, 563, 567, 571, 575, 579, 583, 587, 591, 595, 599,
+		603, 607, 611, 615, 619, 623, 627, 630, 634, 638, 642, 646, 650, 654, 658, 662,
+		666, 669, 673, 677, 681, 685, 689, 693, 698, 702, 706, 709, 713, 717, 721, 724,
+		728, 732, 736, 740, 744, 748, 752, 756, 760, 764, 768, 772, 776, 780, 785, 789,
+		793, 797, 802, 806, 810, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850, 855,
+		859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919,
+		923, 927, 931, 935, 939, 943, 947, 951, 955, 959, 963, 968, 972, 976, 980, 984,
+		988, 992, 996, 1000, 1004, 1008, 1012, 1016, 1020, 1025, 1029, 0, 4, 8, 12, 16,
+		20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80,
+		84, 88, 91, 95, 99, 103, 107, 111, 117, 121, 125, 129, 133, 137, 141, 145,
+		149, 153, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204, 207,
+		211, 215, 219, 223, 227, 231, 235, 239, 243, 247, 251, 255, 259, 263, 267, 271,
+		275, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 321, 325, 329, 333, 339,
+		343, 347, 351, 355, 359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 399, 403,
+		407, 411, 415, 418, 422, 426, 430, 434, 438, 442, 447, 451, 455, 459, 462, 466,
+		470, 474, 478, 482, 487, 492, 496, 501, 505, 509, 513, 517, 521, 525, 529, 533,
+		537, 541, 545, 549, 553, 557, 563, 567, 571, 575, 579, 582, 586, 590, 594, 598,
+		602, 606, 610, 614, 618, 622, 626, 630, 634, 638, 642, 646, 650, 654, 658, 663,
+		667, 671, 675, 679, 683, 687, 691, 696, 700, 706, 710, 714, 718, 722, 726, 730,
+		734, 738, 743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787, 791, 795,
+		799, 803, 807, 811, 815, 819, 823, 827, 831, 835, 839, 843, 847, 851, 855, 859,
+		863, 867, 871, 875, 879, 883, 887, 891, 895, 899, 903, 907, 911, 915, 919, 923,
+		927, 931, 935, 939, 943, 947, 951, 955, 959, 963, 967, 971, 975, 979, 983, 987,
+		991, 995, 999, 1003, 1007, 1011, 1015, 1019, 1025, 1029, 1033, 1037, 0, 3, 7, 11,
+		15, 19, 22, 25, 29, 34, 38, 42, 47, 52, 56, 59, 63, 68, 74, 77,
+		81, 86, 90, 96, 100, 103, 108, 112, 117, 122, 126, 130, 133, 137, 141, 144,
+		147, 150, 155, 158, 163, 167, 171, 176, 180, 185, 190, 195, 200, 205, 209, 214,
+		217, 221, 226, 229, 233, 237, 242, 246, 249, 252, 256, 261, 264, 268, 273, 277,
+		281, 286, 290, 294, 298, 302, 306, 310, 314, 318, 322, 326, 330, 334, 338, 342,
+		346, 351, 355, 359, 363, 367, 371, 375, 379, 383, 387, 391, 395, 399, 403, 407,
+		411, 415, 419, 423, 427, 431, 435, 439, 443, 447, 451, 455, 459, 463, 467, 471,
+		475, 479, 483, 487, 491, 495, 500, 504, 508, 512, 516, 520, 524, 528, 532, 536,
+		540, 544, 550, 554, 557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601,
+		605, 609, 613, 617, 621, 625, 629, 633, 637, 641, 646, 650, 654, 658, 662, 666,
+		670, 674, 678, 682, 686, 690, 694, 698, 702, 706, 710, 714, 718, 722, 726, 730,
+		734, 738, 742, 746, 750, 754, 758, 762, 766, 770, 774, 778, 782, 786, 790, 794,
+		798, 802, 806, 810, 814, 818, 822, 826, 829, 833, 837, 841, 845, 849, 853, 857,
+		861, 865, 869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921,
+		925, 929, 933, 937, 941, 945, 949, 953, 957, 961, 965, 969, 973, 977, 981, 985,
+		989, 993, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025, 1029, 1033, 1037, 0, 4, 8,
+		12, 16, 20, 24, 28, 32, 36, 40, 44, 51, 56, 60, 64, 68, 72, 76,
+		80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132, 135, 139,
+		144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196, 200, 204,
+		208, 212, 215, 219, 222, 225, 229, 233, 237, 241, 245, 249, 252, 256, 261, 265,
+		269, 273, 277, 281, 285, 290, 295, 300, 304, 308, 311, 315, 319, 323, 326, 331,
+		335, 339, 343, 347, 351, 355, 359, 363, 367, 373, 377, 383, 387, 391, 395, 399,
+		403, 407, 411, 415, 418, 422, 426, 429, 433, 437, 441, 445, 448, 452, 456, 459,
+		463, 467, 471, 475, 479, 483, 487, 491, 495, 499, 503, 507, 511, 515, 519, 523,
+		528, 532, 536, 540, 544, 548, 552, 556, 560, 564, 568, 572, 576, 580, 584, 588,
+		592, 596, 600, 604, 608, 612, 616, 620, 624, 628, 632, 636, 640, 643, 648, 652,
+		656, 660, 664, 668, 672, 676, 680, 684, 688, 691, 695, 699, 704, 709, 713, 717,
+		721, 726, 731, 734, 739, 743, 745, 748, 752, 755, 759, 763, 767, 771, 775, 778,
+		783, 787, 792, 796, 800, 804, 808, 812, 816, 820, 824, 828, 832, 836, 840, 844,
+		848, 852, 856, 860, 864, 869, 873, 877, 881, 885, 889, 893, 897, 901, 905, 909,
+		913, 917, 921, 925, 929, 933, 937, 941, 945, 949, 953, 957, 961, 965, 969, 973,
+		978, 982, 986, 989, 993, 997, 1001, 1005, 1010, 1014, 1018, 1022, 1026, 1030, 0, 4,
+		8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68,
+		72, 76, 80, 84, 88, 93, 96, 101, 105, 109, 113, 116, 120, 123, 127, 131,
+		135, 138, 142, 146, 150, 154, 158, 162, 166, 170, 174, 178, 182, 186, 191, 195,
+		199, 203, 207, 211, 215, 219, 223, 227, 231, 235, 239, 243, 247, 251, 255, 259,
+		263, 267, 271, 275, 279, 283, 287, 291, 295, 300, 304, 309, 314, 318, 323, 327,
+		329, 334, 337, 340, 344, 347, 352, 356, 360, 365, 369, 373, 376, 381, 385, 389,
+		394, 399, 402, 404, 407, 412, 416, 421, 424, 428, 432, 436, 441, 443, 448, 453,
+		458, 461, 466, 470, 473, 478, 483, 486, 490, 495, 498, 502, 506, 509, 512, 515,
+		518, 523, 526, 530, 534, 538, 542, 546, 550, 553, 557, 561, 566, 569, 573, 577,
+		582, 586, 590, 593, 597, 601, 605, 609, 613, 617, 621, 625, 629, 633, 637, 641,
+		645, 649, 653, 657, 661, 665, 669, 672, 676, 680, 684, 688, 692, 696, 700, 704,
+		708, 712, 716, 720, 724, 728, 732, 736, 740, 744, 748, 752, 756, 760, 764, 768,
+		772, 776, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816, 820, 824, 828, 832,
+		836, 840, 844, 848, 852, 856, 860, 864, 868, 872, 876, 880, 884, 887, 891, 894,
+		898, 902, 906, 910, 915, 919, 923, 927, 933, 936, 941, 947, 952, 957, 961, 965,
+		969, 973, 977, 980, 985, 990, 994, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025, 0,
+		4, 8, 12, 16, 20, 24, 28, 35, 39, 43, 47, 51, 55, 59, 64, 68,
+		71, 75, 79, 83, 88, 92, 96, 100, 104, 108, 112, 116, 120, 124, 128, 132,
+		136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184, 188, 192, 196,
+		200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248, 252, 256, 260,
+		264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312, 316, 320, 324,
+		328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376, 380, 384, 388,
+		392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440, 444, 448, 452,
+		456, 460, 464, 469, 473, 476, 482, 486, 490, 494, 498, 501, 506, 511, 516, 521,
+		524, 527, 531, 536, 541, 544, 549, 553, 557, 562, 567, 571, 574, 578, 582, 587,
+		591, 596, 602, 605, 610, 614, 619, 624, 629, 632, 635, 639, 643, 649, 654, 657,
+		661, 665, 669, 673, 677, 681, 685, 689, 693, 697, 701, 705, 709, 714, 718, 722,
+		726, 730, 735, 739, 743, 747, 751, 755, 759, 763, 767, 771, 775, 779, 783, 787,
+		791, 795, 799, 803, 807, 811, 814, 818, 822, 826, 830, 834, 838, 842, 846, 850,
+		854, 858, 862, 866, 870, 874, 878, 882, 886, 890, 894, 898, 902, 908, 914, 918,
+		922, 925, 929, 933, 937, 941, 945, 949, 953, 957, 961, 965, 969, 973, 978, 982,
+		986, 990, 994, 997, 1001, 1005, 1009, 1013, 1017, 1021, 1025, 1029, 1033, 1037, 1041, 1045,
+		0, 4, 8, 12, 16, 20, 23, 27, 31, 35, 39, 43, 47, 51, 55, 59,
+		63, 67, 70, 74, 78, 82, 86, 90, 94, 98, 102, 106, 110, 114, 118, 122,
+		126, 130, 134, 138, 142, 146, 150, 154, 158, 162, 166, 170, 174, 178, 182, 186,
+		190, 194, 198, 202, 206, 210, 214, 218, 222, 226, 230, 234, 239, 243, 247, 251,
+		255, 259, 263, 267, 271, 275, 279, 283, 287, 291, 295, 299, 303, 307, 311, 315,
+		319, 323, 327, 331, 335, 339, 342, 345, 349, 352, 356, 362, 367, 370, 373, 375,
+		379, 383, 386, 391, 395, 399, 404, 409, 412, 417, 420, 424, 430, 433, 437, 440,
+		444, 448, 451, 454, 458, 462, 468, 473, 477, 480, 484, 488, 492, 497, 502, 506,
+		510, 513, 518, 522, 527, 531, 535, 538, 542, 546, 550, 555, 559, 564, 569, 572,
+		576, 580, 585, 590, 593, 597, 600, 604, 609, 614, 617, 621, 625, 630, 636, 641,
+		644, 648, 653, 657, 661, 665, 669, 674, 678, 682, 685, 688, 693, 696, 699, 704,
+		709, 713, 718, 721, 725, 728, 732, 736, 740, 745, 750, 754, 759, 764, 768, 772,
+		775, 779, 782, 785, 789, 793, 797, 802, 806, 811, 815, 819, 823, 827, 831, 835,
+		839, 843, 847, 851, 855, 859, 863, 867, 871, 875, 879, 883, 887, 891, 895, 899,
+		903, 907, 911, 915, 919, 923, 927, 932, 936, 941, 945, 949, 953, 958, 962, 966,
+		970, 975, 979, 983, 988, 991, 994, 998, 1002, 1006, 1012, 1017, 1020, 1024, 1028, 1031,
+		1035, 0, 4, 8, 12, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56,
+		60, 64, 68, 72, 76, 80, 84, 88, 92, 96, 100, 104, 108, 112, 116, 120,
+		124, 128, 132, 136, 140, 144, 148, 152, 156, 160, 164, 168, 172, 176, 180, 184,
+		188, 192, 196, 200, 204, 208, 212, 216, 220, 224, 228, 232, 236, 240, 244, 248,
+		252, 256, 260, 264, 268, 272, 276, 280, 284, 288, 292, 296, 300, 304, 308, 312,
+		316, 320, 324, 328, 332, 336, 340, 344, 348, 352, 356, 360, 364, 368, 372, 376,
+		380, 384, 388, 392, 396, 400, 404, 408, 412, 416, 420, 424, 428, 432, 436, 440,
+		444, 448, 452, 455, 459, 463, 466, 470, 475, 478, 482, 486, 490, 494, 498, 502,
+		506, 510, 514, 518, 522, 525, 529, 532, 535, 540, 544, 549, 553, 556, 559, 563,
+		567, 571, 575, 579, 583, 587, 591, 595, 599, 603, 607, 611, 615, 619, 623, 627,
+		631, 635, 639, 642, 647, 651, 655, 657, 662, 666, 668, 671, 675, 679, 683, 688,
+		691, 695, 699, 703, 707, 711, 717, 721, 725, 730, 734, 738, 743, 748, 752, 756,
+		760, 764, 768, 772, 776, 780, 784, 788, 792, 796, 800, 804, 808, 812, 816, 820,
+		824, 828, 832, 836, 840, 844, 849, 853, 857, 861, 865, 869, 872, 876, 880, 884,
+		887, 890, 895, 899, 903, 907, 911, 915, 919, 923, 927, 931, 935, 939, 943, 947,
+		951, 955, 959, 962, 965, 968, 972, 977, 981, 985, 989, 992, 997, 1001, 1005, 1007,
+		1010, 1014, 0, 4, 8, 12, 17, 21, 25, 29, 35, 40, 43, 47, 51, 55,
+		59, 63, 67, 71, 75, 79, 83, 87, 91, 95, 99, 103, 107, 111, 115, 119,
+		123, 127, 131, 135, 139, 143, 147, 151, 155, 159, 163, 167, 171, 175, 179, 183,
+		187, 191, 195, 199, 203, 207, 211, 215, 219, 223, 227, 231, 235, 239, 243, 247,
+		251, 257, 260, 264, 268, 271, 274, 277, 282, 286, 291, 294, 299, 303, 306, 310,
+		313, 316, 320, 323, 327, 331, 334, 339, 343, 348, 350, 354, 358, 362, 366, 370,
+		375, 379, 383, 387, 391, 397, 401, 405, 409, 415, 418, 422, 425, 430, 433, 437,
+		441, 446, 450, 454, 458, 462, 466, 470, 474, 478, 482, 486, 490, 494, 498, 502,
+		506, 510, 514, 519, 524, 528, 532, 536, 540, 544, 548, 553, 557, 561, 565, 571,
+		576, 580, 584, 588, 592, 596, 600, 604, 608, 612, 616, 620, 625, 629, 634, 638,
+		642, 646, 650, 654, 658, 662, 666, 670, 674, 678, 682, 686, 690, 694, 698, 702,
+		706, 710, 714, 718, 722, 726, 730, 734, 738, 742, 746, 750, 754, 758, 762, 766,
+		770, 774, 778, 782, 786, 790, 794, 798, 802, 806, 810, 814, 818, 822, 826, 830,
+		833, 837, 841, 846, 850, 853, 856, 859, 862, 866, 870, 875, 880, 883, 887, 890,
+		893, 898, 902, 906, 911, 915, 918, 922, 927, 930, 933, 937, 942, 946, 949, 952,
+		956, 961, 966, 969, 974, 977, 981, 984, 987, 990, 994, 999, 1003, 1006, 1011, 1015,
+		1018, 1023, 1026, 0, 3, 8, 11, 15, 19, 24, 28, 33, 38, 43, 47, 51,
+		55, 59, 64, 68, 74, 78, 81, 85, 89, 93, 97, 100, 106, 110, 112, 117,
+		122, 126, 130, 134, 138, 142, 146, 150, 154, 158, 162, 166, 170, 174, 178, 182,
+		186, 190, 194, 198, 202, 206, 210, 214, 218, 222, 226, 230, 234, 238, 242, 246,
+		250, 254, 258, 262, 266, 270, 274, 278, 282, 286, 290, 293, 298, 302, 306, 310,
+		313, 316, 319, 324, 327, 331, 336, 339, 343, 346, 349, 353, 356, 360, 364, 368,
+		371, 374, 377, 380, 385, 390, 394, 399, 403, 407, 411, 416, 419, 422, 425, 429,
+		434, 438, 442, 447, 450, 454, 458, 462, 467, 471, 475, 478, 481, 484, 487, 491,
+		494, 497, 501, 505, 508, 511, 516, 519, 522, 527, 530, 533, 536, 540, 545, 550,
+		553, 557, 560, 563, 567, 572, 577, 580, 584, 587, 590, 594, 600, 605, 610, 614,
+		619, 624, 628, 632, 635, 639, 642, 646, 651, 657, 660, 664, 668, 671, 676, 679,
+		683, 686, 690, 693, 696, 699, 702, 706, 710, 714, 718, 722, 726, 730, 733, 736,
+		742, 747, 751, 755, 759, 763, 767, 771, 775, 779, 784, 787, 793, 797, 800, 803,
+		807, 811, 815, 819, 822, 826, 830, 833, 838, 841, 844, 847, 851, 855, 859, 863,
+		866, 869, 873, 877, 881, 885, 890, 894, 898, 902, 906, 910, 914, 918, 922, 928,
+		933, 937, 942, 946, 950, 954, 958, 962, 967, 971, 974, 978, 982, 986, 990, 994,
+		998, 1002, 1005, 1008, 0, 5, 8, 12, 15, 19, 22, 27, 31, 34, 37, 43,
+		47, 51, 55, 59, 63, 67, 71, 75, 79, 83, 87, 92, 95, 98, 101, 105,
+		109, 113, 117, 121, 125, 129, 133, 137, 141, 145, 149, 153, 157, 161, 165, 169,
+		173, 176, 180, 184, 188, 192, 196, 201, 205, 210, 215, 219, 222, 225, 228, 233,
+		237, 241, 244, 248, 252, 256, 261, 264, 268, 272, 276, 281, 286, 290, 294, 298,
+		301, 304, 307, 310, 313, 317, 321, 325, 329, 333, 337, 341, 345, 349, 353, 357,
+		361, 365, 369, 373, 377, 381, 385, 389, 393, 397, 401, 405, 409, 413, 417, 421,
+		425, 429, 433, 437, 441, 445, 449, 453, 457, 461, 465, 469, 473, 477, 481, 485,
+		489, 493, 497, 501, 505, 509, 513, 517, 521, 525, 529, 533, 537, 541, 545, 549,
+		553, 557, 561, 565, 569, 573, 577, 581, 585, 589, 593, 597, 601, 605, 609, 613,
+		617, 621, 625, 629, 633, 637, 641, 645, 649, 653, 657, 661, 665, 669, 673, 677,
+		681, 685, 689, 693, 697, 701, 705, 709, 713, 717, 721, 725, 729, 733, 737, 741,
+		745, 749, 753, 757, 761, 765, 769, 773, 777, 781, 785, 789, 793, 797, 801, 805,
+		809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853, 857, 861, 865, 869,
+		873, 877, 881, 885, 889, 893, 897, 901, 905, 909, 913, 917, 921, 925, 929, 933,
+		937, 941, 945, 949, 953, 957, 961, 965, 969, 973, 977, 981, 985, 989, 993, 997,
+		1001, 1005, 1009, 1013, 1017,
+	},
+	data: "[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E00
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E10
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E20
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2E70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?][?] [?] [?] [?] [?] " + // U+2E90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ED0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EE0
+		"[?] [?] [?] [?] [?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2EF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2F00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2F10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2F20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2F30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2F40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2F50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2F60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2F70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2F80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2F90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2FA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2FB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2FC0
+		"[?] [?] [?] [?] [?] [?] [?][?][?][?][?][?][?][?][?][?]" + // U+2FD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?][?][?]" + // U+2FF0
+		"Qiu Tian [?] [?] Kua Wu Yin [?] [?] [?] [?] [?] Yi [?] [?] [?] " + // U+3400
+		"[?] [?] [?] [?] [?] [?] Xie [?] [?] [?] [?] [?] Chou [?] [?] [?] " + // U+3410
+		"[?] Nuo [?] [?] Dan [?] [?] [?] Xu Xing [?] Xiong Liu Lin Xiang Yong " + // U+3420
+		"Xin Zhen Dai Wu Pan Ru [?] Ma Qian Yi Yin Nei Cheng Feng [?] [?] " + // U+3430
+		"[?] Zhuo Fang Ao Wu Zuo [?] Zhou Dong Su Yi Qiong Kuang Lei Nao Zhu " + // U+3440
+		"Shu [?] [?] [?] Xu [?] [?] Shen Jie Die Nuo Su Yi Long Ying Beng " + // U+3450
+		"[?] [?] [?] Lan Miao Yi Li Ji Yu Luo Chai [?] [?] [?] Hun Xu " + // U+3460
+		"Hui Rao [?] Zhou [?] Han Xi Tai Yao Hui Jun Ma Lue Tang Yao Zhao " + // U+3470
+		"Zhai Yu Zhuo Er Ran Qi Chi Wu Han Tang Se Si Qiong Lei Sa [?] " + // U+3480
+		"[?] Kui Pu Ta Shu Yang Ou Tai [?] Mian Yin Diao Yu Mie Jun Niao " + // U+3490
+		"Xie You [?] [?] Che Feng Lei Li [?] Luo [?] Ji [?] [?] [?] [?] " + // U+34A0
+		"Quan [?] Cai Liang Gu Mao [?] Gua Sui [?] [?] Mao Man Quan Shi Li " + // U+34B0
+		"[?] Wang Kou Du Zhen Ting [?] [?] Bing Huo Dong Gong Cheng [?] Qin Jiong " + // U+34C0
+		"Lu Xing [?] Nan Xie [?] Bi Jie Su [?] Gong [?] You Xing Qia Pi " + // U+34D0
+		"Dian Fu Luo Qia Qia Tang Bai Gan Ci Xuan Lang [?] [?] She Diao Li " + // U+34E0
+		"Hua Tou Pian Di Ruan E Qie Yi Zhuo Rui Jian [?] Chi Chong Xi [?] " + // U+34F0
+		"Lue Deng Lin Jue Su Xiao Zan [?] [?] Zhu Zhan Jian Zou Chua Xie Li " + // U+3500
+		"[?] Chi Xi Jian [?] Ji [?] Fei Chu Beng Jie [?] Ba Liang Kuai [?] " + // U+3510
+		"Xia Bie Jue Lei Xin Bai Yang Lu Bei E Lu [?] [?] Che Nuo Xuan " + // U+3520
+		"Heng Yu [?] Gui Yi Xuan Gong Lou Ti Le Shi [?] Sun Yao Xian Zou " + // U+3530
+		"[?] Que Yin Xi Zhi Jia Hu La Yi Ke Fu Qin Ai [?] Ke Chu " + // U+3540
+		"Xie Chu Wei [?] [?] Huan Su You [?] Jun Zhao Xu Shi [?] Shua Kui " + // U+3550
+		"Shuang He Gai Yan Qiu Shen Hua Xi Fan Pang Dan Fang Gong Ao Fu Ne " + // U+3560
+		"Xue You Hua [?] Chen Guo N Hua Li Fa Xiao Pou [?] Si [?] [?] " + // U+3570
+		"Le Lin Yi Hou [?] Xu Qu Er [?] [?] Xun [?] [?] [?] [?] Nie " + // U+3580
+		"Wei Xie Ti Hong Tun Nie Nie Yin Zhen [?] [?] [?] [?] [?] Wai Shou " + // U+3590
+		"Nuo Ye Qi Tou Han Jun Dong Hun Lu Ju Huo Ling [?] Tian Lun [?] " + // U+35A0
+		"[?] [?] [?] [?] [?] Ge Yan Shi Xue Pen Chun Niu Duo Ze E Xie " + // U+35B0
+		"You E Sheng Wen Ku Hu Ge Xia Man Lue Ji Hou Zhi [?] [?] Wai " + // U+35C0
+		"[?] Bai Ai Zhui Qian Gou Dan Bei Bo Chu Li Xiao Xiu [?] [?] [?] " + // U+35D0
+		"[?] [?] Hong Ti Cu Kuo Lao Zhi Xie Xi [?] Qie Zha Xi [?] [?] " + // U+35E0
+		"Cong Ji Huo Ta Yan Xu Po Sai [?] [?] [?] Guo Ye Xiang Xue He " + // U+35F0
+		"Zuo Yi Ci [?] Leng Xian Tai Rong Yi Zhi Xi Xian Ju Ji Han [?] " + // U+3600
+		"Pao Li [?] Lan Sai Han Yan Qu [?] Yan Han Kan Chi Nie Huo [?] " + // U+3610
+		"Bi Xia Weng Xuan Wan You Qin Xu Nie Bi Hao Jing Ao Ao [?] [?] " + // U+3620
+		"Zhen Tan Ju [?] Zuo Bu Jie Ai Zang Ci Fa [?] [?] [?] [?] Nie " + // U+3630
+		"Liu Mei Dui Bang Bi Bao [?] Chu Xia Tian Chang [?] [?] Duo Wei Fu " + // U+3640
+		"Duo Yu Ye Kui Wei Kuai [?] Wei Yao Long Xing Bu Chi Xie Nie Lang " + // U+3650
+		"Yi Zong Man Zhang Xia Gun Xie [?] Ji Liao Yi Ji Yin [?] Da Yi " + // U+3660
+		"Xie Hao Yong Kan Chan Tai Tang Zhi Bao Meng Kui Chan Lei [?] Xi [?] " + // U+3670
+		"Xi Qiao Nang Yun [?] Long Fu Zong [?] Gu Kai Diao Hua Kui [?] Gao " + // U+3680
+		"Tao [?] Shan Lai Nie Fu Gao Qie Ban Jia Kong Xi Yu Zhui Shen Chuo " + // U+3690
+		"Xiao Ji Nu Xiao Yi Yu Yi Yan Shen Ran Hao Sa Jun You [?] Xin " + // U+36A0
+		"Pei Qiu Chan [?] Bu Dong Si Er [?] Mao Yun Ji [?] Qiao Xiong Pao " + // U+36B0
+		"Chu Peng Nuo Jie Yi Er Duo [?] [?] [?] Duo [?] [?] Qie Lu Qiu " + // U+36C0
+		"Sou Can Dou Xi Feng Yi Suo Qie Po Xin Tong Xin You Bei Long [?] " + // U+36D0
+		"[?] [?] [?] Yun Li Ta Lan Man Qiang Zhou Yan Xi Lu Xi Sao Fan " + // U+36E0
+		"[?] Wei Fa Yi Nao Cheng Tan Ji Shu Pian An Kua Cha [?] Xian Zhi " + // U+36F0
+		"[?] [?] Feng Lian Xun Xu Mi Hui Mu Yong Zhan Yi Nou Tang Xi Yun " + // U+3700
+		"Shu Fu Yi Da [?] Lian Cao Can Ju Lu Su Nen Ao An Qian [?] " + // U+3710
+		"Cui Cong [?] Ran Nian Mai Xin Yue Nai Ao Shen Ma [?] [?] Lan Xi " + // U+3720
+		"Yue Zhi Weng Huai Meng Niao Wan Mi Nie Qu Zan Lian Zhi Zi Hai Xu " + // U+3730
+		"Hao Xuan Zhi Mian Chun Gou [?] Chun Luan Zhu Shou Liao Jiu Xie Ding Jie " + // U+3740
+		"Rong Mang [?] Ke Yao Ning Yi Lang Yong Yin Yan Su [?] Lin Ya Mao " + // U+3750
+		"Ming Zui Yu Yi Gou Mi Jun Wen [?] Kang Dian Long [?] Xing Cui Qiao " + // U+3760
+		"Mian Meng Qin [?] Wan De Ai [?] Bian Nou Lian Jin Yu Chui Zuo Bo " + // U+3770
+		"Hui Yao Tui Ji An Luo Ji Wei Bo Za Xu Nian Yun [?] Ba Zhe " + // U+3780
+		"Ju Wei Xie Qi Yi Xie Ci Qiu Du Niao Qi Ji Tui [?] Song Dian " + // U+3790
+		"Lao Zhan [?] [?] Yin Cen Ji Hui Zi Lan Nao Ju Qin Dai [?] Jie " + // U+37A0
+		"Xu Cong Yong Dou Chi [?] Min Huang Sui Ke Zu Hao Cheng Xue Ni Chi " + // U+37B0
+		"Lian An Mu Si Xiang Yang Hua Cuo Qiu Lao Fu Dui Mang Lang Tuo Han " + // U+37C0
+		"Mang Bo Qun Qi Han [?] Long Bin Tiao Ze Qi Zan Mi Pei Zhan Xiang " + // U+37D0
+		"Gang [?] Qi [?] Lu Cen Yun E Duan Min Wei Quan Sou Min Tu [?] " + // U+37E0
+		"Ming Yao Jue Li Kuai Gang Yuan Da [?] Lao Lou Qian Ao Biao Yong Mang " + // U+37F0
+		"Dao [?] Ao [?] Xi Fu Dan Jiu Run Tong Qu E Qi Ji Ji Hua " + // U+3800
+		"Jiao Zui Biao Meng Bai Wei Yi Ao Yu Hao Dui Wo Ni Cuan [?] Li " + // U+3810
+		"Lu Niao Huai Li [?] Lu Feng Mi Yu [?] Ju [?] [?] Zhan Peng Yi " + // U+3820
+		"[?] Ji Bi [?] Ren Huang Fan Ge Ku Jie Sha [?] Si Tong Yuan Zi " + // U+3830
+		"Bi Kua Li Huang Xun Nuo [?] Zhe Wen Xian Qia Ye Mao [?] Shan Shu " + // U+3840
+		"[?] Qiao Zhun Kun Wu Ying Chuang Ti Lian Bi Gou Mang Xie Feng Lou Zao " + // U+3850
+		"Zheng Chu Man Long [?] Yin Pin Zheng Jian Luan Nie Yi [?] Ji Ji Zhai " + // U+3860
+		"Yu Jiu Huan Zhi La Ling Zhi Ben Zha Ju Dan Liao Yi Zhao Xian Chi " + // U+3870
+		"Ci Chi Yan Lang Dou Long Chan [?] Tui Cha Ai Chi [?] Ying Zhe Tou " + // U+3880
+		"[?] Tui Cha Yao Zong [?] Pan Qiao Lian Qin Lu Yan Kang Su Yi Chan " + // U+3890
+		"Jiong Jiang [?] Jing [?] Dong [?] Juan Han Di [?] [?] Hong [?] Chi Diao " + // U+38A0
+		"Bi [?] Xun Lu [?] Xie Bi [?] Bi [?] Xian Rui Bie Er Juan [?] " + // U+38B0
+		"Zhen Bei E Yu Qu Zan Mi Yi Si [?] [?] [?] Shan Tai Mu Jing " + // U+38C0
+		"Bian Rong Ceng Can Ding [?] [?] [?] [?] Di Tong Ta Xing Song Duo Xi " + // U+38D0
+		"Tao [?] Ti Shan Jian Zhi Wei Yin [?] [?] Huan Zhong Qi Zong [?] Xie " + // U+38E0
+		"Xie Ze Wei [?] [?] Ta Zhan Ning [?] [?] Xin Yi Ren Shu Cha Zhuo " + // U+38F0
+		"[?] Mian Ji Fang Pei Ai Fan Ao Qin Qia Xiao Fen Gan Qiao Ge Tong " + // U+3900
+		"Chan You Gao Ben Fu Chu Zhu [?] Zhou [?] Hang Nin Jue Chong Cha Kong " + // U+3910
+		"Lie Li Yu [?] Yu Hai Li Hou Gong Ke Yuan De Hui Jiao Guang Jiong " + // U+3920
+		"Zuo Fu Qie Bei Che Ci Mang Han Xi Qiu Huang [?] [?] Chou San Yan " + // U+3930
+		"Zhi De Te Men Ling Shou Tui Can Die Che Peng Yi Ju Ji Lai Tian " + // U+3940
+		"Yuan [?] Cai Qi Yu Lian Cong [?] [?] [?] Yu Ji Wei Mi Sui Xie " + // U+3950
+		"Xu Chi Qiu Hui [?] Yu Qie Shun Shui Duo Lou [?] Pang Tai Zhou Yin " + // U+3960
+		"Sao Fei Chen Yuan Yi Hun Se Ye Min Fen He [?] Yin Ce Ni Ao " + // U+3970
+		"Feng Lian Chang Chan Ma Die Hu Lu Ai Yi Hua Zha Hu E Huo Sun " + // U+3980
+		"Ni Xian Li Xian Yan Long Men Jin Ji [?] Bian Yu Huo Miao Chou Mai " + // U+3990
+		"[?] Le Jie Wei Yi Xuan Xi Can Lan Yin Xie Za Luo Ling Qian Huo " + // U+39A0
+		"Jian Wo [?] [?] Ge Zhu Die Yong Ji Yang Ru Xi Shuang Yu Yi Qian " + // U+39B0
+		"Ji Qu Tian Shou Qian Mu Jin Mao Yin Gai Po Xuan Mao Fang Ya Gang " + // U+39C0
+		"Song Hui Yu Gua Guai Liu E Zi Zi Bi Wa Lan Lie [?] [?] Kuai " + // U+39D0
+		"[?] Hai Yin Zhu Chong Xian Xuan [?] Qiu Pei Gui Er Gong Qiong Hu Lao " + // U+39E0
+		"Li Chen San Zhuo Wo Pou Keng Tun Peng Te Ta Zhuo Biao Gu Hu [?] " + // U+39F0
+		"Bing Zhi Dong Dui Zhou Nei Lin Po Ji Min Wei Che Gou Bang Ru Tan " + // U+3A00
+		"Bu Zong Kui Lao Han Ying Zhi Jie Xing Xie Xun Shan Qian Xie Su Hai " + // U+3A10
+		"Mi Hun Pi [?] Hui Na Song Ben Chou Jie Huang Lan [?] Hu Dou Huo " + // U+3A20
+		"Gun Yao Ce Gui Jian Jian Dao Jin Ma Hui Mian Can Lue Pi Yang Ju " + // U+3A30
+		"Ju Que [?] Qian Shai [?] Jiu Huo Yun Da Xuan Xiao Fei Ce Ye [?] " + // U+3A40
+		"Den [?] Qin Hui Tun [?] Qiang Xi Ni Sai Meng Tuan Lan Hao Ci Zhai " + // U+3A50
+		"Ao Luo Mie [?] Fu [?] Xie Bo Hui Qing Xie [?] [?] Bo Qian Po " + // U+3A60
+		"Jiao Jue Kun Song Ju E Nie Qian Die Die [?] Qi Zhi Qi Zhui Ku " + // U+3A70
+		"Yu Qin Ku He Fu Geng Di Xian Gui He Qun Han Tong Bo Shan Bi " + // U+3A80
+		"Lu Ye Ni Chuai San Diao Lu Tou Lian Ke San Zhen Chuai Lian Mao [?] " + // U+3A90
+		"Qian Kai Shao Xiao Bi Zha Yin Xi Shan Su Sa Rui Chuo Lu Ling Cha " + // U+3AA0
+		"[?] Huan [?] [?] Jia Ban Hu Dou [?] Lou Ju Juan Ke Suo Luo Zhe " + // U+3AB0
+		"Ding Duan Zhu Yan Pang Cha [?] [?] [?] [?] Yi [?] [?] You Hui Yao " + // U+3AC0
+		"Yao Zhi Gong Qi Gen [?] [?] Hou Mi Fu Hu Guang Tan Di [?] Yan " + // U+3AD0
+		"[?] [?] Qu [?] Chang Ming Tao Bao An [?] [?] Xian [?] [?] [?] Mao " + // U+3AE0
+		"Lang Nan Bei Chen [?] Fei Zhou Ji Jie Shu [?] Kun Die Lu [?] [?] " + // U+3AF0
+		"[?] [?] Yu Tai Chan Man Min Huan Wen Nuan Huan Hou Jing Bo Xian Li " + // U+3B00
+		"Jin [?] Mang Piao Hao Yang [?] Xian Su Wei Che Xi Jin Ceng He Fen " + // U+3B10
+		"Shai Ling [?] Dui Qi Pu Yue Bo [?] Hui Die Yan Ju Jiao Nan Lie " + // U+3B20
+		"Yu Ti Tian Wu Hong Xiao Hao [?] Tiao Zheng [?] Huang Fu [?] [?] Tun " + // U+3B30
+		"[?] Reng Jiao [?] Xin [?] [?] Yuan Jue Hua [?] Bang Mou [?] Gang Wei " + // U+3B40
+		"[?] Mei Si Bian Lu Qu [?] [?] Ge Zhe Lu Pai Rong Qiu Lie Gong " + // U+3B50
+		"Xian Xi Xin [?] Niao [?] [?] [?] Xie Lie Fu Cuo Zhuo Ba Zuo Zhe " + // U+3B60
+		"Zui He Ji [?] Jian [?] [?] [?] Tu Xian Yan Tang Ta Di Jue Ang " + // U+3B70
+		"Han Xiao Ju Wei Bang Zhui Nie Tian Nai [?] [?] You Mian [?] [?] Nai " + // U+3B80
+		"Sheng Cha Yan Gen Chong Ruan Jia Qin Mao E Li Chi Zang He Jie Nian " + // U+3B90
+		"[?] Guan Hou Gai [?] Ben Suo Wu Ji Xi Qiong He Weng Xian Jie Hun " + // U+3BA0
+		"Pi Shen Chou Zhen [?] Zhan Shuo Ji Song Zhi Ben [?] [?] [?] Lang Bi " + // U+3BB0
+		"Xuan Pei Dai Qi Zhi Pi Chan Bi Su Huo Hen Jiong Chuan Jiang Nen Gu " + // U+3BC0
+		"Fang [?] [?] Ta Cui Xi De Xian Kuan Zhe Ta Hu Cui Lu Juan Lu " + // U+3BD0
+		"Qian Pao Zhen [?] Li Cao Qi [?] [?] Ti Ling Qu Lian Lu Shu Gong " + // U+3BE0
+		"Zhe Pao Jin Qing [?] [?] Zong Pu Jin Biao Jian Gun [?] Bin Zao Lie " + // U+3BF0
+		"Li Luo Shen Mian Jian Di Bei [?] Lian [?] Xian Pin Que Long Zui [?] " + // U+3C00
+		"Jue Shan Xue [?] Xie [?] Lan Qi Yi Nuo Li Yue [?] Yi Chi Ji " + // U+3C10
+		"Hang Xie Keng Zi He Xi Qu Hai Xia Hai Gui Chan Xun Xu Shen Kou " + // U+3C20
+		"Xia Sha Yu Ya Pou Zu You Zi Lian Xian Xia Yi Sha Yan Jiao Xi " + // U+3C30
+		"Chi Shi Kang Yin Hei Yi Xi Se Jin Ye You Que Ye Luan Kun Zheng " + // U+3C40
+		"[?] [?] [?] [?] Xie [?] Cui Xiu An Xiu Can Chuan Zha [?] Yi Pi " + // U+3C50
+		"Ku Sheng Lang Tui Xi Ling Qi Wo Lian Du Men Lan Wei Duan Kuai Ai " + // U+3C60
+		"Zai Hui Yi Mo Zi Fen Peng [?] Bi Li Lu Luo Hai Zhen Gai Que " + // U+3C70
+		"Zhen Kong Cheng Jiu Jue Ji Ling [?] Shao Que Rui Chuo Neng Zhi Lou Pao " + // U+3C80
+		"[?] [?] Bao Rong Xian Lei Xiao Fu Qu [?] Sha Zhi Tan Rong Su Ying " + // U+3C90
+		"Mao Nai Bian [?] Shuai Tang Han Sao Rong [?] Deng Pu Jiao Tan [?] Ran " + // U+3CA0
+		"Ning Lie Die Die Zhong [?] Lu Dan Xi Gui Ji Ni Yi Nian Yu Wang " + // U+3CB0
+		"Guo Ze Yan Cui Xian Jiao Tou Fu Pei [?] You Qiu Ya Bu Bian Shi " + // U+3CC0
+		"Zha Yi Bian [?] Dui Lan Yi Chai Chong Xuan Xu Yu Xiu [?] [?] [?] " + // U+3CD0
+		"Ta Guo [?] [?] [?] Long Xie Che Jian Tan Pi Zan Xuan Xian Niao [?] " + // U+3CE0
+		"[?] [?] [?] [?] Mi Ji Nou Hu Hua Wang You Ze Bi Mi Qiang Xie " + // U+3CF0
+		"Fan Yi Tan Lei Yong [?] Jin She Yin Ji [?] Su [?] [?] Nai Wang " + // U+3D00
+		"Mian Su Yi Shai Xi Ji Luo You Mao Zha Sui Zhi Bian Li [?] [?] " + // U+3D10
+		"[?] [?] [?] [?] [?] Qiao Guan Xi Zhen Yong Nie Jun Xie Yao Xie Zhi " + // U+3D20
+		"Neng [?] Si Long Chen Mi Que Dan Shan [?] [?] [?] Su Xie Bo Ding " + // U+3D30
+		"Zu [?] Shu She Han Tan Gao [?] [?] [?] Na Mi Xun Men Jian Cui " + // U+3D40
+		"Jue He Fei Shi Che Shen Nu Ping Man [?] [?] [?] [?] Yi Chou [?] " + // U+3D50
+		"Ku Bao Lei Ke Sha Bi Sui Ge Pi Yi Xian Ni Ying Zhu Chun Feng " + // U+3D60
+		"Xu Piao Wu Liao Cang Zou Zuo Bian Yao Huan Pai Xiu [?] Lei Qing Xiao " + // U+3D70
+		"Jiao Guo [?] [?] Yan Xue Zhu Heng Ying Xi [?] [?] Lian Xian Huan Yin " + // U+3D80
+		"[?] Lian Shan Cang Bei Jian Shu Fan Dian [?] Ba Yu [?] [?] Nang Lei " + // U+3D90
+		"Yi Dai [?] Chan Chao Gan Jin Nen [?] [?] [?] Liao Mo You [?] Liu " + // U+3DA0
+		"Han [?] Yong Jin Chi Ren Nong [?] [?] Hong Tian [?] Ai Gua Biao Bo " + // U+3DB0
+		"Qiong [?] Shu Chui Hui Chao Fu Hui E Wei Fen Tan [?] Lun He Yong " + // U+3DC0
+		"Hui [?] Yu Zong Yan Qiu Zhao Jiong Tai [?] [?] [?] [?] [?] [?] Tui " + // U+3DD0
+		"Lin Jiong Zha Xing Hu [?] Xu [?] [?] [?] Cui Qing Mo [?] Zao Beng " + // U+3DE0
+		"Chi [?] [?] Yan Ge Mo Bei Juan Die Zhao [?] Wu Yan [?] Jue Xian " + // U+3DF0
+		"Tai Han [?] Dian Ji Jie Kao Zuan [?] Xie Lai Fan Huo Xi Nie Mi " + // U+3E00
+		"Ran Cuan Yin Mi [?] Jue Qu Tong Wan Zhe Li Shao Kong Xian Zhe Zhi " + // U+3E10
+		"Tiao Shu Bei Ye Pian Chan Hu Ken Jiu An Chun Qian Bei Ba Fen Ke " + // U+3E20
+		"Tuo Tuo Zuo Ling [?] Gui Yan Shi Hou Lie Sha Si [?] Bei Ren Du " + // U+3E30
+		"Bo Liang Qian Fei Ji Zong Hui He Li Yuan Yue Xiu Chan Di Lei Jin " + // U+3E40
+		"Chong Si Pu Yao Jiang Huan Huan Tao Ru Weng Ying Rao Yin Shi Yin Jue " + // U+3E50
+		"Tun Xuan Jia Zhong Qie Zhu Diao [?] You [?] [?] Yi Shi Yi Mo [?] " + // U+3E60
+		"[?] Que Xiao Wu Geng Ying Ting Shi Ni Geng Ta Wo Ju Chan Piao Zhuo " + // U+3E70
+		"Hu Nao Yan Gou Yu Hou [?] Si Chi Hu Yang Weng Xian Pin Rong Lou " + // U+3E80
+		"Lao Shan Xiao Ze Hai Fan Han Chan Zhan [?] Ta Zhu Nong Han Yu Zhuo " + // U+3E90
+		"You Li Huo Xi Xian Chan Lian [?] Si Jiu Pu Qiu Gong Zi Yu [?] " + // U+3EA0
+		"[?] Reng Niu Mei Ba Jiu [?] Xu Ping Bian Mao [?] [?] [?] [?] Yi " + // U+3EB0
+		"Yu [?] Ping Qu Bao Hui [?] [?] [?] Bu Mang La Tu Wu Li Ling " + // U+3EC0
+		"[?] Ji Jun Zou Duo Jue Dai Bei [?] [?] [?] [?] [?] La Bin Sui " + // U+3ED0
+		"Tu Xue [?] [?] [?] [?] [?] Duo [?] [?] Sui Bi Tu Se Can Tu " + // U+3EE0
+		"Mian Jin Lu [?] [?] Zhan Bi Ji Zen Xuan Li [?] [?] Sui Yong Shu " + // U+3EF0
+		"[?] [?] E [?] [?] [?] [?] Qiong Luo Zhen Tun Gu Yu Lei Bo Nei " + // U+3F00
+		"Pian Lian Tang Lian Wen Dang Li Ting Wa Zhou Gang Xing Ang Fan Peng Bo " + // U+3F10
+		"Tuo Shu Yi Bo Qie Tou Gong Tong Han Cheng Jie Huan Xing Dian Chai Dong " + // U+3F20
+		"Pi Ruan Lie Sheng Ou Di Yu Chuan Rong Kang Tang Cong Piao Chuang Lu Tong " + // U+3F30
+		"Zheng Li Sa Pan Si [?] Dang Hu Yi Xian Xie Luo Liu [?] Tan Gan " + // U+3F40
+		"[?] Tan [?] [?] [?] You Nan [?] Gang Jun Chi Gou Wan Li Liu Lie " + // U+3F50
+		"Xia Bei An Yu Ju Rou Xun Zi Cuo Can Zeng Yong Fu Ruan [?] Xi " + // U+3F60
+		"Shu Jiao Jiao Xu Zhang [?] [?] Shui Chen Fan Ji Zhi [?] Gu Wu [?] " + // U+3F70
+		"Qie Shu Hai Tuo Du Zi Ran Mu Fu Ling Ji Xiu Xuan Nai Ya Jie " + // U+3F80
+		"Li Da Ru Yuan Lu Shen Li Liang Geng Xin Xie Qin Qie Che You Bu " + // U+3F90
+		"Kuang Que Ai Qin Qiang Chu Pei Kuo Yi Guai Sheng Pian [?] Zhou Huang Hui " + // U+3FA0
+		"Hu Bei [?] [?] Zha Ji Gu Xi Gao Chai Ma Zhu Tui Zhui Xian Lang " + // U+3FB0
+		"[?] [?] [?] Zhi Ai Xian Guo Xi [?] Tui Can Sao Xian Jie Fen Qun " + // U+3FC0
+		"[?] Yao Dao Jia Lei Yan Lu Tui Ying Pi Luo Li Bie [?] Mao Bai " + // U+3FD0
+		"Huang [?] Yao He Chun He Ning Chou Li Tang Huan Bi Ba Che Yang Da " + // U+3FE0
+		"Ao Xue [?] Zi Da Ran Bang Cuo Wan Ta Bao Gan Yan Xi Zhu Ya " + // U+3FF0
+		"Fan You An Tui Meng She Jin Gu Ji Qiao Jiao Yan Xi Kan Mian Xuan " + // U+4000
+		"Shan Wo Qian Huan Ren Zhen Tian Jue Xie Qi Ang Mei Gu [?] Tao Fan " + // U+4010
+		"Ju Chan Shun Bi Mao Shuo Gu Hong Hua Luo Hang Jia Quan Gai Huang Bu " + // U+4020
+		"Gu Feng Mu Ai Ying Shun Liang Jie Chi Jie Chou Ping Chen Yan Du Di " + // U+4030
+		"[?] Liang Xian Biao Xing Meng Ye Mi Qi Qi Wo Xie Yu Qia Cheng Yao " + // U+4040
+		"Ying Yang Ji Zong Xuan Min Lou Kai Yao Yan Sun Gui Huang Ying Sheng Cha " + // U+4050
+		"Lian [?] Xuan Chuan Che Ni Qu Miao Huo Yu Zhan Hu Ceng Biao Qian Xi " + // U+4060
+		"Jiang Kou Mai Mang Zhan Bian Ji Jue Nang Bi Shi Shuo Mo Lie Mie Mo " + // U+4070
+		"Xi Chan Qu Jiao Huo Xian Xu Niu Tong Hou Yu [?] Chong Bo Zuan Diao " + // U+4080
+		"Zhuo Ji Qia [?] Xing Hui Shi Ku [?] Dui Yao Yu Bang Jie Zhe Jia " + // U+4090
+		"Shi Di Dong Ci Fu Min Zhen Zhen [?] Yan Qiao Hang Gong Qiao Lue Guai " + // U+40A0
+		"La Rui Fa Cuo Yan Gong Jie Guai Guo Suo Wo Zheng Nie Diao Lai Ta " + // U+40B0
+		"Cui Ya Gun [?] [?] Di [?] Mian Jie Min Ju Yu Zhen Zhao Zha Xing " + // U+40C0
+		"[?] Ban He Gou Hong Lao Wu Bo Keng Lu Cu Lian Yi Qiao Shu [?] " + // U+40D0
+		"Xuan Jin Qin Hui Su Chuang Dun Long [?] Nao Tan Dan Wei Gan Da Li " + // U+40E0
+		"Ca Xian Pan La Zhu Niao Huai Ying Xian Lan Mo Ba [?] Gui Bi Fu " + // U+40F0
+		"Huo Yi Liu Yang Yin Juan Huo Cheng Dou E [?] Yan Zhui Zha Qi Yu " + // U+4100
+		"Quan Huo Nie Huang Ju She [?] [?] Peng Ming Cao Lou Li Chuang [?] Cui " + // U+4110
+		"Shan Dan Qi [?] Lai Ling Liao Reng Yu Yi Diao Qi Yi Nian Fu Jian " + // U+4120
+		"Ya Fang Rui Xian [?] [?] Bi Shi Po Nian Zhi Tao Tian Tian Ru Yi " + // U+4130
+		"Lie An He Qiong Li Gui Zi Su Yuan Ya Cha Wan Juan Ting You Hui " + // U+4140
+		"Jian Rui Mang Ju Zi Ju An Sui Lai Hun Quan Chang Duo Kong Ne Can " + // U+4150
+		"Ti Xu Jiu Huang Qi Jie Mao Yan [?] Zhi Tui [?] Ai Pang Cang Tang " + // U+4160
+		"En Hun Qi Chu Suo Zhuo Nou Tu Shen Lou Biao Li Man Xin Cen Huang " + // U+4170
+		"Mei Gao Lian Dao Zhan Zi [?] [?] Zhi Ba Cui Qiu [?] Long Xian Fei " + // U+4180
+		"Guo Cheng Jiu E Chong Yue Hong Yao Ya Yao Tong Zha You Xue Yao Ke " + // U+4190
+		"Huan Lang Yue Chen [?] [?] Shen [?] Ning Ming Hong Chuang Yun Xuan Jin Zhuo " + // U+41A0
+		"Yu Tan Kang Qiong [?] Cheng Jiu Xue Zheng Chong Pan Qiao [?] Qu Lan Yi " + // U+41B0
+		"Rong Si Qian Si [?] Fa [?] Meng Hua [?] [?] Hai Qiao Chu Que Dui " + // U+41C0
+		"Li Ba Jie Xu Luo [?] Yun Zhong Hu Yin Po Zhi Qian [?] Gan Jian " + // U+41D0
+		"Zhu Zhu Ku Nie Rui Ze Ang Zhi Gong Yi Chi Ji Zhu Lao Ren Rong " + // U+41E0
+		"Zheng Na Ce [?] [?] Yi Jue Bie Cheng Jun Dou Wei Yi Zhe Yan [?] " + // U+41F0
+		"San Lun Ping Zhao Han Yu Dai Zhao Fei Sha Ling Ta Qu Mang Ye Bao " + // U+4200
+		"Gui Gua Nan Ge [?] Shi Ke Suo Ci Zhou Tai Kuai Qin Xu Du Ce " + // U+4210
+		"Huan Cong Sai Zheng Qian Jin Zong Wei [?] [?] Xi Na Pu Sou Ju Zhen " + // U+4220
+		"Shao Tao Ban Ta Qian Weng Rong Luo Hu Sou Zhong Pu Mie Jin Shao Mi " + // U+4230
+		"Shu Ling Lei Jiang Leng Zhi Diao [?] San Gu Fan Mei Sui Jian Tang Xie " + // U+4240
+		"Ku Wu Fan Luo Can Ceng Ling Yi Cong Yun Meng Yu Zhi Yi Dan Huo " + // U+4250
+		"Wei Tan Se Xie Sou Song Qian Liu Yi [?] Lei Li Fei Lie Lin Xian " + // U+4260
+		"Xiao Ou Mi Xian Rang Zhuan Shuang Yan Bian Ling Hong Qi Liao Ban Bi Hu " + // U+4270
+		"Hu [?] Ce Pei Qiong Ming Jiu Bu Mei San Wei [?] [?] Li Quan [?] " + // U+4280
+		"Hun Xiang [?] Shi Ying [?] Nan Huang Jiu Yan [?] Sa Tuan Xie Zhe Men " + // U+4290
+		"Xi Man [?] Huang Tan Xiao Ye Bi Luo Fan Li Cui Chua Dao Di Kuang " + // U+42A0
+		"Chu Xian Chan Mi Qian Qiu Zhen [?] [?] [?] Hu Gan Chi Guai Mu Bo " + // U+42B0
+		"Hua Geng Yao Mao Wang [?] [?] [?] Ru Xue Zheng Min Jiang [?] Zhan Zuo " + // U+42C0
+		"Yue Lie [?] Zhou Bi Ren Yu [?] Chuo Er Yi Mi Qing [?] Wang Ji " + // U+42D0
+		"Bu [?] Bie Fan Yue Li Fan Qu Fu Er E Zheng Tian Yu Jin Qi " + // U+42E0
+		"Ju Lai Che Bei Niu Yi Xu Mou Xun Fu [?] Nin Ting Beng Zha Wei " + // U+42F0
+		"Ke Yao Ou Xiao Geng Tang Gui Hui Ta [?] Yao Da Qi Jin Lue Mi " + // U+4300
+		"Mi Jian Lu Fan Ou Mi Jie Fu Bie Huang Su Yao Nie Jin Lian Bo " + // U+4310
+		"Jian Ti Ling Zuan Shi Yin Dao Chou Ca Mie Yan Lan Chong Jiao Shuang Quan " + // U+4320
+		"Nie Luo [?] Shi Luo Zhu [?] Chou Juan Jiong Er Yi Rui Cai Ren Fu " + // U+4330
+		"Lan Sui Yu You Dian Ling Zhu Ta Ping Zhai Jiao Chui Bu Kou Cun [?] " + // U+4340
+		"Han Han Mou Hu Gong Di Fu Xuan Mi Mei Lang Gu Zhao Ta Yu Zong " + // U+4350
+		"Li Lu Wu Lei Ji Li Li [?] Po Yang Wa Tuo Peng [?] Zhao Gui " + // U+4360
+		"[?] Xu Nai Que Wei Zheng Dong Wei Bo [?] Huan Xuan Zan Li Yan Huang " + // U+4370
+		"Xue Hu Bao Ran Xiao Po Liao Zhou Yi Xu Luo Kao Chu [?] Na Han " + // U+4380
+		"Chao Lu Zhan Ta Fu Hong Zeng Qiao Su Pin Guan [?] Hun Chu [?] Er " + // U+4390
+		"Er Ruan Qi Si Ju [?] Yan Bang Ye Zi Ne Chuang Ba Cao Ti Han " + // U+43A0
+		"Zuo Ba Zhe Wa Geng Bi Er Zhu Wu Wen Zhi Zhou Lu Wen Gun Qiu " + // U+43B0
+		"La Zai Sou Mian Di Qi Cao Piao Lian Shi Long Su Qi Yuan Feng Xu " + // U+43C0
+		"Jue Di Pian Guan Niu Ren Zhen Gai Pi Tan Chao Chun He Zhuan Mo Bie " + // U+43D0
+		"Qi Shi Bi Jue Si [?] Gua Na Hui Xi Er Xiu Mou [?] Xi Zhi " + // U+43E0
+		"Run Ju Die Zhe Shao Meng Bi Han Yu Xian Pang Neng Can Bu [?] Qi " + // U+43F0
+		"Ji Zhuo Lu Jun Xian Xi Cai Wen Zhi Zi Kun Cong Tian Chu Di Chun " + // U+4400
+		"Qiu Zhe Zha Rou Bin Ji Xi Zhu Jue Ge Ji Da Chen Suo Ruo Xiang " + // U+4410
+		"Huang Qi Zhu Sun Chai Weng Ke Kao Gu Gai Fan Cong Cao Zhi Chan Lei " + // U+4420
+		"Xiu Zhai Zhe Yu Gui Gong Zan Dan Huo Sou Tan Gu Xi Man Duo Ao " + // U+4430
+		"Pi Wu Ai Meng Pi Meng Yang Zhi Bo Ying Wei Rang Lan Yan Chan Quan " + // U+4440
+		"Zhen Pu [?] Tai Fei Shu [?] Dang Cuo Tan Tian Chi Ta Jia Shun Huang " + // U+4450
+		"Liao [?] [?] Chen Jin E Gou Fu Duo [?] E Beng Tao Di [?] Di " + // U+4460
+		"Bu Wan Zhao Lun Qi Mu Qian [?] Zong Sou [?] You Zhou Ta [?] Su " + // U+4470
+		"Bu Xi Jiang Cao Fu Teng Che Fu Fei Wu Xi Yang Ming Pang Mang Seng " + // U+4480
+		"Meng Cao Tiao Kai Bai Xiao Xin Qi [?] [?] Shao Huan Niu Xiao Chen Dan " + // U+4490
+		"Feng Yin Ang Ran Ri Man Fan Qu Shi He Bian Dai Mo Deng [?] [?] " + // U+44A0
+		"Kuang [?] Cha Duo You Hao [?] Gua Xue Lei Jin Qi Qu Wang Yi Liao " + // U+44B0
+		"[?] [?] Yan Yi Yin Qi Zhe Xi Yi Ye Wu Zhi Zhi Han Chuo Fu " + // U+44C0
+		"Chun Ping Kuai Chou [?] Tuo Qiong Cong Gao Kua Qu Qu Zhi Meng Li Zhou " + // U+44D0
+		"Ta Zhi Gu Liang Hu La Dian Ci Ying [?] [?] Qi Zhuo Cha Mao Du " + // U+44E0
+		"Yin Chai Rui Hen Ruan Fu Lai Xing Jian Yi Mei [?] Mang Ji Suo Han " + // U+44F0
+		"[?] Li Zi Zu Yao Ge Li Qi Gong Li Bing Suo [?] [?] Su Chou " + // U+4500
+		"Jian Xie Bei Xu Jing Pu Ling Xiang Zuo Diao Chun Qing Nan Zhai Lu Yi " + // U+4510
+		"Shao Yu Hua Li Pa [?] [?] Li [?] [?] Shuang [?] Yi Ning Si Ku " + // U+4520
+		"Fu Yi Deng Ran Ce [?] Ti Qin Biao Sui Wei Dun Se Ai Qi Zun " + // U+4530
+		"Kuan Fei [?] Yin [?] Sao Dou Hui Xie Ze Tan Tang Zhi Yi Fu E " + // U+4540
+		"[?] Jun Jia Cha Xian Man [?] Bi Ling Jie Kui Jia [?] Cheng Lang Xing " + // U+4550
+		"Fei Lu Zha He Ji Ni Ying Xiao Teng Lao Ze Kui [?] Qian Ju Piao " + // U+4560
+		"Fan Tou Lin Mi Zhuo Xie Hu Mi Jie Za Cong Li Ran Zhu Yin Han " + // U+4570
+		"[?] Yi Luan Yue Ran Ling Niang Yu Nue [?] Yi Nue Yi Qian Xia Chu " + // U+4580
+		"Yin Mi Xi Na Kan Zu Xia Yan Tu Ti Wu Suo Yin Chong Zhou Mang " + // U+4590
+		"Yuan Nu Miao Zao Wan Li Qu Na Shi Bi Zi Bang [?] Juan Xiang Kui " + // U+45A0
+		"Pai Kuang Xun Zha Yao Kun Hui Xi E Yang Tiao You Jue Li [?] Li " + // U+45B0
+		"Cheng Ji Hu Zhan Fu Chang Guan Ju Meng Chang Tan Mou Xing Li Yan Sou " + // U+45C0
+		"Shi Yi Bing Cong Hou Wan Di Ji Ge Han Bo Xiu Liu Can Can Yi " + // U+45D0
+		"Xuan Yan Zao Han Yong Zong [?] Kang Yu Qi Zhe Ma [?] [?] Shuang Jin " + // U+45E0
+		"Guan Pu Lin [?] Ting Jiang La Yi Yong Ci Yan Jie Xun Wei Xian Ning " + // U+45F0
+		"Fu Ge [?] Mo Zhu Nai Xian Wen Li Can Mie Jian Ni Chai Wan Xu " + // U+4600
+		"Nu Mai Zui Kan Ka Hang [?] [?] Yu Wei Zhu [?] [?] Yi [?] Diao " + // U+4610
+		"Fu Bi Zhu Zi Shu Xia Ni [?] Jiao Xun Chong Nou Rong Zhi Sang [?] " + // U+4620
+		"Shan Yu [?] Jin [?] Lu Han Bie Yi Zui Zhan Yu Wan Ni Guan Jue " + // U+4630
+		"Beng Can [?] Duo Qi Yao Kui Ruan Hou Xun Xie [?] Kui [?] Xie Bo " + // U+4640
+		"Ke Cui Xu Bai Ou Zong [?] Ti Chu Chi Niao Guan Feng Xie Deng Wei " + // U+4650
+		"Jue Kui Zeng Sa Duo Ling Meng [?] Guo Meng Long [?] Ying [?] Guan Cu " + // U+4660
+		"Li Du [?] Biao Qian Xi [?] De De Xian Lian [?] Shao Xie Shi Wei " + // U+4670
+		"[?] [?] He You Lu Lai Ying Sheng Juan Qi Jian Yun [?] Qi [?] Lin " + // U+4680
+		"Ji Mai Chuang Nian Bin Li Ling Gang Cheng Xuan Xian Hu Bi Zu Dai Dai " + // U+4690
+		"Hun Sai Che Ti [?] Nuo Zhi Liu Fei Jiao Guan Xi Lin Xuan Reng Tao " + // U+46A0
+		"Pi Xin Shan Zhi Wa Tou Tian Yi Xie Pi Yao Yao Nu Hao Nin Yin " + // U+46B0
+		"Fan Nan Yao Wan Yuan Xia Zhou Yuan Shi Mian Xi Ji Tao Fei Xue Ni " + // U+46C0
+		"Ci Mi Bian Jian Na Yu E Zhi Ren Xu Lue Hui Xun Nao Han Jia " + // U+46D0
+		"Dou Hua Tu Ping Cu Xi Song Mi Xin Wu Qiong Zhang Tao Xing Jiu Ju " + // U+46E0
+		"Hun Ti Man Yan Ji Shou Lei Wan Che Can Jie You Hui Zha Su Ge " + // U+46F0
+		"Nao Xi [?] Dui Chi Wei Zhe Gun Chao Chi Zao Hui Luan Liao Lao Tuo " + // U+4700
+		"Hui Wu Ao She Sui Mai Tan Xin Jing An Ta Chan Wei Tuan Ji Chen " + // U+4710
+		"Che Yu Xian Xin [?] [?] [?] Nao [?] Yan Qiu Jiang Song Jun Liao Ju " + // U+4720
+		"[?] Man Lie [?] Chu Chi Xiang Qin Mei Shu Chai Chi Gu Yu Yin [?] " + // U+4730
+		"Liu Lao Shu Zhe Shuang Hui [?] [?] E [?] Sha Zong Jue Jun Tuan Lou " + // U+4740
+		"Wei Chong Zhu Lie [?] Zhe Zhao [?] Yi Chu Ni Bo Suan Yi Hao Ya " + // U+4750
+		"Huan Man Man Qu Lao Hao Zhong Min Xian Zhen Shu Zuo Zhu Gou Xuan Yi " + // U+4760
+		"Zhi Xie Jin Can [?] Bu Liang Zhi Ji Wan Guan Ju Jing Ai Fu Gui " + // U+4770
+		"Hou Yan Ruan Zhi Biao Yi Suo Die Gui Sheng Xun Chen She Qing [?] [?] " + // U+4780
+		"Chun Hong Dong Cheng Wei Ru Shu Cai Ji Za Qi Yan Fu Yu Fu Po " + // U+4790
+		"Zhi Tan Zuo Che Qu You He Hou Gui E Jiang Yun Tou Cun Tu Fu " + // U+47A0
+		"Zuo Hu [?] Bo Zhao Jue Tang Jue Fu Huang Chun Yong Chui Suo Chi Qian " + // U+47B0
+		"Cai Xiao Man Can Qi Jian Bi Ji Zhi Zhu Qu Zhan Ji Bian [?] Li " + // U+47C0
+		"Li Yue Quan Cheng Fu Cha Tang Shi Hang Qie Qi Bo Na Tou Chu Cu " + // U+47D0
+		"Yue Zhi Chen Chu Bi Meng Ba Tian Min Lie Feng Cheng Qiu Tiao Fu Kuo " + // U+47E0
+		"Jian [?] [?] [?] Zhen Qiu Zuo Chi Kui Lie Bei Du Wu [?] Zhuo Lu " + // U+47F0
+		"Tang [?] Chu Liang Tian Kun Chang Jue Tu Huan Fei Bi [?] Xia Wo Ji " + // U+4800
+		"Qu Kui Hu Qiu Sui Cai [?] Qiu Pi Pang Wa Yao Rong Xun Cu Die " + // U+4810
+		"Chi Cuo Meng Xuan Duo Bie Zhe Chu Chan Gui Duan Zou Deng Lai Teng Yue " + // U+4820
+		"Quan Zhu Ling Chen Zhen Fu She Tiao Kua Ai [?] Qiong Shu Hai Shan Wai " + // U+4830
+		"Zhan Long Jiu Li [?] Chun Rong Yue Jue Kang Fan Qi Hong Fu Lu Hong " + // U+4840
+		"Tuo Min Tian Juan Qi Zheng Qing Gong Tian Lang Mao Yin Lu Yuan Ju Pi " + // U+4850
+		"[?] Xie Bian Hun Zhu Rong Sang Wu Cha Keng Shan Peng Man Xiu [?] Cong " + // U+4860
+		"Keng Zhuan Chan Si Chong Sui Bei Kai [?] Zhi Wei Min Ling Zuan Nie Ling " + // U+4870
+		"Qi Yue [?] Yi Xi Chen [?] Rong Chen Nong You Ji Bo Fang [?] [?] " + // U+4880
+		"Cu Di Jiao Yu He Xu Yu Qu [?] Bai Geng Jiong [?] Ya Shu You " + // U+4890
+		"Song Ye Cang Yao Shu Yan Shuai Liao Cong Yu Bo Sui [?] Yan Lei Lin " + // U+48A0
+		"Ti Du Yue Ji [?] Yun [?] [?] Ju Ju Chu Chen Gong Xiang Xian An " + // U+48B0
+		"Gui Yu Lei [?] Tu Chen Xing Qiu Hang [?] Dang Cai Di Yan Zi [?] " + // U+48C0
+		"Ying Chan [?] Li Suo Ma Ma [?] Tang Pei Lou Qi Cuo Tu E Can " + // U+48D0
+		"Jie Yi Ji Dang Jue Bi Lei Yi Chun Chun Po Li Zai Tai Po Cu " + // U+48E0
+		"Ju Xu Fan [?] Xu Er Huo Zhu Ran Fa Juan Han Liang Zhi Mi Yu " + // U+48F0
+		"[?] Cen Mei Yin Mian Tu Kui [?] [?] Mi Rong Yu Qiang Mi Ju Pi " + // U+4900
+		"Jin Wang Ji Meng Jian Xue Bao Gan Chan Li Li Qiu Dun Ying Yun Chen " + // U+4910
+		"Zhi Ran [?] Lue Kai Gui Yue Hui Pi Cha Duo Chan Sha Shi She Xing " + // U+4920
+		"Ying Shi Chi Ye Han Fei Ye Yan Zuan Sou Jin Duo Xian Guan Tao Qie " + // U+4930
+		"Chan Han Meng Yue Cu Qian Jin Shan Mu Yuan [?] Peng Zheng Zhi Chun Yu " + // U+4940
+		"Mou Wan Jiang Qi Su Pie Tian Kuan Cu Sui [?] Jie Jian Ao Jiao Ye " + // U+4950
+		"[?] Ye Long Zao Bao Lian [?] Huan Lu Wei Xian Tie Bo Zheng Zhu Bei " + // U+4960
+		"Meng Xie Ou You [?] Xiao Li Zha Mi [?] Ye [?] [?] Po Xie [?] " + // U+4970
+		"[?] [?] Shan Zhuo [?] Shan Jue Ji Jie [?] Niao Ao Chu Wu Guan Xie " + // U+4980
+		"Ting Xue Dang Zhan Tan Peng Xie Xu Xian Si Kua Zheng Wu Huo Run Wen " + // U+4990
+		"Du Huan Kuo Fu Chuai Xian Qin Qie Lan [?] Ya Ying Que Hang Chun Zhi " + // U+49A0
+		"[?] Wei Yan Xiang Yi Ni Zheng Chuai [?] Shi Ding Zi Jue Xu Yuan [?] " + // U+49B0
+		"[?] Xu Dao Tian Ge Yi Hong Yi [?] Li Ku Xian Sui Xi Xuan [?] " + // U+49C0
+		"[?] Di Lai Zhou Nian Cheng Jian Bi Zhuan Ling Hao Bang Tang Chi Ma Xian " + // U+49D0
+		"Shuan Yong Qu [?] Pu Hui Wei Yi Ye [?] Che Hao Bin [?] Xian Chan " + // U+49E0
+		"Hun [?] Han Ci Zhi Qi Kui Rou [?] Ying Xiong [?] Hu Cui [?] Que " + // U+49F0
+		"Di Wu Qiu [?] Yan Liao Bi [?] Bin [?] Yuan Nue Bao Ying Hong Ci " + // U+4A00
+		"Qia Ti Yu Lei Bao [?] Ji Fu Xian Cen Hu Se Beng Qing Yu Wa " + // U+4A10
+		"Ai Han Dan Ge Di Huo Pang [?] Zhui Ling Mai Mai Lian Xiao Xue Zhen " + // U+4A20
+		"Po Fu Nou Xi Dui Dan Yun Xian Yin Shu Dui Beng Hu Fei Fei Za " + // U+4A30
+		"Bei Fei Xian Shi Mian Zhan Zhan Zhan Hui Fu Wan Mo Qiao Liao [?] Mie " + // U+4A40
+		"Hu Hong Yu Qi Duo Ang [?] Ba Di Xuan Di Bi Zhou Pao Tie Yi " + // U+4A50
+		"[?] Jia Zhi Tu Xie Dan Tiao Xie Chang Yuan Guan Liang Beng [?] Lu Ji " + // U+4A60
+		"Xuan Shu Du Sou Hu Yun Chan Bang Rong E Weng Ba Feng Yu Zhe Fen " + // U+4A70
+		"Guan Bu Ge Dun Huang Du Ti Bo Qian Lie Long Wei Zhan Lan Sui Na " + // U+4A80
+		"Bi Tuo Zhu Die Bu Ju Po Xia Wei Po Da Fan Chan Hu Za [?] " + // U+4A90
+		"[?] [?] [?] [?] Fan Xie Hong Chi Bao Yin [?] Jing Bo Ruan Chou Ying " + // U+4AA0
+		"Yi Gai Kun Yun Zhen Ya Ju Hou Min Bai Ge Bian Zhuo Hao Zhen Sheng " + // U+4AB0
+		"Gen Bi Duo Chun Chua San Cheng Ran Chen Mao Pei Wei Pi Fu Zhuo Qi " + // U+4AC0
+		"Lin Yi Men Wu Qi Die Chen Xia He Sang Gua Hou Ao Fu Qiao Hun " + // U+4AD0
+		"Pi Yan Si Xi Ming Kui Ge [?] Ao San Shuang Lou Zhen Hui Chan [?] " + // U+4AE0
+		"Lin Na Han Du Jin Mian Fan E Chao Hong Hong Yu Xue Pao Bi Chao " + // U+4AF0
+		"You Yi Xue Sa Xu Li Li Yuan Dui Huo Sha Leng Pou Hu Guo Bu " + // U+4B00
+		"Rui Wei Sou An Yu Xiang Heng Yang Xiao Yao [?] Bi [?] Heng Tao Liu " + // U+4B10
+		"[?] Zhu [?] Xi Zan Yi Dou Yuan Jiu [?] Bo Ti Ying [?] Yi Nian " + // U+4B20
+		"Shao Ben Gou Ban Mo Gai En She [?] Zhi Yang Jian Yuan Shui Ti Wei " + // U+4B30
+		"Xun Zhi Yi Ren Shi Hu Ne Ye Jian Sui Ying Bao Hu Hu Ye [?] " + // U+4B40
+		"Yang Lian Xi En Dui Zan Zhu Ying Ying Jin Chuang Dan [?] Kuai Yi Ye " + // U+4B50
+		"Jian En Ning Ci Qian Xue Bo Mi Shui Mo Liang Qi Qi Shou Fu Bo " + // U+4B60
+		"Beng Bie Yi Wei Huan Fan Qi Mao Fu Ang Ang Fu Qi Qun Tuo Yi " + // U+4B70
+		"Bo Pian Ba [?] Xuan [?] [?] Yu Chi Lu Yi Li [?] Niao Xi Wu " + // U+4B80
+		"[?] Lei Pu Zhuo Zui Zhuo Chang An Er Yu Leng Fu Zha Hun Chun Sou " + // U+4B90
+		"Bi Bi Zha [?] He Li [?] Han Zai Gu Cheng Lou Mo Mi Mai Ao " + // U+4BA0
+		"Zhe Zhu Huang Fan Deng Tong [?] Du Wo Wei Ji Chi Lin Biao Long Jian " + // U+4BB0
+		"Nie Luo Shen [?] Gua Nie Yi Ku Wan Wa Qia Bo Kao Ling Gan Gua " + // U+4BC0
+		"Hai Kuang Heng Kui Ze Ting Lang Bi Huan Po Yao Wan Ti Sui Kua Dui " + // U+4BD0
+		"Ao Jian Mo Kui Kuai An Ma Qing Qiao [?] Kao Hao Duo Xian Nai Suo " + // U+4BE0
+		"Jie Pi Pa Song Chang Nie Man Song Ci Xian Kuo [?] Di Pou Tiao Zu " + // U+4BF0
+		"Wo Fei Cai Peng Sai [?] Rou Qi Cuo Pan Bo Man Zong Ci Kui Ji " + // U+4C00
+		"Lan [?] Meng Mian Pan Lu Zuan Jiu Liu Yi Wen Li Li Zeng Zhu Hun " + // U+4C10
+		"Shen Chi Xing Wang Dong Huo Pi Hu Mei Che Mei Chao Ju Nou [?] Yi " + // U+4C20
+		"Ru Ling Ya [?] Qi Zi [?] Bang Gong Ze Jie Yu Qin Bei Ba Tuo " + // U+4C30
+		"Yang Qiao You Zhi Jie Mo Sheng Shan Qi Shan Mi Gong Yi Geng Geng Tou " + // U+4C40
+		"Fu Xue Ye Ting Tiao Mou Liu Can Li Shu Lu Huo Cuo Pai Liu Ju " + // U+4C50
+		"Zhan Ju Zheng Zu Xian Zhi [?] [?] La [?] [?] La Xu Geng E Mu " + // U+4C60
+		"Zhong Ti Yuan Zhan Geng Weng Lang Yu Sou Zha Hai Hua Zhan Chang Lou Chan " + // U+4C70
+		"Zhi Wei Xuan Zao Min Gui Su [?] [?] Si Duo Cen Kuan Teng Nei Lao " + // U+4C80
+		"Lu Yi Xie Yan Qing Pu Chou Xian Guan Jie Lai Meng Ye Chang Li Yin " + // U+4C90
+		"Chun Qiu Teng Yu [?] [?] Dai Du Hong [?] Xi [?] Qi [?] Yuan Ji " + // U+4CA0
+		"Yun Fang Gong Hang Zhen Que [?] [?] Jie Pi Gan Xuan Sheng Shi Qiao Ci " + // U+4CB0
+		"Die Bo Diao Wan Ci Zhi Bai Wu Bao Dan Ba Tong [?] Gong Jiu Gui " + // U+4CC0
+		"Ci You Yuan Lao Ju Fu Nie E E Xing Kan Yan Tu Pou Beng Ming " + // U+4CD0
+		"Shui Yan Qi Yuan Bie [?] Xuan Hou Huang Yao Juan Kui E Ji Mo Chong " + // U+4CE0
+		"Bao Wu Zhen Xu Ta Chi Xi Cong Ma Kou Yan Can [?] He Deng Ran " + // U+4CF0
+		"Tong Yu Xiang Nao Shun Fen Pu Ling Ao Huan Yi Huan Meng Ying Lei Yan " + // U+4D00
+		"Bao Die Ling Shi Jiao Lie Jing Ju Ti Pi Gang Xiao Wai Chuai Di Huan " + // U+4D10
+		"Yao Li Mi Hu Sheng Jia Yin Wei [?] Piao Lu Ling Yi Cai Shan Hu " + // U+4D20
+		"Shu Tuo Mo Hua Tie Bing Peng Hun Fu Guo Bu Li Chan Pi Cuo Meng " + // U+4D30
+		"Suo Qiang Zhi Kuang Bi Ao Meng Xian Ku Tou Tuan Wei Xian [?] Tuan Lao " + // U+4D40
+		"Chan Ni Ni Li Dong Ju Qian Bo Shai Zha Tao Qian Nong Yi Jing Gan " + // U+4D50
+		"Di Jian Mei Da Jian Yu Xie Zai Mang Li Gun Xun Ta Zhe Yang Tuan " + // U+4D60
+		"Shang Xi Qiao Wei Ying Chua Qu Wa [?] Zhi Ting Gu Shang Ca Fu Tie " + // U+4D70
+		"Ta Ta Zhuo Han Ping He Zhui Zhou Bo Liu Nu Xi Pao Di He Ti " + // U+4D80
+		"Wai Ti Qi Ji Chi Ba Jin Ke Li Ju Qu La Gu Qia Qi Xian " + // U+4D90
+		"Jian Shi Jian Ai Hua Zha Ze Yao Zhan Ji Cha Yan Jian [?] Yan [?] " + // U+4DA0
+		"Jiao Tong Nan Yue [?] Chi [?][?][?][?][?][?][?][?][?][?]" + // U+4DB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4DC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4DD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4DE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+4DF0
+		"Yi Ding Kao Qi Shang Xia Han Mo Zhang San Shang Xia Ji Bu Yu Mian " + // U+4E00
+		"Gai Chou Chou Zhuan Qie Pi Shi Shi Qiu Bing Ye Cong Dong Si Cheng Diu " + // U+4E10
+		"Qiu Liang Diu You Liang Yan Bing Sang Gun Jiu Ge Ya Qiang Zhong Ji Jie " + // U+4E20
+		"Feng Guan Chuan Chan Lin Zhuo Zhu Ha Wan Dan Wei Zhu Jing Li Ju Pie " + // U+4E30
+		"Fu Yi Yi Nai Shime Jiu Jiu Zhe Yao Yi Yi Zhi Wu Zha Hu Fa " + // U+4E40
+		"Le Zhong Ping Pang Qiao Hu Guai Cheng Cheng Yi Yin Ya Mie Jiu Qi Ye " + // U+4E50
+		"Xi Xiang Gai Diu Hal Hu Shu Twul Shi Ji Nang Jia Kel Shi Mao Ol " + // U+4E60
+		"Mai Luan Cal Ru Xue Yan Fu Sha Na Gan Sol El Cwul Zhe Gan Chi " + // U+4E70
+		"Gui Gan Luan Lin Yi Jue Liao Ma Yu Zheng Shi Shi Er Chu Yu Yu " + // U+4E80
+		"Yu Yun Hu Qi Wu Jing Si Sui Gen Gen Ya Xie Ya Qi Ya Ji " + // U+4E90
+		"Tou Wang Kang Ta Jiao Hai Yi Chan Heng Mu Ye Xiang Jing Ting Liang Xiang " + // U+4EA0
+		"Jing Ye Qin Bo You Xie Dan Lian Duo Wei Ren Ren Ji La Wang Yi " + // U+4EB0
+		"Shi Ren Le Ding Ze Jin Pu Chou Ba Zhang Jin Jie Bing Reng Cong Fo " + // U+4EC0
+		"San Lun Sya Cang Zi Shi Ta Zhang Fu Xian Xian Tuo Hong Tong Ren Qian " + // U+4ED0
+		"Gan Yi Di Dai Ling Yi Chao Chang Sa Chang Yi Mu Men Ren Jia Chao " + // U+4EE0
+		"Yang Qian Zhong Pi Wan Wu Jian Jie Yao Feng Cang Ren Wang Fen Di Fang " + // U+4EF0
+		"Zhong Qi Pei Yu Diao Dun Wen Yi Xin Kang Yi Ji Ai Wu Ji Fu " + // U+4F00
+		"Fa Xiu Jin Bei Dan Fu Tang Zhong You Huo Hui Yu Cui Chuan San Wei " + // U+4F10
+		"Chuan Che Ya Xian Shang Chang Lun Cang Xun Xin Wei Zhu Ze Xuan Nu Bo " + // U+4F20
+		"Gu Ni Ni Xie Ban Xu Ling Zhou Shen Qu Si Beng Si Jia Pi Yi " + // U+4F30
+		"Si Ai Zheng Dian Han Mai Dan Zhu Bu Qu Bi Shao Ci Wei Di Zhu " + // U+4F40
+		"Zuo You Yang Ti Zhan He Bi Tuo She Yu Yi Fo Zuo Kou Ning Tong " + // U+4F50
+		"Ni Xuan Qu Yong Wa Qian Shi Ka Bao Pei Huai He Lao Xiang Ge Yang " + // U+4F60
+		"Bai Fa Ming Jia Er Bing Ji Hen Huo Gui Quan Tiao Jiao Ci Yi Shi " + // U+4F70
+		"Xing Shen Tuo Kan Zhi Gai Lai Yi Chi Kua Guang Li Yin Shi Mi Zhu " + // U+4F80
+		"Xu You An Lu Mou Er Lun Tong Cha Chi Xun Gong Zhou Yi Ru Jian " + // U+4F90
+		"Xia Jia Zai Lu Ko Jiao Zhen Ce Qiao Kuai Chai Ning Nong Jin Wu Hou " + // U+4FA0
+		"Jiong Cheng Zhen Zuo Chou Qin Lu Ju Shu Ting Shen Tuo Bo Nan Hao Bian " + // U+4FB0
+		"Tui Yu Xi Cu E Qiu Xu Kuang Ku Wu Jun Yi Fu Lang Zu Qiao " + // U+4FC0
+		"Li Yong Hun Jing Xian San Pai Su Fu Xi Li Fu Ping Bao Yu Si " + // U+4FD0
+		"Xia Xin Xiu Yu Ti Che Chou Zhi Yan Lia Li Lai Si Jian Xiu Fu " + // U+4FE0
+		"He Ju Xiao Pai Jian Biao Chu Fei Feng Ya An Bei Yu Xin Bi Jian " + // U+4FF0
+		"Chang Chi Bing Zan Yao Cui Lia Wan Lai Cang Zong Ge Guan Bei Tian Shu " + // U+5000
+		"Shu Men Dao Tan Jue Chui Xing Peng Tang Hou Yi Qi Ti Gan Jing Jie " + // U+5010
+		"Sui Chang Jie Fang Zhi Kong Juan Zong Ju Qian Ni Lun Zhuo Wei Luo Song " + // U+5020
+		"Leng Hun Dong Zi Ben Wu Ju Nai Cai Jian Zhai Ye Zhi Sha Qing Ning " + // U+5030
+		"Ying Cheng Jian Yan Nuan Zhong Chun Jia Jie Wei Yu Bing Ruo Ti Wei Pian " + // U+5040
+		"Yan Feng Tang Wo E Xie Che Sheng Kan Di Zuo Cha Ting Bei Ye Huang " + // U+5050
+		"Yao Zhan Chou Yan You Jian Xu Zha Ci Fu Bi Zhi Zong Mian Ji Yi " + // U+5060
+		"Xie Xun Si Duan Ce Zhen Ou Tou Tou Bei Za Lu Jie Wei Fen Chang " + // U+5070
+		"Gui Sou Zhi Su Xia Fu Yuan Rong Li Ru Yun Gou Ma Bang Dian Tang " + // U+5080
+		"Hao Jie Xi Shan Qian Jue Cang Chu San Bei Xiao Yong Yao Tan Suo Yang " + // U+5090
+		"Fa Bing Jia Dai Zai Tang Gu Bin Chu Nuo Can Lei Cui Yong Zao Zong " + // U+50A0
+		"Peng Song Ao Chuan Yu Zhai Cou Shang Qiang Jing Chi Sha Han Zhang Qing Yan " + // U+50B0
+		"Di Xi Lu Bei Piao Jin Lian Lu Man Qian Xian Tan Ying Dong Zhuan Xiang " + // U+50C0
+		"Shan Qiao Jiong Tui Zun Pu Xi Lao Chang Guang Liao Qi Deng Chan Wei Ji " + // U+50D0
+		"Fan Hui Chuan Jian Dan Jiao Jiu Seng Fen Xian Jue E Jiao Jian Tong Lin " + // U+50E0
+		"Bo Gu Xian Su Xian Jiang Min Ye Jin Jia Qiao Pi Feng Zhou Ai Sai " + // U+50F0
+		"Yi Jun Nong Chan Yi Dang Jing Xuan Kuai Jian Chu Dan Jiao Sha Zai Can " + // U+5100
+		"Bin An Ru Tai Chou Chai Lan Ni Jin Qian Meng Wu Ning Qiong Ni Chang " + // U+5110
+		"Lie Lei Lu Kuang Bao Du Biao Zan Zhi Si You Hao Chen Chen Li Teng " + // U+5120
+		"Wei Long Chu Chan Rang Shu Hui Li Luo Zan Nuo Tang Yan Lei Nang Er " + // U+5130
+		"Wu Yun Zan Yuan Xiong Chong Zhao Xiong Xian Guang Dui Ke Dui Mian Tu Chang " + // U+5140
+		"Er Dui Er Xin Tu Si Yan Yan Shi Shi Dang Qian Dou Fen Mao Shen " + // U+5150
+		"Dou Bai Jing Li Huang Ru Wang Nei Quan Liang Yu Ba Gong Liu Xi Han " + // U+5160
+		"Lan Gong Tian Guan Xing Bing Qi Ju Dian Zi Ppwun Yang Jian Shou Ji Yi " + // U+5170
+		"Ji Chan Jiong Mao Ran Nei Yuan Mao Gang Ran Ce Jiong Ce Zai Gua Jiong " + // U+5180
+		"Mao Zhou Mou Gou Xu Mian Mi Rong Yin Xie Kan Jun Nong Yi Mi Shi " + // U+5190
+		"Guan Meng Zhong Ju Yuan Ming Kou Lam Fu Xie Mi Bing Dong Tai Gang Feng " + // U+51A0
+		"Bing Hu Chong Jue Hu Kuang Ye Leng Pan Fu Min Dong Xian Lie Xia Jian " + // U+51B0
+		"Jing Shu Mei Tu Qi Gu Zhun Song Jing Liang Qing Diao Ling Dong Gan Jian " + // U+51C0
+		"Yin Cou Yi Li Cang Ming Zhuen Cui Si Duo Jin Lin Lin Ning Xi Du " + // U+51D0
+		"Ji Fan Fan Fan Feng Ju Chu Tako Feng Mok Ci Fu Feng Ping Feng Kai " + // U+51E0
+		"Huang Kai Gan Deng Ping Qu Xiong Kuai Tu Ao Chu Ji Dang Han Han Zao " + // U+51F0
+		"Dao Diao Dao Ren Ren Chuang Fen Qie Yi Ji Kan Qian Cun Chu Wen Ji " + // U+5200
+		"Dan Xing Hua Wan Jue Li Yue Lie Liu Ze Gang Chuang Fu Chu Qu Ju " + // U+5210
+		"Shan Min Ling Zhong Pan Bie Jie Jie Bao Li Shan Bie Chan Jing Gua Gen " + // U+5220
+		"Dao Chuang Kui Ku Duo Er Zhi Shua Quan Cha Ci Ke Jie Gui Ci Gui " + // U+5230
+		"Kai Duo Ji Ti Jing Lou Gen Ze Yuan Cuo Xue Ke La Qian Cha Chuang " + // U+5240
+		"Gua Jian Cuo Li Ti Fei Pou Chan Qi Chuang Zi Gang Wan Bo Ji Duo " + // U+5250
+		"Qing Yan Zhuo Jian Ji Bo Yan Ju Huo Sheng Jian Duo Duan Wu Gua Fu " + // U+5260
+		"Sheng Jian Ge Zha Kai Chuang Juan Chan Tuan Lu Li Fou Shan Piao Kou Jiao " + // U+5270
+		"Gua Qiao Jue Hua Zha Zhuo Lian Ju Pi Liu Gui Jiao Gui Jian Jian Tang " + // U+5280
+		"Huo Ji Jian Yi Jian Zhi Chan Cuan Mo Li Zhu Li Ya Quan Ban Gong " + // U+5290
+		"Jia Wu Mai Lie Jin Keng Xie Zhi Dong Zhu Nu Jie Qu Shao Yi Zhu " + // U+52A0
+		"Miao Li Jing Lao Lao Juan Kou Yang Wa Xiao Mou Kuang Jie Lie He Shi " + // U+52B0
+		"Ke Jing Hao Bo Min Chi Lang Yong Yong Mian Ke Xun Juan Qing Lu Pou " + // U+52C0
+		"Meng Lai Le Kai Mian Dong Xu Xu Kan Wu Yi Xun Weng Sheng Lao Mu " + // U+52D0
+		"Lu Piao Shi Ji Qin Qiang Jiao Quan Yang Yi Jue Fan Juan Tong Ju Dan " + // U+52E0
+		"Xie Mai Xun Xun Lu Li Che Rang Quan Bao Shao Yun Jiu Bao Gou Wu " + // U+52F0
+		"Yun Mwun Nay Gai Gai Bao Cong Yi Xiong Peng Ju Tao Ge Pu An Pao " + // U+5300
+		"Fu Gong Da Jiu Qiong Bi Hua Bei Nao Chi Fang Jiu Yi Za Jiang Kang " + // U+5310
+		"Jiang Kuang Hu Xia Qu Bian Gui Qie Zang Kuang Fei Hu Tou Gui Gui Hui " + // U+5320
+		"Dan Gui Lian Lian Suan Du Jiu Qu Xi Pi Qu Yi Qia Yan Bian Ni " + // U+5330
+		"Qu Shi Xin Qian Nian Sa Zu Sheng Wu Hui Ban Shi Xi Wan Hua Xie " + // U+5340
+		"Wan Bei Zu Zhuo Xie Dan Mai Nan Dan Ji Bo Shuai Bu Kuang Bian Bu " + // U+5350
+		"Zhan Qia Lu You Lu Xi Gua Wo Xie Jie Jie Wei Ang Qiong Zhi Mao " + // U+5360
+		"Yin Wei Shao Ji Que Luan Shi Juan Xie Xu Jin Que Wu Ji E Qing " + // U+5370
+		"Xi San Han Zhan E Ting Li Zhe Han Li Ya Ya Yan She Zhi Zha " + // U+5380
+		"Pang Ya He Ya Zhi Ce Pang Ti Li She Hou Ting Zui Cuo Fei Yuan " + // U+5390
+		"Ce Yuan Xiang Yan Li Jue Sha Dian Chu Jiu Qin Ao Gui Yan Si Li " + // U+53A0
+		"Chang Lan Li Yan Yan Yuan Si Gong Lin Qiu Qu Qu Uk Lei Du Xian " + // U+53B0
+		"Zhuan San Can Can Can Can Ai Dai You Cha Ji You Shuang Fan Shou Guai " + // U+53C0
+		"Ba Fa Ruo Shi Shu Zhuo Qu Shou Bian Xu Jia Pan Sou Gao Wei Sou " + // U+53D0
+		"Die Rui Cong Kou Gu Ju Ling Gua Tao Kou Zhi Jiao Zhao Ba Ding Ke " + // U+53E0
+		"Tai Chi Shi You Qiu Po Xie Hao Si Tan Chi Le Diao Ji Liao Hong " + // U+53F0
+		"Mie Xu Mang Chi Ge Xuan Yao Zi He Ji Diao Cun Tong Ming Hou Li " + // U+5400
+		"Tu Xiang Zha Xia Ye Lu A Ma Ou Xue Yi Jun Chou Lin Tun Yin " + // U+5410
+		"Fei Bi Qin Qin Jie Bu Fou Ba Dun Fen E Han Ting Hang Shun Qi " + // U+5420
+		"Hong Zhi Shen Wu Wu Chao Ne Xue Xi Chui Dou Wen Hou Ou Wu Gao " + // U+5430
+		"Ya Jun Lu E Ge Mei Ai Qi Cheng Wu Gao Fu Jiao Hong Chi Sheng " + // U+5440
+		"Ne Tun Fu Yi Dai Ou Li Bai Yuan Kuai Wen Qiang Wu E Shi Quan " + // U+5450
+		"Pen Wen Ni M Ling Ran You Di Zhou Shi Zhou Tie Xi Yi Qi Ping " + // U+5460
+		"Zi Gu Zi Wei Xu He Nao Xia Pei Yi Xiao Shen Hu Ming Da Qu " + // U+5470
+		"Ju Gem Za Tuo Duo Pou Pao Bi Fu Yang He Zha He Hai Jiu Yong " + // U+5480
+		"Fu Que Zhou Wa Ka Gu Ka Zuo Bu Long Dong Ning Tha Si Xian Huo " + // U+5490
+		"Qi Er E Guang Zha Xi Yi Lie Zi Mie Mi Zhi Yao Ji Zhou Ge " + // U+54A0
+		"Shuai Zan Xiao Ke Hui Kua Huai Tao Xian E Xuan Xiu Wai Yan Lao Yi " + // U+54B0
+		"Ai Pin Shen Tong Hong Xiong Chi Wa Ha Zai Yu Di Pai Xiang Ai Hen " + // U+54C0
+		"Kuang Ya Da Xiao Bi Yue Nian Hua Sasou Kuai Duo Fen Ji Nong Mou Yo " + // U+54D0
+		"Hao Yuan Long Pou Mang Ge E Chi Shao Li Na Zu He Ku Xiao Xian " + // U+54E0
+		"Lao Bo Zhe Zha Liang Ba Mie Le Sui Fou Bu Han Heng Geng Shuo Ge " + // U+54F0
+		"You Yan Gu Gu Bai Han Suo Chun Yi Ai Jia Tu Xian Huan Li Xi " + // U+5500
+		"Tang Zuo Qiu Che Wu Zao Ya Dou Qi Di Qin Ma Mal Hong Dou Kes " + // U+5510
+		"Lao Liang Suo Zao Huan Lang Sha Ji Zuo Wo Feng Yin Hu Qi Shou Wei " + // U+5520
+		"Shua Chang Er Li Qiang An Jie Yo Nian Yu Tian Lai Sha Xi Tuo Hu " + // U+5530
+		"Ai Zhou Nou Ken Zhuo Zhuo Shang Di Heng Lan A Xiao Xiang Tun Wu Wen " + // U+5540
+		"Cui Sha Hu Qi Qi Tao Dan Dan Ye Zi Bi Cui Chuo He Ya Qi " + // U+5550
+		"Zhe Pei Liang Xian Pi Sha La Ze Qing Gua Pa Zhe Se Zhuan Nie Guo " + // U+5560
+		"Luo Yan Di Quan Tan Bo Ding Lang Xiao Ju Tang Chi Ti An Jiu Dan " + // U+5570
+		"Ke Yong Wei Nan Shan Yu Zhe La Jie Hou Han Die Zhou Chai Wai Re " + // U+5580
+		"Yu Yin Zan Yao Wo Mian Hu Yun Chuan Hui Huan Huan Xi He Ji Kui " + // U+5590
+		"Zhong Wei Sha Xu Huang Du Nie Xuan Liang Yu Sang Chi Qiao Yan Dan Pen " + // U+55A0
+		"Can Li Yo Zha Wei Miao Ying Pen Phos Kui Xi Yu Jie Lou Ku Sao " + // U+55B0
+		"Huo Ti Yao He A Xiu Qiang Se Yong Su Hong Xie Yi Suo Ma Cha " + // U+55C0
+		"Hai Ke Ta Sang Tian Ru Sou Wa Ji Pang Wu Xian Shi Ge Zi Jie " + // U+55D0
+		"Luo Weng Wa Si Chi Hao Suo Jia Hai Suo Qin Nie He Cis Sai Ng " + // U+55E0
+		"Ge Na Dia Ai Qiang Tong Bi Ao Ao Lian Cui Zhe Mo Sou Sou Tan " + // U+55F0
+		"Di Qi Jiao Chong Jiao Kai Tan San Cao Jia Ai Xiao Piao Lou Ga Gu " + // U+5600
+		"Xiao Hu Hui Guo Ou Xian Ze Chang Xu Po De Ma Ma Hu Lei Du " + // U+5610
+		"Ga Tang Ye Beng Ying Saai Jiao Mi Xiao Hua Mai Ran Zuo Peng Lao Xiao " + // U+5620
+		"Ji Zhu Chao Kui Zui Xiao Si Hao Fu Liao Qiao Xi Xiu Tan Tan Mo " + // U+5630
+		"Xun E Zun Fan Chi Hui Zan Chuang Cu Dan Yu Tun Cheng Jiao Ye Xi " + // U+5640
+		"Qi Hao Lian Xu Deng Hui Yin Pu Jue Qin Xun Nie Lu Si Yan Ying " + // U+5650
+		"Da Dan Yu Zhou Jin Nong Yue Hui Qi E Zao Yi Shi Jiao Yuan Ai " + // U+5660
+		"Yong Jue Kuai Yu Pen Dao Ge Xin Dun Dang Sin Sai Pi Pi Yin Zui " + // U+5670
+		"Ning Di Lan Ta Huo Ru Hao Xia Ya Duo Xi Chou Ji Jin Hao Ti " + // U+5680
+		"Chang Xun Me Ca Ti Lu Hui Bo You Nie Yin Hu Mo Huang Zhe Li " + // U+5690
+		"Liu Haai Nang Xiao Mo Yan Li Lu Long Fu Dan Chen Pin Pi Xiang Huo " + // U+56A0
+		"Mo Xi Duo Ku Yan Chan Ying Rang Dian La Ta Xiao Jiao Chuo Huan Huo " + // U+56B0
+		"Zhuan Nie Xiao Ca Li Chan Chai Li Yi Luo Nang Zan Su Xi So Jian " + // U+56C0
+		"Za Zhu Lan Nie Nang Lan Lo Wei Hui Yin Qiu Si Nin Jian Hui Xin " + // U+56D0
+		"Yin Nan Tuan Tuan Dun Kang Yuan Jiong Pian Yun Cong Hu Hui Yuan You Guo " + // U+56E0
+		"Kun Cong Wei Tu Wei Lun Guo Qun Ri Ling Gu Guo Tai Guo Tu You " + // U+56F0
+		"Guo Yin Hun Pu Yu Han Yuan Lun Quan Yu Qing Guo Chuan Wei Yuan Quan " + // U+5700
+		"Ku Fu Yuan Yuan E Tu Tu Tu Tuan Lue Hui Yi Yuan Luan Luan Tu " + // U+5710
+		"Ya Tu Ting Sheng Pu Lu Iri Ya Zai Wei Ge Yu Wu Gui Pi Yi " + // U+5720
+		"Di Qian Qian Zhen Zhuo Dang Qia Akutsu Yama Kuang Chang Qi Nie Mo Ji Jia " + // U+5730
+		"Zhi Zhi Ban Xun Tou Qin Fen Jun Keng Tun Fang Fen Ben Tan Kan Pi " + // U+5740
+		"Zuo Keng Bi Xing Di Jing Ji Kuai Di Jing Jian Tan Li Ba Wu Fen " + // U+5750
+		"Zhui Po Pan Tang Kun Qu Tan Zhi Tuo Gan Ping Dian Gua Ni Tai Pi " + // U+5760
+		"Jiong Yang Fo Ao Liu Qiu Mu Ke Gou Xue Ba Chi Che Ling Zhu Fu " + // U+5770
+		"Hu Zhi Chui La Long Long Lu Ao Tay Pao Min Xing Dong Ji Ke Lu " + // U+5780
+		"Ci Chi Lei Gai Yin Hou Dui Zhao Fu Guang Yao Duo Duo Gui Cha Yang " + // U+5790
+		"Yin Fa Gou Yuan Die Xie Ken Jiong Shou E Ha Dian Hong Wu Kua Da " + // U+57A0
+		"Tao Dang Kai Gake Nao An Xing Xian Huan Bang Pei Ba Yi Yin Han Xu " + // U+57B0
+		"Chui Cen Geng Ai Peng Fang Que Yong Xun Jia Di Mai Lang Xuan Cheng Yan " + // U+57C0
+		"Jin Zhe Lei Lie Bu Cheng Gomi Bu Shi Xun Guo Jiong Ye Nian Di Yu " + // U+57D0
+		"Bu Ya Juan Sui Pi Cheng Wan Ju Lun Zheng Kong Chong Dong Dai Tan An " + // U+57E0
+		"Cai Shu Beng Kan Zhi Duo Yi Zhi Yi Pei Ji Zhun Qi Sao Ju Ni " + // U+57F0
+		"Ku Ke Tang Kun Ni Jian Dui Jin Gang Yu E Peng Gu Tu Leng Fang " + // U+5800
+		"Ya Qian Kun An Shen Duo Nao Tu Cheng Yin Hun Bi Lian Guo Die Zhuan " + // U+5810
+		"Hou Bao Bao Yu Di Mao Jie Ruan E Geng Kan Zong Yu Huang E Yao " + // U+5820
+		"Yan Bao Ji Mei Chang Du Tuo Yin Feng Zhong Jie Zhen Feng Gang Chuan Jian " + // U+5830
+		"Pyeng Toride Xiang Huang Leng Duan Wan Xuan Ji Ji Kuai Ying Ta Cheng Yong Kai " + // U+5840
+		"Su Su Shi Mi Ta Weng Cheng Tu Tang Que Zhong Li Peng Bang Sai Zang " + // U+5850
+		"Dui Tian Wu Cheng Xun Ge Zhen Ai Gong Yan Kan Tian Yuan Wen Xie Liu " + // U+5860
+		"Ama Lang Chang Peng Beng Chen Cu Lu Ou Qian Mei Mo Zhuan Shuang Shu Lou " + // U+5870
+		"Chi Man Biao Jing Qi Shu Di Zhang Kan Yong Dian Chen Zhi Xi Guo Qiang " + // U+5880
+		"Jin Di Shang Mu Cui Yan Ta Zeng Qi Qiang Liang Wei Zhui Qiao Zeng Xu " + // U+5890
+		"Shan Shan Ba Pu Kuai Dong Fan Que Mo Dun Dun Dun Di Sheng Duo Duo " + // U+58A0
+		"Tan Deng Wu Fen Huang Tan Da Ye Sho Mama Yu Qiang Ji Qiao Ken Yi " + // U+58B0
+		"Pi Bi Dian Jiang Ye Yong Bo Tan Lan Ju Huai Dang Rang Qian Xun Lan " + // U+58C0
+		"Xi He Ai Ya Dao Hao Ruan Mama Lei Kuang Lu Yan Tan Wei Huai Long " + // U+58D0
+		"Long Rui Li Lin Rang Ten Xun Yan Lei Ba Wan Shi Ren San Zhuang Zhuang " + // U+58E0
+		"Sheng Yi Mai Ke Zhu Zhuang Hu Hu Kun Yi Hu Xu Kun Shou Mang Zun " + // U+58F0
+		"Shou Yi Zhi Gu Chu Jiang Feng Bei Cay Bian Sui Qun Ling Fu Zuo Xia " + // U+5900
+		"Xiong Xie Nao Xia Kui Xi Wai Yuan Mao Su Duo Duo Ye Qing Uys Gou " + // U+5910
+		"Gou Qi Meng Meng Yin Huo Chen Da Ze Tian Tai Fu Guai Yao Yang Hang " + // U+5920
+		"Gao Shi Ben Tai Tou Yan Bi Yi Kua Jia Duo Kwu Kuang Yun Jia Pa " + // U+5930
+		"En Lian Huan Di Yan Pao Quan Qi Nai Feng Xie Fen Dian Quan Kui Zou " + // U+5940
+		"Huan Qi Kai Zha Ben Yi Jiang Tao Zang Ben Xi Xiang Fei Diao Xun Keng " + // U+5950
+		"Dian Ao She Weng Pan Ao Wu Ao Jiang Lian Duo Yun Jiang Shi Fen Huo " + // U+5960
+		"Bi Lian Duo Nu Nu Ding Nai Qian Jian Ta Jiu Nan Cha Hao Xian Fan " + // U+5970
+		"Ji Shuo Ru Fei Wang Hong Zhuang Fu Ma Dan Ren Fu Jing Yan Xie Wen " + // U+5980
+		"Zhong Pa Du Ji Keng Zhong Yao Jin Yun Miao Pei Shi Yue Zhuang Niu Yan " + // U+5990
+		"Na Xin Fen Bi Yu Tuo Feng Yuan Fang Wu Yu Gui Du Ba Ni Zhou " + // U+59A0
+		"Zhuo Zhao Da Nai Yuan Tou Xuan Zhi E Mei Mo Qi Bi Shen Qie E " + // U+59B0
+		"He Xu Fa Zheng Min Ban Mu Fu Ling Zi Zi Shi Ran Shan Yang Man " + // U+59C0
+		"Jie Gu Si Xing Wei Zi Ju Shan Pin Ren Yao Tong Jiang Shu Ji Gai " + // U+59D0
+		"Shang Kuo Juan Jiao Gou Mu Jian Jian Yi Nian Zhi Ji Ji Xian Heng Guang " + // U+59E0
+		"Jun Kua Yan Ming Lie Pei Yan You Yan Cha Shen Yin Chi Gui Quan Zi " + // U+59F0
+		"Song Wei Hong Wa Lou Ya Rao Jiao Luan Ping Xian Shao Li Cheng Xiao Mang " + // U+5A00
+		"Fu Suo Wu Wei Ke Lai Chuo Ding Niang Xing Nan Yu Nuo Pei Nei Juan " + // U+5A10
+		"Shen Zhi Han Di Zhuang E Pin Tui Han Mian Wu Yan Wu Xi Yan Yu " + // U+5A20
+		"Si Yu Wa Li Xian Ju Qu Shui Qi Xian Zhui Dong Chang Lu Ai E " + // U+5A30
+		"E Lou Mian Cong Pou Ju Po Cai Ding Wan Biao Xiao Shu Qi Hui Fu " + // U+5A40
+		"E Wo Tan Fei Wei Jie Tian Ni Quan Jing Hun Jing Qian Dian Xing Hu " + // U+5A50
+		"Wa Lai Bi Yin Chou Chuo Fu Jing Lun Yan Lan Kun Yin Ya Ju Li " + // U+5A60
+		"Dian Xian Hwa Hua Ying Chan Shen Ting Dang Yao Wu Nan Ruo Jia Tou Xu " + // U+5A70
+		"Yu Wei Ti Rou Mei Dan Ruan Qin Hui Wu Qian Chun Mao Fu Jie Duan " + // U+5A80
+		"Xi Zhong Mei Huang Mian An Ying Xuan Jie Wei Mei Yuan Zhen Qiu Ti Xie " + // U+5A90
+		"Tuo Lian Mao Ran Si Pian Wei Wa Jiu Hu Ao Jie Bou Xu Tou Gui " + // U+5AA0
+		"Zou Yao Pi Xi Yuan Ying Rong Ru Chi Liu Mei Pan Ao Ma Gou Kui " + // U+5AB0
+		"Qin Jia Sao Zhen Yuan Cha Yong Ming Ying Ji Su Niao Xian Tao Pang Lang " + // U+5AC0
+		"Nao Bao Ai Pi Pin Yi Piao Yu Lei Xuan Man Yi Zhang Kang Yong Ni " + // U+5AD0
+		"Li Di Gui Yan Jin Zhuan Chang Ce Han Nen Lao Mo Zhe Hu Hu Ao " + // U+5AE0
+		"Nen Qiang Ma Pie Gu Wu Jiao Tuo Zhan Mao Xian Xian Mo Liao Lian Hua " + // U+5AF0
+		"Gui Deng Zhi Xu Yi Hua Xi Hui Rao Xi Yan Chan Jiao Mei Fan Fan " + // U+5B00
+		"Xian Yi Wei Jiao Fu Shi Bi Shan Sui Qiang Lian Huan Xin Niao Dong Yi " + // U+5B10
+		"Can Ai Niang Neng Ma Tiao Chou Jin Ci Yu Pin Yong Xu Nai Yan Tai " + // U+5B20
+		"Ying Can Niao Wo Ying Mian Kaka Ma Shen Xing Ni Du Liu Yuan Lan Yan " + // U+5B30
+		"Shuang Ling Jiao Niang Lan Xian Ying Shuang Shuai Quan Mi Li Luan Yan Zhu Lan " + // U+5B40
+		"Zi Jie Jue Jue Kong Yun Zi Zi Cun Sun Fu Bei Zi Xiao Xin Meng " + // U+5B50
+		"Si Tai Bao Ji Gu Nu Xue You Zhuan Hai Luan Sun Huai Mie Cong Qian " + // U+5B60
+		"Shu Chan Ya Zi Ni Fu Zi Li Xue Bo Ru Lai Nie Nie Ying Luan " + // U+5B70
+		"Mian Zhu Rong Ta Gui Zhai Qiong Yu Shou An Tu Song Wan Rou Yao Hong " + // U+5B80
+		"Yi Jing Zhun Mi Zhu Dang Hong Zong Guan Zhou Ding Wan Yi Bao Shi Shi " + // U+5B90
+		"Chong Shen Ke Xuan Shi You Huan Yi Tiao Shi Xian Gong Cheng Qun Gong Xiao " + // U+5BA0
+		"Zai Zha Bao Hai Yan Xiao Jia Shen Chen Rong Huang Mi Kou Kuan Bin Su " + // U+5BB0
+		"Cai Zan Ji Yuan Ji Yin Mi Kou Qing Que Zhen Jian Fu Ning Bing Huan " + // U+5BC0
+		"Mei Qin Han Yu Shi Ning Qin Ning Zhi Yu Bao Kuan Ning Qin Mo Cha " + // U+5BD0
+		"Ju Gua Qin Hu Wu Liao Shi Zhu Zhai Shen Wei Xie Kuan Hui Liao Jun " + // U+5BE0
+		"Huan Yi Yi Bao Qin Chong Bao Feng Cun Dui Si Xun Dao Lu Dui Shou " + // U+5BF0
+		"Po Feng Zhuan Fu She Ke Jiang Jiang Zhuan Wei Zun Xun Shu Dui Dao Xiao " + // U+5C00
+		"Ji Shao Er Er Er Ga Jian Shu Chen Shang Shang Mo Ga Chang Liao Xian " + // U+5C10
+		"Xian Kun Wang Wang You Liao Liao Yao Mang Wang Wang Wang Ga Yao Duo Kui " + // U+5C20
+		"Zhong Jiu Gan Gu Gan Tui Gan Gan Shi Yin Chi Kao Ni Jin Wei Niao " + // U+5C30
+		"Ju Pi Ceng Xi Bi Ju Jie Tian Qu Ti Jie Wu Diao Shi Shi Ping " + // U+5C40
+		"Ji Xie Chen Xi Ni Zhan Xi Wei Man E Lou Ping Ti Fei Shu Xie " + // U+5C50
+		"Tu Lu Lu Xi Ceng Lu Ju Xie Ju Jue Liao Jue Shu Xi Che Tun " + // U+5C60
+		"Ni Shan Wa Xian Li Xue Nata Hui Long Yi Qi Ren Wu Han Shen Yu " + // U+5C70
+		"Chu Sui Qi Ren Yue Ban Yao Ang Ya Wu Jie E Ji Qian Fen Yuan " + // U+5C80
+		"Qi Cen Qian Qi Cha Jie Qu Gang Xian Ao Lan Dao Ba Zuo Zuo Yang " + // U+5C90
+		"Ju Gang Ke Gou Xue Bei Li Tiao Ju Yan Fu Xiu Jia Ling Tuo Pei " + // U+5CA0
+		"You Dai Kuang Yue Qu Hu Po Min An Tiao Ling Chi Yuri Dong Cem Kui " + // U+5CB0
+		"Xiu Mao Tong Xue Yi Kura He Ke Luo E Fu Xun Die Lu An Er " + // U+5CC0
+		"Gai Quan Tong Yi Mu Shi An Wei Hu Zhi Mi Li Ji Tong Wei You " + // U+5CD0
+		"Sang Xia Li Yao Jiao Zheng Luan Jiao E E Yu Ye Bu Qiao Qun Feng " + // U+5CE0
+		"Feng Nao Li You Xian Hong Dao Shen Cheng Tu Geng Jun Hao Xia Yin Yu " + // U+5CF0
+		"Lang Kan Lao Lai Xian Que Kong Chong Chong Ta Lin Hua Ju Lai Qi Min " + // U+5D00
+		"Kun Kun Zu Gu Cui Ya Ya Gang Lun Lun Leng Jue Duo Zheng Guo Yin " + // U+5D10
+		"Dong Han Zheng Wei Yao Pi Yan Song Jie Beng Zu Jue Dong Zhan Gu Yin " + // U+5D20
+		"Zi Ze Huang Yu Wei Yang Feng Qiu Dun Ti Yi Zhi Shi Zai Yao E " + // U+5D30
+		"Zhu Kan Lu Yan Mei Gan Ji Ji Huan Ting Sheng Mei Qian Wu Yu Zong " + // U+5D40
+		"Lan Jue Yan Yan Wei Zong Cha Sui Rong Yamashina Qin Yu Kewashii Lou Tu Dui " + // U+5D50
+		"Xi Weng Cang Dang Hong Jie Ai Liu Wu Song Qiao Zi Wei Beng Dian Cuo " + // U+5D60
+		"Qian Yong Nie Cuo Ji Shi Tao Song Zong Jiang Liao Kang Chan Die Cen Ding " + // U+5D70
+		"Tu Lou Zhang Zhan Zhan Ao Cao Qu Qiang Zui Zui Dao Dao Xi Yu Bo " + // U+5D80
+		"Long Xiang Ceng Bo Qin Jiao Yan Lao Zhan Lin Liao Liao Jin Deng Duo Zun " + // U+5D90
+		"Jiao Gui Yao Qiao Yao Jue Zhan Yi Xue Nao Ye Ye Yi E Xian Ji " + // U+5DA0
+		"Xie Ke Xi Di Ao Zui Wei Ni Rong Dao Ling Za Yu Yue Yin Ru " + // U+5DB0
+		"Jie Li Sui Long Long Dian Ying Xi Ju Chan Ying Kui Yan Wei Nao Quan " + // U+5DC0
+		"Chao Cuan Luan Dian Dian Nie Yan Yan Yan Nao Yan Chuan Gui Chuan Zhou Huang " + // U+5DD0
+		"Jing Xun Chao Chao Lie Gong Zuo Qiao Ju Gong Kek Wu Pwu Pwu Chai Qiu " + // U+5DE0
+		"Qiu Ji Yi Si Ba Zhi Zhao Xiang Yi Jin Xun Juan Phas Xun Jin Fu " + // U+5DF0
+		"Za Bi Shi Bu Ding Shuai Fan Nie Shi Fen Pa Zhi Xi Hu Dan Wei " + // U+5E00
+		"Zhang Tang Dai Ma Pei Pa Tie Fu Lian Zhi Zhou Bo Zhi Di Mo Yi " + // U+5E10
+		"Yi Ping Qia Juan Ru Shuai Dai Zheng Shui Qiao Zhen Shi Qun Xi Bang Dai " + // U+5E20
+		"Gui Chou Ping Zhang Sha Wan Dai Wei Chang Sha Qi Ze Guo Mao Du Hou " + // U+5E30
+		"Zheng Xu Mi Wei Wo Fu Yi Bang Ping Tazuna Gong Pan Huang Dao Mi Jia " + // U+5E40
+		"Teng Hui Zhong Shan Man Mu Biao Guo Ze Mu Bang Zhang Jiong Chan Fu Zhi " + // U+5E50
+		"Hu Fan Chuang Bi Hei Zhang Mi Qiao Chan Fen Meng Bang Chou Mie Chu Jie " + // U+5E60
+		"Xian Lan Gan Ping Nian Qian Bing Bing Xing Gan Yao Huan You You Ji Guang " + // U+5E70
+		"Pi Ting Ze Guang Zhuang Mo Qing Bi Qin Dun Chuang Gui Ya Bai Jie Xu " + // U+5E80
+		"Lu Wu Zhuang Ku Ying Di Pao Dian Ya Miao Geng Ci Fu Tong Pang Fei " + // U+5E90
+		"Xiang Yi Zhi Tiao Zhi Xiu Du Zuo Xiao Tu Gui Ku Pang Ting You Bu " + // U+5EA0
+		"Ding Cheng Lai Bei Ji An Shu Kang Yong Tuo Song Shu Qing Yu Yu Miao " + // U+5EB0
+		"Sou Ce Xiang Fei Jiu He Hui Liu Sha Lian Lang Sou Jian Pou Qing Jiu " + // U+5EC0
+		"Jiu Qin Ao Kuo Lou Yin Liao Dai Lu Yi Chu Chan Tu Si Xin Miao " + // U+5ED0
+		"Chang Wu Fei Guang Koc Kuai Bi Qiang Xie Lin Lin Liao Lu Ji Ying Xian " + // U+5EE0
+		"Ting Yong Li Ting Yin Xun Yan Ting Di Po Jian Hui Nai Hui Gong Nian " + // U+5EF0
+		"Kai Bian Yi Qi Nong Fen Ju Yan Yi Zang Bi Yi Yi Er San Shi " + // U+5F00
+		"Er Shi Shi Gong Diao Yin Hu Fu Hong Wu Tui Chi Jiang Ba Shen Di " + // U+5F10
+		"Zhang Jue Tao Fu Di Mi Xian Hu Chao Nu Jing Zhen Yi Mi Quan Wan " + // U+5F20
+		"Shao Ruo Xuan Jing Dun Zhang Jiang Qiang Peng Dan Qiang Bi Bi She Dan Jian " + // U+5F30
+		"Gou Sei Fa Bi Kou Nagi Bie Xiao Dan Kuo Qiang Hong Mi Kuo Wan Jue " + // U+5F40
+		"Ji Ji Gui Dang Lu Lu Tuan Hui Zhi Hui Hui Yi Yi Yi Yi Huo " + // U+5F50
+		"Huo Shan Xing Wen Tong Yan Yan Yu Chi Cai Biao Diao Bin Peng Yong Piao " + // U+5F60
+		"Zhang Ying Chi Chi Zhuo Tuo Ji Pang Zhong Yi Wang Che Bi Chi Ling Fu " + // U+5F70
+		"Wang Zheng Cu Wang Jing Dai Xi Xun Hen Yang Huai Lu Hou Wa Cheng Zhi " + // U+5F80
+		"Xu Jing Tu Cong Zhi Lai Cong De Pai Xi Dong Qi Chang Zhi Cong Zhou " + // U+5F90
+		"Lai Yu Xie Jie Jian Chi Jia Bian Huang Fu Xun Wei Pang Yao Wei Xi " + // U+5FA0
+		"Zheng Piao Chi De Zheng Zheng Bie De Chong Che Jiao Wei Jiao Hui Mei Long " + // U+5FB0
+		"Xiang Bao Qu Xin Shu Bi Yi Le Ren Dao Ding Gai Ji Ren Ren Chan " + // U+5FC0
+		"Tan Te Te Gan Qi Shi Cun Zhi Wang Mang Xi Fan Ying Tian Min Min " + // U+5FD0
+		"Zhong Chong Wu Ji Wu Xi Ye You Wan Cong Zhong Kuai Yu Bian Zhi Qi " + // U+5FE0
+		"Cui Chen Tai Tun Qian Nian Hun Xiong Niu Wang Xian Xin Kang Hu Kai Fen " + // U+5FF0
+		"Huai Tai Song Wu Ou Chang Chuang Ju Yi Bao Chao Min Pei Zuo Zen Yang " + // U+6000
+		"Kou Ban Nu Nao Zheng Pa Bu Tie Gu Hu Ju Da Lian Si Chou Di " + // U+6010
+		"Dai Yi Tu You Fu Ji Peng Xing Yuan Ni Guai Fu Xi Bi You Qie " + // U+6020
+		"Xuan Cong Bing Huang Xu Chu Pi Xi Xi Tan Koraeru Zong Dui Mo Ki Yi " + // U+6030
+		"Chi Ren Xun Shi Xi Lao Heng Kuang Mu Zhi Xie Lian Tiao Huang Die Hao " + // U+6040
+		"Kong Gui Heng Xi Xiao Shu S Kua Qiu Yang Hui Hui Chi Jia Yi Xiong " + // U+6050
+		"Guai Lin Hui Zi Xu Chi Xiang Nu Hen En Ke Tong Tian Gong Quan Xi " + // U+6060
+		"Qia Yue Peng Ken De Hui E Kyuu Tong Yan Kai Ce Nao Yun Mang Yong " + // U+6070
+		"Yong Yuan Pi Kun Qiao Yue Yu Yu Jie Xi Zhe Lin Ti Han Hao Qie " + // U+6080
+		"Ti Bu Yi Qian Hui Xi Bei Man Yi Heng Song Quan Cheng Hui Wu Wu " + // U+6090
+		"You Li Liang Huan Cong Yi Yue Li Nin Nao E Que Xuan Qian Wu Min " + // U+60A0
+		"Cong Fei Bei Duo Cui Chang Men Li Ji Guan Guan Xing Dao Qi Kong Tian " + // U+60B0
+		"Lun Xi Kan Kun Ni Qing Chou Dun Guo Chan Jing Wan Yuan Jin Ji Lin " + // U+60C0
+		"Yu Huo He Quan Tan Ti Ti Nie Wang Chuo Bu Hun Xi Tang Xin Wei " + // U+60D0
+		"Hui E Rui Zong Jian Yong Dian Ju Can Cheng De Bei Qie Can Dan Guan " + // U+60E0
+		"Duo Nao Yun Xiang Zhui Die Huang Chun Qiong Re Xing Ce Bian Hun Zong Ti " + // U+60F0
+		"Qiao Chou Bei Xuan Wei Ge Qian Wei Yu Yu Bi Xuan Huan Min Bi Yi " + // U+6100
+		"Mian Yong Kai Dang Yin E Chen Mou Ke Ke Yu Ai Qie Yan Nuo Gan " + // U+6110
+		"Yun Zong Sai Leng Fen Ying Kui Kui Que Gong Yun Su Su Qi Yao Song " + // U+6120
+		"Huang Ji Gu Ju Chuang Ni Xie Kai Zheng Yong Cao Sun Shen Bo Kai Yuan " + // U+6130
+		"Xie Hun Yong Yang Li Sao Tao Yin Ci Xu Qian Tai Huang Yun Shen Ming " + // U+6140
+		"Gong She Cong Piao Mo Mu Guo Chi Can Can Can Cui Min Te Zhang Tong " + // U+6150
+		"Ao Shuang Man Guan Que Zao Jiu Hui Kai Lian Ou Song Jin Yin Lu Shang " + // U+6160
+		"Wei Tuan Man Qian She Yong Qing Kang Di Zhi Lou Juan Qi Qi Yu Ping " + // U+6170
+		"Liao Cong You Chong Zhi Tong Cheng Qi Qu Peng Bei Bie Chun Jiao Zeng Chi " + // U+6180
+		"Lian Ping Kui Hui Qiao Cheng Yin Yin Xi Xi Dan Tan Duo Dui Dui Su " + // U+6190
+		"Jue Ce Xiao Fan Fen Lao Lao Chong Han Qi Xian Min Jing Liao Wu Can " + // U+61A0
+		"Jue Cu Xian Tan Sheng Pi Yi Chu Xian Nao Dan Tan Jing Song Han Jiao " + // U+61B0
+		"Wai Huan Dong Qin Qin Qu Cao Ken Xie Ying Ao Mao Yi Lin Se Jun " + // U+61C0
+		"Huai Men Lan Ai Lin Yan Gua Xia Chi Yu Yin Dai Meng Ai Meng Dui " + // U+61D0
+		"Qi Mo Lan Men Chou Zhi Nuo Nuo Yan Yang Bo Zhi Kuang Kuang You Fu " + // U+61E0
+		"Liu Mie Cheng Hui Chan Meng Lan Huai Xuan Rang Chan Ji Ju Huan She Yi " + // U+61F0
+		"Lian Nan Mi Tang Jue Gang Gang Gang Ge Yue Wu Jian Xu Shu Rong Xi " + // U+6200
+		"Cheng Wo Jie Ge Jian Qiang Huo Qiang Zhan Dong Qi Jia Die Zei Jia Ji " + // U+6210
+		"Shi Kan Ji Kui Gai Deng Zhan Chuang Ge Jian Jie Yu Jian Yan Lu Xi " + // U+6220
+		"Zhan Xi Xi Chuo Dai Qu Hu Hu Hu E Shi Li Mao Hu Li Fang " + // U+6230
+		"Suo Bian Dian Jiong Shang Yi Yi Shan Hu Fei Yan Shou T Cai Zha Qiu " + // U+6240
+		"Le Bu Ba Da Reng Fu Hameru Zai Tuo Zhang Diao Kang Yu Ku Han Shen " + // U+6250
+		"Cha Yi Gu Kou Wu Tuo Qian Zhi Ren Kuo Men Sao Yang Niu Ban Che " + // U+6260
+		"Rao Xi Qian Ban Jia Yu Fu Ao Xi Pi Zhi Zi E Dun Zhao Cheng " + // U+6270
+		"Ji Yan Kuang Bian Chao Ju Wen Hu Yue Jue Ba Qin Zhen Zheng Yun Wan " + // U+6280
+		"Nu Yi Shu Zhua Pou Tou Dou Kang Zhe Pou Fu Pao Ba Ao Ze Tuan " + // U+6290
+		"Kou Lun Qiang Yun Hu Bao Bing Zhi Peng Tan Pu Pi Tai Yao Zhen Zha " + // U+62A0
+		"Yang Bao He Ni Yi Di Chi Pi Za Mo Mo Shen Ya Chou Qu Min " + // U+62B0
+		"Chu Jia Fu Zhan Zhu Dan Chai Mu Nian La Fu Pao Ban Pai Ling Na " + // U+62C0
+		"Guai Qian Ju Tuo Ba Tuo Tuo Ao Ju Zhuo Pan Zhao Bai Bai Di Ni " + // U+62D0
+		"Ju Kuo Long Jian Qia Yong Lan Ning Bo Ze Qian Hen Gua Shi Jie Zheng " + // U+62E0
+		"Nin Gong Gong Quan Shuan Cun Zan Kao Chi Xie Ce Hui Pin Zhuai Shi Na " + // U+62F0
+		"Bo Chi Gua Zhi Kuo Duo Duo Zhi Qie An Nong Zhen Ge Jiao Ku Dong " + // U+6300
+		"Ru Tiao Lie Zha Lu Die Wa Jue Mushiru Ju Zhi Luan Ya Zhua Ta Xie " + // U+6310
+		"Nao Dang Jiao Zheng Ji Hui Xun Ku Ai Tuo Nuo Cuo Bo Geng Ti Zhen " + // U+6320
+		"Cheng Suo Suo Keng Mei Long Ju Peng Jian Yi Ting Shan Nuo Wan Xie Cha " + // U+6330
+		"Feng Jiao Wu Jun Jiu Tong Kun Huo Tu Zhuo Pou Le Ba Han Shao Nie " + // U+6340
+		"Juan Ze Song Ye Jue Bu Huan Bu Zun Yi Zhai Lu Sou Tuo Lao Sun " + // U+6350
+		"Bang Jian Huan Dao Wei Wan Qin Peng She Lie Min Men Fu Bai Ju Dao " + // U+6360
+		"Wo Ai Juan Yue Zong Chen Chui Jie Tu Ben Na Nian Nuo Zu Wo Xi " + // U+6370
+		"Xian Cheng Dian Sao Lun Qing Gang Duo Shou Diao Pou Di Zhang Gun Ji Tao " + // U+6380
+		"Qia Qi Pai Shu Qian Ling Yi Ya Jue Zheng Liang Gua Yi Huo Shan Zheng " + // U+6390
+		"Lue Cai Tan Che Bing Jie Ti Kong Tui Yan Cuo Zou Ju Tian Qian Ken " + // U+63A0
+		"Bai Shou Jie Lu Guo Haba Jie Zhi Dan Mang Xian Sao Guan Peng Yuan Nuo " + // U+63B0
+		"Jian Zhen Jiu Jian Yu Yan Kui Nan Hong Rou Pi Wei Sai Zou Xuan Miao " + // U+63C0
+		"Ti Nie Cha Shi Zong Zhen Yi Shun Heng Bian Yang Huan Yan Zuan An Xu " + // U+63D0
+		"Ya Wo Ke Chuai Ji Ti La La Cheng Kai Jiu Jiu Tu Jie Hui Geng " + // U+63E0
+		"Chong Shuo She Xie Yuan Qian Ye Cha Zha Bei Yao Wei Beng Lan Wen Qin " + // U+63F0
+		"Chan Ge Lou Zong Geng Jiao Gou Qin Yong Que Chou Chi Zhan Sun Sun Bo " + // U+6400
+		"Chu Rong Beng Cuo Sao Ke Yao Dao Zhi Nu Xie Jian Sou Qiu Gao Xian " + // U+6410
+		"Shuo Sang Jin Mie E Chui Nuo Shan Ta Jie Tang Pan Ban Da Li Tao " + // U+6420
+		"Hu Zhi Wa Xia Qian Wen Qiang Tian Zhen E Xi Nuo Quan Cha Zha Ge " + // U+6430
+		"Wu En She Kang She Shu Bai Yao Bin Sou Tan Sa Chan Suo Liao Chong " + // U+6440
+		"Chuang Guo Bing Feng Shuai Di Qi Sou Zhai Lian Tang Chi Guan Lu Luo Lou " + // U+6450
+		"Zong Gai Hu Zha Chuang Tang Hua Cui Nai Mo Jiang Gui Ying Zhi Ao Zhi " + // U+6460
+		"Nie Man Shan Kou Shu Suo Tuan Jiao Mo Mo Zhe Xian Keng Piao Jiang Yin " + // U+6470
+		"Gou Qian Lue Ji Ying Jue Pie Pie Lao Dun Xian Ruan Kui Zan Yi Xun " + // U+6480
+		"Cheng Cheng Sa Nao Heng Si Qian Huang Da Zun Nian Lin Zheng Hui Zhuang Jiao " + // U+6490
+		"Ji Cao Dan Dan Che Bo Che Jue Xiao Liao Ben Fu Qiao Bo Cuo Zhuo " + // U+64A0
+		"Zhuan Tuo Pu Qin Dun Nian Hua Xie Lu Jiao Cuan Ta Han Qiao Zhua Jian " + // U+64B0
+		"Gan Yong Lei Kuo Lu Shan Zhuo Ze Pu Chuo Ji Dang Suo Cao Qing Jing " + // U+64C0
+		"Huan Jie Qin Kuai Dan Xi Ge Pi Bo Ao Ju Ye E Mang Sou Mi " + // U+64D0
+		"Ji Tai Zhuo Dao Xing Lan Ca Ju Ye Ru Ye Ye Ni Hu Ji Bin " + // U+64E0
+		"Ning Ge Zhi Jie Kuo Mo Jian Xie Lie Tan Bai Sou Lu Lue Rao Zhi " + // U+64F0
+		"Pan Yang Lei Sa Shu Zan Nian Xian Jun Huo Li La Han Ying Lu Long " + // U+6500
+		"Qian Qian Zan Qian Lan San Ying Mei Rang Chan Weng Cuan Xi She Luo Jun " + // U+6510
+		"Mi Li Zan Luan Tan Zuan Li Dian Wa Dang Jiao Jue Lan Li Nang Zhi " + // U+6520
+		"Gui Gui Qi Xin Pu Sui Shou Kao You Gai Yi Gong Gan Ban Fang Zheng " + // U+6530
+		"Bo Dian Kou Min Wu Gu He Ce Xiao Mi Chu Ge Di Xu Jiao Min " + // U+6540
+		"Chen Jiu Zhen Duo Yu Chi Ao Bai Xu Jiao Duo Lian Nie Bi Chang Dian " + // U+6550
+		"Duo Yi Gan San Ke Yan Dun Qi Dou Xiao Duo Jiao Jing Yang Xia Min " + // U+6560
+		"Shu Ai Qiao Ai Zheng Di Zhen Fu Shu Liao Qu Xiong Xi Jiao Sen Jiao " + // U+6570
+		"Zhuo Yi Lian Bi Li Xiao Xiao Wen Xue Qi Qi Zhai Bin Jue Zhai Lang " + // U+6580
+		"Fei Ban Ban Lan Yu Lan Wei Dou Sheng Liao Jia Hu Xie Jia Yu Zhen " + // U+6590
+		"Jiao Wo Tou Chu Jin Chi Yin Fu Qiang Zhan Qu Zhuo Zhan Duan Zhuo Si " + // U+65A0
+		"Xin Zhuo Zhuo Qin Lin Zhuo Chu Duan Zhu Fang Xie Hang Yu Shi Pei You " + // U+65B0
+		"Mye Pang Qi Zhan Mao Lu Pei Pi Liu Fu Fang Xuan Jing Jing Ni Zu " + // U+65C0
+		"Zhao Yi Liu Shao Jian Es Yi Qi Zhi Fan Piao Fan Zhan Guai Sui Yu " + // U+65D0
+		"Wu Ji Ji Ji Huo Ri Dan Jiu Zhi Zao Xie Tiao Xun Xu Xu Xu " + // U+65E0
+		"Gan Han Tai Di Xu Chan Shi Kuang Yang Shi Wang Min Min Tun Chun Wu " + // U+65F0
+		"Yun Bei Ang Ze Ban Jie Kun Sheng Hu Fang Hao Gui Chang Xuan Ming Hun " + // U+6600
+		"Fen Qin Hu Yi Xi Xin Yan Ze Fang Tan Shen Ju Yang Zan Bing Xing " + // U+6610
+		"Ying Xuan Pei Zhen Ling Chun Hao Mei Zuo Mo Bian Xu Hun Zhao Zong Shi " + // U+6620
+		"Shi Yu Fei Die Mao Ni Chang Wen Dong Ai Bing Ang Zhou Long Xian Kuang " + // U+6630
+		"Tiao Chao Shi Huang Huang Xuan Kui Xu Jiao Jin Zhi Jin Shang Tong Hong Yan " + // U+6640
+		"Gai Xiang Shai Xiao Ye Yun Hui Han Han Jun Wan Xian Kun Zhou Xi Cheng " + // U+6650
+		"Sheng Bu Zhe Zhe Wu Han Hui Hao Chen Wan Tian Zhuo Zui Zhou Pu Jing " + // U+6660
+		"Xi Shan Yi Xi Qing Qi Jing Gui Zhen Yi Zhi An Wan Lin Liang Chang " + // U+6670
+		"Wang Xiao Zan Hi Xuan Xuan Yi Xia Yun Hui Fu Min Kui He Ying Du " + // U+6680
+		"Wei Shu Qing Mao Nan Jian Nuan An Yang Chun Yao Suo Jin Ming Jiao Kai " + // U+6690
+		"Gao Weng Chang Qi Hao Yan Li Ai Ji Gui Men Zan Xie Hao Mu Mo " + // U+66A0
+		"Cong Ni Zhang Hui Bao Han Xuan Chuan Liao Xian Dan Jing Pie Lin Tun Xi " + // U+66B0
+		"Yi Ji Huang Tai Ye Ye Li Tan Tong Xiao Fei Qin Zhao Hao Yi Xiang " + // U+66C0
+		"Xing Sen Jiao Bao Jing Yian Ai Ye Ru Shu Meng Xun Yao Pu Li Chen " + // U+66D0
+		"Kuang Die Liao Yan Huo Lu Xi Rong Long Nang Luo Luan Shai Tang Yan Chu " + // U+66E0
+		"Yue Yue Qu Yi Geng Ye Hu He Shu Cao Cao Noboru Man Ceng Ceng Ti " + // U+66F0
+		"Zui Can Xu Hui Yin Qie Fen Pi Yue You Ruan Peng Ban Fu Ling Fei " + // U+6700
+		"Qu Ti Nu Tiao Shuo Zhen Lang Lang Juan Ming Huang Wang Tun Zhao Ji Qi " + // U+6710
+		"Ying Zong Wang Tong Lang Lao Meng Long Mu Deng Wei Mo Ben Zha Zhu Zhu " + // U+6720
+		"Mu Zhu Ren Ba Po Duo Duo Dao Li Qiu Ji Jiu Bi Xiu Ting Ci " + // U+6730
+		"Sha Eburi Za Quan Qian Yu Gan Wu Cha Shan Xun Fan Wu Zi Li Xing " + // U+6740
+		"Cai Cun Ren Shao Tuo Di Zhang Mang Chi Yi Gu Gong Du Yi Qi Shu " + // U+6750
+		"Gang Tiao Moku Soma Tochi Lai Sugi Mang Yang Ma Miao Si Yuan Hang Fei Bei " + // U+6760
+		"Jie Dong Gao Yao Xian Chu Qun Pa Shu Hua Xin Chou Zhu Chou Song Ban " + // U+6770
+		"Song Ji Yue Jin Gou Ji Mao Pi Bi Wang Ang Fang Fen Yi Fu Nan " + // U+6780
+		"Xi Hu Ya Dou Xun Zhen Yao Lin Rui E Mei Zhao Guo Zhi Cong Yun " + // U+6790
+		"Waku Dou Shu Zao Di Li Haze Jian Cheng Matsu Qiang Feng Nan Xiao Xian Ku " + // U+67A0
+		"Ping Yi Xi Zhi Guai Xiao Jia Jia Gou Fu Mo Yi Ye Ye Shi Nie " + // U+67B0
+		"Bi Duo Yi Ling Bing Ni La He Pan Fan Zhong Dai Ci Yang Fu Bo " + // U+67C0
+		"Mou Gan Qi Ran Rou Mao Zhao Song Zhe Xia You Shen Ju Tuo Zuo Nan " + // U+67D0
+		"Ning Yong Di Zhi Zha Cha Dan Gu Pu Jiu Ao Fu Jian Bo Duo Ke " + // U+67E0
+		"Nai Zhu Bi Liu Chai Zha Si Zhu Pei Shi Guai Cha Yao Jue Jiu Shi " + // U+67F0
+		"Zhi Liu Mei Hoy Rong Zha Zao Biao Zhan Jie Long Dong Lu Sayng Li Lan " + // U+6800
+		"Yong Shu Xun Shuan Qi Zhen Qi Li Yi Xiang Zhen Li Su Gua Kan Bing " + // U+6810
+		"Ren Xiao Bo Ren Bing Zi Chou Yi Jie Xu Zhu Jian Zui Er Er You " + // U+6820
+		"Fa Gong Kao Lao Zhan Li Yin Yang He Gen Zhi Chi Ge Zai Luan Fu " + // U+6830
+		"Jie Hang Gui Tao Guang Wei Kuang Ru An An Juan Yi Zhuo Ku Zhi Qiong " + // U+6840
+		"Tong Sang Sang Huan Jie Jiu Xue Duo Zhui Yu Zan Kasei Ying Masu Liu Zhan " + // U+6850
+		"Ya Nao Zhen Dang Qi Qiao Hua Kuai Jiang Zhuang Xun Suo Sha Zhen Bei Ting " + // U+6860
+		"Gua Jing Bo Ben Fu Rui Tong Jue Xi Lang Liu Feng Qi Wen Jun Gan " + // U+6870
+		"Cu Liang Qiu Ting You Mei Bang Long Peng Zhuang Di Xuan Tu Zao Ao Gu " + // U+6880
+		"Bi Di Han Zi Zhi Ren Bei Geng Jian Huan Wan Nuo Jia Tiao Ji Xiao " + // U+6890
+		"Lu Huan Shao Cen Fen Song Meng Wu Li Li Dou Cen Ying Suo Ju Ti " + // U+68A0
+		"Jie Kun Zhuo Shu Chan Fan Wei Jing Li Bing Fumoto Shikimi Tao Zhi Lai Lian " + // U+68B0
+		"Jian Zhuo Ling Li Qi Bing Zhun Cong Qian Mian Qi Qi Cai Gun Chan Te " + // U+68C0
+		"Fei Pai Bang Pou Hun Zong Cheng Zao Ji Li Peng Yu Yu Gu Hun Dong " + // U+68D0
+		"Tang Gang Wang Di Xi Fan Cheng Zhan Qi Yuan Yan Yu Quan Yi Sen Ren " + // U+68E0
+		"Chui Leng Qi Zhuo Fu Ke Lai Zou Zou Zhuo Guan Fen Fen Chen Qiong Nie " + // U+68F0
+		"Wan Guo Lu Hao Jie Yi Chou Ju Ju Cheng Zuo Liang Qiang Zhi Zhui Ya " + // U+6900
+		"Ju Bei Jiao Zhuo Zi Bin Peng Ding Chu Chang Kunugi Momiji Jian Gui Xi Du " + // U+6910
+		"Qian Kunugi Soko Shide Luo Zhi Ken Myeng Tafu Geng Peng Zhan Yi Tuo Sen Duo " + // U+6920
+		"Ye Fou Wei Wei Duan Jia Zong Jian Yi Shen Xi Yan Yan Chuan Zhan Chun " + // U+6930
+		"Yu He Zha Wo Pian Bi Yao Huo Xu Ruo Yang La Yan Ben Hun Kui " + // U+6940
+		"Jie Kui Si Feng Xie Tuo Zhi Jian Mu Mao Chu Hu Hu Lian Leng Ting " + // U+6950
+		"Nan Yu You Mei Song Xuan Xuan Ying Zhen Pian Ye Ji Jie Ye Chu Shun " + // U+6960
+		"Yu Cou Wei Mei Di Ji Jie Kai Qiu Ying Rou Heng Lou Le Hazou Katsura " + // U+6970
+		"Pin Muro Gai Tan Lan Yun Yu Chen Lu Ju Sakaki Chu Pi Xie Jia Yi " + // U+6980
+		"Zhan Fu Nai Mi Lang Rong Gu Jian Ju Ta Yao Zhen Bang Sha Yuan Zi " + // U+6990
+		"Ming Su Jia Yao Jie Huang Gan Fei Zha Qian Ma Sun Yuan Xie Rong Shi " + // U+69A0
+		"Zhi Cui Yun Ting Liu Rong Tang Que Zhai Si Sheng Ta Ke Xi Gu Qi " + // U+69B0
+		"Kao Gao Sun Pan Tao Ge Xun Dian Nou Ji Shuo Gou Chui Qiang Cha Qian " + // U+69C0
+		"Huai Mei Xu Gang Gao Zhuo Tuo Hashi Yang Dian Jia Jian Zui Kashi Ori Bin " + // U+69D0
+		"Zhu Sang Xi Qi Lian Hui Yong Qian Guo Gai Gai Tuan Hua Cu Sen Cui " + // U+69E0
+		"Beng You Hu Jiang Hu Huan Kui Yi Nie Gao Kang Gui Gui Cao Man Jin " + // U+69F0
+		"Di Zhuang Le Lang Chen Cong Li Xiu Qing Shuang Fan Tong Guan Ji Suo Lei " + // U+6A00
+		"Lu Liang Mi Lou Chao Su Ke Shu Tang Biao Lu Jiu Shu Zha Shu Zhang " + // U+6A10
+		"Men Mo Niao Yang Tiao Peng Zhu Sha Xi Quan Heng Jian Cong Ji Hokuso Qiang " + // U+6A20
+		"Tara Ying Er Xin Zhi Qiao Zui Cong Pu Shu Hua Kui Zhen Zun Yue Zhan " + // U+6A30
+		"Xi Xun Dian Fa Gan Mo Wu Qiao Nao Lin Liu Qiao Xian Run Fan Zhan " + // U+6A40
+		"Tuo Lao Yun Shun Tui Cheng Tang Meng Ju Cheng Su Jue Jue Tan Hui Ji " + // U+6A50
+		"Nuo Xiang Tuo Ning Rui Zhu Chuang Zeng Fen Qiong Ran Heng Cen Gu Liu Lao " + // U+6A60
+		"Gao Chu Zusa Nude Ca San Ji Dou Shou Lu Jian Chu Yuan Ta Shu Jiang " + // U+6A70
+		"Tan Lin Nong Yin Xi Sui Shan Zui Xuan Cheng Gan Ju Zui Yi Qin Pu " + // U+6A80
+		"Yan Lei Feng Hui Dang Ji Sui Bo Bi Ding Chu Zhua Kuai Ji Jie Jia " + // U+6A90
+		"Qing Zhe Jian Qiang Dao Yi Biao Song She Lin Kunugi Cha Meng Yin Tao Tai " + // U+6AA0
+		"Mian Qi Toan Bin Huo Ji Qian Mi Ning Yi Gao Jian Yin Er Qing Yan " + // U+6AB0
+		"Qi Mi Zhao Gui Chun Ji Kui Po Deng Chu Ge Mian You Zhi Guang Qian " + // U+6AC0
+		"Lei Lei Sa Lu Li Cuan Lu Mie Hui Ou Lu Jie Gao Du Yuan Li " + // U+6AD0
+		"Fei Zhuo Sou Lian Tamo Chu Qing Zhu Lu Yan Li Zhu Chen Jie E Su " + // U+6AE0
+		"Huai Nie Yu Long Lai Jiao Xian Kwi Ju Xiao Ling Ying Jian Yin You Ying " + // U+6AF0
+		"Xiang Nong Bo Chan Lan Ju Shuang She Wei Cong Quan Qu Cang Jiu Yu Luo " + // U+6B00
+		"Li Zan Luan Dang Jue Em Lan Lan Zhu Lei Li Ba Nang Yu Ling Tsuki " + // U+6B10
+		"Qian Ci Huan Xin Yu Yu Qian Ou Xu Chao Chu Chi Kai Yi Jue Xi " + // U+6B20
+		"Xu Xia Yu Kuai Lang Kuan Shuo Xi Ai Yi Qi Hu Chi Qin Kuan Kan " + // U+6B30
+		"Kuan Kan Chuan Sha Gua Yin Xin Xie Yu Qian Xiao Yi Ge Wu Tan Jin " + // U+6B40
+		"Ou Hu Ti Huan Xu Pen Xi Xiao Xu Xi Sen Lian Chu Yi Kan Yu " + // U+6B50
+		"Chuo Huan Zhi Zheng Ci Bu Wu Qi Bu Bu Wai Ju Qian Chi Se Chi " + // U+6B60
+		"Se Zhong Sui Sui Li Cuo Yu Li Gui Dai Dai Si Jian Zhe Mo Mo " + // U+6B70
+		"Yao Mo Cu Yang Tian Sheng Dai Shang Xu Xun Shu Can Jue Piao Qia Qiu " + // U+6B80
+		"Su Qing Yun Lian Yi Fou Zhi Ye Can Hun Dan Ji Ye Zhen Yun Wen " + // U+6B90
+		"Chou Bin Ti Jin Shang Yin Diao Cu Hui Cuan Yi Dan Du Jiang Lian Bin " + // U+6BA0
+		"Du Tsukusu Jian Shu Ou Duan Zhu Yin Qing Yi Sha Que Ke Yao Jun Dian " + // U+6BB0
+		"Hui Hui Gu Que Ji Yi Ou Hui Duan Yi Xiao Wu Guan Mu Mei Mei " + // U+6BC0
+		"Ai Zuo Du Yu Bi Bi Bi Pi Pi Bi Chan Mao Hao Cai Pu Mushiru " + // U+6BD0
+		"Jia Zhan Sai Mu Tuo Xun Er Rong Xian Ju Mu Hao Qiu Dou Mushiru Tan " + // U+6BE0
+		"Pei Ju Duo Cui Bi San San Mao Sui Yu Yu Tuo He Jian Ta San " + // U+6BF0
+		"Lu Mu Li Tong Rong Chang Pu Luo Zhan Sao Zhan Meng Luo Qu Die Shi " + // U+6C00
+		"Di Min Jue Mang Qi Pie Nai Qi Dao Xian Chuan Fen Ri Nei Bin Fu " + // U+6C10
+		"Shen Dong Qing Qi Yin Xi Hai Yang An Ya Ke Qing Ya Dong Dan Lu " + // U+6C20
+		"Qing Yang Yun Yun Shui San Zheng Bing Yong Dang Shitamizu Le Ni Tun Fan Gui " + // U+6C30
+		"Ting Zhi Qiu Bin Ze Mian Cuan Hui Diao Yi Cha Zhuo Chuan Wan Fan Dai " + // U+6C40
+		"Xi Tuo Mang Qiu Qi Shan Pai Han Qian Wu Wu Xun Si Ru Gong Jiang " + // U+6C50
+		"Chi Wu Tsuchi Jiu Tang Zhi Chi Qian Mi Yu Wang Qing Jing Rui Jun Hong " + // U+6C60
+		"Tai Quan Ji Bian Bian Gan Wen Zhong Fang Xiong Jue Hang Niou Qi Fen Xu " + // U+6C70
+		"Xu Qin Yi Wo Yun Yuan Hang Yan Chen Chen Dan You Dun Hu Huo Qie " + // U+6C80
+		"Mu Rou Mei Ta Mian Wu Chong Tian Bi Sha Zhi Pei Pan Zhui Za Gou " + // U+6C90
+		"Liu Mei Ze Feng Ou Li Lun Cang Feng Wei Hu Mo Mei Shu Ju Zan " + // U+6CA0
+		"Tuo Tuo Tuo He Li Mi Yi Fa Fei You Tian Zhi Zhao Gu Zhan Yan " + // U+6CB0
+		"Si Kuang Jiong Ju Xie Qiu Yi Jia Zhong Quan Bo Hui Mi Ben Zhuo Chu " + // U+6CC0
+		"Le You Gu Hong Gan Fa Mao Si Hu Ping Ci Fan Chi Su Ning Cheng " + // U+6CD0
+		"Ling Pao Bo Qi Si Ni Ju Yue Zhu Sheng Lei Xuan Xue Fu Pan Min " + // U+6CE0
+		"Tai Yang Ji Yong Guan Beng Xue Long Lu Dan Bo Xie Po Ze Jing Yin " + // U+6CF0
+		"Zhou Ji Yi Hui Hui Zui Cheng Yin Wei Hou Jian Yang Lie Si Ji Er " + // U+6D00
+		"Xing Fu Sa Suo Zhi Yin Wu Xi Kao Zhu Jiang Luo Luo An Dong Yi " + // U+6D10
+		"Mou Lei Yi Mi Quan Jin Mo Wei Xiao Xie Hong Xu Shuo Kuang Tao Qie " + // U+6D20
+		"Ju Er Zhou Ru Ping Xun Xiong Zhi Guang Huan Ming Huo Wa Qia Pai Wu " + // U+6D30
+		"Qu Liu Yi Jia Jing Qian Jiang Jiao Cheng Shi Zhuo Ce Pal Kuai Ji Liu " + // U+6D40
+		"Chan Hun Hu Nong Xun Jin Lie Qiu Wei Zhe Jun Han Bang Mang Zhuo You " + // U+6D50
+		"Xi Bo Dou Wan Hong Yi Pu Ying Lan Hao Lang Han Li Geng Fu Wu " + // U+6D60
+		"Lian Chun Feng Yi Yu Tong Lao Hai Jin Jia Chong Weng Mei Sui Cheng Pei " + // U+6D70
+		"Xian Shen Tu Kun Pin Nie Han Jing Xiao She Nian Tu Yong Xiao Xian Ting " + // U+6D80
+		"E Su Tun Juan Cen Ti Li Shui Si Lei Shui Tao Du Lao Lai Lian " + // U+6D90
+		"Wei Wo Yun Huan Di Heng Run Jian Zhang Se Fu Guan Xing Shou Shuan Ya " + // U+6DA0
+		"Chuo Zhang Ye Kong Wo Han Tuo Dong He Wo Ju Gan Liang Hun Ta Zhuo " + // U+6DB0
+		"Dian Qie De Juan Zi Xi Yao Qi Gu Guo Han Lin Tang Zhou Peng Hao " + // U+6DC0
+		"Chang Shu Qi Fang Chi Lu Nao Ju Tao Cong Lei Zhi Peng Fei Song Tian " + // U+6DD0
+		"Pi Dan Yu Ni Yu Lu Gan Mi Jing Ling Lun Yin Cui Qu Huai Yu " + // U+6DE0
+		"Nian Shen Piao Chun Wa Yuan Lai Hun Qing Yan Qian Tian Miao Zhi Yin Mi " + // U+6DF0
+		"Ben Yuan Wen Re Fei Qing Yuan Ke Ji She Yuan Shibui Lu Zi Du Yi " + // U+6E00
+		"Jian Min Pi Tani Yu Yuan Shen Shen Rou Huan Zhu Jian Nuan Yu Qiu Ting " + // U+6E10
+		"Qu Du Feng Zha Bo Wo Wo Di Wei Wen Ru Xie Ce Wei Ge Gang " + // U+6E20
+		"Yan Hong Xuan Mi Ke Mao Ying Yan You Hong Miao Xing Mei Zai Hun Nai " + // U+6E30
+		"Kui Shi E Pai Mei Lian Qi Qi Mei Tian Cou Wei Can Tuan Mian Hui " + // U+6E40
+		"Mo Xu Ji Pen Jian Jian Hu Feng Xiang Yi Yin Zhan Shi Jie Cheng Huang " + // U+6E50
+		"Tan Yu Bi Min Shi Tu Sheng Yong Qu Zhong Suei Jiu Jiao Qiou Yin Tang " + // U+6E60
+		"Long Huo Yuan Nan Ban You Quan Chui Liang Chan Yan Chun Nie Zi Wan Shi " + // U+6E70
+		"Man Ying Ratsu Kui Feng Jian Xu Lu Gui Gai Bo Ying Po Jin Gui Tang " + // U+6E80
+		"Yuan Suo Yuan Lian Yao Meng Zhun Sheng Ke Tai Da Wa Liu Gou Sao Ming " + // U+6E90
+		"Zha Shi Yi Lun Ma Pu Wei Li Cai Wu Xi Wen Qiang Ze Shi Su " + // U+6EA0
+		"Yi Zhen Sou Yun Xiu Yin Rong Hun Su Su Ni Ta Shi Ru Wei Pan " + // U+6EB0
+		"Chu Chu Pang Weng Cang Mie He Dian Hao Huang Xi Zi Di Zhi Ying Fu " + // U+6EC0
+		"Jie Hua Ge Zi Tao Teng Sui Bi Jiao Hui Gun Yin Gao Long Zhi Yan " + // U+6ED0
+		"She Man Ying Chun Lu Lan Luan Yao Bin Tan Yu Sou Hu Bi Biao Zhi " + // U+6EE0
+		"Jiang Kou Shen Shang Di Mi Ao Lu Hu Hu You Chan Fan Yong Gun Man " + // U+6EF0
+		"Qing Yu Piao Ji Ya Jiao Qi Xi Ji Lu Lu Long Jin Guo Cong Lou " + // U+6F00
+		"Zhi Gai Qiang Li Yan Cao Jiao Cong Qun Tuan Ou Teng Ye Xi Mi Tang " + // U+6F10
+		"Mo Shang Han Lian Lan Wa Li Qian Feng Xuan Yi Man Zi Mang Kang Lei " + // U+6F20
+		"Peng Shu Zhang Zhang Chong Xu Huan Kuo Jian Yan Chuang Liao Cui Ti Yang Jiang " + // U+6F30
+		"Cong Ying Hong Xun Shu Guan Ying Xiao Zong Kun Xu Lian Zhi Wei Pi Jue " + // U+6F40
+		"Jiao Po Dang Hui Jie Wu Pa Ji Pan Gui Xiao Qian Qian Xi Lu Xi " + // U+6F50
+		"Xuan Dun Huang Min Run Su Liao Zhen Zhong Yi Di Wan Dan Tan Chao Xun " + // U+6F60
+		"Kui Yie Shao Tu Zhu San Hei Bi Shan Chan Chan Shu Tong Pu Lin Wei " + // U+6F70
+		"Se Se Cheng Jiong Cheng Hua Jiao Lao Che Gan Cun Heng Si Shu Peng Han " + // U+6F80
+		"Yun Liu Hong Fu Hao He Xian Jian Shan Xi Oki Lu Lan Ning Yu Lin " + // U+6F90
+		"Min Zao Dang Wan Ze Xie Yu Li Shi Xue Ling Man Zi Yong Kuai Can " + // U+6FA0
+		"Lian Dian Ye Ao Huan Zhen Chan Man Dan Dan Yi Sui Pi Ju Ta Qin " + // U+6FB0
+		"Ji Zhuo Lian Nong Guo Jin Fen Se Ji Sui Hui Chu Ta Song Ding Se " + // U+6FC0
+		"Zhu Lai Bin Lian Mi Shi Shu Mi Ning Ying Ying Meng Jin Qi Pi Ji " + // U+6FD0
+		"Hao Ru Zui Wo Tao Yin Yin Dui Ci Huo Jing Lan Jun Ai Pu Zhuo " + // U+6FE0
+		"Wei Bin Gu Qian Xing Hama Kuo Fei Cang Boku Jian Wei Luo Zan Lu Li " + // U+6FF0
+		"You Yang Lu Si Jie Ying Du Wang Hui Xie Pan Shen Biao Chan Mo Liu " + // U+7000
+		"Jian Pu Se Cheng Gu Bin Huo Xian Lu Qin Han Ying Yong Li Jing Xiao " + // U+7010
+		"Ying Sui Wei Xie Huai Hao Zhu Long Lai Dui Fan Hu Lai Shu Ling Ying " + // U+7020
+		"Mi Ji Lian Jian Ying Fen Lin Yi Jian Yue Chan Dai Rang Jian Lan Fan " + // U+7030
+		"Shuang Yuan Zhuo Feng She Lei Lan Cong Qu Yong Qian Fa Guan Que Yan Hao " + // U+7040
+		"Hyeng Sa Zan Luan Yan Li Mi Shan Tan Dang Jiao Chan Ying Hao Ba Zhu " + // U+7050
+		"Lan Lan Nang Wan Luan Xun Xian Yan Gan Yan Yu Huo Si Mie Guang Deng " + // U+7060
+		"Hui Xiao Xiao Hu Hong Ling Zao Zhuan Jiu Zha Xie Chi Zhuo Zai Zai Can " + // U+7070
+		"Yang Qi Zhong Fen Niu Jiong Wen Po Yi Lu Chui Pi Kai Pan Yan Kai " + // U+7080
+		"Pang Mu Chao Liao Gui Kang Tun Guang Xin Zhi Guang Guang Wei Qiang Bian Da " + // U+7090
+		"Xia Zheng Zhu Ke Zhao Fu Ba Duo Duo Ling Zhuo Xuan Ju Tan Pao Jiong " + // U+70A0
+		"Pao Tai Tai Bing Yang Tong Han Zhu Zha Dian Wei Shi Lian Chi Huang Zhou " + // U+70B0
+		"Hu Shuo Lan Jing Jiao Xu Xing Quan Lie Huan Yang Xiao Xiu Xian Yin Wu " + // U+70C0
+		"Zhou Yao Shi Wei Tong Xue Zai Kai Hong Luo Xia Zhu Xuan Zheng Po Yan " + // U+70D0
+		"Hui Guang Zhe Hui Kao Ju Fan Shao Ye Hui [?] Tang Jin Re Lie Xi " + // U+70E0
+		"Fu Jiong Che Pu Jing Zhuo Ting Wan Hai Peng Lang Shan Hu Feng Chi Rong " + // U+70F0
+		"Hu Xi Shu He Xun Ku Jue Xiao Xi Yan Han Zhuang Jun Di Xie Ji " + // U+7100
+		"Wu Yan Lu Han Yan Huan Men Ju Chou Bei Fen Lin Kun Hun Tun Xi " + // U+7110
+		"Cui Wu Hong Ju Fu Wo Jiao Cong Feng Ping Qiong Ruo Xi Qiong Xin Zhuo " + // U+7120
+		"Yan Yan Yi Jue Yu Gang Ran Pi Gu Gang Sheng Chang Shao Xiong Nian Geng " + // U+7130
+		"Wei Chen He Kui Zhong Duan Xia Hui Feng Lian Xuan Xing Huang Jiao Jian Bi " + // U+7140
+		"Ying Zhu Wei Tuan Tian Xi Nuan Nuan Chan Yan Jiong Jiong Yu Mei Sha Wei " + // U+7150
+		"Ye Xin Qiong Rou Mei Huan Xu Zhao Wei Fan Qiu Sui Yang Lie Zhu Jie " + // U+7160
+		"Gao Gua Bao Hu Yun Xia Shi Liang Bian Gou Tui Tang Chao Shan N Bo " + // U+7170
+		"Huang Xie Xi Wu Xi Yun He He Xi Yun Xiong Nai Shan Qiong Yao Xun " + // U+7180
+		"Mi Lian Ying Wen Rong Oozutsu Yan Qiang Liu Xi Bi Biao Zong Lu Jian Shou " + // U+7190
+		"Yi Lou Feng Sui Yi Tong Jue Zong Yun Hu Yi Zhi Ao Wei Liao Han " + // U+71A0
+		"Ou Re Jiong Man Kun Shang Cuan Zeng Jian Xi Xi Xi Yi Xiao Chi Huang " + // U+71B0
+		"Chan Ye Qian Ran Yan Xian Qiao Zun Deng Dun Shen Jiao Fen Si Liao Yu " + // U+71C0
+		"Lin Tong Shao Fen Fan Yan Xun Lan Mei Tang Yi Jing Men Jing Jiao Ying " + // U+71D0
+		"Yu Yi Xue Lan Tai Zao Can Sui Xi Que Cong Lian Hui Zhu Xie Ling " + // U+71E0
+		"Wei Yi Xie Zhao Hui Tatsu Nung Lan Ru Xian Kao Xun Jin Chou Chou Yao " + // U+71F0
+		"He Lan Biao Rong Li Mo Bao Ruo Lu La Ao Xun Kuang Shuo Liao Li " + // U+7200
+		"Lu Jue Liao Yan Xi Xie Long Ye Can Rang Yue Lan Cong Jue Tong Guan " + // U+7210
+		"Ju Che Mi Tang Lan Zhu Lan Ling Cuan Yu Zhua Tsumekanmuri Pa Zheng Pao Cheng " + // U+7220
+		"Yuan Ai Wei Han Jue Jue Fu Ye Ba Die Ye Yao Zu Shuang Er Qiang " + // U+7230
+		"Chuang Ge Zang Die Qiang Yong Qiang Pian Ban Pan Shao Jian Pai Du Chuang Tou " + // U+7240
+		"Zha Bian Die Bang Bo Chuang You You Du Ya Cheng Niu Ushihen Pin Jiu Mou " + // U+7250
+		"Tuo Mu Lao Ren Mang Fang Mao Mu Gang Wu Yan Ge Bei Si Jian Gu " + // U+7260
+		"You Ge Sheng Mu Di Qian Quan Quan Zi Te Xi Mang Keng Qian Wu Gu " + // U+7270
+		"Xi Li Li Pou Ji Gang Zhi Ben Quan Run Du Ju Jia Jian Feng Pian " + // U+7280
+		"Ke Ju Kao Chu Xi Bei Luo Jie Ma San Wei Li Dun Tong Qiao Jiang " + // U+7290
+		"Ikenie Li Du Lie Pi Piao Bao Xi Chou Wei Kui Chou Quan Fan Ba Fan " + // U+72A0
+		"Qiu Ji Cai Chuo An Jie Zhuang Guang Ma You Kang Bo Hou Ya Yin Huan " + // U+72B0
+		"Zhuang Yun Kuang Niu Di Qing Zhong Mu Bei Pi Ju Ni Sheng Pao Xia Tuo " + // U+72C0
+		"Hu Ling Fei Pi Ni Ao You Gou Yue Ju Dan Po Gu Xian Ning Huan " + // U+72D0
+		"Hen Jiao He Zhao Ji Xun Shan Ta Rong Shou Tong Lao Du Xia Shi Hua " + // U+72E0
+		"Zheng Yu Sun Yu Bi Mang Xi Juan Li Xia Yin Suan Lang Bei Zhi Yan " + // U+72F0
+		"Sha Li Han Xian Jing Pai Fei Yao Ba Qi Ni Biao Yin Lai Xi Jian " + // U+7300
+		"Qiang Kun Yan Guo Zong Mi Chang Yi Zhi Zheng Ya Meng Cai Cu She Kari " + // U+7310
+		"Cen Luo Hu Zong Ji Wei Feng Wo Yuan Xing Zhu Mao Wei Yuan Xian Tuan " + // U+7320
+		"Ya Nao Xie Jia Hou Bian You You Mei Zha Yao Sun Bo Ming Hua Yuan " + // U+7330
+		"Sou Ma Yuan Dai Yu Shi Hao Qiang Yi Zhen Chuang Hao Man Jing Jiang Mu " + // U+7340
+		"Zhang Chan Ao Ao Hao Cui Fen Jue Bi Bi Huang Pu Lin Yu Tong Yao " + // U+7350
+		"Liao Shuo Xiao Swu Ton Xi Ge Juan Du Hui Kuai Xian Xie Ta Xian Xun " + // U+7360
+		"Ning Pin Huo Nou Meng Lie Nao Guang Shou Lu Ta Xian Mi Rang Huan Nao " + // U+7370
+		"Luo Xian Qi Jue Xuan Miao Zi Lu Lu Yu Su Wang Qiu Ga Ding Le " + // U+7380
+		"Ba Ji Hong Di Quan Gan Jiu Yu Ji Yu Yang Ma Gong Wu Fu Wen " + // U+7390
+		"Jie Ya Fen Bian Beng Yue Jue Yun Jue Wan Jian Mei Dan Pi Wei Huan " + // U+73A0
+		"Xian Qiang Ling Dai Yi An Ping Dian Fu Xuan Xi Bo Ci Gou Jia Shao " + // U+73B0
+		"Po Ci Ke Ran Sheng Shen Yi Zu Jia Min Shan Liu Bi Zhen Zhen Jue " + // U+73C0
+		"Fa Long Jin Jiao Jian Li Guang Xian Zhou Gong Yan Xiu Yang Xu Luo Su " + // U+73D0
+		"Zhu Qin Ken Xun Bao Er Xiang Yao Xia Heng Gui Chong Xu Ban Pei Lao " + // U+73E0
+		"Dang Ei Hun Wen E Cheng Ti Wu Wu Cheng Jun Mei Bei Ting Xian Chuo " + // U+73F0
+		"Han Xuan Yan Qiu Quan Lang Li Xiu Fu Liu Ye Xi Ling Li Jin Lian " + // U+7400
+		"Suo Chiisai Feng Wan Dian Pin Zhan Cui Min Yu Ju Chen Lai Wen Sheng Wei " + // U+7410
+		"Dian Chu Zhuo Pei Cheng Hu Qi E Kun Chang Qi Beng Wan Lu Cong Guan " + // U+7420
+		"Yan Diao Bei Lin Qin Pi Pa Que Zhuo Qin Fa Jin Qiong Du Jie Hun " + // U+7430
+		"Yu Mao Mei Chun Xuan Ti Xing Dai Rou Min Zhen Wei Ruan Huan Jie Chuan " + // U+7440
+		"Jian Zhuan Yang Lian Quan Xia Duan Yuan Ye Nao Hu Ying Yu Huang Rui Se " + // U+7450
+		"Liu Shi Rong Suo Yao Wen Wu Jin Jin Ying Ma Tao Liu Tang Li Lang " + // U+7460
+		"Gui Zhen Qiang Cuo Jue Zhao Yao Ai Bin Tu Chang Kun Zhuan Cong Jin Yi " + // U+7470
+		"Cui Cong Qi Li Ying Suo Qiu Xuan Ao Lian Man Zhang Yin Ye Ying Zhi " + // U+7480
+		"Lu Wu Deng Xiou Zeng Xun Qu Dang Lin Liao Qiong Su Huang Gui Pu Jing " + // U+7490
+		"Fan Jin Liu Ji Hui Jing Ai Bi Can Qu Zao Dang Jiao Gun Tan Hui " + // U+74A0
+		"Huan Se Sui Tian Chu Yu Jin Lu Bin Shou Wen Zui Lan Xi Ji Xuan " + // U+74B0
+		"Ruan Huo Gai Lei Du Li Zhi Rou Li Zan Qiong Zhe Gui Sui La Long " + // U+74C0
+		"Lu Li Zan Lan Ying Mi Xiang Xi Guan Dao Zan Huan Gua Bo Die Bao " + // U+74D0
+		"Hu Zhi Piao Ban Rang Li Wa Dekaguramu Jiang Qian Fan Pen Fang Dan Weng Ou " + // U+74E0
+		"Deshiguramu Miriguramu Thon Hu Ling Yi Ping Ci Hekutogura Juan Chang Chi Sarake Dang Meng Pou " + // U+74F0
+		"Zhui Ping Bian Zhou Zhen Senchigura Ci Ying Qi Xian Lou Di Ou Meng Zhuan Peng " + // U+7500
+		"Lin Zeng Wu Pi Dan Weng Ying Yan Gan Dai Shen Tian Tian Han Chang Sheng " + // U+7510
+		"Qing Sheng Chan Chan Rui Sheng Su Sen Yong Shuai Lu Fu Yong Beng Feng Ning " + // U+7520
+		"Tian You Jia Shen Zha Dian Fu Nan Dian Ping Ting Hua Ting Quan Zi Meng " + // U+7530
+		"Bi Qi Liu Xun Liu Chang Mu Yun Fan Fu Geng Tian Jie Jie Quan Wei " + // U+7540
+		"Fu Tian Mu Tap Pan Jiang Wa Da Nan Liu Ben Zhen Chu Mu Mu Ce " + // U+7550
+		"Cen Gai Bi Da Zhi Lue Qi Lue Pan Kesa Fan Hua Yu Yu Mu Jun " + // U+7560
+		"Yi Liu Yu Die Chou Hua Dang Chuo Ji Wan Jiang Sheng Chang Tuan Lei Ji " + // U+7570
+		"Cha Liu Tatamu Tuan Lin Jiang Jiang Chou Bo Die Die Pi Nie Dan Shu Shu " + // U+7580
+		"Zhi Yi Chuang Nai Ding Bi Jie Liao Gong Ge Jiu Zhou Xia Shan Xu Nue " + // U+7590
+		"Li Yang Chen You Ba Jie Jue Zhi Xia Cui Bi Yi Li Zong Chuang Feng " + // U+75A0
+		"Zhu Pao Pi Gan Ke Ci Xie Qi Dan Zhen Fa Zhi Teng Ju Ji Fei " + // U+75B0
+		"Qu Dian Jia Xian Cha Bing Ni Zheng Yong Jing Quan Chong Tong Yi Kai Wei " + // U+75C0
+		"Hui Duo Yang Chi Zhi Hen Ya Mei Dou Jing Xiao Tong Tu Mang Pi Xiao " + // U+75D0
+		"Suan Pu Li Zhi Cuo Duo Wu Sha Lao Shou Huan Xian Yi Peng Zhang Guan " + // U+75E0
+		"Tan Fei Ma Lin Chi Ji Dian An Chi Bi Bei Min Gu Dui E Wei " + // U+75F0
+		"Yu Cui Ya Zhu Cu Dan Shen Zhung Ji Yu Hou Feng La Yang Shen Tu " + // U+7600
+		"Yu Gua Wen Huan Ku Jia Yin Yi Lu Sao Jue Chi Xi Guan Yi Wen " + // U+7610
+		"Ji Chuang Ban Lei Liu Chai Shou Nue Dian Da Pie Tan Zhang Biao Shen Cu " + // U+7620
+		"Luo Yi Zong Chou Zhang Zhai Sou Suo Que Diao Lou Lu Mo Jin Yin Ying " + // U+7630
+		"Huang Fu Liao Long Qiao Liu Lao Xian Fei Dan Yin He Yan Ban Xian Guan " + // U+7640
+		"Guai Nong Yu Wei Yi Yong Pi Lei Li Shu Dan Lin Dian Lin Lai Pie " + // U+7650
+		"Ji Chi Yang Xian Jie Zheng Me Li Huo Lai Shaku Dian Xian Ying Yin Qu " + // U+7660
+		"Yong Tan Dian Luo Luan Luan Bo Bo Gui Po Fa Deng Fa Bai Bai Qie " + // U+7670
+		"Bi Zao Zao Mao De Pa Jie Huang Gui Ci Ling Gao Mo Ji Jiao Peng " + // U+7680
+		"Gao Ai E Hao Han Bi Wan Chou Qian Xi Ai Jiong Hao Huang Hao Ze " + // U+7690
+		"Cui Hao Xiao Ye Po Hao Jiao Ai Xing Huang Li Piao He Jiao Pi Gan " + // U+76A0
+		"Pao Zhou Jun Qiu Cun Que Zha Gu Jun Jun Zhou Zha Gu Zhan Du Min " + // U+76B0
+		"Qi Ying Yu Bei Zhao Zhong Pen He Ying He Yi Bo Wan He Ang Zhan " + // U+76C0
+		"Yan Jian He Yu Kui Fan Gai Dao Pan Fu Qiu Sheng Dao Lu Zhan Meng " + // U+76D0
+		"Li Jin Xu Jian Pan Guan An Lu Shu Zhou Dang An Gu Li Mu Cheng " + // U+76E0
+		"Gan Xu Mang Mang Zhi Qi Ruan Tian Xiang Dun Xin Xi Pan Feng Dun Min " + // U+76F0
+		"Ming Sheng Shi Yun Mian Pan Fang Miao Dan Mei Mao Kan Xian Ou Shi Yang " + // U+7700
+		"Zheng Yao Shen Huo Da Zhen Kuang Ju Shen Chi Sheng Mei Mo Zhu Zhen Zhen " + // U+7710
+		"Mian Di Yuan Die Yi Zi Zi Chao Zha Xuan Bing Mi Long Sui Dong Mi " + // U+7720
+		"Die Yi Er Ming Xuan Chi Kuang Juan Mou Zhen Tiao Yang Yan Mo Zhong Mai " + // U+7730
+		"Zhao Zheng Mei Jun Shao Han Huan Di Cheng Cuo Juan E Wan Xian Xi Kun " + // U+7740
+		"Lai Jian Shan Tian Hun Wan Ling Shi Qiong Lie Yai Jing Zheng Li Lai Sui " + // U+7750
+		"Juan Shui Sui Du Bi Bi Mu Hun Ni Lu Yi Jie Cai Zhou Yu Hun " + // U+7760
+		"Ma Xia Xing Xi Gun Cai Chun Jian Mei Du Hou Xuan Ti Kui Gao Rui " + // U+7770
+		"Mou Xu Fa Wen Miao Chou Kui Mi Weng Kou Dang Chen Ke Sou Xia Qiong " + // U+7780
+		"Mao Ming Man Shui Ze Zhang Yi Diao Ou Mo Shun Cong Lou Chi Man Piao " + // U+7790
+		"Cheng Ji Meng Wan Run Pie Xi Qiao Pu Zhu Deng Shen Shun Liao Che Xian " + // U+77A0
+		"Kan Ye Xu Tong Mou Lin Kui Xian Ye Ai Hui Zhan Jian Gu Zhao Qu " + // U+77B0
+		"Wei Chou Sao Ning Xun Yao Huo Meng Mian Bin Mian Li Kuang Jue Xuan Mian " + // U+77C0
+		"Huo Lu Meng Long Guan Man Xi Chu Tang Kan Zhu Mao Jin Lin Yu Shuo " + // U+77D0
+		"Ce Jue Shi Yi Shen Zhi Hou Shen Ying Ju Zhou Jiao Cuo Duan Ai Jiao " + // U+77E0
+		"Zeng Huo Bai Shi Ding Qi Ji Zi Gan Wu Tuo Ku Qiang Xi Fan Kuang " + // U+77F0
+		"Dang Ma Sha Dan Jue Li Fu Min Nuo Huo Kang Zhi Qi Kan Jie Fen " + // U+7800
+		"E Ya Pi Zhe Yan Sui Zhuan Che Dun Pan Yan Jin Feng Fa Mo Zha " + // U+7810
+		"Qu Yu Luo Tuo Tuo Di Zhai Zhen Ai Fei Mu Zhu Li Bian Nu Ping " + // U+7820
+		"Peng Ling Pao Le Po Bo Po Shen Za Nuo Li Long Tong Yong Li Aragane " + // U+7830
+		"Chu Keng Quan Zhu Kuang Huo E Nao Jia Lu Wei Ai Luo Ken Xing Yan " + // U+7840
+		"Tong Peng Xi Lao Hong Shuo Xia Qiao Qing Wei Qiao Yi Keng Xiao Que Chan " + // U+7850
+		"Lang Hong Yu Xiao Xia Mang Long Iong Che Che E Liu Ying Mang Que Yan " + // U+7860
+		"Sha Kun Yu Chi Kaki Lu Chen Jian Nue Song Zhuo Keng Peng Yan Zhui Kong " + // U+7870
+		"Ceng Qi Zong Qing Lin Jun Bo Ding Min Diao Jian He Lu Ai Sui Que " + // U+7880
+		"Ling Bei Yin Dui Wu Qi Lun Wan Dian Gang Pei Qi Chen Ruan Yan Die " + // U+7890
+		"Ding Du Tuo Jie Ying Bian Ke Bi Wei Shuo Zhen Duan Xia Dang Ti Nao " + // U+78A0
+		"Peng Jian Di Tan Cha Seki Qi Dun Feng Xuan Que Que Ma Gong Nian Su " + // U+78B0
+		"E Ci Liu Si Tang Bang Hua Pi Wei Sang Lei Cuo Zhen Xia Qi Lian " + // U+78C0
+		"Pan Wei Yun Dui Zhe Ke La Zhuan Qing Gun Zhuan Chan Qi Ao Peng Lu " + // U+78D0
+		"Lu Kan Qiang Chen Yin Lei Biao Qi Mo Qi Cui Zong Qing Chuo Lun Ji " + // U+78E0
+		"Shan Lao Qu Zeng Deng Jian Xi Lin Ding Dian Huang Pan Za Qiao Di Li " + // U+78F0
+		"Tani Jiao Xi Zhang Qiao Dun Xian Yu Zhui He Huo Zhai Lei Ke Chu Ji " + // U+7900
+		"Que Dang Yi Jiang Pi Pi Yu Pin Qi Ai Kai Jian Yu Ruan Meng Pao " + // U+7910
+		"Ci Bo Yang Mie Ca Xian Kuang Lei Lei Zhi Li Li Fan Que Pao Ying " + // U+7920
+		"Li Long Long Mo Bo Shuang Guan Lan Zan Yan Shi Shi Li Reng She Yue " + // U+7930
+		"Si Qi Ta Ma Xie Xian Xian Zhi Qi Zhi Beng Dui Zhong Ren Yi Shi " + // U+7940
+		"You Zhi Tiao Fu Fu Mi Zu Zhi Suan Mei Zuo Qu Hu Zhu Shen Sui " + // U+7950
+		"Ci Chai Mi Lu Yu Xiang Wu Tiao Piao Zhu Gui Xia Zhi Ji Gao Zhen " + // U+7960
+		"Gao Shui Jin Chen Gai Kun Di Dao Huo Tao Qi Gu Guan Zui Ling Lu " + // U+7970
+		"Bing Jin Dao Zhi Lu Shan Bei Zhe Hui You Xi Yin Zi Huo Zhen Fu " + // U+7980
+		"Yuan Wu Xian Yang Ti Yi Mei Si Di Bei Zhuo Zhen Yong Ji Gao Tang " + // U+7990
+		"Si Ma Ta Fu Xuan Qi Yu Xi Ji Si Chan Tan Kuai Sui Li Nong " + // U+79A0
+		"Ni Dao Li Rang Yue Ti Zan Lei Rou Yu Yu Chi Xie Qin He Tu " + // U+79B0
+		"Xiu Si Ren Tu Zi Cha Gan Yi Xian Bing Nian Qiu Qiu Chong Fen Hao " + // U+79C0
+		"Yun Ke Miao Zhi Geng Bi Zhi Yu Mi Ku Ban Pi Ni Li You Zu " + // U+79D0
+		"Pi Ba Ling Mo Cheng Nian Qin Yang Zuo Zhi Zhi Shu Ju Zi Huo Ji " + // U+79E0
+		"Cheng Tong Zhi Huo He Yin Zi Zhi Jie Ren Du Yi Zhu Hui Nong Fu " + // U+79F0
+		"Xi Kao Lang Fu Ze Shui Lu Kun Gan Geng Ti Cheng Tu Shao Shui Ya " + // U+7A00
+		"Lun Lu Gu Zuo Ren Zhun Bang Bai Ji Zhi Zhi Kun Leng Peng Ke Bing " + // U+7A10
+		"Chou Zu Yu Su Lue Xiang Yi Xi Bian Ji Fu Bi Nuo Jie Zhong Zong " + // U+7A20
+		"Xu Cheng Dao Wen Lian Zi Yu Ji Xu Zhen Zhi Dao Jia Ji Gao Gao " + // U+7A30
+		"Gu Rong Sui You Ji Kang Mu Shan Men Zhi Ji Lu Su Ji Ying Wen " + // U+7A40
+		"Qiu Se He Yi Huang Qie Ji Sui Xiao Pu Jiao Zhuo Tong Sai Lu Sui " + // U+7A50
+		"Nong Se Hui Rang Nuo Yu Bin Ji Tui Wen Cheng Huo Gong Lu Biao Se " + // U+7A60
+		"Rang Zhuo Li Zan Xue Wa Jiu Qiong Xi Qiong Kong Yu Sen Jing Yao Chuan " + // U+7A70
+		"Zhun Tu Lao Qie Zhai Yao Bian Bao Yao Bing Wa Zhu Jiao Qiao Diao Wu " + // U+7A80
+		"Gui Yao Zhi Chuang Yao Tiao Jiao Chuang Jiong Xiao Cheng Kou Cuan Wo Dan Ku " + // U+7A90
+		"Ke Zhui Xu Su Guan Kui Dou Zhuo Yin Wo Wa Ya Yu Ju Qiong Yao " + // U+7AA0
+		"Yao Tiao Chao Yu Tian Diao Ju Liao Xi Wu Kui Chuang Zhao Kuan Kuan Long " + // U+7AB0
+		"Cheng Cui Piao Zao Cuan Qiao Qiong Dou Zao Long Qie Li Chu Shi Fou Qian " + // U+7AC0
+		"Chu Hong Qi Qian Gong Shi Shu Miao Ju Zhan Zhu Ling Long Bing Jing Jing " + // U+7AD0
+		"Zhang Yi Si Jun Hong Tong Song Jing Diao Yi Shu Jing Qu Jie Ping Duan " + // U+7AE0
+		"Shao Zhuan Ceng Deng Cui Huai Jing Kan Jing Zhu Zhu Le Peng Yu Chi Gan " + // U+7AF0
+		"Mang Zhu Utsubo Du Ji Xiao Ba Suan Ji Zhen Zhao Sun Ya Zhui Yuan Hu " + // U+7B00
+		"Gang Xiao Cen Pi Bi Jian Yi Dong Shan Sheng Xia Di Zhu Na Chi Gu " + // U+7B10
+		"Li Qie Min Bao Tiao Si Fu Ce Ben Pei Da Zi Di Ling Ze Nu " + // U+7B20
+		"Fu Gou Fan Jia Ge Fan Shi Mao Po Sey Jian Qiong Long Souke Bian Luo " + // U+7B30
+		"Gui Qu Chi Yin Yao Xian Bi Qiong Gua Deng Jiao Jin Quan Sun Ru Fa " + // U+7B40
+		"Kuang Zhu Tong Ji Da Xing Ce Zhong Kou Lai Bi Shai Dang Zheng Ce Fu " + // U+7B50
+		"Yun Tu Pa Li Lang Ju Guan Jian Han Tong Xia Zhi Cheng Suan Shi Zhu " + // U+7B60
+		"Zuo Xiao Shao Ting Ce Yan Gao Kuai Gan Chou Kago Gang Yun O Qian Xiao " + // U+7B70
+		"Jian Pu Lai Zou Bi Bi Bi Ge Chi Guai Yu Jian Zhao Gu Chi Zheng " + // U+7B80
+		"Jing Sha Zhou Lu Bo Ji Lin Suan Jun Fu Zha Gu Kong Qian Quan Jun " + // U+7B90
+		"Chui Guan Yuan Ce Ju Bo Ze Qie Tuo Luo Dan Xiao Ruo Jian Xuan Bian " + // U+7BA0
+		"Sun Xiang Xian Ping Zhen Sheng Hu Shi Zhu Yue Chun Lu Wu Dong Xiao Ji " + // U+7BB0
+		"Jie Huang Xing Mei Fan Chui Zhuan Pian Feng Zhu Hong Qie Hou Qiu Miao Qian " + // U+7BC0
+		"Gu Kui Sik Lou Yun He Tang Yue Chou Gao Fei Ruo Zheng Gou Nie Qian " + // U+7BD0
+		"Xiao Cuan Gong Pang Du Li Bi Zhuo Chu Shai Chi Zhu Qiang Long Lan Jian " + // U+7BE0
+		"Bu Li Hui Bi Di Cong Yan Peng Sen Zhuan Pai Piao Dou Yu Mie Zhuan " + // U+7BF0
+		"Ze Xi Guo Yi Hu Chan Kou Cu Ping Chou Ji Gui Su Lou Zha Lu " + // U+7C00
+		"Nian Suo Cuan Sasara Suo Le Duan Yana Xiao Bo Mi Si Dang Liao Dan Dian " + // U+7C10
+		"Fu Jian Min Kui Dai Qiao Deng Huang Sun Lao Zan Xiao Du Shi Zan Qi " + // U+7C20
+		"Pai Hata Pai Gan Ju Du Lu Yan Bo Dang Sai Ke Long Qian Lian Bo " + // U+7C30
+		"Zhou Lai Shi Lan Kui Yu Yue Hao Zhen Tai Ti Mi Chou Ji Yi Hata " + // U+7C40
+		"Teng Zhuan Zhou Fan Sou Zhou Kuji Zhuo Teng Lu Lu Jian Tuo Ying Yu Lai " + // U+7C50
+		"Long Shinshi Lian Lan Qian Yue Zhong Qu Lian Bian Duan Zuan Li Si Luo Ying " + // U+7C60
+		"Yue Zhuo Xu Mi Di Fan Shen Zhe Shen Nu Xie Lei Xian Zi Ni Cun " + // U+7C70
+		"Zhang Qian Kume Bi Ban Wu Sha Kang Rou Fen Bi Cui Yin Li Chi Nukamiso " + // U+7C80
+		"Ro Ba Li Gan Ju Po Mo Cu Nian Zhou Li Su Tiao Li Qi Su " + // U+7C90
+		"Hong Tong Zi Ce Yue Zhou Lin Zhuang Bai Lao Fen Ji Qu Sukumo Liang Xian " + // U+7CA0
+		"Fu Liang Can Geng Li Yue Lu Ju Qi Cui Bai Zhang Lin Zong Jing Guo " + // U+7CB0
+		"Kouji San San Tang Bian Rou Mian Hou Xu Zong Hu Jian Zan Ci Li Xie " + // U+7CC0
+		"Fu Ni Bei Gu Xiu Gao Tang Qiu Sukumo Cao Zhuang Tang Mi San Fen Zao " + // U+7CD0
+		"Kang Jiang Mo San San Nuo Xi Liang Jiang Kuai Bo Huan Shu Zong Xian Nuo " + // U+7CE0
+		"Tuan Nie Li Zuo Di Nie Tiao Lan Mi Jiao Jiu Xi Gong Zheng Jiu You " + // U+7CF0
+		"Ji Cha Zhou Xun Yue Hong Yu He Wan Ren Wen Wen Qiu Na Zi Tou " + // U+7D00
+		"Niu Fou Jie Shu Chun Pi Yin Sha Hong Zhi Ji Fen Yun Ren Dan Jin " + // U+7D10
+		"Su Fang Suo Cui Jiu Zha Kinu Jin Fu Zhi Ci Zi Chou Hong Zha Lei " + // U+7D20
+		"Xi Fu Xie Shen Bei Zhu Qu Ling Zhu Shao Gan Yang Fu Tuo Zhen Dai " + // U+7D30
+		"Zhuo Shi Zhong Xian Zu Jiong Ban Ju Mo Shu Zui Wata Jing Ren Heng Xie " + // U+7D40
+		"Jie Zhu Chou Gua Bai Jue Kuang Hu Ci Geng Geng Tao Xie Ku Jiao Quan " + // U+7D50
+		"Gai Luo Xuan Bing Xian Fu Gei Tong Rong Tiao Yin Lei Xie Quan Xu Lun " + // U+7D60
+		"Die Tong Si Jiang Xiang Hui Jue Zhi Jian Juan Chi Mian Zhen Lu Cheng Qiu " + // U+7D70
+		"Shu Bang Tong Xiao Wan Qin Geng Xiu Ti Xiu Xie Hong Xi Fu Ting Sui " + // U+7D80
+		"Dui Kun Fu Jing Hu Zhi Yan Jiong Feng Ji Sok Kase Zong Lin Duo Li " + // U+7D90
+		"Lu Liang Chou Quan Shao Qi Qi Zhun Qi Wan Qian Xian Shou Wei Qi Tao " + // U+7DA0
+		"Wan Gang Wang Beng Zhui Cai Guo Cui Lun Liu Qi Zhan Bei Chuo Ling Mian " + // U+7DB0
+		"Qi Qie Tan Zong Gun Zou Yi Zi Xing Liang Jin Fei Rui Min Yu Zong " + // U+7DC0
+		"Fan Lu Xu Yingl Zhang Kasuri Xu Xiang Jian Ke Xian Ruan Mian Qi Duan Zhong " + // U+7DD0
+		"Di Min Miao Yuan Xie Bao Si Qiu Bian Huan Geng Cong Mian Wei Fu Wei " + // U+7DE0
+		"Yu Gou Miao Xie Lian Zong Bian Yun Yin Ti Gua Zhi Yun Cheng Chan Dai " + // U+7DF0
+		"Xia Yuan Zong Xu Nawa Odoshi Geng Sen Ying Jin Yi Zhui Ni Bang Gu Pan " + // U+7E00
+		"Zhou Jian Cuo Quan Shuang Yun Xia Shuai Xi Rong Tao Fu Yun Zhen Gao Ru " + // U+7E10
+		"Hu Zai Teng Xian Su Zhen Zong Tao Horo Cai Bi Feng Cu Li Suo Yin " + // U+7E20
+		"Xi Zong Lei Zhuan Qian Man Zhi Lu Mo Piao Lian Mi Xuan Zong Ji Shan " + // U+7E30
+		"Sui Fan Shuai Beng Yi Sao Mou Zhou Qiang Hun Sem Xi Jung Xiu Ran Xuan " + // U+7E40
+		"Hui Qiao Zeng Zuo Zhi Shan San Lin Yu Fan Liao Chuo Zun Jian Rao Chan " + // U+7E50
+		"Rui Xiu Hui Hua Zuan Xi Qiang Un Da Sheng Hui Xi Se Jian Jiang Huan " + // U+7E60
+		"Zao Cong Jie Jiao Bo Chan Yi Nao Sui Yi Shai Xu Ji Bin Qian Lan " + // U+7E70
+		"Pu Xun Zuan Qi Peng Li Mo Lei Xie Zuan Kuang You Xu Lei Xian Chan " + // U+7E80
+		"Kou Lu Chan Ying Cai Xiang Xian Zui Zuan Luo Xi Dao Lan Lei Lian Si " + // U+7E90
+		"Jiu Yu Hong Zhou Xian He Yue Ji Wan Kuang Ji Ren Wei Yun Hong Chun " + // U+7EA0
+		"Pi Sha Gang Na Ren Zong Lun Fen Zhi Wen Fang Zhu Yin Niu Shu Xian " + // U+7EB0
+		"Gan Xie Fu Lian Zu Shen Xi Zhi Zhong Zhou Ban Fu Zhuo Shao Yi Jing " + // U+7EC0
+		"Dai Bang Rong Jie Ku Rao Die Heng Hui Gei Xuan Jiang Luo Jue Jiao Tong " + // U+7ED0
+		"Geng Xiao Juan Xiu Xi Sui Tao Ji Ti Ji Xu Ling Ying Xu Qi Fei " + // U+7EE0
+		"Chuo Zhang Gun Sheng Wei Mian Shou Beng Chou Tao Liu Quan Zong Zhan Wan Lu " + // U+7EF0
+		"Zhui Zi Ke Xiang Jian Mian Lan Ti Miao Qi Yun Hui Si Duo Duan Bian " + // U+7F00
+		"Xian Gou Zhui Huan Di Lu Bian Min Yuan Jin Fu Ru Zhen Feng Shuai Gao " + // U+7F10
+		"Chan Li Yi Jian Bin Piao Man Lei Ying Suo Mou Sao Xie Liao Shan Zeng " + // U+7F20
+		"Jiang Qian Zao Huan Jiao Zuan Fou Xie Gang Fou Que Fou Kaakeru Bo Ping Hou " + // U+7F30
+		"Zhao Gang Ying Ying Qing Xia Guan Zun Tan Chang Qi Weng Ying Lei Tan Lu " + // U+7F40
+		"Guan Wang Wang Gang Wang Han Luo Luo Fu Mi Fa Gu Zhu Ju Mao Gu " + // U+7F50
+		"Min Gang Ba Gua Ti Juan Fu Lin Yan Zhao Zui Gua Zhuo Yu Zhi An " + // U+7F60
+		"Fa Nan Shu Si Pi Ma Liu Ba Fa Li Chao Wei Bi Ji Zeng Tong " + // U+7F70
+		"Liu Ji Juan Mi Zhao Luo Pi Ji Ji Luan Yang Mie Qiang Ta Mei Yang " + // U+7F80
+		"You You Fen Ba Gao Yang Gu Qiang Zang Gao Ling Yi Zhu Di Xiu Qian " + // U+7F90
+		"Yi Xian Rong Qun Qun Qian Huan Zui Xian Yi Yashinau Qiang Xian Yu Geng Jie " + // U+7FA0
+		"Tang Yuan Xi Fan Shan Fen Shan Lian Lei Geng Nou Qiang Chan Yu Gong Yi " + // U+7FB0
+		"Chong Weng Fen Hong Chi Chi Cui Fu Xia Pen Yi La Yi Pi Ling Liu " + // U+7FC0
+		"Zhi Qu Xi Xie Xiang Xi Xi Qi Qiao Hui Hui Xiao Se Hong Jiang Di " + // U+7FD0
+		"Cui Fei Tao Sha Chi Zhu Jian Xuan Shi Pian Zong Wan Hui Hou He He " + // U+7FE0
+		"Han Ao Piao Yi Lian Qu Ao Lin Pen Qiao Ao Fan Yi Hui Xuan Dao " + // U+7FF0
+		"Yao Lao Lao Kao Mao Zhe Qi Gou Gou Gou Die Die Er Shua Ruan Er " + // U+8000
+		"Nai Zhuan Lei Ting Zi Geng Chao Hao Yun Pa Pi Chi Si Chu Jia Ju " + // U+8010
+		"He Chu Lao Lun Ji Tang Ou Lou Nou Gou Pang Ze Lou Ji Lao Huo " + // U+8020
+		"You Mo Huai Er Zhe Ting Ye Da Song Qin Yun Chi Dan Dan Hong Geng " + // U+8030
+		"Zhi Pan Nie Dan Zhen Che Ling Zheng You Wa Liao Long Zhi Ning Tiao Er " + // U+8040
+		"Ya Die Gua Xu Lian Hao Sheng Lie Pin Jing Ju Bi Di Guo Wen Xu " + // U+8050
+		"Ping Cong Shikato Ni Ting Yu Cong Kui Tsuraneru Kui Cong Lian Weng Kui Lian Lian " + // U+8060
+		"Cong Ao Sheng Song Ting Kui Nie Zhi Dan Ning Qie Ji Ting Ting Long Yu " + // U+8070
+		"Yu Zhao Si Su Yi Su Si Zhao Zhao Rou Yi Le Ji Qiu Ken Cao " + // U+8080
+		"Ge Di Huan Huang Yi Ren Xiao Ru Zhou Yuan Du Gang Rong Gan Cha Wo " + // U+8090
+		"Chang Gu Zhi Han Fu Fei Fen Pei Pang Jian Fang Zhun You Na Hang Ken " + // U+80A0
+		"Ran Gong Yu Wen Yao Jin Pi Qian Xi Xi Fei Ken Jing Tai Shen Zhong " + // U+80B0
+		"Zhang Xie Shen Wei Zhou Die Dan Fei Ba Bo Qu Tian Bei Gua Tai Zi " + // U+80C0
+		"Ku Zhi Ni Ping Zi Fu Pang Zhen Xian Zuo Pei Jia Sheng Zhi Bao Mu " + // U+80D0
+		"Qu Hu Ke Yi Yin Xu Yang Long Dong Ka Lu Jing Nu Yan Pang Kua " + // U+80E0
+		"Yi Guang Gai Ge Dong Zhi Xiao Xiong Xiong Er E Xing Pian Neng Zi Gui " + // U+80F0
+		"Cheng Tiao Zhi Cui Mei Xie Cui Xie Mo Mai Ji Obiyaakasu Nin Kuai Sa Zang " + // U+8100
+		"Qi Nao Mi Nong Luan Wan Bo Wen Guan Qiu Jiao Jing Rou Heng Cuo Lie " + // U+8110
+		"Shan Ting Mei Chun Shen Xie De Zui Cu Xiu Xin Tuo Pao Cheng Nei Fu " + // U+8120
+		"Dou Tuo Niao Noy Pi Gu Gua Li Lian Zhang Cui Jie Liang Zhou Pi Biao " + // U+8130
+		"Lun Pian Guo Kui Chui Dan Tian Nei Jing Jie La Yi An Ren Shen Chuo " + // U+8140
+		"Fu Fu Ju Fei Qiang Wan Dong Pi Guo Zong Ding Wu Mei Ruan Zhuan Zhi " + // U+8150
+		"Cou Gua Ou Di An Xing Nao Yu Chuan Nan Yun Zhong Rou E Sai Tu " + // U+8160
+		"Yao Jian Wei Jiao Yu Jia Duan Bi Chang Fu Xian Ni Mian Wa Teng Tui " + // U+8170
+		"Bang Qian Lu Wa Sou Tang Su Zhui Ge Yi Bo Liao Ji Pi Xie Gao " + // U+8180
+		"Lu Bin Ou Chang Lu Guo Pang Chuai Piao Jiang Fu Tang Mo Xi Zhuan Lu " + // U+8190
+		"Jiao Ying Lu Zhi Tara Chun Lian Tong Peng Ni Zha Liao Cui Gui Xiao Teng " + // U+81A0
+		"Fan Zhi Jiao Shan Wu Cui Run Xiang Sui Fen Ying Tan Zhua Dan Kuai Nong " + // U+81B0
+		"Tun Lian Bi Yong Jue Chu Yi Juan La Lian Sao Tun Gu Qi Cui Bin " + // U+81C0
+		"Xun Ru Huo Zang Xian Biao Xing Kuan La Yan Lu Huo Zang Luo Qu Zang " + // U+81D0
+		"Luan Ni Zang Chen Qian Wo Guang Zang Lin Guang Zi Jiao Nie Chou Ji Gao " + // U+81E0
+		"Chou Mian Nie Zhi Zhi Ge Jian Die Zhi Xiu Tai Zhen Jiu Xian Yu Cha " + // U+81F0
+		"Yao Yu Chong Xi Xi Jiu Yu Yu Xing Ju Jiu Xin She She Yadoru Jiu " + // U+8200
+		"Shi Tan Shu Shi Tian Dan Pu Pu Guan Hua Tan Chuan Shun Xia Wu Zhou " + // U+8210
+		"Dao Gang Shan Yi Fan Pa Tai Fan Ban Chuan Hang Fang Ban Que Hesaki Zhong " + // U+8220
+		"Jian Cang Ling Zhu Ze Duo Bo Xian Ge Chuan Jia Lu Hong Pang Xi Kua " + // U+8230
+		"Fu Zao Feng Li Shao Yu Lang Ting Yu Wei Bo Meng Nian Ju Huang Shou " + // U+8240
+		"Zong Bian Mao Die Dao Bang Cha Yi Sao Cang Cao Lou Dai Sori Yao Tong " + // U+8250
+		"Yofune Dang Tan Lu Yi Jie Jian Huo Meng Qi Lu Lu Chan Shuang Gen Liang " + // U+8260
+		"Jian Jian Se Yan Fu Ping Yan Yan Cao Cao Yi Le Ting Qiu Ai Nai " + // U+8270
+		"Tiao Jiao Jie Peng Wan Yi Chai Mian Mie Gan Qian Yu Yu Shuo Qiong Tu " + // U+8280
+		"Xia Qi Mang Zi Hui Sui Zhi Xiang Bi Fu Tun Wei Wu Zhi Qi Shan " + // U+8290
+		"Wen Qian Ren Fou Kou Jie Lu Xu Ji Qin Qi Yuan Fen Ba Rui Xin " + // U+82A0
+		"Ji Hua Hua Fang Wu Jue Gou Zhi Yun Qin Ao Chu Mao Ya Fei Reng " + // U+82B0
+		"Hang Cong Yin You Bian Yi Susa Wei Li Pi E Xian Chang Cang Meng Su " + // U+82C0
+		"Yi Yuan Ran Ling Tai Tiao Di Miao Qiong Li Yong Ke Mu Pei Bao Gou " + // U+82D0
+		"Min Yi Yi Ju Pi Ruo Ku Zhu Ni Bo Bing Shan Qiu Yao Xian Ben " + // U+82E0
+		"Hong Ying Zha Dong Ju Die Nie Gan Hu Ping Mei Fu Sheng Gu Bi Wei " + // U+82F0
+		"Fu Zhuo Mao Fan Qie Mao Mao Ba Zi Mo Zi Di Chi Ji Jing Long " + // U+8300
+		"Cong Niao Yuan Xue Ying Qiong Ge Ming Li Rong Yin Gen Qian Chai Chen Yu " + // U+8310
+		"Xiu Zi Lie Wu Ji Kui Ce Chong Ci Gou Guang Mang Chi Jiao Jiao Fu " + // U+8320
+		"Yu Zhu Zi Jiang Hui Yin Cha Fa Rong Ru Chong Mang Tong Zhong Qian Zhu " + // U+8330
+		"Xun Huan Kua Quan Gai Da Jing Xing Quan Cao Jing Er An Shou Chi Ren " + // U+8340
+		"Jian Ti Huang Ping Li Jin Lao Shu Zhuang Da Jia Rao Bi Ze Qiao Hui " + // U+8350
+		"Qi Dang Zi Rong Hun Ying Luo Ying Xun Jin Sun Yin Mai Hong Zhou Yao " + // U+8360
+		"Du Wei Chu Dou Fu Ren Yin He Bi Bu Yun Di Tu Sui Sui Cheng " + // U+8370
+		"Chen Wu Bie Xi Geng Li Fu Zhu Mo Li Zhuang Ji Duo Qiu Sha Suo " + // U+8380
+		"Chen Feng Ju Mei Meng Xing Jing Che Xin Jun Yan Ting Diao Cuo Wan Han " + // U+8390
+		"You Cuo Jia Wang You Niu Shao Xian Lang Fu E Mo Wen Jie Nan Mu " + // U+83A0
+		"Kan Lai Lian Shi Wo Usagi Lian Huo You Ying Ying Nuc Chun Mang Mang Ci " + // U+83B0
+		"Wan Jing Di Qu Dong Jian Zou Gu La Lu Ju Wei Jun Nie Kun He " + // U+83C0
+		"Pu Zi Gao Guo Fu Lun Chang Chou Song Chui Zhan Men Cai Ba Li Tu " + // U+83D0
+		"Bo Han Bao Qin Juan Xi Qin Di Jie Pu Dang Jin Zhao Tai Geng Hua " + // U+83E0
+		"Gu Ling Fei Jin An Wang Beng Zhou Yan Ju Jian Lin Tan Shu Tian Dao " + // U+83F0
+		"Hu Qi He Cui Tao Chun Bei Chang Huan Fei Lai Qi Meng Ping Wei Dan " + // U+8400
+		"Sha Huan Yan Yi Tiao Qi Wan Ce Nai Kutabireru Tuo Jiu Tie Luo Bi Yi " + // U+8410
+		"Meng Bo Yaji Ding Ying Ying Ying Xiao Sa Qiu Ke Xiang Wan Yu Yu Fu " + // U+8420
+		"Lian Xuan Yuan Nan Ze Wo Chun Xiao Yu Pian Mao An E Luo Ying Huo " + // U+8430
+		"Gua Jiang Mian Zuo Zuo Ju Bao Rou Xi Xie An Qu Jian Fu Lu Jing " + // U+8440
+		"Pen Feng Hong Hong Hou Yan Tu Zhu Zi Xiang Shen Ge Jie Jing Mi Huang " + // U+8450
+		"Shen Pu Gai Dong Zhou Qian Wei Bo Wei Pa Ji Hu Zang Jia Duan Yao " + // U+8460
+		"Jun Cong Quan Wei Xian Kui Ting Hun Xi Shi Qi Lan Zong Yao Yuan Mei " + // U+8470
+		"Yun Shu Di Zhuan Guan Sukumo Xue Chan Kai Kui Hua Jiang Lou Wei Pai You " + // U+8480
+		"Sou Yin Shi Chun Shi Yun Zhen Lang Nu Meng He Que Suan Yuan Li Ju " + // U+8490
+		"Xi Pang Chu Xu Tu Liu Wo Zhen Qian Zu Po Cuo Yuan Chu Yu Kuai " + // U+84A0
+		"Pan Pu Pu Na Shuo Xi Fen Yun Zheng Jian Ji Ruo Cang En Mi Hao " + // U+84B0
+		"Sun Zhen Ming Sou Xu Liu Xi Gu Lang Rong Weng Gai Cuo Shi Tang Luo " + // U+84C0
+		"Ru Suo Xian Bei Yao Gui Bi Zong Gun Za Xiu Ce Hai Lan Dan Ji " + // U+84D0
+		"Li Can Lang Yu Ling Ying Mo Diao Tiao Mao Tong Zhu Peng An Lian Cong " + // U+84E0
+		"Xi Ping Qiu Jin Chun Jie Wei Tui Cao Yu Yi Ji Liao Bi Lu Su " + // U+84F0
+		"Bu Zhang Luo Jiang Man Yan Ling Ji Piao Gun Han Di Su Lu She Shang " + // U+8500
+		"Di Mie Xun Man Bo Di Cuo Zhe Sen Xuan Wei Hu Ao Mi Lou Cu " + // U+8510
+		"Zhong Cai Po Jiang Mi Cong Niao Hui Jun Yin Jian Yan Shu Yin Kui Chen " + // U+8520
+		"Hu Sha Kou Qian Ma Zang Sonoko Qiang Dou Lian Lin Kou Ai Bi Li Wei " + // U+8530
+		"Ji Xun Sheng Fan Meng Ou Chan Dian Xun Jiao Rui Rui Lei Yu Qiao Chu " + // U+8540
+		"Hua Jian Mai Yun Bao You Qu Lu Rao Hui E Teng Fei Jue Zui Fa " + // U+8550
+		"Ru Fen Kui Shun Rui Ya Xu Fu Jue Dang Wu Tong Si Xiao Xi Long " + // U+8560
+		"Yun Shao Qi Jian Yun Sun Ling Yu Xia Yong Ji Hong Si Nong Lei Xuan " + // U+8570
+		"Yun Yu Xi Hao Bo Hao Ai Wei Hui Wei Ji Ci Xiang Luan Mie Yi " + // U+8580
+		"Leng Jiang Can Shen Qiang Lian Ke Yuan Da Ti Tang Xie Bi Zhan Sun Lian " + // U+8590
+		"Fan Ding Jie Gu Xie Shu Jian Kao Hong Sa Xin Xun Yao Hie Sou Shu " + // U+85A0
+		"Xun Dui Pin Wei Neng Chou Mai Ru Piao Tai Qi Zao Chen Zhen Er Ni " + // U+85B0
+		"Ying Gao Cong Xiao Qi Fa Jian Xu Kui Jie Bian Diao Mi Lan Jin Cang " + // U+85C0
+		"Miao Qiong Qie Xian Liao Ou Xian Su Lu Yi Xu Xie Li Yi La Lei " + // U+85D0
+		"Xiao Di Zhi Bei Teng Yao Mo Huan Piao Fan Sou Tan Tui Qiong Qiao Wei " + // U+85E0
+		"Liu Hui Ou Gao Yun Bao Li Shu Chu Ai Lin Zao Xuan Chen Lai Huo " + // U+85F0
+		"Tuo Wu Rui Rui Qi Heng Lu Su Tui Mang Yun Pin Yu Xun Ji Jiong " + // U+8600
+		"Xian Mo Hagi Su Jiong Peng Nie Bo Rang Yi Xian Yu Ju Lian Lian Yin " + // U+8610
+		"Qiang Ying Long Tong Wei Yue Ling Qu Yao Fan Mi Lan Kui Lan Ji Dang " + // U+8620
+		"Katsura Lei Lei Hua Feng Zhi Wei Kui Zhan Huai Li Ji Mi Lei Huai Luo " + // U+8630
+		"Ji Kui Lu Jian San Teng Lei Quan Xiao Yi Luan Men Bie Hu Hu Lu " + // U+8640
+		"Nue Lu Si Xiao Qian Chu Hu Xu Cuo Fu Xu Xu Lu Hu Yu Hao " + // U+8650
+		"Jiao Ju Guo Bao Yan Zhan Zhan Kui Ban Xi Shu Chong Qiu Diao Ji Qiu " + // U+8660
+		"Cheng Shi Xia Di Zhe She Yu Gan Zi Hong Hui Meng Ge Sui Xia Chai " + // U+8670
+		"Shi Yi Ma Xiang Fang E Pa Chi Qian Wen Wen Rui Bang Bi Yue Yue " + // U+8680
+		"Jun Qi Ran Yin Qi Tian Yuan Jue Hui Qin Qi Zhong Ya Ci Mu Wang " + // U+8690
+		"Fen Fen Hang Gong Zao Fu Ran Jie Fu Chi Dou Piao Xian Ni Te Qiu " + // U+86A0
+		"You Zha Ping Chi You He Han Ju Li Fu Ran Zha Gou Pi Bo Xian " + // U+86B0
+		"Zhu Diao Bie Bing Gu Ran Qu She Tie Ling Gu Dan Gu Ying Li Cheng " + // U+86C0
+		"Qu Mou Ge Ci Hui Hui Mang Fu Yang Wa Lie Zhu Yi Xian Kuo Jiao " + // U+86D0
+		"Li Yi Ping Ji Ha She Yi Wang Mo Qiong Qie Gui Gong Zhi Man Ebi " + // U+86E0
+		"Zhi Jia Rao Si Qi Xing Lie Qiu Shao Yong Jia Shui Che Bai E Han " + // U+86F0
+		"Shu Xuan Feng Shen Zhen Fu Xian Zhe Wu Fu Li Lang Bi Chu Yuan You " + // U+8700
+		"Jie Dan Yan Ting Dian Shui Hui Gua Zhi Song Fei Ju Mi Qi Qi Yu " + // U+8710
+		"Jun Zha Meng Qiang Si Xi Lun Li Die Tiao Tao Kun Gan Han Yu Bang " + // U+8720
+		"Fei Pi Wei Dun Yi Yuan Su Quan Qian Rui Ni Qing Wei Liang Guo Wan " + // U+8730
+		"Dong E Ban Di Wang Can Yang Ying Guo Chan Ding La Ke Ji He Ting " + // U+8740
+		"Mai Xu Mian Yu Jie Shi Xuan Huang Yan Bian Rou Wei Fu Yuan Mei Wei " + // U+8750
+		"Fu Ruan Xie You Qiu Mao Xia Ying Shi Chong Tang Zhu Zong Ti Fu Yuan " + // U+8760
+		"Hui Meng La Du Hu Qiu Die Li Gua Yun Ju Nan Lou Qun Rong Ying " + // U+8770
+		"Jiang Ban Lang Pang Si Xi Ci Xi Yuan Weng Lian Sou Ban Rong Rong Ji " + // U+8780
+		"Wu Qiu Han Qin Yi Bi Hua Tang Yi Du Nai He Hu Hui Ma Ming " + // U+8790
+		"Yi Wen Ying Teng Yu Cang So Ebi Man Tiao Shang Zhe Cao Chi Di Ao " + // U+87A0
+		"Lu Wei Zhi Tang Chen Piao Qu Pi Yu Jian Luo Lou Qin Zhong Yin Jiang " + // U+87B0
+		"Shuai Wen Jiao Wan Zhi Zhe Ma Ma Guo Liu Mao Xi Cong Li Man Xiao " + // U+87C0
+		"Kamakiri Zhang Mang Xiang Mo Zui Si Qiu Te Zhi Peng Peng Jiao Qu Bie Liao " + // U+87D0
+		"Pan Gui Xi Ji Zhuan Huang Fei Lao Jue Jue Hui Yin Chan Jiao Shan Rao " + // U+87E0
+		"Xiao Mou Chong Xun Si Chu Cheng Dang Li Xie Shan Yi Jing Da Chan Qi " + // U+87F0
+		"Ci Xiang She Luo Qin Ying Chai Li Ze Xuan Lian Zhu Ze Xie Mang Xie " + // U+8800
+		"Qi Rong Jian Meng Hao Ruan Huo Zhuo Jie Bin He Mie Fan Lei Jie La " + // U+8810
+		"Mi Li Chun Li Qiu Nie Lu Du Xiao Zhu Long Li Long Feng Ye Beng " + // U+8820
+		"Shang Gu Juan Ying Shu Xi Can Qu Quan Du Can Man Jue Jie Zhu Zha " + // U+8830
+		"Xie Huang Niu Pei Nu Xin Zhong Mo Er Ke Mie Xi Xing Yan Kan Yuan " + // U+8840
+		"Qu Ling Xuan Shu Xian Tong Long Jie Xian Ya Hu Wei Dao Chong Wei Dao " + // U+8850
+		"Zhun Heng Qu Yi Yi Bu Gan Yu Biao Cha Yi Shan Chen Fu Gun Fen " + // U+8860
+		"Shuai Jie Na Zhong Dan Ri Zhong Zhong Xie Qi Xie Ran Zhi Ren Qin Jin " + // U+8870
+		"Jun Yuan Mei Chai Ao Niao Hui Ran Jia Tuo Ling Dai Bao Pao Yao Zuo " + // U+8880
+		"Bi Shao Tan Ju He Shu Xiu Zhen Yi Pa Bo Di Wa Fu Gun Zhi " + // U+8890
+		"Zhi Ran Pan Yi Mao Tuo Na Kou Xian Chan Qu Bei Gun Xi Ne Bo " + // U+88A0
+		"Horo Fu Yi Chi Ku Ren Jiang Jia Cun Mo Jie Er Luo Ru Zhu Gui " + // U+88B0
+		"Yin Cai Lie Kamishimo Yuki Zhuang Dang Xu Kun Ken Niao Shu Jia Kun Cheng Li " + // U+88C0
+		"Juan Shen Pou Ge Yi Yu Zhen Liu Qiu Qun Ji Yi Bu Zhuang Shui Sha " + // U+88D0
+		"Qun Li Lian Lian Ku Jian Fou Chan Bi Gun Tao Yuan Ling Chi Chang Chou " + // U+88E0
+		"Duo Biao Liang Chang Pei Pei Fei Yuan Luo Guo Yan Du Xi Zhi Ju Qi " + // U+88F0
+		"Ji Zhi Gua Ken Che Ti Ti Fu Chong Xie Bian Die Kun Duan Xiu Xiu " + // U+8900
+		"He Yuan Bao Bao Fu Yu Tuan Yan Hui Bei Chu Lu Ena Hitoe Yun Da " + // U+8910
+		"Gou Da Huai Rong Yuan Ru Nai Jiong Suo Ban Tun Chi Sang Niao Ying Jie " + // U+8920
+		"Qian Huai Ku Lian Bao Li Zhe Shi Lu Yi Die Xie Xian Wei Biao Cao " + // U+8930
+		"Ji Jiang Sen Bao Xiang Chihaya Pu Jian Zhuan Jian Zui Ji Dan Za Fan Bo " + // U+8940
+		"Xiang Xin Bie Rao Man Lan Ao Duo Gui Cao Sui Nong Chan Lian Bi Jin " + // U+8950
+		"Dang Shu Tan Bi Lan Pu Ru Zhi Dui Shu Wa Shi Bai Xie Bo Chen " + // U+8960
+		"Lai Long Xi Xian Lan Zhe Dai Tasuki Zan Shi Jian Pan Yi Ran Ya Xi " + // U+8970
+		"Xi Yao Feng Tan Fu Biao Fu Ba He Ji Ji Jian Guan Bian Yan Gui " + // U+8980
+		"Jue Pian Mao Mi Mi Mie Shi Si Zhan Luo Jue Mi Tiao Lian Yao Zhi " + // U+8990
+		"Jun Xi Shan Wei Xi Tian Yu Lan E Du Qin Pang Ji Ming Ying Gou " + // U+89A0
+		"Qu Zhan Jin Guan Deng Jian Luo Qu Jian Wei Jue Qu Luo Lan Shen Di " + // U+89B0
+		"Guan Jian Guan Yan Gui Mi Shi Zhan Lan Jue Ji Xi Di Tian Yu Gou " + // U+89C0
+		"Jin Qu Jiao Jiu Jin Cu Jue Zhi Chao Ji Gu Dan Zui Di Shang Hua " + // U+89D0
+		"Quan Ge Chi Jie Gui Gong Hong Jie Hun Qiu Xing Su Ni Ji Lu Zhi " + // U+89E0
+		"Zha Bi Xing Hu Shang Gong Zhi Xue Chu Xi Yi Lu Jue Xi Yan Xi " + // U+89F0
+		"Yan Yan Ding Fu Qiu Qiu Jiao Hong Ji Fan Xun Diao Hong Cha Tao Xu " + // U+8A00
+		"Jie Yi Ren Xun Yin Shan Qi Tuo Ji Xun Yin E Fen Ya Yao Song " + // U+8A10
+		"Shen Yin Xin Jue Xiao Ne Chen You Zhi Xiong Fang Xin Chao She Xian Sha " + // U+8A20
+		"Tun Xu Yi Yi Su Chi He Shen He Xu Zhen Zhu Zheng Gou Zi Zi " + // U+8A30
+		"Zhan Gu Fu Quan Die Ling Di Yang Li Nao Pan Zhou Gan Yi Ju Ao " + // U+8A40
+		"Zha Tuo Yi Qu Zhao Ping Bi Xiong Qu Ba Da Zu Tao Zhu Ci Zhe " + // U+8A50
+		"Yong Xu Xun Yi Huang He Shi Cha Jiao Shi Hen Cha Gou Gui Quan Hui " + // U+8A60
+		"Jie Hua Gai Xiang Wei Shen Chou Tong Mi Zhan Ming E Hui Yan Xiong Gua " + // U+8A70
+		"Er Beng Tiao Chi Lei Zhu Kuang Kua Wu Yu Teng Ji Zhi Ren Su Lang " + // U+8A80
+		"E Kuang E Shi Ting Dan Bo Chan You Heng Qiao Qin Shua An Yu Xiao " + // U+8A90
+		"Cheng Jie Xian Wu Wu Gao Song Pu Hui Jing Shuo Zhen Shuo Du Yasashi Chang " + // U+8AA0
+		"Shui Jie Ke Qu Cong Xiao Sui Wang Xuan Fei Chi Ta Yi Na Yin Diao " + // U+8AB0
+		"Pi Chuo Chan Chen Zhun Ji Qi Tan Zhui Wei Ju Qing Jian Zheng Ze Zou " + // U+8AC0
+		"Qian Zhuo Liang Jian Zhu Hao Lun Shen Biao Huai Pian Yu Die Xu Pian Shi " + // U+8AD0
+		"Xuan Shi Hun Hua E Zhong Di Xie Fu Pu Ting Jian Qi Yu Zi Chuan " + // U+8AE0
+		"Xi Hui Yin An Xian Nan Chen Feng Zhu Yang Yan Heng Xuan Ge Nuo Qi " + // U+8AF0
+		"Mou Ye Wei Xing Teng Zou Shan Jian Bo Ku Huang Huo Ge Ying Mi Xiao " + // U+8B00
+		"Mi Xi Qiang Chen Nue Ti Su Bang Chi Qian Shi Jiang Yuan Xie Xue Tao " + // U+8B10
+		"Yao Yao Lu Yu Biao Cong Qing Li Mo Mo Shang Zhe Miu Jian Ze Jie " + // U+8B20
+		"Lian Lou Can Ou Guan Xi Zhuo Ao Ao Jin Zhe Yi Hu Jiang Man Chao " + // U+8B30
+		"Han Hua Chan Xu Zeng Se Xi She Dui Zheng Nao Lan E Ying Jue Ji " + // U+8B40
+		"Zun Jiao Bo Hui Zhuan Mu Zen Zha Shi Qiao Tan Zen Pu Sheng Xuan Zao " + // U+8B50
+		"Tan Dang Sui Qian Ji Jiao Jing Lian Nou Yi Ai Zhan Pi Hui Hua Yi " + // U+8B60
+		"Yi Shan Rang Nou Qian Zhui Ta Hu Zhou Hao Ye Ying Jian Yu Jian Hui " + // U+8B70
+		"Du Zhe Xuan Zan Lei Shen Wei Chan Li Yi Bian Zhe Yan E Chou Wei " + // U+8B80
+		"Chou Yao Chan Rang Yin Lan Chen Huo Zhe Huan Zan Yi Dang Zhan Yan Du " + // U+8B90
+		"Yan Ji Ding Fu Ren Ji Jie Hong Tao Rang Shan Qi Tuo Xun Yi Xun " + // U+8BA0
+		"Ji Ren Jiang Hui Ou Ju Ya Ne Xu E Lun Xiong Song Feng She Fang " + // U+8BB0
+		"Jue Zheng Gu He Ping Zu Shi Xiong Zha Su Zhen Di Zou Ci Qu Zhao " + // U+8BC0
+		"Bi Yi Yi Kuang Lei Shi Gua Shi Jie Hui Cheng Zhu Shen Hua Dan Gou " + // U+8BD0
+		"Quan Gui Xun Yi Zheng Gai Xiang Cha Hun Xu Zhou Jie Wu Yu Qiao Wu " + // U+8BE0
+		"Gao You Hui Kuang Shuo Song Ai Qing Zhu Zou Nuo Du Zhuo Fei Ke Wei " + // U+8BF0
+		"Yu Shui Shen Diao Chan Liang Zhun Sui Tan Shen Yi Mou Chen Die Huang Jian " + // U+8C00
+		"Xie Nue Ye Wei E Yu Xuan Chan Zi An Yan Di Mi Pian Xu Mo " + // U+8C10
+		"Dang Su Xie Yao Bang Shi Qian Mi Jin Man Zhe Jian Miu Tan Zen Qiao " + // U+8C20
+		"Lan Pu Jue Yan Qian Zhan Chen Gu Qian Hong Xia Jue Hong Han Hong Xi " + // U+8C30
+		"Xi Huo Liao Han Du Long Dou Jiang Qi Shi Li Deng Wan Bi Shu Xian " + // U+8C40
+		"Feng Zhi Zhi Yan Yan Shi Chu Hui Tun Yi Tun Yi Jian Ba Hou E " + // U+8C50
+		"Cu Xiang Huan Jian Ken Gai Qu Fu Xi Bin Hao Yu Zhu Jia Fen Xi " + // U+8C60
+		"Bo Wen Huan Bin Di Zong Fen Yi Zhi Bao Chai Han Pi Na Pi Gou " + // U+8C70
+		"Na You Diao Mo Si Xiu Huan Kun He He Mo Han Mao Li Ni Bi " + // U+8C80
+		"Yu Jia Tuan Mao Pi Xi E Ju Mo Chu Tan Huan Jue Bei Zhen Yuan " + // U+8C90
+		"Fu Cai Gong Te Yi Hang Wan Pin Huo Fan Tan Guan Ze Zhi Er Zhu " + // U+8CA0
+		"Shi Bi Zi Er Gui Pian Bian Mai Dai Sheng Kuang Fei Tie Yi Chi Mao " + // U+8CB0
+		"He Bi Lu Ren Hui Gai Pian Zi Jia Xu Zei Jiao Gai Zang Jian Ying " + // U+8CC0
+		"Xun Zhen She Bin Bin Qiu She Chuan Zang Zhou Lai Zan Si Chen Shang Tian " + // U+8CD0
+		"Pei Geng Xian Mai Jian Sui Fu Tan Cong Cong Zhi Ji Zhang Du Jin Xiong " + // U+8CE0
+		"Shun Yun Bao Zai Lai Feng Cang Ji Sheng Ai Zhuan Fu Gou Sai Ze Liao " + // U+8CF0
+		"Wei Bai Chen Zhuan Zhi Zhui Biao Yun Zeng Tan Zan Yan Pu Shan Wan Ying " + // U+8D00
+		"Jin Gan Xian Zang Bi Du Shu Yan Shang Xuan Long Gan Zang Bei Zhen Fu " + // U+8D10
+		"Yuan Gong Cai Ze Xian Bai Zhang Huo Zhi Fan Tan Pin Bian Gou Zhu Guan " + // U+8D20
+		"Er Jian Bi Shi Tie Gui Kuang Dai Mao Fei He Yi Zei Zhi Jia Hui " + // U+8D30
+		"Zi Ren Lu Zang Zi Gai Jin Qiu Zhen Lai She Fu Du Ji Shu Shang " + // U+8D40
+		"Si Bi Zhou Geng Pei Tan Lai Feng Zhui Fu Zhuan Sai Ze Yan Zan Yun " + // U+8D50
+		"Zeng Shan Ying Gan Chi Xi She Nan Xiong Xi Cheng He Cheng Zhe Xia Tang " + // U+8D60
+		"Zou Zou Li Jiu Fu Zhao Gan Qi Shan Qiong Qin Xian Ci Jue Qin Chi " + // U+8D70
+		"Ci Chen Chen Die Ju Chao Di Se Zhan Zhu Yue Qu Jie Chi Chu Gua " + // U+8D80
+		"Xue Ci Tiao Duo Lie Gan Suo Cu Xi Zhao Su Yin Ju Jian Que Tang " + // U+8D90
+		"Chuo Cui Lu Qu Dang Qiu Zi Ti Qu Chi Huang Qiao Qiao Yao Zao Ti " + // U+8DA0
+		"Er Zan Zan Zu Pa Bao Ku Ke Dun Jue Fu Chen Jian Fang Zhi Sa " + // U+8DB0
+		"Yue Pa Qi Yue Qiang Tuo Tai Yi Nian Ling Mei Ba Die Ku Tuo Jia " + // U+8DC0
+		"Ci Pao Qia Zhu Ju Die Zhi Fu Pan Ju Shan Bo Ni Ju Li Gen " + // U+8DD0
+		"Yi Ji Dai Xian Jiao Duo Zhu Zhuan Kua Zhuai Gui Qiong Kui Xiang Chi Lu " + // U+8DE0
+		"Beng Zhi Jia Tiao Cai Jian Ta Qiao Bi Xian Duo Ji Ju Ji Shu Tu " + // U+8DF0
+		"Chu Jing Nie Xiao Bo Chi Qun Mou Shu Lang Yong Jiao Chou Qiao Mou Ta " + // U+8E00
+		"Jian Qi Wo Wei Zhuo Jie Ji Nie Ju Ju Lun Lu Leng Huai Ju Chi " + // U+8E10
+		"Wan Quan Ti Bo Zu Qie Ji Cu Zong Cai Zong Peng Zhi Zheng Dian Zhi " + // U+8E20
+		"Yu Duo Dun Chun Yong Zhong Di Zhe Chen Chuai Jian Gua Tang Ju Fu Zu " + // U+8E30
+		"Die Pian Rou Nuo Ti Cha Tui Jian Dao Cuo Xi Ta Qiang Zhan Dian Ti " + // U+8E40
+		"Ji Nie Man Liu Zhan Bi Chong Lu Liao Cu Tang Dai Suo Xi Kui Ji " + // U+8E50
+		"Zhi Qiang Di Man Zong Lian Beng Zao Nian Bie Tui Ju Deng Ceng Xian Fan " + // U+8E60
+		"Chu Zhong Dun Bo Cu Zu Jue Jue Lin Ta Qiao Qiao Pu Liao Dun Cuan " + // U+8E70
+		"Kuang Zao Ta Bi Bi Zhu Ju Chu Qiao Dun Chou Ji Wu Yue Nian Lin " + // U+8E80
+		"Lie Zhi Li Zhi Chan Chu Duan Wei Long Lin Xian Wei Zuan Lan Xie Rang " + // U+8E90
+		"Xie Nie Ta Qu Jie Cuan Zuan Xi Kui Jue Lin Shen Gong Dan Segare Qu " + // U+8EA0
+		"Ti Duo Duo Gong Lang Nerau Luo Ai Ji Ju Tang Utsuke Lao Yan Shitsuke Kang " + // U+8EB0
+		"Qu Lou Lao Tuo Zhi Yagate Ti Dao Yagate Yu Che Ya Gui Jun Wei Yue " + // U+8EC0
+		"Xin Di Xuan Fan Ren Shan Qiang Shu Tun Chen Dai E Na Qi Mao Ruan " + // U+8ED0
+		"Ren Fan Zhuan Hong Hu Qu Huang Di Ling Dai Ao Zhen Fan Kuang Ang Peng " + // U+8EE0
+		"Bei Gu Ku Pao Zhu Rong E Ba Zhou Zhi Yao Ke Yi Qing Shi Ping " + // U+8EF0
+		"Er Qiong Ju Jiao Guang Lu Kai Quan Zhou Zai Zhi She Liang Yu Shao You " + // U+8F00
+		"Huan Yun Zhe Wan Fu Qing Zhou Ni Ling Zhe Zhan Liang Zi Hui Wang Chuo " + // U+8F10
+		"Guo Kan Yi Peng Qian Gun Nian Pian Guan Bei Lun Pai Liang Ruan Rou Ji " + // U+8F20
+		"Yang Xian Chuan Cou Qun Ge You Hong Shu Fu Zi Fu Wen Ben Zhan Yu " + // U+8F30
+		"Wen Tao Gu Zhen Xia Yuan Lu Jiu Chao Zhuan Wei Hun Sori Che Jiao Zhan " + // U+8F40
+		"Pu Lao Fen Fan Lin Ge Se Kan Huan Yi Ji Dui Er Yu Xian Hong " + // U+8F50
+		"Lei Pei Li Li Lu Lin Che Ya Gui Xuan Di Ren Zhuan E Lun Ruan " + // U+8F60
+		"Hong Ku Ke Lu Zhou Zhi Yi Hu Zhen Li Yao Qing Shi Zai Zhi Jiao " + // U+8F70
+		"Zhou Quan Lu Jiao Zhe Fu Liang Nian Bei Hui Gun Wang Liang Chuo Zi Cou " + // U+8F80
+		"Fu Ji Wen Shu Pei Yuan Xia Zhan Lu Che Lin Xin Gu Ci Ci Pi " + // U+8F90
+		"Zui Bian La La Ci Xue Ban Bian Bian Bian Xue Bian Ban Ci Bian Bian " + // U+8FA0
+		"Chen Ru Nong Nong Zhen Chuo Chuo Suberu Reng Bian Bian Sip Ip Liao Da Chan " + // U+8FB0
+		"Gan Qian Yu Yu Qi Xun Yi Guo Mai Qi Za Wang Jia Zhun Ying Ti " + // U+8FC0
+		"Yun Jin Hang Ya Fan Wu Da E Huan Zhe Totemo Jin Yuan Wei Lian Chi " + // U+8FD0
+		"Che Ni Tiao Zhi Yi Jiong Jia Chen Dai Er Di Po Wang Die Ze Tao " + // U+8FE0
+		"Shu Tuo Kep Jing Hui Tong You Mi Beng Ji Nai Yi Jie Zhui Lie Xun " + // U+8FF0
+		"Tui Song Gua Tao Pang Hou Ni Dun Jiong Xuan Xun Bu You Xiao Qiu Tou " + // U+9000
+		"Zhu Qiu Di Di Tu Jing Ti Dou Yi Zhe Tong Guang Wu Shi Cheng Su " + // U+9010
+		"Zao Qun Feng Lian Suo Hui Li Sako Lai Ben Cuo Jue Beng Huan Dai Lu " + // U+9020
+		"You Zhou Jin Yu Chuo Kui Wei Ti Yi Da Yuan Luo Bi Nuo Yu Dang " + // U+9030
+		"Sui Dun Sui Yan Chuan Chi Ti Yu Shi Zhen You Yun E Bian Guo E " + // U+9040
+		"Xia Huang Qiu Dao Da Wei Appare Yi Gou Yao Chu Liu Xun Ta Di Chi " + // U+9050
+		"Yuan Su Ta Qian Ma Yao Guan Zhang Ao Shi Ce Chi Su Zao Zhe Dun " + // U+9060
+		"Di Lou Chi Cuo Lin Zun Rao Qian Xuan Yu Yi Wu Liao Ju Shi Bi " + // U+9070
+		"Yao Mai Xie Sui Huan Zhan Teng Er Miao Bian Bian La Li Yuan Yao Luo " + // U+9080
+		"Li Yi Ting Deng Qi Yong Shan Han Yu Mang Ru Qiong Xi Kuang Fu Kang " + // U+9090
+		"Bin Fang Xing Na Xin Shen Bang Yuan Cun Huo Xie Bang Wu Ju You Han " + // U+90A0
+		"Tai Qiu Bi Pei Bing Shao Bei Wa Di Zou Ye Lin Kuang Gui Zhu Shi " + // U+90B0
+		"Ku Yu Gai Ge Xi Zhi Ji Xun Hou Xing Jiao Xi Gui Nuo Lang Jia " + // U+90C0
+		"Kuai Zheng Otoko Yun Yan Cheng Dou Chi Lu Fu Wu Fu Gao Hao Lang Jia " + // U+90D0
+		"Geng Jun Ying Bo Xi Bei Li Yun Bu Xiao Qi Pi Qing Guo Zhou Tan " + // U+90E0
+		"Zou Ping Lai Ni Chen You Bu Xiang Dan Ju Yong Qiao Yi Du Yan Mei " + // U+90F0
+		"Ruo Bei E Yu Juan Yu Yun Hou Kui Xiang Xiang Sou Tang Ming Xi Ru " + // U+9100
+		"Chu Zi Zou Ju Wu Xiang Yun Hao Yong Bi Mo Chao Fu Liao Yin Zhuan " + // U+9110
+		"Hu Qiao Yan Zhang Fan Qiao Xu Deng Bi Xin Bi Ceng Wei Zheng Mao Shan " + // U+9120
+		"Lin Po Dan Meng Ye Cao Kuai Feng Meng Zou Kuang Lian Zan Chan You Qi " + // U+9130
+		"Yan Chan Zan Ling Huan Xi Feng Zan Li You Ding Qiu Zhuo Pei Zhou Yi " + // U+9140
+		"Hang Yu Jiu Yan Zui Mao Dan Xu Tou Zhen Fen Sakenomoto Fu Yun Tai Tian " + // U+9150
+		"Qia Tuo Zuo Han Gu Su Po Chou Zai Ming Luo Chuo Chou You Tong Zhi " + // U+9160
+		"Xian Jiang Cheng Yin Tu Xiao Mei Ku Suan Lei Pu Zui Hai Yan Xi Niang " + // U+9170
+		"Wei Lu Lan Yan Tao Pei Zhan Chun Tan Zui Chuo Cu Kun Ti Mian Du " + // U+9180
+		"Hu Xu Xing Tan Jiu Chun Yun Po Ke Sou Mi Quan Chou Cuo Yun Yong " + // U+9190
+		"Ang Zha Hai Tang Jiang Piao Shan Yu Li Zao Lao Yi Jiang Pu Jiao Xi " + // U+91A0
+		"Tan Po Nong Yi Li Ju Jiao Yi Niang Ru Xun Chou Yan Ling Mi Mi " + // U+91B0
+		"Niang Xin Jiao Xi Mi Yan Bian Cai Shi You Shi Shi Li Zhong Ye Liang " + // U+91C0
+		"Li Jin Jin Qiu Yi Diao Dao Zhao Ding Po Qiu He Fu Zhen Zhi Ba " + // U+91D0
+		"Luan Fu Nai Diao Shan Qiao Kou Chuan Zi Fan Yu Hua Han Gong Qi Mang " + // U+91E0
+		"Ri Di Si Xi Yi Chai Shi Tu Xi Nu Qian Ishiyumi Jian Pi Ye Yin " + // U+91F0
+		"Ba Fang Chen Xing Tou Yue Yan Fu Pi Na Xin E Jue Dun Gou Yin " + // U+9200
+		"Qian Ban Ji Ren Chao Niu Fen Yun Ji Qin Pi Guo Hong Yin Jun Shi " + // U+9210
+		"Yi Zhong Nie Gai Ri Huo Tai Kang Habaki Irori Ngaak Qin Duo Zi Ni Tu " + // U+9220
+		"Shi Min Gu E Ling Bing Yi Gu Ba Pi Yu Si Zuo Bu You Dian " + // U+9230
+		"Jia Zhen Shi Shi Tie Ju Zhan Shi She Xuan Zhao Bao He Bi Sheng Chu " + // U+9240
+		"Shi Bo Zhu Chi Za Po Tong Qian Fu Zhai Liu Qian Fu Li Yue Pi " + // U+9250
+		"Yang Ban Bo Jie Gou Shu Zheng Mu Ni Nie Di Jia Mu Dan Shen Yi " + // U+9260
+		"Si Kuang Ka Bei Jian Tong Xing Hong Jiao Chi Er Ge Bing Shi Mou Jia " + // U+9270
+		"Yin Jun Zhou Chong Shang Tong Mo Lei Ji Yu Xu Ren Zun Zhi Qiong Shan " + // U+9280
+		"Chi Xian Xing Quan Pi Tie Zhu Hou Ming Kua Yao Xian Xian Xiu Jun Cha " + // U+9290
+		"Lao Ji Pi Ru Mi Yi Yin Guang An Diou You Se Kao Qian Luan Kasugai " + // U+92A0
+		"Ai Diao Han Rui Shi Keng Qiu Xiao Zhe Xiu Zang Ti Cuo Gua Gong Zhong " + // U+92B0
+		"Dou Lu Mei Lang Wan Xin Yun Bei Wu Su Yu Chan Ting Bo Han Jia " + // U+92C0
+		"Hong Cuan Feng Chan Wan Zhi Si Xuan Wu Wu Tiao Gong Zhuo Lue Xing Qian " + // U+92D0
+		"Shen Han Lue Xie Chu Zheng Ju Xian Tie Mang Pu Li Pan Rui Cheng Gao " + // U+92E0
+		"Li Te Pyeng Zhu Zhen Tu Liu Zui Ju Chang Yuan Jian Gang Diao Tao Chang " + // U+92F0
+		"Lun Kua Ling Bei Lu Li Qiang Pou Juan Min Zui Peng An Pi Xian Ya " + // U+9300
+		"Zhui Lei A Kong Ta Kun Du Wei Chui Zi Zheng Ben Nie Cong Qun Tan " + // U+9310
+		"Ding Qi Qian Zhuo Qi Yu Jin Guan Mao Chang Tian Xi Lian Tao Gu Cuo " + // U+9320
+		"Shu Zhen Lu Meng Lu Hua Biao Ga Lai Ken Kazari Bu Nai Wan Zan Hu " + // U+9330
+		"De Xian Pian Huo Liang Fa Men Kai Ying Di Lian Guo Xian Du Tu Wei " + // U+9340
+		"Cong Fu Rou Ji E Rou Chen Ti Zha Hong Yang Duan Xia Yu Keng Xing " + // U+9350
+		"Huang Wei Fu Zhao Cha Qie She Hong Kui Tian Mou Qiao Qiao Hou Tou Cong " + // U+9360
+		"Huan Ye Min Jian Duan Jian Song Kui Hu Xuan Duo Jie Zhen Bian Zhong Zi " + // U+9370
+		"Xiu Ye Mei Pai Ai Jie Qian Mei Chuo Ta Bang Xia Lian Suo Xi Liu " + // U+9380
+		"Zu Ye Nou Weng Rong Tang Suo Qiang Ge Shuo Chui Bo Pan Sa Bi Sang " + // U+9390
+		"Gang Zi Wu Ying Huang Tiao Liu Kai Sun Sha Sou Wan Hao Zhen Zhen Luo " + // U+93A0
+		"Yi Yuan Tang Nie Xi Jia Ge Ma Juan Kasugai Habaki Suo Xia Feng Wen Na " + // U+93B0
+		"Lu Suo Ou Zu Tuan Xiu Guan Xuan Lian Shou Ao Man Mo Luo Bi Wei " + // U+93C0
+		"Liu Di Qiao Cong Yi Lu Ao Keng Qiang Cui Qi Chang Tang Man Yong Chan " + // U+93D0
+		"Feng Jing Biao Shu Lou Xiu Cong Long Zan Jian Cao Li Xia Xi Kang Shuang " + // U+93E0
+		"Beng Zhang Qian Zheng Lu Hua Ji Pu Hui Qiang Po Lin Suo Xiu San Cheng " + // U+93F0
+		"Kui Si Liu Nao Heng Pie Sui Fan Qiao Quan Yang Tang Xiang Jue Jiao Zun " + // U+9400
+		"Liao Jie Lao Dui Tan Zan Ji Jian Zhong Deng Ya Ying Dui Jue Nou Ti " + // U+9410
+		"Pu Tie Fan Cheng Ding Shan Kai Jian Fei Sui Lu Juan Hui Yu Lian Zhuo " + // U+9420
+		"Qiao Qian Zhuo Lei Bi Tie Huan Ye Duo Guo Dang Ju Fen Da Bei Yi " + // U+9430
+		"Ai Zong Xun Diao Zhu Heng Zhui Ji Nie Ta Huo Qing Bin Ying Kui Ning " + // U+9440
+		"Xu Jian Jian Yari Cha Zhi Mie Li Lei Ji Zuan Kuang Shang Peng La Du " + // U+9450
+		"Shuo Chuo Lu Biao Bao Lu Xian Kuan Long E Lu Xin Jian Lan Bo Jian " + // U+9460
+		"Yao Chan Xiang Jian Xi Guan Cang Nie Lei Cuan Qu Pan Luo Zuan Luan Zao " + // U+9470
+		"Nie Jue Tang Shu Lan Jin Qiu Yi Zhen Ding Zhao Po Diao Tu Qian Chuan " + // U+9480
+		"Shan Ji Fan Diao Men Nu Xi Chai Xing Gai Bu Tai Ju Dun Chao Zhong " + // U+9490
+		"Na Bei Gang Ban Qian Yao Qin Jun Wu Gou Kang Fang Huo Tou Niu Ba " + // U+94A0
+		"Yu Qian Zheng Qian Gu Bo E Po Bu Ba Yue Zuan Mu Dan Jia Dian " + // U+94B0
+		"You Tie Bo Ling Shuo Qian Liu Bao Shi Xuan She Bi Ni Pi Duo Xing " + // U+94C0
+		"Kao Lao Er Mang Ya You Cheng Jia Ye Nao Zhi Dang Tong Lu Diao Yin " + // U+94D0
+		"Kai Zha Zhu Xian Ting Diu Xian Hua Quan Sha Jia Yao Ge Ming Zheng Se " + // U+94E0
+		"Jiao Yi Chan Chong Tang An Yin Ru Zhu Lao Pu Wu Lai Te Lian Keng " + // U+94F0
+		"Xiao Suo Li Zheng Chu Guo Gao Tie Xiu Cuo Lue Feng Xin Liu Kai Jian " + // U+9500
+		"Rui Ti Lang Qian Ju A Qiang Duo Tian Cuo Mao Ben Qi De Kua Kun " + // U+9510
+		"Chang Xi Gu Luo Chui Zhui Jin Zhi Xian Juan Huo Pou Tan Ding Jian Ju " + // U+9520
+		"Meng Zi Qie Ying Kai Qiang Song E Cha Qiao Zhong Duan Sou Huang Huan Ai " + // U+9530
+		"Du Mei Lou Zi Fei Mei Mo Zhen Bo Ge Nie Tang Juan Nie Na Liu " + // U+9540
+		"Hao Bang Yi Jia Bin Rong Biao Tang Man Luo Beng Yong Jing Di Zu Xuan " + // U+9550
+		"Liu Tan Jue Liao Pu Lu Dui Lan Pu Cuan Qiang Deng Huo Lei Huan Zhuo " + // U+9560
+		"Lian Yi Cha Biao La Chan Xiang Chang Chang Jiu Ao Die Qu Liao Mi Chang " + // U+9570
+		"Men Ma Shuan Shan Huo Men Yan Bi Han Bi San Kai Kang Beng Hong Run " + // U+9580
+		"San Xian Xian Jian Min Xia Yuru Dou Zha Nao Jian Peng Xia Ling Bian Bi " + // U+9590
+		"Run He Guan Ge Ge Fa Chu Hong Gui Min Se Kun Lang Lu Ting Sha " + // U+95A0
+		"Ju Yue Yue Chan Qu Lin Chang Shai Kun Yan Min Yan E Hun Yu Wen " + // U+95B0
+		"Xiang Bao Xiang Qu Yao Wen Ban An Wei Yin Kuo Que Lan Du Quan Phwung " + // U+95C0
+		"Tian Nie Ta Kai He Que Chuang Guan Dou Qi Kui Tang Guan Piao Kan Xi " + // U+95D0
+		"Hui Chan Pi Dang Huan Ta Wen Ta Men Shuan Shan Yan Han Bi Wen Chuang " + // U+95E0
+		"Run Wei Xian Hong Jian Min Kang Men Zha Nao Gui Wen Ta Min Lu Kai " + // U+95F0
+		"Fa Ge He Kun Jiu Yue Lang Du Yu Yan Chang Xi Wen Hun Yan E " + // U+9600
+		"Chan Lan Qu Hui Kuo Que Ge Tian Ta Que Kan Huan Fu Fu Le Dui " + // U+9610
+		"Xin Qian Wu Yi Tuo Yin Yang Dou E Sheng Ban Pei Keng Yun Ruan Zhi " + // U+9620
+		"Pi Jing Fang Yang Yin Zhen Jie Cheng E Qu Di Zu Zuo Dian Ling A " + // U+9630
+		"Tuo Tuo Po Bing Fu Ji Lu Long Chen Xing Duo Lou Mo Jiang Shu Duo " + // U+9640
+		"Xian Er Gui Yu Gai Shan Xun Qiao Xing Chun Fu Bi Xia Shan Sheng Zhi " + // U+9650
+		"Pu Dou Yuan Zhen Chu Xian Tou Nie Yun Xian Pei Pei Zou Yi Dui Lun " + // U+9660
+		"Yin Ju Chui Chen Pi Ling Tao Xian Lu Sheng Xian Yin Zhu Yang Reng Shan " + // U+9670
+		"Chong Yan Yin Yu Ti Yu Long Wei Wei Nie Dui Sui An Huang Jie Sui " + // U+9680
+		"Yin Gai Yan Hui Ge Yun Wu Wei Ai Xi Tang Ji Zhang Dao Ao Xi " + // U+9690
+		"Yin Sa Rao Lin Tui Deng Pi Sui Sui Yu Xian Fen Ni Er Ji Dao " + // U+96A0
+		"Xi Yin E Hui Long Xi Li Li Li Zhui He Zhi Zhun Jun Nan Yi " + // U+96B0
+		"Que Yan Qian Ya Xiong Ya Ji Gu Huan Zhi Gou Jun Ci Yong Ju Chu " + // U+96C0
+		"Hu Za Luo Yu Chou Diao Sui Han Huo Shuang Guan Chu Za Yong Ji Xi " + // U+96D0
+		"Chou Liu Li Nan Xue Za Ji Ji Yu Yu Xue Na Fou Se Mu Wen " + // U+96E0
+		"Fen Pang Yun Li Li Ang Ling Lei An Bao Meng Dian Dang Xing Wu Zhao " + // U+96F0
+		"Xu Ji Mu Chen Xiao Zha Ting Zhen Pei Mei Ling Qi Chou Huo Sha Fei " + // U+9700
+		"Weng Zhan Yin Ni Chou Tun Lin Ling Dong Ying Wu Ling Shuang Ling Xia Hong " + // U+9710
+		"Yin Mo Mai Yun Liu Meng Bin Wu Wei Huo Yin Xi Yi Ai Dan Deng " + // U+9720
+		"Xian Yu Lu Long Dai Ji Pang Yang Ba Pi Wei Feng Xi Ji Mai Meng " + // U+9730
+		"Meng Lei Li Huo Ai Fei Dai Long Ling Ai Feng Li Bao He He He " + // U+9740
+		"Bing Qing Qing Jing Tian Zhen Jing Cheng Qing Jing Jing Dian Jing Tian Fei Fei " + // U+9750
+		"Kao Mi Mian Mian Pao Ye Tian Hui Ye Ge Ding Cha Jian Ren Di Du " + // U+9760
+		"Wu Ren Qin Jin Xue Niu Ba Yin Sa Na Mo Zu Da Ban Yi Yao " + // U+9770
+		"Tao Tuo Jia Hong Pao Yang Tomo Yin Jia Tao Ji Xie An An Hen Gong " + // U+9780
+		"Kohaze Da Qiao Ting Wan Ying Sui Tiao Qiao Xuan Kong Beng Ta Zhang Bing Kuo " + // U+9790
+		"Ju La Xie Rou Bang Yi Qiu Qiu He Xiao Mu Ju Jian Bian Di Jian " + // U+97A0
+		"On Tao Gou Ta Bei Xie Pan Ge Bi Kuo Tang Lou Gui Qiao Xue Ji " + // U+97B0
+		"Jian Jiang Chan Da Huo Xian Qian Du Wa Jian Lan Wei Ren Fu Mei Juan " + // U+97C0
+		"Ge Wei Qiao Han Chang Kuo Rou Xun She Wei Ge Bei Tao Gou Yun Gao " + // U+97D0
+		"Bi Wei Hui Du Wa Du Wei Ren Fu Han Wei Yun Tao Jiu Jiu Xian " + // U+97E0
+		"Xie Xian Ji Yin Za Yun Shao Le Peng Heng Ying Yun Peng Yin Yin Xiang " + // U+97F0
+		"Hu Ye Ding Qing Pan Xiang Shun Han Xu Yi Xu Gu Song Kui Qi Hang " + // U+9800
+		"Yu Wan Ban Dun Di Dan Pan Po Ling Ce Jing Lei He Qiao E E " + // U+9810
+		"Wei Jie Gua Shen Yi Shen Hai Dui Pian Ping Lei Fu Jia Tou Hui Kui " + // U+9820
+		"Jia Le Tian Cheng Ying Jun Hu Han Jing Tui Tui Pin Lai Tui Zi Zi " + // U+9830
+		"Chui Ding Lai Yan Han Jian Ke Cui Jiong Qin Yi Sai Ti E E Yan " + // U+9840
+		"Hun Kan Yong Zhuan Yan Xian Xin Yi Yuan Sang Dian Dian Jiang Ku Lei Liao " + // U+9850
+		"Piao Yi Man Qi Rao Hao Qiao Gu Xun Qian Hui Zhan Ru Hong Bin Xian " + // U+9860
+		"Pin Lu Lan Nie Quan Ye Ding Qing Han Xiang Shun Xu Xu Wan Gu Dun " + // U+9870
+		"Qi Ban Song Hang Yu Lu Ling Po Jing Jie Jia Tian Han Ying Jiong Hai " + // U+9880
+		"Yi Pin Hui Tui Han Ying Ying Ke Ti Yong E Zhuan Yan E Nie Man " + // U+9890
+		"Dian Sang Hao Lei Zhan Ru Pin Quan Feng Biao Oroshi Fu Xia Zhan Biao Sa " + // U+98A0
+		"Ba Tai Lie Gua Xuan Shao Ju Bi Si Wei Yang Yao Sou Kai Sao Fan " + // U+98B0
+		"Liu Xi Liao Piao Piao Liu Biao Biao Biao Liao Biao Se Feng Biao Feng Yang " + // U+98C0
+		"Zhan Biao Sa Ju Si Sou Yao Liu Piao Biao Biao Fei Fan Fei Fei Shi " + // U+98D0
+		"Shi Can Ji Ding Si Tuo Zhan Sun Xiang Tun Ren Yu Juan Chi Yin Fan " + // U+98E0
+		"Fan Sun Yin Zhu Yi Zhai Bi Jie Tao Liu Ci Tie Si Bao Shi Duo " + // U+98F0
+		"Hai Ren Tian Jiao Jia Bing Yao Tong Ci Xiang Yang Yang Er Yan Le Yi " + // U+9900
+		"Can Bo Nei E Bu Jun Dou Su Yu Shi Yao Hun Guo Shi Jian Zhui " + // U+9910
+		"Bing Xian Bu Ye Tan Fei Zhang Wei Guan E Nuan Hun Hu Huang Tie Hui " + // U+9920
+		"Jian Hou He Xing Fen Wei Gu Cha Song Tang Bo Gao Xi Kui Liu Sou " + // U+9930
+		"Tao Ye Yun Mo Tang Man Bi Yu Xiu Jin San Kui Zhuan Shan Chi Dan " + // U+9940
+		"Yi Ji Rao Cheng Yong Tao Hui Xiang Zhan Fen Hai Meng Yan Mo Chan Xiang " + // U+9950
+		"Luo Zuan Nang Shi Ding Ji Tuo Xing Tun Xi Ren Yu Chi Fan Yin Jian " + // U+9960
+		"Shi Bao Si Duo Yi Er Rao Xiang Jia Le Jiao Yi Bing Bo Dou E " + // U+9970
+		"Yu Nei Jun Guo Hun Xian Guan Cha Kui Gu Sou Chan Ye Mo Bo Liu " + // U+9980
+		"Xiu Jin Man San Zhuan Nang Shou Kui Guo Xiang Fen Ba Ni Bi Bo Tu " + // U+9990
+		"Han Fei Jian An Ai Fu Xian Wen Xin Fen Bin Xing Ma Yu Feng Han " + // U+99A0
+		"Di Tuo Tuo Chi Xun Zhu Zhi Pei Xin Ri Sa Yin Wen Zhi Dan Lu " + // U+99B0
+		"You Bo Bao Kuai Tuo Yi Qu Wen Qu Jiong Bo Zhao Yuan Peng Zhou Ju " + // U+99C0
+		"Zhu Nu Ju Pi Zang Jia Ling Zhen Tai Fu Yang Shi Bi Tuo Tuo Si " + // U+99D0
+		"Liu Ma Pian Tao Zhi Rong Teng Dong Xun Quan Shen Jiong Er Hai Bo Zhu " + // U+99E0
+		"Yin Luo Shuu Dan Xie Liu Ju Song Qin Mang Liang Han Tu Xuan Tui Jun " + // U+99F0
+		"E Cheng Xin Ai Lu Zhui Zhou She Pian Kun Tao Lai Zong Ke Qi Qi " + // U+9A00
+		"Yan Fei Sao Yan Jie Yao Wu Pian Cong Pian Qian Fei Huang Jian Huo Yu " + // U+9A10
+		"Ti Quan Xia Zong Kui Rou Si Gua Tuo Kui Sou Qian Cheng Zhi Liu Pang " + // U+9A20
+		"Teng Xi Cao Du Yan Yuan Zou Sao Shan Li Zhi Shuang Lu Xi Luo Zhang " + // U+9A30
+		"Mo Ao Can Piao Cong Qu Bi Zhi Yu Xu Hua Bo Su Xiao Lin Chan " + // U+9A40
+		"Dun Liu Tuo Zeng Tan Jiao Tie Yan Luo Zhan Jing Yi Ye Tuo Bin Zou " + // U+9A50
+		"Yan Peng Lu Teng Xiang Ji Shuang Ju Xi Huan Li Biao Ma Yu Tuo Xun " + // U+9A60
+		"Chi Qu Ri Bo Lu Zang Shi Si Fu Ju Zou Zhu Tuo Nu Jia Yi " + // U+9A70
+		"Tai Xiao Ma Yin Jiao Hua Luo Hai Pian Biao Li Cheng Yan Xin Qin Jun " + // U+9A80
+		"Qi Qi Ke Zhui Zong Su Can Pian Zhi Kui Sao Wu Ao Liu Qian Shan " + // U+9A90
+		"Piao Luo Cong Chan Zou Ji Shuang Xiang Gu Wei Wei Wei Yu Gan Yi Ang " + // U+9AA0
+		"Tou Xie Bao Bi Chi Ti Di Ku Hai Qiao Gou Kua Ge Tui Geng Pian " + // U+9AB0
+		"Bi Ke Ka Yu Sui Lou Bo Xiao Pang Bo Ci Kuan Bin Mo Liao Lou " + // U+9AC0
+		"Nao Du Zang Sui Ti Bin Kuan Lu Gao Gao Qiao Kao Qiao Lao Zao Biao " + // U+9AD0
+		"Kun Kun Ti Fang Xiu Ran Mao Dan Kun Bin Fa Tiao Peng Zi Fa Ran " + // U+9AE0
+		"Ti Pao Pi Mao Fu Er Rong Qu Gong Xiu Gua Ji Peng Zhua Shao Sha " + // U+9AF0
+		"Ti Li Bin Zong Ti Peng Song Zheng Quan Zong Shun Jian Duo Hu La Jiu " + // U+9B00
+		"Qi Lian Zhen Bin Peng Mo San Man Man Seng Xu Lie Qian Qian Nong Huan " + // U+9B10
+		"Kuai Ning Bin Lie Rang Dou Dou Nao Hong Xi Dou Han Dou Dou Jiu Chang " + // U+9B20
+		"Yu Yu Li Juan Fu Qian Gui Zong Liu Gui Shang Yu Gui Mei Ji Qi " + // U+9B30
+		"Jie Kui Hun Ba Po Mei Xu Yan Xiao Liang Yu Tui Qi Wang Liang Wei " + // U+9B40
+		"Jian Chi Piao Bi Mo Ji Xu Chou Yan Zhan Yu Dao Ren Ji Eri Gong " + // U+9B50
+		"Tuo Diao Ji Xu E E Sha Hang Tun Mo Jie Shen Fan Yuan Bi Lu " + // U+9B60
+		"Wen Hu Lu Za Fang Fen Na You Namazu Todo He Xia Qu Han Pi Ling " + // U+9B70
+		"Tuo Bo Qiu Ping Fu Bi Ji Wei Ju Diao Bo You Gun Pi Nian Xing " + // U+9B80
+		"Tai Bao Fu Zha Ju Gu Kajika Tong Dai Ta Jie Shu Hou Xiang Er An " + // U+9B90
+		"Wei Tiao Zhu Yin Lie Luo Tong Yi Qi Bing Wei Jiao Bu Gui Xian Ge " + // U+9BA0
+		"Hui Bora Mate Kao Gori Duo Jun Ti Man Xiao Za Sha Qin Yu Nei Zhe " + // U+9BB0
+		"Gun Geng Su Wu Qiu Ting Fu Wan You Li Sha Sha Gao Meng Ugui Asari " + // U+9BC0
+		"Subashiri Kazunoko Yong Ni Zi Qi Qing Xiang Nei Chun Ji Diao Qie Gu Zhou Dong " + // U+9BD0
+		"Lai Fei Ni Yi Kun Lu Jiu Chang Jing Lun Ling Zou Li Meng Zong Zhi " + // U+9BE0
+		"Nian Shachi Dojou Sukesou Shi Shen Hun Shi Hou Xing Zhu La Zong Ji Bian Bian " + // U+9BF0
+		"Huan Quan Ze Wei Wei Yu Qun Rou Die Huang Lian Yan Qiu Qiu Jian Bi " + // U+9C00
+		"E Yang Fu Sai Jian Xia Tuo Hu Muroaji Ruo Haraka Wen Jian Hao Wu Fang " + // U+9C10
+		"Sao Liu Ma Shi Shi Yin Z Teng Ta Yao Ge Rong Qian Qi Wen Ruo " + // U+9C20
+		"Hatahata Lian Ao Le Hui Min Ji Tiao Qu Jian Sao Man Xi Qiu Biao Ji " + // U+9C30
+		"Ji Zhu Jiang Qiu Zhuan Yong Zhang Kang Xue Bie Jue Qu Xiang Bo Jiao Xun " + // U+9C40
+		"Su Huang Zun Shan Shan Fan Jue Lin Xun Miao Xi Eso Kyou Fen Guan Hou " + // U+9C50
+		"Kuai Zei Sao Zhan Gan Gui Sheng Li Chang Hatahata Shiira Mutsu Ru Ji Xu Huo " + // U+9C60
+		"Shiira Li Lie Li Mie Zhen Xiang E Lu Guan Li Xian Yu Dao Ji You " + // U+9C70
+		"Tun Lu Fang Ba He Bo Ping Nian Lu You Zha Fu Bo Bao Hou Pi " + // U+9C80
+		"Tai Gui Jie Kao Wei Er Tong Ze Hou Kuai Ji Jiao Xian Za Xiang Xun " + // U+9C90
+		"Geng Li Lian Jian Li Shi Tiao Gun Sha Wan Jun Ji Yong Qing Ling Qi " + // U+9CA0
+		"Zou Fei Kun Chang Gu Ni Nian Diao Jing Shen Shi Zi Fen Die Bi Chang " + // U+9CB0
+		"Shi Wen Wei Sai E Qiu Fu Huang Quan Jiang Bian Sao Ao Qi Ta Yin " + // U+9CC0
+		"Yao Fang Jian Le Biao Xue Bie Man Min Yong Wei Xi Jue Shan Lin Zun " + // U+9CD0
+		"Huo Gan Li Zhan Guan Niao Yi Fu Li Jiu Bu Yan Fu Diao Ji Feng " + // U+9CE0
+		"Nio Gan Shi Feng Ming Bao Yuan Zhi Hu Qin Fu Fen Wen Jian Shi Yu " + // U+9CF0
+		"Fou Yiao Jue Jue Pi Huan Zhen Bao Yan Ya Zheng Fang Feng Wen Ou Te " + // U+9D00
+		"Jia Nu Ling Mie Fu Tuo Wen Li Bian Zhi Ge Yuan Zi Qu Xiao Zhi " + // U+9D10
+		"Dan Ju You Gu Zhong Yu Yang Rong Ya Tie Yu Shigi Ying Zhui Wu Er " + // U+9D20
+		"Gua Ai Zhi Yan Heng Jiao Ji Lie Zhu Ren Yi Hong Luo Ru Mou Ge " + // U+9D30
+		"Ren Jiao Xiu Zhou Zhi Luo Chidori Toki Ten Luan Jia Ji Yu Huan Tuo Bu " + // U+9D40
+		"Wu Juan Yu Bo Xun Xun Bi Xi Jun Ju Tu Jing Ti E E Kuang " + // U+9D50
+		"Hu Wu Shen Lai Ikaruga Kakesu Lu Ping Shu Fu An Zhao Peng Qin Qian Bei " + // U+9D60
+		"Diao Lu Que Jian Ju Tu Ya Yuan Qi Li Ye Zhui Kong Zhui Kun Sheng " + // U+9D70
+		"Qi Jing Yi Yi Jing Zi Lai Dong Qi Chun Geng Ju Qu Isuka Kikuitadaki Ji " + // U+9D80
+		"Shu Ying Chi Miao Rou An Qiu Ti Hu Ti E Jie Mao Fu Chun Tu " + // U+9D90
+		"Yan He Yuan Pian Yun Mei Hu Ying Dun Mu Ju Tsugumi Cang Fang Gu Ying " + // U+9DA0
+		"Yuan Xuan Weng Shi He Chu Tang Xia Ruo Liu Ji Gu Jian Zhun Han Zi " + // U+9DB0
+		"Zi Ni Yao Yan Ji Li Tian Kou Ti Ti Ni Tu Ma Jiao Gao Tian " + // U+9DC0
+		"Chen Li Zhuan Zhe Ao Yao Yi Ou Chi Zhi Liao Rong Lou Bi Shuang Zhuo " + // U+9DD0
+		"Yu Wu Jue Yin Quan Si Jiao Yi Hua Bi Ying Su Huang Fan Jiao Liao " + // U+9DE0
+		"Yan Kao Jiu Xian Xian Tu Mai Zun Yu Ying Lu Tuan Xian Xue Yi Pi " + // U+9DF0
+		"Shu Luo Qi Yi Ji Zhe Yu Zhan Ye Yang Pi Ning Huo Mi Ying Meng " + // U+9E00
+		"Di Yue Yu Lei Bao Lu He Long Shuang Yue Ying Guan Qu Li Luan Niao " + // U+9E10
+		"Jiu Ji Yuan Ming Shi Ou Ya Cang Bao Zhen Gu Dong Lu Ya Xiao Yang " + // U+9E20
+		"Ling Zhi Qu Yuan Xue Tuo Si Zhi Er Gua Xiu Heng Zhou Ge Luan Hong " + // U+9E30
+		"Wu Bo Li Juan Hu E Yu Xian Ti Wu Que Miao An Kun Bei Peng " + // U+9E40
+		"Qian Chun Geng Yuan Su Hu He E Gu Qiu Zi Mei Mu Ni Yao Weng " + // U+9E50
+		"Liu Ji Ni Jian He Yi Ying Zhe Liao Liao Jiao Jiu Yu Lu Xuan Zhan " + // U+9E60
+		"Ying Huo Meng Guan Shuang Lu Jin Ling Jian Xian Cuo Jian Jian Yan Cuo Lu " + // U+9E70
+		"You Cu Ji Biao Cu Biao Zhu Jun Zhu Jian Mi Mi Wu Liu Chen Jun " + // U+9E80
+		"Lin Ni Qi Lu Jiu Jun Jing Li Xiang Yan Jia Mi Li She Zhang Lin " + // U+9E90
+		"Jing Ji Ling Yan Cu Mai Mai Ge Chao Fu Mian Mian Fu Pao Qu Qu " + // U+9EA0
+		"Mou Fu Xian Lai Qu Mian Chi Feng Fu Qu Mian Ma Mo Mo Hui Ma " + // U+9EB0
+		"Zou Nen Fen Huang Huang Jin Guang Tian Tou Heng Xi Kuang Heng Shu Li Nian " + // U+9EC0
+		"Chi Hei Hei Yi Qian Dan Xi Tuan Mo Mo Qian Dai Chu You Dian Yi " + // U+9ED0
+		"Xia Yan Qu Mei Yan Jing Yu Li Dang Du Can Yin An Yan Tan An " + // U+9EE0
+		"Zhen Dai Can Yi Mei Dan Yan Du Lu Zhi Fen Fu Fu Min Min Yuan " + // U+9EF0
+		"Cu Qu Chao Wa Zhu Zhi Mang Ao Bie Tuo Bi Yuan Chao Tuo Ding Mi " + // U+9F00
+		"Nai Ding Zi Gu Gu Dong Fen Tao Yuan Pi Chang Gao Qi Yuan Tang Teng " + // U+9F10
+		"Shu Shu Fen Fei Wen Ba Diao Tuo Tong Qu Sheng Shi You Shi Ting Wu " + // U+9F20
+		"Nian Jing Hun Ju Yan Tu Ti Xi Xian Yan Lei Bi Yao Qiu Han Wu " + // U+9F30
+		"Wu Hou Xi Ge Zha Xiu Weng Zha Nong Nang Qi Zhai Ji Zi Ji Ji " + // U+9F40
+		"Qi Ji Chi Chen Chen He Ya Ken Xie Pao Cuo Shi Zi Chi Nian Ju " + // U+9F50
+		"Tiao Ling Ling Chu Quan Xie Ken Nie Jiu Yao Chuo Kun Yu Chu Yi Ni " + // U+9F60
+		"Cuo Zou Qu Nen Xian Ou E Wo Yi Chuo Zou Dian Chu Jin Ya Chi " + // U+9F70
+		"Chen He Ken Ju Ling Pao Tiao Zi Ken Yu Chuo Qu Wo Long Pang Gong " + // U+9F80
+		"Pang Yan Long Long Gong Kan Ta Ling Ta Long Gong Kan Gui Qiu Bie Gui " + // U+9F90
+		"Yue Chui He Jue Xie Yu [?][?][?][?][?][?][?][?][?][?]" + // U+9FA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+9FB0
+		"[?][?][?]Shan [?][?][?][?][?][?][?][?][?]Gang Ta Mai " + // U+9FC0
+		"[?][?][?][?]Ge Dan [?][?][?][?][?][?][?][?][?][?]" + // U+9FD0
+		"[?][?][?][?][?][?][?][?][?][?][?]Ao Tian Ni [?][?]" + // U+9FE0
+		"[?][?][?][?]Dong Zhi Lang An [?][?]Mai [?][?][?][?][?] " + // U+9FF0
+		"itixiipietiexieiepataxaapuoxuouopot" + // U+A000
+		"oxoopexewubitbixbibipbietbiexbiebiepbatbax" + // U+A010
+		"babapbuoxbuobuopbotboxbobopbexbebepbutbuxbubup" + // U+A020
+		"burxburbytbyxbybypbyrxbyrpitpixpipippiexpiepieppat" + // U+A030
+		"paxpapappuoxpuopuoppotpoxpopopputpuxpupuppurxpur" + // U+A040
+		"pytpyxpypyppyrxpyrbbitbbixbbibbipbbietbbiexbbiebbiepbbatbbax" + // U+A050
+		"bbabbapbbuoxbbuobbuopbbotbboxbbobbopbbexbbebbepbbutbbuxbbubbup" + // U+A060
+		"bburxbburbbytbbyxbbybbypnbitnbixnbinbipnbiexnbienbiepnbatnbaxnba" + // U+A070
+		"nbapnbotnboxnbonbopnbutnbuxnbunbupnburxnburnbytnbyxnbynbypnbyrx" + // U+A080
+		"nbyrhmithmixhmihmiphmiexhmiehmiephmathmaxhmahmaphmuoxhmuohmuophmot" + // U+A090
+		"hmoxhmohmophmuthmuxhmuhmuphmurxhmurhmyxhmyhmyphmyrxhmyrmitmix" + // U+A0A0
+		"mimipmiexmiemiepmatmaxmamapmuotmuoxmuomuopmotmoxmo" + // U+A0B0
+		"mopmexmemutmuxmumupmurxmurmytmyxmymypfitfixfi" + // U+A0C0
+		"fipfatfaxfafapfoxfofopfutfuxfufupfurxfurfytfyx" + // U+A0D0
+		"fyfypvitvixvivipvietviexvieviepvatvaxvavapvotvox" + // U+A0E0
+		"vovopvexvepvutvuxvuvupvurxvurvytvyxvyvypvyrxvyr" + // U+A0F0
+		"ditdixdidipdiexdiediepdatdaxdadapduoxduodotdoxdo" + // U+A100
+		"dopdexdedepdutduxdudupdurxdurtittixtitiptiextie" + // U+A110
+		"tieptattaxtataptuottuoxtuotuoptottoxtotoptextetep" + // U+A120
+		"tuttuxtutupturxturdditddixddiddipddiexddieddiepddatddaxdda" + // U+A130
+		"ddapdduoxdduodduopddotddoxddoddopddexddeddepddutdduxdduddupddurx" + // U+A140
+		"ddurnditndixndindipndiexndiendatndaxndandapndotndoxndondopndex" + // U+A150
+		"ndendepndutnduxndundupndurxndurhnithnixhnihniphniethniexhniehniep" + // U+A160
+		"hnathnaxhnahnaphnuoxhnuohnothnoxhnophnexhnehnephnutnitnixni" + // U+A170
+		"nipniexnieniepnaxnanapnuoxnuonuopnotnoxnonopnexne" + // U+A180
+		"nepnutnuxnunupnurxnurhlithlixhlihliphliexhliehliephlathlax" + // U+A190
+		"hlahlaphluoxhluohluophloxhlohlophlexhlehlephluthluxhluhluphlurx" + // U+A1A0
+		"hlurhlythlyxhlyhlyphlyrxhlyrlitlixliliplietliexlielieplat" + // U+A1B0
+		"laxlalapluotluoxluoluoplotloxloloplexleleplutlux" + // U+A1C0
+		"luluplurxlurlytlyxlylyplyrxlyrgitgixgigipgietgiex" + // U+A1D0
+		"giegiepgatgaxgagapguotguoxguoguopgotgoxgogopgetgex" + // U+A1E0
+		"gegepgutguxgugupgurxgurkitkixkikipkiexkiekiepkat" + // U+A1F0
+		"kaxkakapkuoxkuokuopkotkoxkokopketkexkekepkutkux" + // U+A200
+		"kukupkurxkurggitggixggiggiexggieggiepggatggaxggaggapgguotgguox" + // U+A210
+		"gguogguopggotggoxggoggopggetggexggeggepggutgguxgguggupggurxggur" + // U+A220
+		"mgiexmgiemgatmgaxmgamgapmguoxmguomguopmgotmgoxmgomgopmgexmgemgep" + // U+A230
+		"mgutmguxmgumgupmgurxmgurhxithxixhxihxiphxiethxiexhxiehxiephxathxax" + // U+A240
+		"hxahxaphxuothxuoxhxuohxuophxothxoxhxohxophxexhxehxepngiexngiengiep" + // U+A250
+		"ngatngaxngangapnguotnguoxnguongotngoxngongopngexngengephithiex" + // U+A260
+		"hiehathaxhahaphuothuoxhuohuophothoxhohophexhehep" + // U+A270
+		"watwaxwawapwuoxwuowuopwoxwowopwexwewepzitzixzi" + // U+A280
+		"zipziexzieziepzatzaxzazapzuoxzuozuopzotzoxzozopzex" + // U+A290
+		"zezepzutzuxzuzupzurxzurzytzyxzyzypzyrxzyrcitcix" + // U+A2A0
+		"cicipcietciexcieciepcatcaxcacapcuoxcuocuopcotcoxco" + // U+A2B0
+		"copcexcecepcutcuxcucupcurxcurcytcyxcycypcyrxcyr" + // U+A2C0
+		"zzitzzixzzizzipzzietzziexzziezziepzzatzzaxzzazzapzzoxzzozzopzzex" + // U+A2D0
+		"zzezzepzzuxzzuzzupzzurxzzurzzytzzyxzzyzzypzzyrxzzyrnzitnzixnzi" + // U+A2E0
+		"nzipnziexnzienziepnzatnzaxnzanzapnzuoxnzuonzoxnzopnzexnzenzuxnzu" + // U+A2F0
+		"nzupnzurxnzurnzytnzyxnzynzypnzyrxnzyrsitsixsisipsiexsiesiep" + // U+A300
+		"satsaxsasapsuoxsuosuopsotsoxsosopsexsesepsutsux" + // U+A310
+		"susupsurxsursytsyxsysypsyrxsyrssitssixssissipssiexssie" + // U+A320
+		"ssiepssatssaxssassapssotssoxssossopssexssessepssutssuxssussup" + // U+A330
+		"ssytssyxssyssypssyrxssyrzhatzhaxzhazhapzhuoxzhuozhuopzhotzhoxzho" + // U+A340
+		"zhopzhetzhexzhezhepzhutzhuxzhuzhupzhurxzhurzhytzhyxzhyzhypzhyrx" + // U+A350
+		"zhyrchatchaxchachapchuotchuoxchuochuopchotchoxchochopchetchexche" + // U+A360
+		"chepchuxchuchupchurxchurchytchyxchychypchyrxchyrrraxrrarruoxrruo" + // U+A370
+		"rrotrroxrrorroprretrrexrrerreprrutrruxrrurruprrurxrrurrrytrryx" + // U+A380
+		"rryrryprryrxrryrnratnraxnranrapnroxnronropnretnrexnrenrepnrut" + // U+A390
+		"nruxnrunrupnrurxnrurnrytnryxnrynrypnryrxnryrshatshaxshashapshuox" + // U+A3A0
+		"shuoshuopshotshoxshoshopshetshexsheshepshutshuxshushupshurxshur" + // U+A3B0
+		"shytshyxshyshypshyrxshyrratraxrarapruoxruoruoprotroxro" + // U+A3C0
+		"roprexrereprutruxruruprurxrurrytryxryrypryrxryr" + // U+A3D0
+		"jitjixjijipjietjiexjiejiepjuotjuoxjuojuopjotjoxjojop" + // U+A3E0
+		"jutjuxjujupjurxjurjytjyxjyjypjyrxjyrqitqixqiqip" + // U+A3F0
+		"qietqiexqieqiepquotquoxquoquopqotqoxqoqopqutquxququp" + // U+A400
+		"qurxqurqytqyxqyqypqyrxqyrjjitjjixjjijjipjjietjjiexjjiejjiep" + // U+A410
+		"jjuoxjjuojjuopjjotjjoxjjojjopjjutjjuxjjujjupjjurxjjurjjytjjyxjjy" + // U+A420
+		"jjypnjitnjixnjinjipnjietnjiexnjienjiepnjuoxnjuonjotnjoxnjonjopnjux" + // U+A430
+		"njunjupnjurxnjurnjytnjyxnjynjypnjyrxnjyrnyitnyixnyinyipnyietnyiex" + // U+A440
+		"nyienyiepnyuoxnyuonyuopnyotnyoxnyonyopnyutnyuxnyunyupxitxixxi" + // U+A450
+		"xipxietxiexxiexiepxuoxxuoxotxoxxoxopxytxyxxyxypxyrx" + // U+A460
+		"xyryityixyiyipyietyiexyieyiepyuotyuoxyuoyuopyotyoxyo" + // U+A470
+		"yopyutyuxyuyupyurxyuryytyyxyyyypyyrxyyr[?][?][?]" + // U+A480
+		"QotLiKitNyipCypSsiGgopGepMiHxitLyrBbutMopYoPutHxuo" + // U+A490
+		"TatGa[?][?]DdurBurGguoNyopTuOpJjutZotPytHmoYitVur" + // U+A4A0
+		"ShyVepZaJo[?]JjyGotJjieWoDuShurLieCyCuopCipHxop" + // U+A4B0
+		"Shat[?]ShopCheZziet[?]Ke[?][?][?][?][?][?][?][?][?]" + // U+A4C0
+		"bapaphadatathagakakhajacachadzatsatshama" + // U+A4D0
+		"nalasazhazangahaxahhafawashayaghaaae" + // U+A4E0
+		"eeuiouueuhoetipocyabonajeu[?]" + // U+A4F0
+		"Kay Kayng Ke Ko Kol Koc Kwi Kwi Kyun Kul Kum Na Na Na La Na " + // U+F900
+		"Na Na Na Na Nak Nak Nak Nak Nak Nak Nak Nan Nan Nan Nan Nan " + // U+F910
+		"Nan Nam Nam Nam Nam Nap Nap Nap Nang Nang Nang Nang Nang Nay Nayng No " + // U+F920
+		"No No No No No No No No No No No Nok Nok Nok Nok Nok " + // U+F930
+		"Nok Non Nong Nong Nong Nong Noy Noy Noy Noy Nwu Nwu Nwu Nwu Nwu Nwu " + // U+F940
+		"Nwu Nwu Nuk Nuk Num Nung Nung Nung Nung Nung Twu La Lak Lak Lan Lyeng " + // U+F950
+		"Lo Lyul Li Pey Pen Pyen Pwu Pwul Pi Sak Sak Sam Sayk Sayng Sep Sey " + // U+F960
+		"Sway Sin Sim Sip Ya Yak Yak Yang Yang Yang Yang Yang Yang Yang Yang Ye " + // U+F970
+		"Ye Ye Ye Ye Ye Ye Ye Ye Ye Ye Yek Yek Yek Yek Yen Yen " + // U+F980
+		"Yen Yen Yen Yen Yen Yen Yen Yen Yen Yen Yen Yen Yel Yel Yel Yel " + // U+F990
+		"Yel Yel Yem Yem Yem Yem Yem Yep Yeng Yeng Yeng Yeng Yeng Yeng Yeng Yeng " + // U+F9A0
+		"Yeng Yeng Yeng Yeng Yeng Yey Yey Yey Yey O Yo Yo Yo Yo Yo Yo " + // U+F9B0
+		"Yo Yo Yo Yo Yong Wun Wen Yu Yu Yu Yu Yu Yu Yu Yu Yu " + // U+F9C0
+		"Yu Yuk Yuk Yuk Yun Yun Yun Yun Yul Yul Yul Yul Yung I I I " + // U+F9D0
+		"I I I I I I I I I I I Ik Ik In In In " + // U+F9E0
+		"In In In In Im Im Im Ip Ip Ip Cang Cek Ci Cip Cha Chek " + // U+F9F0
+		"Chey Thak Thak Thang Thayk Thong Pho Phok Hang Hang Hyen Hwak Wu Huo [?] [?] " + // U+FA00
+		"Zhong [?] Qing [?] [?] Xi Zhu Yi Li Shen Xiang Fu Jing Jing Yu [?] " + // U+FA10
+		"Hagi [?] Zhu [?] [?] Yi Du [?] [?] [?] Fan Si Guan He Lang Li " + // U+FA20
+		"Wu Seng Mian Mian Qin Bei He Tan Qi Ping Mo Ceng Che Hui Kai Zeng " + // U+FA30
+		"Cheng Min Ji Shu Mei Hai Zhu Han Zhu Zhao Zuo Bei She Zhi Qi You " + // U+FA40
+		"Zu Zhu Huo Zhen Gu Tu Jie Lian Jin Fan Shu Zhe Chou Cao Cao Zhe " + // U+FA50
+		"He Shi Ye Jin Bin Zeng Chuo Yi Nan Xiang Pin Hui [?]Guan [?][?]" + // U+FA60
+		"Bing Kuang Quan Xing Chong Ji Yong Shao He Tao Hui Wa Zhong Fen Yan Ben " + // U+FA70
+		"Bi Ci Ao Yi Cai Yao Wang Shen Yu Zeng Ao Cheng Dai Yu Sou Bing " + // U+FA80
+		"Ao Qing Lang Wang Zhang Dai Sha Liu Yin Zi Han Jing Zhu Qiao Jue Fan " + // U+FA90
+		"Zhu Zhen Ci Hua Guan Wen Yi Sheng Zhi Juan Zhe Tian Tiao Jie Lei Tao " + // U+FAA0
+		"Lian Ping Zhe Huang Hua Yun Qiang Fu Shi Diao Zhu Qing Ye Nuo Yu Jin " + // U+FAB0
+		"Bian Zeng Shu Chi Sou Xing Zhu Nan Jing Bai Xiang E Pin Zhen Gui [?]" + // U+FAC0
+		"[?][?]He Xie Jie [?]Qian Beng E Pang [?][?][?][?][?][?]" + // U+FAD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FAE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+FAF0
+		"He Qi [?] Qie [?] Hai [?] [?] [?] Qiu Cao [?] [?] Shi [?] [?] " + // U+20000
+		"[?] [?] [?] Si Jue [?] [?] [?] [?] [?] [?] Yu [?] Kong [?] [?] " + // U+20010
+		"[?] [?] Zi [?] [?] [?] Xing [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20020
+		"[?] Mou [?] [?] [?] [?] [?] Ji Ye Jun [?] [?] Qian Lu [?] [?] " + // U+20030
+		"[?] Dou [?] [?] [?] [?] [?] [?] [?] Chu [?] [?] [?] [?] [?] [?] " + // U+20040
+		"[?] [?] [?] [?] [?] [?] [?] Shi [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20050
+		"Qie [?] [?] [?] [?] Ga [?] [?] [?] [?] [?] [?] [?] Qi [?] [?] " + // U+20060
+		"[?] [?] [?] [?] [?] [?] [?] Chan [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20070
+		"[?] [?] [?] [?] Huan [?] Yi Zuo Jie [?] [?] [?] [?] [?] [?] [?] " + // U+20080
+		"[?] Zou [?] [?] Zi [?] [?] [?] [?] [?] [?] [?] [?] Za [?] Jin " + // U+20090
+		"[?] [?] Pai [?] Dui Cong [?] Shen [?] [?] [?] [?] [?] [?] [?] [?] " + // U+200A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Huang [?] [?] [?] [?] [?] [?] [?] " + // U+200B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yin [?] Gun [?] [?] [?] " + // U+200C0
+		"[?] [?] [?] Yang [?] [?] Jiu [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+200D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shen [?] [?] [?] [?] " + // U+200E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jiu [?] [?] [?] [?] [?] " + // U+200F0
+		"[?] [?] [?] [?] [?] Ye [?] [?] [?] Dong [?] [?] Jue Jie [?] Diao " + // U+20100
+		"[?] Jue Chui [?] [?] [?] Ling [?] [?] [?] Ting [?] [?] [?] [?] [?] " + // U+20110
+		"[?] [?] [?] Gen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ya [?] " + // U+20120
+		"[?] Yi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wei " + // U+20130
+		"[?] [?] Jie [?] [?] [?] [?] [?] [?] [?] [?] [?] Yi [?] [?] [?] " + // U+20140
+		"[?] [?] [?] [?] [?] [?] [?] Die [?] [?] Qi [?] [?] [?] [?] [?] " + // U+20150
+		"[?] [?] [?] [?] Xi [?] [?] [?] [?] [?] [?] [?] Bao [?] [?] [?] " + // U+20160
+		"[?] Xie [?] [?] [?] [?] [?] [?] [?] Zhang [?] [?] [?] [?] [?] [?] " + // U+20170
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yong [?] [?] [?] " + // U+20180
+		"Xu [?] [?] [?] [?] [?] [?] [?] [?] Die [?] Dan [?] [?] [?] Wei " + // U+20190
+		"[?] [?] [?] Gua [?] [?] [?] [?] [?] Fan [?] [?] [?] [?] Mo [?] " + // U+201A0
+		"[?] Xi Yan [?] [?] Ni Dan [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+201B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Dan [?] [?] [?] Tao " + // U+201C0
+		"Yu [?] Gong [?] [?] [?] [?] Kua Chu [?] [?] [?] [?] [?] [?] [?] " + // U+201D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qu " + // U+201E0
+		"[?] Mo [?] Shi [?] Gan [?] Sheng [?] Jing [?] [?] [?] [?] [?] [?] " + // U+201F0
+		"[?] Tuo [?] [?] [?] Shou [?] [?] [?] [?] Nie [?] [?] [?] [?] [?] " + // U+20200
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20210
+		"[?] [?] [?] [?] Yun Gua [?] [?] [?] [?] [?] [?] Xiao Lao [?] [?] " + // U+20220
+		"Dan Suo [?] [?] [?] Mang Yi [?] Te [?] Bi [?] [?] [?] [?] [?] " + // U+20230
+		"[?] [?] Ta [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20240
+		"[?] [?] [?] [?] [?] [?] [?] Luo [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20250
+		"[?] [?] Xi Hun Da [?] [?] Ju [?] Du [?] [?] An [?] [?] [?] " + // U+20260
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20270
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Mei [?] [?] Ran [?] Ai Yu " + // U+20280
+		"[?] [?] Jian [?] Qi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Min " + // U+20290
+		"[?] [?] [?] Zhou Zhi Zhong Nao Bing [?] Zhuan Shu Xun Jue Qian [?] [?] " + // U+202A0
+		"Gua [?] Tu [?] [?] [?] Ying Zhi [?] [?] [?] [?] [?] [?] Kui [?] " + // U+202B0
+		"[?] [?] [?] [?] [?] [?] Chen [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+202C0
+		"[?] [?] [?] [?] [?] [?] Lian Ya [?] [?] [?] [?] Guo Miao She Yu " + // U+202D0
+		"[?] Si Sou [?] Zhi [?] [?] Qie [?] Fu [?] [?] Ju Bei [?] Bi " + // U+202E0
+		"[?] [?] Suo [?] [?] Qian Ming Chan [?] [?] Sao Ji [?] [?] [?] [?] " + // U+202F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20300
+		"[?] [?] [?] [?] [?] Gong Qiong [?] [?] [?] Rong [?] [?] [?] Sou Sou " + // U+20310
+		"Yao [?] [?] [?] [?] [?] [?] [?] [?] [?] Chou [?] [?] Shuai Zhe Li " + // U+20320
+		"Gai Sui Zhan [?] Zhuang [?] [?] [?] [?] [?] [?] [?] [?] Fu [?] [?] " + // U+20330
+		"[?] [?] [?] Ji Dou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20340
+		"[?] [?] [?] [?] [?] [?] [?] Hui [?] [?] Jian Yan Zhi [?] [?] [?] " + // U+20350
+		"[?] [?] [?] [?] [?] [?] [?] [?] Mei Yao Di Yi [?] [?] [?] Bie " + // U+20360
+		"[?] [?] Qu Yi [?] Yang [?] [?] [?] Zha [?] [?] [?] Sha [?] [?] " + // U+20370
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20380
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Lai [?] [?] [?] [?] [?] [?] " + // U+20390
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jue [?] " + // U+203A0
+		"Qi [?] [?] Yu [?] [?] Zai Sa Se [?] [?] Dun [?] [?] [?] Jie " + // U+203B0
+		"Ke [?] [?] Yue [?] [?] [?] Jian Yao [?] [?] [?] [?] [?] [?] [?] " + // U+203C0
+		"[?] [?] [?] Xian [?] Xiao Qiao [?] [?] [?] Yu Qu [?] [?] [?] [?] " + // U+203D0
+		"[?] Xian Luo [?] Guang [?] [?] Cheng Chuang Yi [?] Zheng [?] Zong Dui [?] " + // U+203E0
+		"Zhai [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Fei " + // U+203F0
+		"Yi Meng [?] [?] [?] [?] [?] [?] Bian Jie Shu Liao Bi Su [?] [?] " + // U+20400
+		"[?] Di [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20410
+		"[?] Bei Wen [?] [?] [?] [?] Meng [?] Chan [?] [?] [?] [?] [?] [?] " + // U+20420
+		"[?] [?] [?] [?] [?] Dao [?] [?] [?] [?] Pin Jian Lin Gui Qi Hong " + // U+20430
+		"[?] [?] [?] Ji Xie Zheng Chan [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20440
+		"Yao Chan [?] [?] [?] [?] [?] [?] Dian Chong Nei Nei [?] [?] Zhai Bian " + // U+20450
+		"[?] Chan [?] [?] [?] [?] [?] [?] [?] [?] Xiao [?] [?] [?] [?] Cu " + // U+20460
+		"Xin Jing Qian [?] Qing [?] [?] [?] [?] Gu [?] [?] [?] [?] [?] [?] " + // U+20470
+		"[?] [?] [?] [?] Wu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20480
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yuan Bing [?] [?] " + // U+20490
+		"[?] [?] Wan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+204A0
+		"Niao [?] [?] [?] [?] Lian [?] [?] Rao [?] [?] [?] [?] [?] Fan Di " + // U+204B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Hui Yi Xian [?] [?] [?] " + // U+204C0
+		"[?] [?] [?] [?] [?] [?] Lan Fu [?] Xiong [?] [?] Liang Tao Ji [?] " + // U+204D0
+		"[?] [?] Jie Zha Shi [?] [?] [?] [?] [?] Qi Bian [?] Lan Lin [?] " + // U+204E0
+		"[?] [?] [?] [?] [?] [?] Zhi Bi Sheng [?] [?] [?] [?] Sheng [?] Qin " + // U+204F0
+		"[?] [?] Biao Xi [?] [?] [?] [?] [?] Juan [?] Ji [?] Xi Qin [?] " + // U+20500
+		"[?] Hai [?] [?] [?] Lun [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20510
+		"Yue [?] [?] [?] [?] [?] [?] [?] Lian [?] [?] [?] [?] [?] [?] Ban " + // U+20520
+		"[?] [?] Heng [?] [?] [?] Qi [?] [?] [?] Qian Zheng Mao [?] [?] [?] " + // U+20530
+		"[?] Cong [?] [?] Na [?] [?] [?] [?] [?] Ting [?] Zong [?] [?] [?] " + // U+20540
+		"[?] [?] [?] [?] [?] Jiong Zhao [?] [?] [?] [?] [?] [?] [?] [?] Nian " + // U+20550
+		"Cheng [?] [?] Qia [?] [?] Yu Jiao [?] [?] [?] [?] [?] Zhao [?] [?] " + // U+20560
+		"[?] [?] [?] Di Jiu [?] [?] [?] Sui [?] [?] Yao [?] [?] [?] Wang " + // U+20570
+		"[?] [?] Liao [?] Tong [?] Meng [?] [?] [?] [?] You [?] [?] [?] [?] " + // U+20580
+		"[?] [?] [?] Si [?] [?] [?] [?] [?] [?] [?] Lou [?] [?] [?] Yin " + // U+20590
+		"[?] [?] [?] [?] [?] Chong [?] [?] [?] [?] [?] Gan Jiu [?] [?] [?] " + // U+205A0
+		"[?] [?] [?] [?] [?] [?] Qin Jiong [?] Xie [?] [?] [?] [?] [?] [?] " + // U+205B0
+		"[?] [?] He [?] [?] [?] Tao [?] Qiu Xie Jing Nian Jing [?] [?] Ji " + // U+205C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Tian [?] Cui Die [?] Qing [?] [?] " + // U+205D0
+		"[?] [?] [?] [?] [?] Ping Ping [?] Die Lou [?] [?] [?] [?] [?] [?] " + // U+205E0
+		"[?] [?] [?] Lian Han Pang Tang [?] [?] [?] Yi Xuan Suo Liu Shuang Shen " + // U+205F0
+		"[?] Bu Sou [?] [?] Qin Shen [?] [?] [?] Nong Ting Jiang [?] [?] [?] " + // U+20600
+		"[?] [?] [?] [?] [?] Xi Zhi [?] [?] [?] [?] [?] [?] Lai Li Li " + // U+20610
+		"[?] [?] He Jiao [?] Yan [?] Shu [?] [?] Shi [?] [?] [?] [?] [?] " + // U+20620
+		"[?] Zhen [?] You [?] [?] [?] [?] [?] [?] Suo Wu [?] [?] [?] [?] " + // U+20630
+		"[?] Chang Cong [?] [?] [?] Ju [?] [?] [?] [?] [?] [?] [?] Shu [?] " + // U+20640
+		"[?] [?] [?] [?] Jiu Wei [?] [?] [?] [?] [?] [?] [?] [?] Huo [?] " + // U+20650
+		"[?] [?] [?] [?] Jie [?] [?] [?] [?] [?] [?] [?] Zao [?] [?] [?] " + // U+20660
+		"[?] [?] [?] [?] [?] [?] Ou [?] [?] [?] [?] [?] Gua [?] [?] [?] " + // U+20670
+		"[?] [?] [?] Hao Li Zhi Xian [?] [?] Bu Chang [?] [?] [?] [?] [?] " + // U+20680
+		"[?] [?] [?] Yun He [?] [?] [?] [?] [?] [?] [?] Tao [?] [?] [?] " + // U+20690
+		"Biao [?] [?] [?] [?] Diao [?] Er Jiu [?] [?] [?] [?] Di Yi Kun " + // U+206A0
+		"[?] Zhe [?] Kuo Zhou Ju [?] [?] [?] Shan Sha Diao Ban Ji [?] [?] " + // U+206B0
+		"Zhong [?] [?] Yi [?] Kou Wu [?] [?] [?] Ge Ba [?] [?] Gou [?] " + // U+206C0
+		"[?] Xian Gua Liu Chi Guai Chuan [?] Li Cu Shua [?] [?] [?] [?] [?] " + // U+206D0
+		"[?] Bi [?] [?] [?] Bing Li [?] [?] Jiu Tiao Duo [?] Yan Quan [?] " + // U+206E0
+		"[?] Lie [?] Ke [?] Gen Zhen [?] Fen [?] [?] [?] [?] [?] [?] [?] " + // U+206F0
+		"[?] Yi [?] Jiu Xu Jiao [?] [?] Lu Jiu [?] Chou [?] [?] Xian [?] " + // U+20700
+		"Kuai Dui [?] [?] [?] [?] Luo Xi Qin Bu [?] [?] [?] [?] [?] [?] " + // U+20710
+		"[?] [?] [?] [?] Qia [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20720
+		"[?] Pi Ya Beng Guo Gua [?] [?] [?] Ju [?] [?] Qia [?] Jue [?] " + // U+20730
+		"[?] [?] [?] [?] Li [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20740
+		"Hua Jiao [?] [?] [?] [?] [?] [?] Qia [?] Zha Qia [?] Zhe Cha Ying " + // U+20750
+		"[?] [?] Yan [?] Chong [?] [?] [?] Chi [?] Wan [?] Sou [?] [?] [?] " + // U+20760
+		"[?] [?] Kan Yuan [?] [?] [?] [?] [?] [?] [?] [?] [?] Chou [?] Suo " + // U+20770
+		"Tu [?] [?] Zhe Ti [?] Wu [?] Da Li Cha [?] [?] [?] [?] [?] " + // U+20780
+		"[?] [?] [?] [?] [?] Rong Gong Que [?] Li [?] [?] [?] [?] Tao [?] " + // U+20790
+		"[?] [?] [?] [?] Li [?] [?] Mi [?] Chi [?] [?] Gun Lou Chuang Suo " + // U+207A0
+		"Jiao Jin [?] [?] [?] Fa Zhai [?] [?] [?] [?] [?] [?] [?] Jin Cui " + // U+207B0
+		"[?] [?] Ceng Zun [?] Zhao [?] [?] Pie Zhan Xi Yao Fu Chong [?] [?] " + // U+207C0
+		"[?] [?] [?] Cui [?] [?] [?] Gua [?] [?] [?] [?] [?] [?] [?] [?] " + // U+207D0
+		"[?] [?] [?] Ji [?] [?] Se Zhan Ling Se Ye [?] [?] [?] [?] [?] " + // U+207E0
+		"Ju [?] [?] [?] [?] [?] Tu [?] [?] [?] Ru Ze Huan [?] [?] [?] " + // U+207F0
+		"[?] Xian [?] Qian Zhao [?] [?] [?] [?] [?] [?] Can [?] [?] Kuo Li " + // U+20800
+		"Rou [?] [?] [?] Du [?] [?] Lie [?] [?] [?] [?] Ying Li [?] [?] " + // U+20810
+		"Du [?] Ling [?] [?] [?] [?] [?] [?] [?] Wan [?] [?] [?] [?] Die " + // U+20820
+		"[?] [?] [?] Jiu [?] Li Ku Keng [?] Zhen [?] [?] [?] [?] [?] [?] " + // U+20830
+		"He [?] Bi [?] Pi [?] [?] [?] [?] [?] Hang [?] [?] [?] [?] [?] " + // U+20840
+		"[?] Zhuo Dui [?] Yi [?] [?] [?] [?] [?] [?] [?] Ke Yi Mo [?] " + // U+20850
+		"Chi Can [?] Geng Ke Shi [?] [?] [?] [?] [?] [?] [?] Ling Beng [?] " + // U+20860
+		"[?] Duan [?] [?] [?] [?] Juan Nao Zi [?] [?] Zong [?] [?] [?] [?] " + // U+20870
+		"[?] [?] [?] Tang [?] [?] Xia Han [?] [?] [?] [?] Lue Qian [?] [?] " + // U+20880
+		"[?] [?] [?] Mo Ou Hao [?] [?] [?] Zha Juan Cong [?] [?] [?] [?] " + // U+20890
+		"Li Zha You Dian Jue Bei [?] [?] [?] Yao Pie [?] [?] [?] [?] [?] " + // U+208A0
+		"[?] Jin Kai Se Yang Jin [?] [?] [?] Ke [?] [?] [?] [?] [?] [?] " + // U+208B0
+		"[?] [?] [?] [?] Chan [?] [?] Nian [?] Wan Lu [?] [?] [?] [?] [?] " + // U+208C0
+		"Yun Yao Bao [?] [?] Jun Xuan [?] Zhou [?] [?] [?] [?] [?] [?] [?] " + // U+208D0
+		"Kui [?] [?] [?] [?] [?] [?] [?] [?] [?] Qu Shao Sun [?] [?] [?] " + // U+208E0
+		"Du [?] Kuai Pao [?] [?] [?] [?] [?] [?] Bao [?] [?] [?] Fu Jiu " + // U+208F0
+		"Ran [?] [?] [?] Ju [?] [?] [?] [?] [?] Qiong [?] [?] Zhou Hua Bao " + // U+20900
+		"[?] [?] [?] [?] [?] Yi [?] Yi Yi [?] [?] [?] [?] Mao [?] [?] " + // U+20910
+		"[?] [?] [?] [?] [?] [?] Ruan [?] [?] [?] [?] Ci [?] [?] Han [?] " + // U+20920
+		"Cong [?] [?] [?] Xi [?] [?] [?] [?] Quan Tiao [?] Diao [?] Han [?] " + // U+20930
+		"[?] [?] [?] [?] [?] [?] [?] Ye [?] [?] [?] [?] [?] E Wei [?] " + // U+20940
+		"Cang Diao [?] [?] [?] E Di [?] Suan Quan [?] [?] E Ou Xuan [?] " + // U+20950
+		"[?] [?] Wu [?] [?] [?] Yi [?] Mou [?] [?] [?] [?] [?] [?] [?] " + // U+20960
+		"Hu [?] [?] [?] Han [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shi " + // U+20970
+		"[?] [?] [?] Sa [?] [?] [?] [?] Bi [?] Han Jing Xi [?] Qin Cuo " + // U+20980
+		"Ci [?] Ban [?] [?] [?] [?] Dui [?] [?] [?] [?] Xi [?] [?] [?] " + // U+20990
+		"[?] [?] [?] [?] [?] [?] [?] Zhi Luan [?] Hu Ji Guai [?] [?] [?] " + // U+209A0
+		"[?] [?] Pang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+209B0
+		"Zhu [?] [?] [?] [?] Bi [?] Yu [?] [?] [?] [?] [?] [?] [?] [?] " + // U+209C0
+		"[?] [?] Qi [?] [?] He Chu [?] [?] Shao Chi Bo [?] [?] [?] Reng " + // U+209D0
+		"You [?] [?] [?] Nai [?] [?] [?] [?] Hui Tiao Ban [?] [?] [?] [?] " + // U+209E0
+		"Xu [?] [?] [?] You Chi [?] [?] [?] [?] [?] [?] [?] [?] [?] Heng " + // U+209F0
+		"[?] [?] [?] Wai [?] [?] Xie [?] [?] [?] Jue [?] Sui Qing Zhuan [?] " + // U+20A00
+		"[?] [?] [?] [?] [?] Ji [?] [?] Bi [?] Xi [?] [?] [?] [?] [?] " + // U+20A10
+		"Ji [?] Jun [?] [?] Liao You [?] [?] [?] [?] [?] [?] Ju [?] [?] " + // U+20A20
+		"[?] [?] Yue [?] [?] Bang [?] [?] Pi [?] [?] Ze [?] [?] Yi Di " + // U+20A30
+		"[?] [?] Qie [?] Suo [?] Ci [?] Zhu Yue [?] [?] [?] [?] [?] Jiao " + // U+20A40
+		"[?] [?] [?] [?] Shi [?] [?] Yi Xia [?] [?] [?] [?] [?] [?] [?] " + // U+20A50
+		"Yuan [?] [?] [?] [?] Guo [?] Ke [?] [?] Cui Yi [?] [?] [?] [?] " + // U+20A60
+		"[?] [?] [?] [?] [?] Li [?] Dian [?] [?] Xi [?] [?] [?] [?] Bi " + // U+20A70
+		"[?] [?] Bian Mei Li [?] [?] Sou [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20A80
+		"Liu Gui Ke [?] [?] [?] [?] Yi [?] Xi Yin [?] [?] [?] [?] Ke " + // U+20A90
+		"[?] [?] [?] She [?] [?] [?] Wo [?] [?] [?] [?] [?] [?] Pi [?] " + // U+20AA0
+		"[?] [?] [?] [?] [?] [?] Yue Hong [?] [?] Li Fu [?] [?] [?] [?] " + // U+20AB0
+		"[?] [?] [?] Jue Xian [?] [?] [?] [?] Dian [?] [?] Li [?] [?] [?] " + // U+20AC0
+		"[?] [?] [?] Tu [?] [?] [?] [?] Jian [?] [?] Bai Di Zhang [?] [?] " + // U+20AD0
+		"[?] [?] [?] Yu [?] [?] [?] [?] Dui [?] [?] [?] [?] Can Tu [?] " + // U+20AE0
+		"[?] [?] [?] [?] [?] [?] Tan Ji Qi Shan Nian [?] [?] [?] [?] [?] " + // U+20AF0
+		"[?] [?] [?] [?] [?] [?] Guan [?] Bi [?] [?] Xing [?] [?] [?] [?] " + // U+20B00
+		"[?] [?] [?] Zhen [?] [?] [?] [?] [?] Sa [?] Mo [?] Fu [?] [?] " + // U+20B10
+		"[?] [?] Tao Bang Yi [?] [?] [?] [?] [?] Biao [?] Xi [?] Jie [?] " + // U+20B20
+		"[?] [?] [?] [?] [?] [?] Jin [?] [?] [?] [?] [?] [?] [?] Qian [?] " + // U+20B30
+		"[?] [?] [?] [?] [?] [?] [?] [?] Si Jing [?] Chi [?] [?] [?] [?] " + // U+20B40
+		"[?] [?] [?] [?] [?] [?] [?] Jing [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20B50
+		"[?] [?] [?] [?] [?] Sui [?] [?] [?] [?] [?] [?] [?] [?] [?] Zha " + // U+20B60
+		"Li [?] [?] [?] Zhuo [?] [?] [?] [?] Bian [?] [?] [?] [?] [?] Tun " + // U+20B70
+		"[?] [?] [?] Bi [?] [?] Fei [?] [?] [?] De [?] Zhu [?] [?] [?] " + // U+20B80
+		"[?] Ju [?] [?] [?] [?] [?] [?] [?] Yi [?] [?] Ya [?] [?] Chi " + // U+20B90
+		"Gua Zhi [?] [?] [?] [?] [?] [?] Reng [?] [?] You [?] Bo [?] Ji " + // U+20BA0
+		"Pin [?] [?] Ying Yang Mang [?] [?] [?] [?] [?] [?] [?] Long N Sa " + // U+20BB0
+		"Chuan [?] Ci Wu Ren [?] [?] [?] Dai Ji [?] Yi [?] Ran [?] [?] " + // U+20BC0
+		"Huo Gua [?] Zhe Pi [?] [?] Za Ban Jie [?] [?] Hou [?] [?] Xian " + // U+20BD0
+		"Hui [?] [?] [?] [?] [?] [?] [?] [?] Zha Dai Ge [?] Pi [?] Pian " + // U+20BE0
+		"Shi Liang Yue Hu Bian [?] [?] Reng [?] Reng [?] [?] [?] [?] [?] [?] " + // U+20BF0
+		"[?] [?] [?] [?] Yi Zhi [?] Jin Weng Chao [?] Qiu [?] Zhu [?] Zha " + // U+20C00
+		"Po An [?] He [?] Chu Yan [?] [?] [?] Shi Hu E [?] [?] [?] " + // U+20C10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20C20
+		"[?] [?] [?] [?] Shi [?] [?] Lu [?] Tuo Dai Wai Po Rong Ju [?] " + // U+20C30
+		"Bo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20C40
+		"Yu Dou [?] Gui Shou [?] [?] Suo Ni Zhou Long Bing Zun Ye Ran [?] " + // U+20C50
+		"Ling Sa [?] [?] Lei E [?] Zhong Ji [?] [?] E [?] [?] [?] Zuo " + // U+20C60
+		"[?] [?] Na Yun [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20C70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xie Zui Shu Diu Fa Ren " + // U+20C80
+		"[?] Bang Han Hong Yi [?] Yi [?] [?] Ke Yi Hui Zheng [?] [?] [?] " + // U+20C90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jing [?] " + // U+20CA0
+		"[?] Ge [?] [?] Nou Qie [?] Die [?] Ji Yi Yi [?] Fu Shuo Shuo " + // U+20CB0
+		"Yong Ken Hua Hong [?] [?] [?] He [?] [?] He Qian Qia [?] Si [?] " + // U+20CC0
+		"Bang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jue [?] " + // U+20CD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jing Ke [?] [?] " + // U+20CE0
+		"[?] [?] [?] Ai Lou [?] Tu [?] [?] Chuang [?] [?] Song Cheng [?] Wei " + // U+20CF0
+		"[?] [?] Nu [?] Jiu [?] [?] Bin [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20D00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20D10
+		"[?] Xiao Sheng Hou [?] [?] Zhu [?] Guan Ji [?] Ji [?] Xi [?] She " + // U+20D20
+		"Ou Hu Ta Xiao [?] Zao [?] [?] Bo Qi Wa Tuo Dao [?] Na [?] " + // U+20D30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20D40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20D50
+		"Zhai [?] [?] Ya [?] [?] Wu Zhen De He [?] Ang Pi Se Fen Gua " + // U+20D60
+		"[?] [?] [?] Po [?] [?] [?] Xuan Han Gang Ba Zong Meng [?] Huo [?] " + // U+20D70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20D80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20D90
+		"[?] [?] [?] [?] [?] [?] [?] Dian Xi [?] [?] Da Nang [?] [?] [?] " + // U+20DA0
+		"Diao Luo Ke [?] [?] [?] [?] Yi Jue He [?] Ji [?] [?] He Nie " + // U+20DB0
+		"Run Qian Dai Shao Ke Zhu [?] Shi Lu Jia Pian Hou Ji Ta Chou Wo " + // U+20DC0
+		"Jing Po Zhai Xin [?] [?] Bian [?] [?] Xu [?] [?] [?] [?] Gu Jie " + // U+20DD0
+		"[?] [?] Xian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20DE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] E [?] Bo Piao [?] [?] [?] Za " + // U+20DF0
+		"[?] Pai Tu [?] Ying [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20E00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20E10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xiang [?] " + // U+20E20
+		"[?] Nuo Ge Bo Xie [?] [?] [?] Zhen Yu Ni [?] [?] [?] [?] [?] " + // U+20E30
+		"Xun Wa [?] Ang Han Hong Dan [?] Nuo [?] Cao Ji Neng Yong Xiao [?] " + // U+20E40
+		"Chua Yao [?] Ge Tang Bao Chan [?] Xu [?] [?] Hai [?] Chou [?] Jian " + // U+20E50
+		"Zuo [?] [?] [?] Wei Da Pi [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20E60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20E70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20E80
+		"Huan [?] Xi [?] Pen Liu Mu Mie Lang Tui Ban [?] [?] Ge [?] Ku " + // U+20E90
+		"[?] [?] Jia Bo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20EA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20EB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Huan [?] Zu " + // U+20EC0
+		"Luo [?] [?] [?] [?] [?] [?] Li [?] He Mo [?] Shui Shen Kang Chi " + // U+20ED0
+		"Ling Luo [?] [?] Yan Zhao Chua Gu Qin [?] Tan Fen Tu [?] [?] [?] " + // U+20EE0
+		"[?] Ling [?] [?] Lang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20EF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20F00
+		"[?] [?] [?] [?] [?] [?] Lan Zan Wu [?] [?] [?] [?] Li A Lue " + // U+20F10
+		"Zhi Chou Jiang [?] Jian [?] [?] [?] [?] Lun Yi [?] Shang [?] [?] [?] " + // U+20F20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ji [?] [?] [?] [?] " + // U+20F30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20F40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yi Nin [?] [?] " + // U+20F50
+		"[?] Hui [?] Zha [?] [?] Han [?] Yin Bi An Xia Ni [?] [?] [?] " + // U+20F60
+		"Di Jian Pan [?] [?] Yu Chuai Za [?] Cha [?] Zhe Se [?] Pen Gu " + // U+20F70
+		"Zhe [?] [?] [?] [?] [?] Li Dou [?] Chou [?] Zui Po [?] [?] She " + // U+20F80
+		"Long [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20F90
+		"[?] [?] Shu [?] Jin Ling [?] [?] Kang La [?] Xu Jin [?] Chuan [?] " + // U+20FA0
+		"[?] [?] Yue [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20FB0
+		"[?] [?] [?] [?] [?] [?] Mai Xie Jiu Ji [?] Yue [?] [?] [?] Jian " + // U+20FC0
+		"[?] Han [?] Sa Hui Qiao [?] Se Zui [?] [?] Lu Hua Chu Shan Wo " + // U+20FD0
+		"Ji Zhuo Xian Yi Guo Kui [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20FE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+20FF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21000
+		"[?] Zhou [?] [?] Lu [?] Bo Shi Ying Ku [?] [?] [?] [?] [?] [?] " + // U+21010
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21020
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Zhi Xie [?] [?] Ye E Lu " + // U+21030
+		"Han Ye [?] [?] [?] [?] Luo Chuo Fan Zhi Ying Wen Wa Ai Yu [?] " + // U+21040
+		"[?] Hua [?] Lie Jing Za [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21050
+		"[?] [?] [?] [?] [?] [?] [?] Zang Dui [?] Ji [?] [?] [?] Wo [?] " + // U+21060
+		"Ji Xi [?] Zhan Tuan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21070
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yu [?] [?] [?] [?] Lie " + // U+21080
+		"[?] [?] Zhi Shi [?] Lao Lai Wei Pao Chi Ying Dou [?] Dou [?] Bao " + // U+21090
+		"Qie Shu [?] Zhi [?] [?] [?] [?] [?] Lie [?] Peng [?] Zhe [?] [?] " + // U+210A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ou " + // U+210B0
+		"[?] [?] Xie Ji Lai Ying Ceng [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+210C0
+		"[?] [?] [?] [?] [?] [?] Le [?] [?] [?] [?] [?] [?] Lun [?] [?] " + // U+210D0
+		"[?] Long Xi [?] [?] [?] Lin [?] [?] Gui [?] [?] [?] [?] [?] [?] " + // U+210E0
+		"[?] [?] [?] Xing [?] [?] [?] Li Ci [?] [?] [?] [?] [?] [?] [?] " + // U+210F0
+		"[?] [?] [?] [?] [?] [?] [?] Qing [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21100
+		"[?] Jian Dao Jian Qing Xie Ying [?] [?] [?] [?] [?] [?] [?] [?] Ha " + // U+21110
+		"[?] Zhe She Mi Huan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21120
+		"[?] Cu Ru Sa Huo Yi [?] Di [?] Luan [?] Yi [?] [?] [?] [?] " + // U+21130
+		"[?] [?] Bo Pang Tan E Zang Cong [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21140
+		"[?] [?] [?] Zhai [?] Xi Mang [?] La Yun [?] [?] [?] [?] [?] [?] " + // U+21150
+		"[?] E [?] [?] [?] Die [?] [?] [?] [?] [?] [?] [?] Guan [?] [?] " + // U+21160
+		"[?] Huan [?] [?] [?] Shi Jian [?] [?] Zhan Ji Huan [?] [?] [?] [?] " + // U+21170
+		"[?] [?] [?] [?] [?] Wan Luo [?] [?] [?] [?] [?] [?] [?] [?] Dou " + // U+21180
+		"[?] [?] [?] [?] [?] Lian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21190
+		"[?] [?] [?] Nie Nan Jiu Yue [?] [?] Yao Chuang [?] [?] [?] Can Li " + // U+211A0
+		"Dun Nan Nan [?] [?] [?] [?] [?] Ri [?] [?] [?] [?] Yue [?] [?] " + // U+211B0
+		"You [?] Yin [?] Guo [?] [?] [?] Dang [?] [?] [?] [?] [?] [?] [?] " + // U+211C0
+		"[?] Zhen Mi Die [?] [?] Zhen [?] [?] [?] Kua [?] Han Song He Ji " + // U+211D0
+		"Zhe [?] [?] [?] Bing [?] Wei Tou [?] Tu [?] [?] Gang Lou Quan Hun " + // U+211E0
+		"Zhuan Que [?] Hong [?] Dang He Tai Guai [?] Yu [?] Ya [?] [?] Wan " + // U+211F0
+		"Qun [?] [?] [?] [?] Jue Ou [?] [?] Quan Zhi [?] [?] Ling Wu Xin " + // U+21200
+		"Da [?] Yuan Yuan [?] [?] [?] Mo [?] You [?] Wan [?] [?] Wu [?] " + // U+21210
+		"Zhang [?] [?] Xuan [?] [?] Rao Gun Yu [?] [?] [?] [?] [?] Xia Bian " + // U+21220
+		"You [?] Yin [?] Xuan You Lei [?] [?] [?] [?] [?] Ting [?] [?] Zhen " + // U+21230
+		"[?] [?] [?] [?] Zai Ga La [?] [?] Que [?] [?] [?] [?] Ju [?] " + // U+21240
+		"Chun Da Tun Ai [?] [?] [?] Zi [?] [?] Huang Yi [?] [?] [?] [?] " + // U+21250
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Bao Chi [?] [?] Ri [?] [?] " + // U+21260
+		"[?] [?] [?] [?] Lu [?] [?] Jie Shi [?] Zuan [?] [?] [?] [?] [?] " + // U+21270
+		"[?] Yi [?] [?] Fen Fen [?] [?] [?] Mo [?] [?] [?] Shu [?] [?] " + // U+21280
+		"[?] Xi [?] [?] [?] [?] [?] [?] [?] [?] [?] Ao [?] Pi Ping Po " + // U+21290
+		"Jia Zhou [?] Qiu [?] [?] [?] You Tan [?] [?] Rong [?] Mi [?] [?] " + // U+212A0
+		"[?] [?] [?] [?] [?] [?] Yi [?] Rong [?] [?] Lie Qiong [?] [?] [?] " + // U+212B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+212C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Hui Ji [?] [?] [?] [?] Gao " + // U+212D0
+		"[?] [?] [?] [?] Jin [?] [?] You Cha De Yin [?] Yu Bei [?] Bo " + // U+212E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+212F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21300
+		"[?] [?] [?] [?] Qiao [?] [?] [?] [?] [?] Cha [?] Xin [?] Chi [?] " + // U+21310
+		"[?] [?] [?] Zao Kui [?] Fei [?] [?] Ta Guai [?] [?] Duo [?] [?] " + // U+21320
+		"[?] [?] Gui [?] Zhi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21330
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chan Nao [?] [?] " + // U+21340
+		"Hu [?] Tao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21350
+		"[?] Yi [?] [?] Nie Zhai Huan [?] Du [?] Qi Ce [?] [?] Chui [?] " + // U+21360
+		"[?] [?] Da [?] [?] [?] Zhi Geng [?] [?] [?] Weng [?] [?] [?] [?] " + // U+21370
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Du [?] [?] [?] Chi [?] [?] " + // U+21380
+		"[?] An Kuo [?] Wo [?] [?] [?] Ying [?] Pian [?] [?] [?] [?] [?] " + // U+21390
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zha Zhua [?] Su [?] " + // U+213A0
+		"[?] [?] [?] Ni [?] [?] [?] [?] [?] [?] Zhu Chan [?] [?] Beng Ni " + // U+213B0
+		"Zhi Hui [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+213C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Xia [?] Zhi Xi [?] [?] Jiang [?] " + // U+213D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Dui Fu [?] [?] Jiao Chao Bai " + // U+213E0
+		"[?] [?] [?] [?] [?] Lie [?] [?] [?] [?] [?] [?] Ao [?] [?] [?] " + // U+213F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zao Chu [?] [?] Tuo " + // U+21400
+		"[?] [?] Hao Kang Yin [?] Xian [?] [?] [?] [?] [?] [?] Fu Bie [?] " + // U+21410
+		"Kui [?] [?] [?] Qie Sa [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21420
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Da " + // U+21430
+		"Ye [?] [?] [?] Zhang [?] Liang [?] Dui [?] [?] [?] [?] Lao Xun [?] " + // U+21440
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zhi [?] Ku [?] [?] [?] Sui Wo " + // U+21450
+		"[?] [?] [?] Ku [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jian " + // U+21460
+		"[?] [?] [?] [?] [?] [?] Jiang [?] [?] [?] [?] Zhui [?] Shuang Yu [?] " + // U+21470
+		"[?] Sa [?] Yu Lan [?] [?] [?] [?] [?] Yu [?] Qian Ju [?] Lie " + // U+21480
+		"[?] [?] Shu Xian [?] [?] Gai [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21490
+		"[?] [?] Tai [?] [?] [?] [?] Tian [?] [?] [?] [?] [?] [?] [?] Meng " + // U+214A0
+		"[?] Di [?] Mian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Hui [?] " + // U+214B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Duo [?] [?] [?] Lie [?] [?] " + // U+214C0
+		"[?] [?] Lai Yin Lan [?] Jiao [?] Huo [?] [?] [?] [?] [?] [?] [?] " + // U+214D0
+		"[?] [?] [?] Guo [?] [?] Zhan [?] [?] [?] [?] [?] [?] Mi [?] [?] " + // U+214E0
+		"Kui [?] [?] [?] [?] [?] [?] Duo [?] [?] [?] [?] [?] [?] [?] Yin " + // U+214F0
+		"[?] [?] [?] [?] [?] [?] [?] Lei [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21500
+		"[?] [?] [?] [?] [?] Gong [?] [?] [?] [?] [?] Ting Yao [?] Wang [?] " + // U+21510
+		"[?] [?] [?] Jie [?] [?] [?] [?] Xiu [?] Shu [?] [?] [?] [?] [?] " + // U+21520
+		"[?] Wei [?] [?] Yu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21530
+		"[?] Zhan [?] [?] [?] [?] [?] [?] [?] Ang [?] [?] [?] [?] [?] Sang " + // U+21540
+		"Chou [?] Kua [?] [?] [?] Ju Hai [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21550
+		"[?] [?] Mian [?] [?] [?] [?] Hang [?] [?] Chou [?] [?] [?] Ling [?] " + // U+21560
+		"Zong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21570
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Kun [?] [?] Zhong [?] Zhao [?] " + // U+21580
+		"Die Gou Yun Dan Nuo [?] [?] [?] [?] [?] [?] Bing [?] Ran Chan [?] " + // U+21590
+		"[?] [?] Rong Yin Chan [?] [?] Zhi [?] [?] Guai Nuo Shen [?] [?] Su " + // U+215A0
+		"[?] [?] Wo Chi [?] [?] [?] [?] [?] [?] Mie Zhi [?] [?] Qi [?] " + // U+215B0
+		"[?] Gou [?] [?] [?] [?] Lou [?] Zi [?] [?] [?] [?] Dang [?] Xian " + // U+215C0
+		"[?] Rou [?] [?] [?] [?] [?] Peng [?] [?] [?] [?] [?] [?] Xi [?] " + // U+215D0
+		"[?] [?] Kua [?] Gui Chun Jie [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+215E0
+		"[?] [?] Jie Xi [?] Ku [?] Gu Zha Fan [?] [?] Xie [?] [?] [?] " + // U+215F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Huan [?] Niao " + // U+21600
+		"Xi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Cu [?] Gun [?] [?] " + // U+21610
+		"[?] Xi [?] [?] [?] [?] [?] Qia [?] [?] Mang [?] [?] Zhe [?] [?] " + // U+21620
+		"Juan [?] [?] [?] Bie [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21630
+		"Bie [?] [?] [?] [?] Quan [?] [?] [?] [?] [?] Xi [?] [?] Jiao [?] " + // U+21640
+		"Quan Zhi Tian Kai [?] [?] [?] [?] San [?] [?] Zi [?] [?] [?] [?] " + // U+21650
+		"[?] [?] [?] Jie [?] [?] [?] [?] [?] [?] Bie [?] Dou Zui [?] [?] " + // U+21660
+		"[?] [?] [?] [?] [?] [?] Yan [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21670
+		"[?] Bi [?] [?] [?] Kuai [?] Yan Wei [?] Huan [?] Hao [?] [?] [?] " + // U+21680
+		"[?] Gong [?] [?] Meng [?] [?] Lei [?] Di [?] Bing Huan [?] [?] Wa " + // U+21690
+		"Jue [?] [?] [?] [?] [?] [?] [?] Chi [?] [?] [?] [?] Ba Jiu [?] " + // U+216A0
+		"[?] [?] [?] [?] [?] [?] [?] Di [?] Zhang [?] Da Shi Hao [?] [?] " + // U+216B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ye [?] [?] [?] " + // U+216C0
+		"[?] [?] [?] [?] [?] [?] [?] Bi Pi Yao [?] [?] Di Can Pin Yue " + // U+216D0
+		"Qie Pi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+216E0
+		"[?] [?] [?] [?] [?] Tuo Xie [?] [?] [?] [?] [?] [?] Ye [?] [?] " + // U+216F0
+		"Fan Gua Hu Ru [?] [?] [?] [?] [?] Ran Fou Huang [?] [?] [?] [?] " + // U+21700
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ru [?] [?] [?] [?] [?] " + // U+21710
+		"[?] [?] Mao [?] [?] Dui Hui Xi Xiu [?] [?] Ran Yi [?] [?] Zhe " + // U+21720
+		"[?] Ji Gao You [?] Pu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21730
+		"[?] [?] [?] [?] [?] [?] [?] [?] Chu Cu Zhe Niao [?] Qie [?] [?] " + // U+21740
+		"Cha [?] Niao Sui [?] [?] [?] [?] [?] Cha Cheng Yao Du Wang [?] Nian " + // U+21750
+		"Mi [?] [?] [?] [?] [?] Nou Xi [?] Yao [?] Chan [?] [?] [?] [?] " + // U+21760
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21770
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Can [?] [?] [?] [?] " + // U+21780
+		"[?] [?] [?] [?] [?] [?] [?] [?] Xie Mie Keng [?] Cu [?] Sheng Pan " + // U+21790
+		"Hu [?] Ke Xian [?] Hou Qiong Zong [?] [?] Fu Nai [?] Ni [?] Ku " + // U+217A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Nen [?] " + // U+217B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ge [?] [?] " + // U+217C0
+		"[?] Hou [?] Ai [?] Shi [?] [?] [?] [?] [?] [?] [?] [?] Xiu Cong " + // U+217D0
+		"Jiao [?] Zha Xiao Lian Qu [?] [?] Shan Xie [?] Gong Mie Chai [?] En " + // U+217E0
+		"[?] [?] [?] Dou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+217F0
+		"[?] [?] [?] [?] [?] [?] Kou [?] [?] [?] Tiao Shi [?] [?] [?] Sang " + // U+21800
+		"[?] [?] Guan [?] [?] [?] Hao Zhi Yang Tong Bi [?] Mo [?] Fu Zhu " + // U+21810
+		"[?] [?] [?] [?] [?] Qiang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21820
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Zhi [?] [?] Sou [?] [?] Niao " + // U+21830
+		"Juan [?] Yang [?] Huang [?] [?] [?] Beng Mo Chao [?] [?] [?] Lu Shao " + // U+21840
+		"Bu Zeng Si [?] Zui Yue Zan Luan [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21850
+		"[?] [?] [?] [?] [?] Qu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21860
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Miao [?] [?] [?] [?] [?] " + // U+21870
+		"Zhuan [?] [?] [?] [?] [?] [?] [?] Dang [?] Yuan [?] [?] [?] [?] [?] " + // U+21880
+		"[?] [?] Ju [?] [?] Hui Qi [?] Yun [?] Man [?] Mo [?] [?] [?] " + // U+21890
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+218A0
+		"[?] Piao [?] Jin [?] [?] [?] [?] [?] Yao [?] [?] [?] [?] [?] [?] " + // U+218B0
+		"Chi Ni Sou [?] [?] [?] [?] [?] Shu [?] [?] Piao [?] [?] [?] [?] " + // U+218C0
+		"[?] [?] [?] [?] Han [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+218D0
+		"Yao [?] Nei [?] [?] [?] [?] [?] [?] [?] Shi [?] Yuan [?] Cai Jie " + // U+218E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Xie [?] [?] [?] Yan Xiao [?] " + // U+218F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xie Li [?] Fan [?] " + // U+21900
+		"[?] [?] [?] [?] [?] [?] [?] Zhu [?] Na [?] Zhuan [?] [?] Kui [?] " + // U+21910
+		"[?] [?] Luo [?] [?] [?] [?] [?] [?] [?] [?] Qia [?] [?] [?] [?] " + // U+21920
+		"[?] [?] [?] [?] [?] [?] Wan [?] [?] [?] [?] [?] [?] Shu [?] Cheng " + // U+21930
+		"[?] Yi [?] [?] [?] [?] Hao [?] Jiao [?] [?] Hui [?] Xiao Ci [?] " + // U+21940
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ji [?] " + // U+21950
+		"[?] [?] [?] [?] [?] [?] Ni Nai Ni Ti [?] [?] [?] [?] [?] [?] " + // U+21960
+		"[?] [?] [?] [?] [?] [?] Ju [?] Ming [?] [?] [?] [?] Li [?] Zhong " + // U+21970
+		"[?] Xu [?] Qiong Fu [?] Bin [?] [?] [?] Ji [?] [?] Qi Xi [?] " + // U+21980
+		"[?] [?] [?] [?] Deng Er [?] [?] [?] [?] [?] Shu Tong Xiao [?] Pi " + // U+21990
+		"[?] [?] [?] [?] [?] [?] [?] [?] Dan [?] Ji [?] [?] [?] [?] [?] " + // U+219A0
+		"[?] [?] [?] Xiao [?] [?] [?] Cong [?] [?] [?] Bin Rong [?] [?] [?] " + // U+219B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mian [?] [?] " + // U+219C0
+		"[?] [?] Mian [?] Shu Xiao Bao Wa [?] Pao [?] [?] [?] [?] [?] [?] " + // U+219D0
+		"[?] [?] [?] Gai [?] Hu Heng [?] Zhu Guai [?] [?] [?] Gui [?] [?] " + // U+219E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Dai [?] [?] Bin Huang [?] [?] " + // U+219F0
+		"Cha [?] [?] [?] Xia Ju [?] Yao [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21A00
+		"[?] [?] [?] [?] [?] [?] Fen Zao [?] [?] [?] Feng [?] [?] [?] [?] " + // U+21A10
+		"[?] [?] Ju Yu [?] [?] [?] [?] [?] Hun [?] [?] [?] [?] [?] [?] " + // U+21A20
+		"[?] [?] Jie Xiong [?] Nai [?] [?] [?] [?] [?] Nou [?] Sheng [?] Yu " + // U+21A30
+		"[?] [?] Huan Geng Wan [?] Tuo Qiao [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21A40
+		"[?] [?] [?] [?] [?] [?] [?] [?] Yin [?] Jia [?] [?] [?] [?] [?] " + // U+21A50
+		"[?] Suo [?] Jie Xi Weng [?] [?] [?] Mang [?] [?] [?] [?] [?] [?] " + // U+21A60
+		"[?] [?] [?] [?] [?] [?] Yang [?] Yao [?] [?] [?] [?] Mang Ou [?] " + // U+21A70
+		"[?] An [?] [?] [?] Lou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21A80
+		"[?] E Zi [?] [?] [?] [?] E [?] An [?] [?] [?] [?] Huo [?] " + // U+21A90
+		"Ceng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21AA0
+		"Xiong Ji [?] Zuo [?] Qi [?] [?] [?] [?] Zheng [?] [?] [?] [?] [?] " + // U+21AB0
+		"Ji Qi Juan Ning [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21AC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Se " + // U+21AD0
+		"[?] [?] [?] [?] [?] He Rong Qin [?] [?] [?] [?] Ju [?] [?] Li " + // U+21AE0
+		"[?] [?] [?] [?] [?] Shi [?] [?] Ni Xian Fu [?] [?] Ru [?] [?] " + // U+21AF0
+		"[?] Xiong Gui [?] Ji [?] Meng Fu [?] Sai Yu Jiao Meng Long Qiang [?] " + // U+21B00
+		"Mi [?] [?] Yi [?] Long Han Ni Lao Seng [?] [?] Lin [?] Yu [?] " + // U+21B10
+		"[?] [?] [?] [?] [?] Nuo [?] [?] [?] [?] [?] Wu [?] [?] [?] Bian " + // U+21B20
+		"[?] [?] Bian Xuan [?] Jian [?] [?] Bian [?] [?] [?] [?] [?] [?] [?] " + // U+21B30
+		"[?] [?] De [?] [?] [?] [?] Zhuan [?] [?] [?] Rong [?] [?] [?] [?] " + // U+21B40
+		"Shuan [?] [?] [?] [?] [?] [?] [?] Jia [?] [?] Hui [?] [?] Zhan [?] " + // U+21B50
+		"[?] [?] Bai Lie [?] Xie [?] [?] [?] [?] [?] [?] [?] Jian Shou [?] " + // U+21B60
+		"[?] [?] [?] Kao [?] [?] [?] Guan Luan [?] [?] [?] [?] [?] Nou Chang " + // U+21B70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Liang [?] " + // U+21B80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Nai Ru [?] [?] [?] Zhi [?] " + // U+21B90
+		"[?] [?] [?] [?] [?] [?] Cao [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21BA0
+		"Li [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lan [?] [?] [?] Chan " + // U+21BB0
+		"[?] Wang [?] [?] Li [?] [?] Wu Pao You [?] Gan [?] [?] [?] An " + // U+21BC0
+		"Xiu Shui Rui [?] [?] [?] [?] [?] Ban You [?] [?] [?] [?] [?] [?] " + // U+21BD0
+		"[?] [?] Huo [?] [?] Hui [?] [?] Zuo Xiao [?] Mian [?] [?] [?] [?] " + // U+21BE0
+		"Ga Yuan [?] Bo Chao Tui [?] Bo [?] [?] [?] [?] [?] Ga [?] Tiao " + // U+21BF0
+		"Na [?] [?] [?] [?] Hu Nie [?] [?] [?] [?] Hui Lou [?] Ti [?] " + // U+21C00
+		"Qiao Qiao Zhong [?] [?] [?] Di [?] [?] [?] Lin [?] [?] Quan Zhuan [?] " + // U+21C10
+		"Lei [?] Xie [?] [?] Ren [?] [?] Dang [?] Du Nian [?] [?] [?] Shi " + // U+21C20
+		"[?] [?] Xian [?] [?] [?] [?] [?] [?] Zhi [?] [?] [?] Ai Ci Pu " + // U+21C30
+		"[?] Shi [?] [?] [?] Qu Shu Dian [?] Xiao Shui [?] Huan [?] [?] [?] " + // U+21C40
+		"Yi Juan [?] [?] Zhi [?] [?] [?] [?] [?] [?] [?] Zhao [?] [?] [?] " + // U+21C50
+		"[?] [?] [?] Xu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Long " + // U+21C60
+		"[?] Zhu [?] Suo [?] [?] [?] Die [?] [?] Qu [?] Ke Hu Ju [?] " + // U+21C70
+		"Qing [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Bing [?] [?] " + // U+21C80
+		"[?] [?] [?] [?] [?] Ti [?] Jue [?] [?] Qiu [?] [?] [?] [?] [?] " + // U+21C90
+		"[?] [?] [?] Jiang [?] [?] [?] [?] [?] [?] Yun [?] [?] Mei Pi [?] " + // U+21CA0
+		"Qu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mi [?] [?] Ti " + // U+21CB0
+		"[?] [?] Kai [?] Bi [?] Qu [?] [?] [?] [?] [?] [?] [?] [?] Tiao " + // U+21CC0
+		"[?] Chu [?] [?] [?] [?] [?] [?] Ju [?] Xi [?] [?] [?] Lin [?] " + // U+21CD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chi Ji [?] " + // U+21CE0
+		"[?] [?] [?] [?] Lu [?] [?] [?] Li [?] [?] [?] [?] [?] Jue [?] " + // U+21CF0
+		"[?] [?] [?] [?] [?] Zhu Lu [?] [?] [?] [?] [?] [?] [?] Nie [?] " + // U+21D00
+		"[?] [?] [?] [?] Quan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21D10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ya [?] E " + // U+21D20
+		"[?] Hu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21D30
+		"Mang [?] [?] [?] [?] [?] [?] [?] [?] Wu [?] [?] Cha [?] [?] [?] " + // U+21D40
+		"[?] Qin Jie Hong [?] Dan En Ze Hu Ang Jie Fu Yong Zong Feng [?] " + // U+21D50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mu [?] [?] [?] " + // U+21D60
+		"[?] [?] [?] [?] [?] [?] Se Cong [?] [?] [?] Kang [?] [?] [?] [?] " + // U+21D70
+		"[?] [?] Yao Ai Bao [?] Po [?] Shi Fan [?] Ju Pi [?] Wei Ku " + // U+21D80
+		"Qie Gan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21D90
+		"[?] [?] Kuang Sui Beng Jia Ya [?] [?] [?] Kan Nie [?] Xing [?] Xi " + // U+21DA0
+		"[?] Lin Duo [?] Chan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21DB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Shi [?] [?] Dui [?] Jiang Yu Lu " + // U+21DC0
+		"En [?] [?] Gu [?] Wei Che Huan Bie [?] [?] Han Tui Na Qi [?] " + // U+21DD0
+		"Tou Yuan Wang [?] Wu Gao [?] [?] Keng [?] Yi [?] [?] [?] [?] [?] " + // U+21DE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Xiao [?] Gui Ya Sui Song [?] Zhuo " + // U+21DF0
+		"[?] [?] Tu Xian [?] [?] [?] [?] Ze Li [?] [?] Zhu [?] Jie [?] " + // U+21E00
+		"[?] Ti [?] [?] Xie Qiong [?] Ya Ju [?] [?] Yin Zhi [?] Kan Zi " + // U+21E10
+		"[?] Ke [?] Nie Qiang Wan Ze [?] Ju [?] Zi [?] [?] [?] [?] [?] " + // U+21E20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21E30
+		"[?] [?] [?] [?] Ya [?] [?] Lin [?] Qi [?] [?] [?] [?] Hui [?] " + // U+21E40
+		"[?] [?] [?] Qi [?] Yang Sui [?] Qi Gui [?] [?] [?] [?] [?] [?] " + // U+21E50
+		"[?] [?] Qin E [?] Zuo [?] [?] Ze Qi Ji [?] Tuo Die [?] Hui " + // U+21E60
+		"Mao [?] Xu [?] [?] Hou Yan Xiang Cong Hu [?] [?] An [?] Bing [?] " + // U+21E70
+		"[?] [?] [?] Qiang [?] [?] [?] Duo [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21E80
+		"Zhu Die You Qi Shi Xun You Kan Qiao [?] [?] Qiang Pen [?] [?] Quan " + // U+21E90
+		"[?] Ying [?] [?] [?] [?] [?] Sha [?] [?] [?] Tao [?] Hong Pi Yao " + // U+21EA0
+		"[?] [?] [?] [?] Tu Chai [?] Xia Qi [?] Qiong [?] [?] Jin [?] [?] " + // U+21EB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zhen [?] [?] [?] Zhu [?] Xi [?] " + // U+21EC0
+		"Weng Zhong [?] [?] [?] Sui [?] [?] Ke Kuo Kang [?] [?] Chao Bi Mo " + // U+21ED0
+		"Zhu Han Yu Yi Ma [?] [?] Qi Gun Man Liao Lin Zu Lei Hu Chuang " + // U+21EE0
+		"Qi Lei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21EF0
+		"[?] Chi [?] Po Die [?] [?] [?] [?] [?] Lei [?] [?] [?] Yi [?] " + // U+21F00
+		"[?] [?] [?] Dian [?] [?] Dun Gao Hu [?] Xiao Ga Peng [?] [?] [?] " + // U+21F10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shen [?] [?] [?] " + // U+21F20
+		"[?] Wei [?] [?] [?] [?] [?] [?] [?] [?] [?] Dui Chao Yin Kuai Ku " + // U+21F30
+		"[?] Zui Gu [?] [?] Yun Zhi [?] [?] Ji Cheng [?] [?] [?] [?] [?] " + // U+21F40
+		"[?] [?] [?] [?] [?] [?] Xie Xian [?] [?] [?] Zui An Hao [?] [?] " + // U+21F50
+		"Po [?] Di Ye [?] [?] [?] Nao [?] [?] [?] [?] [?] [?] [?] [?] " + // U+21F60
+		"[?] Jie Bang Lan Cang [?] Bi [?] [?] [?] [?] Zhan Qi [?] [?] [?] " + // U+21F70
+		"[?] [?] Nao [?] [?] Lu [?] Kuang [?] Mo [?] Lei Pao [?] [?] [?] " + // U+21F80
+		"[?] [?] Li Ceng [?] Dang Lei [?] [?] E [?] Beng Jue [?] [?] [?] " + // U+21F90
+		"[?] [?] [?] [?] [?] Xuan Nie [?] Hai [?] [?] [?] [?] [?] Xian [?] " + // U+21FA0
+		"Jian Mi Nie [?] [?] [?] [?] [?] [?] [?] [?] Cang Song Zeng Yi [?] " + // U+21FB0
+		"[?] [?] Chong [?] Cang [?] [?] [?] [?] Lei Nuo Li [?] [?] Li Luo " + // U+21FC0
+		"[?] [?] [?] Tang [?] [?] Nie Nie [?] Ji [?] Lei [?] Nang [?] [?] " + // U+21FD0
+		"Lin Ling [?] [?] Xian Yu [?] Zai Quan Lie [?] [?] [?] [?] [?] Yu " + // U+21FE0
+		"Huang [?] [?] [?] [?] [?] [?] [?] [?] [?] Nao [?] Xun [?] Ju Huo " + // U+21FF0
+		"[?] Yi [?] [?] [?] [?] [?] [?] [?] [?] Xi Se Jiao Yong [?] [?] " + // U+22000
+		"[?] [?] [?] [?] [?] Shi Jing Wan Ye Jiu [?] [?] Gong [?] [?] [?] " + // U+22010
+		"[?] Hui [?] [?] [?] [?] [?] [?] [?] [?] Er [?] [?] [?] [?] [?] " + // U+22020
+		"[?] [?] [?] [?] [?] Han [?] [?] [?] [?] [?] [?] Fu [?] [?] [?] " + // U+22030
+		"Fu Zhuo Ji [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Bang " + // U+22040
+		"[?] [?] Qi Shi [?] Diao Pei Xian San [?] [?] [?] [?] Chang Yue [?] " + // U+22050
+		"Gong [?] Wu [?] Fen [?] [?] Chan [?] Nei Jue [?] Zhao [?] Qian [?] " + // U+22060
+		"[?] Ao [?] [?] [?] [?] Wang Zhong [?] Huang [?] Bu Zhu Bi Chao Zheng " + // U+22070
+		"Fu Kou [?] Zuo Xuan [?] Fu [?] [?] [?] Yao [?] [?] Bo [?] Bei " + // U+22080
+		"Xie Shi Yi [?] Hong Cui [?] Yi Zhuan [?] [?] [?] [?] Chi [?] [?] " + // U+22090
+		"[?] [?] [?] [?] Po [?] [?] [?] Yin [?] [?] [?] [?] [?] [?] [?] " + // U+220A0
+		"[?] Yuan [?] [?] [?] [?] Jiong [?] [?] Mao Qian [?] Yi [?] [?] [?] " + // U+220B0
+		"Wu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Bei Huo Cong " + // U+220C0
+		"Kong [?] [?] [?] [?] Ta [?] Han Qian [?] [?] [?] Zhi [?] [?] [?] " + // U+220D0
+		"[?] [?] Se [?] [?] Qian Guo [?] [?] Gun [?] [?] Jian Zhong Mian Gui " + // U+220E0
+		"Shi Mou E Ba La [?] [?] [?] Zhou [?] Ji [?] [?] [?] [?] [?] " + // U+220F0
+		"Zao [?] [?] [?] Zha Yi [?] Gou [?] [?] Gui Ying Shai He Bang Mo " + // U+22100
+		"Meng [?] [?] Wu Dai [?] [?] Jiong [?] [?] [?] [?] Han [?] [?] Tong " + // U+22110
+		"Kou Li Zhi Hui Zan [?] Diao Cu [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22120
+		"[?] Zhi [?] Kua [?] Xiang Hua Liao Cui Qiao Jiao [?] Xu Er [?] Tuo " + // U+22130
+		"Tan Zhi [?] [?] [?] [?] [?] [?] Nao Mao Di Ceng [?] [?] Jiao Lian " + // U+22140
+		"[?] Sha Dan [?] [?] Sui Lian Guo [?] [?] Biao [?] Ci Dian Lu Ni " + // U+22150
+		"Yan Lan [?] [?] Gai Chu [?] [?] [?] Bi Zu Hui [?] Lai Xian Fen " + // U+22160
+		"He [?] [?] [?] [?] [?] [?] [?] [?] Yao Zhan [?] Nei [?] Luo [?] " + // U+22170
+		"Yuan [?] Neng [?] [?] [?] [?] [?] [?] Ren [?] [?] [?] [?] [?] [?] " + // U+22180
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ge [?] Jian Ping " + // U+22190
+		"[?] [?] [?] Bie [?] [?] Jian [?] [?] Bing [?] [?] [?] [?] [?] Mi " + // U+221A0
+		"Hu [?] [?] [?] Diao [?] You Yao Beng [?] Chen Ji [?] Yao [?] [?] " + // U+221B0
+		"[?] [?] [?] [?] [?] [?] [?] Guan Yan [?] [?] [?] [?] [?] [?] [?] " + // U+221C0
+		"[?] [?] [?] [?] [?] Chi [?] Sha Yan Yi Yi Che [?] [?] Han Huang " + // U+221D0
+		"[?] [?] [?] [?] Shui Sui Ren Tan Zhi [?] Fan Feng [?] [?] [?] [?] " + // U+221E0
+		"Tan [?] Mi Pi Bu Na Tian Ba Yi [?] [?] [?] [?] [?] [?] [?] " + // U+221F0
+		"[?] [?] Yan [?] Tiao [?] Yao Shen Ke Tong [?] Xuan [?] [?] [?] [?] " + // U+22200
+		"[?] [?] [?] You [?] Bai [?] [?] [?] Xia Lu Kun Zang Qiu [?] [?] " + // U+22210
+		"Cu Zui Lou [?] Xia [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shen " + // U+22220
+		"[?] [?] Pu [?] Jing Qiang Yi [?] Nie Dui [?] Jie Sui Zhan Cou [?] " + // U+22230
+		"[?] Beng Guan She [?] Jin Di [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22240
+		"[?] Dan [?] Nai [?] Nou [?] Ji Yan [?] Nou [?] Du Wei Pian [?] " + // U+22250
+		"[?] [?] Hu [?] Jia Ye Jun Lan La Yin [?] [?] [?] Tui [?] [?] " + // U+22260
+		"[?] [?] [?] [?] [?] Nao [?] [?] [?] [?] Zu [?] [?] [?] [?] Ma " + // U+22270
+		"Si Zhi [?] [?] Hui Zhui [?] Hui [?] [?] [?] [?] [?] Chu [?] Che " + // U+22280
+		"[?] [?] Xiu Lan [?] Cong Shen Mo Yi Yao Xi Zui Bing [?] [?] [?] " + // U+22290
+		"[?] [?] [?] [?] [?] [?] [?] Yu [?] Lu [?] [?] [?] [?] Tui Wei " + // U+222A0
+		"[?] Fen Shen [?] [?] [?] [?] [?] [?] [?] [?] Liao [?] [?] [?] [?] " + // U+222B0
+		"[?] [?] Shu Dan Juan Yu Xin Yao Su [?] [?] [?] [?] [?] [?] [?] " + // U+222C0
+		"[?] [?] Huo [?] Qian [?] [?] [?] [?] [?] Ma [?] [?] Kai [?] [?] " + // U+222D0
+		"[?] Lu [?] You [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xian [?] " + // U+222E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Wu [?] Yin Xi [?] [?] Zhai " + // U+222F0
+		"Xie [?] [?] [?] Qu [?] [?] [?] Li [?] [?] [?] [?] Qian [?] [?] " + // U+22300
+		"[?] [?] [?] [?] Ling Luan [?] [?] [?] [?] Chan [?] [?] [?] [?] [?] " + // U+22310
+		"[?] [?] [?] [?] [?] [?] Zheng [?] Yan [?] [?] [?] [?] [?] [?] [?] " + // U+22320
+		"[?] [?] Yin Kui [?] [?] [?] Qu [?] Fu [?] Yu [?] [?] [?] [?] " + // U+22330
+		"[?] Qi [?] [?] [?] [?] Qi Ji Yuan [?] [?] [?] [?] [?] Gao Juan " + // U+22340
+		"[?] Qi [?] Gai [?] Quan [?] [?] [?] [?] Wei [?] [?] [?] [?] [?] " + // U+22350
+		"[?] [?] [?] [?] [?] [?] [?] Zhi [?] [?] [?] Jian [?] Si [?] [?] " + // U+22360
+		"Yi Qian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Li [?] [?] Zang " + // U+22370
+		"Yi [?] Cai Yi Ge [?] Die [?] Zhi Yi [?] Zai Dai [?] Su [?] " + // U+22380
+		"[?] [?] [?] [?] Jie Chen Qu [?] Han Xian [?] [?] [?] [?] [?] [?] " + // U+22390
+		"Quan Jie [?] [?] [?] Juan [?] [?] [?] [?] Dan [?] [?] Jin [?] [?] " + // U+223A0
+		"[?] [?] [?] [?] Bing Hu [?] [?] [?] Jue [?] Yu [?] [?] [?] [?] " + // U+223B0
+		"[?] [?] [?] Li Qiang Shui Ku [?] Zhen [?] [?] [?] [?] Fu Shen [?] " + // U+223C0
+		"[?] [?] Chui [?] [?] Tong [?] Yi [?] Yang [?] [?] Tuo Zhou Ji [?] " + // U+223D0
+		"[?] [?] [?] [?] Xun [?] Shen Xuan [?] [?] [?] [?] [?] Liu Yuan Hu " + // U+223E0
+		"Zheng [?] [?] Peng [?] [?] [?] Jue [?] [?] [?] [?] [?] [?] [?] [?] " + // U+223F0
+		"[?] [?] Zhi Pian Yuan [?] Jian [?] [?] [?] Pang [?] [?] [?] Zhuan [?] " + // U+22400
+		"Xian [?] Beng [?] Cong [?] Mo [?] [?] [?] Guo [?] [?] [?] Cheng Qiao " + // U+22410
+		"[?] [?] [?] [?] [?] [?] Bi [?] [?] Qiang [?] Zhou [?] [?] [?] [?] " + // U+22420
+		"[?] [?] Fan Bie [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Bo Rong " + // U+22430
+		"[?] [?] [?] [?] [?] Ding Quan Jiu Yao [?] [?] [?] [?] [?] [?] [?] " + // U+22440
+		"[?] [?] [?] Xia [?] [?] Zao [?] [?] [?] [?] [?] [?] Dan [?] Wu " + // U+22450
+		"Tuo [?] Hu [?] [?] [?] [?] Xi [?] [?] [?] [?] Lai [?] Fei [?] " + // U+22460
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Hu [?] [?] [?] [?] [?] [?] " + // U+22470
+		"[?] [?] [?] [?] [?] [?] Xian [?] [?] Shan [?] [?] [?] Fei [?] [?] " + // U+22480
+		"Cuo [?] Fu [?] Chu [?] [?] [?] [?] [?] [?] [?] [?] Diu Lan [?] " + // U+22490
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Xi [?] [?] [?] [?] [?] Biao " + // U+224A0
+		"Yu Sui Xi [?] [?] [?] [?] Pou [?] Shan [?] [?] [?] [?] Jiao [?] " + // U+224B0
+		"Yi [?] [?] Wan Ji [?] Wan Tui [?] [?] [?] Ang [?] Tian Chi [?] " + // U+224C0
+		"[?] [?] Ran [?] Sa Yin Pi Ci Tong Yin [?] [?] Ge Tiao Zheng Zhou " + // U+224D0
+		"[?] Yi Kua Song [?] [?] [?] Di [?] [?] [?] [?] Xie [?] Xiao Guang " + // U+224E0
+		"Tuo Feng Wu [?] [?] Xiu [?] [?] [?] [?] [?] [?] [?] [?] [?] You " + // U+224F0
+		"[?] Ling Yan [?] [?] Dong Qi Tao Han [?] Chi Song [?] [?] [?] [?] " + // U+22500
+		"[?] Quan [?] [?] Han [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Rou " + // U+22510
+		"Qi Kai Yu Cha Cheng Yu [?] Bing [?] Cong Zhu [?] Yu [?] [?] [?] " + // U+22520
+		"[?] Jue Liu Sao Yu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22530
+		"[?] [?] [?] [?] [?] Shuai [?] [?] [?] [?] [?] Yuan [?] [?] Zhang [?] " + // U+22540
+		"[?] Shuai [?] Chu Zhang San Xian [?] Cui Meng Di [?] [?] [?] Zhi Ao " + // U+22550
+		"[?] [?] [?] [?] [?] [?] Xiu [?] Pian [?] Jiao Kuan Sa Xian Zha Dian " + // U+22560
+		"[?] [?] [?] [?] [?] [?] [?] Yi [?] [?] Hui Shan [?] [?] [?] [?] " + // U+22570
+		"[?] [?] [?] [?] Chong Yi Xie Zhi Tiao [?] Ping Xian [?] [?] Xian Su " + // U+22580
+		"[?] Cuan [?] [?] [?] [?] [?] Song [?] [?] [?] Hei [?] Xian [?] You " + // U+22590
+		"[?] Yu [?] [?] Tai [?] Jue Nang [?] Dian [?] Yi Bi [?] [?] [?] " + // U+225A0
+		"[?] [?] [?] Xu Yi Ru [?] Gong [?] [?] Yi [?] [?] [?] [?] Zhi " + // U+225B0
+		"Xin [?] Ji [?] Xia [?] [?] [?] Zhao Ne Xie [?] [?] [?] Yi [?] " + // U+225C0
+		"[?] [?] [?] Yu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+225D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Fu [?] She [?] Yuan " + // U+225E0
+		"Fan [?] Fu Wu Xi Hong [?] [?] [?] Ji Chang [?] [?] [?] [?] Mo " + // U+225F0
+		"Pei [?] [?] Mu Qiu Mao [?] Da [?] Xia Shen Te Hong Bi [?] [?] " + // U+22600
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Long [?] [?] [?] Ni [?] Qiao " + // U+22610
+		"[?] [?] [?] [?] [?] [?] [?] Ruan [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22620
+		"[?] [?] [?] [?] [?] [?] [?] [?] Jiang Cha Mi [?] [?] Yi [?] Suo " + // U+22630
+		"[?] Wu Xuan [?] [?] Xi [?] Yi [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22640
+		"Nao Mai Xiao Wei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22650
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Kan [?] " + // U+22660
+		"[?] Long Lu Zhuang [?] [?] [?] [?] [?] [?] Zhi [?] Xing [?] Geng Jin " + // U+22670
+		"Xian Ji Cuo [?] Lao Fen Ju [?] [?] [?] [?] Miao Xia [?] [?] [?] " + // U+22680
+		"[?] Su [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22690
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zhi [?] Hu Kou [?] Suo Ni [?] " + // U+226A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Teng Zhu [?] [?] [?] [?] " + // U+226B0
+		"[?] Da [?] Qiu Ya [?] Xian [?] [?] Nei [?] [?] [?] Zhi Bie [?] " + // U+226C0
+		"[?] [?] Chong Lan Dong Qun Xiang [?] Xiao Wan Ru Wang Ni [?] Bai Ya " + // U+226D0
+		"[?] [?] [?] [?] [?] Si Yin [?] Yu [?] [?] [?] [?] [?] Li Huo " + // U+226E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+226F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22700
+		"[?] [?] [?] [?] [?] [?] [?] Bang [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22710
+		"[?] [?] [?] Xi [?] Jiu [?] [?] Xie Qian Nuo Xing Duo Ji Wu Mu " + // U+22720
+		"Yan Qi Na Chi Hou [?] Sao [?] Nao [?] [?] Cheng Cheng Kui [?] Jia " + // U+22730
+		"Tu Hong Du [?] [?] Xia Zhong Huo Chong Da [?] [?] Mao Yao [?] [?] " + // U+22740
+		"[?] [?] [?] Juan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22750
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shi [?] [?] Yin " + // U+22760
+		"[?] [?] [?] Gu Wu [?] [?] [?] Guo Ti [?] Hong [?] [?] [?] [?] " + // U+22770
+		"[?] [?] [?] [?] [?] [?] [?] Re [?] Yi [?] Tun [?] [?] [?] Qiong " + // U+22780
+		"Hai [?] Qi [?] [?] Huo Ti Pi [?] [?] Geng [?] Xie [?] Mi Gao " + // U+22790
+		"Ta Xiang [?] Shu [?] [?] Fu [?] [?] [?] [?] [?] Zhuan Liu [?] [?] " + // U+227A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+227B0
+		"[?] [?] [?] [?] [?] You [?] [?] [?] [?] Cheng Dui [?] [?] [?] [?] " + // U+227C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+227D0
+		"[?] [?] Li Yang Li [?] [?] Lu Mu Sui Ai [?] [?] Kou [?] Zhe " + // U+227E0
+		"Ai Teng [?] Lu Tui Bi [?] [?] [?] [?] [?] [?] Meng [?] Hui Huan " + // U+227F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22800
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Kuo [?] Xin [?] [?] " + // U+22810
+		"[?] Sao [?] [?] [?] [?] [?] [?] [?] [?] [?] Shu Que Ba Tui [?] " + // U+22820
+		"[?] [?] Fu Bie [?] Tang [?] Xiang [?] Si Bo [?] Mai Dang [?] Gui " + // U+22830
+		"Hei Xi Dang Yi [?] Bi [?] Gu Cui Se [?] [?] [?] Ge Yu Na " + // U+22840
+		"[?] Li Zhi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22850
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22860
+		"Zhao [?] [?] [?] Ji Ruan [?] [?] [?] Chong [?] [?] [?] [?] [?] [?] " + // U+22870
+		"[?] [?] Jie [?] [?] [?] [?] [?] [?] [?] [?] [?] Chang Zhe [?] [?] " + // U+22880
+		"[?] [?] Su Yong [?] [?] Qi Zhuo [?] [?] Kai [?] Ye [?] Qi [?] " + // U+22890
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+228A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Xiong [?] [?] [?] [?] [?] [?] " + // U+228B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Yi Chou [?] [?] [?] Tuan Ai " + // U+228C0
+		"Pin [?] [?] Lie Mian Ai [?] Mo Wei Ying Ni [?] [?] [?] Bo [?] " + // U+228D0
+		"Liu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+228E0
+		"[?] [?] [?] Rui [?] Chu [?] [?] [?] [?] [?] Lu Cha [?] [?] Chu " + // U+228F0
+		"[?] Sao Li [?] Song [?] Li [?] [?] [?] [?] Xi [?] Yan Cuo [?] " + // U+22900
+		"Liu [?] [?] [?] [?] [?] [?] [?] Meng [?] Zhan [?] [?] [?] [?] [?] " + // U+22910
+		"[?] [?] [?] [?] Zhuang [?] [?] Miao [?] Li [?] Ju [?] [?] [?] Xie " + // U+22920
+		"Xie Long Long [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22930
+		"[?] [?] Teng Zhu [?] [?] [?] [?] [?] [?] [?] Chan Xian [?] [?] Ying " + // U+22940
+		"Pei [?] [?] [?] [?] [?] [?] [?] Xie [?] Jiao [?] [?] [?] Chong [?] " + // U+22950
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22960
+		"[?] [?] [?] He [?] [?] [?] [?] [?] [?] [?] [?] [?] Tun [?] [?] " + // U+22970
+		"[?] [?] [?] [?] [?] Hong [?] [?] Man [?] Jin [?] Qu Dou Qiu Zai " + // U+22980
+		"[?] Sheng Zai [?] [?] Yi [?] [?] [?] [?] Hua [?] [?] [?] [?] Kan " + // U+22990
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+229A0
+		"Yue Ni Si [?] Wo [?] [?] [?] Can [?] Jian [?] Mie Shao [?] Rong " + // U+229B0
+		"Gan [?] [?] [?] [?] Qiang [?] Shu Zhuo [?] [?] [?] [?] [?] [?] Shi " + // U+229C0
+		"Zhan Ti [?] [?] [?] [?] Zha Zhan [?] [?] [?] [?] [?] Fen Mie [?] " + // U+229D0
+		"Ze [?] [?] [?] Zhi Qian Han Ge [?] [?] [?] [?] [?] [?] Can [?] " + // U+229E0
+		"Guo Jiao [?] Yong Ao [?] [?] [?] [?] [?] [?] Zha [?] Xi [?] [?] " + // U+229F0
+		"[?] Xu Wu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jue " + // U+22A00
+		"Ji [?] Chi [?] Wan [?] Mie Zei [?] [?] [?] [?] Jie Shi [?] Xi " + // U+22A10
+		"[?] E [?] [?] [?] Hu Hu [?] Li [?] [?] Chu [?] [?] Yi Mao " + // U+22A20
+		"Xu Zhong [?] Yi [?] [?] [?] [?] [?] [?] Liao [?] [?] [?] [?] Jian " + // U+22A30
+		"Jian Ju [?] [?] Zhu [?] [?] [?] Wu [?] [?] [?] [?] [?] [?] Ke " + // U+22A40
+		"Ke Li Bi Ge [?] Xu Sha Ling Ke [?] [?] [?] [?] [?] Bo Bian " + // U+22A50
+		"Shuan Qi Shan [?] [?] [?] Ji [?] Qiao [?] [?] [?] [?] [?] Yi Jue " + // U+22A60
+		"Zhang [?] Xin [?] [?] [?] [?] Tuo Hai Xia [?] Tuo Yi [?] [?] [?] " + // U+22A70
+		"[?] [?] [?] Cu [?] [?] [?] Jiang Nan [?] [?] Peng [?] Jie Xue Hu " + // U+22A80
+		"[?] [?] [?] Ju [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22A90
+		"[?] [?] [?] [?] [?] You Nu Ye [?] [?] Yin [?] Kong [?] [?] [?] " + // U+22AA0
+		"[?] [?] [?] [?] [?] [?] Xiao Xiang [?] [?] [?] [?] Nao [?] Zhang [?] " + // U+22AB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22AC0
+		"Jie [?] [?] Nu Shan [?] [?] [?] Lu [?] [?] [?] [?] [?] [?] [?] " + // U+22AD0
+		"[?] [?] Jia [?] [?] [?] [?] Zhou Rong [?] [?] Lu Sa Nu [?] Bo " + // U+22AE0
+		"Zhe [?] Qin [?] Ci Zu [?] Wo Wu [?] [?] Nie [?] [?] [?] Xian " + // U+22AF0
+		"Hong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ye [?] [?] " + // U+22B00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22B10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ting Jin [?] [?] [?] " + // U+22B20
+		"[?] Jie He Tu Zhe Pin Jin Nan [?] [?] [?] [?] Dun [?] Xi Xie " + // U+22B30
+		"[?] Xi Lao Duan Ji Cha Chou [?] Gang [?] [?] [?] [?] [?] Xiang Dao " + // U+22B40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22B50
+		"[?] [?] [?] [?] [?] Bian Xiao Xin [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22B60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22B70
+		"[?] Yu Xian Li Qian [?] [?] Mei [?] Qiao Ya [?] Qia Qiong [?] Bang " + // U+22B80
+		"Zheng [?] [?] [?] [?] [?] [?] [?] [?] [?] Ze Shuan [?] [?] Sao [?] " + // U+22B90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22BA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22BB0
+		"[?] [?] [?] [?] [?] Lu [?] [?] [?] Xie [?] Fu Zhai [?] [?] [?] " + // U+22BC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22BD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Ze [?] Duan [?] Deng Yu [?] " + // U+22BE0
+		"Lu [?] Wan Xue Jiao Yue Zhi Wei [?] Ge Ju [?] Yan Cuo Mao [?] " + // U+22BF0
+		"[?] [?] [?] [?] [?] [?] Fu Ai [?] [?] Xuan [?] Gang An [?] [?] " + // U+22C00
+		"[?] [?] Ji [?] [?] [?] [?] [?] Pi Zhi [?] [?] Nuo [?] [?] [?] " + // U+22C10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22C20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Pan " + // U+22C30
+		"[?] Yi [?] [?] Jie [?] Zi [?] Jia Wai [?] [?] Jia [?] [?] [?] " + // U+22C40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chan " + // U+22C50
+		"[?] Suo Suo Ji Song [?] Ti Pi Po [?] [?] [?] [?] [?] Mi [?] " + // U+22C60
+		"[?] [?] [?] [?] Ye [?] Qin Jin [?] [?] Jue [?] [?] Yuan Ruan [?] " + // U+22C70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22C80
+		"[?] [?] [?] [?] Ban [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22C90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22CA0
+		"Bin [?] [?] [?] Wei Zao Qie Sou Lu [?] [?] [?] Die Chuai Bi Zhu " + // U+22CB0
+		"Ma Fei Pie Yin Xuan [?] Ao Zhuo Zu [?] [?] Bi [?] [?] [?] [?] " + // U+22CC0
+		"[?] Lang [?] Ti [?] [?] [?] [?] [?] Tiao Jian [?] [?] [?] [?] Tong " + // U+22CD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22CE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Duo Dong [?] " + // U+22CF0
+		"[?] [?] Bian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22D00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22D10
+		"Zhi [?] Fen [?] [?] [?] Kang Zhi Zhai Bi Kuan [?] Ban Jue Qu [?] " + // U+22D20
+		"Qi Lei Xie Tang [?] [?] [?] [?] [?] [?] [?] [?] Sou [?] Bei [?] " + // U+22D30
+		"[?] [?] [?] [?] [?] [?] [?] Yang Jian [?] [?] [?] [?] [?] [?] [?] " + // U+22D40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22D50
+		"[?] [?] [?] [?] [?] Zao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22D60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22D70
+		"Zhuai [?] [?] Fan [?] She [?] Qiong [?] Po [?] Tie Sha Za [?] [?] " + // U+22D80
+		"[?] Niao Guai Cui [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22D90
+		"[?] Qiao [?] Die [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22DA0
+		"[?] [?] [?] Pin Ci [?] Bang [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22DB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yin [?] [?] " + // U+22DC0
+		"[?] Xian [?] [?] Yi Miao Duan Zhou [?] Kong [?] [?] [?] [?] [?] [?] " + // U+22DD0
+		"[?] [?] Zhang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22DE0
+		"[?] [?] [?] [?] [?] [?] Liu [?] Zhi Chan Du Yuan [?] [?] Suo Jie " + // U+22DF0
+		"Li Gong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Bang [?] [?] [?] " + // U+22E00
+		"[?] [?] [?] [?] [?] [?] [?] Guo Liao Shen [?] [?] [?] [?] [?] [?] " + // U+22E10
+		"[?] [?] [?] Niao [?] Cuan Wei [?] Tuo [?] [?] Su [?] Long [?] [?] " + // U+22E20
+		"[?] [?] [?] Xiao Yan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22E30
+		"[?] [?] [?] Qing [?] [?] [?] [?] [?] [?] [?] [?] [?] Xi [?] Yu " + // U+22E40
+		"[?] Zheng Xie Chai Fen [?] Guo [?] Jing Lan Xian [?] [?] Ling [?] [?] " + // U+22E50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lei [?] " + // U+22E60
+		"[?] [?] Jun Xiao [?] [?] [?] [?] [?] [?] [?] [?] Za [?] [?] [?] " + // U+22E70
+		"[?] [?] [?] [?] Guan Qie Luo Yao Luan Ta [?] [?] [?] [?] [?] [?] " + // U+22E80
+		"[?] Luo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ba Chan " + // U+22E90
+		"[?] Zhuo [?] [?] [?] [?] [?] [?] [?] [?] [?] Tiao [?] [?] [?] Wan " + // U+22EA0
+		"Ling [?] [?] [?] Yu Qi [?] Qi [?] [?] [?] [?] Ji Bo [?] Shi " + // U+22EB0
+		"Fu [?] Gui [?] [?] Dian [?] Hao [?] Gai [?] Qi [?] [?] [?] [?] " + // U+22EC0
+		"[?] [?] [?] Cheng Hui [?] [?] Xia Shi Zhi Qi [?] Hai [?] [?] Jiao " + // U+22ED0
+		"Li [?] Liao [?] Qiao [?] [?] [?] Sa [?] Qi Shi [?] [?] Jie [?] " + // U+22EE0
+		"[?] [?] [?] [?] [?] Bei Bian Ba Jun Pi [?] [?] Dan [?] [?] Tang " + // U+22EF0
+		"Kui Ku [?] Kou [?] [?] [?] [?] [?] Shi Shi Ji Bao [?] [?] [?] " + // U+22F00
+		"Ke Kuang [?] [?] [?] [?] Min [?] [?] Liao E Ge [?] [?] [?] Wang " + // U+22F10
+		"Duo [?] [?] Qia Hua [?] Hong [?] [?] Peng [?] Jiao [?] [?] [?] [?] " + // U+22F20
+		"Qu Zi Zhou Kuang [?] Sha [?] Ji Wei Pu Xue [?] Shao [?] [?] [?] " + // U+22F30
+		"[?] [?] Lang Zhi Ting [?] [?] Da [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22F40
+		"[?] [?] [?] [?] [?] Yang Jin Zhi [?] [?] Zhuo [?] Za Chan [?] [?] " + // U+22F50
+		"[?] [?] Mao [?] [?] [?] Kong Zhou Hu Peng [?] [?] [?] Jiu [?] [?] " + // U+22F60
+		"[?] [?] [?] [?] [?] [?] [?] [?] Chuo Min [?] [?] [?] [?] Xiao [?] " + // U+22F70
+		"Du Wei [?] Can Yu Du Kai Pi [?] [?] Cheng [?] [?] [?] Chun [?] " + // U+22F80
+		"Shao Yan Kuai [?] Yue [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+22F90
+		"[?] [?] [?] [?] [?] [?] Qi Zheng [?] Ke Qi Zhi Lu [?] [?] [?] " + // U+22FA0
+		"[?] Pi Nuo Pao [?] [?] [?] [?] [?] [?] Fei [?] [?] [?] [?] Wen " + // U+22FB0
+		"[?] [?] Meng [?] [?] [?] [?] [?] Shan [?] [?] [?] Xiong [?] Duo Biao " + // U+22FC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] You [?] Man [?] Liao [?] " + // U+22FD0
+		"[?] Xie Luan Qiao Deng [?] Cheng Cheng [?] [?] [?] [?] [?] Chuo [?] [?] " + // U+22FE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Ce [?] [?] [?] [?] [?] [?] [?] " + // U+22FF0
+		"Lei Zhan Li Lian Qun [?] [?] [?] [?] [?] [?] [?] [?] Chen [?] Cheng " + // U+23000
+		"Gu [?] Zong Chou Chuan [?] [?] [?] [?] [?] [?] [?] Lei Shuo Lu [?] " + // U+23010
+		"[?] [?] [?] Fu [?] Li [?] San [?] [?] [?] San [?] [?] [?] Sa " + // U+23020
+		"[?] [?] [?] Nie [?] [?] Zuan Li [?] [?] [?] Shu [?] [?] Fu [?] " + // U+23030
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Bi [?] [?] [?] Dao [?] [?] " + // U+23040
+		"[?] [?] Shi [?] [?] [?] Gan Tan [?] [?] [?] [?] Man [?] [?] Li " + // U+23050
+		"[?] [?] Bi [?] [?] [?] Pan [?] You [?] [?] [?] [?] Jiu [?] Guo " + // U+23060
+		"Liao [?] [?] Wo Qia Dou [?] Lie [?] Jiao [?] Lie [?] [?] [?] [?] " + // U+23070
+		"[?] Tiao [?] [?] Guo [?] Pang Qiao [?] Di Yun [?] [?] [?] [?] [?] " + // U+23080
+		"[?] [?] Le [?] [?] [?] Si Xin [?] [?] [?] [?] Xin Xiang Luo [?] " + // U+23090
+		"[?] [?] [?] [?] Beng Tiao [?] [?] [?] [?] [?] [?] Xiao [?] Dou [?] " + // U+230A0
+		"[?] [?] [?] Dang Ting Zhuan [?] [?] [?] [?] [?] Ou [?] Wo [?] [?] " + // U+230B0
+		"[?] Zhu [?] [?] Xin Ruan [?] [?] Zhuo Dang [?] [?] [?] Cui [?] [?] " + // U+230C0
+		"[?] Zhuo [?] [?] [?] [?] [?] Cong Chan [?] [?] [?] [?] Yang [?] [?] " + // U+230D0
+		"[?] [?] [?] [?] [?] [?] [?] Yan [?] [?] [?] [?] [?] [?] [?] [?] " + // U+230E0
+		"[?] [?] [?] Yan [?] Zhen [?] [?] [?] [?] [?] [?] [?] Nuo Yan [?] " + // U+230F0
+		"[?] [?] [?] [?] [?] Fang [?] [?] [?] Yan Yu [?] [?] Ti Fu Ben " + // U+23100
+		"[?] Yan [?] Hui [?] [?] [?] [?] [?] Huang [?] [?] Gui Yan [?] Hu " + // U+23110
+		"Biao [?] [?] [?] [?] [?] [?] Sui [?] [?] [?] [?] [?] [?] Zi Ji " + // U+23120
+		"E Ji Kui [?] Liang [?] [?] [?] Huo [?] Wei Zhuo [?] [?] [?] Ting " + // U+23130
+		"[?] [?] [?] Zai You [?] [?] [?] [?] Ren [?] [?] [?] Mian [?] [?] " + // U+23140
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Na [?] [?] Tu [?] Dan " + // U+23150
+		"[?] Jue [?] [?] Xu Di [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23160
+		"Xiang [?] [?] [?] [?] [?] [?] Xiong [?] [?] You Gua [?] [?] Xi [?] " + // U+23170
+		"[?] [?] [?] [?] [?] [?] [?] [?] He [?] [?] [?] [?] Ding [?] [?] " + // U+23180
+		"Lu [?] Xu [?] Zhou Xian Huang Cha Shi Gan Nuo An [?] [?] [?] Xie " + // U+23190
+		"[?] [?] [?] [?] [?] [?] [?] Hao [?] [?] [?] [?] [?] [?] [?] [?] " + // U+231A0
+		"[?] [?] Qin Geng Shan Fu [?] [?] [?] [?] [?] [?] [?] Ze [?] [?] " + // U+231B0
+		"[?] [?] [?] [?] [?] [?] [?] Dan [?] [?] [?] [?] [?] [?] [?] [?] " + // U+231C0
+		"[?] [?] [?] [?] [?] [?] Dian Shen [?] Zu [?] [?] [?] [?] [?] [?] " + // U+231D0
+		"[?] [?] Bie [?] [?] [?] Chui Zhe Dai [?] [?] Wo Qiong [?] [?] [?] " + // U+231E0
+		"Lin [?] Hun Ji [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+231F0
+		"[?] [?] [?] [?] [?] Cao [?] [?] [?] [?] Mu [?] [?] Die Wei [?] " + // U+23200
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23210
+		"Bian Ti [?] Ying [?] Tu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23220
+		"[?] [?] [?] [?] [?] [?] Geng [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23230
+		"[?] [?] [?] [?] Chi Cou Ti [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23240
+		"[?] [?] Huo Qi Sao Sang Xuan Ang Nai [?] Yang Shu Sha [?] [?] [?] " + // U+23250
+		"[?] Ting [?] [?] [?] [?] [?] [?] [?] Ya Huang [?] [?] [?] Bin [?] " + // U+23260
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ai [?] Ou Cao " + // U+23270
+		"[?] Ao [?] Mao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23280
+		"[?] [?] [?] [?] Meng [?] Tian [?] [?] [?] [?] [?] [?] Sang Xu Kan " + // U+23290
+		"[?] [?] [?] [?] [?] [?] [?] Lang [?] [?] [?] [?] [?] [?] [?] [?] " + // U+232A0
+		"[?] [?] [?] [?] [?] [?] Bie Cong [?] [?] Xian [?] [?] [?] [?] [?] " + // U+232B0
+		"[?] [?] [?] [?] Tun [?] [?] [?] [?] Yu Dan Ying [?] Zhao [?] Pu " + // U+232C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Hui [?] [?] [?] [?] [?] Ai Mo " + // U+232D0
+		"[?] [?] Jing Lan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+232E0
+		"[?] [?] Lie Piao [?] Bo Qiong [?] [?] Bi [?] [?] [?] [?] [?] Yong " + // U+232F0
+		"[?] [?] [?] [?] [?] Li [?] [?] [?] [?] [?] [?] [?] Nie [?] De " + // U+23300
+		"[?] [?] [?] Huan [?] [?] [?] Yue [?] [?] Chun [?] Li [?] Zhang Ling " + // U+23310
+		"Chun [?] [?] [?] [?] [?] [?] Ce Xun [?] [?] [?] Ju Hui [?] [?] " + // U+23320
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Tong [?] " + // U+23330
+		"[?] [?] [?] [?] [?] [?] Ning Ju [?] [?] [?] [?] [?] [?] [?] Cha " + // U+23340
+		"[?] [?] [?] [?] [?] [?] Zao [?] [?] [?] [?] Yu [?] [?] [?] Ken " + // U+23350
+		"[?] [?] [?] [?] [?] [?] Kuang Fei [?] [?] [?] [?] [?] [?] [?] Yun " + // U+23360
+		"Qian [?] [?] [?] Quan [?] [?] [?] Po [?] Pei [?] [?] [?] [?] [?] " + // U+23370
+		"[?] [?] [?] [?] Geng Yi Luo [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23380
+		"[?] Kuan [?] Xuan Nian [?] [?] [?] [?] [?] Hu Ju [?] [?] [?] [?] " + // U+23390
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Ye [?] [?] [?] [?] Xi [?] " + // U+233A0
+		"[?] Yue Tang Pin Dun Bei [?] [?] Liao [?] [?] [?] [?] [?] [?] [?] " + // U+233B0
+		"Yong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ya [?] " + // U+233C0
+		"[?] Jiao [?] [?] Kun [?] Zhen Shu [?] [?] Shi [?] [?] [?] You Pai " + // U+233D0
+		"Xiao Ji Tuan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+233E0
+		"[?] [?] [?] [?] [?] [?] Qi He [?] [?] Kong [?] [?] [?] [?] [?] " + // U+233F0
+		"[?] [?] Ye Chi [?] [?] [?] [?] [?] [?] Kao Yue [?] [?] Wa Nian " + // U+23400
+		"[?] Ci [?] Yi [?] Jing [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23410
+		"[?] [?] [?] [?] Jiu [?] [?] [?] [?] [?] [?] Yang Li [?] Dai Chong " + // U+23420
+		"[?] [?] [?] [?] [?] Yi [?] [?] [?] [?] Han [?] [?] [?] [?] Yi " + // U+23430
+		"[?] Chong Hu Zhua [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23440
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Tuan [?] [?] " + // U+23450
+		"[?] [?] [?] [?] [?] [?] Qiong Duo [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23460
+		"[?] [?] [?] [?] [?] [?] [?] [?] Tong Xian [?] [?] [?] [?] [?] Fu " + // U+23470
+		"[?] [?] Dian Xi Xie Zhen Qiao Tu [?] [?] [?] [?] Huo [?] [?] [?] " + // U+23480
+		"[?] [?] [?] [?] [?] [?] [?] He [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23490
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+234A0
+		"[?] [?] [?] [?] [?] [?] [?] Han Kuang Suo [?] Shou Tiao [?] [?] [?] " + // U+234B0
+		"Zhen [?] [?] Nei [?] Qian Yin [?] Liang Sha Zi Pi Gao [?] [?] Jin " + // U+234C0
+		"You [?] Shan [?] Mi Ou [?] Hu [?] [?] [?] You [?] Meng [?] [?] " + // U+234D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+234E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lao " + // U+234F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23500
+		"Zhi [?] [?] Bi [?] [?] [?] Shen Qi Xian Pan Kang [?] [?] [?] [?] " + // U+23510
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shuan Pi [?] Zai Zhu " + // U+23520
+		"[?] Sou Jiong [?] [?] Chan Fan Xiao Yin Hou Mao Tu Gan Ji [?] [?] " + // U+23530
+		"[?] Yi [?] Yu Jiong Pao [?] Xiao [?] Gou [?] [?] Gou Sun Xian Zhuan " + // U+23540
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23550
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23560
+		"[?] [?] Gen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chou [?] " + // U+23570
+		"[?] [?] [?] [?] Qiao Ti Yun [?] [?] Shan Lie [?] Zhi [?] [?] [?] " + // U+23580
+		"Pai [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23590
+		"[?] [?] [?] Ju Lai [?] [?] [?] Zi [?] Qu Gu Jue Zhi Ang Qin " + // U+235A0
+		"Pi Zui [?] Qian [?] Cuo [?] Ji Ti Ru [?] Hai Xun [?] Bei Zhi " + // U+235B0
+		"[?] Dun [?] [?] [?] [?] [?] [?] [?] [?] Man Dang [?] [?] [?] [?] " + // U+235C0
+		"Reng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+235D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+235E0
+		"[?] [?] Gan [?] [?] Gang Ta [?] Tuo Yang Ku Zhi [?] [?] [?] [?] " + // U+235F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23600
+		"Li [?] [?] [?] [?] [?] Jian Ni Shen Bang Shuai Dou [?] Qian Han Qia " + // U+23610
+		"Gan [?] [?] Chun Cha Bi Yi Fu E [?] Lao Hao Li [?] [?] [?] " + // U+23620
+		"[?] Te Shen [?] Yin [?] [?] Jian [?] [?] [?] Cha [?] [?] [?] [?] " + // U+23630
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23640
+		"[?] [?] [?] [?] [?] [?] [?] Nie Cou [?] [?] Yi [?] [?] [?] Tang " + // U+23650
+		"[?] [?] Juan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23660
+		"Chi Gou [?] [?] Jie Zhe Hu Mang [?] [?] [?] Zou Si [?] [?] Fei " + // U+23670
+		"Zi Zi [?] Jie Si [?] Chun Pao [?] [?] [?] Ye Di [?] Lei Xu " + // U+23680
+		"Ru [?] Pa Juan Xi Ye An [?] Yi Jian Zhu [?] Song Wo [?] Se " + // U+23690
+		"Zhi Bi Zhuan [?] [?] [?] Jiang Hao [?] Chi Dun [?] [?] [?] [?] [?] " + // U+236A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+236B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+236C0
+		"[?] [?] [?] Bo Ji Chua [?] Luo [?] [?] Rui [?] [?] [?] [?] [?] " + // U+236D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Hu [?] [?] [?] [?] " + // U+236E0
+		"[?] Dan [?] [?] Han Que Sha Zhan Ze Chuan Qi Die [?] Zha Tou [?] " + // U+236F0
+		"[?] Ci Sa [?] Luo [?] [?] Ji [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23700
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23710
+		"[?] [?] Luo Qin [?] [?] [?] Qiong Juan [?] [?] [?] Ai Jian [?] [?] " + // U+23720
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Ti Wen [?] [?] Qiao [?] [?] " + // U+23730
+		"[?] Pai Hun [?] [?] Ai [?] Shuo Lian Dui [?] Ta Jin Bi Yan Gao " + // U+23740
+		"Piao Yu She [?] [?] Jian [?] Hu [?] [?] Lie [?] Bian Su Jiao [?] " + // U+23750
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23760
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zhui [?] [?] [?] [?] Han [?] [?] " + // U+23770
+		"[?] [?] [?] [?] [?] [?] [?] Dun [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23780
+		"Xie Meng Fu Lu Tan [?] [?] Liu Xian Sang [?] [?] Cou Zhuang [?] Chen " + // U+23790
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+237A0
+		"Lian [?] [?] [?] Li [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+237B0
+		"Peng Tuo [?] [?] Tuo [?] Liao Xiao Chui Huai Niao Qian Li [?] [?] Pao " + // U+237C0
+		"Tiao Liu Wu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+237D0
+		"[?] [?] [?] [?] Ying [?] Zha [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+237E0
+		"Yu [?] Xian Xuan Shuan Xi [?] [?] Mei Sen Lian [?] Jiu Lao [?] [?] " + // U+237F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xiao Zou " + // U+23800
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Liu [?] Zhao [?] Zhe [?] " + // U+23810
+		"Lei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Duan [?] [?] " + // U+23820
+		"[?] [?] [?] [?] [?] [?] [?] Jian Shuan Zuo Qie [?] Lao [?] [?] [?] " + // U+23830
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Yu Yi Ni [?] [?] Cen [?] " + // U+23840
+		"[?] [?] [?] [?] [?] Yan [?] Ruan [?] [?] [?] [?] [?] [?] Yan Die " + // U+23850
+		"Mian [?] [?] [?] [?] [?] [?] Lei [?] Wan [?] [?] [?] [?] [?] [?] " + // U+23860
+		"Na [?] [?] [?] [?] [?] Yan [?] [?] [?] Lei [?] [?] Sha Hu [?] " + // U+23870
+		"[?] Xi Xi [?] You Han [?] Hai [?] Wa Xu Pi Tan Xi Xi Bin " + // U+23880
+		"Qin Xi Yu Xi [?] Ci Qian Xia [?] [?] Wa E You Xing Ni Han " + // U+23890
+		"Bi Sheng [?] [?] Zhan Dian Yu [?] Ou [?] Gui Wang Qian Yi [?] [?] " + // U+238A0
+		"Zu [?] Qian Ding Keng [?] Chu Yi [?] [?] Han Kuan [?] [?] [?] [?] " + // U+238B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Dian Xi Zi Ling Zi [?] Yu Hun " + // U+238C0
+		"[?] Si Kan [?] [?] [?] [?] [?] [?] [?] An [?] You Ji Hun Qia " + // U+238D0
+		"Hou Hou [?] Dian [?] [?] [?] [?] [?] Xie [?] [?] [?] She Sha [?] " + // U+238E0
+		"[?] [?] Xie Yao Da [?] Xie Chi You He Sha [?] [?] [?] [?] Tai " + // U+238F0
+		"[?] Zhu [?] Ai [?] [?] [?] Que Ze [?] La Lou Chuai [?] You [?] " + // U+23900
+		"[?] [?] [?] [?] [?] [?] Ti [?] Shi [?] [?] [?] [?] [?] [?] [?] " + // U+23910
+		"[?] Xiao Xi [?] [?] [?] [?] [?] Huo Chi Yi [?] [?] [?] [?] Shu " + // U+23920
+		"Yue Chan E Xi Xi Ying Zu Za [?] [?] Za [?] [?] [?] [?] [?] " + // U+23930
+		"[?] [?] Ta Wan [?] [?] [?] Xin [?] [?] Wang Fu [?] [?] [?] [?] " + // U+23940
+		"Lu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jian [?] " + // U+23950
+		"[?] Yan [?] Bi Ken Guan [?] [?] Zi [?] [?] [?] [?] [?] Kui Zhou " + // U+23960
+		"Zhi [?] [?] Tu [?] [?] [?] Ta [?] Chu Cheng Cheng Zhu [?] Da [?] " + // U+23970
+		"[?] [?] [?] [?] [?] [?] [?] Bi [?] Jia [?] [?] Yi [?] [?] Yue " + // U+23980
+		"Gang [?] [?] [?] [?] [?] Gan [?] [?] [?] [?] [?] Qiao [?] [?] [?] " + // U+23990
+		"Chu Chu Bi [?] [?] [?] Gui [?] [?] Gu Bing Yin Zhui Gu [?] Li " + // U+239A0
+		"[?] [?] [?] [?] [?] E Dai [?] [?] [?] [?] [?] Can [?] [?] [?] " + // U+239B0
+		"[?] [?] Ti Du Yi [?] [?] [?] Die [?] Niu [?] Xue Ne Gui Kao " + // U+239C0
+		"[?] [?] Chuan [?] [?] [?] Zha You [?] Bai Shi Dian Pa Qiu [?] [?] " + // U+239D0
+		"[?] Xue [?] Mo Ke You Jiao Bo [?] [?] [?] [?] Xiu [?] [?] [?] " + // U+239E0
+		"[?] [?] Mi Luo [?] Xue [?] Duo [?] Er Shan [?] Kui Nao Mian Li " + // U+239F0
+		"Luan [?] Die [?] Qia Lei [?] Mao [?] Heng Che Zhi [?] Gu Cuo [?] " + // U+23A00
+		"[?] [?] [?] Wu Tao [?] [?] Xi Yao Wei [?] Zu Ma Yu Peng Yi " + // U+23A10
+		"Qin Yue Jue Jiang Xu Beng [?] [?] [?] [?] Luo Zhui [?] [?] [?] [?] " + // U+23A20
+		"[?] [?] Du Xiang [?] [?] Hui [?] [?] [?] Gu Kao Ti [?] Xing Hun " + // U+23A30
+		"Bian [?] [?] [?] Ke Kao [?] [?] Cuo [?] [?] [?] [?] [?] [?] Lu " + // U+23A40
+		"[?] Zui Zao Jiao Guan [?] [?] [?] [?] Yan Er [?] Qing [?] [?] Deng " + // U+23A50
+		"Si Sui Liao [?] [?] [?] [?] Shan [?] Bi Wei Ye [?] Zhai [?] Ye " + // U+23A60
+		"Diao Ai [?] [?] Jiang [?] [?] Su [?] Huai Yu [?] [?] Rang [?] [?] " + // U+23A70
+		"Dian Zuan Ban [?] Qin [?] [?] Jia [?] Pi [?] [?] Tou [?] [?] [?] " + // U+23A80
+		"Chou [?] [?] [?] [?] Gui [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23A90
+		"Ji [?] [?] [?] [?] [?] [?] [?] Xue [?] Dian [?] [?] Bian Zai Tong " + // U+23AA0
+		"[?] [?] [?] [?] [?] [?] Shan [?] Gu Que [?] [?] [?] [?] [?] [?] " + // U+23AB0
+		"Gu [?] [?] [?] [?] [?] [?] [?] Hu Kuai [?] [?] Gou [?] Su [?] " + // U+23AC0
+		"Chou [?] Keng [?] Du [?] [?] [?] [?] Yi [?] [?] Dao Qiang [?] [?] " + // U+23AD0
+		"[?] [?] [?] Long [?] Li [?] Li Qing [?] Wei [?] Mou [?] [?] [?] " + // U+23AE0
+		"[?] Qi [?] Jiang Xie [?] [?] [?] [?] Dai [?] Lou [?] [?] [?] [?] " + // U+23AF0
+		"[?] [?] Guan [?] [?] [?] Pei [?] [?] Pi [?] Juan [?] Bei Jue Juan " + // U+23B00
+		"Shi [?] [?] [?] [?] Xie [?] [?] Rui Jing Po San [?] [?] [?] [?] " + // U+23B10
+		"Ji [?] [?] [?] [?] [?] [?] [?] [?] Fen Bei Jie Sa [?] Pi [?] " + // U+23B20
+		"[?] [?] [?] [?] Di Mao Ba Ba Tiao Ling Sheng Zhen Pi Wu [?] Ze " + // U+23B30
+		"Bao [?] [?] [?] [?] [?] [?] Lu [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23B40
+		"[?] [?] [?] [?] [?] [?] Hao Dou Fu Ni [?] [?] [?] Ge [?] [?] " + // U+23B50
+		"Ru Xian [?] [?] Bi [?] [?] [?] [?] [?] [?] [?] [?] [?] Mao [?] " + // U+23B60
+		"[?] [?] Rong Qiu [?] [?] [?] Bo [?] Hao Nao Yan [?] [?] [?] [?] " + // U+23B70
+		"[?] [?] [?] Pao Sui [?] Tuo [?] Qu Li De [?] Jie Jie Gun Jian " + // U+23B80
+		"Bi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23B90
+		"San Bang Chun [?] [?] [?] Nai Bang [?] [?] Rong Jia Sou [?] [?] [?] " + // U+23BA0
+		"De [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xian Zhan " + // U+23BB0
+		"Mao [?] [?] Zi [?] Ji Qi [?] [?] [?] [?] Ru Suo Rong Wu Rong " + // U+23BC0
+		"Rong [?] [?] [?] [?] [?] [?] [?] [?] [?] Ta [?] Sou [?] [?] [?] " + // U+23BD0
+		"[?] [?] [?] Men Li [?] [?] Cui Zong Men Xi [?] Mang Nie [?] Sui " + // U+23BE0
+		"[?] Pei [?] [?] Bi Di San [?] Qu Qiao [?] Fen Su [?] [?] [?] " + // U+23BF0
+		"[?] [?] [?] Xu [?] [?] [?] Rong Ji [?] [?] Qu Lie [?] [?] [?] " + // U+23C00
+		"[?] [?] [?] [?] [?] Sao [?] [?] Kun [?] Cui Ye Bing [?] Jie [?] " + // U+23C10
+		"Qu Qu [?] [?] [?] Meng Ran [?] Bin Chao [?] [?] Du [?] [?] [?] " + // U+23C20
+		"[?] [?] [?] [?] [?] [?] Rang Xian [?] [?] Tao Qu Nie [?] [?] Shu " + // U+23C30
+		"Lu [?] Kun [?] [?] [?] [?] [?] Min Min [?] [?] [?] Dan [?] [?] " + // U+23C40
+		"Yin [?] [?] Xiao [?] [?] [?] Ji [?] [?] [?] [?] Yin Dong [?] [?] " + // U+23C50
+		"[?] [?] [?] [?] [?] [?] Fen Zhong [?] [?] [?] Gu [?] [?] [?] [?] " + // U+23C60
+		"[?] Cha [?] Liu [?] [?] Bu [?] [?] [?] Pa Si Dao Zhen [?] [?] " + // U+23C70
+		"Shan [?] Chuai [?] Jiu [?] [?] [?] [?] [?] Ke Chi [?] [?] [?] [?] " + // U+23C80
+		"[?] Hu Li Sha [?] [?] Pai Wei Wu [?] [?] [?] Ying [?] [?] [?] " + // U+23C90
+		"[?] Sha Di [?] [?] Dan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23CA0
+		"[?] Tu He Po [?] Zhi Niu Ni [?] [?] [?] [?] [?] Rong Guai [?] " + // U+23CB0
+		"Zhi [?] [?] Ji [?] [?] Ping [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23CC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Fan [?] [?] Jie " + // U+23CD0
+		"Hai [?] [?] [?] Zhan [?] Xi [?] [?] Zi [?] [?] Xi Piao [?] [?] " + // U+23CE0
+		"Ben [?] Jian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23CF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23D00
+		"[?] [?] [?] Jian [?] [?] Za [?] [?] [?] [?] [?] [?] [?] Ben Mao " + // U+23D10
+		"[?] [?] Zao Zhuang [?] Kuang [?] [?] Bi [?] Pai [?] [?] [?] [?] [?] " + // U+23D20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mao Tan [?] [?] " + // U+23D30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23D40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Tun Luo " + // U+23D50
+		"[?] [?] Tan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23D60
+		"[?] An [?] [?] [?] [?] [?] Han Zhu [?] Duo Duo Gan [?] [?] [?] " + // U+23D70
+		"[?] [?] [?] [?] [?] [?] Qiong [?] Wang [?] Mo Zhe Wen Zhuang [?] Jie " + // U+23D80
+		"Pao [?] [?] [?] [?] [?] [?] [?] Su [?] [?] [?] [?] Ju [?] [?] " + // U+23D90
+		"Qi Can [?] Tuan Sha [?] Tuo [?] [?] Hua [?] Yi [?] [?] [?] [?] " + // U+23DA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23DB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23DC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23DD0
+		"Min Zhong [?] [?] [?] Shuo [?] [?] [?] Yi Wang Ao [?] [?] [?] [?] " + // U+23DE0
+		"[?] [?] [?] [?] [?] [?] Su [?] [?] [?] [?] [?] [?] [?] Gui Tuo " + // U+23DF0
+		"Hui [?] [?] Xu Zan [?] Zi Bian [?] Da Yin Quan [?] [?] Huai Na " + // U+23E00
+		"Za [?] Ti [?] [?] [?] [?] [?] Yi Tan She Shuo [?] Xing [?] [?] " + // U+23E10
+		"You [?] [?] Fen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23E20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23E30
+		"[?] [?] [?] [?] [?] [?] [?] Ke [?] [?] [?] Fu [?] [?] [?] [?] " + // U+23E40
+		"[?] [?] Min [?] [?] [?] [?] [?] [?] [?] Pi [?] Ji Qiao Zhong Gan " + // U+23E50
+		"Yuan Chi [?] [?] [?] Qian [?] Zuo [?] Xie Mao [?] Hu [?] Pi Xun " + // U+23E60
+		"[?] Xia Ti [?] [?] Na Chua [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23E70
+		"Wu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23E80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23E90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Huang Xue Tao [?] " + // U+23EA0
+		"Qiao [?] [?] Jiao [?] [?] [?] [?] [?] [?] [?] [?] Dang Bai [?] [?] " + // U+23EB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Dang Kou [?] " + // U+23EC0
+		"Ju Sha Jing [?] [?] Mo Nou [?] Shuo [?] Shu Zhuang Fu [?] [?] Zang " + // U+23ED0
+		"Xie Lang Tong [?] [?] [?] [?] [?] [?] Zhe [?] [?] Can [?] Yue [?] " + // U+23EE0
+		"[?] Zhou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23EF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23F00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Tan [?] [?] [?] Yan Lu " + // U+23F10
+		"Yan [?] [?] [?] [?] [?] Ze Shuai [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23F20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23F30
+		"[?] [?] [?] [?] [?] Guo Zhu [?] Ru Ru [?] [?] Kan Ji Gao [?] " + // U+23F40
+		"[?] [?] Xie [?] [?] Ou Jian [?] [?] [?] Zhi Zha [?] Hong [?] Kuan " + // U+23F50
+		"[?] Bo [?] [?] Se An Jian [?] Teng [?] [?] Song [?] Meng Yin Tan " + // U+23F60
+		"Guo [?] [?] Ruan Wei [?] [?] Si [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23F70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lian [?] [?] " + // U+23F80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23F90
+		"[?] [?] [?] [?] Qi [?] Zhang [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23FA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23FB0
+		"[?] [?] [?] [?] [?] Dong Fu Shen Su Yi Lian [?] He [?] Zhen [?] " + // U+23FC0
+		"Ze [?] Cui Cui [?] [?] [?] [?] [?] [?] [?] [?] [?] Feng Li Kou " + // U+23FD0
+		"[?] [?] [?] Xiao You [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23FE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+23FF0
+		"[?] [?] [?] Hao [?] [?] [?] [?] [?] Han Ken [?] [?] [?] [?] [?] " + // U+24000
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yu [?] [?] " + // U+24010
+		"[?] [?] [?] Huan Suo [?] La [?] Dou Jian Po Bian [?] [?] [?] [?] " + // U+24020
+		"Xue [?] Bian [?] [?] [?] [?] Wei [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24030
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24040
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24050
+		"[?] Dan Jie Bai [?] Nian Xian Se [?] [?] Hua Chua [?] [?] Ou Lie " + // U+24060
+		"Di Cai [?] Zha [?] Lu [?] [?] [?] Huo [?] [?] Li Ying [?] Wei " + // U+24070
+		"Bi Guo [?] Pi [?] [?] Biao [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24080
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24090
+		"Yan [?] [?] [?] Zhuan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+240A0
+		"[?] [?] Hong [?] [?] [?] Lin E [?] Yin Lan [?] Yao [?] [?] Xuan " + // U+240B0
+		"Li [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+240C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+240D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Lan Ling Xi Hong [?] Jiao Zhuo [?] " + // U+240E0
+		"[?] [?] Zhi [?] [?] Bo Teng An [?] [?] Xun Lei Zang Hui [?] [?] " + // U+240F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xi Hong " + // U+24100
+		"[?] Fan Jian Cong Za [?] Ca [?] You [?] [?] Dui Pan [?] [?] [?] " + // U+24110
+		"[?] [?] [?] [?] [?] Ta [?] Pan [?] [?] [?] Fan Xi [?] [?] [?] " + // U+24120
+		"[?] [?] [?] [?] [?] [?] Yao Luo [?] [?] Bian [?] Jin Li [?] [?] " + // U+24130
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yan Dou [?] [?] Man [?] " + // U+24140
+		"Gong Rang Can [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24150
+		"[?] [?] [?] Men [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24160
+		"[?] Gu Shuan [?] [?] [?] [?] [?] Yan Bi [?] [?] [?] [?] [?] [?] " + // U+24170
+		"Biao Cheng Kui [?] Huo [?] [?] [?] [?] [?] [?] [?] [?] Chi [?] Wo " + // U+24180
+		"[?] Cou Zhi [?] [?] [?] [?] [?] [?] Shui [?] [?] Gua Pu Xu Si " + // U+24190
+		"[?] Wu Lun [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Fu [?] " + // U+241A0
+		"Shi [?] [?] Hui Huang Pa [?] [?] [?] [?] [?] [?] Zhu [?] Yi [?] " + // U+241B0
+		"[?] [?] [?] Li Shan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+241C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Min [?] Ge [?] " + // U+241D0
+		"Hu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Long [?] En " + // U+241E0
+		"Fa [?] [?] Xu Yi [?] [?] [?] [?] [?] [?] [?] [?] [?] Ying [?] " + // U+241F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24200
+		"[?] [?] [?] [?] Chi [?] [?] [?] [?] Yi [?] [?] [?] [?] [?] [?] " + // U+24210
+		"[?] [?] [?] [?] [?] Di Hui He [?] Zha [?] [?] [?] [?] [?] [?] " + // U+24220
+		"[?] [?] [?] [?] [?] [?] Yun Xian [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24230
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xian Lao Shao Shi " + // U+24240
+		"Zhuo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24250
+		"[?] [?] [?] [?] Bie Jiu Wo Jiao Fu [?] Xiang Kai [?] [?] [?] [?] " + // U+24260
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24270
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24280
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24290
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+242A0
+		"[?] [?] Nao [?] Huo Ji La [?] [?] [?] [?] Fou Shan Liao Mie Che " + // U+242B0
+		"[?] [?] Mo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lou " + // U+242C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+242D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Duo [?] [?] Nao [?] Ji [?] [?] " + // U+242E0
+		"Zhu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+242F0
+		"[?] [?] Su Duo [?] [?] [?] Jiong [?] [?] Zai Hui Ying Hu Lin Weng " + // U+24300
+		"Han [?] [?] [?] Nan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24310
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24320
+		"[?] [?] [?] [?] [?] [?] [?] Xi [?] Gan [?] [?] [?] [?] He Ji " + // U+24330
+		"Xiang Sha [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24340
+		"Tui [?] Zhao Shu [?] You Jian [?] [?] [?] [?] [?] Zao [?] [?] [?] " + // U+24350
+		"Re [?] [?] [?] Zhang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24360
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ruo [?] [?] " + // U+24370
+		"[?] [?] [?] [?] Yan [?] [?] [?] [?] [?] [?] Cui [?] [?] [?] [?] " + // U+24380
+		"[?] [?] [?] [?] [?] [?] [?] Ji Shang [?] [?] [?] [?] [?] [?] [?] " + // U+24390
+		"[?] [?] [?] E Lao Tan [?] Zhu [?] [?] [?] [?] [?] Lin [?] Zeng " + // U+243A0
+		"[?] Juan Hu [?] [?] [?] [?] [?] [?] [?] [?] Xiao [?] [?] [?] [?] " + // U+243B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+243C0
+		"[?] [?] [?] [?] [?] [?] [?] Shen Huo [?] [?] [?] Kui [?] [?] [?] " + // U+243D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+243E0
+		"[?] Chu Zhou [?] [?] [?] Ao [?] Zhuo [?] [?] [?] [?] Xing [?] Mie " + // U+243F0
+		"Hu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24400
+		"[?] [?] [?] [?] Tan [?] [?] [?] [?] Bi [?] [?] [?] [?] [?] [?] " + // U+24410
+		"[?] [?] [?] Ding [?] [?] [?] [?] [?] Kai [?] Biao [?] [?] [?] [?] " + // U+24420
+		"Huo Lie Cuan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24430
+		"[?] [?] [?] Xian Re [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24440
+		"[?] [?] [?] Yue [?] Xun [?] Liao [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24450
+		"[?] [?] [?] Sha [?] [?] Shi [?] [?] [?] Xie [?] [?] [?] [?] [?] " + // U+24460
+		"[?] [?] [?] Xiao [?] [?] [?] Ye Lan Yi [?] [?] [?] [?] [?] Lian " + // U+24470
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24480
+		"[?] [?] [?] [?] Bo Cao [?] [?] [?] [?] [?] [?] [?] Yao [?] [?] " + // U+24490
+		"[?] [?] [?] [?] [?] [?] Lian [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+244A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ta [?] [?] [?] [?] " + // U+244B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+244C0
+		"[?] Ji [?] [?] Xi Zhi [?] [?] [?] [?] Xi [?] [?] Yue [?] [?] " + // U+244D0
+		"[?] [?] [?] [?] Xian [?] Zhuo [?] [?] [?] [?] [?] [?] [?] [?] Zhang " + // U+244E0
+		"[?] [?] [?] [?] [?] Zu [?] Na [?] [?] [?] [?] [?] [?] Dao Lie " + // U+244F0
+		"Na [?] [?] [?] [?] [?] [?] [?] [?] Pao [?] Ju [?] [?] [?] [?] " + // U+24500
+		"[?] [?] [?] [?] Luan [?] Luo [?] [?] Shua Shang [?] [?] Luo [?] Fen " + // U+24510
+		"[?] [?] [?] Bao [?] [?] [?] [?] Li [?] [?] Xiong [?] [?] [?] [?] " + // U+24520
+		"[?] [?] [?] [?] [?] [?] Dang [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24530
+		"Cheng [?] [?] [?] Zhang [?] [?] Sou [?] [?] Shen [?] [?] [?] [?] [?] " + // U+24540
+		"[?] [?] Ge [?] [?] [?] [?] [?] Yu [?] Hui Che [?] Jiao Zhu Shu " + // U+24550
+		"[?] [?] Xiao [?] [?] [?] Ning [?] [?] [?] [?] [?] [?] Jiang [?] Jiang " + // U+24560
+		"[?] [?] [?] [?] [?] [?] [?] Diao [?] [?] [?] [?] [?] Qiang Qiu [?] " + // U+24570
+		"Feng [?] [?] [?] [?] [?] Zhan Ke [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24580
+		"[?] [?] Die Ze [?] [?] Guang Se Fen [?] [?] Jiang [?] Yan Zhi [?] " + // U+24590
+		"[?] [?] Li [?] [?] [?] Ling [?] [?] [?] Yi [?] Qu Pan Gou [?] " + // U+245A0
+		"Jia He [?] Peng [?] Ju [?] Che [?] [?] Lie Shi Po Xiang [?] Pi " + // U+245B0
+		"Luo Cu [?] Yu [?] [?] [?] Kong Xie [?] [?] [?] [?] Wan Yan Pei " + // U+245C0
+		"[?] [?] [?] Cheng [?] [?] [?] [?] Ti Che Bi Lian Jia [?] Ting [?] " + // U+245D0
+		"[?] [?] Ti [?] [?] [?] [?] [?] Die [?] Shu Li Lu Xia [?] Cui " + // U+245E0
+		"[?] [?] [?] Bo Tui Pu [?] Lin Fen [?] Bo Chan [?] [?] Dang Tai " + // U+245F0
+		"Dao [?] [?] Li [?] Ya Ya Zhan [?] [?] Yi [?] Qi [?] [?] [?] " + // U+24600
+		"[?] [?] [?] [?] Hu [?] Ting [?] Kou [?] [?] Chun You Fen [?] Nuo " + // U+24610
+		"Tian Jin Pi Chen Pi [?] Jie Gui [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24620
+		"[?] [?] Zhuang [?] [?] Hu Chou Shu Tao Pi Rong Rong [?] Hou Peng [?] " + // U+24630
+		"[?] [?] [?] [?] [?] Bai [?] Xia [?] [?] [?] Qin Ni [?] Tao Qu " + // U+24640
+		"[?] [?] Xie [?] Zhao Hua Xin [?] Shou [?] [?] Tu [?] Liang Bi Chu " + // U+24650
+		"[?] Xing [?] Xin Fu [?] [?] [?] [?] Jie [?] [?] [?] Fu [?] Lao " + // U+24660
+		"Te She [?] [?] Chao Chui [?] [?] [?] [?] [?] [?] Ran Hou Beng [?] " + // U+24670
+		"Cai [?] [?] [?] [?] Mu [?] [?] [?] Xu Die [?] [?] Chan Yu Zhong " + // U+24680
+		"[?] [?] [?] Li Shou [?] [?] [?] [?] [?] Du [?] Mao Huang [?] Tao " + // U+24690
+		"[?] Du Ti Sheng Mei [?] [?] [?] Zhen Qin Pi Tang Cang Yao [?] Xiu " + // U+246A0
+		"Bang Gu [?] [?] [?] Bu [?] [?] [?] [?] [?] [?] Gou Bo [?] [?] " + // U+246B0
+		"[?] Wen [?] [?] Ji [?] [?] [?] [?] [?] La [?] [?] Cui Min Cu " + // U+246C0
+		"Ou Yong [?] [?] [?] [?] Mao Ke Mang Ding Huan Duo Jiang Su [?] [?] " + // U+246D0
+		"[?] [?] Ceng Ta [?] Huang Jue Xun [?] [?] Xiong [?] Mi Qun Lao [?] " + // U+246E0
+		"[?] Zhi Wei [?] [?] [?] [?] Se [?] [?] [?] Zang [?] [?] [?] [?] " + // U+246F0
+		"[?] An Wei [?] Huai [?] [?] Zhan [?] Ying Ge Hui [?] Quan [?] [?] " + // U+24700
+		"[?] [?] [?] Lie Ju Ba Lei [?] Man Ling [?] [?] Li Ji [?] [?] " + // U+24710
+		"[?] Hui Xin Shi Zhe [?] [?] Bo [?] [?] [?] Cha [?] [?] [?] Cha " + // U+24720
+		"Jing Ba Bei [?] [?] Yan [?] Hu [?] Yu [?] Bi Chuan [?] Ji [?] " + // U+24730
+		"[?] [?] Mu [?] Mao Zhong [?] Ye Dou Ye [?] [?] [?] Ri Yin [?] " + // U+24740
+		"Hao [?] Na Tie Fu Mu Zai [?] Hu [?] Chen Tuo [?] [?] Chu Fu " + // U+24750
+		"[?] [?] Ze [?] [?] [?] [?] Bao [?] [?] [?] [?] Di Cai Lu Po " + // U+24760
+		"Da Ye [?] Yi [?] [?] [?] Xiang Bi Zhu [?] Yi [?] Lu [?] Kuang " + // U+24770
+		"[?] [?] Zhi Hui [?] [?] [?] Wa Di Shu Lie Zao Zhi Nao [?] [?] " + // U+24780
+		"[?] [?] [?] [?] [?] [?] [?] Chai [?] [?] Xiao Zang [?] [?] Yu Dou " + // U+24790
+		"Cha Xie Yang [?] Xian Bao [?] [?] [?] [?] [?] [?] [?] [?] Zhai [?] " + // U+247A0
+		"Qiu [?] Hu Zai Jue [?] Han [?] [?] [?] [?] [?] [?] [?] [?] An " + // U+247B0
+		"Zao [?] [?] Sha [?] Xian Chi Yan [?] An [?] [?] [?] Zhe Jue [?] " + // U+247C0
+		"[?] Li [?] Le [?] [?] Cai [?] Lu [?] Jia [?] [?] Xia Xiao Yan " + // U+247D0
+		"Xu [?] Dun Ying Hui Ti Nou Xi [?] [?] Tu [?] [?] [?] [?] [?] " + // U+247E0
+		"[?] [?] [?] [?] [?] [?] [?] Wai Chen [?] [?] [?] Hong [?] Ti Xuan " + // U+247F0
+		"Za [?] [?] [?] [?] [?] [?] Ge [?] [?] [?] Lou Chai Pan Ji [?] " + // U+24800
+		"Ta [?] [?] Xi [?] [?] Xiao [?] Sao Jia Su Huang [?] Cuo [?] Ta " + // U+24810
+		"Shuai [?] [?] [?] [?] [?] [?] [?] [?] [?] Fu Li [?] She [?] Tang " + // U+24820
+		"[?] [?] [?] [?] [?] [?] Dian [?] [?] [?] Bi [?] Gou Cu [?] Qian " + // U+24830
+		"[?] [?] Lei Su [?] [?] Zong Hao [?] [?] [?] [?] [?] [?] [?] Chi " + // U+24840
+		"Cao [?] [?] Wo Xiao Lie Yan [?] [?] [?] [?] [?] [?] Bi [?] Huan " + // U+24850
+		"[?] Xi Chi Xu Nao Yan [?] Xie Zha [?] Sui [?] Xi Beng Ran Shuo " + // U+24860
+		"Ban Gui Kai Chen [?] [?] Xu [?] [?] [?] [?] [?] [?] [?] E Li " + // U+24870
+		"Xi Huan Su [?] Chang [?] [?] [?] [?] [?] Lu Yan [?] [?] Dang Dan " + // U+24880
+		"Yang [?] Zhai Ju [?] Duo Sao Lai Su [?] [?] [?] [?] [?] [?] Ze " + // U+24890
+		"[?] [?] [?] Bi [?] [?] Yin [?] Hao [?] Lie [?] [?] Hao Yang [?] " + // U+248A0
+		"[?] [?] [?] [?] Shuo Ai Qiong [?] [?] Lei Xie [?] Shi [?] [?] [?] " + // U+248B0
+		"[?] [?] [?] Lu [?] Que Lian [?] [?] [?] [?] [?] Xiao [?] Ying [?] " + // U+248C0
+		"[?] Xie [?] [?] [?] [?] [?] [?] Ling You [?] [?] [?] [?] Dang Lan " + // U+248D0
+		"Xiao [?] [?] [?] [?] [?] [?] [?] Yi [?] [?] [?] Wu [?] Yi Tuo " + // U+248E0
+		"Bu [?] Xin [?] [?] Si Jin [?] Ba Fa [?] Mo Ruo [?] [?] [?] " + // U+248F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Da Ji [?] [?] [?] [?] " + // U+24900
+		"Su Qiong Ba [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24910
+		"[?] [?] [?] [?] [?] [?] Tian You [?] Tuo [?] Wai You [?] Dong [?] " + // U+24920
+		"[?] Xi Kong [?] [?] [?] Qiong Dui Duo [?] Yi [?] [?] [?] [?] [?] " + // U+24930
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24940
+		"[?] [?] Xi Qin Su [?] [?] Liu [?] Wan [?] [?] [?] [?] [?] [?] " + // U+24950
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Che Zhu [?] " + // U+24960
+		"Mao [?] [?] [?] [?] [?] [?] Quan [?] [?] [?] [?] [?] Yu [?] Yi " + // U+24970
+		"Mi [?] [?] Lai Zhi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24980
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24990
+		"[?] [?] [?] [?] Ni [?] Ban [?] [?] [?] Dong [?] [?] [?] Zhi [?] " + // U+249A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+249B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+249C0
+		"[?] [?] [?] [?] [?] Yi [?] [?] Ling Yu Cong Di Zhi [?] [?] [?] " + // U+249D0
+		"Ruan [?] [?] Jian [?] [?] [?] [?] [?] Wan [?] Jin [?] Pang [?] [?] " + // U+249E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+249F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lu Qu [?] " + // U+24A00
+		"Xi Da [?] [?] [?] [?] Hu Luo [?] Le [?] [?] [?] [?] [?] [?] " + // U+24A10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24A20
+		"[?] [?] [?] [?] [?] [?] Gong [?] [?] [?] [?] Ling [?] [?] [?] [?] " + // U+24A30
+		"[?] [?] Lao [?] Zhuan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24A40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24A50
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zao Hao Xiang [?] [?] Hao Li [?] " + // U+24A60
+		"[?] Dian Ge [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Huan [?] [?] " + // U+24A70
+		"[?] [?] [?] [?] E [?] Xia [?] [?] [?] [?] Jian Qi Xia You [?] " + // U+24A80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24A90
+		"[?] Zheng [?] [?] [?] [?] [?] [?] [?] [?] Zhuan [?] [?] [?] Chan [?] " + // U+24AA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24AB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Xie [?] [?] [?] [?] [?] [?] " + // U+24AC0
+		"[?] [?] [?] [?] [?] Nao [?] [?] [?] [?] [?] [?] [?] Ji Tian [?] " + // U+24AD0
+		"[?] [?] [?] Yan [?] [?] [?] Hao Xin Ling [?] Ban Beng [?] [?] [?] " + // U+24AE0
+		"[?] Gou Ling [?] [?] Kuo Qia Jiao [?] En Yao Du [?] [?] [?] [?] " + // U+24AF0
+		"[?] Huo Du Pei [?] [?] [?] [?] [?] [?] [?] [?] Yuan [?] [?] Lou " + // U+24B00
+		"Xing [?] [?] Lian Yao Xi Yao [?] Xi [?] [?] Lu [?] Yan [?] [?] " + // U+24B10
+		"Quan [?] [?] [?] [?] Rang Wa Zu Fan Yi Du Sui [?] Pi [?] Han " + // U+24B20
+		"[?] Xu [?] Gong [?] Di [?] Na [?] [?] [?] [?] [?] [?] Duo Wa " + // U+24B30
+		"[?] [?] Nie [?] [?] [?] [?] [?] Diao Huang [?] [?] Ti Fan [?] [?] " + // U+24B40
+		"[?] Wu Ang [?] Ping [?] [?] [?] [?] Han [?] Gang Li [?] Dun Fu " + // U+24B50
+		"Na [?] Cei [?] [?] [?] [?] Jie [?] Qing [?] Ying Xiang [?] [?] [?] " + // U+24B60
+		"[?] Hu [?] [?] Su [?] [?] [?] [?] [?] [?] Ge E Xu [?] [?] " + // U+24B70
+		"[?] [?] [?] [?] [?] [?] Xi [?] [?] [?] Kang Guo Jie Chuan Lei Heng " + // U+24B80
+		"Zun [?] [?] [?] [?] Pie [?] [?] Deng Xi Lei [?] Shan [?] [?] [?] " + // U+24B90
+		"[?] [?] [?] [?] [?] [?] [?] Lu [?] Dui Jun [?] [?] Chan [?] Xie " + // U+24BA0
+		"Wa Zhe [?] Zhuan [?] [?] [?] Liu Lei [?] [?] [?] Dai Gan [?] [?] " + // U+24BB0
+		"[?] [?] [?] [?] Shi [?] [?] Yan [?] [?] [?] [?] Gan [?] [?] [?] " + // U+24BC0
+		"Yan [?] [?] [?] [?] [?] Sui [?] [?] [?] Zhong [?] Shi [?] [?] [?] " + // U+24BD0
+		"[?] Sheng [?] [?] [?] Chan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24BE0
+		"[?] [?] [?] [?] [?] [?] [?] Huang Yin [?] [?] Meng [?] [?] [?] [?] " + // U+24BF0
+		"[?] [?] Rang [?] [?] Xiang [?] [?] Bei [?] [?] [?] Chuan [?] [?] [?] " + // U+24C00
+		"[?] Pu [?] [?] [?] [?] [?] [?] [?] Ke La [?] [?] Quan [?] Hang " + // U+24C10
+		"Chi Mang [?] [?] [?] [?] Zha [?] [?] [?] Fen [?] Chao [?] [?] [?] " + // U+24C20
+		"[?] [?] [?] Jing [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24C30
+		"[?] [?] [?] Lie [?] Na Na Tong [?] [?] [?] Ran Zu Pi You [?] " + // U+24C40
+		"Shu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lie Shou Tuan [?] Gao " + // U+24C50
+		"Shao Tuo [?] Nan [?] [?] [?] Tuo Gong Diao [?] [?] [?] [?] [?] [?] " + // U+24C60
+		"[?] [?] [?] [?] Meng Bang [?] Xie Si Ting Gui [?] [?] Fu Gui [?] " + // U+24C70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Gui [?] [?] [?] [?] [?] [?] " + // U+24C80
+		"[?] Zhu [?] Lai [?] Lun Tian Ran [?] [?] Dong [?] [?] [?] [?] [?] " + // U+24C90
+		"[?] [?] [?] [?] [?] [?] [?] [?] Juan Yan [?] [?] Ruan Dan [?] [?] " + // U+24CA0
+		"Mao [?] [?] [?] [?] [?] Luan [?] Xu [?] Xi [?] [?] [?] [?] [?] " + // U+24CB0
+		"[?] [?] Ma Qi [?] Cha [?] [?] Shang Han Ping [?] [?] [?] Ji [?] " + // U+24CC0
+		"[?] [?] [?] Li [?] Yu Ban [?] Teng [?] [?] [?] [?] Chou [?] [?] " + // U+24CD0
+		"Chou [?] [?] [?] Qi Xi Bei [?] [?] [?] Ye [?] [?] Guang [?] Zhu " + // U+24CE0
+		"[?] [?] [?] Lei Lei Cha [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24CF0
+		"Guang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Die [?] [?] " + // U+24D00
+		"[?] [?] [?] Ya [?] [?] [?] [?] Nie Shu [?] Zhi [?] [?] [?] Zhi " + // U+24D10
+		"[?] [?] Zhi Pi [?] Jiu Jiu Yi You [?] Jiu [?] [?] [?] [?] Huan " + // U+24D20
+		"[?] Du [?] [?] [?] [?] [?] [?] [?] [?] [?] Tao Qie Qin Xin Chan " + // U+24D30
+		"Ji [?] Qin [?] [?] [?] [?] [?] [?] [?] Du Zhi [?] [?] Ou [?] " + // U+24D40
+		"Wu [?] Wen [?] [?] [?] [?] [?] Bi [?] [?] Bei [?] Mu Jin Tao " + // U+24D50
+		"Liao [?] [?] [?] [?] Cao Zha [?] [?] [?] [?] [?] Chi Ya Kui Yin " + // U+24D60
+		"[?] [?] [?] [?] [?] [?] [?] [?] Long Qia [?] Hang Shang Hai Cha [?] " + // U+24D70
+		"Jiao Lao [?] [?] [?] [?] [?] [?] Xi [?] Gui Bo [?] [?] [?] [?] " + // U+24D80
+		"[?] [?] [?] Zhi [?] Tun Fu [?] Hu [?] Nie Yi Zhuang [?] [?] [?] " + // U+24D90
+		"Cha [?] [?] [?] Suan [?] [?] Yun [?] [?] [?] [?] [?] [?] Du [?] " + // U+24DA0
+		"Xi Chuan Xing Jiao Shen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24DB0
+		"Wang Bei Fei Jian Quan Yi Dong Xu Na Ji [?] [?] Zhen Qi Dui Yin " + // U+24DC0
+		"[?] Jiu Pi Xin Lun Cai Ling Bie Dao De [?] [?] [?] [?] [?] La " + // U+24DD0
+		"[?] Xi Ju [?] Xiao [?] Jing [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24DE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Wai [?] Nao Xiang Que Qie Tu " + // U+24DF0
+		"Xu Hui [?] [?] [?] Min Wei [?] You Tui Dai [?] [?] [?] Ke Na " + // U+24E00
+		"[?] Fu Yu Zhi [?] Han Ai Fu [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24E10
+		"[?] Yang [?] [?] Shi [?] Chan [?] [?] [?] Chi Yun Shuai [?] Su Sang " + // U+24E20
+		"[?] E Zheng Ai Suo Bu [?] Qun Yi Yan [?] Na Wu [?] [?] [?] " + // U+24E30
+		"[?] [?] [?] [?] [?] [?] [?] Li Li [?] Xi Jue Shi [?] Ya [?] " + // U+24E40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chen Ying Bi Che [?] " + // U+24E50
+		"[?] Zha Tuo Hu Teng Ying Bi Ning Lian Xin Yu [?] [?] [?] [?] [?] " + // U+24E60
+		"[?] [?] Bei [?] Mo Dui [?] Dao Qi [?] Kai [?] [?] [?] [?] [?] " + // U+24E70
+		"Shuai [?] [?] Xiao Zhong Zhui [?] Bian [?] Wei Xi [?] Deng [?] Xie Pan " + // U+24E80
+		"Nie [?] [?] Bie She Fei Min Qi [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24E90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shan Suo [?] [?] [?] [?] " + // U+24EA0
+		"[?] [?] [?] [?] [?] [?] [?] Ji [?] [?] Dan Juan Lu [?] Ao [?] " + // U+24EB0
+		"[?] [?] Yi Shu Sui Wei Wan Chu [?] [?] Tui [?] Wo [?] [?] [?] " + // U+24EC0
+		"[?] [?] [?] [?] [?] [?] Bi [?] Yin Huo [?] [?] Kai Ning [?] [?] " + // U+24ED0
+		"[?] [?] Ai [?] Li [?] Zhai [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24EE0
+		"[?] Lu [?] [?] [?] [?] Bian Pan [?] [?] [?] [?] [?] [?] [?] Gui " + // U+24EF0
+		"Su Meng Xian Long [?] Qi [?] [?] [?] [?] [?] Chan Yi Hang [?] Lian " + // U+24F00
+		"Guan [?] Wei [?] [?] [?] [?] Jue Lei Luan Li [?] Pi [?] [?] [?] " + // U+24F10
+		"[?] [?] Huan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Gui [?] " + // U+24F20
+		"[?] [?] [?] Ju [?] [?] Deng [?] [?] [?] Fei [?] [?] [?] [?] [?] " + // U+24F30
+		"[?] Zhi [?] Mei [?] Huan [?] [?] [?] Pa Bi [?] Po [?] [?] [?] " + // U+24F40
+		"[?] [?] [?] Er [?] Huan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+24F50
+		"[?] [?] [?] Chang [?] Luo Fou [?] [?] [?] [?] [?] [?] [?] [?] Chou " + // U+24F60
+		"[?] Zu Nan Xiao [?] [?] [?] [?] [?] Bai Lu [?] Luo [?] [?] Nian " + // U+24F70
+		"Ze [?] [?] [?] Zhu Hu [?] [?] Hui Tang Chou [?] [?] [?] [?] [?] " + // U+24F80
+		"[?] Huang Dou [?] [?] [?] [?] [?] [?] [?] [?] Miao [?] Bo [?] [?] " + // U+24F90
+		"Di [?] Deng Pu [?] Song Chou [?] [?] [?] [?] Yao Meng Long [?] [?] " + // U+24FA0
+		"[?] [?] Lian [?] [?] Bie [?] [?] [?] [?] Lu [?] [?] [?] [?] Se " + // U+24FB0
+		"Zuo [?] [?] [?] Cun Ling Zheng Pi Bao [?] [?] Que [?] [?] Pi Nan " + // U+24FC0
+		"Pi Bo Bei Fa [?] Min Mo Wa Zhao Zhi Cu [?] [?] [?] [?] Xun " + // U+24FD0
+		"Ji Gui [?] Cheng [?] [?] [?] Han Xiao Que [?] Chuo [?] Fu [?] [?] " + // U+24FE0
+		"[?] [?] Fu Qin Lu Que Dian Qian [?] [?] [?] [?] Chang Ta Bei [?] " + // U+24FF0
+		"[?] Du Beng Hou [?] [?] [?] [?] Zha Zha [?] [?] [?] [?] Que Ma " + // U+25000
+		"Han [?] [?] Liu Lu [?] Zi [?] Pi Zhou [?] Zao [?] Niu [?] [?] " + // U+25010
+		"Hui [?] [?] Xue [?] La [?] [?] [?] [?] [?] Nou Yan Ran Nao [?] " + // U+25020
+		"La Guang Du [?] [?] Lu [?] [?] [?] Jian Xie Qi [?] [?] Xiang [?] " + // U+25030
+		"[?] Guo Jie Mang [?] [?] Xia Kui [?] [?] [?] [?] [?] [?] Yong [?] " + // U+25040
+		"Hai Mi Yao [?] [?] Wen [?] [?] [?] [?] [?] [?] [?] [?] [?] Li " + // U+25050
+		"Juan Wu Qiao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Diao Chu " + // U+25060
+		"[?] [?] Suo [?] [?] Chong [?] [?] Quan She [?] [?] [?] [?] [?] [?] " + // U+25070
+		"[?] Yan Meng Ju [?] [?] [?] [?] [?] [?] [?] Tu [?] [?] [?] [?] " + // U+25080
+		"[?] [?] Nong Mo [?] [?] [?] [?] [?] Fen [?] [?] [?] [?] [?] [?] " + // U+25090
+		"[?] [?] Ao Guo Hu Can Dun Hai Jiao [?] [?] [?] [?] [?] [?] [?] " + // U+250A0
+		"Gu [?] [?] [?] [?] Jin [?] [?] Yang [?] [?] [?] [?] [?] [?] [?] " + // U+250B0
+		"Cha [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Hui [?] [?] [?] " + // U+250C0
+		"[?] [?] [?] [?] Qu Ke [?] [?] [?] [?] [?] [?] [?] [?] [?] Qing " + // U+250D0
+		"Yi [?] [?] Kai Jiao [?] [?] Chou Bu Gen Jiao Zhi [?] [?] Wen [?] " + // U+250E0
+		"Bin [?] [?] [?] Xiong Fan [?] [?] Yi Chuan Yao [?] [?] Yang Du Yan " + // U+250F0
+		"[?] Meng [?] [?] [?] [?] [?] Chi Mu Jiao [?] Nu [?] Guo Xue [?] " + // U+25100
+		"[?] Fu Xue Fu Pei Mo Xi Wo Shan [?] [?] Xi Qi Mian [?] [?] " + // U+25110
+		"[?] [?] [?] [?] [?] [?] Dan [?] Chou [?] [?] [?] [?] [?] [?] [?] " + // U+25120
+		"[?] Fei Mie [?] Xue Xu Si Ju Mao Bao [?] Yi Gua Ni [?] Yi " + // U+25130
+		"[?] Zuo [?] [?] Nu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25140
+		"[?] Dian Fan Yi Shi [?] [?] Cu Zhen [?] [?] [?] [?] [?] Shi Jiao " + // U+25150
+		"Hou Er [?] [?] [?] [?] Lei Xue Geng [?] Shou [?] Juan [?] [?] [?] " + // U+25160
+		"[?] [?] [?] [?] Jie Wei [?] Shou Jing [?] Xu Chong [?] [?] [?] [?] " + // U+25170
+		"[?] [?] [?] [?] [?] Jiang Mou [?] [?] Yu [?] [?] Jue [?] [?] [?] " + // U+25180
+		"[?] Ting [?] [?] Xiao [?] Dou [?] Guo Mang Wang Xu Wang Suo Juan Yue " + // U+25190
+		"[?] Han [?] Shen [?] Xie Liu Run [?] [?] [?] [?] [?] [?] [?] Bi " + // U+251A0
+		"[?] [?] Nao [?] [?] [?] Wan Jiu Que [?] [?] [?] [?] [?] [?] [?] " + // U+251B0
+		"[?] [?] [?] [?] Ni [?] Mi Suo [?] Qiang [?] [?] Han Zhuo Mi Xu " + // U+251C0
+		"[?] Lang Jie Ding Chang Zhi Fei Jia Jun Huo Qi Ju Zhun [?] Dian Jiao " + // U+251D0
+		"Ya [?] Zhan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhi [?] Mai " + // U+251E0
+		"Hu Xie Shi Gui [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xu " + // U+251F0
+		"[?] [?] Ji [?] Chuang [?] Mao Ruan Xu Huan Sha Ju [?] [?] [?] Kuang " + // U+25200
+		"[?] Hou Guan Gua [?] Mi Die Bi Liang La Shan Lu Xi [?] [?] Sou " + // U+25210
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ou [?] Leng [?] " + // U+25220
+		"[?] [?] [?] [?] [?] [?] [?] Ku Gui [?] [?] Xi Pan Se Jue Hong " + // U+25230
+		"Guan Ju [?] Nai Hua Ge Li Gou Ti [?] Ma Teng Da [?] [?] [?] " + // U+25240
+		"Qi Yu Jiao Mie Geng Meng Wei [?] Ti Qi [?] [?] Chen Dou [?] Pan " + // U+25250
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25260
+		"Han [?] [?] [?] Mi Ma Lu Qi Keng [?] Die Qi Jiao Kang Qiao Mi " + // U+25270
+		"Shan [?] [?] [?] [?] [?] [?] Jian Li Ke Xu [?] [?] [?] [?] [?] " + // U+25280
+		"[?] Man Feng Chan Hui [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25290
+		"[?] [?] [?] [?] [?] [?] [?] Kou [?] [?] Wei Guan Ji Zun Huo Xie " + // U+252A0
+		"[?] [?] [?] [?] Sui [?] Ruan [?] Te [?] [?] [?] Zheng Kun Xiang Mian " + // U+252B0
+		"[?] Xi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Sa [?] [?] [?] " + // U+252C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] E Mie Zhu Zou Meng [?] Xi " + // U+252D0
+		"[?] Tang [?] Jia Chang Ji [?] [?] [?] [?] [?] [?] [?] [?] Zhuo [?] " + // U+252E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] He " + // U+252F0
+		"Cha Qi Mian Zhen Ku Ye Zhou [?] Jian [?] Pan [?] [?] Hui [?] Ming " + // U+25300
+		"Liu [?] [?] [?] [?] [?] [?] [?] Shui [?] Mai Li [?] [?] Shuo Yi " + // U+25310
+		"[?] [?] [?] [?] Li [?] [?] [?] Xie Te Xiu [?] [?] Xuan Li Meng " + // U+25320
+		"Wei Meng [?] [?] [?] [?] [?] [?] [?] [?] Yao Lan Ling Ying Ying Li " + // U+25330
+		"Jian Gui [?] [?] [?] Guan Xie [?] [?] She [?] Zui [?] [?] [?] [?] " + // U+25340
+		"[?] [?] [?] Kan Lei [?] [?] [?] [?] [?] Bian [?] [?] Shu Nu Xu " + // U+25350
+		"[?] [?] [?] Hao [?] [?] [?] [?] Gui [?] Zhai Lang Cuan Zhi Feng Qin " + // U+25360
+		"[?] Ze Na Niu Yi [?] [?] Cong Shi Jian Zong Yan Ying [?] [?] [?] " + // U+25370
+		"Ruan [?] Rong Xi [?] Guan Kai [?] Wu [?] Qin Cong [?] Ze Xie [?] " + // U+25380
+		"Yu Zan Chuang Li Li Xu Mi Xu Ruan [?] [?] Gui Rong Zuan [?] Mao " + // U+25390
+		"[?] Qin Cuan Cuan Cuan [?] [?] [?] [?] [?] [?] [?] [?] [?] Wu [?] " + // U+253A0
+		"Fa Ba [?] [?] [?] [?] [?] [?] Qia Zhi Tiao [?] [?] [?] [?] [?] " + // U+253B0
+		"[?] [?] [?] [?] Zhi Zhi [?] Huan Chou [?] Zhi [?] [?] [?] Ying [?] " + // U+253C0
+		"[?] [?] Wu Bei [?] Hong Shen [?] Jue Kui [?] [?] Yi Ya [?] [?] " + // U+253D0
+		"Bi [?] [?] [?] Kua Qian [?] [?] Zhao [?] Kai Shang [?] [?] An Zhe " + // U+253E0
+		"Zhi [?] [?] [?] [?] [?] [?] Zhi [?] Jiao [?] [?] [?] [?] [?] [?] " + // U+253F0
+		"Si Pu Ou [?] [?] [?] [?] [?] [?] [?] Zhuo [?] [?] [?] [?] [?] " + // U+25400
+		"[?] Ying [?] Huan [?] Ya [?] [?] Shi Pa Pu [?] [?] [?] Mang Chai " + // U+25410
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Yun [?] [?] Gu [?] [?] [?] " + // U+25420
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Dan [?] Nao [?] Zhe [?] Hu " + // U+25430
+		"[?] [?] [?] [?] [?] Keng [?] Die Ting [?] [?] Guai [?] [?] Qiong Shi " + // U+25440
+		"Jia Ao Na Pin Jia [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25450
+		"[?] Zhe Bu Wo [?] Cha [?] [?] [?] [?] Nao Kan [?] [?] [?] Du " + // U+25460
+		"Guai Qiong [?] Rong Yi Dui Lei [?] Zhou Kua E Xian Dian Nuo E Yong " + // U+25470
+		"Wu Keng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25480
+		"[?] [?] [?] Zhi [?] [?] [?] Zhi Xun [?] [?] Zheng [?] [?] Yang [?] " + // U+25490
+		"Huo Ji Nao [?] [?] [?] [?] Ya Lu [?] [?] Fu San Chu Wei [?] " + // U+254A0
+		"Fu Keng Si Kang [?] Yi Hua [?] [?] [?] [?] [?] [?] [?] Yu [?] " + // U+254B0
+		"[?] [?] [?] Li [?] [?] Lin Du E [?] [?] [?] Qiang Du [?] [?] " + // U+254C0
+		"Jie Chuo Xian [?] [?] [?] Gao [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+254D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Dao [?] [?] [?] " + // U+254E0
+		"Hong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zong [?] [?] Qi Tuo " + // U+254F0
+		"Hong Pi Geng [?] Nie [?] [?] Kong [?] [?] Zhi [?] [?] [?] [?] [?] " + // U+25500
+		"[?] Xiao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25510
+		"[?] She Yu Jiang [?] [?] [?] [?] [?] Qi Chen Sang [?] Suo Qian Hui " + // U+25520
+		"[?] Shan E [?] [?] [?] [?] [?] [?] [?] [?] Qiu [?] Ke [?] [?] " + // U+25530
+		"Weng Zi Ji [?] [?] [?] [?] Da [?] Cuo [?] [?] [?] Lou Kang Kuo " + // U+25540
+		"Di Qie [?] Mo [?] [?] Guo Hong Chao Hei [?] [?] [?] [?] [?] [?] " + // U+25550
+		"[?] [?] Cao Zhe Ke [?] Gun [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25560
+		"Xu Peng Jue [?] [?] Gan Si [?] Sui Que [?] Wu Yan Peng Xiao Pan " + // U+25570
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] La [?] [?] " + // U+25580
+		"[?] [?] [?] [?] [?] [?] [?] Beng Zhen Ji [?] [?] Jin Lian Ken [?] " + // U+25590
+		"Zhou [?] [?] [?] [?] [?] [?] [?] Zao [?] Le Qi Bing [?] [?] [?] " + // U+255A0
+		"[?] [?] [?] [?] [?] Yin Pin [?] [?] [?] [?] Sou Lu [?] Di Du " + // U+255B0
+		"Liao Zhuo [?] [?] [?] [?] [?] [?] [?] [?] Chang [?] [?] [?] [?] [?] " + // U+255C0
+		"[?] [?] Chen Ta [?] [?] [?] [?] [?] Que Dao [?] [?] Rang [?] Po " + // U+255D0
+		"[?] [?] [?] [?] [?] [?] Zhong Xie [?] [?] Jiang Qu Lei Ca Que [?] " + // U+255E0
+		"[?] [?] [?] [?] [?] Xiang Lei [?] [?] [?] Lan [?] [?] Lan [?] La " + // U+255F0
+		"[?] La [?] [?] Yu [?] [?] [?] [?] [?] Jiao Qin Ji [?] [?] Gan " + // U+25600
+		"[?] [?] Yi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25610
+		"Yi Zhi [?] [?] Biao Sheng Jiu [?] [?] [?] [?] He Fu [?] Ju [?] " + // U+25620
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25630
+		"Zuo Yi [?] [?] [?] [?] Xian Yi [?] Si [?] Chui [?] [?] Mo [?] " + // U+25640
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25650
+		"[?] Zhan [?] Xun [?] [?] Ru [?] Huo [?] [?] [?] Shao [?] [?] [?] " + // U+25660
+		"Shou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] You Yu " + // U+25670
+		"[?] [?] Jun [?] [?] [?] [?] [?] [?] Zi Lu [?] [?] [?] [?] [?] " + // U+25680
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chi Kun [?] [?] [?] [?] " + // U+25690
+		"Zhun [?] [?] [?] [?] [?] Hou [?] [?] Xu [?] [?] [?] [?] [?] [?] " + // U+256A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zong Ying " + // U+256B0
+		"[?] [?] Zhu [?] [?] Liu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+256C0
+		"[?] Nu [?] [?] [?] [?] [?] [?] Bi [?] Chi [?] Zu Feng Lu Pu " + // U+256D0
+		"[?] [?] [?] [?] [?] Zhuan [?] Zhe Shi Yu Lu Liang [?] [?] [?] Jue " + // U+256E0
+		"Liao Beng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+256F0
+		"[?] [?] [?] Yi Guan [?] [?] [?] [?] [?] [?] [?] Ao [?] [?] Gui " + // U+25700
+		"Min [?] Yan Lan [?] [?] Bo [?] [?] Zan You [?] [?] [?] [?] [?] " + // U+25710
+		"[?] [?] [?] [?] [?] Yi Ni [?] [?] [?] [?] [?] Ni Guo Jun [?] " + // U+25720
+		"Shi [?] Xian [?] Qian Que Kui [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25730
+		"She [?] Huo [?] Wan [?] [?] [?] [?] [?] Fei Fei Ji Yu [?] [?] " + // U+25740
+		"[?] Zhi Gua [?] Jie Mang He [?] You [?] [?] [?] [?] [?] [?] Du " + // U+25750
+		"Si [?] Li [?] [?] Jie Niu Ba Yu [?] [?] [?] [?] [?] Zhi [?] " + // U+25760
+		"[?] [?] [?] [?] [?] [?] [?] [?] He Ke [?] [?] [?] [?] Du Jia " + // U+25770
+		"[?] Chen [?] Chui He Zhai [?] [?] [?] [?] Mei [?] [?] He Zi Zhu " + // U+25780
+		"[?] [?] Tuo [?] [?] [?] [?] [?] Zun [?] Ru Duo Jiang [?] [?] [?] " + // U+25790
+		"[?] [?] [?] [?] [?] [?] Jia Heng [?] Beng Mo [?] [?] [?] [?] Zu " + // U+257A0
+		"[?] [?] Bie [?] Ku Jia [?] [?] [?] [?] Zhuo [?] Xiu [?] [?] [?] " + // U+257B0
+		"[?] [?] Lai He [?] Qiao [?] [?] [?] [?] [?] [?] [?] Fei Sheng [?] " + // U+257C0
+		"[?] [?] Zhui Kuan Ze Xian [?] Bi Yi [?] Chang [?] [?] [?] [?] [?] " + // U+257D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mao [?] [?] [?] [?] [?] " + // U+257E0
+		"[?] [?] [?] [?] [?] [?] Wan [?] [?] [?] [?] [?] [?] Wu Ku Wo " + // U+257F0
+		"Xing Ke [?] Jiu Duan Huan [?] [?] Zhi Ce Rou Ji [?] Ye [?] [?] " + // U+25800
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jing Yang [?] [?] [?] " + // U+25810
+		"[?] Zong [?] [?] [?] [?] [?] [?] [?] Can [?] [?] [?] [?] [?] [?] " + // U+25820
+		"[?] Si Li Gu Chang [?] Fei Liu [?] Jie Yun [?] [?] Zhi [?] [?] " + // U+25830
+		"Chou Bie [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25840
+		"[?] [?] Ji [?] [?] [?] [?] [?] [?] [?] [?] [?] Luo Jian [?] Chuang " + // U+25850
+		"Shuang [?] Lu Jun Jiao [?] Ti Zha [?] [?] Yi [?] Cong Nei Jia [?] " + // U+25860
+		"[?] [?] [?] [?] Ji [?] [?] [?] [?] [?] [?] [?] [?] Ai [?] [?] " + // U+25870
+		"[?] [?] [?] [?] [?] [?] [?] Jian [?] [?] Ben [?] Fan Sui Zun Dian " + // U+25880
+		"Gao Gao Lao [?] Zhuo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25890
+		"[?] [?] Tui [?] [?] [?] Bi Ju [?] [?] [?] [?] [?] [?] Hua [?] " + // U+258A0
+		"[?] [?] Cheng [?] [?] [?] Kuai Dang Ge Xie [?] Jie [?] Can [?] [?] " + // U+258B0
+		"[?] [?] [?] [?] [?] [?] Zu [?] Pu [?] [?] Shu Bu [?] [?] [?] " + // U+258C0
+		"[?] [?] [?] [?] [?] [?] [?] Ning Yan Zhou [?] Meng [?] Bian [?] Xiang " + // U+258D0
+		"[?] [?] [?] [?] Lu Li [?] [?] [?] Ji [?] Mie Lei [?] Zhi You " + // U+258E0
+		"Bian [?] [?] [?] [?] [?] [?] [?] Mu [?] Ran [?] [?] [?] [?] [?] " + // U+258F0
+		"[?] [?] Niao [?] [?] [?] [?] [?] [?] [?] Quan Zhe [?] [?] [?] [?] " + // U+25900
+		"Lei [?] [?] [?] [?] [?] [?] Dang Jue [?] [?] [?] Ling [?] Ling Yan " + // U+25910
+		"[?] [?] [?] Yao Zhen Qi Ai [?] Nu Mang [?] [?] [?] [?] [?] [?] " + // U+25920
+		"[?] Kan [?] Jiu Yan Mian [?] Yin Wan Yao Wa Pi Sui [?] [?] [?] " + // U+25930
+		"[?] [?] [?] [?] [?] Kong [?] [?] Hong [?] Ming Ling Yi Shen [?] Zuo " + // U+25940
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Tu [?] Yong [?] Wa " + // U+25950
+		"Gui Hong [?] [?] [?] Shi [?] Xiong [?] A [?] [?] [?] [?] [?] [?] " + // U+25960
+		"[?] Cheng [?] Keng Yi Yang Ting Dou Cha Liu [?] [?] [?] Qiu Xuan Shen " + // U+25970
+		"Kuan Tong [?] Qian [?] Chou [?] [?] [?] [?] Wen [?] Long An [?] [?] " + // U+25980
+		"[?] [?] [?] [?] Kan [?] Yao [?] Fu [?] [?] [?] Beng Lan Qia Dian " + // U+25990
+		"[?] [?] Jiao Gui [?] Xiong [?] [?] Ke [?] [?] [?] [?] [?] [?] [?] " + // U+259A0
+		"[?] [?] [?] [?] [?] [?] Xian Wong [?] [?] [?] [?] [?] [?] [?] [?] " + // U+259B0
+		"[?] [?] Gong [?] [?] [?] Ou Ke [?] [?] [?] Ku [?] [?] [?] [?] " + // U+259C0
+		"[?] Tian Gou Ma [?] Liu [?] [?] [?] Wei Wen [?] [?] [?] [?] [?] " + // U+259D0
+		"[?] Gong [?] Tu Ning [?] [?] Mi [?] [?] [?] Lang Qian Man Zhe [?] " + // U+259E0
+		"Hua Yong Jin [?] Mei [?] [?] Fu [?] [?] [?] Qu [?] [?] [?] [?] " + // U+259F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Liu Fu Dan [?] " + // U+25A00
+		"Gong [?] Cui [?] [?] Xing [?] [?] [?] [?] [?] [?] Tu Shou [?] [?] " + // U+25A10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qiong [?] [?] [?] [?] [?] " + // U+25A20
+		"[?] [?] [?] Rong [?] [?] [?] [?] [?] [?] [?] Li [?] [?] [?] Ji " + // U+25A30
+		"Tuo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Tong [?] [?] [?] " + // U+25A40
+		"[?] [?] Tan [?] Ling [?] Yi Ruan [?] Pa [?] [?] [?] Ca [?] [?] " + // U+25A50
+		"[?] Yue Que Zhu Hai [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25A60
+		"[?] Fa Hai [?] [?] [?] [?] [?] [?] [?] Lie [?] [?] [?] [?] [?] " + // U+25A70
+		"Bu Ping Lie [?] [?] [?] [?] [?] [?] [?] Kui Fu Tian Wo [?] Ju " + // U+25A80
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zhen [?] Fu [?] [?] [?] [?] [?] " + // U+25A90
+		"[?] [?] Long [?] [?] [?] Xi Tian [?] [?] [?] Ji [?] [?] [?] Yao " + // U+25AA0
+		"[?] Cu [?] [?] Pang Qie [?] [?] [?] [?] [?] Long Ji [?] [?] [?] " + // U+25AB0
+		"[?] [?] Tong Yi [?] Chang [?] [?] [?] [?] [?] Gong [?] [?] Dong [?] " + // U+25AC0
+		"[?] [?] [?] [?] [?] [?] Xiang [?] [?] Ting [?] Zhuan Yi Yi Zi Qi " + // U+25AD0
+		"[?] [?] Cha [?] [?] [?] [?] [?] [?] [?] [?] [?] Dun [?] [?] Chong " + // U+25AE0
+		"Lu Dun [?] Fang Shi Ti Ji Qiu Shui Chen [?] [?] Huang Shi [?] [?] " + // U+25AF0
+		"Yun [?] [?] [?] [?] [?] Long [?] Man Gou [?] [?] [?] Xian Mo [?] " + // U+25B00
+		"Shen [?] Po Yao Qu Ran [?] [?] [?] Ju [?] [?] Yin Bai Nie [?] " + // U+25B10
+		"Chou [?] [?] [?] [?] [?] [?] [?] [?] [?] Rong Chuan Nie Li Jiang Kao " + // U+25B20
+		"Ce Chong Zhua Zi Yang [?] [?] [?] [?] [?] [?] [?] Wen [?] [?] [?] " + // U+25B30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ji Ji [?] [?] [?] " + // U+25B40
+		"Lu Qiu Dun Bao Chan [?] Bo [?] Chi Zhe Mang [?] Ji Miao Yuan [?] " + // U+25B50
+		"Wu Zhi Ping [?] [?] Chong [?] [?] [?] [?] [?] Mi Fei Cuo Meng [?] " + // U+25B60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25B70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yin Mang Dian " + // U+25B80
+		"Diao [?] Qian [?] [?] Hang Zhi Ju Nian [?] [?] [?] Mi Gu [?] [?] " + // U+25B90
+		"[?] [?] [?] Zhua Nie Zhuo [?] Ye Cong [?] Xu [?] Xi [?] [?] Bo " + // U+25BA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Can [?] " + // U+25BB0
+		"[?] [?] [?] Yan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25BC0
+		"[?] Jin [?] [?] Ju Dang Du [?] Ye Jing Ke Luo Wei Tu You Pai " + // U+25BD0
+		"[?] Pi Ding [?] Wei Che Jian [?] Si Zhuo Sou [?] Ruan [?] Yu [?] " + // U+25BE0
+		"[?] [?] [?] E [?] [?] Ku [?] Zhu [?] [?] [?] [?] [?] Xia [?] " + // U+25BF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25C00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Fu Tao Xi Chou Yuan " + // U+25C10
+		"Lu Ce Shan Liu [?] Xi Ji Yi Tan [?] Hu [?] [?] Cuo Ge [?] " + // U+25C20
+		"Shi Sao Hong Xian [?] [?] Xia [?] [?] [?] [?] Mu Suo [?] Zhai [?] " + // U+25C30
+		"Fu Se Nu Yi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25C40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25C50
+		"[?] [?] [?] [?] [?] [?] [?] Qin Qing [?] [?] [?] [?] [?] [?] [?] " + // U+25C60
+		"[?] [?] [?] [?] [?] Hui Shuang Dan Ou Mo Qian Chi Pai Juan [?] [?] " + // U+25C70
+		"Chao Lie Bing Kou Dan Chou Tong Dan Man Hu Liao Xian [?] Cao Lu Chuan " + // U+25C80
+		"Wu Man [?] [?] [?] Zi [?] Du [?] [?] Shuang Fu Ju Zhou [?] Diao " + // U+25C90
+		"Wang Chuang Qian Tui [?] Lian Biao Li [?] [?] Li [?] [?] [?] [?] [?] " + // U+25CA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25CB0
+		"[?] [?] [?] [?] [?] [?] Bi Fu Cui Du [?] Zan Long Xun Qiong Ji " + // U+25CC0
+		"Qian [?] Jian Shao Duo Shu Bu Xu Dong [?] Ran [?] Yang Rui Lin Jian " + // U+25CD0
+		"Di Fen Dian Zui [?] Ning [?] [?] [?] [?] Suan Tian An [?] [?] Ce " + // U+25CE0
+		"Ding Shen Du Ti Jiao Zui Zhang Jian Dan Dan Song [?] [?] [?] [?] [?] " + // U+25CF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25D00
+		"Zhan Ting Zhi [?] [?] You Pai [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25D10
+		"[?] Li [?] [?] Qian [?] Sui Ju Ai Ge Ju Tun Bi Qia Bo Hui " + // U+25D20
+		"[?] Jian [?] [?] Gou Suan [?] [?] [?] [?] Ci Qiang [?] [?] [?] Yan " + // U+25D30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Dian " + // U+25D40
+		"[?] [?] Mie [?] [?] [?] [?] [?] [?] [?] [?] [?] Po Ling Jie Zhu " + // U+25D50
+		"Gu [?] [?] Duan Zhao [?] Shao Qin Mi [?] Ping Cong Chou [?] [?] Sa " + // U+25D60
+		"[?] [?] [?] [?] [?] [?] Tian [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25D70
+		"[?] [?] [?] [?] [?] Liu Lu Lu Zou [?] [?] [?] Lu Huan [?] Tiao " + // U+25D80
+		"Tui Qiang Lin Bei Pao Zhan [?] Li [?] [?] [?] Ti Hu [?] [?] [?] " + // U+25D90
+		"[?] [?] Lie [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25DA0
+		"[?] [?] [?] [?] [?] Hui Qu Xuan [?] Jing Die Sui [?] Wei [?] Yan " + // U+25DB0
+		"Yan Ban [?] Jiang Ni Li Hu Qi Zhong [?] [?] [?] [?] [?] [?] [?] " + // U+25DC0
+		"[?] Bi [?] [?] Yu Die Lin Li Zhuo Ji Ju [?] Feng [?] Yu [?] " + // U+25DD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Lie Za Qian Jie Guan [?] Zhuo [?] " + // U+25DE0
+		"[?] Fu [?] [?] [?] [?] [?] [?] [?] Se [?] [?] Cu [?] [?] [?] " + // U+25DF0
+		"[?] [?] [?] Hui [?] [?] [?] [?] Dang Long Yi [?] [?] [?] [?] [?] " + // U+25E00
+		"[?] [?] [?] [?] [?] [?] [?] Sa Yue [?] Di [?] [?] [?] [?] [?] " + // U+25E10
+		"[?] Gan Zan Shan Yu Bo [?] Ding Fan [?] Yu [?] Shen [?] [?] [?] " + // U+25E20
+		"[?] [?] Gong [?] Mie Tun [?] [?] Lie [?] [?] [?] [?] [?] [?] [?] " + // U+25E30
+		"[?] Zha Pei [?] Mi [?] Ming Fan [?] Na Si Yi Jia Zhu [?] [?] " + // U+25E40
+		"[?] [?] [?] Ban Yu [?] Po [?] [?] [?] Huan Can Jiao [?] [?] [?] " + // U+25E50
+		"Tan [?] [?] [?] [?] [?] [?] [?] [?] Zhi [?] Mi Kao [?] [?] [?] " + // U+25E60
+		"[?] Yao Dui Quan Bu Chu Qiao Liu Bo [?] Kang Fen [?] [?] [?] [?] " + // U+25E70
+		"[?] [?] [?] [?] [?] Dao [?] [?] [?] Dou Ge [?] [?] [?] [?] [?] " + // U+25E80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Ling Xi [?] Ni Zhou Zhou [?] " + // U+25E90
+		"[?] [?] [?] Chou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25EA0
+		"[?] [?] [?] [?] Nian Ji [?] Qu [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25EB0
+		"[?] [?] [?] [?] Kai [?] [?] Xian [?] He [?] Lin [?] Zi [?] [?] " + // U+25EC0
+		"[?] Ou Cu [?] [?] [?] [?] Cha [?] [?] [?] [?] [?] Zhong Bu [?] " + // U+25ED0
+		"[?] [?] [?] [?] Chou Xi Sa Xian Se Mian [?] Fan Zhi [?] Cui [?] " + // U+25EE0
+		"[?] [?] [?] [?] Xia [?] [?] [?] [?] [?] [?] [?] [?] [?] Nuo Li " + // U+25EF0
+		"Zu [?] Cui Ze [?] Li [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+25F00
+		"[?] [?] [?] [?] [?] [?] [?] [?] Qi [?] Zhuo Cui Pu [?] Fan Tan " + // U+25F10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Zi Zu Zhou Rong Lin Tan [?] " + // U+25F20
+		"[?] [?] [?] [?] [?] [?] Shi [?] [?] [?] Cui Zi Fu [?] [?] [?] " + // U+25F30
+		"[?] Xiao [?] [?] [?] [?] [?] [?] Feng [?] [?] [?] [?] [?] [?] Xian " + // U+25F40
+		"Jian [?] Fen [?] [?] [?] [?] Li Mo [?] [?] [?] [?] [?] [?] You " + // U+25F50
+		"[?] [?] [?] [?] [?] Huo [?] Qu [?] [?] [?] [?] Niang [?] [?] [?] " + // U+25F60
+		"Mi [?] [?] Qi [?] [?] He [?] Lian [?] [?] [?] [?] [?] [?] Zuo " + // U+25F70
+		"[?] [?] Ling [?] [?] Zhu [?] Niao [?] [?] Ji Reng Jie Gan [?] [?] " + // U+25F80
+		"Yi [?] [?] Zhou [?] Wu [?] [?] [?] [?] Geng Cu [?] Mie [?] [?] " + // U+25F90
+		"[?] Xun [?] Zhi Xiao [?] [?] Fu Hu [?] [?] [?] Di [?] Jue Diao " + // U+25FA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Shou [?] [?] Wang [?] [?] [?] " + // U+25FB0
+		"[?] [?] [?] Na Di Shi Ci Shu [?] Wa Che Fan [?] Gu Yuan [?] " + // U+25FC0
+		"[?] Guan [?] [?] [?] [?] [?] [?] [?] [?] Qie [?] Zhan Dai She [?] " + // U+25FD0
+		"[?] [?] [?] [?] [?] [?] Zhou Xiang Ming Zi Huang Mi [?] Xi Zhi Pai " + // U+25FE0
+		"Duo [?] [?] [?] Ci Mou [?] Chao [?] Yi Gou [?] [?] [?] [?] [?] " + // U+25FF0
+		"[?] [?] [?] [?] [?] [?] [?] Jing [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26000
+		"[?] [?] [?] Zeng Ping Ye Jie [?] Pi [?] [?] Sha Zhuang Jiong [?] [?] " + // U+26010
+		"Liu Yu [?] Ju [?] [?] [?] [?] Nuo [?] [?] [?] [?] [?] [?] [?] " + // U+26020
+		"[?] [?] [?] [?] [?] [?] [?] [?] Mao [?] [?] [?] [?] [?] [?] [?] " + // U+26030
+		"[?] [?] [?] [?] Chen [?] Zhuan Nian Kong Jie Hua [?] [?] Xin Zuo Yan " + // U+26040
+		"Jue [?] [?] [?] [?] Hu Zhou She [?] Yan [?] Xie Die [?] [?] Chen " + // U+26050
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26060
+		"[?] [?] Jian Ji [?] [?] Chuo Hong [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26070
+		"Da [?] [?] [?] Kai Xing Hui Jian Zhou Zha Fu Chi Beng Nuo [?] [?] " + // U+26080
+		"[?] Ji Qian [?] Wan Ou Bi Shuo [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26090
+		"Jing Ye [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+260A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+260B0
+		"[?] [?] [?] [?] Fei [?] [?] Li [?] [?] Li Pi [?] [?] [?] [?] " + // U+260C0
+		"[?] [?] Sui Liu He Hun Tan Shuo Zhi Bo [?] [?] [?] Xi [?] [?] " + // U+260D0
+		"[?] Po Qun [?] Mu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+260E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yong [?] [?] " + // U+260F0
+		"[?] [?] Dai [?] [?] [?] [?] [?] [?] [?] Qi Diao Nie Shuang [?] Shao " + // U+26100
+		"Kun Sui [?] Dou Die [?] [?] [?] [?] [?] [?] [?] Gong [?] [?] [?] " + // U+26110
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhuan " + // U+26120
+		"Guo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xu Qu [?] [?] " + // U+26130
+		"Xun [?] [?] Jiao Zhe [?] Dian Sang Beng [?] Suo Qian [?] [?] [?] Xu " + // U+26140
+		"[?] Xun [?] [?] Mo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26150
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26160
+		"[?] [?] [?] [?] [?] Sui La Zhu Zhou [?] Li [?] Dan Ju [?] Yun " + // U+26170
+		"Chan Luo [?] [?] Se [?] Lian [?] Zuan [?] [?] Lai Shuang Qie [?] [?] " + // U+26180
+		"[?] [?] [?] [?] [?] [?] [?] [?] Dou [?] [?] [?] [?] [?] Wu Meng " + // U+26190
+		"[?] Ji [?] [?] Chi [?] Ni [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+261A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Yao [?] [?] La [?] [?] Lu [?] " + // U+261B0
+		"Sui Fu [?] [?] Lei Wei [?] [?] [?] [?] [?] [?] [?] [?] Cong [?] " + // U+261C0
+		"[?] [?] [?] [?] Li [?] Pin [?] Jun Ju [?] La [?] [?] [?] [?] " + // U+261D0
+		"[?] [?] [?] [?] [?] [?] [?] Ji [?] [?] Mie [?] Yao Bian [?] [?] " + // U+261E0
+		"[?] Cong Si [?] [?] Si [?] [?] He [?] [?] [?] [?] [?] [?] [?] " + // U+261F0
+		"[?] [?] [?] Nang [?] Die [?] [?] Che Yun [?] Xiu Shu [?] Chan Min " + // U+26200
+		"Lian Yin Xing Wei Gu Tou Ta Fei Da Nie Cu Zuo Jie Xuan Bo Jin " + // U+26210
+		"Yin Xu [?] Yu Xiong [?] Qi Bei Xing Gong [?] [?] Zui [?] [?] [?] " + // U+26220
+		"Jie [?] Kai [?] [?] Xing Bei Shu Yu [?] Zhou Zhan [?] [?] [?] [?] " + // U+26230
+		"[?] [?] Zhong [?] [?] [?] Cha [?] Chui Liu [?] [?] [?] [?] Sui [?] " + // U+26240
+		"Zhu [?] [?] [?] [?] [?] [?] [?] [?] Bian [?] [?] [?] Xin [?] Ya " + // U+26250
+		"[?] [?] Ling [?] [?] [?] [?] Ya [?] [?] [?] [?] Ting [?] [?] [?] " + // U+26260
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Di [?] [?] [?] [?] [?] [?] " + // U+26270
+		"[?] Pi Hu Cen [?] [?] [?] [?] [?] [?] Tian Mou Juan [?] Mou [?] " + // U+26280
+		"Ju Liu [?] Ling [?] [?] [?] Liu Hu [?] [?] [?] [?] [?] [?] [?] " + // U+26290
+		"[?] [?] [?] [?] [?] [?] Fu Hu [?] [?] E Gong Gu [?] [?] [?] " + // U+262A0
+		"[?] Gua [?] [?] [?] [?] [?] [?] [?] Lue [?] Fan Lu Meng Fu Liu " + // U+262B0
+		"[?] [?] [?] [?] [?] Xie Gu [?] Xian Bo [?] Ji [?] [?] [?] [?] " + // U+262C0
+		"[?] [?] [?] Quan Lu [?] [?] [?] [?] [?] [?] [?] [?] [?] Shuo [?] " + // U+262D0
+		"[?] Mou Yu Han [?] [?] [?] [?] [?] Yue Dan [?] [?] [?] [?] Yu " + // U+262E0
+		"Jian [?] [?] Gang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Cao " + // U+262F0
+		"Shen Liu [?] [?] [?] [?] Jiao [?] [?] Su Su Zhong [?] [?] [?] [?] " + // U+26300
+		"[?] [?] Liao [?] Xuan Lu [?] Ji [?] [?] Yan [?] [?] [?] [?] Lu " + // U+26310
+		"[?] Min Ti [?] [?] [?] Huan [?] [?] Yi Tan [?] Wu [?] [?] [?] " + // U+26320
+		"Ji [?] [?] [?] [?] [?] [?] Du Kun [?] Jun [?] [?] [?] [?] Shi " + // U+26330
+		"Nan Po [?] [?] Shu Quan [?] [?] [?] [?] [?] [?] Ren [?] [?] Fen " + // U+26340
+		"[?] [?] Ta Tun [?] Yang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26350
+		"Li [?] [?] [?] [?] [?] Duo Ci [?] Gu Fen [?] [?] Rou [?] [?] " + // U+26360
+		"[?] Gao Xiang [?] Xiang Hou [?] Tao Shan Yang Zi [?] Yuan [?] [?] [?] " + // U+26370
+		"[?] [?] [?] [?] Su [?] [?] Chuan Xiang [?] Ban [?] Man [?] Fu La " + // U+26380
+		"Li [?] Jie You [?] [?] [?] [?] Yu [?] Chi [?] Chuan Yi Shan [?] " + // U+26390
+		"[?] [?] Ji Yan [?] [?] Wu Chun Mang [?] [?] [?] [?] Fu Jia Gou " + // U+263A0
+		"Gu Jia [?] [?] [?] Xian [?] Jin Zi Lou [?] [?] Gou [?] [?] [?] " + // U+263B0
+		"Ren [?] Shan [?] [?] Jue Tong You [?] [?] [?] [?] [?] [?] [?] [?] " + // U+263C0
+		"[?] [?] [?] [?] Jian Du [?] Hu [?] [?] [?] Sao Yu [?] [?] [?] " + // U+263D0
+		"[?] [?] Mai [?] Zhi Yan Gao [?] Huai [?] [?] [?] [?] [?] Quan [?] " + // U+263E0
+		"[?] Yang [?] Zui [?] [?] [?] Xiao Yi Yan Hong Yu [?] [?] [?] Chi " + // U+263F0
+		"[?] Chi [?] [?] Hang Se Pa Ta Fen Chi [?] [?] Hong Xue [?] [?] " + // U+26400
+		"[?] [?] [?] [?] [?] [?] Zhi [?] [?] [?] [?] Qu [?] [?] [?] [?] " + // U+26410
+		"Xi Fu [?] Shu Hai [?] Po [?] Ci [?] [?] [?] [?] [?] [?] [?] " + // U+26420
+		"Chai [?] [?] Hong [?] [?] [?] [?] Pao Shen Xiao [?] [?] Xuan Ci Ting " + // U+26430
+		"Po [?] [?] [?] [?] [?] [?] Ta Cha [?] [?] Zu Huo Xu Yan Chai " + // U+26440
+		"[?] Tuo [?] [?] [?] [?] [?] [?] Xian Xuan Hou Huan Ge Chong Bi Hong " + // U+26450
+		"Hong Chi [?] Cha [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zha " + // U+26460
+		"[?] Zhai Ta [?] [?] Po Ta [?] You Fu Ci Da Ta [?] Liu [?] " + // U+26470
+		"[?] Ci [?] Hong [?] Han La [?] Shi [?] [?] [?] [?] Tong Hui He " + // U+26480
+		"Pie Yu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xian Han [?] Po " + // U+26490
+		"[?] [?] [?] [?] [?] [?] La Huo [?] [?] [?] [?] [?] [?] [?] [?] " + // U+264A0
+		"Tai [?] [?] [?] Lao [?] Shu [?] [?] [?] Dao Dian [?] [?] [?] [?] " + // U+264B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Xiong [?] [?] Wang [?] Che Nai [?] " + // U+264C0
+		"Jue [?] [?] Er Er Nu Nu [?] [?] [?] [?] [?] [?] Zhuan [?] [?] " + // U+264D0
+		"[?] [?] Nuo [?] Lie Lei [?] Ba [?] [?] [?] [?] Cheng [?] [?] Gui " + // U+264E0
+		"Quan Ge [?] Gong Shao [?] [?] [?] [?] Lai Zheng Yi Gun Wei Lun [?] " + // U+264F0
+		"[?] [?] Shi Ying Sheng Tu Bi [?] Ze Zhong [?] Rong Qi Fu Ce [?] " + // U+26500
+		"[?] [?] [?] Li Man [?] Lian Biao [?] [?] [?] Chuang Yi [?] [?] [?] " + // U+26510
+		"Pai [?] [?] [?] [?] Yi Kuai [?] [?] Biao [?] Chi Qu Mo Zhe Sha " + // U+26520
+		"Sha [?] [?] [?] [?] [?] [?] Yao Gong Nai [?] [?] Xie [?] [?] Tian " + // U+26530
+		"[?] [?] [?] [?] [?] [?] Ye [?] [?] Sha [?] [?] [?] [?] [?] Sao " + // U+26540
+		"[?] [?] Dian Xu [?] [?] [?] [?] [?] Qu [?] [?] [?] [?] [?] [?] " + // U+26550
+		"Hong Sheng Ting [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26560
+		"Duo [?] [?] [?] [?] Liao [?] Hong Li [?] Xiang [?] [?] Shen [?] [?] " + // U+26570
+		"Fu [?] [?] [?] [?] [?] [?] [?] Yan Wang Qi Duo [?] Hua Qian [?] " + // U+26580
+		"Xie [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ci Sheng [?] " + // U+26590
+		"[?] [?] Er [?] Xing [?] Tui Yan [?] Lie [?] [?] Mi [?] [?] [?] " + // U+265A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zong [?] Zi [?] Hu Ying Lian Da " + // U+265B0
+		"Tian Tian [?] [?] [?] [?] [?] [?] [?] [?] [?] Rong [?] Ai [?] [?] " + // U+265C0
+		"Ai Zhe Guo Lu Zhao Mi Liao Zhe [?] [?] [?] Qu Cong [?] [?] Ting " + // U+265D0
+		"[?] Tan Zhan Hu [?] Pie [?] Da Rong [?] [?] [?] [?] [?] Nao [?] " + // U+265E0
+		"[?] [?] [?] Nang Dang Jiao [?] [?] [?] [?] [?] Ju Er [?] [?] [?] " + // U+265F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Li [?] Guo Wai [?] [?] " + // U+26600
+		"[?] [?] Nie [?] Jin [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26610
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Pi Chi [?] [?] [?] [?] [?] " + // U+26620
+		"[?] [?] Pi Yi Du Wa Xun [?] Qi Shan [?] [?] Xu [?] [?] He " + // U+26630
+		"Pan [?] Pei [?] Xiong [?] Chi Tan Zui Zuan Qi Du [?] [?] [?] [?] " + // U+26640
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Shui [?] [?] Na Xi [?] [?] " + // U+26650
+		"[?] [?] [?] [?] [?] [?] [?] Chao Yi [?] [?] Zheng [?] [?] Ju Dai " + // U+26660
+		"[?] San [?] [?] Zhu Wan Gu [?] San Ban Jia Mai [?] [?] [?] [?] " + // U+26670
+		"[?] [?] [?] [?] [?] [?] [?] [?] Tuo [?] Qi [?] [?] [?] [?] Zhuang " + // U+26680
+		"Tuo [?] [?] Ping [?] [?] [?] [?] [?] [?] [?] [?] [?] Peng Kuang Yi " + // U+26690
+		"[?] Xie Yue Hen [?] Hou Zheng Chun Shi Wa [?] Xie [?] [?] [?] [?] " + // U+266A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Geng [?] [?] [?] [?] [?] [?] [?] " + // U+266B0
+		"[?] [?] [?] [?] [?] E [?] [?] [?] [?] [?] [?] [?] [?] [?] Ku " + // U+266C0
+		"Na [?] [?] Ju Xuan Qu Che Lu He Sheng Nan [?] He Cha Yan Geng " + // U+266D0
+		"Nie [?] Guo Yan Guan [?] [?] Zhi Lao [?] [?] [?] [?] [?] [?] Du " + // U+266E0
+		"Qi Qu Jue [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+266F0
+		"[?] Feng [?] Xu Tui [?] Han Ku [?] [?] Shen Zhi [?] Pang Zheng Li " + // U+26700
+		"Wan [?] Fan Xin [?] [?] Ya [?] [?] [?] [?] Ju Shen [?] [?] [?] " + // U+26710
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mang [?] Tun " + // U+26720
+		"Zhuo Xi Yin Jing Tun [?] [?] Geng Ji [?] [?] [?] [?] [?] [?] [?] " + // U+26730
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhuan " + // U+26740
+		"[?] [?] Tie [?] Zhi [?] Ji [?] [?] [?] Ying Wei [?] Huan Ting Chan " + // U+26750
+		"[?] [?] Kui Qia Ban Cha Tuo Nan Jie [?] Yan [?] Tu [?] Wen [?] " + // U+26760
+		"Cong [?] [?] Xu Yin [?] [?] Beng [?] [?] [?] [?] Lu [?] [?] [?] " + // U+26770
+		"[?] Zai Da [?] [?] [?] Nie Ju Hou [?] [?] [?] Geng [?] [?] [?] " + // U+26780
+		"[?] [?] [?] [?] [?] Hou Kan Gong [?] Hui Xie [?] [?] Xi Han Mi " + // U+26790
+		"[?] Weng Hun Sao Xin Zhe Huo [?] Gong [?] [?] Sai Jin Wa [?] [?] " + // U+267A0
+		"[?] Dui Chi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xi [?] [?] " + // U+267B0
+		"[?] [?] Mi Zang Sang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+267C0
+		"[?] [?] [?] Tun Zhi Wen [?] [?] Yin Tun [?] Chong Ze [?] Xiao Mo " + // U+267D0
+		"Cu [?] [?] Bian Xiu [?] [?] Yi [?] [?] [?] [?] [?] [?] Huang [?] " + // U+267E0
+		"Zha Suo Hun Ju [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+267F0
+		"[?] Cu [?] [?] Ji Xun Sun Ceng [?] Yi [?] [?] [?] [?] Biao [?] " + // U+26800
+		"[?] [?] Jue Li [?] [?] Pao [?] [?] [?] [?] Za Ye [?] Bi Zhe " + // U+26810
+		"Zhe [?] Jiu Zhe [?] [?] Shu [?] [?] [?] Xi [?] [?] [?] [?] [?] " + // U+26820
+		"[?] [?] [?] [?] [?] [?] [?] Xu Nai Xian Gun Wei [?] [?] Ji Sa " + // U+26830
+		"[?] [?] Dong Nuo Du Zheng Ku [?] [?] Ming [?] [?] [?] [?] [?] [?] " + // U+26840
+		"[?] [?] [?] [?] [?] Bao Hui [?] [?] Zong [?] [?] [?] [?] [?] [?] " + // U+26850
+		"[?] [?] [?] [?] [?] [?] [?] [?] San [?] Teng Yi [?] Yu [?] [?] " + // U+26860
+		"[?] Yao Ning [?] Chou Hun [?] Dui [?] Qi Ying Bing Ning Huang [?] [?] " + // U+26870
+		"[?] [?] [?] [?] [?] [?] Ying [?] [?] [?] Bao [?] [?] [?] Guang Lei " + // U+26880
+		"Zun [?] [?] [?] [?] [?] [?] [?] [?] Chan [?] [?] [?] [?] [?] [?] " + // U+26890
+		"[?] [?] [?] Jian [?] [?] [?] Meng [?] Xiao [?] [?] [?] [?] [?] Xin " + // U+268A0
+		"[?] Li [?] [?] [?] [?] [?] [?] [?] [?] Qiao [?] [?] [?] [?] Wei " + // U+268B0
+		"Na [?] Pang [?] Lei [?] [?] Luo [?] [?] [?] Luan [?] Geng [?] Luan " + // U+268C0
+		"[?] [?] Qu [?] [?] [?] Luo [?] Nang [?] [?] Luo Yue [?] [?] [?] " + // U+268D0
+		"[?] [?] Shui [?] [?] Mi Wang Ce Jian Wang [?] [?] [?] [?] [?] Jia " + // U+268E0
+		"[?] [?] [?] [?] Huan [?] [?] [?] Lian Zi Bai Shou [?] [?] Wan [?] " + // U+268F0
+		"[?] [?] Shu [?] [?] [?] [?] Gui Xi [?] Ru Yao [?] [?] Gao [?] " + // U+26900
+		"[?] [?] [?] [?] [?] Yue [?] [?] Yong Wa Bo [?] [?] [?] [?] Xin " + // U+26910
+		"[?] [?] Pi Bo [?] [?] Hai Zhai Wo [?] Ye Bi Hai [?] [?] [?] " + // U+26920
+		"[?] [?] [?] [?] [?] [?] [?] [?] Chi [?] [?] Zhi [?] Ni [?] [?] " + // U+26930
+		"[?] Wu Ai [?] [?] [?] [?] [?] Ai Yu Chi [?] [?] Jing Zhi Zhi " + // U+26940
+		"Zhi Ju [?] [?] [?] [?] Han [?] [?] [?] Ping [?] [?] Yao [?] [?] " + // U+26950
+		"[?] [?] [?] You Ping [?] Mo [?] [?] [?] [?] [?] Zuo Po [?] Xue " + // U+26960
+		"Kuang Yi Po [?] [?] [?] [?] [?] [?] [?] [?] Zhui [?] [?] [?] [?] " + // U+26970
+		"[?] [?] [?] Ni Qiu Cou [?] [?] [?] [?] [?] [?] Yao [?] [?] [?] " + // U+26980
+		"[?] Fen [?] [?] [?] Xia [?] Jiang Cha [?] [?] Xiao Cha [?] [?] [?] " + // U+26990
+		"[?] [?] Cheng Cui [?] [?] [?] Qiong [?] Yu [?] Yu [?] [?] [?] Wen " + // U+269A0
+		"[?] Cha Yu [?] [?] [?] [?] [?] [?] Zuo Dao [?] [?] Juan Dao Ying " + // U+269B0
+		"[?] Feng [?] [?] [?] Weng [?] [?] Jin Qi [?] Qin [?] Kuo [?] Tan " + // U+269C0
+		"Xian [?] Tian [?] Kuo [?] Tian [?] Hu Zhu Zhan Ta [?] Tian Ta Ta " + // U+269D0
+		"Hua Yan Tie [?] Tie Ta [?] [?] [?] [?] [?] [?] Huai [?] Jia Qi " + // U+269E0
+		"[?] Ta [?] [?] Tan Hua [?] [?] Zhuan Hua [?] [?] Lan [?] [?] [?] " + // U+269F0
+		"[?] [?] [?] [?] [?] [?] Zun Yi Fu Wu [?] Fu [?] Ding Ta [?] " + // U+26A00
+		"[?] [?] [?] [?] [?] [?] Chao [?] [?] Ri Quan [?] Ge [?] [?] [?] " + // U+26A10
+		"[?] Fu Di Diao Yong [?] Jia [?] [?] Long [?] [?] Yong Pi [?] Huo " + // U+26A20
+		"Qiong [?] Fan Wu Tong Hang [?] [?] Tan [?] [?] [?] [?] [?] Heng [?] " + // U+26A30
+		"[?] [?] [?] [?] Tiao [?] [?] [?] Zhou [?] [?] Bai Xie Dao [?] Jin " + // U+26A40
+		"[?] [?] [?] [?] [?] Hu Bei [?] Ding [?] [?] [?] Nuo Wei Yu [?] " + // U+26A50
+		"Xing Fu Xian Qi Tu [?] [?] Ji [?] Ying [?] Deng Wei Xi [?] Pai " + // U+26A60
+		"[?] Sheng You [?] Ai Jian [?] Gou Ruo [?] [?] [?] Gong [?] [?] Sha " + // U+26A70
+		"Tang [?] [?] [?] [?] [?] [?] Lu Ao [?] Qi Xiu [?] Dai [?] [?] " + // U+26A80
+		"[?] Fa Wei [?] Dun Liao Fan Huang Jue Ta Zun Rao Can Teng [?] [?] " + // U+26A90
+		"Hua Xu [?] Zhan [?] [?] [?] Gan [?] [?] Peng Can Xie Da [?] [?] " + // U+26AA0
+		"[?] Ji [?] [?] [?] [?] Li [?] [?] Pan [?] [?] [?] Long Li Xi " + // U+26AB0
+		"Teng [?] [?] Ling [?] [?] [?] [?] Li Ran Ling [?] [?] [?] Gun [?] " + // U+26AC0
+		"[?] [?] [?] [?] Po Mo Pai [?] [?] Ba [?] [?] [?] [?] [?] [?] " + // U+26AD0
+		"[?] Qi [?] [?] Yan [?] [?] [?] [?] [?] Wa Ang [?] Ming Min Xun " + // U+26AE0
+		"Meng [?] [?] Guai [?] [?] Jiao [?] [?] [?] [?] Gai [?] [?] [?] [?] " + // U+26AF0
+		"[?] Cai Wu Zhe Ren Kou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26B00
+		"[?] [?] [?] [?] Zhao Zhong Qiu Guo Gong Pu Hu Mian [?] [?] Tian [?] " + // U+26B10
+		"[?] [?] [?] Wang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26B20
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zhu Da Xiong Na [?] [?] Juan [?] " + // U+26B30
+		"[?] Nian [?] [?] [?] [?] [?] [?] Hu Sha [?] [?] [?] [?] [?] [?] " + // U+26B40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhi [?] [?] Ta " + // U+26B50
+		"[?] Si [?] [?] [?] Yi [?] [?] [?] [?] [?] [?] [?] Qiong Zhi Lu " + // U+26B60
+		"Ru [?] Qi Yu Zhou Yang Xian Mou Chou Hui Jiu Jiu Piao [?] [?] [?] " + // U+26B70
+		"[?] Jiao [?] Guai [?] Mo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26B80
+		"Xi Pu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26B90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ji " + // U+26BA0
+		"[?] [?] [?] [?] [?] [?] Wen Bei Yi Fu Si Juan Ji [?] Ni [?] " + // U+26BB0
+		"Ben [?] [?] [?] [?] Xu [?] [?] Qin Bo [?] [?] Wang Zhe [?] Wo " + // U+26BC0
+		"Shao Zao Yang [?] [?] Song Nie [?] [?] [?] [?] Bi [?] [?] [?] [?] " + // U+26BD0
+		"[?] [?] [?] Cu Qiang [?] [?] [?] [?] [?] Xiao Zhi She [?] [?] Zhi " + // U+26BE0
+		"Peng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26BF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Diao " + // U+26C00
+		"[?] [?] [?] [?] [?] [?] Wo [?] Zhi Bi [?] Fen [?] [?] [?] [?] " + // U+26C10
+		"[?] Na [?] [?] [?] Bang [?] [?] [?] [?] Qiu Ni Bo Dun [?] Shi " + // U+26C20
+		"Xu Chang Xu Ye Mi [?] [?] [?] Xin Zhuo Fu [?] [?] Pi Xue [?] " + // U+26C30
+		"Yu Xian Yu Yu [?] Ju Ta Kong [?] [?] Zheng Meng Gang [?] [?] [?] " + // U+26C40
+		"[?] [?] Mu Xi Bi [?] Fu [?] [?] [?] [?] [?] Xiao [?] [?] [?] " + // U+26C50
+		"Jiu [?] [?] Gou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26C60
+		"Chi Jiu Jiu [?] [?] Sha [?] Fei [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26C70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26C80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26C90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Fu [?] [?] [?] Wan " + // U+26CA0
+		"Xu Bo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26CB0
+		"[?] Hao [?] Xie Pian Yu [?] Tian Pi [?] Shi Kuai Ji [?] [?] Zha " + // U+26CC0
+		"Nai Mou [?] Fu Du [?] [?] Sheng Cha [?] Chi Gui Min Tang Bai Qiang " + // U+26CD0
+		"[?] Zhuo Wei Xun [?] Miao Zai You [?] You [?] Shan He Lu Zhi [?] " + // U+26CE0
+		"[?] [?] Jing Zhen [?] [?] Meng You [?] Wo Ba [?] [?] Juan Ru Cou " + // U+26CF0
+		"Zhi [?] [?] [?] [?] [?] [?] Qiong [?] Hu Yang [?] Jun She Kou [?] " + // U+26D00
+		"[?] Qian [?] [?] Meng [?] [?] [?] [?] [?] Tiao [?] [?] [?] [?] [?] " + // U+26D10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26D20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26D30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26D40
+		"Nie [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chi " + // U+26D50
+		"[?] Xiong [?] Hun [?] [?] Di Lang [?] Zao Ce Suo Zu Sui [?] Xia " + // U+26D60
+		"[?] Xie [?] [?] Jie You [?] Gou Geng [?] [?] [?] Jun Huang Ji Pou " + // U+26D70
+		"Wu [?] Yi [?] [?] Nai [?] Rong Nan [?] Ping Shan Diao Ji Hua Dui " + // U+26D80
+		"Kong Ta [?] Hong [?] Shu [?] [?] [?] Heng Fen [?] [?] [?] [?] [?] " + // U+26D90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26DA0
+		"[?] [?] Kou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26DB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26DC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Nian [?] [?] [?] Chu [?] [?] " + // U+26DD0
+		"[?] [?] [?] [?] [?] [?] Qiang [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26DE0
+		"[?] [?] Xi Hu Song Wo [?] Hai Ru Meng [?] San [?] Wu [?] You " + // U+26DF0
+		"[?] Tan Shen [?] [?] [?] Qi [?] Guo Qia Xian [?] [?] [?] [?] Sui " + // U+26E00
+		"Lu [?] [?] Qi Diao [?] [?] Qi Jia You Xi Chao [?] [?] [?] [?] " + // U+26E10
+		"[?] Mi Lou Bi [?] [?] [?] [?] [?] [?] Pei [?] [?] [?] Zhen Shen " + // U+26E20
+		"Chan Fu [?] [?] [?] [?] Qu Si [?] [?] Zui [?] [?] [?] [?] [?] " + // U+26E30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26E40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26E50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhao [?] [?] [?] [?] " + // U+26E60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Pi [?] [?] " + // U+26E70
+		"Cou [?] [?] [?] [?] [?] Gao Du [?] Fu Guan Sao Sou Jian Pou [?] " + // U+26E80
+		"Can Beng Mou Zhao Xiao [?] Ju Shu Jian Li [?] Chuan Lao [?] He Hu " + // U+26E90
+		"Gu Zhang Jie Xiang [?] Du Han Jia Xiang Ji Shu Lang Ji Shan [?] [?] " + // U+26EA0
+		"Tao Zi Shuan [?] Ji Chu Ji Shen Lin Liao [?] San [?] An Ruan [?] " + // U+26EB0
+		"Ti Dan [?] Huan [?] Sa [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26EC0
+		"[?] [?] [?] [?] [?] Yin [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26ED0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26EE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26EF0
+		"[?] [?] [?] [?] [?] [?] Rui Wu Ju Huan Leng Lu [?] [?] Tan Zeng " + // U+26F00
+		"[?] [?] [?] Qian [?] [?] [?] Xi [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26F10
+		"[?] Ci She [?] [?] [?] [?] Sa [?] [?] Mao Qu [?] Bo Gan [?] " + // U+26F20
+		"Qie Juan Dang Chang Yang He [?] Ji [?] Bing [?] Mei [?] [?] [?] Dun " + // U+26F30
+		"Ao Jing Lu Mian Dian He [?] Jian [?] [?] Hua Gou [?] [?] Lu Fu " + // U+26F40
+		"Hui [?] Zei [?] Jin Si Qun [?] [?] [?] [?] [?] Dan [?] Wan Bian " + // U+26F50
+		"[?] [?] [?] [?] Jia [?] [?] [?] [?] [?] [?] Dan Jiu Xian Bo [?] " + // U+26F60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26F70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xia " + // U+26F80
+		"[?] Biao [?] [?] [?] Po [?] [?] Sao Bei Sha Wei [?] Cang Lu [?] " + // U+26F90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Dan [?] Gu Za Bang Gan [?] " + // U+26FA0
+		"[?] Chao Ji Lie [?] Qiong Jian Lu Duan Suan Yao Yin [?] Ta Yao Jing " + // U+26FB0
+		"Chu Fu Yuan Shao [?] Bing Dang Shi [?] [?] Lu Qie Luo Po [?] Meng " + // U+26FC0
+		"Jie [?] [?] Ji [?] [?] Lu [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26FD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26FE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+26FF0
+		"[?] [?] [?] [?] Chang Mie Meng Jian [?] [?] Cai [?] Su [?] [?] [?] " + // U+27000
+		"[?] [?] [?] [?] He Sa [?] Zi Keng Geng Si [?] [?] [?] [?] [?] " + // U+27010
+		"Ti Zhan Xie Shui Chi You Lu Meng Lie Si [?] [?] Xi Fan Fu Shen " + // U+27020
+		"Ti Chai Yue [?] Fu Jian Di [?] [?] Zhe Xie Dan [?] [?] [?] Zhi " + // U+27030
+		"[?] [?] [?] Xu [?] [?] [?] [?] Nie Fan Meng Min [?] [?] [?] [?] " + // U+27040
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27050
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27060
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lou Du " + // U+27070
+		"[?] Zhan Jian Han Dan Sen Jian Tan Jiao Po [?] Ping [?] Zhuan [?] Liao " + // U+27080
+		"Zi [?] Zhuo [?] Hu [?] [?] [?] [?] Xi [?] Meng Ju Mie Xian [?] " + // U+27090
+		"Kui Meng Jian [?] [?] [?] Nou [?] Di Sao [?] [?] [?] [?] [?] [?] " + // U+270A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+270B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chu " + // U+270C0
+		"Zhi Qian Lu [?] Zhuo [?] [?] [?] Zuo Han Sui Gou [?] Chou Ji Yi " + // U+270D0
+		"Yu [?] [?] [?] [?] [?] [?] [?] Nou Ni Ruo [?] [?] [?] Lin [?] " + // U+270E0
+		"[?] Ning [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+270F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qiao Yao Fu " + // U+27100
+		"Shuang Kui Qu Dong Shu [?] [?] [?] [?] [?] Li Ju Rui [?] [?] [?] " + // U+27110
+		"Zha [?] [?] [?] Xiao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27120
+		"[?] [?] [?] [?] [?] [?] [?] [?] Men Shi Dian Li Deng Zan [?] Luo " + // U+27130
+		"Can [?] [?] Ao [?] [?] Jian [?] Diao [?] [?] Ying [?] [?] [?] [?] " + // U+27140
+		"[?] [?] [?] [?] [?] [?] Yi Dang Nou [?] Yue [?] [?] [?] [?] [?] " + // U+27150
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Li Li " + // U+27160
+		"Hu [?] You [?] [?] [?] [?] [?] [?] [?] Nang [?] [?] [?] [?] [?] " + // U+27170
+		"[?] [?] Chen [?] [?] [?] [?] [?] [?] Feng Bie [?] [?] [?] [?] Man " + // U+27180
+		"Gan Huo [?] Cu [?] You [?] [?] You [?] [?] [?] Xu [?] [?] [?] " + // U+27190
+		"[?] Xu Hu Lu [?] Xia Yi [?] [?] [?] [?] [?] [?] [?] Hu Hu " + // U+271A0
+		"Zi [?] [?] [?] [?] [?] [?] Gong Tui Wu Ling Gu Zhong [?] [?] [?] " + // U+271B0
+		"[?] [?] [?] [?] Lu [?] [?] [?] Zu [?] [?] [?] Tong Xia He [?] " + // U+271C0
+		"[?] [?] [?] Yue [?] [?] [?] [?] [?] Nan Bo Hu Qi Shu Qiang Zhou " + // U+271D0
+		"Yao Gu [?] [?] [?] Ban Kan [?] [?] [?] [?] [?] [?] [?] He Ji " + // U+271E0
+		"Hu Yan [?] [?] [?] [?] Chun Ding Qiu Hou [?] [?] Hao [?] [?] Zu " + // U+271F0
+		"[?] Xian [?] [?] Xia Xi [?] [?] Se [?] [?] [?] Ge Xi [?] [?] " + // U+27200
+		"[?] Ge [?] [?] Lu [?] Ge Ke [?] Shou Zhu [?] Teng Ya Ni [?] " + // U+27210
+		"[?] [?] [?] [?] [?] [?] Luo Sui [?] [?] Chan [?] [?] Wu [?] Yu " + // U+27220
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Zao [?] Yi Xi Hong Quan Wang " + // U+27230
+		"Chi Xi Tian Yun [?] Yi Ji Hui Fou [?] Fu [?] [?] Ji Xuan [?] " + // U+27240
+		"Shuang Tai [?] Du [?] [?] [?] Yuan [?] [?] [?] Di [?] [?] Zhu Tai " + // U+27250
+		"[?] Rong Xue Yu Fan Bei [?] Qu [?] Bu Jia Zha [?] Nu She [?] " + // U+27260
+		"[?] [?] Li [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27270
+		"[?] [?] [?] [?] Gui Guai [?] Dai [?] [?] [?] [?] [?] [?] [?] Gai " + // U+27280
+		"[?] [?] Ci [?] Yan Song Shi [?] Ku Zhi Tong Qu E [?] Xing Ru " + // U+27290
+		"Yu [?] [?] Yi Yi Xu Fou Ge [?] [?] [?] [?] He Yin [?] Hong " + // U+272A0
+		"[?] Duo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xing Fan [?] " + // U+272B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Qi Sha [?] Du Di Li Yi " + // U+272C0
+		"Xi Geng Tong Kao Hong Kun Nie Chi Ti [?] Tong [?] [?] [?] [?] [?] " + // U+272D0
+		"Li Na [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+272E0
+		"[?] Zhan Bei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+272F0
+		"[?] Tiao [?] Za E Shou Kong Peng Fu Lu Xie Xie Xiu Lu Tian Ta " + // U+27300
+		"Ci Qu [?] Fu Zhi [?] Xie Zou Fei Min Xing [?] [?] Tong Qi [?] " + // U+27310
+		"Piao [?] Sui Er [?] [?] [?] Hu [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27320
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Song [?] Bie Ding Ban " + // U+27330
+		"Shi Xie Xiao Fei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27340
+		"[?] [?] Chuan Shuai Yao Jue Sheng [?] You Fan [?] [?] Kui Di [?] Mao " + // U+27350
+		"Jie [?] Yan [?] [?] Wei [?] [?] Sang Jie Yu Wei E Quan Jiong Feng " + // U+27360
+		"Long Die Pian [?] Lian Hu Lu [?] [?] [?] [?] [?] [?] [?] [?] Dian " + // U+27370
+		"[?] [?] [?] Cui Mou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27380
+		"[?] [?] [?] [?] [?] Wang Juan Ke Yan Jiao [?] [?] [?] [?] [?] [?] " + // U+27390
+		"[?] Gong [?] Rong Sun Shan [?] [?] Chi [?] Qi Suo [?] Ye Zao Que " + // U+273A0
+		"Zhan Ba Zu Suo Zhe Xi [?] Chu Jiao Zui Ge Wu [?] [?] Lue Ji " + // U+273B0
+		"[?] [?] Xie Xie [?] [?] Dou [?] [?] [?] [?] Qiu [?] [?] [?] [?] " + // U+273C0
+		"[?] Ping [?] Liu [?] [?] Pin Ci [?] [?] [?] [?] [?] [?] [?] [?] " + // U+273D0
+		"[?] [?] [?] [?] [?] Jie [?] Hui [?] [?] [?] Sha [?] [?] [?] [?] " + // U+273E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zhi Ai Xu Bi [?] Ye Ni Zhu " + // U+273F0
+		"[?] Su [?] Xie Yu Qu [?] [?] Zu Zhi Zhang Lue Wei Chong Mi [?] " + // U+27400
+		"Ji [?] Su Ye Xi Tuan Lian Xuan [?] Wu [?] [?] [?] [?] [?] Mao " + // U+27410
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Hong [?] [?] Lue " + // U+27420
+		"Du Cong Chan Lu Su [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27430
+		"Lue [?] [?] [?] [?] [?] Zhong Li Fei [?] Jing Kui Yi Hua Cui Zhu " + // U+27440
+		"Yu Beng Tun Shu Dai Wu Ci Ning Dang Zu Han [?] Pi Chuan [?] [?] " + // U+27450
+		"Du Pa [?] [?] Zhu [?] Xie Zhe Qie Xuan [?] Sao [?] [?] [?] [?] " + // U+27460
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27470
+		"Bi [?] Fu [?] [?] [?] [?] [?] Li [?] [?] [?] [?] [?] E [?] " + // U+27480
+		"Ye Shu [?] Se [?] Qi Guo Se [?] Fu Mao [?] Lei Zhan [?] [?] " + // U+27490
+		"[?] [?] [?] [?] [?] [?] [?] [?] Chai [?] [?] [?] [?] Wei [?] [?] " + // U+274A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lei [?] Zei " + // U+274B0
+		"Ying Ai Xie [?] Bi [?] [?] [?] [?] [?] [?] Chan [?] [?] Pi Cong " + // U+274C0
+		"Lie Qi [?] Ji Jing Dong Fei Yi Tuan [?] [?] [?] [?] [?] [?] [?] " + // U+274D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Meng Can Ya [?] [?] [?] [?] [?] " + // U+274E0
+		"[?] [?] Yang [?] Ting [?] [?] [?] Zhi [?] Xie Lu [?] Li [?] Mao " + // U+274F0
+		"[?] [?] Xia [?] [?] Sou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27500
+		"[?] [?] [?] [?] [?] [?] Su Xue [?] [?] [?] [?] [?] Li Yuan [?] " + // U+27510
+		"[?] Zhan [?] Ta Xuan Wei Ye Pang Mao Ti Pin [?] Du Qiu Yi [?] " + // U+27520
+		"[?] [?] [?] Tuo Chai [?] [?] Jin [?] [?] [?] [?] E [?] [?] [?] " + // U+27530
+		"[?] [?] [?] Chan Ying Ling [?] Xian [?] Qi [?] Yue Lue Ying Qu [?] " + // U+27540
+		"[?] [?] Fei Zi [?] [?] [?] [?] [?] Qing [?] [?] [?] Ning Wei Shuang " + // U+27550
+		"[?] Fu [?] [?] Mo Mo Tuo Chai Zang [?] [?] [?] [?] [?] Li Li " + // U+27560
+		"[?] Xia Juan [?] Nan Mi [?] [?] Huang [?] Shuang [?] Xu [?] [?] Fei " + // U+27570
+		"[?] Xie [?] [?] [?] [?] Ta Yong [?] Zhan [?] [?] [?] [?] [?] [?] " + // U+27580
+		"[?] Qiang Nang [?] Lin [?] [?] [?] Luan Xian Fu [?] Ling [?] [?] [?] " + // U+27590
+		"Sao [?] Hui [?] [?] [?] [?] [?] Ting [?] Qing [?] Huang [?] An [?] " + // U+275A0
+		"[?] [?] [?] [?] [?] Man [?] Ni [?] [?] [?] Guo Ou [?] [?] Xiang " + // U+275B0
+		"[?] Jin [?] [?] [?] [?] Zheng [?] N [?] [?] San Hu [?] Zu Hui " + // U+275C0
+		"[?] [?] Ji [?] [?] [?] Ye [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+275D0
+		"[?] [?] [?] [?] [?] [?] Xing [?] [?] La Yu Jue [?] [?] [?] [?] " + // U+275E0
+		"[?] Shu Zheng [?] Yong [?] Ge [?] Jian Xin [?] [?] Hui [?] [?] Shuai " + // U+275F0
+		"[?] [?] Chong Hang [?] [?] [?] [?] Liao [?] [?] [?] [?] Jiang [?] Gong " + // U+27600
+		"[?] Zhuo [?] [?] [?] [?] [?] Qi [?] [?] [?] [?] Qian [?] Dou Po " + // U+27610
+		"[?] [?] Hu [?] [?] Niu [?] Qi Diao Diao [?] Li [?] [?] Xiong [?] " + // U+27620
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Na [?] Zheng " + // U+27630
+		"La Zhi [?] E Bo Po Xu Yong Ci Li [?] [?] Pao [?] [?] Xiu " + // U+27640
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Pu [?] Che Qi [?] " + // U+27650
+		"[?] Yi [?] Ti Duo Long [?] Jian [?] [?] [?] [?] [?] Zhan Yuan [?] " + // U+27660
+		"[?] [?] [?] [?] [?] [?] Yu [?] Geng [?] Hou [?] [?] [?] Qi [?] " + // U+27670
+		"Mu Huan Long Xi E Lang Fei Wan [?] Cun [?] Peng [?] [?] [?] Cuo " + // U+27680
+		"Weng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27690
+		"[?] Gao [?] [?] [?] Cui [?] [?] Qi Li Qie Qian Kong Beng [?] Shou " + // U+276A0
+		"[?] [?] [?] [?] [?] [?] [?] Wei [?] [?] [?] [?] [?] [?] [?] [?] " + // U+276B0
+		"[?] [?] [?] [?] Shan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zi " + // U+276C0
+		"[?] [?] Ti Qian Du [?] [?] Tu [?] [?] Wei [?] [?] [?] Hu Xing " + // U+276D0
+		"[?] Shan Zhi [?] [?] [?] [?] Chi [?] [?] [?] [?] [?] [?] [?] [?] " + // U+276E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zhou Weng Chi Suo Xie [?] Ke [?] " + // U+276F0
+		"[?] Shai Shi Shou [?] Jie [?] [?] [?] Gao Lu [?] [?] [?] [?] [?] " + // U+27700
+		"[?] [?] [?] [?] Xie [?] [?] [?] [?] [?] Zhi [?] [?] [?] Man [?] " + // U+27710
+		"Shuai Ke [?] Diao Yi [?] Su Chuang [?] [?] [?] [?] [?] Du [?] [?] " + // U+27720
+		"[?] Cui Tuo [?] [?] Xie [?] [?] [?] [?] [?] [?] [?] Xuan [?] [?] " + // U+27730
+		"[?] [?] He Jue [?] [?] Ti Fei [?] Zhi Shi Tui [?] [?] Chong [?] " + // U+27740
+		"Ti Zhan Heng [?] Qu Wei [?] Dun Bao [?] [?] [?] Liao Lai [?] [?] " + // U+27750
+		"[?] [?] [?] [?] Si [?] [?] [?] [?] [?] Biao Xie Bie [?] Cong [?] " + // U+27760
+		"[?] [?] Ju He [?] [?] [?] Kui Yong [?] [?] [?] [?] [?] [?] [?] " + // U+27770
+		"Shu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Nie [?] Yu " + // U+27780
+		"Zhuo Meng Hu [?] Ke Lie [?] [?] [?] [?] [?] [?] [?] Jie Xiong [?] " + // U+27790
+		"[?] [?] [?] Yan [?] [?] [?] [?] [?] Jie La Shu Jie Lei [?] [?] " + // U+277A0
+		"Zu [?] Shi [?] [?] [?] [?] [?] Wei Du Su [?] [?] [?] [?] [?] " + // U+277B0
+		"[?] [?] [?] Xie Rang [?] [?] [?] [?] [?] [?] [?] Luo [?] [?] [?] " + // U+277C0
+		"[?] Qian [?] [?] [?] [?] [?] [?] Nang Ling [?] [?] Ji [?] [?] [?] " + // U+277D0
+		"Ming [?] [?] Gu [?] [?] [?] [?] Xuan [?] [?] [?] Xu [?] [?] [?] " + // U+277E0
+		"[?] Bo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wei [?] [?] [?] " + // U+277F0
+		"[?] [?] Ku [?] [?] [?] Wan [?] Cha [?] Mao Ke [?] [?] Ci [?] " + // U+27800
+		"[?] [?] Xian Mo [?] [?] [?] [?] [?] [?] Hun Chan Shi Zhen E Mi " + // U+27810
+		"[?] Shi Qu Shu [?] Ci Yan [?] [?] Hu Qi Zhi Huang [?] [?] [?] " + // U+27820
+		"[?] [?] [?] [?] Zhi [?] You [?] [?] [?] [?] [?] Gao Yao Pou [?] " + // U+27830
+		"[?] [?] [?] [?] [?] [?] [?] Yi Cheng Ji [?] Ai [?] Dong [?] Sui " + // U+27840
+		"[?] Jiu [?] [?] [?] [?] [?] [?] Qi Lian Xuan [?] Liao [?] [?] [?] " + // U+27850
+		"[?] Yun Xuan Cou Pian [?] Kui [?] Ti Huan Dan Gui Chen [?] Shang Ji " + // U+27860
+		"[?] [?] [?] [?] Lian Kan Sheng [?] Dou You Qi [?] Xiao [?] [?] [?] " + // U+27870
+		"[?] [?] Yi Lou [?] [?] Chuang [?] [?] [?] [?] Lao Gao [?] [?] [?] " + // U+27880
+		"Zeng [?] Wei [?] [?] [?] Jian [?] [?] [?] [?] Ying Fan Li Qian [?] " + // U+27890
+		"[?] [?] Yao [?] [?] [?] Kui Wei [?] Que [?] [?] Xiao Que [?] [?] " + // U+278A0
+		"Hu [?] [?] [?] [?] Duo Chu [?] [?] Shen [?] [?] Zhuo E Ji [?] " + // U+278B0
+		"[?] Tan [?] Pa [?] [?] [?] [?] [?] [?] [?] Jie Qiao [?] [?] [?] " + // U+278C0
+		"[?] Qian Ju [?] [?] Qiu Tuo [?] [?] [?] Nuo Si [?] [?] [?] Yi " + // U+278D0
+		"[?] Gu Hun Pa Zi [?] Jiao [?] [?] Xi Shao [?] Yi Zhi [?] [?] " + // U+278E0
+		"[?] [?] [?] [?] [?] Lun [?] Zhou Jue Tan Nuo Ju Hu [?] Zhi [?] " + // U+278F0
+		"[?] [?] [?] Bi [?] [?] [?] [?] [?] [?] [?] [?] [?] Chi Xuan Ji " + // U+27900
+		"Gua Ju Wo Tuo [?] Qiu Wei Duan [?] Shou [?] Zhen Ne [?] [?] Xi " + // U+27910
+		"Zhe Zhi [?] Na Xi [?] [?] [?] Jian [?] [?] [?] [?] [?] Yao Guo " + // U+27920
+		"[?] [?] Di [?] Huo Jing [?] [?] [?] [?] [?] [?] Jue Yue [?] [?] " + // U+27930
+		"[?] [?] [?] [?] Ji Xi Su [?] Jian [?] Kun Wo Kuang Biao Jue [?] " + // U+27940
+		"[?] Bi [?] Chan [?] Zi Li [?] [?] [?] Fo Qian Yan [?] Tan Mo " + // U+27950
+		"[?] [?] [?] Kou Xi [?] [?] [?] [?] [?] [?] [?] [?] [?] Hu Hu " + // U+27960
+		"[?] Fu [?] [?] Yang Guo [?] Ren Yin Feng Jun [?] Yun [?] [?] Xun " + // U+27970
+		"[?] Xi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xia [?] " + // U+27980
+		"[?] Hang [?] [?] [?] [?] [?] [?] [?] [?] Hu [?] [?] Hu Pu Fan " + // U+27990
+		"[?] [?] [?] [?] Jia [?] [?] Yi [?] [?] [?] [?] [?] Tuo Na [?] " + // U+279A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Yin Yin [?] [?] [?] [?] [?] [?] " + // U+279B0
+		"[?] [?] [?] Ji Wang Shi Dui Duo [?] Tuo Wa Li [?] [?] [?] Re " + // U+279C0
+		"[?] [?] Ci Xu Zhou Zi [?] [?] [?] [?] [?] [?] Wang Ya [?] Ji " + // U+279D0
+		"Chao [?] [?] [?] [?] [?] [?] [?] [?] Ji [?] [?] [?] [?] [?] [?] " + // U+279E0
+		"[?] [?] [?] [?] [?] Shan Tu [?] Bie Xi Pi Zha [?] [?] Hui [?] " + // U+279F0
+		"Suo [?] He [?] Yue [?] Wu [?] Ling [?] Zha Hua [?] [?] [?] [?] " + // U+27A00
+		"[?] [?] [?] [?] [?] [?] [?] Chan [?] [?] [?] [?] [?] [?] [?] E " + // U+27A10
+		"[?] Chen [?] [?] [?] [?] [?] Sui [?] Tian [?] [?] [?] [?] [?] [?] " + // U+27A20
+		"Zhi Ti Ao Zhuo Zi Ke [?] Se Tian Lu [?] [?] [?] [?] Shan Zha " + // U+27A30
+		"[?] [?] [?] Chong [?] Yan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27A40
+		"[?] [?] Mu Hu [?] [?] [?] [?] [?] [?] Chi [?] [?] Su [?] [?] " + // U+27A50
+		"[?] [?] [?] Nao [?] [?] Ji Duo Hou [?] Cong Zha Yin [?] Xiao [?] " + // U+27A60
+		"Bian Beng La [?] Chi [?] Qia [?] An Shi [?] [?] Chi [?] [?] [?] " + // U+27A70
+		"[?] [?] [?] [?] [?] Nu [?] Ji [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27A80
+		"[?] [?] [?] Ou [?] Xia [?] [?] Chai [?] Ai [?] [?] Sheng He [?] " + // U+27A90
+		"Ji Chi Xi Zheng [?] [?] Ta [?] Ma [?] [?] Pi [?] [?] Xu Qian " + // U+27AA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Xia [?] [?] [?] [?] [?] [?] " + // U+27AB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yu [?] [?] [?] [?] [?] " + // U+27AC0
+		"[?] Jie Xia Lu [?] Qie [?] Cha [?] [?] [?] Yang Ji Sha Lou [?] " + // U+27AD0
+		"Ji Zhi Wang [?] Bi An Yi An [?] [?] [?] [?] Li [?] [?] [?] " + // U+27AE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Xian [?] [?] [?] [?] Jiu Tan " + // U+27AF0
+		"[?] Hao He [?] [?] Zha Zhan Yi Xi [?] Xi Fa Yan [?] [?] Mu " + // U+27B00
+		"[?] [?] [?] [?] [?] Gu [?] [?] [?] [?] [?] [?] [?] [?] Yun [?] " + // U+27B10
+		"[?] [?] [?] [?] Zhong [?] Chan Chuang Hui Za Gun Jian Ya [?] [?] [?] " + // U+27B20
+		"Xiang He [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27B30
+		"[?] [?] [?] Dan [?] [?] [?] Mian Ning [?] Meng [?] Lie Zhou Pu Tai " + // U+27B40
+		"[?] [?] [?] Ying Teng Guo [?] [?] [?] [?] Qiang [?] Lu Sa Lie Chi " + // U+27B50
+		"Xie [?] [?] Guo Bao Luo Juan [?] [?] [?] E [?] [?] [?] [?] [?] " + // U+27B60
+		"[?] [?] [?] He [?] Mei [?] [?] Xie Pin [?] Han Chen Shan Hui [?] " + // U+27B70
+		"[?] [?] [?] [?] [?] [?] Ying [?] Jian [?] [?] [?] [?] An [?] [?] " + // U+27B80
+		"[?] Ta Yi Tui [?] [?] [?] Liu [?] Zuo [?] Li [?] Pin Xue [?] " + // U+27B90
+		"Nen Dou [?] [?] Lan [?] [?] [?] [?] [?] Zhan Jue Zhen Ji Qian [?] " + // U+27BA0
+		"Han Fen [?] Han Hong He Hou [?] [?] [?] Zhan Chou Tai Qian [?] She " + // U+27BB0
+		"Ying [?] [?] Qin [?] [?] Huo [?] Xi He Xi Xia Hao Lao [?] Li " + // U+27BC0
+		"[?] [?] Cheng [?] [?] [?] Jun Xi Han [?] [?] [?] [?] [?] Dou [?] " + // U+27BD0
+		"Dou Wan [?] [?] Dou Zai Juan [?] Lou Chu [?] Zheng [?] [?] [?] Qi " + // U+27BE0
+		"Kan Huo Lai [?] [?] [?] [?] [?] [?] [?] Gai [?] Shou [?] Dong [?] " + // U+27BF0
+		"[?] [?] [?] Lou Tuan [?] [?] Yu Wu [?] Tian [?] [?] [?] [?] [?] " + // U+27C00
+		"[?] [?] Guo [?] [?] [?] [?] [?] Tan Qi [?] [?] [?] [?] [?] [?] " + // U+27C10
+		"Lie Li [?] Xun [?] [?] [?] [?] Geng Ting Han Chu [?] Tun [?] Xiong " + // U+27C20
+		"You Mo Chi [?] Hu Du [?] Mu [?] Na [?] Ling [?] [?] [?] Ai " + // U+27C30
+		"Xian [?] [?] [?] Kan Si San [?] [?] [?] Yi [?] [?] [?] [?] Yi " + // U+27C40
+		"Xiao [?] Zhi Dou [?] [?] [?] [?] Mai [?] [?] [?] Lun Jue [?] [?] " + // U+27C50
+		"[?] Qiang Ling [?] [?] [?] [?] [?] [?] Pian Cou Duo Yu [?] [?] [?] " + // U+27C60
+		"Zhuo [?] Xi Huai Ming Tang [?] [?] [?] Pu [?] Mi Man [?] Guai [?] " + // U+27C70
+		"Qian [?] Lin Min Wei Ceng [?] Hu Sui [?] [?] Ju Sha Meng [?] [?] " + // U+27C80
+		"[?] [?] [?] [?] [?] [?] [?] Wei Xi Ling [?] [?] Bi Wei [?] [?] " + // U+27C90
+		"[?] Li Zhe [?] Yong Hu Wan Ba Jian [?] [?] [?] [?] Zuo Zhan Bo " + // U+27CA0
+		"Qiu Yang [?] [?] Dong Qu [?] [?] [?] [?] Pi Zhai [?] [?] Shan Gou " + // U+27CB0
+		"Biao Yi Fu [?] Xin Shi Tong [?] [?] Ding [?] [?] Tu Xiao Wu Pei " + // U+27CC0
+		"Hui [?] [?] [?] [?] Lai [?] [?] [?] Si Cui Sha Zhou Zhao Wei Lai " + // U+27CD0
+		"Bi [?] [?] Dong [?] [?] Nao Xie Rao Tuan Wei You Mei Yuan Zhong [?] " + // U+27CE0
+		"[?] [?] [?] [?] [?] [?] Sou [?] Gu Shao [?] Zhao Pi [?] [?] Tong " + // U+27CF0
+		"[?] Chi Peng Chan Yong Shuang [?] Wu [?] Pi Huan [?] Fu [?] Biao [?] " + // U+27D00
+		"[?] [?] [?] Nao [?] Biao Wei Yong [?] Nao Guai [?] [?] [?] [?] [?] " + // U+27D10
+		"Li [?] Xin Yan Po Pei [?] [?] [?] [?] Suo [?] Ren Shan [?] [?] " + // U+27D20
+		"[?] [?] Suo [?] [?] [?] [?] [?] Dan [?] Men [?] [?] [?] [?] [?] " + // U+27D30
+		"[?] [?] [?] Shou [?] [?] [?] [?] Gou [?] Han Shi Yang [?] Gu [?] " + // U+27D40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ke [?] [?] Ju [?] " + // U+27D50
+		"Pai Ce Bao Xiong Cai [?] [?] Lin Ai [?] [?] [?] Mi Lai [?] [?] " + // U+27D60
+		"[?] Xiao [?] She [?] [?] [?] [?] [?] [?] [?] Huo Ni [?] [?] [?] " + // U+27D70
+		"[?] [?] [?] [?] Zheng [?] Lin Zha [?] [?] Yun [?] [?] Xu [?] [?] " + // U+27D80
+		"[?] [?] [?] [?] Cheng Wo Xi [?] [?] Bei [?] [?] Shang [?] [?] [?] " + // U+27D90
+		"Yu Mi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27DA0
+		"[?] [?] Duan [?] [?] Cha Fan Ze Cheng [?] Ting [?] [?] [?] [?] [?] " + // U+27DB0
+		"[?] [?] [?] [?] [?] Yi [?] [?] [?] [?] [?] Yao [?] [?] Ku [?] " + // U+27DC0
+		"Fen Xie Cheng [?] [?] [?] [?] [?] [?] [?] [?] Kui [?] [?] [?] Bin " + // U+27DD0
+		"[?] Lou [?] [?] [?] Yi Mi Xie [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27DE0
+		"[?] Gui [?] Luo [?] [?] Shan [?] [?] [?] [?] [?] [?] [?] Ju Du " + // U+27DF0
+		"[?] [?] Xian [?] [?] Zhi [?] [?] Bin [?] [?] [?] [?] [?] [?] [?] " + // U+27E00
+		"[?] [?] [?] [?] [?] Zhi Zhuan Xue Lian Sui [?] [?] [?] [?] [?] [?] " + // U+27E10
+		"[?] [?] [?] [?] [?] [?] Lan Ju Mian Xun Zhan Gun [?] [?] [?] [?] " + // U+27E20
+		"[?] [?] Zhi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wei Quan Chai " + // U+27E30
+		"[?] [?] [?] [?] [?] [?] [?] [?] Reng [?] Yue [?] Zi [?] [?] [?] " + // U+27E40
+		"Luo Gui Mai Cheng Zhang Ju Tian Wan [?] [?] [?] Zhi [?] [?] Nan [?] " + // U+27E50
+		"[?] [?] [?] Han [?] [?] [?] [?] Xi Lin [?] [?] Yan Xu [?] [?] " + // U+27E60
+		"[?] [?] Hu Gan Xu [?] Xi [?] [?] [?] Cui [?] [?] Xi Hu [?] " + // U+27E70
+		"[?] [?] [?] [?] [?] Yan [?] [?] [?] [?] [?] [?] [?] [?] Yi Chi " + // U+27E80
+		"Jue [?] Zu [?] [?] [?] [?] [?] [?] [?] [?] [?] Jiao Yi [?] Tan " + // U+27E90
+		"Chi Ba Tou Zong Qiu [?] [?] Chi Xi [?] [?] [?] [?] [?] [?] [?] " + // U+27EA0
+		"Ni [?] Cu [?] Wu [?] Chu Su Yong Ju Ba [?] Ci Di Pan Chi " + // U+27EB0
+		"[?] Qiu [?] Yan [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhai [?] [?] " + // U+27EC0
+		"[?] [?] Xian Beng Kuang Qi Zhou Ju Qie Mo Yuan [?] Gui Zui [?] [?] " + // U+27ED0
+		"[?] [?] [?] [?] [?] [?] [?] Qie [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27EE0
+		"Hu Qiu Hai Fu Lang Sha Xi Bu Shi Yong Guang [?] Nie [?] [?] Hou " + // U+27EF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mi [?] [?] [?] E Xian " + // U+27F00
+		"Yun Xu Qin Dong Leng Qi Lan Fu Qi Chong [?] [?] Cu [?] [?] Mo " + // U+27F10
+		"Bei [?] [?] [?] Dao [?] [?] [?] Jie Chong Chi Yu Cui Su Ti Shu " + // U+27F20
+		"Zha Fu [?] Che Fo Hou Zha [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+27F30
+		"[?] [?] [?] [?] Jie Zha Zhan [?] [?] Yan Hai Wu Hua Dian Yao Sou " + // U+27F40
+		"Qian Ji Xiong Qi Jun [?] Hai [?] [?] [?] [?] [?] [?] [?] Yan Jie " + // U+27F50
+		"Cui [?] Tuan Zhang Piao Lu Zhi Chu Mi Qiang [?] Lian [?] [?] [?] [?] " + // U+27F60
+		"[?] [?] Li [?] [?] Zong E Su Jue [?] [?] Ju Tan Liao San Dong " + // U+27F70
+		"[?] Za Zhi [?] [?] [?] Xuan Ling [?] [?] Deng [?] [?] Zhan Xuan Qin " + // U+27F80
+		"Jiao Pi [?] [?] Han [?] [?] [?] [?] [?] Yu Guo [?] Xun [?] [?] " + // U+27F90
+		"Xun Chan Jie Ju Yan Du [?] Hong Xian Xun [?] [?] [?] [?] Ling Jie " + // U+27FA0
+		"Yi Qu Gan Feng [?] Jue Qu [?] [?] [?] [?] Jiu [?] Ji Ji [?] " + // U+27FB0
+		"[?] [?] [?] [?] [?] Xi Pang [?] Kuang Ku [?] Ku Zha [?] [?] Ba " + // U+27FC0
+		"[?] [?] Chen Hu Nu E Xiong Dun Sheng Wan Fen Zong [?] Xi Zi [?] " + // U+27FD0
+		"Hu [?] [?] [?] [?] Bie [?] Tuo Ban Ge [?] Ke [?] [?] [?] [?] " + // U+27FE0
+		"[?] [?] Zhui Fu Mo Jia Tuo Yu [?] Mu Jue Ju Gua Po [?] [?] " + // U+27FF0
+		"Ni Long [?] [?] Wa Yan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28000
+		"[?] [?] [?] [?] Chou Kuang Hai [?] Xiang Xi [?] Cun Tong Ruo [?] Duo " + // U+28010
+		"Che [?] [?] [?] Lei Zi [?] Zheng Zuo [?] [?] Kang Zai [?] Yuan Qiong " + // U+28020
+		"[?] [?] [?] Fa Xun [?] Ji [?] Cha [?] [?] [?] [?] [?] [?] [?] " + // U+28030
+		"Shu Xuan Xie Ti Han Xian Shan Tun Hang Kun Cen Dou Nuo Yan Cheng Pu " + // U+28040
+		"Qi Yue Fu [?] [?] [?] [?] Ting [?] [?] [?] [?] [?] [?] [?] Wo " + // U+28050
+		"Sheng Tuo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28060
+		"[?] [?] [?] [?] Tan [?] Ya Zhi Lu Yan Ju [?] [?] De [?] Chu " + // U+28070
+		"Zu E Zhi Peng [?] Bie [?] Di [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28080
+		"Lai [?] Ye [?] [?] [?] [?] [?] [?] [?] [?] [?] Hao Pan Tan Kang " + // U+28090
+		"Xu Zou Ji Wu [?] [?] Chuan [?] [?] Po Yan Tuo [?] Du [?] Pian " + // U+280A0
+		"Chi Hun Ping [?] Cong Zha [?] [?] [?] [?] Wan [?] [?] [?] [?] Wai " + // U+280B0
+		"[?] [?] [?] E Wei Bai [?] Jiang [?] [?] [?] [?] [?] [?] [?] [?] " + // U+280C0
+		"[?] [?] [?] Cha [?] Chu Kua Teng Zou Li Ta Sa [?] [?] Pan Pan " + // U+280D0
+		"[?] [?] [?] Sao Qiao [?] [?] [?] [?] [?] [?] [?] [?] Zu [?] Zhi " + // U+280E0
+		"Yan [?] Jie Neng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+280F0
+		"[?] [?] [?] [?] Luan Qu [?] Deng Liang Chan Qie Lou Die Cui [?] [?] " + // U+28100
+		"Ji [?] [?] Chao Shuan Zu [?] Kang [?] [?] Qiang Li [?] [?] [?] [?] " + // U+28110
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shuai Yu " + // U+28120
+		"Zhang Lei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28130
+		"[?] [?] [?] [?] [?] Po [?] [?] [?] [?] Zhe Xiao [?] Tan Cui Lan " + // U+28140
+		"[?] Xu Shu Zha Can [?] [?] Bi Peng [?] [?] Zhu [?] Cheng [?] [?] " + // U+28150
+		"[?] [?] [?] Qiao Ji [?] [?] [?] [?] [?] Zhai [?] Lan [?] [?] [?] " + // U+28160
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28170
+		"[?] Tian Sa Jin Zhu Duo [?] Cha Juan Tang Beng [?] Fan Lie Zei Sui " + // U+28180
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Se [?] [?] [?] [?] [?] [?] " + // U+28190
+		"[?] [?] [?] [?] [?] [?] [?] Zhi Tui [?] Qing [?] Chuo [?] [?] [?] " + // U+281A0
+		"Ta Bing Wen [?] [?] Po [?] [?] [?] [?] [?] [?] [?] Mo Ca [?] " + // U+281B0
+		"[?] Kuang [?] Cuo Rao Bao Lai [?] [?] [?] [?] [?] [?] Nian Li [?] " + // U+281C0
+		"[?] [?] [?] [?] [?] Jiao Lu Li Long Gui [?] [?] [?] Chan [?] [?] " + // U+281D0
+		"[?] [?] [?] [?] Xian [?] Chan [?] Xie Zhan [?] [?] [?] [?] [?] Shuang " + // U+281E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mi Luan Luo [?] [?] " + // U+281F0
+		"Dian [?] [?] [?] [?] [?] [?] [?] Die [?] Wan Yue Luan [?] Luan [?] " + // U+28200
+		"[?] [?] [?] Leng [?] Wai Din Nen Shao Xie Pi [?] [?] [?] [?] [?] " + // U+28210
+		"[?] [?] [?] [?] [?] Mao [?] Yin [?] Bo [?] Zhu [?] [?] Chong [?] " + // U+28220
+		"[?] [?] [?] [?] [?] [?] Mu Tuo [?] Tong Ye [?] [?] [?] [?] [?] " + // U+28230
+		"[?] Huang [?] Ren [?] Ye [?] [?] [?] [?] [?] Tuo [?] [?] [?] [?] " + // U+28240
+		"[?] [?] [?] [?] [?] [?] Zuan Yu [?] [?] A [?] Zhou Wan [?] [?] " + // U+28250
+		"[?] Duo Zhong Ha Huang Mian [?] [?] [?] Chun Qie Gong Ting Mei [?] [?] " + // U+28260
+		"[?] Tang [?] [?] Rong [?] [?] Rong Qi Guo [?] [?] [?] Xiang Tian [?] " + // U+28270
+		"[?] [?] [?] [?] [?] Xiao [?] [?] Zhan Cui [?] [?] [?] [?] [?] [?] " + // U+28280
+		"[?] [?] [?] [?] Lan [?] [?] [?] Shen [?] Lei Li [?] Chan Nie Luan " + // U+28290
+		"[?] Ting Hui [?] [?] [?] [?] Gong [?] [?] [?] [?] [?] [?] [?] [?] " + // U+282A0
+		"Qi Yu [?] Xin [?] [?] [?] [?] Yue Ba Dai Ji Xuan [?] [?] Jue " + // U+282B0
+		"Niu [?] [?] [?] [?] [?] [?] [?] Du Ji [?] [?] [?] [?] [?] [?] " + // U+282C0
+		"Pa Gong Ben [?] Keng Yang Liu Ni Zha Yin Nian Pao [?] Gong Bu He " + // U+282D0
+		"Rong Gui [?] [?] [?] Bi Xi Ju Hun Bi [?] Tiao Zheng [?] Hong Yi " + // U+282E0
+		"Ci [?] Bing [?] [?] [?] [?] Gong [?] [?] Fa [?] [?] Yang Xu [?] " + // U+282F0
+		"[?] Hong [?] [?] Zang Chai Hong [?] Tian [?] [?] [?] Zhi Xing Xu [?] " + // U+28300
+		"[?] Zhen [?] [?] Wan [?] [?] [?] Jun [?] [?] [?] [?] Wo [?] [?] " + // U+28310
+		"Lu [?] Zheng Rong Cheng Fu [?] E Tao Tang [?] Juan Chao Ta Di [?] " + // U+28320
+		"Zong [?] [?] Keng Tui [?] Keng [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28330
+		"[?] [?] [?] [?] [?] Rong Yun He Zong Cong Qiu [?] [?] [?] Mu Duo " + // U+28340
+		"Xu Keng Xian [?] [?] [?] [?] [?] [?] [?] [?] Du Kan [?] Ying [?] " + // U+28350
+		"[?] [?] Zi [?] [?] [?] [?] Huang [?] Peng [?] Li [?] Bo Ge Ju " + // U+28360
+		"Ke [?] Hu Yao Tang [?] Qiong Rong Liu Hui Ji [?] [?] [?] [?] [?] " + // U+28370
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Zhi [?] Tang Zhi Kang [?] [?] " + // U+28380
+		"[?] [?] [?] [?] Yang [?] Tang Hong [?] [?] [?] Liang [?] Cao [?] [?] " + // U+28390
+		"[?] Nai Zong [?] Deng [?] Jiao Peng [?] Guang Er Jian Jiao Nuo Zao [?] " + // U+283A0
+		"[?] [?] [?] Peng Dang [?] Qu Lian Mu Lan [?] [?] [?] [?] Fen [?] " + // U+283B0
+		"[?] [?] Hun [?] [?] [?] Kuang [?] Yin Shuan Jian [?] [?] [?] [?] [?] " + // U+283C0
+		"[?] [?] Luo [?] Lu [?] [?] [?] [?] [?] Ge Rang [?] [?] Pin [?] " + // U+283D0
+		"Long [?] [?] [?] Zhen Xian [?] [?] Lin Lian Shan Bo Li [?] [?] [?] " + // U+283E0
+		"[?] [?] [?] Xie Ge Min Lian [?] [?] Jue Zhou [?] [?] [?] [?] Ke " + // U+283F0
+		"[?] Die [?] Zhe [?] Shu Ji Long Guang Zao Xian Qian [?] Shen [?] [?] " + // U+28400
+		"Yin Jie [?] [?] Shen Shen Sa [?] [?] [?] [?] Xi [?] [?] [?] [?] " + // U+28410
+		"[?] Ku [?] Qu [?] Ge Ban [?] Bi Qian [?] [?] [?] [?] [?] [?] " + // U+28420
+		"Bin Ban [?] Zuo Pi [?] Huo [?] [?] [?] [?] [?] [?] [?] Ban [?] " + // U+28430
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Nong [?] Chen [?] Peng [?] " + // U+28440
+		"[?] Fu Tu [?] [?] [?] [?] [?] [?] [?] [?] [?] Pi Po [?] [?] " + // U+28450
+		"Chi [?] [?] Xue Qi Wu [?] [?] Zhi Di Cong You [?] [?] [?] [?] " + // U+28460
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Cong [?] [?] Di Zhuo [?] Zou " + // U+28470
+		"Cong [?] [?] Pan Yan Qi Rong Jia [?] Zhi Qiu Yue [?] Shi [?] [?] " + // U+28480
+		"[?] Hao [?] [?] [?] [?] [?] [?] [?] Tuo [?] [?] Bie [?] Kan [?] " + // U+28490
+		"[?] [?] Chuo [?] Ci [?] Yin Shi Hai Ruan [?] Yang Chi [?] Ci [?] " + // U+284A0
+		"[?] Gong Mi [?] Ji [?] [?] [?] [?] [?] [?] [?] Gen Zao [?] [?] " + // U+284B0
+		"[?] Beng [?] [?] [?] [?] [?] Xin Kuo [?] Die [?] [?] Ting [?] [?] " + // U+284C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shui [?] [?] [?] Dai [?] " + // U+284D0
+		"[?] [?] [?] [?] [?] [?] Li [?] Yong Jiao [?] [?] Ta Qu Yin Yuan " + // U+284E0
+		"Jie [?] Qian Yao Ya [?] [?] Qing [?] [?] [?] [?] [?] [?] [?] Pei " + // U+284F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28500
+		"[?] [?] [?] [?] [?] [?] [?] Jia [?] Tou [?] Ti [?] [?] [?] [?] " + // U+28510
+		"[?] Dun Chan Jia Chi Jian Shu [?] [?] [?] [?] [?] [?] [?] [?] Ta " + // U+28520
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28530
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28540
+		"[?] [?] [?] [?] [?] Zhi [?] Yuan [?] [?] Hu [?] Lie [?] [?] [?] " + // U+28550
+		"Ze [?] Chu [?] [?] [?] Qiu Beng [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28560
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Huan Kua Sheng [?] Jie [?] Wang " + // U+28570
+		"[?] [?] [?] Hu [?] [?] [?] [?] [?] [?] Ze Zan Yang [?] Chi Jiu " + // U+28580
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Liao Yu [?] [?] [?] [?] " + // U+28590
+		"Bian [?] Kuang [?] [?] [?] [?] [?] [?] [?] [?] [?] Chou Ya Zhuo [?] " + // U+285A0
+		"Qie Xian [?] Yuan Wu Jiao Xiang Sha [?] Zhi [?] [?] Chong [?] Bian Wei " + // U+285B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+285C0
+		"[?] [?] [?] Dao [?] [?] [?] [?] [?] [?] [?] [?] [?] Yu Tui [?] " + // U+285D0
+		"[?] Chao [?] [?] [?] Hui Qian [?] Wei [?] [?] [?] [?] [?] [?] [?] " + // U+285E0
+		"You [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Di [?] Da [?] " + // U+285F0
+		"[?] You Jiu Tui Zan [?] [?] Hui [?] Sha [?] [?] Huo [?] [?] [?] " + // U+28600
+		"[?] [?] [?] [?] Yao [?] [?] [?] [?] Xian [?] [?] [?] [?] Xian [?] " + // U+28610
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Di [?] Jiu [?] " + // U+28620
+		"[?] [?] Hui [?] Kao You [?] [?] Li [?] [?] [?] Chuan [?] Chi [?] " + // U+28630
+		"Huo [?] You [?] Yue [?] [?] [?] [?] [?] [?] [?] [?] [?] Ta Zan " + // U+28640
+		"[?] [?] [?] Nie Zhu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28650
+		"[?] Xian [?] [?] [?] [?] [?] [?] [?] Shi [?] Kou Qi Tu Fan Cun " + // U+28660
+		"[?] [?] Tun Cha Cai Xiang Pei Jing Qi Shao Niu Na [?] Qin [?] [?] " + // U+28670
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Bi [?] [?] " + // U+28680
+		"[?] [?] [?] Bi Bao Bian Zi Na Wei Hao [?] [?] [?] [?] [?] [?] " + // U+28690
+		"[?] Jin [?] Zheng [?] [?] [?] Qie [?] [?] [?] [?] [?] [?] Hao Tong " + // U+286A0
+		"Zao Sheng Cun Huang Ru Zai Nian [?] [?] [?] [?] [?] [?] [?] Xian [?] " + // U+286B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Quan Ji Yin Li Mang Shao Han Cuo " + // U+286C0
+		"Jun Ji Bu Long Fou You Kuai [?] [?] [?] [?] [?] Xiang [?] [?] [?] " + // U+286D0
+		"[?] Yun [?] Qin Hui Pu [?] [?] [?] [?] [?] Li Pei Shu Ju Yi " + // U+286E0
+		"Zheng Chong [?] Xi [?] Hu Rou [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+286F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Huan Qiao Zhi Ying " + // U+28700
+		"Xi Qiao Ji Zheng Huang [?] Yu Zou Mei [?] [?] [?] Sheng [?] [?] [?] " + // U+28710
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Quan [?] [?] [?] [?] [?] [?] " + // U+28720
+		"Jiang He [?] Tong He Wen Yi Pang [?] [?] Weng Qian Li Yi Chuang Xu " + // U+28730
+		"Wei [?] [?] [?] [?] [?] Ge [?] Yu [?] [?] Zhai Gan Qian Kang Li " + // U+28740
+		"Shen Guan [?] Piao [?] Gai Li [?] Hu [?] [?] Tu Shun [?] Hu Li " + // U+28750
+		"[?] [?] Lou [?] [?] [?] Dang [?] Zuo Shan [?] She [?] Feng Ju Tong " + // U+28760
+		"Jiao Qiao Gao Zi Huang Shan [?] [?] Tan [?] [?] [?] [?] [?] [?] [?] " + // U+28770
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Tuo [?] Ling [?] " + // U+28780
+		"Cheng Weng Zuo Yu [?] Zhu [?] Qun Xi Qu [?] Ge [?] [?] [?] [?] " + // U+28790
+		"[?] [?] Qi Xu [?] [?] [?] [?] Gai Que Chou Meng [?] [?] [?] [?] " + // U+287A0
+		"[?] [?] Shen [?] [?] [?] Qiao Can [?] [?] Li [?] Wan Lei Xing Lang " + // U+287B0
+		"[?] [?] Shi Zheng Fan [?] [?] [?] [?] [?] Zhi [?] [?] [?] [?] Yin " + // U+287C0
+		"[?] Li [?] [?] [?] [?] Mo Wei [?] Ying Rang [?] [?] [?] [?] [?] " + // U+287D0
+		"Quan [?] [?] [?] [?] Luo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+287E0
+		"[?] [?] Dai [?] Yin Bi Ge [?] Wen Yan Mian [?] Gang Qiu Zhi [?] " + // U+287F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Gu Tong [?] Ling Ti " + // U+28800
+		"Ci Yi Fan Po Bi [?] Bao [?] [?] [?] [?] [?] [?] [?] [?] Peng " + // U+28810
+		"[?] Suan [?] [?] Song Wei Xiao [?] Ji [?] [?] [?] Hao Yan [?] [?] " + // U+28820
+		"[?] [?] [?] [?] [?] [?] Yi Zao Ying Nan [?] [?] [?] [?] [?] Za " + // U+28830
+		"[?] Tian Xi Jiao Yan [?] [?] [?] [?] [?] [?] [?] Nei Tan Yan Tian " + // U+28840
+		"Zhi Chou Tao [?] [?] [?] [?] Zha [?] Dan [?] [?] [?] [?] Mian [?] " + // U+28850
+		"[?] Wu Yin Yan Lao [?] [?] [?] [?] Po [?] Hun Hai Mu Cong [?] " + // U+28860
+		"[?] Ku Chou [?] You [?] [?] [?] Zhuo [?] Kui Sou [?] [?] [?] [?] " + // U+28870
+		"[?] [?] Yin [?] [?] Zui Sang Liu Han Wei Meng Hu Li [?] Mi [?] " + // U+28880
+		"Bang Jian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Que [?] [?] [?] " + // U+28890
+		"Meng [?] Mu Hong Hu Mi Shai [?] [?] Shang Chao [?] Zhuo [?] Zhi Nian " + // U+288A0
+		"[?] [?] [?] [?] [?] Ji [?] [?] Ke Zheng [?] [?] [?] [?] [?] Dan " + // U+288B0
+		"Liao Zhan Gong Lao Hua Chuai [?] Jian Kui [?] [?] [?] [?] She [?] [?] " + // U+288C0
+		"[?] [?] [?] [?] Chen Tan [?] Hu Meng Pao Zhan Chang [?] Gan [?] [?] " + // U+288D0
+		"Yi [?] Sui [?] [?] [?] Xu Ji Lan [?] [?] [?] Yi [?] [?] Mi " + // U+288E0
+		"[?] Mie [?] [?] [?] Cuan [?] [?] Lan [?] [?] Yan [?] [?] Mi [?] " + // U+288F0
+		"[?] [?] Yong Cang Jian [?] [?] Sou [?] [?] [?] [?] [?] [?] Yan [?] " + // U+28900
+		"[?] Juan [?] [?] [?] E [?] [?] Fen [?] Fen [?] [?] [?] [?] [?] " + // U+28910
+		"[?] Guang Mai [?] Lie [?] [?] [?] [?] Chong [?] Li [?] [?] [?] [?] " + // U+28920
+		"[?] Zhi [?] [?] Xie [?] [?] Chou [?] Ji [?] [?] [?] Pi [?] [?] " + // U+28930
+		"[?] [?] Jie [?] [?] [?] [?] Zhou [?] [?] [?] [?] [?] Xiong [?] [?] " + // U+28940
+		"[?] Kuang [?] [?] [?] [?] [?] [?] [?] Jing [?] Hu [?] [?] Qian [?] " + // U+28950
+		"[?] [?] [?] Cen [?] [?] Qi Wan Mao [?] Dou [?] [?] [?] [?] [?] " + // U+28960
+		"[?] [?] [?] [?] Kou [?] Dai [?] Nao [?] Hong [?] [?] [?] [?] [?] " + // U+28970
+		"[?] [?] Lai Duo Qian [?] Yin [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28980
+		"[?] [?] [?] [?] [?] [?] Lou Hui [?] [?] [?] Fu Mao [?] Zhou [?] " + // U+28990
+		"[?] Yong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lao Ji Yi " + // U+289A0
+		"Liu Cong [?] Nan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+289B0
+		"Du [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+289C0
+		"Tun Xiang [?] [?] [?] Bian Chuang Wu [?] Ju [?] [?] [?] [?] [?] [?] " + // U+289D0
+		"[?] [?] [?] [?] [?] Xie Pi Zhuo Rui [?] Sao Zi [?] Zheng Mi [?] " + // U+289E0
+		"Zu Qu [?] Chi [?] Zhi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+289F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Bo " + // U+28A00
+		"[?] [?] [?] [?] [?] [?] [?] Quan Qian Ya Chao He Ru [?] [?] [?] " + // U+28A10
+		"Ju Wu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chi Kuang [?] Cou " + // U+28A20
+		"Ruan Kuo Chi Zu Jiao [?] Yu Tu Meng Da Shuo [?] [?] [?] [?] [?] " + // U+28A30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28A40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28A50
+		"[?] [?] [?] [?] [?] Feng Gou Dong Cha Mao Chan Bian Yu [?] [?] Wan " + // U+28A60
+		"Zu [?] Zi [?] Chuan Wan Wa [?] Quan [?] [?] Wan [?] Xia [?] [?] " + // U+28A70
+		"[?] [?] [?] [?] Ying Jian [?] [?] Wei Ti Sao [?] Qi Sha Yu Ji " + // U+28A80
+		"Dou Chan Tuan [?] [?] Liu [?] Zhui [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28A90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28AA0
+		"[?] [?] [?] Ruan [?] [?] Yan Gu [?] Li Cha [?] [?] [?] Di Liu " + // U+28AB0
+		"Zhan Po [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28AC0
+		"[?] [?] Lou [?] Zhi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28AD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28AE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28AF0
+		"[?] Lian [?] [?] [?] Luo [?] [?] [?] [?] [?] [?] [?] Duo [?] [?] " + // U+28B00
+		"Jue Li Lan [?] Ruan Gu Chan Xu [?] [?] Zhi [?] [?] [?] [?] [?] " + // U+28B10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28B20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28B30
+		"[?] Xue Bo Cheng [?] Zhu Hei [?] [?] Ban [?] [?] [?] [?] Xi [?] " + // U+28B40
+		"[?] [?] [?] Die [?] [?] Zhan Guo [?] [?] Biao La [?] [?] [?] [?] " + // U+28B50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28B60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jin [?] [?] [?] [?] [?] " + // U+28B70
+		"[?] [?] Gai [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28B80
+		"[?] [?] Meng [?] Yu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28B90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xi [?] Piao Si [?] [?] " + // U+28BA0
+		"[?] [?] [?] [?] Deng [?] [?] [?] Chuo Di Ji Chan [?] [?] [?] Zhuo " + // U+28BB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28BC0
+		"[?] [?] [?] Cai [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jiang [?] " + // U+28BD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28BE0
+		"[?] [?] Tou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Li [?] [?] " + // U+28BF0
+		"[?] [?] Qian [?] [?] [?] Chuo [?] [?] [?] [?] [?] [?] [?] [?] Ta " + // U+28C00
+		"[?] Diao [?] Jian [?] [?] [?] [?] [?] [?] [?] Zhi Jue [?] Mo [?] " + // U+28C10
+		"Luo [?] [?] [?] [?] [?] Bao [?] [?] [?] [?] [?] [?] Zuan [?] [?] " + // U+28C20
+		"[?] [?] [?] [?] [?] Zhe [?] [?] Yu [?] [?] Bao [?] [?] Ma Xi " + // U+28C30
+		"Hu Yi E Gu Tu Zhen [?] Qiu Su Liang Qu Ling Guan Lang Tou Da " + // U+28C40
+		"Lou Huang Shou Jiao Zun Gai Wei [?] [?] Kun Duan Song Qi Yang [?] [?] " + // U+28C50
+		"[?] Shi [?] Gai [?] [?] Dao Yao [?] [?] [?] Qian [?] Shao Chang Miu " + // U+28C60
+		"[?] Mo [?] [?] [?] Nao [?] [?] Cong [?] Nie Zhao Cen [?] [?] Song " + // U+28C70
+		"Nie Ci [?] [?] Jun [?] Shao [?] Zhu Duo An Bi [?] [?] Ti [?] " + // U+28C80
+		"Pi Xia Qiu Sheng [?] [?] [?] Tang [?] [?] [?] Man Pian [?] Ti Rong " + // U+28C90
+		"[?] [?] [?] [?] [?] [?] [?] Cong [?] [?] Ji Feng Wu Jiao Lao Zeng " + // U+28CA0
+		"Peng Can [?] Nong [?] Chan [?] [?] [?] [?] [?] [?] [?] [?] Man Gui " + // U+28CB0
+		"Niao Chong Chan [?] [?] [?] Nang [?] [?] Xia Jiu Ji Zhen [?] [?] [?] " + // U+28CC0
+		"[?] Ting [?] [?] Men Yue [?] Zhong Tun Rui Xie Xi [?] Ting Niu [?] " + // U+28CD0
+		"Wang Jian [?] Fen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28CE0
+		"[?] [?] Bian [?] [?] [?] [?] Yi [?] [?] Die Ji Gan [?] [?] Jian " + // U+28CF0
+		"Jiong [?] [?] [?] [?] [?] Kai [?] [?] [?] Que [?] Nan Mou Xu Song " + // U+28D00
+		"Shen Kuang Que Wei [?] [?] [?] Die Nan [?] Ruo Gong Dou [?] Nian [?] " + // U+28D10
+		"[?] Chao He Yan [?] [?] [?] [?] [?] Tu Bu [?] Hu Yong [?] Shi " + // U+28D20
+		"Chu [?] [?] [?] [?] [?] [?] [?] [?] Xiao Men Li Ti [?] Jian [?] " + // U+28D30
+		"[?] [?] Zhi Gua Guan [?] Qi [?] Fei Yu Zhe Wei E Chan Xi [?] " + // U+28D40
+		"Gu [?] [?] [?] [?] [?] [?] Que Hui [?] Xie Ying [?] Ta Wai Fu " + // U+28D50
+		"Jie Pi [?] [?] [?] Sheng Yu Kua [?] Pi Xie Nue Xian Jian Xu [?] " + // U+28D60
+		"Bi [?] [?] [?] Nan [?] Liang [?] Pian [?] [?] [?] Jing [?] [?] [?] " + // U+28D70
+		"Ta Yan Ai [?] [?] Xiao Qiang Wu Tang [?] Jun [?] [?] [?] [?] [?] " + // U+28D80
+		"Kuo [?] [?] [?] [?] [?] [?] Lang [?] Neng [?] [?] Dou Shu [?] Jiao " + // U+28D90
+		"Nie [?] Yu [?] [?] [?] [?] [?] Ce [?] Jiao [?] Hua Wen Ye E " + // U+28DA0
+		"Guang Hua Jiao [?] [?] [?] [?] [?] [?] [?] Lei [?] Shang Yong [?] Deng " + // U+28DB0
+		"Guan Niu [?] Sui Xiang [?] Sa Chang [?] [?] [?] [?] [?] [?] Run [?] " + // U+28DC0
+		"Yun [?] Fen Jian Xu [?] [?] [?] Xi Shu [?] [?] [?] [?] [?] [?] " + // U+28DD0
+		"[?] [?] [?] [?] [?] Xie Li [?] [?] Tou [?] [?] Mi Chan Huo [?] " + // U+28DE0
+		"[?] Zhuan Yue [?] [?] [?] [?] [?] [?] [?] [?] Lan [?] Yan Dang Xiang " + // U+28DF0
+		"Yue Ting Beng San Xian Die Pi Pian [?] Ta [?] Jiao Ye [?] Yue [?] " + // U+28E00
+		"Reng Qiao Qi Diao Qi [?] [?] Han Yuan You Ji Gai Hai Shi [?] Qu " + // U+28E10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Wen [?] [?] Zhen Po Yan Gu " + // U+28E20
+		"Ju Tian [?] [?] [?] [?] [?] E [?] [?] Ya Lin Bi [?] [?] [?] " + // U+28E30
+		"Zi Hong [?] Duo [?] Dui Xuan [?] Shan [?] Shan Yao Ran [?] [?] [?] " + // U+28E40
+		"[?] [?] [?] [?] Tuo [?] [?] Bing Xu Tun Cheng [?] Dou Yi [?] [?] " + // U+28E50
+		"[?] Che [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28E60
+		"[?] [?] [?] [?] [?] Juan Ji [?] Zhao Beng [?] Tian [?] [?] [?] [?] " + // U+28E70
+		"Peng [?] [?] [?] [?] Fu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28E80
+		"[?] [?] [?] [?] [?] [?] Tuo [?] Xian Ni Long [?] [?] Zhuo [?] Zheng " + // U+28E90
+		"Shun Zong Feng Duan Pi Yan Sou Qiu E Qian [?] Qian [?] Ca Xun [?] " + // U+28EA0
+		"[?] [?] [?] [?] [?] Zhui [?] [?] Mao Jiao [?] [?] [?] [?] [?] Zhan " + // U+28EB0
+		"Pi Xi Yan Fei Nie [?] Zhi [?] Suo [?] Yi [?] Lei Xu [?] Yi " + // U+28EC0
+		"[?] [?] Wei [?] [?] Ji Chen Die [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28ED0
+		"[?] [?] [?] Yuan [?] Xi [?] Liu Suo [?] [?] [?] [?] [?] [?] [?] " + // U+28EE0
+		"[?] Beng Xia Yan [?] Cui [?] Kang [?] [?] Qing Lou Bi [?] [?] [?] " + // U+28EF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zhan Cuan Wu Xu Chen Hao Jue [?] " + // U+28F00
+		"Chen Cha Chan Zhi Xun [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+28F10
+		"[?] [?] [?] Ge Chen Ye [?] [?] [?] [?] Chu Qu Xie [?] Zhan Ken " + // U+28F20
+		"[?] Jue [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qu [?] Meng " + // U+28F30
+		"Ye Zou Pu [?] Shi [?] [?] [?] [?] Shu Chan [?] [?] Du [?] Guo " + // U+28F40
+		"Lu Yan [?] [?] [?] [?] Niao Bin [?] [?] [?] [?] [?] [?] [?] Tui " + // U+28F50
+		"[?] [?] [?] [?] [?] [?] Ni Huan Qian [?] [?] [?] [?] [?] [?] Xia " + // U+28F60
+		"[?] [?] Ling [?] [?] [?] [?] Lian [?] Yi [?] Li Si [?] [?] Dai " + // U+28F70
+		"[?] [?] Wei [?] [?] Ci [?] [?] [?] Jiu Hong [?] Yu [?] Kui [?] " + // U+28F80
+		"[?] [?] Hang Ge Fang [?] [?] Kui [?] [?] Gui Chi [?] [?] Jiu [?] " + // U+28F90
+		"[?] Sui [?] [?] Die [?] [?] [?] [?] [?] [?] [?] Sui [?] [?] [?] " + // U+28FA0
+		"Qin [?] [?] [?] Gui [?] [?] [?] [?] [?] [?] Zhui [?] [?] Tiao [?] " + // U+28FB0
+		"[?] Yue [?] [?] [?] [?] [?] Zui [?] [?] [?] [?] [?] [?] [?] Wu " + // U+28FC0
+		"Cui [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhi [?] [?] [?] [?] " + // U+28FD0
+		"Shui [?] Dong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wei [?] [?] " + // U+28FE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chong " + // U+28FF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Run [?] [?] [?] [?] " + // U+29000
+		"[?] [?] [?] [?] [?] [?] Ji [?] [?] [?] [?] [?] Diao [?] Cang [?] " + // U+29010
+		"Kou [?] [?] Wei [?] [?] [?] Can [?] [?] Ma Ou [?] [?] [?] [?] " + // U+29020
+		"[?] [?] San [?] [?] [?] Wei [?] [?] [?] [?] [?] San [?] [?] Jin " + // U+29030
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wei [?] [?] [?] " + // U+29040
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Cai Li " + // U+29050
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yue " + // U+29060
+		"[?] [?] [?] [?] Yun [?] [?] Cheng [?] [?] Shan [?] [?] [?] [?] [?] " + // U+29070
+		"[?] [?] Hu Shai Tun [?] Fou [?] Qin Xu [?] [?] [?] Chuan Fu [?] " + // U+29080
+		"[?] [?] Yi Dong Fu Fu Ze Pu [?] Ling [?] [?] [?] Shai Pao [?] " + // U+29090
+		"[?] [?] Yin Luo Hua Yin Beng Yu She [?] Xie Chu [?] [?] [?] [?] " + // U+290A0
+		"[?] [?] [?] [?] She Dian [?] [?] [?] Yi [?] Che Geng Long Ping Yun " + // U+290B0
+		"Yan Mo [?] Sui [?] [?] [?] [?] [?] [?] [?] Jing [?] Song Pang [?] " + // U+290C0
+		"Ya Se Duo [?] [?] Chuang Xie [?] Tuan Gong Xuan [?] La [?] Ling [?] " + // U+290D0
+		"Dai Zha [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yin Song [?] Yu " + // U+290E0
+		"Tuo Tuo [?] [?] Ba Ran Bo Dai [?] Zha Hou [?] [?] [?] Hui [?] " + // U+290F0
+		"[?] [?] [?] [?] [?] Lu [?] [?] [?] [?] Ling Ru [?] [?] [?] [?] " + // U+29100
+		"[?] [?] [?] [?] [?] Dan Meng Xia Weng Han Zi Zhen Se Cuo Li [?] " + // U+29110
+		"Dian Lian Gou [?] [?] [?] Peng [?] [?] [?] Ying [?] Hou [?] Dui Wu " + // U+29120
+		"[?] [?] [?] [?] [?] [?] [?] Piao He [?] Long Mo Fei Lu Ze Bo " + // U+29130
+		"Dian Mang [?] Zhuang Lu Pang Dui Bu [?] [?] [?] [?] Chen Man [?] [?] " + // U+29140
+		"[?] [?] [?] [?] [?] [?] Xi [?] [?] [?] [?] [?] [?] An Zhong [?] " + // U+29150
+		"Nan Tuo He [?] [?] Dui Wan Zhong Cen Li Shuang [?] [?] [?] Cen [?] " + // U+29160
+		"Si [?] Dui [?] Hun [?] [?] [?] [?] [?] [?] [?] Jian Nong Dan Fu " + // U+29170
+		"Huo Hui Ci [?] Yong Sa Ting [?] [?] [?] [?] [?] [?] [?] Liu [?] " + // U+29180
+		"[?] Suan Ling Man Dian [?] [?] [?] Pao [?] Ling [?] [?] Li [?] Nou " + // U+29190
+		"[?] [?] [?] Lie Shan [?] Fei [?] [?] [?] [?] Shan [?] [?] Ling Zhan " + // U+291A0
+		"[?] Bin Li [?] [?] Si Rang Jian Zhuo [?] [?] Ling Ling Meng [?] Shuang " + // U+291B0
+		"[?] [?] [?] [?] Ling [?] [?] Hun [?] [?] [?] [?] [?] [?] Ling Jian " + // U+291C0
+		"Qu [?] [?] [?] Nong Jing Chen [?] [?] [?] [?] [?] Zhen Qing [?] Qing " + // U+291D0
+		"E [?] [?] Se [?] [?] [?] [?] [?] Bei [?] Fei [?] [?] Fei Fei " + // U+291E0
+		"[?] [?] [?] [?] Fang Ku [?] [?] [?] [?] Za Hui [?] Fei [?] [?] " + // U+291F0
+		"[?] Dui [?] [?] [?] [?] Pa Niu Pang Dan Dan Ai [?] Tian Chao Ao " + // U+29200
+		"Mei Nan [?] [?] Bo Yu Xian Mai [?] [?] Ping [?] Dui [?] Dao [?] " + // U+29210
+		"[?] Xing Ni Han Chu Shua Man [?] [?] [?] [?] [?] Wan Yi Diao Yan " + // U+29220
+		"[?] Wo Suan [?] An Lan Nan [?] Qiu Mian Nuo Can Can [?] [?] [?] " + // U+29230
+		"Lan Tian Ye [?] Nian [?] Shua [?] [?] [?] [?] Ci [?] Jian [?] [?] " + // U+29240
+		"Gan [?] [?] [?] Jian Guo [?] Zhan [?] Luo [?] [?] Ji Gui [?] [?] " + // U+29250
+		"[?] Jia Ji [?] [?] Xuan [?] Feng [?] [?] [?] Bi Qi [?] [?] Yuan " + // U+29260
+		"Ang Di [?] [?] E Fen [?] [?] Ju Ni Tuo [?] Shen Fu Xia Qu " + // U+29270
+		"Po Wan Ling Ma Zhou Bao [?] Yu [?] [?] [?] [?] Beng Mai [?] Jia " + // U+29280
+		"[?] Yang [?] Kua Jiao [?] Bing [?] [?] [?] Luo Gui Duo Zhi [?] [?] " + // U+29290
+		"[?] Zhen E Zhu Ba [?] [?] [?] Zhen Feng Dou Nian Bu Dui Sha Se " + // U+292A0
+		"Bi [?] [?] [?] Zhi Zhe Bu [?] [?] [?] Jue Xun [?] [?] [?] Xi " + // U+292B0
+		"[?] Zhuo Bai Yao Chou Ta Qian [?] Nao Yu E Jian Yi Xiao [?] Nie " + // U+292C0
+		"[?] [?] Bing [?] [?] [?] [?] Guo Xie Diao [?] [?] Ju Suo Die Fu " + // U+292D0
+		"Mian Shi Xuan Ti Yu [?] [?] Xie Fu Zhi Ni Xuan Yang [?] Feng Zong " + // U+292E0
+		"Zhou Xuan [?] [?] [?] Zhu [?] La [?] Ying Gao Kuo [?] E Wei Mei " + // U+292F0
+		"[?] [?] [?] Huai Chou [?] Suo Ta Suo Ta Xue [?] Gong Jia [?] Bo " + // U+29300
+		"Ta Yuan [?] [?] [?] [?] [?] [?] Ta [?] [?] [?] [?] Chui [?] [?] " + // U+29310
+		"Xiong He Suo [?] [?] [?] [?] Mo Chong Sui Ze Lu Zhang Luo Xu Jian " + // U+29320
+		"Shan [?] Xu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jiang [?] " + // U+29330
+		"[?] [?] Bao Mai [?] Tong Xi [?] [?] Rong [?] Sheng Zhou [?] Jian Fu " + // U+29340
+		"Deng [?] [?] Yong Ju [?] Yi Bang [?] Se Sui [?] Duo Xie [?] [?] " + // U+29350
+		"[?] Huan [?] [?] [?] Ru Ni Zhou Gui [?] Luo [?] [?] [?] [?] [?] " + // U+29360
+		"[?] [?] Zhi Xu [?] Zhi [?] Jue Ju [?] [?] Yuan Lu [?] [?] Bo " + // U+29370
+		"[?] [?] Rong Xie [?] [?] [?] [?] [?] Xi Luo [?] [?] [?] Ge [?] " + // U+29380
+		"[?] Zuan Han [?] Jiao Sa Qin Qun Pao Yue Che Fu Pei [?] [?] Mei " + // U+29390
+		"[?] [?] Tao [?] Ken Xi [?] [?] [?] [?] [?] Duo [?] Yi [?] [?] " + // U+293A0
+		"Sui [?] Xia Juan [?] Wei [?] Yi [?] Yu [?] Bai Tuo Ta Pao [?] " + // U+293B0
+		"[?] [?] Bing [?] [?] Yun Yun Duan Ruan Wei [?] [?] [?] [?] [?] Wei " + // U+293C0
+		"Gui [?] Da Xia [?] [?] Hun Juan Sui [?] Sui [?] [?] Lou Bai Yu " + // U+293D0
+		"Zheng Gui [?] Kui Gao Dan [?] [?] [?] Xian Zhai Se [?] Ke Bu Bo " + // U+293E0
+		"[?] [?] Sui [?] Yu Bu Jiu Jiu [?] Juan Jue [?] Na Zhai Tao Wei " + // U+293F0
+		"Xia Xie [?] [?] [?] Sa Ji [?] [?] Xie [?] [?] Dui Zi [?] [?] " + // U+29400
+		"[?] [?] [?] [?] [?] [?] [?] [?] Yuan Qin Fu Peng Pao [?] Yin [?] " + // U+29410
+		"Hong Zu [?] Gong Dong He Wo [?] Pang [?] [?] Su Kan Nie Hao Feng " + // U+29420
+		"E Ye [?] [?] Ting Dong Zhe Sang [?] [?] [?] Mo Su [?] Le [?] " + // U+29430
+		"Pu E Zhuo Ye [?] [?] [?] Xiang Guang Ren Ling [?] [?] Ao [?] [?] " + // U+29440
+		"Chai [?] Duo Qiong Ku Xu Huan Yao Zhen Ting Beng [?] [?] Ang [?] Kan " + // U+29450
+		"[?] Ku Pei You Ao Men Mo [?] [?] [?] [?] [?] Fu Qing La Dou " + // U+29460
+		"Tan [?] [?] Qian Yao Wei Hu Mo He Xuan [?] Bi Po [?] Di [?] " + // U+29470
+		"Zhen [?] Shi Kan Ce [?] [?] Xu Zhen [?] Zhu [?] [?] [?] [?] Hui " + // U+29480
+		"Chi [?] [?] Hong Nou Nie Yan [?] Chong Fu Guang Qi [?] Gen Ting [?] " + // U+29490
+		"[?] [?] Tan Qian [?] [?] Jiu Xu Qi [?] Zhen [?] [?] [?] Qiu [?] " + // U+294A0
+		"E [?] [?] Hui Hong Qing [?] Che [?] [?] Fu [?] Hong Xi Wu Mang " + // U+294B0
+		"[?] [?] Ti [?] [?] Hong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+294C0
+		"Bo [?] Qin Gen [?] [?] Fu Kui [?] [?] [?] [?] [?] Bie Jing Kan " + // U+294D0
+		"Gui [?] Gao Xu An Yue Wu Yi Jing [?] Lu Quan Tui [?] Ji [?] " + // U+294E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jiong Jue Pie Kun [?] [?] " + // U+294F0
+		"Wai Hui Dun Yuan Jie [?] Gui Gao Po Men Zhuan Hang [?] [?] [?] [?] " + // U+29500
+		"[?] [?] [?] [?] Yong Qiu [?] Lei Ang Pi Weng [?] [?] Qin [?] Qin " + // U+29510
+		"Mie Dou Mi Zhan [?] Qing Yi [?] [?] [?] [?] [?] [?] [?] Ban [?] " + // U+29520
+		"[?] Juan [?] Ze Xu Lan Ma Ma Ou Bei [?] Pou Xu [?] [?] [?] " + // U+29530
+		"Ao [?] [?] [?] [?] [?] Hong [?] [?] Hong Zhan [?] Sen Gao [?] Po " + // U+29540
+		"Liao [?] [?] [?] [?] Wai Xuan [?] [?] [?] [?] [?] Kui [?] [?] E " + // U+29550
+		"Han Se [?] [?] Dan [?] [?] [?] [?] [?] Xuan [?] E Gai [?] Dao " + // U+29560
+		"[?] Meng Yi Ning [?] Pin [?] [?] [?] Cang [?] [?] [?] [?] Yuan [?] " + // U+29570
+		"E Nie [?] [?] Yin [?] [?] Qiao [?] Hong Ling [?] Chan Ying [?] [?] " + // U+29580
+		"[?] [?] Guan [?] Niao Xu Tan Jin [?] [?] [?] Peng [?] Liao [?] [?] " + // U+29590
+		"Bei [?] [?] Xin Tun Chao Gan [?] Hu Wang [?] [?] Fu Pei [?] Nao " + // U+295A0
+		"Xun Xue [?] [?] Liu Ling Xue Qu Hao Yi Han [?] Fu Ba Yi [?] " + // U+295B0
+		"Bo [?] [?] [?] Hong Li [?] [?] [?] Sa Xi [?] [?] [?] Shi Piao " + // U+295C0
+		"Hua Yi Bo Bo Nei Qiu [?] [?] Wei Che You [?] Wei Hui Sa [?] " + // U+295D0
+		"[?] Tao Hong Sou Han Pao [?] Fang [?] Liu Zhou Pi [?] Li [?] [?] " + // U+295E0
+		"Chui Xi Zheng [?] Beng Zheng Sui Yan [?] [?] [?] [?] Qing Wu Liang [?] " + // U+295F0
+		"Zhao Liang [?] [?] [?] Jie [?] Hong You [?] La Hou [?] Yuan Hong Ye " + // U+29600
+		"[?] Ying Xuan You [?] [?] [?] [?] Quan [?] [?] [?] Tang Suo [?] Li " + // U+29610
+		"Sou Li [?] [?] Yu [?] [?] Yi [?] [?] [?] [?] [?] Xiu Ao Tuan " + // U+29620
+		"Su Shuai [?] Yu [?] Feng [?] [?] [?] Su Tui Yu Zheng Zheng [?] Tao " + // U+29630
+		"[?] [?] [?] [?] Liu [?] Cheng Sui Sao [?] [?] [?] [?] [?] [?] Gu " + // U+29640
+		"Feng Lie Piao [?] [?] [?] Li [?] Long Chu Xiao Hong Xie She [?] [?] " + // U+29650
+		"Long Hou Xuan Feng [?] Ba Bo Tao Su Zhao Biao Sou Tui Suo Xiao Heng " + // U+29660
+		"Sao [?] Fei [?] [?] [?] [?] Niu Mang [?] [?] [?] [?] Huan Zhi [?] " + // U+29670
+		"[?] [?] Yi [?] Yu [?] [?] Yi Yue Chi [?] [?] [?] [?] [?] [?] " + // U+29680
+		"[?] [?] [?] [?] [?] Yin Niu Rong [?] [?] [?] Na [?] [?] [?] [?] " + // U+29690
+		"[?] [?] [?] Tian [?] Ba [?] [?] [?] [?] Er Zheng E Pou Ji Ni " + // U+296A0
+		"[?] Jiong Jia [?] [?] Gan [?] [?] [?] Ling [?] Zui [?] [?] Bei [?] " + // U+296B0
+		"[?] [?] [?] [?] [?] Shu Yi Pai [?] [?] [?] Nao Shi [?] Man Shi " + // U+296C0
+		"[?] Ti [?] [?] [?] [?] [?] [?] Gong [?] [?] [?] [?] Lei Bao Yuan " + // U+296D0
+		"Zuo Lang Xiu [?] [?] Zai Cheng Jian Mao Jia Yu [?] [?] Yu Yi [?] " + // U+296E0
+		"[?] [?] Mang Zai [?] Zhui Ti [?] [?] Xi Ju Zan Lu Tao [?] [?] " + // U+296F0
+		"Zhui Ling [?] Ju [?] [?] Ji Juan [?] [?] Zi [?] Yue Dong [?] [?] " + // U+29700
+		"[?] [?] Nang [?] [?] [?] Chong [?] [?] [?] [?] [?] [?] [?] [?] Ang " + // U+29710
+		"[?] [?] [?] Geng [?] Bo Ding Wei [?] [?] [?] [?] Quan Ke [?] [?] " + // U+29720
+		"Pi Kan Fu Yong [?] Tuan Tou You Yao [?] Ye [?] [?] Yan [?] [?] " + // U+29730
+		"[?] [?] [?] [?] [?] [?] [?] [?] Xian [?] Ti [?] Sui [?] [?] [?] " + // U+29740
+		"Ci [?] [?] [?] Xu Wu Can Yu [?] [?] Chan Xia [?] Kao Cang Cha " + // U+29750
+		"Qiu [?] [?] Da [?] Su [?] [?] Hua [?] [?] [?] [?] [?] [?] [?] " + // U+29760
+		"[?] [?] [?] [?] [?] [?] [?] Wu Yuan [?] [?] [?] [?] Jiang Xiang Zhai " + // U+29770
+		"San Mo [?] Shang Cao Sui Chuang Mi Zhu Chong Ji Chong [?] [?] [?] [?] " + // U+29780
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Lian [?] [?] [?] [?] Hai [?] " + // U+29790
+		"[?] [?] [?] [?] Dun Xiang Cheng Shang Li Huang [?] [?] Deng [?] [?] Liang " + // U+297A0
+		"[?] [?] [?] [?] [?] [?] Za [?] [?] [?] Huo Lin [?] [?] Du Han " + // U+297B0
+		"Yong Yuan Guo Ling [?] Lian [?] Ao Dang Yi Nong Shan [?] Xin [?] [?] " + // U+297C0
+		"Da Yu Can Wo Cha Bo [?] Jian [?] [?] [?] [?] [?] [?] Meng Wei " + // U+297D0
+		"Mo [?] [?] [?] [?] Shui Jie Shuo Huo [?] [?] Chuo [?] Long Huai [?] " + // U+297E0
+		"Tuo [?] [?] Yu [?] [?] Chan Yong Huo [?] Lan [?] [?] [?] [?] Na " + // U+297F0
+		"Ba Gan Yi Jia [?] Da Ding Xun Ren Juan Tuan Xu Song [?] Cao Cheng " + // U+29800
+		"[?] Ding [?] [?] [?] [?] [?] [?] [?] [?] Hai [?] [?] [?] [?] Wu " + // U+29810
+		"[?] [?] [?] [?] [?] [?] Qi [?] Ji [?] [?] [?] [?] [?] Kui Wei " + // U+29820
+		"[?] [?] [?] [?] [?] [?] Shou Fu [?] Tuan [?] Bie [?] Tan Hang Pie " + // U+29830
+		"[?] [?] [?] Yu Tan [?] [?] [?] [?] [?] [?] [?] Xiang [?] Xiu [?] " + // U+29840
+		"[?] [?] [?] Weng Hai Peng Yi [?] [?] [?] [?] [?] [?] Tan [?] Bie " + // U+29850
+		"Xiang [?] [?] Yi [?] [?] Piao Huan Mu Ba [?] Fan [?] [?] [?] Ding " + // U+29860
+		"[?] [?] [?] [?] [?] [?] [?] Fen [?] [?] Jie [?] [?] [?] Suo [?] " + // U+29870
+		"[?] [?] [?] [?] Wan Ge [?] [?] Fen [?] Tuo [?] Wen Gua Duo [?] " + // U+29880
+		"Zhe Ci Yao [?] Ban Bu Mo [?] Po [?] [?] Ge [?] [?] Liu [?] " + // U+29890
+		"[?] Ran [?] [?] [?] [?] [?] [?] Gan [?] Hu Mou [?] [?] Xiu Huang " + // U+298A0
+		"Fu Hui [?] Qu Jie Tuo Yu Mo Zhou Jiu [?] Shu Kuang Qiong Lie Fu " + // U+298B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xu [?] [?] [?] [?] [?] " + // U+298C0
+		"[?] [?] [?] [?] [?] [?] Lin [?] Nie [?] Pi [?] Fu Bu Yi [?] " + // U+298D0
+		"[?] Bo [?] E [?] [?] [?] [?] [?] Zhe [?] Li [?] [?] Tu Da " + // U+298E0
+		"[?] Lu Yan Dong Qie Wan Ming Zui Fu Qu Ben Ao Qiang [?] [?] [?] " + // U+298F0
+		"[?] Qun [?] [?] [?] [?] [?] [?] Que Hua Xian Kun [?] [?] [?] Cui " + // U+29900
+		"[?] [?] Yi [?] [?] [?] Chi Zong Nao Cheng Duan Yong Zhe [?] Tan Yang " + // U+29910
+		"Xie Xuan [?] Duan Shua Xian Xian [?] [?] E [?] [?] [?] [?] [?] [?] " + // U+29920
+		"[?] [?] La [?] [?] [?] [?] [?] Wei You Yu [?] [?] Ti [?] Jin " + // U+29930
+		"[?] Tang Qi [?] Dian Tao Lu Zhan Wen Ji Ao Ou Qia [?] [?] [?] " + // U+29940
+		"Shi Ta [?] [?] Mo [?] [?] [?] You [?] [?] [?] [?] [?] [?] [?] " + // U+29950
+		"Zha [?] [?] Yao [?] [?] [?] [?] [?] [?] [?] Chong Li Yu Chan Yi " + // U+29960
+		"[?] [?] Chi [?] Li [?] [?] [?] [?] [?] [?] [?] [?] Tu [?] Zu " + // U+29970
+		"[?] [?] Xian [?] [?] [?] [?] Xi [?] Bie Han Qi Sang [?] Fei [?] " + // U+29980
+		"Shan [?] [?] [?] [?] [?] [?] [?] Huan [?] [?] [?] [?] [?] [?] [?] " + // U+29990
+		"Bang Yu Yu [?] Ji [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+299A0
+		"[?] Kuai Zong [?] [?] [?] [?] [?] [?] Xian Meng [?] [?] [?] [?] [?] " + // U+299B0
+		"[?] [?] [?] Li Zhi Fan Lie Cai Du Guang Xiong Li Qi [?] [?] Jue " + // U+299C0
+		"Tuo [?] Ju Xiao [?] [?] [?] [?] Qu [?] [?] [?] Zhuan [?] [?] [?] " + // U+299D0
+		"[?] Jue [?] [?] [?] [?] Jie [?] Zhou Xian Long Yang Ran Yi Lie Bo " + // U+299E0
+		"Hun Ji Dong Zhou Quan Jie [?] [?] Li [?] Ju Wan Ben [?] [?] Bi " + // U+299F0
+		"Ge Chun [?] Qian Sou Wei Cheng Lou Yu La Qian Dian Ta Zhan [?] Fan " + // U+29A00
+		"Lie Ting Ji Qian Hu [?] [?] Yu Qi Yu Wa [?] Ba Qi Sa Qiao " + // U+29A10
+		"Ya Xian [?] [?] [?] [?] [?] [?] Ci Fan [?] Kun Gun Que E Qiong " + // U+29A20
+		"[?] [?] Ma Ku Yao [?] [?] Que Chu Jia [?] Zhu [?] Dui Wa [?] " + // U+29A30
+		"Nao [?] [?] [?] Yan Tong [?] [?] Kuai [?] [?] Xing Gun Ping [?] [?] " + // U+29A40
+		"[?] Yu He [?] Zhuo [?] [?] She Yu [?] [?] Ji [?] Qiang Shui Chuo " + // U+29A50
+		"Zu Leng Ni [?] Wa Zha [?] Dan [?] [?] [?] [?] [?] [?] Du Bian " + // U+29A60
+		"Jie Qia He Chong Yan [?] Yan [?] [?] [?] Song Teng Yao [?] Kao [?] " + // U+29A70
+		"Zhui Gui Ai Hai [?] [?] [?] [?] Suo Xu Biao [?] Feng Qu Mang [?] " + // U+29A80
+		"Guo [?] [?] [?] [?] [?] Bi Jue Chuang [?] [?] Pu [?] [?] [?] Yi " + // U+29A90
+		"[?] [?] Qian Yi E Ling [?] Bi [?] [?] [?] [?] [?] Huo Mo [?] " + // U+29AA0
+		"[?] Xun [?] [?] Yan [?] [?] [?] Li [?] Tan [?] [?] [?] Luan [?] " + // U+29AB0
+		"Kai Mao Xiao [?] [?] [?] [?] Ai [?] [?] Ta [?] [?] Mei [?] Guo " + // U+29AC0
+		"[?] [?] [?] Gao Nao Hao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+29AD0
+		"Que [?] [?] [?] [?] Cao Sao [?] [?] [?] [?] Pi [?] [?] [?] [?] " + // U+29AE0
+		"[?] [?] Xie Xiao Ju [?] [?] [?] [?] Cheng Nao [?] [?] [?] [?] [?] " + // U+29AF0
+		"Nei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mu [?] Shao " + // U+29B00
+		"[?] Dian [?] [?] Ling [?] Zhen Yao [?] Fu Qian Qiong Ju Bing Mao Zha " + // U+29B10
+		"Tai [?] [?] [?] Chong [?] [?] [?] [?] [?] [?] Zhai [?] Shi Yong [?] " + // U+29B20
+		"Qiong Dao Ti Zhui [?] Yin [?] Nao Bo Kuang Zhi Duo Cong Bao Ya [?] " + // U+29B30
+		"[?] [?] [?] [?] [?] [?] [?] Li [?] [?] Ju Wen Lie [?] [?] Wo " + // U+29B40
+		"Shi Niao Mang Jiu [?] [?] [?] [?] Xiu [?] [?] [?] [?] Wo [?] Dao " + // U+29B50
+		"[?] Xi An Da Zong Han Chui Bi [?] Dong [?] Zhang [?] [?] [?] Ya " + // U+29B60
+		"[?] [?] Di Huo [?] [?] [?] Min [?] San Fu [?] Bao Ke Mao Re " + // U+29B70
+		"Zong Qia Xia Sou Xiu Na [?] [?] [?] Man [?] [?] [?] [?] Zha Chan " + // U+29B80
+		"She Wo [?] [?] [?] [?] Ai Bang Hao [?] Sao Suo Ti Ya [?] Bing " + // U+29B90
+		"Rong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Sha Weng [?] [?] Ao " + // U+29BA0
+		"[?] Zhuang [?] Piao Sui Yi Sou Dou Sou Luo [?] [?] [?] [?] [?] [?] " + // U+29BB0
+		"[?] [?] [?] Fei Zun [?] Nao Deng Zhi Cuo Liao Ji Bo Cong Cheng Bu " + // U+29BC0
+		"[?] San Zan [?] [?] [?] [?] [?] Jiao [?] [?] Yao Lu [?] Can [?] " + // U+29BD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Ni [?] [?] [?] [?] [?] [?] [?] " + // U+29BE0
+		"Jie Pu Zhuang Zan [?] [?] [?] [?] [?] [?] Li [?] [?] La [?] [?] " + // U+29BF0
+		"Chong [?] [?] Zhan [?] [?] [?] [?] [?] [?] [?] [?] [?] Bian Weng [?] " + // U+29C00
+		"[?] [?] [?] Hong [?] [?] [?] Pin [?] Se [?] [?] [?] [?] Ni Fen " + // U+29C10
+		"Xu [?] Shi [?] Ju [?] [?] [?] Jue [?] Yu [?] Guo Guo [?] Hu " + // U+29C20
+		"Jing [?] Li Xie Er Yuan Hai [?] [?] Jing [?] Ke [?] Zong Fei [?] " + // U+29C30
+		"Peng Geng [?] Jian Ni [?] Xian Li Chao [?] Er Geng Yu Hu Fei Ao " + // U+29C40
+		"[?] [?] [?] Er [?] [?] [?] [?] Ke Ku Bo [?] [?] Ye Jiao [?] " + // U+29C50
+		"[?] [?] [?] [?] [?] [?] Chao Geng Ru [?] Yue [?] Lin [?] [?] [?] " + // U+29C60
+		"[?] Yu Yue Zhai Xiao [?] [?] Mie [?] [?] [?] Gui Jiu [?] Tuo [?] " + // U+29C70
+		"[?] Xi Wei Zhuo Wei Kui [?] [?] Mei [?] Hao Hang Fang Niu You Hua " + // U+29C80
+		"[?] [?] Lang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+29C90
+		"Zhu Gui Bi Jia Tiao [?] Lu Kong Zui Ling Qi [?] Zhu [?] [?] [?] " + // U+29CA0
+		"[?] Gu Zu [?] Yang Su [?] Kui [?] Chang [?] Yao [?] [?] Yu [?] " + // U+29CB0
+		"[?] [?] [?] [?] [?] Shu Lai Yi Dou [?] [?] [?] Wu Ying Fu Zhuan " + // U+29CC0
+		"Fu [?] Su Li Yao Tui [?] [?] [?] [?] [?] [?] [?] Gui [?] [?] " + // U+29CD0
+		"[?] Lu Yan Qi Lang Zhu [?] Gui Hu [?] [?] [?] [?] [?] [?] Jing " + // U+29CE0
+		"[?] [?] Chi [?] [?] Ju Zha [?] Miao [?] [?] [?] [?] [?] [?] [?] " + // U+29CF0
+		"Zhu Gan Xiong Ji [?] [?] [?] Shai Mei Yun [?] [?] Gan Shou [?] [?] " + // U+29D00
+		"Lu You Jiang Nuo [?] [?] [?] [?] Ju You [?] [?] Yi Teng Wei Che " + // U+29D10
+		"Lin Gu [?] Li Liao [?] [?] Jiao Yang Biao Qi [?] [?] [?] Yi [?] " + // U+29D20
+		"[?] Bin Meng Cha [?] Gan [?] [?] [?] Qu Di Lei [?] [?] [?] [?] " + // U+29D30
+		"Ling [?] [?] [?] Huan Qu [?] Luo [?] Kui [?] [?] [?] Qiu Yu Hua " + // U+29D40
+		"[?] [?] [?] Lei [?] Ren Xiao Si [?] [?] Du Bie [?] [?] [?] [?] " + // U+29D50
+		"Niu [?] He Pei [?] Fei Mu [?] [?] Fu [?] [?] Hu Wang Sha [?] " + // U+29D60
+		"Jiao Wu [?] [?] [?] [?] [?] [?] [?] Fu [?] [?] [?] [?] [?] [?] " + // U+29D70
+		"[?] Bing Zhu [?] Zhu Chi [?] Shen Hu Bu [?] [?] [?] [?] Ran [?] " + // U+29D80
+		"[?] [?] [?] [?] [?] [?] Mu [?] Li [?] [?] Jia [?] [?] Ma [?] " + // U+29D90
+		"[?] Meng Mou Zhou Xian Hui Guai Jiu [?] Mu [?] Ru [?] Wu [?] Ru " + // U+29DA0
+		"[?] Zha [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+29DB0
+		"[?] Nuo Xie [?] Jiang [?] [?] [?] [?] [?] [?] Li Shu Yi Di Qing " + // U+29DC0
+		"Ju [?] [?] Zhi [?] Lang Bu Kuang Yi [?] Bo [?] [?] [?] [?] [?] " + // U+29DD0
+		"[?] [?] [?] [?] [?] [?] [?] Chi [?] [?] [?] [?] [?] Jiang [?] Wo " + // U+29DE0
+		"Xun [?] [?] [?] [?] Tun Mang [?] Fang Zhuo [?] Qia [?] Ta Qi [?] " + // U+29DF0
+		"Peng Bie Fen Tu Hua [?] [?] E [?] [?] [?] E [?] [?] Ding [?] " + // U+29E00
+		"Ru [?] [?] [?] [?] [?] E [?] [?] [?] [?] [?] [?] [?] Yan Si " + // U+29E10
+		"[?] [?] [?] [?] [?] Ying Ni Ni Yi [?] [?] [?] [?] [?] [?] [?] " + // U+29E20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Mi [?] [?] [?] [?] Ye Po " + // U+29E30
+		"Cou [?] Wei [?] Hai Ying [?] Ting Zhi Fei You [?] [?] Kui An Ba " + // U+29E40
+		"[?] Han [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Nan Nai " + // U+29E50
+		"[?] [?] Jing [?] [?] Wei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+29E60
+		"[?] Chu [?] Suo Tao Qi Tang Wei Gan [?] Ge [?] Han [?] Na Ge " + // U+29E70
+		"[?] [?] [?] [?] Zheng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+29E80
+		"[?] [?] [?] [?] [?] [?] [?] Ta [?] [?] [?] Si [?] Ni Sang [?] " + // U+29E90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xie [?] [?] [?] Zu " + // U+29EA0
+		"Yu Ni Qi [?] [?] Shen [?] [?] [?] [?] [?] [?] Bu [?] [?] [?] " + // U+29EB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Kun Li [?] Gua [?] " + // U+29EC0
+		"[?] [?] [?] [?] [?] [?] Yan Bu Jian [?] Wu Cen Lin Zhuan [?] Hui " + // U+29ED0
+		"[?] Tong Zha [?] Hei [?] [?] Guo [?] [?] [?] [?] [?] [?] [?] [?] " + // U+29EE0
+		"[?] Jing [?] [?] [?] Die [?] Ying [?] [?] [?] [?] Zhi [?] [?] [?] " + // U+29EF0
+		"[?] [?] Wei [?] Ji Rong [?] [?] Ao Dang Luo Ye Wei [?] [?] [?] " + // U+29F00
+		"[?] [?] Qiang [?] [?] [?] [?] [?] [?] Ge Ji [?] [?] [?] [?] [?] " + // U+29F10
+		"[?] [?] [?] [?] [?] [?] Zou [?] Yi [?] [?] Zha [?] Lie [?] [?] " + // U+29F20
+		"[?] [?] [?] [?] Ye [?] [?] [?] [?] [?] [?] [?] Zhan [?] [?] [?] " + // U+29F30
+		"Chou Biao [?] [?] [?] [?] Xu You [?] [?] [?] [?] [?] Xie Wei Li " + // U+29F40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Bo Jian Chan Kun [?] " + // U+29F50
+		"[?] Qing [?] [?] [?] [?] [?] Shuang Xi Qu [?] [?] [?] [?] [?] [?] " + // U+29F60
+		"Luo [?] [?] Dang Nian Li [?] Ba [?] E Fu Fu Hun Zha An [?] " + // U+29F70
+		"[?] Qiu Chou Mian Xun Tu Ni Hu Shu [?] Xu Zhong Kang [?] You [?] " + // U+29F80
+		"[?] [?] Xiao Xiao Ci Chi [?] Diao Yi [?] Ding [?] [?] Han Wan [?] " + // U+29F90
+		"Yi Bao Yi [?] [?] [?] [?] Xun [?] [?] [?] [?] Xiang [?] [?] [?] " + // U+29FA0
+		"[?] [?] [?] Bi [?] [?] Jie Ge Ze [?] Zhen Hu Xi Xin Xiao Fu " + // U+29FB0
+		"Zhong [?] Mao Xin Qiang [?] [?] [?] Fen Ban Huan [?] [?] [?] [?] [?] " + // U+29FC0
+		"[?] Jiao [?] Bao Ya Yao [?] [?] [?] [?] [?] Xi [?] Ju [?] Qu " + // U+29FD0
+		"Yue Tai Tou Mo Zha Qu [?] Fu [?] Qu Chi [?] You [?] [?] [?] " + // U+29FE0
+		"[?] [?] [?] [?] [?] [?] [?] Ti [?] [?] Wa [?] [?] Tuo [?] Chu " + // U+29FF0
+		"[?] Ge [?] [?] [?] [?] [?] [?] [?] Ge Qu [?] [?] [?] [?] Ju " + // U+2A000
+		"[?] [?] Die Yi Shi Yi [?] Gui Jiang [?] Song Qiong [?] E Huang Hui " + // U+2A010
+		"Xun [?] [?] Ju [?] Zhai Chi Lao [?] Qi Xiu [?] Hui Tong [?] [?] " + // U+2A020
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Fu [?] [?] Xun Jie Mi " + // U+2A030
+		"Yu [?] [?] [?] [?] [?] [?] [?] Zhuang Jiao Zhi Cheng [?] Jie Xiao Chen " + // U+2A040
+		"Li Yue [?] Zhi Lao Wo Qu [?] Wang [?] Yi Yi Lang [?] Tou An " + // U+2A050
+		"Jue Yan [?] [?] [?] Ju [?] Zhen [?] Zhi Mang [?] [?] [?] Xiu [?] " + // U+2A060
+		"[?] Chuang Chu [?] [?] [?] [?] [?] Qiang Fei Chang [?] Mian Su Ao [?] " + // U+2A070
+		"Fu [?] [?] [?] Wei Zhi Min Chang Yan Yu [?] Fu Ta Ji [?] Fei " + // U+2A080
+		"[?] [?] Hu Ju [?] Yu [?] [?] [?] [?] [?] Qi Mei [?] [?] Bie " + // U+2A090
+		"Guo [?] [?] [?] Ming [?] Wan Wan [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A0A0
+		"[?] [?] [?] [?] Jing Yu Xian [?] [?] Chun Ji [?] Xiang Pen Fu [?] " + // U+2A0B0
+		"[?] [?] Liu [?] Sai Xue Zou [?] Jie [?] [?] Zhan [?] Yu Yu Mei " + // U+2A0C0
+		"Miao Mao Duo Fu [?] [?] [?] [?] [?] [?] [?] Jian [?] [?] [?] [?] " + // U+2A0D0
+		"[?] [?] [?] [?] [?] [?] Miao [?] Ao [?] [?] [?] [?] Ke [?] [?] " + // U+2A0E0
+		"[?] [?] [?] [?] [?] [?] Hou [?] [?] [?] Gou [?] Xi [?] Rong Ge " + // U+2A0F0
+		"Pan Yuan Xia [?] [?] Sha Pi [?] Qing Yong Qu [?] Gong [?] Ge Xian " + // U+2A100
+		"[?] Su [?] [?] [?] Ban Qi Hou [?] [?] [?] Xi [?] Wu [?] [?] " + // U+2A110
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qi Hu Gui " + // U+2A120
+		"[?] Di Shang Mai Min Ji Xi Xian Ji Chang Kou Chong [?] [?] [?] [?] " + // U+2A130
+		"[?] [?] Zhang Piao Su Lue Li Meng Chong Tian [?] Ling [?] Chi [?] [?] " + // U+2A140
+		"[?] [?] [?] [?] [?] [?] Chong [?] [?] Chi [?] [?] [?] Niao [?] Yong " + // U+2A150
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mi [?] " + // U+2A160
+		"Shu [?] Xi [?] E Zi [?] [?] Jie Ji Hou Sheng Li [?] Qi [?] " + // U+2A170
+		"Zhou Si Qu [?] [?] [?] [?] [?] [?] [?] [?] Xie [?] [?] [?] [?] " + // U+2A180
+		"[?] [?] [?] [?] [?] [?] [?] Si [?] [?] [?] Xu [?] [?] [?] [?] " + // U+2A190
+		"Fu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Nong " + // U+2A1A0
+		"Ya Liu Jia Gui Kui Chi Can Chu [?] Guo [?] Dan [?] [?] [?] Jian " + // U+2A1B0
+		"[?] Dang Hou [?] Kou [?] Chu Qian Ai [?] Pi [?] [?] [?] [?] [?] " + // U+2A1C0
+		"[?] Xun Jing Meng [?] Bin Lan Gu Chou [?] [?] Yong Gua Yu Zhou [?] " + // U+2A1D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Cai [?] Liu " + // U+2A1E0
+		"Bu Luo Jie Zhen Mie Guang [?] Jia [?] La [?] [?] [?] [?] [?] [?] " + // U+2A1F0
+		"Shou [?] [?] Guo [?] [?] Meng Qian Lai [?] He Tuan [?] [?] [?] [?] " + // U+2A200
+		"[?] Hui [?] [?] [?] [?] [?] [?] Hong [?] [?] [?] Lu [?] [?] Jia " + // U+2A210
+		"[?] [?] [?] [?] [?] Gui [?] [?] Yi Huan [?] [?] [?] [?] [?] [?] " + // U+2A220
+		"Luo [?] [?] [?] Jue [?] [?] [?] Guan [?] [?] Quan Niao [?] [?] Man " + // U+2A230
+		"[?] [?] Yun Wen Chi Chi Zhi [?] Ci Zhuang Hua Jie Qu Tu Min Mei " + // U+2A240
+		"Yu Ao Ban [?] Pi Zhen Lu Chi Tou [?] Jie [?] Zhan [?] [?] [?] " + // U+2A250
+		"[?] [?] Jin Lu [?] [?] Jian Tan Chang [?] Ci [?] [?] Wai Cou Kan " + // U+2A260
+		"[?] Bian [?] [?] [?] [?] [?] [?] Wen [?] [?] Qian [?] [?] [?] Gan " + // U+2A270
+		"[?] [?] Hui [?] Gan [?] Ji Gan [?] Huai [?] [?] [?] Si [?] [?] " + // U+2A280
+		"Fu [?] [?] [?] [?] Pi [?] Ca [?] [?] [?] [?] Ben [?] [?] [?] " + // U+2A290
+		"[?] [?] Shi [?] [?] Huan [?] Gui [?] [?] Ou [?] [?] [?] [?] [?] " + // U+2A2A0
+		"[?] [?] [?] Pao [?] Ying Ting Xiao [?] Zhu [?] Yu [?] [?] [?] [?] " + // U+2A2B0
+		"[?] Jian [?] [?] Qu Wan Kun Zhui [?] Yu Guo Ping Zui Zu [?] Zhu " + // U+2A2C0
+		"Nuan Zhu [?] [?] [?] [?] Piao Mi [?] [?] [?] [?] Bi Su [?] [?] " + // U+2A2D0
+		"[?] Pu Mi [?] [?] [?] [?] [?] [?] [?] [?] Ye Yu [?] Yu [?] " + // U+2A2E0
+		"Zhu [?] [?] Ling [?] [?] [?] [?] [?] [?] Nou [?] [?] [?] Ling [?] " + // U+2A2F0
+		"Liao [?] Tuo [?] Bi Na Qu [?] Pi Dou Nie Tun [?] Ji [?] Ling " + // U+2A300
+		"[?] [?] [?] Ku Su [?] [?] [?] Tou [?] [?] [?] [?] [?] Nai Ze " + // U+2A310
+		"[?] [?] Tong Ge Dui [?] [?] Jie [?] Tian Tiao Chi Qu [?] Sha [?] " + // U+2A320
+		"Bo Li [?] Luo [?] Liao Shu Deng [?] Chi Mie [?] Tao Hun [?] Nie " + // U+2A330
+		"[?] Jun Hu [?] Lu Ye [?] Mo Chao [?] [?] [?] Suo [?] Ke Fu " + // U+2A340
+		"[?] Chao [?] [?] Suo [?] [?] Qiu [?] [?] [?] Su [?] Yun [?] Suo " + // U+2A350
+		"Ku Bo [?] Lou Mo [?] Lian Xuan Suo Man Bi [?] [?] [?] [?] [?] " + // U+2A360
+		"[?] [?] Ti [?] Lian Tan Shan [?] Qu Du Huan Sao [?] [?] [?] Kuang " + // U+2A370
+		"[?] [?] [?] Nie [?] Nie Luo Zuo Yi Xian Chao Tie Lai [?] [?] [?] " + // U+2A380
+		"[?] [?] Shuo [?] Mi [?] [?] Mi [?] [?] [?] Wan [?] Ben Qiang [?] " + // U+2A390
+		"Mo [?] [?] Liu Wo [?] Mei [?] Tou [?] [?] Mu [?] Mei [?] [?] " + // U+2A3A0
+		"[?] [?] Zuo [?] Tun Kang Tun [?] [?] [?] Che Zheng [?] Chong Tian [?] " + // U+2A3B0
+		"Zhi Chan Chan [?] [?] Qing Tun Hui Que Zhan Jian Chan [?] Huang [?] Hui " + // U+2A3C0
+		"Chi [?] Huang Heng Yun [?] Tuan Bian [?] Huang Yun [?] [?] [?] [?] Mo " + // U+2A3D0
+		"Gong [?] Gong [?] Gui [?] Chan [?] Que Rui Kuang Piao [?] [?] Ru [?] " + // U+2A3E0
+		"[?] [?] Niu Hu Jin Ni Bao [?] Ni [?] Bi Hu Li [?] [?] Zhu " + // U+2A3F0
+		"Na [?] Quan Feng Bi Li Bie Nian Dong [?] [?] Lian Ni Lian Ma Zhe " + // U+2A400
+		"[?] [?] [?] Jia Yi [?] Long [?] Yi [?] [?] [?] [?] Dai Du [?] " + // U+2A410
+		"[?] [?] [?] Yi [?] Tai Hang Shu [?] [?] [?] [?] Wan [?] Su Yao " + // U+2A420
+		"Er [?] Zhen [?] [?] [?] [?] [?] [?] [?] Dou Jian [?] [?] [?] Pang " + // U+2A430
+		"Hui [?] Cha Shan Lu Wei Yu [?] Yan Wan Qiao Luo Yu [?] [?] Tu " + // U+2A440
+		"Wei [?] Tun [?] [?] Hun Ben Qie [?] Jin Lai [?] Zhi Yu [?] Ci " + // U+2A450
+		"[?] [?] [?] [?] [?] [?] Ye Die Cha Dian Man [?] Deng Wei Nian Lei " + // U+2A460
+		"Bing Wu [?] Zhen [?] [?] Rou Wai Mi Jie [?] Hou [?] Zhai Ru Zi " + // U+2A470
+		"Pan [?] Mo [?] Mi [?] Qi Mo [?] [?] Zhi Ban [?] Mie [?] Lu " + // U+2A480
+		"[?] Qi Chong [?] Li Yi [?] [?] Deng Cuo [?] Dui Ma Yan [?] Zeng " + // U+2A490
+		"Yan Dui Pu [?] [?] Yue [?] [?] [?] Huo Mai Jian Nong Qin [?] Qin " + // U+2A4A0
+		"[?] [?] Ye [?] Tai [?] [?] [?] [?] Jian [?] [?] Cha [?] Dan Teng " + // U+2A4B0
+		"Li [?] [?] Niang Chan Zang [?] [?] [?] [?] Yu [?] Zui Bian [?] [?] " + // U+2A4C0
+		"Chu [?] [?] [?] [?] [?] [?] [?] Ran [?] Ran Yang Bo [?] [?] [?] " + // U+2A4D0
+		"[?] Cu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mi [?] Ke [?] " + // U+2A4E0
+		"Cu [?] [?] [?] [?] [?] [?] Xi [?] Ma [?] Shi Dian [?] [?] Shi " + // U+2A4F0
+		"[?] [?] Ding Jiong [?] Yuan Gan [?] [?] [?] Hui Ji [?] Peng [?] Deng " + // U+2A500
+		"[?] Beng [?] [?] Pang Ta [?] Yuan Gao Yuan [?] [?] [?] [?] [?] Jia " + // U+2A510
+		"[?] [?] [?] Kong [?] [?] Dong [?] [?] Xian Qi [?] Sang [?] [?] [?] " + // U+2A520
+		"Yin [?] [?] Long [?] [?] Teng Long [?] [?] Ren [?] [?] Yin Ping Pu " + // U+2A530
+		"Yuan Rong [?] Fang [?] [?] [?] Hang Mi Hu Zi [?] Ling Jiong Rong [?] " + // U+2A540
+		"[?] [?] Ping Guang Er [?] [?] [?] [?] [?] [?] [?] [?] Cu Jun [?] " + // U+2A550
+		"[?] [?] [?] [?] [?] [?] Xiu [?] Er Ti [?] Yang [?] Ai Hu Xi " + // U+2A560
+		"[?] Hu [?] Si Li [?] Yi Gu [?] Tang [?] [?] [?] [?] [?] [?] " + // U+2A570
+		"Que Zong Li [?] Jiao [?] [?] Fan Pu Si [?] Jie Lu Li Chan [?] " + // U+2A580
+		"Yao [?] [?] [?] [?] Hui [?] [?] [?] Hou Dian Qiu Jue [?] Pi [?] " + // U+2A590
+		"[?] [?] Kui [?] [?] Xi Ti [?] [?] Xu [?] [?] [?] [?] [?] Bian " + // U+2A5A0
+		"[?] [?] He Lian [?] [?] Su Liao [?] [?] [?] [?] Jin [?] [?] [?] " + // U+2A5B0
+		"[?] Li Chan [?] [?] Qi Qi [?] [?] Zi [?] Zi [?] Qi [?] Qi " + // U+2A5C0
+		"Zi [?] Zhai Zhai Pa [?] Ju [?] [?] Yan [?] [?] Hang Na [?] [?] " + // U+2A5D0
+		"[?] [?] [?] [?] Yan [?] Zhan Shi Zhi [?] [?] [?] [?] Zha [?] [?] " + // U+2A5E0
+		"[?] [?] [?] [?] Rong Zha [?] Yi Ming Ya [?] Zhi [?] Kuo Xia [?] " + // U+2A5F0
+		"Pian Ta [?] Yi [?] [?] Xiu Zhai [?] Duo E [?] [?] [?] Yin [?] " + // U+2A600
+		"E Suan An Cuo [?] Tuo [?] Tuo Xia [?] [?] Chuo [?] Suan [?] [?] " + // U+2A610
+		"[?] [?] [?] [?] [?] Ji Qian Zu Zhai Yun Zhan [?] Yi [?] [?] [?] " + // U+2A620
+		"[?] [?] Ya Yue [?] [?] [?] [?] [?] He Qia [?] [?] [?] Cha [?] " + // U+2A630
+		"[?] [?] [?] Ou [?] [?] [?] [?] Hu [?] Yan [?] Qie Bo Qiang Jie " + // U+2A640
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ni [?] [?] Chan Qin " + // U+2A650
+		"[?] Zao [?] [?] Yin Xie [?] Qi Jian [?] [?] Xu [?] Zeng [?] E " + // U+2A660
+		"[?] [?] [?] Zu Yi [?] [?] [?] [?] Zhi Li [?] [?] Li Yin [?] " + // U+2A670
+		"[?] Lian [?] Chan [?] Jue [?] Za [?] [?] [?] [?] [?] [?] Zhai Pian " + // U+2A680
+		"[?] Long [?] Long [?] [?] [?] [?] Long [?] [?] [?] [?] Long [?] [?] " + // U+2A690
+		"Long [?] Mang [?] [?] Zhe [?] [?] [?] [?] [?] [?] Gan Gou Ran Cu " + // U+2A6A0
+		"Jiao [?] [?] [?] [?] [?] [?] Bo [?] Zhu Qiu Yang [?] [?] [?] [?] " + // U+2A6B0
+		"Xiao [?] Hui Qu [?] [?] [?] [?] Ling [?] Yin [?] [?] [?] Pi [?] " + // U+2A6C0
+		"[?] [?] Lian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A6D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2A6E0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2A6F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qiao [?] " + // U+2A700
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A710
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A720
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A730
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A740
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A750
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A760
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A770
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A780
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Duo [?] [?] " + // U+2A790
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A7A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A7B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jian [?] " + // U+2A7C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ji [?] [?] " + // U+2A7D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A7E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A7F0
+		"Ku [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yan " + // U+2A800
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhen " + // U+2A810
+		"[?] Sa [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A820
+		"[?] [?] [?] Che [?] Lun [?] [?] Hu [?] [?] [?] [?] Dang [?] [?] " + // U+2A830
+		"Qiao [?] [?] Mai [?] [?] [?] [?] Bai [?] [?] Yan [?] [?] [?] Zhan " + // U+2A840
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] He [?] [?] Kui [?] " + // U+2A850
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A860
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lai [?] [?] [?] [?] [?] " + // U+2A870
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lan [?] [?] [?] " + // U+2A880
+		"[?] [?] Shu [?] [?] Chuo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A890
+		"Lan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Luan [?] " + // U+2A8A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A8B0
+		"[?] [?] [?] [?] [?] [?] Dong [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A8C0
+		"[?] [?] Hun [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A8D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A8E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lou [?] [?] [?] [?] " + // U+2A8F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A900
+		"[?] [?] [?] [?] [?] [?] [?] Liao [?] [?] Lin [?] [?] [?] [?] [?] " + // U+2A910
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A920
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A930
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A940
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A950
+		"Reng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shuang [?] [?] [?] [?] " + // U+2A960
+		"Ning [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Du " + // U+2A970
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A980
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A990
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A9A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A9B0
+		"Ying [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A9C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Ju [?] [?] [?] [?] [?] [?] [?] " + // U+2A9D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A9E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2A9F0
+		"[?] [?] [?] [?] [?] [?] [?] Tui [?] [?] Song [?] [?] [?] [?] [?] " + // U+2AA00
+		"[?] [?] [?] [?] [?] [?] [?] Jue [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AA10
+		"[?] [?] [?] [?] [?] [?] [?] Lun [?] Ya [?] [?] [?] [?] [?] [?] " + // U+2AA20
+		"Qu [?] [?] [?] [?] [?] She Yan [?] Tuo [?] [?] [?] [?] [?] [?] " + // U+2AA30
+		"[?] [?] [?] [?] [?] [?] [?] Lou [?] [?] [?] [?] [?] [?] Ying [?] " + // U+2AA40
+		"[?] [?] [?] [?] [?] [?] [?] [?] Yan [?] [?] Nie [?] [?] [?] [?] " + // U+2AA50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AA60
+		"[?] [?] [?] [?] [?] [?] [?] [?] Fen [?] [?] [?] [?] [?] [?] [?] " + // U+2AA70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AA80
+		"[?] Qiao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yong Qiang [?] " + // U+2AA90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AAA0
+		"[?] [?] [?] [?] Yi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AAB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Long [?] [?] [?] " + // U+2AAC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AAD0
+		"[?] Li [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AAE0
+		"[?] [?] [?] [?] [?] [?] [?] Chou Ji [?] Xian [?] [?] [?] [?] [?] " + // U+2AAF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AB00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Pin [?] [?] [?] [?] [?] " + // U+2AB10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AB20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AB30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AB40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wei [?] [?] " + // U+2AB50
+		"[?] [?] Chuang [?] [?] [?] [?] Mi [?] [?] [?] [?] [?] [?] [?] Guai " + // U+2AB60
+		"[?] [?] [?] [?] [?] Liang [?] [?] [?] [?] [?] [?] [?] [?] Xian [?] " + // U+2AB70
+		"[?] [?] [?] Can [?] [?] [?] [?] [?] [?] [?] Xiao [?] [?] [?] [?] " + // U+2AB80
+		"[?] [?] [?] [?] [?] [?] Jue [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AB90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ABA0
+		"[?] [?] [?] [?] [?] [?] La [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ABB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shan [?] [?] [?] [?] " + // U+2ABC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ABD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ABE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ABF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AC00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AC10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AC20
+		"[?] [?] [?] [?] [?] [?] Fei [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AC30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AC40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AC50
+		"[?] [?] [?] [?] [?] Fen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AC60
+		"[?] [?] [?] [?] [?] [?] [?] Bei [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AC70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ji [?] " + // U+2AC80
+		"[?] [?] [?] [?] Li [?] [?] [?] [?] [?] [?] Ji [?] [?] [?] [?] " + // U+2AC90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Fei [?] " + // U+2ACA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ACB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] She [?] [?] " + // U+2ACC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ACD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ACE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ACF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AD00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Cuan [?] [?] [?] [?] [?] [?] " + // U+2AD10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] E " + // U+2AD20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AD30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AD40
+		"[?] Xiao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AD50
+		"[?] [?] [?] Xi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AD60
+		"[?] Li [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AD70
+		"[?] [?] [?] [?] Shi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AD80
+		"[?] [?] Luo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AD90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ADA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ADB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qing [?] [?] " + // U+2ADC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ADD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2ADE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zan [?] [?] " + // U+2ADF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AE00
+		"[?] [?] [?] [?] [?] Xi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AE10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Hui [?] [?] [?] [?] [?] [?] " + // U+2AE20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AE30
+		"Yi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AE40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AE50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AE60
+		"[?] [?] [?] Zhu [?] [?] [?] [?] [?] Ta [?] [?] [?] [?] [?] [?] " + // U+2AE70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AE80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AE90
+		"[?] [?] [?] Dao [?] [?] [?] [?] [?] [?] [?] [?] [?] Qiao [?] [?] " + // U+2AEA0
+		"[?] [?] [?] [?] [?] [?] [?] Cang [?] Nu [?] Nong [?] Yin [?] [?] " + // U+2AEB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AEC0
+		"Cong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AED0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Du [?] [?] [?] [?] [?] [?] [?] " + // U+2AEE0
+		"[?] [?] Kun [?] [?] [?] [?] [?] [?] [?] Gui [?] [?] [?] [?] [?] " + // U+2AEF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Bi [?] [?] [?] [?] " + // U+2AF00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AF10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AF20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AF30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AF40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AF50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhang [?] [?] Jian Lu [?] " + // U+2AF60
+		"[?] [?] [?] [?] She [?] [?] Yi [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AF70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AF80
+		"[?] [?] [?] [?] Xu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AF90
+		"[?] [?] Xian [?] [?] [?] Lu [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AFA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Pin [?] [?] [?] [?] [?] [?] [?] " + // U+2AFB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ya [?] [?] [?] [?] [?] " + // U+2AFC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AFD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Pao [?] [?] [?] [?] " + // U+2AFE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2AFF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shi [?] [?] [?] " + // U+2B000
+		"[?] [?] [?] Min [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B010
+		"[?] [?] [?] [?] [?] [?] [?] [?] Jian [?] [?] [?] Chu [?] Dang [?] " + // U+2B020
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B030
+		"[?] [?] Ba [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B040
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhuan " + // U+2B050
+		"[?] Li [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B060
+		"[?] [?] Wu Wei [?] [?] [?] Shuang [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B070
+		"[?] [?] [?] Min [?] [?] Gui [?] Fei [?] [?] [?] [?] [?] [?] [?] " + // U+2B080
+		"[?] [?] [?] [?] [?] [?] Ai [?] [?] Su [?] [?] [?] [?] [?] [?] " + // U+2B090
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B0A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Sa " + // U+2B0B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B0C0
+		"[?] [?] [?] [?] [?] [?] [?] Jiu [?] [?] [?] [?] Kou [?] [?] [?] " + // U+2B0D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B0E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B0F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B100
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Jiu Hu Jin Mao Diao Bi Shi " + // U+2B110
+		"Huan Dong Fu Nong Da Li Jie Yan Chi Fan Sang Li Xie Fu Ting [?] " + // U+2B120
+		"Bang Se Mu Xi Lu Beng Qiang Yi Xun Zui [?] [?] [?] [?] [?] [?] " + // U+2B130
+		"[?] [?] [?] [?] [?] Ji [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B140
+		"[?] [?] [?] [?] [?] [?] [?] Fen [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B150
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Pin [?] [?] " + // U+2B160
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lian [?] [?] [?] " + // U+2B170
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ni " + // U+2B180
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B190
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B1A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B1B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B1C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B1D0
+		"[?] [?] [?] [?] [?] [?] Ying [?] [?] [?] Gang [?] [?] Wei [?] [?] " + // U+2B1E0
+		"[?] [?] [?] [?] Chu [?] [?] [?] [?] [?] [?] [?] [?] Nong [?] [?] " + // U+2B1F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Jian [?] [?] [?] [?] Qing [?] " + // U+2B200
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chen " + // U+2B210
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B220
+		"Qia [?] [?] [?] [?] Jue [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B230
+		"[?] Ai [?] [?] Shu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B240
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B250
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B260
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B270
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B280
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B290
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chang [?] [?] [?] Lu [?] " + // U+2B2A0
+		"[?] Jian [?] [?] [?] [?] [?] [?] Jiao [?] [?] Xun [?] [?] [?] [?] " + // U+2B2B0
+		"[?] [?] [?] [?] [?] [?] [?] Xiao [?] [?] [?] [?] Te [?] [?] [?] " + // U+2B2C0
+		"Gong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B2D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B2E0
+		"[?] [?] Ou [?] [?] [?] [?] Ze [?] Rao [?] Gui [?] [?] [?] [?] " + // U+2B2F0
+		"Ji [?] [?] [?] [?] [?] [?] Zhe [?] [?] [?] Shu [?] [?] [?] [?] " + // U+2B300
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B310
+		"[?] [?] [?] [?] [?] [?] [?] [?] Luo [?] Mi Lian [?] Wei [?] Jiao " + // U+2B320
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B330
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B340
+		"Pin [?] [?] [?] [?] [?] [?] [?] [?] Yi Yao Fen Qu [?] Hu Yi " + // U+2B350
+		"Yuan Yi Nao Tong Jiao Tiao Nang Chi Zhen Hua Ji Yan Wang [?] Qu Xian " + // U+2B360
+		"Xi Zhuan Xiao Zhong Lou Ao Chi Kui Shan Jie Sha Xi Zhong Xuan Ning Jian " + // U+2B370
+		"[?] [?] [?] [?] [?] [?] Zong [?] [?] [?] [?] [?] Ju [?] [?] [?] " + // U+2B380
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B390
+		"[?] [?] [?] [?] [?] [?] Long Gou Lian Chen Chen Dan Gan Cheng [?] [?] " + // U+2B3A0
+		"[?] Li [?] Bi [?] [?] [?] [?] [?] [?] Can [?] [?] [?] [?] [?] " + // U+2B3B0
+		"[?] [?] [?] Chang [?] [?] Bo [?] [?] [?] [?] Jue Lai [?] [?] [?] " + // U+2B3C0
+		"Zan Luo [?] [?] [?] Qing [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B3D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Xian [?] [?] [?] [?] [?] [?] [?] " + // U+2B3E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B3F0
+		"[?] [?] [?] [?] Yue Shan Kuai Na Ba Ling Fan Zhi Ping Tian Yi Zhou " + // U+2B400
+		"Ni Guan Hong Rou Ke Wei Jiao Bu Kan Lei [?] [?] [?] [?] [?] [?] " + // U+2B410
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B420
+		"[?] [?] [?] [?] [?] [?] [?] Lou [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B430
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B440
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zhuan [?] [?] [?] [?] [?] [?] [?] " + // U+2B450
+		"[?] Meng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B460
+		"[?] [?] [?] [?] [?] [?] [?] Yan [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B470
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B480
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B490
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B4A0
+		"[?] [?] [?] [?] [?] [?] Han [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B4B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B4C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B4D0
+		"[?] [?] [?] [?] [?] Ba Zi Fu Guo Cong Qian [?] Za Yang Liu Ji " + // U+2B4E0
+		"Xu Qiao Jun [?] Mou Shen Xuan [?] Wan Ji Lu Nie He Zong Yu [?] " + // U+2B4F0
+		"Lian Fen Di [?] Rou Suo Bei Bi Shuo [?] Xiu Kuan San Fan Jue Chan " + // U+2B500
+		"[?] Zhan [?] [?] Xi [?] Nong [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B510
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Kai [?] Dou " + // U+2B520
+		"Bian Lan Xiao [?] Lin Zhi Nie [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B530
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B540
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B550
+		"[?] [?] [?] [?] [?] Yun [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B560
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B570
+		"[?] [?] [?] Hui [?] Long [?] Xue [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B580
+		"[?] Qin Bi Bing Bai Gou Zheng [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B590
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ku Zhen Zhen [?] Yi Fu " + // U+2B5A0
+		"Duo Gen Hun Yun Ze Yue Qian [?] Yuan Cu Fan [?] [?] [?] [?] [?] " + // U+2B5B0
+		"[?] [?] [?] [?] [?] [?] [?] Yu Bo Beng An Se [?] [?] [?] [?] " + // U+2B5C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jian [?] [?] [?] Zhan Yuan " + // U+2B5D0
+		"Zhang [?] Zuo Bi Shi Yun Bu Su Lang Luo Wei Hu Nuan Wei Huang Hou " + // U+2B5E0
+		"Dui Lian [?] Jiang Zhan Xiang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B5F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B600
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Han Wen Jue Tuo Po " + // U+2B610
+		"Zhi Jiong [?] Han Ai Kun Tao Lu Ti Huang Yuan Yan Xi Shuang Zhe Ceng " + // U+2B620
+		"Zhan Xi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Kuo [?] [?] " + // U+2B630
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B640
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B650
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B660
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B670
+		"[?] [?] [?] [?] [?] [?] [?] [?] Xu Hong Yang Zhuan Sha Fen Bing Qiao " + // U+2B680
+		"Yang Bi Fu Lie Hui Shi Ci Ge [?] Pu Zhe Duo Gui Hua Li [?] " + // U+2B690
+		"[?] Zhou Yan Bian Zi Xia Yong Qiu Bu [?] Yu Sao [?] Lie [?] [?] " + // U+2B6A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B6B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B6C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jian Zhi Fou Huan Jue Long " + // U+2B6D0
+		"Zha Fu Ning Yu Ge Jia Wu [?] Pi Yan Ru Yuan Tu Kuang Bie Fang " + // U+2B6E0
+		"Qi Zhuo Diao Fu Ti Jue Chi Hu Ti [?] Hou Duo Kui Li Chu [?] " + // U+2B6F0
+		"Chen Bi Zhang Yin Zun Huan [?] [?] [?] [?] Wen [?] [?] [?] [?] [?] " + // U+2B700
+		"[?] Feng Tuo [?] Pi Ku [?] [?] [?] Ying [?] [?] [?] [?] [?] Cu " + // U+2B710
+		"[?] [?] [?] [?] [?] [?] [?] [?] Ba Nie Yao [?] Ze Chu Yan Jie " + // U+2B720
+		"Ya [?] Long Nan [?] [?] [?] Shou Hua [?] [?][?][?][?][?][?]" + // U+2B730
+		"[?] [?] [?] [?] [?] [?] [?] [?] Hun [?] [?] Shu [?] [?] [?] [?] " + // U+2B740
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B750
+		"[?] [?] [?] [?] [?] [?] Bei Shu Hui Chou Gong Lai Kui Ying Lan [?] " + // U+2B760
+		"[?] [?] [?] [?] [?] Dao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B770
+		"[?] [?] [?] [?] [?] Xun [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B780
+		"[?] [?] [?] [?] [?] [?] [?] Min [?] [?] Zi Zhu [?] Jue [?] [?] " + // U+2B790
+		"Ling Rong Zhi Chan [?] Bei Zi Lao Lan Men [?] [?] [?] [?] [?] [?] " + // U+2B7A0
+		"[?] [?] [?] [?] [?] [?] [?] Kuai [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B7B0
+		"[?] [?] [?] Ren Hong Liang Chong [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B7C0
+		"[?] Ling [?] [?] [?] Ji [?] [?] [?] [?] [?] [?] [?] [?] Zhi Pan " + // U+2B7D0
+		"Chan Cong Tan [?] Tuo Keng Sui [?] [?] [?] [?] Li Zhi [?] [?] [?] " + // U+2B7E0
+		"[?] [?] Qiu Si Fen Yun Shan Li Shi Hong Kai Zhou Da Zu Zu Suo " + // U+2B7F0
+		"Cu He Ling [?] [?] Ge Kui Xun Bi [?] Xuan Lu Bang [?] [?] Mu " + // U+2B800
+		"Han Sou Zhang [?] [?] [?] [?] [?] [?] [?] [?] [?] Ni [?] [?][?]" + // U+2B810
+		"[?] [?] [?] [?] [?] Lou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B820
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B830
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B840
+		"[?] Yin [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B850
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wo [?] [?] [?] " + // U+2B860
+		"[?] [?] [?] [?] [?] [?] Dan [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B870
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B880
+		"[?] [?] Long [?] Gui [?] [?] [?] Dui Dong [?] [?] [?] [?] [?] [?] " + // U+2B890
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lian [?] Lao Wei [?] [?] " + // U+2B8A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Dan Chong Can [?] [?] [?] [?] [?] " + // U+2B8B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Lan Ai [?] [?] [?] [?] [?] " + // U+2B8C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qian [?] [?] [?] [?] " + // U+2B8D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Su [?] [?] [?] [?] " + // U+2B8E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B8F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B900
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B910
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B920
+		"[?] [?] [?] [?] [?] [?] [?] [?] Mao [?] [?] [?] [?] Gan [?] [?] " + // U+2B930
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yan [?] [?] " + // U+2B940
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B950
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B960
+		"[?] [?] [?] Li [?] Li [?] [?] [?] [?] Ye [?] Ya [?] [?] [?] " + // U+2B970
+		"[?] Suo [?] [?] [?] Xiao [?] [?] [?] Lou [?] [?] Jue [?] [?] [?] " + // U+2B980
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B990
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Liang [?] [?] [?] [?] [?] [?] " + // U+2B9A0
+		"[?] [?] [?] Jue [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B9B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B9C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2B9D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xie " + // U+2B9E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Du " + // U+2B9F0
+		"[?] [?] [?] [?] [?] [?] Xia [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BA00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BA10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BA20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BA30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BA40
+		"[?] [?] [?] [?] [?] Hong [?] [?] [?] [?] Gang Cong [?] [?] [?] [?] " + // U+2BA50
+		"[?] [?] [?] [?] Ye Huo [?] [?] [?] Chu [?] Ye [?] [?] [?] Hai " + // U+2BA60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jin [?] [?] [?] [?] [?] " + // U+2BA70
+		"Guo Lai Yan Li Jian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BA80
+		"[?] [?] [?] [?] [?] [?] [?] [?] Ou [?] Zan [?] [?] [?] [?] [?] " + // U+2BA90
+		"[?] [?] [?] [?] [?] [?] [?] Jiao [?] [?] Zhi [?] [?] [?] [?] [?] " + // U+2BAA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ti [?] [?] Jin [?] [?] " + // U+2BAB0
+		"[?] [?] [?] [?] [?] [?] [?] E [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BAC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BAD0
+		"[?] [?] [?] [?] [?] [?] Dan [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BAE0
+		"[?] [?] [?] [?] [?] Lu [?] [?] [?] [?] [?] [?] [?] [?] Pin [?] " + // U+2BAF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BB00
+		"Sai [?] [?] [?] [?] [?] [?] [?] [?] Gun [?] [?] [?] [?] [?] Die " + // U+2BB10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BB20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BB30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BB40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhuan Ou " + // U+2BB50
+		"[?] [?] Lun [?] [?] [?] [?] [?] Ba [?] Qiao [?] [?] [?] Ce Xun " + // U+2BB60
+		"[?] [?] Yan [?] [?] [?] [?] [?] [?] [?] [?] [?] Lao [?] [?] [?] " + // U+2BB70
+		"[?] [?] [?] Shan [?] Chen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BB80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yin [?] [?] [?] " + // U+2BB90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jiang [?] [?] [?] " + // U+2BBA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BBB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BBC0
+		"[?] [?] Nong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BBD0
+		"[?] [?] [?] [?] [?] Huo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BBE0
+		"[?] [?] [?] [?] [?] [?] Yun [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BBF0
+		"[?] [?] Duo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wei [?] [?] " + // U+2BC00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xing [?] [?] [?] [?] " + // U+2BC10
+		"[?] Hua Hui [?] [?] [?] [?] [?] Mai [?] [?] [?] [?] [?] [?] [?] " + // U+2BC20
+		"Xian [?] [?] [?] [?] [?] [?] [?] [?] Gui [?] [?] [?] [?] [?] [?] " + // U+2BC30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BC40
+		"[?] [?] [?] [?] [?] Lan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BC50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BC60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qia " + // U+2BC70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BC80
+		"[?] [?] [?] [?] [?] [?] [?] Zan [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BC90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BCA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Feng [?] [?] [?] [?] [?] [?] [?] " + // U+2BCB0
+		"[?] [?] [?] Ya [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BCC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BCD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BCE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BCF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BD00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BD10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BD20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BD30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BD40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BD50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BD60
+		"[?] [?] [?] [?] [?] Yang [?] Li Keng [?] [?] [?] [?] [?] [?] [?] " + // U+2BD70
+		"[?] [?] [?] [?] [?] [?] [?] Die [?] [?] Lan [?] [?] [?] [?] [?] " + // U+2BD80
+		"[?] [?] [?] [?] [?] Rong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BD90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BDA0
+		"[?] [?] Keng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BDB0
+		"[?] [?] [?] [?] [?] Kun [?] [?] Xu Xian [?] [?] Lan [?] [?] [?] " + // U+2BDC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BDD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lai [?] Yin [?] " + // U+2BDE0
+		"[?] [?] [?] [?] [?] [?] [?] Xin [?] Lou [?] [?] [?] [?] Ying [?] " + // U+2BDF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BE00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BE10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Kou [?] [?] [?] [?] [?] [?] " + // U+2BE20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BE30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BE40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BE50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yu [?] " + // U+2BE60
+		"[?] [?] [?] [?] Wei [?] [?] [?] [?] [?] [?] [?] Dong Shang [?] [?] " + // U+2BE70
+		"[?] Qie Qie [?] [?] [?] Hua [?] [?] [?] Qi [?] Gong [?] [?] [?] " + // U+2BE80
+		"[?] [?] [?] Men [?] [?] [?] [?] Lao [?] [?] [?] [?] [?] [?] [?] " + // U+2BE90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yang [?] [?] [?] [?] [?] " + // U+2BEA0
+		"[?] [?] [?] [?] [?] [?] [?] Nan [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BEB0
+		"[?] Xi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BEC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BED0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BEE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BEF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BF00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Diao [?] Chou [?] [?] " + // U+2BF10
+		"[?] [?] [?] Pie Ze Hai [?] Hua [?] [?] [?] Bi [?] [?] Nang [?] " + // U+2BF20
+		"[?] Kang [?] [?] [?] Lu Suo [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BF30
+		"Na Lian [?] [?] [?] [?] [?] Huo [?] [?] Bo Luo [?] [?] [?] [?] " + // U+2BF40
+		"Jian [?] [?] [?] [?] [?] [?] [?] [?] Kang [?] [?] [?] [?] [?] [?] " + // U+2BF50
+		"[?] [?] [?] Jiang [?] Qian [?] Fei [?] [?] [?] [?] [?] [?] Dang [?] " + // U+2BF60
+		"[?] [?] Niao Bi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BF70
+		"[?] Yan [?] Xiao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BF80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BF90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BFA0
+		"[?] [?] Li [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BFB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BFC0
+		"[?] [?] [?] [?] [?] [?] [?] Shu [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BFD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BFE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2BFF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C000
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C010
+		"[?] [?] [?] [?] [?] Huo [?] [?] [?] Wei Xian [?] [?] [?] Dui [?] " + // U+2C020
+		"[?] Kai [?] [?] [?] [?] [?] Shang [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C030
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C040
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C050
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C060
+		"[?] [?] [?] [?] [?] Ou [?] [?] [?] [?] Ya [?] [?] Sun [?] [?] " + // U+2C070
+		"Run [?] Luo [?] [?] Dan [?] [?] [?] Sheng [?] [?] [?] [?] [?] [?] " + // U+2C080
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C090
+		"Mo [?] [?] [?] [?] [?] [?] [?] [?] Jia [?] [?] [?] [?] Sha [?] " + // U+2C0A0
+		"Nong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C0B0
+		"Gui [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhi [?] [?] [?] [?] [?] " + // U+2C0C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Er [?] [?] [?] [?] [?] [?] [?] " + // U+2C0D0
+		"[?] [?] [?] [?] [?] [?] Jian [?] [?] [?] [?] Xian [?] [?] Fei [?] " + // U+2C0E0
+		"[?] [?] Lin Yan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C0F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C100
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C110
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Yin [?] [?] [?] [?] [?] [?] " + // U+2C120
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C130
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C140
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C150
+		"[?] [?] Xia [?] [?] Gua [?] [?] [?] [?] [?] Pen [?] [?] [?] [?] " + // U+2C160
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C170
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C180
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Nao [?] [?] [?] [?] " + // U+2C190
+		"[?] [?] [?] [?] [?] [?] Ji [?] [?] [?] [?] [?] [?] [?] Kou [?] " + // U+2C1A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mao [?] " + // U+2C1B0
+		"[?] [?] [?] Jian Bin [?] [?] Die [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C1C0
+		"[?] [?] [?] [?] [?] Wan [?] [?] Tuan Bei [?] [?] [?] [?] [?] [?] " + // U+2C1D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C1E0
+		"Men [?] [?] [?] [?] [?] [?] [?] [?] Guo [?] [?] [?] [?] [?] [?] " + // U+2C1F0
+		"[?] Shan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C200
+		"[?] [?] [?] [?] [?] Fei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C210
+		"[?] [?] [?] [?] [?] [?] [?] Se [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C220
+		"[?] Po [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Hua [?] " + // U+2C230
+		"[?] [?] Fen [?] [?] [?] [?] Sha [?] [?] [?] Wei [?] [?] [?] [?] " + // U+2C240
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C250
+		"Lan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C260
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ou [?] [?] [?] " + // U+2C270
+		"[?] [?] Chao [?] [?] [?] [?] [?] Xun Da [?] [?] [?] Chou Gong [?] " + // U+2C280
+		"[?] [?] [?] [?] [?] [?] Mei Jian [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C290
+		"[?] [?] [?] [?] Chan [?] Shan [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C2A0
+		"[?] [?] [?] [?] [?] Tang Lan [?] [?] [?] Wei [?] [?] [?] Ran [?] " + // U+2C2B0
+		"[?] [?] [?] Lian [?] [?] [?] [?] [?] [?] [?] [?] [?] Lai [?] [?] " + // U+2C2C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C2D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C2E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C2F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C300
+		"[?] [?] [?] [?] [?] [?] [?] He [?] [?] [?] [?] [?] Chu [?] [?] " + // U+2C310
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yao [?] " + // U+2C320
+		"[?] [?] [?] [?] [?] [?] [?] Shan [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C330
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C340
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Zhan [?] Li [?] [?] [?] [?] " + // U+2C350
+		"[?] Dang [?] [?] Xun [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C360
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C370
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C380
+		"[?] Lan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C390
+		"[?] [?] [?] [?] [?] [?] [?] Cong [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C3A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C3B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C3C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ma [?] [?] Ji " + // U+2C3D0
+		"[?] [?] [?] [?] [?] [?] Fei [?] [?] [?] [?] Wen [?] [?] Guo [?] " + // U+2C3E0
+		"[?] [?] [?] [?] [?] [?] [?] Fen [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C3F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C400
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Hai [?] [?] [?] [?] [?] " + // U+2C410
+		"Yang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C420
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C430
+		"[?] [?] [?] [?] [?] [?] Gun Chang [?] [?] [?] [?] [?] Chou [?] Ying " + // U+2C440
+		"[?] [?] Kou Ye Zhong Gun [?] Jian [?] Mai [?] [?] [?] [?] [?] [?] " + // U+2C450
+		"[?] Chou [?] [?] [?] [?] [?] Mian [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C460
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C470
+		"[?] [?] [?] [?] [?] [?] Ye Lan Que [?] [?] [?] [?] Yun Keng [?] " + // U+2C480
+		"[?] [?] [?] [?] Geng Su [?] Lan Xin [?] [?] [?] [?] [?] [?] [?] " + // U+2C490
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C4A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C4B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C4C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C4D0
+		"Ji [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qiu [?] [?] [?] [?] " + // U+2C4E0
+		"[?] Ben [?] [?] [?] [?] [?] [?] Shai [?] [?] [?] Tui [?] [?] [?] " + // U+2C4F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C500
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C510
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ou " + // U+2C520
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C530
+		"[?] [?] Long [?] Si [?] [?] [?] [?] [?] Jian [?] [?] [?] [?] [?] " + // U+2C540
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ku [?] [?] [?] [?] " + // U+2C550
+		"[?] [?] [?] [?] [?] [?] Ou [?] [?] [?] [?] [?] Ling [?] [?] [?] " + // U+2C560
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C570
+		"[?] [?] [?] Zuan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C580
+		"[?] Xian [?] [?] [?] [?] Chou [?] Shi [?] [?] [?] [?] [?] [?] [?] " + // U+2C590
+		"Lou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xian [?] " + // U+2C5A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C5B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C5C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C5D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C5E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C5F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C600
+		"[?] [?] [?] Xun Mie Qiu Jian [?] Dan Yue Wa Qu Zhan Zhen Diao Xian " + // U+2C610
+		"Gai Yin Kuang [?] Lu Quan Pai Qi Zhi Ting Lian Huan Qian Chen Mi Zhun " + // U+2C620
+		"Ruan Yao Zha Xu [?] Geng Qiu Ci Chi [?] Tao Xia Sui Zhi Shuang [?] " + // U+2C630
+		"[?] Yan Yan Qian [?] Zhu Fan Ran Lin Dan Mo Xiang [?] [?] Lu [?] " + // U+2C640
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Bi [?] [?] " + // U+2C650
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wei [?] [?] " + // U+2C660
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C670
+		"[?] [?] [?] [?] Su [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C680
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C690
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C6A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C6B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C6C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C6D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C6E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Nie Dang [?] [?] Yu [?] [?] [?] " + // U+2C6F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C700
+		"[?] [?] [?] [?] Da [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C710
+		"[?] [?] [?] [?] [?] Lan [?] Fa Hui [?] [?] [?] Man [?] [?] Liang " + // U+2C720
+		"[?] [?] [?] [?] [?] [?] [?] [?] Huai [?] [?] [?] [?] [?] Xian Guo " + // U+2C730
+		"[?] Yu [?] Lao [?] [?] [?] [?] [?] [?] Si Jie [?] [?] [?] [?] " + // U+2C740
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C750
+		"Zei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Pin " + // U+2C760
+		"[?] [?] [?] [?] Ran [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C770
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jian [?] [?] [?] [?] " + // U+2C780
+		"[?] [?] [?] [?] [?] Lan [?] [?] Tui [?] [?] [?] [?] [?] [?] Pin " + // U+2C790
+		"[?] [?] [?] Sen [?] [?] [?] [?] [?] [?] [?] Han [?] [?] [?] [?] " + // U+2C7A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C7B0
+		"[?] Yi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C7C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C7D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xi [?] [?] [?] [?] [?] " + // U+2C7E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Cong [?] [?] Dong [?] [?] " + // U+2C7F0
+		"[?] [?] [?] Bi [?] Dang [?] [?] Gong [?] [?] [?] [?] [?] [?] [?] " + // U+2C800
+		"Wang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C810
+		"Zei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C820
+		"[?] [?] [?] [?] [?] [?] [?] Tuo [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C830
+		"[?] [?] [?] [?] [?] [?] [?] Niao [?] [?] [?] [?] [?] Diao Zhou [?] " + // U+2C840
+		"[?] [?] Jia Die Shi Shai [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C850
+		"Luo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C860
+		"[?] [?] [?] [?] [?] [?] [?] Zan [?] [?] [?] Chan [?] [?] [?] [?] " + // U+2C870
+		"[?] [?] [?] [?] [?] [?] [?] Cha Dong Ci Si Tiao Zhi [?] Du Dan " + // U+2C880
+		"Qi Ying Ming [?] Deng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C890
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhou [?] [?] [?] [?] Ying " + // U+2C8A0
+		"[?] [?] [?] Can [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C8B0
+		"Wei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C8C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Xu Kou Zhi Hao Jun Zhu Tou " + // U+2C8D0
+		"Gan Jian Tuo Zhu Jian Tao Yao Fei Ya Wei Hui [?] Hui Dang Ming Ren " + // U+2C8E0
+		"Hui Zhou Pu Hen [?] [?] Zha Cu Han Cu [?] Ting Lang Lian Ze Lao " + // U+2C8F0
+		"Hao Tao Shan [?] Can Ji Gun Yin [?] Hui Shi Cong Zha Huang Xuan Duo " + // U+2C900
+		"Ge Ge He Chi [?] Ta Gun [?] Chi Hu Ying Chan Zha Hui Yi Liao " + // U+2C910
+		"Yan Ao Zun Dui Zeng Zhuan Yi [?] Zao Ai Ta Hao Tuan Hui Zan Jian " + // U+2C920
+		"Huan Tui [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C930
+		"[?] [?] [?] [?] Zhe [?] [?] [?] Mao [?] [?] [?] [?] [?] [?] [?] " + // U+2C940
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C950
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C960
+		"[?] [?] [?] Cang Han Yi Bi Zheng Chuan [?] Yan Ruan Duan Hou Lan Zhan " + // U+2C970
+		"[?] [?] [?] [?] [?] Tang Tuan [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C980
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C990
+		"[?] [?] [?] Li [?] Bei [?] Lun [?] Zou [?] Duo [?] [?] [?] Xie " + // U+2C9A0
+		"[?] [?] [?] [?] Bing Zhang [?] [?] [?] [?] [?] Guan [?] [?] [?] [?] " + // U+2C9B0
+		"Qiang [?] [?] Teng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C9C0
+		"[?] Nian [?] [?] [?] [?] [?] [?] [?] [?] Dian [?] [?] [?] [?] [?] " + // U+2C9D0
+		"[?] [?] Long [?] Lao [?] [?] [?] [?] Guo [?] [?] [?] [?] [?] [?] " + // U+2C9E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2C9F0
+		"[?] Mao Qi [?] Yang Nian Gong Kai Wan Tian [?] Zang Pi Ge You Xu " + // U+2CA00
+		"Hui Sang Guang Chao Bei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CA10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CA20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CA30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Huo [?] " + // U+2CA40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CA50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CA60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xun Lai [?] " + // U+2CA70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Mao [?] [?] " + // U+2CA80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CA90
+		"[?] [?] [?] [?] [?] [?] [?] Yu Zhan Nong [?] [?] [?] [?] [?] [?] " + // U+2CAA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Guang [?] [?] [?] [?] [?] " + // U+2CAB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CAC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CAD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CAE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CAF0
+		"[?] [?] [?] [?] [?] [?] [?] Zhe [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CB00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CB10
+		"[?] [?] [?] [?] [?] [?] [?] Han Hua Yi Kou Pi Wei Lun Chang Ren " + // U+2CB20
+		"Qiang Jin Pi Qi [?] Chen [?] Shi Shu Shen Chu Lu [?] Zuo Ning Zhao " + // U+2CB30
+		"Si Mu Hong Pi [?] Xiang Duo Guo Cha Ji Du [?] Hua Han Hong Yang " + // U+2CB40
+		"[?] Zi [?] Tian Chuo Tao Peng Nei [?] [?] Chun Bo Lei San Da Cou " + // U+2CB50
+		"Cha Zhao Kui Xian Hou Cong Nou [?] Chui Weng Xia Zhan Hui Qi [?] Pie " + // U+2CB60
+		"Yi [?] Suo Xi Jie [?] Hei Cheng Lin [?] Biao Jiao Sui Bi [?] Ji " + // U+2CB70
+		"[?] Bo [?] Zuan Jiao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CB80
+		"[?] [?] [?] [?] [?] [?] [?] [?] Huo [?] [?] [?] [?] [?] [?] Lin " + // U+2CB90
+		"Xia Jian Hong Kuang He Chu [?] [?] Huo Qi Sha [?] [?] Kui [?] Que " + // U+2CBA0
+		"E Yin Yao Ban An Xian [?] [?] [?] Deng Ma Wu [?] [?] [?] Gai " + // U+2CBB0
+		"Ji [?] [?] [?] [?] Xia [?] [?] [?] [?] [?] [?] [?] [?] Tui [?] " + // U+2CBC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CBD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CBE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CBF0
+		"[?] [?] [?] Mu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CC00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CC10
+		"[?] Luo [?] Jian Zhou Liang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CC20
+		"[?] Han [?] Die [?] [?] Sui Chan Yu [?] Ye [?] [?] [?] [?] [?] " + // U+2CC30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CC40
+		"[?] [?] [?] Kui You Mo Di Dan [?] Pan [?] Zhuan Lei Gen [?] Wei " + // U+2CC50
+		"Kuo [?] Wen Chen [?] Hong Qi [?] [?] [?] Yi Ding San Po Ao Shou " + // U+2CC60
+		"Pi Zhan [?] Yu [?] Wei [?] Xue Xue [?] [?] [?] Kai Xuan [?] Guo " + // U+2CC70
+		"Hu [?] [?] [?] [?] Liao Yu [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CC80
+		"[?] [?] [?] [?] [?] Zan [?] [?] [?] [?] [?] [?] Cheng [?] [?] [?] " + // U+2CC90
+		"[?] [?] [?] [?] [?] [?] Si [?] [?] [?] Chuang Nian [?] Jie Ti Gou " + // U+2CCA0
+		"Shang [?] Ning Yi Man [?] Ci En Guo Mang Bao Ti Ye [?] Hu [?] " + // U+2CCB0
+		"Ye Yao Zhui Pi [?] Sui Jian [?] [?] Da Tao Xi Qiu Tang [?] [?] " + // U+2CCC0
+		"Chong Ying Deng Yong Mo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Fen " + // U+2CCD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CCE0
+		"[?] [?] Chan Fan Pei Pi Jiong Gua Wo [?] [?] Kuang [?] Shen Chi Tu " + // U+2CCF0
+		"Lang An Fei Huo [?] Peng Qi Chi [?] [?] Lin Tie Meng Biao Tuo Jian " + // U+2CD00
+		"Huan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CD10
+		"[?] [?] [?] [?] [?] [?] [?] [?] Nao Nang [?] [?] [?] [?] [?] [?] " + // U+2CD20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CD30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CD40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CD50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CD60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CD70
+		"Jie Ren Du [?] Diao [?] Bang Jie [?] Wu Geng Ju Dai Tuo Jie Wei " + // U+2CD80
+		"Zhao [?] [?] Ting Kao Ti [?] Lao [?] [?] [?] Shan [?] [?] Hu La " + // U+2CD90
+		"Lian [?] Xing Zha Ti [?] You Rou Ji [?] Ni Huang Qu Ji Xi Guo " + // U+2CDA0
+		"[?] Jing Xiang [?] Pu Guan [?] [?] [?] [?] Guan Ba [?] [?] [?] [?] " + // U+2CDB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CDC0
+		"[?] [?] [?] [?] [?] Bu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CDD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CDE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yi Xiao Hong [?] " + // U+2CDF0
+		"Wen Wa Ge [?] [?] Chu Sheng [?] Chi Qiong Ren [?] Sha Chou Li Lang " + // U+2CE00
+		"Chuang Yue Qi [?] [?] Ying Yan [?] Yan Miao Yue Huang Pian An Lu [?] " + // U+2CE10
+		"Ge Lan Gao Xian Piao Chong Zhuo Yan Qi Ao Fan [?] Kou Ya Kui Pi " + // U+2CE20
+		"He Qu [?] [?] [?] Zhan Chang Cou Bian Gan [?] [?] [?] [?] Qi [?] " + // U+2CE30
+		"[?] [?] [?] [?] [?] Cai [?] Bo [?] Hun [?] Hun Nie Mo Shan [?] " + // U+2CE40
+		"[?] [?] [?] [?] [?] Zhen Nong Lai Teng [?] [?] [?] [?] [?] [?] [?] " + // U+2CE50
+		"[?] [?] [?] Zhu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CE60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ya Na Xie Hang [?] [?] " + // U+2CE70
+		"Zha Zhi Nie Ai Kuo Chan [?] Cuo Yi Ze Yun Zu Ya Jian Ci Bo " + // U+2CE80
+		"[?] [?] Yin Chu Jin Cha Jue [?] [?] [?] [?] [?] Long [?] [?] Yao " + // U+2CE90
+		"[?] [?] [?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2CEA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CEB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CEC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CED0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CEE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2CEF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D000
+		"[?] [?] [?] [?] [?] [?] Li [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D010
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D020
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D030
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D040
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D050
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D060
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D070
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D080
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D090
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D0A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D0B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D0C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D0D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D0E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D0F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D100
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jian [?] [?] [?] [?] " + // U+2D110
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D120
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D130
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D140
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D150
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D160
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D170
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D180
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D190
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D1A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D1B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D1C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Po [?] [?] [?] " + // U+2D1D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Liu " + // U+2D1E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D1F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Mu Ying [?] [?] [?] [?] [?] [?] " + // U+2D200
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D210
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Huan [?] " + // U+2D220
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D230
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D240
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D250
+		"[?] [?] [?] [?] [?] [?] [?] [?] Zui [?] [?] [?] [?] [?] [?] [?] " + // U+2D260
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D270
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D280
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D290
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D2A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] La [?] [?] [?] [?] [?] [?] [?] " + // U+2D2B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D2C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D2D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D2E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D2F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D300
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D310
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D320
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D330
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D340
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D350
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D360
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D370
+		"[?] [?] Zong [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D380
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Dao [?] [?] [?] " + // U+2D390
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D3A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D3B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D3C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D3D0
+		"[?] [?] [?] [?] [?] [?] Ai [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D3E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Wei [?] [?] [?] [?] [?] [?] [?] " + // U+2D3F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D500
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D510
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D520
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D530
+		"[?] [?] [?] [?] [?] [?] Suo [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D540
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D550
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D560
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D570
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D580
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D590
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D5A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D5B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D5C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D5D0
+		"[?] Ke [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D5E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D5F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D600
+		"[?] [?] [?] Hu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D610
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D620
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D630
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D640
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D650
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D660
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D670
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D680
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D690
+		"[?] [?] [?] [?] [?] [?] Guo [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D6A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D6B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D6C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D6D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D6E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D6F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D700
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D710
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D720
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D730
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ling [?] [?] [?] [?] " + // U+2D740
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D750
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D760
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D770
+		"[?] [?] [?] [?] Ai [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D780
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D790
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D7A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D7B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D7C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D7D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D7E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D7F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D800
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Pu [?] [?] [?] [?] [?] [?] " + // U+2D810
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D820
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D830
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D840
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Shen [?] [?] [?] " + // U+2D850
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D860
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D870
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D880
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D890
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D8A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D8B0
+		"[?] [?] [?] [?] [?] [?] [?] Dian [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D8C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D8D0
+		"[?] [?] [?] [?] [?] [?] [?] Yi [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D8E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D8F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Li [?] " + // U+2D900
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D910
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D920
+		"Yu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D930
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D940
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D950
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D960
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D970
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D980
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D990
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D9A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D9B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Tang [?] [?] [?] [?] " + // U+2D9C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D9D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D9E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2D9F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DA00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DA10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DA20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DA30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DA40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yi Men [?] [?] [?] [?] " + // U+2DA50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DA60
+		"Fa [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DA70
+		"[?] [?] [?] [?] [?] [?] Zhua [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DA80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DA90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DAA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DAB0
+		"Kui [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DAC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Di [?] [?] [?] [?] [?] [?] " + // U+2DAD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DAE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DAF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DB00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DB10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DB20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DB30
+		"[?] [?] [?] [?] [?] [?] [?] [?] Ya [?] [?] [?] [?] [?] [?] [?] " + // U+2DB40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DB50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DB60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DB70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DB80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DB90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DBA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DBB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DBC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DBD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DBE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DBF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Dang [?] " + // U+2DC00
+		"[?] [?] Sha [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DC10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DC20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DC30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Hong [?] [?] [?] [?] [?] " + // U+2DC40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DC50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DC60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DC70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DC80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DC90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhu [?] [?] [?] [?] " + // U+2DCA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DCB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DCC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DCD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DCE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DCF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wu [?] [?] [?] [?] [?] " + // U+2DD00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DD10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DD20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DD30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DD40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DD50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DD60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DD70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DD80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DD90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DDA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DDB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DDC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DDD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DDE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DDF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DE00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DE10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DE20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DE30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DE40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Luo [?] [?] [?] " + // U+2DE50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DE60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DE70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DE80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DE90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DEA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DEB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DEC0
+		"[?] [?] [?] [?] Lai [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DED0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DEE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2DEF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E000
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E010
+		"[?] [?] [?] [?] Lao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E020
+		"[?] [?] Geng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E030
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E040
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E050
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E060
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E070
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E080
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E090
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E0A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E0B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E0C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E0D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E0E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E0F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E100
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E110
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E120
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E130
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E140
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E150
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E160
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E170
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lan " + // U+2E180
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E190
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E1A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E1B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E1C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E1D0
+		"[?] [?] [?] [?] Ling [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E1E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E1F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E200
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E210
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E220
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E230
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E240
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E250
+		"Qiu Lu Zhi Zuo Gua [?] [?] Liang Gua [?] Zou Rui Cui [?] Sui Cai " + // U+2E260
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E270
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E280
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E290
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E2A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E2B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E2C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E2D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E2E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E2F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E300
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E310
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E320
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E330
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E340
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E350
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E360
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E370
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E380
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E390
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E3A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E3B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E3C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E3D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E3E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Du [?] [?] [?] [?] [?] " + // U+2E3F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E400
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Fan [?] [?] [?] [?] [?] " + // U+2E410
+		"[?] [?] [?] [?] [?] [?] [?] [?] Ying [?] [?] [?] [?] [?] [?] [?] " + // U+2E420
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E430
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E440
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E450
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E460
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E470
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E480
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E490
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E4A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E4B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E4C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E4D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E4E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E4F0
+		"[?] [?] Jie [?] [?] Fu [?] [?] [?] [?] Liang [?] [?] [?] [?] [?] " + // U+2E500
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E510
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E520
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E530
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E540
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E550
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E560
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E570
+		"[?] Liang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E580
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E590
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E5A0
+		"[?] Xie [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E5B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E5C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E5D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E5E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E5F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E600
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E610
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E620
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E630
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yin Du [?] [?] [?] [?] " + // U+2E640
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E650
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E660
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E670
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E680
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E690
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E6A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E6B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E6C0
+		"[?] [?] [?] [?] [?] [?] [?] Tang [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E6D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E6E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E6F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E700
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E710
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E720
+		"[?] [?] [?] [?] [?] [?] Dao [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E730
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E740
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E750
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E760
+		"[?] [?] [?] [?] Gu Er [?] Fen Chun Huan Ge [?] [?] [?] [?] [?] " + // U+2E770
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E780
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E790
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E7A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E7B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E7C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E7D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E7E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E7F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E800
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qiang [?] " + // U+2E810
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E820
+		"[?] [?] [?] Chen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E830
+		"[?] [?] [?] [?] [?] [?] [?] [?] Ke [?] [?] [?] [?] [?] [?] [?] " + // U+2E840
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E850
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E860
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E870
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E880
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E890
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E8A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E8B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E8C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E8D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E8E0
+		"[?] [?] Gang Hong Chan Zhui Lu [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E8F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E900
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E910
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Men Chu [?] [?] Rui " + // U+2E920
+		"[?] [?] Bi [?] [?] [?] [?] [?] E [?] [?] [?] [?] [?] [?] [?] " + // U+2E930
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E940
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E950
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E960
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E970
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E980
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E990
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E9A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E9B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E9C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E9D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E9E0
+		"[?] [?] [?] [?] Chang Wei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2E9F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EA00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EA10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EA20
+		"[?] [?] [?] [?] Feng Liu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EA30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EA40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Bi Hai Ai Yi [?] " + // U+2EA50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EA60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EA70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EA80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EA90
+		"[?] Mang Hai Zong Cao Dun [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EAA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EAB0
+		"[?] [?] Ning [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EAC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EAD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EAE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EAF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EB00
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xu Yi Gui Can Huo " + // U+2EB10
+		"Lu Hua Weng Xian Zhen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EB20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EB30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EB40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EB50
+		"[?] Jie Yao [?] Mie Gong Chen [?] Yan [?] Shuang [?] [?] [?] [?] [?] " + // U+2EB60
+		"Gang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EB70
+		"[?] [?] [?] [?] [?] [?] [?] Mou [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EB80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EB90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EBA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EBB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EBC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Ke [?] [?] [?] [?] [?] [?] " + // U+2EBD0
+		"[?] [?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2EBE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+2EBF0
+		"Li Wan Yi [?] Ni Wu Tui Bing Za Bei Seng Xiang Jun Suo Mian Tu " + // U+2F800
+		"Huang Ju [?] [?] Nei Zai [?] Rong Yuan Bing Dong Kuang Qing Qian Ren Pi " + // U+2F810
+		"Ke Luo Ge Chan Ji Yong Mian Qin Shao Bao Cong Bei Hui Bei Bo Ji " + // U+2F820
+		"Ji Qing Qing Qing [?] Hui Ji Sou [?] Jiao Chi Yao Xian Xi Cheng Zhou " + // U+2F830
+		"E Mie Tang Qi Xian Shan Shan Hui Chi Zha Yao Tu Tan Tu Hao Pen " + // U+2F840
+		"Qie Zhuang Cheng Zhi Tu Xing Ci Bao Di [?] Mai Hu Feng Duo Meng She " + // U+2F850
+		"Chi [?] Ji Yu Tui Pin Fu Sao Cha Rao Lan Lan [?] Yuan Zhi Ning " + // U+2F860
+		"Bao Lao Shou Jiang Dang You Yao Tu Che Xiu Qian Wu Yan [?] Dian Zi " + // U+2F870
+		"Chan Xun Chao Yi Xun Shui Mao Fen Man [?] Yi Bing Bi Shu Lang Shuo " + // U+2F880
+		"Gong [?] [?] Yu Tao Tao Yi [?] [?] Xing Diao Shan Dong Ren Zhi Kuang " + // U+2F890
+		"Yuan Huang Jue Hui Dong Dun Ci Huang Shen Huang Lou Zeng Xian Fen Can Meng " + // U+2F8A0
+		"Cheng Lan Cheng Jia Ku Bao Ba Juan [?] Wan Pin She Sao Ji [?] Jin " + // U+2F8B0
+		"Yan Yan Dou Mo Jiang Hui Jiao [?] Min Jing [?] Ji Shu Jin Wei Shu " + // U+2F8C0
+		"Wen Chang Mao Mian Zui Pu Na Tan Lang Wang Zong Qi Biao [?] Hua Guai " + // U+2F8D0
+		"Ji Sang Mei [?] Ao Ben Zi He Zha Yao Gai She [?] Zhi Yi Ci " + // U+2F8E0
+		"[?] Xu Kun Sui Wen Sha Qiao [?] [?] [?] Fan [?] Yan Ben Qian Wu " + // U+2F8F0
+		"Pai Hai Liu Hao Jin Nie Ben Ping Gang Yan Long Zi Dian Sha Yan Chao " + // U+2F900
+		"[?] [?] Fen Yue Jing Ying Shu Qian Zai Zhuan Tan [?] Duan [?] Cong [?] " + // U+2F910
+		"Cuan Jue Zha [?] Xi Bei Yan [?] Ta Wang Gong Yue Ping Ping Dai Yu " + // U+2F920
+		"Zhen Suo Qiong Xing Rui [?] Zai [?] Yi Ping Yu [?] [?] [?] Yan Ji " + // U+2F930
+		"Zhi [?] [?] [?] [?] Zhen Zhen Zhen Juan Jie Chen Ye Shi [?] Xing Lu " + // U+2F940
+		"Tian Hui Jiu Zu Chi Liu Fu Shu Jian Gu Ji Wen [?] Tian Tian Ping " + // U+2F950
+		"Ping [?] Zhuan Zhu Wei Chao Bei Xi Jiang San Ji [?] Beng Yao Zi Zong " + // U+2F960
+		"Sao Luo Xing [?] Mei [?] Chao [?] Yang Ao Zhe [?] [?] Ping [?] Cong " + // U+2F970
+		"Ken Ren Yu Cui Cong Pi Ying [?] [?] Xuan [?] Yu Xi Ci Beng Qi " + // U+2F980
+		"Yu Zhi Lao Hua Fang Ya Ku [?] Ruo Chai Rong Jie Wu Mang Di Zhe " + // U+2F990
+		"Ping Ju Jun Cai [?] Suo [?] [?] Ping Jin Cuo [?] Rui [?] Cheng Lu " + // U+2F9A0
+		"Chao Lu Kui Nue Lu Kui Xi Chi Qian Yuan Ping Yun Die Zhu Ci Ji " + // U+2F9B0
+		"Gui Xiang Ci Zhun Yi Jian Liu Shui Lu Yan Mao [?] [?] Nin Yuan Cheng " + // U+2F9C0
+		"Yu Bian Shi Jian Guan Bi Gan Qi Shu Zhao Ba Jian Pian [?] Ren Shu " + // U+2F9D0
+		"[?] [?] Qi Ping Zi [?] Chao Bo Xuan Hua Bing Qiang Zan [?] Kai Peng " + // U+2F9E0
+		"Shai [?] Wei Qian Xi Yun Pang Ping Lu Chan Bi [?] Kun Yan E E " + // U+2F9F0
+		"Ping Xue Ji Ban E Yun Bao Tui Gan Qiong Zhen Ji Jian Jiu Ji Pi " + // U+2FA00
+		"Yu Ma Sha [?] [?] Ma Qian Zhi Mian Zhi Mi Fen Bi Pian [?][?]" + // U+2FA10
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FA20
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FA30
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FA40
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FA50
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FA60
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FA70
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FA80
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FA90
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FAA0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FAB0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FAC0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FAD0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FAE0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+2FAF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30000
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30010
+		"[?] Qian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30020
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30030
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30040
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30050
+		"[?] [?] [?] [?] [?] [?] [?] Song [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30060
+		"[?] [?] [?] [?] [?] [?] [?] [?] Dang [?] [?] [?] [?] [?] Fu [?] " + // U+30070
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xian [?] [?] Xian Yu " + // U+30080
+		"[?] [?] [?] [?] [?] [?] [?] Di [?] [?] [?] [?] Tui [?] [?] [?] " + // U+30090
+		"[?] [?] [?] [?] [?] [?] Lu [?] [?] [?] [?] [?] [?] Si [?] [?] " + // U+300A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+300B0
+		"[?] [?] [?] [?] [?] [?] Zan [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+300C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+300D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xue [?] " + // U+300E0
+		"[?] [?] [?] [?] [?] [?] [?] Chuang [?] [?] [?] Bi [?] [?] [?] Du " + // U+300F0
+		"[?] Shen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30100
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Tuan [?] " + // U+30110
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30120
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30130
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30140
+		"[?] [?] [?] [?] Lu [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30150
+		"[?] [?] [?] [?] [?] Du Dan [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30160
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xia [?] [?] [?] [?] " + // U+30170
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30180
+		"[?] [?] [?] [?] [?] Wei [?] [?] [?] [?] Lan [?] [?] [?] [?] [?] " + // U+30190
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+301A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+301B0
+		"Gai [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Dong [?] " + // U+301C0
+		"[?] [?] [?] [?] [?] Jia Hong [?] Ji [?] [?] [?] [?] [?] [?] [?] " + // U+301D0
+		"[?] He [?] Xi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+301E0
+		"[?] [?] Tan [?] [?] [?] [?] [?] [?] [?] [?] [?] Shan [?] [?] [?] " + // U+301F0
+		"[?] [?] [?] [?] [?] [?] Lan Chang [?] [?] Dian [?] [?] Chen [?] [?] " + // U+30200
+		"[?] [?] [?] Lan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30210
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Za " + // U+30220
+		"[?] [?] [?] [?] [?] [?] Mo [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30230
+		"[?] Yan [?] [?] Za [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30240
+		"[?] [?] [?] [?] [?] [?] [?] [?] Wei Gang Xian [?] [?] [?] [?] [?] " + // U+30250
+		"[?] [?] [?] Yi [?] Jia [?] [?] [?] Que Ye [?] [?] [?] [?] [?] " + // U+30260
+		"[?] Jian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Xi [?] [?] " + // U+30270
+		"[?] [?] Zhi [?] [?] Zhan [?] [?] Qiang [?] [?] [?] [?] [?] [?] [?] " + // U+30280
+		"[?] Xian [?] [?] [?] [?] [?] [?] [?] [?] [?] Kui [?] [?] [?] Man " + // U+30290
+		"[?] Yan Qian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+302A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+302B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+302C0
+		"[?] [?] [?] [?] [?] [?] Lou [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+302D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+302E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Dang Zhuan [?] [?] [?] Yan Bi [?] " + // U+302F0
+		"Ying [?] Zheng [?] [?] [?] Qian Ze [?] Yun [?] [?] [?] [?] [?] [?] " + // U+30300
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Lan [?] [?] [?] [?] [?] [?] " + // U+30310
+		"[?] [?] [?] [?] [?] [?] Ya [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30320
+		"[?] [?] [?] [?] [?] [?] [?] Wei [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30330
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30340
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30350
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30360
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30370
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Song [?] Long Dong " + // U+30380
+		"Lu Ye [?] [?] Yao [?] Ze [?] [?] [?] [?] Kuai [?] [?] Nao [?] " + // U+30390
+		"Yan [?] Wei [?] [?] [?] [?] [?] [?] [?] [?] Yue [?] [?] [?] [?] " + // U+303A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Yan [?] [?] [?] [?] [?] [?] " + // U+303B0
+		"[?] Cuan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+303C0
+		"[?] [?] [?] Wu [?] San [?] [?] [?] [?] [?] [?] Lou [?] [?] Lan " + // U+303D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+303E0
+		"[?] [?] Ma [?] [?] [?] Kuai [?] [?] [?] [?] [?] Dai [?] [?] [?] " + // U+303F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30400
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30410
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30420
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Kuang [?] " + // U+30430
+		"[?] Tuan [?] [?] Lun [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30440
+		"[?] [?] [?] [?] Zhi Yan [?] [?] [?] Liu [?] [?] [?] [?] [?] Gong " + // U+30450
+		"[?] [?] [?] [?] [?] Xian [?] Can [?] [?] Sheng Luo Zhi [?] [?] [?] " + // U+30460
+		"[?] [?] [?] [?] [?] Di [?] [?] Ye [?] [?] [?] [?] [?] [?] Tang " + // U+30470
+		"[?] [?] [?] [?] [?] [?] Man [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30480
+		"[?] [?] Chi [?] [?] [?] Miao [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30490
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+304A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+304B0
+		"[?] [?] [?] [?] Cheng [?] Wu [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+304C0
+		"[?] [?] [?] [?] Shan [?] [?] [?] [?] Ye [?] [?] Zhi [?] [?] Za " + // U+304D0
+		"[?] [?] [?] [?] [?] [?] [?] Sun [?] [?] [?] [?] Li [?] [?] [?] " + // U+304E0
+		"[?] Keng [?] [?] [?] [?] [?] Ruan [?] [?] [?] Gui Chan [?] [?] [?] " + // U+304F0
+		"[?] [?] [?] [?] [?] [?] [?] Di [?] [?] [?] Gui [?] [?] [?] [?] " + // U+30500
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30510
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30520
+		"[?] [?] Qian [?] [?] [?] Yu [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30530
+		"[?] Xun [?] [?] [?] Qu [?] [?] Jiao [?] [?] [?] [?] [?] [?] [?] " + // U+30540
+		"Xie [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30550
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Kuai [?] [?] " + // U+30560
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30570
+		"[?] [?] [?] [?] [?] [?] [?] [?] Yan [?] [?] [?] [?] [?] [?] [?] " + // U+30580
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30590
+		"Nan [?] [?] [?] [?] [?] [?] [?] [?] Li [?] [?] [?] [?] [?] [?] " + // U+305A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+305B0
+		"[?] [?] [?] [?] [?] [?] Hui [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+305C0
+		"[?] [?] [?] Huang [?] [?] Lun [?] [?] Chou Xie Zhai Yan [?] [?] [?] " + // U+305D0
+		"[?] Chan Hui [?] [?] [?] Jin [?] Shi [?] [?] [?] Qian [?] [?] [?] " + // U+305E0
+		"[?] [?] [?] [?] [?] Shen [?] [?] [?] Su Fen [?] [?] [?] [?] [?] " + // U+305F0
+		"Dai [?] [?] [?] [?] [?] [?] [?] Ju [?] [?] [?] [?] [?] [?] [?] " + // U+30600
+		"[?] [?] [?] Qing [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30610
+		"Yan [?] [?] Mian [?] [?] [?] [?] [?] Xiao [?] [?] [?] [?] [?] [?] " + // U+30620
+		"[?] [?] [?] Lai [?] [?] Su [?] Sou [?] [?] [?] [?] [?] [?] [?] " + // U+30630
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wu [?] [?] Han [?] " + // U+30640
+		"[?] Xiao [?] [?] [?] Lou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30650
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30660
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30670
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30680
+		"[?] [?] [?] [?] Ning [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30690
+		"[?] [?] [?] [?] [?] [?] Lu [?] [?] [?] Ran [?] [?] [?] [?] [?] " + // U+306A0
+		"[?] Ye [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+306B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ma [?] [?] [?] [?] Cong " + // U+306C0
+		"[?] [?] Dong [?] [?] [?] [?] [?] [?] [?] [?] Shan [?] [?] [?] [?] " + // U+306D0
+		"[?] Bi [?] Zhi Zhi Ai Hui [?] [?] Shan Luan [?] [?] [?] Lu [?] " + // U+306E0
+		"[?] [?] Lian [?] [?] Xian [?] [?] [?] [?] [?] [?] [?] Ding [?] [?] " + // U+306F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30700
+		"Lan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qin Yang [?] [?] " + // U+30710
+		"[?] [?] He [?] [?] [?] [?] [?] Jian [?] [?] [?] [?] [?] [?] [?] " + // U+30720
+		"[?] [?] [?] Ying [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30730
+		"[?] [?] [?] [?] [?] Ying [?] [?] [?] [?] [?] Yin [?] Hui [?] [?] " + // U+30740
+		"[?] [?] [?] [?] [?] [?] [?] Jian [?] [?] [?] [?] [?] [?] [?] Xian " + // U+30750
+		"[?] [?] [?] [?] Xian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30760
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Chui [?] " + // U+30770
+		"[?] [?] [?] [?] [?] [?] [?] Yi [?] [?] [?] [?] [?] Jie [?] [?] " + // U+30780
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30790
+		"[?] [?] [?] [?] Jian [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+307A0
+		"[?] [?] Bei [?] [?] [?] [?] Zao [?] [?] [?] Fen [?] [?] [?] [?] " + // U+307B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+307C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] Yan [?] [?] [?] [?] [?] [?] [?] " + // U+307D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+307E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+307F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30800
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jian [?] [?] [?] [?] " + // U+30810
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Gang [?] [?] [?] [?] " + // U+30820
+		"[?] [?] Keng [?] Yao [?] [?] [?] [?] Pai [?] [?] [?] [?] [?] [?] " + // U+30830
+		"[?] [?] [?] [?] Lu [?] [?] [?] [?] [?] Xiao Hui [?] [?] Lai Wo " + // U+30840
+		"[?] [?] [?] [?] Chan [?] [?] [?] [?] [?] [?] [?] [?] [?] Ben [?] " + // U+30850
+		"[?] [?] Ying [?] [?] [?] [?] [?] [?] Qi [?] [?] [?] [?] [?] [?] " + // U+30860
+		"Che [?] [?] [?] [?] Lu [?] [?] [?] [?] [?] Nao [?] Shu [?] [?] " + // U+30870
+		"[?] [?] [?] [?] Su [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30880
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30890
+		"[?] [?] Wu [?] Ying [?] Lou [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+308A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+308B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+308C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+308D0
+		"[?] [?] [?] [?] [?] [?] Wei [?] [?] Ning [?] [?] Ji [?] [?] Xian " + // U+308E0
+		"[?] [?] [?] [?] [?] [?] Tui [?] [?] [?] [?] [?] Ji Luan [?] [?] " + // U+308F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30900
+		"[?] [?] [?] Tang [?] Li [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30910
+		"[?] [?] [?] [?] [?] [?] [?] [?] Ta [?] [?] Du Du [?] [?] [?] " + // U+30920
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30930
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Kan [?] [?] [?] [?] [?] " + // U+30940
+		"[?] [?] Cong [?] [?] [?] [?] [?] [?] [?] [?] Shui [?] [?] Ying [?] " + // U+30950
+		"Man [?] Xi Keng [?] [?] [?] [?] Xian [?] Gui [?] [?] Meng [?] [?] " + // U+30960
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Tang [?] [?] [?] [?] [?] [?] " + // U+30970
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30980
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ba [?] [?] [?] " + // U+30990
+		"[?] [?] [?] [?] [?] [?] Li [?] Hui [?] [?] [?] [?] Long [?] [?] " + // U+309A0
+		"Ze [?] [?] [?] Ya [?] [?] Lao [?] [?] [?] [?] [?] [?] Yan Tuo " + // U+309B0
+		"[?] [?] [?] Jian [?] [?] [?] Xian [?] Zhi [?] [?] [?] [?] Qin [?] " + // U+309C0
+		"[?] [?] [?] [?] Jian [?] [?] [?] Zhu [?] [?] [?] [?] [?] [?] [?] " + // U+309D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+309E0
+		"Yang [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Gui [?] [?] Nong [?] " + // U+309F0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30A00
+		"[?] [?] [?] [?] [?] [?] Ti [?] [?] [?] [?] [?] Kuang [?] [?] [?] " + // U+30A10
+		"[?] [?] [?] [?] [?] [?] Ji [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30A20
+		"[?] [?] [?] Zi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30A30
+		"[?] [?] [?] [?] [?] Long [?] [?] [?] [?] [?] [?] [?] [?] [?] Tiao " + // U+30A40
+		"[?] [?] [?] Cheng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30A50
+		"[?] [?] [?] [?] [?] [?] [?] Xu [?] [?] [?] [?] [?] [?] Tuan [?] " + // U+30A60
+		"[?] [?] Lu [?] [?] [?] [?] [?] Jiao Dang Yue Zhua [?] [?] [?] [?] " + // U+30A70
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhong [?] [?] [?] [?] Lu " + // U+30A80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30A90
+		"[?] [?] [?] Ying [?] [?] [?] [?] [?] [?] [?] Tuan [?] Zhang [?] [?] " + // U+30AA0
+		"[?] [?] [?] [?] [?] [?] Tuan [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30AB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Men [?] [?] [?] [?] " + // U+30AC0
+		"[?] [?] [?] [?] [?] [?] Lan [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30AD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30AE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zheng Fou [?] [?] " + // U+30AF0
+		"Ji [?] [?] Lie [?] Fu Qu Zhu Xian Tuo [?] Hong Geng Xie Bi Zhu " + // U+30B00
+		"Qiao Zheng Ci Gai Beng [?] Cheng Qiu Fu Chen Yun Zhen Mian [?] Qin Yu " + // U+30B10
+		"Hua Qie Qi Xi Bi Zong Hu Tian [?] Xiu Shan Zhi Miao Beng Qian Zong " + // U+30B20
+		"Cong Hui Quan Ni Zai Xuan Qi San Sui Zuan Zun Yu La Xie Lian Pu " + // U+30B30
+		"You Cong [?] [?] Guan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30B40
+		"[?] [?] [?] [?] Juan [?] [?] [?] [?] [?] Lou [?] [?] [?] [?] [?] " + // U+30B50
+		"[?] [?] Sha Dao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30B60
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Long [?] [?] [?] [?] [?] [?] " + // U+30B70
+		"[?] [?] [?] [?] [?] Xiang [?] Hu [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30B80
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Zhu [?] [?] [?] Xiao [?] [?] " + // U+30B90
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30BA0
+		"[?] [?] Sou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30BB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ni [?] [?] Man [?] " + // U+30BC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30BD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30BE0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30BF0
+		"[?] [?] [?] [?] [?] [?] Tan [?] [?] [?] [?] Shuang Zhou [?] [?] Dai " + // U+30C00
+		"[?] Lou [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30C10
+		"Xu [?] Tang [?] Qiu [?] [?] [?] Lun [?] [?] [?] [?] [?] Kui [?] " + // U+30C20
+		"[?] Dui [?] Shi Qi Sha Za Fu [?] Liu She [?] [?] [?] Ke [?] " + // U+30C30
+		"Gong [?] [?] [?] [?] [?] [?] Jian Yong Meng [?] [?] Na Fen [?] [?] " + // U+30C40
+		"Xu Jian [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhong [?] Xiang [?] Fen " + // U+30C50
+		"[?] [?] [?] [?] [?] [?] Fu [?] [?] Lu [?] [?] [?] [?] Lu Bei " + // U+30C60
+		"[?] Ci Zhi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Lai [?] " + // U+30C70
+		"[?] Tui Teng [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30C80
+		"[?] [?] Lei [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wu " + // U+30C90
+		"Zhan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wu Che [?] Qian Zong " + // U+30CA0
+		"Lun [?] Gui Zhan Long Ze Xing [?] E Yi Guo Da [?] [?] [?] [?] " + // U+30CB0
+		"[?] Ji Jian [?] Chen [?] [?] [?] [?] [?] Fei [?] [?] [?] [?] [?] " + // U+30CC0
+		"[?] [?] [?] [?] [?] [?] [?] Ying [?] [?] Wei [?] [?] [?] [?] [?] " + // U+30CD0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30CE0
+		"[?] [?] Long [?] [?] Yi [?] [?] Dui Yan Nong [?] Shi [?] [?] [?] " + // U+30CF0
+		"[?] [?] Sen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30D00
+		"[?] [?] [?] [?] [?] Mie Shao Li Jian Jun Lou Dou Qu Luo Qu [?] " + // U+30D10
+		"[?] [?] Li Xue Dai [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ying " + // U+30D20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30D30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Jiao [?] Yang Zhun You Chao " + // U+30D40
+		"Fan E Chen Xu Yi [?] Yi Yang [?] Die Ling Gou Tao He Yong [?] " + // U+30D50
+		"Nao Keng Shan He E Yi Xiao Zhi Zhan Bu [?] Dou Bie Chan E Shua " + // U+30D60
+		"Xing Chen Qi Zhuo Chu Ni Zhui Zhuo Ta Nie Yuan Xu Huo Tao Xi He " + // U+30D70
+		"Yu Yi Cong Xi Zha Hao Han Tuo Chi Tan Yi [?] Nou Xuan Se Yao " + // U+30D80
+		"[?] Long [?] [?] Du [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30D90
+		"[?] [?] [?] [?] [?] [?] [?] [?] Mi [?] [?] [?] Lou [?] [?] [?] " + // U+30DA0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30DB0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30DC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Te [?] " + // U+30DD0
+		"Yi Wan Chi [?] Sui Cong Fei Jin Kui Sheng Yi Biao Zhuan Zang Xuan [?] " + // U+30DE0
+		"[?] [?] [?] [?] Li Qiao Qiao [?] Du [?] [?] [?] [?] [?] [?] [?] " + // U+30DF0
+		"[?] [?] [?] [?] [?] [?] [?] Shuang Die [?] Gui [?] [?] [?] Chan [?] " + // U+30E00
+		"Xi [?] [?] [?] Lian [?] [?] [?] [?] [?] Dai Chi [?] [?] Tui [?] " + // U+30E10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30E20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30E30
+		"Duo [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30E40
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30E50
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30E60
+		"[?] [?] Wei Chun Fan Yi Kuang Yue Tun [?] Fan Ba Zhan Peng Xian Rong " + // U+30E70
+		"Di Qu Pao Zhui Gong Ju Hong Wan Yin Qian Leng Zhe Lu Guo Pai Peng " + // U+30E80
+		"Kan Yuan Chuan Fu Zong Duo Zhen Jian Keng Se Er Lao Fan Chong Zhan Ji " + // U+30E90
+		"Nie Kai Luo Lin Min [?] [?] [?] Huo [?] [?] [?] [?] Chen [?] [?] " + // U+30EA0
+		"[?] [?] Dang [?] [?] [?] [?] Rao [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30EB0
+		"[?] [?] [?] [?] [?] [?] Di [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30EC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Biang Biang [?] " + // U+30ED0
+		"[?] Xu [?] [?] [?] [?] Wei [?] [?] [?] [?] [?] [?] [?] Qiao [?] " + // U+30EE0
+		"[?] [?] [?] Bi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30EF0
+		"[?] [?] [?] [?] [?] Yi [?] [?] [?] [?] [?] Lao [?] [?] [?] Lan " + // U+30F00
+		"[?] Mi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30F10
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30F20
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Qing [?] [?] [?] [?] " + // U+30F30
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30F40
+		"[?] [?] [?] [?] [?] Ji Ba Hua Di [?] Kuang Qiao Ou Li E [?] " + // U+30F50
+		"Zhi Ri Xian Yin [?] Bing Long Pi Zhai Ka [?] Sheng He Gou Fu Zhu " + // U+30F60
+		"Ban Fu Ji Qin Kua Guang Tong Hui Pi Chi Gui Ren Bing Yong Guo Zhe " + // U+30F70
+		"Bo Hong [?] Zhuo Mei Han Yu Tun Sha [?] Cong [?] Jian Biao Pi Tao " + // U+30F80
+		"Fei Pian Guan Ta [?] Ye Duo Zhen Chen Yu Wei Jie Duan Sheng Zong Fu " + // U+30F90
+		"[?] Hong Shi [?] Xia Kui Zuan [?] [?] Ye Ya Jian Da Ai Lian Tang " + // U+30FA0
+		"Cui Zong Lu Lan Si [?] Hui Zan Ding Juan [?] Tian Quan Zhu Nie La " + // U+30FB0
+		"[?] Ao Xu Mie Lei [?] Zuan Zha Jian Lei Luo [?] [?] [?] [?] [?] " + // U+30FC0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+30FD0
+		"[?] [?] [?] [?] [?] [?] Zhen [?] Guan Xia Xie Wei Peng [?] [?] [?] " + // U+30FE0
+		"Zuan [?] [?] Shai Ya Yu [?] [?] [?] Guang Tang Xi [?] [?] Pi [?] " + // U+30FF0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31000
+		"[?] Zhi [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31010
+		"[?] Fen [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31020
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31030
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31040
+		"[?] [?] Dui [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Wan [?] " + // U+31050
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31060
+		"[?] Yi [?] Duo Bi [?] Qian Du [?] Gui Lou [?] [?] Lan Lan [?] " + // U+31070
+		"[?] [?] [?] Sa Mei Bi Ge Quan Yun Qiao She Du Hu Dui Xie [?] " + // U+31080
+		"Yun [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31090
+		"Duo Ku Zhuo Yao Hui E Ping Lei E Fu [?] Yan Kui Chui Pi Cui " + // U+310A0
+		"Han Kan Sai Gao Jiang Kui Xin Zhan Bin [?] Piao Qiao [?] [?] [?] [?] " + // U+310B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+310C0
+		"[?] [?] [?] [?] Biao Xia You Tai Lie Liu Li [?] Rui Xiu Yu Xi " + // U+310D0
+		"Biao [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+310E0
+		"[?] Xi Yu Bo Fan Ying [?] Ban Zhi En Tian [?] Fen E Bu Tan " + // U+310F0
+		"Fen Tou [?] Jian Hui [?] Chi Shang Meng En Zan [?] [?] [?] [?] [?] " + // U+31100
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31110
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31120
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Zhu Zhe Guang Du " + // U+31130
+		"Bao Zhi Sa Ju Ba Long [?] Zhen Bi Rong Zhi Er [?] Xu Fu Tao " + // U+31140
+		"Bo [?] [?] Bo Song Lai Xuan E [?] Tui She Tuo Yao Pian Xia Rou " + // U+31150
+		"Han Gui Cheng Tao Pin [?] Huang Xu [?] Xi Dian Yu Yan [?] Xiao [?] " + // U+31160
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31170
+		"Qian Qian [?] Kui Fei Lan Man [?] Zuan [?] [?] [?] Shang Chao [?] [?] " + // U+31180
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] Chao Kui Chou [?] [?] [?] [?] " + // U+31190
+		"[?] [?] [?] [?] [?] Dan [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+311A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+311B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Ya [?] Tuo " + // U+311C0
+		"Yuan Bei Pi Hu Na Yu Ban Lun Hang Shen Mo Wei Qu Pi Shan Xia " + // U+311D0
+		"Qiu Xing Gun Bo You Geng Ku Ti Shu Jiao Luo Ru Yi Lai Mang Shao " + // U+311E0
+		"Ye Tiao Fu Suo Na Ji Lu Cuo Ying Zhi Ji Xian Jiu Ju Zong Su " + // U+311F0
+		"Ju Shan Yu Wei Hou Zong Lou Geng Geng Qian Liu Hao Xiu Ruo Sang Zhu " + // U+31200
+		"Zhuan Hui Si Xun Cen Fan Lai Ru Chang Mie E Meng Xian [?] [?] [?] " + // U+31210
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31220
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31230
+		"[?] [?] [?] [?] [?] [?] [?] Yi Diao [?] Gan Han Yi Yu Fu Tuan " + // U+31240
+		"Ju Ban Huan Hu Jue Ge Pi Dan Ju Zhi Tie Zhi Fu Bi Wu Ze " + // U+31250
+		"Jing Bian Yao Song Gui Jia Lao Yuan Ya Ti Ai Zhu Jiao Gui Luo Yang " + // U+31260
+		"Yu Kun Mou Mai Yang Lai Kan Yi Tu Xi Fu Tuo Ji Ji Jun Jun " + // U+31270
+		"Lu Qi Ming Li Yi Zhui Tu Ju Yi [?] Kong Jian Ju Duo Zi Fu " + // U+31280
+		"Jie Chun Yuan Mo [?] Ti Tu Rou Chuan Zhen Tian Sun Niao Tang [?] Xia " + // U+31290
+		"Tu Yao Ji Yan Yong Shang Yi Jue [?] Tu Ling Yu Shu Yu Luo Ji " + // U+312A0
+		"Yi Di Bu Lei [?] Yue [?] [?] [?] [?] Lu Lu Chuai Huai [?] [?] " + // U+312B0
+		"[?] [?] [?] [?] [?] [?] Shan He Kuang [?] Mo Qu [?] Tou Hua [?] " + // U+312C0
+		"Qu [?] [?] Ge Bing Qu Fu Fu Sha Guo [?] [?] Mo Bu [?] [?] " + // U+312D0
+		"[?] Lou Chao [?] [?] [?] [?] [?] [?] [?] Zhi Kuang Lian [?] Meng [?] " + // U+312E0
+		"[?] Fen [?] [?] Chan [?] Lu [?] [?] [?] [?] [?] [?] [?] Cu Qu " + // U+312F0
+		"Yang Qu [?] Wa Zhi Mi Meng Bie Ma Bi Chang [?] [?] [?] [?] Hun " + // U+31300
+		"[?] [?] [?] [?] [?] [?] Nong Ji Zi Zi [?] [?] [?] [?] [?] [?] " + // U+31310
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] Yan Ze Zou Chu Chi " + // U+31320
+		"Yao Xie Quan Yun Zhai Zhan Zou Yi Ji Ou Dian [?] [?] Yi [?] [?] " + // U+31330
+		"[?] Gong Da [?] Ran Gou Jiao Tong Bie [?] [?] [?][?][?][?][?]" + // U+31340
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31350
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31360
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31370
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31380
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+31390
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+313A0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+313B0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+313C0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+313D0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] " + // U+313E0
+		"[?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] [?] ", // U+313F0
+}
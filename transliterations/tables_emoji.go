@@ -0,0 +1,594 @@
+// Code generated by go run gen.go; DO NOT EDIT.
+
+package transliterations
+
+// EmojiVersion is the version of the emoji data the emoji names were
+// generated from.
+const EmojiVersion = "15.1"
+
+// emojiEnds has for every emoji where its sequence ends in
+// emojiSequences and where its name ends in emojiNames.
+var emojiEnds = []emojiEnd{
+	{4, 9}, {11, 18}, {15, 27}, {22, 36}, {26, 45}, {33, 54}, {37, 63}, {44, 72},
+	{48, 81}, {55, 90}, {59, 99}, {66, 108}, {70, 117}, {77, 126}, {81, 135}, {88, 144},
+	{92, 153}, {99, 162}, {103, 171}, {110, 180}, {114, 189}, {121, 198}, {125, 207}, {132, 216},
+	{137, 225}, {142, 235}, {148, 258}, {154, 283}, {160, 293}, {163, 304}, {169, 315}, {175, 331},
+	{181, 344}, {187, 357}, {193, 371}, {199, 387}, {205, 402}, {211, 426}, {217, 450}, {220, 455},
+	{223, 469}, {226, 477}, {232, 485}, {235, 497}, {241, 509}, {244, 528}, {247, 547}, {250, 561},
+	{253, 577}, {256, 594}, {262, 611}, {265, 628}, {271, 645}, {274, 665}, {280, 685}, {283, 696},
+	{286, 705}, {292, 714}, {295, 725}, {301, 736}, {304, 754}, {307, 766}, {313, 778}, {316, 789},
+	{322, 800}, {325, 813}, {331, 826}, {337, 835}, {343, 853}, {349, 871}, {355, 882}, {361, 896},
+	{364, 915}, {370, 934}, {373, 953}, {379, 972}, {382, 997}, {385, 1022}, {388, 1025}, {394, 1028},
+	{397, 1033}, {403, 1038}, {406, 1046}, {412, 1054}, {415, 1061}, {421, 1068}, {424, 1073}, {430, 1078},
+	{433, 1087}, {439, 1096}, {442, 1116}, {448, 1136}, {451, 1160}, {454, 1172}, {457, 1180}, {463, 1188},
+	{466, 1205}, {472, 1222}, {475, 1242}, {481, 1262}, {484, 1273}, {490, 1284}, {493, 1293}, {499, 1302},
+	{502, 1316}, {508, 1330}, {511, 1347}, {517, 1364}, {520, 1376}, {526, 1388}, {529, 1396}, {535, 1404},
+	{538, 1419}, {544, 1434}, {547, 1447}, {553, 1460}, {556, 1472}, {562, 1484}, {565, 1495}, {571, 1506},
+	{574, 1515}, {580, 1524}, {583, 1529}, {586, 1535}, {589, 1541}, {592, 1547}, {595, 1550}, {598, 1555},
+	{601, 1560}, {604, 1567}, {607, 1578}, {610, 1587}, {613, 1595}, {616, 1601}, {619, 1611}, {625, 1621},
+	{628, 1631}, {634, 1641}, {637, 1650}, {643, 1659}, {646, 1669}, {652, 1679}, {655, 1691}, {661, 1703},
+	{664, 1714}, {670, 1725}, {673, 1741}, {679, 1757}, {682, 1765}, {688, 1773}, {691, 1790}, {694, 1805},
+	{700, 1820}, {703, 1826}, {706, 1840}, {712, 1854}, {715, 1868}, {721, 1882}, {724, 1895}, {730, 1908},
+	{733, 1915}, {739, 1922}, {742, 1926}, {748, 1930}, {751, 1941}, {757, 1952}, {760, 1964}, {766, 1976},
+	{769, 1983}, {775, 1990}, {778, 2002}, {781, 2020}, {787, 2038}, {790, 2050}, {793, 2062}, {796, 2068},
+	{802, 2074}, {805, 2085}, {811, 2096}, {814, 2107}, {817, 2115}, {820, 2135}, {823, 2151}, {826, 2180},
+	{832, 2209}, {835, 2218}, {838, 2222}, {844, 2226}, {847, 2248}, {853, 2270}, {856, 2276}, {866, 2288},
+	{872, 2294}, {885, 2306}, {888, 2314}, {891, 2327}, {897, 2340}, {900, 2346}, {903, 2354}, {909, 2362},
+	{912, 2380}, {918, 2398}, {921, 2406}, {924, 2418}, {927, 2423}, {933, 2428}, {936, 2436}, {939, 2441},
+	{945, 2446}, {948, 2455}, {954, 2464}, {957, 2484}, {966, 2503}, {978, 2522}, {987, 2539}, {999, 2556},
+	{1005, 2576}, {1017, 2595}, {1032, 2614}, {1044, 2631}, {1059, 2648}, {1062, 2652}, {1065, 2661}, {1068, 2669},
+	{1074, 2677}, {1077, 2694}, {1080, 2702}, {1086, 2710}, {1089, 2718}, {1095, 2726}, {1098, 2737}, {1101, 2748},
+	{1104, 2760}, {1110, 2772}, {1113, 2784}, {1119, 2796}, {1122, 2802}, {1128, 2808}, {1131, 2817}, {1137, 2826},
+	{1140, 2836}, {1146, 2846}, {1149, 2854}, {1155, 2862}, {1158, 2873}, {1164, 2884}, {1167, 2897}, {1173, 2910},
+	{1176, 2918}, {1179, 2939}, {1185, 2960}, {1188, 2978}, {1194, 2996}, {1197, 3005}, {1203, 3014}, {1206, 3021},
+	{1212, 3028}, {1215, 3038}, {1218, 3055}, {1221, 3072}, {1224, 3091}, {1227, 3113}, {1230, 3133}, {1233, 3150},
+	{1239, 3167}, {1242, 3176}, {1252, 3189}, {1262, 3202}, {1268, 3211}, {1281, 3224}, {1294, 3237}, {1297, 3241},
+	{1300, 3246}, {1303, 3252}, {1306, 3263}, {1312, 3274}, {1315, 3284}, {1318, 3301}, {1321, 3323}, {1327, 3345},
+	{1330, 3369}, {1336, 3393}, {1339, 3403}, {1345, 3413}, {1348, 3421}, {1354, 3429}, {1357, 3439}, {1363, 3449},
+	{1366, 3467}, {1369, 3485}, {1372, 3489}, {1375, 3506}, {1381, 3515}, {1384, 3536}, {1390, 3557}, {1396, 3590},
+	{1402, 3614}, {1406, 3632}, {1410, 3637}, {1414, 3658}, {1421, 3679}, {1425, 3700}, {1432, 3721}, {1436, 3742},
+	{1443, 3763}, {1447, 3771}, {1454, 3779}, {1458, 3801}, {1462, 3810}, {1466, 3821}, {1470, 3832}, {1474, 3841},
+	{1478, 3851}, {1482, 3860}, {1486, 3869}, {1490, 3879}, {1494, 3889}, {1498, 3898}, {1506, 3914}, {1514, 3921},
+	{1522, 3941}, {1530, 3952}, {1538, 3969}, {1546, 3977}, {1554, 3984}, {1562, 3991}, {1570, 3997}, {1578, 4007},
+	{1586, 4016}, {1594, 4030}, {1602, 4037}, {1610, 4046}, {1618, 4051}, {1626, 4064}, {1634, 4074}, {1642, 4094},
+	{1650, 4102}, {1658, 4112}, {1666, 4119}, {1674, 4131}, {1682, 4139}, {1690, 4146}, {1698, 4153}, {1706, 4158},
+	{1714, 4172}, {1722, 4179}, {1730, 4185}, {1738, 4192}, {1746, 4213}, {1754, 4219}, {1762, 4226}, {1770, 4232},
+	{1778, 4245}, {1786, 4253}, {1794, 4260}, {1802, 4266}, {1810, 4272}, {1818, 4295}, {1826, 4311}, {1834, 4335},
+	{1842, 4354}, {1850, 4365}, {1858, 4378}, {1866, 4390}, {1874, 4395}, {1882, 4403}, {1890, 4408}, {1898, 4416},
+	{1906, 4433}, {1914, 4443}, {1922, 4447}, {1930, 4457}, {1938, 4464}, {1946, 4480}, {1954, 4486}, {1962, 4493},
+	{1970, 4500}, {1978, 4512}, {1986, 4520}, {1994, 4527}, {2002, 4535}, {2010, 4553}, {2018, 4560}, {2026, 4575},
+	{2034, 4582}, {2042, 4589}, {2050, 4594}, {2058, 4608}, {2066, 4615}, {2074, 4620}, {2082, 4628}, {2090, 4642},
+	{2098, 4649}, {2106, 4653}, {2114, 4669}, {2122, 4679}, {2130, 4692}, {2138, 4698}, {2146, 4703}, {2154, 4717},
+	{2162, 4724}, {2170, 4731}, {2178, 4744}, {2186, 4752}, {2194, 4757}, {2202, 4766}, {2210, 4775}, {2218, 4781},
+	{2226, 4787}, {2234, 4797}, {2242, 4814}, {2250, 4820}, {2258, 4858}, {2266, 4867}, {2274, 4871}, {2282, 4884},
+	{2290, 4890}, {2298, 4909}, {2306, 4933}, {2314, 4941}, {2322, 4948}, {2330, 4953}, {2338, 4960}, {2346, 4974},
+	{2354, 4983}, {2362, 4990}, {2370, 4996}, {2378, 5007}, {2386, 5012}, {2394, 5042}, {2402, 5046}, {2410, 5050},
+	{2418, 5057}, {2426, 5062}, {2434, 5068}, {2442, 5075}, {2450, 5081}, {2458, 5086}, {2466, 5091}, {2474, 5101},
+	{2482, 5109}, {2490, 5117}, {2498, 5124}, {2506, 5141}, {2514, 5152}, {2522, 5163}, {2530, 5169}, {2538, 5183},
+	{2546, 5193}, {2554, 5197}, {2562, 5204}, {2570, 5213}, {2578, 5226}, {2586, 5235}, {2594, 5242}, {2602, 5249},
+	{2610, 5258}, {2618, 5268}, {2626, 5274}, {2634, 5279}, {2642, 5286}, {2650, 5292}, {2658, 5299}, {2666, 5309},
+	{2674, 5319}, {2682, 5329}, {2690, 5345}, {2698, 5360}, {2706, 5364}, {2714, 5379}, {2722, 5387}, {2730, 5402},
+	{2738, 5426}, {2746, 5436}, {2754, 5446}, {2762, 5456}, {2770, 5461}, {2778, 5470}, {2786, 5478}, {2794, 5484},
+	{2802, 5490}, {2810, 5498}, {2818, 5508}, {2826, 5515}, {2834, 5528}, {2842, 5533}, {2850, 5547}, {2858, 5554},
+	{2866, 5563}, {2874, 5574}, {2882, 5580}, {2890, 5585}, {2898, 5590}, {2906, 5594}, {2914, 5605}, {2922, 5609},
+	{2930, 5615}, {2938, 5619}, {2946, 5635}, {2954, 5651}, {2962, 5662}, {2970, 5670}, {2978, 5676}, {2986, 5697},
+	{2994, 5713}, {3002, 5724}, {3010, 5747}, {3018, 5755}, {3026, 5760}, {3034, 5768}, {3042, 5773}, {3050, 5780},
+	{3058, 5787}, {3066, 5793}, {3074, 5799}, {3082, 5805}, {3090, 5817}, {3098, 5832}, {3106, 5842}, {3114, 5847},
+	{3122, 5853}, {3130, 5862}, {3138, 5872}, {3146, 5880}, {3154, 5900}, {3162, 5908}, {3170, 5920}, {3178, 5930},
+	{3186, 5937}, {3194, 5944}, {3202, 5952}, {3210, 5963}, {3218, 5982}, {3226, 5993}, {3234, 6005}, {3242, 6010},
+	{3250, 6018}, {3258, 6034}, {3266, 6056}, {3274, 6060}, {3282, 6087}, {3290, 6091}, {3298, 6099}, {3306, 6109},
+	{3314, 6116}, {3322, 6127}, {3330, 6139}, {3338, 6146}, {3346, 6151}, {3354, 6158}, {3362, 6175}, {3370, 6181},
+	{3378, 6187}, {3386, 6195}, {3394, 6202}, {3402, 6208}, {3410, 6229}, {3418, 6243}, {3426, 6256}, {3434, 6263},
+	{3442, 6273}, {3450, 6285}, {3458, 6309}, {3466, 6318}, {3474, 6340}, {3482, 6359}, {3490, 6366}, {3498, 6373},
+	{3506, 6388}, {3514, 6393}, {3522, 6399}, {3530, 6404}, {3538, 6411}, {3546, 6423}, {3554, 6429}, {3562, 6437},
+	{3566, 6459}, {3570, 6491}, {3577, 6523}, {3581, 6555}, {3585, 6581}, {3589, 6609}, {3593, 6634}, {3597, 6665},
+	{3601, 6693}, {3605, 6729}, {3609, 6761}, {3616, 6793}, {3620, 6822}, {3624, 6848}, {3628, 6883}, {3632, 6908},
+	{3636, 6936}, {3640, 6943}, {3644, 6948}, {3648, 6963}, {3652, 6979}, {3656, 7001}, {3660, 7008}, {3664, 7025},
+	{3668, 7031}, {3672, 7038}, {3676, 7053}, {3680, 7063}, {3684, 7070}, {3688, 7079}, {3692, 7106}, {3696, 7128},
+	{3700, 7156}, {3704, 7176}, {3708, 7184}, {3712, 7204}, {3716, 7222}, {3720, 7241}, {3724, 7250}, {3728, 7269},
+	{3732, 7286}, {3736, 7306}, {3740, 7319}, {3744, 7332}, {3748, 7355}, {3752, 7377}, {3756, 7391}, {3760, 7404},
+	{3764, 7416}, {3768, 7429}, {3772, 7440}, {3779, 7451}, {3783, 7473}, {3790, 7495}, {3794, 7517}, {3801, 7539},
+	{3805, 7560}, {3812, 7581}, {3816, 7596}, {3823, 7611}, {3827, 7626}, {3834, 7641}, {3838, 7661}, {3845, 7681},
+	{3849, 7688}, {3856, 7695}, {3860, 7698}, {3867, 7701}, {3871, 7710}, {3878, 7719}, {3882, 7726}, {3886, 7730},
+	{3890, 7737}, {3894, 7745}, {3898, 7753}, {3902, 7767}, {3906, 7781}, {3910, 7790}, {3914, 7796}, {3918, 7806},
+	{3925, 7816}, {3929, 7821}, {3933, 7835}, {3937, 7839}, {3941, 7847}, {3945, 7856}, {3949, 7863}, {3953, 7874},
+	{3957, 7887}, {3961, 7891}, {3965, 7907}, {3969, 7917}, {3973, 7928}, {3977, 7951}, {3981, 7959}, {3992, 7973},
+	{3996, 7979}, {4000, 7987}, {4004, 7993}, {4008, 7998}, {4012, 8008}, {4016, 8017}, {4020, 8022}, {4031, 8026},
+	{4035, 8032}, {4039, 8041}, {4043, 8050}, {4047, 8061}, {4051, 8065}, {4055, 8070}, {4059, 8078}, {4063, 8088},
+	{4067, 8097}, {4071, 8102}, {4075, 8114}, {4079, 8125}, {4083, 8137}, {4087, 8146}, {4091, 8157}, {4095, 8167},
+	{4099, 8180}, {4103, 8189}, {4107, 8194}, {4111, 8206}, {4115, 8226}, {4119, 8231}, {4123, 8235}, {4127, 8240},
+	{4131, 8252}, {4135, 8272}, {4139, 8286}, {4143, 8296}, {4147, 8305}, {4151, 8313}, {4155, 8319}, {4159, 8332},
+	{4163, 8337}, {4167, 8345}, {4171, 8352}, {4175, 8361}, {4179, 8370}, {4183, 8379}, {4187, 8390}, {4191, 8397},
+	{4195, 8411}, {4199, 8432}, {4203, 8436}, {4207, 8446}, {4211, 8460}, {4215, 8474}, {4219, 8482}, {4223, 8500},
+	{4227, 8511}, {4231, 8536}, {4238, 8561}, {4242, 8585}, {4246, 8592}, {4250, 8598}, {4254, 8610}, {4258, 8623},
+	{4262, 8637}, {4266, 8651}, {4270, 8662}, {4274, 8671}, {4278, 8679}, {4282, 8686}, {4286, 8698}, {4290, 8711},
+	{4294, 8724}, {4298, 8737}, {4302, 8752}, {4306, 8766}, {4310, 8779}, {4314, 8789}, {4318, 8810}, {4322, 8818},
+	{4326, 8832}, {4330, 8846}, {4337, 8860}, {4341, 8875}, {4348, 8890}, {4352, 8907}, {4359, 8924}, {4363, 8936},
+	{4370, 8948}, {4374, 8961}, {4381, 8974}, {4385, 8985}, {4392, 8996}, {4396, 9013}, {4403, 9030}, {4407, 9044},
+	{4411, 9056}, {4415, 9070}, {4419, 9082}, {4423, 9092}, {4427, 9104}, {4431, 9110}, {4435, 9119}, {4439, 9133},
+	{4443, 9140}, {4447, 9151}, {4451, 9157}, {4455, 9170}, {4459, 9185}, {4463, 9195}, {4467, 9203}, {4471, 9215},
+	{4475, 9226}, {4479, 9234}, {4483, 9241}, {4487, 9261}, {4491, 9273}, {4495, 9286}, {4499, 9295}, {4503, 9301},
+	{4507, 9317}, {4511, 9324}, {4515, 9330}, {4519, 9343}, {4523, 9356}, {4527, 9362}, {4531, 9366}, {4535, 9376},
+	{4539, 9390}, {4543, 9401}, {4547, 9415}, {4557, 9428}, {4573, 9454}, {4592, 9480}, {4605, 9493}, {4624, 9519},
+	{4646, 9545}, {4656, 9556}, {4672, 9580}, {4691, 9604}, {4704, 9615}, {4723, 9639}, {4745, 9663}, {4755, 9690},
+	{4768, 9717}, {4772, 9731}, {4782, 9744}, {4795, 9757}, {4805, 9768}, {4818, 9779}, {4822, 9791}, {4826, 9797},
+	{4830, 9809}, {4834, 9826}, {4838, 9840}, {4842, 9855}, {4852, 9869}, {4865, 9883}, {4875, 9895}, {4888, 9907},
+	{4892, 9929}, {4902, 9950}, {4915, 9971}, {4925, 9990}, {4938, 10009}, {4945, 10031}, {4958, 10052}, {4974, 10073},
+	{4987, 10092}, {5003, 10111}, {5007, 10125}, {5017, 10138}, {5030, 10151}, {5040, 10162}, {5053, 10173}, {5060, 10187},
+	{5073, 10200}, {5089, 10213}, {5102, 10224}, {5118, 10235}, {5122, 10245}, {5129, 10255}, {5133, 10265}, {5140, 10275},
+	{5144, 10287}, {5148, 10297}, {5152, 10309}, {5156, 10319}, {5160, 10328}, {5164, 10348}, {5171, 10368}, {5175, 10375},
+	{5182, 10382}, {5186, 10401}, {5193, 10420}, {5197, 10441}, {5204, 10462}, {5208, 10468}, {5215, 10474}, {5219, 10483},
+	{5226, 10492}, {5230, 10506}, {5237, 10520}, {5241, 10538}, {5248, 10556}, {5252, 10562}, {5259, 10568}, {5263, 10581},
+	{5270, 10594}, {5274, 10607}, {5281, 10620}, {5285, 10627}, {5292, 10634}, {5296, 10639}, {5300, 10656}, {5304, 10671},
+	{5308, 10691}, {5312, 10702}, {5316, 10710}, {5320, 10714}, {5324, 10722}, {5328, 10727}, {5332, 10737}, {5336, 10754},
+	{5340, 10760}, {5344, 10776}, {5348, 10783}, {5352, 10800}, {5356, 10815}, {5360, 10821}, {5364, 10831}, {5374, 10847},
+	{5387, 10863}, {5398, 10875}, {5405, 10885}, {5418, 10901}, {5434, 10917}, {5448, 10929}, {5452, 10939}, {5462, 10950},
+	{5475, 10961}, {5503, 10968}, {5531, 10976}, {5559, 10981}, {5563, 10988}, {5570, 10995}, {5574, 11000}, {5581, 11005},
+	{5585, 11014}, {5589, 11027}, {5593, 11034}, {5597, 11037}, {5601, 11042}, {5605, 11044}, {5609, 11057}, {5613, 11060},
+	{5617, 11065}, {5621, 11072}, {5625, 11078}, {5629, 11081}, {5639, 11090}, {5643, 11096}, {5647, 11105}, {5651, 11110},
+	{5655, 11115}, {5659, 11120}, {5663, 11125}, {5667, 11128}, {5671, 11132}, {5675, 11135}, {5679, 11141}, {5683, 11148},
+	{5687, 11155}, {5691, 11158}, {5702, 11169}, {5706, 11172}, {5710, 11176}, {5714, 11184}, {5718, 11191}, {5722, 11203},
+	{5726, 11206}, {5730, 11209}, {5734, 11217}, {5738, 11228}, {5742, 11232}, {5746, 11245}, {5750, 11253}, {5754, 11259},
+	{5758, 11273}, {5762, 11283}, {5766, 11306}, {5770, 11310}, {5780, 11320}, {5791, 11327}, {5795, 11334}, {5799, 11339},
+	{5803, 11345}, {5807, 11350}, {5811, 11364}, {5815, 11371}, {5819, 11381}, {5823, 11389}, {5827, 11399}, {5831, 11410},
+	{5835, 11418}, {5839, 11429}, {5843, 11443}, {5847, 11453}, {5851, 11464}, {5855, 11472}, {5859, 11480}, {5863, 11484},
+	{5867, 11491}, {5871, 11495}, {5875, 11499}, {5885, 11509}, {5898, 11519}, {5902, 11524}, {5906, 11532}, {5910, 11542},
+	{5914, 11550}, {5921, 11558}, {5925, 11562}, {5929, 11565}, {5940, 11585}, {5954, 11605}, {5961, 11608}, {5975, 11628},
+	{5992, 11648}, {5996, 11651}, {6000, 11655}, {6004, 11660}, {6008, 11666}, {6012, 11692}, {6016, 11720}, {6020, 11748},
+	{6024, 11777}, {6028, 11790}, {6032, 11801}, {6036, 11808}, {6040, 11817}, {6044, 11828}, {6048, 11842}, {6052, 11852},
+	{6056, 11857}, {6060, 11868}, {6064, 11875}, {6068, 11882}, {6072, 11889}, {6076, 11894}, {6080, 11899}, {6084, 11905},
+	{6088, 11911}, {6092, 11926}, {6096, 11931}, {6100, 11938}, {6104, 11948}, {6108, 11958}, {6112, 11970}, {6116, 11986},
+	{6120, 12000}, {6124, 12012}, {6128, 12022}, {6132, 12040}, {6136, 12059}, {6140, 12062}, {6144, 12066}, {6148, 12069},
+	{6158, 12086}, {6171, 12103}, {6181, 12112}, {6194, 12121}, {6204, 12130}, {6217, 12139}, {6234, 12166}, {6258, 12180},
+	{6278, 12207}, {6305, 12221}, {6316, 12231}, {6327, 12239}, {6338, 12255}, {6349, 12266}, {6360, 12276}, {6371, 12286},
+	{6382, 12297}, {6393, 12315}, {6404, 12331}, {6422, 12352}, {6433, 12369}, {6451, 12391}, {6469, 12414}, {6487, 12435},
+	{6512, 12461}, {6530, 12483}, {6555, 12510}, {6580, 12538}, {6598, 12561}, {6623, 12589}, {6641, 12613}, {6666, 12642},
+	{6691, 12672}, {6702, 12688}, {6713, 12705}, {6724, 12717}, {6735, 12730}, {6746, 12743}, {6757, 12758}, {6775, 12775},
+	{6786, 12794}, {6803, 12826}, {6823, 12858}, {6834, 12871}, {6845, 12886}, {6856, 12895}, {6867, 12910}, {6878, 12937},
+	{6895, 12977}, {6915, 13017}, {6926, 13041}, {6943, 13078}, {6963, 13115}, {6967, 13120}, {6977, 13139}, {6990, 13158},
+	{7000, 13169}, {7013, 13180}, {7023, 13191}, {7036, 13202}, {7053, 13231}, {7070, 13262}, {7094, 13278}, {7118, 13296},
+	{7138, 13325}, {7158, 13356}, {7185, 13372}, {7212, 13390}, {7223, 13402}, {7234, 13412}, {7245, 13430}, {7256, 13443},
+	{7267, 13455}, {7278, 13467}, {7289, 13480}, {7300, 13500}, {7311, 13518}, {7329, 13541}, {7340, 13560}, {7358, 13584},
+	{7376, 13609}, {7394, 13634}, {7419, 13664}, {7437, 13690}, {7462, 13721}, {7487, 13753}, {7498, 13771}, {7509, 13790},
+	{7520, 13804}, {7531, 13819}, {7542, 13834}, {7553, 13851}, {7571, 13878}, {7589, 13897}, {7600, 13918}, {7617, 13952},
+	{7637, 13986}, {7648, 14001}, {7659, 14018}, {7670, 14029}, {7681, 14046}, {7692, 14075}, {7709, 14117}, {7729, 14159},
+	{7740, 14185}, {7757, 14224}, {7777, 14263}, {7781, 14269}, {7785, 14296}, {7789, 14313}, {7793, 14332}, {7797, 14346},
+	{7807, 14366}, {7820, 14386}, {7830, 14404}, {7843, 14422}, {7847, 14444}, {7857, 14465}, {7870, 14486}, {7880, 14505},
+	{7893, 14524}, {7897, 14540}, {7907, 14555}, {7920, 14570}, {7930, 14583}, {7943, 14596}, {7947, 14614}, {7957, 14631},
+	{7970, 14648}, {7980, 14663}, {7993, 14678}, {7997, 14698}, {8001, 14719}, {8011, 14739}, {8024, 14759}, {8034, 14777},
+	{8047, 14795}, {8051, 14802}, {8055, 14811}, {8059, 14815}, {8063, 14834}, {8073, 14859}, {8086, 14884}, {8096, 14907},
+	{8109, 14930}, {8113, 14938}, {8117, 14942}, {8121, 14948}, {8125, 14953}, {8129, 14963}, {8133, 14968}, {8137, 14981},
+	{8141, 15002}, {8145, 15007}, {8149, 15026}, {8159, 15044}, {8172, 15062}, {8182, 15078}, {8195, 15094}, {8199, 15099},
+	{8209, 15110}, {8222, 15121}, {8232, 15130}, {8245, 15139}, {8249, 15152}, {8253, 15160}, {8257, 15171}, {8261, 15193},
+	{8271, 15214}, {8284, 15235}, {8294, 15254}, {8307, 15273}, {8311, 15295}, {8321, 15316}, {8334, 15337}, {8344, 15356},
+	{8357, 15375}, {8361, 15386}, {8365, 15393}, {8369, 15397}, {8373, 15406}, {8377, 15417}, {8381, 15421}, {8385, 15430},
+	{8389, 15434}, {8393, 15441}, {8397, 15458}, {8401, 15465}, {8405, 15478}, {8409, 15490}, {8413, 15500}, {8417, 15515},
+	{8421, 15528}, {8425, 15544}, {8429, 15554}, {8433, 15565}, {8437, 15577}, {8441, 15589}, {8445, 15606}, {8449, 15622},
+	{8453, 15638}, {8457, 15656}, {8461, 15666}, {8465, 15678}, {8469, 15682}, {8473, 15685}, {8477, 15694}, {8481, 15708},
+	{8485, 15715}, {8489, 15727}, {8493, 15738}, {8497, 15751}, {8501, 15756}, {8505, 15770}, {8509, 15785}, {8513, 15797},
+	{8517, 15811}, {8521, 15820}, {8525, 15837}, {8529, 15854}, {8533, 15865}, {8537, 15877}, {8541, 15892}, {8545, 15905},
+	{8549, 15919}, {8553, 15935}, {8557, 15960}, {8561, 15964}, {8565, 15970}, {8569, 15979}, {8573, 15992}, {8577, 16003},
+	{8581, 16015}, {8585, 16018}, {8589, 16029}, {8593, 16045}, {8597, 16059}, {8601, 16073}, {8605, 16081}, {8609, 16098},
+	{8613, 16108}, {8617, 16124}, {8621, 16140}, {8625, 16149}, {8629, 16158}, {8633, 16165}, {8637, 16178}, {8641, 16187},
+	{8645, 16201}, {8649, 16217}, {8653, 16230}, {8657, 16236}, {8661, 16244}, {8665, 16274}, {8669, 16285}, {8673, 16294},
+	{8677, 16304}, {8681, 16313}, {8685, 16324}, {8689, 16329}, {8693, 16339}, {8697, 16345}, {8701, 16349}, {8705, 16367},
+	{8709, 16372}, {8713, 16383}, {8717, 16400}, {8721, 16411}, {8725, 16420}, {8729, 16431}, {8733, 16441}, {8737, 16448},
+	{8741, 16454}, {8745, 16471}, {8749, 16490}, {8753, 16522}, {8757, 16553}, {8761, 16582}, {8765, 16612}, {8769, 16619},
+	{8773, 16630}, {8777, 16639}, {8781, 16651}, {8785, 16674}, {8789, 16688}, {8793, 16704}, {8797, 16720}, {8801, 16732},
+	{8805, 16738}, {8809, 16755}, {8813, 16767}, {8817, 16777}, {8821, 16782}, {8825, 16795}, {8829, 16809}, {8836, 16823},
+	{8840, 16835}, {8844, 16856}, {8848, 16869}, {8852, 16889}, {8856, 16914}, {8860, 16944}, {8864, 16954}, {8868, 16967},
+	{8872, 16980}, {8876, 16998}, {8880, 17019}, {8884, 17038}, {8888, 17045}, {8892, 17058}, {8896, 17086}, {8900, 17115},
+	{8904, 17130}, {8908, 17145}, {8912, 17148}, {8916, 17154}, {8920, 17162}, {8924, 17166}, {8928, 17181}, {8932, 17189},
+	{8936, 17193}, {8940, 17205}, {8944, 17215}, {8948, 17224}, {8952, 17233}, {8956, 17243}, {8960, 17252}, {8964, 17273},
+	{8968, 17283}, {8972, 17304}, {8976, 17325}, {8980, 17338}, {8984, 17351}, {8988, 17370}, {8992, 17374}, {8996, 17384},
+	{9000, 17390}, {9004, 17396}, {9008, 17408}, {9012, 17421}, {9016, 17433}, {9020, 17443}, {9024, 17452}, {9028, 17464},
+	{9032, 17487}, {9036, 17515}, {9040, 17529}, {9044, 17548}, {9048, 17567}, {9052, 17577}, {9056, 17588}, {9060, 17608},
+	{9064, 17626}, {9068, 17646}, {9072, 17664}, {9076, 17687}, {9080, 17712}, {9084, 17726}, {9088, 17742}, {9092, 17744},
+	{9099, 17746}, {9103, 17750}, {9110, 17754}, {9114, 17759}, {9118, 17765}, {9122, 17774}, {9126, 17781}, {9130, 17792},
+	{9134, 17803}, {9138, 17816}, {9142, 17828}, {9146, 17840}, {9150, 17851}, {9154, 17864}, {9158, 17877}, {9162, 17889},
+	{9166, 17900}, {9170, 17914}, {9174, 17928}, {9178, 17938}, {9182, 17948}, {9186, 17960}, {9190, 17971}, {9194, 17982},
+	{9198, 17992}, {9202, 18004}, {9206, 18016}, {9210, 18027}, {9214, 18037}, {9218, 18050}, {9222, 18063}, {9226, 18069},
+	{9233, 18075}, {9237, 18092}, {9244, 18109}, {9248, 18113}, {9255, 18117}, {9259, 18142}, {9266, 18167}, {9270, 18176},
+	{9280, 18191}, {9293, 18206}, {9303, 18219}, {9316, 18232}, {9323, 18241}, {9336, 18256}, {9352, 18271}, {9365, 18284},
+	{9381, 18297}, {9385, 18307}, {9392, 18317}, {9396, 18323}, {9403, 18329}, {9407, 18339}, {9414, 18349}, {9418, 18357},
+	{9425, 18365}, {9429, 18376}, {9433, 18393}, {9440, 18410}, {9444, 18413}, {9451, 18416}, {9455, 18428}, {9462, 18440},
+	{9466, 18450}, {9473, 18460}, {9477, 18466}, {9484, 18472}, {9488, 18497}, {9495, 18522}, {9499, 18535}, {9503, 18548},
+	{9507, 18559}, {9511, 18575}, {9518, 18591}, {9522, 18598}, {9529, 18605}, {9533, 18619}, {9540, 18633}, {9544, 18642},
+	{9551, 18651}, {9555, 18665}, {9562, 18679}, {9566, 18698}, {9573, 18717}, {9577, 18730}, {9584, 18743}, {9588, 18755},
+	{9595, 18767}, {9599, 18778}, {9606, 18789}, {9610, 18803}, {9617, 18817}, {9621, 18832}, {9628, 18847}, {9632, 18852},
+	{9639, 18857}, {9643, 18864}, {9650, 18871}, {9654, 18890}, {9661, 18909}, {9665, 18915}, {9672, 18921}, {9676, 18934},
+	{9683, 18947}, {9687, 18965}, {9694, 18983}, {9698, 19001}, {9705, 19019}, {9709, 19041}, {9716, 19063}, {9720, 19072},
+	{9727, 19081}, {9731, 19091}, {9735, 19102}, {9739, 19119}, {9743, 19131}, {9747, 19135}, {9751, 19148}, {9755, 19178},
+	{9759, 19200}, {9763, 19227}, {9767, 19258}, {9771, 19282}, {9775, 19305}, {9779, 19327}, {9783, 19350}, {9787, 19362},
+	{9791, 19392}, {9795, 19410}, {9799, 19423}, {9803, 19451}, {9807, 19479}, {9811, 19492}, {9815, 19504}, {9819, 19523},
+	{9823, 19536}, {9827, 19560}, {9831, 19572}, {9835, 19585}, {9839, 19600}, {9843, 19612}, {9847, 19631}, {9851, 19661},
+	{9855, 19690}, {9859, 19706}, {9863, 19730}, {9867, 19756}, {9871, 19773}, {9875, 19785}, {9879, 19795}, {9883, 19807},
+	{9887, 19818}, {9891, 19834}, {9895, 19859}, {9899, 19880}, {9903, 19909}, {9907, 19923}, {9911, 19935}, {9915, 19945},
+	{9919, 19956}, {9923, 19966}, {9927, 19980}, {9931, 19998}, {9935, 20018}, {9946, 20031}, {9950, 20042}, {9954, 20065},
+	{9958, 20087}, {9962, 20102}, {9966, 20114}, {9970, 20127}, {9974, 20153}, {9985, 20174}, {9989, 20192}, {10000, 20206},
+	{10014, 20220}, {10018, 20242}, {10022, 20272}, {10026, 20293}, {10030, 20305}, {10034, 20332}, {10038, 20350}, {10042, 20361},
+	{10046, 20372}, {10050, 20382}, {10054, 20391}, {10058, 20413}, {10062, 20434}, {10072, 20459}, {10085, 20484}, {10095, 20507},
+	{10108, 20530}, {10112, 20546}, {10116, 20568}, {10120, 20587}, {10130, 20605}, {10143, 20623}, {10153, 20639}, {10166, 20655},
+	{10170, 20674}, {10180, 20692}, {10193, 20710}, {10203, 20726}, {10216, 20742}, {10220, 20755}, {10230, 20767}, {10243, 20779},
+	{10253, 20789}, {10266, 20799}, {10270, 20817}, {10274, 20836}, {10278, 20856}, {10282, 20875}, {10292, 20893}, {10305, 20911},
+	{10315, 20927}, {10328, 20943}, {10332, 20956}, {10336, 20971}, {10346, 20985}, {10359, 20999}, {10369, 21011}, {10382, 21023},
+	{10386, 21037}, {10396, 21050}, {10409, 21063}, {10419, 21074}, {10432, 21085}, {10436, 21097}, {10440, 21103}, {10444, 21113},
+	{10448, 21123}, {10452, 21134}, {10456, 21150}, {10460, 21162}, {10464, 21167}, {10468, 21172}, {10472, 21182}, {10476, 21189},
+	{10480, 21193}, {10484, 21201}, {10488, 21204}, {10492, 21216}, {10496, 21226}, {10500, 21234}, {10504, 21241}, {10508, 21250},
+	{10512, 21261}, {10516, 21271}, {10520, 21290}, {10524, 21294}, {10528, 21307}, {10532, 21317}, {10536, 21336}, {10540, 21357},
+	{10544, 21371}, {10548, 21388}, {10552, 21395}, {10556, 21403}, {10560, 21419}, {10564, 21437}, {10568, 21454}, {10572, 21468},
+	{10576, 21472}, {10580, 21490}, {10590, 21507}, {10603, 21524}, {10613, 21539}, {10626, 21554}, {10630, 21563}, {10634, 21587},
+	{10638, 21609}, {10642, 21621}, {10646, 21637}, {10650, 21652}, {10654, 21656}, {10658, 21666}, {10662, 21675}, {10666, 21685},
+	{10670, 21703}, {10674, 21715}, {10678, 21728}, {10682, 21745}, {10686, 21752}, {10690, 21763}, {10694, 21776}, {10704, 21788},
+	{10717, 21800}, {10727, 21810}, {10740, 21820}, {10744, 21842}, {10754, 21863}, {10767, 21884}, {10777, 21903}, {10790, 21922},
+	{10794, 21936}, {10804, 21949}, {10820, 21975}, {10839, 22001}, {10852, 22014}, {10871, 22040}, {10893, 22066}, {10903, 22077},
+	{10919, 22101}, {10938, 22125}, {10951, 22136}, {10970, 22160}, {10992, 22184}, {11002, 22211}, {11015, 22238}, {11019, 22252},
+	{11023, 22269}, {11027, 22279}, {11031, 22291}, {11035, 22299}, {11039, 22310}, {11043, 22316}, {11047, 22328}, {11051, 22334},
+	{11055, 22352}, {11059, 22359}, {11063, 22375}, {11067, 22382}, {11071, 22395}, {11075, 22407}, {11079, 22421}, {11086, 22435},
+	{11090, 22448}, {11094, 22461}, {11101, 22474}, {11105, 22486}, {11112, 22498}, {11116, 22501}, {11123, 22504}, {11127, 22520},
+	{11131, 22529}, {11135, 22542}, {11139, 22554}, {11143, 22557}, {11147, 22565}, {11151, 22573}, {11155, 22589}, {11159, 22594},
+	{11163, 22603}, {11167, 22620}, {11174, 22637}, {11178, 22643}, {11185, 22649}, {11189, 22657}, {11196, 22665}, {11200, 22673},
+	{11207, 22681}, {11211, 22694}, {11218, 22707}, {11222, 22717}, {11229, 22727}, {11233, 22741}, {11240, 22755}, {11244, 22773},
+	{11248, 22789}, {11252, 22798}, {11259, 22807}, {11263, 22821}, {11270, 22835}, {11274, 22847}, {11278, 22860}, {11282, 22865},
+	{11286, 22869}, {11290, 22882}, {11294, 22892}, {11298, 22905}, {11302, 22917}, {11306, 22929}, {11310, 22942}, {11314, 22955},
+	{11318, 22967}, {11322, 22980}, {11326, 22992}, {11330, 23002}, {11334, 23013}, {11338, 23026}, {11342, 23039}, {11346, 23051},
+	{11350, 23064}, {11354, 23076}, {11358, 23093}, {11362, 23108}, {11366, 23119}, {11370, 23130}, {11374, 23143}, {11378, 23160},
+	{11382, 23176}, {11386, 23197}, {11390, 23206}, {11394, 23219}, {11398, 23241}, {11402, 23246}, {11406, 23274}, {11410, 23291},
+	{11414, 23303}, {11418, 23322}, {11422, 23338}, {11426, 23355}, {11430, 23364}, {11434, 23379}, {11438, 23395}, {11442, 23410},
+	{11446, 23420}, {11450, 23434}, {11454, 23463}, {11458, 23476}, {11462, 23486}, {11466, 23504}, {11476, 23521}, {11489, 23538},
+	{11499, 23553}, {11512, 23568}, {11516, 23581}, {11520, 23605}, {11524, 23616}, {11528, 23625}, {11532, 23638}, {11536, 23664},
+	{11540, 23689}, {11544, 23702}, {11548, 23716}, {11552, 23730}, {11556, 23744}, {11560, 23761}, {11564, 23767}, {11568, 23773},
+	{11572, 23789}, {11582, 23804}, {11595, 23819}, {11605, 23832}, {11618, 23845}, {11622, 23855}, {11626, 23871}, {11636, 23886},
+	{11649, 23901}, {11659, 23914}, {11672, 23927}, {11676, 23946}, {11686, 23964}, {11699, 23982}, {11709, 23998}, {11722, 24014},
+	{11726, 24029}, {11736, 24043}, {11749, 24057}, {11759, 24069}, {11772, 24081}, {11776, 24095}, {11780, 24111}, {11790, 24126},
+	{11803, 24141}, {11813, 24154}, {11826, 24167}, {11830, 24192}, {11840, 24216}, {11853, 24240}, {11863, 24262}, {11876, 24284},
+	{11880, 24307}, {11890, 24329}, {11903, 24351}, {11913, 24371}, {11926, 24391}, {11930, 24402}, {11934, 24415}, {11938, 24419},
+	{11942, 24435}, {11946, 24448}, {11950, 24453}, {11954, 24461}, {11958, 24476}, {11962, 24491}, {11966, 24506}, {11970, 24518},
+	{11974, 24538}, {11978, 24551}, {11982, 24559}, {11986, 24567}, {11990, 24578}, {11994, 24587}, {11998, 24594}, {12002, 24602},
+	{12006, 24607}, {12010, 24613}, {12014, 24619}, {12018, 24633}, {12022, 24644}, {12026, 24663}, {12030, 24680}, {12034, 24683},
+	{12038, 24696}, {12042, 24703}, {12046, 24713}, {12050, 24721}, {12054, 24729}, {12058, 24743}, {12062, 24754}, {12066, 24764},
+	{12070, 24779}, {12074, 24793}, {12078, 24800}, {12082, 24808}, {12086, 24811}, {12090, 24818}, {12094, 24829}, {12098, 24837},
+	{12102, 24848}, {12106, 24859}, {12110, 24864}, {12114, 24873}, {12118, 24878}, {12122, 24902}, {12126, 24914}, {12130, 24936},
+	{12134, 24949}, {12138, 24959}, {12142, 24967}, {12146, 24976}, {12150, 24981}, {12154, 24995}, {12158, 25018}, {12162, 25031},
+	{12166, 25035}, {12170, 25043}, {12174, 25050}, {12178, 25061}, {12182, 25070}, {12186, 25074}, {12190, 25078}, {12194, 25086},
+	{12198, 25092}, {12202, 25099}, {12206, 25104}, {12210, 25108}, {12214, 25111}, {12218, 25116}, {12222, 25119}, {12226, 25122},
+	{12230, 25131}, {12234, 25135}, {12238, 25142}, {12242, 25148}, {12246, 25158}, {12250, 25164}, {12254, 25169}, {12258, 25176},
+	{12262, 25181}, {12266, 25189}, {12270, 25197}, {12274, 25202}, {12278, 25209}, {12282, 25217}, {12286, 25222}, {12290, 25229},
+	{12294, 25241}, {12298, 25247}, {12302, 25254}, {12306, 25261}, {12310, 25269}, {12314, 25276}, {12318, 25282}, {12322, 25286},
+	{12326, 25293}, {12330, 25297}, {12334, 25302}, {12338, 25307}, {12342, 25316}, {12346, 25321}, {12350, 25329}, {12354, 25335},
+	{12358, 25341}, {12362, 25346}, {12366, 25350}, {12370, 25359}, {12374, 25369}, {12378, 25377}, {12382, 25387}, {12386, 25391},
+	{12390, 25401}, {12394, 25405}, {12398, 25408}, {12402, 25412}, {12406, 25417}, {12410, 25426}, {12420, 25441}, {12433, 25456},
+	{12443, 25469}, {12456, 25482}, {12460, 25494}, {12470, 25512}, {12483, 25530}, {12493, 25546}, {12506, 25562}, {12510, 25573},
+	{12514, 25593}, {12518, 25613}, {12522, 25630}, {12526, 25644}, {12530, 25658}, {12534, 25670}, {12538, 25677}, {12542, 25681},
+	{12546, 25693}, {12550, 25699}, {12554, 25704}, {12558, 25711}, {12562, 25717}, {12566, 25723}, {12570, 25727}, {12574, 25730},
+	{12578, 25740}, {12582, 25745}, {12586, 25760}, {12596, 25774}, {12609, 25788}, {12619, 25800}, {12632, 25812}, {12636, 25827},
+	{12646, 25841}, {12662, 25868}, {12681, 25895}, {12694, 25909}, {12713, 25936}, {12735, 25963}, {12745, 25975}, {12761, 26000},
+	{12780, 26025}, {12793, 26037}, {12812, 26062}, {12834, 26087}, {12844, 26115}, {12857, 26143}, {12861, 26154}, {12871, 26164},
+	{12884, 26174}, {12894, 26182}, {12907, 26190}, {12911, 26207}, {12915, 26213}, {12925, 26226}, {12938, 26239}, {12948, 26244},
+	{12961, 26249}, {12971, 26254}, {12984, 26259}, {13008, 26279}, {13025, 26312}, {13052, 26332}, {13072, 26365}, {13083, 26371},
+	{13094, 26375}, {13105, 26394}, {13116, 26402}, {13127, 26409}, {13138, 26415}, {13149, 26421}, {13160, 26428}, {13171, 26442},
+	{13182, 26454}, {13193, 26467}, {13204, 26475}, {13215, 26484}, {13226, 26493}, {13237, 26504}, {13255, 26524}, {13266, 26546},
+	{13283, 26581}, {13303, 26616}, {13314, 26632}, {13325, 26650}, {13336, 26662}, {13347, 26680}, {13358, 26710}, {13375, 26753},
+	{13395, 26796}, {13406, 26823}, {13423, 26863}, {13443, 26903}, {13461, 26930}, {13486, 26964}, {13497, 26984}, {13515, 27011},
+	{13519, 27016}, {13523, 27028}, {13527, 27041}, {13537, 27053}, {13550, 27065}, {13560, 27075}, {13573, 27085}, {13577, 27105},
+	{13581, 27126}, {13591, 27146}, {13604, 27166}, {13614, 27184}, {13627, 27202}, {13631, 27217}, {13641, 27231}, {13654, 27245},
+	{13664, 27257}, {13677, 27269}, {13681, 27293}, {13691, 27316}, {13704, 27339}, {13714, 27360}, {13727, 27381}, {13731, 27385},
+	{13741, 27395}, {13754, 27405}, {13764, 27413}, {13777, 27421}, {13781, 27426}, {13791, 27437}, {13804, 27448}, {13814, 27457},
+	{13827, 27466}, {13831, 27473}, {13841, 27486}, {13854, 27499}, {13864, 27510}, {13877, 27521}, {13881, 27530}, {13891, 27537},
+	{13904, 27544}, {13914, 27550}, {13927, 27556}, {13931, 27559}, {13941, 27568}, {13954, 27577}, {13964, 27584}, {13977, 27591},
+	{13981, 27596}, {13991, 27607}, {14004, 27618}, {14014, 27627}, {14027, 27636}, {14031, 27642}, {14041, 27654}, {14054, 27666},
+	{14064, 27676}, {14077, 27686}, {14081, 27691}, {14085, 27703}, {14089, 27713}, {14093, 27718}, {14097, 27724}, {14101, 27728},
+	{14105, 27733}, {14109, 27745}, {14113, 27756}, {14117, 27768}, {14121, 27777}, {14125, 27787}, {14129, 27790}, {14133, 27797},
+	{14137, 27803}, {14141, 27820}, {14145, 27827}, {14149, 27832}, {14153, 27838}, {14157, 27845}, {14161, 27858}, {14165, 27864},
+	{14169, 27868}, {14173, 27878}, {14177, 27888}, {14181, 27893}, {14185, 27899}, {14189, 27912}, {14193, 27916}, {14197, 27922},
+	{14201, 27929}, {14205, 27941}, {14209, 27953}, {14213, 27971}, {14217, 27977}, {14221, 27983}, {14225, 27995}, {14229, 28011},
+	{14233, 28021}, {14237, 28031}, {14241, 28044}, {14245, 28060}, {14249, 28071}, {14253, 28076}, {14257, 28082}, {14261, 28087},
+	{14265, 28091}, {14269, 28100}, {14273, 28109}, {14277, 28119}, {14281, 28125}, {14285, 28138}, {14289, 28145}, {14293, 28150},
+	{14297, 28163}, {14301, 28168}, {14305, 28173}, {14309, 28176}, {14313, 28185}, {14317, 28190}, {14321, 28205}, {14325, 28214},
+	{14329, 28223}, {14333, 28227}, {14337, 28240}, {14341, 28251}, {14345, 28257}, {14349, 28261}, {14353, 28267}, {14357, 28273},
+	{14361, 28280}, {14365, 28293}, {14369, 28297}, {14373, 28303}, {14377, 28313}, {14381, 28323}, {14385, 28332}, {14389, 28339},
+	{14393, 28343}, {14397, 28354}, {14401, 28373}, {14405, 28384}, {14409, 28389}, {14413, 28405}, {14417, 28414}, {14421, 28420},
+	{14425, 28423}, {14429, 28427}, {14433, 28433}, {14437, 28442}, {14441, 28454}, {14445, 28458}, {14449, 28465}, {14453, 28470},
+	{14457, 28475}, {14461, 28485}, {14465, 28499}, {14469, 28507}, {14473, 28516}, {14477, 28520}, {14481, 28525}, {14485, 28541},
+	{14489, 28546}, {14493, 28560}, {14497, 28572}, {14501, 28587}, {14505, 28604}, {14509, 28609}, {14513, 28615}, {14517, 28626},
+	{14521, 28637}, {14525, 28642}, {14529, 28651}, {14533, 28657}, {14537, 28663}, {14541, 28669}, {14545, 28683}, {14549, 28688},
+	{14553, 28691}, {14557, 28702}, {14561, 28709}, {14565, 28721}, {14569, 28734}, {14573, 28773}, {14577, 28794}, {14581, 28818},
+	{14585, 28834}, {14589, 28844}, {14593, 28851}, {14597, 28863}, {14601, 28903}, {14605, 28918}, {14616, 28927}, {14620, 28941},
+	{14624, 28955}, {14628, 28967}, {14632, 28995}, {14636, 29006}, {14640, 29028}, {14644, 29051},
+}
+
+// emojiSequences has the sequences of the emoji, without skin tones, in
+// order.
+const emojiSequences = "" +
+	"#\u20e3#\ufe0f\u20e3*\u20e3*\ufe0f\u20e30\u20e30\ufe0f\u20e31\u20e31\ufe0f\u20e32\u20e32\ufe0f\u20e33\u20e33\ufe0f\u20e34\u20e34\ufe0f\u20e35\u20e35\ufe0f\u20e3" +
+	"6\u20e36\ufe0f\u20e37\u20e37\ufe0f\u20e38\u20e38\ufe0f\u20e39\u20e39\ufe0f\u20e3\u00a9\ufe0f\u00ae\ufe0f\u203c\ufe0f\u2049\ufe0f\u2122\ufe0f\u2139\u2139\ufe0f\u2194\ufe0f" +
+	"\u2195\ufe0f\u2196\ufe0f\u2197\ufe0f\u2198\ufe0f\u2199\ufe0f\u21a9\ufe0f\u21aa\ufe0f\u231a\u231b\u2328\u2328\ufe0f\u23cf\u23cf\ufe0f\u23e9\u23ea\u23eb" +
+	"\u23ec\u23ed\u23ed\ufe0f\u23ee\u23ee\ufe0f\u23ef\u23ef\ufe0f\u23f0\u23f1\u23f1\ufe0f\u23f2\u23f2\ufe0f\u23f3\u23f8\u23f8\ufe0f\u23f9" +
+	"\u23f9\ufe0f\u23fa\u23fa\ufe0f\u24c2\ufe0f\u25aa\ufe0f\u25ab\ufe0f\u25b6\ufe0f\u25c0\ufe0f\u25fb\u25fb\ufe0f\u25fc\u25fc\ufe0f\u25fd\u25fe\u2600\u2600\ufe0f" +
+	"\u2601\u2601\ufe0f\u2602\u2602\ufe0f\u2603\u2603\ufe0f\u2604\u2604\ufe0f\u260e\u260e\ufe0f\u2611\u2611\ufe0f\u2614\u2615\u2618\u2618\ufe0f" +
+	"\u261d\u261d\ufe0f\u2620\u2620\ufe0f\u2622\u2622\ufe0f\u2623\u2623\ufe0f\u2626\u2626\ufe0f\u262a\u262a\ufe0f\u262e\u262e\ufe0f\u262f\u262f\ufe0f" +
+	"\u2638\u2638\ufe0f\u2639\u2639\ufe0f\u263a\u263a\ufe0f\u2640\u2640\ufe0f\u2642\u2642\ufe0f\u2648\u2649\u264a\u264b\u264c\u264d" +
+	"\u264e\u264f\u2650\u2651\u2652\u2653\u265f\u265f\ufe0f\u2660\u2660\ufe0f\u2663\u2663\ufe0f\u2665\u2665\ufe0f\u2666\u2666\ufe0f" +
+	"\u2668\u2668\ufe0f\u267b\u267b\ufe0f\u267e\u267e\ufe0f\u267f\u2692\u2692\ufe0f\u2693\u2694\u2694\ufe0f\u2695\u2695\ufe0f\u2696\u2696\ufe0f" +
+	"\u2697\u2697\ufe0f\u2699\u2699\ufe0f\u269b\u269b\ufe0f\u269c\u269c\ufe0f\u26a0\u26a0\ufe0f\u26a1\u26a7\u26a7\ufe0f\u26aa\u26ab\u26b0" +
+	"\u26b0\ufe0f\u26b1\u26b1\ufe0f\u26bd\u26be\u26c4\u26c5\u26c8\u26c8\ufe0f\u26ce\u26cf\u26cf\ufe0f\u26d1\u26d1\ufe0f\u26d3\u26d3\u200d\U0001f4a5" +
+	"\u26d3\ufe0f\u26d3\ufe0f\u200d\U0001f4a5\u26d4\u26e9\u26e9\ufe0f\u26ea\u26f0\u26f0\ufe0f\u26f1\u26f1\ufe0f\u26f2\u26f3\u26f4\u26f4\ufe0f\u26f5\u26f7" +
+	"\u26f7\ufe0f\u26f8\u26f8\ufe0f\u26f9\u26f9\u200d\u2640\u26f9\u200d\u2640\ufe0f\u26f9\u200d\u2642\u26f9\u200d\u2642\ufe0f\u26f9\ufe0f\u26f9\ufe0f\u200d\u2640\u26f9\ufe0f\u200d\u2640\ufe0f\u26f9\ufe0f\u200d\u2642\u26f9\ufe0f\u200d\u2642\ufe0f\u26fa\u26fd\u2702" +
+	"\u2702\ufe0f\u2705\u2708\u2708\ufe0f\u2709\u2709\ufe0f\u270a\u270b\u270c\u270c\ufe0f\u270d\u270d\ufe0f\u270f\u270f\ufe0f\u2712\u2712\ufe0f" +
+	"\u2714\u2714\ufe0f\u2716\u2716\ufe0f\u271d\u271d\ufe0f\u2721\u2721\ufe0f\u2728\u2733\u2733\ufe0f\u2734\u2734\ufe0f\u2744\u2744\ufe0f\u2747" +
+	"\u2747\ufe0f\u274c\u274e\u2753\u2754\u2755\u2757\u2763\u2763\ufe0f\u2764\u2764\u200d\U0001f525\u2764\u200d\U0001fa79\u2764\ufe0f\u2764\ufe0f\u200d\U0001f525\u2764\ufe0f\u200d\U0001fa79\u2795" +
+	"\u2796\u2797\u27a1\u27a1\ufe0f\u27b0\u27bf\u2934\u2934\ufe0f\u2935\u2935\ufe0f\u2b05\u2b05\ufe0f\u2b06\u2b06\ufe0f\u2b07\u2b07\ufe0f" +
+	"\u2b1b\u2b1c\u2b50\u2b55\u3030\ufe0f\u303d\u303d\ufe0f\u3297\ufe0f\u3299\ufe0f\U0001f004\U0001f0cf\U0001f170\U0001f170\ufe0f\U0001f171\U0001f171\ufe0f\U0001f17e" +
+	"\U0001f17e\ufe0f\U0001f17f\U0001f17f\ufe0f\U0001f18e\U0001f191\U0001f192\U0001f193\U0001f194\U0001f195\U0001f196\U0001f197\U0001f198\U0001f199\U0001f19a\U0001f1e6\U0001f1e8\U0001f1e6\U0001f1e9" +
+	"\U0001f1e6\U0001f1ea\U0001f1e6\U0001f1eb\U0001f1e6\U0001f1ec\U0001f1e6\U0001f1ee\U0001f1e6\U0001f1f1\U0001f1e6\U0001f1f2\U0001f1e6\U0001f1f4\U0001f1e6\U0001f1f6\U0001f1e6\U0001f1f7\U0001f1e6\U0001f1f8\U0001f1e6\U0001f1f9\U0001f1e6\U0001f1fa\U0001f1e6\U0001f1fc\U0001f1e6\U0001f1fd\U0001f1e6\U0001f1ff\U0001f1e7\U0001f1e6" +
+	"\U0001f1e7\U0001f1e7\U0001f1e7\U0001f1e9\U0001f1e7\U0001f1ea\U0001f1e7\U0001f1eb\U0001f1e7\U0001f1ec\U0001f1e7\U0001f1ed\U0001f1e7\U0001f1ee\U0001f1e7\U0001f1ef\U0001f1e7\U0001f1f1\U0001f1e7\U0001f1f2\U0001f1e7\U0001f1f3\U0001f1e7\U0001f1f4\U0001f1e7\U0001f1f6\U0001f1e7\U0001f1f7\U0001f1e7\U0001f1f8\U0001f1e7\U0001f1f9" +
+	"\U0001f1e7\U0001f1fb\U0001f1e7\U0001f1fc\U0001f1e7\U0001f1fe\U0001f1e7\U0001f1ff\U0001f1e8\U0001f1e6\U0001f1e8\U0001f1e8\U0001f1e8\U0001f1e9\U0001f1e8\U0001f1eb\U0001f1e8\U0001f1ec\U0001f1e8\U0001f1ed\U0001f1e8\U0001f1ee\U0001f1e8\U0001f1f0\U0001f1e8\U0001f1f1\U0001f1e8\U0001f1f2\U0001f1e8\U0001f1f3\U0001f1e8\U0001f1f4" +
+	"\U0001f1e8\U0001f1f5\U0001f1e8\U0001f1f7\U0001f1e8\U0001f1fa\U0001f1e8\U0001f1fb\U0001f1e8\U0001f1fc\U0001f1e8\U0001f1fd\U0001f1e8\U0001f1fe\U0001f1e8\U0001f1ff\U0001f1e9\U0001f1ea\U0001f1e9\U0001f1ec\U0001f1e9\U0001f1ef\U0001f1e9\U0001f1f0\U0001f1e9\U0001f1f2\U0001f1e9\U0001f1f4\U0001f1e9\U0001f1ff\U0001f1ea\U0001f1e6" +
+	"\U0001f1ea\U0001f1e8\U0001f1ea\U0001f1ea\U0001f1ea\U0001f1ec\U0001f1ea\U0001f1ed\U0001f1ea\U0001f1f7\U0001f1ea\U0001f1f8\U0001f1ea\U0001f1f9\U0001f1ea\U0001f1fa\U0001f1eb\U0001f1ee\U0001f1eb\U0001f1ef\U0001f1eb\U0001f1f0\U0001f1eb\U0001f1f2\U0001f1eb\U0001f1f4\U0001f1eb\U0001f1f7\U0001f1ec\U0001f1e6\U0001f1ec\U0001f1e7" +
+	"\U0001f1ec\U0001f1e9\U0001f1ec\U0001f1ea\U0001f1ec\U0001f1eb\U0001f1ec\U0001f1ec\U0001f1ec\U0001f1ed\U0001f1ec\U0001f1ee\U0001f1ec\U0001f1f1\U0001f1ec\U0001f1f2\U0001f1ec\U0001f1f3\U0001f1ec\U0001f1f5\U0001f1ec\U0001f1f6\U0001f1ec\U0001f1f7\U0001f1ec\U0001f1f8\U0001f1ec\U0001f1f9\U0001f1ec\U0001f1fa\U0001f1ec\U0001f1fc" +
+	"\U0001f1ec\U0001f1fe\U0001f1ed\U0001f1f0\U0001f1ed\U0001f1f2\U0001f1ed\U0001f1f3\U0001f1ed\U0001f1f7\U0001f1ed\U0001f1f9\U0001f1ed\U0001f1fa\U0001f1ee\U0001f1e8\U0001f1ee\U0001f1e9\U0001f1ee\U0001f1ea\U0001f1ee\U0001f1f1\U0001f1ee\U0001f1f2\U0001f1ee\U0001f1f3\U0001f1ee\U0001f1f4\U0001f1ee\U0001f1f6\U0001f1ee\U0001f1f7" +
+	"\U0001f1ee\U0001f1f8\U0001f1ee\U0001f1f9\U0001f1ef\U0001f1ea\U0001f1ef\U0001f1f2\U0001f1ef\U0001f1f4\U0001f1ef\U0001f1f5\U0001f1f0\U0001f1ea\U0001f1f0\U0001f1ec\U0001f1f0\U0001f1ed\U0001f1f0\U0001f1ee\U0001f1f0\U0001f1f2\U0001f1f0\U0001f1f3\U0001f1f0\U0001f1f5\U0001f1f0\U0001f1f7\U0001f1f0\U0001f1fc\U0001f1f0\U0001f1fe" +
+	"\U0001f1f0\U0001f1ff\U0001f1f1\U0001f1e6\U0001f1f1\U0001f1e7\U0001f1f1\U0001f1e8\U0001f1f1\U0001f1ee\U0001f1f1\U0001f1f0\U0001f1f1\U0001f1f7\U0001f1f1\U0001f1f8\U0001f1f1\U0001f1f9\U0001f1f1\U0001f1fa\U0001f1f1\U0001f1fb\U0001f1f1\U0001f1fe\U0001f1f2\U0001f1e6\U0001f1f2\U0001f1e8\U0001f1f2\U0001f1e9\U0001f1f2\U0001f1ea" +
+	"\U0001f1f2\U0001f1eb\U0001f1f2\U0001f1ec\U0001f1f2\U0001f1ed\U0001f1f2\U0001f1f0\U0001f1f2\U0001f1f1\U0001f1f2\U0001f1f2\U0001f1f2\U0001f1f3\U0001f1f2\U0001f1f4\U0001f1f2\U0001f1f5\U0001f1f2\U0001f1f6\U0001f1f2\U0001f1f7\U0001f1f2\U0001f1f8\U0001f1f2\U0001f1f9\U0001f1f2\U0001f1fa\U0001f1f2\U0001f1fb\U0001f1f2\U0001f1fc" +
+	"\U0001f1f2\U0001f1fd\U0001f1f2\U0001f1fe\U0001f1f2\U0001f1ff\U0001f1f3\U0001f1e6\U0001f1f3\U0001f1e8\U0001f1f3\U0001f1ea\U0001f1f3\U0001f1eb\U0001f1f3\U0001f1ec\U0001f1f3\U0001f1ee\U0001f1f3\U0001f1f1\U0001f1f3\U0001f1f4\U0001f1f3\U0001f1f5\U0001f1f3\U0001f1f7\U0001f1f3\U0001f1fa\U0001f1f3\U0001f1ff\U0001f1f4\U0001f1f2" +
+	"\U0001f1f5\U0001f1e6\U0001f1f5\U0001f1ea\U0001f1f5\U0001f1eb\U0001f1f5\U0001f1ec\U0001f1f5\U0001f1ed\U0001f1f5\U0001f1f0\U0001f1f5\U0001f1f1\U0001f1f5\U0001f1f2\U0001f1f5\U0001f1f3\U0001f1f5\U0001f1f7\U0001f1f5\U0001f1f8\U0001f1f5\U0001f1f9\U0001f1f5\U0001f1fc\U0001f1f5\U0001f1fe\U0001f1f6\U0001f1e6\U0001f1f7\U0001f1ea" +
+	"\U0001f1f7\U0001f1f4\U0001f1f7\U0001f1f8\U0001f1f7\U0001f1fa\U0001f1f7\U0001f1fc\U0001f1f8\U0001f1e6\U0001f1f8\U0001f1e7\U0001f1f8\U0001f1e8\U0001f1f8\U0001f1e9\U0001f1f8\U0001f1ea\U0001f1f8\U0001f1ec\U0001f1f8\U0001f1ed\U0001f1f8\U0001f1ee\U0001f1f8\U0001f1ef\U0001f1f8\U0001f1f0\U0001f1f8\U0001f1f1\U0001f1f8\U0001f1f2" +
+	"\U0001f1f8\U0001f1f3\U0001f1f8\U0001f1f4\U0001f1f8\U0001f1f7\U0001f1f8\U0001f1f8\U0001f1f8\U0001f1f9\U0001f1f8\U0001f1fb\U0001f1f8\U0001f1fd\U0001f1f8\U0001f1fe\U0001f1f8\U0001f1ff\U0001f1f9\U0001f1e6\U0001f1f9\U0001f1e8\U0001f1f9\U0001f1e9\U0001f1f9\U0001f1eb\U0001f1f9\U0001f1ec\U0001f1f9\U0001f1ed\U0001f1f9\U0001f1ef" +
+	"\U0001f1f9\U0001f1f0\U0001f1f9\U0001f1f1\U0001f1f9\U0001f1f2\U0001f1f9\U0001f1f3\U0001f1f9\U0001f1f4\U0001f1f9\U0001f1f7\U0001f1f9\U0001f1f9\U0001f1f9\U0001f1fb\U0001f1f9\U0001f1fc\U0001f1f9\U0001f1ff\U0001f1fa\U0001f1e6\U0001f1fa\U0001f1ec\U0001f1fa\U0001f1f2\U0001f1fa\U0001f1f3\U0001f1fa\U0001f1f8\U0001f1fa\U0001f1fe" +
+	"\U0001f1fa\U0001f1ff\U0001f1fb\U0001f1e6\U0001f1fb\U0001f1e8\U0001f1fb\U0001f1ea\U0001f1fb\U0001f1ec\U0001f1fb\U0001f1ee\U0001f1fb\U0001f1f3\U0001f1fb\U0001f1fa\U0001f1fc\U0001f1eb\U0001f1fc\U0001f1f8\U0001f1fd\U0001f1f0\U0001f1fe\U0001f1ea\U0001f1fe\U0001f1f9\U0001f1ff\U0001f1e6\U0001f1ff\U0001f1f2\U0001f1ff\U0001f1fc" +
+	"\U0001f201\U0001f202\U0001f202\ufe0f\U0001f21a\U0001f22f\U0001f232\U0001f233\U0001f234\U0001f235\U0001f236\U0001f237\U0001f237\ufe0f\U0001f238\U0001f239\U0001f23a\U0001f250" +
+	"\U0001f251\U0001f300\U0001f301\U0001f302\U0001f303\U0001f304\U0001f305\U0001f306\U0001f307\U0001f308\U0001f309\U0001f30a\U0001f30b\U0001f30c\U0001f30d\U0001f30e" +
+	"\U0001f30f\U0001f310\U0001f311\U0001f312\U0001f313\U0001f314\U0001f315\U0001f316\U0001f317\U0001f318\U0001f319\U0001f31a\U0001f31b\U0001f31c\U0001f31d\U0001f31e" +
+	"\U0001f31f\U0001f320\U0001f321\U0001f321\ufe0f\U0001f324\U0001f324\ufe0f\U0001f325\U0001f325\ufe0f\U0001f326\U0001f326\ufe0f\U0001f327\U0001f327\ufe0f\U0001f328\U0001f328\ufe0f\U0001f329\U0001f329\ufe0f" +
+	"\U0001f32a\U0001f32a\ufe0f\U0001f32b\U0001f32b\ufe0f\U0001f32c\U0001f32c\ufe0f\U0001f32d\U0001f32e\U0001f32f\U0001f330\U0001f331\U0001f332\U0001f333\U0001f334\U0001f335\U0001f336" +
+	"\U0001f336\ufe0f\U0001f337\U0001f338\U0001f339\U0001f33a\U0001f33b\U0001f33c\U0001f33d\U0001f33e\U0001f33f\U0001f340\U0001f341\U0001f342\U0001f343\U0001f344\U0001f344\u200d\U0001f7eb" +
+	"\U0001f345\U0001f346\U0001f347\U0001f348\U0001f349\U0001f34a\U0001f34b\U0001f34b\u200d\U0001f7e9\U0001f34c\U0001f34d\U0001f34e\U0001f34f\U0001f350\U0001f351\U0001f352\U0001f353" +
+	"\U0001f354\U0001f355\U0001f356\U0001f357\U0001f358\U0001f359\U0001f35a\U0001f35b\U0001f35c\U0001f35d\U0001f35e\U0001f35f\U0001f360\U0001f361\U0001f362\U0001f363" +
+	"\U0001f364\U0001f365\U0001f366\U0001f367\U0001f368\U0001f369\U0001f36a\U0001f36b\U0001f36c\U0001f36d\U0001f36e\U0001f36f\U0001f370\U0001f371\U0001f372\U0001f373" +
+	"\U0001f374\U0001f375\U0001f376\U0001f377\U0001f378\U0001f379\U0001f37a\U0001f37b\U0001f37c\U0001f37d\U0001f37d\ufe0f\U0001f37e\U0001f37f\U0001f380\U0001f381\U0001f382" +
+	"\U0001f383\U0001f384\U0001f385\U0001f386\U0001f387\U0001f388\U0001f389\U0001f38a\U0001f38b\U0001f38c\U0001f38d\U0001f38e\U0001f38f\U0001f390\U0001f391\U0001f392" +
+	"\U0001f393\U0001f396\U0001f396\ufe0f\U0001f397\U0001f397\ufe0f\U0001f399\U0001f399\ufe0f\U0001f39a\U0001f39a\ufe0f\U0001f39b\U0001f39b\ufe0f\U0001f39e\U0001f39e\ufe0f\U0001f39f\U0001f39f\ufe0f\U0001f3a0" +
+	"\U0001f3a1\U0001f3a2\U0001f3a3\U0001f3a4\U0001f3a5\U0001f3a6\U0001f3a7\U0001f3a8\U0001f3a9\U0001f3aa\U0001f3ab\U0001f3ac\U0001f3ad\U0001f3ae\U0001f3af\U0001f3b0" +
+	"\U0001f3b1\U0001f3b2\U0001f3b3\U0001f3b4\U0001f3b5\U0001f3b6\U0001f3b7\U0001f3b8\U0001f3b9\U0001f3ba\U0001f3bb\U0001f3bc\U0001f3bd\U0001f3be\U0001f3bf\U0001f3c0" +
+	"\U0001f3c1\U0001f3c2\U0001f3c3\U0001f3c3\u200d\u2640\U0001f3c3\u200d\u2640\u200d\u27a1\U0001f3c3\u200d\u2640\u200d\u27a1\ufe0f\U0001f3c3\u200d\u2640\ufe0f\U0001f3c3\u200d\u2640\ufe0f\u200d\u27a1\U0001f3c3\u200d\u2640\ufe0f\u200d\u27a1\ufe0f\U0001f3c3\u200d\u2642\U0001f3c3\u200d\u2642\u200d\u27a1\U0001f3c3\u200d\u2642\u200d\u27a1\ufe0f\U0001f3c3\u200d\u2642\ufe0f\U0001f3c3\u200d\u2642\ufe0f\u200d\u27a1\U0001f3c3\u200d\u2642\ufe0f\u200d\u27a1\ufe0f\U0001f3c3\u200d\u27a1" +
+	"\U0001f3c3\u200d\u27a1\ufe0f\U0001f3c4\U0001f3c4\u200d\u2640\U0001f3c4\u200d\u2640\ufe0f\U0001f3c4\u200d\u2642\U0001f3c4\u200d\u2642\ufe0f\U0001f3c5\U0001f3c6\U0001f3c7\U0001f3c8\U0001f3c9\U0001f3ca\U0001f3ca\u200d\u2640\U0001f3ca\u200d\u2640\ufe0f\U0001f3ca\u200d\u2642\U0001f3ca\u200d\u2642\ufe0f" +
+	"\U0001f3cb\U0001f3cb\u200d\u2640\U0001f3cb\u200d\u2640\ufe0f\U0001f3cb\u200d\u2642\U0001f3cb\u200d\u2642\ufe0f\U0001f3cb\ufe0f\U0001f3cb\ufe0f\u200d\u2640\U0001f3cb\ufe0f\u200d\u2640\ufe0f\U0001f3cb\ufe0f\u200d\u2642\U0001f3cb\ufe0f\u200d\u2642\ufe0f\U0001f3cc\U0001f3cc\u200d\u2640\U0001f3cc\u200d\u2640\ufe0f\U0001f3cc\u200d\u2642\U0001f3cc\u200d\u2642\ufe0f\U0001f3cc\ufe0f" +
+	"\U0001f3cc\ufe0f\u200d\u2640\U0001f3cc\ufe0f\u200d\u2640\ufe0f\U0001f3cc\ufe0f\u200d\u2642\U0001f3cc\ufe0f\u200d\u2642\ufe0f\U0001f3cd\U0001f3cd\ufe0f\U0001f3ce\U0001f3ce\ufe0f\U0001f3cf\U0001f3d0\U0001f3d1\U0001f3d2\U0001f3d3\U0001f3d4\U0001f3d4\ufe0f\U0001f3d5" +
+	"\U0001f3d5\ufe0f\U0001f3d6\U0001f3d6\ufe0f\U0001f3d7\U0001f3d7\ufe0f\U0001f3d8\U0001f3d8\ufe0f\U0001f3d9\U0001f3d9\ufe0f\U0001f3da\U0001f3da\ufe0f\U0001f3db\U0001f3db\ufe0f\U0001f3dc\U0001f3dc\ufe0f\U0001f3dd" +
+	"\U0001f3dd\ufe0f\U0001f3de\U0001f3de\ufe0f\U0001f3df\U0001f3df\ufe0f\U0001f3e0\U0001f3e1\U0001f3e2\U0001f3e3\U0001f3e4\U0001f3e5\U0001f3e6\U0001f3e7\U0001f3e8\U0001f3e9\U0001f3ea" +
+	"\U0001f3eb\U0001f3ec\U0001f3ed\U0001f3ee\U0001f3ef\U0001f3f0\U0001f3f3\U0001f3f3\u200d\u26a7\U0001f3f3\u200d\u26a7\ufe0f\U0001f3f3\u200d\U0001f308\U0001f3f3\ufe0f\U0001f3f3\ufe0f\u200d\u26a7\U0001f3f3\ufe0f\u200d\u26a7\ufe0f\U0001f3f3\ufe0f\u200d\U0001f308\U0001f3f4\U0001f3f4\u200d\u2620" +
+	"\U0001f3f4\u200d\u2620\ufe0f\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f\U0001f3f4\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f\U0001f3f5\U0001f3f5\ufe0f\U0001f3f7\U0001f3f7\ufe0f\U0001f3f8\U0001f3f9\U0001f3fa\U0001f400\U0001f401\U0001f402\U0001f403\U0001f404" +
+	"\U0001f405\U0001f406\U0001f407\U0001f408\U0001f408\u200d\u2b1b\U0001f409\U0001f40a\U0001f40b\U0001f40c\U0001f40d\U0001f40e\U0001f40f\U0001f410\U0001f411\U0001f412\U0001f413" +
+	"\U0001f414\U0001f415\U0001f415\u200d\U0001f9ba\U0001f416\U0001f417\U0001f418\U0001f419\U0001f41a\U0001f41b\U0001f41c\U0001f41d\U0001f41e\U0001f41f\U0001f420\U0001f421\U0001f422" +
+	"\U0001f423\U0001f424\U0001f425\U0001f426\U0001f426\u200d\u2b1b\U0001f426\u200d\U0001f525\U0001f427\U0001f428\U0001f429\U0001f42a\U0001f42b\U0001f42c\U0001f42d\U0001f42e\U0001f42f\U0001f430" +
+	"\U0001f431\U0001f432\U0001f433\U0001f434\U0001f435\U0001f436\U0001f437\U0001f438\U0001f439\U0001f43a\U0001f43b\U0001f43b\u200d\u2744\U0001f43b\u200d\u2744\ufe0f\U0001f43c\U0001f43d\U0001f43e" +
+	"\U0001f43f\U0001f43f\ufe0f\U0001f440\U0001f441\U0001f441\u200d\U0001f5e8\U0001f441\u200d\U0001f5e8\ufe0f\U0001f441\ufe0f\U0001f441\ufe0f\u200d\U0001f5e8\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f\U0001f442\U0001f443\U0001f444\U0001f445\U0001f446\U0001f447\U0001f448" +
+	"\U0001f449\U0001f44a\U0001f44b\U0001f44c\U0001f44d\U0001f44e\U0001f44f\U0001f450\U0001f451\U0001f452\U0001f453\U0001f454\U0001f455\U0001f456\U0001f457\U0001f458" +
+	"\U0001f459\U0001f45a\U0001f45b\U0001f45c\U0001f45d\U0001f45e\U0001f45f\U0001f460\U0001f461\U0001f462\U0001f463\U0001f464\U0001f465\U0001f466\U0001f467\U0001f468" +
+	"\U0001f468\u200d\u2695\U0001f468\u200d\u2695\ufe0f\U0001f468\u200d\u2696\U0001f468\u200d\u2696\ufe0f\U0001f468\u200d\u2708\U0001f468\u200d\u2708\ufe0f\U0001f468\u200d\u2764\u200d\U0001f468\U0001f468\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f468\u200d\u2764\ufe0f\u200d\U0001f468\U0001f468\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f468\u200d\U0001f33e\U0001f468\u200d\U0001f373\U0001f468\u200d\U0001f37c\U0001f468\u200d\U0001f393\U0001f468\u200d\U0001f3a4\U0001f468\u200d\U0001f3a8" +
+	"\U0001f468\u200d\U0001f3eb\U0001f468\u200d\U0001f3ed\U0001f468\u200d\U0001f466\U0001f468\u200d\U0001f466\u200d\U0001f466\U0001f468\u200d\U0001f467\U0001f468\u200d\U0001f467\u200d\U0001f466\U0001f468\u200d\U0001f467\u200d\U0001f467\U0001f468\u200d\U0001f468\u200d\U0001f466\U0001f468\u200d\U0001f468\u200d\U0001f466\u200d\U0001f466\U0001f468\u200d\U0001f468\u200d\U0001f467\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f466\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f467\U0001f468\u200d\U0001f469\u200d\U0001f466\U0001f468\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466\U0001f468\u200d\U0001f469\u200d\U0001f467\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466" +
+	"\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467\U0001f468\u200d\U0001f4bb\U0001f468\u200d\U0001f4bc\U0001f468\u200d\U0001f527\U0001f468\u200d\U0001f52c\U0001f468\u200d\U0001f680\U0001f468\u200d\U0001f692\U0001f468\u200d\U0001f91d\u200d\U0001f468\U0001f468\u200d\U0001f9af\U0001f468\u200d\U0001f9af\u200d\u27a1\U0001f468\u200d\U0001f9af\u200d\u27a1\ufe0f\U0001f468\u200d\U0001f9b0\U0001f468\u200d\U0001f9b1\U0001f468\u200d\U0001f9b2\U0001f468\u200d\U0001f9b3\U0001f468\u200d\U0001f9bc" +
+	"\U0001f468\u200d\U0001f9bc\u200d\u27a1\U0001f468\u200d\U0001f9bc\u200d\u27a1\ufe0f\U0001f468\u200d\U0001f9bd\U0001f468\u200d\U0001f9bd\u200d\u27a1\U0001f468\u200d\U0001f9bd\u200d\u27a1\ufe0f\U0001f469\U0001f469\u200d\u2695\U0001f469\u200d\u2695\ufe0f\U0001f469\u200d\u2696\U0001f469\u200d\u2696\ufe0f\U0001f469\u200d\u2708\U0001f469\u200d\u2708\ufe0f\U0001f469\u200d\u2764\u200d\U0001f468\U0001f469\u200d\u2764\u200d\U0001f469\U0001f469\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f469\u200d\u2764\u200d\U0001f48b\u200d\U0001f469" +
+	"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f468\U0001f469\u200d\u2764\ufe0f\u200d\U0001f469\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f469\u200d\U0001f33e\U0001f469\u200d\U0001f373\U0001f469\u200d\U0001f37c\U0001f469\u200d\U0001f393\U0001f469\u200d\U0001f3a4\U0001f469\u200d\U0001f3a8\U0001f469\u200d\U0001f3eb\U0001f469\u200d\U0001f3ed\U0001f469\u200d\U0001f466\U0001f469\u200d\U0001f466\u200d\U0001f466\U0001f469\u200d\U0001f467\U0001f469\u200d\U0001f467\u200d\U0001f466" +
+	"\U0001f469\u200d\U0001f467\u200d\U0001f467\U0001f469\u200d\U0001f469\u200d\U0001f466\U0001f469\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466\U0001f469\u200d\U0001f469\u200d\U0001f467\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467\U0001f469\u200d\U0001f4bb\U0001f469\u200d\U0001f4bc\U0001f469\u200d\U0001f527\U0001f469\u200d\U0001f52c\U0001f469\u200d\U0001f680\U0001f469\u200d\U0001f692\U0001f469\u200d\U0001f91d\u200d\U0001f468\U0001f469\u200d\U0001f91d\u200d\U0001f469\U0001f469\u200d\U0001f9af\U0001f469\u200d\U0001f9af\u200d\u27a1" +
+	"\U0001f469\u200d\U0001f9af\u200d\u27a1\ufe0f\U0001f469\u200d\U0001f9b0\U0001f469\u200d\U0001f9b1\U0001f469\u200d\U0001f9b2\U0001f469\u200d\U0001f9b3\U0001f469\u200d\U0001f9bc\U0001f469\u200d\U0001f9bc\u200d\u27a1\U0001f469\u200d\U0001f9bc\u200d\u27a1\ufe0f\U0001f469\u200d\U0001f9bd\U0001f469\u200d\U0001f9bd\u200d\u27a1\U0001f469\u200d\U0001f9bd\u200d\u27a1\ufe0f\U0001f46a\U0001f46b\U0001f46c\U0001f46d\U0001f46e" +
+	"\U0001f46e\u200d\u2640\U0001f46e\u200d\u2640\ufe0f\U0001f46e\u200d\u2642\U0001f46e\u200d\u2642\ufe0f\U0001f46f\U0001f46f\u200d\u2640\U0001f46f\u200d\u2640\ufe0f\U0001f46f\u200d\u2642\U0001f46f\u200d\u2642\ufe0f\U0001f470\U0001f470\u200d\u2640\U0001f470\u200d\u2640\ufe0f\U0001f470\u200d\u2642\U0001f470\u200d\u2642\ufe0f\U0001f471\U0001f471\u200d\u2640" +
+	"\U0001f471\u200d\u2640\ufe0f\U0001f471\u200d\u2642\U0001f471\u200d\u2642\ufe0f\U0001f472\U0001f473\U0001f473\u200d\u2640\U0001f473\u200d\u2640\ufe0f\U0001f473\u200d\u2642\U0001f473\u200d\u2642\ufe0f\U0001f474\U0001f475\U0001f476\U0001f477\U0001f477\u200d\u2640\U0001f477\u200d\u2640\ufe0f\U0001f477\u200d\u2642" +
+	"\U0001f477\u200d\u2642\ufe0f\U0001f478\U0001f479\U0001f47a\U0001f47b\U0001f47c\U0001f47d\U0001f47e\U0001f47f\U0001f480\U0001f481\U0001f481\u200d\u2640\U0001f481\u200d\u2640\ufe0f\U0001f481\u200d\u2642\U0001f481\u200d\u2642\ufe0f\U0001f482" +
+	"\U0001f482\u200d\u2640\U0001f482\u200d\u2640\ufe0f\U0001f482\u200d\u2642\U0001f482\u200d\u2642\ufe0f\U0001f483\U0001f484\U0001f485\U0001f486\U0001f486\u200d\u2640\U0001f486\u200d\u2640\ufe0f\U0001f486\u200d\u2642\U0001f486\u200d\u2642\ufe0f\U0001f487\U0001f487\u200d\u2640\U0001f487\u200d\u2640\ufe0f\U0001f487\u200d\u2642" +
+	"\U0001f487\u200d\u2642\ufe0f\U0001f488\U0001f489\U0001f48a\U0001f48b\U0001f48c\U0001f48d\U0001f48e\U0001f48f\U0001f490\U0001f491\U0001f492\U0001f493\U0001f494\U0001f495\U0001f496" +
+	"\U0001f497\U0001f498\U0001f499\U0001f49a\U0001f49b\U0001f49c\U0001f49d\U0001f49e\U0001f49f\U0001f4a0\U0001f4a1\U0001f4a2\U0001f4a3\U0001f4a4\U0001f4a5\U0001f4a6" +
+	"\U0001f4a7\U0001f4a8\U0001f4a9\U0001f4aa\U0001f4ab\U0001f4ac\U0001f4ad\U0001f4ae\U0001f4af\U0001f4b0\U0001f4b1\U0001f4b2\U0001f4b3\U0001f4b4\U0001f4b5\U0001f4b6" +
+	"\U0001f4b7\U0001f4b8\U0001f4b9\U0001f4ba\U0001f4bb\U0001f4bc\U0001f4bd\U0001f4be\U0001f4bf\U0001f4c0\U0001f4c1\U0001f4c2\U0001f4c3\U0001f4c4\U0001f4c5\U0001f4c6" +
+	"\U0001f4c7\U0001f4c8\U0001f4c9\U0001f4ca\U0001f4cb\U0001f4cc\U0001f4cd\U0001f4ce\U0001f4cf\U0001f4d0\U0001f4d1\U0001f4d2\U0001f4d3\U0001f4d4\U0001f4d5\U0001f4d6" +
+	"\U0001f4d7\U0001f4d8\U0001f4d9\U0001f4da\U0001f4db\U0001f4dc\U0001f4dd\U0001f4de\U0001f4df\U0001f4e0\U0001f4e1\U0001f4e2\U0001f4e3\U0001f4e4\U0001f4e5\U0001f4e6" +
+	"\U0001f4e7\U0001f4e8\U0001f4e9\U0001f4ea\U0001f4eb\U0001f4ec\U0001f4ed\U0001f4ee\U0001f4ef\U0001f4f0\U0001f4f1\U0001f4f2\U0001f4f3\U0001f4f4\U0001f4f5\U0001f4f6" +
+	"\U0001f4f7\U0001f4f8\U0001f4f9\U0001f4fa\U0001f4fb\U0001f4fc\U0001f4fd\U0001f4fd\ufe0f\U0001f4ff\U0001f500\U0001f501\U0001f502\U0001f503\U0001f504\U0001f505\U0001f506" +
+	"\U0001f507\U0001f508\U0001f509\U0001f50a\U0001f50b\U0001f50c\U0001f50d\U0001f50e\U0001f50f\U0001f510\U0001f511\U0001f512\U0001f513\U0001f514\U0001f515\U0001f516" +
+	"\U0001f517\U0001f518\U0001f519\U0001f51a\U0001f51b\U0001f51c\U0001f51d\U0001f51e\U0001f51f\U0001f520\U0001f521\U0001f522\U0001f523\U0001f524\U0001f525\U0001f526" +
+	"\U0001f527\U0001f528\U0001f529\U0001f52a\U0001f52b\U0001f52c\U0001f52d\U0001f52e\U0001f52f\U0001f530\U0001f531\U0001f532\U0001f533\U0001f534\U0001f535\U0001f536" +
+	"\U0001f537\U0001f538\U0001f539\U0001f53a\U0001f53b\U0001f53c\U0001f53d\U0001f549\U0001f549\ufe0f\U0001f54a\U0001f54a\ufe0f\U0001f54b\U0001f54c\U0001f54d\U0001f54e\U0001f550" +
+	"\U0001f551\U0001f552\U0001f553\U0001f554\U0001f555\U0001f556\U0001f557\U0001f558\U0001f559\U0001f55a\U0001f55b\U0001f55c\U0001f55d\U0001f55e\U0001f55f\U0001f560" +
+	"\U0001f561\U0001f562\U0001f563\U0001f564\U0001f565\U0001f566\U0001f567\U0001f56f\U0001f56f\ufe0f\U0001f570\U0001f570\ufe0f\U0001f573\U0001f573\ufe0f\U0001f574\U0001f574\ufe0f\U0001f575" +
+	"\U0001f575\u200d\u2640\U0001f575\u200d\u2640\ufe0f\U0001f575\u200d\u2642\U0001f575\u200d\u2642\ufe0f\U0001f575\ufe0f\U0001f575\ufe0f\u200d\u2640\U0001f575\ufe0f\u200d\u2640\ufe0f\U0001f575\ufe0f\u200d\u2642\U0001f575\ufe0f\u200d\u2642\ufe0f\U0001f576\U0001f576\ufe0f\U0001f577\U0001f577\ufe0f\U0001f578\U0001f578\ufe0f\U0001f579" +
+	"\U0001f579\ufe0f\U0001f57a\U0001f587\U0001f587\ufe0f\U0001f58a\U0001f58a\ufe0f\U0001f58b\U0001f58b\ufe0f\U0001f58c\U0001f58c\ufe0f\U0001f58d\U0001f58d\ufe0f\U0001f590\U0001f590\ufe0f\U0001f595\U0001f596" +
+	"\U0001f5a4\U0001f5a5\U0001f5a5\ufe0f\U0001f5a8\U0001f5a8\ufe0f\U0001f5b1\U0001f5b1\ufe0f\U0001f5b2\U0001f5b2\ufe0f\U0001f5bc\U0001f5bc\ufe0f\U0001f5c2\U0001f5c2\ufe0f\U0001f5c3\U0001f5c3\ufe0f\U0001f5c4" +
+	"\U0001f5c4\ufe0f\U0001f5d1\U0001f5d1\ufe0f\U0001f5d2\U0001f5d2\ufe0f\U0001f5d3\U0001f5d3\ufe0f\U0001f5dc\U0001f5dc\ufe0f\U0001f5dd\U0001f5dd\ufe0f\U0001f5de\U0001f5de\ufe0f\U0001f5e1\U0001f5e1\ufe0f\U0001f5e3" +
+	"\U0001f5e3\ufe0f\U0001f5e8\U0001f5e8\ufe0f\U0001f5ef\U0001f5ef\ufe0f\U0001f5f3\U0001f5f3\ufe0f\U0001f5fa\U0001f5fa\ufe0f\U0001f5fb\U0001f5fc\U0001f5fd\U0001f5fe\U0001f5ff\U0001f600\U0001f601" +
+	"\U0001f602\U0001f603\U0001f604\U0001f605\U0001f606\U0001f607\U0001f608\U0001f609\U0001f60a\U0001f60b\U0001f60c\U0001f60d\U0001f60e\U0001f60f\U0001f610\U0001f611" +
+	"\U0001f612\U0001f613\U0001f614\U0001f615\U0001f616\U0001f617\U0001f618\U0001f619\U0001f61a\U0001f61b\U0001f61c\U0001f61d\U0001f61e\U0001f61f\U0001f620\U0001f621" +
+	"\U0001f622\U0001f623\U0001f624\U0001f625\U0001f626\U0001f627\U0001f628\U0001f629\U0001f62a\U0001f62b\U0001f62c\U0001f62d\U0001f62e\U0001f62e\u200d\U0001f4a8\U0001f62f\U0001f630" +
+	"\U0001f631\U0001f632\U0001f633\U0001f634\U0001f635\U0001f635\u200d\U0001f4ab\U0001f636\U0001f636\u200d\U0001f32b\U0001f636\u200d\U0001f32b\ufe0f\U0001f637\U0001f638\U0001f639\U0001f63a\U0001f63b\U0001f63c\U0001f63d" +
+	"\U0001f63e\U0001f63f\U0001f640\U0001f641\U0001f642\U0001f642\u200d\u2194\U0001f642\u200d\u2194\ufe0f\U0001f642\u200d\u2195\U0001f642\u200d\u2195\ufe0f\U0001f643\U0001f644\U0001f645\U0001f645\u200d\u2640\U0001f645\u200d\u2640\ufe0f\U0001f645\u200d\u2642\U0001f645\u200d\u2642\ufe0f" +
+	"\U0001f646\U0001f646\u200d\u2640\U0001f646\u200d\u2640\ufe0f\U0001f646\u200d\u2642\U0001f646\u200d\u2642\ufe0f\U0001f647\U0001f647\u200d\u2640\U0001f647\u200d\u2640\ufe0f\U0001f647\u200d\u2642\U0001f647\u200d\u2642\ufe0f\U0001f648\U0001f649\U0001f64a\U0001f64b\U0001f64b\u200d\u2640\U0001f64b\u200d\u2640\ufe0f" +
+	"\U0001f64b\u200d\u2642\U0001f64b\u200d\u2642\ufe0f\U0001f64c\U0001f64d\U0001f64d\u200d\u2640\U0001f64d\u200d\u2640\ufe0f\U0001f64d\u200d\u2642\U0001f64d\u200d\u2642\ufe0f\U0001f64e\U0001f64e\u200d\u2640\U0001f64e\u200d\u2640\ufe0f\U0001f64e\u200d\u2642\U0001f64e\u200d\u2642\ufe0f\U0001f64f\U0001f680\U0001f681" +
+	"\U0001f682\U0001f683\U0001f684\U0001f685\U0001f686\U0001f687\U0001f688\U0001f689\U0001f68a\U0001f68b\U0001f68c\U0001f68d\U0001f68e\U0001f68f\U0001f690\U0001f691" +
+	"\U0001f692\U0001f693\U0001f694\U0001f695\U0001f696\U0001f697\U0001f698\U0001f699\U0001f69a\U0001f69b\U0001f69c\U0001f69d\U0001f69e\U0001f69f\U0001f6a0\U0001f6a1" +
+	"\U0001f6a2\U0001f6a3\U0001f6a3\u200d\u2640\U0001f6a3\u200d\u2640\ufe0f\U0001f6a3\u200d\u2642\U0001f6a3\u200d\u2642\ufe0f\U0001f6a4\U0001f6a5\U0001f6a6\U0001f6a7\U0001f6a8\U0001f6a9\U0001f6aa\U0001f6ab\U0001f6ac\U0001f6ad" +
+	"\U0001f6ae\U0001f6af\U0001f6b0\U0001f6b1\U0001f6b2\U0001f6b3\U0001f6b4\U0001f6b4\u200d\u2640\U0001f6b4\u200d\u2640\ufe0f\U0001f6b4\u200d\u2642\U0001f6b4\u200d\u2642\ufe0f\U0001f6b5\U0001f6b5\u200d\u2640\U0001f6b5\u200d\u2640\ufe0f\U0001f6b5\u200d\u2642\U0001f6b5\u200d\u2642\ufe0f" +
+	"\U0001f6b6\U0001f6b6\u200d\u2640\U0001f6b6\u200d\u2640\u200d\u27a1\U0001f6b6\u200d\u2640\u200d\u27a1\ufe0f\U0001f6b6\u200d\u2640\ufe0f\U0001f6b6\u200d\u2640\ufe0f\u200d\u27a1\U0001f6b6\u200d\u2640\ufe0f\u200d\u27a1\ufe0f\U0001f6b6\u200d\u2642\U0001f6b6\u200d\u2642\u200d\u27a1\U0001f6b6\u200d\u2642\u200d\u27a1\ufe0f\U0001f6b6\u200d\u2642\ufe0f\U0001f6b6\u200d\u2642\ufe0f\u200d\u27a1\U0001f6b6\u200d\u2642\ufe0f\u200d\u27a1\ufe0f\U0001f6b6\u200d\u27a1\U0001f6b6\u200d\u27a1\ufe0f\U0001f6b7" +
+	"\U0001f6b8\U0001f6b9\U0001f6ba\U0001f6bb\U0001f6bc\U0001f6bd\U0001f6be\U0001f6bf\U0001f6c0\U0001f6c1\U0001f6c2\U0001f6c3\U0001f6c4\U0001f6c5\U0001f6cb\U0001f6cb\ufe0f" +
+	"\U0001f6cc\U0001f6cd\U0001f6cd\ufe0f\U0001f6ce\U0001f6ce\ufe0f\U0001f6cf\U0001f6cf\ufe0f\U0001f6d0\U0001f6d1\U0001f6d2\U0001f6d5\U0001f6d6\U0001f6d7\U0001f6dc\U0001f6dd\U0001f6de" +
+	"\U0001f6df\U0001f6e0\U0001f6e0\ufe0f\U0001f6e1\U0001f6e1\ufe0f\U0001f6e2\U0001f6e2\ufe0f\U0001f6e3\U0001f6e3\ufe0f\U0001f6e4\U0001f6e4\ufe0f\U0001f6e5\U0001f6e5\ufe0f\U0001f6e9\U0001f6e9\ufe0f\U0001f6eb" +
+	"\U0001f6ec\U0001f6f0\U0001f6f0\ufe0f\U0001f6f3\U0001f6f3\ufe0f\U0001f6f4\U0001f6f5\U0001f6f6\U0001f6f7\U0001f6f8\U0001f6f9\U0001f6fa\U0001f6fb\U0001f6fc\U0001f7e0\U0001f7e1" +
+	"\U0001f7e2\U0001f7e3\U0001f7e4\U0001f7e5\U0001f7e6\U0001f7e7\U0001f7e8\U0001f7e9\U0001f7ea\U0001f7eb\U0001f7f0\U0001f90c\U0001f90d\U0001f90e\U0001f90f\U0001f910" +
+	"\U0001f911\U0001f912\U0001f913\U0001f914\U0001f915\U0001f916\U0001f917\U0001f918\U0001f919\U0001f91a\U0001f91b\U0001f91c\U0001f91d\U0001f91e\U0001f91f\U0001f920" +
+	"\U0001f921\U0001f922\U0001f923\U0001f924\U0001f925\U0001f926\U0001f926\u200d\u2640\U0001f926\u200d\u2640\ufe0f\U0001f926\u200d\u2642\U0001f926\u200d\u2642\ufe0f\U0001f927\U0001f928\U0001f929\U0001f92a\U0001f92b\U0001f92c" +
+	"\U0001f92d\U0001f92e\U0001f92f\U0001f930\U0001f931\U0001f932\U0001f933\U0001f934\U0001f935\U0001f935\u200d\u2640\U0001f935\u200d\u2640\ufe0f\U0001f935\u200d\u2642\U0001f935\u200d\u2642\ufe0f\U0001f936\U0001f937\U0001f937\u200d\u2640" +
+	"\U0001f937\u200d\u2640\ufe0f\U0001f937\u200d\u2642\U0001f937\u200d\u2642\ufe0f\U0001f938\U0001f938\u200d\u2640\U0001f938\u200d\u2640\ufe0f\U0001f938\u200d\u2642\U0001f938\u200d\u2642\ufe0f\U0001f939\U0001f939\u200d\u2640\U0001f939\u200d\u2640\ufe0f\U0001f939\u200d\u2642\U0001f939\u200d\u2642\ufe0f\U0001f93a\U0001f93c\U0001f93c\u200d\u2640" +
+	"\U0001f93c\u200d\u2640\ufe0f\U0001f93c\u200d\u2642\U0001f93c\u200d\u2642\ufe0f\U0001f93d\U0001f93d\u200d\u2640\U0001f93d\u200d\u2640\ufe0f\U0001f93d\u200d\u2642\U0001f93d\u200d\u2642\ufe0f\U0001f93e\U0001f93e\u200d\u2640\U0001f93e\u200d\u2640\ufe0f\U0001f93e\u200d\u2642\U0001f93e\u200d\u2642\ufe0f\U0001f93f\U0001f940\U0001f941" +
+	"\U0001f942\U0001f943\U0001f944\U0001f945\U0001f947\U0001f948\U0001f949\U0001f94a\U0001f94b\U0001f94c\U0001f94d\U0001f94e\U0001f94f\U0001f950\U0001f951\U0001f952" +
+	"\U0001f953\U0001f954\U0001f955\U0001f956\U0001f957\U0001f958\U0001f959\U0001f95a\U0001f95b\U0001f95c\U0001f95d\U0001f95e\U0001f95f\U0001f960\U0001f961\U0001f962" +
+	"\U0001f963\U0001f964\U0001f965\U0001f966\U0001f967\U0001f968\U0001f969\U0001f96a\U0001f96b\U0001f96c\U0001f96d\U0001f96e\U0001f96f\U0001f970\U0001f971\U0001f972" +
+	"\U0001f973\U0001f974\U0001f975\U0001f976\U0001f977\U0001f978\U0001f979\U0001f97a\U0001f97b\U0001f97c\U0001f97d\U0001f97e\U0001f97f\U0001f980\U0001f981\U0001f982" +
+	"\U0001f983\U0001f984\U0001f985\U0001f986\U0001f987\U0001f988\U0001f989\U0001f98a\U0001f98b\U0001f98c\U0001f98d\U0001f98e\U0001f98f\U0001f990\U0001f991\U0001f992" +
+	"\U0001f993\U0001f994\U0001f995\U0001f996\U0001f997\U0001f998\U0001f999\U0001f99a\U0001f99b\U0001f99c\U0001f99d\U0001f99e\U0001f99f\U0001f9a0\U0001f9a1\U0001f9a2" +
+	"\U0001f9a3\U0001f9a4\U0001f9a5\U0001f9a6\U0001f9a7\U0001f9a8\U0001f9a9\U0001f9aa\U0001f9ab\U0001f9ac\U0001f9ad\U0001f9ae\U0001f9af\U0001f9b0\U0001f9b1\U0001f9b2" +
+	"\U0001f9b3\U0001f9b4\U0001f9b5\U0001f9b6\U0001f9b7\U0001f9b8\U0001f9b8\u200d\u2640\U0001f9b8\u200d\u2640\ufe0f\U0001f9b8\u200d\u2642\U0001f9b8\u200d\u2642\ufe0f\U0001f9b9\U0001f9b9\u200d\u2640\U0001f9b9\u200d\u2640\ufe0f\U0001f9b9\u200d\u2642\U0001f9b9\u200d\u2642\ufe0f\U0001f9ba" +
+	"\U0001f9bb\U0001f9bc\U0001f9bd\U0001f9be\U0001f9bf\U0001f9c0\U0001f9c1\U0001f9c2\U0001f9c3\U0001f9c4\U0001f9c5\U0001f9c6\U0001f9c7\U0001f9c8\U0001f9c9\U0001f9ca" +
+	"\U0001f9cb\U0001f9cc\U0001f9cd\U0001f9cd\u200d\u2640\U0001f9cd\u200d\u2640\ufe0f\U0001f9cd\u200d\u2642\U0001f9cd\u200d\u2642\ufe0f\U0001f9ce\U0001f9ce\u200d\u2640\U0001f9ce\u200d\u2640\u200d\u27a1\U0001f9ce\u200d\u2640\u200d\u27a1\ufe0f\U0001f9ce\u200d\u2640\ufe0f\U0001f9ce\u200d\u2640\ufe0f\u200d\u27a1\U0001f9ce\u200d\u2640\ufe0f\u200d\u27a1\ufe0f\U0001f9ce\u200d\u2642\U0001f9ce\u200d\u2642\u200d\u27a1" +
+	"\U0001f9ce\u200d\u2642\u200d\u27a1\ufe0f\U0001f9ce\u200d\u2642\ufe0f\U0001f9ce\u200d\u2642\ufe0f\u200d\u27a1\U0001f9ce\u200d\u2642\ufe0f\u200d\u27a1\ufe0f\U0001f9ce\u200d\u27a1\U0001f9ce\u200d\u27a1\ufe0f\U0001f9cf\U0001f9cf\u200d\u2640\U0001f9cf\u200d\u2640\ufe0f\U0001f9cf\u200d\u2642\U0001f9cf\u200d\u2642\ufe0f\U0001f9d0\U0001f9d1\U0001f9d1\u200d\u2695\U0001f9d1\u200d\u2695\ufe0f\U0001f9d1\u200d\u2696" +
+	"\U0001f9d1\u200d\u2696\ufe0f\U0001f9d1\u200d\u2708\U0001f9d1\u200d\u2708\ufe0f\U0001f9d1\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f9d1\u200d\u2764\u200d\U0001f9d1\U0001f9d1\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f9d1\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f9d1\u200d\U0001f33e\U0001f9d1\u200d\U0001f373\U0001f9d1\u200d\U0001f37c\U0001f9d1\u200d\U0001f384\U0001f9d1\u200d\U0001f393\U0001f9d1\u200d\U0001f3a4\U0001f9d1\u200d\U0001f3a8\U0001f9d1\u200d\U0001f3eb\U0001f9d1\u200d\U0001f3ed" +
+	"\U0001f9d1\u200d\U0001f4bb\U0001f9d1\u200d\U0001f4bc\U0001f9d1\u200d\U0001f527\U0001f9d1\u200d\U0001f52c\U0001f9d1\u200d\U0001f680\U0001f9d1\u200d\U0001f692\U0001f9d1\u200d\U0001f91d\u200d\U0001f9d1\U0001f9d1\u200d\U0001f9af\U0001f9d1\u200d\U0001f9af\u200d\u27a1\U0001f9d1\u200d\U0001f9af\u200d\u27a1\ufe0f\U0001f9d1\u200d\U0001f9b0\U0001f9d1\u200d\U0001f9b1\U0001f9d1\u200d\U0001f9b2\U0001f9d1\u200d\U0001f9b3\U0001f9d1\u200d\U0001f9bc\U0001f9d1\u200d\U0001f9bc\u200d\u27a1" +
+	"\U0001f9d1\u200d\U0001f9bc\u200d\u27a1\ufe0f\U0001f9d1\u200d\U0001f9bd\U0001f9d1\u200d\U0001f9bd\u200d\u27a1\U0001f9d1\u200d\U0001f9bd\u200d\u27a1\ufe0f\U0001f9d1\u200d\U0001f9d1\u200d\U0001f9d2\U0001f9d1\u200d\U0001f9d1\u200d\U0001f9d2\u200d\U0001f9d2\U0001f9d1\u200d\U0001f9d2\U0001f9d1\u200d\U0001f9d2\u200d\U0001f9d2\U0001f9d2\U0001f9d3\U0001f9d4\U0001f9d4\u200d\u2640\U0001f9d4\u200d\u2640\ufe0f\U0001f9d4\u200d\u2642\U0001f9d4\u200d\u2642\ufe0f\U0001f9d5" +
+	"\U0001f9d6\U0001f9d6\u200d\u2640\U0001f9d6\u200d\u2640\ufe0f\U0001f9d6\u200d\u2642\U0001f9d6\u200d\u2642\ufe0f\U0001f9d7\U0001f9d7\u200d\u2640\U0001f9d7\u200d\u2640\ufe0f\U0001f9d7\u200d\u2642\U0001f9d7\u200d\u2642\ufe0f\U0001f9d8\U0001f9d8\u200d\u2640\U0001f9d8\u200d\u2640\ufe0f\U0001f9d8\u200d\u2642\U0001f9d8\u200d\u2642\ufe0f\U0001f9d9" +
+	"\U0001f9d9\u200d\u2640\U0001f9d9\u200d\u2640\ufe0f\U0001f9d9\u200d\u2642\U0001f9d9\u200d\u2642\ufe0f\U0001f9da\U0001f9da\u200d\u2640\U0001f9da\u200d\u2640\ufe0f\U0001f9da\u200d\u2642\U0001f9da\u200d\u2642\ufe0f\U0001f9db\U0001f9db\u200d\u2640\U0001f9db\u200d\u2640\ufe0f\U0001f9db\u200d\u2642\U0001f9db\u200d\u2642\ufe0f\U0001f9dc\U0001f9dc\u200d\u2640" +
+	"\U0001f9dc\u200d\u2640\ufe0f\U0001f9dc\u200d\u2642\U0001f9dc\u200d\u2642\ufe0f\U0001f9dd\U0001f9dd\u200d\u2640\U0001f9dd\u200d\u2640\ufe0f\U0001f9dd\u200d\u2642\U0001f9dd\u200d\u2642\ufe0f\U0001f9de\U0001f9de\u200d\u2640\U0001f9de\u200d\u2640\ufe0f\U0001f9de\u200d\u2642\U0001f9de\u200d\u2642\ufe0f\U0001f9df\U0001f9df\u200d\u2640\U0001f9df\u200d\u2640\ufe0f" +
+	"\U0001f9df\u200d\u2642\U0001f9df\u200d\u2642\ufe0f\U0001f9e0\U0001f9e1\U0001f9e2\U0001f9e3\U0001f9e4\U0001f9e5\U0001f9e6\U0001f9e7\U0001f9e8\U0001f9e9\U0001f9ea\U0001f9eb\U0001f9ec\U0001f9ed" +
+	"\U0001f9ee\U0001f9ef\U0001f9f0\U0001f9f1\U0001f9f2\U0001f9f3\U0001f9f4\U0001f9f5\U0001f9f6\U0001f9f7\U0001f9f8\U0001f9f9\U0001f9fa\U0001f9fb\U0001f9fc\U0001f9fd" +
+	"\U0001f9fe\U0001f9ff\U0001fa70\U0001fa71\U0001fa72\U0001fa73\U0001fa74\U0001fa75\U0001fa76\U0001fa77\U0001fa78\U0001fa79\U0001fa7a\U0001fa7b\U0001fa7c\U0001fa80" +
+	"\U0001fa81\U0001fa82\U0001fa83\U0001fa84\U0001fa85\U0001fa86\U0001fa87\U0001fa88\U0001fa90\U0001fa91\U0001fa92\U0001fa93\U0001fa94\U0001fa95\U0001fa96\U0001fa97" +
+	"\U0001fa98\U0001fa99\U0001fa9a\U0001fa9b\U0001fa9c\U0001fa9d\U0001fa9e\U0001fa9f\U0001faa0\U0001faa1\U0001faa2\U0001faa3\U0001faa4\U0001faa5\U0001faa6\U0001faa7" +
+	"\U0001faa8\U0001faa9\U0001faaa\U0001faab\U0001faac\U0001faad\U0001faae\U0001faaf\U0001fab0\U0001fab1\U0001fab2\U0001fab3\U0001fab4\U0001fab5\U0001fab6\U0001fab7" +
+	"\U0001fab8\U0001fab9\U0001faba\U0001fabb\U0001fabc\U0001fabd\U0001fabf\U0001fac0\U0001fac1\U0001fac2\U0001fac3\U0001fac4\U0001fac5\U0001face\U0001facf\U0001fad0" +
+	"\U0001fad1\U0001fad2\U0001fad3\U0001fad4\U0001fad5\U0001fad6\U0001fad7\U0001fad8\U0001fad9\U0001fada\U0001fadb\U0001fae0\U0001fae1\U0001fae2\U0001fae3\U0001fae4" +
+	"\U0001fae5\U0001fae6\U0001fae7\U0001fae8\U0001faf0\U0001faf1\U0001faf1\u200d\U0001faf2\U0001faf2\U0001faf3\U0001faf4\U0001faf5\U0001faf6\U0001faf7\U0001faf8" +
+	""
+
+// emojiNames has the names of the emoji, in the order of their
+// sequences.
+const emojiNames = "" +
+	"keycap: #keycap: #keycap: *keycap: *keycap: 0keycap: 0keycap: 1keycap: 1keycap: 2keycap: 2keycap: 3keycap: 3keycap: 4keycap: 4keycap: 5keycap: 5" +
+	"keycap: 6keycap: 6keycap: 7keycap: 7keycap: 8keycap: 8keycap: 9keycap: 9copyrightregistereddouble exclamation markexclamation question marktrade markinformationinformationleft-right arrow" +
+	"up-down arrowup-left arrowup-right arrowdown-right arrowdown-left arrowright arrow curving leftleft arrow curving rightwatchhourglass donekeyboardkeyboardeject buttoneject buttonfast-forward buttonfast reverse buttonfast up button" +
+	"fast down buttonnext track buttonnext track buttonlast track buttonlast track buttonplay or pause buttonplay or pause buttonalarm clockstopwatchstopwatchtimer clocktimer clockhourglass not donepause buttonpause buttonstop button" +
+	"stop buttonrecord buttonrecord buttoncircled Mblack small squarewhite small squareplay buttonreverse buttonwhite medium squarewhite medium squareblack medium squareblack medium squarewhite medium-small squareblack medium-small squaresunsun" +
+	"cloudcloudumbrellaumbrellasnowmansnowmancometcomettelephonetelephonecheck box with checkcheck box with checkumbrella with rain dropshot beverageshamrockshamrock" +
+	"index pointing upindex pointing upskull and crossbonesskull and crossbonesradioactiveradioactivebiohazardbiohazardorthodox crossorthodox crossstar and crescentstar and crescentpeace symbolpeace symbolyin yangyin yang" +
+	"wheel of dharmawheel of dharmafrowning facefrowning facesmiling facesmiling facefemale signfemale signmale signmale signAriesTaurusGeminiCancerLeoVirgo" +
+	"LibraScorpioSagittariusCapricornAquariusPisceschess pawnchess pawnspade suitspade suitclub suitclub suitheart suitheart suitdiamond suitdiamond suit" +
+	"hot springshot springsrecycling symbolrecycling symbolinfinityinfinitywheelchair symbolhammer and pickhammer and pickanchorcrossed swordscrossed swordsmedical symbolmedical symbolbalance scalebalance scale" +
+	"alembicalembicgeargearatom symbolatom symbolfleur-de-lisfleur-de-liswarningwarninghigh voltagetransgender symboltransgender symbolwhite circleblack circlecoffin" +
+	"coffinfuneral urnfuneral urnsoccer ballbaseballsnowman without snowsun behind cloudcloud with lightning and raincloud with lightning and rainOphiuchuspickpickrescue worker's helmetrescue worker's helmetchainsbroken chain" +
+	"chainsbroken chainno entryshinto shrineshinto shrinechurchmountainmountainumbrella on groundumbrella on groundfountainflag in holeferryferrysailboatskier" +
+	"skierice skateice skateperson bouncing ballwoman bouncing ballwoman bouncing ballman bouncing ballman bouncing ballperson bouncing ballwoman bouncing ballwoman bouncing ballman bouncing ballman bouncing balltentfuel pumpscissors" +
+	"scissorscheck mark buttonairplaneairplaneenvelopeenveloperaised fistraised handvictory handvictory handwriting handwriting handpencilpencilblack nibblack nib" +
+	"check markcheck markmultiplymultiplylatin crosslatin crossstar of Davidstar of Davidsparkleseight-spoked asteriskeight-spoked asteriskeight-pointed stareight-pointed starsnowflakesnowflakesparkle" +
+	"sparklecross markcross mark buttonred question markwhite question markwhite exclamation markred exclamation markheart exclamationheart exclamationred heartheart on firemending heartred heartheart on firemending heartplus" +
+	"minusdivideright arrowright arrowcurly loopdouble curly loopright arrow curving upright arrow curving upright arrow curving downright arrow curving downleft arrowleft arrowup arrowup arrowdown arrowdown arrow" +
+	"black large squarewhite large squarestarhollow red circlewavy dashpart alternation markpart alternation markJapanese \"congratulations\" buttonJapanese \"secret\" buttonmahjong red dragonjokerA button (blood type)A button (blood type)B button (blood type)B button (blood type)O button (blood type)" +
+	"O button (blood type)P buttonP buttonAB button (blood type)CL buttonCOOL buttonFREE buttonID buttonNEW buttonNG buttonOK buttonSOS buttonUP! buttonVS buttonAscension IslandAndorra" +
+	"United Arab EmiratesAfghanistanAntigua & BarbudaAnguillaAlbaniaArmeniaAngolaAntarcticaArgentinaAmerican SamoaAustriaAustraliaArubaAland IslandsAzerbaijanBosnia & Herzegovina" +
+	"BarbadosBangladeshBelgiumBurkina FasoBulgariaBahrainBurundiBeninSt. BarthelemyBermudaBruneiBoliviaCaribbean NetherlandsBrazilBahamasBhutan" +
+	"Bouvet IslandBotswanaBelarusBelizeCanadaCocos (Keeling) IslandsCongo - KinshasaCentral African RepublicCongo - BrazzavilleSwitzerlandCote d'IvoireCook IslandsChileCameroonChinaColombia" +
+	"Clipperton IslandCosta RicaCubaCape VerdeCuracaoChristmas IslandCyprusCzechiaGermanyDiego GarciaDjiboutiDenmarkDominicaDominican RepublicAlgeriaCeuta & Melilla" +
+	"EcuadorEstoniaEgyptWestern SaharaEritreaSpainEthiopiaEuropean UnionFinlandFijiFalkland IslandsMicronesiaFaroe IslandsFranceGabonUnited Kingdom" +
+	"GrenadaGeorgiaFrench GuianaGuernseyGhanaGibraltarGreenlandGambiaGuineaGuadeloupeEquatorial GuineaGreeceSouth Georgia & South Sandwich IslandsGuatemalaGuamGuinea-Bissau" +
+	"GuyanaHong Kong SAR ChinaHeard & McDonald IslandsHondurasCroatiaHaitiHungaryCanary IslandsIndonesiaIrelandIsraelIsle of ManIndiaBritish Indian Ocean TerritoryIraqIran" +
+	"IcelandItalyJerseyJamaicaJordanJapanKenyaKyrgyzstanCambodiaKiribatiComorosSt. Kitts & NevisNorth KoreaSouth KoreaKuwaitCayman Islands" +
+	"KazakhstanLaosLebanonSt. LuciaLiechtensteinSri LankaLiberiaLesothoLithuaniaLuxembourgLatviaLibyaMoroccoMonacoMoldovaMontenegro" +
+	"St. MartinMadagascarMarshall IslandsNorth MacedoniaMaliMyanmar (Burma)MongoliaMacao SAR ChinaNorthern Mariana IslandsMartiniqueMauritaniaMontserratMaltaMauritiusMaldivesMalawi" +
+	"MexicoMalaysiaMozambiqueNamibiaNew CaledoniaNigerNorfolk IslandNigeriaNicaraguaNetherlandsNorwayNepalNauruNiueNew ZealandOman" +
+	"PanamaPeruFrench PolynesiaPapua New GuineaPhilippinesPakistanPolandSt. Pierre & MiquelonPitcairn IslandsPuerto RicoPalestinian TerritoriesPortugalPalauParaguayQatarReunion" +
+	"RomaniaSerbiaRussiaRwandaSaudi ArabiaSolomon IslandsSeychellesSudanSwedenSingaporeSt. HelenaSloveniaSvalbard & Jan MayenSlovakiaSierra LeoneSan Marino" +
+	"SenegalSomaliaSurinameSouth SudanSao Tome & PrincipeEl SalvadorSint MaartenSyriaEswatiniTristan da CunhaTurks & Caicos IslandsChadFrench Southern TerritoriesTogoThailandTajikistan" +
+	"TokelauTimor-LesteTurkmenistanTunisiaTongaTurkiyeTrinidad & TobagoTuvaluTaiwanTanzaniaUkraineUgandaU.S. Outlying IslandsUnited NationsUnited StatesUruguay" +
+	"UzbekistanVatican CitySt. Vincent & GrenadinesVenezuelaBritish Virgin IslandsU.S. Virgin IslandsVietnamVanuatuWallis & FutunaSamoaKosovoYemenMayotteSouth AfricaZambiaZimbabwe" +
+	"Japanese \"here\" buttonJapanese \"service charge\" buttonJapanese \"service charge\" buttonJapanese \"free of charge\" buttonJapanese \"reserved\" buttonJapanese \"prohibited\" buttonJapanese \"vacancy\" buttonJapanese \"passing grade\" buttonJapanese \"no vacancy\" buttonJapanese \"not free of charge\" buttonJapanese \"monthly amount\" buttonJapanese \"monthly amount\" buttonJapanese \"application\" buttonJapanese \"discount\" buttonJapanese \"open for business\" buttonJapanese \"bargain\" button" +
+	"Japanese \"acceptable\" buttoncyclonefoggyclosed umbrellanight with starssunrise over mountainssunrisecityscape at dusksunsetrainbowbridge at nightwater wavevolcanomilky wayglobe showing Europe-Africaglobe showing Americas" +
+	"globe showing Asia-Australiaglobe with meridiansnew moonwaxing crescent moonfirst quarter moonwaxing gibbous moonfull moonwaning gibbous moonlast quarter moonwaning crescent mooncrescent moonnew moon facefirst quarter moon facelast quarter moon facefull moon facesun with face" +
+	"glowing starshooting starthermometerthermometersun behind small cloudsun behind small cloudsun behind large cloudsun behind large cloudsun behind rain cloudsun behind rain cloudcloud with raincloud with raincloud with snowcloud with snowcloud with lightningcloud with lightning" +
+	"tornadotornadofogfogwind facewind facehot dogtacoburritochestnutseedlingevergreen treedeciduous treepalm treecactushot pepper" +
+	"hot peppertulipcherry blossomrosehibiscussunflowerblossomear of cornsheaf of riceherbfour leaf clovermaple leaffallen leafleaf fluttering in windmushroombrown mushroom" +
+	"tomatoeggplantgrapesmelonwatermelontangerinelemonlimebananapineapplered applegreen applepearpeachcherriesstrawberry" +
+	"hamburgerpizzameat on bonepoultry legrice crackerrice ballcooked ricecurry ricesteaming bowlspaghettibreadfrench friesroasted sweet potatodangoodensushi" +
+	"fried shrimpfish cake with swirlsoft ice creamshaved iceice creamdoughnutcookiechocolate barcandylollipopcustardhoney potshortcakebento boxpot of foodcooking" +
+	"fork and knifeteacup without handlesakewine glasscocktail glasstropical drinkbeer mugclinking beer mugsbaby bottlefork and knife with platefork and knife with platebottle with popping corkpopcornribbonwrapped giftbirthday cake" +
+	"jack-o-lanternChristmas treeSanta Clausfireworkssparklerballoonparty popperconfetti balltanabata treecrossed flagspine decorationJapanese dollscarp streamerwind chimemoon viewing ceremonybackpack" +
+	"graduation capmilitary medalmilitary medalreminder ribbonreminder ribbonstudio microphonestudio microphonelevel sliderlevel slidercontrol knobscontrol knobsfilm framesfilm framesadmission ticketsadmission ticketscarousel horse" +
+	"ferris wheelroller coasterfishing polemicrophonemovie cameracinemaheadphoneartist palettetop hatcircus tentticketclapper boardperforming artsvideo gamebullseyeslot machine" +
+	"pool 8 ballgame diebowlingflower playing cardsmusical notemusical notessaxophoneguitarmusical keyboardtrumpetviolinmusical scorerunning shirttennisskisbasketball" +
+	"chequered flagsnowboarderperson runningwoman runningwoman running facing rightwoman running facing rightwoman runningwoman running facing rightwoman running facing rightman runningman running facing rightman running facing rightman runningman running facing rightman running facing rightperson running facing right" +
+	"person running facing rightperson surfingwoman surfingwoman surfingman surfingman surfingsports medaltrophyhorse racingamerican footballrugby footballperson swimmingwoman swimmingwoman swimmingman swimmingman swimming" +
+	"person lifting weightswoman lifting weightswoman lifting weightsman lifting weightsman lifting weightsperson lifting weightswoman lifting weightswoman lifting weightsman lifting weightsman lifting weightsperson golfingwoman golfingwoman golfingman golfingman golfingperson golfing" +
+	"woman golfingwoman golfingman golfingman golfingmotorcyclemotorcycleracing carracing carcricket gamevolleyballfield hockeyice hockeyping pongsnow-capped mountainsnow-capped mountaincamping" +
+	"campingbeach with umbrellabeach with umbrellabuilding constructionbuilding constructionhouseshousescityscapecityscapederelict housederelict houseclassical buildingclassical buildingdesertdesertdesert island" +
+	"desert islandnational parknational parkstadiumstadiumhousehouse with gardenoffice buildingJapanese post officepost officehospitalbankATM signhotellove hotelconvenience store" +
+	"schooldepartment storefactoryred paper lanternJapanese castlecastlewhite flagtransgender flagtransgender flagrainbow flagwhite flagtransgender flagtransgender flagrainbow flagblack flagpirate flag" +
+	"pirate flagEnglandScotlandWalesrosetterosettelabellabelbadmintonbow and arrowamphoraratmouseoxwater buffalocow" +
+	"tigerleopardrabbitcatblack catdragoncrocodilewhalesnailsnakehorseramgoatewemonkeyrooster" +
+	"chickendogservice dogpigboarelephantoctopusspiral shellbuganthoneybeelady beetlefishtropical fishblowfishturtle" +
+	"hatching chickbaby chickfront-facing baby chickbirdblack birdphoenixpenguinkoalapoodlecameltwo-hump cameldolphinmouse facecow facetiger facerabbit face" +
+	"cat facedragon facespouting whalehorse facemonkey facedog facepig facefroghamsterwolfbearpolar bearpolar bearpandapig nosepaw prints" +
+	"chipmunkchipmunkeyeseyeeye in speech bubbleeye in speech bubbleeyeeye in speech bubbleeye in speech bubbleearnosemouthtonguebackhand index pointing upbackhand index pointing downbackhand index pointing left" +
+	"backhand index pointing rightoncoming fistwaving handOK handthumbs upthumbs downclapping handsopen handscrownwoman's hatglassesnecktiet-shirtjeansdresskimono" +
+	"bikiniwoman's clothespursehandbagclutch bagman's shoerunning shoehigh-heeled shoewoman's sandalwoman's bootfootprintsbust in silhouettebusts in silhouetteboygirlman" +
+	"man health workerman health workerman judgeman judgeman pilotman pilotcouple with heart: man, mankiss: man, mancouple with heart: man, mankiss: man, manman farmerman cookman feeding babyman studentman singerman artist" +
+	"man teacherman factory workerfamily: man, boyfamily: man, boy, boyfamily: man, girlfamily: man, girl, boyfamily: man, girl, girlfamily: man, man, boyfamily: man, man, boy, boyfamily: man, man, girlfamily: man, man, girl, boyfamily: man, man, girl, girlfamily: man, woman, boyfamily: man, woman, boy, boyfamily: man, woman, girlfamily: man, woman, girl, boy" +
+	"family: man, woman, girl, girlman technologistman office workerman mechanicman scientistman astronautman firefightermen holding handsman with white caneman with white cane facing rightman with white cane facing rightman: red hairman: curly hairman: baldman: white hairman in motorized wheelchair" +
+	"man in motorized wheelchair facing rightman in motorized wheelchair facing rightman in manual wheelchairman in manual wheelchair facing rightman in manual wheelchair facing rightwomanwoman health workerwoman health workerwoman judgewoman judgewoman pilotwoman pilotcouple with heart: woman, mancouple with heart: woman, womankiss: woman, mankiss: woman, woman" +
+	"couple with heart: woman, mancouple with heart: woman, womankiss: woman, mankiss: woman, womanwoman farmerwoman cookwoman feeding babywoman studentwoman singerwoman artistwoman teacherwoman factory workerfamily: woman, boyfamily: woman, boy, boyfamily: woman, girlfamily: woman, girl, boy" +
+	"family: woman, girl, girlfamily: woman, woman, boyfamily: woman, woman, boy, boyfamily: woman, woman, girlfamily: woman, woman, girl, boyfamily: woman, woman, girl, girlwoman technologistwoman office workerwoman mechanicwoman scientistwoman astronautwoman firefighterwoman and man holding handswomen holding handswoman with white canewoman with white cane facing right" +
+	"woman with white cane facing rightwoman: red hairwoman: curly hairwoman: baldwoman: white hairwoman in motorized wheelchairwoman in motorized wheelchair facing rightwoman in motorized wheelchair facing rightwoman in manual wheelchairwoman in manual wheelchair facing rightwoman in manual wheelchair facing rightfamilywoman and man holding handsmen holding handswomen holding handspolice officer" +
+	"woman police officerwoman police officerman police officerman police officerpeople with bunny earswomen with bunny earswomen with bunny earsmen with bunny earsmen with bunny earsperson with veilwoman with veilwoman with veilman with veilman with veilperson: blond hairwoman: blond hair" +
+	"woman: blond hairman: blond hairman: blond hairperson with skullcapperson wearing turbanwoman wearing turbanwoman wearing turbanman wearing turbanman wearing turbanold manold womanbabyconstruction workerwoman construction workerwoman construction workerman construction worker" +
+	"man construction workerprincessogregoblinghostbaby angelalienalien monsterangry face with hornsskullperson tipping handwoman tipping handwoman tipping handman tipping handman tipping handguard" +
+	"woman guardwoman guardman guardman guardwoman dancinglipsticknail polishperson getting massagewoman getting massagewoman getting massageman getting massageman getting massageperson getting haircutwoman getting haircutwoman getting haircutman getting haircut" +
+	"man getting haircutbarber polesyringepillkiss marklove letterringgem stonekissbouquetcouple with heartweddingbeating heartbroken hearttwo heartssparkling heart" +
+	"growing heartheart with arrowblue heartgreen heartyellow heartpurple heartheart with ribbonrevolving heartsheart decorationdiamond with a dotlight bulbanger symbolbombZZZcollisionsweat droplets" +
+	"dropletdashing awaypile of pooflexed bicepsdizzyspeech balloonthought balloonwhite flowerhundred pointsmoney bagcurrency exchangeheavy dollar signcredit cardyen banknotedollar banknoteeuro banknote" +
+	"pound banknotemoney with wingschart increasing with yenseatlaptopbriefcasecomputer diskfloppy diskoptical diskdvdfile folderopen file folderpage with curlpage facing upcalendartear-off calendar" +
+	"card indexchart increasingchart decreasingbar chartclipboardpushpinround pushpinpaperclipstraight rulertriangular rulerbookmark tabsledgernotebooknotebook with decorative coverclosed bookopen book" +
+	"green bookblue bookorange bookbooksname badgescrollmemotelephone receiverpagerfax machinesatellite antennaloudspeakermegaphoneoutbox trayinbox traypackage" +
+	"e-mailincoming envelopeenvelope with arrowclosed mailbox with lowered flagclosed mailbox with raised flagopen mailbox with raised flagopen mailbox with lowered flagpostboxpostal hornnewspapermobile phonemobile phone with arrowvibration modemobile phone offno mobile phonesantenna bars" +
+	"cameracamera with flashvideo cameratelevisionradiovideocassettefilm projectorfilm projectorprayer beadsshuffle tracks buttonrepeat buttonrepeat single buttonclockwise vertical arrowscounterclockwise arrows buttondim buttonbright button" +
+	"muted speakerspeaker low volumespeaker medium volumespeaker high volumebatteryelectric plugmagnifying glass tilted leftmagnifying glass tilted rightlocked with penlocked with keykeylockedunlockedbellbell with slashbookmark" +
+	"linkradio buttonBACK arrowEND arrowON! arrowSOON arrowTOP arrowno one under eighteenkeycap: 10input latin uppercaseinput latin lowercaseinput numbersinput symbolsinput latin lettersfireflashlight" +
+	"wrenchhammernut and boltkitchen knifewater pistolmicroscopetelescopecrystal balldotted six-pointed starJapanese symbol for beginnertrident emblemblack square buttonwhite square buttonred circleblue circlelarge orange diamond" +
+	"large blue diamondsmall orange diamondsmall blue diamondred triangle pointed upred triangle pointed downupwards buttondownwards buttonomomdovedovekaabamosquesynagoguemenorahone o'clock" +
+	"two o'clockthree o'clockfour o'clockfive o'clocksix o'clockseven o'clockeight o'clocknine o'clockten o'clockeleven o'clocktwelve o'clockone-thirtytwo-thirtythree-thirtyfour-thirtyfive-thirty" +
+	"six-thirtyseven-thirtyeight-thirtynine-thirtyten-thirtyeleven-thirtytwelve-thirtycandlecandlemantelpiece clockmantelpiece clockholeholeperson in suit levitatingperson in suit levitatingdetective" +
+	"woman detectivewoman detectiveman detectiveman detectivedetectivewoman detectivewoman detectiveman detectiveman detectivesunglassessunglassesspiderspiderspider webspider webjoystick" +
+	"joystickman dancinglinked paperclipslinked paperclipspenpenfountain penfountain penpaintbrushpaintbrushcrayoncrayonhand with fingers splayedhand with fingers splayedmiddle fingervulcan salute" +
+	"black heartdesktop computerdesktop computerprinterprintercomputer mousecomputer mousetrackballtrackballframed pictureframed picturecard index dividerscard index dividerscard file boxcard file boxfile cabinet" +
+	"file cabinetwastebasketwastebasketspiral notepadspiral notepadspiral calendarspiral calendarclampclampold keyold keyrolled-up newspaperrolled-up newspaperdaggerdaggerspeaking head" +
+	"speaking headleft speech bubbleleft speech bubbleright anger bubbleright anger bubbleballot box with ballotballot box with ballotworld mapworld mapmount fujiTokyo towerStatue of Libertymap of Japanmoaigrinning facebeaming face with smiling eyes" +
+	"face with tears of joygrinning face with big eyesgrinning face with smiling eyesgrinning face with sweatgrinning squinting facesmiling face with halosmiling face with hornswinking facesmiling face with smiling eyesface savoring foodrelieved facesmiling face with heart-eyessmiling face with sunglassessmirking faceneutral faceexpressionless face" +
+	"unamused facedowncast face with sweatpensive faceconfused faceconfounded facekissing faceface blowing a kisskissing face with smiling eyeskissing face with closed eyesface with tonguewinking face with tonguesquinting face with tonguedisappointed faceworried faceangry faceenraged face" +
+	"crying facepersevering faceface with steam from nosesad but relieved facefrowning face with open mouthanguished facefearful faceweary facesleepy facetired facegrimacing faceloudly crying faceface with open mouthface exhalinghushed faceanxious face with sweat" +
+	"face screaming in fearastonished faceflushed facesleeping faceface with crossed-out eyesface with spiral eyesface without mouthface in cloudsface in cloudsface with medical maskgrinning cat with smiling eyescat with tears of joygrinning catsmiling cat with heart-eyescat with wry smilekissing cat" +
+	"pouting catcrying catweary catslightly frowning faceslightly smiling facehead shaking horizontallyhead shaking horizontallyhead shaking verticallyhead shaking verticallyupside-down faceface with rolling eyesperson gesturing NOwoman gesturing NOwoman gesturing NOman gesturing NOman gesturing NO" +
+	"person gesturing OKwoman gesturing OKwoman gesturing OKman gesturing OKman gesturing OKperson bowingwoman bowingwoman bowingman bowingman bowingsee-no-evil monkeyhear-no-evil monkeyspeak-no-evil monkeyperson raising handwoman raising handwoman raising hand" +
+	"man raising handman raising handraising handsperson frowningwoman frowningwoman frowningman frowningman frowningperson poutingwoman poutingwoman poutingman poutingman poutingfolded handsrockethelicopter" +
+	"locomotiverailway carhigh-speed trainbullet traintrainmetrolight railstationtramtram carbusoncoming bustrolleybusbus stopminibusambulance" +
+	"fire enginepolice caroncoming police cartaxioncoming taxiautomobileoncoming automobilesport utility vehicledelivery truckarticulated lorrytractormonorailmountain railwaysuspension railwaymountain cablewayaerial tramway" +
+	"shipperson rowing boatwoman rowing boatwoman rowing boatman rowing boatman rowing boatspeedboathorizontal traffic lightvertical traffic lightconstructionpolice car lighttriangular flagdoorprohibitedcigaretteno smoking" +
+	"litter in bin signno litteringpotable waternon-potable waterbicycleno bicyclesperson bikingwoman bikingwoman bikingman bikingman bikingperson mountain bikingwoman mountain bikingwoman mountain bikingman mountain bikingman mountain biking" +
+	"person walkingwoman walkingwoman walking facing rightwoman walking facing rightwoman walkingwoman walking facing rightwoman walking facing rightman walkingman walking facing rightman walking facing rightman walkingman walking facing rightman walking facing rightperson walking facing rightperson walking facing rightno pedestrians" +
+	"children crossingmen's roomwomen's roomrestroombaby symboltoiletwater closetshowerperson taking bathbathtubpassport controlcustomsbaggage claimleft luggagecouch and lampcouch and lamp" +
+	"person in bedshopping bagsshopping bagsbellhop bellbellhop bellbedbedplace of worshipstop signshopping carthindu templehutelevatorwirelessplayground slidewheel" +
+	"ring buoyhammer and wrenchhammer and wrenchshieldshieldoil drumoil drummotorwaymotorwayrailway trackrailway trackmotor boatmotor boatsmall airplanesmall airplaneairplane departure" +
+	"airplane arrivalsatellitesatellitepassenger shippassenger shipkick scootermotor scootercanoesledflying saucerskateboardauto rickshawpickup truckroller skateorange circleyellow circle" +
+	"green circlepurple circlebrown circlered squareblue squareorange squareyellow squaregreen squarepurple squarebrown squareheavy equals signpinched fingerswhite heartbrown heartpinching handzipper-mouth face" +
+	"money-mouth faceface with thermometernerd facethinking faceface with head-bandagerobotsmiling face with open handssign of the hornscall me handraised back of handleft-facing fistright-facing fisthandshakecrossed fingerslove-you gesturecowboy hat face" +
+	"clown facenauseated facerolling on the floor laughingdrooling facelying faceperson facepalmingwoman facepalmingwoman facepalmingman facepalmingman facepalmingsneezing faceface with raised eyebrowstar-struckzany faceshushing faceface with symbols on mouth" +
+	"face with hand over mouthface vomitingexploding headpregnant womanbreast-feedingpalms up togetherselfieprinceperson in tuxedowoman in tuxedowoman in tuxedoman in tuxedoman in tuxedoMrs. Clausperson shruggingwoman shrugging" +
+	"woman shruggingman shruggingman shruggingperson cartwheelingwoman cartwheelingwoman cartwheelingman cartwheelingman cartwheelingperson jugglingwoman jugglingwoman jugglingman jugglingman jugglingperson fencingpeople wrestlingwomen wrestling" +
+	"women wrestlingmen wrestlingmen wrestlingperson playing water polowoman playing water polowoman playing water poloman playing water poloman playing water poloperson playing handballwoman playing handballwoman playing handballman playing handballman playing handballdiving maskwilted flowerdrum" +
+	"clinking glassestumbler glassspoongoal net1st place medal2nd place medal3rd place medalboxing glovemartial arts uniformcurling stonelacrossesoftballflying disccroissantavocadocucumber" +
+	"baconpotatocarrotbaguette breadgreen saladshallow pan of foodstuffed flatbreadeggglass of milkpeanutskiwi fruitpancakesdumplingfortune cookietakeout boxchopsticks" +
+	"bowl with spooncup with strawcoconutbroccolipiepretzelcut of meatsandwichcanned foodleafy greenmangomoon cakebagelsmiling face with heartsyawning facesmiling face with tear" +
+	"partying facewoozy facehot facecold faceninjadisguised faceface holding back tearspleading facesarilab coatgoggleshiking bootflat shoecrablionscorpion" +
+	"turkeyunicorneagleduckbatsharkowlfoxbutterflydeergorillalizardrhinocerosshrimpsquidgiraffe" +
+	"zebrahedgehogsauropodT-Rexcricketkangaroollamapeacockhippopotamusparrotraccoonlobstermosquitomicrobebadgerswan" +
+	"mammothdodoslothotterorangutanskunkflamingooysterbeaverbisonsealguide dogwhite canered haircurly hairbald" +
+	"white hairbonelegfoottoothsuperherowoman superherowoman superheroman superheroman superherosupervillainwoman supervillainwoman supervillainman supervillainman supervillainsafety vest" +
+	"ear with hearing aidmotorized wheelchairmanual wheelchairmechanical armmechanical legcheese wedgecupcakesaltbeverage boxgarliconionfalafelwafflebuttermateice" +
+	"bubble teatrollperson standingwoman standingwoman standingman standingman standingperson kneelingwoman kneelingwoman kneeling facing rightwoman kneeling facing rightwoman kneelingwoman kneeling facing rightwoman kneeling facing rightman kneelingman kneeling facing right" +
+	"man kneeling facing rightman kneelingman kneeling facing rightman kneeling facing rightperson kneeling facing rightperson kneeling facing rightdeaf persondeaf womandeaf womandeaf mandeaf manface with monoclepersonhealth workerhealth workerjudge" +
+	"judgepilotpilotkiss: person, personcouple with heart: person, personkiss: person, personcouple with heart: person, personfarmercookperson feeding babymx clausstudentsingerartistteacherfactory worker" +
+	"technologistoffice workermechanicscientistastronautfirefighterpeople holding handsperson with white caneperson with white cane facing rightperson with white cane facing rightperson: red hairperson: curly hairperson: baldperson: white hairperson in motorized wheelchairperson in motorized wheelchair facing right" +
+	"person in motorized wheelchair facing rightperson in manual wheelchairperson in manual wheelchair facing rightperson in manual wheelchair facing rightfamily: adult, adult, childfamily: adult, adult, child, childfamily: adult, childfamily: adult, child, childchildolder personperson: beardwoman: beardwoman: beardman: beardman: beardwoman with headscarf" +
+	"person in steamy roomwoman in steamy roomwoman in steamy roomman in steamy roomman in steamy roomperson climbingwoman climbingwoman climbingman climbingman climbingperson in lotus positionwoman in lotus positionwoman in lotus positionman in lotus positionman in lotus positionmage" +
+	"woman magewoman mageman mageman magefairywoman fairywoman fairyman fairyman fairyvampirewoman vampirewoman vampireman vampireman vampiremerpersonmermaid" +
+	"mermaidmermanmermanelfwoman elfwoman elfman elfman elfgeniewoman geniewoman genieman genieman geniezombiewoman zombiewoman zombie" +
+	"man zombieman zombiebrainorange heartbilled capscarfglovescoatsocksred envelopefirecrackerpuzzle piecetest tubepetri dishdnacompass" +
+	"abacusfire extinguishertoolboxbrickmagnetluggagelotion bottlethreadyarnsafety pinteddy bearbroombasketroll of papersoapsponge" +
+	"receiptnazar amuletballet shoesone-piece swimsuitbriefsshortsthong sandallight blue heartgrey heartpink heartdrop of bloodadhesive bandagestethoscopex-raycrutchyo-yo" +
+	"kiteparachuteboomerangmagic wandpinatanesting dollsmaracasfluteringed planetchairrazoraxediya lampbanjomilitary helmetaccordion" +
+	"long drumcoincarpentry sawscrewdriverladderhookmirrorwindowplungersewing needleknotbucketmouse traptoothbrushheadstoneplacard" +
+	"rockmirror ballidentification cardlow batteryhamsafolding hand fanhair pickkhandaflywormbeetlecockroachpotted plantwoodfeatherlotus" +
+	"coralempty nestnest with eggshyacinthjellyfishwinggooseanatomical heartlungspeople huggingpregnant manpregnant personperson with crownmoosedonkeyblueberries" +
+	"bell pepperoliveflatbreadtamalefondueteapotpouring liquidbeansjarginger rootpea podmelting facesaluting faceface with open eyes and hand over mouthface with peeking eyeface with diagonal mouth" +
+	"dotted line facebiting lipbubblesshaking facehand with index finger and thumb crossedrightwards handhandshakeleftwards handpalm down handpalm up handindex pointing at the viewerheart handsleftwards pushing handrightwards pushing hand" +
+	""
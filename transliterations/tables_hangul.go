@@ -0,0 +1,199 @@
+//go:build !slugify_nohangul
+// +build !slugify_nohangul
+
+// Code generated by go run gen.go; DO NOT EDIT.
+
+package transliterations
+
+// hangulTables has the tables of Hangul, which the slugify_nohangul build tag
+// leaves out.
+var hangulTables = &packedTables{
+	sections: []uint16{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5,
+	},
+	starts: []tableStart{
+		{0, 0},       // 0x011
+		{648, 257},   // 0x031
+		{1166, 514},  // 0x0a9
+		{1899, 771},  // 0x0d7
+		{3383, 1028}, // 0x0ff
+		{3769, 1285},
+	},
+	offsets: []uint16{
+		0, 1, 3, 4, 5, 7, 8, 9, 10, 12, 13, 15, 15, 16, 18, 19,
+		20, 21, 22, 23, 25, 27, 29, 31, 33, 35, 37, 39, 41, 43, 45, 47,
+		49, 49, 51, 54, 57, 60, 63, 66, 68, 70, 72, 74, 76, 79, 81, 83,
+		85, 87, 89, 91, 94, 97, 98, 100, 102, 104, 106, 108, 110, 110, 110, 110,
+		110, 111, 112, 113, 114, 115, 116, 117, 117, 118, 119, 120, 121, 122, 123, 123,
+		123, 123, 123, 125, 127, 127, 127, 129, 131, 133, 134, 146, 155, 165, 175, 186,
+		186, 186, 187, 189, 191, 194, 196, 197, 200, 202, 203, 205, 208, 210, 212, 213,
+		216, 218, 220, 222, 224, 226, 227, 230, 233, 237, 242, 246, 250, 255, 260, 265,
+		269, 272, 276, 279, 282, 287, 293, 299, 303, 307, 310, 314, 321, 325, 328, 332,
+		337, 341, 347, 352, 356, 360, 364, 369, 373, 376, 380, 383, 386, 390, 393, 394,
+		398, 401, 404, 406, 409, 412, 417, 420, 424, 425, 427, 429, 430, 432, 434, 435,
+		436, 438, 440, 442, 444, 446, 448, 450, 451, 452, 454, 455, 457, 459, 460, 461,
+		462, 463, 464, 465, 467, 470, 472, 474, 476, 478, 480, 482, 484, 487, 489, 491,
+		494, 496, 499, 502, 505, 508, 511, 514, 516, 518, 520, 522, 524, 526, 528, 531,
+		533, 535, 537, 539, 541, 543, 545, 547, 549, 551, 553, 555, 556, 557, 559, 559,
+		561, 562, 564, 566, 568, 570, 572, 574, 576, 578, 579, 590, 601, 614, 627, 638,
+		648, 0, 3, 6, 9, 12, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
+		25, 26, 27, 28, 29, 31, 33, 35, 36, 37, 38, 39, 40, 41, 42, 44,
+		46, 48, 50, 52, 54, 56, 59, 62, 64, 65, 66, 68, 69, 71, 73, 75,
+		76, 78, 81, 82, 84, 86, 87, 89, 91, 92, 94, 95, 97, 99, 101, 103,
+		105, 107, 109, 110, 111, 113, 115, 116, 118, 118, 119, 121, 122, 123, 124, 125,
+		126, 127, 129, 131, 134, 136, 137, 140, 142, 143, 145, 148, 150, 152, 153, 156,
+		158, 160, 162, 164, 166, 167, 167, 169, 171, 173, 175, 178, 180, 183, 185, 187,
+		189, 191, 193, 195, 197, 197, 200, 203, 205, 207, 209, 212, 214, 216, 218, 220,
+		222, 223, 223, 224, 226, 228, 230, 232, 233, 238, 244, 248, 254, 259, 263, 264,
+		267, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270,
+		270, 270, 272, 274, 276, 278, 280, 283, 285, 288, 290, 293, 296, 299, 301, 304,
+		308, 312, 314, 316, 319, 323, 324, 325, 326, 327, 329, 331, 333, 334, 336, 338,
+		340, 342, 345, 348, 351, 354, 357, 360, 363, 366, 369, 372, 375, 378, 381, 384,
+		387, 390, 393, 396, 399, 402, 405, 408, 411, 414, 417, 420, 423, 426, 429, 432,
+		435, 438, 441, 444, 447, 450, 453, 456, 459, 462, 465, 468, 471, 474, 477, 480,
+		483, 486, 488, 490, 492, 494, 496, 498, 500, 502, 504, 506, 508, 510, 512, 514,
+		516, 518, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 15, 17,
+		20, 22, 25, 27, 30, 32, 35, 37, 39, 42, 44, 46, 48, 50, 52, 54,
+		56, 59, 61, 63, 65, 66, 68, 69, 71, 71, 71, 71, 71, 71, 71, 71,
+		71, 71, 71, 73, 75, 78, 80, 82, 84, 86, 88, 90, 92, 94, 97, 99,
+		101, 103, 105, 107, 109, 112, 116, 119, 123, 124, 124, 124, 124, 124, 124, 124,
+		124, 124, 124, 124, 124, 124, 124, 127, 130, 133, 136, 139, 142, 145, 148, 151,
+		154, 157, 157, 168, 179, 189, 200, 211, 227, 238, 254, 264, 274, 289, 307, 316,
+		326, 338, 349, 360, 369, 385, 397, 407, 421, 431, 441, 456, 468, 480, 489, 505,
+		508, 511, 514, 514, 514, 514, 514, 515, 519, 520, 522, 523, 528, 533, 540, 541,
+		543, 544, 546, 548, 550, 552, 554, 557, 559, 561, 563, 566, 568, 571, 574, 577,
+		580, 583, 585, 587, 589, 591, 593, 595, 597, 599, 601, 603, 605, 607, 609, 614,
+		616, 618, 620, 622, 624, 626, 626, 626, 626, 626, 626, 626, 626, 626, 626, 626,
+		626, 626, 626, 626, 626, 626, 626, 626, 626, 626, 626, 626, 626, 626, 626, 626,
+		626, 629, 632, 633, 634, 635, 636, 637, 638, 639, 640, 641, 642, 645, 648, 651,
+		654, 654, 654, 657, 660, 663, 666, 669, 669, 682, 685, 687, 689, 692, 694, 697,
+		700, 704, 707, 708, 709, 710, 711, 712, 713, 714, 715, 716, 717, 720, 722, 725,
+		727, 730, 733, 0, 4, 9, 14, 18, 22, 27, 32, 37, 42, 47, 52, 57,
+		61, 65, 70, 74, 79, 84, 88, 92, 96, 100, 104, 108, 111, 115, 120, 125,
+		129, 134, 139, 143, 147, 152, 157, 162, 167, 172, 177, 182, 186, 190, 195, 199,
+		204, 209, 213, 217, 221, 225, 229, 233, 236, 240, 245, 250, 254, 259, 264, 268,
+		272, 277, 282, 287, 292, 297, 302, 307, 311, 315, 320, 324, 329, 334, 338, 342,
+		346, 350, 354, 358, 361, 365, 370, 375, 379, 384, 389, 393, 397, 402, 407, 412,
+		417, 422, 427, 432, 436, 440, 445, 449, 454, 459, 463, 467, 471, 475, 479, 483,
+		486, 490, 495, 500, 504, 509, 514, 518, 522, 527, 532, 537, 542, 547, 552, 557,
+		561, 565, 570, 574, 579, 584, 588, 592, 596, 600, 604, 608, 610, 613, 617, 621,
+		624, 628, 632, 635, 638, 642, 646, 650, 654, 658, 662, 666, 669, 672, 676, 679,
+		683, 687, 690, 693, 696, 699, 702, 705, 708, 711, 714, 717, 720, 723, 726, 729,
+		732, 735, 738, 741, 745, 748, 751, 755, 759, 763, 766, 770, 773, 776, 780, 783,
+		786, 790, 794, 798, 801, 804, 807, 810, 812, 818, 824, 827, 830, 833, 836, 846,
+		858, 869, 885, 896, 906, 922, 933, 946, 959, 975, 991, 1008, 1023, 1039, 1056, 1069,
+		1090, 1103, 1113, 1128, 1138, 1152, 1162, 1173, 1190, 1200, 1210, 1225, 1235, 1247, 1256, 1273,
+		1288, 1303, 1314, 1323, 1334, 1345, 1354, 1366, 1386, 1399, 1412, 1422, 1437, 1447, 1458, 1472,
+		1475, 1478, 1481, 1484, 0, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
+		14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29,
+		30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45,
+		46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+		62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77,
+		78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93,
+		94, 95, 96, 97, 100, 103, 104, 105, 106, 107, 108, 110, 111, 112, 113, 114,
+		115, 117, 119, 121, 123, 124, 125, 126, 127, 128, 129, 131, 133, 135, 137, 139,
+		141, 143, 145, 147, 149, 151, 153, 155, 157, 159, 161, 163, 165, 167, 169, 171,
+		173, 175, 177, 179, 181, 183, 185, 187, 189, 191, 193, 195, 197, 199, 201, 203,
+		205, 207, 208, 209, 210, 210, 211, 213, 215, 216, 218, 220, 221, 223, 224, 226,
+		228, 230, 232, 234, 236, 238, 239, 240, 242, 244, 245, 247, 247, 248, 250, 251,
+		252, 253, 254, 255, 258, 261, 264, 265, 267, 269, 272, 274, 275, 278, 281, 284,
+		286, 287, 289, 292, 294, 297, 300, 302, 303, 306, 308, 310, 312, 315, 318, 320,
+		322, 323, 326, 329, 332, 334, 336, 337, 338, 339, 341, 343, 346, 347, 348, 349,
+		350, 351, 352, 353, 356, 359, 362, 365, 368, 371, 374, 377, 380, 383, 384, 385,
+		386, 386, 386, 386, 386,
+	},
+	data: "gggndddrmbbbsssjjjck" + // U+1100
+		"tphngnnndnbdgrnrrrhrNmbmNbgbn" + // U+1110
+		"bsbsgbstbsbbssbsjbjbcbtbpbNbbNsgsnsd" + // U+1120
+		"srsmsbsbgsssssjscskstspsh" + // U+1130
+		"ZgdmbsZjctpNj" + // U+1140
+		"ckchpbpNhhQkiyeoktikeutnieunsiosnieuncieucnieunhieuhtikeutrieul" + // U+1150
+		"aaeyayaeeoeyeoyeowawaeoeyouweo" + // U+1160
+		"wewiyueuyiia-oa-uya-oya-yoeo-oeo-ueo-euyeo-oyeo-uo-eo" + // U+1170
+		"o-eo-yeo-oo-uyo-yayo-yaeyo-yeoyo-oyo-iu-au-aeu-eo-euu-yeu-uyu-ayu-eo" + // U+1180
+		"yu-eyu-yeoyu-yeyu-uyu-ieu-ueu-euyi-ui-ai-yai-oi-ui-eui-UUU-eo" + // U+1190
+		"U-uU-iUUaeuyauyeoyaoyaoyaeggggsnnjnhdl" + // U+11A0
+		"lglmlblsltlplhmbbssssngjck" + // U+11B0
+		"tphglgsgngndnsnZntdgtllgslnldlth" + // U+11C0
+		"lllmglmslbslbhrNplsslZlklQmgmlmbmsmssmZ" + // U+11D0
+		"mcmhmNblbpphpNsgsdslsbZgsskh" + // U+11E0
+		"NNsNZpbpNhnhlhmhbQkiyeoknieunkiyeokpieupkiyeokchieuchkiyeokkhieukhkiyeokhieuhssangnieun" + // U+11F0
+		"[?][?][?][?][?]BPMFDTNLGKH" + // U+3100
+		"JQXZHCHSHRZCSAOEEHAIEI" + // U+3110
+		"AUOUANENANGENGERIUIUVNGGNihonn" + // U+3120
+		"[?]ggggsnnjnhdddrlglmlblsltlp" + // U+3130
+		"rhmbbbbssssjjjcktpha" + // U+3140
+		"aeyayaeeoeyeoyeowawaeoeyouweowewi" + // U+3150
+		"yueuyiinnndnsnZlgsldlbslZlQmbms" + // U+3160
+		"mZmNbgbsgbstbjbtbNbbNsgsnsdsbsjZ" + // U+3170
+		"NNsNZpNhhQyo-yayo-yaeyo-iyu-yeoyu-yeyu-iUU-i[?]" + // U+3180
+		"BUZIJIGUEEENNOOONNIRANNINNUNNIMNGGAINNAUNN" + // U+31A0
+		"AMOMONGINNNPTKHghlhzyggwkwoeah" + // U+31B0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+31C0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+31D0
+		"[?][?][?][?][?][?][?][?][?][?][?][?][?][?][?][?]" + // U+31E0
+		"kusisutonuhahihuhehomurarirurero" + // U+31F0
+		"0123456789kakhagangasasha" + // U+A900
+		"zanyatahtanapaphamadabarayalawathaha" + // U+A910
+		"vacaaoeioo" + // U+A920
+		"kagangatadanapabamacajanyasaralaya" + // U+A930
+		"wahambanggandanyjaa" + // U+A940
+		"[?][?][?][?][?][?][?][?][?][?][?]" + // U+A950
+		"tikeutmieumtikeutpieuptikeutsiostikeutcieucrieulkiyeokrieulssangkiyeokrieultikeutrieulssangtikeutrieulmieumrieulpieuprieulssangpieuprieulkapyeounpieuprieulsiosrieulcieucrieulkhieukhmieumkiyeok" + // U+A960
+		"mieumtikeutmieumsiospieupsiosthieuthpieupkhieukhpieuphieuhssangsiospieupieungrieulieunghieuhssangcieuchieuhssangthieuthphieuphhieuhhieuhsiosssangyeorinhieuh[?][?][?]" + // U+A970
+		"akawiiiiucerekleletraswadieaioka" + // U+A980
+		"kakagagangacacajanyajanyattattaddaddana" + // U+A990
+		"tatadadanapapababamayaraagunglawasa" + // U+A9A0
+		"sasaha" + // U+A9B0
+		"[?][?]" + // U+A9C0
+		"0123456789[?][?][?][?]" + // U+A9D0
+		"ghachajhannabhareduplicationnyafagaghajajhaddaddhanna" + // U+A9E0
+		"0123456789lladadhababha[?]" + // U+A9F0
+		"hwenhwenjhwenhhwedhwelhwelghwelmhwelbhwelshwelthwelphwelhhwemhwebhwebshwes" + // U+D700
+		"hwesshwenghwejhwechwekhwethwephwehhwihwighwigghwigshwinhwinjhwinhhwid" + // U+D710
+		"hwilhwilghwilmhwilbhwilshwilthwilphwilhhwimhwibhwibshwishwisshwinghwijhwic" + // U+D720
+		"hwikhwithwiphwihhyuhyughyugghyugshyunhyunjhyunhhyudhyulhyulghyulmhyulb" + // U+D730
+		"hyulshyulthyulphyulhhyumhyubhyubshyushyusshyunghyujhyuchyukhyuthyuphyuh" + // U+D740
+		"heuheugheuggheugsheunheunjheunhheudheulheulgheulmheulbheulsheultheulpheulh" + // U+D750
+		"heumheubheubsheusheussheungheujheucheukheutheupheuhhyihyighyigghyigs" + // U+D760
+		"hyinhyinjhyinhhyidhyilhyilghyilmhyilbhyilshyilthyilphyilhhyimhyibhyibshyis" + // U+D770
+		"hyisshyinghyijhyichyikhyithyiphyihhihighigghigshinhinjhinhhid" + // U+D780
+		"hilhilghilmhilbhilshilthilphilhhimhibhibshishisshinghijhic" + // U+D790
+		"hikhithiphih[?][?][?][?][?][?][?][?][?][?][?][?]" + // U+D7A0
+		"oyeoooiyoayoaeyoeouyeouiiyuaeyuoeuaeueoeueeuoiyaoiyaeiyeo" + // U+D7B0
+		"iyeioiiyoiyuiiaraeaaaraeae[?][?][?][?]nieunrieulnieunchieuchssangtikeutssangtikeutpieuptikeutpieup" + // U+D7C0
+		"tikeutsiostikeutsioskiyeoktikeutcieuctikeutchieuchtikeutthieuthrieulssangkiyeokrieulkiyeokhieuhssangrieulkhieukhrieulmieumhieuhrieulpieuptikeutrieulpieupphieuphrieulyesieungrieulyeorinhieuhhieuhkapyeounrieulmieumnieunmieumssangnieun" + // U+D7D0
+		"ssangmieummieumpieupsiosmieumcieucpieuptikeutpieuprieulphieuphpieupmieumssangpieuppieupsiostikeutpieupcieucpieupchieuchsiosmieumsioskapyeounpieupssangsioskiyeokssangsiostikeutsiospansiossioscieuc" + // U+D7E0
+		"sioschieuchsiosthieuthsioshieuhpansiospieuppansioskapyeounpieupyesieungmieumyesieunghieuhcieucpieupcieucssangpieupssangcieucphieuphsiosphieuphthieuth[?][?][?][?]" + // U+D7F0
+		"[?]!\"#$%&'()*+,-./" + // U+FF00
+		"0123456789:;<=>?" + // U+FF10
+		"@ABCDEFGHIJKLMNO" + // U+FF20
+		"PQRSTUVWXYZ[\\]^_" + // U+FF30
+		"`abcdefghijklmno" + // U+FF40
+		"pqrstuvwxyz{|}~[?]" + // U+FF50
+		"[?].[],*woaiueoyayuyotu" + // U+FF60
+		"+aiueokakikukekosasisuseso" + // U+FF70
+		"tatitutetonaninunenohahihuhehoma" + // U+FF80
+		"mimumemoyayuyorarirurerowan:;" + // U+FF90
+		"ggggsnnjnhdddrlglmlblsltlp" + // U+FFA0
+		"rhmbbbbssssjjjcktph[?]" + // U+FFB0
+		"[?][?]aaeyayaeeoe[?][?]yeoyeowawaeoe" + // U+FFC0
+		"[?][?]youweowewiyu[?][?]euyii[?][?][?]" + // U+FFD0
+		"/CPS!-|Y=W=[?]|-|-|#O[?]" + // U+FFE0
+		"[?][?][?][?][?][?][?][?][?]{|}", // U+FFF0
+}
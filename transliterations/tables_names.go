@@ -0,0 +1,2465 @@
+// Code generated by go run gen.go; DO NOT EDIT.
+
+package transliterations
+
+// nameTables has the names of the symbols the tables have no
+// transliteration for.
+var nameTables = &packedTables{
+	sections: []uint16{
+		0, 0, 1, 2, 0, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
+		14, 15, 0, 16, 17, 0, 0, 18, 19, 20, 21, 22, 23, 24, 0, 0,
+		25, 26, 27, 28, 29, 30, 31, 32, 0, 33, 34, 35, 36, 37, 38, 39,
+		40, 41, 42, 43, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 44, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 45, 0, 46, 47, 48, 49, 50, 51, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 52, 53, 54, 55, 56,
+		57, 58, 0, 59, 60, 61, 0, 0, 62, 63, 64, 65, 0, 66, 67, 68,
+		69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+		0, 0, 0, 0, 85, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 86,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 87, 88, 0, 0, 89, 90,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 91, 92, 93, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 94, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 95,
+		96, 97, 98, 99, 0, 0, 100, 101, 102, 103, 104, 0, 0, 0, 0, 0,
+		105, 106, 107, 0, 0, 0, 0, 0, 0, 108, 0, 0, 109, 110, 111, 0,
+		112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	},
+	starts: []tableStart{
+		{0, 0},          // 0x002
+		{759, 257},      // 0x003
+		{846, 505},      // 0x005
+		{1024, 746},     // 0x006
+		{1468, 978},     // 0x007
+		{1628, 1235},    // 0x008
+		{2269, 1438},    // 0x009
+		{2338, 1693},    // 0x00a
+		{2433, 1936},    // 0x00b
+		{2598, 2188},    // 0x00c
+		{2654, 2322},    // 0x00d
+		{2692, 2445},    // 0x00e
+		{2709, 2621},    // 0x00f
+		{4094, 2841},    // 0x010
+		{4148, 3002},    // 0x011
+		{4620, 3242},    // 0x013
+		{4905, 3397},    // 0x014
+		{4930, 3399},    // 0x017
+		{4988, 3455},    // 0x018
+		{5030, 3467},    // 0x019
+		{5928, 3724},    // 0x01a
+		{6239, 3899},    // 0x01b
+		{7224, 4156},    // 0x01c
+		{7855, 4369},    // 0x01d
+		{12961, 4562},   // 0x020
+		{13449, 4756},   // 0x021
+		{14851, 5013},   // 0x022
+		{19617, 5270},   // 0x023
+		{26245, 5527},   // 0x024
+		{27326, 5603},   // 0x025
+		{27842, 5860},   // 0x026
+		{32021, 6117},   // 0x027
+		{39276, 6374},   // 0x029
+		{47774, 6631},   // 0x02a
+		{55354, 6888},   // 0x02b
+		{63099, 7145},   // 0x02c
+		{70182, 7402},   // 0x02d
+		{70205, 7516},   // 0x02e
+		{72291, 7611},   // 0x02f
+		{72500, 7868},   // 0x030
+		{72822, 8122},   // 0x031
+		{73961, 8363},   // 0x032
+		{74283, 8572},   // 0x033
+		{74363, 8797},   // 0x04d
+		{75998, 9054},   // 0x0a4
+		{76118, 9311},   // 0x0a6
+		{76484, 9560},   // 0x0a7
+		{77535, 9700},   // 0x0a8
+		{77993, 9955},   // 0x0a9
+		{78391, 10180},  // 0x0aa
+		{78819, 10426},  // 0x0ab
+		{78925, 10663},  // 0x0fb
+		{84711, 10920},  // 0x0fc
+		{96115, 11177},  // 0x0fd
+		{106171, 11434}, // 0x0fe
+		{111458, 11688}, // 0x0ff
+		{111624, 11943}, // 0x100
+		{115124, 12195}, // 0x101
+		{117212, 12449}, // 0x103
+		{117257, 12659}, // 0x104
+		{117276, 12752}, // 0x105
+		{117308, 12865}, // 0x108
+		{117400, 12987}, // 0x109
+		{117515, 13148}, // 0x10a
+		{118072, 13396}, // 0x10b
+		{118467, 13554}, // 0x10d
+		{118523, 13591}, // 0x10e
+		{118546, 13766}, // 0x10f
+		{118890, 13905}, // 0x110
+		{119173, 14100}, // 0x111
+		{119512, 14325}, // 0x112
+		{119653, 14496}, // 0x113
+		{119663, 14578}, // 0x114
+		{119872, 14779}, // 0x115
+		{120737, 14996}, // 0x116
+		{121266, 15183}, // 0x117
+		{121335, 15248}, // 0x118
+		{121372, 15505}, // 0x119
+		{121545, 15734}, // 0x11a
+		{122691, 15984}, // 0x11b
+		{123008, 15995}, // 0x11c
+		{123147, 16110}, // 0x11d
+		{123182, 16264}, // 0x11e
+		{123235, 16514}, // 0x11f
+		{124157, 16771}, // 0x124
+		{124380, 16889}, // 0x12f
+		{124426, 17133}, // 0x16a
+		{124470, 17380}, // 0x16b
+		{124771, 17451}, // 0x16e
+		{124858, 17607}, // 0x16f
+		{124946, 17836}, // 0x188
+		{130066, 18093}, // 0x189
+		{135186, 18350}, // 0x18a
+		{140306, 18607}, // 0x1bc
+		{141300, 18768}, // 0x1cf
+		{145376, 18965}, // 0x1d0
+		{155198, 19212}, // 0x1d1
+		{161209, 19448}, // 0x1d2
+		{163453, 19519}, // 0x1d3
+		{165448, 19607}, // 0x1d6
+		{166620, 19864}, // 0x1d7
+		{174757, 20069}, // 0x1d8
+		{185423, 20326}, // 0x1d9
+		{197506, 20583}, // 0x1da
+		{198461, 20724}, // 0x1e0
+		{198500, 20806}, // 0x1e1
+		{198533, 20887}, // 0x1e2
+		{198549, 21144}, // 0x1e9
+		{198629, 21241}, // 0x1ec
+		{198674, 21419}, // 0x1ed
+		{198696, 21467}, // 0x1ee
+		{198933, 21710}, // 0x1f0
+		{204839, 21957}, // 0x1f1
+		{208514, 22214}, // 0x1f2
+		{208779, 22317}, // 0x1f3
+		{212284, 22574}, // 0x1f4
+		{215331, 22831}, // 0x1f5
+		{220432, 23088}, // 0x1f6
+		{225097, 23342}, // 0x1f7
+		{231522, 23584}, // 0x1f8
+		{237261, 23763}, // 0x1f9
+		{240492, 24020}, // 0x1fa
+		{245125, 24270}, // 0x1fb
+		{251966, 24474},
+	},
+	offsets: []uint16{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 35, 64, 92, 120, 154, 193, 233, 233, 233, 233,
+		267, 299, 333, 368, 392, 427, 469, 511, 536, 564, 595, 624, 654, 682, 703, 729,
+		759, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 19, 19, 19, 19,
+		19, 19, 19, 19, 19, 19, 30, 51, 51, 51, 51, 51, 51, 51, 51, 51,
+		51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51,
+		51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51,
+		51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51,
+		51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51,
+		51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51,
+		51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51,
+		51, 51, 51, 51, 51, 51, 51, 51, 87, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 35, 69, 87, 87, 87, 87, 87, 87, 87,
+		87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87,
+		87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87,
+		87, 87, 87, 87, 87, 87, 87, 87, 111, 111, 111, 111, 111, 111, 111, 111,
+		141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 159, 159, 159, 159, 159, 159,
+		159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+		159, 159, 159, 159, 159, 159, 159, 159, 159, 178, 0, 0, 0, 0, 0, 0,
+		0, 22, 46, 56, 83, 117, 129, 129, 150, 174, 191, 191, 191, 191, 191, 191,
+		191, 191, 191, 191, 191, 191, 191, 191, 214, 248, 248, 248, 267, 267, 267, 267,
+		302, 302, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+		320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 334, 334, 334, 334, 334,
+		334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334,
+		334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334,
+		334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 358,
+		358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358,
+		358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358,
+		358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358,
+		358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358,
+		358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358,
+		358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 358, 373, 412, 412, 412, 412,
+		412, 412, 412, 412, 412, 412, 412, 412, 412, 412, 412, 412, 412, 412, 412, 412,
+		428, 444, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 24, 47, 67, 88, 97, 117, 131, 131, 131,
+		131, 146, 160, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 29, 57, 84, 109, 137, 169, 196, 231, 258, 285, 311, 337,
+		366, 400, 428, 428, 428, 428, 428, 428, 428, 428, 428, 428, 428, 428, 428, 428,
+		428, 428, 428, 428, 428, 428, 428, 428, 428, 428, 428, 428, 428, 428, 428, 428,
+		428, 428, 447, 447, 447, 447, 447, 447, 447, 447, 447, 447, 447, 447, 447, 447,
+		447, 447, 447, 447, 447, 447, 447, 447, 447, 447, 447, 447, 447, 447, 447, 447,
+		447, 447, 447, 447, 447, 447, 447, 483, 517, 551, 551, 576, 599, 599, 599, 599,
+		599, 599, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619,
+		619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619,
+		619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619,
+		619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 619, 641, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 26, 44, 44, 69, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		12, 24, 24, 24, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 76, 95,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
+		15, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+		27, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
+		35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
+		35, 35, 35, 35, 49, 65, 80, 96, 113, 132, 148, 165, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 19, 19, 19, 19, 19, 19, 19, 19, 36, 36, 36, 36,
+		36, 56, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
+		19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
+		19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 38, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 37,
+		79, 122, 158, 194, 233, 267, 267, 267, 297, 297, 297, 297, 297, 297, 297, 297,
+		297, 297, 297, 329, 361, 408, 408, 408, 435, 463, 490, 516, 543, 574, 574, 574,
+		574, 574, 574, 574, 574, 574, 574, 574, 574, 574, 574, 574, 574, 574, 574, 574,
+		574, 574, 574, 574, 574, 574, 594, 594, 621, 621, 621, 621, 621, 621, 621, 621,
+		621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621,
+		621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621,
+		621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621,
+		621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621, 621,
+		621, 621, 621, 640, 640, 640, 664, 685, 713, 741, 741, 741, 741, 741, 741, 741,
+		741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741,
+		741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741,
+		741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741, 741,
+		741, 741, 763, 785, 785, 817, 839, 860, 893, 926, 959, 959, 990, 1016, 1052, 1087,
+		1110, 1158, 1206, 1230, 1253, 1287, 1320, 1352, 1385, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 23, 54, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+		21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 49, 49, 49, 49, 49,
+		49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49,
+		49, 49, 49, 49, 49, 49, 49, 77, 110, 141, 177, 177, 177, 177, 177, 177,
+		177, 177, 203, 203, 203, 203, 203, 203, 203, 232, 266, 298, 335, 335, 335, 366,
+		400, 400, 400, 400, 400, 400, 400, 400, 400, 400, 422, 445, 445, 445, 445, 445,
+		445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445,
+		445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445,
+		445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445,
+		445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445,
+		445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445,
+		445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445,
+		445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445,
+		445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445, 445,
+		445, 445, 445, 445, 445, 445, 445, 445, 445, 472, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 21, 21, 21, 21, 21,
+		21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+		21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+		21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 46, 71, 97, 129, 154,
+		179, 205, 230, 261, 285, 0, 25, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 29, 58, 0,
+		0, 0, 0, 0, 0, 0, 26, 26, 26, 26, 42, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 14, 14, 14, 14,
+		36, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+		55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+		55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+		55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+		55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+		55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+		55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+		55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+		55, 55, 55, 55, 78, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+		101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 121, 142, 165, 187, 208, 229,
+		251, 273, 300, 326, 352, 379, 400, 426, 451, 476, 502, 528, 550, 571, 591, 611,
+		632, 653, 679, 704, 729, 755, 775, 800, 824, 848, 873, 898, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 16, 39, 39, 39, 39,
+		39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+		39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+		39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+		39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+		39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+		39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+		39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+		39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 58, 81, 100,
+		118, 135, 155, 190, 190, 208, 229, 250, 274, 292, 311, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 14, 29, 43, 67, 86, 108, 125, 153, 181, 209,
+		237, 272, 300, 329, 358, 387, 420, 420, 420, 420, 420, 420, 420, 420, 420, 420,
+		463, 506, 551, 596, 639, 682, 727, 772, 815, 837, 860, 860, 860, 860, 860, 860,
+		860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860,
+		860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860,
+		860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860, 860,
+		860, 860, 860, 860, 860, 860, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878,
+		878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878,
+		878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878,
+		878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878, 878,
+		878, 878, 878, 878, 878, 878, 878, 878, 905, 934, 958, 985, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 25, 62, 87, 119, 144, 144, 144, 144,
+		144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+		144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+		144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+		144, 144, 144, 144, 144, 163, 188, 216, 230, 247, 260, 287, 321, 321, 321, 321,
+		321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321,
+		321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321,
+		321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321,
+		321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 354, 390, 425,
+		458, 497, 535, 573, 611, 611, 611, 611, 611, 611, 611, 611, 611, 611, 611, 611,
+		631, 0, 28, 57, 85, 120, 148, 176, 206, 234, 266, 293, 321, 349, 389, 417,
+		454, 482, 515, 544, 578, 619, 647, 676, 705, 737, 765, 802, 837, 865, 893, 922,
+		963, 999, 1027, 1055, 1083, 1113, 1150, 1166, 1198, 1230, 1259, 1289, 1319, 1351, 1376, 1402,
+		1427, 1459, 1484, 1509, 1543, 1568, 1593, 1618, 1643, 1668, 1693, 1718, 1743, 1777, 1802, 1828,
+		1853, 1878, 1903, 1928, 1953, 1976, 2006, 2033, 2064, 2087, 2110, 2133, 2160, 2188, 2223, 2246,
+		2276, 2299, 2322, 2347, 2370, 2398, 2430, 2465, 2488, 2511, 2534, 2566, 2596, 2619, 2644, 2670,
+		2703, 2730, 2761, 2786, 2816, 2846, 2876, 2906, 2939, 2973, 3005, 3037, 3069, 3090, 3090, 3090,
+		3090, 3090, 3090, 3090, 3090, 3090, 3090, 3090, 3090, 3090, 3117, 3145, 3185, 3225, 3260, 3260,
+		3300, 3338, 3338, 3338, 3338, 3338, 3338, 3338, 3338, 3338, 3338, 3338, 3338, 3378, 3378, 3378,
+		3378, 3418, 3462, 3501, 3541, 3586, 3640, 3684, 3724, 3769, 3811, 3851, 3893, 3927, 3950, 3983,
+		4008, 4045, 4068, 4111, 4141, 4171, 4206, 4232, 4263, 4306, 4347, 4390, 4431, 4462, 4495, 4539,
+		4577, 4620, 4651, 4681, 4706, 4739, 4764, 4805, 4832, 4861, 4892, 4925, 4955, 4978, 5021, 5054,
+		5079, 5106, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 18, 26, 58, 58, 58, 75, 98, 119, 134, 154, 174, 193, 206, 218,
+		226, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+		244, 244, 244, 244, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+		276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+		276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+		276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+		276, 276, 276, 293, 302, 314, 326, 338, 347, 366, 379, 389, 406, 423, 439, 449,
+		459, 468, 480, 488, 0, 10, 34, 34, 48, 66, 73, 81, 95, 102, 119, 133,
+		149, 171, 171, 186, 213, 229, 251, 267, 281, 295, 295, 306, 331, 347, 347, 347,
+		363, 385, 385, 402, 410, 410, 410, 410, 418, 418, 428, 436, 453, 475, 505, 505,
+		505, 521, 543, 543, 543, 543, 543, 543, 543, 557, 568, 578, 590, 602, 620, 637,
+		637, 659, 684, 711, 735, 764, 791, 818, 847, 874, 874, 874, 874, 874, 874, 887,
+		903, 911, 923, 923, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950,
+		950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950,
+		950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950,
+		950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 950, 966,
+		984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984,
+		984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984,
+		984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984,
+		984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984,
+		984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984,
+		984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984, 984,
+		984, 984, 984, 984, 984, 984, 984, 984, 984, 1013, 1055, 1078, 1114, 1151, 1188, 1231,
+		1275, 1319, 1346, 1374, 1402, 0, 7, 17, 37, 49, 69, 78, 87, 92, 102, 119,
+		135, 153, 179, 203, 215, 228, 243, 258, 258, 276, 284, 284, 284, 284, 297, 312,
+		323, 332, 343, 358, 366, 377, 382, 396, 411, 411, 426, 437, 452, 463, 473, 485,
+		490, 498, 513, 528, 544, 560, 575, 593, 619, 649, 658, 665, 665, 675, 684, 690,
+		710, 720, 720, 734, 749, 758, 772, 781, 792, 815, 842, 864, 903, 946, 961, 980,
+		1004, 1016, 1028, 1041, 1068, 1086, 1106, 1128, 1166, 1200, 1212, 1224, 1240, 1253, 1267, 1276,
+		1290, 1301, 1315, 1337, 1348, 1367, 1379, 1391, 1407, 1429, 1429, 1429, 1429, 1429, 1455, 1484,
+		1498, 1515, 1522, 1539, 1552, 1568, 1598, 1631, 1657, 1686, 1721, 1759, 1784, 1809, 1843, 1877,
+		1885, 1893, 1913, 1933, 1958, 1983, 1999, 2015, 2024, 2035, 2050, 2067, 2088, 2111, 2143, 2177,
+		2204, 2233, 2241, 2264, 2278, 2293, 2311, 2338, 2368, 2378, 2388, 2400, 2413, 2426, 2448, 2468,
+		2489, 2514, 2528, 2540, 2552, 2565, 2578, 2598, 2608, 2617, 2626, 2633, 2642, 2648, 2652, 2658,
+		2693, 2735, 2749, 2757, 2771, 2821, 2844, 2867, 2885, 2912, 2942, 2981, 2992, 3000, 3008, 3034,
+		3045, 3048, 3052, 3055, 3075, 3089, 3106, 3122, 3140, 3151, 3167, 3179, 3192, 3206, 3212, 3249,
+		3287, 3310, 3334, 3355, 3371, 3388, 3401, 3416, 3435, 3447, 3456, 3477, 3495, 3516, 3535, 3557,
+		3591, 3625, 3646, 3670, 3690, 3710, 3735, 3760, 3791, 3825, 3856, 3890, 3921, 3955, 3985, 4015,
+		4037, 4072, 4106, 4150, 4167, 4194, 4220, 4248, 4286, 4342, 4404, 4429, 4452, 4481, 4505, 4543,
+		4579, 4633, 4693, 4714, 4741, 4766, 0, 13, 27, 32, 32, 46, 56, 67, 76, 88,
+		101, 111, 122, 139, 155, 169, 182, 199, 213, 216, 223, 229, 247, 265, 280, 302,
+		317, 322, 331, 346, 362, 380, 399, 416, 436, 441, 446, 486, 496, 514, 534, 542,
+		542, 542, 559, 571, 583, 601, 609, 621, 637, 650, 655, 666, 677, 705, 738, 770,
+		803, 837, 867, 900, 934, 966, 997, 1032, 1064, 1100, 1136, 1175, 1211, 1248, 1290, 1333,
+		1371, 1411, 1444, 1481, 1513, 1548, 1582, 1622, 1659, 1690, 1725, 1755, 1788, 1824, 1866, 1902,
+		1938, 1976, 2010, 2047, 2080, 2112, 2145, 2177, 2216, 2251, 2287, 2322, 2360, 2397, 2434, 2471,
+		2515, 2546, 2577, 2604, 2637, 2677, 2713, 2748, 2786, 2822, 2848, 2873, 2900, 2936, 2974, 3009,
+		3045, 3072, 3086, 3125, 3144, 3155, 3184, 3200, 3227, 3257, 3272, 3290, 3328, 3340, 3362, 3373,
+		3406, 3427, 3461, 3472, 3489, 3506, 3539, 3572, 3603, 3632, 3662, 3686, 3712, 3740, 3753, 3762,
+		3781, 3800, 3827, 3853, 3880, 3908, 3935, 3963, 3995, 4024, 4056, 4089, 4119, 4152, 4181, 4212,
+		4241, 4264, 4294, 4326, 4356, 4374, 4399, 4446, 4493, 4506, 4522, 4540, 4561, 4606, 4627, 4649,
+		4672, 4694, 4716, 4738, 4760, 4805, 4853, 4896, 4950, 5002, 5047, 5103, 5157, 5197, 5249, 5299,
+		5341, 5381, 5425, 5472, 5483, 5496, 5508, 5531, 5545, 5569, 5593, 5622, 5651, 5677, 5693, 5711,
+		5729, 5741, 5745, 5760, 5778, 5795, 5815, 5841, 5870, 5885, 5909, 5921, 5929, 5939, 5962, 5985,
+		6021, 6056, 6089, 6124, 6178, 6231, 6285, 6296, 6305, 6316, 6343, 6378, 6414, 6447, 6482, 6501,
+		6522, 6545, 6557, 6576, 6591, 6609, 6628, 0, 15, 42, 66, 88, 118, 136, 158, 173,
+		193, 225, 245, 275, 295, 321, 341, 360, 387, 416, 445, 476, 506, 537, 564, 600,
+		617, 641, 662, 679, 704, 730, 757, 782, 798, 815, 827, 835, 853, 879, 909, 909,
+		909, 909, 909, 909, 909, 909, 909, 909, 909, 909, 909, 909, 909, 909, 909, 909,
+		909, 909, 909, 909, 909, 909, 909, 909, 917, 926, 934, 951, 966, 977, 1007, 1026,
+		1034, 1061, 1081, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 19, 39, 58, 108, 143, 193,
+		244, 264, 299, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350,
+		350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350,
+		350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350,
+		350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350,
+		350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350,
+		350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 369, 389, 408, 427,
+		446, 471, 496, 516, 0, 19, 24, 32, 39, 44, 54, 64, 73, 85, 88, 102,
+		117, 128, 138, 153, 168, 178, 199, 216, 223, 247, 259, 276, 293, 301, 337, 362,
+		388, 413, 436, 462, 487, 507, 519, 535, 549, 557, 561, 575, 582, 599, 617, 634,
+		646, 656, 673, 685, 693, 711, 727, 743, 762, 778, 795, 815, 832, 847, 866, 884,
+		902, 921, 939, 956, 963, 974, 979, 988, 995, 1001, 1007, 1014, 1019, 1024, 1030, 1036,
+		1042, 1045, 1050, 1055, 1063, 1074, 1083, 1091, 1097, 1113, 1130, 1146, 1164, 1182, 1198, 1214,
+		1231, 1247, 1265, 1283, 1299, 1315, 1331, 1349, 1364, 1380, 1396, 1414, 1429, 1440, 1452, 1463,
+		1482, 1504, 1519, 1537, 1537, 1554, 1571, 1597, 1633, 1669, 1705, 1741, 1777, 1813, 1849, 1887,
+		1919, 1940, 1971, 1991, 2008, 2018, 2028, 2038, 2048, 2058, 2068, 2095, 2121, 2154, 2186, 2203,
+		2219, 2242, 2263, 2285, 2307, 2317, 2327, 2342, 2348, 2362, 2382, 2388, 2395, 2401, 2405, 2420,
+		2431, 2443, 2462, 2490, 2517, 2529, 2546, 2565, 2582, 2614, 2634, 2655, 2696, 2726, 2758, 2777,
+		2796, 2821, 2836, 2850, 2878, 2884, 2895, 2901, 2906, 2912, 2916, 2921, 2927, 2944, 2951, 2962,
+		2970, 2984, 2995, 3003, 3014, 3032, 3051, 3069, 3088, 3108, 3124, 3128, 3141, 3163, 3187, 3211,
+		3234, 3248, 3260, 3269, 3273, 3284, 3307, 3329, 3335, 3343, 3377, 3407, 3437, 3458, 3479, 3494,
+		3528, 3545, 3560, 3608, 3619, 3642, 3665, 3695, 3738, 3747, 3780, 3812, 3830, 3851, 3864, 3870,
+		3876, 3889, 3905, 3922, 3947, 3955, 3973, 3981, 3993, 3998, 4006, 4025, 4030, 4039, 4055, 4059,
+		4079, 4105, 4114, 4133, 4179, 0, 21, 41, 55, 75, 89, 111, 134, 144, 152, 160,
+		171, 182, 194, 206, 224, 230, 248, 257, 266, 276, 292, 308, 330, 338, 352, 372,
+		389, 406, 429, 440, 466, 486, 499, 512, 541, 569, 603, 628, 651, 674, 682, 708,
+		726, 748, 771, 790, 815, 828, 847, 847, 867, 888, 912, 939, 961, 997, 1039, 1064,
+		1088, 1112, 1148, 1178, 1215, 1229, 1243, 1281, 1319, 1358, 1367, 1393, 1416, 1423, 1436, 1459,
+		1499, 1545, 1555, 1576, 1603, 1641, 1679, 1712, 1745, 1773, 1801, 1832, 1859, 1888, 1888, 1907,
+		1925, 1974, 2016, 2065, 2107, 2153, 2199, 2234, 2234, 2271, 2288, 2320, 2332, 2359, 2391, 2424,
+		2466, 2509, 2552, 2596, 2645, 2695, 2737, 2780, 2822, 2865, 2899, 2934, 2968, 3002, 3038, 3073,
+		3108, 3142, 3178, 3214, 3249, 3284, 3320, 3356, 3394, 3431, 3468, 3504, 3542, 3580, 3617, 3654,
+		3699, 3744, 3791, 3837, 3883, 3928, 3975, 4022, 4068, 4114, 4148, 4163, 4179, 4198, 4220, 4242,
+		4264, 4295, 4330, 4362, 4400, 4439, 4484, 4506, 4546, 4589, 4615, 4664, 4711, 4739, 4783, 4818,
+		4852, 4895, 4939, 4988, 5037, 5088, 5098, 5149, 5185, 5217, 5249, 5281, 5313, 5351, 5389, 5427,
+		5459, 5498, 5527, 5562, 5592, 5609, 5632, 5678, 5691, 5702, 5715, 5742, 5770, 5788, 5820, 5846,
+		5881, 5909, 5922, 5951, 5970, 5988, 6018, 6030, 6056, 6083, 6109, 6124, 6140, 6155, 6168, 6183,
+		6214, 6233, 6246, 6261, 6275, 6300, 6334, 6361, 6408, 6456, 6488, 6521, 6521, 6560, 6560, 6560,
+		6598, 6637, 6683, 6730, 6769, 6809, 6832, 6857, 6890, 6919, 6948, 6968, 6989, 7010, 7037, 7065,
+		7093, 7122, 7152, 7188, 7225, 7255, 0, 48, 103, 146, 190, 234, 270, 301, 333, 371,
+		407, 427, 449, 476, 504, 531, 559, 598, 631, 651, 673, 720, 774, 811, 869, 934,
+		954, 975, 1002, 1030, 1062, 1095, 1136, 1178, 1209, 1240, 1266, 1292, 1318, 1344, 1381, 1418,
+		1455, 1492, 1533, 1574, 1616, 1658, 1700, 1741, 1783, 1825, 1859, 1905, 1953, 2000, 2048, 2078,
+		2111, 2138, 2168, 2202, 2239, 2279, 2322, 2355, 2384, 2428, 2472, 2516, 2548, 2579, 2605, 2642,
+		2684, 2720, 2756, 2792, 2828, 2862, 2899, 2937, 2972, 3009, 3047, 3085, 3125, 3164, 3204, 3241,
+		3280, 3319, 3359, 3399, 3441, 3482, 3524, 3563, 3604, 3673, 3742, 3813, 3886, 3954, 4026, 4094,
+		4166, 4212, 4260, 4307, 4356, 4427, 4498, 4534, 4568, 4605, 4641, 4678, 4716, 4747, 4780, 4815,
+		4844, 4874, 4904, 4918, 4933, 4945, 4959, 4988, 5003, 5024, 5024, 5024, 5046, 5069, 5098, 5128,
+		5159, 5191, 5224, 5258, 5301, 5348, 5394, 5438, 5465, 5493, 5519, 5549, 5585, 5619, 5652, 5686,
+		5698, 5718, 5745, 5776, 5805, 5824, 5835, 5863, 5889, 5901, 5915, 5934, 5962, 5986, 6012, 6078,
+		6143, 6211, 6278, 6344, 6409, 6477, 6544, 6562, 6584, 6617, 6649, 6680, 6706, 6726, 6742, 6765,
+		6786, 6855, 6881, 6924, 6947, 6967, 6981, 6998, 7018, 7055, 7102, 7131, 7161, 7177, 7197, 7211,
+		7229, 7252, 7274, 7287, 7317, 7351, 7384, 7418, 7445, 7473, 7485, 7511, 7538, 7553, 7568, 7585,
+		7603, 7627, 7652, 7671, 7688, 7722, 7743, 7772, 7784, 7799, 7831, 7880, 7913, 7925, 7938, 7981,
+		8025, 8054, 8067, 8095, 8123, 8148, 8173, 8199, 8225, 8250, 8275, 8287, 8311, 8331, 8369, 8380,
+		8399, 8410, 8421, 8455, 8490, 8494, 8498, 0, 26, 53, 81, 110, 140, 174, 201, 225,
+		248, 268, 282, 305, 332, 352, 379, 406, 426, 451, 501, 552, 591, 623, 651, 690,
+		714, 740, 759, 780, 802, 806, 834, 863, 887, 915, 948, 986, 1012, 1036, 1062, 1090,
+		1119, 1146, 1171, 1199, 1226, 1255, 1285, 1308, 1342, 1375, 1412, 1425, 1464, 1504, 1554, 1590,
+		1611, 1632, 1654, 1685, 1701, 1727, 1760, 1785, 1806, 1827, 1845, 1870, 1899, 1920, 1944, 1968,
+		2002, 2036, 2070, 2118, 2142, 2173, 2199, 2218, 2260, 2286, 2311, 2329, 2346, 2374, 2401, 2417,
+		2434, 2468, 2496, 2523, 2555, 2586, 2617, 2642, 2674, 2697, 2727, 2758, 2791, 2823, 2849, 2873,
+		2922, 2971, 3000, 3031, 3052, 3076, 3096, 3134, 3165, 3192, 3219, 3251, 3251, 3251, 3251, 3301,
+		3332, 3360, 3391, 3425, 3462, 3491, 3523, 3568, 3616, 3660, 3707, 3757, 3809, 3833, 3860, 3898,
+		3939, 3968, 4000, 4052, 4104, 4136, 4171, 4213, 4255, 4307, 4359, 4427, 4495, 4524, 4556, 4601,
+		4649, 4682, 4718, 4759, 4803, 4823, 4846, 4887, 4931, 4954, 4980, 5017, 5051, 5080, 5105, 5133,
+		5178, 5226, 5238, 5249, 5273, 5296, 5324, 5362, 5400, 5439, 5478, 5504, 5530, 5557, 5584, 5614,
+		5644, 5678, 5712, 5727, 5742, 5757, 5774, 5801, 5830, 5867, 5906, 5942, 5980, 6007, 6036, 6066,
+		6098, 6129, 6162, 6190, 6220, 6249, 6279, 6292, 6307, 6332, 6359, 6380, 6401, 6420, 6443, 6465,
+		6511, 6539, 6561, 6585, 6592, 6602, 6617, 6632, 6645, 6665, 6700, 6734, 6768, 6809, 6854, 6882,
+		6909, 6944, 6960, 6974, 6996, 7027, 7071, 7102, 7133, 7160, 7191, 7219, 7254, 7296, 7317, 7340,
+		7366, 7407, 7451, 7481, 7515, 7538, 7556, 7580, 0, 22, 44, 66, 88, 110, 131, 150,
+		171, 193, 215, 237, 259, 281, 300, 335, 368, 402, 434, 460, 489, 532, 574, 602,
+		631, 658, 688, 701, 719, 737, 760, 783, 797, 811, 824, 837, 861, 879, 899, 919,
+		939, 959, 978, 997, 1016, 1040, 1064, 1086, 1108, 1136, 1158, 1186, 1215, 1262, 1316, 1351,
+		1389, 1421, 1467, 1520, 1556, 1613, 1677, 1702, 1735, 1768, 1812, 1857, 1894, 1927, 1952, 1978,
+		2023, 2069, 2105, 2142, 2186, 2231, 2269, 2294, 2323, 2340, 2356, 2372, 2401, 2430, 2448, 2475,
+		2506, 2518, 2539, 2575, 2615, 2655, 2699, 2744, 2795, 2826, 2855, 2887, 2918, 2950, 2979, 3011,
+		3043, 3075, 3107, 3145, 3181, 3220, 3258, 3301, 3348, 3386, 3422, 3461, 3499, 3499, 3499, 3538,
+		3577, 3616, 3655, 3716, 3775, 3837, 3898, 3916, 3932, 4001, 4075, 4144, 4218, 4257, 4294, 4334,
+		4373, 4408, 4441, 4477, 4512, 4562, 4613, 4662, 4710, 4721, 4733, 4745, 4758, 4799, 4821, 4821,
+		4850, 4901, 4952, 5004, 5056, 5093, 5128, 5166, 5203, 5258, 5314, 5367, 5421, 5474, 5528, 5583,
+		5639, 5681, 5724, 5764, 5805, 5845, 5886, 5928, 5971, 5993, 6016, 6036, 6057, 6077, 6098, 6120,
+		6143, 6191, 6222, 6247, 6282, 6307, 6330, 6339, 6353, 6373, 6394, 6415, 6439, 6452, 6493, 6536,
+		6579, 6623, 6639, 6660, 6684, 6713, 6750, 6773, 6804, 6829, 6845, 6855, 6869, 6885, 6900, 6915,
+		6925, 6935, 6942, 6948, 6954, 6960, 6977, 6999, 7019, 7025, 7030, 7034, 7040, 7047, 7054, 7062,
+		7070, 7090, 7111, 7136, 7162, 7213, 7262, 7314, 7365, 7378, 7391, 7396, 7433, 7467, 7503, 7539,
+		7575, 7612, 7643, 7656, 7672, 7686, 7699, 7719, 7745, 0, 29, 59, 89, 122, 153, 184,
+		217, 248, 280, 310, 348, 375, 407, 437, 470, 503, 534, 563, 595, 626, 657, 689,
+		718, 749, 779, 808, 836, 866, 895, 927, 956, 986, 1016, 1046, 1082, 1110, 1145, 1190,
+		1218, 1261, 1294, 1335, 1365, 1398, 1431, 1469, 1511, 1551, 1578, 1606, 1634, 1665, 1694, 1723,
+		1754, 1783, 1813, 1841, 1877, 1902, 1932, 1960, 1991, 2022, 2051, 2078, 2108, 2137, 2166, 2196,
+		2223, 2252, 2280, 2307, 2333, 2361, 2388, 2418, 2445, 2473, 2501, 2529, 2563, 2589, 2622, 2665,
+		2691, 2732, 2763, 2802, 2830, 2861, 2892, 2928, 2968, 3006, 3006, 3006, 3006, 3006, 3006, 3006,
+		3006, 3006, 3006, 3006, 3006, 3006, 3006, 3032, 3032, 3032, 3065, 3101, 3133, 3163, 3193, 3220,
+		3245, 3276, 3307, 3344, 3385, 3420, 3450, 3475, 3513, 3551, 3577, 3601, 3627, 3651, 3678, 3703,
+		3730, 3755, 3780, 3803, 3828, 3851, 3877, 3901, 3927, 3951, 3979, 4005, 4032, 4057, 4083, 4107,
+		4134, 4159, 4183, 4205, 4229, 4251, 4276, 4299, 4322, 4343, 4367, 4389, 4413, 4435, 4461, 4485,
+		4510, 4533, 4557, 4579, 4603, 4625, 4650, 4673, 4698, 4721, 4746, 4769, 4805, 4839, 4875, 4909,
+		4948, 4985, 5021, 5055, 5089, 5121, 5159, 5195, 5231, 5265, 5292, 5317, 5351, 5383, 5420, 5455,
+		5491, 5525, 5560, 5593, 5629, 5663, 5700, 5735, 5770, 5803, 5836, 5867, 5903, 5937, 5973, 6007,
+		6046, 6083, 6119, 6153, 6191, 6227, 6265, 6301, 6337, 6371, 6407, 6441, 6477, 6511, 6528, 6547,
+		6566, 6587, 6607, 6627, 6651, 6691, 6729, 6771, 6811, 6811, 6811, 6811, 6846, 6879, 6879, 6879,
+		6879, 6879, 6879, 6906, 6944, 6984, 7015, 7039, 7055, 7083, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 23, 0, 31, 69, 94,
+		120, 152, 185, 212, 246, 273, 299, 326, 339, 367, 396, 413, 424, 442, 469, 481,
+		494, 510, 524, 551, 572, 592, 603, 624, 645, 672, 700, 720, 740, 768, 797, 818,
+		840, 864, 889, 912, 936, 959, 983, 1016, 1049, 1077, 1090, 1112, 1126, 1136, 1161, 1173,
+		1183, 1195, 1211, 1233, 1256, 1269, 1290, 1301, 1314, 1336, 1353, 1373, 1382, 1395, 1409, 1445,
+		1466, 1488, 1507, 1544, 1554, 1573, 1593, 1607, 1620, 1634, 1650, 1671, 1692, 1723, 1753, 1777,
+		1802, 1824, 1855, 1887, 1925, 1964, 1989, 2015, 2043, 2072, 2086, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 53, 112, 167, 209, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 36, 36, 36, 36, 36, 36, 71, 80, 101, 132, 159, 159, 159, 159,
+		159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+		159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+		159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+		159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+		159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+		159, 159, 159, 159, 159, 159, 159, 159, 194, 234, 234, 234, 234, 265, 265, 265,
+		265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265,
+		265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265,
+		265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265,
+		265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265,
+		265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265,
+		265, 265, 265, 265, 265, 265, 265, 265, 284, 322, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
+		19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 32,
+		32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 58, 58,
+		58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 82, 82, 82, 82, 82,
+		82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 117, 152, 183, 214, 247,
+		279, 310, 344, 378, 411, 445, 478, 512, 546, 579, 610, 610, 610, 610, 610, 610,
+		610, 610, 610, 610, 610, 610, 610, 610, 610, 610, 610, 610, 610, 610, 610, 610,
+		610, 610, 610, 610, 610, 610, 610, 610, 610, 610, 610, 622, 635, 648, 662, 675,
+		689, 703, 716, 731, 746, 760, 775, 790, 804, 819, 831, 843, 855, 867, 880, 892,
+		905, 918, 931, 945, 958, 971, 984, 997, 1010, 1024, 1038, 1053, 1069, 1082, 1094, 1094,
+		1094, 1094, 1094, 1094, 1094, 1094, 1094, 1094, 1094, 1094, 1139, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 36, 71, 71, 71, 71, 71, 71,
+		71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+		71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+		97, 127, 151, 173, 173, 173, 173, 173, 173, 173, 173, 173, 189, 189, 189, 189,
+		189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+		189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+		189, 189, 189, 189, 189, 189, 189, 189, 220, 250, 272, 272, 272, 272, 272, 272,
+		272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+		272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+		272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+		272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+		272, 272, 272, 272, 272, 272, 272, 272, 281, 291, 300, 322, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 9, 26, 41, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+		50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 65, 80, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 32, 64,
+		104, 131, 151, 172, 193, 222, 247, 268, 286, 309, 332, 361, 381, 404, 426, 458,
+		479, 505, 532, 550, 578, 597, 619, 644, 670, 702, 732, 762, 784, 805, 825, 849,
+		870, 905, 928, 951, 975, 999, 1020, 1041, 1066, 1093, 1124, 1151, 1174, 1195, 1218, 1243,
+		1276, 1315, 1339, 1371, 1393, 1418, 1446, 1474, 1497, 1520, 1544, 1576, 1605, 1635, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 14, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
+		28, 28, 28, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
+		57, 57, 57, 57, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+		72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+		72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+		72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 94, 120, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 22, 39,
+		39, 39, 39, 55, 71, 86, 100, 115, 129, 143, 158, 174, 189, 204, 219, 234,
+		234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
+		234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
+		234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
+		234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
+		234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
+		234, 234, 234, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 266, 282,
+		282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+		282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+		282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+		282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+		282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+		282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+		282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+		282, 282, 295, 310, 321, 332, 347, 366, 0, 37, 75, 113, 152, 187, 223, 258,
+		294, 336, 372, 407, 442, 483, 535, 581, 626, 671, 722, 767, 806, 844, 882, 926,
+		926, 926, 926, 926, 926, 926, 926, 926, 926, 962, 997, 997, 997, 997, 997, 997,
+		997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997,
+		997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997,
+		997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997,
+		997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997,
+		997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997,
+		997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997, 997,
+		997, 997, 1018, 1051, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 26, 52, 78,
+		104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 128, 156, 178, 203, 203,
+		203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203,
+		203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203,
+		203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203,
+		203, 203, 203, 203, 203, 203, 203, 203, 203, 228, 253, 271, 296, 296, 296, 296,
+		296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+		296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+		296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+		296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+		296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+		296, 296, 296, 312, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335,
+		335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335,
+		335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 359, 380, 396,
+		417, 440, 458, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 17, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
+		35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
+		35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
+		35, 35, 35, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+		54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+		54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+		54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+		54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+		54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+		54, 54, 54, 54, 54, 77, 101, 120, 139, 158, 177, 198, 218, 238, 256, 279,
+		300, 328, 328, 348, 348, 348, 348, 348, 348, 348, 348, 348, 348, 348, 348, 348,
+		348, 348, 375, 398, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 23, 45, 74, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
+		103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 135, 159, 183, 183,
+		183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183,
+		183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183,
+		183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183,
+		183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183,
+		183, 183, 183, 183, 183, 206, 206, 228, 228, 228, 228, 228, 228, 228, 228, 228,
+		228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228,
+		247, 268, 287, 309, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332,
+		332, 332, 332, 332, 332, 353, 378, 395, 395, 428, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
+		34, 34, 34, 34, 34, 59, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
+		85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
+		85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
+		85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
+		85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
+		85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
+		85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
+		85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
+		85, 85, 85, 85, 85, 85, 106, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 52, 52, 52, 52, 52, 52, 52, 52,
+		52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52,
+		52, 52, 52, 52, 52, 52, 52, 52, 90, 125, 157, 186, 217, 247, 278, 306,
+		336, 365, 398, 428, 460, 491, 525, 556, 589, 621, 654, 684, 716, 747, 779, 808,
+		839, 869, 900, 928, 958, 987, 1020, 1050, 1082, 1113, 1145, 1174, 1205, 1235, 1267, 1296,
+		1327, 1357, 1390, 1420, 1452, 1483, 1518, 1550, 1584, 1617, 1651, 1682, 1715, 1745, 1776, 1804,
+		1836, 1865, 1896, 1924, 1956, 1985, 2018, 2048, 2080, 2111, 2142, 2170, 2200, 2229, 2261, 2290,
+		2321, 2351, 2384, 2414, 2446, 2477, 2516, 2552, 2585, 2615, 2647, 2678, 2724, 2767, 2803, 2836,
+		2871, 2905, 2948, 2988, 3030, 3071, 3109, 3144, 3199, 3251, 3274, 3297, 3325, 3353, 3383, 3413,
+		3462, 3511, 3540, 3569, 3608, 3647, 3686, 3704, 3733, 3762, 3787, 3787, 3787, 3787, 3787, 3787,
+		3787, 3787, 3787, 3787, 3787, 3787, 3787, 3787, 3787, 3787, 3787, 3817, 3844, 3873, 3901, 3930,
+		3956, 3986, 4013, 4043, 4070, 4116, 4146, 4173, 4211, 4246, 4284, 4319, 4348, 4374, 4402, 4429,
+		4490, 4550, 4610, 4667, 4725, 4780, 4839, 4895, 4952, 5006, 5064, 5119, 5177, 5232, 5289, 5343,
+		5399, 5482, 5562, 5644, 5681, 5715, 5751, 5786, 0, 60, 119, 179, 247, 306, 349, 391,
+		434, 477, 528, 570, 613, 655, 698, 741, 792, 834, 878, 922, 974, 1017, 1060, 1104,
+		1147, 1190, 1234, 1277, 1321, 1365, 1408, 1452, 1496, 1538, 1581, 1624, 1666, 1709, 1752, 1794,
+		1837, 1880, 1923, 1966, 2011, 2056, 2099, 2141, 2184, 2227, 2278, 2320, 2362, 2405, 2456, 2498,
+		2541, 2584, 2626, 2669, 2711, 2754, 2805, 2847, 2890, 2932, 2975, 3018, 3069, 3111, 3155, 3198,
+		3242, 3286, 3338, 3381, 3425, 3468, 3512, 3556, 3608, 3651, 3694, 3737, 3788, 3830, 3873, 3915,
+		3958, 4001, 4052, 4094, 4150, 4205, 4269, 4319, 4369, 4416, 4463, 4510, 4568, 4624, 4681, 4738,
+		4795, 4860, 4916, 4955, 4995, 5035, 5075, 5123, 5162, 5201, 5241, 5281, 5321, 5369, 5408, 5448,
+		5489, 5530, 5571, 5620, 5660, 5708, 5747, 5795, 5834, 5874, 5913, 5953, 6001, 6040, 6080, 6128,
+		6167, 6208, 6249, 6289, 6330, 6371, 6412, 6461, 6501, 6562, 6601, 6641, 6681, 6721, 6769, 6808,
+		6867, 6925, 6984, 7043, 7101, 7143, 7184, 7226, 7268, 7309, 7351, 7392, 7434, 7476, 7517, 7560,
+		7602, 7645, 7687, 7729, 7772, 7815, 7858, 7900, 7943, 7986, 8027, 8069, 8111, 8153, 8194, 8236,
+		8278, 8319, 8361, 8403, 8445, 8489, 8533, 8575, 8616, 8658, 8700, 8741, 8783, 8825, 8866, 8908,
+		8949, 8991, 9033, 9074, 9116, 9158, 9199, 9242, 9284, 9327, 9370, 9413, 9455, 9498, 9541, 9583,
+		9625, 9667, 9721, 9763, 9804, 9846, 9888, 9929, 9987, 10044, 10085, 10125, 10166, 10206, 10248, 10289,
+		10331, 10372, 10415, 10457, 10497, 10538, 10579, 10621, 10662, 10703, 10743, 10788, 10833, 10878, 10929, 10971,
+		11022, 11064, 11117, 11161, 11213, 11256, 11309, 11353, 11404, 0, 42, 94, 137, 189, 232, 283,
+		325, 376, 418, 463, 507, 552, 597, 641, 684, 726, 768, 816, 855, 903, 942, 992,
+		1033, 1082, 1122, 1172, 1213, 1261, 1300, 1349, 1389, 1438, 1478, 1526, 1565, 1613, 1652, 1694,
+		1735, 1777, 1819, 1860, 1900, 1939, 1978, 2022, 2065, 2109, 2153, 2195, 2238, 2280, 2322, 2363,
+		2405, 2448, 2490, 2533, 2574, 2615, 2660, 2708, 2731, 2755, 2786, 2818, 2852, 2886, 2922, 2958,
+		3001, 3033, 3066, 3101, 3145, 3175, 3228, 3261, 3297, 3329, 3381, 3430, 3481, 3532, 3584, 3636,
+		3687, 3739, 3789, 3841, 3890, 3948, 4000, 4052, 4111, 4161, 4213, 4266, 4317, 4370, 4418, 4468,
+		4518, 4569, 4622, 4673, 4725, 4779, 4831, 4885, 4942, 4992, 5044, 5093, 5144, 5196, 5245, 5295,
+		5345, 5397, 5455, 5507, 5558, 5618, 5668, 5720, 5769, 5819, 5868, 5916, 5973, 6025, 6075, 6125,
+		6177, 6226, 6277, 6329, 6381, 6430, 6482, 6535, 6588, 6641, 6641, 6641, 6694, 6746, 6798, 6850,
+		6908, 6959, 7012, 7071, 7121, 7180, 7230, 7282, 7331, 7380, 7438, 7487, 7545, 7594, 7652, 7702,
+		7760, 7819, 7878, 7926, 7976, 8024, 8073, 8122, 8170, 8219, 8268, 8318, 8367, 8416, 8467, 8518,
+		8567, 8616, 8668, 8718, 8770, 8820, 8870, 8920, 8969, 9018, 9068, 9117, 9165, 9217, 9269, 9321,
+		9371, 9421, 9421, 9421, 9421, 9421, 9421, 9421, 9421, 9454, 9454, 9454, 9454, 9454, 9454, 9454,
+		9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454,
+		9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454, 9454, 9515, 9575, 9610, 9645, 9683, 9718,
+		9754, 9790, 9828, 9863, 9906, 9937, 9946, 9991, 10028, 10056, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 36, 84, 136, 172, 212,
+		259, 303, 363, 424, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474,
+		474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474,
+		474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474, 474,
+		484, 500, 550, 601, 616, 635, 648, 668, 683, 702, 715, 715, 715, 715, 715, 715,
+		715, 715, 715, 715, 715, 715, 715, 715, 715, 715, 715, 715, 715, 715, 715, 715,
+		715, 715, 715, 715, 715, 715, 715, 715, 715, 715, 715, 744, 778, 807, 827, 856,
+		856, 882, 906, 932, 956, 982, 1006, 1033, 1058, 1084, 1108, 1141, 1190, 1236, 1285, 1331,
+		1379, 1424, 1473, 1519, 1567, 1612, 1659, 1705, 1737, 1766, 1797, 1825, 1855, 1884, 1923, 1959,
+		1990, 2018, 2048, 2077, 2109, 2138, 2169, 2199, 2231, 2260, 2291, 2321, 2352, 2380, 2410, 2439,
+		2471, 2500, 2531, 2561, 2592, 2620, 2652, 2681, 2712, 2740, 2772, 2801, 2833, 2862, 2893, 2923,
+		2956, 2986, 3018, 3049, 3080, 3108, 3138, 3167, 3198, 3226, 3256, 3285, 3316, 3344, 3374, 3403,
+		3434, 3462, 3492, 3521, 3552, 3580, 3610, 3639, 3672, 3702, 3734, 3765, 3796, 3824, 3854, 3883,
+		3914, 3942, 3972, 4001, 4032, 4060, 4090, 4119, 4150, 4178, 4208, 4237, 4269, 4298, 4329, 4359,
+		4391, 4420, 4451, 4481, 4512, 4540, 4570, 4599, 4630, 4658, 4698, 4735, 4766, 4794, 4824, 4853,
+		4913, 4970, 5030, 5087, 5147, 5204, 5247, 5287, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 32, 65, 65, 65, 65, 65, 65, 65,
+		65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
+		65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
+		65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
+		65, 65, 65, 65, 65, 65, 65, 65, 65, 88, 88, 88, 88, 88, 88, 88,
+		88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88,
+		117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+		117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+		117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+		117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+		117, 117, 117, 117, 117, 145, 166, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 20, 40, 60, 80, 100, 120, 140, 160,
+		180, 200, 220, 240, 260, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
+		280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
+		280, 280, 280, 280, 280, 280, 280, 280, 306, 334, 361, 389, 417, 449, 476, 503,
+		535, 566, 593, 621, 648, 676, 704, 733, 761, 789, 819, 846, 875, 901, 928, 950,
+		978, 1005, 1034, 1061, 1083, 1110, 1132, 1154, 1181, 1203, 1225, 1247, 1275, 1297, 1319, 1347,
+		1369, 1391, 1421, 1450, 1472, 1494, 1516, 1538, 1560, 1582, 1604, 1626, 1648, 1676, 1698, 1725,
+		1747, 1769, 1791, 1813, 1835, 1857, 1879, 1901, 1923, 1945, 1967, 1996, 2028, 2058, 2086, 2114,
+		2136, 2164, 2186, 2208, 2246, 2276, 2312, 2340, 2362, 2384, 2412, 2434, 2456, 2478, 2500, 2522,
+		2549, 2571, 2593, 2615, 2637, 2659, 2688, 2717, 2746, 2775, 2804, 2833, 2862, 2891, 2920, 2949,
+		2978, 3007, 3036, 3065, 3094, 3123, 3152, 3181, 3210, 3239, 3268, 3297, 3326, 3355, 3384, 3413,
+		3442, 3471, 3500, 0, 26, 51, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
+		68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
+		68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
+		68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 91, 118, 146, 173, 201,
+		233, 268, 297, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325,
+		325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325,
+		325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325,
+		325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 340, 357, 375,
+		390, 410, 432, 453, 474, 493, 516, 532, 549, 566, 583, 600, 617, 641, 641, 641,
+		660, 680, 692, 692, 710, 726, 745, 763, 789, 807, 826, 846, 867, 887, 900, 920,
+		932, 932, 932, 932, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952,
+		952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952,
+		952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952, 952,
+		952, 952, 952, 952, 981, 1011, 1043, 1069, 1093, 1117, 1142, 1169, 1193, 1217, 1239, 1264,
+		1287, 1314, 1340, 1362, 1384, 1412, 1446, 1471, 1494, 1518, 1543, 1569, 1592, 1615, 1638, 1666,
+		1688, 1710, 1734, 1757, 1781, 1803, 1832, 1855, 1881, 1907, 1930, 1956, 1980, 2005, 2032, 2060,
+		2088, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+		21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+		21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+		21, 21, 45, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 19,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		32, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 29, 29, 29, 29, 29, 29, 29,
+		29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29,
+		29, 29, 29, 29, 29, 29, 29, 29, 29, 60, 92, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 25, 25, 25, 25, 25,
+		25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+		25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 47, 47, 47, 47, 47,
+		47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+		47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+		47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+		47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+		47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+		47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 80, 115, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 26, 61, 90,
+		125, 156, 184, 212, 247, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275,
+		275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275,
+		275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 310, 310, 310, 310,
+		310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310,
+		310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310,
+		310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310,
+		310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310,
+		310, 310, 310, 310, 310, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328,
+		328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328,
+		328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 355, 385, 429,
+		466, 492, 523, 557, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 25, 63,
+		102, 141, 180, 221, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+		262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+		262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+		262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+		262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+		262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 290, 325,
+		361, 395, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 26, 56, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 23, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 24, 24, 24, 24,
+		24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 61, 108, 143, 184,
+		224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+		224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+		224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 250, 281, 312,
+		344, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 12, 31, 53, 82, 105, 136, 160,
+		160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
+		160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
+		160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
+		160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
+		160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
+		160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
+		160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 184, 207, 207,
+		226, 252, 264, 283, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 19, 31, 50, 70, 70, 70, 70, 70, 70, 70, 70,
+		70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70,
+		70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70,
+		70, 70, 70, 70, 70, 70, 70, 70, 70, 96, 117, 117, 117, 117, 117, 117,
+		117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+		117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+		117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+		117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+		117, 117, 117, 117, 117, 117, 117, 117, 117, 127, 140, 160, 185, 202, 202, 202,
+		202, 202, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 232,
+		252, 270, 295, 317, 339, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 12, 31,
+		52, 71, 97, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+		121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+		121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+		121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+		121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+		121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+		121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 141,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 18, 28, 45,
+		55, 70, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 109, 130, 130,
+		149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+		149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+		149, 149, 149, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+		161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+		161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+		161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+		161, 161, 161, 161, 161, 161, 161, 161, 174, 199, 209, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 20, 33, 53,
+		74, 95, 120, 145, 170, 194, 250, 304, 355, 413, 471, 503, 545, 587, 629, 674,
+		718, 760, 812, 865, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 10, 27, 49, 49, 49, 49, 49, 49, 49, 49,
+		49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49,
+		49, 49, 49, 49, 49, 78, 101, 137, 173, 209, 246, 290, 314, 359, 380, 415,
+		457, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506,
+		506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506,
+		506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506,
+		506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506,
+		506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 506, 529, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 23, 40, 55, 69,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		37, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 31, 31, 53, 53, 53, 77, 99, 127, 127, 127, 127, 127, 127, 127, 127,
+		127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+		127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+		127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+		127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+		127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+		127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+		127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+		127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+		127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+		127, 127, 127, 127, 151, 173, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 34, 68, 95, 121, 154, 186, 233, 280, 280, 280,
+		280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
+		280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
+		280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
+		280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
+		280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
+		280, 298, 315, 339, 357, 409, 454, 489, 512, 535, 535, 535, 535, 535, 535, 535,
+		535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535,
+		535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535,
+		535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535,
+		535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 535, 563, 586, 617, 651,
+		680, 717, 745, 768, 802, 831, 857, 889, 926, 958, 991, 1019, 1043, 1082, 1116, 1146,
+		0, 20, 56, 77, 108, 138, 178, 217, 256, 296, 317, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 15, 37, 61,
+		83, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105,
+		105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105,
+		105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 122, 139, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
+		19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
+		19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
+		19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
+		19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
+		19, 19, 19, 19, 19, 19, 19, 35, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 13, 13, 13, 13, 13,
+		31, 53, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 10, 27, 58, 99, 122, 151, 171, 198, 225, 248,
+		278, 301, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332,
+		332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332,
+		332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332,
+		332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332,
+		332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332,
+		332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332,
+		332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332,
+		332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332,
+		332, 332, 332, 332, 332, 332, 332, 332, 346, 363, 383, 401, 423, 440, 459, 479,
+		495, 511, 525, 544, 561, 577, 592, 618, 644, 659, 678, 707, 736, 754, 772, 788,
+		804, 828, 852, 872, 893, 893, 893, 893, 893, 893, 893, 893, 893, 893, 893, 893,
+		893, 893, 922, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 52, 93, 134, 178, 223, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 23, 46, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 25, 25, 25,
+		25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+		25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+		25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+		25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+		25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+		25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+		25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+		25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+		25, 25, 25, 44, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 26, 58, 85, 112,
+		138, 167, 194, 221, 249, 249, 249, 249, 249, 271, 301, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 17, 38, 61, 87, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 21, 41, 62, 88, 0, 20, 40, 60,
+		80, 100, 120, 140, 160, 180, 200, 220, 240, 260, 280, 300, 320, 340, 360, 380,
+		400, 420, 440, 460, 480, 500, 520, 540, 560, 580, 600, 620, 640, 660, 680, 700,
+		720, 740, 760, 780, 800, 820, 840, 860, 880, 900, 920, 940, 960, 980, 1000, 1020,
+		1040, 1060, 1080, 1100, 1120, 1140, 1160, 1180, 1200, 1220, 1240, 1260, 1280, 1300, 1320, 1340,
+		1360, 1380, 1400, 1420, 1440, 1460, 1480, 1500, 1520, 1540, 1560, 1580, 1600, 1620, 1640, 1660,
+		1680, 1700, 1720, 1740, 1760, 1780, 1800, 1820, 1840, 1860, 1880, 1900, 1920, 1940, 1960, 1980,
+		2000, 2020, 2040, 2060, 2080, 2100, 2120, 2140, 2160, 2180, 2200, 2220, 2240, 2260, 2280, 2300,
+		2320, 2340, 2360, 2380, 2400, 2420, 2440, 2460, 2480, 2500, 2520, 2540, 2560, 2580, 2600, 2620,
+		2640, 2660, 2680, 2700, 2720, 2740, 2760, 2780, 2800, 2820, 2840, 2860, 2880, 2900, 2920, 2940,
+		2960, 2980, 3000, 3020, 3040, 3060, 3080, 3100, 3120, 3140, 3160, 3180, 3200, 3220, 3240, 3260,
+		3280, 3300, 3320, 3340, 3360, 3380, 3400, 3420, 3440, 3460, 3480, 3500, 3520, 3540, 3560, 3580,
+		3600, 3620, 3640, 3660, 3680, 3700, 3720, 3740, 3760, 3780, 3800, 3820, 3840, 3860, 3880, 3900,
+		3920, 3940, 3960, 3980, 4000, 4020, 4040, 4060, 4080, 4100, 4120, 4140, 4160, 4180, 4200, 4220,
+		4240, 4260, 4280, 4300, 4320, 4340, 4360, 4380, 4400, 4420, 4440, 4460, 4480, 4500, 4520, 4540,
+		4560, 4580, 4600, 4620, 4640, 4660, 4680, 4700, 4720, 4740, 4760, 4780, 4800, 4820, 4840, 4860,
+		4880, 4900, 4920, 4940, 4960, 4980, 5000, 5020, 5040, 5060, 5080, 5100, 5120, 0, 20, 40,
+		60, 80, 100, 120, 140, 160, 180, 200, 220, 240, 260, 280, 300, 320, 340, 360,
+		380, 400, 420, 440, 460, 480, 500, 520, 540, 560, 580, 600, 620, 640, 660, 680,
+		700, 720, 740, 760, 780, 800, 820, 840, 860, 880, 900, 920, 940, 960, 980, 1000,
+		1020, 1040, 1060, 1080, 1100, 1120, 1140, 1160, 1180, 1200, 1220, 1240, 1260, 1280, 1300, 1320,
+		1340, 1360, 1380, 1400, 1420, 1440, 1460, 1480, 1500, 1520, 1540, 1560, 1580, 1600, 1620, 1640,
+		1660, 1680, 1700, 1720, 1740, 1760, 1780, 1800, 1820, 1840, 1860, 1880, 1900, 1920, 1940, 1960,
+		1980, 2000, 2020, 2040, 2060, 2080, 2100, 2120, 2140, 2160, 2180, 2200, 2220, 2240, 2260, 2280,
+		2300, 2320, 2340, 2360, 2380, 2400, 2420, 2440, 2460, 2480, 2500, 2520, 2540, 2560, 2580, 2600,
+		2620, 2640, 2660, 2680, 2700, 2720, 2740, 2760, 2780, 2800, 2820, 2840, 2860, 2880, 2900, 2920,
+		2940, 2960, 2980, 3000, 3020, 3040, 3060, 3080, 3100, 3120, 3140, 3160, 3180, 3200, 3220, 3240,
+		3260, 3280, 3300, 3320, 3340, 3360, 3380, 3400, 3420, 3440, 3460, 3480, 3500, 3520, 3540, 3560,
+		3580, 3600, 3620, 3640, 3660, 3680, 3700, 3720, 3740, 3760, 3780, 3800, 3820, 3840, 3860, 3880,
+		3900, 3920, 3940, 3960, 3980, 4000, 4020, 4040, 4060, 4080, 4100, 4120, 4140, 4160, 4180, 4200,
+		4220, 4240, 4260, 4280, 4300, 4320, 4340, 4360, 4380, 4400, 4420, 4440, 4460, 4480, 4500, 4520,
+		4540, 4560, 4580, 4600, 4620, 4640, 4660, 4680, 4700, 4720, 4740, 4760, 4780, 4800, 4820, 4840,
+		4860, 4880, 4900, 4920, 4940, 4960, 4980, 5000, 5020, 5040, 5060, 5080, 5100, 5120, 0, 20,
+		40, 60, 80, 100, 120, 140, 160, 180, 200, 220, 240, 260, 280, 300, 320, 340,
+		360, 380, 400, 420, 440, 460, 480, 500, 520, 540, 560, 580, 600, 620, 640, 660,
+		680, 700, 720, 740, 760, 780, 800, 820, 840, 860, 880, 900, 920, 940, 960, 980,
+		1000, 1020, 1040, 1060, 1080, 1100, 1120, 1140, 1160, 1180, 1200, 1220, 1240, 1260, 1280, 1300,
+		1320, 1340, 1360, 1380, 1400, 1420, 1440, 1460, 1480, 1500, 1520, 1540, 1560, 1580, 1600, 1620,
+		1640, 1660, 1680, 1700, 1720, 1740, 1760, 1780, 1800, 1820, 1840, 1860, 1880, 1900, 1920, 1940,
+		1960, 1980, 2000, 2020, 2040, 2060, 2080, 2100, 2120, 2140, 2160, 2180, 2200, 2220, 2240, 2260,
+		2280, 2300, 2320, 2340, 2360, 2380, 2400, 2420, 2440, 2460, 2480, 2500, 2520, 2540, 2560, 2580,
+		2600, 2620, 2640, 2660, 2680, 2700, 2720, 2740, 2760, 2780, 2800, 2820, 2840, 2860, 2880, 2900,
+		2920, 2940, 2960, 2980, 3000, 3020, 3040, 3060, 3080, 3100, 3120, 3140, 3160, 3180, 3200, 3220,
+		3240, 3260, 3280, 3300, 3320, 3340, 3360, 3380, 3400, 3420, 3440, 3460, 3480, 3500, 3520, 3540,
+		3560, 3580, 3600, 3620, 3640, 3660, 3680, 3700, 3720, 3740, 3760, 3780, 3800, 3820, 3840, 3860,
+		3880, 3900, 3920, 3940, 3960, 3980, 4000, 4020, 4040, 4060, 4080, 4100, 4120, 4140, 4160, 4180,
+		4200, 4220, 4240, 4260, 4280, 4300, 4320, 4340, 4360, 4380, 4400, 4420, 4440, 4460, 4480, 4500,
+		4520, 4540, 4560, 4580, 4600, 4620, 4640, 4660, 4680, 4700, 4720, 4740, 4760, 4780, 4800, 4820,
+		4840, 4860, 4880, 4900, 4920, 4940, 4960, 4980, 5000, 5020, 5040, 5060, 5080, 5100, 5120, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		37, 73, 111, 145, 179, 214, 244, 288, 319, 347, 377, 407, 443, 443, 443, 443,
+		468, 499, 524, 554, 577, 603, 627, 651, 679, 679, 679, 679, 679, 679, 679, 679,
+		703, 733, 757, 786, 808, 833, 856, 879, 906, 930, 930, 930, 956, 956, 956, 994,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 20, 46, 69, 96, 117, 140, 163, 195, 219, 258, 302, 327, 347, 371, 401,
+		431, 463, 494, 528, 560, 593, 625, 660, 704, 749, 793, 840, 871, 905, 928, 950,
+		972, 997, 1031, 1064, 1100, 1132, 1165, 1210, 1256, 1300, 1346, 1392, 1444, 1493, 1517, 1552,
+		1585, 1619, 1639, 1657, 1676, 1697, 1719, 1740, 1772, 1803, 1845, 1887, 1918, 1960, 1989, 2029,
+		2075, 2100, 2136, 2166, 2202, 2234, 2269, 2300, 2336, 2374, 2417, 2454, 2488, 2530, 2571, 2617,
+		2670, 2722, 2780, 2810, 2846, 2904, 2941, 2988, 3009, 3038, 3070, 3106, 3147, 3183, 3220, 3257,
+		3277, 3306, 3338, 3378, 3419, 3466, 3498, 3540, 3603, 3672, 3713, 3760, 3829, 3877, 3935, 3975,
+		3997, 4018, 4037, 4057, 4076, 0, 30, 61, 97, 139, 175, 218, 255, 288, 322, 358,
+		392, 432, 465, 507, 547, 578, 612, 647, 688, 720, 750, 790, 830, 861, 894, 924,
+		962, 1003, 1046, 1079, 1112, 1153, 1193, 1234, 1276, 1309, 1340, 1370, 1410, 1442, 1479, 1510,
+		1551, 1596, 1639, 1669, 1699, 1727, 1756, 1788, 1822, 1860, 1900, 1934, 1976, 2016, 2049, 2083,
+		2116, 2158, 2190, 2235, 2280, 2312, 2341, 2373, 2411, 2449, 2486, 2523, 2556, 2591, 2625, 2657,
+		2690, 2730, 2770, 2807, 2849, 2889, 2920, 2959, 3008, 3040, 3080, 3112, 3144, 3180, 3215, 3250,
+		3288, 3319, 3354, 3386, 3426, 3466, 3509, 3541, 3581, 3618, 3653, 3689, 3719, 3756, 3789, 3824,
+		3861, 3902, 3942, 3981, 4024, 4068, 4107, 4147, 4186, 4224, 4269, 4307, 4347, 4387, 4424, 4465,
+		4499, 4533, 4567, 4600, 4632, 4667, 4706, 4747, 4784, 4820, 4857, 4886, 4916, 4947, 4980, 5012,
+		5056, 5099, 5144, 5192, 5239, 5278, 5332, 5383, 5416, 5477, 5537, 5590, 5624, 5654, 5689, 5721,
+		5761, 5800, 5835, 5872, 5907, 5943, 5983, 6024, 6070, 6121, 6169, 6222, 6268, 6311, 6359, 6415,
+		6462, 6509, 6557, 6609, 6655, 6700, 6743, 6788, 6838, 6894, 6938, 6983, 7030, 7063, 7098, 7153,
+		7197, 7243, 7285, 7329, 7380, 7424, 7468, 7517, 7565, 7620, 7677, 7729, 7782, 7820, 7856, 7893,
+		7930, 7984, 8036, 8079, 8122, 8167, 8216, 8276, 8329, 8385, 8434, 8494, 8547, 8603, 8641, 8679,
+		8723, 8768, 8807, 8848, 8889, 8938, 8988, 9040, 9080, 9128, 9177, 9228, 9264, 9301, 9332, 9367,
+		9403, 9438, 9473, 9508, 9543, 9578, 9621, 9662, 9705, 9746, 9782, 9822, 0, 29, 58, 86,
+		122, 151, 179, 210, 242, 268, 292, 314, 334, 353, 385, 417, 449, 471, 499, 525,
+		547, 567, 589, 618, 647, 678, 708, 738, 767, 802, 838, 859, 892, 926, 947, 968,
+		1001, 1035, 1061, 1087, 1087, 1087, 1123, 1150, 1176, 1198, 1222, 1247, 1274, 1297, 1322, 1355,
+		1387, 1413, 1436, 1462, 1489, 1521, 1554, 1579, 1604, 1628, 1655, 1681, 1710, 1743, 1775, 1820,
+		1845, 1873, 1905, 1941, 1977, 2018, 2059, 2102, 2145, 2189, 2233, 2276, 2319, 2366, 2413, 2447,
+		2481, 2530, 2579, 2614, 2642, 2671, 2699, 2736, 2773, 2793, 2818, 2842, 2869, 2895, 2924, 2957,
+		2989, 3034, 3034, 3034, 3034, 3034, 3034, 3067, 3100, 3133, 3133, 3133, 3133, 3133, 3133, 3133,
+		3133, 3133, 3133, 3133, 3133, 3133, 3133, 3133, 3133, 3133, 3133, 3133, 3133, 3133, 3133, 3133,
+		3161, 3191, 3191, 3191, 3191, 3191, 3191, 3191, 3191, 3217, 3238, 3254, 3274, 3294, 3314, 3338,
+		3364, 3395, 3429, 3446, 3465, 3493, 3518, 3540, 3572, 3604, 3636, 3668, 3700, 3732, 3764, 3796,
+		3828, 3861, 3894, 3920, 3946, 3974, 4001, 4001, 4001, 4001, 4001, 4026, 4054, 4084, 4111, 4140,
+		4171, 4190, 4213, 4234, 4254, 4275, 4306, 4337, 4358, 4385, 4416, 4447, 4472, 4497, 4531, 4567,
+		4593, 4623, 4649, 4679, 4734, 4791, 4859, 4916, 4975, 5047, 5119, 5191, 5211, 5242, 5273, 5296,
+		5316, 5338, 5359, 5383, 5406, 5429, 5453, 5484, 5515, 5548, 5577, 5605, 5639, 5671, 5708, 5740,
+		5771, 5815, 5857, 5900, 5941, 5972, 5991, 6011, 0, 29, 58, 87, 116, 145, 174, 203,
+		232, 261, 291, 321, 351, 381, 411, 441, 471, 501, 531, 561, 591, 621, 651, 681,
+		711, 741, 771, 801, 831, 861, 897, 933, 969, 1005, 1041, 1077, 1114, 1151, 1188, 1225,
+		1262, 1299, 1336, 1373, 1410, 1447, 1484, 1521, 1558, 1595, 1632, 1669, 1706, 1743, 1780, 1817,
+		1854, 1891, 1928, 1965, 2002, 2039, 2076, 2113, 2150, 2187, 2224, 2224, 2224, 2224, 2244, 0,
+		18, 43, 65, 90, 114, 130, 150, 175, 194, 215, 242, 267, 287, 311, 338, 379,
+		403, 429, 451, 476, 495, 516, 542, 563, 586, 607, 628, 652, 670, 687, 711, 734,
+		754, 774, 800, 829, 850, 870, 893, 914, 937, 959, 979, 1001, 1024, 1050, 1072, 1099,
+		1123, 1142, 1165, 1190, 1211, 1231, 1251, 1284, 1307, 1328, 1350, 1369, 1395, 1421, 1446, 1472,
+		1493, 1519, 1546, 1565, 1584, 1605, 1624, 1647, 1670, 1691, 1715, 1738, 1760, 1782, 1806, 1827,
+		1848, 1873, 1897, 1923, 1949, 1972, 1995, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 38, 70, 100, 130, 158, 186, 213, 246, 278, 311, 344, 379, 411,
+		442, 475, 507, 540, 573, 603, 633, 663, 698, 728, 759, 799, 832, 863, 898, 929,
+		960, 991, 1024, 1049, 1080, 1110, 1141, 1172, 0, 33, 63, 92, 123, 153, 184, 215,
+		243, 271, 299, 332, 360, 389, 426, 457, 486, 519, 548, 577, 606, 637, 677, 711,
+		743, 775, 805, 835, 864, 902, 939, 977, 1015, 1055, 1092, 1128, 1166, 1203, 1241, 1279,
+		1314, 1349, 1384, 1424, 1459, 1495, 1540, 1578, 1614, 1654, 1690, 1726, 1762, 1800, 1830, 1866,
+		1901, 1937, 1973, 2011, 2046, 2080, 2116, 2151, 2187, 2223, 2256, 2289, 2322, 2360, 2393, 2427,
+		2469, 2505, 2539, 2577, 2611, 2645, 2679, 2715, 2760, 2799, 2836, 2873, 2908, 2943, 2977, 3019,
+		3060, 3102, 3144, 3188, 3229, 3269, 3311, 3352, 3394, 3436, 3475, 3514, 3553, 3597, 3636, 3676,
+		3725, 3767, 3807, 3851, 3891, 3931, 3971, 4013, 4047, 4087, 4126, 4166, 4206, 4248, 4287, 4325,
+		4365, 4404, 4444, 4484, 4521, 4558, 4595, 4637, 4674, 4712, 4758, 4798, 4836, 4878, 4916, 4954,
+		4992, 5032, 5081, 5124, 5165, 5206, 5245, 5284, 5322, 5371, 5419, 5468, 5517, 5568, 5616, 5663,
+		5712, 5760, 5809, 5858, 5904, 5950, 5996, 6047, 6093, 6140, 6196, 6245, 6292, 6343, 6390, 6437,
+		6484, 6533, 6574, 6621, 6667, 6714, 6761, 6810, 6856, 6901, 6948, 6994, 7041, 7088, 7132, 7176,
+		7220, 7269, 7313, 7358, 7411, 7458, 7503, 7552, 7597, 7642, 7687, 7734, 7790, 7840, 7888, 7936,
+		7982, 8028, 8073, 8106, 8137, 0, 27, 56, 82, 109, 137, 165, 197, 231, 275, 317,
+		351, 385, 423, 458, 492, 528, 567, 617, 658, 702, 746, 790, 845, 901, 952, 1003,
+		1045, 1089, 1133, 1177, 1217, 1259, 1313, 1367, 1412, 1471, 1526, 1586, 1641, 1699, 1746, 1794,
+		1841, 1888, 1945, 2000, 2065, 2125, 2175, 2225, 2287, 2340, 2398, 2463, 2513, 2563, 2614, 2669,
+		2723, 2776, 2827, 2884, 2935, 2982, 3036, 3095, 3145, 3199, 3233, 3272, 3313, 3357, 3407, 3451,
+		3500, 3545, 3586, 3632, 3683, 3739, 3785, 3836, 3891, 3931, 3976, 4023, 4064, 4112, 4171, 4228,
+		4249, 4291, 4317, 4349, 4386, 4418, 4453, 4497, 4531, 4576, 4626, 4660, 4681, 4713, 4743, 4778,
+		4808, 4829, 4854, 4874, 4910, 4941, 4975, 5004, 5043, 5077, 5107, 5132, 5155, 5176, 5208, 5238,
+		5273, 5300, 5341, 5363, 5391, 5429, 5462, 5498, 5529, 5577, 5625, 5647, 5686, 5727, 5767, 5807,
+		5836, 5880, 5929, 5979, 6012, 6061, 6108, 6156, 6187, 6230, 6263, 6294, 6326, 6369, 6402, 6444,
+		6478, 6513, 6553, 6594, 6651, 6704, 6738, 6774, 6809, 6844, 6885, 6928, 6970, 6997, 7039, 7086,
+		7135, 7166, 7222, 7279, 7308, 7349, 7382, 7417, 7450, 7484, 7517, 7560, 7609, 7641, 7673, 7705,
+		7744, 7785, 7831, 7874, 7916, 7961, 7999, 8039, 8083, 8123, 8156, 8185, 8216, 8249, 8292, 8334,
+		8368, 8409, 8444, 8484, 8526, 8573, 8612, 8653, 8698, 8742, 8783, 8830, 8880, 8935, 8989, 9042,
+		9092, 9127, 9165, 9204, 9257, 9311, 9360, 9409, 9457, 9507, 9563, 9615, 9653, 9695, 9747, 9799,
+		9850, 9890, 9922, 9959, 9998, 10038, 10072, 10112, 10150, 10187, 10221, 10248, 10280, 10321, 10363, 10400,
+		10435, 10483, 10530, 10577, 10622, 10666, 0, 47, 93, 146, 167, 193, 217, 243, 268, 292,
+		318, 343, 368, 395, 421, 445, 471, 496, 518, 542, 565, 592, 619, 651, 683, 717,
+		751, 781, 811, 841, 873, 905, 933, 970, 1010, 1050, 1090, 1132, 1184, 1236, 1284, 1329,
+		1375, 1427, 1480, 1532, 1586, 1634, 1680, 1728, 1777, 1837, 1873, 1928, 1976, 2025, 2085, 2126,
+		2168, 2209, 2252, 2296, 2339, 2385, 2427, 2470, 2512, 2552, 2593, 2633, 2676, 2720, 2763, 2805,
+		2848, 2890, 2944, 2998, 3057, 3112, 3167, 3227, 3263, 3309, 3355, 3401, 3441, 3482, 3522, 3564,
+		3607, 3651, 3694, 3739, 3787, 3836, 3884, 3934, 3985, 4037, 4088, 4141, 4194, 4248, 4301, 4356,
+		4405, 4452, 4501, 4551, 4612, 4649, 4705, 4754, 4813, 4874, 4910, 4954, 4999, 5043, 5080, 5121,
+		5163, 5204, 5248, 5293, 5337, 5380, 5424, 5467, 5523, 5579, 5640, 5695, 5750, 5810, 5847, 5897,
+		5948, 5998, 6050, 6104, 6159, 6213, 6269, 6332, 6396, 6437, 6479, 6520, 6561, 6603, 6644, 6692,
+		6746, 6801, 6855, 6909, 6964, 7018, 7068, 7117, 7167, 7204, 7241, 7281, 7319, 7374, 7428, 7482,
+		7536, 7592, 7648, 7709, 7759, 7808, 7857, 7906, 7957, 8008, 8064, 8119, 8175, 8230, 8289, 8348,
+		8413, 8478, 8543, 8608, 8673, 8738, 8803, 8868, 8926, 8984, 9038, 9092, 9151, 9208, 9265, 9328,
+		9391, 9461, 9531, 9594, 9657, 9720, 9783, 9839, 9895, 9947, 9999, 10056, 10099, 10143, 10186, 10231,
+		10277, 10319, 10361, 10403, 10445, 10487, 10525, 10563, 10606, 10654, 10708, 10763, 10817, 10872, 10940, 11009,
+		11077, 11145, 11214, 11282, 11338, 11394, 11458, 11522, 11574, 11626, 11692, 11758, 11793, 11828, 11852, 11876,
+		11901, 11928, 11960, 12004, 12040, 12067, 12083, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 36,
+		72, 97, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+		122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+		122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+		122, 122, 122, 122, 122, 152, 186, 225, 265, 304, 348, 387, 424, 424, 452, 477,
+		502, 527, 552, 577, 602, 627, 645, 681, 718, 745, 771, 797, 797, 823, 856, 873,
+		894, 915, 932, 955, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 33, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+		23, 23, 23, 23, 23, 23, 23, 53, 80, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 23, 23, 23, 23, 45, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 22, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 31, 31, 31, 62, 62, 62, 62, 62,
+		62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+		62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+		62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+		62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 100, 100, 100, 100, 100,
+		100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100,
+		100, 100, 100, 100, 100, 100, 100, 100, 141, 141, 141, 141, 141, 141, 141, 141,
+		141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+		141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+		141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+		141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+		141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+		141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+		141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 196, 237, 0, 22,
+		45, 67, 90, 113, 138, 163, 193, 223, 255, 286, 317, 347, 379, 411, 442, 469,
+		496, 525, 553, 581, 608, 637, 666, 694, 721, 748, 777, 805, 833, 860, 889, 918,
+		946, 963, 982, 1001, 1027, 1046, 1065, 1084, 1103, 1121, 1138, 1138, 1138, 1138, 1138, 1165,
+		1193, 1221, 1249, 1277, 1305, 1333, 1361, 1389, 1417, 1445, 1473, 1501, 1529, 1557, 1585, 1613,
+		1641, 1669, 1697, 1725, 1753, 1781, 1809, 1837, 1865, 1893, 1921, 1949, 1977, 2005, 2033, 2061,
+		2089, 2117, 2145, 2173, 2201, 2229, 2257, 2285, 2313, 2341, 2369, 2397, 2425, 2453, 2481, 2509,
+		2537, 2562, 2588, 2614, 2640, 2666, 2692, 2718, 2744, 2770, 2796, 2822, 2848, 2874, 2900, 2926,
+		2952, 2978, 3004, 3030, 3056, 3082, 3108, 3134, 3160, 3186, 3212, 3238, 3264, 3290, 3316, 3342,
+		3368, 3394, 3420, 3446, 3472, 3498, 3524, 3550, 3576, 3602, 3628, 3654, 3680, 3706, 3732, 3758,
+		3784, 3810, 3836, 3836, 3836, 3836, 3836, 3836, 3836, 3836, 3836, 3836, 3836, 3836, 3836, 3853,
+		3879, 3905, 3933, 3960, 3987, 4013, 4041, 4069, 4096, 4122, 4149, 4178, 4206, 4233, 4233, 4233,
+		4259, 4285, 4313, 4340, 4367, 4393, 4421, 4449, 4476, 4502, 4529, 4558, 4586, 4613, 4635, 4635,
+		4663, 4691, 4721, 4750, 4779, 4807, 4837, 4867, 4896, 4924, 4953, 4984, 5014, 5043, 5067, 5067,
+		5092, 5117, 5144, 5170, 5196, 5221, 5248, 5275, 5301, 5326, 5352, 5380, 5407, 5433, 5457, 5474,
+		5494, 5514, 5534, 5554, 5574, 5594, 5614, 5634, 5654, 5675, 5696, 5717, 5738, 5759, 5780, 5801,
+		5822, 5843, 5864, 5885, 5906, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 23, 50, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93,
+		93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93,
+		93, 93, 93, 93, 93, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+		108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+		108, 108, 108, 108, 108, 108, 147, 186, 225, 264, 303, 342, 381, 420, 459, 498,
+		537, 576, 615, 654, 693, 732, 771, 810, 849, 888, 927, 966, 1005, 1044, 1083, 1122,
+		1122, 1122, 1122, 1132, 1165, 1185, 1224, 1263, 1302, 1341, 1380, 1419, 1458, 1497, 1536, 1575,
+		1614, 1653, 1692, 1731, 1770, 1809, 1848, 1887, 1926, 1965, 2004, 2043, 2082, 2121, 2160, 2199,
+		2246, 2265, 2284, 2303, 2322, 2341, 2341, 2351, 2363, 2375, 2385, 2396, 2406, 2416, 2427, 2459,
+		2469, 2484, 2505, 2518, 2532, 2547, 2569, 2592, 2620, 2635, 2663, 2691, 2701, 2712, 2726, 2742,
+		2753, 2764, 2775, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791,
+		2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791,
+		2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791,
+		2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2791, 2825, 2859, 2893, 2927,
+		2961, 2995, 3029, 3063, 3097, 3131, 3165, 3199, 3233, 3267, 3301, 3335, 3369, 3403, 3437, 3471,
+		3505, 3539, 3573, 3607, 3641, 3675, 0, 20, 41, 60, 60, 60, 60, 60, 60, 60,
+		60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 79, 79, 79, 79, 79, 79,
+		79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+		79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+		79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+		79, 79, 79, 79, 79, 79, 79, 106, 130, 130, 130, 130, 130, 130, 130, 130,
+		130, 130, 130, 130, 130, 130, 130, 151, 172, 195, 216, 243, 265, 0, 7, 12,
+		27, 43, 65, 72, 89, 110, 117, 132, 142, 149, 158, 183, 203, 229, 249, 264,
+		291, 316, 342, 358, 384, 408, 435, 448, 466, 494, 521, 540, 553, 565, 578, 589,
+		602, 611, 637, 659, 691, 706, 721, 741, 759, 762, 779, 786, 790, 797, 805, 813,
+		827, 841, 850, 856, 866, 871, 885, 889, 897, 906, 913, 925, 936, 940, 956, 966,
+		977, 1000, 1008, 1014, 1023, 1029, 1034, 1044, 1053, 1058, 1064, 1073, 1082, 1093, 1097, 1102,
+		1110, 1120, 1129, 1143, 1155, 1166, 1178, 1187, 1198, 1212, 1225, 1234, 1239, 1251, 1271, 1276,
+		1280, 1285, 1297, 1324, 1338, 1348, 1357, 1365, 1371, 1384, 1389, 1397, 1404, 1413, 1422, 1431,
+		1442, 1449, 1463, 1484, 1503, 1513, 1527, 1541, 1549, 1567, 1578, 1603, 1627, 1634, 1640, 1655,
+		1668, 1682, 1696, 1712, 1721, 1738, 1745, 1757, 1770, 1783, 1796, 1811, 1825, 1838, 1848, 1869,
+		1883, 1897, 1923, 1941, 1955, 1970, 1997, 2014, 2026, 2039, 2069, 2100, 2111, 2128, 2142, 2154,
+		2168, 2189, 2199, 2211, 2217, 2226, 2240, 2247, 2258, 2264, 2277, 2292, 2302, 2312, 2324, 2333,
+		2341, 2348, 2368, 2380, 2402, 2411, 2417, 2433, 2440, 2446, 2459, 2482, 2505, 2521, 2540, 2554,
+		2565, 2571, 2577, 2589, 2595, 2607, 2624, 2638, 2645, 2658, 2664, 2681, 2691, 2711, 2721, 2748,
+		2773, 2801, 2821, 2828, 2847, 2868, 2883, 2892, 2915, 2933, 2939, 2952, 2965, 2972, 2986, 3003,
+		3018, 3038, 3058, 3066, 3070, 3094, 3099, 3109, 3126, 3132, 3148, 3155, 3170, 3185, 3200, 3213,
+		3226, 3243, 3260, 3267, 3280, 3285, 3318, 3331, 3338, 3373, 3406, 3439, 3472, 3505, 0, 3,
+		8, 10, 23, 26, 31, 38, 44, 47, 53, 62, 67, 72, 77, 82, 85, 89,
+		94, 100, 107, 114, 117, 120, 124, 132, 139, 151, 154, 157, 165, 176, 180, 193,
+		201, 207, 221, 231, 254, 258, 265, 270, 276, 291, 305, 312, 322, 330, 340, 351,
+		359, 370, 384, 394, 405, 413, 421, 430, 442, 451, 460, 470, 478, 488, 496, 500,
+		503, 506, 510, 515, 521, 553, 587, 621, 656, 672, 688, 700, 714, 730, 749, 764,
+		769, 779, 789, 796, 803, 808, 813, 819, 825, 839, 844, 851, 856, 865, 878, 894,
+		907, 919, 929, 947, 966, 969, 973, 976, 981, 987, 1014, 1035, 1058, 1072, 1093, 1108,
+		1130, 1149, 1164, 1173, 1184, 1188, 1207, 1215, 1228, 1243, 1248, 1258, 1280, 1293, 1296, 1301,
+		1324, 1333, 1339, 1347, 1358, 1370, 1377, 1388, 1395, 1399, 1408, 1419, 1423, 1432, 1436, 1443,
+		1460, 1467, 1480, 1492, 1502, 1517, 1530, 1546, 1556, 1567, 1579, 1591, 1608, 1624, 1640, 1671,
+		1690, 1702, 1706, 1721, 1737, 1759, 1766, 1777, 1788, 1801, 1813, 1827, 1842, 1854, 1875, 1884,
+		1901, 1918, 1929, 1951, 1976, 1999, 2023, 2039, 2076, 2080, 2097, 2106, 2114, 2125, 2137, 2140,
+		2151, 2167, 2181, 2195, 2203, 2220, 2230, 2254, 2280, 2289, 2298, 2305, 2318, 2327, 2341, 2357,
+		2370, 2376, 2384, 2414, 2425, 2434, 2444, 2453, 2464, 2469, 2479, 2485, 2489, 2507, 2512, 2523,
+		2540, 2566, 2584, 2595, 2605, 2612, 2625, 2642, 2677, 2709, 2740, 2769, 2799, 2806, 2817, 2826,
+		2838, 2880, 2894, 2910, 2926, 2943, 2949, 2966, 2978, 2988, 2993, 3006, 3020, 3035, 3047, 0,
+		25, 78, 156, 206, 260, 281, 303, 335, 342, 369, 399, 406, 419, 449, 480, 497,
+		517, 520, 524, 533, 537, 566, 574, 585, 597, 628, 658, 710, 742, 770, 798, 808,
+		846, 882, 906, 930, 960, 964, 978, 984, 990, 1002, 1007, 1013, 1023, 1032, 1044, 1076,
+		1104, 1118, 1137, 1156, 1172, 1189, 1209, 1227, 1247, 1265, 1289, 1315, 1345, 1377, 1410, 1443,
+		1463, 1498, 1510, 1549, 1589, 1613, 1630, 1647, 1659, 1668, 1681, 1686, 1692, 1701, 1727, 1742,
+		1763, 1784, 1807, 1829, 1851, 1872, 1895, 1918, 1940, 1961, 1985, 2009, 2030, 2051, 2074, 2096,
+		2118, 2139, 2162, 2185, 2207, 2228, 2252, 2276, 2289, 2322, 2358, 2366, 2391, 2403, 2407, 2413,
+		2430, 2456, 2465, 2469, 2500, 2513, 2528, 2534, 2544, 2552, 2563, 2591, 2619, 2648, 2673, 2698,
+		2723, 2745, 2761, 2777, 2800, 2815, 2840, 2857, 2870, 2887, 2911, 2934, 2955, 2972, 2989, 3008,
+		3040, 3081, 3104, 3129, 3150, 3191, 3244, 3279, 3313, 3348, 3382, 3417, 3451, 3486, 3518, 3552,
+		3584, 3618, 3650, 3684, 3695, 3711, 3729, 3754, 3761, 3778, 3806, 3834, 3856, 3870, 3884, 3900,
+		3916, 3934, 3943, 3964, 3973, 3979, 3991, 3999, 4016, 4034, 4064, 4085, 4103, 4119, 4134, 4146,
+		4152, 4163, 4182, 4195, 4207, 4217, 4232, 4246, 4250, 4259, 4267, 4281, 4291, 4302, 4310, 4314,
+		4319, 4330, 4345, 4364, 4378, 4386, 4394, 4401, 4443, 4457, 4482, 4507, 4518, 4525, 4544, 4566,
+		4577, 4589, 4593, 4620, 4636, 4652, 4667, 4683, 4701, 4720, 4738, 4758, 4777, 4797, 4814, 4832,
+		4843, 4864, 4878, 4900, 4915, 4939, 4959, 4988, 5004, 5030, 5039, 5049, 5060, 5077, 5096, 5101,
+		0, 13, 44, 66, 94, 139, 182, 234, 256, 279, 291, 321, 350, 363, 398, 426,
+		439, 451, 470, 483, 503, 515, 528, 543, 555, 575, 605, 634, 660, 702, 752, 769,
+		781, 791, 803, 814, 830, 855, 885, 914, 928, 940, 950, 961, 971, 985, 1003, 1023,
+		1034, 1069, 1091, 1106, 1118, 1131, 1141, 1159, 1181, 1216, 1242, 1274, 1313, 1336, 1369, 1385,
+		1400, 1414, 1436, 1457, 1473, 1495, 1520, 1540, 1560, 1578, 1597, 1617, 1646, 1686, 1701, 1725,
+		1749, 1773, 1797, 1821, 1845, 1876, 1907, 1938, 1969, 1998, 2027, 2056, 2085, 2120, 2155, 2190,
+		2225, 2248, 2271, 2294, 2317, 2346, 2375, 2404, 2433, 2461, 2505, 2532, 2575, 2591, 2605, 2622,
+		2638, 2665, 2698, 2723, 2754, 2778, 2802, 2862, 2915, 2972, 2998, 3029, 3066, 3084, 3110, 3123,
+		3144, 3150, 3160, 3176, 3187, 3203, 3236, 3241, 3246, 3256, 3263, 3267, 3275, 3278, 3290, 3300,
+		3308, 3315, 3324, 3335, 3345, 3364, 3368, 3381, 3391, 3410, 3430, 3444, 3461, 3468, 3476, 3492,
+		3510, 3527, 3541, 3545, 3552, 3561, 3585, 3607, 3624, 3651, 3674, 3678, 3691, 3705, 3722, 3752,
+		3772, 3792, 3816, 3823, 3834, 3843, 3861, 3871, 3885, 3902, 3913, 3926, 3934, 3945, 3951, 3963,
+		3969, 3973, 3980, 3996, 4003, 4016, 4028, 4057, 4072, 4098, 4109, 4121, 4135, 4157, 4170, 4182,
+		4185, 4201, 4215, 4231, 4236, 4242, 4254, 4257, 4265, 4265, 4265, 4265, 4265, 4273, 4289, 4294,
+		4303, 4320, 4326, 4334, 4342, 4355, 4365, 4394, 4414, 4440, 4454, 4481, 4499, 4516, 4516, 4516,
+		4516, 4525, 4545, 4562, 4576, 4583, 4596, 4601, 4605, 4618, 4628, 4641, 4653, 4665, 0, 34,
+		59, 85, 112, 139, 171, 203, 237, 269, 303, 332, 363, 394, 422, 463, 497, 536,
+		577, 618, 648, 674, 701, 730, 761, 792, 825, 851, 879, 909, 941, 977, 1014, 1046,
+		1083, 1124, 1162, 1202, 1242, 1290, 1339, 1370, 1399, 1429, 1463, 1506, 1544, 1595, 1636, 1677,
+		1720, 1749, 1780, 1811, 1842, 1870, 1900, 1931, 1965, 1994, 2023, 2054, 2087, 2120, 2148, 2178,
+		2210, 2237, 2266, 2295, 2321, 2346, 2374, 2404, 2429, 2454, 2482, 2508, 2535, 2570, 2607, 2637,
+		2666, 2703, 2734, 2760, 2787, 2819, 2846, 2877, 2904, 2940, 2969, 3012, 3057, 3090, 3123, 3152,
+		3182, 3214, 3242, 3276, 3306, 3338, 3370, 3402, 3434, 3463, 3497, 3534, 3562, 3588, 3615, 3646,
+		3673, 3704, 3736, 3750, 3761, 3774, 3774, 3774, 3774, 3774, 3780, 3788, 3796, 3802, 3807, 3851,
+		3893, 3938, 3982, 4009, 4033, 4050, 4068, 4091, 4119, 4161, 4173, 4190, 4217, 4235, 4254, 4271,
+		4289, 4312, 4340, 4387, 4430, 4443, 4461, 4485, 4511, 4560, 4605, 4619, 4637, 4661, 4687, 4731,
+		4747, 4764, 4782, 4798, 4819, 4841, 4868, 4880, 4893, 4907, 4919, 4932, 4950, 4973, 4999, 5026,
+		5051, 5077, 5108, 5144, 5169, 5195, 5219, 5244, 5274, 5309, 5336, 5364, 5390, 5417, 5449, 5479,
+		5510, 5534, 5568, 5597, 5627, 5650, 5683, 5723, 5752, 5781, 5810, 5838, 5863, 5894, 5924, 5959,
+		5992, 6023, 6054, 6088, 6104, 6129, 6143, 6166, 6189, 6189, 6189, 6189, 6189, 6189, 6189, 6208,
+		6227, 6245, 6264, 6282, 6298, 6315, 6334, 6353, 6371, 6390, 6408, 6408, 6408, 6408, 6408, 6425,
+		0, 45, 88, 134, 179, 225, 269, 316, 362, 407, 450, 496, 541, 541, 541, 541,
+		541, 589, 635, 684, 732, 774, 814, 857, 899, 947, 993, 1042, 1090, 1144, 1196, 1251,
+		1305, 1354, 1401, 1451, 1500, 1549, 1596, 1646, 1695, 1742, 1787, 1835, 1882, 1930, 1976, 2025,
+		2073, 2126, 2177, 2231, 2284, 2311, 2336, 2364, 2391, 2414, 2435, 2459, 2482, 2508, 2532, 2559,
+		2585, 2617, 2647, 2680, 2712, 2733, 2752, 2774, 2795, 2795, 2795, 2795, 2795, 2795, 2795, 2795,
+		2795, 2821, 2845, 2872, 2898, 2925, 2952, 2979, 3006, 3033, 3057, 3057, 3057, 3057, 3057, 3057,
+		3057, 3095, 3131, 3170, 3208, 3247, 3286, 3325, 3364, 3396, 3426, 3459, 3491, 3524, 3557, 3590,
+		3623, 3662, 3699, 3739, 3778, 3818, 3858, 3898, 3938, 3976, 4012, 4051, 4089, 4128, 4167, 4206,
+		4245, 4288, 4329, 4373, 4416, 4460, 4504, 4548, 4592, 4592, 4592, 4592, 4592, 4592, 4592, 4592,
+		4592, 4620, 4646, 4675, 4703, 4750, 4795, 4843, 4890, 4923, 4954, 4988, 5021, 5048, 5082, 5114,
+		5147, 5182, 5218, 5250, 5283, 5316, 5351, 5385, 5419, 5461, 5504, 5547, 5591, 5618, 5652, 5652,
+		5652, 5690, 5739, 0, 35, 69, 89, 120, 152, 182, 207, 223, 243, 271, 300, 337,
+		352, 363, 374, 387, 404, 420, 441, 450, 463, 485, 495, 507, 524, 536, 555, 571,
+		588, 597, 639, 659, 679, 689, 703, 732, 745, 755, 764, 777, 805, 833, 879, 916,
+		956, 1010, 1039, 1071, 1085, 1099, 1116, 1122, 1128, 1141, 1157, 1162, 1184, 1192, 1198, 1215,
+		1224, 1234, 1242, 1253, 1266, 1286, 1302, 1315, 1320, 1328, 1333, 1350, 1368, 1385, 1397, 1417,
+		1430, 1453, 1461, 1472, 1481, 1488, 1496, 1501, 1507, 1513, 1527, 1538, 1557, 1574, 1577, 1590,
+		1597, 1606, 1614, 1622, 1636, 1647, 1657, 1672, 1686, 1693, 1701, 1704, 1711, 1722, 1730, 1741,
+		1752, 1757, 1766, 1771, 1818, 1830, 1852, 1886, 1922, 1937, 1950, 1955, 1969, 1992, 2015, 2019,
+		2027, 2034, 2045, 2054, 2058, 2067, 2075, 2081, 2093, 2098, 2102, 2105, 2110, 2113, 2121, 2130,
+		2134, 2141, 2147, 2157, 2163, 2168, 2180, 2190, 2198, 2206, 2211, 2218, 2226, 2231, 2238, 2250,
+		2256, 2263, 2270, 2278, 2285, 2291, 2295, 2302, 2306, 2311, 2316, 2325, 2330, 2338, 2344, 2350,
+		2355, 2359, 2368, 2380, 2404, 2430, 2450, 2476, 2480, 2483, 2487, 2492, 2501, 2513, 2524, 2544,
+		2564, 2581, 2595, 2609, 2621, 2628, 2639, 2651, 2657, 2662, 2669, 2675, 2681, 2691, 2699, 2709,
+		2714, 2729, 2744, 2755, 2772, 2777, 2782, 2793, 2807, 2828, 2849, 2864, 2888, 2892, 2897, 2904,
+		2913, 2916, 2921, 2927, 2932, 2944, 2954, 2959, 2965, 2969, 2974, 2991, 3002, 3021, 3030, 3040,
+		3056, 3063, 3069, 3086, 3093, 3098, 3104, 3111, 3124, 3139, 3151, 3161, 3171, 3176, 3182, 3195,
+		3206, 3212, 3219, 3231, 0, 18, 37, 55, 75, 95, 113, 158, 203, 250, 289, 329,
+		368, 409, 450, 489, 528, 568, 607, 648, 689, 728, 769, 811, 852, 895, 938, 979,
+		1037, 1095, 1155, 1178, 1202, 1225, 1250, 1275, 1298, 1321, 1345, 1368, 1393, 1418, 1441, 1466,
+		1492, 1517, 1544, 1571, 1596, 1654, 1712, 1772, 1824, 1877, 1929, 1983, 2037, 2089, 2141, 2194,
+		2246, 2300, 2354, 2406, 2460, 2515, 2569, 2625, 2681, 2735, 2791, 2847, 2905, 2927, 2949, 2973,
+		3018, 3063, 3110, 3134, 3157, 3182, 3206, 3229, 3254, 3254, 3254, 3254, 3254, 3254, 3254, 3254,
+		3254, 3254, 3254, 3254, 3254, 3273, 3293, 3313, 3330, 3349, 3367, 3386, 3407, 3429, 3451, 3470,
+		3491, 3511, 3532, 3532, 3532, 3544, 3562, 3568, 3574, 3586, 3602, 3612, 3622, 3635, 3651, 3662,
+		3667, 3673, 3673, 3673, 3673, 3678, 3682, 3691, 3700, 3710, 3716, 3729, 3736, 3741, 3741, 3741,
+		3741, 3741, 3741, 3741, 3741, 3754, 3759, 3764, 3767, 3776, 3781, 3796, 3805, 3814, 3818, 3831,
+		3842, 3848, 3852, 3858, 3864, 3871, 3884, 3888, 3894, 3904, 3914, 3923, 3930, 3934, 3945, 3964,
+		3975, 3980, 3996, 4005, 4011, 4014, 4018, 4024, 4033, 4045, 4049, 4056, 4061, 4066, 4076, 4090,
+		4098, 4107, 4111, 4111, 4116, 4132, 4137, 4151, 4163, 4178, 4195, 4195, 4195, 4195, 4195, 4195,
+		4195, 4195, 4195, 4200, 4206, 4217, 4228, 4233, 4242, 4248, 4254, 4260, 4274, 4279, 4282, 4293,
+		4300, 4300, 4300, 4300, 4300, 4312, 4325, 4364, 4385, 4409, 4425, 4435, 4442, 4454, 4454, 4454,
+		4454, 4454, 4454, 4454, 4454, 4494, 4509, 4523, 4537, 4549, 4577, 4588, 4610, 4633, 0, 15,
+		30, 46, 61, 77, 93, 110, 125, 141, 157, 174, 190, 207, 224, 224, 239, 255,
+		271, 288, 304, 321, 321, 337, 354, 371, 371, 388, 388, 388, 388, 403, 419, 435,
+		452, 468, 485, 502, 502, 518, 535, 535, 552, 552, 552, 552, 568, 585, 602, 602,
+		619, 619, 619, 619, 636, 636, 636, 636, 636, 636, 636, 695, 753, 812, 870, 922,
+		982, 1041, 1101, 1160, 1213, 1279, 1340, 1399, 1460, 1519, 1573, 1633, 1691, 1751, 1809, 1862,
+		1927, 1987, 2046, 2106, 2165, 2218, 2277, 2335, 2394, 2452, 2504, 2569, 2629, 2687, 2747, 2805,
+		2858, 2919, 2978, 3039, 3098, 3152, 3218, 3275, 3331, 3387, 3443, 3476, 3510, 3544, 3578, 3605,
+		3632, 3659, 3686, 3713, 3740, 3769, 3798, 3827, 3856, 3885, 3914, 3945, 3976, 4008, 4040, 4072,
+		4072, 4095, 4120, 4144, 4170, 4195, 4218, 4243, 4267, 4293, 4318, 4340, 4363, 4386, 4409, 4429,
+		4481, 4533, 4533, 4584, 4602, 4628, 4649, 4679, 4709, 4746, 4782, 4816, 4851, 4886, 4920, 4975,
+		5031, 5086, 5142, 5213, 5285, 5356, 5427, 5515, 5603, 5690, 5777, 5864, 5951, 5986, 6036, 6060,
+		6078, 6099, 6121, 6163, 6215, 6268, 6310, 6350, 6366, 6383, 6401, 6423, 6446, 6492, 6517, 6557,
+		6594, 6633, 6671, 6701, 6713, 6742, 6767, 6793, 6816, 6841,
+	},
+	data: "modifier letter extra-high tone barmodifier letter high tone barmodifier letter mid tone barmodifier letter low tone barmodifier letter extra-low tone barmodifier letter yin departing tone markmodifier letter yang departing tone markmodifier letter low down arrowhead" + // U+02E0
+		"modifier letter low up arrowheadmodifier letter low left arrowheadmodifier letter low right arrowheadmodifier letter low ringmodifier letter middle grave accentmodifier letter middle double grave accentmodifier letter middle double acute accentmodifier letter low tildemodifier letter raised colonmodifier letter begin high tonemodifier letter end high tonemodifier letter begin low tonemodifier letter end low tonemodifier letter shelfmodifier letter open shelfmodifier letter low left arrow" + // U+02F0
+		"greek ypogegrammeni" + // U+0370
+		"greek tonosgreek dialytika tonos" + // U+0380
+		"greek reversed lunate epsilon symbol" + // U+03F0
+		"right-facing armenian eternity signleft-facing armenian eternity signarmenian dram sign" + // U+0580
+		"hebrew punctuation maqaf" + // U+05B0
+		"hebrew punctuation nun hafukha" + // U+05C0
+		"hebrew letter alef" + // U+05D0
+		"hebrew yod triangle" + // U+05E0
+		"arabic-indic cube rootarabic-indic fourth rootarabic rayarabic-indic per mille signarabic-indic per ten thousand signafghani signarabic date separatorarabic poetic verse signarabic sign misra" + // U+0600
+		"arabic end of text markarabic triple dot punctuation mark" + // U+0610
+		"arabic letter hamzaarabic letter alef with hamza belowarabic letter alef" + // U+0620
+		"arabic tatweel" + // U+0640
+		"arabic letter high hamza" + // U+0670
+		"arabic letter earabic letter yeh with three dots below" + // U+06D0
+		"arabic small wawarabic small yeh" + // U+06E0
+		"nko high tone apostrophenko low tone apostrophenko symbol oo dennennko symbol gbakurunennko commanko exclamation marknko lajanyalannko dorome signnko taman sign" + // U+07F0
+		"samaritan punctuation nequdaasamaritan punctuation afsaaqsamaritan punctuation angedsamaritan punctuation bausamaritan punctuation atmaausamaritan punctuation shiyyaalaasamaritan abbreviation marksamaritan punctuation melodic qitsasamaritan punctuation ziqaasamaritan punctuation qitsasamaritan punctuation zaefsamaritan punctuation turusamaritan punctuation arkaanusamaritan punctuation sof mashfaatsamaritan punctuation annaau" + // U+0830
+		"mandaic punctuation" + // U+0850
+		"arabic tatweel with overstruck hamzaarabic tatweel with overstruck wawarabic tatweel with two dots belowarabic baseline round dotarabic raised round dotarabic vertical tail" + // U+0880
+		"arabic small farsi yeh" + // U+08C0
+		"bengali anji" + // U+0980
+		"bengali issharbengali ganda markbengali abbreviation sign" + // U+09F0
+		"gurmukhi irigurmukhi uragurmukhi abbreviation sign" + // U+0A70
+		"gujarati abbreviation signgujarati rupee sign" + // U+0AF0
+		"oriya letter va" + // U+0B30
+		"oriya isshar" + // U+0B70
+		"tamil om" + // U+0BD0
+		"tamil day signtamil month signtamil year signtamil debit signtamil credit signtamil as above signtamil rupee signtamil number sign" + // U+0BF0
+		"telugu sign siddhamtelugu sign tuumu" + // U+0C70
+		"kannada sign siddham" + // U+0C80
+		"malayalam sign para" + // U+0D40
+		"malayalam date mark" + // U+0D70
+		"lao letter ho tam" + // U+0EA0
+		"tibetan mark gter yig mgo truncated atibetan mark gter yig mgo -um rnam bcad matibetan mark gter yig mgo -um gter tsheg matibetan mark initial yig mgo mdun matibetan mark closing yig mgo sgab matibetan mark caret yig mgo phur shad matibetan mark yig mgo tsheg shad matibetan mark bka- shog yig mgo" + // U+0F00
+		"tibetan logotype sign chad rtagstibetan logotype sign lhag rtagstibetan astrological sign sgra gcan -char rtagstibetan sign rdel dkar gcigtibetan sign rdel dkar gnyistibetan sign rdel dkar gsumtibetan sign rdel nag gcigtibetan sign rdel nag gnyistibetan sign rdel dkar rdel nag" + // U+0F10
+		"tibetan mark che mgotibetan mark gug rtags gyon" + // U+0F30
+		"tibetan mark palutatibetan sign lce tsa cantibetan sign mchu cantibetan sign gru can rgyingstibetan sign gru med rgyings" + // U+0F80
+		"tibetan symbol dril butibetan symbol rdo rjetibetan symbol rdo rje rgya gramtibetan symbol phur patibetan symbol nor butibetan symbol nor bu nyis -khyiltibetan symbol nor bu gsum -khyiltibetan symbol nor bu bzhi -khyiltibetan sign rdel nag rdel dkartibetan sign rdel nag gsum" + // U+0FC0
+		"tibetan mark bska- shog gi mgo rgyantibetan mark mnyam yig gi mgo rgyantibetan mark nyis tshegtibetan mark initial brda rnying yig mgo mdun matibetan mark closing brda rnying yig mgo sgab maright-facing svasti signleft-facing svasti signright-facing svasti sign with dotsleft-facing svasti sign with dotstibetan mark leading mchan rtagstibetan mark trailing mchan rtags" + // U+0FD0
+		"myanmar symbol shan onemyanmar symbol shan exclamation" + // U+1090
+		"hangul choseong ieung" + // U+1100
+		"hangul choseong pieup-tikeut" + // U+1120
+		"hangul choseong chitueumsioshangul choseong chitueumssangsioshangul choseong ceongchieumsioshangul choseong ceongchieumssangsios" + // U+1130
+		"hangul choseong ssangieunghangul choseong chitueumcieuchangul choseong chitueumssangcieuc" + // U+1140
+		"hangul choseong ceongchieumcieuchangul choseong ceongchieumssangcieuchangul choseong chitueumchieuchhangul choseong ceongchieumchieuchhangul choseong filler" + // U+1150
+		"hangul jungseong filler" + // U+1160
+		"hangul jongseong ssangieung" + // U+11E0
+		"ethiopic section mark" + // U+1360
+		"ethiopic tonal mark yizetethiopic tonal mark deretethiopic tonal mark rikrikethiopic tonal mark short rikrikethiopic tonal mark difatethiopic tonal mark kenatethiopic tonal mark chiretethiopic tonal mark hidetethiopic tonal mark deret-hidetethiopic tonal mark kurt" + // U+1390
+		"canadian syllabics hyphen" + // U+1400
+		"philippine single punctuationphilippine double punctuation" + // U+1730
+		"mongolian todo soft hyphenmongolian nirugu" + // U+1800
+		"limbu sign loolimbu exclamation marklimbu question mark" + // U+1940
+		"new tai lue tone mark-1new tai lue tone mark-2" + // U+19C0
+		"new tai lue sign laenew tai lue sign laev" + // U+19D0
+		"khmer symbol pathamasatkhmer symbol muoy koetkhmer symbol pii koetkhmer symbol bei koetkhmer symbol buon koetkhmer symbol pram koetkhmer symbol pram-muoy koetkhmer symbol pram-pii koetkhmer symbol pram-bei koetkhmer symbol pram-buon koetkhmer symbol dap koetkhmer symbol dap-muoy koetkhmer symbol dap-pii koetkhmer symbol dap-bei koetkhmer symbol dap-buon koetkhmer symbol dap-pram koet" + // U+19E0
+		"khmer symbol tuteyasatkhmer symbol muoy rockhmer symbol pii rockhmer symbol bei rockhmer symbol buon rockhmer symbol pram rockhmer symbol pram-muoy rockhmer symbol pram-pii rockhmer symbol pram-bei rockhmer symbol pram-buon rockhmer symbol dap rockhmer symbol dap-muoy rockhmer symbol dap-pii rockhmer symbol dap-bei rockhmer symbol dap-buon rockhmer symbol dap-pram roc" + // U+19F0
+		"buginese pallawabuginese end of section" + // U+1A10
+		"tai tham sign wiangtai tham sign wiangwaaktai tham sign sawantai tham sign keowtai tham sign hoytai tham sign dokmaitai tham sign reversed rotated ranatai tham sign kaantai tham sign kaankuutai tham sign satkaantai tham sign satkaankuutai tham sign hangtai tham sign caang" + // U+1AA0
+		"balinese pantibalinese pamadabalinese windubalinese carik pamungkahbalinese carik sikibalinese carik pareren" + // U+1B50
+		"balinese pamenengbalinese musical symbol dongbalinese musical symbol dengbalinese musical symbol dungbalinese musical symbol dangbalinese musical symbol dang surangbalinese musical symbol dingbalinese musical symbol daengbalinese musical symbol deungbalinese musical symbol daingbalinese musical symbol dang gede" + // U+1B60
+		"balinese musical symbol right-hand open dugbalinese musical symbol right-hand open dagbalinese musical symbol right-hand closed tukbalinese musical symbol right-hand closed takbalinese musical symbol left-hand open pangbalinese musical symbol left-hand open pungbalinese musical symbol left-hand closed plakbalinese musical symbol left-hand closed plukbalinese musical symbol left-hand open pingbalinese panti lantangbalinese pamada lantang" + // U+1B70
+		"sundanese avagraha" + // U+1BB0
+		"batak symbol bindu na metekbatak symbol bindu pinarborasbatak symbol bindu judulbatak symbol bindu pangolat" + // U+1BF0
+		"lepcha punctuation ta-rollepcha punctuation nyet thyoom ta-rollepcha punctuation cer-walepcha punctuation tshook cer-walepcha punctuation tshook" + // U+1C30
+		"ol chiki mu ttuddagol chiki gaahlaa ttuddaagol chiki mu-gaahlaa ttuddaagol chiki relaaol chiki phaarkaaol chiki ahadol chiki punctuation mucaadol chiki punctuation double mucaad" + // U+1C70
+		"sundanese punctuation bindu suryasundanese punctuation bindu panglongsundanese punctuation bindu purnamasundanese punctuation bindu cakrasundanese punctuation bindu leu satangasundanese punctuation bindu ka satangasundanese punctuation bindu da satangasundanese punctuation bindu ba satanga" + // U+1CC0
+		"vedic sign nihshvasa" + // U+1CD0
+		"latin letter small capital alatin letter small capital aelatin small letter turned aelatin letter small capital barred blatin letter small capital clatin letter small capital dlatin letter small capital ethlatin letter small capital elatin small letter turned open elatin small letter turned ilatin letter small capital jlatin letter small capital klatin letter small capital l with strokelatin letter small capital mlatin letter small capital reversed nlatin letter small capital o" + // U+1D00
+		"latin letter small capital open olatin small letter sideways olatin small letter sideways open olatin small letter sideways o with strokelatin small letter turned oelatin letter small capital oulatin small letter top half olatin small letter bottom half olatin letter small capital platin letter small capital reversed rlatin letter small capital turned rlatin letter small capital tlatin letter small capital ulatin small letter sideways ulatin small letter sideways diaeresized ulatin small letter sideways turned m" + // U+1D10
+		"latin letter small capital vlatin letter small capital wlatin letter small capital zlatin letter small capital ezhlatin letter voiced laryngeal spirantlatin letter aingreek letter small capital gammagreek letter small capital lamdagreek letter small capital pigreek letter small capital rhogreek letter small capital psicyrillic letter small capital elmodifier letter capital amodifier letter capital aemodifier letter capital bmodifier letter capital barred b" + // U+1D20
+		"modifier letter capital dmodifier letter capital emodifier letter capital reversed emodifier letter capital gmodifier letter capital hmodifier letter capital imodifier letter capital jmodifier letter capital kmodifier letter capital lmodifier letter capital mmodifier letter capital nmodifier letter capital reversed nmodifier letter capital omodifier letter capital oumodifier letter capital pmodifier letter capital r" + // U+1D30
+		"modifier letter capital tmodifier letter capital umodifier letter capital wmodifier letter small amodifier letter small turned amodifier letter small alphamodifier letter small turned aemodifier letter small bmodifier letter small dmodifier letter small emodifier letter small schwamodifier letter small open emodifier letter small turned open emodifier letter small gmodifier letter small turned imodifier letter small k" + // U+1D40
+		"modifier letter small mmodifier letter small engmodifier letter small omodifier letter small open omodifier letter small top half omodifier letter small bottom half omodifier letter small pmodifier letter small tmodifier letter small umodifier letter small sideways umodifier letter small turned mmodifier letter small vmodifier letter small ainmodifier letter small betamodifier letter small greek gammamodifier letter small delta" + // U+1D50
+		"modifier letter small greek phimodifier letter small chilatin subscript small letter ilatin subscript small letter rlatin subscript small letter ulatin subscript small letter vgreek subscript small letter betagreek subscript small letter gammagreek subscript small letter rhogreek subscript small letter phigreek subscript small letter chilatin small letter ue" + // U+1D60
+		"modifier letter cyrillic enlatin small letter insular glatin small letter th with strikethroughlatin small capital letter i with strokelatin small letter iota with strokelatin small capital letter u with strokelatin small letter upsilon with stroke" + // U+1D70
+		"latin small letter esh with palatal hooklatin small letter a with retroflex hook" + // U+1D80
+		"latin small letter alpha with retroflex hooklatin small letter d with hook and taillatin small letter e with retroflex hooklatin small letter open e with retroflex hooklatin small letter reversed open e with retroflex hooklatin small letter schwa with retroflex hooklatin small letter i with retroflex hooklatin small letter open o with retroflex hooklatin small letter esh with retroflex hooklatin small letter u with retroflex hooklatin small letter ezh with retroflex hookmodifier letter small turned alphamodifier letter small cmodifier letter small c with curlmodifier letter small ethmodifier letter small reversed open e" + // U+1D90
+		"modifier letter small fmodifier letter small dotless j with strokemodifier letter small script gmodifier letter small turned hmodifier letter small i with strokemodifier letter small iotamodifier letter small capital imodifier letter small capital i with strokemodifier letter small j with crossed-tailmodifier letter small l with retroflex hookmodifier letter small l with palatal hookmodifier letter small capital lmodifier letter small m with hookmodifier letter small turned m with long legmodifier letter small n with left hookmodifier letter small n with retroflex hook" + // U+1DA0
+		"modifier letter small capital nmodifier letter small barred omodifier letter small phimodifier letter small s with hookmodifier letter small eshmodifier letter small t with palatal hookmodifier letter small u barmodifier letter small upsilonmodifier letter small capital umodifier letter small v with hookmodifier letter small turned vmodifier letter small zmodifier letter small z with retroflex hookmodifier letter small z with curlmodifier letter small ezhmodifier letter small theta" + // U+1DB0
+		"reversed semicolon" + // U+2040
+		"close uptwo asterisks aligned verticallyinverted undertieflower punctuation markthree dot punctuationquadruple primefour dot punctuationfive dot punctuationtwo dot punctuationfour dot markdotted crosstricolonvertical four dots" + // U+2050
+		"superscript latin small letter i" + // U+2070
+		"german penny signpeso signguarani signaustral signhryvnia signcedi signlivre tournois signspesmilo signtenge signindian rupee signturkish lira signnordic mark signmanat signruble signlari signbitcoin sign" + // U+20B0
+		"som sign" + // U+20C0
+		"account ofaddressed to the subjectdegree celsiuscentre line symbolcare ofcada unaeuler constantscrupledegree fahrenheitscript small gscript capital hblack-letter capital hplanck constantplanck constant over two pi" + // U+2100
+		"script capital iblack-letter capital iscript capital lscript small ll b bar symbolnumero signsound recording copyrightscript capital pscript capital rblack-letter capital rprescription takeresponse" + // U+2110
+		"versicleounce signohm signinverted ohm signblack-letter capital zturned greek small letter iotascript capital bblack-letter capital c" + // U+2120
+		"script small oalef symbolbet symbolgimel symboldalet symbolinformation sourcerotated capital qdouble-struck small pidouble-struck small gammadouble-struck capital gammadouble-struck capital pi" + // U+2130
+		"double-struck n-ary summationturned sans-serif capital gturned sans-serif capital lreversed sans-serif capital lturned sans-serif capital yproperty lineturned ampersandper signaktieselskabsymbol for samaritan source" + // U+2140
+		"turned digit twoturned digit three" + // U+2180
+		"right arrow with small circledownwards arrow leftwards of upwards arrowthree rightwards arrowsleftwards arrow with vertical strokerightwards arrow with vertical strokeleft right arrow with vertical strokeleftwards arrow with double vertical strokerightwards arrow with double vertical strokeleft right arrow with double vertical strokeleftwards open-headed arrowrightwards open-headed arrowleft right open-headed arrow" + // U+21F0
+		"for allcomplementpartial differentialthere existsthere does not existempty setincrementnablaelement ofnot an element ofsmall element ofcontains as memberdoes not contain as membersmall contains as memberend of proofn-ary product" + // U+2200
+		"n-ary coproductn-ary summationminus-or-plus signdot plusring operatorbullet operatorsquare rootcube rootfourth rootproportional toinfinityright angle" + // U+2210
+		"anglemeasured anglespherical angledoes not divideparallel tonot parallel tological andlogical orintersectionunionintegraldouble integraltriple integralcontour integralsurface integral" + // U+2220
+		"volume integralclockwise integralclockwise contour integralanticlockwise contour integralthereforebecauseproportiondot minusexcessgeometric proportionhomotheticreversed tildeinverted lazy ssine wave" + // U+2230
+		"wreath productnot tildeminus tildeasymptotically equal tonot asymptotically equal toapproximately equal toapproximately but not actually equal toneither approximately nor actually equal toalmost equal tonot almost equal toalmost equal or equal totriple tildeall equal toequivalent togeometrically equivalent todifference between" + // U+2240
+		"approaches the limitgeometrically equal toapproximately equal to or the image ofimage of or approximately equal tocolon equalsequals colonring in equal toring equal tocorresponds toestimatesequiangular tostar equalsdelta equal toequal to by definitionmeasured byquestioned equal to" + // U+2250
+		"not equal toidentical tonot identical tostrictly equivalent toless-than but not equal togreater-than but not equal tomuch less-thanmuch greater-thanbetweennot equivalent tonot less-thannot greater-than" + // U+2260
+		"neither less-than nor equal toneither greater-than nor equal toless-than or equivalent togreater-than or equivalent toneither less-than nor equivalent toneither greater-than nor equivalent toless-than or greater-thangreater-than or less-thanneither less-than nor greater-thanneither greater-than nor less-thanprecedessucceedsprecedes or equal tosucceeds or equal toprecedes or equivalent tosucceeds or equivalent to" + // U+2270
+		"does not precededoes not succeedsubset ofsuperset ofnot a subset ofnot a superset ofsubset of or equal tosuperset of or equal toneither a subset of nor equal toneither a superset of nor equal tosubset of with not equal tosuperset of with not equal tomultisetmultiset multiplicationmultiset unionsquare image of" + // U+2280
+		"square original ofsquare image of or equal tosquare original of or equal tosquare capsquare cupcircled pluscircled minuscircled timescircled division slashcircled dot operatorcircled ring operatorcircled asterisk operatorcircled equalscircled dashsquared plussquared minus" + // U+2290
+		"squared timessquared dot operatorright tackleft tackdown tackup tackassertionmodelstrueforcestriple vertical bar right turnstiledouble vertical bar double right turnstiledoes not provenot truedoes not forcenegated double vertical bar double right turnstile" + // U+22A0
+		"precedes under relationsucceeds under relationnormal subgroup ofcontains as normal subgroupnormal subgroup of or equal tocontains as normal subgroup or equal tooriginal ofimage ofmultimaphermitian conjugate matrixintercalatexornandnorright angle with arcright triangle" + // U+22B0
+		"n-ary logical andn-ary logical orn-ary intersectionn-ary uniondiamond operatordot operatorstar operatordivision timesbowtieleft normal factor semidirect productright normal factor semidirect productleft semidirect productright semidirect productreversed tilde equalscurly logical orcurly logical and" + // U+22C0
+		"double subsetdouble supersetdouble intersectiondouble unionpitchforkequal and parallel toless-than with dotgreater-than with dotvery much less-thanvery much greater-thanless-than equal to or greater-thangreater-than equal to or less-thanequal to or less-thanequal to or greater-thanequal to or precedesequal to or succeeds" + // U+22D0
+		"does not precede or equaldoes not succeed or equalnot square image of or equal tonot square original of or equal tosquare image of or not equal tosquare original of or not equal toless-than but not equivalent togreater-than but not equivalent toprecedes but not equivalent tosucceeds but not equivalent tonot normal subgroup ofdoes not contain as normal subgroupnot normal subgroup of or equal todoes not contain as normal subgroup or equalvertical ellipsismidline horizontal ellipsis" + // U+22E0
+		"up right diagonal ellipsisdown right diagonal ellipsiselement of with long horizontal strokeelement of with vertical bar at end of horizontal strokesmall element of with vertical bar at end of horizontal strokeelement of with dot aboveelement of with overbarsmall element of with overbarelement of with underbarelement of with two horizontal strokescontains with long horizontal strokecontains with vertical bar at end of horizontal strokesmall contains with vertical bar at end of horizontal strokecontains with overbarsmall contains with overbarz notation bag membership" + // U+22F0
+		"diameter signelectric arrowhousedown arrowheadprojectiveperspectivewavy lineleft ceilingright ceilingleft floorright floorbottom right cropbottom left croptop right croptop left crop" + // U+2300
+		"reversed not signsquare lozengearcsegmentsectortelephone recorderposition indicatorviewdata squareplace of interest signturned not signwatchhourglasstop left cornertop right cornerbottom left cornerbottom right corner" + // U+2310
+		"top half integralbottom half integralfrownsmileup arrowhead between two horizontal barsoption keyerase to the rightx in a rectangle boxkeyboarderase to the leftbenzene ringcylindricityall around-profilesymmetry" + // U+2320
+		"total runoutdimension originconical taperslopecounterborecountersinkapl functional symbol i-beamapl functional symbol squish quadapl functional symbol quad equalapl functional symbol quad divideapl functional symbol quad diamondapl functional symbol quad jotapl functional symbol quad circleapl functional symbol circle stileapl functional symbol circle jotapl functional symbol slash bar" + // U+2330
+		"apl functional symbol backslash barapl functional symbol quad slashapl functional symbol quad backslashapl functional symbol quad less-thanapl functional symbol quad greater-thanapl functional symbol leftwards vaneapl functional symbol rightwards vaneapl functional symbol quad leftwards arrowapl functional symbol quad rightwards arrowapl functional symbol circle backslashapl functional symbol down tack underbarapl functional symbol delta stileapl functional symbol quad down caretapl functional symbol quad deltaapl functional symbol down tack jotapl functional symbol upwards vane" + // U+2340
+		"apl functional symbol quad upwards arrowapl functional symbol up tack overbarapl functional symbol del stileapl functional symbol quad up caretapl functional symbol quad delapl functional symbol up tack jotapl functional symbol downwards vaneapl functional symbol quad downwards arrowapl functional symbol quote underbarapl functional symbol delta underbarapl functional symbol diamond underbarapl functional symbol jot underbarapl functional symbol circle underbarapl functional symbol up shoe jotapl functional symbol quote quadapl functional symbol circle star" + // U+2350
+		"apl functional symbol quad colonapl functional symbol up tack diaeresisapl functional symbol del diaeresisapl functional symbol star diaeresisapl functional symbol jot diaeresisapl functional symbol circle diaeresisapl functional symbol down shoe stileapl functional symbol left shoe stileapl functional symbol tilde diaeresisapl functional symbol greater-than diaeresisapl functional symbol comma barapl functional symbol del tildeapl functional symbol zildeapl functional symbol stile tildeapl functional symbol semicolon underbarapl functional symbol quad not equal" + // U+2360
+		"apl functional symbol quad questionapl functional symbol down caret tildeapl functional symbol up caret tildeapl functional symbol iotaapl functional symbol rhoapl functional symbol omegaapl functional symbol alpha underbarapl functional symbol epsilon underbarapl functional symbol iota underbarapl functional symbol omega underbarapl functional symbol alphanot check markright angle with downwards zigzag arrowshouldered open boxbell symbolvertical line with middle dot" + // U+2370
+		"insertion symbolcontinuous underline symboldiscontinuous underline symbolemphasis symbolcomposition symbolwhite square with centre vertical lineenter symbolalternative key symbolhelm symbolcircled horizontal bar with notchcircled triangle downbroken circle with northwest arrowundo symbolmonostable symbolhysteresis symbolopen-circuit-output h-type symbol" + // U+2380
+		"open-circuit-output l-type symbolpassive-pull-down-output symbolpassive-pull-up-output symboldirect current symbol form twosoftware-function symbolapl functional symbol quaddecimal separator key symbolprevious pagenext pageprint screen symbolclear screen symbolleft parenthesis upper hookleft parenthesis extensionleft parenthesis lower hookright parenthesis upper hookright parenthesis extension" + // U+2390
+		"right parenthesis lower hookleft square bracket upper cornerleft square bracket extensionleft square bracket lower cornerright square bracket upper cornerright square bracket extensionright square bracket lower cornerleft curly bracket upper hookleft curly bracket middle pieceleft curly bracket lower hookcurly bracket extensionright curly bracket upper hookright curly bracket middle pieceright curly bracket lower hookintegral extensionhorizontal line extension" + // U+23A0
+		"upper left or lower right curly bracket sectionupper right or lower left curly bracket sectionsummation topsummation bottomtop square bracketbottom square bracketbottom square bracket over top square bracketradical symbol bottomleft vertical box lineright vertical box linehorizontal scan line-1horizontal scan line-3horizontal scan line-7horizontal scan line-9dentistry symbol light vertical and top rightdentistry symbol light vertical and bottom right" + // U+23B0
+		"dentistry symbol light vertical with circledentistry symbol light down and horizontal with circledentistry symbol light up and horizontal with circledentistry symbol light vertical with triangledentistry symbol light down and horizontal with triangledentistry symbol light up and horizontal with triangledentistry symbol light vertical and wavedentistry symbol light down and horizontal with wavedentistry symbol light up and horizontal with wavedentistry symbol light down and horizontaldentistry symbol light up and horizontaldentistry symbol light vertical and top leftdentistry symbol light vertical and bottom leftsquare footreturn symboleject symbol" + // U+23C0
+		"vertical line extensionmetrical brevemetrical long over shortmetrical short over longmetrical long over two shortsmetrical two shorts over longmetrical two shorts joinedmetrical trisememetrical tetrasememetrical pentasemeearth groundfusetop parenthesisbottom parenthesistop curly bracketbottom curly bracket" + // U+23D0
+		"top tortoise shell bracketbottom tortoise shell bracketwhite trapeziumbenzene ring with circlestraightnessflatnessac currentelectrical intersectiondecimal exponent symbolblack right-pointing double triangleblack left-pointing double triangleblack up-pointing double triangleblack down-pointing double triangleblack right-pointing double triangle with vertical barblack left-pointing double triangle with vertical barblack right-pointing triangle with double vertical bar" + // U+23E0
+		"alarm clockstopwatchtimer clockhourglass with flowing sandblack medium left-pointing triangleblack medium right-pointing triangleblack medium up-pointing triangleblack medium down-pointing triangledouble vertical barblack square for stopblack circle for recordpower symbolpower on-off symbolpower on symbolpower sleep symbolobserver eye symbol" + // U+23F0
+		"symbol for nullsymbol for start of headingsymbol for start of textsymbol for end of textsymbol for end of transmissionsymbol for enquirysymbol for acknowledgesymbol for bellsymbol for backspacesymbol for horizontal tabulationsymbol for line feedsymbol for vertical tabulationsymbol for form feedsymbol for carriage returnsymbol for shift outsymbol for shift in" + // U+2400
+		"symbol for data link escapesymbol for device control onesymbol for device control twosymbol for device control threesymbol for device control foursymbol for negative acknowledgesymbol for synchronous idlesymbol for end of transmission blocksymbol for cancelsymbol for end of mediumsymbol for substitutesymbol for escapesymbol for file separatorsymbol for group separatorsymbol for record separatorsymbol for unit separator" + // U+2410
+		"symbol for spacesymbol for deleteblank symbolopen boxsymbol for newlinesymbol for delete form twosymbol for substitute form two" + // U+2420
+		"ocr hookocr chairocr forkocr inverted forkocr belt buckleocr bow tieocr branch bank identificationocr amount of checkocr dashocr customer account numberocr double backslash" + // U+2440
+		"quadrant lower leftquadrant lower rightquadrant upper leftquadrant upper left and lower left and lower rightquadrant upper left and lower rightquadrant upper left and upper right and lower leftquadrant upper left and upper right and lower rightquadrant upper rightquadrant upper right and lower leftquadrant upper right and lower left and lower right" + // U+2590
+		"upper left triangleupper right trianglelower left trianglewhite medium squareblack medium squarewhite medium small squareblack medium small squarelower right triangle" + // U+25F0
+		"black sun with rayscloudumbrellasnowmancometblack starwhite starlightningthunderstormsunascending nodedescending nodeconjunctionoppositionblack telephonewhite telephone" + // U+2600
+		"ballot boxballot box with checkballot box with xsaltireumbrella with rain dropshot beveragewhite shogi pieceblack shogi pieceshamrockreversed rotated floral heart bulletblack left pointing indexblack right pointing indexwhite left pointing indexwhite up pointing indexwhite right pointing indexwhite down pointing index" + // U+2610
+		"skull and crossbonescaution signradioactive signbiohazard signcaduceusankhorthodox crosschi rhocross of lorrainecross of jerusalemstar and crescentfarsi symboladi shaktihammer and sicklepeace symbolyin yang" + // U+2620
+		"trigram for heaventrigram for laketrigram for firetrigram for thundertrigram for windtrigram for watertrigram for mountaintrigram for earthwheel of dharmawhite frowning facewhite smiling faceblack smiling facewhite sun with raysfirst quarter moonlast quarter moonmercury" + // U+2630
+		"female signearthmale signjupitersaturnuranusneptuneplutoariestaurusgeminicancerleovirgolibrascorpius" + // U+2640
+		"sagittariuscapricornaquariuspisceswhite chess kingwhite chess queenwhite chess rookwhite chess bishopwhite chess knightwhite chess pawnblack chess kingblack chess queenblack chess rookblack chess bishopblack chess knightblack chess pawn" + // U+2650
+		"black spade suitwhite heart suitwhite diamond suitblack club suitwhite spade suitblack heart suitblack diamond suitwhite club suithot springsquarter noteeighth notebeamed eighth notesbeamed sixteenth notesmusic flat signmusic natural sign" + // U+2660
+		"west syriac crosseast syriac crossuniversal recycling symbolrecycling symbol for type-1 plasticsrecycling symbol for type-2 plasticsrecycling symbol for type-3 plasticsrecycling symbol for type-4 plasticsrecycling symbol for type-5 plasticsrecycling symbol for type-6 plasticsrecycling symbol for type-7 plasticsrecycling symbol for generic materialsblack universal recycling symbolrecycled paper symbolpartially-recycled paper symbolpermanent paper signwheelchair symbol" + // U+2670
+		"die face-1die face-2die face-3die face-4die face-5die face-6white circle with dot rightwhite circle with two dotsblack circle with white dot rightblack circle with two white dotsmonogram for yangmonogram for yindigram for greater yangdigram for lesser yindigram for lesser yangdigram for greater yin" + // U+2680
+		"white flagblack flaghammer and pickanchorcrossed swordsstaff of aesculapiusscalesalembicflowergearstaff of hermesatom symbolfleur-de-lisoutlined white starthree lines converging rightthree lines converging left" + // U+2690
+		"warning signhigh voltage signdoubled female signdoubled male signinterlocked female and male signmale and female signmale with stroke signmale with stroke and male and female signvertical male with stroke signhorizontal male with stroke signmedium white circlemedium black circlemedium small white circlemarriage symboldivorce symbolunmarried partnership symbol" + // U+26A0
+		"coffinfuneral urnneutercerespallasjunovestachironblack moon lilithsextilesemisextilequincunxsesquiquadratesoccer ballbaseballsquared key" + // U+26B0
+		"white draughts manwhite draughts kingblack draughts manblack draughts kingsnowman without snowsun behind cloudrainblack snowmanthunder cloud and rainturned white shogi pieceturned black shogi piecewhite diamond in squarecrossing lanesdisabled carophiuchuspick" + // U+26C0
+		"car slidinghelmet with white crosscircled crossing laneschainsno entryalternate one-way left way trafficblack two-way left way trafficwhite two-way left way trafficblack left lane mergewhite left lane mergedrive slow signheavy white down-pointing triangleleft closed entrysquared saltirefalling diagonal in white circle in black squareblack truck" + // U+26D0
+		"restricted left entry-1restricted left entry-2astronomical symbol for uranusheavy circle with stroke and two dots abovepentagramright-handed interlaced pentagramleft-handed interlaced pentagraminverted pentagramblack cross on shieldshinto shrinechurchcastlehistoric sitegear without hubgear with handlesmap symbol for lighthouse" + // U+26E0
+		"mountainumbrella on groundfountainflag in holeferrysailboatsquare four cornersskierice skateperson with balltentjapanese bank symbolheadstone graveyard symbolfuel pumpcup on black squarewhite flag with horizontal middle black stripe" + // U+26F0
+		"black safety scissorsupper blade scissorsblack scissorslower blade scissorswhite scissorswhite heavy check marktelephone location signtape driveairplaneenveloperaised fistraised handvictory handwriting handlower right pencilpencil" + // U+2700
+		"upper right pencilwhite nibblack nibcheck markheavy check markmultiplication xheavy multiplication xballot xheavy ballot xoutlined greek crossheavy greek crossopen centre crossheavy open centre crosslatin crossshadowed white latin crossoutlined latin cross" + // U+2710
+		"maltese crossstar of davidfour teardrop-spoked asteriskfour balloon-spoked asteriskheavy four balloon-spoked asteriskfour club-spoked asteriskblack four pointed starwhite four pointed starsparklesstress outlined white starcircled white staropen centre black starblack centre white staroutlined black starheavy outlined black starpinwheel star" + // U+2720
+		"shadowed white staropen centre asteriskeight spoked asteriskeight pointed black stareight pointed pinwheel starsix pointed black stareight pointed rectilinear black starheavy eight pointed rectilinear black startwelve pointed black starsixteen pointed asteriskteardrop-spoked asteriskopen centre teardrop-spoked asteriskheavy teardrop-spoked asterisksix petalled black and white floretteblack florette" + // U+2730
+		"white floretteeight petalled outlined black florettecircled open centre eight pointed starheavy teardrop-spoked pinwheel asterisksnowflaketight trifoliate snowflakeheavy chevron snowflakesparkleheavy sparkleballoon-spoked asteriskeight teardrop-spoked propeller asteriskheavy eight teardrop-spoked propeller asteriskcross markshadowed white circlenegative squared cross marklower right drop-shadowed white square" + // U+2740
+		"upper right drop-shadowed white squarelower right shadowed white squareupper right shadowed white squareblack question mark ornamentwhite question mark ornamentwhite exclamation mark ornamentblack diamond minus white xheavy exclamation mark symbolmedium vertical barheavy vertical barheavy single turned comma quotation mark ornamentheavy single comma quotation mark ornamentheavy double turned comma quotation mark ornamentheavy double comma quotation mark ornamentheavy low single comma quotation mark ornament" + // U+2750
+		"heavy low double comma quotation mark ornamentcurved stem paragraph sign ornamentheavy heart exclamation mark ornamentheavy black heartrotated heavy black heart bulletfloral heartrotated floral heart bulletmedium left parenthesis ornamentmedium right parenthesis ornamentmedium flattened left parenthesis ornamentmedium flattened right parenthesis ornamentmedium left-pointing angle bracket ornamentmedium right-pointing angle bracket ornamentheavy left-pointing angle quotation mark ornamentheavy right-pointing angle quotation mark ornament" + // U+2760
+		"heavy left-pointing angle bracket ornamentheavy right-pointing angle bracket ornamentlight left tortoise shell bracket ornamentlight right tortoise shell bracket ornamentmedium left curly bracket ornamentmedium right curly bracket ornamentdingbat negative circled digit onedingbat negative circled digit twodingbat negative circled digit threedingbat negative circled digit fourdingbat negative circled digit fivedingbat negative circled digit sixdingbat negative circled digit sevendingbat negative circled digit eightdingbat negative circled digit ninedingbat negative circled number ten" + // U+2770
+		"dingbat circled sans-serif digit onedingbat circled sans-serif digit twodingbat circled sans-serif digit threedingbat circled sans-serif digit fourdingbat circled sans-serif digit fivedingbat circled sans-serif digit sixdingbat circled sans-serif digit sevendingbat circled sans-serif digit eightdingbat circled sans-serif digit ninedingbat circled sans-serif number tendingbat negative circled sans-serif digit onedingbat negative circled sans-serif digit twodingbat negative circled sans-serif digit threedingbat negative circled sans-serif digit fourdingbat negative circled sans-serif digit fivedingbat negative circled sans-serif digit six" + // U+2780
+		"dingbat negative circled sans-serif digit sevendingbat negative circled sans-serif digit eightdingbat negative circled sans-serif digit ninedingbat negative circled sans-serif number tenheavy wide-headed rightwards arrowheavy plus signheavy minus signheavy division signheavy south east arrowheavy rightwards arrowheavy north east arrowdrafting point rightwards arrowheavy round-tipped rightwards arrowtriangle-headed rightwards arrowheavy triangle-headed rightwards arrowdashed triangle-headed rightwards arrow" + // U+2790
+		"heavy dashed triangle-headed rightwards arrowblack rightwards arrowthree-d top-lighted rightwards arrowheadthree-d bottom-lighted rightwards arrowheadblack rightwards arrowheadheavy black curved downwards and rightwards arrowheavy black curved upwards and rightwards arrowsquat black rightwards arrowheavy concave-pointed black rightwards arrowright-shaded white rightwards arrowleft-shaded white rightwards arrowback-tilted shadowed white rightwards arrowfront-tilted shadowed white rightwards arrowheavy lower right-shadowed white rightwards arrowheavy upper right-shadowed white rightwards arrownotched lower right-shadowed white rightwards arrow" + // U+27A0
+		"curly loopnotched upper right-shadowed white rightwards arrowcircled heavy white rightwards arrowwhite-feathered rightwards arrowblack-feathered south east arrowblack-feathered rightwards arrowblack-feathered north east arrowheavy black-feathered south east arrowheavy black-feathered rightwards arrowheavy black-feathered north east arrowteardrop-barbed rightwards arrowheavy teardrop-shanked rightwards arrowwedge-tailed rightwards arrowheavy wedge-tailed rightwards arrowopen-outlined rightwards arrowdouble curly loop" + // U+27B0
+		"three dimensional anglewhite triangle containing small white triangleperpendicularopen subsetopen supersetleft s-shaped bag delimiterright s-shaped bag delimiteror with dot insidereverse solidus preceding subsetsuperset preceding solidusvertical bar with horizontal strokemathematical rising diagonallong divisionmathematical falling diagonalsquared logical andsquared logical or" + // U+27C0
+		"white diamond with centred dotand with dotelement of opening upwardslower right corner with dotupper left corner with dotleft outer joinright outer joinfull outer joinlarge up tacklarge down tackleft and right double turnstileleft and right tackleft multimaplong right tacklong left tackup tack with circle above" + // U+27D0
+		"lozenge divided by horizontal rulewhite concave-sided diamondwhite concave-sided diamond with leftwards tickwhite concave-sided diamond with rightwards tickwhite square with leftwards tickwhite square with rightwards tickmathematical right white square bracketmathematical left double angle bracketmathematical right double angle bracketmathematical left white tortoise shell bracketmathematical right white tortoise shell bracketmathematical left flattened parenthesismathematical right flattened parenthesis" + // U+27E0
+		"upwards quadruple arrowdownwards quadruple arrowanticlockwise gapped circle arrowclockwise gapped circle arrowright arrow with circled pluslong leftwards arrowlong rightwards arrowlong left right arrowlong leftwards double arrowlong rightwards double arrowlong left right double arrowlong leftwards arrow from barlong rightwards arrow from barlong leftwards double arrow from barlong rightwards double arrow from barlong rightwards squiggle arrow" + // U+27F0
+		"rightwards two-headed arrow with vertical strokerightwards two-headed arrow with double vertical strokeleftwards double arrow with vertical strokerightwards double arrow with vertical strokeleft right double arrow with vertical strokerightwards two-headed arrow from barleftwards double arrow from barrightwards double arrow from bardownwards arrow with horizontal strokeupwards arrow with horizontal strokeupwards triple arrowdownwards triple arrowleftwards double dash arrowrightwards double dash arrowleftwards triple dash arrowrightwards triple dash arrow" + // U+2900
+		"rightwards two-headed triple dash arrowrightwards arrow with dotted stemupwards arrow to bardownwards arrow to barrightwards arrow with tail with vertical strokerightwards arrow with tail with double vertical strokerightwards two-headed arrow with tailrightwards two-headed arrow with tail with vertical strokerightwards two-headed arrow with tail with double vertical strokeleftwards arrow-tailrightwards arrow-tailleftwards double arrow-tailrightwards double arrow-tailleftwards arrow to black diamondrightwards arrow to black diamondleftwards arrow from bar to black diamond" + // U+2910
+		"rightwards arrow from bar to black diamondnorth west and south east arrownorth east and south west arrownorth west arrow with hooknorth east arrow with hooksouth east arrow with hooksouth west arrow with hooknorth west arrow and north east arrownorth east arrow and south east arrowsouth east arrow and south west arrowsouth west arrow and north west arrowrising diagonal crossing falling diagonalfalling diagonal crossing rising diagonalsouth east arrow crossing north east arrownorth east arrow crossing south east arrowfalling diagonal crossing north east arrow" + // U+2920
+		"rising diagonal crossing south east arrownorth east arrow crossing north west arrownorth west arrow crossing north east arrowwave arrow pointing directly rightarrow pointing rightwards then curving upwardsarrow pointing rightwards then curving downwardsarrow pointing downwards then curving leftwardsarrow pointing downwards then curving rightwardsright-side arc clockwise arrowleft-side arc anticlockwise arrowtop arc anticlockwise arrowbottom arc anticlockwise arrowtop arc clockwise arrow with minustop arc anticlockwise arrow with pluslower right semicircular clockwise arrowlower left semicircular anticlockwise arrow" + // U+2930
+		"anticlockwise closed circle arrowclockwise closed circle arrowrightwards arrow above short leftwards arrowleftwards arrow above short rightwards arrowshort rightwards arrow above leftwards arrowrightwards arrow with plus belowleftwards arrow with plus belowrightwards arrow through xleft right arrow through small circleupwards two-headed arrow from small circleleft barb up right barb down harpoonleft barb down right barb up harpoonup barb right down barb left harpoonup barb left down barb right harpoonleft barb up right barb up harpoonup barb right down barb right harpoon" + // U+2940
+		"left barb down right barb down harpoonup barb left down barb left harpoonleftwards harpoon with barb up to barrightwards harpoon with barb up to barupwards harpoon with barb right to bardownwards harpoon with barb right to barleftwards harpoon with barb down to barrightwards harpoon with barb down to barupwards harpoon with barb left to bardownwards harpoon with barb left to barleftwards harpoon with barb up from barrightwards harpoon with barb up from barupwards harpoon with barb right from bardownwards harpoon with barb right from barleftwards harpoon with barb down from barrightwards harpoon with barb down from bar" + // U+2950
+		"upwards harpoon with barb left from bardownwards harpoon with barb left from barleftwards harpoon with barb up above leftwards harpoon with barb downupwards harpoon with barb left beside upwards harpoon with barb rightrightwards harpoon with barb up above rightwards harpoon with barb downdownwards harpoon with barb left beside downwards harpoon with barb rightleftwards harpoon with barb up above rightwards harpoon with barb upleftwards harpoon with barb down above rightwards harpoon with barb downrightwards harpoon with barb up above leftwards harpoon with barb uprightwards harpoon with barb down above leftwards harpoon with barb downleftwards harpoon with barb up above long dashleftwards harpoon with barb down below long dashrightwards harpoon with barb up above long dashrightwards harpoon with barb down below long dashupwards harpoon with barb left beside downwards harpoon with barb rightdownwards harpoon with barb left beside upwards harpoon with barb right" + // U+2960
+		"right double arrow with rounded headequals sign above rightwards arrowtilde operator above rightwards arrowleftwards arrow above tilde operatorrightwards arrow above tilde operatorrightwards arrow above almost equal toless-than above leftwards arrowleftwards arrow through less-thangreater-than above rightwards arrowsubset above rightwards arrowleftwards arrow through subsetsuperset above leftwards arrowleft fish tailright fish tailup fish taildown fish tail" + // U+2970
+		"triple vertical bar delimiterz notation spotz notation type colonleft white parenthesisright white parenthesisz notation left image bracketz notation right image bracketz notation left binding bracketz notation right binding bracketleft square bracket with underbarright square bracket with underbarleft square bracket with tick in top cornerright square bracket with tick in bottom cornerleft square bracket with tick in bottom corner" + // U+2980
+		"right square bracket with tick in top cornerleft angle bracket with dotright angle bracket with dotleft arc less-than bracketright arc greater-than bracketdouble left arc greater-than bracketdouble right arc less-than bracketleft black tortoise shell bracketright black tortoise shell bracketdotted fencevertical zigzag linemeasured angle opening leftright angle variant with squaremeasured right angle with dotangle with s insideacute angle" + // U+2990
+		"spherical angle opening leftspherical angle opening upturned anglereversed angleangle with underbarreversed angle with underbaroblique angle opening upoblique angle opening downmeasured angle with open arm ending in arrow pointing up and rightmeasured angle with open arm ending in arrow pointing up and leftmeasured angle with open arm ending in arrow pointing down and rightmeasured angle with open arm ending in arrow pointing down and leftmeasured angle with open arm ending in arrow pointing right and upmeasured angle with open arm ending in arrow pointing left and upmeasured angle with open arm ending in arrow pointing right and downmeasured angle with open arm ending in arrow pointing left and down" + // U+29A0
+		"reversed empty setempty set with overbarempty set with small circle aboveempty set with right arrow aboveempty set with left arrow abovecircle with horizontal barcircled vertical barcircled parallelcircled reverse soliduscircled perpendicularcircle divided by horizontal bar and top half divided by vertical barcircle with superimposed xcircled anticlockwise-rotated division signup arrow through circlecircled white bulletcircled bullet" + // U+29B0
+		"circled less-thancircled greater-thancircle with small circle to the rightcircle with two horizontal strokes to the rightsquared rising diagonal slashsquared falling diagonal slashsquared asterisksquared small circlesquared squaretwo joined squarestriangle with dot abovetriangle with underbars in triangletriangle with serifs at bottomright triangle above left triangleleft triangle beside vertical bar" + // U+29C0
+		"vertical bar beside right trianglebowtie with left half blackbowtie with right half blackblack bowtietimes with left half blacktimes with right half blackwhite hourglassblack hourglassleft wiggly fenceright wiggly fenceleft double wiggly fenceright double wiggly fenceincomplete infinitytie over infinityinfinity negated with vertical bardouble-ended multimap" + // U+29D0
+		"square with contoured outlineincreases asshuffle productequals sign and slanted parallelequals sign and slanted parallel with tilde aboveidentical to and slanted parallelgleich starkthermodynamicdown-pointing triangle with left half blackdown-pointing triangle with right half blackblack diamond with down arrowblack lozengewhite circle with down arrowblack circle with down arrowerror-barred white squareerror-barred black square" + // U+29E0
+		"error-barred white diamonderror-barred black diamonderror-barred white circleerror-barred black circlerule-delayedreverse solidus operatorsolidus with overbarreverse solidus with horizontal strokebig solidusbig reverse solidusdouble plustriple plusleft-pointing curved angle bracketright-pointing curved angle brackettinyminy" + // U+29F0
+		"n-ary circled dot operatorn-ary circled plus operatorn-ary circled times operatorn-ary union operator with dotn-ary union operator with plusn-ary square intersection operatorn-ary square union operatortwo logical and operatortwo logical or operatorn-ary times operatormodulo two sumsummation with integralquadruple integral operatorfinite part integralintegral with double strokeintegral average with slash" + // U+2A00
+		"circulation functionanticlockwise integrationline integration with rectangular path around poleline integration with semicircular path around poleline integration not including the poleintegral around a point operatorquaternion integral operatorintegral with leftwards arrow with hookintegral with times signintegral with intersectionintegral with unionintegral with overbarintegral with underbarjoinlarge left triangle operatorz notation schema composition" + // U+2A10
+		"z notation schema pipingz notation schema projectionplus sign with small circle aboveplus sign with circumflex accent aboveplus sign with tilde aboveplus sign with dot belowplus sign with tilde belowplus sign with subscript twoplus sign with black triangleminus sign with comma aboveminus sign with dot belowminus sign with falling dotsminus sign with rising dotsplus sign in left half circleplus sign in right half circlevector or cross product" + // U+2A20
+		"multiplication sign with dot abovemultiplication sign with underbarsemidirect product with bottom closedsmash productmultiplication sign in left half circlemultiplication sign in right half circlecircled multiplication sign with circumflex accentmultiplication sign in double circlecircled division signplus sign in triangleminus sign in trianglemultiplication sign in triangleinterior productrighthand interior productz notation relational compositionamalgamation or coproduct" + // U+2A30
+		"intersection with dotunion with minus signunion with overbarintersection with overbarintersection with logical andunion with logical orunion above intersectionintersection above unionunion above bar above intersectionintersection above bar above unionunion beside and joined with unionintersection beside and joined with intersectionclosed union with serifsclosed intersection with serifsdouble square intersectiondouble square union" + // U+2A40
+		"closed union with serifs and smash productlogical and with dot abovelogical or with dot abovedouble logical anddouble logical ortwo intersecting logical andtwo intersecting logical orsloping large orsloping large andlogical or overlapping logical andlogical and with middle stemlogical or with middle stemlogical and with horizontal dashlogical or with horizontal dashlogical and with double overbarlogical and with underbar" + // U+2A50
+		"logical and with double underbarsmall vee with underbarlogical or with double overbarlogical or with double underbarz notation domain antirestrictionz notation range antirestrictionequals sign with dot belowidentical with dot abovetriple horizontal bar with double vertical stroketriple horizontal bar with triple vertical stroketilde operator with dot abovetilde operator with rising dotssimilar minus similarcongruent with dot aboveequals with asteriskalmost equal to with circumflex accent" + // U+2A60
+		"approximately equal or equal toequals sign above plus signplus sign above equals signequals sign above tilde operatorequals sign with two dots above and two dots belowequivalent with four dots aboveless-than with circle insidegreater-than with circle insideless-than with question mark abovegreater-than with question mark aboveless-than or slanted equal togreater-than or slanted equal toless-than or slanted equal to with dot inside" + // U+2A70
+		"greater-than or slanted equal to with dot insideless-than or slanted equal to with dot abovegreater-than or slanted equal to with dot aboveless-than or slanted equal to with dot above rightgreater-than or slanted equal to with dot above leftless-than or approximategreater-than or approximateless-than and single-line not equal togreater-than and single-line not equal toless-than and not approximategreater-than and not approximateless-than above double-line equal above greater-thangreater-than above double-line equal above less-thanless-than above similar or equalgreater-than above similar or equalless-than above similar above greater-than" + // U+2A80
+		"greater-than above similar above less-thanless-than above greater-than above double-line equalgreater-than above less-than above double-line equalless-than above slanted equal above greater-than above slanted equalgreater-than above slanted equal above less-than above slanted equalslanted equal to or less-thanslanted equal to or greater-thanslanted equal to or less-than with dot insideslanted equal to or greater-than with dot insidedouble-line equal to or less-thandouble-line equal to or greater-thandouble-line slanted equal to or less-thandouble-line slanted equal to or greater-thansimilar or less-thansimilar or greater-thansimilar above less-than above equals sign" + // U+2A90
+		"similar above greater-than above equals signdouble nested less-thandouble nested greater-thandouble nested less-than with underbargreater-than overlapping less-thangreater-than beside less-thanless-than closed by curvegreater-than closed by curveless-than closed by curve above slanted equalgreater-than closed by curve above slanted equalsmaller thanlarger thansmaller than or equal tolarger than or equal toequals sign with bumpy aboveprecedes above single-line equals sign" + // U+2AA0
+		"succeeds above single-line equals signprecedes above single-line not equal tosucceeds above single-line not equal toprecedes above equals signsucceeds above equals signprecedes above not equal tosucceeds above not equal toprecedes above almost equal tosucceeds above almost equal toprecedes above not almost equal tosucceeds above not almost equal todouble precedesdouble succeedssubset with dotsuperset with dotsubset with plus sign below" + // U+2AB0
+		"superset with plus sign belowsubset with multiplication sign belowsuperset with multiplication sign belowsubset of or equal to with dot abovesuperset of or equal to with dot abovesubset of above equals signsuperset of above equals signsubset of above tilde operatorsuperset of above tilde operatorsubset of above almost equal tosuperset of above almost equal tosubset of above not equal tosuperset of above not equal tosquare left open box operatorsquare right open box operatorclosed subset" + // U+2AC0
+		"closed supersetclosed subset or equal toclosed superset or equal tosubset above supersetsuperset above subsetsubset above subsetsuperset above supersetsuperset beside subsetsuperset beside and joined by dash with subsetelement of opening downwardspitchfork with tee toptransversal intersectionforkingnonforkingshort left tackshort down tack" + // U+2AD0
+		"short up tackperpendicular with svertical bar triple right turnstiledouble vertical bar left turnstilevertical bar double left turnstiledouble vertical bar double left turnstilelong dash from left member of double verticalshort down tack with overbarshort up tack with underbarshort up tack above short down tackdouble down tackdouble up tackdouble stroke not signreversed double stroke not signdoes not divide with reversed negation slashvertical line with circle above" + // U+2AE0
+		"vertical line with circle belowdown tack with circle belowparallel with horizontal strokeparallel with tilde operatortriple vertical bar binary relationtriple vertical bar with horizontal stroketriple colon operatortriple nested less-thantriple nested greater-thandouble-line slanted less-than or equal todouble-line slanted greater-than or equal totriple solidus binary relationlarge triple vertical bar operatordouble solidus operatorwhite vertical barn-ary white vertical bar" + // U+2AF0
+		"north east white arrownorth west white arrowsouth east white arrowsouth west white arrowleft right white arrowleftwards black arrowupwards black arrowdownwards black arrownorth east black arrownorth west black arrowsouth east black arrowsouth west black arrowleft right black arrowup down black arrowrightwards arrow with tip downwardsrightwards arrow with tip upwards" + // U+2B00
+		"leftwards arrow with tip downwardsleftwards arrow with tip upwardssquare with top half blacksquare with bottom half blacksquare with upper right diagonal half blacksquare with lower left diagonal half blackdiamond with left half blackdiamond with right half blackdiamond with top half blackdiamond with bottom half blackdotted squareblack large squarewhite large squareblack very small squarewhite very small squareblack pentagon" + // U+2B10
+		"white pentagonwhite hexagonblack hexagonhorizontal black hexagonblack large circleblack medium diamondwhite medium diamondblack medium lozengewhite medium lozengeblack small diamondblack small lozengewhite small lozengeblack horizontal ellipsewhite horizontal ellipseblack vertical ellipsewhite vertical ellipse" + // U+2B20
+		"left arrow with small circlethree leftwards arrowsleft arrow with circled pluslong leftwards squiggle arrowleftwards two-headed arrow with vertical strokeleftwards two-headed arrow with double vertical strokeleftwards two-headed arrow from barleftwards two-headed triple dash arrowleftwards arrow with dotted stemleftwards arrow with tail with vertical strokeleftwards arrow with tail with double vertical strokeleftwards two-headed arrow with tailleftwards two-headed arrow with tail with vertical strokeleftwards two-headed arrow with tail with double vertical strokeleftwards arrow through xwave arrow pointing directly left" + // U+2B30
+		"equals sign above leftwards arrowreverse tilde operator above leftwards arrowleftwards arrow above reverse almost equal torightwards arrow through greater-thanrightwards arrow through supersetleftwards quadruple arrowrightwards quadruple arrowreverse tilde operator above rightwards arrowrightwards arrow above reverse almost equal totilde operator above leftwards arrowleftwards arrow above almost equal toleftwards arrow above reverse tilde operatorrightwards arrow above reverse tilde operatordownwards triangle-headed zigzag arrowshort slanted north arrowshort backslanted south arrow" + // U+2B40
+		"white medium starblack small starwhite small starblack right-pointing pentagonwhite right-pointing pentagonheavy large circleheavy oval with oval insideheavy circle with circle insideheavy circleheavy circled saltireslanted north arrow with hooked headbackslanted south arrow with hooked tailslanted north arrow with horizontal tailbackslanted south arrow with horizontal tailbent arrow pointing downwards then north eastshort bent arrow pointing downwards then north east" + // U+2B50
+		"leftwards triangle-headed arrowupwards triangle-headed arrowrightwards triangle-headed arrowdownwards triangle-headed arrowleft right triangle-headed arrowup down triangle-headed arrownorth west triangle-headed arrownorth east triangle-headed arrowsouth east triangle-headed arrowsouth west triangle-headed arrowleftwards triangle-headed dashed arrowupwards triangle-headed dashed arrowrightwards triangle-headed dashed arrowdownwards triangle-headed dashed arrowclockwise triangle-headed open circle arrowanticlockwise triangle-headed open circle arrow" + // U+2B60
+		"leftwards triangle-headed arrow to barupwards triangle-headed arrow to barrightwards triangle-headed arrow to bardownwards triangle-headed arrow to barnorth west triangle-headed arrow to barnorth east triangle-headed arrow to barsouth east triangle-headed arrow to barsouth west triangle-headed arrow to barleftwards triangle-headed arrow with double horizontal strokeupwards triangle-headed arrow with double horizontal strokerightwards triangle-headed arrow with double horizontal strokedownwards triangle-headed arrow with double horizontal strokehorizontal tab keyvertical tab key" + // U+2B70
+		"leftwards triangle-headed arrow over rightwards triangle-headed arrowupwards triangle-headed arrow leftwards of downwards triangle-headed arrowrightwards triangle-headed arrow over leftwards triangle-headed arrowdownwards triangle-headed arrow leftwards of upwards triangle-headed arrowleftwards triangle-headed paired arrowsupwards triangle-headed paired arrowsrightwards triangle-headed paired arrowsdownwards triangle-headed paired arrowsleftwards black circled white arrowupwards black circled white arrowrightwards black circled white arrowdownwards black circled white arrowanticlockwise triangle-headed right u-shaped arrowanticlockwise triangle-headed bottom u-shaped arrowanticlockwise triangle-headed left u-shaped arrowanticlockwise triangle-headed top u-shaped arrow" + // U+2B80
+		"return leftreturn rightnewline leftnewline rightfour corner arrows circling anticlockwiserightwards black arrowsymbol for type a electronicsthree-d top-lighted leftwards equilateral arrowheadthree-d right-lighted upwards equilateral arrowheadthree-d top-lighted rightwards equilateral arrowheadthree-d left-lighted downwards equilateral arrowheadblack leftwards equilateral arrowheadblack upwards equilateral arrowheadblack rightwards equilateral arrowheadblack downwards equilateral arrowhead" + // U+2B90
+		"downwards triangle-headed arrow with long tip leftwardsdownwards triangle-headed arrow with long tip rightwardsupwards triangle-headed arrow with long tip leftwardsupwards triangle-headed arrow with long tip rightwardsleftwards triangle-headed arrow with long tip upwardsrightwards triangle-headed arrow with long tip upwardsleftwards triangle-headed arrow with long tip downwardsrightwards triangle-headed arrow with long tip downwardsblack curved downwards and leftwards arrowblack curved downwards and rightwards arrowblack curved upwards and leftwards arrowblack curved upwards and rightwards arrowblack curved leftwards and upwards arrowblack curved rightwards and upwards arrowblack curved leftwards and downwards arrowblack curved rightwards and downwards arrow" + // U+2BA0
+		"ribbon arrow down leftribbon arrow down rightribbon arrow up leftribbon arrow up rightribbon arrow left upribbon arrow right upribbon arrow left downribbon arrow right downupwards white arrow from bar with horizontal barup arrowhead in a rectangle boxoverlapping white squaresoverlapping white and black squaresoverlapping black squaresballot box with light xcircled xcircled bold x" + // U+2BB0
+		"black square centredblack diamond centredturned black pentagonhorizontal black octagonblack octagonblack medium up-pointing triangle centredblack medium down-pointing triangle centredblack medium left-pointing triangle centredblack medium right-pointing triangle centredneptune form twotop half black circlebottom half black circlelight four pointed black cusprotated light four pointed black cuspwhite four pointed cusprotated white four pointed cusp" + // U+2BC0
+		"square position indicatoruncertainty signgroup markpluto form twopluto form threepluto form fourpluto form fivetransplutoproserpinaastraeahygieapholusnessuswhite moon selenablack diamond on crosstrue light moon arta" + // U+2BD0
+		"cupidohadeszeuskronosapollonadmetosvulcanusposeidonleft half black starright half black starstar with left half blackstar with right half blackleftwards two-headed arrow with triangle arrowheadsupwards two-headed arrow with triangle arrowheadsrightwards two-headed arrow with triangle arrowheadsdownwards two-headed arrow with triangle arrowheads" + // U+2BE0
+		"eris form oneeris form twosednarussian astrological symbol vigintilerussian astrological symbol novilerussian astrological symbol quintilerussian astrological symbol binovilerussian astrological symbol sentagonrussian astrological symbol tredecileequals sign with infinity belowunited symbolseparated symboldoubled symbolpassed symbolreversed right anglehellschreiber pause symbol" + // U+2BF0
+		"glagolitic capital letter azuglagolitic capital letter bukyglagolitic capital letter vedeglagolitic capital letter glagoliglagolitic capital letter dobroglagolitic capital letter yestuglagolitic capital letter zhiveteglagolitic capital letter dzeloglagolitic capital letter zemljaglagolitic capital letter izheglagolitic capital letter initial izheglagolitic capital letter iglagolitic capital letter djerviglagolitic capital letter kakoglagolitic capital letter ljudijeglagolitic capital letter myslite" + // U+2C00
+		"glagolitic capital letter nashiglagolitic capital letter onuglagolitic capital letter pokojiglagolitic capital letter ritsiglagolitic capital letter slovoglagolitic capital letter tvridoglagolitic capital letter ukuglagolitic capital letter frituglagolitic capital letter heruglagolitic capital letter otuglagolitic capital letter peglagolitic capital letter shtaglagolitic capital letter tsiglagolitic capital letter chriviglagolitic capital letter shaglagolitic capital letter yeru" + // U+2C10
+		"glagolitic capital letter yeriglagolitic capital letter yatiglagolitic capital letter spidery haglagolitic capital letter yuglagolitic capital letter small yusglagolitic capital letter small yus with tailglagolitic capital letter yoglagolitic capital letter iotated small yusglagolitic capital letter big yusglagolitic capital letter iotated big yusglagolitic capital letter fitaglagolitic capital letter izhitsaglagolitic capital letter shtapicglagolitic capital letter trokutasti aglagolitic capital letter latinate mysliteglagolitic capital letter caudate chrivi" + // U+2C20
+		"glagolitic small letter azuglagolitic small letter bukyglagolitic small letter vedeglagolitic small letter glagoliglagolitic small letter dobroglagolitic small letter yestuglagolitic small letter zhiveteglagolitic small letter dzeloglagolitic small letter zemljaglagolitic small letter izheglagolitic small letter initial izheglagolitic small letter iglagolitic small letter djerviglagolitic small letter kakoglagolitic small letter ljudijeglagolitic small letter myslite" + // U+2C30
+		"glagolitic small letter nashiglagolitic small letter onuglagolitic small letter pokojiglagolitic small letter ritsiglagolitic small letter slovoglagolitic small letter tvridoglagolitic small letter ukuglagolitic small letter frituglagolitic small letter heruglagolitic small letter otuglagolitic small letter peglagolitic small letter shtaglagolitic small letter tsiglagolitic small letter chriviglagolitic small letter shaglagolitic small letter yeru" + // U+2C40
+		"glagolitic small letter yeriglagolitic small letter yatiglagolitic small letter spidery haglagolitic small letter yuglagolitic small letter small yusglagolitic small letter small yus with tailglagolitic small letter yoglagolitic small letter iotated small yusglagolitic small letter big yusglagolitic small letter iotated big yusglagolitic small letter fitaglagolitic small letter izhitsaglagolitic small letter shtapicglagolitic small letter trokutasti aglagolitic small letter latinate mysliteglagolitic small letter caudate chrivi" + // U+2C50
+		"latin capital letter alpha" + // U+2C60
+		"latin capital letter turned alphalatin small letter v with right hooklatin capital letter w with hooklatin small letter w with hooklatin small letter v with curllatin capital letter half hlatin small letter half hlatin small letter tailless philatin small letter e with notchlatin small letter turned r with taillatin small letter o with low ring insidelatin letter small capital turned elatin subscript small letter jmodifier letter capital vlatin capital letter s with swash taillatin capital letter z with swash tail" + // U+2C70
+		"coptic capital letter alfacoptic small letter alfacoptic capital letter vidacoptic small letter vidacoptic capital letter gammacoptic small letter gammacoptic capital letter daldacoptic small letter daldacoptic capital letter eiecoptic small letter eiecoptic capital letter soucoptic small letter soucoptic capital letter zatacoptic small letter zatacoptic capital letter hatecoptic small letter hate" + // U+2C80
+		"coptic capital letter thethecoptic small letter thethecoptic capital letter iaudacoptic small letter iaudacoptic capital letter kapacoptic small letter kapacoptic capital letter laulacoptic small letter laulacoptic capital letter micoptic small letter micoptic capital letter nicoptic small letter nicoptic capital letter ksicoptic small letter ksicoptic capital letter ocoptic small letter o" + // U+2C90
+		"coptic capital letter picoptic small letter picoptic capital letter rocoptic small letter rocoptic capital letter simacoptic small letter simacoptic capital letter taucoptic small letter taucoptic capital letter uacoptic small letter uacoptic capital letter ficoptic small letter ficoptic capital letter khicoptic small letter khicoptic capital letter psicoptic small letter psi" + // U+2CA0
+		"coptic capital letter ooucoptic small letter ooucoptic capital letter dialect-p alefcoptic small letter dialect-p alefcoptic capital letter old coptic aincoptic small letter old coptic aincoptic capital letter cryptogrammic eiecoptic small letter cryptogrammic eiecoptic capital letter dialect-p kapacoptic small letter dialect-p kapacoptic capital letter dialect-p nicoptic small letter dialect-p nicoptic capital letter cryptogrammic nicoptic small letter cryptogrammic nicoptic capital letter old coptic ooucoptic small letter old coptic oou" + // U+2CB0
+		"coptic capital letter sampicoptic small letter sampicoptic capital letter crossed sheicoptic small letter crossed sheicoptic capital letter old coptic sheicoptic small letter old coptic sheicoptic capital letter old coptic eshcoptic small letter old coptic eshcoptic capital letter akhmimic kheicoptic small letter akhmimic kheicoptic capital letter dialect-p horicoptic small letter dialect-p horicoptic capital letter old coptic horicoptic small letter old coptic horicoptic capital letter old coptic hacoptic small letter old coptic ha" + // U+2CC0
+		"coptic capital letter l-shaped hacoptic small letter l-shaped hacoptic capital letter old coptic heicoptic small letter old coptic heicoptic capital letter old coptic hatcoptic small letter old coptic hatcoptic capital letter old coptic gangiacoptic small letter old coptic gangiacoptic capital letter old coptic djacoptic small letter old coptic djacoptic capital letter old coptic shimacoptic small letter old coptic shimacoptic capital letter old nubian shimacoptic small letter old nubian shimacoptic capital letter old nubian ngicoptic small letter old nubian ngi" + // U+2CD0
+		"coptic capital letter old nubian nyicoptic small letter old nubian nyicoptic capital letter old nubian waucoptic small letter old nubian waucoptic symbol kaicoptic symbol mi rocoptic symbol pi rocoptic symbol stauroscoptic symbol tau rocoptic symbol khi rocoptic symbol shima simacoptic capital letter cryptogrammic sheicoptic small letter cryptogrammic sheicoptic capital letter cryptogrammic gangiacoptic small letter cryptogrammic gangia" + // U+2CE0
+		"coptic capital letter bohairic kheicoptic small letter bohairic kheicoptic old nubian full stopcoptic old nubian direct question markcoptic old nubian indirect question markcoptic old nubian verse dividercoptic fraction one halfcoptic full stopcoptic morphological divider" + // U+2CF0
+		"tifinagh separator mark" + // U+2D70
+		"right angle substitution markerright angle dotted substitution markerleft substitution bracketright substitution bracketleft dotted substitution bracketright dotted substitution bracketraised interpolation markerraised dotted interpolation markerdotted transposition markerleft transposition bracketright transposition bracketraised squareleft raised omission bracketright raised omission bracketeditorial coronisparagraphos" + // U+2E00
+		"forked paragraphosreversed forked paragraphoshypodiastoledotted obelosdownwards ancoraupwards ancoradotted right-pointing angledouble oblique hypheninverted interrobangpalm branchhyphen with diaeresistilde with ring aboveleft low paraphrase bracketright low paraphrase brackettilde with dot abovetilde with dot below" + // U+2E10
+		"left vertical bar with quillright vertical bar with quilltop left half brackettop right half bracketbottom left half bracketbottom right half bracketleft sideways u bracketright sideways u bracketleft double parenthesisright double parenthesistwo dots over one dot punctuationone dot over two dots punctuationsquared four dot punctuationfive dot markreversed question markvertical tilde" + // U+2E20
+		"ring pointword separator middle dotturned commaraised dotraised commaturned semicolondagger with left guarddagger with right guardturned daggertop half section signtwo-em dashthree-em dashstenographic full stopvertical six dotswiggly vertical linecapitulum" + // U+2E30
+		"double hyphenreversed commadouble low-reversed-9 quotation markdash with left upturndouble suspension markinverted low kavykainverted low kavyka with kavyka abovelow kavykalow kavyka with dotdouble stacked commadotted solidustriple daggermedieval commaparagraphus markpunctus elevatus markcornish verse divider" + // U+2E40
+		"cross patty with right crossbarcross patty with left crossbartironian sign capital etmedieval exclamation markmedieval question markleft square bracket with strokeright square bracket with strokeleft square bracket with double strokeright square bracket with double stroketop half left parenthesistop half right parenthesisbottom half left parenthesisbottom half right parenthesisoblique hyphen" + // U+2E50
+		"ideographic description character surround from rightideographic description character surround from lower rightideographic description character horizontal reflectionideographic description character rotation" + // U+2FF0
+		"vertical kana repeat mark lower halfvertical ideographic iteration markmasu markpart alternation markideographic variation indicatorideographic half fill space" + // U+3030
+		"katakana-hiragana voiced sound markkatakana-hiragana semi-voiced sound mark" + // U+3090
+		"katakana-hiragana double hyphen" + // U+30A0
+		"katakana middle dotkatakana-hiragana prolonged sound mark" + // U+30F0
+		"hangul letter ieung" + // U+3140
+		"hangul filler" + // U+3160
+		"hangul letter pieup-tikeut" + // U+3170
+		"hangul letter ssangieung" + // U+3180
+		"ideographic annotation linking markideographic annotation reverse markideographic annotation one markideographic annotation two markideographic annotation three markideographic annotation four markideographic annotation top markideographic annotation middle markideographic annotation bottom markideographic annotation first markideographic annotation second markideographic annotation third markideographic annotation fourth markideographic annotation heaven markideographic annotation earth markideographic annotation man mark" + // U+3190
+		"cjk stroke tcjk stroke wgcjk stroke xgcjk stroke bxgcjk stroke swcjk stroke hzzcjk stroke hzgcjk stroke hpcjk stroke hzwgcjk stroke szwgcjk stroke hztcjk stroke hzzpcjk stroke hpwgcjk stroke hzwcjk stroke hzzzcjk stroke n" + // U+31C0
+		"cjk stroke hcjk stroke scjk stroke pcjk stroke spcjk stroke dcjk stroke hzcjk stroke hgcjk stroke szcjk stroke swzcjk stroke stcjk stroke sgcjk stroke pdcjk stroke pzcjk stroke tncjk stroke szzcjk stroke swg" + // U+31D0
+		"cjk stroke hxwgcjk stroke hzzzgcjk stroke pgcjk stroke qideographic description character subtraction" + // U+31E0
+		"parenthesized korean character ojeonparenthesized korean character o hu" + // U+3210
+		"circled ideograph questioncircled ideograph kindergartencircled ideograph schoolcircled ideograph koto" + // U+3240
+		"partnership sign" + // U+3250
+		"circled korean character chamkocircled korean character jueuicircled hangul ieung u" + // U+3270
+		"square hgsquare ergsquare evlimited liability sign" + // U+32C0
+		"square dmsquare dm squaredsquare dm cubedsquare iu" + // U+3370
+		"square v over msquare a over m" + // U+33D0
+		"hexagram for the creative heavenhexagram for the receptive earthhexagram for difficulty at the beginninghexagram for youthful follyhexagram for waitinghexagram for conflicthexagram for the armyhexagram for holding togetherhexagram for small taminghexagram for treadinghexagram for peacehexagram for standstillhexagram for fellowshiphexagram for great possessionhexagram for modestyhexagram for enthusiasm" + // U+4DC0
+		"hexagram for followinghexagram for work on the decayedhexagram for approachhexagram for contemplationhexagram for biting throughhexagram for gracehexagram for splitting aparthexagram for returnhexagram for innocencehexagram for great taminghexagram for mouth cornershexagram for great preponderancehexagram for the abysmal waterhexagram for the clinging firehexagram for influencehexagram for duration" + // U+4DD0
+		"hexagram for retreathexagram for great powerhexagram for progresshexagram for darkening of the lighthexagram for the familyhexagram for oppositionhexagram for obstructionhexagram for deliverancehexagram for decreasehexagram for increasehexagram for breakthroughhexagram for coming to meethexagram for gathering togetherhexagram for pushing upwardhexagram for oppressionhexagram for the well" + // U+4DE0
+		"hexagram for revolutionhexagram for the cauldronhexagram for the arousing thunderhexagram for the keeping still mountainhexagram for developmenthexagram for the marrying maidenhexagram for abundancehexagram for the wandererhexagram for the gentle windhexagram for the joyous lakehexagram for dispersionhexagram for limitationhexagram for inner truthhexagram for small preponderancehexagram for after completionhexagram for before completion" + // U+4DF0
+		"yi radical zupyi radical cyt" + // U+A4A0
+		"yi radical nzup" + // U+A4B0
+		"yi radical zuryi radical nbie" + // U+A4C0
+		"lisu punctuation commalisu punctuation full stop" + // U+A4F0
+		"vai commavai full stopvai question mark" + // U+A600
+		"vai symbol feengvai symbol keengvai symbol tingvai symbol niivai symbol bangvai symbol faavai symbol taavai symbol dangvai symbol doongvai symbol kungvai symbol tongvai symbol do-ovai symbol jong" + // U+A610
+		"slavonic asteriskcyrillic kavykacyrillic payerok" + // U+A670
+		"bamum njaemlibamum full stopbamum colonbamum commabamum semicolonbamum question mark" + // U+A6F0
+		"modifier letter chinese tone yin pingmodifier letter chinese tone yang pingmodifier letter chinese tone yin shangmodifier letter chinese tone yang shangmodifier letter chinese tone yin qumodifier letter chinese tone yang qumodifier letter chinese tone yin rumodifier letter chinese tone yang rumodifier letter extra-high dotted tone barmodifier letter high dotted tone barmodifier letter mid dotted tone barmodifier letter low dotted tone barmodifier letter extra-low dotted tone barmodifier letter extra-high dotted left-stem tone barmodifier letter high dotted left-stem tone barmodifier letter mid dotted left-stem tone bar" + // U+A700
+		"modifier letter low dotted left-stem tone barmodifier letter extra-low dotted left-stem tone barmodifier letter extra-high left-stem tone barmodifier letter high left-stem tone barmodifier letter mid left-stem tone barmodifier letter low left-stem tone barmodifier letter extra-low left-stem tone bar" + // U+A710
+		"modifier letter stress and high tonemodifier letter stress and low tone" + // U+A720
+		"modifier letter colonmodifier letter short equals sign" + // U+A780
+		"syloti nagri poetry mark-1syloti nagri poetry mark-2syloti nagri poetry mark-3syloti nagri poetry mark-4" + // U+A820
+		"north indic quarter marknorth indic placeholder marknorth indic rupee marknorth indic quantity mark" + // U+A830
+		"phags-pa single head markphags-pa double head markphags-pa mark shadphags-pa mark double shad" + // U+A870
+		"saurashtra dandasaurashtra double danda" + // U+A8C0
+		"devanagari sign pushpikadevanagari gap fillerdevanagari caretdevanagari headstrokedevanagari sign siddhamdevanagari jain om" + // U+A8F0
+		"kayah li sign cwikayah li sign shya" + // U+A920
+		"rejang section mark" + // U+A950
+		"javanese left rerengganjavanese right rerengganjavanese pada andapjavanese pada madyajavanese pada luhurjavanese pada windujavanese pada pangkatjavanese pada lingsajavanese pada lungsijavanese pada adegjavanese pada adeg adegjavanese pada piselehjavanese turned pada piselehjavanese pangrangkep" + // U+A9C0
+		"javanese pada tirta tumetesjavanese pada isen-isen" + // U+A9D0
+		"cham punctuation spiralcham punctuation dandacham punctuation double dandacham punctuation triple danda" + // U+AA50
+		"myanmar symbol aiton exclamationmyanmar symbol aiton onemyanmar symbol aiton two" + // U+AA70
+		"tai viet tone mai nuengtai viet tone mai song" + // U+AAC0
+		"tai viet symbol kontai viet symbol nuengtai viet symbol samtai viet symbol ho hoitai viet symbol koi koi" + // U+AAD0
+		"meetei mayek cheikhanmeetei mayek ahang khudammeetei mayek anjimeetei mayek word repetition mark" + // U+AAF0
+		"modifier breve with inverted breve" + // U+AB50
+		"modifier letter left tackmodifier letter right tack" + // U+AB60
+		"meetei mayek cheikhei" + // U+ABE0
+		"hebrew letter wide alef" + // U+FB20
+		"hebrew letter alef with mapiq" + // U+FB30
+		"arabic letter alef wasla isolated formarabic letter alef wasla final formarabic letter beeh isolated formarabic letter beeh final formarabic letter beeh initial formarabic letter beeh medial formarabic letter peh isolated formarabic letter peh final formarabic letter peh initial formarabic letter peh medial formarabic letter beheh isolated formarabic letter beheh final formarabic letter beheh initial formarabic letter beheh medial formarabic letter tteheh isolated formarabic letter tteheh final form" + // U+FB50
+		"arabic letter tteheh initial formarabic letter tteheh medial formarabic letter teheh isolated formarabic letter teheh final formarabic letter teheh initial formarabic letter teheh medial formarabic letter tteh isolated formarabic letter tteh final formarabic letter tteh initial formarabic letter tteh medial formarabic letter veh isolated formarabic letter veh final formarabic letter veh initial formarabic letter veh medial formarabic letter peheh isolated formarabic letter peheh final form" + // U+FB60
+		"arabic letter peheh initial formarabic letter peheh medial formarabic letter dyeh isolated formarabic letter dyeh final formarabic letter dyeh initial formarabic letter dyeh medial formarabic letter nyeh isolated formarabic letter nyeh final formarabic letter nyeh initial formarabic letter nyeh medial formarabic letter tcheh isolated formarabic letter tcheh final formarabic letter tcheh initial formarabic letter tcheh medial formarabic letter tcheheh isolated formarabic letter tcheheh final form" + // U+FB70
+		"arabic letter tcheheh initial formarabic letter tcheheh medial formarabic letter ddahal isolated formarabic letter ddahal final formarabic letter dahal isolated formarabic letter dahal final formarabic letter dul isolated formarabic letter dul final formarabic letter ddal isolated formarabic letter ddal final formarabic letter jeh isolated formarabic letter jeh final formarabic letter rreh isolated formarabic letter rreh final formarabic letter keheh isolated formarabic letter keheh final form" + // U+FB80
+		"arabic letter keheh initial formarabic letter keheh medial formarabic letter gaf isolated formarabic letter gaf final formarabic letter gaf initial formarabic letter gaf medial formarabic letter gueh isolated formarabic letter gueh final formarabic letter gueh initial formarabic letter gueh medial formarabic letter ngoeh isolated formarabic letter ngoeh final formarabic letter ngoeh initial formarabic letter ngoeh medial formarabic letter noon ghunna isolated formarabic letter noon ghunna final form" + // U+FB90
+		"arabic letter rnoon isolated formarabic letter rnoon final formarabic letter rnoon initial formarabic letter rnoon medial formarabic letter heh with yeh above isolated formarabic letter heh with yeh above final formarabic letter heh goal isolated formarabic letter heh goal final formarabic letter heh goal initial formarabic letter heh goal medial formarabic letter heh doachashmee isolated formarabic letter heh doachashmee final formarabic letter heh doachashmee initial formarabic letter heh doachashmee medial formarabic letter yeh barree isolated formarabic letter yeh barree final form" + // U+FBA0
+		"arabic letter yeh barree with hamza above isolated formarabic letter yeh barree with hamza above final formarabic symbol dot abovearabic symbol dot belowarabic symbol two dots abovearabic symbol two dots belowarabic symbol three dots abovearabic symbol three dots belowarabic symbol three dots pointing downwards abovearabic symbol three dots pointing downwards belowarabic symbol four dots abovearabic symbol four dots belowarabic symbol double vertical bar belowarabic symbol two dots vertically abovearabic symbol two dots vertically belowarabic symbol ring" + // U+FBB0
+		"arabic symbol small tah abovearabic symbol small tah belowarabic symbol wasla above" + // U+FBC0
+		"arabic letter ng isolated formarabic letter ng final formarabic letter ng initial formarabic letter ng medial formarabic letter u isolated formarabic letter u final formarabic letter oe isolated formarabic letter oe final formarabic letter yu isolated formarabic letter yu final formarabic letter u with hamza above isolated formarabic letter ve isolated formarabic letter ve final form" + // U+FBD0
+		"arabic letter kirghiz oe isolated formarabic letter kirghiz oe final formarabic letter kirghiz yu isolated formarabic letter kirghiz yu final formarabic letter e isolated formarabic letter e final formarabic letter e initial formarabic letter e medial formarabic letter uighur kazakh kirghiz alef maksura initial formarabic letter uighur kazakh kirghiz alef maksura medial formarabic ligature yeh with hamza above with alef isolated formarabic ligature yeh with hamza above with alef final formarabic ligature yeh with hamza above with ae isolated formarabic ligature yeh with hamza above with ae final formarabic ligature yeh with hamza above with waw isolated formarabic ligature yeh with hamza above with waw final form" + // U+FBE0
+		"arabic ligature yeh with hamza above with u isolated formarabic ligature yeh with hamza above with u final formarabic ligature yeh with hamza above with oe isolated formarabic ligature yeh with hamza above with oe final formarabic ligature yeh with hamza above with yu isolated formarabic ligature yeh with hamza above with yu final formarabic ligature yeh with hamza above with e isolated formarabic ligature yeh with hamza above with e final formarabic ligature yeh with hamza above with e initial formarabic ligature uighur kirghiz yeh with hamza above with alef maksura isolated formarabic ligature uighur kirghiz yeh with hamza above with alef maksura final formarabic ligature uighur kirghiz yeh with hamza above with alef maksura initial formarabic letter farsi yeh isolated formarabic letter farsi yeh final formarabic letter farsi yeh initial formarabic letter farsi yeh medial form" + // U+FBF0
+		"arabic ligature yeh with hamza above with jeem isolated formarabic ligature yeh with hamza above with hah isolated formarabic ligature yeh with hamza above with meem isolated formarabic ligature yeh with hamza above with alef maksura isolated formarabic ligature yeh with hamza above with yeh isolated formarabic ligature beh with jeem isolated formarabic ligature beh with hah isolated formarabic ligature beh with khah isolated formarabic ligature beh with meem isolated formarabic ligature beh with alef maksura isolated formarabic ligature beh with yeh isolated formarabic ligature teh with jeem isolated formarabic ligature teh with hah isolated formarabic ligature teh with khah isolated formarabic ligature teh with meem isolated formarabic ligature teh with alef maksura isolated form" + // U+FC00
+		"arabic ligature teh with yeh isolated formarabic ligature theh with jeem isolated formarabic ligature theh with meem isolated formarabic ligature theh with alef maksura isolated formarabic ligature theh with yeh isolated formarabic ligature jeem with hah isolated formarabic ligature jeem with meem isolated formarabic ligature hah with jeem isolated formarabic ligature hah with meem isolated formarabic ligature khah with jeem isolated formarabic ligature khah with hah isolated formarabic ligature khah with meem isolated formarabic ligature seen with jeem isolated formarabic ligature seen with hah isolated formarabic ligature seen with khah isolated formarabic ligature seen with meem isolated form" + // U+FC10
+		"arabic ligature sad with hah isolated formarabic ligature sad with meem isolated formarabic ligature dad with jeem isolated formarabic ligature dad with hah isolated formarabic ligature dad with khah isolated formarabic ligature dad with meem isolated formarabic ligature tah with hah isolated formarabic ligature tah with meem isolated formarabic ligature zah with meem isolated formarabic ligature ain with jeem isolated formarabic ligature ain with meem isolated formarabic ligature ghain with jeem isolated formarabic ligature ghain with meem isolated formarabic ligature feh with jeem isolated formarabic ligature feh with hah isolated formarabic ligature feh with khah isolated form" + // U+FC20
+		"arabic ligature feh with meem isolated formarabic ligature feh with alef maksura isolated formarabic ligature feh with yeh isolated formarabic ligature qaf with hah isolated formarabic ligature qaf with meem isolated formarabic ligature qaf with alef maksura isolated formarabic ligature qaf with yeh isolated formarabic ligature kaf with alef isolated formarabic ligature kaf with jeem isolated formarabic ligature kaf with hah isolated formarabic ligature kaf with khah isolated formarabic ligature kaf with lam isolated formarabic ligature kaf with meem isolated formarabic ligature kaf with alef maksura isolated formarabic ligature kaf with yeh isolated formarabic ligature lam with jeem isolated form" + // U+FC30
+		"arabic ligature lam with hah isolated formarabic ligature lam with khah isolated formarabic ligature lam with meem isolated formarabic ligature lam with alef maksura isolated formarabic ligature lam with yeh isolated formarabic ligature meem with jeem isolated formarabic ligature meem with hah isolated formarabic ligature meem with khah isolated formarabic ligature meem with meem isolated formarabic ligature meem with alef maksura isolated formarabic ligature meem with yeh isolated formarabic ligature noon with jeem isolated formarabic ligature noon with hah isolated formarabic ligature noon with khah isolated formarabic ligature noon with meem isolated formarabic ligature noon with alef maksura isolated form" + // U+FC40
+		"arabic ligature noon with yeh isolated formarabic ligature heh with jeem isolated formarabic ligature heh with meem isolated formarabic ligature heh with alef maksura isolated formarabic ligature heh with yeh isolated formarabic ligature yeh with jeem isolated formarabic ligature yeh with hah isolated formarabic ligature yeh with khah isolated formarabic ligature yeh with meem isolated formarabic ligature yeh with alef maksura isolated formarabic ligature yeh with yeh isolated formarabic ligature thal with superscript alef isolated formarabic ligature reh with superscript alef isolated formarabic ligature alef maksura with superscript alef isolated formarabic ligature shadda with dammatan isolated formarabic ligature shadda with kasratan isolated form" + // U+FC50
+		"arabic ligature shadda with fatha isolated formarabic ligature shadda with damma isolated formarabic ligature shadda with kasra isolated formarabic ligature shadda with superscript alef isolated formarabic ligature yeh with hamza above with reh final formarabic ligature yeh with hamza above with zain final formarabic ligature yeh with hamza above with meem final formarabic ligature yeh with hamza above with noon final formarabic ligature yeh with hamza above with alef maksura final formarabic ligature yeh with hamza above with yeh final formarabic ligature beh with reh final formarabic ligature beh with zain final formarabic ligature beh with meem final formarabic ligature beh with noon final formarabic ligature beh with alef maksura final formarabic ligature beh with yeh final form" + // U+FC60
+		"arabic ligature teh with reh final formarabic ligature teh with zain final formarabic ligature teh with meem final formarabic ligature teh with noon final formarabic ligature teh with alef maksura final formarabic ligature teh with yeh final formarabic ligature theh with reh final formarabic ligature theh with zain final formarabic ligature theh with meem final formarabic ligature theh with noon final formarabic ligature theh with alef maksura final formarabic ligature theh with yeh final formarabic ligature feh with alef maksura final formarabic ligature feh with yeh final formarabic ligature qaf with alef maksura final formarabic ligature qaf with yeh final form" + // U+FC70
+		"arabic ligature kaf with alef final formarabic ligature kaf with lam final formarabic ligature kaf with meem final formarabic ligature kaf with alef maksura final formarabic ligature kaf with yeh final formarabic ligature lam with meem final formarabic ligature lam with alef maksura final formarabic ligature lam with yeh final formarabic ligature meem with alef final formarabic ligature meem with meem final formarabic ligature noon with reh final formarabic ligature noon with zain final formarabic ligature noon with meem final formarabic ligature noon with noon final formarabic ligature noon with alef maksura final formarabic ligature noon with yeh final form" + // U+FC80
+		"arabic ligature alef maksura with superscript alef final formarabic ligature yeh with reh final formarabic ligature yeh with zain final formarabic ligature yeh with meem final formarabic ligature yeh with noon final formarabic ligature yeh with alef maksura final formarabic ligature yeh with yeh final formarabic ligature yeh with hamza above with jeem initial formarabic ligature yeh with hamza above with hah initial formarabic ligature yeh with hamza above with khah initial formarabic ligature yeh with hamza above with meem initial formarabic ligature yeh with hamza above with heh initial formarabic ligature beh with jeem initial formarabic ligature beh with hah initial formarabic ligature beh with khah initial formarabic ligature beh with meem initial form" + // U+FC90
+		"arabic ligature beh with heh initial formarabic ligature teh with jeem initial formarabic ligature teh with hah initial formarabic ligature teh with khah initial formarabic ligature teh with meem initial formarabic ligature teh with heh initial formarabic ligature theh with meem initial formarabic ligature jeem with hah initial formarabic ligature jeem with meem initial formarabic ligature hah with jeem initial formarabic ligature hah with meem initial formarabic ligature khah with jeem initial formarabic ligature khah with meem initial formarabic ligature seen with jeem initial formarabic ligature seen with hah initial formarabic ligature seen with khah initial form" + // U+FCA0
+		"arabic ligature seen with meem initial formarabic ligature sad with hah initial formarabic ligature sad with khah initial formarabic ligature sad with meem initial formarabic ligature dad with jeem initial formarabic ligature dad with hah initial formarabic ligature dad with khah initial formarabic ligature dad with meem initial formarabic ligature tah with hah initial formarabic ligature zah with meem initial formarabic ligature ain with jeem initial formarabic ligature ain with meem initial formarabic ligature ghain with jeem initial formarabic ligature ghain with meem initial formarabic ligature feh with jeem initial formarabic ligature feh with hah initial form" + // U+FCB0
+		"arabic ligature feh with khah initial formarabic ligature feh with meem initial formarabic ligature qaf with hah initial formarabic ligature qaf with meem initial formarabic ligature kaf with jeem initial formarabic ligature kaf with hah initial formarabic ligature kaf with khah initial formarabic ligature kaf with lam initial formarabic ligature kaf with meem initial formarabic ligature lam with jeem initial formarabic ligature lam with hah initial formarabic ligature lam with khah initial formarabic ligature lam with meem initial formarabic ligature lam with heh initial formarabic ligature meem with jeem initial formarabic ligature meem with hah initial form" + // U+FCC0
+		"arabic ligature meem with khah initial formarabic ligature meem with meem initial formarabic ligature noon with jeem initial formarabic ligature noon with hah initial formarabic ligature noon with khah initial formarabic ligature noon with meem initial formarabic ligature noon with heh initial formarabic ligature heh with jeem initial formarabic ligature heh with meem initial formarabic ligature heh with superscript alef initial formarabic ligature yeh with jeem initial formarabic ligature yeh with hah initial formarabic ligature yeh with khah initial formarabic ligature yeh with meem initial formarabic ligature yeh with heh initial formarabic ligature yeh with hamza above with meem medial form" + // U+FCD0
+		"arabic ligature yeh with hamza above with heh medial formarabic ligature beh with meem medial formarabic ligature beh with heh medial formarabic ligature teh with meem medial formarabic ligature teh with heh medial formarabic ligature theh with meem medial formarabic ligature theh with heh medial formarabic ligature seen with meem medial formarabic ligature seen with heh medial formarabic ligature sheen with meem medial formarabic ligature sheen with heh medial formarabic ligature kaf with lam medial formarabic ligature kaf with meem medial formarabic ligature lam with meem medial formarabic ligature noon with meem medial formarabic ligature noon with heh medial form" + // U+FCE0
+		"arabic ligature yeh with meem medial formarabic ligature yeh with heh medial formarabic ligature shadda with fatha medial formarabic ligature shadda with damma medial formarabic ligature shadda with kasra medial formarabic ligature tah with alef maksura isolated formarabic ligature tah with yeh isolated formarabic ligature ain with alef maksura isolated formarabic ligature ain with yeh isolated formarabic ligature ghain with alef maksura isolated formarabic ligature ghain with yeh isolated formarabic ligature seen with alef maksura isolated formarabic ligature seen with yeh isolated formarabic ligature sheen with alef maksura isolated formarabic ligature sheen with yeh isolated formarabic ligature hah with alef maksura isolated form" + // U+FCF0
+		"arabic ligature hah with yeh isolated formarabic ligature jeem with alef maksura isolated formarabic ligature jeem with yeh isolated formarabic ligature khah with alef maksura isolated formarabic ligature khah with yeh isolated formarabic ligature sad with alef maksura isolated formarabic ligature sad with yeh isolated formarabic ligature dad with alef maksura isolated formarabic ligature dad with yeh isolated formarabic ligature sheen with jeem isolated formarabic ligature sheen with hah isolated formarabic ligature sheen with khah isolated formarabic ligature sheen with meem isolated formarabic ligature sheen with reh isolated formarabic ligature seen with reh isolated formarabic ligature sad with reh isolated form" + // U+FD00
+		"arabic ligature dad with reh isolated formarabic ligature tah with alef maksura final formarabic ligature tah with yeh final formarabic ligature ain with alef maksura final formarabic ligature ain with yeh final formarabic ligature ghain with alef maksura final formarabic ligature ghain with yeh final formarabic ligature seen with alef maksura final formarabic ligature seen with yeh final formarabic ligature sheen with alef maksura final formarabic ligature sheen with yeh final formarabic ligature hah with alef maksura final formarabic ligature hah with yeh final formarabic ligature jeem with alef maksura final formarabic ligature jeem with yeh final formarabic ligature khah with alef maksura final form" + // U+FD10
+		"arabic ligature khah with yeh final formarabic ligature sad with alef maksura final formarabic ligature sad with yeh final formarabic ligature dad with alef maksura final formarabic ligature dad with yeh final formarabic ligature sheen with jeem final formarabic ligature sheen with hah final formarabic ligature sheen with khah final formarabic ligature sheen with meem final formarabic ligature sheen with reh final formarabic ligature seen with reh final formarabic ligature sad with reh final formarabic ligature dad with reh final formarabic ligature sheen with jeem initial formarabic ligature sheen with hah initial formarabic ligature sheen with khah initial form" + // U+FD20
+		"arabic ligature sheen with meem initial formarabic ligature seen with heh initial formarabic ligature sheen with heh initial formarabic ligature tah with meem initial formarabic ligature seen with jeem medial formarabic ligature seen with hah medial formarabic ligature seen with khah medial formarabic ligature sheen with jeem medial formarabic ligature sheen with hah medial formarabic ligature sheen with khah medial formarabic ligature tah with meem medial formarabic ligature zah with meem medial formarabic ligature alef with fathatan final formarabic ligature alef with fathatan isolated formornate left parenthesisornate right parenthesis" + // U+FD30
+		"arabic ligature rahimahu allaaharabic ligature radi allaahu anharabic ligature radi allaahu anhaaarabic ligature radi allaahu anhumarabic ligature radi allaahu anhumaaarabic ligature radi allaahu anhunnaarabic ligature sallallaahu alayhi wa-aaliharabic ligature alayhi as-salaamarabic ligature alayhim as-salaamarabic ligature alayhimaa as-salaamarabic ligature alayhi as-salaatu was-salaamarabic ligature quddisa sirraharabic ligature sallallahu alayhi waaalihee wa-sallamarabic ligature alayhaa as-salaamarabic ligature tabaaraka wa-taaalaaarabic ligature rahimahum allaah" + // U+FD40
+		"arabic ligature teh with jeem with meem initial formarabic ligature teh with hah with jeem final formarabic ligature teh with hah with jeem initial formarabic ligature teh with hah with meem initial formarabic ligature teh with khah with meem initial formarabic ligature teh with meem with jeem initial formarabic ligature teh with meem with hah initial formarabic ligature teh with meem with khah initial formarabic ligature jeem with meem with hah final formarabic ligature jeem with meem with hah initial formarabic ligature hah with meem with yeh final formarabic ligature hah with meem with alef maksura final formarabic ligature seen with hah with jeem initial formarabic ligature seen with jeem with hah initial formarabic ligature seen with jeem with alef maksura final formarabic ligature seen with meem with hah final form" + // U+FD50
+		"arabic ligature seen with meem with hah initial formarabic ligature seen with meem with jeem initial formarabic ligature seen with meem with meem final formarabic ligature seen with meem with meem initial formarabic ligature sad with hah with hah final formarabic ligature sad with hah with hah initial formarabic ligature sad with meem with meem final formarabic ligature sheen with hah with meem final formarabic ligature sheen with hah with meem initial formarabic ligature sheen with jeem with yeh final formarabic ligature sheen with meem with khah final formarabic ligature sheen with meem with khah initial formarabic ligature sheen with meem with meem final formarabic ligature sheen with meem with meem initial formarabic ligature dad with hah with alef maksura final formarabic ligature dad with khah with meem final form" + // U+FD60
+		"arabic ligature dad with khah with meem initial formarabic ligature tah with meem with hah final formarabic ligature tah with meem with hah initial formarabic ligature tah with meem with meem initial formarabic ligature tah with meem with yeh final formarabic ligature ain with jeem with meem final formarabic ligature ain with meem with meem final formarabic ligature ain with meem with meem initial formarabic ligature ain with meem with alef maksura final formarabic ligature ghain with meem with meem final formarabic ligature ghain with meem with yeh final formarabic ligature ghain with meem with alef maksura final formarabic ligature feh with khah with meem final formarabic ligature feh with khah with meem initial formarabic ligature qaf with meem with hah final formarabic ligature qaf with meem with meem final form" + // U+FD70
+		"arabic ligature lam with hah with meem final formarabic ligature lam with hah with yeh final formarabic ligature lam with hah with alef maksura final formarabic ligature lam with jeem with jeem initial formarabic ligature lam with jeem with jeem final formarabic ligature lam with khah with meem final formarabic ligature lam with khah with meem initial formarabic ligature lam with meem with hah final formarabic ligature lam with meem with hah initial formarabic ligature meem with hah with jeem initial formarabic ligature meem with hah with meem initial formarabic ligature meem with hah with yeh final formarabic ligature meem with jeem with hah initial formarabic ligature meem with jeem with meem initial formarabic ligature meem with khah with jeem initial formarabic ligature meem with khah with meem initial form" + // U+FD80
+		"arabic ligature meem with jeem with khah initial formarabic ligature heh with meem with jeem initial formarabic ligature heh with meem with meem initial formarabic ligature noon with hah with meem initial formarabic ligature noon with hah with alef maksura final formarabic ligature noon with jeem with meem final formarabic ligature noon with jeem with meem initial formarabic ligature noon with jeem with alef maksura final formarabic ligature noon with meem with yeh final formarabic ligature noon with meem with alef maksura final formarabic ligature yeh with meem with meem final formarabic ligature yeh with meem with meem initial formarabic ligature beh with khah with yeh final formarabic ligature teh with jeem with yeh final form" + // U+FD90
+		"arabic ligature teh with jeem with alef maksura final formarabic ligature teh with khah with yeh final formarabic ligature teh with khah with alef maksura final formarabic ligature teh with meem with yeh final formarabic ligature teh with meem with alef maksura final formarabic ligature jeem with meem with yeh final formarabic ligature jeem with hah with alef maksura final formarabic ligature jeem with meem with alef maksura final formarabic ligature seen with khah with alef maksura final formarabic ligature sad with hah with yeh final formarabic ligature sheen with hah with yeh final formarabic ligature dad with hah with yeh final formarabic ligature lam with jeem with yeh final formarabic ligature lam with meem with yeh final formarabic ligature yeh with hah with yeh final formarabic ligature yeh with jeem with yeh final form" + // U+FDA0
+		"arabic ligature yeh with meem with yeh final formarabic ligature meem with meem with yeh final formarabic ligature qaf with meem with yeh final formarabic ligature noon with hah with yeh final formarabic ligature qaf with meem with hah initial formarabic ligature lam with hah with meem initial formarabic ligature ain with meem with yeh final formarabic ligature kaf with meem with yeh final formarabic ligature noon with jeem with hah initial formarabic ligature meem with khah with yeh final formarabic ligature lam with jeem with meem initial formarabic ligature kaf with meem with meem final formarabic ligature lam with jeem with meem final formarabic ligature noon with jeem with hah final formarabic ligature jeem with hah with yeh final formarabic ligature hah with jeem with yeh final form" + // U+FDB0
+		"arabic ligature meem with jeem with yeh final formarabic ligature feh with meem with yeh final formarabic ligature beh with hah with yeh final formarabic ligature kaf with meem with meem initial formarabic ligature ain with jeem with meem initial formarabic ligature sad with meem with meem initial formarabic ligature seen with khah with yeh final formarabic ligature noon with jeem with yeh final formarabic ligature salaamuhu alaynaa" + // U+FDC0
+		"arabic ligature salla used as koranic stop sign isolated formarabic ligature qala used as koranic stop sign isolated formarabic ligature allah isolated formarabic ligature akbar isolated formarabic ligature mohammad isolated formarabic ligature salam isolated formarabic ligature rasoul isolated formarabic ligature alayhe isolated formarabic ligature wasallam isolated formarabic ligature salla isolated formarabic ligature sallallahou alayhe wasallamarabic ligature jallajalalouhourial signarabic ligature bismillah ar-rahman ar-raheemarabic ligature subhaanahu wa taaalaaarabic ligature azza wa jall" + // U+FDF0
+		"presentation form for vertical commapresentation form for vertical ideographic commapresentation form for vertical ideographic full stoppresentation form for vertical colonpresentation form for vertical semicolonpresentation form for vertical exclamation markpresentation form for vertical question markpresentation form for vertical left white lenticular bracketpresentation form for vertical right white lenticular brakcetpresentation form for vertical horizontal ellipsis" + // U+FE10
+		"sesame dotwhite sesame dotpresentation form for vertical left square bracketpresentation form for vertical right square bracketdashed overlinecentreline overlinewavy overlinedouble wavy overlinedashed low linecentreline low linewavy low line" + // U+FE40
+		"arabic fathatan isolated formarabic tatweel with fathatan abovearabic dammatan isolated formarabic tail fragmentarabic kasratan isolated formarabic fatha isolated formarabic fatha medial formarabic damma isolated formarabic damma medial formarabic kasra isolated formarabic kasra medial formarabic shadda isolated formarabic shadda medial formarabic sukun isolated formarabic sukun medial form" + // U+FE70
+		"arabic letter hamza isolated formarabic letter alef with madda above isolated formarabic letter alef with madda above final formarabic letter alef with hamza above isolated formarabic letter alef with hamza above final formarabic letter waw with hamza above isolated formarabic letter waw with hamza above final formarabic letter alef with hamza below isolated formarabic letter alef with hamza below final formarabic letter yeh with hamza above isolated formarabic letter yeh with hamza above final formarabic letter yeh with hamza above initial formarabic letter yeh with hamza above medial formarabic letter alef isolated formarabic letter alef final formarabic letter beh isolated form" + // U+FE80
+		"arabic letter beh final formarabic letter beh initial formarabic letter beh medial formarabic letter teh marbuta isolated formarabic letter teh marbuta final formarabic letter teh isolated formarabic letter teh final formarabic letter teh initial formarabic letter teh medial formarabic letter theh isolated formarabic letter theh final formarabic letter theh initial formarabic letter theh medial formarabic letter jeem isolated formarabic letter jeem final formarabic letter jeem initial form" + // U+FE90
+		"arabic letter jeem medial formarabic letter hah isolated formarabic letter hah final formarabic letter hah initial formarabic letter hah medial formarabic letter khah isolated formarabic letter khah final formarabic letter khah initial formarabic letter khah medial formarabic letter dal isolated formarabic letter dal final formarabic letter thal isolated formarabic letter thal final formarabic letter reh isolated formarabic letter reh final formarabic letter zain isolated form" + // U+FEA0
+		"arabic letter zain final formarabic letter seen isolated formarabic letter seen final formarabic letter seen initial formarabic letter seen medial formarabic letter sheen isolated formarabic letter sheen final formarabic letter sheen initial formarabic letter sheen medial formarabic letter sad isolated formarabic letter sad final formarabic letter sad initial formarabic letter sad medial formarabic letter dad isolated formarabic letter dad final formarabic letter dad initial form" + // U+FEB0
+		"arabic letter dad medial formarabic letter tah isolated formarabic letter tah final formarabic letter tah initial formarabic letter tah medial formarabic letter zah isolated formarabic letter zah final formarabic letter zah initial formarabic letter zah medial formarabic letter ain isolated formarabic letter ain final formarabic letter ain initial formarabic letter ain medial formarabic letter ghain isolated formarabic letter ghain final formarabic letter ghain initial form" + // U+FEC0
+		"arabic letter ghain medial formarabic letter feh isolated formarabic letter feh final formarabic letter feh initial formarabic letter feh medial formarabic letter qaf isolated formarabic letter qaf final formarabic letter qaf initial formarabic letter qaf medial formarabic letter kaf isolated formarabic letter kaf final formarabic letter kaf initial formarabic letter kaf medial formarabic letter lam isolated formarabic letter lam final formarabic letter lam initial form" + // U+FED0
+		"arabic letter lam medial formarabic letter meem isolated formarabic letter meem final formarabic letter meem initial formarabic letter meem medial formarabic letter noon isolated formarabic letter noon final formarabic letter noon initial formarabic letter noon medial formarabic letter heh isolated formarabic letter heh final formarabic letter heh initial formarabic letter heh medial formarabic letter waw isolated formarabic letter waw final formarabic letter alef maksura isolated form" + // U+FEE0
+		"arabic letter alef maksura final formarabic letter yeh isolated formarabic letter yeh final formarabic letter yeh initial formarabic letter yeh medial formarabic ligature lam with alef with madda above isolated formarabic ligature lam with alef with madda above final formarabic ligature lam with alef with hamza above isolated formarabic ligature lam with alef with hamza above final formarabic ligature lam with alef with hamza below isolated formarabic ligature lam with alef with hamza below final formarabic ligature lam with alef isolated formarabic ligature lam with alef final form" + // U+FEF0
+		"fullwidth left white parenthesis" + // U+FF50
+		"fullwidth right white parenthesis" + // U+FF60
+		"halfwidth hangul filler" + // U+FFA0
+		"halfwidth hangul letter ieung" + // U+FFB0
+		"object replacement characterreplacement character" + // U+FFF0
+		"linear b symbol b018linear b symbol b019linear b symbol b022linear b symbol b034linear b symbol b047linear b symbol b049linear b symbol b056linear b symbol b063linear b symbol b064linear b symbol b079linear b symbol b082linear b symbol b083linear b symbol b086linear b symbol b089" + // U+10050
+		"linear b ideogram b100 manlinear b ideogram b102 womanlinear b ideogram b104 deerlinear b ideogram b105 equidlinear b ideogram b105f marelinear b ideogram b105m stallionlinear b ideogram b106f ewelinear b ideogram b106m ramlinear b ideogram b107f she-goatlinear b ideogram b107m he-goatlinear b ideogram b108f sowlinear b ideogram b108m boarlinear b ideogram b109f cowlinear b ideogram b109m bulllinear b ideogram b120 wheatlinear b ideogram b121 barley" + // U+10080
+		"linear b ideogram b122 olivelinear b ideogram b123 spicelinear b ideogram b125 cyperuslinear b monogram b127 kapolinear b monogram b128 kanakolinear b ideogram b130 oillinear b ideogram b131 winelinear b ideogram b132linear b monogram b133 arepalinear b monogram b135 merilinear b ideogram b140 bronzelinear b ideogram b141 goldlinear b ideogram b142linear b ideogram b145 woollinear b ideogram b146linear b ideogram b150" + // U+10090
+		"linear b ideogram b151 hornlinear b ideogram b152linear b ideogram b153linear b ideogram b154linear b monogram b156 turo2linear b ideogram b157linear b ideogram b158linear b ideogram b159 clothlinear b ideogram b160linear b ideogram b161linear b ideogram b162 garmentlinear b ideogram b163 armourlinear b ideogram b164linear b ideogram b165linear b ideogram b166linear b ideogram b167" + // U+100A0
+		"linear b ideogram b168linear b ideogram b169linear b ideogram b170linear b ideogram b171linear b ideogram b172linear b ideogram b173 monthlinear b ideogram b174linear b ideogram b176 treelinear b ideogram b177linear b ideogram b178linear b ideogram b179linear b ideogram b180linear b ideogram b181linear b ideogram b182linear b ideogram b183linear b ideogram b184" + // U+100B0
+		"linear b ideogram b185linear b ideogram b189linear b ideogram b190linear b ideogram b191 helmetlinear b ideogram b220 footstoollinear b ideogram b225 bathtublinear b ideogram b230 spearlinear b ideogram b231 arrowlinear b ideogram b232linear b ideogram b233 swordlinear b ideogram b234linear b ideogram b236linear b ideogram b240 wheeled chariotlinear b ideogram b241 chariotlinear b ideogram b242 chariot framelinear b ideogram b243 wheel" + // U+100C0
+		"linear b ideogram b245linear b ideogram b246linear b monogram b247 diptelinear b ideogram b248linear b ideogram b249linear b ideogram b251linear b ideogram b252linear b ideogram b253linear b ideogram b254 dartlinear b ideogram b255linear b ideogram b256linear b ideogram b257linear b ideogram b258linear b ideogram b259linear b ideogram vessel b155linear b ideogram vessel b200" + // U+100D0
+		"linear b ideogram vessel b201linear b ideogram vessel b202linear b ideogram vessel b203linear b ideogram vessel b204linear b ideogram vessel b205linear b ideogram vessel b206linear b ideogram vessel b207linear b ideogram vessel b208linear b ideogram vessel b209linear b ideogram vessel b210linear b ideogram vessel b211linear b ideogram vessel b212linear b ideogram vessel b213linear b ideogram vessel b214linear b ideogram vessel b215linear b ideogram vessel b216" + // U+100E0
+		"linear b ideogram vessel b217linear b ideogram vessel b218linear b ideogram vessel b219linear b ideogram vessel b221linear b ideogram vessel b222linear b ideogram vessel b226linear b ideogram vessel b227linear b ideogram vessel b228linear b ideogram vessel b229linear b ideogram vessel b250linear b ideogram vessel b305" + // U+100F0
+		"aegean word separator lineaegean word separator dotaegean check mark" + // U+10100
+		"aegean weight base unitaegean weight first subunitaegean weight second subunitaegean weight third subunitaegean weight fourth subunitaegean dry measure first subunitaegean liquid measure first subunitaegean measure second subunitaegean measure third subunit" + // U+10130
+		"greek year signgreek talent signgreek drachma signgreek obol signgreek two obols signgreek three obols signgreek four obols sign" + // U+10170
+		"greek five obols signgreek metretes signgreek kyathos base signgreek litra signgreek ounkia signgreek xestes signgreek artabe signgreek aroura signgreek gramma signgreek tryblion base signgreek sinusoid signgreek indiction signnomisma sign" + // U+10180
+		"roman sextans signroman uncia signroman semuncia signroman sextula signroman dimidia sextula signroman siliqua signroman denarius signroman quinarius signroman sestertius signroman dupondius signroman as signroman centurial signascia symbol" + // U+10190
+		"greek symbol tau rho" + // U+101A0
+		"phaistos disc sign pedestrianphaistos disc sign plumed headphaistos disc sign tattooed headphaistos disc sign captivephaistos disc sign childphaistos disc sign womanphaistos disc sign helmetphaistos disc sign gauntletphaistos disc sign tiaraphaistos disc sign arrowphaistos disc sign bowphaistos disc sign shieldphaistos disc sign clubphaistos disc sign manaclesphaistos disc sign mattockphaistos disc sign saw" + // U+101D0
+		"phaistos disc sign lidphaistos disc sign boomerangphaistos disc sign carpentry planephaistos disc sign doliumphaistos disc sign combphaistos disc sign slingphaistos disc sign columnphaistos disc sign beehivephaistos disc sign shipphaistos disc sign hornphaistos disc sign hidephaistos disc sign bulls legphaistos disc sign catphaistos disc sign ramphaistos disc sign eaglephaistos disc sign dove" + // U+101E0
+		"phaistos disc sign tunnyphaistos disc sign beephaistos disc sign plane treephaistos disc sign vinephaistos disc sign papyrusphaistos disc sign rosettephaistos disc sign lilyphaistos disc sign ox backphaistos disc sign flutephaistos disc sign graterphaistos disc sign strainerphaistos disc sign small axephaistos disc sign wavy band" + // U+101F0
+		"ugaritic word divider" + // U+10390
+		"old persian word divider" + // U+103D0
+		"shavian letter dead" + // U+10450
+		"caucasian albanian citation mark" + // U+10560
+		"imperial aramaic section sign" + // U+10850
+		"palmyrene left-pointing fleuronpalmyrene right-pointing fleuron" + // U+10870
+		"phoenician word separator" + // U+10910
+		"lydian triangular mark" + // U+10930
+		"meroitic hieroglyphic symbol vidjmeroitic hieroglyphic symbol vidj-2" + // U+10990
+		"kharoshthi punctuation dotkharoshthi punctuation small circlekharoshthi punctuation circlekharoshthi punctuation crescent barkharoshthi punctuation mangalamkharoshthi punctuation lotuskharoshthi punctuation dandakharoshthi punctuation double dandakharoshthi punctuation lines" + // U+10A50
+		"old south arabian numeric indicator" + // U+10A70
+		"manichaean sign ud" + // U+10AC0
+		"manichaean punctuation starmanichaean punctuation fleuronmanichaean punctuation double dot within dotmanichaean punctuation dot within dotmanichaean punctuation dotmanichaean punctuation two dotsmanichaean punctuation line filler" + // U+10AF0
+		"avestan abbreviation marktiny two dots over one dot punctuationsmall two dots over one dot punctuationlarge two dots over one dot punctuationlarge one dot over two dots punctuationlarge two rings over one ring punctuationlarge one ring over two rings punctuation" + // U+10B30
+		"psalter pahlavi section markpsalter pahlavi turned section markpsalter pahlavi four dots with crosspsalter pahlavi four dots with dot" + // U+10B90
+		"hanifi rohingya mark sakinhanifi rohingya mark na khonna" + // U+10D20
+		"yezidi hyphenation mark" + // U+10EA0
+		"sogdian independent shin" + // U+10F40
+		"sogdian punctuation two vertical barssogdian punctuation two vertical bars with dotssogdian punctuation circle with dotsogdian punctuation two circles with dotssogdian punctuation half circle with dot" + // U+10F50
+		"old uyghur punctuation barold uyghur punctuation two barsold uyghur punctuation two dotsold uyghur punctuation four dots" + // U+10F80
+		"brahmi dandabrahmi double dandabrahmi punctuation dotbrahmi punctuation double dotbrahmi punctuation linebrahmi punctuation crescent barbrahmi punctuation lotus" + // U+11040
+		"kaithi abbreviation signkaithi enumeration signkaithi section markkaithi double section mark" + // U+110B0
+		"kaithi dandakaithi double danda" + // U+110C0
+		"chakma section markchakma dandachakma double dandachakma question mark" + // U+11140
+		"mahajani abbreviation signmahajani section mark" + // U+11170
+		"sharada omsharada dandasharada double dandasharada abbreviation signsharada separatorsharada sutra mark" + // U+111C0
+		"sharada ekamsharada sign siddhamsharada headstrokesharada continuation signsharada section mark-1sharada section mark-2" + // U+111D0
+		"khojki dandakhojki double dandakhojki word separatorkhojki section markkhojki double section markkhojki abbreviation sign" + // U+11230
+		"multani section mark" + // U+112A0
+		"grantha om" + // U+11350
+		"newa omnewa siddhinewa dandanewa double dandanewa commanewa gap fillernewa abbreviation sign" + // U+11440
+		"newa double commanewa placeholder marknewa insertion sign" + // U+11450
+		"tirhuta anji" + // U+11480
+		"tirhuta gvangtirhuta abbreviation signtirhuta om" + // U+114C0
+		"siddham sign siddhamsiddham dandasiddham double dandasiddham separator dotsiddham separator barsiddham repetition mark-1siddham repetition mark-2siddham repetition mark-3siddham end of text marksiddham section mark with trident and u-shaped ornamentssiddham section mark with trident and dotted crescentssiddham section mark with rays and dotted crescentssiddham section mark with rays and dotted double crescentssiddham section mark with rays and dotted triple crescentssiddham section mark double ring" + // U+115C0
+		"siddham section mark double ring with rayssiddham section mark with double crescentssiddham section mark with triple crescentssiddham section mark with quadruple crescentssiddham section mark with septuple crescentssiddham section mark with circles and rayssiddham section mark with circles and two enclosuressiddham section mark with circles and four enclosures" + // U+115D0
+		"modi dandamodi double dandamodi abbreviation sign" + // U+11640
+		"mongolian birga with ornamentmongolian rotated birgamongolian double birga with ornamentmongolian triple birga with ornamentmongolian birga with double ornamentmongolian rotated birga with ornamentmongolian rotated birga with double ornamentmongolian inverted birgamongolian inverted birga with double ornamentmongolian swirl birgamongolian swirl birga with ornamentmongolian swirl birga with double ornamentmongolian turned swirl birga with double ornament" + // U+11660
+		"takri abbreviation sign" + // U+116B0
+		"ahom sign small sectionahom sign sectionahom sign rulaiahom symbol vi" + // U+11730
+		"dogra abbreviation sign" + // U+11830
+		"warang citi om" + // U+118F0
+		"dives akuru prefixed nasal sign" + // U+11930
+		"dives akuru initial radives akuru double dandadives akuru gap fillerdives akuru end of text mark" + // U+11940
+		"nandinagari sign siddhamnandinagari headstroke" + // U+119E0
+		"zanabazar square initial head mark" + // U+11A30
+		"zanabazar square closing head markzanabazar square mark tshegzanabazar square mark shadzanabazar square mark double shadzanabazar square mark long tshegzanabazar square initial double-lined head markzanabazar square closing double-lined head mark" + // U+11A40
+		"soyombo mark tshegsoyombo mark shadsoyombo mark double shadsoyombo mark plutasoyombo head mark with moon and sun and triple flamesoyombo head mark with moon and sun and flame" + // U+11A90
+		"soyombo head mark with moon and sunsoyombo terminal mark-1soyombo terminal mark-2" + // U+11AA0
+		"pau cin hau rising tone longpau cin hau rising tonepau cin hau sandhi glottal stoppau cin hau rising tone long finalpau cin hau rising tone finalpau cin hau sandhi glottal stop finalpau cin hau sandhi tone longpau cin hau sandhi tonepau cin hau sandhi tone long finalpau cin hau sandhi tone finalpau cin hau mid-level tone" + // U+11AE0
+		"pau cin hau glottal stop variantpau cin hau mid-level tone long finalpau cin hau mid-level tone finalpau cin hau low-falling tone longpau cin hau low-falling tonepau cin hau glottal stoppau cin hau low-falling tone long finalpau cin hau low-falling tone finalpau cin hau glottal stop final" + // U+11AF0
+		"devanagari head markdevanagari head mark with headstrokedevanagari sign bhaledevanagari sign bhale with hookdevanagari sign extended bhaledevanagari sign extended bhale with hookdevanagari sign western five-like bhaledevanagari sign western nine-like bhaledevanagari sign reversed nine-like bhaledevanagari sign mindu" + // U+11B00
+		"bhaiksuki dandabhaiksuki double dandabhaiksuki word separatorbhaiksuki gap filler-1bhaiksuki gap filler-2" + // U+11C40
+		"marchen head markmarchen mark shad" + // U+11C70
+		"masaram gondi repha" + // U+11D40
+		"gunjala gondi om" + // U+11D90
+		"makasar angkamakasar passimbangmakasar end of section" + // U+11EF0
+		"kawi dandakawi double dandakawi punctuation section markerkawi punctuation alternate section markerkawi punctuation flowerkawi punctuation space fillerkawi punctuation dotkawi punctuation double dotkawi punctuation triple dotkawi punctuation circlekawi punctuation filled circlekawi punctuation spiralkawi punctuation closing spiral" + // U+11F40
+		"tamil sign neltamil sign cevitutamil sign aazhaakkutamil sign uzhakkutamil sign muuvuzhakkutamil sign kurunitamil sign pathakkutamil sign mukkurunitamil sign kaacutamil sign panamtamil sign pon" + // U+11FD0
+		"tamil sign varaakantamil sign paaramtamil sign kuzhitamil sign velitamil wet cultivation signtamil dry cultivation signtamil land signtamil salt pan signtamil traditional credit signtamil traditional number signtamil current signtamil and odd signtamil spent signtamil total signtamil in possession signtamil starting from sign" + // U+11FE0
+		"tamil sign muthaliyatamil sign vakaiyaraatamil punctuation end of text" + // U+11FF0
+		"cuneiform punctuation sign old assyrian word dividercuneiform punctuation sign vertical coloncuneiform punctuation sign diagonal coloncuneiform punctuation sign diagonal tricoloncuneiform punctuation sign diagonal quadcolon" + // U+12470
+		"cypro-minoan sign cm301cypro-minoan sign cm302" + // U+12FF0
+		"mro dandamro double danda" + // U+16A60
+		"bassa vah full stop" + // U+16AF0
+		"pahawh hmong sign vos thompahawh hmong sign vos tshab ceebpahawh hmong sign cim cheempahawh hmong sign vos thiabpahawh hmong sign vos feempahawh hmong sign xyeem ntxivpahawh hmong sign xyeem rhopahawh hmong sign xyeem tovpahawh hmong sign xyeem faib" + // U+16B30
+		"pahawh hmong sign xauspahawh hmong sign cim tsov rog" + // U+16B40
+		"medefaidrin commamedefaidrin full stopmedefaidrin symbol aivamedefaidrin exclamation oh" + // U+16E90
+		"tangut iteration marknushu iteration markold chinese hook markold chinese iteration mark" + // U+16FE0
+		"tangut component-001tangut component-002tangut component-003tangut component-004tangut component-005tangut component-006tangut component-007tangut component-008tangut component-009tangut component-010tangut component-011tangut component-012tangut component-013tangut component-014tangut component-015tangut component-016" + // U+18800
+		"tangut component-017tangut component-018tangut component-019tangut component-020tangut component-021tangut component-022tangut component-023tangut component-024tangut component-025tangut component-026tangut component-027tangut component-028tangut component-029tangut component-030tangut component-031tangut component-032" + // U+18810
+		"tangut component-033tangut component-034tangut component-035tangut component-036tangut component-037tangut component-038tangut component-039tangut component-040tangut component-041tangut component-042tangut component-043tangut component-044tangut component-045tangut component-046tangut component-047tangut component-048" + // U+18820
+		"tangut component-049tangut component-050tangut component-051tangut component-052tangut component-053tangut component-054tangut component-055tangut component-056tangut component-057tangut component-058tangut component-059tangut component-060tangut component-061tangut component-062tangut component-063tangut component-064" + // U+18830
+		"tangut component-065tangut component-066tangut component-067tangut component-068tangut component-069tangut component-070tangut component-071tangut component-072tangut component-073tangut component-074tangut component-075tangut component-076tangut component-077tangut component-078tangut component-079tangut component-080" + // U+18840
+		"tangut component-081tangut component-082tangut component-083tangut component-084tangut component-085tangut component-086tangut component-087tangut component-088tangut component-089tangut component-090tangut component-091tangut component-092tangut component-093tangut component-094tangut component-095tangut component-096" + // U+18850
+		"tangut component-097tangut component-098tangut component-099tangut component-100tangut component-101tangut component-102tangut component-103tangut component-104tangut component-105tangut component-106tangut component-107tangut component-108tangut component-109tangut component-110tangut component-111tangut component-112" + // U+18860
+		"tangut component-113tangut component-114tangut component-115tangut component-116tangut component-117tangut component-118tangut component-119tangut component-120tangut component-121tangut component-122tangut component-123tangut component-124tangut component-125tangut component-126tangut component-127tangut component-128" + // U+18870
+		"tangut component-129tangut component-130tangut component-131tangut component-132tangut component-133tangut component-134tangut component-135tangut component-136tangut component-137tangut component-138tangut component-139tangut component-140tangut component-141tangut component-142tangut component-143tangut component-144" + // U+18880
+		"tangut component-145tangut component-146tangut component-147tangut component-148tangut component-149tangut component-150tangut component-151tangut component-152tangut component-153tangut component-154tangut component-155tangut component-156tangut component-157tangut component-158tangut component-159tangut component-160" + // U+18890
+		"tangut component-161tangut component-162tangut component-163tangut component-164tangut component-165tangut component-166tangut component-167tangut component-168tangut component-169tangut component-170tangut component-171tangut component-172tangut component-173tangut component-174tangut component-175tangut component-176" + // U+188A0
+		"tangut component-177tangut component-178tangut component-179tangut component-180tangut component-181tangut component-182tangut component-183tangut component-184tangut component-185tangut component-186tangut component-187tangut component-188tangut component-189tangut component-190tangut component-191tangut component-192" + // U+188B0
+		"tangut component-193tangut component-194tangut component-195tangut component-196tangut component-197tangut component-198tangut component-199tangut component-200tangut component-201tangut component-202tangut component-203tangut component-204tangut component-205tangut component-206tangut component-207tangut component-208" + // U+188C0
+		"tangut component-209tangut component-210tangut component-211tangut component-212tangut component-213tangut component-214tangut component-215tangut component-216tangut component-217tangut component-218tangut component-219tangut component-220tangut component-221tangut component-222tangut component-223tangut component-224" + // U+188D0
+		"tangut component-225tangut component-226tangut component-227tangut component-228tangut component-229tangut component-230tangut component-231tangut component-232tangut component-233tangut component-234tangut component-235tangut component-236tangut component-237tangut component-238tangut component-239tangut component-240" + // U+188E0
+		"tangut component-241tangut component-242tangut component-243tangut component-244tangut component-245tangut component-246tangut component-247tangut component-248tangut component-249tangut component-250tangut component-251tangut component-252tangut component-253tangut component-254tangut component-255tangut component-256" + // U+188F0
+		"tangut component-257tangut component-258tangut component-259tangut component-260tangut component-261tangut component-262tangut component-263tangut component-264tangut component-265tangut component-266tangut component-267tangut component-268tangut component-269tangut component-270tangut component-271tangut component-272" + // U+18900
+		"tangut component-273tangut component-274tangut component-275tangut component-276tangut component-277tangut component-278tangut component-279tangut component-280tangut component-281tangut component-282tangut component-283tangut component-284tangut component-285tangut component-286tangut component-287tangut component-288" + // U+18910
+		"tangut component-289tangut component-290tangut component-291tangut component-292tangut component-293tangut component-294tangut component-295tangut component-296tangut component-297tangut component-298tangut component-299tangut component-300tangut component-301tangut component-302tangut component-303tangut component-304" + // U+18920
+		"tangut component-305tangut component-306tangut component-307tangut component-308tangut component-309tangut component-310tangut component-311tangut component-312tangut component-313tangut component-314tangut component-315tangut component-316tangut component-317tangut component-318tangut component-319tangut component-320" + // U+18930
+		"tangut component-321tangut component-322tangut component-323tangut component-324tangut component-325tangut component-326tangut component-327tangut component-328tangut component-329tangut component-330tangut component-331tangut component-332tangut component-333tangut component-334tangut component-335tangut component-336" + // U+18940
+		"tangut component-337tangut component-338tangut component-339tangut component-340tangut component-341tangut component-342tangut component-343tangut component-344tangut component-345tangut component-346tangut component-347tangut component-348tangut component-349tangut component-350tangut component-351tangut component-352" + // U+18950
+		"tangut component-353tangut component-354tangut component-355tangut component-356tangut component-357tangut component-358tangut component-359tangut component-360tangut component-361tangut component-362tangut component-363tangut component-364tangut component-365tangut component-366tangut component-367tangut component-368" + // U+18960
+		"tangut component-369tangut component-370tangut component-371tangut component-372tangut component-373tangut component-374tangut component-375tangut component-376tangut component-377tangut component-378tangut component-379tangut component-380tangut component-381tangut component-382tangut component-383tangut component-384" + // U+18970
+		"tangut component-385tangut component-386tangut component-387tangut component-388tangut component-389tangut component-390tangut component-391tangut component-392tangut component-393tangut component-394tangut component-395tangut component-396tangut component-397tangut component-398tangut component-399tangut component-400" + // U+18980
+		"tangut component-401tangut component-402tangut component-403tangut component-404tangut component-405tangut component-406tangut component-407tangut component-408tangut component-409tangut component-410tangut component-411tangut component-412tangut component-413tangut component-414tangut component-415tangut component-416" + // U+18990
+		"tangut component-417tangut component-418tangut component-419tangut component-420tangut component-421tangut component-422tangut component-423tangut component-424tangut component-425tangut component-426tangut component-427tangut component-428tangut component-429tangut component-430tangut component-431tangut component-432" + // U+189A0
+		"tangut component-433tangut component-434tangut component-435tangut component-436tangut component-437tangut component-438tangut component-439tangut component-440tangut component-441tangut component-442tangut component-443tangut component-444tangut component-445tangut component-446tangut component-447tangut component-448" + // U+189B0
+		"tangut component-449tangut component-450tangut component-451tangut component-452tangut component-453tangut component-454tangut component-455tangut component-456tangut component-457tangut component-458tangut component-459tangut component-460tangut component-461tangut component-462tangut component-463tangut component-464" + // U+189C0
+		"tangut component-465tangut component-466tangut component-467tangut component-468tangut component-469tangut component-470tangut component-471tangut component-472tangut component-473tangut component-474tangut component-475tangut component-476tangut component-477tangut component-478tangut component-479tangut component-480" + // U+189D0
+		"tangut component-481tangut component-482tangut component-483tangut component-484tangut component-485tangut component-486tangut component-487tangut component-488tangut component-489tangut component-490tangut component-491tangut component-492tangut component-493tangut component-494tangut component-495tangut component-496" + // U+189E0
+		"tangut component-497tangut component-498tangut component-499tangut component-500tangut component-501tangut component-502tangut component-503tangut component-504tangut component-505tangut component-506tangut component-507tangut component-508tangut component-509tangut component-510tangut component-511tangut component-512" + // U+189F0
+		"tangut component-513tangut component-514tangut component-515tangut component-516tangut component-517tangut component-518tangut component-519tangut component-520tangut component-521tangut component-522tangut component-523tangut component-524tangut component-525tangut component-526tangut component-527tangut component-528" + // U+18A00
+		"tangut component-529tangut component-530tangut component-531tangut component-532tangut component-533tangut component-534tangut component-535tangut component-536tangut component-537tangut component-538tangut component-539tangut component-540tangut component-541tangut component-542tangut component-543tangut component-544" + // U+18A10
+		"tangut component-545tangut component-546tangut component-547tangut component-548tangut component-549tangut component-550tangut component-551tangut component-552tangut component-553tangut component-554tangut component-555tangut component-556tangut component-557tangut component-558tangut component-559tangut component-560" + // U+18A20
+		"tangut component-561tangut component-562tangut component-563tangut component-564tangut component-565tangut component-566tangut component-567tangut component-568tangut component-569tangut component-570tangut component-571tangut component-572tangut component-573tangut component-574tangut component-575tangut component-576" + // U+18A30
+		"tangut component-577tangut component-578tangut component-579tangut component-580tangut component-581tangut component-582tangut component-583tangut component-584tangut component-585tangut component-586tangut component-587tangut component-588tangut component-589tangut component-590tangut component-591tangut component-592" + // U+18A40
+		"tangut component-593tangut component-594tangut component-595tangut component-596tangut component-597tangut component-598tangut component-599tangut component-600tangut component-601tangut component-602tangut component-603tangut component-604tangut component-605tangut component-606tangut component-607tangut component-608" + // U+18A50
+		"tangut component-609tangut component-610tangut component-611tangut component-612tangut component-613tangut component-614tangut component-615tangut component-616tangut component-617tangut component-618tangut component-619tangut component-620tangut component-621tangut component-622tangut component-623tangut component-624" + // U+18A60
+		"tangut component-625tangut component-626tangut component-627tangut component-628tangut component-629tangut component-630tangut component-631tangut component-632tangut component-633tangut component-634tangut component-635tangut component-636tangut component-637tangut component-638tangut component-639tangut component-640" + // U+18A70
+		"tangut component-641tangut component-642tangut component-643tangut component-644tangut component-645tangut component-646tangut component-647tangut component-648tangut component-649tangut component-650tangut component-651tangut component-652tangut component-653tangut component-654tangut component-655tangut component-656" + // U+18A80
+		"tangut component-657tangut component-658tangut component-659tangut component-660tangut component-661tangut component-662tangut component-663tangut component-664tangut component-665tangut component-666tangut component-667tangut component-668tangut component-669tangut component-670tangut component-671tangut component-672" + // U+18A90
+		"tangut component-673tangut component-674tangut component-675tangut component-676tangut component-677tangut component-678tangut component-679tangut component-680tangut component-681tangut component-682tangut component-683tangut component-684tangut component-685tangut component-686tangut component-687tangut component-688" + // U+18AA0
+		"tangut component-689tangut component-690tangut component-691tangut component-692tangut component-693tangut component-694tangut component-695tangut component-696tangut component-697tangut component-698tangut component-699tangut component-700tangut component-701tangut component-702tangut component-703tangut component-704" + // U+18AB0
+		"tangut component-705tangut component-706tangut component-707tangut component-708tangut component-709tangut component-710tangut component-711tangut component-712tangut component-713tangut component-714tangut component-715tangut component-716tangut component-717tangut component-718tangut component-719tangut component-720" + // U+18AC0
+		"tangut component-721tangut component-722tangut component-723tangut component-724tangut component-725tangut component-726tangut component-727tangut component-728tangut component-729tangut component-730tangut component-731tangut component-732tangut component-733tangut component-734tangut component-735tangut component-736" + // U+18AD0
+		"tangut component-737tangut component-738tangut component-739tangut component-740tangut component-741tangut component-742tangut component-743tangut component-744tangut component-745tangut component-746tangut component-747tangut component-748tangut component-749tangut component-750tangut component-751tangut component-752" + // U+18AE0
+		"tangut component-753tangut component-754tangut component-755tangut component-756tangut component-757tangut component-758tangut component-759tangut component-760tangut component-761tangut component-762tangut component-763tangut component-764tangut component-765tangut component-766tangut component-767tangut component-768" + // U+18AF0
+		"duployan affix left horizontal secantduployan affix mid horizontal secantduployan affix right horizontal secantduployan affix low vertical secantduployan affix mid vertical secantduployan affix high vertical secantduployan affix attached secantduployan affix attached left-to-right secantduployan affix attached tangentduployan affix attached tailduployan affix attached e hookduployan affix attached i hookduployan affix attached tangent hook" + // U+1BC70
+		"duployan affix high acuteduployan affix high tight acuteduployan affix high graveduployan affix high long graveduployan affix high dotduployan affix high circleduployan affix high lineduployan affix high waveduployan affix high vertical" + // U+1BC80
+		"duployan affix low acuteduployan affix low tight acuteduployan affix low graveduployan affix low long graveduployan affix low dotduployan affix low circleduployan affix low lineduployan affix low waveduployan affix low verticalduployan affix low arrowduployan sign o with crossduployan punctuation chinook full stop" + // U+1BC90
+		"znamenny neume kryukznamenny neume kryuk tikhyznamenny neume paraklitznamenny neume dva v chelnuznamenny neume klyuchznamenny neume zanozhekznamenny neume stopitsaznamenny neume stopitsa s ochkomznamenny neume perevodkaznamenny neume perevodka nepostoyannayaznamenny neume stopitsa with sorochya nozhkaznamenny neume chelyustkaznamenny neume palkaznamenny neume zapyatayaznamenny neume golubchik borzyznamenny neume golubchik tikhy" + // U+1CF50
+		"znamenny neume golubchik mrachnyznamenny neume golubchik svetlyznamenny neume golubchik tresvetlyznamenny neume vrakhiya prostayaznamenny neume vrakhiya mrachnayaznamenny neume vrakhiya svetlayaznamenny neume vrakhiya tresvetlayaznamenny neume vrakhiya klyuchevaya prostayaznamenny neume vrakhiya klyuchevaya mrachnayaznamenny neume vrakhiya klyuchevaya svetlayaznamenny neume vrakhiya klyuchevaya tresvetlayaznamenny neume double zapyatayaznamenny neume reversed chelyustkaznamenny neume derbitsaznamenny neume khamiloznamenny neume chashka" + // U+1CF60
+		"znamenny neume podchashieznamenny neume skameytsa mrachnayaznamenny neume skameytsa svetlayaznamenny neume skameytsa tresvetlayaznamenny neume skameytsa tikhayaznamenny neume demestvenny klyuchznamenny neume skameytsa klyuchevaya svetlayaznamenny neume skameytsa klyuchenepostoyannayaznamenny neume skameytsa klyuchevaya tikhayaznamenny neume skameytsa dvoechelnaya prostayaznamenny neume skameytsa dvoechelnaya svetlayaznamenny neume skameytsa dvoechelnaya nepostoyannayaznamenny neume skameytsa dvoechelnaya klyuchevayaznamenny neume slozhitieznamenny neume slozhitie s zapyatoyznamenny neume slozhitie zakrytoe" + // U+1CF70
+		"znamenny neume slozhitie s kryzhemznamenny neume kryzhznamenny neume rogznamenny neume fitaznamenny neume kobylaznamenny neume zmeytsaznamenny neume statyaznamenny neume statya s zapyatoyznamenny neume statya s kryzhemznamenny neume statya s zapyatoy i kryzhemznamenny neume statya s kryzhem i zapyatoyznamenny neume statya zakrytayaznamenny neume statya zakrytaya s zapyatoyznamenny neume statya s rogomznamenny neume statya s dvumya zapyatymiznamenny neume statya s zapyatoy i podchashiem" + // U+1CF80
+		"znamenny neume polkulizmyznamenny neume statya nepostoyannayaznamenny neume strela prostayaznamenny neume strela mrachnotikhayaznamenny neume strela kryzhevayaznamenny neume strela polupovodnayaznamenny neume strela povodnayaznamenny neume strela nepostoyannayaznamenny neume strela klyuchepovodnayaznamenny neume strela klyuchenepostoyannayaznamenny neume strela tikhaya putnayaznamenny neume strela dvoechelnayaznamenny neume strela dvoechelnokryzhevayaznamenny neume strela dvoechelnopovodnayaznamenny neume strela dvoechelnaya klyuchevayaznamenny neume strela dvoechelnopovodnaya klyuchevaya" + // U+1CF90
+		"znamenny neume strela gromnaya with single zapyatayaznamenny neume strela gromopovodnaya with single zapyatayaznamenny neume strela gromnayaznamenny neume strela gromopovodnayaznamenny neume strela gromopovodnaya with double zapyatayaznamenny neume strela gromokryzhevayaznamenny neume strela gromokryzhevaya povodnayaznamenny neume mechikznamenny neume mechik povodnyznamenny neume mechik klyuchevoyznamenny neume mechik klyuchepovodnyznamenny neume mechik klyuchenepostoyannyznamenny neume strela tryasoglasnayaznamenny neume strela tryasopovodnayaznamenny neume strela tryasostrelnayaznamenny neume osoka" + // U+1CFA0
+		"znamenny neume osoka svetlayaznamenny neume osoka tresvetlayaznamenny neume osoka kryukovaya svetlayaznamenny neume osoka klyuchevaya svetlayaznamenny neume osoka klyuchevaya nepostoyannayaznamenny neume strela kryukovayaznamenny neume strela kryukovaya povodnayaznamenny neume strela kryukovaya gromnaya with single zapyatayaznamenny neume strela kryukovaya gromopovodnaya with single zapyatayaznamenny neume strela kryukovaya gromnayaznamenny neume strela kryukovaya gromopovodnayaznamenny neume strela kryukovaya gromopovodnaya with double zapyatayaznamenny neume strela kryukovaya gromokryzhevayaznamenny neume strela kryukovaya gromokryzhevaya povodnayaznamenny neume strela kryukovaya tryaskaznamenny neume kufisma" + // U+1CFB0
+		"znamenny neume oblakoznamenny neume dudaznamenny neume nemkaznamenny neume pauk" + // U+1CFC0
+		"byzantine musical symbol psilibyzantine musical symbol daseiabyzantine musical symbol perispomenibyzantine musical symbol oxeia ekfonitikonbyzantine musical symbol oxeia diplibyzantine musical symbol vareia ekfonitikonbyzantine musical symbol vareia diplibyzantine musical symbol kathistibyzantine musical symbol syrmatikibyzantine musical symbol paraklitikibyzantine musical symbol ypokrisisbyzantine musical symbol ypokrisis diplibyzantine musical symbol kremastibyzantine musical symbol apeso ekfonitikonbyzantine musical symbol exo ekfonitikonbyzantine musical symbol teleia" + // U+1D000
+		"byzantine musical symbol kentimatabyzantine musical symbol apostrofosbyzantine musical symbol apostrofos diplibyzantine musical symbol synevmabyzantine musical symbol thitabyzantine musical symbol oligon archaionbyzantine musical symbol gorgon archaionbyzantine musical symbol psilonbyzantine musical symbol chamilonbyzantine musical symbol vathybyzantine musical symbol ison archaionbyzantine musical symbol kentima archaionbyzantine musical symbol kentimata archaionbyzantine musical symbol saximatabyzantine musical symbol parichonbyzantine musical symbol stavros apodexia" + // U+1D010
+		"byzantine musical symbol oxeiai archaionbyzantine musical symbol vareiai archaionbyzantine musical symbol apoderma archaionbyzantine musical symbol apothemabyzantine musical symbol klasmabyzantine musical symbol revmabyzantine musical symbol piasma archaionbyzantine musical symbol tinagmabyzantine musical symbol anatrichismabyzantine musical symbol seismabyzantine musical symbol synagma archaionbyzantine musical symbol synagma meta stavroubyzantine musical symbol oyranisma archaionbyzantine musical symbol themabyzantine musical symbol lemoibyzantine musical symbol dyo" + // U+1D020
+		"byzantine musical symbol triabyzantine musical symbol tesserabyzantine musical symbol kratimatabyzantine musical symbol apeso exo neobyzantine musical symbol fthora archaionbyzantine musical symbol imifthorabyzantine musical symbol tromikon archaionbyzantine musical symbol katava tromikonbyzantine musical symbol pelastonbyzantine musical symbol psifistonbyzantine musical symbol kontevmabyzantine musical symbol chorevma archaionbyzantine musical symbol rapismabyzantine musical symbol parakalesma archaionbyzantine musical symbol paraklitiki archaionbyzantine musical symbol ichadin" + // U+1D030
+		"byzantine musical symbol nanabyzantine musical symbol petasmabyzantine musical symbol kontevma allobyzantine musical symbol tromikon allobyzantine musical symbol straggismatabyzantine musical symbol gronthismatabyzantine musical symbol ison neobyzantine musical symbol oligon neobyzantine musical symbol oxeia neobyzantine musical symbol petastibyzantine musical symbol koufismabyzantine musical symbol petastokoufismabyzantine musical symbol kratimokoufismabyzantine musical symbol pelaston neobyzantine musical symbol kentimata neo anobyzantine musical symbol kentima neo ano" + // U+1D040
+		"byzantine musical symbol ypsilibyzantine musical symbol apostrofos neobyzantine musical symbol apostrofoi syndesmos neobyzantine musical symbol yporroibyzantine musical symbol kratimoyporroonbyzantine musical symbol elafronbyzantine musical symbol chamilibyzantine musical symbol mikron isonbyzantine musical symbol vareia neobyzantine musical symbol piasma neobyzantine musical symbol psifiston neobyzantine musical symbol omalonbyzantine musical symbol antikenomabyzantine musical symbol lygismabyzantine musical symbol paraklitiki neobyzantine musical symbol parakalesma neo" + // U+1D050
+		"byzantine musical symbol eteron parakalesmabyzantine musical symbol kylismabyzantine musical symbol antikenokylismabyzantine musical symbol tromikon neobyzantine musical symbol ekstreptonbyzantine musical symbol synagma neobyzantine musical symbol syrmabyzantine musical symbol chorevma neobyzantine musical symbol epegermabyzantine musical symbol seisma neobyzantine musical symbol xiron klasmabyzantine musical symbol tromikopsifistonbyzantine musical symbol psifistolygismabyzantine musical symbol tromikolygismabyzantine musical symbol tromikoparakalesmabyzantine musical symbol psifistoparakalesma" + // U+1D060
+		"byzantine musical symbol tromikosynagmabyzantine musical symbol psifistosynagmabyzantine musical symbol gorgosynthetonbyzantine musical symbol argosynthetonbyzantine musical symbol eteron argosynthetonbyzantine musical symbol oyranisma neobyzantine musical symbol thematismos esobyzantine musical symbol thematismos exobyzantine musical symbol thema aplounbyzantine musical symbol thes kai apothesbyzantine musical symbol katavasmabyzantine musical symbol endofononbyzantine musical symbol yfen katobyzantine musical symbol yfen anobyzantine musical symbol stavrosbyzantine musical symbol klasma ano" + // U+1D070
+		"byzantine musical symbol dipli archaionbyzantine musical symbol kratima archaionbyzantine musical symbol kratima allobyzantine musical symbol kratima neobyzantine musical symbol apoderma neobyzantine musical symbol aplibyzantine musical symbol diplibyzantine musical symbol triplibyzantine musical symbol tetraplibyzantine musical symbol koronisbyzantine musical symbol leimma enos chronoubyzantine musical symbol leimma dyo chrononbyzantine musical symbol leimma trion chrononbyzantine musical symbol leimma tessaron chrononbyzantine musical symbol leimma imiseos chronoubyzantine musical symbol gorgon neo ano" + // U+1D080
+		"byzantine musical symbol gorgon parestigmenon aristerabyzantine musical symbol gorgon parestigmenon dexiabyzantine musical symbol digorgonbyzantine musical symbol digorgon parestigmenon aristera katobyzantine musical symbol digorgon parestigmenon aristera anobyzantine musical symbol digorgon parestigmenon dexiabyzantine musical symbol trigorgonbyzantine musical symbol argonbyzantine musical symbol imidiargonbyzantine musical symbol diargonbyzantine musical symbol agogi poli argibyzantine musical symbol agogi argoteribyzantine musical symbol agogi argibyzantine musical symbol agogi metriabyzantine musical symbol agogi mesibyzantine musical symbol agogi gorgi" + // U+1D090
+		"byzantine musical symbol agogi gorgoteribyzantine musical symbol agogi poli gorgibyzantine musical symbol martyria protos ichosbyzantine musical symbol martyria alli protos ichosbyzantine musical symbol martyria deyteros ichosbyzantine musical symbol martyria alli deyteros ichosbyzantine musical symbol martyria tritos ichosbyzantine musical symbol martyria trifoniasbyzantine musical symbol martyria tetartos ichosbyzantine musical symbol martyria tetartos legetos ichosbyzantine musical symbol martyria legetos ichosbyzantine musical symbol martyria plagios ichosbyzantine musical symbol isakia telous ichimatosbyzantine musical symbol apostrofoi telous ichimatosbyzantine musical symbol fanerosis tetrafoniasbyzantine musical symbol fanerosis monofonias" + // U+1D0A0
+		"byzantine musical symbol fanerosis difoniasbyzantine musical symbol martyria varys ichosbyzantine musical symbol martyria protovarys ichosbyzantine musical symbol martyria plagios tetartos ichosbyzantine musical symbol gorthmikon n aplounbyzantine musical symbol gorthmikon n diplounbyzantine musical symbol enarxis kai fthora voubyzantine musical symbol imifononbyzantine musical symbol imifthoronbyzantine musical symbol fthora archaion deyterou ichoubyzantine musical symbol fthora diatoniki pabyzantine musical symbol fthora diatoniki nanabyzantine musical symbol fthora naos ichosbyzantine musical symbol fthora diatoniki dibyzantine musical symbol fthora skliron diatonon dibyzantine musical symbol fthora diatoniki ke" + // U+1D0B0
+		"byzantine musical symbol fthora diatoniki zobyzantine musical symbol fthora diatoniki ni katobyzantine musical symbol fthora diatoniki ni anobyzantine musical symbol fthora malakon chroma difoniasbyzantine musical symbol fthora malakon chroma monofoniasbyzantine musical symbol fhtora skliron chroma vasisbyzantine musical symbol fthora skliron chroma synafibyzantine musical symbol fthora nenanobyzantine musical symbol chroa zygosbyzantine musical symbol chroa klitonbyzantine musical symbol chroa spathibyzantine musical symbol fthora i yfesis tetartimorionbyzantine musical symbol fthora enarmonios antifoniabyzantine musical symbol yfesis tritimorionbyzantine musical symbol diesis tritimorionbyzantine musical symbol diesis tetartimorion" + // U+1D0C0
+		"byzantine musical symbol diesis apli dyo dodekatabyzantine musical symbol diesis monogrammos tessera dodekatabyzantine musical symbol diesis digrammos ex dodekatabyzantine musical symbol diesis trigrammos okto dodekatabyzantine musical symbol yfesis apli dyo dodekatabyzantine musical symbol yfesis monogrammos tessera dodekatabyzantine musical symbol yfesis digrammos ex dodekatabyzantine musical symbol yfesis trigrammos okto dodekatabyzantine musical symbol geniki diesisbyzantine musical symbol geniki yfesisbyzantine musical symbol diastoli apli mikribyzantine musical symbol diastoli apli megalibyzantine musical symbol diastoli diplibyzantine musical symbol diastoli theseosbyzantine musical symbol simansis theseosbyzantine musical symbol simansis theseos disimou" + // U+1D0D0
+		"byzantine musical symbol simansis theseos trisimoubyzantine musical symbol simansis theseos tetrasimoubyzantine musical symbol simansis arseosbyzantine musical symbol simansis arseos disimoubyzantine musical symbol simansis arseos trisimoubyzantine musical symbol simansis arseos tetrasimoubyzantine musical symbol digramma ggbyzantine musical symbol diftoggos oubyzantine musical symbol stigmabyzantine musical symbol arktiko pabyzantine musical symbol arktiko voubyzantine musical symbol arktiko gabyzantine musical symbol arktiko dibyzantine musical symbol arktiko kebyzantine musical symbol arktiko zobyzantine musical symbol arktiko ni" + // U+1D0E0
+		"byzantine musical symbol kentimata neo mesobyzantine musical symbol kentima neo mesobyzantine musical symbol kentimata neo katobyzantine musical symbol kentima neo katobyzantine musical symbol klasma katobyzantine musical symbol gorgon neo kato" + // U+1D0F0
+		"musical symbol single barlinemusical symbol double barlinemusical symbol final barlinemusical symbol reverse final barlinemusical symbol dashed barlinemusical symbol short barlinemusical symbol left repeat signmusical symbol right repeat signmusical symbol repeat dotsmusical symbol dal segnomusical symbol da capomusical symbol segnomusical symbol codamusical symbol repeated figure-1musical symbol repeated figure-2musical symbol repeated figure-3" + // U+1D100
+		"musical symbol fermatamusical symbol fermata belowmusical symbol breath markmusical symbol caesuramusical symbol bracemusical symbol bracketmusical symbol one-line staffmusical symbol two-line staffmusical symbol three-line staffmusical symbol four-line staffmusical symbol five-line staffmusical symbol six-line staffmusical symbol six-string fretboardmusical symbol four-string fretboardmusical symbol g clefmusical symbol g clef ottava alta" + // U+1D110
+		"musical symbol g clef ottava bassamusical symbol c clefmusical symbol f clefmusical symbol f clef ottava altamusical symbol f clef ottava bassamusical symbol drum clef-1musical symbol drum clef-2musical symbol multiple measure restmusical symbol double sharpmusical symbol double flatmusical symbol flat upmusical symbol flat downmusical symbol natural upmusical symbol natural down" + // U+1D120
+		"musical symbol sharp upmusical symbol sharp downmusical symbol quarter tone sharpmusical symbol quarter tone flatmusical symbol common timemusical symbol cut timemusical symbol ottava altamusical symbol ottava bassamusical symbol quindicesima altamusical symbol quindicesima bassamusical symbol multi restmusical symbol whole restmusical symbol half restmusical symbol quarter restmusical symbol eighth restmusical symbol sixteenth rest" + // U+1D130
+		"musical symbol thirty-second restmusical symbol sixty-fourth restmusical symbol one hundred twenty-eighth restmusical symbol x noteheadmusical symbol plus noteheadmusical symbol circle x noteheadmusical symbol square notehead whitemusical symbol square notehead blackmusical symbol triangle notehead up whitemusical symbol triangle notehead up blackmusical symbol triangle notehead left whitemusical symbol triangle notehead left blackmusical symbol triangle notehead right whitemusical symbol triangle notehead right blackmusical symbol triangle notehead down whitemusical symbol triangle notehead down black" + // U+1D140
+		"musical symbol triangle notehead up right whitemusical symbol triangle notehead up right blackmusical symbol moon notehead whitemusical symbol moon notehead blackmusical symbol triangle-round notehead down whitemusical symbol triangle-round notehead down blackmusical symbol parenthesis noteheadmusical symbol void noteheadmusical symbol notehead blackmusical symbol null noteheadmusical symbol cluster notehead whitemusical symbol cluster notehead blackmusical symbol brevemusical symbol whole notemusical symbol half notemusical symbol quarter note" + // U+1D150
+		"musical symbol eighth notemusical symbol sixteenth notemusical symbol thirty-second notemusical symbol sixty-fourth notemusical symbol one hundred twenty-eighth notemusical symbol fingered tremolo-1musical symbol fingered tremolo-2musical symbol fingered tremolo-3" + // U+1D160
+		"musical symbol arpeggiato upmusical symbol arpeggiato downmusical symbol rinforzandomusical symbol subitomusical symbol zmusical symbol piano" + // U+1D180
+		"musical symbol mezzomusical symbol fortemusical symbol crescendomusical symbol decrescendomusical symbol grace note slashmusical symbol grace note no slashmusical symbol trmusical symbol turnmusical symbol inverted turnmusical symbol turn slashmusical symbol turn upmusical symbol ornament stroke-1musical symbol ornament stroke-2musical symbol ornament stroke-3musical symbol ornament stroke-4musical symbol ornament stroke-5" + // U+1D190
+		"musical symbol ornament stroke-6musical symbol ornament stroke-7musical symbol ornament stroke-8musical symbol ornament stroke-9musical symbol ornament stroke-10musical symbol ornament stroke-11musical symbol hauptstimmemusical symbol nebenstimmemusical symbol end of stimmemusical symbol degree slashmusical symbol pedal markmusical symbol pedal up mark" + // U+1D1A0
+		"musical symbol half pedal markmusical symbol glissando upmusical symbol glissando downmusical symbol with fingernailsmusical symbol dampmusical symbol damp allmusical symbol maximamusical symbol longamusical symbol brevismusical symbol semibrevis whitemusical symbol semibrevis blackmusical symbol minimamusical symbol minima blackmusical symbol semiminima whitemusical symbol semiminima blackmusical symbol fusa white" + // U+1D1B0
+		"musical symbol fusa blackmusical symbol longa perfecta restmusical symbol longa imperfecta restmusical symbol brevis restmusical symbol semibrevis restmusical symbol minima restmusical symbol semiminima restmusical symbol tempus perfectum cum prolatione perfectamusical symbol tempus perfectum cum prolatione imperfectamusical symbol tempus perfectum cum prolatione perfecta diminution-1musical symbol tempus imperfectum cum prolatione perfectamusical symbol tempus imperfectum cum prolatione imperfectamusical symbol tempus imperfectum cum prolatione imperfecta diminution-1musical symbol tempus imperfectum cum prolatione imperfecta diminution-2musical symbol tempus imperfectum cum prolatione imperfecta diminution-3musical symbol croix" + // U+1D1C0
+		"musical symbol gregorian c clefmusical symbol gregorian f clefmusical symbol square bmusical symbol virgamusical symbol podatusmusical symbol clivismusical symbol scandicusmusical symbol climacusmusical symbol torculusmusical symbol porrectusmusical symbol porrectus flexusmusical symbol scandicus flexusmusical symbol torculus resupinusmusical symbol pes subpunctismusical symbol kievan c clefmusical symbol kievan end of piece" + // U+1D1D0
+		"musical symbol kievan final notemusical symbol kievan recitative markmusical symbol kievan whole notemusical symbol kievan half notemusical symbol kievan quarter note stem downmusical symbol kievan quarter note stem upmusical symbol kievan eighth note stem downmusical symbol kievan eighth note stem upmusical symbol kievan flat signmusical symbol sorimusical symbol koron" + // U+1D1E0
+		"greek vocal notation symbol-1greek vocal notation symbol-2greek vocal notation symbol-3greek vocal notation symbol-4greek vocal notation symbol-5greek vocal notation symbol-6greek vocal notation symbol-7greek vocal notation symbol-8greek vocal notation symbol-9greek vocal notation symbol-10greek vocal notation symbol-11greek vocal notation symbol-12greek vocal notation symbol-13greek vocal notation symbol-14greek vocal notation symbol-15greek vocal notation symbol-16" + // U+1D200
+		"greek vocal notation symbol-17greek vocal notation symbol-18greek vocal notation symbol-19greek vocal notation symbol-20greek vocal notation symbol-21greek vocal notation symbol-22greek vocal notation symbol-23greek vocal notation symbol-24greek vocal notation symbol-50greek vocal notation symbol-51greek vocal notation symbol-52greek vocal notation symbol-53greek vocal notation symbol-54greek instrumental notation symbol-1greek instrumental notation symbol-2greek instrumental notation symbol-4" + // U+1D210
+		"greek instrumental notation symbol-5greek instrumental notation symbol-7greek instrumental notation symbol-8greek instrumental notation symbol-11greek instrumental notation symbol-12greek instrumental notation symbol-13greek instrumental notation symbol-14greek instrumental notation symbol-17greek instrumental notation symbol-18greek instrumental notation symbol-19greek instrumental notation symbol-23greek instrumental notation symbol-24greek instrumental notation symbol-25greek instrumental notation symbol-26greek instrumental notation symbol-27greek instrumental notation symbol-29" + // U+1D220
+		"greek instrumental notation symbol-30greek instrumental notation symbol-32greek instrumental notation symbol-36greek instrumental notation symbol-37greek instrumental notation symbol-38greek instrumental notation symbol-39greek instrumental notation symbol-40greek instrumental notation symbol-42greek instrumental notation symbol-43greek instrumental notation symbol-45greek instrumental notation symbol-47greek instrumental notation symbol-48greek instrumental notation symbol-49greek instrumental notation symbol-50greek instrumental notation symbol-51greek instrumental notation symbol-52" + // U+1D230
+		"greek instrumental notation symbol-53greek instrumental notation symbol-54greek musical leimma" + // U+1D240
+		"monogram for earthdigram for heavenly earthdigram for human earthdigram for earthly heavendigram for earthly humandigram for earthtetragram for centretetragram for full circletetragram for miredtetragram for barriertetragram for keeping smalltetragram for contrarietytetragram for ascenttetragram for oppositiontetragram for branching outtetragram for defectiveness or distortion" + // U+1D300
+		"tetragram for divergencetetragram for youthfulnesstetragram for increasetetragram for penetrationtetragram for reachtetragram for contacttetragram for holding backtetragram for waitingtetragram for followingtetragram for advancetetragram for releasetetragram for resistancetetragram for easetetragram for joytetragram for contentiontetragram for endeavour" + // U+1D310
+		"tetragram for dutiestetragram for changetetragram for decisivenesstetragram for bold resolutiontetragram for packingtetragram for legiontetragram for closenesstetragram for kinshiptetragram for gatheringtetragram for strengthtetragram for puritytetragram for fullnesstetragram for residencetetragram for law or modeltetragram for responsetetragram for going to meet" + // U+1D320
+		"tetragram for encounterstetragram for stovetetragram for greatnesstetragram for enlargementtetragram for patterntetragram for ritualtetragram for flighttetragram for vastness or wastingtetragram for constancytetragram for measuretetragram for eternitytetragram for unitytetragram for diminishmenttetragram for closed mouthtetragram for guardednesstetragram for gathering in" + // U+1D330
+		"tetragram for massingtetragram for accumulationtetragram for embellishmenttetragram for doubttetragram for watchtetragram for sinkingtetragram for innertetragram for departuretetragram for darkeningtetragram for dimmingtetragram for exhaustiontetragram for severancetetragram for stoppagetetragram for hardnesstetragram for completiontetragram for closure" + // U+1D340
+		"tetragram for failuretetragram for aggravationtetragram for compliancetetragram for on the vergetetragram for difficultiestetragram for labouringtetragram for fostering" + // U+1D350
+		"mathematical bold partial differentialmathematical bold epsilon symbolmathematical bold theta symbolmathematical bold kappa symbolmathematical bold phi symbol" + // U+1D6D0
+		"mathematical bold rho symbolmathematical bold pi symbolmathematical italic capital alphamathematical italic capital betamathematical italic capital gammamathematical italic capital deltamathematical italic capital epsilonmathematical italic capital zetamathematical italic capital etamathematical italic capital thetamathematical italic capital iotamathematical italic capital kappamathematical italic capital lamdamathematical italic capital mumathematical italic capital numathematical italic capital xi" + // U+1D6E0
+		"mathematical italic capital omicronmathematical italic capital pimathematical italic capital rhomathematical italic capital theta symbolmathematical italic capital sigmamathematical italic capital taumathematical italic capital upsilonmathematical italic capital phimathematical italic capital chimathematical italic capital psimathematical italic capital omegamathematical italic nablamathematical italic small alphamathematical italic small betamathematical italic small gammamathematical italic small delta" + // U+1D6F0
+		"mathematical italic small epsilonmathematical italic small zetamathematical italic small etamathematical italic small thetamathematical italic small iotamathematical italic small kappamathematical italic small lamdamathematical italic small mumathematical italic small numathematical italic small ximathematical italic small omicronmathematical italic small pimathematical italic small rhomathematical italic small final sigmamathematical italic small sigmamathematical italic small tau" + // U+1D700
+		"mathematical italic small upsilonmathematical italic small phimathematical italic small chimathematical italic small psimathematical italic small omegamathematical italic partial differentialmathematical italic epsilon symbolmathematical italic theta symbolmathematical italic kappa symbolmathematical italic phi symbolmathematical italic rho symbolmathematical italic pi symbolmathematical bold italic capital alphamathematical bold italic capital betamathematical bold italic capital gammamathematical bold italic capital delta" + // U+1D710
+		"mathematical bold italic capital epsilonmathematical bold italic capital zetamathematical bold italic capital etamathematical bold italic capital thetamathematical bold italic capital iotamathematical bold italic capital kappamathematical bold italic capital lamdamathematical bold italic capital mumathematical bold italic capital numathematical bold italic capital ximathematical bold italic capital omicronmathematical bold italic capital pimathematical bold italic capital rhomathematical bold italic capital theta symbolmathematical bold italic capital sigmamathematical bold italic capital tau" + // U+1D720
+		"mathematical bold italic capital upsilonmathematical bold italic capital phimathematical bold italic capital chimathematical bold italic capital psimathematical bold italic capital omegamathematical bold italic nablamathematical bold italic small alphamathematical bold italic small betamathematical bold italic small gammamathematical bold italic small deltamathematical bold italic small epsilonmathematical bold italic small zetamathematical bold italic small etamathematical bold italic small thetamathematical bold italic small iotamathematical bold italic small kappa" + // U+1D730
+		"mathematical bold italic small lamdamathematical bold italic small mumathematical bold italic small numathematical bold italic small ximathematical bold italic small omicronmathematical bold italic small pimathematical bold italic small rhomathematical bold italic small final sigmamathematical bold italic small sigmamathematical bold italic small taumathematical bold italic small upsilonmathematical bold italic small phimathematical bold italic small chimathematical bold italic small psimathematical bold italic small omegamathematical bold italic partial differential" + // U+1D740
+		"mathematical bold italic epsilon symbolmathematical bold italic theta symbolmathematical bold italic kappa symbolmathematical bold italic phi symbolmathematical bold italic rho symbolmathematical bold italic pi symbolmathematical sans-serif bold capital alphamathematical sans-serif bold capital betamathematical sans-serif bold capital gammamathematical sans-serif bold capital deltamathematical sans-serif bold capital epsilonmathematical sans-serif bold capital zetamathematical sans-serif bold capital etamathematical sans-serif bold capital thetamathematical sans-serif bold capital iotamathematical sans-serif bold capital kappa" + // U+1D750
+		"mathematical sans-serif bold capital lamdamathematical sans-serif bold capital mumathematical sans-serif bold capital numathematical sans-serif bold capital ximathematical sans-serif bold capital omicronmathematical sans-serif bold capital pimathematical sans-serif bold capital rhomathematical sans-serif bold capital theta symbolmathematical sans-serif bold capital sigmamathematical sans-serif bold capital taumathematical sans-serif bold capital upsilonmathematical sans-serif bold capital phimathematical sans-serif bold capital chimathematical sans-serif bold capital psimathematical sans-serif bold capital omegamathematical sans-serif bold nabla" + // U+1D760
+		"mathematical sans-serif bold small alphamathematical sans-serif bold small betamathematical sans-serif bold small gammamathematical sans-serif bold small deltamathematical sans-serif bold small epsilonmathematical sans-serif bold small zetamathematical sans-serif bold small etamathematical sans-serif bold small thetamathematical sans-serif bold small iotamathematical sans-serif bold small kappamathematical sans-serif bold small lamdamathematical sans-serif bold small mumathematical sans-serif bold small numathematical sans-serif bold small ximathematical sans-serif bold small omicronmathematical sans-serif bold small pi" + // U+1D770
+		"mathematical sans-serif bold small rhomathematical sans-serif bold small final sigmamathematical sans-serif bold small sigmamathematical sans-serif bold small taumathematical sans-serif bold small upsilonmathematical sans-serif bold small phimathematical sans-serif bold small chimathematical sans-serif bold small psimathematical sans-serif bold small omegamathematical sans-serif bold partial differentialmathematical sans-serif bold epsilon symbolmathematical sans-serif bold theta symbolmathematical sans-serif bold kappa symbolmathematical sans-serif bold phi symbolmathematical sans-serif bold rho symbolmathematical sans-serif bold pi symbol" + // U+1D780
+		"mathematical sans-serif bold italic capital alphamathematical sans-serif bold italic capital betamathematical sans-serif bold italic capital gammamathematical sans-serif bold italic capital deltamathematical sans-serif bold italic capital epsilonmathematical sans-serif bold italic capital zetamathematical sans-serif bold italic capital etamathematical sans-serif bold italic capital thetamathematical sans-serif bold italic capital iotamathematical sans-serif bold italic capital kappamathematical sans-serif bold italic capital lamdamathematical sans-serif bold italic capital mumathematical sans-serif bold italic capital numathematical sans-serif bold italic capital ximathematical sans-serif bold italic capital omicronmathematical sans-serif bold italic capital pi" + // U+1D790
+		"mathematical sans-serif bold italic capital rhomathematical sans-serif bold italic capital theta symbolmathematical sans-serif bold italic capital sigmamathematical sans-serif bold italic capital taumathematical sans-serif bold italic capital upsilonmathematical sans-serif bold italic capital phimathematical sans-serif bold italic capital chimathematical sans-serif bold italic capital psimathematical sans-serif bold italic capital omegamathematical sans-serif bold italic nablamathematical sans-serif bold italic small alphamathematical sans-serif bold italic small betamathematical sans-serif bold italic small gammamathematical sans-serif bold italic small deltamathematical sans-serif bold italic small epsilonmathematical sans-serif bold italic small zeta" + // U+1D7A0
+		"mathematical sans-serif bold italic small etamathematical sans-serif bold italic small thetamathematical sans-serif bold italic small iotamathematical sans-serif bold italic small kappamathematical sans-serif bold italic small lamdamathematical sans-serif bold italic small mumathematical sans-serif bold italic small numathematical sans-serif bold italic small ximathematical sans-serif bold italic small omicronmathematical sans-serif bold italic small pimathematical sans-serif bold italic small rhomathematical sans-serif bold italic small final sigmamathematical sans-serif bold italic small sigmamathematical sans-serif bold italic small taumathematical sans-serif bold italic small upsilonmathematical sans-serif bold italic small phi" + // U+1D7B0
+		"mathematical sans-serif bold italic small chimathematical sans-serif bold italic small psimathematical sans-serif bold italic small omegamathematical sans-serif bold italic partial differentialmathematical sans-serif bold italic epsilon symbolmathematical sans-serif bold italic theta symbolmathematical sans-serif bold italic kappa symbolmathematical sans-serif bold italic phi symbolmathematical sans-serif bold italic rho symbolmathematical sans-serif bold italic pi symbolmathematical bold capital digammamathematical bold small digamma" + // U+1D7C0
+		"signwriting hand-fist indexsignwriting hand-circle indexsignwriting hand-cup indexsignwriting hand-oval indexsignwriting hand-hinge indexsignwriting hand-angle indexsignwriting hand-fist index bentsignwriting hand-circle index bentsignwriting hand-fist thumb under index bentsignwriting hand-fist index raised knucklesignwriting hand-fist index cuppedsignwriting hand-fist index hingedsignwriting hand-fist index hinged lowsignwriting hand-circle index hingesignwriting hand-fist index middlesignwriting hand-circle index middle" + // U+1D800
+		"signwriting hand-fist index middle bentsignwriting hand-fist index middle raised knucklessignwriting hand-fist index middle hingedsignwriting hand-fist index up middle hingedsignwriting hand-fist index hinged middle upsignwriting hand-fist index middle conjoinedsignwriting hand-fist index middle conjoined index bentsignwriting hand-fist index middle conjoined middle bentsignwriting hand-fist index middle conjoined cuppedsignwriting hand-fist index middle conjoined hingedsignwriting hand-fist index middle crossedsignwriting hand-circle index middle crossedsignwriting hand-fist middle bent over indexsignwriting hand-fist index bent over middlesignwriting hand-fist index middle thumbsignwriting hand-circle index middle thumb" + // U+1D810
+		"signwriting hand-fist index middle straight thumb bentsignwriting hand-fist index middle bent thumb straightsignwriting hand-fist index middle thumb bentsignwriting hand-fist index middle hinged spread thumb sidesignwriting hand-fist index up middle hinged thumb sidesignwriting hand-fist index up middle hinged thumb conjoinedsignwriting hand-fist index hinged middle up thumb sidesignwriting hand-fist index middle up spread thumb forwardsignwriting hand-fist index middle thumb cuppedsignwriting hand-fist index middle thumb circledsignwriting hand-fist index middle thumb hookedsignwriting hand-fist index middle thumb hingedsignwriting hand-fist thumb between index middle straightsignwriting hand-fist index middle conjoined thumb sidesignwriting hand-fist index middle conjoined thumb side conjoinedsignwriting hand-fist index middle conjoined thumb side bent" + // U+1D820
+		"signwriting hand-fist middle thumb hooked index upsignwriting hand-fist index thumb hooked middle upsignwriting hand-fist index middle conjoined hinged thumb sidesignwriting hand-fist index middle crossed thumb sidesignwriting hand-fist index middle conjoined thumb forwardsignwriting hand-fist index middle conjoined cupped thumb forwardsignwriting hand-fist middle thumb cupped index upsignwriting hand-fist index thumb cupped middle upsignwriting hand-fist middle thumb circled index upsignwriting hand-fist middle thumb circled index hingedsignwriting hand-fist index thumb angled out middle upsignwriting hand-fist index thumb angled in middle upsignwriting hand-fist index thumb circled middle upsignwriting hand-fist index middle thumb conjoined hingedsignwriting hand-fist index middle thumb angled outsignwriting hand-fist index middle thumb angled" + // U+1D830
+		"signwriting hand-fist middle thumb angled out index upsignwriting hand-fist middle thumb angled out index crossedsignwriting hand-fist middle thumb angled index upsignwriting hand-fist index thumb hooked middle hingedsignwriting hand-flat four fingerssignwriting hand-flat four fingers bentsignwriting hand-flat four fingers hingedsignwriting hand-flat four fingers conjoinedsignwriting hand-flat four fingers conjoined splitsignwriting hand-claw four fingers conjoinedsignwriting hand-fist four fingers conjoined bentsignwriting hand-hinge four fingers conjoinedsignwriting hand-flat five fingers spreadsignwriting hand-flat heel five fingers spreadsignwriting hand-flat five fingers spread four bentsignwriting hand-flat heel five fingers spread four bent" + // U+1D840
+		"signwriting hand-flat five fingers spread bentsignwriting hand-flat heel five fingers spread bentsignwriting hand-flat five fingers spread thumb forwardsignwriting hand-cup five fingers spreadsignwriting hand-cup five fingers spread opensignwriting hand-hinge five fingers spread opensignwriting hand-oval five fingers spreadsignwriting hand-flat five fingers spread hingedsignwriting hand-flat five fingers spread hinged thumb sidesignwriting hand-flat five fingers spread hinged no thumbsignwriting hand-flatsignwriting hand-flat between palm facingssignwriting hand-flat heelsignwriting hand-flat thumb sidesignwriting hand-flat heel thumb sidesignwriting hand-flat thumb bent" + // U+1D850
+		"signwriting hand-flat thumb forwardsignwriting hand-flat split index thumb sidesignwriting hand-flat split centresignwriting hand-flat split centre thumb sidesignwriting hand-flat split centre thumb side bentsignwriting hand-flat split littlesignwriting hand-clawsignwriting hand-claw thumb sidesignwriting hand-claw no thumbsignwriting hand-claw thumb forwardsignwriting hand-hook curlicuesignwriting hand-hooksignwriting hand-cup opensignwriting hand-cupsignwriting hand-cup open thumb sidesignwriting hand-cup thumb side" + // U+1D860
+		"signwriting hand-cup open no thumbsignwriting hand-cup no thumbsignwriting hand-cup open thumb forwardsignwriting hand-cup thumb forwardsignwriting hand-curlicue opensignwriting hand-curlicuesignwriting hand-circlesignwriting hand-ovalsignwriting hand-oval thumb sidesignwriting hand-oval no thumbsignwriting hand-oval thumb forwardsignwriting hand-hinge opensignwriting hand-hinge open thumb forwardsignwriting hand-hingesignwriting hand-hinge smallsignwriting hand-hinge open thumb side" + // U+1D870
+		"signwriting hand-hinge thumb sidesignwriting hand-hinge open no thumbsignwriting hand-hinge no thumbsignwriting hand-hinge thumb side touching indexsignwriting hand-hinge thumb between middle ringsignwriting hand-anglesignwriting hand-fist index middle ringsignwriting hand-circle index middle ringsignwriting hand-hinge index middle ringsignwriting hand-angle index middle ringsignwriting hand-hinge littlesignwriting hand-fist index middle ring bentsignwriting hand-fist index middle ring conjoinedsignwriting hand-hinge index middle ring conjoinedsignwriting hand-fist little downsignwriting hand-fist little down ripple straight" + // U+1D880
+		"signwriting hand-fist little down ripple curvedsignwriting hand-fist little down others circledsignwriting hand-fist little upsignwriting hand-fist thumb under little upsignwriting hand-circle little upsignwriting hand-oval little upsignwriting hand-angle little upsignwriting hand-fist little raised knucklesignwriting hand-fist little bentsignwriting hand-fist little touches thumbsignwriting hand-fist little thumbsignwriting hand-hinge little thumbsignwriting hand-fist little index thumbsignwriting hand-hinge little index thumbsignwriting hand-angle little index thumb index thumb outsignwriting hand-angle little index thumb index thumb" + // U+1D890
+		"signwriting hand-fist little indexsignwriting hand-circle little indexsignwriting hand-hinge little indexsignwriting hand-angle little indexsignwriting hand-fist index middle littlesignwriting hand-circle index middle littlesignwriting hand-hinge index middle littlesignwriting hand-hinge ringsignwriting hand-angle index middle littlesignwriting hand-fist index middle cross littlesignwriting hand-circle index middle cross littlesignwriting hand-fist ring downsignwriting hand-hinge ring down index thumb hook middlesignwriting hand-angle ring down middle thumb index crosssignwriting hand-fist ring upsignwriting hand-fist ring raised knuckle" + // U+1D8A0
+		"signwriting hand-fist ring littlesignwriting hand-circle ring littlesignwriting hand-oval ring littlesignwriting hand-angle ring littlesignwriting hand-fist ring middlesignwriting hand-fist ring middle conjoinedsignwriting hand-fist ring middle raised knucklessignwriting hand-fist ring indexsignwriting hand-fist ring thumbsignwriting hand-hook ring thumbsignwriting hand-fist index ring littlesignwriting hand-circle index ring littlesignwriting hand-curlicue index ring little onsignwriting hand-hook index ring little outsignwriting hand-hook index ring little insignwriting hand-hook index ring little under" + // U+1D8B0
+		"signwriting hand-cup index ring littlesignwriting hand-hinge index ring littlesignwriting hand-angle index ring little outsignwriting hand-angle index ring littlesignwriting hand-fist middle downsignwriting hand-hinge middlesignwriting hand-fist middle upsignwriting hand-circle middle upsignwriting hand-fist middle raised knucklesignwriting hand-fist middle up thumb sidesignwriting hand-hook middle thumbsignwriting hand-fist middle thumb littlesignwriting hand-fist middle littlesignwriting hand-fist middle ring littlesignwriting hand-circle middle ring littlesignwriting hand-curlicue middle ring little on" + // U+1D8C0
+		"signwriting hand-cup middle ring littlesignwriting hand-hinge middle ring littlesignwriting hand-angle middle ring little outsignwriting hand-angle middle ring little insignwriting hand-angle middle ring littlesignwriting hand-circle middle ring little bentsignwriting hand-claw middle ring little conjoinedsignwriting hand-claw middle ring little conjoined sidesignwriting hand-hook middle ring little conjoined outsignwriting hand-hook middle ring little conjoined insignwriting hand-hook middle ring little conjoinedsignwriting hand-hinge index hingedsignwriting hand-fist index thumb sidesignwriting hand-hinge index thumb sidesignwriting hand-fist index thumb side thumb diagonalsignwriting hand-fist index thumb side thumb conjoined" + // U+1D8D0
+		"signwriting hand-fist index thumb side thumb bentsignwriting hand-fist index thumb side index bentsignwriting hand-fist index thumb side both bentsignwriting hand-fist index thumb side index hingesignwriting hand-fist index thumb forward index straightsignwriting hand-fist index thumb forward index bentsignwriting hand-fist index thumb hooksignwriting hand-fist index thumb curlicuesignwriting hand-fist index thumb curve thumb insidesignwriting hand-claw index thumb curve thumb insidesignwriting hand-fist index thumb curve thumb undersignwriting hand-fist index thumb circlesignwriting hand-cup index thumbsignwriting hand-cup index thumb opensignwriting hand-hinge index thumb opensignwriting hand-hinge index thumb large" + // U+1D8E0
+		"signwriting hand-hinge index thumbsignwriting hand-hinge index thumb smallsignwriting hand-angle index thumb outsignwriting hand-angle index thumb insignwriting hand-angle index thumbsignwriting hand-fist thumbsignwriting hand-fist thumb heelsignwriting hand-fist thumb side diagonalsignwriting hand-fist thumb side conjoinedsignwriting hand-fist thumb side bentsignwriting hand-fist thumb forwardsignwriting hand-fist thumb between index middlesignwriting hand-fist thumb between middle ringsignwriting hand-fist thumb between ring littlesignwriting hand-fist thumb under two fingerssignwriting hand-fist thumb over two fingers" + // U+1D8F0
+		"signwriting hand-fist thumb under three fingerssignwriting hand-fist thumb under four fingerssignwriting hand-fist thumb over four raised knucklessignwriting hand-fistsignwriting hand-fist heelsignwriting touch singlesignwriting touch multiplesignwriting touch betweensignwriting grasp singlesignwriting grasp multiplesignwriting grasp betweensignwriting strike singlesignwriting strike multiplesignwriting strike betweensignwriting brush singlesignwriting brush multiple" + // U+1D900
+		"signwriting brush betweensignwriting rub singlesignwriting rub multiplesignwriting rub betweensignwriting surface symbolssignwriting surface betweensignwriting squeeze large singlesignwriting squeeze small singlesignwriting squeeze large multiplesignwriting squeeze small multiplesignwriting squeeze sequentialsignwriting flick large singlesignwriting flick small singlesignwriting flick large multiplesignwriting flick small multiplesignwriting flick sequential" + // U+1D910
+		"signwriting squeeze flick alternatingsignwriting movement-hinge up down largesignwriting movement-hinge up down smallsignwriting movement-hinge up sequentialsignwriting movement-hinge down sequentialsignwriting movement-hinge up down alternating largesignwriting movement-hinge up down alternating smallsignwriting movement-hinge side to side scissorssignwriting movement-wallplane finger contactsignwriting movement-floorplane finger contactsignwriting movement-wallplane single straight smallsignwriting movement-wallplane single straight mediumsignwriting movement-wallplane single straight largesignwriting movement-wallplane single straight largestsignwriting movement-wallplane single wrist flexsignwriting movement-wallplane double straight" + // U+1D920
+		"signwriting movement-wallplane double wrist flexsignwriting movement-wallplane double alternatingsignwriting movement-wallplane double alternating wrist flexsignwriting movement-wallplane crosssignwriting movement-wallplane triple straight movementsignwriting movement-wallplane triple wrist flexsignwriting movement-wallplane triple alternatingsignwriting movement-wallplane triple alternating wrist flexsignwriting movement-wallplane bend smallsignwriting movement-wallplane bend mediumsignwriting movement-wallplane bend largesignwriting movement-wallplane corner smallsignwriting movement-wallplane corner mediumsignwriting movement-wallplane corner largesignwriting movement-wallplane corner rotationsignwriting movement-wallplane check small" + // U+1D930
+		"signwriting movement-wallplane check mediumsignwriting movement-wallplane check largesignwriting movement-wallplane box smallsignwriting movement-wallplane box mediumsignwriting movement-wallplane box largesignwriting movement-wallplane zigzag smallsignwriting movement-wallplane zigzag mediumsignwriting movement-wallplane zigzag largesignwriting movement-wallplane peaks smallsignwriting movement-wallplane peaks mediumsignwriting movement-wallplane peaks largesignwriting travel-wallplane rotation-wallplane singlesignwriting travel-wallplane rotation-wallplane doublesignwriting travel-wallplane rotation-wallplane alternatingsignwriting travel-wallplane rotation-floorplane singlesignwriting travel-wallplane rotation-floorplane double" + // U+1D940
+		"signwriting travel-wallplane rotation-floorplane alternatingsignwriting travel-wallplane shakingsignwriting travel-wallplane arm spiral singlesignwriting travel-wallplane arm spiral doublesignwriting travel-wallplane arm spiral triplesignwriting movement-diagonal away smallsignwriting movement-diagonal away mediumsignwriting movement-diagonal away largesignwriting movement-diagonal away largestsignwriting movement-diagonal towards smallsignwriting movement-diagonal towards mediumsignwriting movement-diagonal towards largesignwriting movement-diagonal towards largestsignwriting movement-diagonal between away smallsignwriting movement-diagonal between away mediumsignwriting movement-diagonal between away large" + // U+1D950
+		"signwriting movement-diagonal between away largestsignwriting movement-diagonal between towards smallsignwriting movement-diagonal between towards mediumsignwriting movement-diagonal between towards largesignwriting movement-diagonal between towards largestsignwriting movement-floorplane single straight smallsignwriting movement-floorplane single straight mediumsignwriting movement-floorplane single straight largesignwriting movement-floorplane single straight largestsignwriting movement-floorplane single wrist flexsignwriting movement-floorplane double straightsignwriting movement-floorplane double wrist flexsignwriting movement-floorplane double alternatingsignwriting movement-floorplane double alternating wrist flexsignwriting movement-floorplane crosssignwriting movement-floorplane triple straight movement" + // U+1D960
+		"signwriting movement-floorplane triple wrist flexsignwriting movement-floorplane triple alternating movementsignwriting movement-floorplane triple alternating wrist flexsignwriting movement-floorplane bendsignwriting movement-floorplane corner smallsignwriting movement-floorplane corner mediumsignwriting movement-floorplane corner largesignwriting movement-floorplane checksignwriting movement-floorplane box smallsignwriting movement-floorplane box mediumsignwriting movement-floorplane box largesignwriting movement-floorplane zigzag smallsignwriting movement-floorplane zigzag mediumsignwriting movement-floorplane zigzag largesignwriting movement-floorplane peaks smallsignwriting movement-floorplane peaks medium" + // U+1D970
+		"signwriting movement-floorplane peaks largesignwriting travel-floorplane rotation-floorplane singlesignwriting travel-floorplane rotation-floorplane doublesignwriting travel-floorplane rotation-floorplane alternatingsignwriting travel-floorplane rotation-wallplane singlesignwriting travel-floorplane rotation-wallplane doublesignwriting travel-floorplane rotation-wallplane alternatingsignwriting travel-floorplane shakingsignwriting movement-wallplane curve quarter smallsignwriting movement-wallplane curve quarter mediumsignwriting movement-wallplane curve quarter largesignwriting movement-wallplane curve quarter largestsignwriting movement-wallplane curve half-circle smallsignwriting movement-wallplane curve half-circle mediumsignwriting movement-wallplane curve half-circle largesignwriting movement-wallplane curve half-circle largest" + // U+1D980
+		"signwriting movement-wallplane curve three-quarter circle smallsignwriting movement-wallplane curve three-quarter circle mediumsignwriting movement-wallplane hump smallsignwriting movement-wallplane hump mediumsignwriting movement-wallplane hump largesignwriting movement-wallplane loop smallsignwriting movement-wallplane loop mediumsignwriting movement-wallplane loop largesignwriting movement-wallplane loop small doublesignwriting movement-wallplane wave curve double smallsignwriting movement-wallplane wave curve double mediumsignwriting movement-wallplane wave curve double largesignwriting movement-wallplane wave curve triple smallsignwriting movement-wallplane wave curve triple mediumsignwriting movement-wallplane wave curve triple largesignwriting movement-wallplane curve then straight" + // U+1D990
+		"signwriting movement-wallplane curved cross smallsignwriting movement-wallplane curved cross mediumsignwriting rotation-wallplane singlesignwriting rotation-wallplane doublesignwriting rotation-wallplane alternatesignwriting movement-wallplane shakingsignwriting movement-wallplane curve hitting front wallsignwriting movement-wallplane hump hitting front wallsignwriting movement-wallplane loop hitting front wallsignwriting movement-wallplane wave hitting front wallsignwriting rotation-wallplane single hitting front wallsignwriting rotation-wallplane double hitting front wallsignwriting rotation-wallplane alternating hitting front wallsignwriting movement-wallplane curve hitting chestsignwriting movement-wallplane hump hitting chestsignwriting movement-wallplane loop hitting chest" + // U+1D9A0
+		"signwriting movement-wallplane wave hitting chestsignwriting rotation-wallplane single hitting chestsignwriting rotation-wallplane double hitting chestsignwriting rotation-wallplane alternating hitting chestsignwriting movement-wallplane wave diagonal path smallsignwriting movement-wallplane wave diagonal path mediumsignwriting movement-wallplane wave diagonal path largesignwriting movement-floorplane curve hitting ceiling smallsignwriting movement-floorplane curve hitting ceiling largesignwriting movement-floorplane hump hitting ceiling small doublesignwriting movement-floorplane hump hitting ceiling large doublesignwriting movement-floorplane hump hitting ceiling small triplesignwriting movement-floorplane hump hitting ceiling large triplesignwriting movement-floorplane loop hitting ceiling small singlesignwriting movement-floorplane loop hitting ceiling large singlesignwriting movement-floorplane loop hitting ceiling small double" + // U+1D9B0
+		"signwriting movement-floorplane loop hitting ceiling large doublesignwriting movement-floorplane wave hitting ceiling smallsignwriting movement-floorplane wave hitting ceiling largesignwriting rotation-floorplane single hitting ceilingsignwriting rotation-floorplane double hitting ceilingsignwriting rotation-floorplane alternating hitting ceilingsignwriting movement-floorplane curve hitting floor smallsignwriting movement-floorplane curve hitting floor largesignwriting movement-floorplane hump hitting floor small doublesignwriting movement-floorplane hump hitting floor large doublesignwriting movement-floorplane hump hitting floor triple small triplesignwriting movement-floorplane hump hitting floor triple large triplesignwriting movement-floorplane loop hitting floor small singlesignwriting movement-floorplane loop hitting floor large singlesignwriting movement-floorplane loop hitting floor small doublesignwriting movement-floorplane loop hitting floor large double" + // U+1D9C0
+		"signwriting movement-floorplane wave hitting floor smallsignwriting movement-floorplane wave hitting floor largesignwriting rotation-floorplane single hitting floorsignwriting rotation-floorplane double hitting floorsignwriting rotation-floorplane alternating hitting floorsignwriting movement-floorplane curve smallsignwriting movement-floorplane curve mediumsignwriting movement-floorplane curve largesignwriting movement-floorplane curve largestsignwriting movement-floorplane curve combinedsignwriting movement-floorplane hump smallsignwriting movement-floorplane loop smallsignwriting movement-floorplane wave snakesignwriting movement-floorplane wave smallsignwriting movement-floorplane wave largesignwriting rotation-floorplane single" + // U+1D9D0
+		"signwriting rotation-floorplane doublesignwriting rotation-floorplane alternatingsignwriting movement-floorplane shaking parallelsignwriting movement-wallplane arm circle small singlesignwriting movement-wallplane arm circle medium singlesignwriting movement-wallplane arm circle small doublesignwriting movement-wallplane arm circle medium doublesignwriting movement-floorplane arm circle hitting wall small singlesignwriting movement-floorplane arm circle hitting wall medium singlesignwriting movement-floorplane arm circle hitting wall large singlesignwriting movement-floorplane arm circle hitting wall small doublesignwriting movement-floorplane arm circle hitting wall medium doublesignwriting movement-floorplane arm circle hitting wall large doublesignwriting movement-wallplane wrist circle front singlesignwriting movement-wallplane wrist circle front doublesignwriting movement-floorplane wrist circle hitting wall single" + // U+1D9E0
+		"signwriting movement-floorplane wrist circle hitting wall doublesignwriting movement-wallplane finger circles singlesignwriting movement-wallplane finger circles doublesignwriting movement-floorplane finger circles hitting wall singlesignwriting movement-floorplane finger circles hitting wall doublesignwriting dynamic arrowhead smallsignwriting dynamic arrowhead largesignwriting dynamic fastsignwriting dynamic slowsignwriting dynamic tensesignwriting dynamic relaxedsignwriting dynamic simultaneoussignwriting dynamic simultaneous alternatingsignwriting dynamic every other timesignwriting dynamic gradualsignwriting head" + // U+1D9F0
+		"signwriting air blow small rotationssignwriting air suck small rotationssignwriting breath inhalesignwriting breath exhale" + // U+1DA30
+		"signwriting shoulder hip spinesignwriting shoulder hip positionssignwriting wallplane shoulder hip move" + // U+1DA60
+		"signwriting floorplane shoulder hip movesignwriting shoulder tilting from waistsignwriting torso-wallplane straight stretchsignwriting torso-wallplane curved bendsignwriting torso-floorplane twistingsignwriting limb combinationsignwriting limb length-1signwriting limb length-2signwriting limb length-3signwriting limb length-4signwriting limb length-5signwriting limb length-6signwriting limb length-7signwriting fingersignwriting location-wallplane space" + // U+1DA70
+		"signwriting location-floorplane spacesignwriting location heightsignwriting location widthsignwriting location depthsignwriting location torsosignwriting location limbs digitssignwriting commasignwriting full stopsignwriting semicolonsignwriting colonsignwriting parenthesis" + // U+1DA80
+		"modifier letter cyrillic small palochka" + // U+1E050
+		"nyiakeng puachue hmong circled ca" + // U+1E140
+		"wancho ngun sign" + // U+1E2F0
+		"adlam nasalization mark" + // U+1E940
+		"adlam initial exclamation markadlam initial question mark" + // U+1E950
+		"indic siyaq placeholder" + // U+1ECA0
+		"indic siyaq rupee mark" + // U+1ECB0
+		"ottoman siyaq marratan" + // U+1ED20
+		"arabic mathematical dotless beharabic mathematical dotless qaf" + // U+1EE10
+		"arabic mathematical tailed dotless qaf" + // U+1EE50
+		"arabic mathematical stretched dotless beh" + // U+1EE70
+		"arabic mathematical operator meem with hah with tatweelarabic mathematical operator hah with dal" + // U+1EEF0
+		"mahjong tile east windmahjong tile south windmahjong tile west windmahjong tile north windmahjong tile red dragonmahjong tile green dragonmahjong tile white dragonmahjong tile one of charactersmahjong tile two of charactersmahjong tile three of charactersmahjong tile four of charactersmahjong tile five of charactersmahjong tile six of charactersmahjong tile seven of charactersmahjong tile eight of charactersmahjong tile nine of characters" + // U+1F000
+		"mahjong tile one of bamboosmahjong tile two of bamboosmahjong tile three of bamboosmahjong tile four of bamboosmahjong tile five of bamboosmahjong tile six of bamboosmahjong tile seven of bamboosmahjong tile eight of bamboosmahjong tile nine of bamboosmahjong tile one of circlesmahjong tile two of circlesmahjong tile three of circlesmahjong tile four of circlesmahjong tile five of circlesmahjong tile six of circlesmahjong tile seven of circles" + // U+1F010
+		"mahjong tile eight of circlesmahjong tile nine of circlesmahjong tile plummahjong tile orchidmahjong tile bamboomahjong tile chrysanthemummahjong tile springmahjong tile summermahjong tile autumnmahjong tile wintermahjong tile jokermahjong tile back" + // U+1F020
+		"domino tile horizontal backdomino tile horizontal-00-00domino tile horizontal-00-01domino tile horizontal-00-02domino tile horizontal-00-03domino tile horizontal-00-04domino tile horizontal-00-05domino tile horizontal-00-06domino tile horizontal-01-00domino tile horizontal-01-01domino tile horizontal-01-02domino tile horizontal-01-03domino tile horizontal-01-04domino tile horizontal-01-05domino tile horizontal-01-06domino tile horizontal-02-00" + // U+1F030
+		"domino tile horizontal-02-01domino tile horizontal-02-02domino tile horizontal-02-03domino tile horizontal-02-04domino tile horizontal-02-05domino tile horizontal-02-06domino tile horizontal-03-00domino tile horizontal-03-01domino tile horizontal-03-02domino tile horizontal-03-03domino tile horizontal-03-04domino tile horizontal-03-05domino tile horizontal-03-06domino tile horizontal-04-00domino tile horizontal-04-01domino tile horizontal-04-02" + // U+1F040
+		"domino tile horizontal-04-03domino tile horizontal-04-04domino tile horizontal-04-05domino tile horizontal-04-06domino tile horizontal-05-00domino tile horizontal-05-01domino tile horizontal-05-02domino tile horizontal-05-03domino tile horizontal-05-04domino tile horizontal-05-05domino tile horizontal-05-06domino tile horizontal-06-00domino tile horizontal-06-01domino tile horizontal-06-02domino tile horizontal-06-03domino tile horizontal-06-04" + // U+1F050
+		"domino tile horizontal-06-05domino tile horizontal-06-06domino tile vertical backdomino tile vertical-00-00domino tile vertical-00-01domino tile vertical-00-02domino tile vertical-00-03domino tile vertical-00-04domino tile vertical-00-05domino tile vertical-00-06domino tile vertical-01-00domino tile vertical-01-01domino tile vertical-01-02domino tile vertical-01-03domino tile vertical-01-04domino tile vertical-01-05" + // U+1F060
+		"domino tile vertical-01-06domino tile vertical-02-00domino tile vertical-02-01domino tile vertical-02-02domino tile vertical-02-03domino tile vertical-02-04domino tile vertical-02-05domino tile vertical-02-06domino tile vertical-03-00domino tile vertical-03-01domino tile vertical-03-02domino tile vertical-03-03domino tile vertical-03-04domino tile vertical-03-05domino tile vertical-03-06domino tile vertical-04-00" + // U+1F070
+		"domino tile vertical-04-01domino tile vertical-04-02domino tile vertical-04-03domino tile vertical-04-04domino tile vertical-04-05domino tile vertical-04-06domino tile vertical-05-00domino tile vertical-05-01domino tile vertical-05-02domino tile vertical-05-03domino tile vertical-05-04domino tile vertical-05-05domino tile vertical-05-06domino tile vertical-06-00domino tile vertical-06-01domino tile vertical-06-02" + // U+1F080
+		"domino tile vertical-06-03domino tile vertical-06-04domino tile vertical-06-05domino tile vertical-06-06" + // U+1F090
+		"playing card backplaying card ace of spadesplaying card two of spadesplaying card three of spadesplaying card four of spadesplaying card five of spadesplaying card six of spadesplaying card seven of spadesplaying card eight of spadesplaying card nine of spadesplaying card ten of spadesplaying card jack of spadesplaying card knight of spadesplaying card queen of spadesplaying card king of spades" + // U+1F0A0
+		"playing card ace of heartsplaying card two of heartsplaying card three of heartsplaying card four of heartsplaying card five of heartsplaying card six of heartsplaying card seven of heartsplaying card eight of heartsplaying card nine of heartsplaying card ten of heartsplaying card jack of heartsplaying card knight of heartsplaying card queen of heartsplaying card king of heartsplaying card red joker" + // U+1F0B0
+		"playing card ace of diamondsplaying card two of diamondsplaying card three of diamondsplaying card four of diamondsplaying card five of diamondsplaying card six of diamondsplaying card seven of diamondsplaying card eight of diamondsplaying card nine of diamondsplaying card ten of diamondsplaying card jack of diamondsplaying card knight of diamondsplaying card queen of diamondsplaying card king of diamondsplaying card black joker" + // U+1F0C0
+		"playing card ace of clubsplaying card two of clubsplaying card three of clubsplaying card four of clubsplaying card five of clubsplaying card six of clubsplaying card seven of clubsplaying card eight of clubsplaying card nine of clubsplaying card ten of clubsplaying card jack of clubsplaying card knight of clubsplaying card queen of clubsplaying card king of clubsplaying card white joker" + // U+1F0D0
+		"playing card foolplaying card trump-1playing card trump-2playing card trump-3playing card trump-4playing card trump-5playing card trump-6playing card trump-7playing card trump-8playing card trump-9playing card trump-10playing card trump-11playing card trump-12playing card trump-13playing card trump-14playing card trump-15" + // U+1F0E0
+		"playing card trump-16playing card trump-17playing card trump-18playing card trump-19playing card trump-20playing card trump-21" + // U+1F0F0
+		"circled zero with slashcircled anticlockwise arrowcircled dollar sign with overlaid backslash" + // U+1F100
+		"copyleft symbol" + // U+1F120
+		"negative circled latin capital letter anegative circled latin capital letter bnegative circled latin capital letter cnegative circled latin capital letter dnegative circled latin capital letter enegative circled latin capital letter fnegative circled latin capital letter gnegative circled latin capital letter hnegative circled latin capital letter inegative circled latin capital letter jnegative circled latin capital letter knegative circled latin capital letter lnegative circled latin capital letter mnegative circled latin capital letter nnegative circled latin capital letter onegative circled latin capital letter p" + // U+1F150
+		"negative circled latin capital letter qnegative circled latin capital letter rnegative circled latin capital letter snegative circled latin capital letter tnegative circled latin capital letter unegative circled latin capital letter vnegative circled latin capital letter wnegative circled latin capital letter xnegative circled latin capital letter ynegative circled latin capital letter zcircled cccircled c with overlaid backslashcircled human figure" + // U+1F160
+		"negative squared latin capital letter anegative squared latin capital letter bnegative squared latin capital letter cnegative squared latin capital letter dnegative squared latin capital letter enegative squared latin capital letter fnegative squared latin capital letter gnegative squared latin capital letter hnegative squared latin capital letter inegative squared latin capital letter jnegative squared latin capital letter knegative squared latin capital letter lnegative squared latin capital letter mnegative squared latin capital letter nnegative squared latin capital letter onegative squared latin capital letter p" + // U+1F170
+		"negative squared latin capital letter qnegative squared latin capital letter rnegative squared latin capital letter snegative squared latin capital letter tnegative squared latin capital letter unegative squared latin capital letter vnegative squared latin capital letter wnegative squared latin capital letter xnegative squared latin capital letter ynegative squared latin capital letter zcrossed negative squared latin capital letter pnegative squared icnegative squared panegative squared sanegative squared abnegative squared wc" + // U+1F180
+		"squared clsquared coolsquared freesquared idsquared newsquared ngsquared oksquared sossquared up with exclamation marksquared vssquared three dsquared second screensquared two ksquared four ksquared eight k" + // U+1F190
+		"squared five point onesquared seven point onesquared twenty-two point twosquared sixty psquared one hundred twenty psquared latin small letter dsquared hcsquared hdrsquared hi-ressquared losslesssquared shvsquared uhdsquared vodmask work symbol" + // U+1F1A0
+		"regional indicator symbol letter aregional indicator symbol letter bregional indicator symbol letter cregional indicator symbol letter dregional indicator symbol letter eregional indicator symbol letter fregional indicator symbol letter gregional indicator symbol letter hregional indicator symbol letter iregional indicator symbol letter j" + // U+1F1E0
+		"regional indicator symbol letter kregional indicator symbol letter lregional indicator symbol letter mregional indicator symbol letter nregional indicator symbol letter oregional indicator symbol letter pregional indicator symbol letter qregional indicator symbol letter rregional indicator symbol letter sregional indicator symbol letter tregional indicator symbol letter uregional indicator symbol letter vregional indicator symbol letter wregional indicator symbol letter xregional indicator symbol letter yregional indicator symbol letter z" + // U+1F1F0
+		"square hiragana hokasquared katakana kokosquared katakana sa" + // U+1F200
+		"squared katakana de" + // U+1F210
+		"circled ideograph advantagecircled ideograph accept" + // U+1F250
+		"rounded symbol for furounded symbol for lurounded symbol for shourounded symbol for xirounded symbol for shuangxirounded symbol for cai" + // U+1F260
+		"cyclonefoggyclosed umbrellanight with starssunrise over mountainssunrisecityscape at dusksunset over buildingsrainbowbridge at nightwater wavevolcanomilky wayearth globe europe-africaearth globe americasearth globe asia-australia" + // U+1F300
+		"globe with meridiansnew moon symbolwaxing crescent moon symbolfirst quarter moon symbolwaxing gibbous moon symbolfull moon symbolwaning gibbous moon symbollast quarter moon symbolwaning crescent moon symbolcrescent moonnew moon with facefirst quarter moon with facelast quarter moon with facefull moon with facesun with faceglowing star" + // U+1F310
+		"shooting starthermometerblack dropletwhite sunwhite sun with small cloudwhite sun behind cloudwhite sun behind cloud with raincloud with raincloud with snowcloud with lightningcloud with tornadofogwind blowing facehot dogtacoburrito" + // U+1F320
+		"chestnutseedlingevergreen treedeciduous treepalm treecactushot peppertulipcherry blossomrosehibiscussunflowerblossomear of maizeear of riceherb" + // U+1F330
+		"four leaf clovermaple leaffallen leafleaf fluttering in windmushroomtomatoauberginegrapesmelonwatermelontangerinelemonbananapineapplered applegreen apple" + // U+1F340
+		"pearpeachcherriesstrawberryhamburgerslice of pizzameat on bonepoultry legrice crackerrice ballcooked ricecurry and ricesteaming bowlspaghettibreadfrench fries" + // U+1F350
+		"roasted sweet potatodangoodensushifried shrimpfish cake with swirl designsoft ice creamshaved iceice creamdoughnutcookiechocolate barcandylollipopcustardhoney pot" + // U+1F360
+		"shortcakebento boxpot of foodcookingfork and knifeteacup without handlesake bottle and cupwine glasscocktail glasstropical drinkbeer mugclinking beer mugsbaby bottlefork and knife with platebottle with popping corkpopcorn" + // U+1F370
+		"ribbonwrapped presentbirthday cakejack-o-lanternchristmas treefather christmasfireworksfirework sparklerballoonparty popperconfetti balltanabata treecrossed flagspine decorationjapanese dollscarp streamer" + // U+1F380
+		"wind chimemoon viewing ceremonyschool satchelgraduation capheart with tip on the leftbouquet of flowersmilitary medalreminder ribbonmusical keyboard with jacksstudio microphonelevel slidercontrol knobsbeamed ascending musical notesbeamed descending musical notesfilm framesadmission tickets" + // U+1F390
+		"carousel horseferris wheelroller coasterfishing pole and fishmicrophonemovie cameracinemaheadphoneartist palettetop hatcircus tentticketclapper boardperforming artsvideo gamedirect hit" + // U+1F3A0
+		"slot machinebilliardsgame diebowlingflower playing cardsmusical notemultiple musical notessaxophoneguitarmusical keyboardtrumpetviolinmusical scorerunning shirt with sashtennis racquet and ballski and ski boot" + // U+1F3B0
+		"basketball and hoopchequered flagsnowboarderrunnersurfersports medaltrophyhorse racingamerican footballrugby footballswimmerweight liftergolferracing motorcycleracing carcricket bat and ball" + // U+1F3C0
+		"volleyballfield hockey stick and ballice hockey stick and pucktable tennis paddle and ballsnow capped mountaincampingbeach with umbrellabuilding constructionhouse buildingscityscapederelict house buildingclassical buildingdesertdesert islandnational parkstadium" + // U+1F3D0
+		"house buildinghouse with gardenoffice buildingjapanese post officeeuropean post officehospitalbankautomated teller machinehotellove hotelconvenience storeschooldepartment storefactoryizakaya lanternjapanese castle" + // U+1F3E0
+		"european castlewhite pennantblack pennantwaving white flagwaving black flagrosetteblack rosettelabelbadminton racquet and shuttlecockbow and arrowamphoraemoji modifier fitzpatrick type-1-2emoji modifier fitzpatrick type-3emoji modifier fitzpatrick type-4emoji modifier fitzpatrick type-5emoji modifier fitzpatrick type-6" + // U+1F3F0
+		"ratmouseoxwater buffalocowtigerleopardrabbitcatdragoncrocodilewhalesnailsnakehorseram" + // U+1F400
+		"goatsheepmonkeyroosterchickendogpigboarelephantoctopusspiral shellbuganthoneybeelady beetlefish" + // U+1F410
+		"tropical fishblowfishturtlehatching chickbaby chickfront-facing baby chickbirdpenguinkoalapoodledromedary camelbactrian cameldolphinmouse facecow facetiger face" + // U+1F420
+		"rabbit facecat facedragon facespouting whalehorse facemonkey facedog facepig facefrog facehamster facewolf facebear facepanda facepig nosepaw printschipmunk" + // U+1F430
+		"eyeseyeearnosemouthtonguewhite up pointing backhand indexwhite down pointing backhand indexwhite left pointing backhand indexwhite right pointing backhand indexfisted hand signwaving hand signok hand signthumbs up signthumbs down signclapping hands sign" + // U+1F440
+		"open hands signcrownwomans hateyeglassesnecktiet-shirtjeansdresskimonobikiniwomans clothespursehandbagpouchmans shoeathletic shoe" + // U+1F450
+		"high-heeled shoewomans sandalwomans bootsfootprintsbust in silhouettebusts in silhouetteboygirlmanwomanfamilyman and woman holding handstwo men holding handstwo women holding handspolice officerwoman with bunny ears" + // U+1F460
+		"bride with veilperson with blond hairman with gua pi maoman with turbanolder manolder womanbabyconstruction workerprincessjapanese ogrejapanese goblinghostbaby angelextraterrestrial alienalien monsterimp" + // U+1F470
+		"skullinformation desk personguardsmandancerlipsticknail polishface massagehaircutbarber polesyringepillkiss marklove letterringgem stonekiss" + // U+1F480
+		"bouquetcouple with heartweddingbeating heartbroken hearttwo heartssparkling heartgrowing heartheart with arrowblue heartgreen heartyellow heartpurple heartheart with ribbonrevolving heartsheart decoration" + // U+1F490
+		"diamond shape with a dot insideelectric light bulbanger symbolbombsleeping symbolcollision symbolsplashing sweat symboldropletdash symbolpile of pooflexed bicepsdizzy symbolspeech balloonthought balloonwhite flowerhundred points symbol" + // U+1F4A0
+		"money bagcurrency exchangeheavy dollar signcredit cardbanknote with yen signbanknote with dollar signbanknote with euro signbanknote with pound signmoney with wingschart with upwards trend and yen signseatpersonal computerbriefcaseminidiscfloppy diskoptical disc" + // U+1F4B0
+		"dvdfile folderopen file folderpage with curlpage facing upcalendartear-off calendarcard indexchart with upwards trendchart with downwards trendbar chartclipboardpushpinround pushpinpaperclipstraight ruler" + // U+1F4C0
+		"triangular rulerbookmark tabsledgernotebooknotebook with decorative coverclosed bookopen bookgreen bookblue bookorange bookbooksname badgescrollmemotelephone receiverpager" + // U+1F4D0
+		"fax machinesatellite antennapublic address loudspeakercheering megaphoneoutbox trayinbox traypackagee-mail symbolincoming envelopeenvelope with downwards arrow aboveclosed mailbox with lowered flagclosed mailbox with raised flagopen mailbox with raised flagopen mailbox with lowered flagpostboxpostal horn" + // U+1F4E0
+		"newspapermobile phonemobile phone with rightwards arrow at leftvibration modemobile phone offno mobile phonesantenna with barscameracamera with flashvideo cameratelevisionradiovideocassettefilm projectorportable stereoprayer beads" + // U+1F4F0
+		"twisted rightwards arrowsclockwise rightwards and leftwards open circle arrowsclockwise rightwards and leftwards open circle arrows with circled one overlayclockwise downwards and upwards open circle arrowsanticlockwise downwards and upwards open circle arrowslow brightness symbolhigh brightness symbolspeaker with cancellation strokespeakerspeaker with one sound wavespeaker with three sound wavesbatteryelectric plugleft-pointing magnifying glassright-pointing magnifying glasslock with ink pen" + // U+1F500
+		"closed lock with keykeylockopen lockbellbell with cancellation strokebookmarklink symbolradio buttonback with leftwards arrow aboveend with leftwards arrow aboveon with exclamation mark with left right arrow abovesoon with rightwards arrow abovetop with upwards arrow aboveno one under eighteen symbolkeycap ten" + // U+1F510
+		"input symbol for latin capital lettersinput symbol for latin small lettersinput symbol for numbersinput symbol for symbolsinput symbol for latin lettersfireelectric torchwrenchhammernut and bolthochopistolmicroscopetelescopecrystal ballsix pointed star with middle dot" + // U+1F520
+		"japanese symbol for beginnertrident emblemblack square buttonwhite square buttonlarge red circlelarge blue circlelarge orange diamondlarge blue diamondsmall orange diamondsmall blue diamondup-pointing red triangledown-pointing red triangleup-pointing small red triangledown-pointing small red trianglelower right shadowed white circleupper right shadowed white circle" + // U+1F530
+		"circled cross pommeecross pommee with half-circle belowcross pommeenotched left semicircle with three dotsnotched right semicircle with three dotssymbol for marks chapterwhite latin crossheavy latin crossceltic crossom symboldove of peacekaabamosquesynagoguemenorah with nine branchesbowl of hygieia" + // U+1F540
+		"clock face one oclockclock face two oclockclock face three oclockclock face four oclockclock face five oclockclock face six oclockclock face seven oclockclock face eight oclockclock face nine oclockclock face ten oclockclock face eleven oclockclock face twelve oclockclock face one-thirtyclock face two-thirtyclock face three-thirtyclock face four-thirty" + // U+1F550
+		"clock face five-thirtyclock face six-thirtyclock face seven-thirtyclock face eight-thirtyclock face nine-thirtyclock face ten-thirtyclock face eleven-thirtyclock face twelve-thirtyright speakerright speaker with one sound waveright speaker with three sound wavesbullhornbullhorn with sound wavesringing bellbookcandle" + // U+1F560
+		"mantelpiece clockblack skull and crossbonesno piracyholeman in business suit levitatingsleuth or spydark sunglassesspiderspider webjoystickman dancingleft hand telephone receivertelephone receiver with pageright hand telephone receiverwhite touchtone telephoneblack touchtone telephone" + // U+1F570
+		"telephone on top of modemclamshell mobile phoneback of envelopestamped envelopeenvelope with lightningflying envelopepen over stamped envelopelinked paperclipsblack pushpinlower left pencillower left ballpoint penlower left fountain penlower left paintbrushlower left crayonleft writing handturned ok hand sign" + // U+1F580
+		"raised hand with fingers splayedreversed raised hand with fingers splayedreversed thumbs up signreversed thumbs down signreversed victory handreversed hand with middle finger extendedraised hand with part between middle and ring fingerswhite down pointing left hand indexsideways white left pointing indexsideways white right pointing indexsideways black left pointing indexsideways black right pointing indexblack left pointing backhand indexblack right pointing backhand indexsideways white up pointing indexsideways white down pointing index" + // U+1F590
+		"sideways black up pointing indexsideways black down pointing indexblack up pointing backhand indexblack down pointing backhand indexblack heartdesktop computerkeyboard and mousethree networked computersprinterpocket calculatorblack hard shell floppy diskwhite hard shell floppy disksoft shell floppy disktape cartridgewired keyboardone button mouse" + // U+1F5A0
+		"two button mousethree button mousetrackballold personal computerhard diskscreenprinter iconfax iconoptical disc icondocument with textdocument with text and picturedocument with pictureframe with pictureframe with tilesframe with an xblack folder" + // U+1F5B0
+		"folderopen foldercard index dividerscard file boxfile cabinetempty noteempty note pageempty note padnotenote pagenote padempty documentempty pageempty pagesdocumentpage" + // U+1F5C0
+		"pageswastebasketspiral note padspiral calendar paddesktop windowminimizemaximizeoverlapclockwise right and left semicircle arrowscancellation xincrease font size symboldecrease font size symbolcompressionold keyrolled-up newspaperpage with circled text" + // U+1F5D0
+		"stock chartdagger knifelipsspeaking head in silhouettethree rays abovethree rays belowthree rays leftthree rays rightleft speech bubbleright speech bubbletwo speech bubblesthree speech bubblesleft thought bubbleright thought bubbleleft anger bubbleright anger bubble" + // U+1F5E0
+		"mood bubblelightning mood bubblelightning moodballot box with ballotballot script xballot box with script xballot bold script xballot box with bold script xlight check markballot box with bold checkworld mapmount fujitokyo towerstatue of libertysilhouette of japanmoyai" + // U+1F5F0
+		"grinning facegrinning face with smiling eyesface with tears of joysmiling face with open mouthsmiling face with open mouth and smiling eyessmiling face with open mouth and cold sweatsmiling face with open mouth and tightly-closed eyessmiling face with halosmiling face with hornswinking facesmiling face with smiling eyesface savouring delicious foodrelieved facesmiling face with heart-shaped eyessmiling face with sunglassessmirking face" + // U+1F600
+		"neutral faceexpressionless faceunamused faceface with cold sweatpensive faceconfused faceconfounded facekissing faceface throwing a kisskissing face with smiling eyeskissing face with closed eyesface with stuck-out tongueface with stuck-out tongue and winking eyeface with stuck-out tongue and tightly-closed eyesdisappointed faceworried face" + // U+1F610
+		"angry facepouting facecrying facepersevering faceface with look of triumphdisappointed but relieved facefrowning face with open mouthanguished facefearful faceweary facesleepy facetired facegrimacing faceloudly crying faceface with open mouthhushed face" + // U+1F620
+		"face with open mouth and cold sweatface screaming in fearastonished faceflushed facesleeping facedizzy faceface without mouthface with medical maskgrinning cat face with smiling eyescat face with tears of joysmiling cat face with open mouthsmiling cat face with heart-shaped eyescat face with wry smilekissing cat face with closed eyespouting cat facecrying cat face" + // U+1F630
+		"weary cat faceslightly frowning faceslightly smiling faceupside-down faceface with rolling eyesface with no good gestureface with ok gestureperson bowing deeplysee-no-evil monkeyhear-no-evil monkeyspeak-no-evil monkeyhappy person raising one handperson raising both hands in celebrationperson frowningperson with pouting faceperson with folded hands" + // U+1F640
+		"north west pointing leafsouth west pointing leafnorth east pointing leafsouth east pointing leafturned north west pointing leafturned south west pointing leafturned north east pointing leafturned south east pointing leafnorth west pointing vine leafsouth west pointing vine leafnorth east pointing vine leafsouth east pointing vine leafheavy north west pointing vine leafheavy south west pointing vine leafheavy north east pointing vine leafheavy south east pointing vine leaf" + // U+1F650
+		"north west pointing budsouth west pointing budnorth east pointing budsouth east pointing budheavy north west pointing budheavy south west pointing budheavy north east pointing budheavy south east pointing budhollow quilt square ornamenthollow quilt square ornament in black squaresolid quilt square ornamentsolid quilt square ornament in black squareleftwards rocketupwards rocketrightwards rocketdownwards rocket" + // U+1F660
+		"script ligature et ornamentheavy script ligature et ornamentligature open et ornamentheavy ligature open et ornamentheavy ampersand ornamentswash ampersand ornamentsans-serif heavy double turned comma quotation mark ornamentsans-serif heavy double comma quotation mark ornamentsans-serif heavy low double comma quotation mark ornamentheavy interrobang ornamentsans-serif interrobang ornamentheavy sans-serif interrobang ornamentvery heavy solidusvery heavy reverse soliduschecker boardreverse checker board" + // U+1F670
+		"rockethelicoptersteam locomotiverailway carhigh-speed trainhigh-speed train with bullet nosetrainmetrolight railstationtramtram carbusoncoming bustrolleybusbus stop" + // U+1F680
+		"minibusambulancefire enginepolice caroncoming police cartaxioncoming taxiautomobileoncoming automobilerecreational vehicledelivery truckarticulated lorrytractormonorailmountain railwaysuspension railway" + // U+1F690
+		"mountain cablewayaerial tramwayshiprowboatspeedboathorizontal traffic lightvertical traffic lightconstruction signpolice cars revolving lighttriangular flag on postdoorno entry signsmoking symbolno smoking symbolput litter in its place symboldo not litter symbol" + // U+1F6A0
+		"potable water symbolnon-potable water symbolbicycleno bicyclesbicyclistmountain bicyclistpedestrianno pedestrianschildren crossingmens symbolwomens symbolrestroombaby symboltoiletwater closetshower" + // U+1F6B0
+		"bathbathtubpassport controlcustomsbaggage claimleft luggagetriangle with rounded cornersprohibited signcircled information sourceboys symbolgirls symbolcouch and lampsleeping accommodationshopping bagsbellhop bellbed" + // U+1F6C0
+		"place of worshipoctagonal signshopping trolleystupapagodahindu templehutelevatorwirelessplayground slidewheelring buoy" + // U+1F6D0
+		"hammer and wrenchshieldoil drummotorwayrailway trackmotor boatup-pointing military airplaneup-pointing airplaneup-pointing small airplanesmall airplanenortheast-pointing airplaneairplane departureairplane arriving" + // U+1F6E0
+		"satelliteoncoming fire enginediesel locomotivepassenger shipscootermotor scootercanoesledflying saucerskateboardauto rickshawpickup truckroller skate" + // U+1F6F0
+		"alchemical symbol for quintessencealchemical symbol for airalchemical symbol for firealchemical symbol for earthalchemical symbol for wateralchemical symbol for aquafortisalchemical symbol for aqua regiaalchemical symbol for aqua regia-2alchemical symbol for aqua vitaealchemical symbol for aqua vitae-2alchemical symbol for vinegaralchemical symbol for vinegar-2alchemical symbol for vinegar-3alchemical symbol for sulfuralchemical symbol for philosophers sulfuralchemical symbol for black sulfur" + // U+1F700
+		"alchemical symbol for mercury sublimatealchemical symbol for mercury sublimate-2alchemical symbol for mercury sublimate-3alchemical symbol for cinnabaralchemical symbol for saltalchemical symbol for nitrealchemical symbol for vitriolalchemical symbol for vitriol-2alchemical symbol for rock saltalchemical symbol for rock salt-2alchemical symbol for goldalchemical symbol for silveralchemical symbol for iron orealchemical symbol for iron ore-2alchemical symbol for crocus of ironalchemical symbol for regulus of iron" + // U+1F710
+		"alchemical symbol for copper orealchemical symbol for iron-copper orealchemical symbol for sublimate of copperalchemical symbol for crocus of copperalchemical symbol for crocus of copper-2alchemical symbol for copper antimoniatealchemical symbol for salt of copper antimoniatealchemical symbol for sublimate of salt of copperalchemical symbol for verdigrisalchemical symbol for tin orealchemical symbol for lead orealchemical symbol for antimony orealchemical symbol for sublimate of antimonyalchemical symbol for salt of antimonyalchemical symbol for sublimate of salt of antimonyalchemical symbol for vinegar of antimony" + // U+1F720
+		"alchemical symbol for regulus of antimonyalchemical symbol for regulus of antimony-2alchemical symbol for regulusalchemical symbol for regulus-2alchemical symbol for regulus-3alchemical symbol for regulus-4alchemical symbol for alkalialchemical symbol for alkali-2alchemical symbol for marcasitealchemical symbol for sal-ammoniacalchemical symbol for arsenicalchemical symbol for realgaralchemical symbol for realgar-2alchemical symbol for auripigmentalchemical symbol for bismuth orealchemical symbol for tartar" + // U+1F730
+		"alchemical symbol for tartar-2alchemical symbol for quick limealchemical symbol for boraxalchemical symbol for borax-2alchemical symbol for borax-3alchemical symbol for alumalchemical symbol for oilalchemical symbol for spiritalchemical symbol for tincturealchemical symbol for gumalchemical symbol for waxalchemical symbol for powderalchemical symbol for calxalchemical symbol for tuttyalchemical symbol for caput mortuumalchemical symbol for scepter of jove" + // U+1F740
+		"alchemical symbol for caduceusalchemical symbol for tridentalchemical symbol for starred tridentalchemical symbol for lodestonealchemical symbol for soapalchemical symbol for urinealchemical symbol for horse dungalchemical symbol for ashesalchemical symbol for pot ashesalchemical symbol for brickalchemical symbol for powdered brickalchemical symbol for amalgamalchemical symbol for stratum super stratumalchemical symbol for stratum super stratum-2alchemical symbol for sublimationalchemical symbol for precipitate" + // U+1F750
+		"alchemical symbol for distillalchemical symbol for dissolvealchemical symbol for dissolve-2alchemical symbol for purifyalchemical symbol for putrefactionalchemical symbol for cruciblealchemical symbol for crucible-2alchemical symbol for crucible-3alchemical symbol for crucible-4alchemical symbol for crucible-5alchemical symbol for alembicalchemical symbol for bath of maryalchemical symbol for bath of vapoursalchemical symbol for retortalchemical symbol for houralchemical symbol for night" + // U+1F760
+		"alchemical symbol for day-nightalchemical symbol for monthalchemical symbol for half dramalchemical symbol for half ouncelot of fortuneoccultationlunar eclipsehaumeamakemakegonggongquaoarorcus" + // U+1F770
+		"black left-pointing isosceles right triangleblack up-pointing isosceles right triangleblack right-pointing isosceles right triangleblack down-pointing isosceles right triangleblack slightly small circlemedium bold white circlebold white circleheavy white circlevery heavy white circleextremely heavy white circlewhite circle containing black small circleround targetblack tiny squareblack slightly small squarelight white squaremedium white square" + // U+1F780
+		"bold white squareheavy white squarevery heavy white squareextremely heavy white squarewhite square containing black very small squarewhite square containing black medium squaresquare targetblack tiny diamondblack very small diamondblack medium small diamondwhite diamond containing black very small diamondwhite diamond containing black medium diamonddiamond targetblack tiny lozengeblack very small lozengeblack medium small lozenge" + // U+1F790
+		"white lozenge containing black small lozengethin greek crosslight greek crossmedium greek crossbold greek crossvery bold greek crossvery heavy greek crossextremely heavy greek crossthin saltirelight saltiremedium saltirebold saltireheavy saltirevery heavy saltireextremely heavy saltirelight five spoked asterisk" + // U+1F7A0
+		"medium five spoked asteriskbold five spoked asteriskheavy five spoked asteriskvery heavy five spoked asteriskextremely heavy five spoked asterisklight six spoked asteriskmedium six spoked asteriskbold six spoked asteriskheavy six spoked asteriskvery heavy six spoked asteriskextremely heavy six spoked asterisklight eight spoked asteriskmedium eight spoked asteriskbold eight spoked asteriskheavy eight spoked asteriskvery heavy eight spoked asterisk" + // U+1F7B0
+		"light three pointed black starmedium three pointed black starthree pointed black starmedium three pointed pinwheel starlight four pointed black starmedium four pointed black starfour pointed black starmedium four pointed pinwheel starreverse light four pointed pinwheel starlight five pointed black starheavy five pointed black starmedium six pointed black starheavy six pointed black starsix pointed pinwheel starmedium eight pointed black starheavy eight pointed black star" + // U+1F7C0
+		"very heavy eight pointed black starheavy eight pointed pinwheel starlight twelve pointed black starheavy twelve pointed black starheavy twelve pointed pinwheel starcircled trianglenegative circled trianglecircled squarenegative circled squarenine pointed white star" + // U+1F7D0
+		"large orange circlelarge yellow circlelarge green circlelarge purple circlelarge brown circlelarge red squarelarge blue squarelarge orange squarelarge yellow squarelarge green squarelarge purple squarelarge brown square" + // U+1F7E0
+		"heavy equals sign" + // U+1F7F0
+		"leftwards arrow with small triangle arrowheadupwards arrow with small triangle arrowheadrightwards arrow with small triangle arrowheaddownwards arrow with small triangle arrowheadleftwards arrow with medium triangle arrowheadupwards arrow with medium triangle arrowheadrightwards arrow with medium triangle arrowheaddownwards arrow with medium triangle arrowheadleftwards arrow with large triangle arrowheadupwards arrow with large triangle arrowheadrightwards arrow with large triangle arrowheaddownwards arrow with large triangle arrowhead" + // U+1F800
+		"leftwards arrow with small equilateral arrowheadupwards arrow with small equilateral arrowheadrightwards arrow with small equilateral arrowheaddownwards arrow with small equilateral arrowheadleftwards arrow with equilateral arrowheadupwards arrow with equilateral arrowheadrightwards arrow with equilateral arrowheaddownwards arrow with equilateral arrowheadheavy leftwards arrow with equilateral arrowheadheavy upwards arrow with equilateral arrowheadheavy rightwards arrow with equilateral arrowheadheavy downwards arrow with equilateral arrowheadheavy leftwards arrow with large equilateral arrowheadheavy upwards arrow with large equilateral arrowheadheavy rightwards arrow with large equilateral arrowheadheavy downwards arrow with large equilateral arrowhead" + // U+1F810
+		"leftwards triangle-headed arrow with narrow shaftupwards triangle-headed arrow with narrow shaftrightwards triangle-headed arrow with narrow shaftdownwards triangle-headed arrow with narrow shaftleftwards triangle-headed arrow with medium shaftupwards triangle-headed arrow with medium shaftrightwards triangle-headed arrow with medium shaftdownwards triangle-headed arrow with medium shaftleftwards triangle-headed arrow with bold shaftupwards triangle-headed arrow with bold shaftrightwards triangle-headed arrow with bold shaftdownwards triangle-headed arrow with bold shaftleftwards triangle-headed arrow with heavy shaftupwards triangle-headed arrow with heavy shaftrightwards triangle-headed arrow with heavy shaftdownwards triangle-headed arrow with heavy shaft" + // U+1F820
+		"leftwards triangle-headed arrow with very heavy shaftupwards triangle-headed arrow with very heavy shaftrightwards triangle-headed arrow with very heavy shaftdownwards triangle-headed arrow with very heavy shaftleftwards finger-post arrowupwards finger-post arrowrightwards finger-post arrowdownwards finger-post arrowleftwards squared arrowupwards squared arrowrightwards squared arrowdownwards squared arrowleftwards compressed arrowupwards compressed arrowrightwards compressed arrowdownwards compressed arrow" + // U+1F830
+		"leftwards heavy compressed arrowupwards heavy compressed arrowrightwards heavy compressed arrowdownwards heavy compressed arrowleftwards heavy arrowupwards heavy arrowrightwards heavy arrowdownwards heavy arrow" + // U+1F840
+		"leftwards sans-serif arrowupwards sans-serif arrowrightwards sans-serif arrowdownwards sans-serif arrownorth west sans-serif arrownorth east sans-serif arrowsouth east sans-serif arrowsouth west sans-serif arrowleft right sans-serif arrowup down sans-serif arrow" + // U+1F850
+		"wide-headed leftwards light barb arrowwide-headed upwards light barb arrowwide-headed rightwards light barb arrowwide-headed downwards light barb arrowwide-headed north west light barb arrowwide-headed north east light barb arrowwide-headed south east light barb arrowwide-headed south west light barb arrowwide-headed leftwards barb arrowwide-headed upwards barb arrowwide-headed rightwards barb arrowwide-headed downwards barb arrowwide-headed north west barb arrowwide-headed north east barb arrowwide-headed south east barb arrowwide-headed south west barb arrow" + // U+1F860
+		"wide-headed leftwards medium barb arrowwide-headed upwards medium barb arrowwide-headed rightwards medium barb arrowwide-headed downwards medium barb arrowwide-headed north west medium barb arrowwide-headed north east medium barb arrowwide-headed south east medium barb arrowwide-headed south west medium barb arrowwide-headed leftwards heavy barb arrowwide-headed upwards heavy barb arrowwide-headed rightwards heavy barb arrowwide-headed downwards heavy barb arrowwide-headed north west heavy barb arrowwide-headed north east heavy barb arrowwide-headed south east heavy barb arrowwide-headed south west heavy barb arrow" + // U+1F870
+		"wide-headed leftwards very heavy barb arrowwide-headed upwards very heavy barb arrowwide-headed rightwards very heavy barb arrowwide-headed downwards very heavy barb arrowwide-headed north west very heavy barb arrowwide-headed north east very heavy barb arrowwide-headed south east very heavy barb arrowwide-headed south west very heavy barb arrow" + // U+1F880
+		"leftwards triangle arrowheadupwards triangle arrowheadrightwards triangle arrowheaddownwards triangle arrowheadleftwards white arrow within triangle arrowheadupwards white arrow within triangle arrowheadrightwards white arrow within triangle arrowheaddownwards white arrow within triangle arrowheadleftwards arrow with notched tailupwards arrow with notched tailrightwards arrow with notched taildownwards arrow with notched tailheavy arrow shaft width oneheavy arrow shaft width two thirdsheavy arrow shaft width one halfheavy arrow shaft width one third" + // U+1F890
+		"leftwards bottom-shaded white arrowrightwards bottom shaded white arrowleftwards top shaded white arrowrightwards top shaded white arrowleftwards left-shaded white arrowrightwards right-shaded white arrowleftwards right-shaded white arrowrightwards left-shaded white arrowleftwards back-tilted shadowed white arrowrightwards back-tilted shadowed white arrowleftwards front-tilted shadowed white arrowrightwards front-tilted shadowed white arrowwhite arrow shaft width onewhite arrow shaft width two thirds" + // U+1F8A0
+		"arrow pointing upwards then north westarrow pointing rightwards then curving south west" + // U+1F8B0
+		"circled cross formee with four dotscircled cross formee with two dotscircled cross formeeleft half circle with four dotsleft half circle with three dotsleft half circle with two dotsleft half circle with dotleft half circledownward facing hookdownward facing notched hookdownward facing hook with dotdownward facing notched hook with dotpinched fingerswhite heartbrown heartpinching hand" + // U+1F900
+		"zipper-mouth facemoney-mouth faceface with thermometernerd facethinking faceface with head-bandagerobot facehugging facesign of the hornscall me handraised back of handleft-facing fistright-facing fisthandshakehand with index and middle fingers crossedi love you hand sign" + // U+1F910
+		"face with cowboy hatclown facenauseated facerolling on the floor laughingdrooling facelying faceface palmsneezing faceface with one eyebrow raisedgrinning face with star eyesgrinning face with one large and one small eyeface with finger covering closed lipsserious face with symbols covering mouthsmiling face with smiling eyes and hand covering mouthface with open mouth vomitingshocked face with exploding head" + // U+1F920
+		"pregnant womanbreast-feedingpalms up togetherselfieprinceman in tuxedomother christmasshrugperson doing cartwheeljugglingfencermodern pentathlonwrestlerswater polohandballdiving mask" + // U+1F930
+		"wilted flowerdrum with drumsticksclinking glassestumbler glassspoongoal netriflefirst place medalsecond place medalthird place medalboxing glovemartial arts uniformcurling stonelacrosse stick and ballsoftballflying disc" + // U+1F940
+		"croissantavocadocucumberbaconpotatocarrotbaguette breadgreen saladshallow pan of foodstuffed flatbreadeggglass of milkpeanutskiwifruitpancakesdumpling" + // U+1F950
+		"fortune cookietakeout boxchopsticksbowl with spooncup with strawcoconutbroccolipiepretzelcut of meatsandwichcanned foodleafy greenmangomoon cakebagel" + // U+1F960
+		"smiling face with smiling eyes and three heartsyawning facesmiling face with tearface with party horn and party hatface with uneven eyes and wavy mouthoverheated facefreezing faceninjadisguised faceface holding back tearsface with pleading eyessarilab coatgoggleshiking bootflat shoe" + // U+1F970
+		"crablion facescorpionturkeyunicorn faceeagleduckbatsharkowlfox facebutterflydeergorillalizardrhinoceros" + // U+1F980
+		"shrimpsquidgiraffe facezebra facehedgehogsauropodt-rexcricketkangaroollamapeacockhippopotamusparrotraccoonlobstermosquito" + // U+1F990
+		"microbebadgerswanmammothdodoslothotterorangutanskunkflamingooysterbeaverbisonsealguide dogprobing cane" + // U+1F9A0
+		"emoji component red hairemoji component curly hairemoji component baldemoji component white hairbonelegfoottoothsuperherosupervillainsafety vestear with hearing aidmotorized wheelchairmanual wheelchairmechanical armmechanical leg" + // U+1F9B0
+		"cheese wedgecupcakesalt shakerbeverage boxgarliconionfalafelwafflebuttermate drinkice cubebubble teatrollstanding personkneeling persondeaf person" + // U+1F9C0
+		"face with monocleadultchildolder adultbearded personperson with headscarfperson in steamy roomperson climbingperson in lotus positionmagefairyvampiremerpersonelfgeniezombie" + // U+1F9D0
+		"brainorange heartbilled capscarfglovescoatsocksred gift envelopefirecrackerjigsaw puzzle piecetest tubepetri dishdna double helixcompassabacusfire extinguisher" + // U+1F9E0
+		"toolboxbrickmagnetluggagelotion bottlespool of threadball of yarnsafety pinteddy bearbroombasketroll of paperbar of soapspongereceiptnazar amulet" + // U+1F9F0
+		"neutral chess kingneutral chess queenneutral chess rookneutral chess bishopneutral chess knightneutral chess pawnwhite chess knight rotated forty-five degreesblack chess knight rotated forty-five degreesneutral chess knight rotated forty-five degreeswhite chess king rotated ninety degreeswhite chess queen rotated ninety degreeswhite chess rook rotated ninety degreeswhite chess bishop rotated ninety degreeswhite chess knight rotated ninety degreeswhite chess pawn rotated ninety degreesblack chess king rotated ninety degrees" + // U+1FA00
+		"black chess queen rotated ninety degreesblack chess rook rotated ninety degreesblack chess bishop rotated ninety degreesblack chess knight rotated ninety degreesblack chess pawn rotated ninety degreesneutral chess king rotated ninety degreesneutral chess queen rotated ninety degreesneutral chess rook rotated ninety degreesneutral chess bishop rotated ninety degreesneutral chess knight rotated ninety degreesneutral chess pawn rotated ninety degreeswhite chess knight rotated one hundred thirty-five degreesblack chess knight rotated one hundred thirty-five degreesneutral chess knight rotated one hundred thirty-five degreeswhite chess turned kingwhite chess turned queen" + // U+1FA10
+		"white chess turned rookwhite chess turned bishopwhite chess turned knightwhite chess turned pawnblack chess turned kingblack chess turned queenblack chess turned rookblack chess turned bishopblack chess turned knightblack chess turned pawnneutral chess turned kingneutral chess turned queenneutral chess turned rookneutral chess turned bishopneutral chess turned knightneutral chess turned pawn" + // U+1FA20
+		"white chess knight rotated two hundred twenty-five degreesblack chess knight rotated two hundred twenty-five degreesneutral chess knight rotated two hundred twenty-five degreeswhite chess king rotated two hundred seventy degreeswhite chess queen rotated two hundred seventy degreeswhite chess rook rotated two hundred seventy degreeswhite chess bishop rotated two hundred seventy degreeswhite chess knight rotated two hundred seventy degreeswhite chess pawn rotated two hundred seventy degreesblack chess king rotated two hundred seventy degreesblack chess queen rotated two hundred seventy degreesblack chess rook rotated two hundred seventy degreesblack chess bishop rotated two hundred seventy degreesblack chess knight rotated two hundred seventy degreesblack chess pawn rotated two hundred seventy degreesneutral chess king rotated two hundred seventy degrees" + // U+1FA30
+		"neutral chess queen rotated two hundred seventy degreesneutral chess rook rotated two hundred seventy degreesneutral chess bishop rotated two hundred seventy degreesneutral chess knight rotated two hundred seventy degreesneutral chess pawn rotated two hundred seventy degreeswhite chess knight rotated three hundred fifteen degreesblack chess knight rotated three hundred fifteen degreesneutral chess knight rotated three hundred fifteen degreeswhite chess equihopperblack chess equihopperneutral chess equihopperwhite chess equihopper rotated ninety degreesblack chess equihopper rotated ninety degreesneutral chess equihopper rotated ninety degreeswhite chess knight-queenwhite chess knight-rook" + // U+1FA40
+		"white chess knight-bishopblack chess knight-queenblack chess knight-rookblack chess knight-bishop" + // U+1FA50
+		"xiangqi red generalxiangqi red mandarinxiangqi red elephantxiangqi red horsexiangqi red chariotxiangqi red cannonxiangqi red soldierxiangqi black generalxiangqi black mandarinxiangqi black elephantxiangqi black horsexiangqi black chariotxiangqi black cannonxiangqi black soldier" + // U+1FA60
+		"ballet shoesone-piece swimsuitbriefsshortsthong sandallight blue heartgrey heartpink heartdrop of bloodadhesive bandagestethoscopex-raycrutch" + // U+1FA70
+		"yo-yokiteparachuteboomerangmagic wandpinatanesting dollsmaracasflute" + // U+1FA80
+		"ringed planetchairrazoraxediya lampbanjomilitary helmetaccordionlong drumcoincarpentry sawscrewdriverladderhookmirrorwindow" + // U+1FA90
+		"plungersewing needleknotbucketmouse traptoothbrushheadstoneplacardrockmirror ballidentification cardlow batteryhamsafolding hand fanhair pickkhanda" + // U+1FAA0
+		"flywormbeetlecockroachpotted plantwoodfeatherlotuscoralempty nestnest with eggshyacinthjellyfishwinggoose" + // U+1FAB0
+		"anatomical heartlungspeople huggingpregnant manpregnant personperson with crownmoosedonkey" + // U+1FAC0
+		"blueberriesbell pepperoliveflatbreadtamalefondueteapotpouring liquidbeansjarginger rootpea pod" + // U+1FAD0
+		"melting facesaluting faceface with open eyes and hand over mouthface with peeking eyeface with diagonal mouthdotted line facebiting lipbubblesshaking face" + // U+1FAE0
+		"hand with index finger and thumb crossedrightwards handleftwards handpalm down handpalm up handindex pointing at the viewerheart handsleftwards pushing handrightwards pushing hand" + // U+1FAF0
+		"block sextant-1block sextant-2block sextant-12block sextant-3block sextant-13block sextant-23block sextant-123block sextant-4block sextant-14block sextant-24block sextant-124block sextant-34block sextant-134block sextant-234block sextant-5" + // U+1FB00
+		"block sextant-15block sextant-25block sextant-125block sextant-35block sextant-235block sextant-45block sextant-145block sextant-245block sextant-345block sextant-6block sextant-16" + // U+1FB10
+		"block sextant-26block sextant-126block sextant-36block sextant-136block sextant-236block sextant-46block sextant-146block sextant-346block sextant-56block sextant-156block sextant-256" + // U+1FB20
+		"block sextant-356block sextant-456lower left block diagonal lower middle left to lower centrelower left block diagonal lower middle left to lower rightlower left block diagonal upper middle left to lower centrelower left block diagonal upper middle left to lower right" + // U+1FB30
+		"lower left block diagonal upper left to lower centrelower right block diagonal upper middle left to upper centrelower right block diagonal upper middle left to upper rightlower right block diagonal lower middle left to upper centrelower right block diagonal lower middle left to upper rightlower right block diagonal lower left to upper centrelower right block diagonal lower middle left to upper middle rightlower right block diagonal lower centre to lower middle rightlower right block diagonal lower left to lower middle rightlower right block diagonal lower centre to upper middle rightlower right block diagonal lower left to upper middle rightlower right block diagonal lower centre to upper rightlower left block diagonal upper centre to upper middle rightlower left block diagonal upper left to upper middle rightlower left block diagonal upper centre to lower middle rightlower left block diagonal upper left to lower middle right" + // U+1FB40
+		"lower left block diagonal upper centre to lower rightlower left block diagonal upper middle left to lower middle rightupper right block diagonal lower middle left to lower centreupper right block diagonal lower middle left to lower rightupper right block diagonal upper middle left to lower centreupper right block diagonal upper middle left to lower rightupper right block diagonal upper left to lower centreupper left block diagonal upper middle left to upper centreupper left block diagonal upper middle left to upper rightupper left block diagonal lower middle left to upper centreupper left block diagonal lower middle left to upper rightupper left block diagonal lower left to upper centreupper left block diagonal lower middle left to upper middle rightupper left block diagonal lower centre to lower middle rightupper left block diagonal lower left to lower middle rightupper left block diagonal lower centre to upper middle right" + // U+1FB50
+		"upper left block diagonal lower left to upper middle rightupper left block diagonal lower centre to upper rightupper right block diagonal upper centre to upper middle rightupper right block diagonal upper left to upper middle rightupper right block diagonal upper centre to lower middle rightupper right block diagonal upper left to lower middle rightupper right block diagonal upper centre to lower rightupper right block diagonal upper middle left to lower middle rightupper and right and lower triangular three quarters blockleft and lower and right triangular three quarters blockupper and left and lower triangular three quarters blockleft and upper and right triangular three quarters blockleft triangular one quarter blockupper triangular one quarter blockright triangular one quarter blocklower triangular one quarter block" + // U+1FB60
+		"vertical one eighth block-2vertical one eighth block-3vertical one eighth block-4vertical one eighth block-5vertical one eighth block-6vertical one eighth block-7horizontal one eighth block-2horizontal one eighth block-3horizontal one eighth block-4horizontal one eighth block-5horizontal one eighth block-6horizontal one eighth block-7left and lower one eighth blockleft and upper one eighth blockright and upper one eighth blockright and lower one eighth block" + // U+1FB70
+		"upper and lower one eighth blockupper one quarter blockupper three eighths blockupper five eighths blockupper three quarters blockupper seven eighths blockright one quarter blockright three eighths blockright five eighths blockright three quarters blockright seven eighths blockleft half medium shaderight half medium shadeupper half medium shadelower half medium shade" + // U+1FB80
+		"inverse medium shadeupper half block and lower half inverse medium shadeupper half inverse medium shade and lower half blockleft half inverse medium shade and right half blockchecker board fillinverse checker board fillheavy horizontal fillupper left to lower right fillupper right to lower left fillupper and lower triangular half blockleft and right triangular half blockupper left triangular medium shadeupper right triangular medium shadelower right triangular medium shadelower left triangular medium shade" + // U+1FB90
+		"box drawings light diagonal upper centre to middle leftbox drawings light diagonal upper centre to middle rightbox drawings light diagonal middle left to lower centrebox drawings light diagonal middle right to lower centrebox drawings light diagonal upper centre to middle left to lower centrebox drawings light diagonal upper centre to middle right to lower centrebox drawings light diagonal middle left to lower centre to middle rightbox drawings light diagonal middle left to upper centre to middle rightbox drawings light diagonal upper centre to middle left and middle right to lower centrebox drawings light diagonal upper centre to middle right and middle left to lower centrebox drawings light diagonal upper centre to middle right to lower centre to middle leftbox drawings light diagonal upper centre to middle left to lower centre to middle rightbox drawings light diagonal middle left to upper centre to middle right to lower centrebox drawings light diagonal middle right to upper centre to middle left to lower centrebox drawings light diagonal diamondbox drawings light horizontal with vertical stroke" + // U+1FBA0
+		"arrowhead-shaped pointerinverse check markleft half running manright half running maninverse downwards arrow with tip leftwardsleftwards arrow and upper and lower one eighth blockrightwards arrow and upper and lower one eighth blockdownwards arrow and right one eighth blockupwards arrow and right one eighth blockleft half folderright half foldervoided greek crossright open squared dotnegative diagonal crossnegative diagonal middle right to lower centrenegative diagonal diamond" + // U+1FBB0
+		"white heavy saltire with rounded cornersleft third white right pointing indexmiddle third white right pointing indexright third white right pointing indexnegative squared question markstick figurestick figure with arms raisedstick figure leaning leftstick figure leaning rightstick figure with dresswhite up-pointing chevron", // U+1FBC0
+}
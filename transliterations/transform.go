@@ -0,0 +1,111 @@
+package transliterations
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxSegment is the length in bytes past which a Transformer cuts text
+// that has no whitespace in it.
+const maxSegment = 1024
+
+// Transformer returns a transform.Transformer that transliterates without
+// any language profile, see (*Profile).Transformer.
+func Transformer() transform.Transformer {
+	var p *Profile
+	return p.Transformer()
+}
+
+// Transformer returns a transform.Transformer that transliterates the way
+// p.TransliterateString does, for use with transform.NewReader and
+// transform.NewWriter on text too large to hold in memory.
+//
+// The text is transliterated a segment at a time, cut after whitespace,
+// so that words are transliterated whole and the result is the same as
+// TransliterateString's. Words longer than 1 KB are cut where the
+// normalization allows it, which may change how their parts are read.
+// Kanji are read as Japanese from the first segment with kana in it on.
+func (p *Profile) Transformer() transform.Transformer {
+	return &transformer{profile: p}
+}
+
+// SegmentTransformer returns a transform.Transformer that transforms text
+// with f a segment at a time, cutting it the way (*Profile).Transformer
+// does, for functions of whole strings that only look at the words around
+// a rune. f is given the segments in NFC.
+func SegmentTransformer(f func(text string) string) transform.Transformer {
+	return &transformer{f: f}
+}
+
+type transformer struct {
+	profile *Profile
+	f       func(text string) string
+	kanji   *JapaneseDictionary
+	// pending holds the transliteration of the last segment that did not
+	// fit in dst.
+	pending []byte
+}
+
+func (t *transformer) Reset() {
+	t.kanji = nil
+	t.pending = t.pending[:0]
+}
+
+func (t *transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for {
+		n := copy(dst[nDst:], t.pending)
+		nDst += n
+		t.pending = t.pending[:copy(t.pending, t.pending[n:])]
+		if len(t.pending) > 0 {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		if nSrc == len(src) {
+			return nDst, nSrc, nil
+		}
+
+		end := segment(src[nSrc:], atEOF)
+		if end == 0 {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		text := norm.NFC.String(string(src[nSrc : nSrc+end]))
+		if t.f != nil {
+			t.pending = append(t.pending, t.f(text)...)
+			nSrc += end
+			continue
+		}
+		if t.kanji == nil {
+			t.kanji = t.profile.kanji(text)
+		}
+		t.pending = append(t.pending, t.profile.transliterate(text, t.kanji, nil)...)
+		nSrc += end
+	}
+}
+
+// segment returns the length in bytes of the start of src that can be
+// transliterated on its own: up to the last whitespace that is followed by
+// a normalization boundary, or the last boundary if there is no such
+// whitespace and src is longer than maxSegment. It returns 0 if src needs
+// more text after it.
+func segment(src []byte, atEOF bool) int {
+	if atEOF {
+		return len(src)
+	}
+	end := 0
+	for i := 0; i < len(src) && utf8.FullRune(src[i:]); {
+		r, size := utf8.DecodeRune(src[i:])
+		i += size
+		if unicode.IsSpace(r) && norm.NFC.FirstBoundary(src[i:]) == 0 {
+			end = i
+		}
+	}
+	if end == 0 && len(src) > maxSegment {
+		end = norm.NFC.LastBoundary(src)
+	}
+	if end < 0 {
+		return 0
+	}
+	return end
+}
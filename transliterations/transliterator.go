@@ -0,0 +1,76 @@
+package transliterations
+
+// Transliterator transliterates single runes. It returns false when it
+// has no transliteration for r, so that the next Transliterator of a
+// Chain is tried.
+type Transliterator interface {
+	Transliterate(r rune) (string, bool)
+}
+
+// TransliteratorFunc adapts a function to a Transliterator.
+type TransliteratorFunc func(r rune) (string, bool)
+
+// Transliterate calls f(r).
+func (f TransliteratorFunc) Transliterate(r rune) (string, bool) {
+	return f(r)
+}
+
+// Map is a Transliterator of single runes. It must not be modified once
+// it is in use.
+type Map map[rune]string
+
+// Transliterate returns the transliteration of r in m.
+func (m Map) Transliterate(r rune) (string, bool) {
+	out, ok := m[r]
+	return out, ok
+}
+
+// Sections is a Transliterator of whole sections, like the built-in
+// tables: the table of a section holds the transliterations of the 256
+// code points that share all but their last two hex digits, so
+// Sections{0x1f6: table} covers U+1F600 to U+1F6FF. A table may be
+// shorter than 256, leaving out the end of the section. It must not be
+// modified once it is in use.
+type Sections map[rune][]string
+
+// Transliterate returns the transliteration of r in the table of its
+// section.
+func (t Sections) Transliterate(r rune) (string, bool) {
+	if tb, ok := t[r>>8]; ok && int(r&0xff) < len(tb) {
+		return tb[r&0xff], true
+	}
+	return "", false
+}
+
+// Builtin is the Transliterator of the built-in tables, Transliterate. It
+// has a transliteration for every rune.
+var Builtin Transliterator = TransliteratorFunc(func(r rune) (string, bool) {
+	return Transliterate(r), true
+})
+
+type chain []Transliterator
+
+// Chain returns a Transliterator that uses the first transliteration any
+// of ts has, in order, so
+//
+//	Chain(Map{'€': "euro"}, Builtin)
+//
+// is the built-in tables with € written as euro.
+func Chain(ts ...Transliterator) Transliterator {
+	var c chain
+	for _, t := range ts {
+		if t != nil {
+			c = append(c, t)
+		}
+	}
+	return c
+}
+
+func (c chain) Transliterate(r rune) (string, bool) {
+	for _, t := range c {
+		if out, ok := t.Transliterate(r); ok {
+			return out, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,148 @@
+package slugify
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// Measure tells how much a rune counts towards the maximum length of a
+// slug.
+type Measure func(r rune) int
+
+// MeasureBytes counts the length of a rune in UTF-8 bytes. It is the
+// default, and keeps a slug within a column or key size limit.
+func MeasureBytes(r rune) int {
+	return utf8.RuneLen(r)
+}
+
+// MeasureRunes counts every rune as one.
+func MeasureRunes(r rune) int {
+	return 1
+}
+
+// MeasureWidth counts the columns a rune takes on a terminal or in a
+// monospaced font: two for East Asian wide and fullwidth runes and one
+// for everything else.
+func MeasureWidth(r rune) int {
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	}
+	return 1
+}
+
+// Truncator joins the words of a slug with sep, cutting the result down
+// so that it measures no more than maxLen.
+type Truncator func(words []string, sep string, maxLen int, measure Measure) string
+
+// TruncateRunes joins the words and cuts the result after the last whole
+// rune that fits, even when that is in the middle of a word. It is the
+// default.
+func TruncateRunes(words []string, sep string, maxLen int, measure Measure) string {
+	text := strings.Join(words, sep)
+	n := 0
+	for i, r := range text {
+		n += measure(r)
+		if n > maxLen {
+			return strings.TrimSuffix(text[:i], sep)
+		}
+	}
+	return text
+}
+
+// TruncateAtSeparator keeps as many leading words as fit and cuts at the
+// last separator before the limit. A first word that does not fit on its
+// own is cut with TruncateRunes instead of leaving the slug empty.
+func TruncateAtSeparator(words []string, sep string, maxLen int, measure Measure) string {
+	n := 0
+	for i, word := range words {
+		if i > 0 {
+			n += measureString(sep, measure)
+		}
+		n += measureString(word, measure)
+		if n > maxLen {
+			if i == 0 {
+				return TruncateRunes(words[:1], sep, maxLen, measure)
+			}
+			return strings.Join(words[:i], sep)
+		}
+	}
+	return strings.Join(words, sep)
+}
+
+// TruncateWords keeps whole words only, dropping every word that does not
+// fit in what is left of the limit and trying the ones after it. When no
+// word fits the first one is cut with TruncateRunes.
+func TruncateWords(words []string, sep string, maxLen int, measure Measure) string {
+	kept := make([]string, 0, len(words))
+	n, sepLen := 0, measureString(sep, measure)
+	for _, word := range words {
+		wordLen := measureString(word, measure)
+		if len(kept) > 0 {
+			wordLen += sepLen
+		}
+		if n+wordLen <= maxLen {
+			kept = append(kept, word)
+			n += wordLen
+		}
+	}
+	if len(kept) == 0 && len(words) > 0 {
+		return TruncateRunes(words[:1], sep, maxLen, measure)
+	}
+	return strings.Join(kept, sep)
+}
+
+func measureString(s string, measure Measure) (n int) {
+	for _, r := range s {
+		n += measure(r)
+	}
+	return n
+}
+
+// DefaultHashAlphabet is the alphabet hash suffixes are written in unless
+// WithHashAlphabet says otherwise.
+const DefaultHashAlphabet = "0123456789abcdef"
+
+// hashSuffix returns a hash of text that is length runes long and
+// written in alphabet.
+func hashSuffix(text string, length int, alphabet []rune) string {
+	sum := sha256.Sum256([]byte(text))
+	n := new(big.Int).SetBytes(sum[:])
+	base := big.NewInt(int64(len(alphabet)))
+	digit := new(big.Int)
+	out := make([]rune, length)
+	for i := range out {
+		n.DivMod(n, base, digit)
+		out[i] = alphabet[digit.Int64()]
+	}
+	return string(out)
+}
+
+// hashAlphabet keeps the ASCII letters and digits of alphabet, lowercased
+// when the slug is, so the suffix never breaks the guarantees of the
+// slug it is added to.
+func hashAlphabet(alphabet string, caseMode CaseMode) []rune {
+	var runes []rune
+	seen := map[rune]bool{}
+	for _, r := range alphabet {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			continue
+		}
+		if caseMode == CaseLower {
+			r = unicode.ToLower(r)
+		}
+		if !seen[r] {
+			seen[r] = true
+			runes = append(runes, r)
+		}
+	}
+	if len(runes) < 2 {
+		return []rune(DefaultHashAlphabet)
+	}
+	return runes
+}
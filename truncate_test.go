@@ -0,0 +1,82 @@
+package slugify
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncators(t *testing.T) {
+	var tests = []struct {
+		truncate Truncator
+		measure  Measure
+		words    []string
+		maxLen   int
+		out      string
+	}{
+		{TruncateRunes, MeasureBytes, []string{"simple", "test"}, 9, "simple-te"},
+		{TruncateRunes, MeasureBytes, []string{"simple", "test"}, 7, "simple"},
+		{TruncateRunes, MeasureBytes, []string{"привет", "мир"}, 5, "пр"},
+		{TruncateRunes, MeasureRunes, []string{"привет", "мир"}, 8, "привет-м"},
+		{TruncateRunes, MeasureWidth, []string{"日本語", "テスト"}, 7, "日本語"},
+		{TruncateAtSeparator, MeasureBytes, []string{"simple", "test", "case"}, 14, "simple-test"},
+		{TruncateAtSeparator, MeasureBytes, []string{"simple", "test"}, 3, "sim"},
+		{TruncateWords, MeasureBytes, []string{"a", "verylongword", "of", "text"}, 9, "a-of-text"},
+		{TruncateWords, MeasureBytes, []string{"verylongword"}, 4, "very"},
+	}
+
+	for _, test := range tests {
+		out := test.truncate(test.words, "-", test.maxLen, test.measure)
+		if out != test.out {
+			t.Errorf("%q: %q != %q", test.words, out, test.out)
+		}
+		if !utf8.ValidString(out) {
+			t.Errorf("%q: %q is not valid UTF-8", test.words, out)
+		}
+	}
+}
+
+func TestSlugifierTruncator(t *testing.T) {
+	var tests = []struct {
+		opts    []Option
+		in, out string
+	}{
+		{[]Option{WithMaxLen(2)}, "simple test", "si"},
+		{[]Option{WithMaxLen(8), WithTruncator(TruncateAtSeparator)}, "simple test", "simple"},
+		{[]Option{WithMaxLen(7), WithTruncator(TruncateWords)}, "a simple test", "a-test"},
+		{[]Option{WithMaxLen(8), WithTruncator(TruncateAtSeparator), WithSeparator(NoSeparator)}, "Simple Test", "simple"},
+	}
+
+	for _, test := range tests {
+		if out := New(test.opts...).Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+}
+
+func TestSlugifierHashSuffix(t *testing.T) {
+	s := New(WithMaxLen(16), WithHashSuffix(6))
+
+	a := s.Slugify("A very long title about gophers")
+	b := s.Slugify("A very long title about badgers")
+	if a == b {
+		t.Errorf("%q and %q collide", a, b)
+	}
+	if a[:9] != "a-very-lo" || len(a) != 16 {
+		t.Errorf("%q does not keep a 9 byte prefix and a hash", a)
+	}
+	if again := s.Slugify("A very long title about gophers"); again != a {
+		t.Errorf("%q != %q", again, a)
+	}
+	if short := s.Slugify("Short title"); short != "short-title" {
+		t.Errorf("%q != %q", short, "short-title")
+	}
+
+	id := New(WithCase(CasePreserve), WithMaxLen(12), WithHashSuffix(4), WithHashAlphabet("ABCDÉF_"))
+	out := id.Slugify("Simples código em go")
+	for _, r := range out[len(out)-4:] {
+		if !strings.ContainsRune("ABCDF", r) {
+			t.Errorf("%q: hash uses %q outside of the alphabet", out, r)
+		}
+	}
+}
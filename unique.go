@@ -0,0 +1,214 @@
+package slugify
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrNoUniqueSlug is returned by UniqueSlugger when every attempt it was
+// allowed to make was already taken.
+var ErrNoUniqueSlug = errors.New("slugify: no unique slug found")
+
+// Store tells a UniqueSlugger which slugs are already taken.
+type Store interface {
+	Exists(ctx context.Context, slug string) (bool, error)
+}
+
+// Reserver is a Store that can also claim a slug. Reserve atomically
+// checks that slug is free and marks it as taken, returning false if it
+// was already taken. A UniqueSlugger prefers Reserve over Exists, so two
+// callers sharing a Reserver never get the same slug.
+type Reserver interface {
+	Store
+	Reserve(ctx context.Context, slug string) (bool, error)
+}
+
+// Suffixer returns the suffix to try for the attempt'th time a slug is
+// taken, attempt starting at 2. slug is the slug that was first tried.
+type Suffixer func(slug string, attempt int) string
+
+// NumericSuffix tries my-post-2, my-post-3 and so on.
+func NumericSuffix(slug string, attempt int) string {
+	return strconv.Itoa(attempt)
+}
+
+// RandomSuffix returns a Suffixer that tries random lowercase letters and
+// digits, length runes long.
+func RandomSuffix(length int) Suffixer {
+	alphabet := []rune("0123456789abcdefghijklmnopqrstuvwxyz")
+	return func(slug string, attempt int) string {
+		out := make([]rune, length)
+		max := big.NewInt(int64(len(alphabet)))
+		for i := range out {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				panic(err)
+			}
+			out[i] = alphabet[n.Int64()]
+		}
+		return string(out)
+	}
+}
+
+// HashSuffix returns a Suffixer that tries a hash of the slug and the
+// attempt, length runes long, so the same text gets the same sequence of
+// slugs every time.
+func HashSuffix(length int) Suffixer {
+	alphabet := []rune(DefaultHashAlphabet)
+	return func(slug string, attempt int) string {
+		return hashSuffix(slug+"\x00"+strconv.Itoa(attempt), length, alphabet)
+	}
+}
+
+// UniqueSlugger slugifies texts with a Slugifier and adds a suffix until
+// its Store says the slug is free. The suffix is never truncated away: it
+// takes its room out of the Slugifier's maximum length, and only a suffix
+// that does not fit in it on its own is cut. Slugs with a suffix are cut
+// without the hash of WithHashSuffix, which the suffix stands in for.
+type UniqueSlugger struct {
+	slugifier   *Slugifier
+	store       Store
+	suffix      Suffixer
+	maxAttempts int
+}
+
+// UniqueOption configures a UniqueSlugger built by NewUniqueSlugger.
+type UniqueOption func(*UniqueSlugger)
+
+// WithSuffixer sets how suffixes are made, NumericSuffix by default.
+func WithSuffixer(suffix Suffixer) UniqueOption {
+	return func(u *UniqueSlugger) {
+		u.suffix = suffix
+	}
+}
+
+// WithMaxAttempts sets how many slugs are tried, including the one
+// without a suffix, before giving up with ErrNoUniqueSlug. It is 100 by
+// default.
+func WithMaxAttempts(maxAttempts int) UniqueOption {
+	return func(u *UniqueSlugger) {
+		u.maxAttempts = maxAttempts
+	}
+}
+
+// NewUniqueSlugger builds a UniqueSlugger that makes slugs with slugifier
+// and checks them against store.
+func NewUniqueSlugger(slugifier *Slugifier, store Store, opts ...UniqueOption) *UniqueSlugger {
+	u := &UniqueSlugger{
+		slugifier:   slugifier,
+		store:       store,
+		suffix:      NumericSuffix,
+		maxAttempts: 100,
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
+}
+
+// Slugify returns a slug of text that the store did not have, or ErrEmpty
+// if nothing in text makes it into a slug. When the store is a Reserver
+// the slug is also reserved in it.
+func (u *UniqueSlugger) Slugify(ctx context.Context, text string) (string, error) {
+	s := u.slugifier
+	words := s.slugWords(text)
+	slug := s.cleanup(words, s.maxLen)
+	if slug == "" {
+		return "", ErrEmpty
+	}
+
+	for attempt := 1; attempt <= u.maxAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		candidate := slug
+		if attempt > 1 {
+			candidate = u.withSuffix(words, u.suffix(slug, attempt))
+		}
+		candidate = s.finish(candidate)
+		free, err := u.claim(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if free {
+			return candidate, nil
+		}
+	}
+	return "", ErrNoUniqueSlug
+}
+
+func (u *UniqueSlugger) withSuffix(words []string, suffix string) string {
+	s := u.slugifier
+	sep := s.sep()
+	maxLen := s.maxLen
+	if maxLen > 0 {
+		maxLen -= measureString(sep+suffix, s.measure)
+		if maxLen <= 0 {
+			// There is no room left for the slug, and maybe not for all
+			// of the suffix either.
+			return TruncateRunes([]string{suffix}, "", s.maxLen, s.measure)
+		}
+	}
+	prefix := strings.Join(words, sep)
+	if maxLen > 0 {
+		prefix = s.truncate(words, sep, maxLen, s.measure)
+	}
+	if prefix != "" {
+		return prefix + sep + suffix
+	}
+	return suffix
+}
+
+func (u *UniqueSlugger) claim(ctx context.Context, slug string) (bool, error) {
+	if r, ok := u.store.(Reserver); ok {
+		return r.Reserve(ctx, slug)
+	}
+	exists, err := u.store.Exists(ctx, slug)
+	return !exists, err
+}
+
+// MemoryStore is an in-memory Reserver, safe for concurrent use.
+type MemoryStore struct {
+	mu    sync.Mutex
+	slugs map[string]bool
+}
+
+// NewMemoryStore returns a MemoryStore holding slugs.
+func NewMemoryStore(slugs ...string) *MemoryStore {
+	m := &MemoryStore{slugs: make(map[string]bool, len(slugs))}
+	for _, slug := range slugs {
+		m.slugs[slug] = true
+	}
+	return m
+}
+
+// Exists reports whether slug is taken.
+func (m *MemoryStore) Exists(ctx context.Context, slug string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.slugs[slug], nil
+}
+
+// Reserve marks slug as taken, returning false if it already was.
+func (m *MemoryStore) Reserve(ctx context.Context, slug string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.slugs[slug] {
+		return false, nil
+	}
+	m.slugs[slug] = true
+	return true, nil
+}
+
+// Release frees a slug that was reserved, for example because the record
+// it was meant for was never saved.
+func (m *MemoryStore) Release(slug string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.slugs, slug)
+}
@@ -0,0 +1,129 @@
+package slugify
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestUniqueSlugger(t *testing.T) {
+	store := NewMemoryStore("my-post", "my-post-2")
+	u := NewUniqueSlugger(New(), store)
+
+	var tests = []struct{ in, out string }{
+		{"My Post", "my-post-3"},
+		{"My Post", "my-post-4"},
+		{"Other Post", "other-post"},
+	}
+
+	for _, test := range tests {
+		out, err := u.Slugify(context.Background(), test.in)
+		if err != nil {
+			t.Fatalf("%q: %v", test.in, err)
+		}
+		if out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+}
+
+func TestUniqueSluggerMaxLen(t *testing.T) {
+	store := NewMemoryStore("a-long-t")
+	u := NewUniqueSlugger(New(WithMaxLen(8)), store, WithSuffixer(HashSuffix(3)))
+
+	out, err := u.Slugify(context.Background(), "A long title")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != 8 || out[:4] != "a-lo" {
+		t.Errorf("%q does not keep the suffix within the maximum length", out)
+	}
+}
+
+func TestUniqueSluggerLongSuffix(t *testing.T) {
+	for _, suffix := range []Suffixer{HashSuffix(4), HashSuffix(6), RandomSuffix(10)} {
+		store := NewMemoryStore("a-lo")
+		u := NewUniqueSlugger(New(WithMaxLen(4)), store, WithSuffixer(suffix))
+
+		out, err := u.Slugify(context.Background(), "A long title")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(out) != 4 || out == "a-lo" {
+			t.Errorf("%q is not a new slug within the maximum length", out)
+		}
+	}
+}
+
+func TestUniqueSluggerHashSuffix(t *testing.T) {
+	s := New(WithMaxLen(8), WithHashSuffix(4))
+	store := NewMemoryStore(s.Slugify("aaa bbb ccc"))
+	u := NewUniqueSlugger(s, store)
+
+	out, err := u.Slugify(context.Background(), "aaa bbb ccc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "aaa-bb-2" {
+		t.Errorf("%q != %q", out, "aaa-bb-2")
+	}
+}
+
+func TestUniqueSluggerExhausted(t *testing.T) {
+	store := NewMemoryStore("post", "post-2")
+	u := NewUniqueSlugger(New(), store, WithMaxAttempts(2))
+
+	if out, err := u.Slugify(context.Background(), "Post"); err != ErrNoUniqueSlug {
+		t.Errorf("%q, %v != %v", out, err, ErrNoUniqueSlug)
+	}
+}
+
+func TestUniqueSluggerEmpty(t *testing.T) {
+	u := NewUniqueSlugger(New(), NewMemoryStore())
+
+	for _, in := range []string{"", "!!!", "!!!"} {
+		if out, err := u.Slugify(context.Background(), in); err != ErrEmpty {
+			t.Errorf("%q: %q, %v != %v", in, out, err, ErrEmpty)
+		}
+	}
+}
+
+func TestUniqueSluggerPercentEncoding(t *testing.T) {
+	store := NewMemoryStore("%D0%B9%D0%BE%D0%B4")
+	u := NewUniqueSlugger(New(WithUnicode(), WithPercentEncoding()), store)
+
+	out, err := u.Slugify(context.Background(), "Йод")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "%D0%B9%D0%BE%D0%B4-2"; out != want {
+		t.Errorf("%q != %q", out, want)
+	}
+}
+
+func TestUniqueSluggerConcurrent(t *testing.T) {
+	store := NewMemoryStore()
+	u := NewUniqueSlugger(New(), store, WithSuffixer(RandomSuffix(4)))
+
+	var mu sync.Mutex
+	seen := map[string]bool{}
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			out, err := u.Slugify(context.Background(), "Same title")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if seen[out] {
+				t.Errorf("%q was handed out twice", out)
+			}
+			seen[out] = true
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,102 @@
+package slugify
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
+
+// Unmapped writes a rune the transliteration tables have nothing for,
+// such as an emoji or a letter newer than the tables, see WithUnmapped.
+type Unmapped func(r rune) string
+
+// DropUnmapped leaves unmapped runes out of the slug.
+func DropUnmapped(r rune) string {
+	return ""
+}
+
+// HexUnmapped writes unmapped runes as their code point in hex, u1f600
+// for 😀.
+func HexUnmapped(r rune) string {
+	return "u" + strconv.FormatInt(int64(r), 16)
+}
+
+// NameUnmapped writes unmapped runes as their Unicode name in lowercase,
+// snowman for ☃, or as HexUnmapped does if they have no name of their
+// own, see transliterations.Name.
+func NameUnmapped(r rune) string {
+	if name := transliterations.Name(r); name != "" {
+		return name
+	}
+	return HexUnmapped(r)
+}
+
+// WithUnmapped writes the runes that the tables, or the transliterator
+// set by WithTransliterator, have no transliteration for with f, as a
+// word of their own. Marks, spaces, control and format characters are
+// not unmapped. Whatever f returns is transliterated and anything left
+// that is not ASCII is dropped, so IDs stay ASCII.
+//
+// Without it unmapped letters and numbers are dropped, or kept as they
+// are when a transliterator has nothing for them, and so are symbols.
+func WithUnmapped(f Unmapped) Option {
+	return func(s *Slugifier) {
+		s.unmapped = f
+	}
+}
+
+// unmappedTransliterator writes the runes t has no transliteration for
+// with f.
+type unmappedTransliterator struct {
+	t transliterations.Transliterator
+	f Unmapped
+}
+
+func (u unmappedTransliterator) Transliterate(r rune) (string, bool) {
+	out, ok := u.t.Transliterate(r)
+	if !isUnmapped(r, out, ok) {
+		return out, ok
+	}
+	word := asciiWord(u.f(r))
+	if word == "" {
+		return "", true
+	}
+	return " " + word + " ", true
+}
+
+// isUnmapped reports whether out, the transliteration of r, and ok, whether
+// there was one, mean that r could not be transliterated. What comes from
+// the built-in tables is looked up in them, since they have empty entries
+// for letters that are written with nothing, see transliterations.Lookup.
+func isUnmapped(r rune, out string, ok bool) bool {
+	if unicode.IsMark(r) || unicode.IsSpace(r) || unicode.IsControl(r) || unicode.Is(unicode.Cf, r) {
+		return false
+	}
+	if !ok {
+		return true
+	}
+	if builtin, found := transliterations.Lookup(r); out == builtin {
+		return !found
+	}
+	return strings.TrimSpace(out) == "[?]" || r >= utf8.RuneSelf && out == string(r)
+}
+
+// asciiWord transliterates word and drops what is still not ASCII.
+func asciiWord(word string) string {
+	b := strings.Builder{}
+	for _, r := range word {
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			continue
+		}
+		for _, c := range []byte(transliterations.Transliterate(r)) {
+			if c < utf8.RuneSelf {
+				b.WriteByte(c)
+			}
+		}
+	}
+	return b.String()
+}
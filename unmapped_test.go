@@ -0,0 +1,58 @@
+package slugify
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/digitalxero/slugify/transliterations"
+)
+
+func TestSlugifierUnmapped(t *testing.T) {
+	var tests = []struct {
+		opts    []Option
+		in, out string
+	}{
+		{nil, "I ☃ NY 😀", "i-ny"},
+		{[]Option{WithUnmapped(DropUnmapped)}, "I ☃ NY 😀", "i-ny"},
+		{[]Option{WithUnmapped(HexUnmapped)}, "I ☃ NY 😀", "i-u2603-ny-u1f600"},
+		{[]Option{WithUnmapped(NameUnmapped)}, "I ☃ NY 😀", "i-snowman-ny-grinning-face"},
+		{[]Option{WithUnmapped(NameUnmapped)}, "a😀b", "a-grinning-face-b"},
+		{[]Option{WithUnmapped(NameUnmapped)}, "Ᲊ", "u1c89"},
+		{[]Option{WithUnmapped(NameUnmapped)}, "café, ok", "cafe-ok"},
+		{[]Option{WithUnmapped(func(r rune) string { return "Жук" })}, "a ☃", "a-zhuk"},
+		{[]Option{WithUnmapped(HexUnmapped), WithTransliterator(transliterations.Map{})}, "жук", "u436-u443-u43a"},
+		{[]Option{WithUnmapped(HexUnmapped)}, "سلام عليكم", "slm-lykm"},
+		{[]Option{WithUnmapped(NameUnmapped)}, "שלום אבא", "shlvm-b"},
+		{[]Option{WithUnmapped(HexUnmapped)}, "𰀀 x", "u30000-x"},
+		{[]Option{WithUnmapped(NameUnmapped)}, "𰀀 x", "u30000-x"},
+	}
+
+	for _, test := range tests {
+		if out := New(test.opts...).Slugify(test.in); out != test.out {
+			t.Errorf("%q: %q != %q", test.in, out, test.out)
+		}
+	}
+}
+
+func TestSlugifierUnmappedASCII(t *testing.T) {
+	policies := []Unmapped{
+		DropUnmapped,
+		HexUnmapped,
+		NameUnmapped,
+		func(r rune) string { return string(r) + "é" },
+	}
+
+	var text []rune
+	for r := rune(0x80); r < 0x30000; r += 7 {
+		text = append(text, r)
+	}
+	for i, policy := range policies {
+		out := New(WithCase(CasePreserve), WithUnmapped(policy)).Slugify(string(text))
+		for _, r := range out {
+			if r > unicode.MaxASCII {
+				t.Errorf("policy %d: %q is not ASCII", i, r)
+				break
+			}
+		}
+	}
+}